@@ -35,7 +35,7 @@ func main() {
 
 	grpcClients := grpc.InitializeGrpcClients(cfg)
 	handlers.InitClients(grpcClients)
-	routes.SetUpRoutes(router, grpcClients.UserClient)
+	routes.SetUpRoutes(router, grpcClients.UserClient, grpcClients.EducationClient)
 
 	port := cfg.Server.Port
 	log.Printf("Starting api gateway on port %s", port)
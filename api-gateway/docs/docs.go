@@ -19,47 +19,71 @@ const docTemplate = `{
     "host": "{{.Host}}",
     "basePath": "{{.BasePath}}",
     "paths": {
-        "/api/attendance/get-attendance": {
-            "post": {
+        "/api/attendance/analytics/rates": {
+            "get": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "Retrieve attendance records for students in a group over a specified date range.",
+                "description": "Attendance rate of the marked lessons between from and till per student, group, teacher or course. A teacher only gets its own lessons",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
                     "attendance"
                 ],
-                "summary": "ADMIN , TEACHER",
+                "summary": "ADMIN , CEO , FINANCIST , TEACHER",
                 "parameters": [
                     {
-                        "description": "Group ID and date range",
-                        "name": "attendance",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/pb.GetAttendanceRequest"
-                        }
+                        "type": "string",
+                        "description": "yyyy-MM-dd",
+                        "name": "from",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "yyyy-MM-dd",
+                        "name": "till",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "STUDENT, GROUP, TEACHER or COURSE",
+                        "name": "by",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Group ID",
+                        "name": "groupId",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Teacher ID",
+                        "name": "teacherId",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Course ID",
+                        "name": "courseId",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Attendance records",
-                        "schema": {
-                            "$ref": "#/definitions/pb.GetAttendanceResponse"
-                        }
-                    },
-                    "400": {
-                        "description": "Invalid request",
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/utils.AbsResponse"
+                            "$ref": "#/definitions/pb.GetAttendanceRatesResponse"
                         }
                     },
-                    "500": {
-                        "description": "Internal server error",
+                    "409": {
+                        "description": "Conflict",
                         "schema": {
                             "$ref": "#/definitions/utils.AbsResponse"
                         }
@@ -67,47 +91,50 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/attendance/set": {
-            "post": {
+        "/api/attendance/analytics/streaks": {
+            "get": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "Record attendance for a student in a group on a specific date.",
+                "description": "Active students who missed their last lessons in a row, longest streak first",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
                     "attendance"
                 ],
-                "summary": "TEACHER",
+                "summary": "ADMIN , CEO , FINANCIST , TEACHER",
                 "parameters": [
                     {
-                        "description": "Attendance details",
-                        "name": "attendance",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/pb.SetAttendanceRequest"
-                        }
+                        "type": "string",
+                        "description": "Group ID",
+                        "name": "groupId",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Teacher ID",
+                        "name": "teacherId",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Shortest streak returned, 2 by default",
+                        "name": "minStreak",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Attendance recorded successfully",
-                        "schema": {
-                            "$ref": "#/definitions/utils.AbsResponse"
-                        }
-                    },
-                    "400": {
-                        "description": "Invalid request",
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/utils.AbsResponse"
+                            "$ref": "#/definitions/pb.GetAbsenceStreaksResponse"
                         }
                     },
-                    "500": {
-                        "description": "Internal server error",
+                    "409": {
+                        "description": "Conflict",
                         "schema": {
                             "$ref": "#/definitions/utils.AbsResponse"
                         }
@@ -115,33 +142,71 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/common-information-company": {
+        "/api/attendance/analytics/trend": {
             "get": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "Get common information about company",
+                "description": "Attendance rate per day, week or month between from and till for charts",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "education"
+                    "attendance"
+                ],
+                "summary": "ADMIN , CEO , FINANCIST , TEACHER",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "yyyy-MM-dd",
+                        "name": "from",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "yyyy-MM-dd",
+                        "name": "till",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "DAY, WEEK or MONTH",
+                        "name": "interval",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Group ID",
+                        "name": "groupId",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Teacher ID",
+                        "name": "teacherId",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Course ID",
+                        "name": "courseId",
+                        "in": "query"
+                    }
                 ],
-                "summary": "ADMIN , CEO",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "integer"
-                            }
+                            "$ref": "#/definitions/pb.GetAttendanceTrendResponse"
                         }
                     },
-                    "400": {
-                        "description": "Bad Request",
+                    "409": {
+                        "description": "Conflict",
                         "schema": {
                             "$ref": "#/definitions/utils.AbsResponse"
                         }
@@ -149,72 +214,88 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/company-user/create": {
-            "post": {
+        "/api/attendance/at-risk/get-all": {
+            "get": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "Create a new user associated with a company",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Students flagged by the daily at risk job, newest first. A teacher only gets the students of its groups",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "company-user"
+                    "attendance"
                 ],
-                "summary": "SUPER_CEO",
+                "summary": "ADMIN , CEO , TEACHER",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Company ID",
-                        "name": "companyId",
-                        "in": "query",
-                        "required": true
+                        "description": "OPEN (default) or RESOLVED",
+                        "name": "status",
+                        "in": "query"
                     },
                     {
-                        "description": "User data",
-                        "name": "user",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/pb.CreateUserRequest"
-                        }
+                        "type": "string",
+                        "description": "Group ID",
+                        "name": "groupId",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Successfully created user",
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/utils.AbsResponse"
+                            "$ref": "#/definitions/pb.GetAtRiskStudentsResponse"
                         }
                     },
-                    "400": {
-                        "description": "Bad request",
+                    "409": {
+                        "description": "Conflict",
                         "schema": {
                             "$ref": "#/definitions/utils.AbsResponse"
                         }
+                    }
+                }
+            }
+        },
+        "/api/attendance/at-risk/policy": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "When the daily job flags a student as at risk, a rule set to 0 is off",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "attendance"
+                ],
+                "summary": "ADMIN , CEO",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/pb.AtRiskPolicy"
+                        }
                     },
-                    "500": {
-                        "description": "Internal server error",
+                    "409": {
+                        "description": "Conflict",
                         "schema": {
                             "$ref": "#/definitions/utils.AbsResponse"
                         }
                     }
                 }
-            }
-        },
-        "/api/company-user/delete/{userId}": {
-            "delete": {
+            },
+            "put": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "Delete a user by their ID and associated company ID",
+                "description": "Sets the at risk rules of the company. smsParents texts the AT_RISK_ALERT template, (STREAK) and (RATE) are filled in",
                 "consumes": [
                     "application/json"
                 ],
@@ -222,46 +303,35 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "company-user"
+                    "attendance"
                 ],
-                "summary": "SUPER_CEO",
+                "summary": "CEO",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "Company ID",
-                        "name": "companyId",
-                        "in": "query",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "User ID",
-                        "name": "userId",
-                        "in": "path",
-                        "required": true
+                        "description": "At risk policy",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/pb.AtRiskPolicy"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Successfully deleted user",
+                        "description": "OK",
                         "schema": {
                             "$ref": "#/definitions/utils.AbsResponse"
                         }
                     },
                     "400": {
-                        "description": "Bad request",
-                        "schema": {
-                            "$ref": "#/definitions/utils.AbsResponse"
-                        }
-                    },
-                    "404": {
-                        "description": "User not found",
+                        "description": "Bad Request",
                         "schema": {
                             "$ref": "#/definitions/utils.AbsResponse"
                         }
                     },
-                    "500": {
-                        "description": "Internal server error",
+                    "409": {
+                        "description": "Conflict",
                         "schema": {
                             "$ref": "#/definitions/utils.AbsResponse"
                         }
@@ -269,55 +339,41 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/company-user/get-user/{userId}": {
-            "get": {
+        "/api/attendance/get-attendance": {
+            "post": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "Retrieve details of a user by their ID and company ID",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Retrieve attendance records for students in a group over a specified date range.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "company-user"
+                    "attendance"
                 ],
-                "summary": "SUPER_CEO",
+                "summary": "ADMIN , TEACHER",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "Company ID",
-                        "name": "companyId",
-                        "in": "query",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "User ID",
-                        "name": "userId",
-                        "in": "path",
-                        "required": true
+                        "description": "Group ID and date range",
+                        "name": "attendance",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/pb.GetAttendanceRequest"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Successfully retrieved user",
+                        "description": "Attendance records",
                         "schema": {
-                            "$ref": "#/definitions/pb.GetUserByIdResponse"
+                            "$ref": "#/definitions/pb.GetAttendanceResponse"
                         }
                     },
                     "400": {
-                        "description": "Bad request",
-                        "schema": {
-                            "$ref": "#/definitions/utils.AbsResponse"
-                        }
-                    },
-                    "404": {
-                        "description": "User not found",
+                        "description": "Invalid request",
                         "schema": {
                             "$ref": "#/definitions/utils.AbsResponse"
                         }
@@ -331,14 +387,43 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/company-user/update": {
-            "patch": {
+        "/api/attendance/policy": {
+            "get": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "Update user details by their ID and company ID",
+                "description": "When teachers may mark attendance: editDaysBack days back until cutoffHour in timezone, future dates with allowFuture. overrideRoles mark any date",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "attendance"
+                ],
+                "summary": "ADMIN , CEO , FINANCIST , TEACHER",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/pb.AttendancePolicy"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Sets the attendance policy of the company. cutoffHour is 0-24, timezone an IANA name like Asia/Tashkent, overrideRoles any of CEO, ADMIN, FINANCIST, TEACHER",
                 "consumes": [
                     "application/json"
                 ],
@@ -346,48 +431,35 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "company-user"
+                    "attendance"
                 ],
-                "summary": "SUPER_CEO",
+                "summary": "CEO",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "Company ID",
-                        "name": "companyId",
-                        "in": "query",
-                        "required": true
-                    },
-                    {
-                        "description": "Updated user data",
-                        "name": "user",
+                        "description": "Attendance policy",
+                        "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/pb.UpdateUserRequest"
+                            "$ref": "#/definitions/pb.AttendancePolicy"
                         }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Successfully updated user",
+                        "description": "OK",
                         "schema": {
                             "$ref": "#/definitions/utils.AbsResponse"
                         }
                     },
                     "400": {
-                        "description": "Bad request",
-                        "schema": {
-                            "$ref": "#/definitions/utils.AbsResponse"
-                        }
-                    },
-                    "404": {
-                        "description": "User not found",
+                        "description": "Bad Request",
                         "schema": {
                             "$ref": "#/definitions/utils.AbsResponse"
                         }
                     },
-                    "500": {
-                        "description": "Internal server error",
+                    "409": {
+                        "description": "Conflict",
                         "schema": {
                             "$ref": "#/definitions/utils.AbsResponse"
                         }
@@ -395,44 +467,47 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/company/create": {
+        "/api/attendance/set": {
             "post": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "Create a new company",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Record attendance for a student in a group on a specific date. status is a code from /api/attendance/status/get-all, -1 deletes the attendance. A makeup status takes makeupGroupId and makeupDate of the absence it makes up.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "company"
+                    "attendance"
                 ],
-                "summary": "SUPER_CEO",
+                "summary": "TEACHER",
                 "parameters": [
                     {
-                        "description": "Company creation request",
-                        "name": "request",
+                        "description": "Attendance details",
+                        "name": "attendance",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/pb.CreateCompanyRequest"
+                            "$ref": "#/definitions/pb.SetAttendanceRequest"
                         }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK",
+                        "description": "Attendance recorded successfully",
                         "schema": {
                             "$ref": "#/definitions/utils.AbsResponse"
                         }
                     },
                     "400": {
-                        "description": "Bad Request",
+                        "description": "Invalid request",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
                         "schema": {
                             "$ref": "#/definitions/utils.AbsResponse"
                         }
@@ -440,44 +515,47 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/company/finance/create": {
+        "/api/attendance/set-group": {
             "post": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "Create a new company finance record",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Record attendance for the whole roster of a group on one date. Each student gets its own result, status -1 deletes the attendance.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "companyFinance"
+                    "attendance"
                 ],
-                "summary": "Create a finance record",
+                "summary": "ADMIN , CEO , TEACHER , FINANCIST",
                 "parameters": [
                     {
-                        "description": "Company finance details",
-                        "name": "body",
+                        "description": "Roster attendance",
+                        "name": "attendance",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/pb.CompanyFinance"
+                            "$ref": "#/definitions/pb.SetGroupAttendanceRequest"
                         }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "created",
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/utils.AbsResponse"
+                            "$ref": "#/definitions/pb.SetGroupAttendanceResponse"
                         }
                     },
                     "400": {
-                        "description": "Bad request",
+                        "description": "Invalid request",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Attendance could not be recorded",
                         "schema": {
                             "$ref": "#/definitions/utils.AbsResponse"
                         }
@@ -485,14 +563,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/company/finance/delete": {
-            "delete": {
+        "/api/attendance/status/create": {
+            "post": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "Delete a company finance record by its ID",
+                "description": "Adds an attendance status. billable charges the lesson in groups not billed monthly, paysTeacher counts the student in the teacher salary, absenceSms sends the not participate sms and isMakeup marks attending a lesson that makes up an absence. Returns the status code to mark attendance with in message",
                 "consumes": [
                     "application/json"
                 ],
@@ -500,27 +578,35 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "companyFinance"
+                    "attendance"
                 ],
-                "summary": "Delete a finance record",
+                "summary": "ADMIN , CEO",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "ID of the company finance record to delete",
-                        "name": "id",
-                        "in": "query",
-                        "required": true
+                        "description": "Attendance status",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/pb.AbsAttendanceStatus"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "deleted",
+                        "description": "OK",
                         "schema": {
                             "$ref": "#/definitions/utils.AbsResponse"
                         }
                     },
                     "400": {
-                        "description": "Bad request",
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
                         "schema": {
                             "$ref": "#/definitions/utils.AbsResponse"
                         }
@@ -528,44 +614,39 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/company/finance/get-all": {
-            "post": {
+        "/api/attendance/status/delete/{id}": {
+            "delete": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "Retrieve a list of all company finance records",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Deletes an attendance status nothing was marked with, preloaded and used statuses can only be switched off",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "companyFinance"
+                    "attendance"
                 ],
-                "summary": "Get all finance records",
+                "summary": "ADMIN , CEO",
                 "parameters": [
                     {
-                        "description": "Pagination details",
-                        "name": "body",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/pb.PageRequest"
-                        }
+                        "type": "string",
+                        "description": "Attendance status ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "list of finance records",
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/pb.CompanyFinanceList"
+                            "$ref": "#/definitions/utils.AbsResponse"
                         }
                     },
-                    "400": {
-                        "description": "Bad request",
+                    "409": {
+                        "description": "Conflict",
                         "schema": {
                             "$ref": "#/definitions/utils.AbsResponse"
                         }
@@ -573,44 +654,38 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/company/finance/get-by-company": {
-            "post": {
+        "/api/attendance/status/get-all": {
+            "get": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "Retrieve finance records filtered by company",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Attendance statuses of the company, switched off ones only with withInactive",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "companyFinance"
+                    "attendance"
                 ],
-                "summary": "Get finance records by company",
+                "summary": "ADMIN , CEO , FINANCIST , TEACHER",
                 "parameters": [
                     {
-                        "description": "Pagination and filtering details",
-                        "name": "body",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/pb.PageRequest"
-                        }
+                        "type": "boolean",
+                        "description": "Include switched off statuses",
+                        "name": "withInactive",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "list of finance records for a specific company",
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/pb.CompanyFinanceSelfList"
+                            "$ref": "#/definitions/pb.GetAttendanceStatusesResponse"
                         }
                     },
-                    "400": {
-                        "description": "Bad request",
+                    "409": {
+                        "description": "Conflict",
                         "schema": {
                             "$ref": "#/definitions/utils.AbsResponse"
                         }
@@ -618,14 +693,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/company/finance/update": {
+        "/api/attendance/status/update": {
             "put": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "Update finance details for a specific company",
+                "description": "Edits the name and flags of an attendance status, the flags apply to attendance marked before too. Switch a status off with isActive",
                 "consumes": [
                     "application/json"
                 ],
@@ -633,29 +708,35 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "companyFinance"
+                    "attendance"
                 ],
-                "summary": "Update finance records by company",
+                "summary": "ADMIN , CEO",
                 "parameters": [
                     {
-                        "description": "Finance details to be updated",
-                        "name": "body",
+                        "description": "Attendance status",
+                        "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/pb.CompanyFinance"
+                            "$ref": "#/definitions/pb.AbsAttendanceStatus"
                         }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Updated finance details",
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/pb.CompanyFinance"
+                            "$ref": "#/definitions/utils.AbsResponse"
                         }
                     },
                     "400": {
-                        "description": "Bad request",
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
                         "schema": {
                             "$ref": "#/definitions/utils.AbsResponse"
                         }
@@ -663,41 +744,26 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/company/get-all": {
+        "/api/attendance/unlock/get-all": {
             "get": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "Retrieve a paginated list of all companies",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Unlock requests of the company, newest first. A teacher only gets the requests of its own",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "company"
+                    "attendance"
                 ],
-                "summary": "SUPER_CEO",
+                "summary": "ADMIN , CEO , TEACHER",
                 "parameters": [
-                    {
-                        "type": "integer",
-                        "description": "Page number",
-                        "name": "page",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "description": "Page size",
-                        "name": "size",
-                        "in": "query"
-                    },
                     {
                         "type": "string",
-                        "description": "filter only accept (active , no_active , demo)",
-                        "name": "filter",
+                        "description": "PENDING, APPROVED or REJECTED",
+                        "name": "status",
                         "in": "query"
                     }
                 ],
@@ -705,11 +771,11 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/pb.GetAllResponse"
+                            "$ref": "#/definitions/pb.GetAttendanceUnlockRequestsResponse"
                         }
                     },
-                    "400": {
-                        "description": "Bad Request",
+                    "409": {
+                        "description": "Conflict",
                         "schema": {
                             "$ref": "#/definitions/utils.AbsResponse"
                         }
@@ -717,13 +783,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/company/get-statistic": {
+        "/api/attendance/unlock/request": {
             "post": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
+                "description": "Asks an admin to open a date the attendance policy closed for the teacher. Returns the request id in message",
                 "consumes": [
                     "application/json"
                 ],
@@ -731,29 +798,35 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "company"
+                    "attendance"
                 ],
-                "summary": "SUPER_CEO",
+                "summary": "TEACHER",
                 "parameters": [
                     {
-                        "description": "Параметры запроса статистики компании",
-                        "name": "body",
+                        "description": "Unlock request",
+                        "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/pb.GetStatisticRequest"
+                            "$ref": "#/definitions/pb.RequestAttendanceUnlockRequest"
                         }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Успешный ответ со статистикой",
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/pb.GetStatisticResponse"
+                            "$ref": "#/definitions/utils.AbsResponse"
                         }
                     },
                     "400": {
-                        "description": "Неверный запрос",
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
                         "schema": {
                             "$ref": "#/definitions/utils.AbsResponse"
                         }
@@ -761,14 +834,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/company/sms/add-sms-count": {
-            "post": {
+        "/api/attendance/unlock/review": {
+            "put": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "SUPER_CEO role can add SMS count",
+                "description": "Approves or rejects a pending unlock request, an approved date stays open for the teacher for hours (24 by default)",
                 "consumes": [
                     "application/json"
                 ],
@@ -776,17 +849,17 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "company-sms"
+                    "attendance"
                 ],
-                "summary": "Add SMS count to a company",
+                "summary": "ADMIN , CEO",
                 "parameters": [
                     {
-                        "description": "Add SMS count request",
-                        "name": "body",
+                        "description": "Review",
+                        "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/pb.AddSmsRequest"
+                            "$ref": "#/definitions/pb.ReviewAttendanceUnlockRequest"
                         }
                     }
                 ],
@@ -794,7 +867,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/pb.AbsResponse"
+                            "$ref": "#/definitions/utils.AbsResponse"
                         }
                     },
                     "400": {
@@ -803,8 +876,8 @@ const docTemplate = `{
                             "$ref": "#/definitions/utils.AbsResponse"
                         }
                     },
-                    "500": {
-                        "description": "Internal Server Error",
+                    "409": {
+                        "description": "Conflict",
                         "schema": {
                             "$ref": "#/definitions/utils.AbsResponse"
                         }
@@ -812,30 +885,27 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/company/sms/delete-sms-count": {
-            "delete": {
+        "/api/check-in/code/{lessonId}": {
+            "get": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "SUPER_CEO role can delete SMS transaction",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Signed check in code of today's lesson for the room tablet to show as a QR code, ask for the next one after refreshIn seconds",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "company-sms"
+                    "check-in"
                 ],
-                "summary": "Delete SMS count by transaction ID",
+                "summary": "ADMIN , CEO , TEACHER",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Transaction ID",
-                        "name": "transactionId",
-                        "in": "query",
+                        "description": "Lesson ID",
+                        "name": "lessonId",
+                        "in": "path",
                         "required": true
                     }
                 ],
@@ -843,11 +913,11 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/pb.AbsResponse"
+                            "$ref": "#/definitions/pb.CheckInCode"
                         }
                     },
-                    "500": {
-                        "description": "Internal Server Error",
+                    "409": {
+                        "description": "Conflict",
                         "schema": {
                             "$ref": "#/definitions/utils.AbsResponse"
                         }
@@ -855,14 +925,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/company/sms/get-logs": {
+        "/api/check-in/confirm": {
             "post": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "ADMIN, CEO, FINANCIST, SUPER_CEO roles can retrieve SMS logs",
+                "description": "Confirms the waiting check ins of the students so they are billed, or deletes them when reject is set. Returns a result per student",
                 "consumes": [
                     "application/json"
                 ],
@@ -870,17 +940,17 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "company-sms"
+                    "check-in"
                 ],
-                "summary": "Get SMS logs",
+                "summary": "ADMIN , CEO , TEACHER",
                 "parameters": [
                     {
-                        "description": "Request for fetching SMS logs",
-                        "name": "body",
+                        "description": "Lesson and students",
+                        "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/pb.GetSmsLogRequest"
+                            "$ref": "#/definitions/pb.ConfirmCheckInsRequest"
                         }
                     }
                 ],
@@ -888,7 +958,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/pb.GetSmsLogResponse"
+                            "$ref": "#/definitions/pb.SetGroupAttendanceResponse"
                         }
                     },
                     "400": {
@@ -897,8 +967,8 @@ const docTemplate = `{
                             "$ref": "#/definitions/utils.AbsResponse"
                         }
                     },
-                    "500": {
-                        "description": "Internal Server Error",
+                    "409": {
+                        "description": "Conflict",
                         "schema": {
                             "$ref": "#/definitions/utils.AbsResponse"
                         }
@@ -906,50 +976,39 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/company/sms/get-sms-count-all": {
-            "post": {
+        "/api/check-in/lesson/{lessonId}": {
+            "get": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "ADMIN, CEO, SUPER_CEO roles can access this",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Students who checked in on the lesson, in the order they did",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "company-sms"
+                    "check-in"
                 ],
-                "summary": "Get all SMS transactions (paginated)",
+                "summary": "ADMIN , CEO , TEACHER",
                 "parameters": [
                     {
-                        "description": "Pagination request",
-                        "name": "body",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/pb.PageRequest"
-                        }
+                        "type": "string",
+                        "description": "Lesson ID",
+                        "name": "lessonId",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/pb.GetSmsTransactionDetailResponse"
-                        }
-                    },
-                    "400": {
-                        "description": "Bad Request",
-                        "schema": {
-                            "$ref": "#/definitions/utils.AbsResponse"
+                            "$ref": "#/definitions/pb.GetLessonCheckInsResponse"
                         }
                     },
-                    "500": {
-                        "description": "Internal Server Error",
+                    "409": {
+                        "description": "Conflict",
                         "schema": {
                             "$ref": "#/definitions/utils.AbsResponse"
                         }
@@ -957,14 +1016,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/company/sms/send-directly": {
+        "/api/check-in/scan": {
             "post": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "ADMIN, CEO roles can send SMS",
+                "description": "Marks the student present on the lesson of a scanned QR code, the teacher confirms it before it is billed",
                 "consumes": [
                     "application/json"
                 ],
@@ -972,17 +1031,17 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "company-sms"
+                    "check-in"
                 ],
-                "summary": "Send an SMS message directly",
+                "summary": "STUDENT",
                 "parameters": [
                     {
-                        "description": "Send SMS directly request",
+                        "description": "Scanned token",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/pb.SendSmsDirectlyRequest"
+                            "$ref": "#/definitions/pb.CheckInRequest"
                         }
                     }
                 ],
@@ -990,7 +1049,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/pb.AbsResponse"
+                            "$ref": "#/definitions/pb.CheckInResponse"
                         }
                     },
                     "400": {
@@ -999,8 +1058,8 @@ const docTemplate = `{
                             "$ref": "#/definitions/utils.AbsResponse"
                         }
                     },
-                    "500": {
-                        "description": "Internal Server Error",
+                    "409": {
+                        "description": "Conflict",
                         "schema": {
                             "$ref": "#/definitions/utils.AbsResponse"
                         }
@@ -1008,40 +1067,29 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/company/sms/template/get-templates": {
-            "post": {
+        "/api/common-information-company": {
+            "get": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "ADMIN, CEO roles can fetch templates",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Get common information about company",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "company-sms-template"
-                ],
-                "summary": "Get SMS templates",
-                "parameters": [
-                    {
-                        "description": "Get templates request",
-                        "name": "body",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/pb.GetSmsTemplateRequest"
-                        }
-                    }
+                    "education"
                 ],
+                "summary": "ADMIN , CEO",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/pb.GetSmsTemplateResponse"
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "integer"
+                            }
                         }
                     },
                     "400": {
@@ -1049,24 +1097,18 @@ const docTemplate = `{
                         "schema": {
                             "$ref": "#/definitions/utils.AbsResponse"
                         }
-                    },
-                    "500": {
-                        "description": "Internal Server Error",
-                        "schema": {
-                            "$ref": "#/definitions/utils.AbsResponse"
-                        }
                     }
                 }
             }
         },
-        "/api/company/sms/template/set-template": {
-            "put": {
+        "/api/company-user/create": {
+            "post": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "CEO, ADMIN roles can update templates",
+                "description": "Create a new user associated with a company",
                 "consumes": [
                     "application/json"
                 ],
@@ -1074,35 +1116,42 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "company-sms-template"
+                    "company-user"
                 ],
-                "summary": "Set or update SMS templates",
+                "summary": "SUPER_CEO",
                 "parameters": [
                     {
-                        "description": "Set template request",
-                        "name": "request",
+                        "type": "string",
+                        "description": "Company ID",
+                        "name": "companyId",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "description": "User data",
+                        "name": "user",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/pb.SetSmsTemplateRequest"
+                            "$ref": "#/definitions/pb.CreateUserRequest"
                         }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK",
+                        "description": "Successfully created user",
                         "schema": {
-                            "$ref": "#/definitions/pb.AbsResponse"
+                            "$ref": "#/definitions/utils.AbsResponse"
                         }
                     },
                     "400": {
-                        "description": "Bad Request",
+                        "description": "Bad request",
                         "schema": {
                             "$ref": "#/definitions/utils.AbsResponse"
                         }
                     },
                     "500": {
-                        "description": "Internal Server Error",
+                        "description": "Internal server error",
                         "schema": {
                             "$ref": "#/definitions/utils.AbsResponse"
                         }
@@ -1110,9 +1159,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/company/subdomain/{domain}": {
-            "get": {
-                "description": "Retrieve the data company by domain",
+        "/api/company-user/delete/{userId}": {
+            "delete": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Delete a user by their ID and associated company ID",
                 "consumes": [
                     "application/json"
                 ],
@@ -1120,43 +1174,61 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "company"
+                    "company-user"
                 ],
-                "summary": "ALL",
+                "summary": "SUPER_CEO",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Start date of the period",
-                        "name": "domain",
+                        "description": "Company ID",
+                        "name": "companyId",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "User ID",
+                        "name": "userId",
                         "in": "path",
                         "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK",
+                        "description": "Successfully deleted user",
                         "schema": {
-                            "$ref": "#/definitions/pb.GetCompanyResponse"
+                            "$ref": "#/definitions/utils.AbsResponse"
                         }
                     },
                     "400": {
-                        "description": "Bad Request",
+                        "description": "Bad request",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": true
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "User not found",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
                         }
                     }
                 }
             }
         },
-        "/api/company/tariff/create": {
-            "post": {
+        "/api/company-user/get-user/{userId}": {
+            "get": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "Create a new tariff with the provided details",
+                "description": "Retrieve details of a user by their ID and company ID",
                 "consumes": [
                     "application/json"
                 ],
@@ -1164,25 +1236,30 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "tariff"
+                    "company-user"
                 ],
                 "summary": "SUPER_CEO",
                 "parameters": [
                     {
-                        "description": "Tariff data",
-                        "name": "tariff",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/pb.Tariff"
-                        }
+                        "type": "string",
+                        "description": "Company ID",
+                        "name": "companyId",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "User ID",
+                        "name": "userId",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "created",
+                        "description": "Successfully retrieved user",
                         "schema": {
-                            "$ref": "#/definitions/utils.AbsResponse"
+                            "$ref": "#/definitions/pb.GetUserByIdResponse"
                         }
                     },
                     "400": {
@@ -1190,18 +1267,30 @@ const docTemplate = `{
                         "schema": {
                             "$ref": "#/definitions/utils.AbsResponse"
                         }
+                    },
+                    "404": {
+                        "description": "User not found",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
                     }
                 }
             }
         },
-        "/api/company/tariff/delete/{id}": {
-            "delete": {
+        "/api/company-user/update": {
+            "patch": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "Delete a tariff by its ID",
+                "description": "Update user details by their ID and company ID",
                 "consumes": [
                     "application/json"
                 ],
@@ -1209,21 +1298,30 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "tariff"
+                    "company-user"
                 ],
-                "summary": "Delete a tariff",
+                "summary": "SUPER_CEO",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "description": "ID of the tariff to delete",
-                        "name": "id",
-                        "in": "path",
+                        "type": "string",
+                        "description": "Company ID",
+                        "name": "companyId",
+                        "in": "query",
                         "required": true
+                    },
+                    {
+                        "description": "Updated user data",
+                        "name": "user",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/pb.UpdateUserRequest"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "deleted",
+                        "description": "Successfully updated user",
                         "schema": {
                             "$ref": "#/definitions/utils.AbsResponse"
                         }
@@ -1235,7 +1333,13 @@ const docTemplate = `{
                         }
                     },
                     "404": {
-                        "description": "Tariff not found",
+                        "description": "User not found",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
                         "schema": {
                             "$ref": "#/definitions/utils.AbsResponse"
                         }
@@ -1243,14 +1347,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/company/tariff/get-all": {
-            "get": {
+        "/api/company/create": {
+            "post": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "Retrieve a list of all tariffs",
+                "description": "Create a new company",
                 "consumes": [
                     "application/json"
                 ],
@@ -1258,18 +1362,29 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "tariff"
+                    "company"
                 ],
                 "summary": "SUPER_CEO",
+                "parameters": [
+                    {
+                        "description": "Company creation request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/pb.CreateCompanyRequest"
+                        }
+                    }
+                ],
                 "responses": {
                     "200": {
-                        "description": "List of tariffs",
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/pb.TariffList"
+                            "$ref": "#/definitions/utils.AbsResponse"
                         }
                     },
-                    "500": {
-                        "description": "Internal server error",
+                    "400": {
+                        "description": "Bad Request",
                         "schema": {
                             "$ref": "#/definitions/utils.AbsResponse"
                         }
@@ -1277,14 +1392,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/company/tariff/update": {
-            "put": {
+        "/api/company/finance/create": {
+            "post": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "Update the details of an existing tariff",
+                "description": "Create a new company finance record",
                 "consumes": [
                     "application/json"
                 ],
@@ -1292,23 +1407,23 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "tariff"
+                    "companyFinance"
                 ],
-                "summary": "SUPER_CEO",
+                "summary": "Create a finance record",
                 "parameters": [
                     {
-                        "description": "Updated tariff data",
-                        "name": "tariff",
+                        "description": "Company finance details",
+                        "name": "body",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/pb.Tariff"
+                            "$ref": "#/definitions/pb.CompanyFinance"
                         }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "updated",
+                        "description": "created",
                         "schema": {
                             "$ref": "#/definitions/utils.AbsResponse"
                         }
@@ -1322,14 +1437,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/company/update": {
-            "put": {
+        "/api/company/finance/delete": {
+            "delete": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "Update an existing company by ID",
+                "description": "Delete a company finance record by its ID",
                 "consumes": [
                     "application/json"
                 ],
@@ -1337,36 +1452,27 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "company"
+                    "companyFinance"
                 ],
-                "summary": "SUPER_CEO",
+                "summary": "Delete a finance record",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Company ID",
+                        "description": "ID of the company finance record to delete",
                         "name": "id",
-                        "in": "path",
+                        "in": "query",
                         "required": true
-                    },
-                    {
-                        "description": "Company update request",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/pb.UpdateCompanyRequest"
-                        }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK",
+                        "description": "deleted",
                         "schema": {
                             "$ref": "#/definitions/utils.AbsResponse"
                         }
                     },
                     "400": {
-                        "description": "Bad Request",
+                        "description": "Bad request",
                         "schema": {
                             "$ref": "#/definitions/utils.AbsResponse"
                         }
@@ -1374,14 +1480,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/course/create": {
+        "/api/company/finance/get-all": {
             "post": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "Create a new course based on the provided request data",
+                "description": "Retrieve a list of all company finance records",
                 "consumes": [
                     "application/json"
                 ],
@@ -1389,35 +1495,29 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "courses"
+                    "companyFinance"
                 ],
-                "summary": "ADMIN , CEO",
+                "summary": "Get all finance records",
                 "parameters": [
                     {
-                        "description": "Request to create a course",
-                        "name": "request",
+                        "description": "Pagination details",
+                        "name": "body",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/pb.CreateCourseRequest"
+                            "$ref": "#/definitions/pb.PageRequest"
                         }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK",
+                        "description": "list of finance records",
                         "schema": {
-                            "$ref": "#/definitions/utils.AbsResponse"
+                            "$ref": "#/definitions/pb.CompanyFinanceList"
                         }
                     },
                     "400": {
-                        "description": "Bad Request",
-                        "schema": {
-                            "$ref": "#/definitions/utils.AbsResponse"
-                        }
-                    },
-                    "500": {
-                        "description": "Internal Server Error",
+                        "description": "Bad request",
                         "schema": {
                             "$ref": "#/definitions/utils.AbsResponse"
                         }
@@ -1425,39 +1525,44 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/course/delete/{id}": {
-            "delete": {
+        "/api/company/finance/get-by-company": {
+            "post": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "Delete a course by its ID",
+                "description": "Retrieve finance records filtered by company",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "courses"
+                    "companyFinance"
                 ],
-                "summary": "ADMIN , CEO",
+                "summary": "Get finance records by company",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "Course ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "description": "Pagination and filtering details",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/pb.PageRequest"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK",
+                        "description": "list of finance records for a specific company",
                         "schema": {
-                            "$ref": "#/definitions/utils.AbsResponse"
+                            "$ref": "#/definitions/pb.CompanyFinanceSelfList"
                         }
                     },
-                    "500": {
-                        "description": "Internal Server Error",
+                    "400": {
+                        "description": "Bad request",
                         "schema": {
                             "$ref": "#/definitions/utils.AbsResponse"
                         }
@@ -1465,30 +1570,44 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/course/get-all": {
-            "get": {
+        "/api/company/finance/update": {
+            "put": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "Retrieve all courses",
+                "description": "Update finance details for a specific company",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "courses"
+                    "companyFinance"
+                ],
+                "summary": "Update finance records by company",
+                "parameters": [
+                    {
+                        "description": "Finance details to be updated",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/pb.CompanyFinance"
+                        }
+                    }
                 ],
-                "summary": "ALL",
                 "responses": {
                     "200": {
-                        "description": "OK",
+                        "description": "Updated finance details",
                         "schema": {
-                            "$ref": "#/definitions/pb.GetUpdateCourseAbs"
+                            "$ref": "#/definitions/pb.CompanyFinance"
                         }
                     },
-                    "500": {
-                        "description": "Internal Server Error",
+                    "400": {
+                        "description": "Bad request",
                         "schema": {
                             "$ref": "#/definitions/utils.AbsResponse"
                         }
@@ -1496,39 +1615,53 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/course/get-by-id/{id}": {
+        "/api/company/get-all": {
             "get": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "Retrieves a course by its ID for admin users.",
+                "description": "Retrieve a paginated list of all companies",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "courses"
+                    "company"
                 ],
-                "summary": "ADMIN , CEO",
+                "summary": "SUPER_CEO",
                 "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Page number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page size",
+                        "name": "size",
+                        "in": "query"
+                    },
                     {
                         "type": "string",
-                        "description": "Course ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "description": "filter only accept (active , no_active , demo)",
+                        "name": "filter",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Successful response with course details",
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/pb.AbsCourse"
+                            "$ref": "#/definitions/pb.GetAllResponse"
                         }
                     },
-                    "500": {
-                        "description": "Internal server error",
+                    "400": {
+                        "description": "Bad Request",
                         "schema": {
                             "$ref": "#/definitions/utils.AbsResponse"
                         }
@@ -1536,14 +1669,13 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/course/update": {
-            "put": {
+        "/api/company/get-statistic": {
+            "post": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "Update the details of an existing course based on the provided request data",
                 "consumes": [
                     "application/json"
                 ],
@@ -1551,35 +1683,29 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "courses"
+                    "company"
                 ],
-                "summary": "ADMIN , CEO",
+                "summary": "SUPER_CEO",
                 "parameters": [
                     {
-                        "description": "Request to update course",
-                        "name": "request",
+                        "description": "Параметры запроса статистики компании",
+                        "name": "body",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/pb.AbsCourse"
+                            "$ref": "#/definitions/pb.GetStatisticRequest"
                         }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK",
+                        "description": "Успешный ответ со статистикой",
                         "schema": {
-                            "$ref": "#/definitions/utils.AbsResponse"
+                            "$ref": "#/definitions/pb.GetStatisticResponse"
                         }
                     },
                     "400": {
-                        "description": "Bad Request",
-                        "schema": {
-                            "$ref": "#/definitions/utils.AbsResponse"
-                        }
-                    },
-                    "500": {
-                        "description": "Internal Server Error",
+                        "description": "Неверный запрос",
                         "schema": {
                             "$ref": "#/definitions/utils.AbsResponse"
                         }
@@ -1587,14 +1713,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/expectation/create": {
+        "/api/company/sms/add-sms-count": {
             "post": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "Create a new expectation.",
+                "description": "SUPER_CEO role can add SMS count",
                 "consumes": [
                     "application/json"
                 ],
@@ -1602,33 +1728,35 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "expectations"
+                    "company-sms"
                 ],
-                "summary": "ADMIN",
+                "summary": "Add SMS count to a company",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "Title of the expectation",
-                        "name": "title",
-                        "in": "query",
-                        "required": true
+                        "description": "Add SMS count request",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/pb.AddSmsRequest"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Expectation created successfully",
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/utils.AbsResponse"
+                            "$ref": "#/definitions/pb.AbsResponse"
                         }
                     },
-                    "409": {
-                        "description": "Conflict occurred",
+                    "400": {
+                        "description": "Bad Request",
                         "schema": {
                             "$ref": "#/definitions/utils.AbsResponse"
                         }
                     },
                     "500": {
-                        "description": "Internal server error",
+                        "description": "Internal Server Error",
                         "schema": {
                             "$ref": "#/definitions/utils.AbsResponse"
                         }
@@ -1636,14 +1764,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/expectation/delete/{id}": {
+        "/api/company/sms/delete-sms-count": {
             "delete": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "Delete an expectation by ID.",
+                "description": "SUPER_CEO role can delete SMS transaction",
                 "consumes": [
                     "application/json"
                 ],
@@ -1651,33 +1779,27 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "expectations"
+                    "company-sms"
                 ],
-                "summary": "ADMIN",
+                "summary": "Delete SMS count by transaction ID",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Expectation ID",
-                        "name": "id",
-                        "in": "path",
+                        "description": "Transaction ID",
+                        "name": "transactionId",
+                        "in": "query",
                         "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Expectation deleted successfully",
-                        "schema": {
-                            "$ref": "#/definitions/utils.AbsResponse"
-                        }
-                    },
-                    "409": {
-                        "description": "Conflict occurred",
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/utils.AbsResponse"
+                            "$ref": "#/definitions/pb.AbsResponse"
                         }
                     },
                     "500": {
-                        "description": "Internal server error",
+                        "description": "Internal Server Error",
                         "schema": {
                             "$ref": "#/definitions/utils.AbsResponse"
                         }
@@ -1685,14 +1807,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/expectation/update/{id}": {
-            "put": {
+        "/api/company/sms/get-logs": {
+            "post": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "Update an existing expectation by ID.",
+                "description": "ADMIN, CEO, FINANCIST, SUPER_CEO roles can retrieve SMS logs",
                 "consumes": [
                     "application/json"
                 ],
@@ -1700,40 +1822,35 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "expectations"
+                    "company-sms"
                 ],
-                "summary": "ADMIN",
+                "summary": "Get SMS logs",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "Expectation ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "Title of the expectation",
-                        "name": "title",
-                        "in": "query",
-                        "required": true
+                        "description": "Request for fetching SMS logs",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/pb.GetSmsLogRequest"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Expectation updated successfully",
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/utils.AbsResponse"
+                            "$ref": "#/definitions/pb.GetSmsLogResponse"
                         }
                     },
-                    "409": {
-                        "description": "Conflict occurred",
+                    "400": {
+                        "description": "Bad Request",
                         "schema": {
                             "$ref": "#/definitions/utils.AbsResponse"
                         }
                     },
                     "500": {
-                        "description": "Internal server error",
+                        "description": "Internal Server Error",
                         "schema": {
                             "$ref": "#/definitions/utils.AbsResponse"
                         }
@@ -1741,14 +1858,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/finance/category/create": {
+        "/api/company/sms/get-sms-count-all": {
             "post": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "Creates a new category with the provided name and description",
+                "description": "ADMIN, CEO, SUPER_CEO roles can access this",
                 "consumes": [
                     "application/json"
                 ],
@@ -1756,17 +1873,17 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "category"
+                    "company-sms"
                 ],
-                "summary": "ADMIN , CEO",
+                "summary": "Get all SMS transactions (paginated)",
                 "parameters": [
                     {
-                        "description": "Category Data",
-                        "name": "category",
+                        "description": "Pagination request",
+                        "name": "body",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/pb.CreateCategoryRequest"
+                            "$ref": "#/definitions/pb.PageRequest"
                         }
                     }
                 ],
@@ -1774,7 +1891,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/utils.AbsResponse"
+                            "$ref": "#/definitions/pb.GetSmsTransactionDetailResponse"
                         }
                     },
                     "400": {
@@ -1792,35 +1909,40 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/finance/category/delete/{categoryId}": {
-            "delete": {
+        "/api/company/sms/send-directly": {
+            "post": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "Deletes a category by its ID",
+                "description": "ADMIN, CEO roles can send SMS",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "category"
+                    "company-sms"
                 ],
-                "summary": "ADMIN , CEO",
+                "summary": "Send an SMS message directly",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "Category ID",
-                        "name": "categoryId",
-                        "in": "path",
-                        "required": true
+                        "description": "Send SMS directly request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/pb.SendSmsDirectlyRequest"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/utils.AbsResponse"
+                            "$ref": "#/definitions/pb.AbsResponse"
                         }
                     },
                     "400": {
@@ -1838,26 +1960,46 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/finance/category/get-all": {
-            "get": {
+        "/api/company/sms/template/get-templates": {
+            "post": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "Retrieves all categories",
+                "description": "ADMIN, CEO roles can fetch templates",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "category"
+                    "company-sms-template"
+                ],
+                "summary": "Get SMS templates",
+                "parameters": [
+                    {
+                        "description": "Get templates request",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/pb.GetSmsTemplateRequest"
+                        }
+                    }
                 ],
-                "summary": "ADMIN , CEO",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/pb.GetAllCategoryRequest"
+                            "$ref": "#/definitions/pb.GetSmsTemplateResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
                         }
                     },
                     "500": {
@@ -1869,14 +2011,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/finance/discount/create": {
-            "post": {
+        "/api/company/sms/template/set-template": {
+            "put": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "Creates a new discount for a specified group and student",
+                "description": "CEO, ADMIN roles can update templates",
                 "consumes": [
                     "application/json"
                 ],
@@ -1884,25 +2026,25 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "discount"
+                    "company-sms-template"
                 ],
-                "summary": "CEO , ADMIN",
+                "summary": "Set or update SMS templates",
                 "parameters": [
                     {
-                        "description": "Discount Request",
+                        "description": "Set template request",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/pb.AbsDiscountRequest"
+                            "$ref": "#/definitions/pb.SetSmsTemplateRequest"
                         }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Success response with status and message",
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/utils.AbsResponse"
+                            "$ref": "#/definitions/pb.AbsResponse"
                         }
                     },
                     "400": {
@@ -1920,14 +2062,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/finance/discount/delete": {
-            "delete": {
-                "security": [
-                    {
-                        "Bearer": []
-                    }
-                ],
-                "description": "Deletes a discount for a specific group and student",
+        "/api/company/subdomain/{domain}": {
+            "get": {
+                "description": "Retrieve the data company by domain",
                 "consumes": [
                     "application/json"
                 ],
@@ -1935,49 +2072,43 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "discount"
+                    "company"
                 ],
-                "summary": "ADMIN , CEO",
+                "summary": "ALL",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Group ID",
-                        "name": "groupId",
-                        "in": "query",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "Student ID",
-                        "name": "studentId",
-                        "in": "query",
+                        "description": "Start date of the period",
+                        "name": "domain",
+                        "in": "path",
                         "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Success response with status and message",
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/utils.AbsResponse"
+                            "$ref": "#/definitions/pb.GetCompanyResponse"
                         }
                     },
-                    "500": {
-                        "description": "Internal Server Error",
+                    "400": {
+                        "description": "Bad Request",
                         "schema": {
-                            "$ref": "#/definitions/utils.AbsResponse"
+                            "type": "object",
+                            "additionalProperties": true
                         }
                     }
                 }
             }
         },
-        "/api/finance/discount/get-all-by-group/{groupId}": {
-            "get": {
+        "/api/company/tariff/create": {
+            "post": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "Retrieves all discount information for a specific group ID",
+                "description": "Create a new tariff with the provided details",
                 "consumes": [
                     "application/json"
                 ],
@@ -1985,27 +2116,29 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "discount"
+                    "tariff"
                 ],
-                "summary": "ADMIN , CEO",
+                "summary": "SUPER_CEO",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "Group ID",
-                        "name": "groupId",
-                        "in": "path",
-                        "required": true
+                        "description": "Tariff data",
+                        "name": "tariff",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/pb.Tariff"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Success response",
+                        "description": "created",
                         "schema": {
-                            "$ref": "#/definitions/pb.GetInformationDiscountResponse"
+                            "$ref": "#/definitions/utils.AbsResponse"
                         }
                     },
-                    "500": {
-                        "description": "Internal Server Error",
+                    "400": {
+                        "description": "Bad request",
                         "schema": {
                             "$ref": "#/definitions/utils.AbsResponse"
                         }
@@ -2013,14 +2146,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/finance/discount/history/{userId}": {
-            "get": {
+        "/api/company/tariff/delete/{id}": {
+            "delete": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "Retrieves the discount history for a user by their ID",
+                "description": "Delete a tariff by its ID",
                 "consumes": [
                     "application/json"
                 ],
@@ -2028,33 +2161,67 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "discount"
+                    "tariff"
                 ],
-                "summary": "ADMIN , CEO",
+                "summary": "Delete a tariff",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "User ID",
-                        "name": "userId",
+                        "type": "integer",
+                        "description": "ID of the tariff to delete",
+                        "name": "id",
                         "in": "path",
                         "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Success",
+                        "description": "deleted",
                         "schema": {
-                            "$ref": "#/definitions/pb.GetHistoryDiscountResponse"
+                            "$ref": "#/definitions/utils.AbsResponse"
                         }
                     },
                     "400": {
-                        "description": "Bad Request",
+                        "description": "Bad request",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Tariff not found",
                         "schema": {
                             "$ref": "#/definitions/utils.AbsResponse"
                         }
+                    }
+                }
+            }
+        },
+        "/api/company/tariff/get-all": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Retrieve a list of all tariffs",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "tariff"
+                ],
+                "summary": "SUPER_CEO",
+                "responses": {
+                    "200": {
+                        "description": "List of tariffs",
+                        "schema": {
+                            "$ref": "#/definitions/pb.TariffList"
+                        }
                     },
                     "500": {
-                        "description": "Internal Server Error",
+                        "description": "Internal server error",
                         "schema": {
                             "$ref": "#/definitions/utils.AbsResponse"
                         }
@@ -2062,14 +2229,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/finance/expense/create": {
-            "post": {
+        "/api/company/tariff/update": {
+            "put": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "Creates a new expense entry with details provided in the request body.",
+                "description": "Update the details of an existing tariff",
                 "consumes": [
                     "application/json"
                 ],
@@ -2077,29 +2244,29 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "expense"
+                    "tariff"
                 ],
-                "summary": "ADMIN , CEO",
+                "summary": "SUPER_CEO",
                 "parameters": [
                     {
-                        "description": "Expense details",
-                        "name": "expense",
+                        "description": "Updated tariff data",
+                        "name": "tariff",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/pb.CreateExpenseRequest"
+                            "$ref": "#/definitions/pb.Tariff"
                         }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK",
+                        "description": "updated",
                         "schema": {
                             "$ref": "#/definitions/utils.AbsResponse"
                         }
                     },
-                    "500": {
-                        "description": "Internal Server Error",
+                    "400": {
+                        "description": "Bad request",
                         "schema": {
                             "$ref": "#/definitions/utils.AbsResponse"
                         }
@@ -2107,28 +2274,40 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/finance/expense/delete/{id}": {
-            "delete": {
+        "/api/company/update": {
+            "put": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "Deletes an expense entry by ID.",
+                "description": "Update an existing company by ID",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "expense"
+                    "company"
                 ],
-                "summary": "CEO",
+                "summary": "SUPER_CEO",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Expense ID",
+                        "description": "Company ID",
                         "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "description": "Company update request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/pb.UpdateCompanyRequest"
+                        }
                     }
                 ],
                 "responses": {
@@ -2138,8 +2317,8 @@ const docTemplate = `{
                             "$ref": "#/definitions/utils.AbsResponse"
                         }
                     },
-                    "500": {
-                        "description": "Internal Server Error",
+                    "400": {
+                        "description": "Bad Request",
                         "schema": {
                             "$ref": "#/definitions/utils.AbsResponse"
                         }
@@ -2147,78 +2326,50 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/finance/expense/get-all-information/{from}/{to}": {
-            "get": {
+        "/api/course/billing": {
+            "put": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "Retrieves expenses with optional filters, pagination, and date range.",
+                "description": "Sets how a course is billed. MONTHLY takes the course price every month, PER_LESSON charges lessonPrice per attended lesson, PACKAGE sells packages of packageLessons lessons for packagePrice valid packageValidDays days (0 never expires) and consumes a lesson per attendance. Students get the low credit sms when lowCreditLessons lessons are left",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "expense"
+                    "courses"
                 ],
-                "summary": "ADMIN , CEO",
+                "summary": "ADMIN , CEO , FINANCIST",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "Start date",
-                        "name": "from",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "End date",
-                        "name": "to",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "type": "integer",
-                        "description": "Page number",
-                        "name": "page",
-                        "in": "query",
-                        "required": true
-                    },
-                    {
-                        "type": "integer",
-                        "description": "Page size",
-                        "name": "size",
-                        "in": "query",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "ID to filter by user or creator",
-                        "name": "id",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "Filter type (USER or CATEGORY)",
-                        "name": "type",
-                        "in": "query"
+                        "description": "Course billing",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/pb.CourseBilling"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/pb.GetAllExpenseResponse"
+                            "$ref": "#/definitions/utils.AbsResponse"
                         }
                     },
                     "400": {
-                        "description": "Invalid request parameter",
+                        "description": "Bad Request",
                         "schema": {
                             "$ref": "#/definitions/utils.AbsResponse"
                         }
                     },
-                    "500": {
-                        "description": "Internal Server Error",
+                    "409": {
+                        "description": "Conflict",
                         "schema": {
                             "$ref": "#/definitions/utils.AbsResponse"
                         }
@@ -2226,49 +2377,39 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/finance/expense/get-chart-diagram/{from}/{to}": {
+        "/api/course/billing/{id}": {
             "get": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "Queries the finance service for expense data between 'from' and 'to' dates, returning it as a chart diagram.",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Billing mode of a course with the lesson and package prices",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "expense"
+                    "courses"
                 ],
-                "summary": "ADMIN , CEO",
+                "summary": "ADMIN , CEO , FINANCIST",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Start date for the chart (YYYY-MM-DD)",
-                        "name": "from",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "End date for the chart (YYYY-MM-DD)",
-                        "name": "to",
+                        "description": "Course ID",
+                        "name": "id",
                         "in": "path",
                         "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Chart data successfully retrieved",
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/pb.GetAllExpenseDiagramResponse"
+                            "$ref": "#/definitions/pb.CourseBilling"
                         }
                     },
                     "409": {
-                        "description": "Conflict or error retrieving chart data",
+                        "description": "Conflict",
                         "schema": {
                             "$ref": "#/definitions/utils.AbsResponse"
                         }
@@ -2276,47 +2417,50 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/finance/payment/all-student-payments": {
+        "/api/course/create": {
             "post": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "Retrieves a list of student payments between the provided 'from' and 'to' date parameters.",
+                "description": "Create a new course based on the provided request data",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "payments"
+                    "courses"
                 ],
                 "summary": "ADMIN , CEO",
                 "parameters": [
                     {
-                        "description": "get all student payment request",
+                        "description": "Request to create a course",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/pb.GetAllStudentPaymentsRequest"
+                            "$ref": "#/definitions/pb.CreateCourseRequest"
                         }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "List of payments",
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/pb.GetAllStudentPaymentsResponse"
+                            "$ref": "#/definitions/utils.AbsResponse"
                         }
                     },
-                    "409": {
-                        "description": "Conflict error with an explanation",
+                    "400": {
+                        "description": "Bad Request",
                         "schema": {
                             "$ref": "#/definitions/utils.AbsResponse"
                         }
                     },
                     "500": {
-                        "description": "Internal server error",
+                        "description": "Internal Server Error",
                         "schema": {
                             "$ref": "#/definitions/utils.AbsResponse"
                         }
@@ -2324,131 +2468,39 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/finance/payment/all-student-payments/chart": {
-            "post": {
-                "security": [
-                    {
-                        "Bearer": []
-                    }
-                ],
-                "description": "Fetches payment data for students between the given 'from' and 'to' dates to be used in chart visualizations.",
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "payments"
-                ],
-                "summary": "ADMIN ,CEO",
-                "parameters": [
-                    {
-                        "description": "get all student payment request",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/pb.GetAllStudentPaymentsRequest"
-                        }
-                    }
-                ],
-                "responses": {
-                    "200": {
-                        "description": "Chart data of payments",
-                        "schema": {
-                            "$ref": "#/definitions/pb.GetAllStudentPaymentsChartResponse"
-                        }
-                    },
-                    "409": {
-                        "description": "Conflict error with an explanation",
-                        "schema": {
-                            "$ref": "#/definitions/utils.AbsResponse"
-                        }
-                    },
-                    "500": {
-                        "description": "Internal server error",
-                        "schema": {
-                            "$ref": "#/definitions/utils.AbsResponse"
-                        }
-                    }
-                }
-            }
-        },
-        "/api/finance/payment/get-all-debts/{page}/{size}": {
-            "get": {
+        "/api/course/delete/{id}": {
+            "delete": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "Retrieves information about debts within the specified date range.",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Delete a course by its ID",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "payments"
+                    "courses"
                 ],
                 "summary": "ADMIN , CEO",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "page",
-                        "name": "page",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "size",
-                        "name": "size",
+                        "description": "Course ID",
+                        "name": "id",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "from",
-                        "name": "from",
-                        "in": "query",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "to",
-                        "name": "to",
-                        "in": "query",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "amountTo",
-                        "name": "amountTo",
-                        "in": "query",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "amountFrom",
-                        "name": "amountFrom",
-                        "in": "query",
-                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Success response containing debt information",
-                        "schema": {
-                            "$ref": "#/definitions/pb.GetAllDebtsInformationResponse"
-                        }
-                    },
-                    "409": {
-                        "description": "Conflict occurred while fetching debt information",
+                        "description": "OK",
                         "schema": {
                             "$ref": "#/definitions/utils.AbsResponse"
                         }
                     },
                     "500": {
-                        "description": "Internal server error",
+                        "description": "Internal Server Error",
                         "schema": {
                             "$ref": "#/definitions/utils.AbsResponse"
                         }
@@ -2456,46 +2508,30 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/finance/payment/get-all-payments/{studentId}/{month}": {
+        "/api/course/get-all": {
             "get": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "Get all payment records for a student within a specified month.",
+                "description": "Retrieve all courses",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "payments"
-                ],
-                "summary": "ADMIN , CEO",
-                "parameters": [
-                    {
-                        "type": "string",
-                        "description": "Student ID",
-                        "name": "studentId",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "Month (format: YYYY-MM)",
-                        "name": "month",
-                        "in": "path",
-                        "required": true
-                    }
+                    "courses"
                 ],
+                "summary": "ALL",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/pb.GetAllPaymentsByMonthResponse"
+                            "$ref": "#/definitions/pb.GetUpdateCourseAbs"
                         }
                     },
-                    "400": {
-                        "description": "Invalid request parameters or failed retrieval",
+                    "500": {
+                        "description": "Internal Server Error",
                         "schema": {
                             "$ref": "#/definitions/utils.AbsResponse"
                         }
@@ -2503,55 +2539,39 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/finance/payment/payment-take-off/chart/{from}/{to}": {
+        "/api/course/get-by-id/{id}": {
             "get": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "Retrieve a chart representation of take-off payments within a specified date range.",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Retrieves a course by its ID for admin users.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "payments"
+                    "courses"
                 ],
                 "summary": "ADMIN , CEO",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Start date (format: YYYY-MM-DD)",
-                        "name": "from",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "End date (format: YYYY-MM-DD)",
-                        "name": "to",
+                        "description": "Course ID",
+                        "name": "id",
                         "in": "path",
                         "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Chart data of take-off payments",
-                        "schema": {
-                            "$ref": "#/definitions/pb.GetAllPaymentTakeOffChartResponse"
-                        }
-                    },
-                    "409": {
-                        "description": "Error message",
+                        "description": "Successful response with course details",
                         "schema": {
-                            "$ref": "#/definitions/utils.AbsResponse"
+                            "$ref": "#/definitions/pb.AbsCourse"
                         }
                     },
                     "500": {
-                        "description": "Server error",
+                        "description": "Internal server error",
                         "schema": {
                             "$ref": "#/definitions/utils.AbsResponse"
                         }
@@ -2559,14 +2579,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/finance/payment/payment-take-off/{from}/{to}": {
-            "get": {
+        "/api/course/price": {
+            "post": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "Retrieve all take-off payments within a specified date range.",
+                "description": "Adds a course price version used for lessons and monthly billing from effectiveFrom (yyyy-MM-dd, today when empty). Earlier months keep the price they were charged with. With grandfatherExisting students enrolled now keep paying their current price. Returns the version id in message",
                 "consumes": [
                     "application/json"
                 ],
@@ -2574,40 +2594,35 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "payments"
+                    "courses"
                 ],
-                "summary": "ADMIN , CEO",
+                "summary": "ADMIN , CEO , FINANCIST",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "Start date (format: YYYY-MM-DD)",
-                        "name": "from",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "End date (format: YYYY-MM-DD)",
-                        "name": "to",
-                        "in": "path",
-                        "required": true
+                        "description": "Course price",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/pb.ScheduleCoursePriceRequest"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "List of take-off payments",
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/pb.GetAllPaymentTakeOffResponse"
+                            "$ref": "#/definitions/utils.AbsResponse"
                         }
                     },
-                    "409": {
-                        "description": "Error message",
+                    "400": {
+                        "description": "Bad Request",
                         "schema": {
                             "$ref": "#/definitions/utils.AbsResponse"
                         }
                     },
-                    "500": {
-                        "description": "Server error",
+                    "409": {
+                        "description": "Conflict",
                         "schema": {
                             "$ref": "#/definitions/utils.AbsResponse"
                         }
@@ -2615,14 +2630,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/finance/payment/student/add": {
-            "post": {
+        "/api/course/price/lock": {
+            "put": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "Add a payment for a student",
+                "description": "Keeps one student of a group on a fixed monthly price from lockedFrom (yyyy-MM-dd, today when empty) regardless of course price changes. price 0 removes the lock",
                 "consumes": [
                     "application/json"
                 ],
@@ -2630,17 +2645,17 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "payments"
+                    "courses"
                 ],
-                "summary": "ADMIN , CEO",
+                "summary": "ADMIN , CEO , FINANCIST",
                 "parameters": [
                     {
-                        "description": "Payment Add Request",
+                        "description": "Enrollment price lock",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/pb.PaymentAddRequest"
+                            "$ref": "#/definitions/pb.SetEnrollmentPriceLockRequest"
                         }
                     }
                 ],
@@ -2666,26 +2681,26 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/finance/payment/student/get-monthly-status/{studentId}": {
+        "/api/course/price/{id}": {
             "get": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "Get the monthly payment status for a student.",
+                "description": "Price versions of a course, newest first, with the enrollments paying a grandfathered price",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "payments"
+                    "courses"
                 ],
-                "summary": "ADMIN , CEO",
+                "summary": "ADMIN , CEO , FINANCIST",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Student ID",
-                        "name": "studentId",
+                        "description": "Course ID",
+                        "name": "id",
                         "in": "path",
                         "required": true
                     }
@@ -2694,11 +2709,11 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/pb.GetMonthlyStatusResponse"
+                            "$ref": "#/definitions/pb.GetCoursePriceHistoryResponse"
                         }
                     },
-                    "400": {
-                        "description": "Invalid request parameters or failed retrieval",
+                    "409": {
+                        "description": "Conflict",
                         "schema": {
                             "$ref": "#/definitions/utils.AbsResponse"
                         }
@@ -2706,14 +2721,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/finance/payment/student/return": {
-            "post": {
+        "/api/course/update": {
+            "put": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "Return a payment for a student",
+                "description": "Update the details of an existing course based on the provided request data",
                 "consumes": [
                     "application/json"
                 ],
@@ -2721,17 +2736,17 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "payments"
+                    "courses"
                 ],
                 "summary": "ADMIN , CEO",
                 "parameters": [
                     {
-                        "description": "Payment Return Request",
+                        "description": "Request to update course",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/pb.PaymentReturnRequest"
+                            "$ref": "#/definitions/pb.AbsCourse"
                         }
                     }
                 ],
@@ -2748,8 +2763,8 @@ const docTemplate = `{
                             "$ref": "#/definitions/utils.AbsResponse"
                         }
                     },
-                    "409": {
-                        "description": "Conflict",
+                    "500": {
+                        "description": "Internal Server Error",
                         "schema": {
                             "$ref": "#/definitions/utils.AbsResponse"
                         }
@@ -2757,14 +2772,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/finance/payment/student/update": {
-            "patch": {
+        "/api/expectation/create": {
+            "post": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "Update a payment for a student",
+                "description": "Create a new expectation.",
                 "consumes": [
                     "application/json"
                 ],
@@ -2772,35 +2787,33 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "payments"
+                    "expectations"
                 ],
-                "summary": "ADMIN , CEO",
+                "summary": "ADMIN",
                 "parameters": [
                     {
-                        "description": "Payment Update Request",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/pb.PaymentUpdateRequest"
-                        }
+                        "type": "string",
+                        "description": "Title of the expectation",
+                        "name": "title",
+                        "in": "query",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK",
+                        "description": "Expectation created successfully",
                         "schema": {
                             "$ref": "#/definitions/utils.AbsResponse"
                         }
                     },
-                    "400": {
-                        "description": "Bad Request",
+                    "409": {
+                        "description": "Conflict occurred",
                         "schema": {
                             "$ref": "#/definitions/utils.AbsResponse"
                         }
                     },
-                    "409": {
-                        "description": "Conflict",
+                    "500": {
+                        "description": "Internal server error",
                         "schema": {
                             "$ref": "#/definitions/utils.AbsResponse"
                         }
@@ -2808,14 +2821,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/finance/salary/calculate/{from}/{to}": {
-            "get": {
+        "/api/expectation/delete/{id}": {
+            "delete": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "Calculates the salary for a specified teacher within a given date range.",
+                "description": "Delete an expectation by ID.",
                 "consumes": [
                     "application/json"
                 ],
@@ -2823,40 +2836,33 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "salary"
+                    "expectations"
                 ],
-                "summary": "CEO",
+                "summary": "ADMIN",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Start date for the salary calculation period in YYYY-MM-DD format",
-                        "name": "from",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "End date for the salary calculation period in YYYY-MM-DD format",
-                        "name": "to",
+                        "description": "Expectation ID",
+                        "name": "id",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "Teacher ID for whom the salary is calculated",
-                        "name": "teacherId",
-                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Calculated salary information",
+                        "description": "Expectation deleted successfully",
                         "schema": {
-                            "$ref": "#/definitions/pb.CalculateTeacherSalaryResponse"
+                            "$ref": "#/definitions/utils.AbsResponse"
                         }
                     },
                     "409": {
-                        "description": "Conflict or calculation error",
+                        "description": "Conflict occurred",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
                         "schema": {
                             "$ref": "#/definitions/utils.AbsResponse"
                         }
@@ -2864,39 +2870,55 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/finance/salary/delete/{teacherID}": {
-            "delete": {
+        "/api/expectation/update/{id}": {
+            "put": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "Deletes a salary entry for a specific teacher by ID",
+                "description": "Update an existing expectation by ID.",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "salary"
+                    "expectations"
                 ],
-                "summary": "CEO",
+                "summary": "ADMIN",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Teacher ID",
-                        "name": "teacherID",
+                        "description": "Expectation ID",
+                        "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Title of the expectation",
+                        "name": "title",
+                        "in": "query",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Salary deleted successfully",
+                        "description": "Expectation updated successfully",
                         "schema": {
                             "$ref": "#/definitions/utils.AbsResponse"
                         }
                     },
                     "409": {
-                        "description": "Conflict error",
+                        "description": "Conflict occurred",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
                         "schema": {
                             "$ref": "#/definitions/utils.AbsResponse"
                         }
@@ -2904,14 +2926,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/finance/salary/teacher-add": {
-            "post": {
+        "/api/finance/category/budget": {
+            "put": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "Adds a new salary entry for a specific teacher",
+                "description": "Sets the monthly budget of an expense category. Zero removes the budget. CEO and FINANCIST get an sms when spend of the month crosses 80% and 100% of the budget",
                 "consumes": [
                     "application/json"
                 ],
@@ -2919,35 +2941,35 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "salary"
+                    "category"
                 ],
-                "summary": "CEO",
+                "summary": "CEO , FINANCIST",
                 "parameters": [
                     {
-                        "description": "Salary details",
+                        "description": "Category and monthly budget",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/pb.CreateTeacherSalaryRequest"
+                            "$ref": "#/definitions/pb.SetCategoryBudgetRequest"
                         }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Salary added successfully",
+                        "description": "OK",
                         "schema": {
                             "$ref": "#/definitions/utils.AbsResponse"
                         }
                     },
                     "400": {
-                        "description": "Invalid request body",
+                        "description": "Bad Request",
                         "schema": {
                             "$ref": "#/definitions/utils.AbsResponse"
                         }
                     },
                     "409": {
-                        "description": "Conflict error",
+                        "description": "Conflict",
                         "schema": {
                             "$ref": "#/definitions/utils.AbsResponse"
                         }
@@ -2955,30 +2977,50 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/finance/salary/teacher-all": {
-            "get": {
+        "/api/finance/category/create": {
+            "post": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "Retrieves the salary information for all teachers",
+                "description": "Creates a new category with the provided name and description",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "salary"
+                    "category"
+                ],
+                "summary": "ADMIN , CEO",
+                "parameters": [
+                    {
+                        "description": "Category Data",
+                        "name": "category",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/pb.CreateCategoryRequest"
+                        }
+                    }
                 ],
-                "summary": "CEO",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/pb.GetTeachersSalaryRequest"
+                            "$ref": "#/definitions/utils.AbsResponse"
                         }
                     },
                     "400": {
-                        "description": "Bad request",
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
                         "schema": {
                             "$ref": "#/definitions/utils.AbsResponse"
                         }
@@ -2986,34 +3028,27 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/get-chart-income": {
-            "get": {
+        "/api/finance/category/delete/{categoryId}": {
+            "delete": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "Get information about a income",
+                "description": "Deletes a category by its ID",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "education"
+                    "category"
                 ],
-                "summary": "CEO",
+                "summary": "ADMIN , CEO",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "from",
-                        "name": "from",
-                        "in": "query",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "to",
-                        "name": "to",
-                        "in": "query",
+                        "description": "Category ID",
+                        "name": "categoryId",
+                        "in": "path",
                         "required": true
                     }
                 ],
@@ -3021,7 +3056,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/pb.GetCommonInformationResponse"
+                            "$ref": "#/definitions/utils.AbsResponse"
                         }
                     },
                     "400": {
@@ -3029,44 +3064,40 @@ const docTemplate = `{
                         "schema": {
                             "$ref": "#/definitions/utils.AbsResponse"
                         }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
                     }
                 }
             }
         },
-        "/api/get-table-groups": {
+        "/api/finance/category/get-all": {
             "get": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "Get common information about company",
+                "description": "Retrieves all categories",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "education"
-                ],
-                "summary": "ADMIN , CEO, TEACHER",
-                "parameters": [
-                    {
-                        "type": "string",
-                        "description": "dateType",
-                        "name": "dateType",
-                        "in": "query",
-                        "required": true
-                    }
+                    "category"
                 ],
+                "summary": "ADMIN , CEO",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": true
+                            "$ref": "#/definitions/pb.GetAllCategoryRequest"
                         }
                     },
-                    "400": {
-                        "description": "Bad Request",
+                    "500": {
+                        "description": "Internal Server Error",
                         "schema": {
                             "$ref": "#/definitions/utils.AbsResponse"
                         }
@@ -3074,14 +3105,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/group/create": {
+        "/api/finance/charge/item/create": {
             "post": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "Create a new group with provided details.",
+                "description": "Adds a chargeable item to the catalog. Physical items keep stock and can not be sold beyond it. Returns the item id in message",
                 "consumes": [
                     "application/json"
                 ],
@@ -3089,35 +3120,35 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "groups"
+                    "charge"
                 ],
-                "summary": "ADMIN , CEO",
+                "summary": "CEO , ADMIN , FINANCIST",
                 "parameters": [
                     {
-                        "description": "Group Data",
-                        "name": "group",
+                        "description": "Charge item",
+                        "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/pb.CreateGroupRequest"
+                            "$ref": "#/definitions/pb.CreateChargeItemRequest"
                         }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Group successfully created",
+                        "description": "OK",
                         "schema": {
                             "$ref": "#/definitions/utils.AbsResponse"
                         }
                     },
                     "400": {
-                        "description": "Bad request",
+                        "description": "Bad Request",
                         "schema": {
                             "$ref": "#/definitions/utils.AbsResponse"
                         }
                     },
-                    "500": {
-                        "description": "Internal server error",
+                    "409": {
+                        "description": "Conflict",
                         "schema": {
                             "$ref": "#/definitions/utils.AbsResponse"
                         }
@@ -3125,39 +3156,38 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/group/delete/{id}": {
-            "delete": {
+        "/api/finance/charge/item/get-all": {
+            "get": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "Delete a group by its ID.",
+                "description": "Catalog of chargeable items with the current stock",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "groups"
+                    "charge"
                 ],
-                "summary": "ADMIN , CEO",
+                "summary": "CEO , ADMIN , FINANCIST",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "Group ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "type": "boolean",
+                        "description": "Include inactive items",
+                        "name": "includeInactive",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Group successfully deleted",
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/utils.AbsResponse"
+                            "$ref": "#/definitions/pb.GetChargeItemsResponse"
                         }
                     },
-                    "500": {
-                        "description": "Internal server error",
+                    "409": {
+                        "description": "Conflict",
                         "schema": {
                             "$ref": "#/definitions/utils.AbsResponse"
                         }
@@ -3165,40 +3195,40 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/group/get-all": {
-            "post": {
+        "/api/finance/charge/item/update": {
+            "put": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "Retrieve a list of all groups.",
+                "description": "Updates a catalog item, stock is replaced with the given value. isActive=false stops selling the item",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "groups"
+                    "charge"
                 ],
-                "summary": "ADMIN , CEO",
+                "summary": "CEO , ADMIN , FINANCIST",
                 "parameters": [
                     {
-                        "description": "Group Data",
-                        "name": "group",
+                        "description": "Charge item",
+                        "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/pb.GetGroupsRequest"
+                            "$ref": "#/definitions/pb.AbsChargeItem"
                         }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "List of groups",
+                        "description": "OK",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/pb.GetGroupsResponse"
-                            }
+                            "$ref": "#/definitions/utils.AbsResponse"
                         }
                     },
                     "400": {
@@ -3207,48 +3237,53 @@ const docTemplate = `{
                             "$ref": "#/definitions/utils.AbsResponse"
                         }
                     },
-                    "500": {
-                        "description": "Internal server error",
-                        "schema": {
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
                             "$ref": "#/definitions/utils.AbsResponse"
                         }
                     }
                 }
             }
         },
-        "/api/group/get-by-course/{courseId}": {
+        "/api/finance/charge/report": {
             "get": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "Retrieve groups associated with a specific course ID.",
+                "description": "Sold quantity, amount, sale count and buyer count per catalog item in the period",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "groups"
+                    "charge"
                 ],
-                "summary": "ADMIN, TEACHER",
+                "summary": "CEO , FINANCIST",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Course ID",
-                        "name": "courseId",
-                        "in": "path",
-                        "required": true
+                        "description": "Start date (YYYY-MM-DD)",
+                        "name": "from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "End date (YYYY-MM-DD)",
+                        "name": "to",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Group details",
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/pb.GetGroupsByCourseResponse"
+                            "$ref": "#/definitions/pb.GetChargeItemSalesReportResponse"
                         }
                     },
-                    "500": {
-                        "description": "Internal server error",
+                    "409": {
+                        "description": "Conflict",
                         "schema": {
                             "$ref": "#/definitions/utils.AbsResponse"
                         }
@@ -3256,39 +3291,50 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/group/get-by-id/{id}": {
-            "get": {
+        "/api/finance/charge/student": {
+            "post": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "Retrieve details of a group by its ID.",
+                "description": "Sells one or more catalog items to a student. The total is taken off the student balance as one TAKE_OFF payment, returning that payment puts the items back to stock. Returns the charge id in message",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "groups"
+                    "charge"
                 ],
-                "summary": "ADMIN",
+                "summary": "CEO , ADMIN , FINANCIST",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "Group ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "description": "Charge",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/pb.ChargeStudentRequest"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Group details",
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/pb.GetGroupAbsResponse"
+                            "$ref": "#/definitions/utils.AbsResponse"
                         }
                     },
-                    "500": {
-                        "description": "Internal server error",
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
                         "schema": {
                             "$ref": "#/definitions/utils.AbsResponse"
                         }
@@ -3296,42 +3342,80 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/group/get-by-teacher/{teacherId}": {
+        "/api/finance/charge/student/{studentId}": {
             "get": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "Get information about a specific teacher by their ID, with an option to filter archived data.",
+                "description": "Item sales charged to a student, newest first",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "groups"
+                    "charge"
                 ],
-                "summary": "ADMIN , TEACHER , CEO",
+                "summary": "CEO , ADMIN , FINANCIST",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Teacher ID",
-                        "name": "teacherId",
+                        "description": "Student ID",
+                        "name": "studentId",
                         "in": "path",
                         "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/pb.GetStudentChargesResponse"
+                        }
                     },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/finance/collection/activity": {
+            "post": {
+                "security": [
                     {
-                        "type": "boolean",
-                        "description": "Whether to include archived information",
-                        "name": "isArchived",
-                        "in": "query",
-                        "required": true
+                        "Bearer": []
+                    }
+                ],
+                "description": "Logs a contact attempt with a debtor. type is CALL, SMS, MEETING, PROMISE or NOTE; PROMISE requires promisedAmount and promisedDate (YYYY-MM-DD)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "collection"
+                ],
+                "summary": "CEO , ADMIN , FINANCIST",
+                "parameters": [
+                    {
+                        "description": "Activity",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/pb.AddCollectionActivityRequest"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/pb.GetGroupsByTeacherResponse"
+                            "$ref": "#/definitions/utils.AbsResponse"
                         }
                     },
                     "400": {
@@ -3339,55 +3423,96 @@ const docTemplate = `{
                         "schema": {
                             "$ref": "#/definitions/utils.AbsResponse"
                         }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
                     }
                 }
             }
         },
-        "/api/group/left-after-trial/{from}/{to}": {
+        "/api/finance/collection/activity/{studentId}": {
             "get": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "Retrieve the data left after the trial period based on the provided from date, to date, page, and page size",
-                "consumes": [
+                "description": "Retrieves contact attempts, reminders and promises to pay of a debtor, newest first",
+                "produces": [
                     "application/json"
                 ],
+                "tags": [
+                    "collection"
+                ],
+                "summary": "CEO , ADMIN , FINANCIST",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Student ID",
+                        "name": "studentId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/pb.GetCollectionActivitiesResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/finance/collection/aging/{page}/{size}": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Debt aging report. Age of every debt is counted from the oldest take-off not covered by payments, but not earlier than the moment the balance went below zero. Debtors are grouped into 0-30, 31-60, 61-90 and 90+ day buckets with assignee, last contact and last promise to pay",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "education"
+                    "collection"
                 ],
-                "summary": "ADMIN , CEO",
+                "summary": "CEO , ADMIN , FINANCIST",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Start date of the period",
-                        "name": "from",
+                        "description": "page",
+                        "name": "page",
                         "in": "path",
                         "required": true
                     },
                     {
                         "type": "string",
-                        "description": "End date of the period",
-                        "name": "to",
+                        "description": "size",
+                        "name": "size",
                         "in": "path",
                         "required": true
                     },
                     {
                         "type": "string",
-                        "default": "1",
-                        "description": "Page number",
-                        "name": "page",
+                        "description": "Age bucket filter: 0-30, 31-60, 61-90 or 90+",
+                        "name": "bucket",
                         "in": "query"
                     },
                     {
                         "type": "string",
-                        "default": "10",
-                        "description": "Page size",
-                        "name": "page_size",
+                        "description": "Only debtors assigned to this staff member",
+                        "name": "assignedToId",
                         "in": "query"
                     }
                 ],
@@ -3395,22 +3520,26 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/pb.GetLeftAfterTrialPeriodResponse"
+                            "$ref": "#/definitions/pb.GetDebtAgingResponse"
                         }
                     },
-                    "400": {
-                        "description": "Bad Request",
+                    "409": {
+                        "description": "Conflict",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": true
+                            "$ref": "#/definitions/utils.AbsResponse"
                         }
                     }
                 }
             }
         },
-        "/api/group/transfer-date": {
+        "/api/finance/collection/assign": {
             "post": {
-                "description": "Transfers the lesson date for a course",
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Assigns a debtor to a staff member responsible for collecting the debt",
                 "consumes": [
                     "application/json"
                 ],
@@ -3418,35 +3547,35 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "lesson"
+                    "collection"
                 ],
-                "summary": "ADMIN",
+                "summary": "CEO , FINANCIST",
                 "parameters": [
                     {
-                        "description": "Transfer Lesson Request",
+                        "description": "Student and staff member",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/pb.TransferLessonRequest"
+                            "$ref": "#/definitions/pb.AssignDebtorRequest"
                         }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Status and message",
+                        "description": "OK",
                         "schema": {
                             "$ref": "#/definitions/utils.AbsResponse"
                         }
                     },
                     "400": {
-                        "description": "Bad request",
+                        "description": "Bad Request",
                         "schema": {
                             "$ref": "#/definitions/utils.AbsResponse"
                         }
                     },
-                    "500": {
-                        "description": "Internal server error",
+                    "409": {
+                        "description": "Conflict",
                         "schema": {
                             "$ref": "#/definitions/utils.AbsResponse"
                         }
@@ -3454,50 +3583,30 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/group/update": {
-            "put": {
+        "/api/finance/collection/run": {
+            "post": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "Update details of an existing group.",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Runs the collections job right away instead of waiting for the nightly run: sends reminder SMS for debtors who moved into a new age bucket and flags long overdue debtors to freeze",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "groups"
-                ],
-                "summary": "ADMIN , CEO",
-                "parameters": [
-                    {
-                        "description": "Group Data",
-                        "name": "group",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/pb.GetUpdateGroupAbs"
-                        }
-                    }
+                    "collection"
                 ],
+                "summary": "CEO , FINANCIST",
                 "responses": {
                     "200": {
-                        "description": "Group successfully updated",
-                        "schema": {
-                            "$ref": "#/definitions/utils.AbsResponse"
-                        }
-                    },
-                    "400": {
-                        "description": "Bad request",
+                        "description": "OK",
                         "schema": {
                             "$ref": "#/definitions/utils.AbsResponse"
                         }
                     },
-                    "500": {
-                        "description": "Internal server error",
+                    "409": {
+                        "description": "Conflict",
                         "schema": {
                             "$ref": "#/definitions/utils.AbsResponse"
                         }
@@ -3505,57 +3614,43 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/history/group/{groupId}": {
+        "/api/finance/collection/setting": {
             "get": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "Get the history of a specific group by its ID",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Retrieves collection settings of the company",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "groups"
-                ],
-                "summary": "ADMIN",
-                "parameters": [
-                    {
-                        "type": "string",
-                        "description": "Group ID",
-                        "name": "groupId",
-                        "in": "path",
-                        "required": true
-                    }
+                    "collection"
                 ],
+                "summary": "CEO , FINANCIST",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/pb.GetHistoryGroupResponse"
+                            "$ref": "#/definitions/pb.CollectionSetting"
                         }
                     },
-                    "500": {
-                        "description": "Internal Server Error",
+                    "409": {
+                        "description": "Conflict",
                         "schema": {
                             "$ref": "#/definitions/utils.AbsResponse"
                         }
                     }
                 }
-            }
-        },
-        "/api/history/student/{studentId}": {
-            "get": {
+            },
+            "put": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "Get the history of a specific student by their ID",
+                "description": "Updates collection settings. Debtors overdue for freezeAfterDays or more are flagged to freeze (0 disables), remindersEnabled turns escalating DEBT_REMINDER_* SMS templates on or off",
                 "consumes": [
                     "application/json"
                 ],
@@ -3563,27 +3658,35 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "students"
+                    "collection"
                 ],
-                "summary": "ADMIN",
+                "summary": "CEO",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "Student ID",
-                        "name": "studentId",
-                        "in": "path",
-                        "required": true
+                        "description": "Collection setting",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/pb.CollectionSetting"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/pb.GetHistoryStudentResponse"
+                            "$ref": "#/definitions/utils.AbsResponse"
                         }
                     },
-                    "500": {
-                        "description": "Internal Server Error",
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
                         "schema": {
                             "$ref": "#/definitions/utils.AbsResponse"
                         }
@@ -3591,42 +3694,50 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/image/get-image": {
-            "get": {
-                "description": "Retrieve an uploaded image by filename",
+        "/api/finance/discount/create": {
+            "post": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Creates a new discount for a specified group and student",
                 "consumes": [
                     "application/json"
                 ],
                 "produces": [
-                    "image/jpeg",
-                    "image/png",
-                    "image/gif"
+                    "application/json"
                 ],
                 "tags": [
-                    "image"
+                    "discount"
                 ],
-                "summary": "Get an uploaded image",
+                "summary": "CEO , ADMIN",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "Filename of the image to retrieve",
-                        "name": "filename",
-                        "in": "query",
-                        "required": true
+                        "description": "Discount Request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/pb.AbsDiscountRequest"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Image file"
+                        "description": "Success response with status and message",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
                     },
                     "400": {
-                        "description": "Bad request, filename missing",
+                        "description": "Bad Request",
                         "schema": {
                             "$ref": "#/definitions/utils.AbsResponse"
                         }
                     },
-                    "404": {
-                        "description": "Image not found",
+                    "500": {
+                        "description": "Internal Server Error",
                         "schema": {
                             "$ref": "#/definitions/utils.AbsResponse"
                         }
@@ -3634,43 +3745,49 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/image/upload": {
-            "post": {
-                "description": "Upload an image file and save it to the server",
+        "/api/finance/discount/delete": {
+            "delete": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Deletes a discount for a specific group and student",
                 "consumes": [
-                    "multipart/form-data"
+                    "application/json"
                 ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "image"
+                    "discount"
                 ],
-                "summary": "Upload an image",
+                "summary": "ADMIN , CEO",
                 "parameters": [
                     {
-                        "type": "file",
-                        "description": "Image file to upload",
-                        "name": "image",
-                        "in": "formData",
+                        "type": "string",
+                        "description": "Group ID",
+                        "name": "groupId",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Student ID",
+                        "name": "studentId",
+                        "in": "query",
                         "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Success response with file URL",
-                        "schema": {
-                            "$ref": "#/definitions/utils.AbsResponse"
-                        }
-                    },
-                    "400": {
-                        "description": "Bad request or file error",
+                        "description": "Success response with status and message",
                         "schema": {
                             "$ref": "#/definitions/utils.AbsResponse"
                         }
                     },
                     "500": {
-                        "description": "Internal server error",
+                        "description": "Internal Server Error",
                         "schema": {
                             "$ref": "#/definitions/utils.AbsResponse"
                         }
@@ -3678,14 +3795,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/lead/create": {
-            "post": {
+        "/api/finance/discount/get-all-by-group/{groupId}": {
+            "get": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "Create a new lead with the given title.",
+                "description": "Retrieves all discount information for a specific group ID",
                 "consumes": [
                     "application/json"
                 ],
@@ -3693,33 +3810,27 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "leads"
+                    "discount"
                 ],
-                "summary": "ADMIN",
+                "summary": "ADMIN , CEO",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Title of the lead",
-                        "name": "title",
-                        "in": "query",
+                        "description": "Group ID",
+                        "name": "groupId",
+                        "in": "path",
                         "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Lead successfully created",
-                        "schema": {
-                            "$ref": "#/definitions/utils.AbsResponse"
-                        }
-                    },
-                    "409": {
-                        "description": "Lead creation conflict",
+                        "description": "Success response",
                         "schema": {
-                            "$ref": "#/definitions/utils.AbsResponse"
+                            "$ref": "#/definitions/pb.GetInformationDiscountResponse"
                         }
                     },
                     "500": {
-                        "description": "Internal server error",
+                        "description": "Internal Server Error",
                         "schema": {
                             "$ref": "#/definitions/utils.AbsResponse"
                         }
@@ -3727,14 +3838,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/lead/delete/{id}": {
-            "delete": {
+        "/api/finance/discount/history/{userId}": {
+            "get": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "Delete a lead by ID.",
+                "description": "Retrieves the discount history for a user by their ID",
                 "consumes": [
                     "application/json"
                 ],
@@ -3742,33 +3853,33 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "leads"
+                    "discount"
                 ],
-                "summary": "ADMIN",
+                "summary": "ADMIN , CEO",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Lead ID",
-                        "name": "id",
+                        "description": "User ID",
+                        "name": "userId",
                         "in": "path",
                         "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Lead deleted successfully",
+                        "description": "Success",
                         "schema": {
-                            "$ref": "#/definitions/utils.AbsResponse"
+                            "$ref": "#/definitions/pb.GetHistoryDiscountResponse"
                         }
                     },
-                    "409": {
-                        "description": "Conflict occurred",
+                    "400": {
+                        "description": "Bad Request",
                         "schema": {
                             "$ref": "#/definitions/utils.AbsResponse"
                         }
                     },
                     "500": {
-                        "description": "Internal server error",
+                        "description": "Internal Server Error",
                         "schema": {
                             "$ref": "#/definitions/utils.AbsResponse"
                         }
@@ -3776,14 +3887,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/lead/get-all/{companyId}": {
-            "get": {
+        "/api/finance/discount/rule/apply": {
+            "post": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "Update the data associated with a lead",
+                "description": "Applies the most generous matching sibling, multi-course or promo code rule to the student in the group. Nothing is applied when the student already has a discount in the group",
                 "consumes": [
                     "application/json"
                 ],
@@ -3791,14 +3902,25 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "leads"
+                    "discount"
+                ],
+                "summary": "ADMIN , CEO , FINANCIST",
+                "parameters": [
+                    {
+                        "description": "Student, group and optional promo code",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/pb.ApplyDiscountRulesRequest"
+                        }
+                    }
                 ],
-                "summary": "ALL",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/pb.GetLeadListResponse"
+                            "$ref": "#/definitions/pb.ApplyDiscountRulesResponse"
                         }
                     },
                     "400": {
@@ -3807,12 +3929,6 @@ const docTemplate = `{
                             "$ref": "#/definitions/utils.AbsResponse"
                         }
                     },
-                    "401": {
-                        "description": "Unauthorized",
-                        "schema": {
-                            "$ref": "#/definitions/utils.AbsResponse"
-                        }
-                    },
                     "409": {
                         "description": "Conflict",
                         "schema": {
@@ -3822,14 +3938,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/lead/get-lead-common": {
+        "/api/finance/discount/rule/apply-template": {
             "post": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "Get a common lead by ID and type.",
+                "description": "Gives the student a discount from the chosen rule starting at startDate (today by default)",
                 "consumes": [
                     "application/json"
                 ],
@@ -3837,25 +3953,25 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "leads"
+                    "discount"
                 ],
-                "summary": "ADMIN",
+                "summary": "ADMIN , CEO , FINANCIST",
                 "parameters": [
                     {
-                        "description": "Lead ID",
-                        "name": "req",
+                        "description": "Rule, student and group",
+                        "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/pb.GetLeadCommonRequest"
+                            "$ref": "#/definitions/pb.ApplyDiscountTemplateRequest"
                         }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Lead details retrieved",
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/pb.GetLeadCommonResponse"
+                            "$ref": "#/definitions/pb.ApplyDiscountRulesResponse"
                         }
                     },
                     "400": {
@@ -3864,8 +3980,8 @@ const docTemplate = `{
                             "$ref": "#/definitions/utils.AbsResponse"
                         }
                     },
-                    "500": {
-                        "description": "Internal Server Error",
+                    "409": {
+                        "description": "Conflict",
                         "schema": {
                             "$ref": "#/definitions/utils.AbsResponse"
                         }
@@ -3873,14 +3989,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/lead/get-lead-reports": {
-            "get": {
+        "/api/finance/discount/rule/create": {
+            "post": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "Retrieves lead reports for a given date range.",
+                "description": "Creates a reusable discount rule. ruleType TEMPLATE is applied by hand, SIBLING applies to a student whose family phone is shared with an earlier registered active student, MULTI_COURSE applies from minCourses courses, PROMO_CODE applies with promoCode until usageLimit or validUntil. valueType PERCENT takes value percent off the course price, FIXED takes value off. Returns the rule id in message",
                 "consumes": [
                     "application/json"
                 ],
@@ -3888,34 +4004,35 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "leads"
+                    "discount"
                 ],
-                "summary": "CEO , ADMIN",
+                "summary": "CEO , FINANCIST",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "Start date in YYYY-MM-DD format",
-                        "name": "from",
-                        "in": "query",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "End date in YYYY-MM-DD format",
-                        "name": "till",
-                        "in": "query",
-                        "required": true
+                        "description": "Discount rule",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/pb.AbsDiscountRule"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Lead reports response",
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
                         "schema": {
                             "$ref": "#/definitions/utils.AbsResponse"
                         }
                     },
                     "409": {
-                        "description": "Conflict error with details",
+                        "description": "Conflict",
                         "schema": {
                             "$ref": "#/definitions/utils.AbsResponse"
                         }
@@ -3923,55 +4040,38 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/lead/update/{id}": {
-            "put": {
+        "/api/finance/discount/rule/get-all": {
+            "get": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "Update an existing lead by ID.",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Discount rules with their usage counts",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "leads"
+                    "discount"
                 ],
-                "summary": "ADMIN",
+                "summary": "ADMIN , CEO , FINANCIST",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "Lead ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "Title of the lead",
-                        "name": "title",
-                        "in": "query",
-                        "required": true
+                        "type": "boolean",
+                        "description": "Include inactive rules",
+                        "name": "includeInactive",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Lead updated successfully",
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/utils.AbsResponse"
+                            "$ref": "#/definitions/pb.GetDiscountRulesResponse"
                         }
                     },
                     "409": {
-                        "description": "Conflict occurred",
-                        "schema": {
-                            "$ref": "#/definitions/utils.AbsResponse"
-                        }
-                    },
-                    "500": {
-                        "description": "Internal server error",
+                        "description": "Conflict",
                         "schema": {
                             "$ref": "#/definitions/utils.AbsResponse"
                         }
@@ -3979,14 +4079,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/leadData/change-lead-data": {
-            "patch": {
+        "/api/finance/discount/rule/update": {
+            "put": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "Update the data associated with a lead",
+                "description": "Updates a discount rule, isActive=false stops it from being applied. Discounts already given keep their amount and period",
                 "consumes": [
                     "application/json"
                 ],
@@ -3994,17 +4094,17 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "leadData"
+                    "discount"
                 ],
-                "summary": "Change lead data",
+                "summary": "CEO , FINANCIST",
                 "parameters": [
                     {
-                        "description": "Lead change request",
+                        "description": "Discount rule",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/pb.ChangeLeadPlaceRequest"
+                            "$ref": "#/definitions/pb.AbsDiscountRule"
                         }
                     }
                 ],
@@ -4021,11 +4121,36 @@ const docTemplate = `{
                             "$ref": "#/definitions/utils.AbsResponse"
                         }
                     },
-                    "401": {
-                        "description": "Unauthorized",
+                    "409": {
+                        "description": "Conflict",
                         "schema": {
                             "$ref": "#/definitions/utils.AbsResponse"
                         }
+                    }
+                }
+            }
+        },
+        "/api/finance/expense/approval-setting": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Expense approval thresholds of the company, zero means the approval step is off",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "expense"
+                ],
+                "summary": "ADMIN , CEO , FINANCIST",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/pb.ExpenseApprovalSetting"
+                        }
                     },
                     "409": {
                         "description": "Conflict",
@@ -4034,16 +4159,14 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/api/leadData/create": {
-            "post": {
+            },
+            "put": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "Create lead data.",
+                "description": "Sets expense approval thresholds. Expenses reaching ceoThreshold need CEO approval, those reaching financistThreshold need FINANCIST or CEO approval. Zero turns the step off",
                 "consumes": [
                     "application/json"
                 ],
@@ -4051,35 +4174,35 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "leadData"
+                    "expense"
                 ],
-                "summary": "ADMIN",
+                "summary": "CEO",
                 "parameters": [
                     {
-                        "description": "Lead data",
-                        "name": "data",
+                        "description": "Thresholds",
+                        "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/pb.CreateLeadDataRequest"
+                            "$ref": "#/definitions/pb.ExpenseApprovalSetting"
                         }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Lead data created successfully",
+                        "description": "OK",
                         "schema": {
                             "$ref": "#/definitions/utils.AbsResponse"
                         }
                     },
-                    "409": {
-                        "description": "Conflict occurred",
+                    "400": {
+                        "description": "Bad Request",
                         "schema": {
                             "$ref": "#/definitions/utils.AbsResponse"
                         }
                     },
-                    "500": {
-                        "description": "Internal server error",
+                    "409": {
+                        "description": "Conflict",
                         "schema": {
                             "$ref": "#/definitions/utils.AbsResponse"
                         }
@@ -4087,14 +4210,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/leadData/delete/{id}": {
-            "delete": {
+        "/api/finance/expense/approve/{id}": {
+            "put": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "Delete lead data by ID.",
+                "description": "Approves an expense waiting for approval. FINANCIST may approve only expenses routed to FINANCIST, CEO may approve any",
                 "consumes": [
                     "application/json"
                 ],
@@ -4102,33 +4225,35 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "leadData"
+                    "expense"
                 ],
-                "summary": "ADMIN",
+                "summary": "CEO , FINANCIST",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Lead data ID",
+                        "description": "Expense ID",
                         "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "description": "Optional comment",
+                        "name": "request",
+                        "in": "body",
+                        "schema": {
+                            "$ref": "#/definitions/pb.ExpenseActionRequest"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Lead data deleted successfully",
+                        "description": "OK",
                         "schema": {
                             "$ref": "#/definitions/utils.AbsResponse"
                         }
                     },
                     "409": {
-                        "description": "Conflict occurred",
-                        "schema": {
-                            "$ref": "#/definitions/utils.AbsResponse"
-                        }
-                    },
-                    "500": {
-                        "description": "Internal server error",
+                        "description": "Conflict",
                         "schema": {
                             "$ref": "#/definitions/utils.AbsResponse"
                         }
@@ -4136,50 +4261,38 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/leadData/update": {
-            "put": {
+        "/api/finance/expense/budget-alerts": {
+            "get": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "Update lead data by ID.",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Category budget alerts raised when the monthly spend crossed 80% or 100% of the budget, newest first",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "leadData"
+                    "expense"
                 ],
-                "summary": "ADMIN",
+                "summary": "CEO , FINANCIST",
                 "parameters": [
                     {
-                        "description": "Lead data",
-                        "name": "data",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/pb.UpdateLeadDataRequest"
-                        }
+                        "type": "string",
+                        "description": "Month in YYYY-MM format, all recent alerts by default",
+                        "name": "period",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Lead data updated successfully",
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/utils.AbsResponse"
+                            "$ref": "#/definitions/pb.GetBudgetAlertsResponse"
                         }
                     },
                     "409": {
-                        "description": "Conflict occurred",
-                        "schema": {
-                            "$ref": "#/definitions/utils.AbsResponse"
-                        }
-                    },
-                    "500": {
-                        "description": "Internal server error",
+                        "description": "Conflict",
                         "schema": {
                             "$ref": "#/definitions/utils.AbsResponse"
                         }
@@ -4187,14 +4300,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/room/create": {
+        "/api/finance/expense/create": {
             "post": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "Create a new room based on the provided request data",
+                "description": "Creates a new expense entry with details provided in the request body. With asDraft the expense is saved as DRAFT, otherwise it is submitted: expenses reaching the company approval thresholds wait for CEO or FINANCIST approval (PENDING_APPROVAL), the rest are booked as PAID. Returns the expense id in message.",
                 "consumes": [
                     "application/json"
                 ],
@@ -4202,17 +4315,17 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "rooms"
+                    "expense"
                 ],
                 "summary": "ADMIN , CEO",
                 "parameters": [
                     {
-                        "description": "Request to create a room",
-                        "name": "request",
+                        "description": "Expense details",
+                        "name": "expense",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/pb.CreateRoomRequest"
+                            "$ref": "#/definitions/pb.CreateExpenseRequest"
                         }
                     }
                 ],
@@ -4223,12 +4336,6 @@ const docTemplate = `{
                             "$ref": "#/definitions/utils.AbsResponse"
                         }
                     },
-                    "400": {
-                        "description": "Bad Request",
-                        "schema": {
-                            "$ref": "#/definitions/utils.AbsResponse"
-                        }
-                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -4238,25 +4345,25 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/room/delete/{id}": {
+        "/api/finance/expense/delete/{id}": {
             "delete": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "Delete a room by its ID",
+                "description": "Deletes an expense entry by ID.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "rooms"
+                    "expense"
                 ],
-                "summary": "ADMIN , CEO",
+                "summary": "CEO",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Room ID",
+                        "description": "Expense ID",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -4278,69 +4385,66 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/room/get-all": {
+        "/api/finance/expense/get-all-information/{from}/{to}": {
             "get": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "Retrieve all rooms",
+                "description": "Retrieves expenses with optional filters, pagination, and date range.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "rooms"
+                    "expense"
                 ],
                 "summary": "ADMIN , CEO",
-                "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/pb.GetUpdateRoomAbs"
-                        }
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Start date",
+                        "name": "from",
+                        "in": "path",
+                        "required": true
                     },
-                    "500": {
-                        "description": "Internal Server Error",
-                        "schema": {
-                            "$ref": "#/definitions/utils.AbsResponse"
-                        }
-                    }
-                }
-            }
-        },
-        "/api/room/update": {
-            "put": {
-                "security": [
                     {
-                        "Bearer": []
-                    }
-                ],
-                "description": "Update the details of an existing room based on the provided request data",
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "rooms"
-                ],
-                "summary": "ADMIN , CEO",
-                "parameters": [
+                        "type": "string",
+                        "description": "End date",
+                        "name": "to",
+                        "in": "path",
+                        "required": true
+                    },
                     {
                         "type": "integer",
-                        "name": "capacity",
-                        "in": "query"
+                        "description": "Page number",
+                        "name": "page",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page size",
+                        "name": "size",
+                        "in": "query",
+                        "required": true
                     },
                     {
                         "type": "string",
+                        "description": "ID to filter by user, category or vendor",
                         "name": "id",
                         "in": "query"
                     },
                     {
                         "type": "string",
-                        "name": "name",
+                        "description": "Filter type (USER, CATEGORY or VENDOR)",
+                        "name": "type",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by status (DRAFT, PENDING_APPROVAL, APPROVED, REJECTED, PAID)",
+                        "name": "status",
                         "in": "query"
                     }
                 ],
@@ -4348,11 +4452,11 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/utils.AbsResponse"
+                            "$ref": "#/definitions/pb.GetAllExpenseResponse"
                         }
                     },
-                    "422": {
-                        "description": "Unprocessable Entity",
+                    "400": {
+                        "description": "Invalid request parameter",
                         "schema": {
                             "$ref": "#/definitions/utils.AbsResponse"
                         }
@@ -4366,46 +4470,35 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/set/change-to-group": {
-            "patch": {
+        "/api/finance/expense/get-by-id/{id}": {
+            "get": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "Change the lead set to a group based on the provided request data",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Expense with its status, vendor, attached receipts and status history",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "sets"
+                    "expense"
                 ],
-                "summary": "ADMIN",
+                "summary": "ADMIN , CEO , FINANCIST",
                 "parameters": [
                     {
-                        "description": "Request to change set to group",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/pb.ChangeToSetRequest"
-                        }
+                        "type": "string",
+                        "description": "Expense ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/utils.AbsResponse"
-                        }
-                    },
-                    "400": {
-                        "description": "Bad Request",
-                        "schema": {
-                            "$ref": "#/definitions/utils.AbsResponse"
+                            "$ref": "#/definitions/pb.ExpenseDetail"
                         }
                     },
                     "409": {
@@ -4417,14 +4510,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/set/create": {
-            "post": {
+        "/api/finance/expense/get-chart-diagram/{from}/{to}": {
+            "get": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "Create a new set.",
+                "description": "Queries the finance service for expense data between 'from' and 'to' dates, returning it as a chart diagram. Budgets holds budget vs actual spend of every budgeted category per month.",
                 "consumes": [
                     "application/json"
                 ],
@@ -4432,35 +4525,34 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "sets"
+                    "expense"
                 ],
-                "summary": "ADMIN",
+                "summary": "ADMIN , CEO",
                 "parameters": [
                     {
-                        "description": "Set data",
-                        "name": "data",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/pb.CreateSetRequest"
-                        }
+                        "type": "string",
+                        "description": "Start date for the chart (YYYY-MM-DD)",
+                        "name": "from",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "End date for the chart (YYYY-MM-DD)",
+                        "name": "to",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Set created successfully",
+                        "description": "Chart data successfully retrieved",
                         "schema": {
-                            "$ref": "#/definitions/utils.AbsResponse"
+                            "$ref": "#/definitions/pb.GetAllExpenseDiagramResponse"
                         }
                     },
                     "409": {
-                        "description": "Conflict occurred",
-                        "schema": {
-                            "$ref": "#/definitions/utils.AbsResponse"
-                        }
-                    },
-                    "500": {
-                        "description": "Internal server error",
+                        "description": "Conflict or error retrieving chart data",
                         "schema": {
                             "$ref": "#/definitions/utils.AbsResponse"
                         }
@@ -4468,14 +4560,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/set/delete/{id}": {
-            "delete": {
+        "/api/finance/expense/mark-paid/{id}": {
+            "put": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "Delete a set by ID.",
+                "description": "Marks an approved expense as paid",
                 "consumes": [
                     "application/json"
                 ],
@@ -4483,33 +4575,35 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "sets"
+                    "expense"
                 ],
-                "summary": "ADMIN",
+                "summary": "CEO , FINANCIST",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Set ID",
+                        "description": "Expense ID",
                         "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "description": "Optional comment",
+                        "name": "request",
+                        "in": "body",
+                        "schema": {
+                            "$ref": "#/definitions/pb.ExpenseActionRequest"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Set deleted successfully",
+                        "description": "OK",
                         "schema": {
                             "$ref": "#/definitions/utils.AbsResponse"
                         }
                     },
                     "409": {
-                        "description": "Conflict occurred",
-                        "schema": {
-                            "$ref": "#/definitions/utils.AbsResponse"
-                        }
-                    },
-                    "500": {
-                        "description": "Internal server error",
+                        "description": "Conflict",
                         "schema": {
                             "$ref": "#/definitions/utils.AbsResponse"
                         }
@@ -4517,14 +4611,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/set/get-by-id/{id}": {
-            "get": {
+        "/api/finance/expense/receipt": {
+            "post": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "Retrieves lead details based on the provided ID.",
+                "description": "Attaches a receipt (photo or PDF) to an expense. Upload the file through /api/image/upload first and pass the returned url as fileUrl. Returns the receipt id in message",
                 "consumes": [
                     "application/json"
                 ],
@@ -4532,33 +4626,35 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "sets"
+                    "expense"
                 ],
-                "summary": "ADMIN , CEO",
+                "summary": "ADMIN , CEO , FINANCIST",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "Lead ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "description": "Expense and uploaded file",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/pb.AddExpenseReceiptRequest"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Successful response with lead details",
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/pb.SetDataResponse"
+                            "$ref": "#/definitions/utils.AbsResponse"
                         }
                     },
-                    "404": {
-                        "description": "Lead not found",
+                    "400": {
+                        "description": "Bad Request",
                         "schema": {
                             "$ref": "#/definitions/utils.AbsResponse"
                         }
                     },
                     "409": {
-                        "description": "Conflict error with details",
+                        "description": "Conflict",
                         "schema": {
                             "$ref": "#/definitions/utils.AbsResponse"
                         }
@@ -4566,56 +4662,39 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/set/update": {
-            "put": {
+        "/api/finance/expense/receipt/{id}": {
+            "delete": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "Update an existing set by ID.",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Removes a receipt from an expense",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "sets"
+                    "expense"
                 ],
-                "summary": "ADMIN",
+                "summary": "ADMIN , CEO , FINANCIST",
                 "parameters": [
                     {
-                        "description": "Set data",
-                        "name": "data",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/pb.UpdateSetRequest"
-                        }
+                        "type": "string",
+                        "description": "Receipt ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Set updated successfully",
-                        "schema": {
-                            "$ref": "#/definitions/utils.AbsResponse"
-                        }
-                    },
-                    "400": {
-                        "description": "Bad request",
+                        "description": "OK",
                         "schema": {
                             "$ref": "#/definitions/utils.AbsResponse"
                         }
                     },
                     "409": {
-                        "description": "Conflict occurred",
-                        "schema": {
-                            "$ref": "#/definitions/utils.AbsResponse"
-                        }
-                    },
-                    "500": {
-                        "description": "Internal server error",
+                        "description": "Conflict",
                         "schema": {
                             "$ref": "#/definitions/utils.AbsResponse"
                         }
@@ -4623,46 +4702,50 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/student/add-to-group": {
+        "/api/finance/expense/recurring/create": {
             "post": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
+                "description": "Creates a recurring expense (rent, utilities, subscriptions) that is posted automatically. Schedule is MONTHLY with day 1-31 (last day of shorter months) or WEEKLY with day 1 (monday) - 7 (sunday). endDate is optional. Returns the id in message",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "students"
+                    "expense"
                 ],
-                "summary": "ADMIN",
+                "summary": "CEO , FINANCIST",
                 "parameters": [
                     {
-                        "description": "Add student to group details",
+                        "description": "Recurring expense",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/pb.AddToGroupRequest"
+                            "$ref": "#/definitions/pb.CreateRecurringExpenseRequest"
                         }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Success message",
+                        "description": "OK",
                         "schema": {
                             "$ref": "#/definitions/utils.AbsResponse"
                         }
                     },
                     "400": {
-                        "description": "Invalid request",
+                        "description": "Bad Request",
                         "schema": {
                             "$ref": "#/definitions/utils.AbsResponse"
                         }
                     },
-                    "500": {
-                        "description": "Internal server error",
+                    "409": {
+                        "description": "Conflict",
                         "schema": {
                             "$ref": "#/definitions/utils.AbsResponse"
                         }
@@ -4670,50 +4753,70 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/student/change-condition": {
-            "put": {
+        "/api/finance/expense/recurring/delete/{id}": {
+            "delete": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "Changes the condition of a student based on provided details",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Stops a recurring expense. Expenses it has already posted are kept",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "students"
+                    "expense"
                 ],
-                "summary": "ADMIN, CEO",
+                "summary": "CEO , FINANCIST",
                 "parameters": [
                     {
-                        "description": "Change Condition Student Request",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/pb.ChangeConditionStudentRequest"
-                        }
+                        "type": "string",
+                        "description": "Recurring expense ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Status and message",
+                        "description": "OK",
                         "schema": {
                             "$ref": "#/definitions/utils.AbsResponse"
                         }
                     },
-                    "400": {
-                        "description": "Bad request",
+                    "409": {
+                        "description": "Conflict",
                         "schema": {
                             "$ref": "#/definitions/utils.AbsResponse"
                         }
+                    }
+                }
+            }
+        },
+        "/api/finance/expense/recurring/get-all": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Lists recurring expenses with their next run date, active ones first. skippedDates are occurrences in closed accounting periods that were not posted",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "expense"
+                ],
+                "summary": "ADMIN , CEO , FINANCIST",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/pb.GetRecurringExpensesResponse"
+                        }
                     },
-                    "500": {
-                        "description": "Internal server error",
+                    "409": {
+                        "description": "Conflict",
                         "schema": {
                             "$ref": "#/definitions/utils.AbsResponse"
                         }
@@ -4721,46 +4824,30 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/student/create": {
+        "/api/finance/expense/recurring/post": {
             "post": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
+                "description": "Posts all due recurring expenses now. The scheduler runs the same every day, occurrences in closed accounting periods are not posted and are listed in skippedDates of the recurring expense",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "students"
-                ],
-                "summary": "ADMIN",
-                "parameters": [
-                    {
-                        "description": "Student details",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/pb.CreateStudentRequest"
-                        }
-                    }
+                    "expense"
                 ],
+                "summary": "CEO , FINANCIST",
                 "responses": {
                     "200": {
-                        "description": "Created student details",
-                        "schema": {
-                            "$ref": "#/definitions/utils.AbsResponse"
-                        }
-                    },
-                    "400": {
-                        "description": "Invalid request",
+                        "description": "OK",
                         "schema": {
                             "$ref": "#/definitions/utils.AbsResponse"
                         }
                     },
-                    "500": {
-                        "description": "Internal server error",
+                    "409": {
+                        "description": "Conflict",
                         "schema": {
                             "$ref": "#/definitions/utils.AbsResponse"
                         }
@@ -4768,45 +4855,51 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/student/delete/{id}": {
-            "delete": {
+        "/api/finance/expense/reject/{id}": {
+            "put": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
+                "description": "Rejects an expense waiting for approval, comment with the reason is required. A rejected expense may be corrected and submitted again",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "students"
+                    "expense"
                 ],
-                "summary": "ADMIN",
+                "summary": "CEO , FINANCIST",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Student ID",
+                        "description": "Expense ID",
                         "name": "id",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "type": "boolean",
-                        "description": "Flag to determine if money should be returned",
-                        "name": "returnMoney",
-                        "in": "query",
-                        "required": true
+                        "description": "Rejection reason in comment",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/pb.ExpenseActionRequest"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Delete success message",
+                        "description": "OK",
                         "schema": {
                             "$ref": "#/definitions/utils.AbsResponse"
                         }
                     },
-                    "500": {
-                        "description": "Internal server error",
+                    "409": {
+                        "description": "Conflict",
                         "schema": {
                             "$ref": "#/definitions/utils.AbsResponse"
                         }
@@ -4814,46 +4907,50 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/student/get-all": {
-            "post": {
+        "/api/finance/expense/submit/{id}": {
+            "put": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "produces": [
+                "description": "Submits a DRAFT or REJECTED expense. It goes to PENDING_APPROVAL when it reaches an approval threshold the submitter may not approve, otherwise it is booked as PAID. Returns the new status in message",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "students"
+                    "expense"
                 ],
-                "summary": "ADMIN",
+                "summary": "ADMIN , CEO , FINANCIST",
                 "parameters": [
                     {
-                        "description": "filter students",
-                        "name": "filter",
+                        "type": "string",
+                        "description": "Expense ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Optional comment",
+                        "name": "request",
                         "in": "body",
-                        "required": true,
                         "schema": {
-                            "$ref": "#/definitions/pb.GetAllStudentRequest"
+                            "$ref": "#/definitions/pb.ExpenseActionRequest"
                         }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "List of students",
-                        "schema": {
-                            "$ref": "#/definitions/pb.GetAllStudentResponse"
-                        }
-                    },
-                    "400": {
-                        "description": "Invalid condition",
+                        "description": "OK",
                         "schema": {
                             "$ref": "#/definitions/utils.AbsResponse"
                         }
                     },
-                    "500": {
-                        "description": "Internal server error",
+                    "409": {
+                        "description": "Conflict",
                         "schema": {
                             "$ref": "#/definitions/utils.AbsResponse"
                         }
@@ -4861,59 +4958,90 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/student/get-student-by-id/{studentId}": {
+        "/api/finance/export/1c": {
             "get": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
+                "description": "Downloads student payments, refunds, paid expenses and salary payouts of the date range as a 1CClientBankExchange file in Windows-1251. Accounts come from the company 1C setting",
                 "produces": [
-                    "application/json"
+                    "application/octet-stream"
                 ],
                 "tags": [
-                    "students"
+                    "export"
                 ],
-                "summary": "ADMIN",
+                "summary": "CEO , FINANCIST",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Student ID",
-                        "name": "studentId",
-                        "in": "path",
+                        "description": "Start date (format: YYYY-MM-DD)",
+                        "name": "from",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "End date (format: YYYY-MM-DD)",
+                        "name": "to",
+                        "in": "query",
                         "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "List of students",
+                        "description": "1C exchange file",
                         "schema": {
-                            "$ref": "#/definitions/pb.GetAllStudentResponse"
+                            "type": "file"
                         }
                     },
-                    "400": {
-                        "description": "Invalid condition",
+                    "409": {
+                        "description": "Conflict",
                         "schema": {
                             "$ref": "#/definitions/utils.AbsResponse"
                         }
+                    }
+                }
+            }
+        },
+        "/api/finance/export/1c/setting": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Organization requisites and account mappings used by the 1C export",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "export"
+                ],
+                "summary": "CEO , FINANCIST",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/pb.OneCSetting"
+                        }
                     },
-                    "500": {
-                        "description": "Internal server error",
+                    "409": {
+                        "description": "Conflict",
                         "schema": {
                             "$ref": "#/definitions/utils.AbsResponse"
                         }
                     }
                 }
-            }
-        },
-        "/api/student/note/create": {
-            "post": {
+            },
+            "put": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "Create a new note associated with a specific student",
+                "description": "Replaces organization requisites and account mappings of the 1C export. sourceType is PAYMENT_METHOD (sourceKey CASH, CLICK or PAYME), STUDENT, EXPENSE_CATEGORY (sourceKey is the category id, empty for the default), EXPENSE_USER or SALARY",
                 "consumes": [
                     "application/json"
                 ],
@@ -4921,17 +5049,17 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "notes"
+                    "export"
                 ],
-                "summary": "ADMIN",
+                "summary": "CEO , FINANCIST",
                 "parameters": [
                     {
-                        "description": "Note details",
+                        "description": "1C setting",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/pb.CreateNoteRequest"
+                            "$ref": "#/definitions/pb.OneCSetting"
                         }
                     }
                 ],
@@ -4948,8 +5076,8 @@ const docTemplate = `{
                             "$ref": "#/definitions/utils.AbsResponse"
                         }
                     },
-                    "500": {
-                        "description": "Internal Server Error",
+                    "409": {
+                        "description": "Conflict",
                         "schema": {
                             "$ref": "#/definitions/utils.AbsResponse"
                         }
@@ -4957,29 +5085,26 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/student/note/delete/{noteId}": {
-            "delete": {
+        "/api/finance/installment/cancel/{planId}": {
+            "put": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "Delete a specific note associated with a student",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Cancels an active installment plan. Already charged installments stay on the student balance and the monthly job goes back to charging the course price",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "notes"
+                    "installment"
                 ],
-                "summary": "ADMIN",
+                "summary": "CEO , FINANCIST",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Note ID",
-                        "name": "noteId",
+                        "description": "Installment plan ID",
+                        "name": "planId",
                         "in": "path",
                         "required": true
                     }
@@ -4991,8 +5116,8 @@ const docTemplate = `{
                             "$ref": "#/definitions/utils.AbsResponse"
                         }
                     },
-                    "500": {
-                        "description": "Internal Server Error",
+                    "409": {
+                        "description": "Conflict",
                         "schema": {
                             "$ref": "#/definitions/utils.AbsResponse"
                         }
@@ -5000,14 +5125,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/student/note/get-notes/{studentId}": {
-            "get": {
+        "/api/finance/installment/create": {
+            "post": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "Get all notes associated with a specific student",
+                "description": "Creates a payment plan for a student in a group. planType is FULL_PREPAY (one installment of totalAmount on startDate), MONTHLY (totalAmount split over monthsCount months from startDate) or CUSTOM (installments list). Installments due today or earlier are charged right away, later ones are charged by the monthly charge job instead of the course price",
                 "consumes": [
                     "application/json"
                 ],
@@ -5015,27 +5140,35 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "notes"
+                    "installment"
                 ],
-                "summary": "ADMIN",
+                "summary": "CEO , ADMIN , FINANCIST",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "Student ID",
-                        "name": "studentId",
-                        "in": "path",
-                        "required": true
+                        "description": "Installment plan",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/pb.CreateInstallmentPlanRequest"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/pb.GetNotesByStudent"
+                            "$ref": "#/definitions/utils.AbsResponse"
                         }
                     },
-                    "500": {
-                        "description": "Internal Server Error",
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
                         "schema": {
                             "$ref": "#/definitions/utils.AbsResponse"
                         }
@@ -5043,42 +5176,38 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/student/search-student/{value}": {
+        "/api/finance/installment/get-all": {
             "get": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "Search for students by phone number or name",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Retrieves installment plans with their schedule, optionally only for one student",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "students"
+                    "installment"
                 ],
-                "summary": "ADMIN",
+                "summary": "CEO , ADMIN , FINANCIST",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Search value (phone number or name)",
-                        "name": "value",
-                        "in": "path",
-                        "required": true
+                        "description": "Student ID",
+                        "name": "studentId",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/pb.SearchStudentResponse"
+                            "$ref": "#/definitions/pb.GetInstallmentPlansResponse"
                         }
                     },
-                    "500": {
-                        "description": "Internal Server Error",
+                    "409": {
+                        "description": "Conflict",
                         "schema": {
                             "$ref": "#/definitions/utils.AbsResponse"
                         }
@@ -5086,46 +5215,39 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/student/update": {
-            "put": {
+        "/api/finance/installment/get-by-id/{planId}": {
+            "get": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
+                "description": "Retrieves an installment plan with due dates, paid amounts and status of every installment",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "students"
+                    "installment"
                 ],
-                "summary": "ADMIN",
+                "summary": "CEO , ADMIN , FINANCIST",
                 "parameters": [
                     {
-                        "description": "Updated student details",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/pb.UpdateStudentRequest"
-                        }
+                        "type": "string",
+                        "description": "Installment plan ID",
+                        "name": "planId",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Update success message",
-                        "schema": {
-                            "$ref": "#/definitions/utils.AbsResponse"
-                        }
-                    },
-                    "400": {
-                        "description": "Invalid request",
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/utils.AbsResponse"
+                            "$ref": "#/definitions/pb.AbsInstallmentPlan"
                         }
                     },
-                    "500": {
-                        "description": "Internal server error",
+                    "409": {
+                        "description": "Conflict",
                         "schema": {
                             "$ref": "#/definitions/utils.AbsResponse"
                         }
@@ -5133,50 +5255,44 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/user/create": {
-            "post": {
+        "/api/finance/installment/overdue": {
+            "get": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "Create a new user",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Debtor report by overdue installments. Every unpaid installment past its due date is listed with days overdue and its age bucket (0-30, 31-60, 61-90, 90+), totals are returned per bucket",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "user"
+                    "installment"
                 ],
-                "summary": "CEO",
+                "summary": "CEO , ADMIN , FINANCIST",
                 "parameters": [
                     {
-                        "description": "User data",
-                        "name": "user",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/pb.CreateUserRequest"
-                        }
+                        "type": "string",
+                        "description": "Report date in YYYY-MM-DD format, today by default",
+                        "name": "date",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Age bucket filter: 0-30, 31-60, 61-90 or 90+",
+                        "name": "bucket",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Successfully created user",
-                        "schema": {
-                            "$ref": "#/definitions/utils.AbsResponse"
-                        }
-                    },
-                    "400": {
-                        "description": "Bad request",
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/utils.AbsResponse"
+                            "$ref": "#/definitions/pb.GetOverdueInstallmentsResponse"
                         }
                     },
-                    "500": {
-                        "description": "Internal server error",
+                    "409": {
+                        "description": "Conflict",
                         "schema": {
                             "$ref": "#/definitions/utils.AbsResponse"
                         }
@@ -5184,36 +5300,47 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/user/delete/{userId}": {
-            "delete": {
+        "/api/finance/payment/all-student-payments": {
+            "post": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "Delete a user from the system using their ID",
+                "description": "Retrieves a list of student payments between the provided 'from' and 'to' date parameters.",
+                "produces": [
+                    "application/json"
+                ],
                 "tags": [
-                    "user"
+                    "payments"
                 ],
                 "summary": "ADMIN , CEO",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "User ID",
-                        "name": "userId",
-                        "in": "path",
-                        "required": true
+                        "description": "get all student payment request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/pb.GetAllStudentPaymentsRequest"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK",
+                        "description": "List of payments",
+                        "schema": {
+                            "$ref": "#/definitions/pb.GetAllStudentPaymentsResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict error with an explanation",
                         "schema": {
                             "$ref": "#/definitions/utils.AbsResponse"
                         }
                     },
-                    "400": {
-                        "description": "Bad Request",
+                    "500": {
+                        "description": "Internal server error",
                         "schema": {
                             "$ref": "#/definitions/utils.AbsResponse"
                         }
@@ -5221,54 +5348,47 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/user/get-all-employee/{isArchived}": {
-            "get": {
+        "/api/finance/payment/all-student-payments/chart": {
+            "post": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "Retrieves a list of all employees based on archive status. Restricted to ADMIN and CEO roles.",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Fetches payment data for students between the given 'from' and 'to' dates to be used in chart visualizations.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "user"
+                    "payments"
                 ],
-                "summary": "ADMIN , CEO",
+                "summary": "ADMIN ,CEO",
                 "parameters": [
                     {
-                        "type": "boolean",
-                        "description": "Filter by archive status (true=archived, false=active)",
-                        "name": "isArchived",
-                        "in": "path",
-                        "required": true
+                        "description": "get all student payment request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/pb.GetAllStudentPaymentsRequest"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/pb.GetAllEmployeeResponse"
-                        }
-                    },
-                    "400": {
-                        "description": "Invalid input or processing error",
+                        "description": "Chart data of payments",
                         "schema": {
-                            "$ref": "#/definitions/utils.AbsResponse"
+                            "$ref": "#/definitions/pb.GetAllStudentPaymentsChartResponse"
                         }
                     },
-                    "401": {
-                        "description": "Unauthorized access",
+                    "409": {
+                        "description": "Conflict error with an explanation",
                         "schema": {
                             "$ref": "#/definitions/utils.AbsResponse"
                         }
                     },
-                    "408": {
-                        "description": "Request timeout",
+                    "500": {
+                        "description": "Internal server error",
                         "schema": {
                             "$ref": "#/definitions/utils.AbsResponse"
                         }
@@ -5276,14 +5396,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/user/get-all-staff/{isArchived}": {
+        "/api/finance/payment/get-all-debts/{page}/{size}": {
             "get": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "Retrieve a list of all staff members, filtered by their archived status.",
+                "description": "Retrieves information about debts within the specified date range.",
                 "consumes": [
                     "application/json"
                 ],
@@ -5291,36 +5411,68 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "user"
+                    "payments"
                 ],
                 "summary": "ADMIN , CEO",
                 "parameters": [
                     {
-                        "type": "boolean",
-                        "description": "Boolean to filter archived or active staff; true for archived, false for active",
-                        "name": "isArchived",
+                        "type": "string",
+                        "description": "page",
+                        "name": "page",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "size",
+                        "name": "size",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "from",
+                        "name": "from",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "to",
+                        "name": "to",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "amountTo",
+                        "name": "amountTo",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "amountFrom",
+                        "name": "amountFrom",
+                        "in": "query",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "List of staff members based on archived status",
+                        "description": "Success response containing debt information",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/pb.GetAllStuffResponse"
-                            }
+                            "$ref": "#/definitions/pb.GetAllDebtsInformationResponse"
                         }
                     },
-                    "400": {
-                        "description": "Bad request - Invalid 'isArchived' parameter",
+                    "409": {
+                        "description": "Conflict occurred while fetching debt information",
                         "schema": {
                             "$ref": "#/definitions/utils.AbsResponse"
                         }
                     },
                     "500": {
-                        "description": "Internal server error during data retrieval",
+                        "description": "Internal server error",
                         "schema": {
                             "$ref": "#/definitions/utils.AbsResponse"
                         }
@@ -5328,40 +5480,46 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/user/get-my-profile": {
+        "/api/finance/payment/get-all-payments/{studentId}/{month}": {
             "get": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "Retrieve the information of the authenticated user. Admin and CEO roles receive basic user info, while Teachers get additional group information.",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Get all payment records for a student within a specified month.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "user"
+                    "payments"
+                ],
+                "summary": "ADMIN , CEO",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Student ID",
+                        "name": "studentId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Month (format: YYYY-MM)",
+                        "name": "month",
+                        "in": "path",
+                        "required": true
+                    }
                 ],
-                "summary": "ADMIN , CEO , TEACHER",
                 "responses": {
                     "200": {
-                        "description": "User information with optional groups for Teachers",
-                        "schema": {
-                            "type": "object",
-                            "additionalProperties": true
-                        }
-                    },
-                    "401": {
-                        "description": "Unauthorized - Invalid or missing authentication",
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/utils.AbsResponse"
+                            "$ref": "#/definitions/pb.GetAllPaymentsByMonthResponse"
                         }
                     },
-                    "500": {
-                        "description": "Internal server error while retrieving teacher's group information",
+                    "400": {
+                        "description": "Invalid request parameters or failed retrieval",
                         "schema": {
                             "$ref": "#/definitions/utils.AbsResponse"
                         }
@@ -5369,14 +5527,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/user/get-teachers/{isDeleted}": {
+        "/api/finance/payment/payment-take-off/chart/{from}/{to}": {
             "get": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "Fetches a list of teachers based on the deletion status",
+                "description": "Retrieve a chart representation of take-off payments within a specified date range.",
                 "consumes": [
                     "application/json"
                 ],
@@ -5384,33 +5542,40 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "user"
+                    "payments"
                 ],
-                "summary": "ADMIN,CEO",
+                "summary": "ADMIN , CEO",
                 "parameters": [
                     {
-                        "type": "boolean",
-                        "description": "Deletion status (true/false)",
-                        "name": "isDeleted",
+                        "type": "string",
+                        "description": "Start date (format: YYYY-MM-DD)",
+                        "name": "from",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "End date (format: YYYY-MM-DD)",
+                        "name": "to",
                         "in": "path",
                         "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "List of teachers",
+                        "description": "Chart data of take-off payments",
                         "schema": {
-                            "$ref": "#/definitions/pb.GetTeachersResponse"
+                            "$ref": "#/definitions/pb.GetAllPaymentTakeOffChartResponse"
                         }
                     },
-                    "400": {
-                        "description": "Bad request",
+                    "409": {
+                        "description": "Error message",
                         "schema": {
                             "$ref": "#/definitions/utils.AbsResponse"
                         }
                     },
                     "500": {
-                        "description": "Internal server error",
+                        "description": "Server error",
                         "schema": {
                             "$ref": "#/definitions/utils.AbsResponse"
                         }
@@ -5418,34 +5583,55 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/user/get-user/{userId}": {
+        "/api/finance/payment/payment-take-off/{from}/{to}": {
             "get": {
-                "description": "Retrieve user details by their unique user ID.",
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Retrieve all take-off payments within a specified date range.",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "user"
+                    "payments"
                 ],
-                "summary": "CEO , ADMIN , TEACHER",
+                "summary": "ADMIN , CEO",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "User ID",
-                        "name": "userId",
+                        "description": "Start date (format: YYYY-MM-DD)",
+                        "name": "from",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "End date (format: YYYY-MM-DD)",
+                        "name": "to",
                         "in": "path",
                         "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK",
+                        "description": "List of take-off payments",
                         "schema": {
-                            "$ref": "#/definitions/pb.GetUserByIdResponse"
+                            "$ref": "#/definitions/pb.GetAllPaymentTakeOffResponse"
                         }
                     },
-                    "400": {
-                        "description": "Bad Request",
+                    "409": {
+                        "description": "Error message",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
                         "schema": {
                             "$ref": "#/definitions/utils.AbsResponse"
                         }
@@ -5453,32 +5639,40 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/user/history/{userId}": {
-            "get": {
+        "/api/finance/payment/student/add": {
+            "post": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "Fetches the history data for a given user ID.",
+                "description": "Add a payment for a student",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
                 "tags": [
-                    "user"
+                    "payments"
                 ],
-                "summary": "ADMIN, CEO, TEACHER",
+                "summary": "ADMIN , CEO",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "User ID",
-                        "name": "userId",
-                        "in": "path",
-                        "required": true
+                        "description": "Payment Add Request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/pb.PaymentAddRequest"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/pb.GetHistoryByUserIdResponse"
+                            "$ref": "#/definitions/utils.AbsResponse"
                         }
                     },
                     "400": {
@@ -5486,43 +5680,100 @@ const docTemplate = `{
                         "schema": {
                             "$ref": "#/definitions/utils.AbsResponse"
                         }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
                     }
                 }
             }
         },
-        "/api/user/login": {
-            "post": {
-                "description": "Authenticate a user and return a token upon successful login.",
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
+        "/api/finance/payment/student/get-monthly-status/{studentId}": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Get the monthly payment status for a student.",
+                "produces": [
+                    "application/json"
                 ],
                 "tags": [
-                    "user"
+                    "payments"
                 ],
-                "summary": "ALL",
+                "summary": "ADMIN , CEO",
                 "parameters": [
                     {
-                        "description": "Login credentials",
-                        "name": "LoginRequest",
+                        "type": "string",
+                        "description": "Student ID",
+                        "name": "studentId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/pb.GetMonthlyStatusResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request parameters or failed retrieval",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/finance/payment/student/return": {
+            "post": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Return a payment for a student",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "payments"
+                ],
+                "summary": "ADMIN , CEO",
+                "parameters": [
+                    {
+                        "description": "Payment Return Request",
+                        "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/pb.LoginRequest"
+                            "$ref": "#/definitions/pb.PaymentReturnRequest"
                         }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Successful login",
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/pb.LoginResponse"
+                            "$ref": "#/definitions/utils.AbsResponse"
                         }
                     },
                     "400": {
-                        "description": "Bad request - Invalid JSON or login failure",
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
                         "schema": {
                             "$ref": "#/definitions/utils.AbsResponse"
                         }
@@ -5530,9 +5781,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/user/update": {
+        "/api/finance/payment/student/update": {
             "patch": {
-                "description": "Update user details using their ID",
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Update a payment for a student",
                 "consumes": [
                     "application/json"
                 ],
@@ -5540,17 +5796,17 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "user"
+                    "payments"
                 ],
-                "summary": "ADMIN , CEO , TEACHER",
+                "summary": "ADMIN , CEO",
                 "parameters": [
                     {
-                        "description": "User Details",
-                        "name": "user",
+                        "description": "Payment Update Request",
+                        "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/pb.UpdateUserRequest"
+                            "$ref": "#/definitions/pb.PaymentUpdateRequest"
                         }
                     }
                 ],
@@ -5566,18 +5822,24 @@ const docTemplate = `{
                         "schema": {
                             "$ref": "#/definitions/utils.AbsResponse"
                         }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
                     }
                 }
             }
         },
-        "/api/user/update-password/{userId}/{password}": {
-            "put": {
+        "/api/finance/payroll/adjustment/add": {
+            "post": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "Updates the password of a user specified by the userId.",
+                "description": "Adds BONUS, PENALTY or ADVANCE adjustment to a teacher in a DRAFT payroll run",
                 "consumes": [
                     "application/json"
                 ],
@@ -5585,266 +5847,8219 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "user"
+                    "payroll"
                 ],
-                "summary": "CEO",
+                "summary": "CEO , FINANCIST",
+                "parameters": [
+                    {
+                        "description": "Adjustment",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/pb.AddPayrollAdjustmentRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/finance/payroll/adjustment/delete/{id}": {
+            "delete": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Deletes an adjustment from a DRAFT payroll run",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "payroll"
+                ],
+                "summary": "CEO , FINANCIST",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "User ID",
-                        "name": "userId",
+                        "description": "Adjustment ID",
+                        "name": "id",
                         "in": "path",
                         "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
                     },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/finance/payroll/approve/{runId}": {
+            "post": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Approves a DRAFT payroll run. Approved payslips become visible to teachers",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "payroll"
+                ],
+                "summary": "CEO",
+                "parameters": [
                     {
                         "type": "string",
-                        "description": "New Password",
-                        "name": "password",
+                        "description": "Payroll run ID",
+                        "name": "runId",
                         "in": "path",
                         "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Password updated successfully",
+                        "description": "OK",
                         "schema": {
                             "$ref": "#/definitions/utils.AbsResponse"
                         }
                     },
-                    "400": {
-                        "description": "Bad Request",
+                    "409": {
+                        "description": "Conflict",
                         "schema": {
                             "$ref": "#/definitions/utils.AbsResponse"
                         }
                     }
                 }
             }
-        }
-    },
-    "definitions": {
-        "pb.AbsCalculateSalary": {
-            "type": "object",
-            "properties": {
-                "commonLessonCountInPeriod": {
-                    "type": "integer"
-                },
-                "groupId": {
-                    "type": "string"
-                },
-                "groupName": {
-                    "type": "string"
-                },
-                "salaries": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/pb.StudentSalary"
+        },
+        "/api/finance/payroll/create": {
+            "post": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Creates a DRAFT payroll run for the month. Salary of every teacher is calculated from attendance and stored with per group and per student breakdown",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "payroll"
+                ],
+                "summary": "CEO , FINANCIST",
+                "parameters": [
+                    {
+                        "description": "Period in YYYY-MM format",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/pb.CreatePayrollRunRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "message contains created payroll run id",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
                     }
                 }
             }
         },
-        "pb.AbsCategory": {
-            "type": "object",
-            "properties": {
-                "desc": {
-                    "type": "string"
-                },
-                "id": {
+        "/api/finance/payroll/delete/{runId}": {
+            "delete": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Deletes a DRAFT payroll run",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "payroll"
+                ],
+                "summary": "CEO , FINANCIST",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Payroll run ID",
+                        "name": "runId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/finance/payroll/get-all": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Retrieves all payroll runs with totals",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "payroll"
+                ],
+                "summary": "CEO , FINANCIST",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/pb.GetPayrollRunsResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/finance/payroll/get-by-id/{runId}": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Retrieves a payroll run with salary, bonus, penalty and advance of every teacher",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "payroll"
+                ],
+                "summary": "CEO , FINANCIST",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Payroll run ID",
+                        "name": "runId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/pb.AbsPayrollRun"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/finance/payroll/my-payslips": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Retrieves approved and paid payslips of the current teacher",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "payroll"
+                ],
+                "summary": "TEACHER",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/pb.GetTeacherPayslipsResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/finance/payroll/my-payslips/{runId}/print": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Renders the payslip of the current teacher as printable html page",
+                "produces": [
+                    "text/html"
+                ],
+                "tags": [
+                    "payroll"
+                ],
+                "summary": "TEACHER",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Payroll run ID",
+                        "name": "runId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "html page",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/finance/payroll/pay": {
+            "post": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Pays an APPROVED payroll run. Every teacher payout is posted as an expense",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "payroll"
+                ],
+                "summary": "CEO , FINANCIST",
+                "parameters": [
+                    {
+                        "description": "Payment details",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/pb.PayPayrollRunRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/finance/payroll/payslip/{runId}/{teacherId}": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Retrieves the payslip of a teacher in the payroll run",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "payroll"
+                ],
+                "summary": "CEO , FINANCIST",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Payroll run ID",
+                        "name": "runId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Teacher ID",
+                        "name": "teacherId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/pb.Payslip"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/finance/payroll/payslip/{runId}/{teacherId}/print": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Renders the payslip of a teacher as printable html page",
+                "produces": [
+                    "text/html"
+                ],
+                "tags": [
+                    "payroll"
+                ],
+                "summary": "CEO , FINANCIST",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Payroll run ID",
+                        "name": "runId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Teacher ID",
+                        "name": "teacherId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "html page",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/finance/period/close": {
+            "post": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Closes the accounting period (month). After closing, payments, expenses, discounts and attendance dated in this month can not be changed",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "period"
+                ],
+                "summary": "CEO , FINANCIST",
+                "parameters": [
+                    {
+                        "description": "Period in YYYY-MM format",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/pb.AccountingPeriodRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/finance/period/get-all": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Retrieves all closed and reopened accounting periods",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "period"
+                ],
+                "summary": "CEO , FINANCIST",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/pb.GetAllPeriodsResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/finance/period/history": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Retrieves the close/reopen audit of accounting periods",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "period"
+                ],
+                "summary": "CEO , FINANCIST",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Period in YYYY-MM format",
+                        "name": "period",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/pb.GetPeriodHistoryResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/finance/period/reopen": {
+            "post": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Reopens a closed accounting period (month)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "period"
+                ],
+                "summary": "CEO",
+                "parameters": [
+                    {
+                        "description": "Period in YYYY-MM format",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/pb.AccountingPeriodRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/finance/reconciliation/create-payments": {
+            "post": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Creates student payments for unmatched or suspicious lines. With empty lines every unmatched line whose payer phone belongs to a single student is paid. studentId and groupId of a line override the recognised student. Each line reports its own result",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "reconciliation"
+                ],
+                "summary": "CEO , ADMIN , FINANCIST",
+                "parameters": [
+                    {
+                        "description": "Session and lines",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/pb.CreateMissingPaymentsRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/pb.CreateMissingPaymentsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/finance/reconciliation/import": {
+            "post": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Uploads a bank or provider statement and reconciles it with student payments. Lines are matched by provider transaction id, then by amount and date (3 days window) narrowed by payer phone. The response is the reconciliation session with MATCHED, UNMATCHED and SUSPICIOUS lines",
+                "consumes": [
+                    "multipart/form-data"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "reconciliation"
+                ],
+                "summary": "CEO , ADMIN , FINANCIST",
+                "parameters": [
+                    {
+                        "type": "file",
+                        "description": "Statement file, CSV or XLSX up to 3MB",
+                        "name": "file",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "CSV, XLSX, PAYME or CLICK",
+                        "name": "source",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Payment method of the statement: CASH, CLICK or PAYME. PAYME and CLICK sources default to their own method",
+                        "name": "method",
+                        "in": "formData"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/pb.ReconciliationSession"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/finance/reconciliation/resolve": {
+            "put": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Resolves a line by hand: MATCHED links it to paymentId, IGNORED removes it from the unmatched list",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "reconciliation"
+                ],
+                "summary": "CEO , ADMIN , FINANCIST",
+                "parameters": [
+                    {
+                        "description": "Line resolution",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/pb.ResolveReconciliationLineRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/finance/reconciliation/session/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Reconciliation session with its lines",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "reconciliation"
+                ],
+                "summary": "CEO , ADMIN , FINANCIST",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Session ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Only lines of status MATCHED, UNMATCHED, SUSPICIOUS, CREATED or IGNORED",
+                        "name": "status",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/pb.ReconciliationSession"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/finance/reconciliation/sessions/{page}/{size}": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Imported statements with matched, unmatched, suspicious and created line counts, newest first",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "reconciliation"
+                ],
+                "summary": "CEO , ADMIN , FINANCIST",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Page",
+                        "name": "page",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Size",
+                        "name": "size",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/pb.GetReconciliationSessionsResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/finance/report/pnl": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Monthly profit and loss statement. Revenue is the sum of take-offs, payments are student payments minus refunds, teacher cost comes from payroll runs or from attendance when the month has no run, expenses exclude payroll expenses. With groupBy the revenue, discounts and teacher cost are broken down per course, group or teacher. format=csv or format=xlsx downloads the statement as a file",
+                "produces": [
+                    "application/json",
+                    "text/csv",
+                    "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
+                ],
+                "tags": [
+                    "report"
+                ],
+                "summary": "CEO , FINANCIST",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "First month in YYYY-MM format, equals to by default",
+                        "name": "from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Last month in YYYY-MM format, current month by default",
+                        "name": "to",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Drill down: COURSE, GROUP or TEACHER",
+                        "name": "groupBy",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "json (default), csv or xlsx",
+                        "name": "format",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/pb.GetProfitAndLossResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/finance/salary/calculate/{from}/{to}": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Calculates the salary for a specified teacher within a given date range.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "salary"
+                ],
+                "summary": "CEO",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Start date for the salary calculation period in YYYY-MM-DD format",
+                        "name": "from",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "End date for the salary calculation period in YYYY-MM-DD format",
+                        "name": "to",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Teacher ID for whom the salary is calculated",
+                        "name": "teacherId",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Calculated salary information",
+                        "schema": {
+                            "$ref": "#/definitions/pb.CalculateTeacherSalaryResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict or calculation error",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/finance/salary/delete/{teacherID}": {
+            "delete": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Deletes a salary entry for a specific teacher by ID",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "salary"
+                ],
+                "summary": "CEO",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Teacher ID",
+                        "name": "teacherID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Salary rule ID, deletes all rules of the teacher when empty",
+                        "name": "id",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Salary deleted successfully",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict error",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/finance/salary/teacher-add": {
+            "post": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Adds a new salary entry for a specific teacher",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "salary"
+                ],
+                "summary": "CEO",
+                "parameters": [
+                    {
+                        "description": "Salary details",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/pb.CreateTeacherSalaryRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Salary added successfully",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request body",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict error",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/finance/salary/teacher-all": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Retrieves the salary information for all teachers",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "salary"
+                ],
+                "summary": "CEO",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/pb.GetTeachersSalaryRequest"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/finance/vendor/create": {
+            "post": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Creates a vendor that expenses can be linked to. Returns the vendor id in message",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "vendor"
+                ],
+                "summary": "ADMIN , CEO , FINANCIST",
+                "parameters": [
+                    {
+                        "description": "Vendor",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/pb.CreateVendorRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/finance/vendor/get-all": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Vendors with total approved spend, expense count and last expense date",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "vendor"
+                ],
+                "summary": "ADMIN , CEO , FINANCIST",
+                "parameters": [
+                    {
+                        "type": "boolean",
+                        "description": "Include inactive vendors",
+                        "name": "includeInactive",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/pb.GetVendorsResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/finance/vendor/spend-history/{vendorId}": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Monthly spend with a vendor. Single expenses are listed by /api/finance/expense/get-all-information with type=VENDOR",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "vendor"
+                ],
+                "summary": "CEO , FINANCIST",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Vendor ID",
+                        "name": "vendorId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Start date (YYYY-MM-DD)",
+                        "name": "from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "End date (YYYY-MM-DD)",
+                        "name": "to",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/pb.GetVendorSpendHistoryResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/finance/vendor/update": {
+            "put": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Updates vendor details, isActive=false hides the vendor from the default list",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "vendor"
+                ],
+                "summary": "ADMIN , CEO , FINANCIST",
+                "parameters": [
+                    {
+                        "description": "Vendor",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/pb.AbsVendor"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/get-chart-income": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Get information about a income",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "education"
+                ],
+                "summary": "CEO",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "from",
+                        "name": "from",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "to",
+                        "name": "to",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/pb.GetCommonInformationResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/get-table-groups": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Get common information about company",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "education"
+                ],
+                "summary": "ADMIN , CEO, TEACHER",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "dateType",
+                        "name": "dateType",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/group/billing-mode": {
+            "put": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Overrides the billing mode of the course for one group, an empty billingMode uses the course mode again",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "groups"
+                ],
+                "summary": "ADMIN , CEO , FINANCIST",
+                "parameters": [
+                    {
+                        "description": "Group billing mode",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/pb.UpdateGroupBillingModeRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/group/check-conflicts": {
+            "post": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Lists the groups whose room or teacher is already booked at the lesson time of a group being created or edited.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "groups"
+                ],
+                "summary": "ADMIN , CEO , FINANCIST",
+                "parameters": [
+                    {
+                        "description": "Group schedule, groupId is empty for a new group",
+                        "name": "group",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/pb.CheckScheduleConflictsRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/pb.ScheduleConflicts"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/group/conflict-overrides": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Lists groups and lesson transfers saved with force although the room or teacher was booked.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "groups"
+                ],
+                "summary": "ADMIN , CEO",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "From date (YYYY-MM-DD)",
+                        "name": "from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Till date (YYYY-MM-DD)",
+                        "name": "till",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/pb.GetScheduleConflictOverridesResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/group/create": {
+            "post": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Create a new group with provided details.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "groups"
+                ],
+                "summary": "ADMIN , CEO",
+                "parameters": [
+                    {
+                        "description": "Group Data",
+                        "name": "group",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/pb.CreateGroupRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Group successfully created",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Room or teacher already booked, send force to save anyway",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ScheduleConflictResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/group/delete/{id}": {
+            "delete": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Delete a group by its ID.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "groups"
+                ],
+                "summary": "ADMIN , CEO",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Group ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Group successfully deleted",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/group/get-all": {
+            "post": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Retrieve a list of all groups.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "groups"
+                ],
+                "summary": "ADMIN , CEO",
+                "parameters": [
+                    {
+                        "description": "Group Data",
+                        "name": "group",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/pb.GetGroupsRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "List of groups",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/pb.GetGroupsResponse"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/group/get-by-course/{courseId}": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Retrieve groups associated with a specific course ID.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "groups"
+                ],
+                "summary": "ADMIN, TEACHER",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Course ID",
+                        "name": "courseId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Group details",
+                        "schema": {
+                            "$ref": "#/definitions/pb.GetGroupsByCourseResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/group/get-by-id/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Retrieve details of a group by its ID.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "groups"
+                ],
+                "summary": "ADMIN",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Group ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Group details",
+                        "schema": {
+                            "$ref": "#/definitions/pb.GetGroupAbsResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/group/get-by-teacher/{teacherId}": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Get information about a specific teacher by their ID, with an option to filter archived data.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "groups"
+                ],
+                "summary": "ADMIN , TEACHER , CEO",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Teacher ID",
+                        "name": "teacherId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Whether to include archived information",
+                        "name": "isArchived",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/pb.GetGroupsByTeacherResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/group/left-after-trial/{from}/{to}": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Retrieve the data left after the trial period based on the provided from date, to date, page, and page size",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "education"
+                ],
+                "summary": "ADMIN , CEO",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Start date of the period",
+                        "name": "from",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "End date of the period",
+                        "name": "to",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "default": "1",
+                        "description": "Page number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "default": "10",
+                        "description": "Page size",
+                        "name": "page_size",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/pb.GetLeftAfterTrialPeriodResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    }
+                }
+            }
+        },
+        "/api/group/max-students": {
+            "put": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Limits the seats of the group below or above the capacity of its room, 0 uses the room capacity again. Seats freed by the change are offered to the group waitlist",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "groups"
+                ],
+                "summary": "ADMIN , CEO , FINANCIST",
+                "parameters": [
+                    {
+                        "description": "Group seats",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/pb.UpdateGroupMaxStudentsRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/group/transfer-date": {
+            "post": {
+                "description": "Transfers the lesson date for a course",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "lesson"
+                ],
+                "summary": "ADMIN",
+                "parameters": [
+                    {
+                        "description": "Transfer Lesson Request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/pb.TransferLessonRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Status and message",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Room or teacher already booked on the new date, send force to move anyway",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ScheduleConflictResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/group/update": {
+            "put": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Update details of an existing group.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "groups"
+                ],
+                "summary": "ADMIN , CEO",
+                "parameters": [
+                    {
+                        "description": "Group Data",
+                        "name": "group",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/pb.GetUpdateGroupAbs"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Group successfully updated",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Room or teacher already booked, send force to save anyway",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ScheduleConflictResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/history/group/{groupId}": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Get the history of a specific group by its ID",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "groups"
+                ],
+                "summary": "ADMIN",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Group ID",
+                        "name": "groupId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/pb.GetHistoryGroupResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/history/student/{studentId}": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Get the history of a specific student by their ID",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "students"
+                ],
+                "summary": "ADMIN",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Student ID",
+                        "name": "studentId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/pb.GetHistoryStudentResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/holiday/create": {
+            "post": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Adds a holiday or closure on which groups have no lessons, lessons are not billed and attendance can not be marked. scope is COMPANY, ROOM (with roomId) or GROUP (with groupId). recurring holidays repeat every year. Returns the holiday id in message",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "holidays"
+                ],
+                "summary": "ADMIN , CEO",
+                "parameters": [
+                    {
+                        "description": "Holiday",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/pb.AbsHoliday"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/holiday/delete/{id}": {
+            "delete": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Deletes a company holiday, national holidays can only be switched off",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "holidays"
+                ],
+                "summary": "ADMIN , CEO",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Holiday ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/holiday/get-all": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Holidays of the company overlapping from-till (yyyy-MM-dd), recurring holidays are always listed, all holidays when the period is empty",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "holidays"
+                ],
+                "summary": "ADMIN , CEO , FINANCIST , TEACHER",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "From date",
+                        "name": "from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Till date",
+                        "name": "till",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/pb.GetHolidaysResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/holiday/group/{groupId}": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Days between from and till (yyyy-MM-dd) on which the group has no lessons because of a company, room or group holiday",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "holidays"
+                ],
+                "summary": "ADMIN , CEO , FINANCIST , TEACHER",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Group ID",
+                        "name": "groupId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "From date",
+                        "name": "from",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Till date",
+                        "name": "till",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/pb.GetGroupHolidaysResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/holiday/update": {
+            "put": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Edits a holiday. Preloaded national holidays only take isActive, switch one off when the centre works that day",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "holidays"
+                ],
+                "summary": "ADMIN , CEO",
+                "parameters": [
+                    {
+                        "description": "Holiday",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/pb.AbsHoliday"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/image/get-image": {
+            "get": {
+                "description": "Retrieve an uploaded image by filename",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "image/jpeg",
+                    "image/png",
+                    "image/gif"
+                ],
+                "tags": [
+                    "image"
+                ],
+                "summary": "Get an uploaded image",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Filename of the image to retrieve",
+                        "name": "filename",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Image file"
+                    },
+                    "400": {
+                        "description": "Bad request, filename missing",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Image not found",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/image/upload": {
+            "post": {
+                "description": "Upload an image file and save it to the server",
+                "consumes": [
+                    "multipart/form-data"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "image"
+                ],
+                "summary": "Upload an image",
+                "parameters": [
+                    {
+                        "type": "file",
+                        "description": "Image file to upload",
+                        "name": "image",
+                        "in": "formData",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Success response with file URL",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request or file error",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/lead/create": {
+            "post": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Create a new lead with the given title.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "leads"
+                ],
+                "summary": "ADMIN",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Title of the lead",
+                        "name": "title",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Lead successfully created",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Lead creation conflict",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/lead/delete/{id}": {
+            "delete": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Delete a lead by ID.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "leads"
+                ],
+                "summary": "ADMIN",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Lead ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Lead deleted successfully",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict occurred",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/lead/get-all/{companyId}": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Update the data associated with a lead",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "leads"
+                ],
+                "summary": "ALL",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/pb.GetLeadListResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/lead/get-lead-common": {
+            "post": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Get a common lead by ID and type.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "leads"
+                ],
+                "summary": "ADMIN",
+                "parameters": [
+                    {
+                        "description": "Lead ID",
+                        "name": "req",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/pb.GetLeadCommonRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Lead details retrieved",
+                        "schema": {
+                            "$ref": "#/definitions/pb.GetLeadCommonResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/lead/get-lead-reports": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Retrieves lead reports for a given date range.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "leads"
+                ],
+                "summary": "CEO , ADMIN",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Start date in YYYY-MM-DD format",
+                        "name": "from",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "End date in YYYY-MM-DD format",
+                        "name": "till",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Lead reports response",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict error with details",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/lead/update/{id}": {
+            "put": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Update an existing lead by ID.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "leads"
+                ],
+                "summary": "ADMIN",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Lead ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Title of the lead",
+                        "name": "title",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Lead updated successfully",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict occurred",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/leadData/change-lead-data": {
+            "patch": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Update the data associated with a lead",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "leadData"
+                ],
+                "summary": "Change lead data",
+                "parameters": [
+                    {
+                        "description": "Lead change request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/pb.ChangeLeadPlaceRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/leadData/create": {
+            "post": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Create lead data.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "leadData"
+                ],
+                "summary": "ADMIN",
+                "parameters": [
+                    {
+                        "description": "Lead data",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/pb.CreateLeadDataRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Lead data created successfully",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict occurred",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/leadData/delete/{id}": {
+            "delete": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Delete lead data by ID.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "leadData"
+                ],
+                "summary": "ADMIN",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Lead data ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Lead data deleted successfully",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict occurred",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/leadData/update": {
+            "put": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Update lead data by ID.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "leadData"
+                ],
+                "summary": "ADMIN",
+                "parameters": [
+                    {
+                        "description": "Lead data",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/pb.UpdateLeadDataRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Lead data updated successfully",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict occurred",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/lesson/get-all/{groupId}": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Lessons of a group between from and till (yyyy-MM-dd, at most 93 days) with topic, homework, materials and the teacher who teaches them",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "lessons"
+                ],
+                "summary": "ADMIN , CEO , FINANCIST , TEACHER",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Group ID",
+                        "name": "groupId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "From date",
+                        "name": "from",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Till date",
+                        "name": "till",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/pb.GetLessonsResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/lesson/get-by-id/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Get a lesson by its ID",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "lessons"
+                ],
+                "summary": "ADMIN , CEO , FINANCIST , TEACHER",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Lesson ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/pb.AbsLesson"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/lesson/update": {
+            "put": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Saves topic, notes, homework and materials of a lesson. Admins can set a substitute teacher who is paid for the lesson instead of the teacher of the group, an empty substituteTeacherId gives it back.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "lessons"
+                ],
+                "summary": "ADMIN , CEO , TEACHER",
+                "parameters": [
+                    {
+                        "description": "Lesson",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/pb.UpdateLessonRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/pb.AbsLesson"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/notification/get-all": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "In app notifications of the user, newest first, with the count of the unread ones",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "notification"
+                ],
+                "summary": "ADMIN , CEO , FINANCIST , TEACHER",
+                "parameters": [
+                    {
+                        "type": "boolean",
+                        "description": "Only unread notifications",
+                        "name": "unreadOnly",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "50 by default",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/pb.GetNotificationsResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/notification/read": {
+            "put": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Marks the notifications read for the user, every notification when ids is empty",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "notification"
+                ],
+                "summary": "ADMIN , CEO , FINANCIST , TEACHER",
+                "parameters": [
+                    {
+                        "description": "Notification ids",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/pb.MarkNotificationsReadRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/room/create": {
+            "post": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Create a new room based on the provided request data",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "rooms"
+                ],
+                "summary": "ADMIN , CEO",
+                "parameters": [
+                    {
+                        "description": "Request to create a room",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/pb.CreateRoomRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/room/delete/{id}": {
+            "delete": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Delete a room by its ID",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "rooms"
+                ],
+                "summary": "ADMIN , CEO",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Room ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/room/get-all": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Retrieve all rooms",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "rooms"
+                ],
+                "summary": "ADMIN , CEO",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/pb.GetUpdateRoomAbs"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/room/update": {
+            "put": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Update the details of an existing room based on the provided request data",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "rooms"
+                ],
+                "summary": "ADMIN , CEO",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "name": "capacity",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "name": "id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "name": "name",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/set/change-to-group": {
+            "patch": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Change the lead set to a group based on the provided request data",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "sets"
+                ],
+                "summary": "ADMIN",
+                "parameters": [
+                    {
+                        "description": "Request to change set to group",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/pb.ChangeToSetRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Room or teacher already booked, send force to create the group anyway",
+                        "schema": {
+                            "$ref": "#/definitions/utils.ScheduleConflictResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/set/create": {
+            "post": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Create a new set.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "sets"
+                ],
+                "summary": "ADMIN",
+                "parameters": [
+                    {
+                        "description": "Set data",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/pb.CreateSetRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Set created successfully",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict occurred",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/set/delete/{id}": {
+            "delete": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Delete a set by ID.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "sets"
+                ],
+                "summary": "ADMIN",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Set ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Set deleted successfully",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict occurred",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/set/get-by-id/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Retrieves lead details based on the provided ID.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "sets"
+                ],
+                "summary": "ADMIN , CEO",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Lead ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Successful response with lead details",
+                        "schema": {
+                            "$ref": "#/definitions/pb.SetDataResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Lead not found",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict error with details",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/set/update": {
+            "put": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Update an existing set by ID.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "sets"
+                ],
+                "summary": "ADMIN",
+                "parameters": [
+                    {
+                        "description": "Set data",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/pb.UpdateSetRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Set updated successfully",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict occurred",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/student-auth/me": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "The student of the session token",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "check-in"
+                ],
+                "summary": "STUDENT",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/pb.StudentSession"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/student-auth/send-code": {
+            "post": {
+                "description": "Sends a one time login code by sms to the phone of an active student of the company",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "check-in"
+                ],
+                "summary": "STUDENT",
+                "parameters": [
+                    {
+                        "description": "Company subdomain and phone",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/pb.SendStudentLoginCodeRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/student-auth/verify": {
+            "post": {
+                "description": "Checks the sms code and returns a session token for every student of the phone",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "check-in"
+                ],
+                "summary": "STUDENT",
+                "parameters": [
+                    {
+                        "description": "Company subdomain, phone and code",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/pb.VerifyStudentLoginCodeRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/pb.VerifyStudentLoginCodeResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/student/add-to-group": {
+            "post": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Enrolls students into the group while it has free seats (the group maximum, or the capacity of its room), the rest are put on the group waitlist. Sibling and multi-course discount rules are applied automatically, promoCode applies a promo code to every enrolled student. Every student gets a result: ADDED, WAITLISTED with its place, or FAILED with the reason, discounts that could not be applied are listed in the message of an ADDED student",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "students"
+                ],
+                "summary": "ADMIN",
+                "parameters": [
+                    {
+                        "description": "Add student to group details",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/pb.AddToGroupRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Result of every student",
+                        "schema": {
+                            "$ref": "#/definitions/pb.AddToGroupResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/student/change-condition": {
+            "put": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Changes the condition of a student based on provided details",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "students"
+                ],
+                "summary": "ADMIN, CEO",
+                "parameters": [
+                    {
+                        "description": "Change Condition Student Request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/pb.ChangeConditionStudentRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Status and message",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/student/create": {
+            "post": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "students"
+                ],
+                "summary": "ADMIN",
+                "parameters": [
+                    {
+                        "description": "Student details",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/pb.CreateStudentRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Created student details",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/student/delete/{id}": {
+            "delete": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "students"
+                ],
+                "summary": "ADMIN",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Student ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Flag to determine if money should be returned",
+                        "name": "returnMoney",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Delete success message",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/student/get-all": {
+            "post": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "students"
+                ],
+                "summary": "ADMIN",
+                "parameters": [
+                    {
+                        "description": "filter students",
+                        "name": "filter",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/pb.GetAllStudentRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "List of students",
+                        "schema": {
+                            "$ref": "#/definitions/pb.GetAllStudentResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid condition",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/student/get-student-by-id/{studentId}": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "students"
+                ],
+                "summary": "ADMIN",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Student ID",
+                        "name": "studentId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "List of students",
+                        "schema": {
+                            "$ref": "#/definitions/pb.GetAllStudentResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid condition",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/student/lesson-package/sell": {
+            "post": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Sells a prepaid lesson package of a PACKAGE billed group to a student, the price is taken off the student balance at once. lessons and price default to the course package. Returns the package id in message",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "students"
+                ],
+                "summary": "ADMIN , CEO , FINANCIST",
+                "parameters": [
+                    {
+                        "description": "Lesson package",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/pb.SellLessonPackageRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/student/lesson-package/{studentId}": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Lesson packages of a student with the lessons left, newest first",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "students"
+                ],
+                "summary": "ADMIN , CEO , FINANCIST",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Student ID",
+                        "name": "studentId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Group ID",
+                        "name": "groupId",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/pb.GetLessonPackagesResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/student/note/create": {
+            "post": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Create a new note associated with a specific student",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "notes"
+                ],
+                "summary": "ADMIN",
+                "parameters": [
+                    {
+                        "description": "Note details",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/pb.CreateNoteRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/student/note/delete/{noteId}": {
+            "delete": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Delete a specific note associated with a student",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "notes"
+                ],
+                "summary": "ADMIN",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Note ID",
+                        "name": "noteId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/student/note/get-notes/{studentId}": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Get all notes associated with a specific student",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "notes"
+                ],
+                "summary": "ADMIN",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Student ID",
+                        "name": "studentId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/pb.GetNotesByStudent"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/student/search-student/{value}": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Search for students by phone number or name",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "students"
+                ],
+                "summary": "ADMIN",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Search value (phone number or name)",
+                        "name": "value",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/pb.SearchStudentResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/student/update": {
+            "put": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "students"
+                ],
+                "summary": "ADMIN",
+                "parameters": [
+                    {
+                        "description": "Updated student details",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/pb.UpdateStudentRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Update success message",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/timetable/feed/create": {
+            "post": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Creates a tokenised .ics feed url of a teacher or room to subscribe to in a calendar app. Teachers can only create a feed of their own lessons.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "timetable"
+                ],
+                "summary": "ADMIN , CEO , TEACHER",
+                "parameters": [
+                    {
+                        "description": "ownerType is TEACHER or ROOM",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/pb.CreateCalendarFeedRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/pb.CalendarFeed"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/timetable/feed/get-all": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Active calendar feeds, optionally of one teacher or room. Teachers only see their own feeds.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "timetable"
+                ],
+                "summary": "ADMIN , CEO , TEACHER",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "TEACHER or ROOM",
+                        "name": "ownerType",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Teacher or room ID",
+                        "name": "ownerId",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/pb.GetCalendarFeedsResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/timetable/feed/ics/{file}": {
+            "get": {
+                "description": "iCalendar feed of a teacher or room, the token in the url is the only credential so calendar apps can subscribe without logging in",
+                "produces": [
+                    "text/calendar"
+                ],
+                "tags": [
+                    "timetable"
+                ],
+                "summary": "PUBLIC",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Feed token followed by .ics",
+                        "name": "file",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "iCalendar document",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/timetable/feed/revoke/{id}": {
+            "delete": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Revokes a calendar feed, its url stops working",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "timetable"
+                ],
+                "summary": "ADMIN , CEO",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Feed ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/timetable/get": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Lessons of the groups between from and till (yyyy-MM-dd, at most 93 days) with holidays left out and transferred lessons on their new date. Teachers only see their own lessons.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "timetable"
+                ],
+                "summary": "ADMIN , CEO , FINANCIST , TEACHER",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "From date",
+                        "name": "from",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Till date",
+                        "name": "till",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Room ID",
+                        "name": "roomId",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Teacher ID",
+                        "name": "teacherId",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Course ID",
+                        "name": "courseId",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Group ID",
+                        "name": "groupId",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/pb.GetTimetableResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/user/create": {
+            "post": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Create a new user",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "user"
+                ],
+                "summary": "CEO",
+                "parameters": [
+                    {
+                        "description": "User data",
+                        "name": "user",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/pb.CreateUserRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Successfully created user",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/user/delete/{userId}": {
+            "delete": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Delete a user from the system using their ID",
+                "tags": [
+                    "user"
+                ],
+                "summary": "ADMIN , CEO",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "User ID",
+                        "name": "userId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/user/get-all-employee/{isArchived}": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Retrieves a list of all employees based on archive status. Restricted to ADMIN and CEO roles.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "user"
+                ],
+                "summary": "ADMIN , CEO",
+                "parameters": [
+                    {
+                        "type": "boolean",
+                        "description": "Filter by archive status (true=archived, false=active)",
+                        "name": "isArchived",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/pb.GetAllEmployeeResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid input or processing error",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized access",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    },
+                    "408": {
+                        "description": "Request timeout",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/user/get-all-staff/{isArchived}": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Retrieve a list of all staff members, filtered by their archived status.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "user"
+                ],
+                "summary": "ADMIN , CEO",
+                "parameters": [
+                    {
+                        "type": "boolean",
+                        "description": "Boolean to filter archived or active staff; true for archived, false for active",
+                        "name": "isArchived",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "List of staff members based on archived status",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/pb.GetAllStuffResponse"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - Invalid 'isArchived' parameter",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error during data retrieval",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/user/get-my-profile": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Retrieve the information of the authenticated user. Admin and CEO roles receive basic user info, while Teachers get additional group information.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "user"
+                ],
+                "summary": "ADMIN , CEO , TEACHER",
+                "responses": {
+                    "200": {
+                        "description": "User information with optional groups for Teachers",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized - Invalid or missing authentication",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error while retrieving teacher's group information",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/user/get-teachers/{isDeleted}": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Fetches a list of teachers based on the deletion status",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "user"
+                ],
+                "summary": "ADMIN,CEO",
+                "parameters": [
+                    {
+                        "type": "boolean",
+                        "description": "Deletion status (true/false)",
+                        "name": "isDeleted",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "List of teachers",
+                        "schema": {
+                            "$ref": "#/definitions/pb.GetTeachersResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/user/get-user/{userId}": {
+            "get": {
+                "description": "Retrieve user details by their unique user ID.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "user"
+                ],
+                "summary": "CEO , ADMIN , TEACHER",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "User ID",
+                        "name": "userId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/pb.GetUserByIdResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/user/history/{userId}": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Fetches the history data for a given user ID.",
+                "tags": [
+                    "user"
+                ],
+                "summary": "ADMIN, CEO, TEACHER",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "User ID",
+                        "name": "userId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/pb.GetHistoryByUserIdResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/user/login": {
+            "post": {
+                "description": "Authenticate a user and return a token upon successful login.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "user"
+                ],
+                "summary": "ALL",
+                "parameters": [
+                    {
+                        "description": "Login credentials",
+                        "name": "LoginRequest",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/pb.LoginRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Successful login",
+                        "schema": {
+                            "$ref": "#/definitions/pb.LoginResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - Invalid JSON or login failure",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/user/update": {
+            "patch": {
+                "description": "Update user details using their ID",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "user"
+                ],
+                "summary": "ADMIN , CEO , TEACHER",
+                "parameters": [
+                    {
+                        "description": "User Details",
+                        "name": "user",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/pb.UpdateUserRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/user/update-password/{userId}/{password}": {
+            "put": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Updates the password of a user specified by the userId.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "user"
+                ],
+                "summary": "CEO",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "User ID",
+                        "name": "userId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "New Password",
+                        "name": "password",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Password updated successfully",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/waitlist/accept": {
+            "post": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Enrolls an OFFERED student into the seat held for it, the offer must not be expired. createdDate is today when empty",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "waitlist"
+                ],
+                "summary": "ADMIN , CEO , FINANCIST",
+                "parameters": [
+                    {
+                        "description": "Offer to accept",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/pb.AcceptWaitlistOfferRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/pb.AddToGroupResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/waitlist/cancel/{id}": {
+            "delete": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Takes the student off the waitlist, a seat it was offered goes to the next student",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "waitlist"
+                ],
+                "summary": "ADMIN , CEO , FINANCIST",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Waitlist entry ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/waitlist/group/{groupId}": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Seats of the group and its waitlist, OFFERED students first and then the WAITING ones in order",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "waitlist"
+                ],
+                "summary": "ADMIN , CEO , FINANCIST",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Group ID",
+                        "name": "groupId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "List ENROLLED, EXPIRED and CANCELLED entries as well",
+                        "name": "withHistory",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/pb.GetGroupWaitlistResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/waitlist/move": {
+            "put": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Moves a WAITING student to another place of the group waitlist",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "waitlist"
+                ],
+                "summary": "ADMIN , CEO , FINANCIST",
+                "parameters": [
+                    {
+                        "description": "New place, starting from 1",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/pb.MoveWaitlistEntryRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/utils.AbsResponse"
+                        }
+                    }
+                }
+            }
+        }
+    },
+    "definitions": {
+        "pb.AbsAccountingPeriod": {
+            "type": "object",
+            "properties": {
+                "closedAt": {
+                    "type": "string"
+                },
+                "closedById": {
+                    "type": "string"
+                },
+                "closedByName": {
+                    "type": "string"
+                },
+                "isClosed": {
+                    "type": "boolean"
+                },
+                "period": {
+                    "type": "string"
+                }
+            }
+        },
+        "pb.AbsAgedDebtor": {
+            "type": "object",
+            "properties": {
+                "assignedToId": {
+                    "type": "string"
+                },
+                "assignedToName": {
+                    "type": "string"
+                },
+                "balance": {
+                    "type": "string"
+                },
+                "bucket": {
+                    "type": "string"
+                },
+                "daysOverdue": {
+                    "type": "integer"
+                },
+                "debtSince": {
+                    "type": "string"
+                },
+                "freezeFlagged": {
+                    "type": "boolean"
+                },
+                "lastContactAt": {
+                    "type": "string"
+                },
+                "lastReminderBucket": {
+                    "type": "string"
+                },
+                "phoneNumber": {
+                    "type": "string"
+                },
+                "promisedAmount": {
+                    "type": "string"
+                },
+                "promisedDate": {
+                    "type": "string"
+                },
+                "studentId": {
+                    "type": "string"
+                },
+                "studentName": {
+                    "type": "string"
+                }
+            }
+        },
+        "pb.AbsAttendanceStatus": {
+            "type": "object",
+            "properties": {
+                "absenceSms": {
+                    "description": "sends the NOT_PARTICIPATE_ALERT sms",
+                    "type": "boolean"
+                },
+                "billable": {
+                    "description": "charges the lesson price or a package credit in groups not billed monthly",
+                    "type": "boolean"
+                },
+                "code": {
+                    "description": "the value marked as status of an attendance, assigned when the status is created",
+                    "type": "integer"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "isActive": {
+                    "type": "boolean"
+                },
+                "isDefault": {
+                    "description": "preloaded status, it can be changed or switched off but not deleted",
+                    "type": "boolean"
+                },
+                "isMakeup": {
+                    "description": "attending a lesson to make up an absence, the mark names the absence",
+                    "type": "boolean"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "paysTeacher": {
+                    "description": "counts the student in the salary of the teacher of the lesson",
+                    "type": "boolean"
+                }
+            }
+        },
+        "pb.AbsBudgetAlert": {
+            "type": "object",
+            "properties": {
+                "actual": {
+                    "type": "number"
+                },
+                "budget": {
+                    "type": "number"
+                },
+                "categoryId": {
+                    "type": "string"
+                },
+                "categoryName": {
+                    "type": "string"
+                },
+                "createdAt": {
+                    "type": "string"
+                },
+                "period": {
+                    "type": "string"
+                },
+                "threshold": {
+                    "type": "integer"
+                }
+            }
+        },
+        "pb.AbsCalculateSalary": {
+            "type": "object",
+            "properties": {
+                "commonLessonCountInPeriod": {
+                    "type": "integer"
+                },
+                "groupId": {
+                    "type": "string"
+                },
+                "groupName": {
+                    "type": "string"
+                },
+                "salaries": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/pb.StudentSalary"
+                    }
+                }
+            }
+        },
+        "pb.AbsCategory": {
+            "type": "object",
+            "properties": {
+                "desc": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "monthlyBudget": {
+                    "type": "number"
+                },
+                "name": {
+                    "type": "string"
+                }
+            }
+        },
+        "pb.AbsChargeItem": {
+            "type": "object",
+            "properties": {
+                "comment": {
+                    "type": "string"
+                },
+                "createdAt": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "isActive": {
+                    "type": "boolean"
+                },
+                "isPhysical": {
+                    "type": "boolean"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "price": {
+                    "type": "number"
+                },
+                "stock": {
+                    "type": "integer"
+                }
+            }
+        },
+        "pb.AbsCollectionActivity": {
+            "type": "object",
+            "properties": {
+                "comment": {
+                    "type": "string"
+                },
+                "createdAt": {
+                    "type": "string"
+                },
+                "createdById": {
+                    "type": "string"
+                },
+                "createdByName": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "promisedAmount": {
+                    "type": "string"
+                },
+                "promisedDate": {
+                    "type": "string"
+                },
+                "studentId": {
+                    "type": "string"
+                },
+                "type": {
+                    "type": "string"
+                }
+            }
+        },
+        "pb.AbsCourse": {
+            "type": "object",
+            "properties": {
+                "courseDuration": {
+                    "type": "integer"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "lessonDuration": {
+                    "type": "integer"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "price": {
+                    "type": "number"
+                }
+            }
+        },
+        "pb.AbsDebtsInformation": {
+            "type": "object",
+            "properties": {
+                "balance": {
+                    "type": "string"
+                },
+                "comments": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/pb.DebtorComment"
+                    }
+                },
+                "debtorId": {
+                    "type": "string"
+                },
+                "debtorName": {
+                    "type": "string"
+                },
+                "groups": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/pb.DebtorGroup"
+                    }
+                },
+                "phoneNumber": {
+                    "type": "string"
+                },
+                "totalOnPeriod": {
+                    "type": "string"
+                }
+            }
+        },
+        "pb.AbsDiscountRequest": {
+            "type": "object",
+            "properties": {
+                "comment": {
+                    "type": "string"
+                },
+                "discountPrice": {
+                    "type": "string"
+                },
+                "endDate": {
+                    "type": "string"
+                },
+                "groupId": {
+                    "type": "string"
+                },
+                "startDate": {
+                    "type": "string"
+                },
+                "studentId": {
+                    "type": "string"
+                },
+                "withTeacher": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "pb.AbsDiscountRule": {
+            "type": "object",
+            "properties": {
+                "courseId": {
+                    "description": "empty applies to every course",
+                    "type": "string"
+                },
+                "createdAt": {
+                    "type": "string"
+                },
+                "durationMonths": {
+                    "description": "0 keeps the discount until the group end date",
+                    "type": "integer"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "isActive": {
+                    "type": "boolean"
+                },
+                "minCourses": {
+                    "description": "MULTI_COURSE applies from this many courses, 2 by default",
+                    "type": "integer"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "promoCode": {
+                    "type": "string"
+                },
+                "ruleType": {
+                    "description": "TEMPLATE, SIBLING, MULTI_COURSE or PROMO_CODE",
+                    "type": "string"
+                },
+                "usageLimit": {
+                    "description": "0 means unlimited",
+                    "type": "integer"
+                },
+                "usedCount": {
+                    "type": "integer"
+                },
+                "validFrom": {
+                    "type": "string"
+                },
+                "validUntil": {
+                    "type": "string"
+                },
+                "value": {
+                    "type": "number"
+                },
+                "valueType": {
+                    "description": "PERCENT or FIXED",
+                    "type": "string"
+                },
+                "withTeacher": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "pb.AbsGetAllPaymentsByMonthResponse": {
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "type": "string"
+                },
+                "comment": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "created_by_id": {
+                    "type": "string"
+                },
+                "created_by_name": {
+                    "type": "string"
+                },
+                "givenDate": {
+                    "type": "string"
+                },
+                "groupId": {
+                    "type": "string"
+                },
+                "groupName": {
+                    "type": "string"
+                },
+                "method": {
+                    "type": "string"
+                },
+                "paymentId": {
+                    "type": "string"
+                },
+                "payment_type": {
+                    "type": "string"
+                }
+            }
+        },
+        "pb.AbsGetHistoryByUserIdResponse": {
+            "type": "object",
+            "properties": {
+                "createdAt": {
+                    "type": "string"
+                },
+                "currentValue": {
+                    "type": "string"
+                },
+                "oldValue": {
+                    "type": "string"
+                },
+                "updatedField": {
+                    "type": "string"
+                }
+            }
+        },
+        "pb.AbsGetLeftAfter": {
+            "type": "object",
+            "properties": {
+                "createdAt": {
+                    "type": "string"
+                },
+                "groupId": {
+                    "type": "string"
+                },
+                "groupName": {
+                    "type": "string"
+                },
+                "returnMoney": {
+                    "type": "boolean"
+                },
+                "specificDate": {
+                    "type": "string"
+                },
+                "studentBalance": {
+                    "type": "string"
+                },
+                "studentId": {
+                    "type": "string"
+                },
+                "studentName": {
+                    "type": "string"
+                },
+                "studentPhone": {
+                    "type": "string"
+                }
+            }
+        },
+        "pb.AbsGetMonthlyStatusResponse": {
+            "type": "object",
+            "properties": {
+                "balance": {
+                    "type": "string"
+                },
+                "month": {
+                    "type": "string"
+                }
+            }
+        },
+        "pb.AbsGetTeachersSalary": {
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "type": "integer"
+                },
+                "courseId": {
+                    "type": "string"
+                },
+                "effectiveFrom": {
+                    "type": "string"
+                },
+                "groupId": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "teacherId": {
+                    "type": "string"
+                },
+                "teacherName": {
+                    "type": "string"
+                },
+                "type": {
+                    "type": "string"
+                }
+            }
+        },
+        "pb.AbsGroup": {
+            "type": "object",
+            "properties": {
+                "course": {
+                    "$ref": "#/definitions/pb.AbsCourse"
+                },
+                "currentGroupStatus": {
+                    "type": "string"
+                },
+                "dateType": {
+                    "type": "string"
+                },
+                "groupEndDate": {
+                    "type": "string"
+                },
+                "groupStartDate": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "isArchived": {
+                    "type": "boolean"
+                },
+                "lessonStartTime": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "teacherName": {
+                    "type": "string"
+                }
+            }
+        },
+        "pb.AbsHistory": {
+            "type": "object",
+            "properties": {
+                "currentValue": {
+                    "type": "string"
+                },
+                "editedField": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "oldValue": {
+                    "type": "string"
+                }
+            }
+        },
+        "pb.AbsHistoryDiscount": {
+            "type": "object",
+            "properties": {
+                "action": {
+                    "type": "string"
+                },
+                "comment": {
+                    "type": "string"
+                },
+                "createdAt": {
+                    "type": "string"
+                },
+                "discountPrice": {
+                    "type": "string"
+                },
+                "endDate": {
+                    "type": "string"
+                },
+                "groupId": {
+                    "type": "string"
+                },
+                "groupName": {
+                    "type": "string"
+                },
+                "ruleId": {
+                    "type": "string"
+                },
+                "ruleName": {
+                    "type": "string"
+                },
+                "startDate": {
+                    "type": "string"
+                },
+                "studentId": {
+                    "type": "string"
+                },
+                "studentName": {
+                    "type": "string"
+                },
+                "withTeacher": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "pb.AbsHoliday": {
+            "type": "object",
+            "properties": {
+                "fromDate": {
+                    "description": "yyyy-MM-dd, a one day holiday has equal dates",
+                    "type": "string"
+                },
+                "groupId": {
+                    "type": "integer"
+                },
+                "groupName": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "isActive": {
+                    "type": "boolean"
+                },
+                "isNational": {
+                    "description": "preloaded public holiday, it can be switched off but not deleted",
+                    "type": "boolean"
+                },
+                "recurring": {
+                    "description": "repeats every year on the same days",
+                    "type": "boolean"
+                },
+                "roomId": {
+                    "type": "integer"
+                },
+                "roomName": {
+                    "type": "string"
+                },
+                "scope": {
+                    "description": "COMPANY closes every group, ROOM the groups of roomId, GROUP only groupId",
+                    "type": "string"
+                },
+                "tillDate": {
+                    "type": "string"
+                },
+                "title": {
+                    "type": "string"
+                }
+            }
+        },
+        "pb.AbsInstallment": {
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "type": "string"
+                },
+                "discountAmount": {
+                    "type": "string"
+                },
+                "dueDate": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "isCharged": {
+                    "type": "boolean"
+                },
+                "isLateFeeApplied": {
+                    "type": "boolean"
+                },
+                "paidAmount": {
+                    "type": "string"
+                },
+                "paidAt": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string"
+                }
+            }
+        },
+        "pb.AbsInstallmentPlan": {
+            "type": "object",
+            "properties": {
+                "comment": {
+                    "type": "string"
+                },
+                "createdAt": {
+                    "type": "string"
+                },
+                "createdByName": {
+                    "type": "string"
+                },
+                "earlyDays": {
+                    "type": "integer"
+                },
+                "earlyDiscountPercent": {
+                    "type": "number"
+                },
+                "graceDays": {
+                    "type": "integer"
+                },
+                "groupId": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "installments": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/pb.AbsInstallment"
+                    }
+                },
+                "lateFeeAmount": {
+                    "type": "string"
+                },
+                "paidAmount": {
+                    "type": "string"
+                },
+                "planType": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string"
+                },
+                "studentId": {
+                    "type": "string"
+                },
+                "totalAmount": {
+                    "type": "string"
+                }
+            }
+        },
+        "pb.AbsLesson": {
+            "type": "object",
+            "properties": {
+                "attendanceCount": {
+                    "type": "integer"
+                },
+                "date": {
+                    "description": "yyyy-MM-dd, realDate is the date the lesson was moved from when it was transferred",
+                    "type": "string"
+                },
+                "groupId": {
+                    "type": "integer"
+                },
+                "groupName": {
+                    "type": "string"
+                },
+                "homework": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "materials": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "notes": {
+                    "type": "string"
+                },
+                "realDate": {
+                    "type": "string"
+                },
+                "substitute": {
+                    "type": "boolean"
+                },
+                "teacherId": {
+                    "description": "teacherId is who teaches the lesson, substitute is set when it is not the teacher of the group",
+                    "type": "string"
+                },
+                "teacherName": {
+                    "type": "string"
+                },
+                "topic": {
+                    "type": "string"
+                },
+                "updatedAt": {
+                    "type": "string"
+                }
+            }
+        },
+        "pb.AbsLessonPackage": {
+            "type": "object",
+            "properties": {
+                "createdByName": {
+                    "type": "string"
+                },
+                "expiresAt": {
+                    "type": "string"
+                },
+                "groupId": {
+                    "type": "string"
+                },
+                "groupName": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "lessonsLeft": {
+                    "type": "integer"
+                },
+                "lessonsTotal": {
+                    "type": "integer"
+                },
+                "price": {
+                    "type": "number"
+                },
+                "purchasedAt": {
+                    "type": "string"
+                },
+                "status": {
+                    "description": "ACTIVE, USED or EXPIRED",
+                    "type": "string"
+                },
+                "studentId": {
+                    "type": "string"
+                }
+            }
+        },
+        "pb.AbsNote": {
+            "type": "object",
+            "properties": {
+                "comment": {
+                    "type": "string"
+                },
+                "createdAt": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                }
+            }
+        },
+        "pb.AbsOverdueInstallment": {
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "type": "string"
+                },
+                "bucket": {
+                    "type": "string"
+                },
+                "daysOverdue": {
+                    "type": "integer"
+                },
+                "dueDate": {
+                    "type": "string"
+                },
+                "groupId": {
+                    "type": "string"
+                },
+                "installmentId": {
+                    "type": "string"
+                },
+                "isLateFeeApplied": {
+                    "type": "boolean"
+                },
+                "phoneNumber": {
+                    "type": "string"
+                },
+                "planId": {
+                    "type": "string"
+                },
+                "remainingAmount": {
+                    "type": "string"
+                },
+                "studentId": {
+                    "type": "string"
+                },
+                "studentName": {
+                    "type": "string"
+                }
+            }
+        },
+        "pb.AbsPaymentTakeOff": {
+            "type": "object",
+            "properties": {
+                "comment": {
+                    "type": "string"
+                },
+                "creatorId": {
+                    "type": "string"
+                },
+                "creatorName": {
+                    "type": "string"
+                },
+                "givenDate": {
+                    "type": "string"
+                },
+                "paymentId": {
+                    "type": "string"
+                },
+                "studentId": {
+                    "type": "string"
+                },
+                "studentName": {
+                    "type": "string"
+                },
+                "sum": {
+                    "type": "string"
+                }
+            }
+        },
+        "pb.AbsPayrollAdjustment": {
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "type": "string"
+                },
+                "comment": {
+                    "type": "string"
+                },
+                "createdAt": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "type": {
+                    "type": "string"
+                }
+            }
+        },
+        "pb.AbsPayrollItem": {
+            "type": "object",
+            "properties": {
+                "advanceAmount": {
+                    "type": "string"
+                },
+                "bonusAmount": {
+                    "type": "string"
+                },
+                "expenseId": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "penaltyAmount": {
+                    "type": "string"
+                },
+                "salaryAmount": {
+                    "type": "string"
+                },
+                "teacherId": {
+                    "type": "string"
+                },
+                "teacherName": {
+                    "type": "string"
+                },
+                "totalAmount": {
+                    "type": "string"
+                }
+            }
+        },
+        "pb.AbsPayrollRun": {
+            "type": "object",
+            "properties": {
+                "approvedAt": {
+                    "type": "string"
+                },
+                "approvedByName": {
+                    "type": "string"
+                },
+                "createdAt": {
+                    "type": "string"
+                },
+                "createdByName": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/pb.AbsPayrollItem"
+                    }
+                },
+                "paidAt": {
+                    "type": "string"
+                },
+                "paidByName": {
+                    "type": "string"
+                },
+                "period": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string"
+                },
+                "totalAmount": {
+                    "type": "string"
+                }
+            }
+        },
+        "pb.AbsPeriodHistory": {
+            "type": "object",
+            "properties": {
+                "action": {
+                    "type": "string"
+                },
+                "actionById": {
+                    "type": "string"
+                },
+                "actionByName": {
+                    "type": "string"
+                },
+                "comment": {
+                    "type": "string"
+                },
+                "createdAt": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "period": {
+                    "type": "string"
+                }
+            }
+        },
+        "pb.AbsRecurringExpense": {
+            "type": "object",
+            "properties": {
+                "categoryId": {
+                    "type": "string"
+                },
+                "categoryName": {
+                    "type": "string"
+                },
+                "createdAt": {
+                    "type": "string"
+                },
+                "day": {
+                    "type": "integer"
+                },
+                "endDate": {
+                    "type": "string"
+                },
+                "expenseType": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "isActive": {
+                    "type": "boolean"
+                },
+                "nextRunDate": {
+                    "type": "string"
+                },
+                "paymentMethod": {
+                    "type": "string"
+                },
+                "schedule": {
+                    "type": "string"
+                },
+                "skippedDates": {
+                    "description": "occurrences that fell into a closed accounting period and were not posted, yyyy-MM-dd",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "startDate": {
+                    "type": "string"
+                },
+                "sum": {
+                    "type": "number"
+                },
+                "title": {
+                    "type": "string"
+                },
+                "userId": {
+                    "type": "string"
+                },
+                "vendorId": {
+                    "type": "string"
+                },
+                "vendorName": {
+                    "type": "string"
+                }
+            }
+        },
+        "pb.AbsResponse": {
+            "type": "object",
+            "properties": {
+                "message": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "integer"
+                }
+            }
+        },
+        "pb.AbsRoom": {
+            "type": "object",
+            "properties": {
+                "capacity": {
+                    "type": "integer"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                }
+            }
+        },
+        "pb.AbsStudent": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "phoneNumber": {
+                    "type": "string"
+                }
+            }
+        },
+        "pb.AbsStudentCharge": {
+            "type": "object",
+            "properties": {
+                "comment": {
+                    "type": "string"
+                },
+                "createdAt": {
+                    "type": "string"
+                },
+                "createdByName": {
+                    "type": "string"
+                },
+                "givenDate": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "lines": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/pb.AbsStudentChargeLine"
+                    }
+                },
+                "paymentId": {
+                    "type": "string"
+                },
+                "studentId": {
+                    "type": "string"
+                },
+                "totalAmount": {
+                    "type": "number"
+                }
+            }
+        },
+        "pb.AbsStudentChargeLine": {
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "type": "number"
+                },
+                "itemId": {
+                    "type": "string"
+                },
+                "itemName": {
+                    "type": "string"
+                },
+                "quantity": {
+                    "type": "integer"
+                },
+                "unitPrice": {
+                    "type": "number"
+                }
+            }
+        },
+        "pb.AbsStudentDiscount": {
+            "type": "object",
+            "properties": {
+                "cause": {
+                    "type": "string"
+                },
+                "createdAt": {
+                    "type": "string"
+                },
+                "discount": {
+                    "type": "string"
+                },
+                "endAt": {
+                    "type": "string"
+                },
+                "startAt": {
+                    "type": "string"
+                },
+                "studentId": {
+                    "type": "string"
+                },
+                "studentName": {
+                    "type": "string"
+                },
+                "studentPhoneNumber": {
+                    "type": "string"
+                },
+                "withTeacher": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "pb.AbsStudentHistory": {
+            "type": "object",
+            "properties": {
+                "comment": {
+                    "type": "string"
+                },
+                "createdAt": {
+                    "type": "string"
+                },
+                "currentCondition": {
+                    "type": "string"
+                },
+                "group": {
+                    "$ref": "#/definitions/pb.AbsGroup"
+                },
+                "oldCondition": {
+                    "type": "string"
+                },
+                "specificDate": {
+                    "type": "string"
+                },
+                "student": {
+                    "$ref": "#/definitions/pb.AbsStudent"
+                }
+            }
+        },
+        "pb.AbsStudentPayments": {
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "type": "string"
+                },
+                "comment": {
+                    "type": "string"
+                },
+                "creatorId": {
+                    "type": "string"
+                },
+                "creatorName": {
+                    "type": "string"
+                },
+                "givenDate": {
+                    "type": "string"
+                },
+                "method": {
+                    "type": "string"
+                },
+                "studentId": {
+                    "type": "string"
+                },
+                "studentName": {
+                    "type": "string"
+                }
+            }
+        },
+        "pb.AbsTakeOfChartResponse": {
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "type": "string"
+                },
+                "yearMonth": {
+                    "type": "string"
+                }
+            }
+        },
+        "pb.AbsTeacher": {
+            "type": "object",
+            "properties": {
+                "activeGroups": {
+                    "type": "string"
+                },
+                "fullName": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "phoneNumber": {
+                    "type": "string"
+                }
+            }
+        },
+        "pb.AbsVendor": {
+            "type": "object",
+            "properties": {
+                "comment": {
+                    "type": "string"
+                },
+                "createdAt": {
+                    "type": "string"
+                },
+                "expenseCount": {
+                    "type": "integer"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "isActive": {
+                    "type": "boolean"
+                },
+                "lastExpenseDate": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "phone": {
+                    "type": "string"
+                },
+                "tin": {
+                    "type": "string"
+                },
+                "totalSpent": {
+                    "type": "number"
+                }
+            }
+        },
+        "pb.AbsenceStreak": {
+            "type": "object",
+            "properties": {
+                "groupId": {
+                    "type": "integer"
+                },
+                "groupName": {
+                    "type": "string"
+                },
+                "lastAbsentDate": {
+                    "type": "string"
+                },
+                "streak": {
+                    "type": "integer"
+                },
+                "studentId": {
+                    "type": "string"
+                },
+                "studentName": {
+                    "type": "string"
+                }
+            }
+        },
+        "pb.AcceptWaitlistOfferRequest": {
+            "type": "object",
+            "properties": {
+                "createdBy": {
+                    "type": "string"
+                },
+                "createdByName": {
+                    "type": "string"
+                },
+                "createdDate": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "promoCode": {
+                    "type": "string"
+                }
+            }
+        },
+        "pb.AccountingPeriodRequest": {
+            "type": "object",
+            "properties": {
+                "actionById": {
+                    "type": "string"
+                },
+                "actionByName": {
+                    "type": "string"
+                },
+                "comment": {
+                    "type": "string"
+                },
+                "period": {
+                    "type": "string"
+                }
+            }
+        },
+        "pb.AddCollectionActivityRequest": {
+            "type": "object",
+            "properties": {
+                "actionById": {
+                    "type": "string"
+                },
+                "actionByName": {
+                    "type": "string"
+                },
+                "comment": {
+                    "type": "string"
+                },
+                "promisedAmount": {
+                    "type": "string"
+                },
+                "promisedDate": {
+                    "type": "string"
+                },
+                "studentId": {
+                    "type": "string"
+                },
+                "type": {
+                    "type": "string"
+                }
+            }
+        },
+        "pb.AddExpenseReceiptRequest": {
+            "type": "object",
+            "properties": {
+                "expenseId": {
+                    "type": "string"
+                },
+                "fileName": {
+                    "type": "string"
+                },
+                "fileUrl": {
+                    "type": "string"
+                },
+                "uploadedById": {
+                    "type": "string"
+                }
+            }
+        },
+        "pb.AddPayrollAdjustmentRequest": {
+            "type": "object",
+            "properties": {
+                "actionById": {
+                    "type": "string"
+                },
+                "amount": {
+                    "type": "string"
+                },
+                "comment": {
+                    "type": "string"
+                },
+                "runId": {
+                    "type": "string"
+                },
+                "teacherId": {
+                    "type": "string"
+                },
+                "type": {
+                    "type": "string"
+                }
+            }
+        },
+        "pb.AddSmsRequest": {
+            "type": "object",
+            "properties": {
+                "comment": {
+                    "type": "string"
+                },
+                "companyId": {
+                    "type": "string"
+                },
+                "smsCount": {
+                    "type": "integer"
+                },
+                "sum": {
+                    "type": "integer"
+                }
+            }
+        },
+        "pb.AddToGroupRequest": {
+            "type": "object",
+            "properties": {
+                "createdBy": {
+                    "type": "string"
+                },
+                "createdByName": {
+                    "type": "string"
+                },
+                "createdDate": {
+                    "type": "string"
+                },
+                "groupId": {
+                    "type": "string"
+                },
+                "promoCode": {
+                    "type": "string"
+                },
+                "student_ids": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "pb.AddToGroupResponse": {
+            "type": "object",
+            "properties": {
+                "results": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/pb.AddToGroupResult"
+                    }
+                }
+            }
+        },
+        "pb.AddToGroupResult": {
+            "type": "object",
+            "properties": {
+                "message": {
+                    "description": "the reason of a failure, or the discounts that could not be applied",
+                    "type": "string"
+                },
+                "position": {
+                    "description": "place in the waitlist of a WAITLISTED student",
+                    "type": "integer"
+                },
+                "status": {
+                    "description": "ADDED, WAITLISTED when the group is full or FAILED",
+                    "type": "string"
+                },
+                "studentId": {
+                    "type": "string"
+                }
+            }
+        },
+        "pb.AgingBucket": {
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "type": "string"
+                },
+                "bucket": {
+                    "type": "string"
+                },
+                "count": {
+                    "type": "integer"
+                }
+            }
+        },
+        "pb.ApplyDiscountRulesRequest": {
+            "type": "object",
+            "properties": {
+                "actionById": {
+                    "type": "string"
+                },
+                "actionByName": {
+                    "type": "string"
+                },
+                "date": {
+                    "type": "string"
+                },
+                "groupId": {
+                    "type": "string"
+                },
+                "promoCode": {
+                    "type": "string"
+                },
+                "studentId": {
+                    "type": "string"
+                }
+            }
+        },
+        "pb.ApplyDiscountRulesResponse": {
+            "type": "object",
+            "properties": {
+                "applied": {
+                    "type": "boolean"
+                },
+                "discount": {
+                    "type": "number"
+                },
+                "message": {
+                    "type": "string"
+                },
+                "ruleId": {
+                    "type": "string"
+                },
+                "ruleName": {
+                    "type": "string"
+                }
+            }
+        },
+        "pb.ApplyDiscountTemplateRequest": {
+            "type": "object",
+            "properties": {
+                "actionById": {
+                    "type": "string"
+                },
+                "actionByName": {
+                    "type": "string"
+                },
+                "groupId": {
+                    "type": "string"
+                },
+                "ruleId": {
+                    "type": "string"
+                },
+                "startDate": {
+                    "type": "string"
+                },
+                "studentId": {
+                    "type": "string"
+                }
+            }
+        },
+        "pb.AssignDebtorRequest": {
+            "type": "object",
+            "properties": {
+                "assignedToId": {
+                    "type": "string"
+                },
+                "studentId": {
+                    "type": "string"
+                }
+            }
+        },
+        "pb.AtRiskPolicy": {
+            "type": "object",
+            "properties": {
+                "absenceStreak": {
+                    "type": "integer"
+                },
+                "actionById": {
+                    "type": "string"
+                },
+                "minLessons": {
+                    "description": "marked lessons this month before the rate is judged",
+                    "type": "integer"
+                },
+                "minRate": {
+                    "description": "attendance percent this month below which a student is flagged",
+                    "type": "integer"
+                },
+                "smsParents": {
+                    "description": "sms the parents with the AT_RISK_ALERT template when it is active",
+                    "type": "boolean"
+                }
+            }
+        },
+        "pb.AtRiskStudent": {
+            "type": "object",
+            "properties": {
+                "absenceStreak": {
+                    "type": "integer"
+                },
+                "attendanceRate": {
+                    "type": "number"
+                },
+                "flaggedAt": {
+                    "type": "string"
+                },
+                "groupId": {
+                    "type": "integer"
+                },
+                "groupName": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "reason": {
+                    "description": "ABSENCE_STREAK or LOW_RATE",
+                    "type": "string"
+                },
+                "resolvedAt": {
+                    "type": "string"
+                },
+                "status": {
+                    "description": "OPEN until the student is no longer at risk",
+                    "type": "string"
+                },
+                "studentId": {
+                    "type": "string"
+                },
+                "studentName": {
+                    "type": "string"
+                }
+            }
+        },
+        "pb.Attendance": {
+            "type": "object",
+            "properties": {
+                "attend_date": {
+                    "type": "string"
+                },
+                "confirmed": {
+                    "description": "a check in is neither billed nor paid until the teacher confirms it",
+                    "type": "boolean"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "isCome": {
+                    "description": "the status is billable",
+                    "type": "boolean"
+                },
+                "makeupDate": {
+                    "type": "string"
+                },
+                "makeupGroupId": {
+                    "description": "the absence a makeup attendance makes up",
+                    "type": "string"
+                },
+                "selfCheckIn": {
+                    "description": "the student checked in by scanning the code of the lesson",
+                    "type": "boolean"
+                },
+                "status": {
+                    "type": "integer"
+                },
+                "statusName": {
+                    "type": "string"
+                },
+                "studentId": {
+                    "type": "string"
+                },
+                "teacherId": {
+                    "type": "string"
+                }
+            }
+        },
+        "pb.AttendancePolicy": {
+            "type": "object",
+            "properties": {
+                "actionById": {
+                    "type": "string"
+                },
+                "allowFuture": {
+                    "type": "boolean"
+                },
+                "cutoffHour": {
+                    "description": "hour (0-24) of the day after the edit window at which the oldest editable day closes, 24 closes it at midnight",
+                    "type": "integer"
+                },
+                "editDaysBack": {
+                    "description": "days before today a teacher may still mark, 0 allows today only",
+                    "type": "integer"
+                },
+                "overrideRoles": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "timezone": {
+                    "description": "IANA timezone the days and the cutoff hour are counted in",
+                    "type": "string"
+                }
+            }
+        },
+        "pb.AttendanceRate": {
+            "type": "object",
+            "properties": {
+                "absent": {
+                    "type": "integer"
+                },
+                "attended": {
+                    "description": "marks with a billable status",
+                    "type": "integer"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "rate": {
+                    "description": "percent of the marked lessons attended",
+                    "type": "number"
+                },
+                "total": {
+                    "type": "integer"
+                }
+            }
+        },
+        "pb.AttendanceTrendPoint": {
+            "type": "object",
+            "properties": {
+                "attended": {
+                    "type": "integer"
+                },
+                "period": {
+                    "description": "first day of the interval",
+                    "type": "string"
+                },
+                "rate": {
+                    "type": "number"
+                },
+                "total": {
+                    "type": "integer"
+                }
+            }
+        },
+        "pb.AttendanceUnlockRequest": {
+            "type": "object",
+            "properties": {
+                "attendDate": {
+                    "type": "string"
+                },
+                "createdAt": {
+                    "type": "string"
+                },
+                "groupId": {
+                    "type": "integer"
+                },
+                "groupName": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "reason": {
+                    "type": "string"
+                },
+                "reviewedAt": {
+                    "type": "string"
+                },
+                "reviewedByName": {
+                    "type": "string"
+                },
+                "status": {
+                    "description": "PENDING, APPROVED or REJECTED",
+                    "type": "string"
+                },
+                "teacherId": {
+                    "type": "string"
+                },
+                "teacherName": {
+                    "type": "string"
+                },
+                "unlockedUntil": {
+                    "description": "the date is open for the teacher until then",
+                    "type": "string"
+                }
+            }
+        },
+        "pb.CalculateTeacherSalaryResponse": {
+            "type": "object",
+            "properties": {
+                "salaries": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/pb.AbsCalculateSalary"
+                    }
+                }
+            }
+        },
+        "pb.CalendarFeed": {
+            "type": "object",
+            "properties": {
+                "createdAt": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "ownerId": {
+                    "type": "string"
+                },
+                "ownerName": {
+                    "type": "string"
+                },
+                "ownerType": {
+                    "type": "string"
+                },
+                "token": {
+                    "type": "string"
+                },
+                "url": {
+                    "description": "url is the .ics address, it is filled in by the gateway",
+                    "type": "string"
+                }
+            }
+        },
+        "pb.CategoryBudget": {
+            "type": "object",
+            "properties": {
+                "actual": {
+                    "type": "number"
+                },
+                "budget": {
+                    "type": "number"
+                },
+                "categoryId": {
+                    "type": "string"
+                },
+                "categoryName": {
+                    "type": "string"
+                },
+                "month": {
+                    "type": "string"
+                },
+                "percent": {
+                    "type": "number"
+                }
+            }
+        },
+        "pb.ChangeConditionStudentRequest": {
+            "type": "object",
+            "properties": {
+                "actionById": {
+                    "type": "string"
+                },
+                "actionByName": {
+                    "type": "string"
+                },
+                "comment": {
+                    "type": "string"
+                },
+                "groupId": {
+                    "type": "string"
+                },
+                "returnTheMoney": {
+                    "type": "boolean"
+                },
+                "status": {
+                    "type": "string"
+                },
+                "studentId": {
+                    "type": "string"
+                },
+                "tillDate": {
+                    "type": "string"
+                }
+            }
+        },
+        "pb.ChangeLeadDataRequest": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "string"
+                },
+                "sectionType": {
+                    "type": "string"
+                }
+            }
+        },
+        "pb.ChangeLeadPlaceRequest": {
+            "type": "object",
+            "properties": {
+                "changedSet": {
+                    "$ref": "#/definitions/pb.ChangeLeadDataRequest"
+                },
+                "leadDataId": {
+                    "type": "string"
+                }
+            }
+        },
+        "pb.ChangeToSetRequest": {
+            "type": "object",
+            "properties": {
+                "actionById": {
+                    "type": "string"
+                },
+                "actionByName": {
+                    "type": "string"
+                },
+                "courseId": {
+                    "type": "string"
+                },
+                "dateType": {
+                    "type": "string"
+                },
+                "days": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "end_date": {
+                    "type": "string"
+                },
+                "force": {
+                    "description": "saves the group although its room or teacher is already booked, the override is audited",
+                    "type": "boolean"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "roomId": {
+                    "type": "string"
+                },
+                "setId": {
+                    "type": "string"
+                },
+                "startDate": {
+                    "type": "string"
+                },
+                "startTime": {
+                    "type": "string"
+                },
+                "teacherId": {
+                    "type": "string"
+                }
+            }
+        },
+        "pb.ChargeItemSales": {
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "type": "number"
+                },
+                "chargeCount": {
+                    "type": "integer"
+                },
+                "isPhysical": {
+                    "type": "boolean"
+                },
+                "itemId": {
+                    "type": "string"
+                },
+                "itemName": {
+                    "type": "string"
+                },
+                "quantity": {
+                    "type": "integer"
+                },
+                "stock": {
+                    "type": "integer"
+                },
+                "studentCount": {
+                    "type": "integer"
+                }
+            }
+        },
+        "pb.ChargeStudentLine": {
+            "type": "object",
+            "properties": {
+                "itemId": {
+                    "type": "string"
+                },
+                "quantity": {
+                    "type": "integer"
+                }
+            }
+        },
+        "pb.ChargeStudentRequest": {
+            "type": "object",
+            "properties": {
+                "actionById": {
+                    "type": "string"
+                },
+                "actionByName": {
+                    "type": "string"
+                },
+                "comment": {
+                    "type": "string"
+                },
+                "date": {
+                    "type": "string"
+                },
+                "groupId": {
+                    "description": "optional, the group the charge is shown under in the student balance history",
+                    "type": "string"
+                },
+                "lines": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/pb.ChargeStudentLine"
+                    }
+                },
+                "studentId": {
+                    "type": "string"
+                }
+            }
+        },
+        "pb.CheckInCode": {
+            "type": "object",
+            "properties": {
+                "expiresAt": {
+                    "type": "string"
+                },
+                "groupName": {
+                    "type": "string"
+                },
+                "lessonDate": {
+                    "type": "string"
+                },
+                "lessonId": {
+                    "type": "string"
+                },
+                "refreshIn": {
+                    "description": "seconds after which the tablet should show a new code",
+                    "type": "integer"
+                },
+                "token": {
+                    "type": "string"
+                }
+            }
+        },
+        "pb.CheckInRequest": {
+            "type": "object",
+            "properties": {
+                "device": {
+                    "type": "string"
+                },
+                "studentId": {
+                    "type": "string"
+                },
+                "token": {
+                    "description": "the token of the scanned QR code",
+                    "type": "string"
+                }
+            }
+        },
+        "pb.CheckInResponse": {
+            "type": "object",
+            "properties": {
+                "checkedInAt": {
+                    "type": "string"
+                },
+                "confirmed": {
+                    "description": "false until the teacher confirms the check in",
+                    "type": "boolean"
+                },
+                "groupName": {
+                    "type": "string"
+                },
+                "lessonDate": {
+                    "type": "string"
+                },
+                "lessonId": {
+                    "type": "string"
+                },
+                "message": {
+                    "type": "string"
+                }
+            }
+        },
+        "pb.CheckScheduleConflictsRequest": {
+            "type": "object",
+            "properties": {
+                "courseId": {
+                    "type": "integer"
+                },
+                "days": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "groupEndDate": {
+                    "type": "string"
+                },
+                "groupId": {
+                    "description": "group being edited, its own lessons are not conflicts",
+                    "type": "string"
+                },
+                "groupStartDate": {
+                    "type": "string"
+                },
+                "lessonStartTime": {
+                    "type": "string"
+                },
+                "roomId": {
+                    "type": "integer"
+                },
+                "teacherId": {
+                    "type": "string"
+                },
+                "type": {
+                    "type": "string"
+                }
+            }
+        },
+        "pb.CollectionSetting": {
+            "type": "object",
+            "properties": {
+                "freezeAfterDays": {
+                    "type": "integer"
+                },
+                "remindersEnabled": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "pb.CompanyCommonDetails": {
+            "type": "object",
+            "properties": {
+                "activeCompanies": {
+                    "type": "integer"
+                },
+                "activeStudents": {
+                    "type": "integer"
+                },
+                "debtorCompanies": {
+                    "type": "integer"
+                },
+                "demoCompanies": {
+                    "type": "integer"
+                },
+                "wholeCompanies": {
+                    "type": "integer"
+                }
+            }
+        },
+        "pb.CompanyFinance": {
+            "type": "object",
+            "properties": {
+                "comment": {
+                    "type": "string"
+                },
+                "company_id": {
+                    "type": "integer"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "discount_id": {
+                    "type": "string"
+                },
+                "discount_name": {
+                    "type": "string"
+                },
+                "edited_valid_date": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "sum": {
+                    "type": "number"
+                },
+                "tariff_id": {
+                    "type": "integer"
+                },
+                "tariff_sum": {
+                    "type": "number"
+                }
+            }
+        },
+        "pb.CompanyFinanceForList": {
+            "type": "object",
+            "properties": {
+                "company_id": {
+                    "type": "integer"
+                },
+                "company_name": {
+                    "type": "string"
+                },
+                "discount_id": {
+                    "type": "string"
+                },
+                "discount_name": {
+                    "type": "string"
+                },
+                "finished_to": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "start_from": {
+                    "type": "string"
+                },
+                "sum": {
+                    "type": "number"
+                },
+                "tariff_id": {
+                    "type": "string"
+                },
+                "tariff_name": {
+                    "type": "string"
+                },
+                "tariff_sum": {
+                    "type": "number"
+                }
+            }
+        },
+        "pb.CompanyFinanceList": {
+            "type": "object",
+            "properties": {
+                "count": {
+                    "type": "integer"
+                },
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/pb.CompanyFinanceForList"
+                    }
+                }
+            }
+        },
+        "pb.CompanyFinanceSelf": {
+            "type": "object",
+            "properties": {
+                "comment": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "discount_id": {
+                    "type": "string"
+                },
+                "discount_name": {
+                    "type": "string"
+                },
+                "edit_valid_date": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "sum": {
+                    "type": "number"
+                },
+                "tariff_id": {
+                    "type": "integer"
+                },
+                "tariff_name": {
+                    "type": "string"
+                },
+                "tariff_sum": {
+                    "type": "integer"
+                }
+            }
+        },
+        "pb.CompanyFinanceSelfList": {
+            "type": "object",
+            "properties": {
+                "count": {
+                    "type": "integer"
+                },
+                "discount_name": {
+                    "type": "string"
+                },
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/pb.CompanyFinanceSelf"
+                    }
+                },
+                "required_sum": {
+                    "type": "number"
+                },
+                "sum_amount_period": {
+                    "type": "number"
+                },
+                "tariff_name": {
+                    "type": "string"
+                }
+            }
+        },
+        "pb.ConfirmCheckInsRequest": {
+            "type": "object",
+            "properties": {
+                "actionById": {
+                    "type": "string"
+                },
+                "actionByRole": {
+                    "type": "string"
+                },
+                "lessonId": {
+                    "type": "string"
+                },
+                "reject": {
+                    "description": "rejected check ins are deleted",
+                    "type": "boolean"
+                },
+                "studentIds": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "pb.CourseBilling": {
+            "type": "object",
+            "properties": {
+                "billingMode": {
+                    "description": "MONTHLY takes courses.price every month, PER_LESSON charges lessonPrice per attended lesson,\nPACKAGE consumes a credit of a prepaid package per attended lesson",
+                    "type": "string"
+                },
+                "courseId": {
+                    "type": "string"
+                },
+                "lessonPrice": {
+                    "type": "number"
+                },
+                "lowCreditLessons": {
+                    "description": "lessons left on a package that trigger the low credit sms",
+                    "type": "integer"
+                },
+                "packageLessons": {
+                    "type": "integer"
+                },
+                "packagePrice": {
+                    "type": "number"
+                },
+                "packageValidDays": {
+                    "description": "days a sold package stays usable, 0 means it does not expire",
+                    "type": "integer"
+                }
+            }
+        },
+        "pb.CoursePriceVersion": {
+            "type": "object",
+            "properties": {
+                "createdAt": {
+                    "type": "string"
+                },
+                "createdByName": {
+                    "type": "string"
+                },
+                "effectiveFrom": {
+                    "type": "string"
+                },
+                "grandfatherExisting": {
+                    "type": "boolean"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "price": {
+                    "type": "number"
+                }
+            }
+        },
+        "pb.CreateCalendarFeedRequest": {
+            "type": "object",
+            "properties": {
+                "ownerId": {
+                    "description": "teacher id or room id",
+                    "type": "string"
+                },
+                "ownerType": {
+                    "description": "TEACHER or ROOM",
+                    "type": "string"
+                }
+            }
+        },
+        "pb.CreateCategoryRequest": {
+            "type": "object",
+            "properties": {
+                "desc": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                }
+            }
+        },
+        "pb.CreateChargeItemRequest": {
+            "type": "object",
+            "properties": {
+                "comment": {
+                    "type": "string"
+                },
+                "isPhysical": {
+                    "description": "physical items keep stock and can not be sold beyond it",
+                    "type": "boolean"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "price": {
+                    "type": "number"
+                },
+                "stock": {
+                    "type": "integer"
+                }
+            }
+        },
+        "pb.CreateCompanyRequest": {
+            "type": "object",
+            "properties": {
+                "avatarUrl": {
+                    "type": "string"
+                },
+                "companyPhone": {
+                    "type": "string"
+                },
+                "discount_id": {
+                    "type": "string"
+                },
+                "endTime": {
+                    "type": "string"
+                },
+                "is_demo": {
+                    "type": "boolean"
+                },
+                "startTime": {
+                    "type": "string"
+                },
+                "subdomain": {
+                    "type": "string"
+                },
+                "tariff_id": {
+                    "type": "integer"
+                },
+                "title": {
+                    "type": "string"
+                },
+                "valid_date": {
+                    "type": "string"
+                }
+            }
+        },
+        "pb.CreateCourseRequest": {
+            "type": "object",
+            "properties": {
+                "courseDuration": {
+                    "type": "integer"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "lessonDuration": {
+                    "type": "integer"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "price": {
+                    "type": "number"
+                }
+            }
+        },
+        "pb.CreateExpenseRequest": {
+            "type": "object",
+            "properties": {
+                "asDraft": {
+                    "type": "boolean"
+                },
+                "categoryId": {
                     "type": "string"
                 },
-                "name": {
+                "createdById": {
+                    "type": "string"
+                },
+                "createdByName": {
+                    "type": "string"
+                },
+                "createdByRole": {
+                    "type": "string"
+                },
+                "expenseType": {
+                    "type": "string"
+                },
+                "givenDate": {
+                    "type": "string"
+                },
+                "paymentMethod": {
+                    "type": "string"
+                },
+                "sum": {
+                    "type": "string"
+                },
+                "title": {
+                    "type": "string"
+                },
+                "userId": {
+                    "type": "string"
+                },
+                "vendorId": {
                     "type": "string"
                 }
             }
         },
-        "pb.AbsCourse": {
+        "pb.CreateGroupRequest": {
             "type": "object",
             "properties": {
-                "courseDuration": {
+                "actionById": {
+                    "type": "string"
+                },
+                "actionByName": {
+                    "type": "string"
+                },
+                "courseId": {
                     "type": "integer"
                 },
-                "description": {
+                "days": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "force": {
+                    "description": "saves the group although its room or teacher is already booked, the override is audited",
+                    "type": "boolean"
+                },
+                "groupEndDate": {
                     "type": "string"
                 },
-                "id": {
+                "groupStartDate": {
                     "type": "string"
                 },
-                "lessonDuration": {
-                    "type": "integer"
+                "lessonStartTime": {
+                    "type": "string"
                 },
                 "name": {
                     "type": "string"
                 },
-                "price": {
-                    "type": "number"
+                "roomId": {
+                    "type": "integer"
+                },
+                "teacherId": {
+                    "type": "string"
+                },
+                "type": {
+                    "type": "string"
                 }
             }
         },
-        "pb.AbsDebtsInformation": {
+        "pb.CreateInstallmentPlanRequest": {
             "type": "object",
             "properties": {
-                "balance": {
+                "actionById": {
                     "type": "string"
                 },
-                "comments": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/pb.DebtorComment"
-                    }
+                "actionByName": {
+                    "type": "string"
                 },
-                "debtorId": {
+                "comment": {
                     "type": "string"
                 },
-                "debtorName": {
+                "earlyDays": {
+                    "type": "integer"
+                },
+                "earlyDiscountPercent": {
+                    "type": "number"
+                },
+                "graceDays": {
+                    "type": "integer"
+                },
+                "groupId": {
                     "type": "string"
                 },
-                "groups": {
+                "installments": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/pb.DebtorGroup"
+                        "$ref": "#/definitions/pb.InstallmentInput"
                     }
                 },
-                "phoneNumber": {
+                "lateFeeAmount": {
                     "type": "string"
                 },
-                "totalOnPeriod": {
+                "monthsCount": {
+                    "type": "integer"
+                },
+                "planType": {
+                    "type": "string"
+                },
+                "startDate": {
+                    "type": "string"
+                },
+                "studentId": {
+                    "type": "string"
+                },
+                "totalAmount": {
                     "type": "string"
                 }
             }
         },
-        "pb.AbsDiscountRequest": {
+        "pb.CreateLeadDataRequest": {
             "type": "object",
             "properties": {
                 "comment": {
                     "type": "string"
                 },
-                "discountPrice": {
+                "companyId": {
                     "type": "string"
                 },
-                "endDate": {
+                "leadId": {
                     "type": "string"
                 },
-                "groupId": {
+                "name": {
                     "type": "string"
                 },
-                "startDate": {
+                "phoneNumber": {
+                    "type": "string"
+                }
+            }
+        },
+        "pb.CreateMissingPaymentsRequest": {
+            "type": "object",
+            "properties": {
+                "actionById": {
+                    "type": "string"
+                },
+                "actionByName": {
+                    "type": "string"
+                },
+                "lines": {
+                    "description": "empty means every unmatched line with a recognised student",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/pb.ReconciliationLineStudent"
+                    }
+                },
+                "sessionId": {
+                    "type": "string"
+                }
+            }
+        },
+        "pb.CreateMissingPaymentsResponse": {
+            "type": "object",
+            "properties": {
+                "results": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/pb.ReconciliationLineResult"
+                    }
+                }
+            }
+        },
+        "pb.CreateNoteRequest": {
+            "type": "object",
+            "properties": {
+                "note": {
                     "type": "string"
                 },
                 "studentId": {
                     "type": "string"
+                }
+            }
+        },
+        "pb.CreatePayrollRunRequest": {
+            "type": "object",
+            "properties": {
+                "actionById": {
+                    "type": "string"
                 },
-                "withTeacher": {
-                    "type": "boolean"
+                "actionByName": {
+                    "type": "string"
+                },
+                "period": {
+                    "type": "string"
                 }
             }
         },
-        "pb.AbsGetAllPaymentsByMonthResponse": {
+        "pb.CreateRecurringExpenseRequest": {
             "type": "object",
             "properties": {
-                "amount": {
+                "categoryId": {
                     "type": "string"
                 },
-                "comment": {
+                "createdById": {
                     "type": "string"
                 },
-                "created_at": {
+                "day": {
+                    "type": "integer"
+                },
+                "endDate": {
                     "type": "string"
                 },
-                "created_by_id": {
+                "expenseType": {
                     "type": "string"
                 },
-                "created_by_name": {
+                "paymentMethod": {
                     "type": "string"
                 },
-                "givenDate": {
+                "schedule": {
                     "type": "string"
                 },
-                "groupId": {
+                "startDate": {
                     "type": "string"
                 },
-                "groupName": {
+                "sum": {
                     "type": "string"
                 },
-                "method": {
+                "title": {
                     "type": "string"
                 },
-                "paymentId": {
+                "userId": {
                     "type": "string"
                 },
-                "payment_type": {
+                "vendorId": {
                     "type": "string"
                 }
             }
         },
-        "pb.AbsGetHistoryByUserIdResponse": {
+        "pb.CreateRoomRequest": {
             "type": "object",
             "properties": {
-                "createdAt": {
+                "capacity": {
+                    "type": "integer"
+                },
+                "name": {
+                    "type": "string"
+                }
+            }
+        },
+        "pb.CreateSetRequest": {
+            "type": "object",
+            "properties": {
+                "courseId": {
                     "type": "string"
                 },
-                "currentValue": {
+                "date": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "dateType": {
                     "type": "string"
                 },
-                "oldValue": {
+                "lessonStartTime": {
                     "type": "string"
                 },
-                "updatedField": {
+                "teacherId": {
+                    "type": "string"
+                },
+                "title": {
                     "type": "string"
                 }
             }
         },
-        "pb.AbsGetLeftAfter": {
+        "pb.CreateStudentRequest": {
             "type": "object",
             "properties": {
-                "createdAt": {
+                "additionalContact": {
                     "type": "string"
                 },
-                "groupId": {
+                "address": {
                     "type": "string"
                 },
-                "groupName": {
+                "createdBy": {
                     "type": "string"
                 },
-                "returnMoney": {
-                    "type": "boolean"
-                },
-                "specificDate": {
+                "dateFrom": {
                     "type": "string"
                 },
-                "studentBalance": {
+                "dateOfBirth": {
                     "type": "string"
                 },
-                "studentId": {
+                "gender": {
+                    "type": "boolean"
+                },
+                "groupId": {
                     "type": "string"
                 },
-                "studentName": {
+                "name": {
                     "type": "string"
                 },
-                "studentPhone": {
+                "passportId": {
                     "type": "string"
-                }
-            }
-        },
-        "pb.AbsGetMonthlyStatusResponse": {
-            "type": "object",
-            "properties": {
-                "balance": {
+                },
+                "phoneNumber": {
                     "type": "string"
                 },
-                "month": {
+                "telegramUsername": {
                     "type": "string"
                 }
             }
         },
-        "pb.AbsGetTeachersSalary": {
+        "pb.CreateTeacherSalaryRequest": {
             "type": "object",
             "properties": {
                 "amount": {
                     "type": "integer"
                 },
-                "teacherId": {
+                "courseId": {
+                    "type": "string"
+                },
+                "effectiveFrom": {
+                    "type": "string"
+                },
+                "groupId": {
                     "type": "string"
                 },
-                "teacherName": {
+                "teacherId": {
                     "type": "string"
                 },
                 "type": {
@@ -5852,644 +14067,744 @@ const docTemplate = `{
                 }
             }
         },
-        "pb.AbsGroup": {
+        "pb.CreateUserRequest": {
             "type": "object",
             "properties": {
-                "course": {
-                    "$ref": "#/definitions/pb.AbsCourse"
-                },
-                "currentGroupStatus": {
-                    "type": "string"
-                },
-                "dateType": {
-                    "type": "string"
-                },
-                "groupEndDate": {
-                    "type": "string"
-                },
-                "groupStartDate": {
+                "birthDate": {
                     "type": "string"
                 },
-                "id": {
+                "fullName": {
                     "type": "string"
                 },
-                "isArchived": {
+                "gender": {
                     "type": "boolean"
                 },
-                "lessonStartTime": {
+                "password": {
                     "type": "string"
                 },
-                "name": {
+                "phoneNumber": {
                     "type": "string"
                 },
-                "teacherName": {
+                "role": {
                     "type": "string"
                 }
             }
         },
-        "pb.AbsHistory": {
+        "pb.CreateVendorRequest": {
             "type": "object",
             "properties": {
-                "currentValue": {
+                "comment": {
                     "type": "string"
                 },
-                "editedField": {
+                "name": {
                     "type": "string"
                 },
-                "id": {
+                "phone": {
                     "type": "string"
                 },
-                "oldValue": {
+                "tin": {
                     "type": "string"
                 }
             }
         },
-        "pb.AbsHistoryDiscount": {
+        "pb.Day": {
             "type": "object",
             "properties": {
-                "action": {
-                    "type": "string"
-                },
-                "comment": {
+                "date": {
                     "type": "string"
                 },
-                "createdAt": {
+                "transferDate": {
                     "type": "string"
-                },
-                "discountPrice": {
+                }
+            }
+        },
+        "pb.DebtorComment": {
+            "type": "object",
+            "properties": {
+                "comment": {
                     "type": "string"
                 },
-                "endDate": {
+                "commentId": {
                     "type": "string"
-                },
+                }
+            }
+        },
+        "pb.DebtorGroup": {
+            "type": "object",
+            "properties": {
                 "groupId": {
                     "type": "string"
                 },
                 "groupName": {
                     "type": "string"
-                },
-                "startDate": {
-                    "type": "string"
-                },
-                "studentId": {
-                    "type": "string"
-                },
-                "studentName": {
-                    "type": "string"
-                },
-                "withTeacher": {
-                    "type": "boolean"
                 }
             }
         },
-        "pb.AbsNote": {
+        "pb.DynamicSection": {
             "type": "object",
             "properties": {
-                "comment": {
-                    "type": "string"
-                },
-                "createdAt": {
+                "id": {
                     "type": "string"
                 },
-                "id": {
+                "name": {
                     "type": "string"
                 }
             }
         },
-        "pb.AbsPaymentTakeOff": {
+        "pb.EnrollmentPriceLock": {
             "type": "object",
             "properties": {
-                "comment": {
+                "groupId": {
                     "type": "string"
                 },
-                "creatorId": {
+                "groupName": {
                     "type": "string"
                 },
-                "creatorName": {
+                "lockedFrom": {
                     "type": "string"
                 },
-                "givenDate": {
+                "price": {
+                    "type": "number"
+                },
+                "studentId": {
                     "type": "string"
                 },
-                "paymentId": {
+                "studentName": {
+                    "type": "string"
+                }
+            }
+        },
+        "pb.ExpenseActionRequest": {
+            "type": "object",
+            "properties": {
+                "actionById": {
                     "type": "string"
                 },
-                "studentId": {
+                "actionByName": {
                     "type": "string"
                 },
-                "studentName": {
+                "actionByRole": {
                     "type": "string"
                 },
-                "sum": {
+                "comment": {
+                    "type": "string"
+                },
+                "id": {
                     "type": "string"
                 }
             }
         },
-        "pb.AbsResponse": {
+        "pb.ExpenseApprovalSetting": {
             "type": "object",
             "properties": {
-                "message": {
-                    "type": "string"
+                "ceoThreshold": {
+                    "type": "number"
                 },
-                "status": {
-                    "type": "integer"
+                "financistThreshold": {
+                    "type": "number"
                 }
             }
         },
-        "pb.AbsRoom": {
+        "pb.ExpenseDetail": {
             "type": "object",
             "properties": {
-                "capacity": {
-                    "type": "integer"
+                "expense": {
+                    "$ref": "#/definitions/pb.GetAllExpenseAbs"
                 },
-                "id": {
-                    "type": "string"
+                "history": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/pb.ExpenseStatusHistory"
+                    }
                 },
-                "name": {
-                    "type": "string"
+                "receipts": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/pb.ExpenseReceipt"
+                    }
                 }
             }
         },
-        "pb.AbsStudent": {
+        "pb.ExpenseReceipt": {
             "type": "object",
             "properties": {
-                "id": {
+                "createdAt": {
                     "type": "string"
                 },
-                "name": {
+                "fileName": {
                     "type": "string"
                 },
-                "phoneNumber": {
+                "fileUrl": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "uploadedById": {
                     "type": "string"
                 }
             }
         },
-        "pb.AbsStudentDiscount": {
+        "pb.ExpenseStatusHistory": {
             "type": "object",
             "properties": {
-                "cause": {
+                "actionById": {
                     "type": "string"
                 },
-                "createdAt": {
+                "actionByName": {
                     "type": "string"
                 },
-                "discount": {
+                "comment": {
                     "type": "string"
                 },
-                "endAt": {
+                "createdAt": {
                     "type": "string"
                 },
-                "startAt": {
+                "fromStatus": {
                     "type": "string"
                 },
-                "studentId": {
+                "toStatus": {
                     "type": "string"
-                },
-                "studentName": {
+                }
+            }
+        },
+        "pb.Filters": {
+            "type": "object",
+            "properties": {
+                "field": {
                     "type": "string"
                 },
-                "studentPhoneNumber": {
+                "type": {
                     "type": "string"
                 },
-                "withTeacher": {
-                    "type": "boolean"
+                "value": {
+                    "type": "string"
                 }
             }
         },
-        "pb.AbsStudentHistory": {
+        "pb.FreezeDetail": {
             "type": "object",
             "properties": {
-                "comment": {
+                "reason": {
                     "type": "string"
                 },
-                "createdAt": {
+                "till_date": {
                     "type": "string"
+                }
+            }
+        },
+        "pb.GetAbsenceStreaksResponse": {
+            "type": "object",
+            "properties": {
+                "streaks": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/pb.AbsenceStreak"
+                    }
+                }
+            }
+        },
+        "pb.GetAllCategoryRequest": {
+            "type": "object",
+            "properties": {
+                "categories": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/pb.AbsCategory"
+                    }
+                }
+            }
+        },
+        "pb.GetAllDebtsInformationResponse": {
+            "type": "object",
+            "properties": {
+                "debts": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/pb.AbsDebtsInformation"
+                    }
                 },
-                "currentCondition": {
+                "totalPageCount": {
+                    "type": "integer"
+                }
+            }
+        },
+        "pb.GetAllEmployeeResponse": {
+            "type": "object",
+            "properties": {
+                "employees": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/pb.GetUserByIdResponse"
+                    }
+                }
+            }
+        },
+        "pb.GetAllExpenseAbs": {
+            "type": "object",
+            "properties": {
+                "approverRole": {
                     "type": "string"
                 },
-                "group": {
-                    "$ref": "#/definitions/pb.AbsGroup"
+                "category": {
+                    "$ref": "#/definitions/pb.AbsCategory"
                 },
-                "oldCondition": {
+                "createdAt": {
                     "type": "string"
                 },
-                "specificDate": {
+                "creator": {
+                    "$ref": "#/definitions/pb.GetUserByIdResponse"
+                },
+                "expenseType": {
                     "type": "string"
                 },
-                "student": {
-                    "$ref": "#/definitions/pb.AbsStudent"
-                }
-            }
-        },
-        "pb.AbsStudentPayments": {
-            "type": "object",
-            "properties": {
-                "amount": {
+                "givenDate": {
                     "type": "string"
                 },
-                "comment": {
+                "id": {
                     "type": "string"
                 },
-                "creatorId": {
+                "paymentType": {
                     "type": "string"
                 },
-                "creatorName": {
+                "receiptCount": {
+                    "type": "integer"
+                },
+                "status": {
                     "type": "string"
                 },
-                "givenDate": {
+                "sum": {
                     "type": "string"
                 },
-                "method": {
+                "title": {
                     "type": "string"
                 },
-                "studentId": {
+                "user": {
+                    "$ref": "#/definitions/pb.GetUserByIdResponse"
+                },
+                "vendorId": {
                     "type": "string"
                 },
-                "studentName": {
+                "vendorName": {
                     "type": "string"
                 }
             }
         },
-        "pb.AbsTakeOfChartResponse": {
+        "pb.GetAllExpenseDiagramResponse": {
             "type": "object",
             "properties": {
-                "amount": {
+                "amountCommonExpense": {
                     "type": "string"
                 },
-                "yearMonth": {
-                    "type": "string"
+                "budgets": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/pb.CategoryBudget"
+                    }
+                },
+                "monthAmount": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "months": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "userOrCategories": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "userOrCategoriesAmount": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
                 }
             }
         },
-        "pb.AbsTeacher": {
+        "pb.GetAllExpenseResponse": {
             "type": "object",
             "properties": {
-                "activeGroups": {
-                    "type": "string"
-                },
-                "fullName": {
-                    "type": "string"
-                },
-                "id": {
-                    "type": "string"
+                "expenses": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/pb.GetAllExpenseAbs"
+                    }
                 },
-                "phoneNumber": {
-                    "type": "string"
+                "totalPageCount": {
+                    "type": "integer"
                 }
             }
         },
-        "pb.AddSmsRequest": {
+        "pb.GetAllPaymentTakeOffChartResponse": {
             "type": "object",
             "properties": {
-                "comment": {
-                    "type": "string"
-                },
-                "companyId": {
-                    "type": "string"
-                },
-                "smsCount": {
-                    "type": "integer"
+                "chartResponse": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/pb.AbsTakeOfChartResponse"
+                    }
+                }
+            }
+        },
+        "pb.GetAllPaymentTakeOffResponse": {
+            "type": "object",
+            "properties": {
+                "pennies": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/pb.AbsPaymentTakeOff"
+                    }
+                }
+            }
+        },
+        "pb.GetAllPaymentsByMonthResponse": {
+            "type": "object",
+            "properties": {
+                "payments": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/pb.AbsGetAllPaymentsByMonthResponse"
+                    }
+                }
+            }
+        },
+        "pb.GetAllPeriodsResponse": {
+            "type": "object",
+            "properties": {
+                "periods": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/pb.AbsAccountingPeriod"
+                    }
+                }
+            }
+        },
+        "pb.GetAllResponse": {
+            "type": "object",
+            "properties": {
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/pb.GetCompanyResponse"
+                    }
                 },
-                "sum": {
+                "totalCount": {
                     "type": "integer"
                 }
             }
         },
-        "pb.AddToGroupRequest": {
+        "pb.GetAllStudentPaymentsChartResponse": {
             "type": "object",
             "properties": {
-                "createdBy": {
+                "cash": {
                     "type": "string"
                 },
-                "createdDate": {
+                "click": {
                     "type": "string"
                 },
-                "groupId": {
+                "payme": {
                     "type": "string"
                 },
-                "student_ids": {
+                "paymentsChart": {
                     "type": "array",
                     "items": {
-                        "type": "string"
+                        "$ref": "#/definitions/pb.AbsTakeOfChartResponse"
                     }
+                },
+                "totalRevenue": {
+                    "type": "string"
                 }
             }
         },
-        "pb.Attendance": {
+        "pb.GetAllStudentPaymentsRequest": {
             "type": "object",
             "properties": {
-                "attend_date": {
-                    "type": "string"
+                "filters": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/pb.Filters"
+                    }
                 },
-                "id": {
+                "from": {
                     "type": "string"
                 },
-                "isCome": {
-                    "type": "boolean"
+                "page": {
+                    "$ref": "#/definitions/pb.PageRequest"
                 },
-                "studentId": {
-                    "type": "string"
+                "sorts": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/pb.SortBy"
+                    }
                 },
-                "teacherId": {
+                "to": {
                     "type": "string"
                 }
             }
         },
-        "pb.CalculateTeacherSalaryResponse": {
+        "pb.GetAllStudentPaymentsResponse": {
             "type": "object",
             "properties": {
-                "salaries": {
+                "payments": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/pb.AbsCalculateSalary"
+                        "$ref": "#/definitions/pb.AbsStudentPayments"
                     }
                 }
             }
         },
-        "pb.ChangeConditionStudentRequest": {
+        "pb.GetAllStudentRequest": {
             "type": "object",
             "properties": {
-                "actionById": {
+                "condition": {
                     "type": "string"
                 },
-                "actionByName": {
+                "courseId": {
                     "type": "string"
                 },
-                "comment": {
+                "nameOrSurname": {
                     "type": "string"
                 },
-                "groupId": {
+                "page": {
                     "type": "string"
                 },
-                "returnTheMoney": {
-                    "type": "boolean"
-                },
-                "status": {
+                "size": {
                     "type": "string"
                 },
-                "studentId": {
+                "trainingDatesEnd": {
                     "type": "string"
                 },
-                "tillDate": {
+                "trainingDatesStart": {
                     "type": "string"
                 }
             }
         },
-        "pb.ChangeLeadDataRequest": {
+        "pb.GetAllStudentResponse": {
             "type": "object",
             "properties": {
-                "id": {
-                    "type": "string"
+                "response": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/pb.GetGroupsAbsForStudent"
+                    }
                 },
-                "sectionType": {
-                    "type": "string"
+                "totalCount": {
+                    "type": "integer"
                 }
             }
         },
-        "pb.ChangeLeadPlaceRequest": {
+        "pb.GetAllStuffResponse": {
             "type": "object",
             "properties": {
-                "changedSet": {
-                    "$ref": "#/definitions/pb.ChangeLeadDataRequest"
-                },
-                "leadDataId": {
-                    "type": "string"
+                "stuff": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/pb.GetUserByIdResponse"
+                    }
                 }
             }
         },
-        "pb.ChangeToSetRequest": {
+        "pb.GetAtRiskStudentsResponse": {
             "type": "object",
             "properties": {
-                "courseId": {
-                    "type": "string"
-                },
-                "dateType": {
-                    "type": "string"
-                },
-                "days": {
+                "students": {
                     "type": "array",
                     "items": {
-                        "type": "string"
+                        "$ref": "#/definitions/pb.AtRiskStudent"
                     }
-                },
-                "end_date": {
-                    "type": "string"
-                },
-                "name": {
-                    "type": "string"
-                },
-                "roomId": {
-                    "type": "string"
-                },
-                "setId": {
-                    "type": "string"
-                },
-                "startDate": {
-                    "type": "string"
-                },
-                "startTime": {
-                    "type": "string"
-                },
-                "teacherId": {
-                    "type": "string"
                 }
             }
         },
-        "pb.CompanyCommonDetails": {
-            "type": "object",
-            "properties": {
-                "activeCompanies": {
-                    "type": "integer"
-                },
-                "activeStudents": {
-                    "type": "integer"
-                },
-                "debtorCompanies": {
-                    "type": "integer"
-                },
-                "demoCompanies": {
-                    "type": "integer"
-                },
-                "wholeCompanies": {
-                    "type": "integer"
+        "pb.GetAttendanceRatesResponse": {
+            "type": "object",
+            "properties": {
+                "rates": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/pb.AttendanceRate"
+                    }
                 }
             }
         },
-        "pb.CompanyFinance": {
+        "pb.GetAttendanceRequest": {
             "type": "object",
             "properties": {
-                "comment": {
+                "actionId": {
                     "type": "string"
                 },
-                "company_id": {
-                    "type": "integer"
-                },
-                "created_at": {
+                "actionRole": {
                     "type": "string"
                 },
-                "discount_id": {
+                "from": {
                     "type": "string"
                 },
-                "discount_name": {
+                "groupId": {
                     "type": "string"
                 },
-                "edited_valid_date": {
+                "till": {
                     "type": "string"
                 },
-                "id": {
-                    "type": "integer"
-                },
-                "sum": {
-                    "type": "number"
-                },
-                "tariff_id": {
-                    "type": "integer"
-                },
-                "tariff_sum": {
-                    "type": "number"
+                "withOutdated": {
+                    "type": "boolean"
                 }
             }
         },
-        "pb.CompanyFinanceForList": {
+        "pb.GetAttendanceResponse": {
             "type": "object",
             "properties": {
-                "company_id": {
-                    "type": "integer"
-                },
-                "company_name": {
-                    "type": "string"
-                },
-                "discount_id": {
-                    "type": "string"
-                },
-                "discount_name": {
-                    "type": "string"
-                },
-                "finished_to": {
-                    "type": "string"
-                },
-                "id": {
-                    "type": "integer"
-                },
-                "start_from": {
-                    "type": "string"
-                },
-                "sum": {
-                    "type": "number"
-                },
-                "tariff_id": {
-                    "type": "string"
-                },
-                "tariff_name": {
-                    "type": "string"
+                "days": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/pb.Day"
+                    }
                 },
-                "tariff_sum": {
-                    "type": "number"
+                "students": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/pb.Student"
+                    }
                 }
             }
         },
-        "pb.CompanyFinanceList": {
+        "pb.GetAttendanceStatusesResponse": {
             "type": "object",
             "properties": {
-                "count": {
-                    "type": "integer"
-                },
-                "items": {
+                "statuses": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/pb.CompanyFinanceForList"
+                        "$ref": "#/definitions/pb.AbsAttendanceStatus"
                     }
                 }
             }
         },
-        "pb.CompanyFinanceSelf": {
+        "pb.GetAttendanceTrendResponse": {
             "type": "object",
             "properties": {
-                "comment": {
-                    "type": "string"
-                },
-                "created_at": {
-                    "type": "string"
-                },
-                "discount_id": {
-                    "type": "string"
-                },
-                "discount_name": {
-                    "type": "string"
-                },
-                "edit_valid_date": {
-                    "type": "string"
-                },
-                "id": {
-                    "type": "integer"
+                "points": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/pb.AttendanceTrendPoint"
+                    }
+                }
+            }
+        },
+        "pb.GetAttendanceUnlockRequestsResponse": {
+            "type": "object",
+            "properties": {
+                "requests": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/pb.AttendanceUnlockRequest"
+                    }
+                }
+            }
+        },
+        "pb.GetBudgetAlertsResponse": {
+            "type": "object",
+            "properties": {
+                "alerts": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/pb.AbsBudgetAlert"
+                    }
+                }
+            }
+        },
+        "pb.GetCalendarFeedsResponse": {
+            "type": "object",
+            "properties": {
+                "feeds": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/pb.CalendarFeed"
+                    }
+                }
+            }
+        },
+        "pb.GetChargeItemSalesReportResponse": {
+            "type": "object",
+            "properties": {
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/pb.ChargeItemSales"
+                    }
                 },
-                "sum": {
+                "totalAmount": {
                     "type": "number"
                 },
-                "tariff_id": {
-                    "type": "integer"
-                },
-                "tariff_name": {
-                    "type": "string"
-                },
-                "tariff_sum": {
+                "totalQuantity": {
                     "type": "integer"
                 }
             }
         },
-        "pb.CompanyFinanceSelfList": {
+        "pb.GetChargeItemsResponse": {
             "type": "object",
             "properties": {
-                "count": {
-                    "type": "integer"
-                },
-                "discount_name": {
-                    "type": "string"
-                },
                 "items": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/pb.CompanyFinanceSelf"
+                        "$ref": "#/definitions/pb.AbsChargeItem"
                     }
-                },
-                "required_sum": {
-                    "type": "number"
-                },
-                "sum_amount_period": {
-                    "type": "number"
-                },
-                "tariff_name": {
-                    "type": "string"
                 }
             }
         },
-        "pb.CreateCategoryRequest": {
+        "pb.GetCollectionActivitiesResponse": {
             "type": "object",
             "properties": {
-                "desc": {
-                    "type": "string"
+                "activities": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/pb.AbsCollectionActivity"
+                    }
+                }
+            }
+        },
+        "pb.GetCommonInformationResponse": {
+            "type": "object",
+            "properties": {
+                "debtorsCount": {
+                    "type": "integer"
                 },
-                "name": {
-                    "type": "string"
+                "payInCurrentMonth": {
+                    "type": "integer"
                 }
             }
         },
-        "pb.CreateCompanyRequest": {
+        "pb.GetCompanyResponse": {
             "type": "object",
             "properties": {
+                "active_student_count": {
+                    "type": "integer"
+                },
                 "avatarUrl": {
                     "type": "string"
                 },
+                "ceo_id": {
+                    "type": "string"
+                },
                 "companyPhone": {
                     "type": "string"
                 },
+                "created_at": {
+                    "type": "string"
+                },
                 "discount_id": {
                     "type": "string"
                 },
                 "endTime": {
                     "type": "string"
                 },
+                "id": {
+                    "type": "string"
+                },
                 "is_demo": {
                     "type": "boolean"
                 },
@@ -6499,8 +14814,8 @@ const docTemplate = `{
                 "subdomain": {
                     "type": "string"
                 },
-                "tariff_id": {
-                    "type": "integer"
+                "tariff": {
+                    "$ref": "#/definitions/pb.Tariff"
                 },
                 "title": {
                     "type": "string"
@@ -6510,60 +14825,66 @@ const docTemplate = `{
                 }
             }
         },
-        "pb.CreateCourseRequest": {
+        "pb.GetCoursePriceHistoryResponse": {
             "type": "object",
             "properties": {
-                "courseDuration": {
-                    "type": "integer"
-                },
-                "description": {
-                    "type": "string"
-                },
-                "lessonDuration": {
-                    "type": "integer"
-                },
-                "name": {
-                    "type": "string"
+                "locks": {
+                    "description": "enrollments of the course paying a grandfathered price",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/pb.EnrollmentPriceLock"
+                    }
                 },
-                "price": {
-                    "type": "number"
+                "versions": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/pb.CoursePriceVersion"
+                    }
                 }
             }
         },
-        "pb.CreateExpenseRequest": {
+        "pb.GetDebtAgingResponse": {
             "type": "object",
             "properties": {
-                "categoryId": {
-                    "type": "string"
-                },
-                "createdById": {
-                    "type": "string"
-                },
-                "expenseType": {
-                    "type": "string"
-                },
-                "givenDate": {
-                    "type": "string"
-                },
-                "paymentMethod": {
-                    "type": "string"
-                },
-                "sum": {
-                    "type": "string"
+                "buckets": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/pb.AgingBucket"
+                    }
                 },
-                "title": {
-                    "type": "string"
+                "debtors": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/pb.AbsAgedDebtor"
+                    }
                 },
-                "userId": {
-                    "type": "string"
+                "totalPageCount": {
+                    "type": "integer"
+                }
+            }
+        },
+        "pb.GetDiscountRulesResponse": {
+            "type": "object",
+            "properties": {
+                "rules": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/pb.AbsDiscountRule"
+                    }
                 }
             }
         },
-        "pb.CreateGroupRequest": {
+        "pb.GetGroupAbsResponse": {
             "type": "object",
             "properties": {
-                "courseId": {
-                    "type": "integer"
+                "course": {
+                    "$ref": "#/definitions/pb.AbsCourse"
+                },
+                "createdAt": {
+                    "type": "string"
+                },
+                "dateType": {
+                    "type": "string"
                 },
                 "days": {
                     "type": "array",
@@ -6571,98 +14892,137 @@ const docTemplate = `{
                         "type": "string"
                     }
                 },
-                "groupEndDate": {
+                "endDate": {
                     "type": "string"
                 },
-                "groupStartDate": {
+                "id": {
                     "type": "string"
                 },
+                "isArchived": {
+                    "type": "boolean"
+                },
                 "lessonStartTime": {
                     "type": "string"
                 },
                 "name": {
                     "type": "string"
                 },
-                "roomId": {
+                "room": {
+                    "$ref": "#/definitions/pb.AbsRoom"
+                },
+                "startDate": {
+                    "type": "string"
+                },
+                "studentCount": {
                     "type": "integer"
                 },
                 "teacherId": {
                     "type": "string"
                 },
-                "type": {
+                "teacherName": {
                     "type": "string"
                 }
             }
         },
-        "pb.CreateLeadDataRequest": {
+        "pb.GetGroupByCourseAbsResponse": {
             "type": "object",
             "properties": {
-                "comment": {
+                "dateType": {
                     "type": "string"
                 },
-                "companyId": {
+                "groupEndDate": {
                     "type": "string"
                 },
-                "leadId": {
+                "groupName": {
                     "type": "string"
                 },
-                "name": {
+                "groupStartDate": {
                     "type": "string"
                 },
-                "phoneNumber": {
+                "id": {
                     "type": "string"
-                }
-            }
-        },
-        "pb.CreateNoteRequest": {
-            "type": "object",
-            "properties": {
-                "note": {
+                },
+                "lessonStartTime": {
                     "type": "string"
                 },
-                "studentId": {
+                "teacherName": {
                     "type": "string"
                 }
             }
         },
-        "pb.CreateRoomRequest": {
+        "pb.GetGroupByTeacherAbs": {
             "type": "object",
             "properties": {
-                "capacity": {
+                "activeStudentCount": {
                     "type": "integer"
                 },
+                "courseName": {
+                    "type": "string"
+                },
+                "dayType": {
+                    "type": "string"
+                },
+                "groupEndAt": {
+                    "type": "string"
+                },
+                "groupStartAt": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "lessonStartTime": {
+                    "type": "string"
+                },
                 "name": {
                     "type": "string"
+                },
+                "roomName": {
+                    "type": "string"
+                },
+                "students": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/pb.AbsStudent"
+                    }
                 }
             }
         },
-        "pb.CreateSetRequest": {
+        "pb.GetGroupHolidaysResponse": {
             "type": "object",
             "properties": {
-                "courseId": {
-                    "type": "string"
-                },
-                "date": {
+                "dates": {
+                    "description": "days without lessons of the group in the period",
                     "type": "array",
                     "items": {
                         "type": "string"
                     }
+                }
+            }
+        },
+        "pb.GetGroupWaitlistResponse": {
+            "type": "object",
+            "properties": {
+                "capacity": {
+                    "description": "0 when neither the group nor its room limits the seats",
+                    "type": "integer"
                 },
-                "dateType": {
-                    "type": "string"
-                },
-                "lessonStartTime": {
-                    "type": "string"
+                "enrolled": {
+                    "type": "integer"
                 },
-                "teacherId": {
-                    "type": "string"
+                "entries": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/pb.WaitlistEntry"
+                    }
                 },
-                "title": {
-                    "type": "string"
+                "held": {
+                    "description": "seats held for OFFERED students",
+                    "type": "integer"
                 }
             }
         },
-        "pb.CreateStudentRequest": {
+        "pb.GetGroupsAbsForStudent": {
             "type": "object",
             "properties": {
                 "additionalContact": {
@@ -6671,10 +15031,13 @@ const docTemplate = `{
                 "address": {
                     "type": "string"
                 },
-                "createdBy": {
+                "balance": {
+                    "type": "number"
+                },
+                "condition": {
                     "type": "string"
                 },
-                "dateFrom": {
+                "createdAt": {
                     "type": "string"
                 },
                 "dateOfBirth": {
@@ -6683,7 +15046,13 @@ const docTemplate = `{
                 "gender": {
                     "type": "boolean"
                 },
-                "groupId": {
+                "groups": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/pb.GroupGetAllStudentAbs"
+                    }
+                },
+                "id": {
                     "type": "string"
                 },
                 "name": {
@@ -6692,7 +15061,7 @@ const docTemplate = `{
                 "passportId": {
                     "type": "string"
                 },
-                "phoneNumber": {
+                "phone": {
                     "type": "string"
                 },
                 "telegramUsername": {
@@ -6700,511 +15069,594 @@ const docTemplate = `{
                 }
             }
         },
-        "pb.CreateTeacherSalaryRequest": {
+        "pb.GetGroupsByCourseResponse": {
             "type": "object",
             "properties": {
-                "amount": {
-                    "type": "integer"
-                },
-                "teacherId": {
-                    "type": "string"
-                },
-                "type": {
-                    "type": "string"
+                "groups": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/pb.GetGroupByCourseAbsResponse"
+                    }
                 }
             }
         },
-        "pb.CreateUserRequest": {
+        "pb.GetGroupsByTeacherResponse": {
             "type": "object",
             "properties": {
-                "birthDate": {
+                "groups": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/pb.GetGroupByTeacherAbs"
+                    }
+                }
+            }
+        },
+        "pb.GetGroupsRequest": {
+            "type": "object",
+            "properties": {
+                "courseId": {
+                    "type": "integer"
+                },
+                "dateType": {
                     "type": "string"
                 },
-                "fullName": {
+                "endDate": {
                     "type": "string"
                 },
-                "gender": {
+                "isArchived": {
                     "type": "boolean"
                 },
-                "password": {
+                "orderBy": {
                     "type": "string"
                 },
-                "phoneNumber": {
+                "orderDirection": {
                     "type": "string"
                 },
-                "role": {
+                "page": {
+                    "$ref": "#/definitions/pb.PageRequest"
+                },
+                "startDate": {
+                    "type": "string"
+                },
+                "teacherId": {
                     "type": "string"
                 }
             }
         },
-        "pb.Day": {
+        "pb.GetGroupsResponse": {
+            "type": "object",
+            "properties": {
+                "groups": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/pb.GetGroupAbsResponse"
+                    }
+                },
+                "totalPageCount": {
+                    "type": "integer"
+                }
+            }
+        },
+        "pb.GetHistoryByUserIdResponse": {
+            "type": "object",
+            "properties": {
+                "histories": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/pb.AbsGetHistoryByUserIdResponse"
+                    }
+                }
+            }
+        },
+        "pb.GetHistoryDiscountResponse": {
+            "type": "object",
+            "properties": {
+                "discounts": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/pb.AbsHistoryDiscount"
+                    }
+                }
+            }
+        },
+        "pb.GetHistoryGroupResponse": {
+            "type": "object",
+            "properties": {
+                "groupHistory": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/pb.AbsHistory"
+                    }
+                },
+                "studentsHistory": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/pb.AbsStudentHistory"
+                    }
+                }
+            }
+        },
+        "pb.GetHistoryStudentResponse": {
+            "type": "object",
+            "properties": {
+                "conditionsHistory": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/pb.AbsStudentHistory"
+                    }
+                },
+                "studentHistory": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/pb.AbsHistory"
+                    }
+                }
+            }
+        },
+        "pb.GetHolidaysResponse": {
+            "type": "object",
+            "properties": {
+                "holidays": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/pb.AbsHoliday"
+                    }
+                }
+            }
+        },
+        "pb.GetInformationDiscountResponse": {
             "type": "object",
             "properties": {
-                "date": {
-                    "type": "string"
-                },
-                "transferDate": {
-                    "type": "string"
+                "discounts": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/pb.AbsStudentDiscount"
+                    }
                 }
             }
         },
-        "pb.DebtorComment": {
+        "pb.GetInstallmentPlansResponse": {
             "type": "object",
             "properties": {
-                "comment": {
-                    "type": "string"
-                },
-                "commentId": {
-                    "type": "string"
+                "plans": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/pb.AbsInstallmentPlan"
+                    }
                 }
             }
         },
-        "pb.DebtorGroup": {
+        "pb.GetLeadCommonRequest": {
             "type": "object",
             "properties": {
-                "groupId": {
-                    "type": "string"
-                },
-                "groupName": {
-                    "type": "string"
+                "requests": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/pb.LeadCommonRequest"
+                    }
                 }
             }
         },
-        "pb.DynamicSection": {
+        "pb.GetLeadCommonResponse": {
             "type": "object",
             "properties": {
-                "id": {
-                    "type": "string"
+                "expectations": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/pb.Section"
+                    }
                 },
-                "name": {
-                    "type": "string"
+                "leads": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/pb.Section"
+                    }
+                },
+                "sets": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/pb.Section"
+                    }
                 }
             }
         },
-        "pb.Filters": {
+        "pb.GetLeadListResponse": {
             "type": "object",
             "properties": {
-                "field": {
-                    "type": "string"
-                },
-                "type": {
-                    "type": "string"
-                },
-                "value": {
-                    "type": "string"
+                "sections": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/pb.DynamicSection"
+                    }
                 }
             }
         },
-        "pb.FreezeDetail": {
+        "pb.GetLeftAfterTrialPeriodResponse": {
             "type": "object",
             "properties": {
-                "reason": {
-                    "type": "string"
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/pb.AbsGetLeftAfter"
+                    }
                 },
-                "till_date": {
-                    "type": "string"
+                "totalItemCount": {
+                    "type": "integer"
                 }
             }
         },
-        "pb.GetAllCategoryRequest": {
+        "pb.GetLessonCheckInsResponse": {
             "type": "object",
             "properties": {
-                "categories": {
+                "checkIns": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/pb.AbsCategory"
+                        "$ref": "#/definitions/pb.LessonCheckIn"
                     }
                 }
             }
         },
-        "pb.GetAllDebtsInformationResponse": {
+        "pb.GetLessonPackagesResponse": {
             "type": "object",
             "properties": {
-                "debts": {
+                "packages": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/pb.AbsDebtsInformation"
+                        "$ref": "#/definitions/pb.AbsLessonPackage"
                     }
-                },
-                "totalPageCount": {
-                    "type": "integer"
                 }
             }
         },
-        "pb.GetAllEmployeeResponse": {
+        "pb.GetLessonsResponse": {
             "type": "object",
             "properties": {
-                "employees": {
+                "lessons": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/pb.GetUserByIdResponse"
+                        "$ref": "#/definitions/pb.AbsLesson"
                     }
                 }
             }
         },
-        "pb.GetAllExpenseAbs": {
+        "pb.GetMonthlyStatusResponse": {
             "type": "object",
             "properties": {
-                "category": {
-                    "$ref": "#/definitions/pb.AbsCategory"
-                },
-                "createdAt": {
-                    "type": "string"
-                },
-                "creator": {
-                    "$ref": "#/definitions/pb.GetUserByIdResponse"
-                },
-                "expenseType": {
-                    "type": "string"
-                },
-                "givenDate": {
-                    "type": "string"
-                },
-                "id": {
-                    "type": "string"
-                },
-                "paymentType": {
-                    "type": "string"
-                },
-                "sum": {
-                    "type": "string"
-                },
-                "title": {
-                    "type": "string"
-                },
-                "user": {
-                    "$ref": "#/definitions/pb.GetUserByIdResponse"
+                "monthStatus": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/pb.AbsGetMonthlyStatusResponse"
+                    }
                 }
             }
         },
-        "pb.GetAllExpenseDiagramResponse": {
+        "pb.GetNotesByStudent": {
             "type": "object",
             "properties": {
-                "amountCommonExpense": {
-                    "type": "string"
-                },
-                "monthAmount": {
+                "notes": {
                     "type": "array",
                     "items": {
-                        "type": "string"
+                        "$ref": "#/definitions/pb.AbsNote"
                     }
-                },
-                "months": {
+                }
+            }
+        },
+        "pb.GetNotificationsResponse": {
+            "type": "object",
+            "properties": {
+                "notifications": {
                     "type": "array",
                     "items": {
-                        "type": "string"
+                        "$ref": "#/definitions/pb.Notification"
                     }
                 },
-                "userOrCategories": {
+                "unread": {
+                    "type": "integer"
+                }
+            }
+        },
+        "pb.GetOverdueInstallmentsResponse": {
+            "type": "object",
+            "properties": {
+                "buckets": {
                     "type": "array",
                     "items": {
-                        "type": "string"
+                        "$ref": "#/definitions/pb.OverdueBucket"
                     }
                 },
-                "userOrCategoriesAmount": {
+                "installments": {
                     "type": "array",
                     "items": {
-                        "type": "string"
+                        "$ref": "#/definitions/pb.AbsOverdueInstallment"
                     }
                 }
             }
         },
-        "pb.GetAllExpenseResponse": {
+        "pb.GetPayrollRunsResponse": {
             "type": "object",
             "properties": {
-                "expenses": {
+                "runs": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/pb.GetAllExpenseAbs"
+                        "$ref": "#/definitions/pb.AbsPayrollRun"
                     }
-                },
-                "totalPageCount": {
-                    "type": "integer"
                 }
             }
         },
-        "pb.GetAllPaymentTakeOffChartResponse": {
+        "pb.GetPeriodHistoryResponse": {
             "type": "object",
             "properties": {
-                "chartResponse": {
+                "histories": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/pb.AbsTakeOfChartResponse"
+                        "$ref": "#/definitions/pb.AbsPeriodHistory"
                     }
                 }
             }
         },
-        "pb.GetAllPaymentTakeOffResponse": {
+        "pb.GetProfitAndLossResponse": {
             "type": "object",
             "properties": {
-                "pennies": {
+                "breakdown": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/pb.AbsPaymentTakeOff"
+                        "$ref": "#/definitions/pb.PnlBreakdown"
+                    }
+                },
+                "groupBy": {
+                    "type": "string"
+                },
+                "months": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/pb.PnlMonth"
                     }
+                },
+                "total": {
+                    "$ref": "#/definitions/pb.PnlMonth"
                 }
             }
         },
-        "pb.GetAllPaymentsByMonthResponse": {
+        "pb.GetReconciliationSessionsResponse": {
             "type": "object",
             "properties": {
-                "payments": {
+                "sessions": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/pb.AbsGetAllPaymentsByMonthResponse"
+                        "$ref": "#/definitions/pb.ReconciliationSession"
                     }
+                },
+                "totalCount": {
+                    "type": "integer"
                 }
             }
         },
-        "pb.GetAllResponse": {
+        "pb.GetRecurringExpensesResponse": {
             "type": "object",
             "properties": {
-                "items": {
+                "recurringExpenses": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/pb.GetCompanyResponse"
+                        "$ref": "#/definitions/pb.AbsRecurringExpense"
                     }
-                },
-                "totalCount": {
-                    "type": "integer"
                 }
             }
         },
-        "pb.GetAllStudentPaymentsChartResponse": {
+        "pb.GetScheduleConflictOverridesResponse": {
             "type": "object",
             "properties": {
-                "cash": {
-                    "type": "string"
-                },
-                "click": {
-                    "type": "string"
-                },
-                "payme": {
-                    "type": "string"
-                },
-                "paymentsChart": {
+                "overrides": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/pb.AbsTakeOfChartResponse"
+                        "$ref": "#/definitions/pb.ScheduleConflictOverride"
                     }
+                }
+            }
+        },
+        "pb.GetSmsLogRequest": {
+            "type": "object",
+            "properties": {
+                "pageRequest": {
+                    "$ref": "#/definitions/pb.PageRequest"
                 },
-                "totalRevenue": {
+                "studentId": {
                     "type": "string"
                 }
             }
         },
-        "pb.GetAllStudentPaymentsRequest": {
+        "pb.GetSmsLogResponse": {
             "type": "object",
             "properties": {
-                "filters": {
+                "datas": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/pb.Filters"
+                        "$ref": "#/definitions/pb.SmsLogList"
                     }
                 },
-                "from": {
+                "smsCount": {
+                    "type": "integer"
+                }
+            }
+        },
+        "pb.GetSmsTemplateRequest": {
+            "type": "object",
+            "properties": {
+                "smsType": {
                     "type": "string"
-                },
-                "page": {
-                    "$ref": "#/definitions/pb.PageRequest"
-                },
-                "sorts": {
+                }
+            }
+        },
+        "pb.GetSmsTemplateResponse": {
+            "type": "object",
+            "properties": {
+                "datas": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/pb.SortBy"
+                        "$ref": "#/definitions/pb.SmsTemplateList"
                     }
                 },
-                "to": {
+                "insufficientBalanceResendCount": {
+                    "type": "integer"
+                },
+                "smsType": {
                     "type": "string"
                 }
             }
         },
-        "pb.GetAllStudentPaymentsResponse": {
+        "pb.GetSmsTransactionDetailResponse": {
             "type": "object",
             "properties": {
-                "payments": {
+                "datas": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/pb.AbsStudentPayments"
+                        "$ref": "#/definitions/pb.GetSmsTransactionList"
                     }
                 }
             }
         },
-        "pb.GetAllStudentRequest": {
+        "pb.GetSmsTransactionList": {
             "type": "object",
             "properties": {
-                "condition": {
-                    "type": "string"
-                },
-                "courseId": {
-                    "type": "string"
-                },
-                "nameOrSurname": {
+                "comment": {
                     "type": "string"
                 },
-                "page": {
-                    "type": "string"
+                "smsCount": {
+                    "type": "integer"
                 },
-                "size": {
-                    "type": "string"
+                "sum": {
+                    "type": "integer"
                 },
-                "trainingDatesEnd": {
+                "transactionId": {
+                    "type": "integer"
+                }
+            }
+        },
+        "pb.GetStatisticRequest": {
+            "type": "object",
+            "properties": {
+                "from": {
                     "type": "string"
                 },
-                "trainingDatesStart": {
+                "to": {
                     "type": "string"
                 }
             }
         },
-        "pb.GetAllStudentResponse": {
+        "pb.GetStatisticResponse": {
             "type": "object",
             "properties": {
-                "response": {
+                "details": {
+                    "$ref": "#/definitions/pb.CompanyCommonDetails"
+                },
+                "paymentDetails": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/pb.GetGroupsAbsForStudent"
+                        "$ref": "#/definitions/pb.OtherDetails"
                     }
                 },
-                "totalCount": {
-                    "type": "integer"
+                "registerDetails": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/pb.OtherDetails"
+                    }
                 }
             }
         },
-        "pb.GetAllStuffResponse": {
+        "pb.GetStudentChargesResponse": {
             "type": "object",
             "properties": {
-                "stuff": {
+                "charges": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/pb.GetUserByIdResponse"
+                        "$ref": "#/definitions/pb.AbsStudentCharge"
                     }
                 }
             }
         },
-        "pb.GetAttendanceRequest": {
+        "pb.GetTeacherPayslipsResponse": {
             "type": "object",
             "properties": {
-                "actionId": {
-                    "type": "string"
-                },
-                "actionRole": {
-                    "type": "string"
-                },
-                "from": {
-                    "type": "string"
-                },
-                "groupId": {
-                    "type": "string"
-                },
-                "till": {
-                    "type": "string"
-                },
-                "withOutdated": {
-                    "type": "boolean"
+                "payslips": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/pb.Payslip"
+                    }
                 }
             }
         },
-        "pb.GetAttendanceResponse": {
+        "pb.GetTeachersResponse": {
             "type": "object",
             "properties": {
-                "days": {
+                "teachers": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/pb.Day"
+                        "$ref": "#/definitions/pb.AbsTeacher"
                     }
-                },
-                "students": {
+                }
+            }
+        },
+        "pb.GetTeachersSalaryRequest": {
+            "type": "object",
+            "properties": {
+                "salaries": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/pb.Student"
+                        "$ref": "#/definitions/pb.AbsGetTeachersSalary"
                     }
                 }
             }
         },
-        "pb.GetCommonInformationResponse": {
+        "pb.GetTimetableResponse": {
             "type": "object",
             "properties": {
-                "debtorsCount": {
-                    "type": "integer"
-                },
-                "payInCurrentMonth": {
-                    "type": "integer"
+                "lessons": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/pb.TimetableLesson"
+                    }
                 }
             }
         },
-        "pb.GetCompanyResponse": {
+        "pb.GetUpdateCourseAbs": {
             "type": "object",
             "properties": {
-                "active_student_count": {
-                    "type": "integer"
-                },
-                "avatarUrl": {
-                    "type": "string"
-                },
-                "ceo_id": {
-                    "type": "string"
-                },
-                "companyPhone": {
-                    "type": "string"
-                },
-                "created_at": {
-                    "type": "string"
-                },
-                "discount_id": {
-                    "type": "string"
-                },
-                "endTime": {
-                    "type": "string"
-                },
-                "id": {
-                    "type": "string"
-                },
-                "is_demo": {
-                    "type": "boolean"
-                },
-                "startTime": {
-                    "type": "string"
-                },
-                "subdomain": {
-                    "type": "string"
-                },
-                "tariff": {
-                    "$ref": "#/definitions/pb.Tariff"
-                },
-                "title": {
-                    "type": "string"
-                },
-                "valid_date": {
-                    "type": "string"
+                "courses": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/pb.AbsCourse"
+                    }
                 }
             }
         },
-        "pb.GetGroupAbsResponse": {
+        "pb.GetUpdateGroupAbs": {
             "type": "object",
             "properties": {
-                "course": {
-                    "$ref": "#/definitions/pb.AbsCourse"
-                },
-                "createdAt": {
+                "actionById": {
                     "type": "string"
                 },
-                "dateType": {
+                "actionByName": {
                     "type": "string"
                 },
+                "courseId": {
+                    "type": "integer"
+                },
                 "days": {
                     "type": "array",
                     "items": {
                         "type": "string"
                     }
                 },
-                "endDate": {
+                "force": {
+                    "description": "saves the group although its room or teacher is already booked, the override is audited",
+                    "type": "boolean"
+                },
+                "groupEndDate": {
                     "type": "string"
                 },
-                "id": {
+                "groupStartDate": {
                     "type": "string"
                 },
-                "isArchived": {
-                    "type": "boolean"
+                "id": {
+                    "type": "string"
                 },
                 "lessonStartTime": {
                     "type": "string"
@@ -7212,760 +15664,895 @@ const docTemplate = `{
                 "name": {
                     "type": "string"
                 },
-                "room": {
-                    "$ref": "#/definitions/pb.AbsRoom"
-                },
-                "startDate": {
-                    "type": "string"
-                },
-                "studentCount": {
+                "roomId": {
                     "type": "integer"
                 },
                 "teacherId": {
                     "type": "string"
                 },
-                "teacherName": {
-                    "type": "string"
-                }
-            }
-        },
-        "pb.GetGroupByCourseAbsResponse": {
-            "type": "object",
-            "properties": {
-                "dateType": {
-                    "type": "string"
-                },
-                "groupEndDate": {
-                    "type": "string"
-                },
-                "groupName": {
-                    "type": "string"
-                },
-                "groupStartDate": {
-                    "type": "string"
-                },
-                "id": {
-                    "type": "string"
-                },
-                "lessonStartTime": {
-                    "type": "string"
-                },
-                "teacherName": {
+                "type": {
                     "type": "string"
                 }
             }
         },
-        "pb.GetGroupByTeacherAbs": {
+        "pb.GetUpdateRoomAbs": {
             "type": "object",
             "properties": {
-                "activeStudentCount": {
-                    "type": "integer"
-                },
-                "courseName": {
-                    "type": "string"
-                },
-                "dayType": {
-                    "type": "string"
-                },
-                "groupEndAt": {
-                    "type": "string"
-                },
-                "groupStartAt": {
-                    "type": "string"
-                },
-                "id": {
-                    "type": "string"
-                },
-                "lessonStartTime": {
-                    "type": "string"
-                },
-                "name": {
-                    "type": "string"
-                },
-                "roomName": {
-                    "type": "string"
-                },
-                "students": {
+                "rooms": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/pb.AbsStudent"
+                        "$ref": "#/definitions/pb.AbsRoom"
                     }
                 }
             }
         },
-        "pb.GetGroupsAbsForStudent": {
+        "pb.GetUserByIdResponse": {
             "type": "object",
             "properties": {
-                "additionalContact": {
-                    "type": "string"
-                },
-                "address": {
+                "birthDate": {
                     "type": "string"
                 },
-                "balance": {
-                    "type": "number"
-                },
-                "condition": {
-                    "type": "string"
+                "companyId": {
+                    "type": "integer"
                 },
                 "createdAt": {
                     "type": "string"
                 },
-                "dateOfBirth": {
-                    "type": "string"
-                },
                 "gender": {
                     "type": "boolean"
                 },
-                "groups": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/pb.GroupGetAllStudentAbs"
-                    }
-                },
+                "has_access_finance": {
+                    "type": "boolean"
+                },
                 "id": {
                     "type": "string"
                 },
-                "name": {
-                    "type": "string"
+                "is_deleted": {
+                    "type": "boolean"
                 },
-                "passportId": {
+                "name": {
                     "type": "string"
                 },
-                "phone": {
+                "phoneNumber": {
                     "type": "string"
                 },
-                "telegramUsername": {
+                "role": {
                     "type": "string"
                 }
             }
         },
-        "pb.GetGroupsByCourseResponse": {
+        "pb.GetVendorSpendHistoryResponse": {
             "type": "object",
             "properties": {
-                "groups": {
+                "months": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/pb.GetGroupByCourseAbsResponse"
+                        "$ref": "#/definitions/pb.VendorMonthSpend"
                     }
+                },
+                "vendor": {
+                    "$ref": "#/definitions/pb.AbsVendor"
                 }
             }
         },
-        "pb.GetGroupsByTeacherResponse": {
+        "pb.GetVendorsResponse": {
             "type": "object",
             "properties": {
-                "groups": {
+                "vendors": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/pb.GetGroupByTeacherAbs"
+                        "$ref": "#/definitions/pb.AbsVendor"
                     }
                 }
             }
         },
-        "pb.GetGroupsRequest": {
+        "pb.GroupAttendanceMark": {
             "type": "object",
             "properties": {
-                "courseId": {
-                    "type": "integer"
-                },
-                "dateType": {
+                "makeupDate": {
                     "type": "string"
                 },
-                "endDate": {
+                "makeupGroupId": {
+                    "description": "the absence a makeup status makes up, a student making up an absence need not be in the group",
                     "type": "string"
                 },
-                "isArchived": {
-                    "type": "boolean"
+                "status": {
+                    "description": "status as in SetAttendanceRequest, -1 deletes the attendance",
+                    "type": "integer"
                 },
-                "orderBy": {
+                "studentId": {
                     "type": "string"
-                },
-                "orderDirection": {
+                }
+            }
+        },
+        "pb.GroupAttendanceResult": {
+            "type": "object",
+            "properties": {
+                "message": {
                     "type": "string"
                 },
-                "page": {
-                    "$ref": "#/definitions/pb.PageRequest"
+                "status": {
+                    "type": "integer"
                 },
-                "startDate": {
+                "studentId": {
                     "type": "string"
                 },
-                "teacherId": {
-                    "type": "string"
+                "success": {
+                    "type": "boolean"
                 }
             }
         },
-        "pb.GetGroupsResponse": {
+        "pb.GroupGetAllStudentAbs": {
             "type": "object",
             "properties": {
-                "groups": {
+                "course": {
+                    "$ref": "#/definitions/pb.AbsCourse"
+                },
+                "days": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/pb.GetGroupAbsResponse"
+                        "type": "string"
                     }
                 },
-                "totalPageCount": {
+                "groupEndDate": {
+                    "type": "string"
+                },
+                "groupStartDate": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "lessonStartTime": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "roomId": {
                     "type": "integer"
+                },
+                "studentActivatedAt": {
+                    "type": "string"
+                },
+                "studentCondition": {
+                    "type": "string"
+                },
+                "teacherName": {
+                    "type": "string"
+                },
+                "type": {
+                    "type": "string"
                 }
             }
         },
-        "pb.GetHistoryByUserIdResponse": {
+        "pb.InstallmentInput": {
             "type": "object",
             "properties": {
-                "histories": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/pb.AbsGetHistoryByUserIdResponse"
-                    }
+                "amount": {
+                    "type": "string"
+                },
+                "dueDate": {
+                    "type": "string"
                 }
             }
         },
-        "pb.GetHistoryDiscountResponse": {
+        "pb.Lead": {
             "type": "object",
             "properties": {
-                "discounts": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/pb.AbsHistoryDiscount"
-                    }
+                "comment": {
+                    "type": "string"
+                },
+                "createdAt": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "phoneNumber": {
+                    "type": "string"
                 }
             }
         },
-        "pb.GetHistoryGroupResponse": {
+        "pb.LeadCommonRequest": {
             "type": "object",
             "properties": {
-                "groupHistory": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/pb.AbsHistory"
-                    }
+                "id": {
+                    "type": "string"
                 },
-                "studentsHistory": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/pb.AbsStudentHistory"
-                    }
+                "type": {
+                    "type": "string"
                 }
             }
         },
-        "pb.GetHistoryStudentResponse": {
+        "pb.LessonCheckIn": {
             "type": "object",
             "properties": {
-                "conditionsHistory": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/pb.AbsStudentHistory"
-                    }
+                "checkedInAt": {
+                    "type": "string"
                 },
-                "studentHistory": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/pb.AbsHistory"
-                    }
+                "confirmed": {
+                    "type": "boolean"
+                },
+                "device": {
+                    "type": "string"
+                },
+                "studentId": {
+                    "type": "string"
+                },
+                "studentName": {
+                    "type": "string"
                 }
             }
         },
-        "pb.GetInformationDiscountResponse": {
+        "pb.LoginRequest": {
             "type": "object",
             "properties": {
-                "discounts": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/pb.AbsStudentDiscount"
-                    }
+                "companyId": {
+                    "type": "string"
+                },
+                "password": {
+                    "type": "string"
+                },
+                "phoneNumber": {
+                    "type": "string"
                 }
             }
         },
-        "pb.GetLeadCommonRequest": {
+        "pb.LoginResponse": {
             "type": "object",
             "properties": {
-                "requests": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/pb.LeadCommonRequest"
-                    }
+                "isOk": {
+                    "type": "boolean"
+                },
+                "token": {
+                    "type": "string"
+                },
+                "user": {
+                    "$ref": "#/definitions/pb.GetUserByIdResponse"
                 }
             }
         },
-        "pb.GetLeadCommonResponse": {
+        "pb.MarkNotificationsReadRequest": {
             "type": "object",
             "properties": {
-                "expectations": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/pb.Section"
-                    }
+                "actionById": {
+                    "type": "string"
                 },
-                "leads": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/pb.Section"
-                    }
+                "actionByRole": {
+                    "type": "string"
                 },
-                "sets": {
+                "ids": {
+                    "description": "every notification of the user when empty",
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/pb.Section"
+                        "type": "string"
                     }
                 }
             }
         },
-        "pb.GetLeadListResponse": {
+        "pb.MoveWaitlistEntryRequest": {
             "type": "object",
             "properties": {
-                "sections": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/pb.DynamicSection"
-                    }
+                "id": {
+                    "type": "string"
+                },
+                "position": {
+                    "description": "new place among the WAITING students, starting from 1",
+                    "type": "integer"
                 }
             }
         },
-        "pb.GetLeftAfterTrialPeriodResponse": {
+        "pb.Notification": {
             "type": "object",
             "properties": {
-                "items": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/pb.AbsGetLeftAfter"
-                    }
+                "body": {
+                    "type": "string"
                 },
-                "totalItemCount": {
+                "createdAt": {
+                    "type": "string"
+                },
+                "groupId": {
                     "type": "integer"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "read": {
+                    "type": "boolean"
+                },
+                "studentId": {
+                    "type": "string"
+                },
+                "title": {
+                    "type": "string"
+                },
+                "type": {
+                    "description": "AT_RISK_STUDENT",
+                    "type": "string"
                 }
             }
         },
-        "pb.GetMonthlyStatusResponse": {
+        "pb.OneCAccountMapping": {
             "type": "object",
             "properties": {
-                "monthStatus": {
+                "account": {
+                    "type": "string"
+                },
+                "sourceKey": {
+                    "type": "string"
+                },
+                "sourceType": {
+                    "type": "string"
+                }
+            }
+        },
+        "pb.OneCSetting": {
+            "type": "object",
+            "properties": {
+                "bankName": {
+                    "type": "string"
+                },
+                "bik": {
+                    "type": "string"
+                },
+                "inn": {
+                    "type": "string"
+                },
+                "mappings": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/pb.AbsGetMonthlyStatusResponse"
+                        "$ref": "#/definitions/pb.OneCAccountMapping"
                     }
+                },
+                "organizationName": {
+                    "type": "string"
                 }
             }
         },
-        "pb.GetNotesByStudent": {
+        "pb.OtherDetails": {
             "type": "object",
             "properties": {
-                "notes": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/pb.AbsNote"
+                "details": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
                     }
                 }
             }
         },
-        "pb.GetSmsLogRequest": {
+        "pb.OverdueBucket": {
             "type": "object",
             "properties": {
-                "pageRequest": {
-                    "$ref": "#/definitions/pb.PageRequest"
+                "amount": {
+                    "type": "string"
                 },
-                "studentId": {
+                "bucket": {
                     "type": "string"
+                },
+                "count": {
+                    "type": "integer"
                 }
             }
         },
-        "pb.GetSmsLogResponse": {
+        "pb.PageRequest": {
             "type": "object",
             "properties": {
-                "datas": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/pb.SmsLogList"
-                    }
+                "companyId": {
+                    "type": "string"
                 },
-                "smsCount": {
+                "filter": {
+                    "type": "string"
+                },
+                "from": {
+                    "type": "string"
+                },
+                "page": {
+                    "type": "integer"
+                },
+                "size": {
                     "type": "integer"
+                },
+                "to": {
+                    "type": "string"
                 }
             }
         },
-        "pb.GetSmsTemplateRequest": {
+        "pb.PayPayrollRunRequest": {
             "type": "object",
             "properties": {
-                "smsType": {
+                "actionById": {
+                    "type": "string"
+                },
+                "actionByName": {
+                    "type": "string"
+                },
+                "givenDate": {
+                    "type": "string"
+                },
+                "paymentMethod": {
+                    "type": "string"
+                },
+                "runId": {
                     "type": "string"
                 }
             }
         },
-        "pb.GetSmsTemplateResponse": {
+        "pb.PaymentAddRequest": {
             "type": "object",
             "properties": {
-                "datas": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/pb.SmsTemplateList"
-                    }
+                "actionById": {
+                    "type": "string"
                 },
-                "insufficientBalanceResendCount": {
-                    "type": "integer"
+                "actionByName": {
+                    "type": "string"
                 },
-                "smsType": {
+                "comment": {
+                    "type": "string"
+                },
+                "date": {
+                    "type": "string"
+                },
+                "groupId": {
+                    "type": "string"
+                },
+                "method": {
+                    "type": "string"
+                },
+                "sum": {
+                    "type": "string"
+                },
+                "type": {
+                    "type": "string"
+                },
+                "userId": {
                     "type": "string"
                 }
             }
         },
-        "pb.GetSmsTransactionDetailResponse": {
+        "pb.PaymentReturnRequest": {
             "type": "object",
             "properties": {
-                "datas": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/pb.GetSmsTransactionList"
-                    }
+                "actionById": {
+                    "type": "string"
+                },
+                "actionByName": {
+                    "type": "string"
+                },
+                "paymentId": {
+                    "type": "string"
                 }
             }
         },
-        "pb.GetSmsTransactionList": {
+        "pb.PaymentUpdateRequest": {
             "type": "object",
             "properties": {
+                "actionById": {
+                    "type": "string"
+                },
+                "actionByName": {
+                    "type": "string"
+                },
                 "comment": {
                     "type": "string"
                 },
-                "smsCount": {
-                    "type": "integer"
+                "date": {
+                    "type": "string"
                 },
-                "sum": {
-                    "type": "integer"
+                "debit": {
+                    "type": "string"
                 },
-                "transactionId": {
-                    "type": "integer"
-                }
-            }
-        },
-        "pb.GetStatisticRequest": {
-            "type": "object",
-            "properties": {
-                "from": {
+                "groupId": {
                     "type": "string"
                 },
-                "to": {
+                "method": {
+                    "type": "string"
+                },
+                "paymentId": {
+                    "type": "string"
+                },
+                "userId": {
                     "type": "string"
                 }
             }
         },
-        "pb.GetStatisticResponse": {
+        "pb.Payslip": {
             "type": "object",
             "properties": {
-                "details": {
-                    "$ref": "#/definitions/pb.CompanyCommonDetails"
-                },
-                "paymentDetails": {
+                "adjustments": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/pb.OtherDetails"
+                        "$ref": "#/definitions/pb.AbsPayrollAdjustment"
                     }
                 },
-                "registerDetails": {
+                "groups": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/pb.OtherDetails"
+                        "$ref": "#/definitions/pb.PayslipGroup"
                     }
+                },
+                "item": {
+                    "$ref": "#/definitions/pb.AbsPayrollItem"
+                },
+                "period": {
+                    "type": "string"
+                },
+                "runId": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string"
                 }
             }
         },
-        "pb.GetTeachersResponse": {
+        "pb.PayslipGroup": {
             "type": "object",
             "properties": {
-                "teachers": {
+                "amount": {
+                    "type": "string"
+                },
+                "commonLessonCount": {
+                    "type": "integer"
+                },
+                "groupId": {
+                    "type": "string"
+                },
+                "groupName": {
+                    "type": "string"
+                },
+                "students": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/pb.AbsTeacher"
+                        "$ref": "#/definitions/pb.PayslipStudent"
                     }
                 }
             }
         },
-        "pb.GetTeachersSalaryRequest": {
+        "pb.PayslipStudent": {
             "type": "object",
             "properties": {
-                "salaries": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/pb.AbsGetTeachersSalary"
-                    }
+                "amount": {
+                    "type": "string"
+                },
+                "passedLessonCount": {
+                    "type": "integer"
+                },
+                "priceType": {
+                    "type": "string"
+                },
+                "studentId": {
+                    "type": "string"
+                },
+                "studentName": {
+                    "type": "string"
                 }
             }
         },
-        "pb.GetUpdateCourseAbs": {
+        "pb.PnlBreakdown": {
             "type": "object",
             "properties": {
-                "courses": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/pb.AbsCourse"
-                    }
+                "discounts": {
+                    "type": "number"
+                },
+                "grossProfit": {
+                    "type": "number"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "period": {
+                    "type": "string"
+                },
+                "revenue": {
+                    "type": "number"
+                },
+                "teacherCost": {
+                    "type": "number"
                 }
             }
         },
-        "pb.GetUpdateGroupAbs": {
+        "pb.PnlMonth": {
             "type": "object",
             "properties": {
-                "courseId": {
+                "discounts": {
+                    "type": "number"
+                },
+                "expenses": {
+                    "type": "number"
+                },
+                "grossProfit": {
+                    "type": "number"
+                },
+                "netProfit": {
+                    "type": "number"
+                },
+                "payments": {
+                    "type": "number"
+                },
+                "period": {
+                    "type": "string"
+                },
+                "revenue": {
+                    "type": "number"
+                },
+                "teacherCost": {
+                    "type": "number"
+                }
+            }
+        },
+        "pb.ReconciliationLine": {
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "type": "number"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "lineNo": {
                     "type": "integer"
                 },
-                "days": {
-                    "type": "array",
-                    "items": {
-                        "type": "string"
-                    }
+                "note": {
+                    "type": "string"
                 },
-                "groupEndDate": {
+                "paidAt": {
                     "type": "string"
                 },
-                "groupStartDate": {
+                "payerName": {
                     "type": "string"
                 },
-                "id": {
+                "paymentId": {
                     "type": "string"
                 },
-                "lessonStartTime": {
+                "phone": {
                     "type": "string"
                 },
-                "name": {
+                "status": {
+                    "description": "MATCHED, UNMATCHED, SUSPICIOUS, CREATED or IGNORED",
                     "type": "string"
                 },
-                "roomId": {
-                    "type": "integer"
+                "studentId": {
+                    "type": "string"
                 },
-                "teacherId": {
+                "studentName": {
                     "type": "string"
                 },
-                "type": {
+                "transactionId": {
                     "type": "string"
                 }
             }
         },
-        "pb.GetUpdateRoomAbs": {
-            "type": "object",
-            "properties": {
-                "rooms": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/pb.AbsRoom"
-                    }
-                }
-            }
-        },
-        "pb.GetUserByIdResponse": {
+        "pb.ReconciliationLineResult": {
             "type": "object",
             "properties": {
-                "birthDate": {
+                "lineId": {
                     "type": "string"
                 },
-                "companyId": {
-                    "type": "integer"
-                },
-                "createdAt": {
+                "message": {
                     "type": "string"
                 },
-                "gender": {
-                    "type": "boolean"
-                },
-                "has_access_finance": {
-                    "type": "boolean"
-                },
-                "id": {
+                "paymentId": {
                     "type": "string"
                 },
-                "is_deleted": {
+                "success": {
                     "type": "boolean"
-                },
-                "name": {
+                }
+            }
+        },
+        "pb.ReconciliationLineStudent": {
+            "type": "object",
+            "properties": {
+                "groupId": {
                     "type": "string"
                 },
-                "phoneNumber": {
+                "lineId": {
                     "type": "string"
                 },
-                "role": {
+                "studentId": {
                     "type": "string"
                 }
             }
         },
-        "pb.GroupGetAllStudentAbs": {
+        "pb.ReconciliationSession": {
             "type": "object",
             "properties": {
-                "course": {
-                    "$ref": "#/definitions/pb.AbsCourse"
-                },
-                "days": {
-                    "type": "array",
-                    "items": {
-                        "type": "string"
-                    }
-                },
-                "groupEndDate": {
+                "createdAt": {
                     "type": "string"
                 },
-                "groupStartDate": {
+                "createdByName": {
                     "type": "string"
                 },
-                "id": {
-                    "type": "string"
+                "createdCount": {
+                    "type": "integer"
                 },
-                "lessonStartTime": {
+                "fileName": {
                     "type": "string"
                 },
-                "name": {
+                "id": {
                     "type": "string"
                 },
-                "roomId": {
+                "lines": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/pb.ReconciliationLine"
+                    }
+                },
+                "matchedCount": {
                     "type": "integer"
                 },
-                "studentActivatedAt": {
+                "method": {
                     "type": "string"
                 },
-                "studentCondition": {
+                "source": {
                     "type": "string"
                 },
-                "teacherName": {
-                    "type": "string"
+                "suspiciousCount": {
+                    "type": "integer"
                 },
-                "type": {
-                    "type": "string"
+                "totalAmount": {
+                    "type": "number"
+                },
+                "totalCount": {
+                    "type": "integer"
+                },
+                "unmatchedCount": {
+                    "type": "integer"
                 }
             }
         },
-        "pb.Lead": {
+        "pb.RequestAttendanceUnlockRequest": {
             "type": "object",
             "properties": {
-                "comment": {
+                "actionById": {
                     "type": "string"
                 },
-                "createdAt": {
+                "actionByName": {
                     "type": "string"
                 },
-                "id": {
+                "attendDate": {
+                    "description": "yyyy-MM-dd date the teacher can no longer mark",
                     "type": "string"
                 },
-                "name": {
+                "groupId": {
                     "type": "string"
                 },
-                "phoneNumber": {
+                "reason": {
                     "type": "string"
                 }
             }
         },
-        "pb.LeadCommonRequest": {
+        "pb.ResolveReconciliationLineRequest": {
             "type": "object",
             "properties": {
-                "id": {
+                "lineId": {
                     "type": "string"
                 },
-                "type": {
+                "paymentId": {
+                    "type": "string"
+                },
+                "status": {
+                    "description": "MATCHED links the line to paymentId, IGNORED drops it from the unmatched list",
                     "type": "string"
                 }
             }
         },
-        "pb.LoginRequest": {
+        "pb.ReviewAttendanceUnlockRequest": {
             "type": "object",
             "properties": {
-                "companyId": {
+                "actionById": {
                     "type": "string"
                 },
-                "password": {
+                "actionByName": {
                     "type": "string"
                 },
-                "phoneNumber": {
-                    "type": "string"
-                }
-            }
-        },
-        "pb.LoginResponse": {
-            "type": "object",
-            "properties": {
-                "isOk": {
+                "approve": {
                     "type": "boolean"
                 },
-                "token": {
-                    "type": "string"
+                "hours": {
+                    "description": "hours the date stays open after approval, 24 when 0",
+                    "type": "integer"
                 },
-                "user": {
-                    "$ref": "#/definitions/pb.GetUserByIdResponse"
+                "id": {
+                    "type": "string"
                 }
             }
         },
-        "pb.OtherDetails": {
+        "pb.ScheduleConflict": {
             "type": "object",
             "properties": {
-                "details": {
-                    "type": "object",
-                    "additionalProperties": {
+                "days": {
+                    "description": "weekdays both groups have lessons on, the transfer date for a transferred lesson",
+                    "type": "array",
+                    "items": {
                         "type": "string"
                     }
-                }
-            }
-        },
-        "pb.PageRequest": {
-            "type": "object",
-            "properties": {
-                "companyId": {
-                    "type": "string"
                 },
-                "filter": {
+                "endTime": {
                     "type": "string"
                 },
-                "from": {
+                "fromDate": {
+                    "description": "period both groups run in",
                     "type": "string"
                 },
-                "page": {
+                "groupId": {
                     "type": "integer"
                 },
-                "size": {
+                "groupName": {
+                    "type": "string"
+                },
+                "kind": {
+                    "description": "ROOM when the group uses the same room, TEACHER when it has the same teacher",
+                    "type": "string"
+                },
+                "roomId": {
                     "type": "integer"
                 },
-                "to": {
+                "startTime": {
+                    "description": "lesson time of the conflicting group, the end is the start plus courses.duration_lesson minutes",
+                    "type": "string"
+                },
+                "teacherId": {
+                    "type": "string"
+                },
+                "tillDate": {
                     "type": "string"
                 }
             }
         },
-        "pb.PaymentAddRequest": {
+        "pb.ScheduleConflictOverride": {
             "type": "object",
             "properties": {
-                "actionById": {
-                    "type": "string"
-                },
-                "actionByName": {
+                "action": {
+                    "description": "CREATE_GROUP, UPDATE_GROUP or TRANSFER_LESSON",
                     "type": "string"
                 },
-                "comment": {
-                    "type": "string"
+                "conflicts": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/pb.ScheduleConflict"
+                    }
                 },
-                "date": {
+                "createdAt": {
                     "type": "string"
                 },
-                "groupId": {
+                "forcedById": {
                     "type": "string"
                 },
-                "method": {
+                "forcedByName": {
                     "type": "string"
                 },
-                "sum": {
-                    "type": "string"
+                "groupId": {
+                    "type": "integer"
                 },
-                "type": {
+                "groupName": {
                     "type": "string"
                 },
-                "userId": {
+                "id": {
                     "type": "string"
                 }
             }
         },
-        "pb.PaymentReturnRequest": {
+        "pb.ScheduleConflicts": {
             "type": "object",
             "properties": {
-                "actionById": {
-                    "type": "string"
-                },
-                "actionByName": {
-                    "type": "string"
-                },
-                "paymentId": {
-                    "type": "string"
+                "conflicts": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/pb.ScheduleConflict"
+                    }
                 }
             }
         },
-        "pb.PaymentUpdateRequest": {
+        "pb.ScheduleCoursePriceRequest": {
             "type": "object",
             "properties": {
                 "actionById": {
@@ -7974,26 +16561,19 @@ const docTemplate = `{
                 "actionByName": {
                     "type": "string"
                 },
-                "comment": {
-                    "type": "string"
-                },
-                "date": {
-                    "type": "string"
-                },
-                "debit": {
-                    "type": "string"
-                },
-                "groupId": {
+                "courseId": {
                     "type": "string"
                 },
-                "method": {
+                "effectiveFrom": {
+                    "description": "yyyy-MM-dd, the price is used for lessons and billing from this date, today when empty",
                     "type": "string"
                 },
-                "paymentId": {
-                    "type": "string"
+                "grandfatherExisting": {
+                    "description": "keeps students enrolled before effectiveFrom on the price they pay now",
+                    "type": "boolean"
                 },
-                "userId": {
-                    "type": "string"
+                "price": {
+                    "type": "number"
                 }
             }
         },
@@ -8031,6 +16611,33 @@ const docTemplate = `{
                 }
             }
         },
+        "pb.SellLessonPackageRequest": {
+            "type": "object",
+            "properties": {
+                "actionById": {
+                    "type": "string"
+                },
+                "actionByName": {
+                    "type": "string"
+                },
+                "date": {
+                    "type": "string"
+                },
+                "groupId": {
+                    "type": "string"
+                },
+                "lessons": {
+                    "description": "lessons and price default to the course package when zero",
+                    "type": "integer"
+                },
+                "price": {
+                    "type": "number"
+                },
+                "studentId": {
+                    "type": "string"
+                }
+            }
+        },
         "pb.SendSmsDirectlyRequest": {
             "type": "object",
             "properties": {
@@ -8046,7 +16653,19 @@ const docTemplate = `{
                 "smsValue": {
                     "type": "string"
                 },
-                "studentId": {
+                "studentId": {
+                    "type": "string"
+                }
+            }
+        },
+        "pb.SendStudentLoginCodeRequest": {
+            "type": "object",
+            "properties": {
+                "phone": {
+                    "type": "string"
+                },
+                "subdomain": {
+                    "description": "subdomain of the company the student studies at",
                     "type": "string"
                 }
             }
@@ -8066,6 +16685,13 @@ const docTemplate = `{
                 "groupId": {
                     "type": "string"
                 },
+                "makeupDate": {
+                    "type": "string"
+                },
+                "makeupGroupId": {
+                    "description": "group and yyyy-MM-dd date of the absence a makeup status makes up",
+                    "type": "string"
+                },
                 "status": {
                     "type": "integer"
                 },
@@ -8077,6 +16703,17 @@ const docTemplate = `{
                 }
             }
         },
+        "pb.SetCategoryBudgetRequest": {
+            "type": "object",
+            "properties": {
+                "categoryId": {
+                    "type": "string"
+                },
+                "monthlyBudget": {
+                    "type": "number"
+                }
+            }
+        },
         "pb.SetDataResponse": {
             "type": "object",
             "properties": {
@@ -8109,6 +16746,68 @@ const docTemplate = `{
                 }
             }
         },
+        "pb.SetEnrollmentPriceLockRequest": {
+            "type": "object",
+            "properties": {
+                "groupId": {
+                    "type": "string"
+                },
+                "lockedFrom": {
+                    "description": "yyyy-MM-dd, today when empty",
+                    "type": "string"
+                },
+                "price": {
+                    "description": "0 removes the lock so the enrollment follows the course price again",
+                    "type": "number"
+                },
+                "studentId": {
+                    "type": "string"
+                }
+            }
+        },
+        "pb.SetGroupAttendanceRequest": {
+            "type": "object",
+            "properties": {
+                "actionById": {
+                    "type": "string"
+                },
+                "actionByRole": {
+                    "type": "string"
+                },
+                "attendDate": {
+                    "type": "string"
+                },
+                "groupId": {
+                    "type": "string"
+                },
+                "marks": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/pb.GroupAttendanceMark"
+                    }
+                },
+                "teacherId": {
+                    "type": "string"
+                }
+            }
+        },
+        "pb.SetGroupAttendanceResponse": {
+            "type": "object",
+            "properties": {
+                "failed": {
+                    "type": "integer"
+                },
+                "results": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/pb.GroupAttendanceResult"
+                    }
+                },
+                "succeeded": {
+                    "type": "integer"
+                }
+            }
+        },
         "pb.SetSmsTemplateRequest": {
             "type": "object",
             "properties": {
@@ -8254,6 +16953,27 @@ const docTemplate = `{
                 }
             }
         },
+        "pb.StudentSession": {
+            "type": "object",
+            "properties": {
+                "companyId": {
+                    "type": "string"
+                },
+                "expiresAt": {
+                    "type": "string"
+                },
+                "studentId": {
+                    "type": "string"
+                },
+                "studentName": {
+                    "type": "string"
+                },
+                "token": {
+                    "description": "bearer token of the student, only returned when the student logs in",
+                    "type": "string"
+                }
+            }
+        },
         "pb.Tariff": {
             "type": "object",
             "properties": {
@@ -8294,9 +17014,66 @@ const docTemplate = `{
                 }
             }
         },
+        "pb.TimetableLesson": {
+            "type": "object",
+            "properties": {
+                "courseId": {
+                    "type": "integer"
+                },
+                "courseName": {
+                    "type": "string"
+                },
+                "date": {
+                    "description": "yyyy-MM-dd",
+                    "type": "string"
+                },
+                "endTime": {
+                    "type": "string"
+                },
+                "groupId": {
+                    "type": "integer"
+                },
+                "groupName": {
+                    "type": "string"
+                },
+                "realDate": {
+                    "type": "string"
+                },
+                "roomId": {
+                    "type": "integer"
+                },
+                "roomName": {
+                    "type": "string"
+                },
+                "startTime": {
+                    "description": "HH:mm, endTime adds the lesson duration of the course",
+                    "type": "string"
+                },
+                "teacherId": {
+                    "type": "string"
+                },
+                "teacherName": {
+                    "type": "string"
+                },
+                "transferred": {
+                    "description": "realDate is the date the lesson was moved from when transferred is set",
+                    "type": "boolean"
+                }
+            }
+        },
         "pb.TransferLessonRequest": {
             "type": "object",
             "properties": {
+                "actionById": {
+                    "type": "string"
+                },
+                "actionByName": {
+                    "type": "string"
+                },
+                "force": {
+                    "description": "moves the lesson although the room or teacher is already booked on the new date, the override is audited",
+                    "type": "boolean"
+                },
                 "from": {
                     "type": "string"
                 },
@@ -8346,6 +17123,30 @@ const docTemplate = `{
                 }
             }
         },
+        "pb.UpdateGroupBillingModeRequest": {
+            "type": "object",
+            "properties": {
+                "billingMode": {
+                    "description": "MONTHLY, PER_LESSON or PACKAGE, empty uses the billing mode of the course",
+                    "type": "string"
+                },
+                "groupId": {
+                    "type": "string"
+                }
+            }
+        },
+        "pb.UpdateGroupMaxStudentsRequest": {
+            "type": "object",
+            "properties": {
+                "groupId": {
+                    "type": "string"
+                },
+                "maxStudents": {
+                    "description": "seats of the group, 0 uses the capacity of its room",
+                    "type": "integer"
+                }
+            }
+        },
         "pb.UpdateLeadDataRequest": {
             "type": "object",
             "properties": {
@@ -8369,6 +17170,39 @@ const docTemplate = `{
                 }
             }
         },
+        "pb.UpdateLessonRequest": {
+            "type": "object",
+            "properties": {
+                "actionById": {
+                    "type": "string"
+                },
+                "actionByRole": {
+                    "type": "string"
+                },
+                "homework": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "materials": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "notes": {
+                    "type": "string"
+                },
+                "substituteTeacherId": {
+                    "description": "empty gives the lesson back to the teacher of the group, teachers can not change it",
+                    "type": "string"
+                },
+                "topic": {
+                    "type": "string"
+                }
+            }
+        },
         "pb.UpdateSetRequest": {
             "type": "object",
             "properties": {
@@ -8456,6 +17290,87 @@ const docTemplate = `{
                 }
             }
         },
+        "pb.VendorMonthSpend": {
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "type": "number"
+                },
+                "expenseCount": {
+                    "type": "integer"
+                },
+                "month": {
+                    "type": "string"
+                }
+            }
+        },
+        "pb.VerifyStudentLoginCodeRequest": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "type": "string"
+                },
+                "device": {
+                    "type": "string"
+                },
+                "phone": {
+                    "type": "string"
+                },
+                "subdomain": {
+                    "type": "string"
+                }
+            }
+        },
+        "pb.VerifyStudentLoginCodeResponse": {
+            "type": "object",
+            "properties": {
+                "sessions": {
+                    "description": "one session for every student of the phone, siblings often share one",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/pb.StudentSession"
+                    }
+                }
+            }
+        },
+        "pb.WaitlistEntry": {
+            "type": "object",
+            "properties": {
+                "createdAt": {
+                    "type": "string"
+                },
+                "createdByName": {
+                    "type": "string"
+                },
+                "holdUntil": {
+                    "description": "an OFFERED seat is kept for the student until this time",
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "offeredAt": {
+                    "type": "string"
+                },
+                "position": {
+                    "description": "place among the WAITING students, 0 for the others",
+                    "type": "integer"
+                },
+                "status": {
+                    "description": "WAITING, OFFERED, ENROLLED, EXPIRED or CANCELLED",
+                    "type": "string"
+                },
+                "studentId": {
+                    "type": "string"
+                },
+                "studentName": {
+                    "type": "string"
+                },
+                "studentPhone": {
+                    "type": "string"
+                }
+            }
+        },
         "utils.AbsResponse": {
             "type": "object",
             "properties": {
@@ -8466,6 +17381,23 @@ const docTemplate = `{
                     "type": "integer"
                 }
             }
+        },
+        "utils.ScheduleConflictResponse": {
+            "type": "object",
+            "properties": {
+                "conflicts": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/pb.ScheduleConflict"
+                    }
+                },
+                "message": {
+                    "type": "string"
+                },
+                "statusCode": {
+                    "type": "integer"
+                }
+            }
         }
     },
     "securityDefinitions": {
@@ -1870,30 +1870,37 @@ func (x *GetCourseByIdRequest) GetId() string {
 	return ""
 }
 
-type GetLeftAfterTrialPeriodRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	From          string                 `protobuf:"bytes,1,opt,name=from,proto3" json:"from"`
-	To            string                 `protobuf:"bytes,2,opt,name=to,proto3" json:"to"`
-	Page          string                 `protobuf:"bytes,3,opt,name=page,proto3" json:"page"`
-	Size          string                 `protobuf:"bytes,4,opt,name=size,proto3" json:"size"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type CourseBilling struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	CourseId string                 `protobuf:"bytes,1,opt,name=courseId,proto3" json:"courseId"`
+	// MONTHLY takes courses.price every month, PER_LESSON charges lessonPrice per attended lesson,
+	// PACKAGE consumes a credit of a prepaid package per attended lesson
+	BillingMode    string  `protobuf:"bytes,2,opt,name=billingMode,proto3" json:"billingMode"`
+	LessonPrice    float64 `protobuf:"fixed64,3,opt,name=lessonPrice,proto3" json:"lessonPrice"`
+	PackageLessons int32   `protobuf:"varint,4,opt,name=packageLessons,proto3" json:"packageLessons"`
+	PackagePrice   float64 `protobuf:"fixed64,5,opt,name=packagePrice,proto3" json:"packagePrice"`
+	// days a sold package stays usable, 0 means it does not expire
+	PackageValidDays int32 `protobuf:"varint,6,opt,name=packageValidDays,proto3" json:"packageValidDays"`
+	// lessons left on a package that trigger the low credit sms
+	LowCreditLessons int32 `protobuf:"varint,7,opt,name=lowCreditLessons,proto3" json:"lowCreditLessons"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
-func (x *GetLeftAfterTrialPeriodRequest) Reset() {
-	*x = GetLeftAfterTrialPeriodRequest{}
+func (x *CourseBilling) Reset() {
+	*x = CourseBilling{}
 	mi := &file_education_proto_msgTypes[24]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetLeftAfterTrialPeriodRequest) String() string {
+func (x *CourseBilling) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetLeftAfterTrialPeriodRequest) ProtoMessage() {}
+func (*CourseBilling) ProtoMessage() {}
 
-func (x *GetLeftAfterTrialPeriodRequest) ProtoReflect() protoreflect.Message {
+func (x *CourseBilling) ProtoReflect() protoreflect.Message {
 	mi := &file_education_proto_msgTypes[24]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1905,61 +1912,88 @@ func (x *GetLeftAfterTrialPeriodRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetLeftAfterTrialPeriodRequest.ProtoReflect.Descriptor instead.
-func (*GetLeftAfterTrialPeriodRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use CourseBilling.ProtoReflect.Descriptor instead.
+func (*CourseBilling) Descriptor() ([]byte, []int) {
 	return file_education_proto_rawDescGZIP(), []int{24}
 }
 
-func (x *GetLeftAfterTrialPeriodRequest) GetFrom() string {
+func (x *CourseBilling) GetCourseId() string {
 	if x != nil {
-		return x.From
+		return x.CourseId
 	}
 	return ""
 }
 
-func (x *GetLeftAfterTrialPeriodRequest) GetTo() string {
+func (x *CourseBilling) GetBillingMode() string {
 	if x != nil {
-		return x.To
+		return x.BillingMode
 	}
 	return ""
 }
 
-func (x *GetLeftAfterTrialPeriodRequest) GetPage() string {
+func (x *CourseBilling) GetLessonPrice() float64 {
 	if x != nil {
-		return x.Page
+		return x.LessonPrice
 	}
-	return ""
+	return 0
 }
 
-func (x *GetLeftAfterTrialPeriodRequest) GetSize() string {
+func (x *CourseBilling) GetPackageLessons() int32 {
 	if x != nil {
-		return x.Size
+		return x.PackageLessons
 	}
-	return ""
+	return 0
 }
 
-type GetLeftAfterTrialPeriodResponse struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	Items          []*AbsGetLeftAfter     `protobuf:"bytes,1,rep,name=items,proto3" json:"items"`
-	TotalItemCount int32                  `protobuf:"varint,2,opt,name=totalItemCount,proto3" json:"totalItemCount"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+func (x *CourseBilling) GetPackagePrice() float64 {
+	if x != nil {
+		return x.PackagePrice
+	}
+	return 0
 }
 
-func (x *GetLeftAfterTrialPeriodResponse) Reset() {
-	*x = GetLeftAfterTrialPeriodResponse{}
+func (x *CourseBilling) GetPackageValidDays() int32 {
+	if x != nil {
+		return x.PackageValidDays
+	}
+	return 0
+}
+
+func (x *CourseBilling) GetLowCreditLessons() int32 {
+	if x != nil {
+		return x.LowCreditLessons
+	}
+	return 0
+}
+
+type ScheduleCoursePriceRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	CourseId string                 `protobuf:"bytes,1,opt,name=courseId,proto3" json:"courseId"`
+	Price    float64                `protobuf:"fixed64,2,opt,name=price,proto3" json:"price"`
+	// yyyy-MM-dd, the price is used for lessons and billing from this date, today when empty
+	EffectiveFrom string `protobuf:"bytes,3,opt,name=effectiveFrom,proto3" json:"effectiveFrom"`
+	// keeps students enrolled before effectiveFrom on the price they pay now
+	GrandfatherExisting bool   `protobuf:"varint,4,opt,name=grandfatherExisting,proto3" json:"grandfatherExisting"`
+	ActionById          string `protobuf:"bytes,5,opt,name=actionById,proto3" json:"actionById"`
+	ActionByName        string `protobuf:"bytes,6,opt,name=actionByName,proto3" json:"actionByName"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *ScheduleCoursePriceRequest) Reset() {
+	*x = ScheduleCoursePriceRequest{}
 	mi := &file_education_proto_msgTypes[25]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetLeftAfterTrialPeriodResponse) String() string {
+func (x *ScheduleCoursePriceRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetLeftAfterTrialPeriodResponse) ProtoMessage() {}
+func (*ScheduleCoursePriceRequest) ProtoMessage() {}
 
-func (x *GetLeftAfterTrialPeriodResponse) ProtoReflect() protoreflect.Message {
+func (x *ScheduleCoursePriceRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_education_proto_msgTypes[25]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1971,54 +2005,79 @@ func (x *GetLeftAfterTrialPeriodResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetLeftAfterTrialPeriodResponse.ProtoReflect.Descriptor instead.
-func (*GetLeftAfterTrialPeriodResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ScheduleCoursePriceRequest.ProtoReflect.Descriptor instead.
+func (*ScheduleCoursePriceRequest) Descriptor() ([]byte, []int) {
 	return file_education_proto_rawDescGZIP(), []int{25}
 }
 
-func (x *GetLeftAfterTrialPeriodResponse) GetItems() []*AbsGetLeftAfter {
+func (x *ScheduleCoursePriceRequest) GetCourseId() string {
 	if x != nil {
-		return x.Items
+		return x.CourseId
 	}
-	return nil
+	return ""
 }
 
-func (x *GetLeftAfterTrialPeriodResponse) GetTotalItemCount() int32 {
+func (x *ScheduleCoursePriceRequest) GetPrice() float64 {
 	if x != nil {
-		return x.TotalItemCount
+		return x.Price
 	}
 	return 0
 }
 
-type AbsGetLeftAfter struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	StudentId      string                 `protobuf:"bytes,1,opt,name=studentId,proto3" json:"studentId"`
-	StudentName    string                 `protobuf:"bytes,2,opt,name=studentName,proto3" json:"studentName"`
-	StudentPhone   string                 `protobuf:"bytes,3,opt,name=studentPhone,proto3" json:"studentPhone"`
-	StudentBalance string                 `protobuf:"bytes,4,opt,name=studentBalance,proto3" json:"studentBalance"`
-	GroupId        string                 `protobuf:"bytes,5,opt,name=groupId,proto3" json:"groupId"`
-	GroupName      string                 `protobuf:"bytes,6,opt,name=groupName,proto3" json:"groupName"`
-	ReturnMoney    bool                   `protobuf:"varint,7,opt,name=returnMoney,proto3" json:"returnMoney"`
-	CreatedAt      string                 `protobuf:"bytes,8,opt,name=createdAt,proto3" json:"createdAt"`
-	SpecificDate   string                 `protobuf:"bytes,9,opt,name=specificDate,proto3" json:"specificDate"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+func (x *ScheduleCoursePriceRequest) GetEffectiveFrom() string {
+	if x != nil {
+		return x.EffectiveFrom
+	}
+	return ""
 }
 
-func (x *AbsGetLeftAfter) Reset() {
-	*x = AbsGetLeftAfter{}
+func (x *ScheduleCoursePriceRequest) GetGrandfatherExisting() bool {
+	if x != nil {
+		return x.GrandfatherExisting
+	}
+	return false
+}
+
+func (x *ScheduleCoursePriceRequest) GetActionById() string {
+	if x != nil {
+		return x.ActionById
+	}
+	return ""
+}
+
+func (x *ScheduleCoursePriceRequest) GetActionByName() string {
+	if x != nil {
+		return x.ActionByName
+	}
+	return ""
+}
+
+type CoursePriceVersion struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	Id                  string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id"`
+	Price               float64                `protobuf:"fixed64,2,opt,name=price,proto3" json:"price"`
+	EffectiveFrom       string                 `protobuf:"bytes,3,opt,name=effectiveFrom,proto3" json:"effectiveFrom"`
+	GrandfatherExisting bool                   `protobuf:"varint,4,opt,name=grandfatherExisting,proto3" json:"grandfatherExisting"`
+	CreatedByName       string                 `protobuf:"bytes,5,opt,name=createdByName,proto3" json:"createdByName"`
+	CreatedAt           string                 `protobuf:"bytes,6,opt,name=createdAt,proto3" json:"createdAt"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *CoursePriceVersion) Reset() {
+	*x = CoursePriceVersion{}
 	mi := &file_education_proto_msgTypes[26]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AbsGetLeftAfter) String() string {
+func (x *CoursePriceVersion) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AbsGetLeftAfter) ProtoMessage() {}
+func (*CoursePriceVersion) ProtoMessage() {}
 
-func (x *AbsGetLeftAfter) ProtoReflect() protoreflect.Message {
+func (x *CoursePriceVersion) ProtoReflect() protoreflect.Message {
 	mi := &file_education_proto_msgTypes[26]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2030,99 +2089,76 @@ func (x *AbsGetLeftAfter) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AbsGetLeftAfter.ProtoReflect.Descriptor instead.
-func (*AbsGetLeftAfter) Descriptor() ([]byte, []int) {
+// Deprecated: Use CoursePriceVersion.ProtoReflect.Descriptor instead.
+func (*CoursePriceVersion) Descriptor() ([]byte, []int) {
 	return file_education_proto_rawDescGZIP(), []int{26}
 }
 
-func (x *AbsGetLeftAfter) GetStudentId() string {
-	if x != nil {
-		return x.StudentId
-	}
-	return ""
-}
-
-func (x *AbsGetLeftAfter) GetStudentName() string {
-	if x != nil {
-		return x.StudentName
-	}
-	return ""
-}
-
-func (x *AbsGetLeftAfter) GetStudentPhone() string {
-	if x != nil {
-		return x.StudentPhone
-	}
-	return ""
-}
-
-func (x *AbsGetLeftAfter) GetStudentBalance() string {
+func (x *CoursePriceVersion) GetId() string {
 	if x != nil {
-		return x.StudentBalance
+		return x.Id
 	}
 	return ""
 }
 
-func (x *AbsGetLeftAfter) GetGroupId() string {
+func (x *CoursePriceVersion) GetPrice() float64 {
 	if x != nil {
-		return x.GroupId
+		return x.Price
 	}
-	return ""
+	return 0
 }
 
-func (x *AbsGetLeftAfter) GetGroupName() string {
+func (x *CoursePriceVersion) GetEffectiveFrom() string {
 	if x != nil {
-		return x.GroupName
+		return x.EffectiveFrom
 	}
 	return ""
 }
 
-func (x *AbsGetLeftAfter) GetReturnMoney() bool {
+func (x *CoursePriceVersion) GetGrandfatherExisting() bool {
 	if x != nil {
-		return x.ReturnMoney
+		return x.GrandfatherExisting
 	}
 	return false
 }
 
-func (x *AbsGetLeftAfter) GetCreatedAt() string {
+func (x *CoursePriceVersion) GetCreatedByName() string {
 	if x != nil {
-		return x.CreatedAt
+		return x.CreatedByName
 	}
 	return ""
 }
 
-func (x *AbsGetLeftAfter) GetSpecificDate() string {
+func (x *CoursePriceVersion) GetCreatedAt() string {
 	if x != nil {
-		return x.SpecificDate
+		return x.CreatedAt
 	}
 	return ""
 }
 
-type GetCommonInformationEducationResponse struct {
-	state              protoimpl.MessageState `protogen:"open.v1"`
-	ActiveStudentCount int32                  `protobuf:"varint,1,opt,name=activeStudentCount,proto3" json:"activeStudentCount"`
-	ActiveGroupCount   int32                  `protobuf:"varint,2,opt,name=activeGroupCount,proto3" json:"activeGroupCount"`
-	LeaveGroupCount    int32                  `protobuf:"varint,3,opt,name=leaveGroupCount,proto3" json:"leaveGroupCount"`
-	DebtorsCount       int32                  `protobuf:"varint,4,opt,name=debtorsCount,proto3" json:"debtorsCount"`
-	EleminatedInTrial  int32                  `protobuf:"varint,5,opt,name=eleminatedInTrial,proto3" json:"eleminatedInTrial"`
-	unknownFields      protoimpl.UnknownFields
-	sizeCache          protoimpl.SizeCache
+type GetCoursePriceHistoryResponse struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Versions []*CoursePriceVersion  `protobuf:"bytes,1,rep,name=versions,proto3" json:"versions"`
+	// enrollments of the course paying a grandfathered price
+	Locks         []*EnrollmentPriceLock `protobuf:"bytes,2,rep,name=locks,proto3" json:"locks"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetCommonInformationEducationResponse) Reset() {
-	*x = GetCommonInformationEducationResponse{}
+func (x *GetCoursePriceHistoryResponse) Reset() {
+	*x = GetCoursePriceHistoryResponse{}
 	mi := &file_education_proto_msgTypes[27]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetCommonInformationEducationResponse) String() string {
+func (x *GetCoursePriceHistoryResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetCommonInformationEducationResponse) ProtoMessage() {}
+func (*GetCoursePriceHistoryResponse) ProtoMessage() {}
 
-func (x *GetCommonInformationEducationResponse) ProtoReflect() protoreflect.Message {
+func (x *GetCoursePriceHistoryResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_education_proto_msgTypes[27]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2134,68 +2170,51 @@ func (x *GetCommonInformationEducationResponse) ProtoReflect() protoreflect.Mess
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetCommonInformationEducationResponse.ProtoReflect.Descriptor instead.
-func (*GetCommonInformationEducationResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetCoursePriceHistoryResponse.ProtoReflect.Descriptor instead.
+func (*GetCoursePriceHistoryResponse) Descriptor() ([]byte, []int) {
 	return file_education_proto_rawDescGZIP(), []int{27}
 }
 
-func (x *GetCommonInformationEducationResponse) GetActiveStudentCount() int32 {
-	if x != nil {
-		return x.ActiveStudentCount
-	}
-	return 0
-}
-
-func (x *GetCommonInformationEducationResponse) GetActiveGroupCount() int32 {
-	if x != nil {
-		return x.ActiveGroupCount
-	}
-	return 0
-}
-
-func (x *GetCommonInformationEducationResponse) GetLeaveGroupCount() int32 {
-	if x != nil {
-		return x.LeaveGroupCount
-	}
-	return 0
-}
-
-func (x *GetCommonInformationEducationResponse) GetDebtorsCount() int32 {
+func (x *GetCoursePriceHistoryResponse) GetVersions() []*CoursePriceVersion {
 	if x != nil {
-		return x.DebtorsCount
+		return x.Versions
 	}
-	return 0
+	return nil
 }
 
-func (x *GetCommonInformationEducationResponse) GetEleminatedInTrial() int32 {
+func (x *GetCoursePriceHistoryResponse) GetLocks() []*EnrollmentPriceLock {
 	if x != nil {
-		return x.EleminatedInTrial
+		return x.Locks
 	}
-	return 0
+	return nil
 }
 
-type GetGroupsByTeacherIdRequest struct {
+type EnrollmentPriceLock struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	TeacherId     string                 `protobuf:"bytes,1,opt,name=teacherId,proto3" json:"teacherId"`
-	IsArchived    bool                   `protobuf:"varint,2,opt,name=isArchived,proto3" json:"isArchived"`
+	StudentId     string                 `protobuf:"bytes,1,opt,name=studentId,proto3" json:"studentId"`
+	StudentName   string                 `protobuf:"bytes,2,opt,name=studentName,proto3" json:"studentName"`
+	GroupId       string                 `protobuf:"bytes,3,opt,name=groupId,proto3" json:"groupId"`
+	GroupName     string                 `protobuf:"bytes,4,opt,name=groupName,proto3" json:"groupName"`
+	Price         float64                `protobuf:"fixed64,5,opt,name=price,proto3" json:"price"`
+	LockedFrom    string                 `protobuf:"bytes,6,opt,name=lockedFrom,proto3" json:"lockedFrom"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetGroupsByTeacherIdRequest) Reset() {
-	*x = GetGroupsByTeacherIdRequest{}
+func (x *EnrollmentPriceLock) Reset() {
+	*x = EnrollmentPriceLock{}
 	mi := &file_education_proto_msgTypes[28]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetGroupsByTeacherIdRequest) String() string {
+func (x *EnrollmentPriceLock) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetGroupsByTeacherIdRequest) ProtoMessage() {}
+func (*EnrollmentPriceLock) ProtoMessage() {}
 
-func (x *GetGroupsByTeacherIdRequest) ProtoReflect() protoreflect.Message {
+func (x *EnrollmentPriceLock) ProtoReflect() protoreflect.Message {
 	mi := &file_education_proto_msgTypes[28]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2207,46 +2226,79 @@ func (x *GetGroupsByTeacherIdRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetGroupsByTeacherIdRequest.ProtoReflect.Descriptor instead.
-func (*GetGroupsByTeacherIdRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use EnrollmentPriceLock.ProtoReflect.Descriptor instead.
+func (*EnrollmentPriceLock) Descriptor() ([]byte, []int) {
 	return file_education_proto_rawDescGZIP(), []int{28}
 }
 
-func (x *GetGroupsByTeacherIdRequest) GetTeacherId() string {
+func (x *EnrollmentPriceLock) GetStudentId() string {
 	if x != nil {
-		return x.TeacherId
+		return x.StudentId
 	}
 	return ""
 }
 
-func (x *GetGroupsByTeacherIdRequest) GetIsArchived() bool {
+func (x *EnrollmentPriceLock) GetStudentName() string {
 	if x != nil {
-		return x.IsArchived
+		return x.StudentName
 	}
-	return false
+	return ""
 }
 
-type GetGroupsByTeacherResponse struct {
-	state         protoimpl.MessageState  `protogen:"open.v1"`
-	Groups        []*GetGroupByTeacherAbs `protobuf:"bytes,1,rep,name=groups,proto3" json:"groups"`
+func (x *EnrollmentPriceLock) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+func (x *EnrollmentPriceLock) GetGroupName() string {
+	if x != nil {
+		return x.GroupName
+	}
+	return ""
+}
+
+func (x *EnrollmentPriceLock) GetPrice() float64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *EnrollmentPriceLock) GetLockedFrom() string {
+	if x != nil {
+		return x.LockedFrom
+	}
+	return ""
+}
+
+type SetEnrollmentPriceLockRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	StudentId string                 `protobuf:"bytes,1,opt,name=studentId,proto3" json:"studentId"`
+	GroupId   string                 `protobuf:"bytes,2,opt,name=groupId,proto3" json:"groupId"`
+	// 0 removes the lock so the enrollment follows the course price again
+	Price float64 `protobuf:"fixed64,3,opt,name=price,proto3" json:"price"`
+	// yyyy-MM-dd, today when empty
+	LockedFrom    string `protobuf:"bytes,4,opt,name=lockedFrom,proto3" json:"lockedFrom"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetGroupsByTeacherResponse) Reset() {
-	*x = GetGroupsByTeacherResponse{}
+func (x *SetEnrollmentPriceLockRequest) Reset() {
+	*x = SetEnrollmentPriceLockRequest{}
 	mi := &file_education_proto_msgTypes[29]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetGroupsByTeacherResponse) String() string {
+func (x *SetEnrollmentPriceLockRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetGroupsByTeacherResponse) ProtoMessage() {}
+func (*SetEnrollmentPriceLockRequest) ProtoMessage() {}
 
-func (x *GetGroupsByTeacherResponse) ProtoReflect() protoreflect.Message {
+func (x *SetEnrollmentPriceLockRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_education_proto_msgTypes[29]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2258,22 +2310,443 @@ func (x *GetGroupsByTeacherResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetGroupsByTeacherResponse.ProtoReflect.Descriptor instead.
-func (*GetGroupsByTeacherResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use SetEnrollmentPriceLockRequest.ProtoReflect.Descriptor instead.
+func (*SetEnrollmentPriceLockRequest) Descriptor() ([]byte, []int) {
 	return file_education_proto_rawDescGZIP(), []int{29}
 }
 
-func (x *GetGroupsByTeacherResponse) GetGroups() []*GetGroupByTeacherAbs {
+func (x *SetEnrollmentPriceLockRequest) GetStudentId() string {
 	if x != nil {
-		return x.Groups
+		return x.StudentId
 	}
-	return nil
+	return ""
 }
 
-type GetGroupByTeacherAbs struct {
-	state              protoimpl.MessageState `protogen:"open.v1"`
-	Id                 string                 `protobuf:"bytes,10,opt,name=id,proto3" json:"id"`
-	Name               string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name"`
+func (x *SetEnrollmentPriceLockRequest) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+func (x *SetEnrollmentPriceLockRequest) GetPrice() float64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *SetEnrollmentPriceLockRequest) GetLockedFrom() string {
+	if x != nil {
+		return x.LockedFrom
+	}
+	return ""
+}
+
+type GetLeftAfterTrialPeriodRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	From          string                 `protobuf:"bytes,1,opt,name=from,proto3" json:"from"`
+	To            string                 `protobuf:"bytes,2,opt,name=to,proto3" json:"to"`
+	Page          string                 `protobuf:"bytes,3,opt,name=page,proto3" json:"page"`
+	Size          string                 `protobuf:"bytes,4,opt,name=size,proto3" json:"size"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetLeftAfterTrialPeriodRequest) Reset() {
+	*x = GetLeftAfterTrialPeriodRequest{}
+	mi := &file_education_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetLeftAfterTrialPeriodRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLeftAfterTrialPeriodRequest) ProtoMessage() {}
+
+func (x *GetLeftAfterTrialPeriodRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLeftAfterTrialPeriodRequest.ProtoReflect.Descriptor instead.
+func (*GetLeftAfterTrialPeriodRequest) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *GetLeftAfterTrialPeriodRequest) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *GetLeftAfterTrialPeriodRequest) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+func (x *GetLeftAfterTrialPeriodRequest) GetPage() string {
+	if x != nil {
+		return x.Page
+	}
+	return ""
+}
+
+func (x *GetLeftAfterTrialPeriodRequest) GetSize() string {
+	if x != nil {
+		return x.Size
+	}
+	return ""
+}
+
+type GetLeftAfterTrialPeriodResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Items          []*AbsGetLeftAfter     `protobuf:"bytes,1,rep,name=items,proto3" json:"items"`
+	TotalItemCount int32                  `protobuf:"varint,2,opt,name=totalItemCount,proto3" json:"totalItemCount"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetLeftAfterTrialPeriodResponse) Reset() {
+	*x = GetLeftAfterTrialPeriodResponse{}
+	mi := &file_education_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetLeftAfterTrialPeriodResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLeftAfterTrialPeriodResponse) ProtoMessage() {}
+
+func (x *GetLeftAfterTrialPeriodResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLeftAfterTrialPeriodResponse.ProtoReflect.Descriptor instead.
+func (*GetLeftAfterTrialPeriodResponse) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *GetLeftAfterTrialPeriodResponse) GetItems() []*AbsGetLeftAfter {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *GetLeftAfterTrialPeriodResponse) GetTotalItemCount() int32 {
+	if x != nil {
+		return x.TotalItemCount
+	}
+	return 0
+}
+
+type AbsGetLeftAfter struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	StudentId      string                 `protobuf:"bytes,1,opt,name=studentId,proto3" json:"studentId"`
+	StudentName    string                 `protobuf:"bytes,2,opt,name=studentName,proto3" json:"studentName"`
+	StudentPhone   string                 `protobuf:"bytes,3,opt,name=studentPhone,proto3" json:"studentPhone"`
+	StudentBalance string                 `protobuf:"bytes,4,opt,name=studentBalance,proto3" json:"studentBalance"`
+	GroupId        string                 `protobuf:"bytes,5,opt,name=groupId,proto3" json:"groupId"`
+	GroupName      string                 `protobuf:"bytes,6,opt,name=groupName,proto3" json:"groupName"`
+	ReturnMoney    bool                   `protobuf:"varint,7,opt,name=returnMoney,proto3" json:"returnMoney"`
+	CreatedAt      string                 `protobuf:"bytes,8,opt,name=createdAt,proto3" json:"createdAt"`
+	SpecificDate   string                 `protobuf:"bytes,9,opt,name=specificDate,proto3" json:"specificDate"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *AbsGetLeftAfter) Reset() {
+	*x = AbsGetLeftAfter{}
+	mi := &file_education_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AbsGetLeftAfter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AbsGetLeftAfter) ProtoMessage() {}
+
+func (x *AbsGetLeftAfter) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AbsGetLeftAfter.ProtoReflect.Descriptor instead.
+func (*AbsGetLeftAfter) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *AbsGetLeftAfter) GetStudentId() string {
+	if x != nil {
+		return x.StudentId
+	}
+	return ""
+}
+
+func (x *AbsGetLeftAfter) GetStudentName() string {
+	if x != nil {
+		return x.StudentName
+	}
+	return ""
+}
+
+func (x *AbsGetLeftAfter) GetStudentPhone() string {
+	if x != nil {
+		return x.StudentPhone
+	}
+	return ""
+}
+
+func (x *AbsGetLeftAfter) GetStudentBalance() string {
+	if x != nil {
+		return x.StudentBalance
+	}
+	return ""
+}
+
+func (x *AbsGetLeftAfter) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+func (x *AbsGetLeftAfter) GetGroupName() string {
+	if x != nil {
+		return x.GroupName
+	}
+	return ""
+}
+
+func (x *AbsGetLeftAfter) GetReturnMoney() bool {
+	if x != nil {
+		return x.ReturnMoney
+	}
+	return false
+}
+
+func (x *AbsGetLeftAfter) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *AbsGetLeftAfter) GetSpecificDate() string {
+	if x != nil {
+		return x.SpecificDate
+	}
+	return ""
+}
+
+type GetCommonInformationEducationResponse struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	ActiveStudentCount int32                  `protobuf:"varint,1,opt,name=activeStudentCount,proto3" json:"activeStudentCount"`
+	ActiveGroupCount   int32                  `protobuf:"varint,2,opt,name=activeGroupCount,proto3" json:"activeGroupCount"`
+	LeaveGroupCount    int32                  `protobuf:"varint,3,opt,name=leaveGroupCount,proto3" json:"leaveGroupCount"`
+	DebtorsCount       int32                  `protobuf:"varint,4,opt,name=debtorsCount,proto3" json:"debtorsCount"`
+	EleminatedInTrial  int32                  `protobuf:"varint,5,opt,name=eleminatedInTrial,proto3" json:"eleminatedInTrial"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *GetCommonInformationEducationResponse) Reset() {
+	*x = GetCommonInformationEducationResponse{}
+	mi := &file_education_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCommonInformationEducationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCommonInformationEducationResponse) ProtoMessage() {}
+
+func (x *GetCommonInformationEducationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCommonInformationEducationResponse.ProtoReflect.Descriptor instead.
+func (*GetCommonInformationEducationResponse) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *GetCommonInformationEducationResponse) GetActiveStudentCount() int32 {
+	if x != nil {
+		return x.ActiveStudentCount
+	}
+	return 0
+}
+
+func (x *GetCommonInformationEducationResponse) GetActiveGroupCount() int32 {
+	if x != nil {
+		return x.ActiveGroupCount
+	}
+	return 0
+}
+
+func (x *GetCommonInformationEducationResponse) GetLeaveGroupCount() int32 {
+	if x != nil {
+		return x.LeaveGroupCount
+	}
+	return 0
+}
+
+func (x *GetCommonInformationEducationResponse) GetDebtorsCount() int32 {
+	if x != nil {
+		return x.DebtorsCount
+	}
+	return 0
+}
+
+func (x *GetCommonInformationEducationResponse) GetEleminatedInTrial() int32 {
+	if x != nil {
+		return x.EleminatedInTrial
+	}
+	return 0
+}
+
+type GetGroupsByTeacherIdRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TeacherId     string                 `protobuf:"bytes,1,opt,name=teacherId,proto3" json:"teacherId"`
+	IsArchived    bool                   `protobuf:"varint,2,opt,name=isArchived,proto3" json:"isArchived"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetGroupsByTeacherIdRequest) Reset() {
+	*x = GetGroupsByTeacherIdRequest{}
+	mi := &file_education_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetGroupsByTeacherIdRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGroupsByTeacherIdRequest) ProtoMessage() {}
+
+func (x *GetGroupsByTeacherIdRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGroupsByTeacherIdRequest.ProtoReflect.Descriptor instead.
+func (*GetGroupsByTeacherIdRequest) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *GetGroupsByTeacherIdRequest) GetTeacherId() string {
+	if x != nil {
+		return x.TeacherId
+	}
+	return ""
+}
+
+func (x *GetGroupsByTeacherIdRequest) GetIsArchived() bool {
+	if x != nil {
+		return x.IsArchived
+	}
+	return false
+}
+
+type GetGroupsByTeacherResponse struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Groups        []*GetGroupByTeacherAbs `protobuf:"bytes,1,rep,name=groups,proto3" json:"groups"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetGroupsByTeacherResponse) Reset() {
+	*x = GetGroupsByTeacherResponse{}
+	mi := &file_education_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetGroupsByTeacherResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGroupsByTeacherResponse) ProtoMessage() {}
+
+func (x *GetGroupsByTeacherResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGroupsByTeacherResponse.ProtoReflect.Descriptor instead.
+func (*GetGroupsByTeacherResponse) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *GetGroupsByTeacherResponse) GetGroups() []*GetGroupByTeacherAbs {
+	if x != nil {
+		return x.Groups
+	}
+	return nil
+}
+
+type GetGroupByTeacherAbs struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Id                 string                 `protobuf:"bytes,10,opt,name=id,proto3" json:"id"`
+	Name               string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name"`
 	CourseName         string                 `protobuf:"bytes,2,opt,name=courseName,proto3" json:"courseName"`
 	RoomName           string                 `protobuf:"bytes,3,opt,name=roomName,proto3" json:"roomName"`
 	LessonStartTime    string                 `protobuf:"bytes,4,opt,name=lessonStartTime,proto3" json:"lessonStartTime"`
@@ -2286,21 +2759,5318 @@ type GetGroupByTeacherAbs struct {
 	sizeCache          protoimpl.SizeCache
 }
 
-func (x *GetGroupByTeacherAbs) Reset() {
-	*x = GetGroupByTeacherAbs{}
-	mi := &file_education_proto_msgTypes[30]
+func (x *GetGroupByTeacherAbs) Reset() {
+	*x = GetGroupByTeacherAbs{}
+	mi := &file_education_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetGroupByTeacherAbs) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGroupByTeacherAbs) ProtoMessage() {}
+
+func (x *GetGroupByTeacherAbs) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGroupByTeacherAbs.ProtoReflect.Descriptor instead.
+func (*GetGroupByTeacherAbs) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *GetGroupByTeacherAbs) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *GetGroupByTeacherAbs) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *GetGroupByTeacherAbs) GetCourseName() string {
+	if x != nil {
+		return x.CourseName
+	}
+	return ""
+}
+
+func (x *GetGroupByTeacherAbs) GetRoomName() string {
+	if x != nil {
+		return x.RoomName
+	}
+	return ""
+}
+
+func (x *GetGroupByTeacherAbs) GetLessonStartTime() string {
+	if x != nil {
+		return x.LessonStartTime
+	}
+	return ""
+}
+
+func (x *GetGroupByTeacherAbs) GetDayType() string {
+	if x != nil {
+		return x.DayType
+	}
+	return ""
+}
+
+func (x *GetGroupByTeacherAbs) GetGroupStartAt() string {
+	if x != nil {
+		return x.GroupStartAt
+	}
+	return ""
+}
+
+func (x *GetGroupByTeacherAbs) GetGroupEndAt() string {
+	if x != nil {
+		return x.GroupEndAt
+	}
+	return ""
+}
+
+func (x *GetGroupByTeacherAbs) GetActiveStudentCount() int32 {
+	if x != nil {
+		return x.ActiveStudentCount
+	}
+	return 0
+}
+
+func (x *GetGroupByTeacherAbs) GetStudents() []*AbsStudent {
+	if x != nil {
+		return x.Students
+	}
+	return nil
+}
+
+type CreateGroupRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Name            string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name"`
+	CourseId        int32                  `protobuf:"varint,2,opt,name=courseId,proto3" json:"courseId"`
+	TeacherId       string                 `protobuf:"bytes,3,opt,name=teacherId,proto3" json:"teacherId"`
+	Type            string                 `protobuf:"bytes,4,opt,name=type,proto3" json:"type"`
+	Days            []string               `protobuf:"bytes,5,rep,name=days,proto3" json:"days"`
+	RoomId          int32                  `protobuf:"varint,6,opt,name=roomId,proto3" json:"roomId"`
+	LessonStartTime string                 `protobuf:"bytes,7,opt,name=lessonStartTime,proto3" json:"lessonStartTime"`
+	GroupStartDate  string                 `protobuf:"bytes,8,opt,name=groupStartDate,proto3" json:"groupStartDate"`
+	GroupEndDate    string                 `protobuf:"bytes,9,opt,name=groupEndDate,proto3" json:"groupEndDate"`
+	// saves the group although its room or teacher is already booked, the override is audited
+	Force         bool   `protobuf:"varint,10,opt,name=force,proto3" json:"force"`
+	ActionById    string `protobuf:"bytes,11,opt,name=actionById,proto3" json:"actionById"`
+	ActionByName  string `protobuf:"bytes,12,opt,name=actionByName,proto3" json:"actionByName"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateGroupRequest) Reset() {
+	*x = CreateGroupRequest{}
+	mi := &file_education_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateGroupRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateGroupRequest) ProtoMessage() {}
+
+func (x *CreateGroupRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateGroupRequest.ProtoReflect.Descriptor instead.
+func (*CreateGroupRequest) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *CreateGroupRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateGroupRequest) GetCourseId() int32 {
+	if x != nil {
+		return x.CourseId
+	}
+	return 0
+}
+
+func (x *CreateGroupRequest) GetTeacherId() string {
+	if x != nil {
+		return x.TeacherId
+	}
+	return ""
+}
+
+func (x *CreateGroupRequest) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *CreateGroupRequest) GetDays() []string {
+	if x != nil {
+		return x.Days
+	}
+	return nil
+}
+
+func (x *CreateGroupRequest) GetRoomId() int32 {
+	if x != nil {
+		return x.RoomId
+	}
+	return 0
+}
+
+func (x *CreateGroupRequest) GetLessonStartTime() string {
+	if x != nil {
+		return x.LessonStartTime
+	}
+	return ""
+}
+
+func (x *CreateGroupRequest) GetGroupStartDate() string {
+	if x != nil {
+		return x.GroupStartDate
+	}
+	return ""
+}
+
+func (x *CreateGroupRequest) GetGroupEndDate() string {
+	if x != nil {
+		return x.GroupEndDate
+	}
+	return ""
+}
+
+func (x *CreateGroupRequest) GetForce() bool {
+	if x != nil {
+		return x.Force
+	}
+	return false
+}
+
+func (x *CreateGroupRequest) GetActionById() string {
+	if x != nil {
+		return x.ActionById
+	}
+	return ""
+}
+
+func (x *CreateGroupRequest) GetActionByName() string {
+	if x != nil {
+		return x.ActionByName
+	}
+	return ""
+}
+
+type GetGroupByIdRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id"`
+	ActionRole    string                 `protobuf:"bytes,2,opt,name=actionRole,proto3" json:"actionRole"`
+	ActionId      string                 `protobuf:"bytes,3,opt,name=actionId,proto3" json:"actionId"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetGroupByIdRequest) Reset() {
+	*x = GetGroupByIdRequest{}
+	mi := &file_education_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetGroupByIdRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGroupByIdRequest) ProtoMessage() {}
+
+func (x *GetGroupByIdRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGroupByIdRequest.ProtoReflect.Descriptor instead.
+func (*GetGroupByIdRequest) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *GetGroupByIdRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *GetGroupByIdRequest) GetActionRole() string {
+	if x != nil {
+		return x.ActionRole
+	}
+	return ""
+}
+
+func (x *GetGroupByIdRequest) GetActionId() string {
+	if x != nil {
+		return x.ActionId
+	}
+	return ""
+}
+
+type GetUpdateGroupAbs struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              string                 `protobuf:"bytes,10,opt,name=id,proto3" json:"id"`
+	Name            string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name"`
+	CourseId        int32                  `protobuf:"varint,2,opt,name=courseId,proto3" json:"courseId"`
+	TeacherId       string                 `protobuf:"bytes,3,opt,name=teacherId,proto3" json:"teacherId"`
+	Type            string                 `protobuf:"bytes,4,opt,name=type,proto3" json:"type"`
+	Days            []string               `protobuf:"bytes,5,rep,name=days,proto3" json:"days"`
+	RoomId          int32                  `protobuf:"varint,6,opt,name=roomId,proto3" json:"roomId"`
+	LessonStartTime string                 `protobuf:"bytes,7,opt,name=lessonStartTime,proto3" json:"lessonStartTime"`
+	GroupStartDate  string                 `protobuf:"bytes,8,opt,name=groupStartDate,proto3" json:"groupStartDate"`
+	GroupEndDate    string                 `protobuf:"bytes,9,opt,name=groupEndDate,proto3" json:"groupEndDate"`
+	// saves the group although its room or teacher is already booked, the override is audited
+	Force         bool   `protobuf:"varint,11,opt,name=force,proto3" json:"force"`
+	ActionById    string `protobuf:"bytes,12,opt,name=actionById,proto3" json:"actionById"`
+	ActionByName  string `protobuf:"bytes,13,opt,name=actionByName,proto3" json:"actionByName"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUpdateGroupAbs) Reset() {
+	*x = GetUpdateGroupAbs{}
+	mi := &file_education_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUpdateGroupAbs) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUpdateGroupAbs) ProtoMessage() {}
+
+func (x *GetUpdateGroupAbs) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUpdateGroupAbs.ProtoReflect.Descriptor instead.
+func (*GetUpdateGroupAbs) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *GetUpdateGroupAbs) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *GetUpdateGroupAbs) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *GetUpdateGroupAbs) GetCourseId() int32 {
+	if x != nil {
+		return x.CourseId
+	}
+	return 0
+}
+
+func (x *GetUpdateGroupAbs) GetTeacherId() string {
+	if x != nil {
+		return x.TeacherId
+	}
+	return ""
+}
+
+func (x *GetUpdateGroupAbs) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *GetUpdateGroupAbs) GetDays() []string {
+	if x != nil {
+		return x.Days
+	}
+	return nil
+}
+
+func (x *GetUpdateGroupAbs) GetRoomId() int32 {
+	if x != nil {
+		return x.RoomId
+	}
+	return 0
+}
+
+func (x *GetUpdateGroupAbs) GetLessonStartTime() string {
+	if x != nil {
+		return x.LessonStartTime
+	}
+	return ""
+}
+
+func (x *GetUpdateGroupAbs) GetGroupStartDate() string {
+	if x != nil {
+		return x.GroupStartDate
+	}
+	return ""
+}
+
+func (x *GetUpdateGroupAbs) GetGroupEndDate() string {
+	if x != nil {
+		return x.GroupEndDate
+	}
+	return ""
+}
+
+func (x *GetUpdateGroupAbs) GetForce() bool {
+	if x != nil {
+		return x.Force
+	}
+	return false
+}
+
+func (x *GetUpdateGroupAbs) GetActionById() string {
+	if x != nil {
+		return x.ActionById
+	}
+	return ""
+}
+
+func (x *GetUpdateGroupAbs) GetActionByName() string {
+	if x != nil {
+		return x.ActionByName
+	}
+	return ""
+}
+
+type GetGroupsByCourseResponse struct {
+	state         protoimpl.MessageState         `protogen:"open.v1"`
+	Groups        []*GetGroupByCourseAbsResponse `protobuf:"bytes,1,rep,name=groups,proto3" json:"groups"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetGroupsByCourseResponse) Reset() {
+	*x = GetGroupsByCourseResponse{}
+	mi := &file_education_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetGroupsByCourseResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGroupsByCourseResponse) ProtoMessage() {}
+
+func (x *GetGroupsByCourseResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGroupsByCourseResponse.ProtoReflect.Descriptor instead.
+func (*GetGroupsByCourseResponse) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *GetGroupsByCourseResponse) GetGroups() []*GetGroupByCourseAbsResponse {
+	if x != nil {
+		return x.Groups
+	}
+	return nil
+}
+
+type GetGroupByCourseAbsResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id"`
+	TeacherName     string                 `protobuf:"bytes,2,opt,name=teacherName,proto3" json:"teacherName"`
+	GroupStartDate  string                 `protobuf:"bytes,3,opt,name=groupStartDate,proto3" json:"groupStartDate"`
+	GroupEndDate    string                 `protobuf:"bytes,4,opt,name=groupEndDate,proto3" json:"groupEndDate"`
+	DateType        string                 `protobuf:"bytes,5,opt,name=dateType,proto3" json:"dateType"`
+	LessonStartTime string                 `protobuf:"bytes,6,opt,name=lessonStartTime,proto3" json:"lessonStartTime"`
+	GroupName       string                 `protobuf:"bytes,7,opt,name=groupName,proto3" json:"groupName"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *GetGroupByCourseAbsResponse) Reset() {
+	*x = GetGroupByCourseAbsResponse{}
+	mi := &file_education_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetGroupByCourseAbsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGroupByCourseAbsResponse) ProtoMessage() {}
+
+func (x *GetGroupByCourseAbsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGroupByCourseAbsResponse.ProtoReflect.Descriptor instead.
+func (*GetGroupByCourseAbsResponse) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *GetGroupByCourseAbsResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *GetGroupByCourseAbsResponse) GetTeacherName() string {
+	if x != nil {
+		return x.TeacherName
+	}
+	return ""
+}
+
+func (x *GetGroupByCourseAbsResponse) GetGroupStartDate() string {
+	if x != nil {
+		return x.GroupStartDate
+	}
+	return ""
+}
+
+func (x *GetGroupByCourseAbsResponse) GetGroupEndDate() string {
+	if x != nil {
+		return x.GroupEndDate
+	}
+	return ""
+}
+
+func (x *GetGroupByCourseAbsResponse) GetDateType() string {
+	if x != nil {
+		return x.DateType
+	}
+	return ""
+}
+
+func (x *GetGroupByCourseAbsResponse) GetLessonStartTime() string {
+	if x != nil {
+		return x.LessonStartTime
+	}
+	return ""
+}
+
+func (x *GetGroupByCourseAbsResponse) GetGroupName() string {
+	if x != nil {
+		return x.GroupName
+	}
+	return ""
+}
+
+type GetGroupAbsResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id"`
+	Course          *AbsCourse             `protobuf:"bytes,2,opt,name=course,proto3" json:"course"`
+	TeacherName     string                 `protobuf:"bytes,3,opt,name=teacherName,proto3" json:"teacherName"`
+	TeacherId       string                 `protobuf:"bytes,14,opt,name=teacherId,proto3" json:"teacherId"`
+	LessonStartTime string                 `protobuf:"bytes,4,opt,name=lessonStartTime,proto3" json:"lessonStartTime"`
+	Room            *AbsRoom               `protobuf:"bytes,5,opt,name=room,proto3" json:"room"`
+	StudentCount    int32                  `protobuf:"varint,6,opt,name=studentCount,proto3" json:"studentCount"`
+	Name            string                 `protobuf:"bytes,7,opt,name=name,proto3" json:"name"`
+	IsArchived      bool                   `protobuf:"varint,8,opt,name=isArchived,proto3" json:"isArchived"`
+	CreatedAt       string                 `protobuf:"bytes,9,opt,name=createdAt,proto3" json:"createdAt"`
+	StartDate       string                 `protobuf:"bytes,10,opt,name=startDate,proto3" json:"startDate"`
+	EndDate         string                 `protobuf:"bytes,11,opt,name=endDate,proto3" json:"endDate"`
+	Days            []string               `protobuf:"bytes,12,rep,name=days,proto3" json:"days"`
+	DateType        string                 `protobuf:"bytes,13,opt,name=dateType,proto3" json:"dateType"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *GetGroupAbsResponse) Reset() {
+	*x = GetGroupAbsResponse{}
+	mi := &file_education_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetGroupAbsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGroupAbsResponse) ProtoMessage() {}
+
+func (x *GetGroupAbsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGroupAbsResponse.ProtoReflect.Descriptor instead.
+func (*GetGroupAbsResponse) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *GetGroupAbsResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *GetGroupAbsResponse) GetCourse() *AbsCourse {
+	if x != nil {
+		return x.Course
+	}
+	return nil
+}
+
+func (x *GetGroupAbsResponse) GetTeacherName() string {
+	if x != nil {
+		return x.TeacherName
+	}
+	return ""
+}
+
+func (x *GetGroupAbsResponse) GetTeacherId() string {
+	if x != nil {
+		return x.TeacherId
+	}
+	return ""
+}
+
+func (x *GetGroupAbsResponse) GetLessonStartTime() string {
+	if x != nil {
+		return x.LessonStartTime
+	}
+	return ""
+}
+
+func (x *GetGroupAbsResponse) GetRoom() *AbsRoom {
+	if x != nil {
+		return x.Room
+	}
+	return nil
+}
+
+func (x *GetGroupAbsResponse) GetStudentCount() int32 {
+	if x != nil {
+		return x.StudentCount
+	}
+	return 0
+}
+
+func (x *GetGroupAbsResponse) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *GetGroupAbsResponse) GetIsArchived() bool {
+	if x != nil {
+		return x.IsArchived
+	}
+	return false
+}
+
+func (x *GetGroupAbsResponse) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *GetGroupAbsResponse) GetStartDate() string {
+	if x != nil {
+		return x.StartDate
+	}
+	return ""
+}
+
+func (x *GetGroupAbsResponse) GetEndDate() string {
+	if x != nil {
+		return x.EndDate
+	}
+	return ""
+}
+
+func (x *GetGroupAbsResponse) GetDays() []string {
+	if x != nil {
+		return x.Days
+	}
+	return nil
+}
+
+func (x *GetGroupAbsResponse) GetDateType() string {
+	if x != nil {
+		return x.DateType
+	}
+	return ""
+}
+
+type GetGroupsResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Groups         []*GetGroupAbsResponse `protobuf:"bytes,1,rep,name=groups,proto3" json:"groups"`
+	TotalPageCount int32                  `protobuf:"varint,2,opt,name=totalPageCount,proto3" json:"totalPageCount"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetGroupsResponse) Reset() {
+	*x = GetGroupsResponse{}
+	mi := &file_education_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetGroupsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGroupsResponse) ProtoMessage() {}
+
+func (x *GetGroupsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGroupsResponse.ProtoReflect.Descriptor instead.
+func (*GetGroupsResponse) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *GetGroupsResponse) GetGroups() []*GetGroupAbsResponse {
+	if x != nil {
+		return x.Groups
+	}
+	return nil
+}
+
+func (x *GetGroupsResponse) GetTotalPageCount() int32 {
+	if x != nil {
+		return x.TotalPageCount
+	}
+	return 0
+}
+
+type GetGroupsRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	IsArchived     bool                   `protobuf:"varint,1,opt,name=isArchived,proto3" json:"isArchived"`
+	TeacherId      *string                `protobuf:"bytes,2,opt,name=teacherId,proto3,oneof" json:"teacherId"`
+	CourseId       *int32                 `protobuf:"varint,3,opt,name=courseId,proto3,oneof" json:"courseId"`
+	DateType       *string                `protobuf:"bytes,4,opt,name=dateType,proto3,oneof" json:"dateType"`
+	StartDate      *string                `protobuf:"bytes,5,opt,name=startDate,proto3,oneof" json:"startDate"`
+	EndDate        *string                `protobuf:"bytes,6,opt,name=endDate,proto3,oneof" json:"endDate"`
+	Page           *PageRequest           `protobuf:"bytes,7,opt,name=page,proto3" json:"page"`
+	OrderBy        string                 `protobuf:"bytes,8,opt,name=orderBy,proto3" json:"orderBy"`
+	OrderDirection string                 `protobuf:"bytes,9,opt,name=orderDirection,proto3" json:"orderDirection"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetGroupsRequest) Reset() {
+	*x = GetGroupsRequest{}
+	mi := &file_education_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetGroupsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGroupsRequest) ProtoMessage() {}
+
+func (x *GetGroupsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGroupsRequest.ProtoReflect.Descriptor instead.
+func (*GetGroupsRequest) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *GetGroupsRequest) GetIsArchived() bool {
+	if x != nil {
+		return x.IsArchived
+	}
+	return false
+}
+
+func (x *GetGroupsRequest) GetTeacherId() string {
+	if x != nil && x.TeacherId != nil {
+		return *x.TeacherId
+	}
+	return ""
+}
+
+func (x *GetGroupsRequest) GetCourseId() int32 {
+	if x != nil && x.CourseId != nil {
+		return *x.CourseId
+	}
+	return 0
+}
+
+func (x *GetGroupsRequest) GetDateType() string {
+	if x != nil && x.DateType != nil {
+		return *x.DateType
+	}
+	return ""
+}
+
+func (x *GetGroupsRequest) GetStartDate() string {
+	if x != nil && x.StartDate != nil {
+		return *x.StartDate
+	}
+	return ""
+}
+
+func (x *GetGroupsRequest) GetEndDate() string {
+	if x != nil && x.EndDate != nil {
+		return *x.EndDate
+	}
+	return ""
+}
+
+func (x *GetGroupsRequest) GetPage() *PageRequest {
+	if x != nil {
+		return x.Page
+	}
+	return nil
+}
+
+func (x *GetGroupsRequest) GetOrderBy() string {
+	if x != nil {
+		return x.OrderBy
+	}
+	return ""
+}
+
+func (x *GetGroupsRequest) GetOrderDirection() string {
+	if x != nil {
+		return x.OrderDirection
+	}
+	return ""
+}
+
+type UpdateGroupBillingModeRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	GroupId string                 `protobuf:"bytes,1,opt,name=groupId,proto3" json:"groupId"`
+	// MONTHLY, PER_LESSON or PACKAGE, empty uses the billing mode of the course
+	BillingMode   string `protobuf:"bytes,2,opt,name=billingMode,proto3" json:"billingMode"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateGroupBillingModeRequest) Reset() {
+	*x = UpdateGroupBillingModeRequest{}
+	mi := &file_education_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateGroupBillingModeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateGroupBillingModeRequest) ProtoMessage() {}
+
+func (x *UpdateGroupBillingModeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateGroupBillingModeRequest.ProtoReflect.Descriptor instead.
+func (*UpdateGroupBillingModeRequest) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *UpdateGroupBillingModeRequest) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+func (x *UpdateGroupBillingModeRequest) GetBillingMode() string {
+	if x != nil {
+		return x.BillingMode
+	}
+	return ""
+}
+
+type UpdateGroupMaxStudentsRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	GroupId string                 `protobuf:"bytes,1,opt,name=groupId,proto3" json:"groupId"`
+	// seats of the group, 0 uses the capacity of its room
+	MaxStudents   int32 `protobuf:"varint,2,opt,name=maxStudents,proto3" json:"maxStudents"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateGroupMaxStudentsRequest) Reset() {
+	*x = UpdateGroupMaxStudentsRequest{}
+	mi := &file_education_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateGroupMaxStudentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateGroupMaxStudentsRequest) ProtoMessage() {}
+
+func (x *UpdateGroupMaxStudentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateGroupMaxStudentsRequest.ProtoReflect.Descriptor instead.
+func (*UpdateGroupMaxStudentsRequest) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *UpdateGroupMaxStudentsRequest) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+func (x *UpdateGroupMaxStudentsRequest) GetMaxStudents() int32 {
+	if x != nil {
+		return x.MaxStudents
+	}
+	return 0
+}
+
+type ScheduleConflict struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// ROOM when the group uses the same room, TEACHER when it has the same teacher
+	Kind      string `protobuf:"bytes,1,opt,name=kind,proto3" json:"kind"`
+	GroupId   int64  `protobuf:"varint,2,opt,name=groupId,proto3" json:"groupId"`
+	GroupName string `protobuf:"bytes,3,opt,name=groupName,proto3" json:"groupName"`
+	RoomId    int32  `protobuf:"varint,4,opt,name=roomId,proto3" json:"roomId"`
+	TeacherId string `protobuf:"bytes,5,opt,name=teacherId,proto3" json:"teacherId"`
+	// weekdays both groups have lessons on, the transfer date for a transferred lesson
+	Days []string `protobuf:"bytes,6,rep,name=days,proto3" json:"days"`
+	// lesson time of the conflicting group, the end is the start plus courses.duration_lesson minutes
+	StartTime string `protobuf:"bytes,7,opt,name=startTime,proto3" json:"startTime"`
+	EndTime   string `protobuf:"bytes,8,opt,name=endTime,proto3" json:"endTime"`
+	// period both groups run in
+	FromDate      string `protobuf:"bytes,9,opt,name=fromDate,proto3" json:"fromDate"`
+	TillDate      string `protobuf:"bytes,10,opt,name=tillDate,proto3" json:"tillDate"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ScheduleConflict) Reset() {
+	*x = ScheduleConflict{}
+	mi := &file_education_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScheduleConflict) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScheduleConflict) ProtoMessage() {}
+
+func (x *ScheduleConflict) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScheduleConflict.ProtoReflect.Descriptor instead.
+func (*ScheduleConflict) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *ScheduleConflict) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *ScheduleConflict) GetGroupId() int64 {
+	if x != nil {
+		return x.GroupId
+	}
+	return 0
+}
+
+func (x *ScheduleConflict) GetGroupName() string {
+	if x != nil {
+		return x.GroupName
+	}
+	return ""
+}
+
+func (x *ScheduleConflict) GetRoomId() int32 {
+	if x != nil {
+		return x.RoomId
+	}
+	return 0
+}
+
+func (x *ScheduleConflict) GetTeacherId() string {
+	if x != nil {
+		return x.TeacherId
+	}
+	return ""
+}
+
+func (x *ScheduleConflict) GetDays() []string {
+	if x != nil {
+		return x.Days
+	}
+	return nil
+}
+
+func (x *ScheduleConflict) GetStartTime() string {
+	if x != nil {
+		return x.StartTime
+	}
+	return ""
+}
+
+func (x *ScheduleConflict) GetEndTime() string {
+	if x != nil {
+		return x.EndTime
+	}
+	return ""
+}
+
+func (x *ScheduleConflict) GetFromDate() string {
+	if x != nil {
+		return x.FromDate
+	}
+	return ""
+}
+
+func (x *ScheduleConflict) GetTillDate() string {
+	if x != nil {
+		return x.TillDate
+	}
+	return ""
+}
+
+type ScheduleConflicts struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Conflicts     []*ScheduleConflict    `protobuf:"bytes,1,rep,name=conflicts,proto3" json:"conflicts"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ScheduleConflicts) Reset() {
+	*x = ScheduleConflicts{}
+	mi := &file_education_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScheduleConflicts) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScheduleConflicts) ProtoMessage() {}
+
+func (x *ScheduleConflicts) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScheduleConflicts.ProtoReflect.Descriptor instead.
+func (*ScheduleConflicts) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *ScheduleConflicts) GetConflicts() []*ScheduleConflict {
+	if x != nil {
+		return x.Conflicts
+	}
+	return nil
+}
+
+type CheckScheduleConflictsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// group being edited, its own lessons are not conflicts
+	GroupId         string   `protobuf:"bytes,1,opt,name=groupId,proto3" json:"groupId"`
+	CourseId        int32    `protobuf:"varint,2,opt,name=courseId,proto3" json:"courseId"`
+	TeacherId       string   `protobuf:"bytes,3,opt,name=teacherId,proto3" json:"teacherId"`
+	RoomId          int32    `protobuf:"varint,4,opt,name=roomId,proto3" json:"roomId"`
+	Type            string   `protobuf:"bytes,5,opt,name=type,proto3" json:"type"`
+	Days            []string `protobuf:"bytes,6,rep,name=days,proto3" json:"days"`
+	LessonStartTime string   `protobuf:"bytes,7,opt,name=lessonStartTime,proto3" json:"lessonStartTime"`
+	GroupStartDate  string   `protobuf:"bytes,8,opt,name=groupStartDate,proto3" json:"groupStartDate"`
+	GroupEndDate    string   `protobuf:"bytes,9,opt,name=groupEndDate,proto3" json:"groupEndDate"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *CheckScheduleConflictsRequest) Reset() {
+	*x = CheckScheduleConflictsRequest{}
+	mi := &file_education_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckScheduleConflictsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckScheduleConflictsRequest) ProtoMessage() {}
+
+func (x *CheckScheduleConflictsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckScheduleConflictsRequest.ProtoReflect.Descriptor instead.
+func (*CheckScheduleConflictsRequest) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *CheckScheduleConflictsRequest) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+func (x *CheckScheduleConflictsRequest) GetCourseId() int32 {
+	if x != nil {
+		return x.CourseId
+	}
+	return 0
+}
+
+func (x *CheckScheduleConflictsRequest) GetTeacherId() string {
+	if x != nil {
+		return x.TeacherId
+	}
+	return ""
+}
+
+func (x *CheckScheduleConflictsRequest) GetRoomId() int32 {
+	if x != nil {
+		return x.RoomId
+	}
+	return 0
+}
+
+func (x *CheckScheduleConflictsRequest) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *CheckScheduleConflictsRequest) GetDays() []string {
+	if x != nil {
+		return x.Days
+	}
+	return nil
+}
+
+func (x *CheckScheduleConflictsRequest) GetLessonStartTime() string {
+	if x != nil {
+		return x.LessonStartTime
+	}
+	return ""
+}
+
+func (x *CheckScheduleConflictsRequest) GetGroupStartDate() string {
+	if x != nil {
+		return x.GroupStartDate
+	}
+	return ""
+}
+
+func (x *CheckScheduleConflictsRequest) GetGroupEndDate() string {
+	if x != nil {
+		return x.GroupEndDate
+	}
+	return ""
+}
+
+type GetScheduleConflictOverridesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	From          string                 `protobuf:"bytes,1,opt,name=from,proto3" json:"from"`
+	Till          string                 `protobuf:"bytes,2,opt,name=till,proto3" json:"till"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetScheduleConflictOverridesRequest) Reset() {
+	*x = GetScheduleConflictOverridesRequest{}
+	mi := &file_education_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetScheduleConflictOverridesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetScheduleConflictOverridesRequest) ProtoMessage() {}
+
+func (x *GetScheduleConflictOverridesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetScheduleConflictOverridesRequest.ProtoReflect.Descriptor instead.
+func (*GetScheduleConflictOverridesRequest) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *GetScheduleConflictOverridesRequest) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *GetScheduleConflictOverridesRequest) GetTill() string {
+	if x != nil {
+		return x.Till
+	}
+	return ""
+}
+
+type ScheduleConflictOverride struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Id        string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id"`
+	GroupId   int64                  `protobuf:"varint,2,opt,name=groupId,proto3" json:"groupId"`
+	GroupName string                 `protobuf:"bytes,3,opt,name=groupName,proto3" json:"groupName"`
+	// CREATE_GROUP, UPDATE_GROUP or TRANSFER_LESSON
+	Action        string              `protobuf:"bytes,4,opt,name=action,proto3" json:"action"`
+	Conflicts     []*ScheduleConflict `protobuf:"bytes,5,rep,name=conflicts,proto3" json:"conflicts"`
+	ForcedById    string              `protobuf:"bytes,6,opt,name=forcedById,proto3" json:"forcedById"`
+	ForcedByName  string              `protobuf:"bytes,7,opt,name=forcedByName,proto3" json:"forcedByName"`
+	CreatedAt     string              `protobuf:"bytes,8,opt,name=createdAt,proto3" json:"createdAt"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ScheduleConflictOverride) Reset() {
+	*x = ScheduleConflictOverride{}
+	mi := &file_education_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScheduleConflictOverride) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScheduleConflictOverride) ProtoMessage() {}
+
+func (x *ScheduleConflictOverride) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScheduleConflictOverride.ProtoReflect.Descriptor instead.
+func (*ScheduleConflictOverride) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *ScheduleConflictOverride) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ScheduleConflictOverride) GetGroupId() int64 {
+	if x != nil {
+		return x.GroupId
+	}
+	return 0
+}
+
+func (x *ScheduleConflictOverride) GetGroupName() string {
+	if x != nil {
+		return x.GroupName
+	}
+	return ""
+}
+
+func (x *ScheduleConflictOverride) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *ScheduleConflictOverride) GetConflicts() []*ScheduleConflict {
+	if x != nil {
+		return x.Conflicts
+	}
+	return nil
+}
+
+func (x *ScheduleConflictOverride) GetForcedById() string {
+	if x != nil {
+		return x.ForcedById
+	}
+	return ""
+}
+
+func (x *ScheduleConflictOverride) GetForcedByName() string {
+	if x != nil {
+		return x.ForcedByName
+	}
+	return ""
+}
+
+func (x *ScheduleConflictOverride) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+type GetScheduleConflictOverridesResponse struct {
+	state         protoimpl.MessageState      `protogen:"open.v1"`
+	Overrides     []*ScheduleConflictOverride `protobuf:"bytes,1,rep,name=overrides,proto3" json:"overrides"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetScheduleConflictOverridesResponse) Reset() {
+	*x = GetScheduleConflictOverridesResponse{}
+	mi := &file_education_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetScheduleConflictOverridesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetScheduleConflictOverridesResponse) ProtoMessage() {}
+
+func (x *GetScheduleConflictOverridesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetScheduleConflictOverridesResponse.ProtoReflect.Descriptor instead.
+func (*GetScheduleConflictOverridesResponse) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *GetScheduleConflictOverridesResponse) GetOverrides() []*ScheduleConflictOverride {
+	if x != nil {
+		return x.Overrides
+	}
+	return nil
+}
+
+type CalculateTeacherSalaryRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	From      string                 `protobuf:"bytes,1,opt,name=from,proto3" json:"from"`
+	To        string                 `protobuf:"bytes,2,opt,name=to,proto3" json:"to"`
+	TeacherId string                 `protobuf:"bytes,3,opt,name=teacherId,proto3" json:"teacherId"`
+	// calculates several teachers in one call, teacherId is ignored when set
+	TeacherIds    []string `protobuf:"bytes,4,rep,name=teacherIds,proto3" json:"teacherIds"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CalculateTeacherSalaryRequest) Reset() {
+	*x = CalculateTeacherSalaryRequest{}
+	mi := &file_education_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CalculateTeacherSalaryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CalculateTeacherSalaryRequest) ProtoMessage() {}
+
+func (x *CalculateTeacherSalaryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CalculateTeacherSalaryRequest.ProtoReflect.Descriptor instead.
+func (*CalculateTeacherSalaryRequest) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *CalculateTeacherSalaryRequest) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *CalculateTeacherSalaryRequest) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+func (x *CalculateTeacherSalaryRequest) GetTeacherId() string {
+	if x != nil {
+		return x.TeacherId
+	}
+	return ""
+}
+
+func (x *CalculateTeacherSalaryRequest) GetTeacherIds() []string {
+	if x != nil {
+		return x.TeacherIds
+	}
+	return nil
+}
+
+type CalculateTeacherSalaryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Salaries      []*AbsCalculateSalary  `protobuf:"bytes,1,rep,name=salaries,proto3" json:"salaries"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CalculateTeacherSalaryResponse) Reset() {
+	*x = CalculateTeacherSalaryResponse{}
+	mi := &file_education_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CalculateTeacherSalaryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CalculateTeacherSalaryResponse) ProtoMessage() {}
+
+func (x *CalculateTeacherSalaryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CalculateTeacherSalaryResponse.ProtoReflect.Descriptor instead.
+func (*CalculateTeacherSalaryResponse) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *CalculateTeacherSalaryResponse) GetSalaries() []*AbsCalculateSalary {
+	if x != nil {
+		return x.Salaries
+	}
+	return nil
+}
+
+type AbsCalculateSalary struct {
+	state                     protoimpl.MessageState `protogen:"open.v1"`
+	GroupId                   string                 `protobuf:"bytes,1,opt,name=groupId,proto3" json:"groupId"`
+	GroupName                 string                 `protobuf:"bytes,2,opt,name=groupName,proto3" json:"groupName"`
+	CommonLessonCountInPeriod int32                  `protobuf:"varint,3,opt,name=commonLessonCountInPeriod,proto3" json:"commonLessonCountInPeriod"`
+	Salaries                  []*StudentSalary       `protobuf:"bytes,4,rep,name=salaries,proto3" json:"salaries"`
+	TeacherId                 string                 `protobuf:"bytes,5,opt,name=teacherId,proto3" json:"teacherId"`
+	unknownFields             protoimpl.UnknownFields
+	sizeCache                 protoimpl.SizeCache
+}
+
+func (x *AbsCalculateSalary) Reset() {
+	*x = AbsCalculateSalary{}
+	mi := &file_education_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AbsCalculateSalary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AbsCalculateSalary) ProtoMessage() {}
+
+func (x *AbsCalculateSalary) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AbsCalculateSalary.ProtoReflect.Descriptor instead.
+func (*AbsCalculateSalary) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *AbsCalculateSalary) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+func (x *AbsCalculateSalary) GetGroupName() string {
+	if x != nil {
+		return x.GroupName
+	}
+	return ""
+}
+
+func (x *AbsCalculateSalary) GetCommonLessonCountInPeriod() int32 {
+	if x != nil {
+		return x.CommonLessonCountInPeriod
+	}
+	return 0
+}
+
+func (x *AbsCalculateSalary) GetSalaries() []*StudentSalary {
+	if x != nil {
+		return x.Salaries
+	}
+	return nil
+}
+
+func (x *AbsCalculateSalary) GetTeacherId() string {
+	if x != nil {
+		return x.TeacherId
+	}
+	return ""
+}
+
+type StudentSalary struct {
+	state                    protoimpl.MessageState `protogen:"open.v1"`
+	StudentId                string                 `protobuf:"bytes,1,opt,name=studentId,proto3" json:"studentId"`
+	StudentName              string                 `protobuf:"bytes,2,opt,name=studentName,proto3" json:"studentName"`
+	PassedLessonCount        int32                  `protobuf:"varint,3,opt,name=passedLessonCount,proto3" json:"passedLessonCount"`
+	CalculatedSalaryInPeriod int32                  `protobuf:"varint,4,opt,name=calculatedSalaryInPeriod,proto3" json:"calculatedSalaryInPeriod"`
+	PriceType                string                 `protobuf:"bytes,5,opt,name=priceType,proto3" json:"priceType"`
+	TotalCount               float64                `protobuf:"fixed64,6,opt,name=totalCount,proto3" json:"totalCount"`
+	CoursePrice              float64                `protobuf:"fixed64,7,opt,name=coursePrice,proto3" json:"coursePrice"`
+	unknownFields            protoimpl.UnknownFields
+	sizeCache                protoimpl.SizeCache
+}
+
+func (x *StudentSalary) Reset() {
+	*x = StudentSalary{}
+	mi := &file_education_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StudentSalary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StudentSalary) ProtoMessage() {}
+
+func (x *StudentSalary) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StudentSalary.ProtoReflect.Descriptor instead.
+func (*StudentSalary) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *StudentSalary) GetStudentId() string {
+	if x != nil {
+		return x.StudentId
+	}
+	return ""
+}
+
+func (x *StudentSalary) GetStudentName() string {
+	if x != nil {
+		return x.StudentName
+	}
+	return ""
+}
+
+func (x *StudentSalary) GetPassedLessonCount() int32 {
+	if x != nil {
+		return x.PassedLessonCount
+	}
+	return 0
+}
+
+func (x *StudentSalary) GetCalculatedSalaryInPeriod() int32 {
+	if x != nil {
+		return x.CalculatedSalaryInPeriod
+	}
+	return 0
+}
+
+func (x *StudentSalary) GetPriceType() string {
+	if x != nil {
+		return x.PriceType
+	}
+	return ""
+}
+
+func (x *StudentSalary) GetTotalCount() float64 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+func (x *StudentSalary) GetCoursePrice() float64 {
+	if x != nil {
+		return x.CoursePrice
+	}
+	return 0
+}
+
+type GetAttendanceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GroupId       string                 `protobuf:"bytes,1,opt,name=groupId,proto3" json:"groupId"`
+	From          string                 `protobuf:"bytes,2,opt,name=from,proto3" json:"from"`
+	Till          string                 `protobuf:"bytes,3,opt,name=till,proto3" json:"till"`
+	WithOutdated  bool                   `protobuf:"varint,4,opt,name=withOutdated,proto3" json:"withOutdated"`
+	ActionId      string                 `protobuf:"bytes,5,opt,name=actionId,proto3" json:"actionId"`
+	ActionRole    string                 `protobuf:"bytes,6,opt,name=actionRole,proto3" json:"actionRole"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAttendanceRequest) Reset() {
+	*x = GetAttendanceRequest{}
+	mi := &file_education_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAttendanceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAttendanceRequest) ProtoMessage() {}
+
+func (x *GetAttendanceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAttendanceRequest.ProtoReflect.Descriptor instead.
+func (*GetAttendanceRequest) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *GetAttendanceRequest) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+func (x *GetAttendanceRequest) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *GetAttendanceRequest) GetTill() string {
+	if x != nil {
+		return x.Till
+	}
+	return ""
+}
+
+func (x *GetAttendanceRequest) GetWithOutdated() bool {
+	if x != nil {
+		return x.WithOutdated
+	}
+	return false
+}
+
+func (x *GetAttendanceRequest) GetActionId() string {
+	if x != nil {
+		return x.ActionId
+	}
+	return ""
+}
+
+func (x *GetAttendanceRequest) GetActionRole() string {
+	if x != nil {
+		return x.ActionRole
+	}
+	return ""
+}
+
+type GetAttendanceResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Days          []*Day                 `protobuf:"bytes,1,rep,name=days,proto3" json:"days"`
+	Students      []*Student             `protobuf:"bytes,2,rep,name=students,proto3" json:"students"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAttendanceResponse) Reset() {
+	*x = GetAttendanceResponse{}
+	mi := &file_education_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAttendanceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAttendanceResponse) ProtoMessage() {}
+
+func (x *GetAttendanceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAttendanceResponse.ProtoReflect.Descriptor instead.
+func (*GetAttendanceResponse) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *GetAttendanceResponse) GetDays() []*Day {
+	if x != nil {
+		return x.Days
+	}
+	return nil
+}
+
+func (x *GetAttendanceResponse) GetStudents() []*Student {
+	if x != nil {
+		return x.Students
+	}
+	return nil
+}
+
+type Day struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Date          string                 `protobuf:"bytes,1,opt,name=date,proto3" json:"date"`
+	TransferDate  string                 `protobuf:"bytes,2,opt,name=transferDate,proto3" json:"transferDate"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Day) Reset() {
+	*x = Day{}
+	mi := &file_education_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Day) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Day) ProtoMessage() {}
+
+func (x *Day) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Day.ProtoReflect.Descriptor instead.
+func (*Day) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *Day) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *Day) GetTransferDate() string {
+	if x != nil {
+		return x.TransferDate
+	}
+	return ""
+}
+
+type Student struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,9,opt,name=id,proto3" json:"id"`
+	ActivatedAt   string                 `protobuf:"bytes,1,opt,name=activatedAt,proto3" json:"activatedAt"`
+	AddedAt       string                 `protobuf:"bytes,2,opt,name=addedAt,proto3" json:"addedAt"`
+	Attendance    []*Attendance          `protobuf:"bytes,3,rep,name=attendance,proto3" json:"attendance"`
+	Balance       float64                `protobuf:"fixed64,4,opt,name=balance,proto3" json:"balance"`
+	CreatedAt     string                 `protobuf:"bytes,5,opt,name=createdAt,proto3" json:"createdAt"`
+	DateOfBirth   string                 `protobuf:"bytes,6,opt,name=dateOfBirth,proto3" json:"dateOfBirth"`
+	FreezeDetail  *FreezeDetail          `protobuf:"bytes,7,opt,name=freezeDetail,proto3" json:"freezeDetail"`
+	Gender        bool                   `protobuf:"varint,8,opt,name=gender,proto3" json:"gender"`
+	Condition     string                 `protobuf:"bytes,10,opt,name=condition,proto3" json:"condition"`
+	Name          string                 `protobuf:"bytes,11,opt,name=name,proto3" json:"name"`
+	Phone         string                 `protobuf:"bytes,12,opt,name=phone,proto3" json:"phone"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Student) Reset() {
+	*x = Student{}
+	mi := &file_education_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Student) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Student) ProtoMessage() {}
+
+func (x *Student) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Student.ProtoReflect.Descriptor instead.
+func (*Student) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *Student) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Student) GetActivatedAt() string {
+	if x != nil {
+		return x.ActivatedAt
+	}
+	return ""
+}
+
+func (x *Student) GetAddedAt() string {
+	if x != nil {
+		return x.AddedAt
+	}
+	return ""
+}
+
+func (x *Student) GetAttendance() []*Attendance {
+	if x != nil {
+		return x.Attendance
+	}
+	return nil
+}
+
+func (x *Student) GetBalance() float64 {
+	if x != nil {
+		return x.Balance
+	}
+	return 0
+}
+
+func (x *Student) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *Student) GetDateOfBirth() string {
+	if x != nil {
+		return x.DateOfBirth
+	}
+	return ""
+}
+
+func (x *Student) GetFreezeDetail() *FreezeDetail {
+	if x != nil {
+		return x.FreezeDetail
+	}
+	return nil
+}
+
+func (x *Student) GetGender() bool {
+	if x != nil {
+		return x.Gender
+	}
+	return false
+}
+
+func (x *Student) GetCondition() string {
+	if x != nil {
+		return x.Condition
+	}
+	return ""
+}
+
+func (x *Student) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Student) GetPhone() string {
+	if x != nil {
+		return x.Phone
+	}
+	return ""
+}
+
+type Attendance struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	Id         string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id"`
+	AttendDate string                 `protobuf:"bytes,2,opt,name=attend_date,json=attendDate,proto3" json:"attend_date"`
+	// the status is billable
+	IsCome     bool   `protobuf:"varint,3,opt,name=isCome,proto3" json:"isCome"`
+	StudentId  string `protobuf:"bytes,4,opt,name=studentId,proto3" json:"studentId"`
+	TeacherId  string `protobuf:"bytes,5,opt,name=teacherId,proto3" json:"teacherId"`
+	Status     int32  `protobuf:"varint,6,opt,name=status,proto3" json:"status"`
+	StatusName string `protobuf:"bytes,7,opt,name=statusName,proto3" json:"statusName"`
+	// the absence a makeup attendance makes up
+	MakeupGroupId string `protobuf:"bytes,8,opt,name=makeupGroupId,proto3" json:"makeupGroupId"`
+	MakeupDate    string `protobuf:"bytes,9,opt,name=makeupDate,proto3" json:"makeupDate"`
+	// the student checked in by scanning the code of the lesson
+	SelfCheckIn bool `protobuf:"varint,10,opt,name=selfCheckIn,proto3" json:"selfCheckIn"`
+	// a check in is neither billed nor paid until the teacher confirms it
+	Confirmed     bool `protobuf:"varint,11,opt,name=confirmed,proto3" json:"confirmed"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Attendance) Reset() {
+	*x = Attendance{}
+	mi := &file_education_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Attendance) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Attendance) ProtoMessage() {}
+
+func (x *Attendance) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Attendance.ProtoReflect.Descriptor instead.
+func (*Attendance) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *Attendance) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Attendance) GetAttendDate() string {
+	if x != nil {
+		return x.AttendDate
+	}
+	return ""
+}
+
+func (x *Attendance) GetIsCome() bool {
+	if x != nil {
+		return x.IsCome
+	}
+	return false
+}
+
+func (x *Attendance) GetStudentId() string {
+	if x != nil {
+		return x.StudentId
+	}
+	return ""
+}
+
+func (x *Attendance) GetTeacherId() string {
+	if x != nil {
+		return x.TeacherId
+	}
+	return ""
+}
+
+func (x *Attendance) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *Attendance) GetStatusName() string {
+	if x != nil {
+		return x.StatusName
+	}
+	return ""
+}
+
+func (x *Attendance) GetMakeupGroupId() string {
+	if x != nil {
+		return x.MakeupGroupId
+	}
+	return ""
+}
+
+func (x *Attendance) GetMakeupDate() string {
+	if x != nil {
+		return x.MakeupDate
+	}
+	return ""
+}
+
+func (x *Attendance) GetSelfCheckIn() bool {
+	if x != nil {
+		return x.SelfCheckIn
+	}
+	return false
+}
+
+func (x *Attendance) GetConfirmed() bool {
+	if x != nil {
+		return x.Confirmed
+	}
+	return false
+}
+
+type FreezeDetail struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Reason        string                 `protobuf:"bytes,1,opt,name=reason,proto3" json:"reason"`
+	TillDate      string                 `protobuf:"bytes,2,opt,name=till_date,json=tillDate,proto3" json:"till_date"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FreezeDetail) Reset() {
+	*x = FreezeDetail{}
+	mi := &file_education_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FreezeDetail) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FreezeDetail) ProtoMessage() {}
+
+func (x *FreezeDetail) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FreezeDetail.ProtoReflect.Descriptor instead.
+func (*FreezeDetail) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *FreezeDetail) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *FreezeDetail) GetTillDate() string {
+	if x != nil {
+		return x.TillDate
+	}
+	return ""
+}
+
+type SetAttendanceRequest struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	AttendDate   string                 `protobuf:"bytes,1,opt,name=attendDate,proto3" json:"attendDate"`
+	GroupId      string                 `protobuf:"bytes,2,opt,name=groupId,proto3" json:"groupId"`
+	Status       int32                  `protobuf:"varint,3,opt,name=status,proto3" json:"status"`
+	StudentId    string                 `protobuf:"bytes,4,opt,name=studentId,proto3" json:"studentId"`
+	TeacherId    string                 `protobuf:"bytes,5,opt,name=teacherId,proto3" json:"teacherId"`
+	ActionById   string                 `protobuf:"bytes,6,opt,name=actionById,proto3" json:"actionById"`
+	ActionByRole string                 `protobuf:"bytes,7,opt,name=actionByRole,proto3" json:"actionByRole"`
+	// group and yyyy-MM-dd date of the absence a makeup status makes up
+	MakeupGroupId string `protobuf:"bytes,8,opt,name=makeupGroupId,proto3" json:"makeupGroupId"`
+	MakeupDate    string `protobuf:"bytes,9,opt,name=makeupDate,proto3" json:"makeupDate"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetAttendanceRequest) Reset() {
+	*x = SetAttendanceRequest{}
+	mi := &file_education_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetAttendanceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetAttendanceRequest) ProtoMessage() {}
+
+func (x *SetAttendanceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetAttendanceRequest.ProtoReflect.Descriptor instead.
+func (*SetAttendanceRequest) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *SetAttendanceRequest) GetAttendDate() string {
+	if x != nil {
+		return x.AttendDate
+	}
+	return ""
+}
+
+func (x *SetAttendanceRequest) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+func (x *SetAttendanceRequest) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *SetAttendanceRequest) GetStudentId() string {
+	if x != nil {
+		return x.StudentId
+	}
+	return ""
+}
+
+func (x *SetAttendanceRequest) GetTeacherId() string {
+	if x != nil {
+		return x.TeacherId
+	}
+	return ""
+}
+
+func (x *SetAttendanceRequest) GetActionById() string {
+	if x != nil {
+		return x.ActionById
+	}
+	return ""
+}
+
+func (x *SetAttendanceRequest) GetActionByRole() string {
+	if x != nil {
+		return x.ActionByRole
+	}
+	return ""
+}
+
+func (x *SetAttendanceRequest) GetMakeupGroupId() string {
+	if x != nil {
+		return x.MakeupGroupId
+	}
+	return ""
+}
+
+func (x *SetAttendanceRequest) GetMakeupDate() string {
+	if x != nil {
+		return x.MakeupDate
+	}
+	return ""
+}
+
+// SetGroupAttendanceRequest marks the roster of a group for one date
+type SetGroupAttendanceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AttendDate    string                 `protobuf:"bytes,1,opt,name=attendDate,proto3" json:"attendDate"`
+	GroupId       string                 `protobuf:"bytes,2,opt,name=groupId,proto3" json:"groupId"`
+	TeacherId     string                 `protobuf:"bytes,3,opt,name=teacherId,proto3" json:"teacherId"`
+	Marks         []*GroupAttendanceMark `protobuf:"bytes,4,rep,name=marks,proto3" json:"marks"`
+	ActionById    string                 `protobuf:"bytes,5,opt,name=actionById,proto3" json:"actionById"`
+	ActionByRole  string                 `protobuf:"bytes,6,opt,name=actionByRole,proto3" json:"actionByRole"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetGroupAttendanceRequest) Reset() {
+	*x = SetGroupAttendanceRequest{}
+	mi := &file_education_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetGroupAttendanceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetGroupAttendanceRequest) ProtoMessage() {}
+
+func (x *SetGroupAttendanceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetGroupAttendanceRequest.ProtoReflect.Descriptor instead.
+func (*SetGroupAttendanceRequest) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *SetGroupAttendanceRequest) GetAttendDate() string {
+	if x != nil {
+		return x.AttendDate
+	}
+	return ""
+}
+
+func (x *SetGroupAttendanceRequest) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+func (x *SetGroupAttendanceRequest) GetTeacherId() string {
+	if x != nil {
+		return x.TeacherId
+	}
+	return ""
+}
+
+func (x *SetGroupAttendanceRequest) GetMarks() []*GroupAttendanceMark {
+	if x != nil {
+		return x.Marks
+	}
+	return nil
+}
+
+func (x *SetGroupAttendanceRequest) GetActionById() string {
+	if x != nil {
+		return x.ActionById
+	}
+	return ""
+}
+
+func (x *SetGroupAttendanceRequest) GetActionByRole() string {
+	if x != nil {
+		return x.ActionByRole
+	}
+	return ""
+}
+
+type GroupAttendanceMark struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	StudentId string                 `protobuf:"bytes,1,opt,name=studentId,proto3" json:"studentId"`
+	// status as in SetAttendanceRequest, -1 deletes the attendance
+	Status int32 `protobuf:"varint,2,opt,name=status,proto3" json:"status"`
+	// the absence a makeup status makes up, a student making up an absence need not be in the group
+	MakeupGroupId string `protobuf:"bytes,3,opt,name=makeupGroupId,proto3" json:"makeupGroupId"`
+	MakeupDate    string `protobuf:"bytes,4,opt,name=makeupDate,proto3" json:"makeupDate"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GroupAttendanceMark) Reset() {
+	*x = GroupAttendanceMark{}
+	mi := &file_education_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GroupAttendanceMark) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GroupAttendanceMark) ProtoMessage() {}
+
+func (x *GroupAttendanceMark) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GroupAttendanceMark.ProtoReflect.Descriptor instead.
+func (*GroupAttendanceMark) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *GroupAttendanceMark) GetStudentId() string {
+	if x != nil {
+		return x.StudentId
+	}
+	return ""
+}
+
+func (x *GroupAttendanceMark) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *GroupAttendanceMark) GetMakeupGroupId() string {
+	if x != nil {
+		return x.MakeupGroupId
+	}
+	return ""
+}
+
+func (x *GroupAttendanceMark) GetMakeupDate() string {
+	if x != nil {
+		return x.MakeupDate
+	}
+	return ""
+}
+
+type GroupAttendanceResult struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StudentId     string                 `protobuf:"bytes,1,opt,name=studentId,proto3" json:"studentId"`
+	Status        int32                  `protobuf:"varint,2,opt,name=status,proto3" json:"status"`
+	Success       bool                   `protobuf:"varint,3,opt,name=success,proto3" json:"success"`
+	Message       string                 `protobuf:"bytes,4,opt,name=message,proto3" json:"message"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GroupAttendanceResult) Reset() {
+	*x = GroupAttendanceResult{}
+	mi := &file_education_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GroupAttendanceResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GroupAttendanceResult) ProtoMessage() {}
+
+func (x *GroupAttendanceResult) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GroupAttendanceResult.ProtoReflect.Descriptor instead.
+func (*GroupAttendanceResult) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *GroupAttendanceResult) GetStudentId() string {
+	if x != nil {
+		return x.StudentId
+	}
+	return ""
+}
+
+func (x *GroupAttendanceResult) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *GroupAttendanceResult) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *GroupAttendanceResult) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type SetGroupAttendanceResponse struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	Results       []*GroupAttendanceResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results"`
+	Succeeded     int32                    `protobuf:"varint,2,opt,name=succeeded,proto3" json:"succeeded"`
+	Failed        int32                    `protobuf:"varint,3,opt,name=failed,proto3" json:"failed"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetGroupAttendanceResponse) Reset() {
+	*x = SetGroupAttendanceResponse{}
+	mi := &file_education_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetGroupAttendanceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetGroupAttendanceResponse) ProtoMessage() {}
+
+func (x *SetGroupAttendanceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetGroupAttendanceResponse.ProtoReflect.Descriptor instead.
+func (*SetGroupAttendanceResponse) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *SetGroupAttendanceResponse) GetResults() []*GroupAttendanceResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+func (x *SetGroupAttendanceResponse) GetSucceeded() int32 {
+	if x != nil {
+		return x.Succeeded
+	}
+	return 0
+}
+
+func (x *SetGroupAttendanceResponse) GetFailed() int32 {
+	if x != nil {
+		return x.Failed
+	}
+	return 0
+}
+
+type AbsAttendanceStatus struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id"`
+	// the value marked as status of an attendance, assigned when the status is created
+	Code int32  `protobuf:"varint,2,opt,name=code,proto3" json:"code"`
+	Name string `protobuf:"bytes,3,opt,name=name,proto3" json:"name"`
+	// charges the lesson price or a package credit in groups not billed monthly
+	Billable bool `protobuf:"varint,4,opt,name=billable,proto3" json:"billable"`
+	// counts the student in the salary of the teacher of the lesson
+	PaysTeacher bool `protobuf:"varint,5,opt,name=paysTeacher,proto3" json:"paysTeacher"`
+	// sends the NOT_PARTICIPATE_ALERT sms
+	AbsenceSms bool `protobuf:"varint,6,opt,name=absenceSms,proto3" json:"absenceSms"`
+	// attending a lesson to make up an absence, the mark names the absence
+	IsMakeup bool `protobuf:"varint,7,opt,name=isMakeup,proto3" json:"isMakeup"`
+	// preloaded status, it can be changed or switched off but not deleted
+	IsDefault     bool `protobuf:"varint,8,opt,name=isDefault,proto3" json:"isDefault"`
+	IsActive      bool `protobuf:"varint,9,opt,name=isActive,proto3" json:"isActive"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AbsAttendanceStatus) Reset() {
+	*x = AbsAttendanceStatus{}
+	mi := &file_education_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AbsAttendanceStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AbsAttendanceStatus) ProtoMessage() {}
+
+func (x *AbsAttendanceStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AbsAttendanceStatus.ProtoReflect.Descriptor instead.
+func (*AbsAttendanceStatus) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *AbsAttendanceStatus) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *AbsAttendanceStatus) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *AbsAttendanceStatus) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *AbsAttendanceStatus) GetBillable() bool {
+	if x != nil {
+		return x.Billable
+	}
+	return false
+}
+
+func (x *AbsAttendanceStatus) GetPaysTeacher() bool {
+	if x != nil {
+		return x.PaysTeacher
+	}
+	return false
+}
+
+func (x *AbsAttendanceStatus) GetAbsenceSms() bool {
+	if x != nil {
+		return x.AbsenceSms
+	}
+	return false
+}
+
+func (x *AbsAttendanceStatus) GetIsMakeup() bool {
+	if x != nil {
+		return x.IsMakeup
+	}
+	return false
+}
+
+func (x *AbsAttendanceStatus) GetIsDefault() bool {
+	if x != nil {
+		return x.IsDefault
+	}
+	return false
+}
+
+func (x *AbsAttendanceStatus) GetIsActive() bool {
+	if x != nil {
+		return x.IsActive
+	}
+	return false
+}
+
+type GetAttendanceStatusesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	WithInactive  bool                   `protobuf:"varint,1,opt,name=withInactive,proto3" json:"withInactive"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAttendanceStatusesRequest) Reset() {
+	*x = GetAttendanceStatusesRequest{}
+	mi := &file_education_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAttendanceStatusesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAttendanceStatusesRequest) ProtoMessage() {}
+
+func (x *GetAttendanceStatusesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAttendanceStatusesRequest.ProtoReflect.Descriptor instead.
+func (*GetAttendanceStatusesRequest) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *GetAttendanceStatusesRequest) GetWithInactive() bool {
+	if x != nil {
+		return x.WithInactive
+	}
+	return false
+}
+
+type GetAttendanceStatusesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Statuses      []*AbsAttendanceStatus `protobuf:"bytes,1,rep,name=statuses,proto3" json:"statuses"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAttendanceStatusesResponse) Reset() {
+	*x = GetAttendanceStatusesResponse{}
+	mi := &file_education_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAttendanceStatusesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAttendanceStatusesResponse) ProtoMessage() {}
+
+func (x *GetAttendanceStatusesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAttendanceStatusesResponse.ProtoReflect.Descriptor instead.
+func (*GetAttendanceStatusesResponse) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *GetAttendanceStatusesResponse) GetStatuses() []*AbsAttendanceStatus {
+	if x != nil {
+		return x.Statuses
+	}
+	return nil
+}
+
+// AttendancePolicy is when teachers may mark attendance, roles in overrideRoles may mark any date
+type AttendancePolicy struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// days before today a teacher may still mark, 0 allows today only
+	EditDaysBack int32 `protobuf:"varint,1,opt,name=editDaysBack,proto3" json:"editDaysBack"`
+	// hour (0-24) of the day after the edit window at which the oldest editable day closes, 24 closes it at midnight
+	CutoffHour int32 `protobuf:"varint,2,opt,name=cutoffHour,proto3" json:"cutoffHour"`
+	// IANA timezone the days and the cutoff hour are counted in
+	Timezone      string   `protobuf:"bytes,3,opt,name=timezone,proto3" json:"timezone"`
+	AllowFuture   bool     `protobuf:"varint,4,opt,name=allowFuture,proto3" json:"allowFuture"`
+	OverrideRoles []string `protobuf:"bytes,5,rep,name=overrideRoles,proto3" json:"overrideRoles"`
+	ActionById    string   `protobuf:"bytes,6,opt,name=actionById,proto3" json:"actionById"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AttendancePolicy) Reset() {
+	*x = AttendancePolicy{}
+	mi := &file_education_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AttendancePolicy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AttendancePolicy) ProtoMessage() {}
+
+func (x *AttendancePolicy) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[71]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AttendancePolicy.ProtoReflect.Descriptor instead.
+func (*AttendancePolicy) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *AttendancePolicy) GetEditDaysBack() int32 {
+	if x != nil {
+		return x.EditDaysBack
+	}
+	return 0
+}
+
+func (x *AttendancePolicy) GetCutoffHour() int32 {
+	if x != nil {
+		return x.CutoffHour
+	}
+	return 0
+}
+
+func (x *AttendancePolicy) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+func (x *AttendancePolicy) GetAllowFuture() bool {
+	if x != nil {
+		return x.AllowFuture
+	}
+	return false
+}
+
+func (x *AttendancePolicy) GetOverrideRoles() []string {
+	if x != nil {
+		return x.OverrideRoles
+	}
+	return nil
+}
+
+func (x *AttendancePolicy) GetActionById() string {
+	if x != nil {
+		return x.ActionById
+	}
+	return ""
+}
+
+type RequestAttendanceUnlockRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	GroupId string                 `protobuf:"bytes,1,opt,name=groupId,proto3" json:"groupId"`
+	// yyyy-MM-dd date the teacher can no longer mark
+	AttendDate    string `protobuf:"bytes,2,opt,name=attendDate,proto3" json:"attendDate"`
+	Reason        string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason"`
+	ActionById    string `protobuf:"bytes,4,opt,name=actionById,proto3" json:"actionById"`
+	ActionByName  string `protobuf:"bytes,5,opt,name=actionByName,proto3" json:"actionByName"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RequestAttendanceUnlockRequest) Reset() {
+	*x = RequestAttendanceUnlockRequest{}
+	mi := &file_education_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RequestAttendanceUnlockRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestAttendanceUnlockRequest) ProtoMessage() {}
+
+func (x *RequestAttendanceUnlockRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[72]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestAttendanceUnlockRequest.ProtoReflect.Descriptor instead.
+func (*RequestAttendanceUnlockRequest) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *RequestAttendanceUnlockRequest) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+func (x *RequestAttendanceUnlockRequest) GetAttendDate() string {
+	if x != nil {
+		return x.AttendDate
+	}
+	return ""
+}
+
+func (x *RequestAttendanceUnlockRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *RequestAttendanceUnlockRequest) GetActionById() string {
+	if x != nil {
+		return x.ActionById
+	}
+	return ""
+}
+
+func (x *RequestAttendanceUnlockRequest) GetActionByName() string {
+	if x != nil {
+		return x.ActionByName
+	}
+	return ""
+}
+
+type AttendanceUnlockRequest struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Id          string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id"`
+	GroupId     int64                  `protobuf:"varint,2,opt,name=groupId,proto3" json:"groupId"`
+	GroupName   string                 `protobuf:"bytes,3,opt,name=groupName,proto3" json:"groupName"`
+	AttendDate  string                 `protobuf:"bytes,4,opt,name=attendDate,proto3" json:"attendDate"`
+	TeacherId   string                 `protobuf:"bytes,5,opt,name=teacherId,proto3" json:"teacherId"`
+	TeacherName string                 `protobuf:"bytes,6,opt,name=teacherName,proto3" json:"teacherName"`
+	Reason      string                 `protobuf:"bytes,7,opt,name=reason,proto3" json:"reason"`
+	// PENDING, APPROVED or REJECTED
+	Status         string `protobuf:"bytes,8,opt,name=status,proto3" json:"status"`
+	ReviewedByName string `protobuf:"bytes,9,opt,name=reviewedByName,proto3" json:"reviewedByName"`
+	ReviewedAt     string `protobuf:"bytes,10,opt,name=reviewedAt,proto3" json:"reviewedAt"`
+	// the date is open for the teacher until then
+	UnlockedUntil string `protobuf:"bytes,11,opt,name=unlockedUntil,proto3" json:"unlockedUntil"`
+	CreatedAt     string `protobuf:"bytes,12,opt,name=createdAt,proto3" json:"createdAt"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AttendanceUnlockRequest) Reset() {
+	*x = AttendanceUnlockRequest{}
+	mi := &file_education_proto_msgTypes[73]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AttendanceUnlockRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AttendanceUnlockRequest) ProtoMessage() {}
+
+func (x *AttendanceUnlockRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[73]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AttendanceUnlockRequest.ProtoReflect.Descriptor instead.
+func (*AttendanceUnlockRequest) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *AttendanceUnlockRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *AttendanceUnlockRequest) GetGroupId() int64 {
+	if x != nil {
+		return x.GroupId
+	}
+	return 0
+}
+
+func (x *AttendanceUnlockRequest) GetGroupName() string {
+	if x != nil {
+		return x.GroupName
+	}
+	return ""
+}
+
+func (x *AttendanceUnlockRequest) GetAttendDate() string {
+	if x != nil {
+		return x.AttendDate
+	}
+	return ""
+}
+
+func (x *AttendanceUnlockRequest) GetTeacherId() string {
+	if x != nil {
+		return x.TeacherId
+	}
+	return ""
+}
+
+func (x *AttendanceUnlockRequest) GetTeacherName() string {
+	if x != nil {
+		return x.TeacherName
+	}
+	return ""
+}
+
+func (x *AttendanceUnlockRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *AttendanceUnlockRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *AttendanceUnlockRequest) GetReviewedByName() string {
+	if x != nil {
+		return x.ReviewedByName
+	}
+	return ""
+}
+
+func (x *AttendanceUnlockRequest) GetReviewedAt() string {
+	if x != nil {
+		return x.ReviewedAt
+	}
+	return ""
+}
+
+func (x *AttendanceUnlockRequest) GetUnlockedUntil() string {
+	if x != nil {
+		return x.UnlockedUntil
+	}
+	return ""
+}
+
+func (x *AttendanceUnlockRequest) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+type GetAttendanceUnlockRequestsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// all statuses when empty
+	Status string `protobuf:"bytes,1,opt,name=status,proto3" json:"status"`
+	// a teacher only sees the requests of its own
+	ActionById    string `protobuf:"bytes,2,opt,name=actionById,proto3" json:"actionById"`
+	ActionByRole  string `protobuf:"bytes,3,opt,name=actionByRole,proto3" json:"actionByRole"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAttendanceUnlockRequestsRequest) Reset() {
+	*x = GetAttendanceUnlockRequestsRequest{}
+	mi := &file_education_proto_msgTypes[74]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAttendanceUnlockRequestsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAttendanceUnlockRequestsRequest) ProtoMessage() {}
+
+func (x *GetAttendanceUnlockRequestsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[74]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAttendanceUnlockRequestsRequest.ProtoReflect.Descriptor instead.
+func (*GetAttendanceUnlockRequestsRequest) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *GetAttendanceUnlockRequestsRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *GetAttendanceUnlockRequestsRequest) GetActionById() string {
+	if x != nil {
+		return x.ActionById
+	}
+	return ""
+}
+
+func (x *GetAttendanceUnlockRequestsRequest) GetActionByRole() string {
+	if x != nil {
+		return x.ActionByRole
+	}
+	return ""
+}
+
+type GetAttendanceUnlockRequestsResponse struct {
+	state         protoimpl.MessageState     `protogen:"open.v1"`
+	Requests      []*AttendanceUnlockRequest `protobuf:"bytes,1,rep,name=requests,proto3" json:"requests"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAttendanceUnlockRequestsResponse) Reset() {
+	*x = GetAttendanceUnlockRequestsResponse{}
+	mi := &file_education_proto_msgTypes[75]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAttendanceUnlockRequestsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAttendanceUnlockRequestsResponse) ProtoMessage() {}
+
+func (x *GetAttendanceUnlockRequestsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[75]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAttendanceUnlockRequestsResponse.ProtoReflect.Descriptor instead.
+func (*GetAttendanceUnlockRequestsResponse) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *GetAttendanceUnlockRequestsResponse) GetRequests() []*AttendanceUnlockRequest {
+	if x != nil {
+		return x.Requests
+	}
+	return nil
+}
+
+type ReviewAttendanceUnlockRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Id      string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id"`
+	Approve bool                   `protobuf:"varint,2,opt,name=approve,proto3" json:"approve"`
+	// hours the date stays open after approval, 24 when 0
+	Hours         int32  `protobuf:"varint,3,opt,name=hours,proto3" json:"hours"`
+	ActionById    string `protobuf:"bytes,4,opt,name=actionById,proto3" json:"actionById"`
+	ActionByName  string `protobuf:"bytes,5,opt,name=actionByName,proto3" json:"actionByName"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReviewAttendanceUnlockRequest) Reset() {
+	*x = ReviewAttendanceUnlockRequest{}
+	mi := &file_education_proto_msgTypes[76]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReviewAttendanceUnlockRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReviewAttendanceUnlockRequest) ProtoMessage() {}
+
+func (x *ReviewAttendanceUnlockRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[76]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReviewAttendanceUnlockRequest.ProtoReflect.Descriptor instead.
+func (*ReviewAttendanceUnlockRequest) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *ReviewAttendanceUnlockRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ReviewAttendanceUnlockRequest) GetApprove() bool {
+	if x != nil {
+		return x.Approve
+	}
+	return false
+}
+
+func (x *ReviewAttendanceUnlockRequest) GetHours() int32 {
+	if x != nil {
+		return x.Hours
+	}
+	return 0
+}
+
+func (x *ReviewAttendanceUnlockRequest) GetActionById() string {
+	if x != nil {
+		return x.ActionById
+	}
+	return ""
+}
+
+func (x *ReviewAttendanceUnlockRequest) GetActionByName() string {
+	if x != nil {
+		return x.ActionByName
+	}
+	return ""
+}
+
+type SendStudentLoginCodeRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// subdomain of the company the student studies at
+	Subdomain     string `protobuf:"bytes,1,opt,name=subdomain,proto3" json:"subdomain"`
+	Phone         string `protobuf:"bytes,2,opt,name=phone,proto3" json:"phone"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SendStudentLoginCodeRequest) Reset() {
+	*x = SendStudentLoginCodeRequest{}
+	mi := &file_education_proto_msgTypes[77]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SendStudentLoginCodeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendStudentLoginCodeRequest) ProtoMessage() {}
+
+func (x *SendStudentLoginCodeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[77]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendStudentLoginCodeRequest.ProtoReflect.Descriptor instead.
+func (*SendStudentLoginCodeRequest) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *SendStudentLoginCodeRequest) GetSubdomain() string {
+	if x != nil {
+		return x.Subdomain
+	}
+	return ""
+}
+
+func (x *SendStudentLoginCodeRequest) GetPhone() string {
+	if x != nil {
+		return x.Phone
+	}
+	return ""
+}
+
+type VerifyStudentLoginCodeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Subdomain     string                 `protobuf:"bytes,1,opt,name=subdomain,proto3" json:"subdomain"`
+	Phone         string                 `protobuf:"bytes,2,opt,name=phone,proto3" json:"phone"`
+	Code          string                 `protobuf:"bytes,3,opt,name=code,proto3" json:"code"`
+	Device        string                 `protobuf:"bytes,4,opt,name=device,proto3" json:"device"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VerifyStudentLoginCodeRequest) Reset() {
+	*x = VerifyStudentLoginCodeRequest{}
+	mi := &file_education_proto_msgTypes[78]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VerifyStudentLoginCodeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyStudentLoginCodeRequest) ProtoMessage() {}
+
+func (x *VerifyStudentLoginCodeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[78]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyStudentLoginCodeRequest.ProtoReflect.Descriptor instead.
+func (*VerifyStudentLoginCodeRequest) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *VerifyStudentLoginCodeRequest) GetSubdomain() string {
+	if x != nil {
+		return x.Subdomain
+	}
+	return ""
+}
+
+func (x *VerifyStudentLoginCodeRequest) GetPhone() string {
+	if x != nil {
+		return x.Phone
+	}
+	return ""
+}
+
+func (x *VerifyStudentLoginCodeRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *VerifyStudentLoginCodeRequest) GetDevice() string {
+	if x != nil {
+		return x.Device
+	}
+	return ""
+}
+
+type StudentSession struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	StudentId   string                 `protobuf:"bytes,1,opt,name=studentId,proto3" json:"studentId"`
+	StudentName string                 `protobuf:"bytes,2,opt,name=studentName,proto3" json:"studentName"`
+	CompanyId   string                 `protobuf:"bytes,3,opt,name=companyId,proto3" json:"companyId"`
+	// bearer token of the student, only returned when the student logs in
+	Token         string `protobuf:"bytes,4,opt,name=token,proto3" json:"token"`
+	ExpiresAt     string `protobuf:"bytes,5,opt,name=expiresAt,proto3" json:"expiresAt"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StudentSession) Reset() {
+	*x = StudentSession{}
+	mi := &file_education_proto_msgTypes[79]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StudentSession) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StudentSession) ProtoMessage() {}
+
+func (x *StudentSession) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[79]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StudentSession.ProtoReflect.Descriptor instead.
+func (*StudentSession) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *StudentSession) GetStudentId() string {
+	if x != nil {
+		return x.StudentId
+	}
+	return ""
+}
+
+func (x *StudentSession) GetStudentName() string {
+	if x != nil {
+		return x.StudentName
+	}
+	return ""
+}
+
+func (x *StudentSession) GetCompanyId() string {
+	if x != nil {
+		return x.CompanyId
+	}
+	return ""
+}
+
+func (x *StudentSession) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *StudentSession) GetExpiresAt() string {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return ""
+}
+
+type VerifyStudentLoginCodeResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// one session for every student of the phone, siblings often share one
+	Sessions      []*StudentSession `protobuf:"bytes,1,rep,name=sessions,proto3" json:"sessions"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VerifyStudentLoginCodeResponse) Reset() {
+	*x = VerifyStudentLoginCodeResponse{}
+	mi := &file_education_proto_msgTypes[80]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VerifyStudentLoginCodeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyStudentLoginCodeResponse) ProtoMessage() {}
+
+func (x *VerifyStudentLoginCodeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[80]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyStudentLoginCodeResponse.ProtoReflect.Descriptor instead.
+func (*VerifyStudentLoginCodeResponse) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *VerifyStudentLoginCodeResponse) GetSessions() []*StudentSession {
+	if x != nil {
+		return x.Sessions
+	}
+	return nil
+}
+
+type ValidateStudentSessionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ValidateStudentSessionRequest) Reset() {
+	*x = ValidateStudentSessionRequest{}
+	mi := &file_education_proto_msgTypes[81]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ValidateStudentSessionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidateStudentSessionRequest) ProtoMessage() {}
+
+func (x *ValidateStudentSessionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[81]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidateStudentSessionRequest.ProtoReflect.Descriptor instead.
+func (*ValidateStudentSessionRequest) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *ValidateStudentSessionRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+type GetCheckInCodeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	LessonId      string                 `protobuf:"bytes,1,opt,name=lessonId,proto3" json:"lessonId"`
+	ActionById    string                 `protobuf:"bytes,2,opt,name=actionById,proto3" json:"actionById"`
+	ActionByRole  string                 `protobuf:"bytes,3,opt,name=actionByRole,proto3" json:"actionByRole"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCheckInCodeRequest) Reset() {
+	*x = GetCheckInCodeRequest{}
+	mi := &file_education_proto_msgTypes[82]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCheckInCodeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCheckInCodeRequest) ProtoMessage() {}
+
+func (x *GetCheckInCodeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[82]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCheckInCodeRequest.ProtoReflect.Descriptor instead.
+func (*GetCheckInCodeRequest) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *GetCheckInCodeRequest) GetLessonId() string {
+	if x != nil {
+		return x.LessonId
+	}
+	return ""
+}
+
+func (x *GetCheckInCodeRequest) GetActionById() string {
+	if x != nil {
+		return x.ActionById
+	}
+	return ""
+}
+
+func (x *GetCheckInCodeRequest) GetActionByRole() string {
+	if x != nil {
+		return x.ActionByRole
+	}
+	return ""
+}
+
+// CheckInCode is shown as a QR code on the tablet of the room, it expires shortly after the tablet asks for the next one
+type CheckInCode struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	LessonId   string                 `protobuf:"bytes,1,opt,name=lessonId,proto3" json:"lessonId"`
+	GroupName  string                 `protobuf:"bytes,2,opt,name=groupName,proto3" json:"groupName"`
+	LessonDate string                 `protobuf:"bytes,3,opt,name=lessonDate,proto3" json:"lessonDate"`
+	Token      string                 `protobuf:"bytes,4,opt,name=token,proto3" json:"token"`
+	ExpiresAt  string                 `protobuf:"bytes,5,opt,name=expiresAt,proto3" json:"expiresAt"`
+	// seconds after which the tablet should show a new code
+	RefreshIn     int32 `protobuf:"varint,6,opt,name=refreshIn,proto3" json:"refreshIn"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckInCode) Reset() {
+	*x = CheckInCode{}
+	mi := &file_education_proto_msgTypes[83]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckInCode) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckInCode) ProtoMessage() {}
+
+func (x *CheckInCode) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[83]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckInCode.ProtoReflect.Descriptor instead.
+func (*CheckInCode) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *CheckInCode) GetLessonId() string {
+	if x != nil {
+		return x.LessonId
+	}
+	return ""
+}
+
+func (x *CheckInCode) GetGroupName() string {
+	if x != nil {
+		return x.GroupName
+	}
+	return ""
+}
+
+func (x *CheckInCode) GetLessonDate() string {
+	if x != nil {
+		return x.LessonDate
+	}
+	return ""
+}
+
+func (x *CheckInCode) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *CheckInCode) GetExpiresAt() string {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return ""
+}
+
+func (x *CheckInCode) GetRefreshIn() int32 {
+	if x != nil {
+		return x.RefreshIn
+	}
+	return 0
+}
+
+type CheckInRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// the token of the scanned QR code
+	Token         string `protobuf:"bytes,1,opt,name=token,proto3" json:"token"`
+	StudentId     string `protobuf:"bytes,2,opt,name=studentId,proto3" json:"studentId"`
+	Device        string `protobuf:"bytes,3,opt,name=device,proto3" json:"device"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckInRequest) Reset() {
+	*x = CheckInRequest{}
+	mi := &file_education_proto_msgTypes[84]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckInRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckInRequest) ProtoMessage() {}
+
+func (x *CheckInRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[84]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckInRequest.ProtoReflect.Descriptor instead.
+func (*CheckInRequest) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *CheckInRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *CheckInRequest) GetStudentId() string {
+	if x != nil {
+		return x.StudentId
+	}
+	return ""
+}
+
+func (x *CheckInRequest) GetDevice() string {
+	if x != nil {
+		return x.Device
+	}
+	return ""
+}
+
+type CheckInResponse struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	LessonId    string                 `protobuf:"bytes,1,opt,name=lessonId,proto3" json:"lessonId"`
+	GroupName   string                 `protobuf:"bytes,2,opt,name=groupName,proto3" json:"groupName"`
+	LessonDate  string                 `protobuf:"bytes,3,opt,name=lessonDate,proto3" json:"lessonDate"`
+	CheckedInAt string                 `protobuf:"bytes,4,opt,name=checkedInAt,proto3" json:"checkedInAt"`
+	// false until the teacher confirms the check in
+	Confirmed     bool   `protobuf:"varint,5,opt,name=confirmed,proto3" json:"confirmed"`
+	Message       string `protobuf:"bytes,6,opt,name=message,proto3" json:"message"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckInResponse) Reset() {
+	*x = CheckInResponse{}
+	mi := &file_education_proto_msgTypes[85]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckInResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckInResponse) ProtoMessage() {}
+
+func (x *CheckInResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[85]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckInResponse.ProtoReflect.Descriptor instead.
+func (*CheckInResponse) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *CheckInResponse) GetLessonId() string {
+	if x != nil {
+		return x.LessonId
+	}
+	return ""
+}
+
+func (x *CheckInResponse) GetGroupName() string {
+	if x != nil {
+		return x.GroupName
+	}
+	return ""
+}
+
+func (x *CheckInResponse) GetLessonDate() string {
+	if x != nil {
+		return x.LessonDate
+	}
+	return ""
+}
+
+func (x *CheckInResponse) GetCheckedInAt() string {
+	if x != nil {
+		return x.CheckedInAt
+	}
+	return ""
+}
+
+func (x *CheckInResponse) GetConfirmed() bool {
+	if x != nil {
+		return x.Confirmed
+	}
+	return false
+}
+
+func (x *CheckInResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type GetLessonCheckInsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	LessonId      string                 `protobuf:"bytes,1,opt,name=lessonId,proto3" json:"lessonId"`
+	ActionById    string                 `protobuf:"bytes,2,opt,name=actionById,proto3" json:"actionById"`
+	ActionByRole  string                 `protobuf:"bytes,3,opt,name=actionByRole,proto3" json:"actionByRole"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetLessonCheckInsRequest) Reset() {
+	*x = GetLessonCheckInsRequest{}
+	mi := &file_education_proto_msgTypes[86]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetLessonCheckInsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLessonCheckInsRequest) ProtoMessage() {}
+
+func (x *GetLessonCheckInsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[86]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLessonCheckInsRequest.ProtoReflect.Descriptor instead.
+func (*GetLessonCheckInsRequest) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *GetLessonCheckInsRequest) GetLessonId() string {
+	if x != nil {
+		return x.LessonId
+	}
+	return ""
+}
+
+func (x *GetLessonCheckInsRequest) GetActionById() string {
+	if x != nil {
+		return x.ActionById
+	}
+	return ""
+}
+
+func (x *GetLessonCheckInsRequest) GetActionByRole() string {
+	if x != nil {
+		return x.ActionByRole
+	}
+	return ""
+}
+
+type LessonCheckIn struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StudentId     string                 `protobuf:"bytes,1,opt,name=studentId,proto3" json:"studentId"`
+	StudentName   string                 `protobuf:"bytes,2,opt,name=studentName,proto3" json:"studentName"`
+	CheckedInAt   string                 `protobuf:"bytes,3,opt,name=checkedInAt,proto3" json:"checkedInAt"`
+	Device        string                 `protobuf:"bytes,4,opt,name=device,proto3" json:"device"`
+	Confirmed     bool                   `protobuf:"varint,5,opt,name=confirmed,proto3" json:"confirmed"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LessonCheckIn) Reset() {
+	*x = LessonCheckIn{}
+	mi := &file_education_proto_msgTypes[87]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LessonCheckIn) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LessonCheckIn) ProtoMessage() {}
+
+func (x *LessonCheckIn) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[87]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LessonCheckIn.ProtoReflect.Descriptor instead.
+func (*LessonCheckIn) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *LessonCheckIn) GetStudentId() string {
+	if x != nil {
+		return x.StudentId
+	}
+	return ""
+}
+
+func (x *LessonCheckIn) GetStudentName() string {
+	if x != nil {
+		return x.StudentName
+	}
+	return ""
+}
+
+func (x *LessonCheckIn) GetCheckedInAt() string {
+	if x != nil {
+		return x.CheckedInAt
+	}
+	return ""
+}
+
+func (x *LessonCheckIn) GetDevice() string {
+	if x != nil {
+		return x.Device
+	}
+	return ""
+}
+
+func (x *LessonCheckIn) GetConfirmed() bool {
+	if x != nil {
+		return x.Confirmed
+	}
+	return false
+}
+
+type GetLessonCheckInsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CheckIns      []*LessonCheckIn       `protobuf:"bytes,1,rep,name=checkIns,proto3" json:"checkIns"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetLessonCheckInsResponse) Reset() {
+	*x = GetLessonCheckInsResponse{}
+	mi := &file_education_proto_msgTypes[88]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetLessonCheckInsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLessonCheckInsResponse) ProtoMessage() {}
+
+func (x *GetLessonCheckInsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[88]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLessonCheckInsResponse.ProtoReflect.Descriptor instead.
+func (*GetLessonCheckInsResponse) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{88}
+}
+
+func (x *GetLessonCheckInsResponse) GetCheckIns() []*LessonCheckIn {
+	if x != nil {
+		return x.CheckIns
+	}
+	return nil
+}
+
+type ConfirmCheckInsRequest struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	LessonId   string                 `protobuf:"bytes,1,opt,name=lessonId,proto3" json:"lessonId"`
+	StudentIds []string               `protobuf:"bytes,2,rep,name=studentIds,proto3" json:"studentIds"`
+	// rejected check ins are deleted
+	Reject        bool   `protobuf:"varint,3,opt,name=reject,proto3" json:"reject"`
+	ActionById    string `protobuf:"bytes,4,opt,name=actionById,proto3" json:"actionById"`
+	ActionByRole  string `protobuf:"bytes,5,opt,name=actionByRole,proto3" json:"actionByRole"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConfirmCheckInsRequest) Reset() {
+	*x = ConfirmCheckInsRequest{}
+	mi := &file_education_proto_msgTypes[89]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConfirmCheckInsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfirmCheckInsRequest) ProtoMessage() {}
+
+func (x *ConfirmCheckInsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[89]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfirmCheckInsRequest.ProtoReflect.Descriptor instead.
+func (*ConfirmCheckInsRequest) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{89}
+}
+
+func (x *ConfirmCheckInsRequest) GetLessonId() string {
+	if x != nil {
+		return x.LessonId
+	}
+	return ""
+}
+
+func (x *ConfirmCheckInsRequest) GetStudentIds() []string {
+	if x != nil {
+		return x.StudentIds
+	}
+	return nil
+}
+
+func (x *ConfirmCheckInsRequest) GetReject() bool {
+	if x != nil {
+		return x.Reject
+	}
+	return false
+}
+
+func (x *ConfirmCheckInsRequest) GetActionById() string {
+	if x != nil {
+		return x.ActionById
+	}
+	return ""
+}
+
+func (x *ConfirmCheckInsRequest) GetActionByRole() string {
+	if x != nil {
+		return x.ActionByRole
+	}
+	return ""
+}
+
+// a teacher only gets the attendance of the lessons it taught whatever teacherId is
+type GetAttendanceRatesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// yyyy-MM-dd
+	From string `protobuf:"bytes,1,opt,name=from,proto3" json:"from"`
+	Till string `protobuf:"bytes,2,opt,name=till,proto3" json:"till"`
+	// STUDENT, GROUP, TEACHER or COURSE
+	By            string `protobuf:"bytes,3,opt,name=by,proto3" json:"by"`
+	GroupId       string `protobuf:"bytes,4,opt,name=groupId,proto3" json:"groupId"`
+	TeacherId     string `protobuf:"bytes,5,opt,name=teacherId,proto3" json:"teacherId"`
+	CourseId      string `protobuf:"bytes,6,opt,name=courseId,proto3" json:"courseId"`
+	ActionById    string `protobuf:"bytes,7,opt,name=actionById,proto3" json:"actionById"`
+	ActionByRole  string `protobuf:"bytes,8,opt,name=actionByRole,proto3" json:"actionByRole"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAttendanceRatesRequest) Reset() {
+	*x = GetAttendanceRatesRequest{}
+	mi := &file_education_proto_msgTypes[90]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAttendanceRatesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAttendanceRatesRequest) ProtoMessage() {}
+
+func (x *GetAttendanceRatesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[90]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAttendanceRatesRequest.ProtoReflect.Descriptor instead.
+func (*GetAttendanceRatesRequest) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{90}
+}
+
+func (x *GetAttendanceRatesRequest) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *GetAttendanceRatesRequest) GetTill() string {
+	if x != nil {
+		return x.Till
+	}
+	return ""
+}
+
+func (x *GetAttendanceRatesRequest) GetBy() string {
+	if x != nil {
+		return x.By
+	}
+	return ""
+}
+
+func (x *GetAttendanceRatesRequest) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+func (x *GetAttendanceRatesRequest) GetTeacherId() string {
+	if x != nil {
+		return x.TeacherId
+	}
+	return ""
+}
+
+func (x *GetAttendanceRatesRequest) GetCourseId() string {
+	if x != nil {
+		return x.CourseId
+	}
+	return ""
+}
+
+func (x *GetAttendanceRatesRequest) GetActionById() string {
+	if x != nil {
+		return x.ActionById
+	}
+	return ""
+}
+
+func (x *GetAttendanceRatesRequest) GetActionByRole() string {
+	if x != nil {
+		return x.ActionByRole
+	}
+	return ""
+}
+
+type AttendanceRate struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id"`
+	Name  string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name"`
+	// marks with a billable status
+	Attended int32 `protobuf:"varint,3,opt,name=attended,proto3" json:"attended"`
+	Absent   int32 `protobuf:"varint,4,opt,name=absent,proto3" json:"absent"`
+	Total    int32 `protobuf:"varint,5,opt,name=total,proto3" json:"total"`
+	// percent of the marked lessons attended
+	Rate          float64 `protobuf:"fixed64,6,opt,name=rate,proto3" json:"rate"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AttendanceRate) Reset() {
+	*x = AttendanceRate{}
+	mi := &file_education_proto_msgTypes[91]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AttendanceRate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AttendanceRate) ProtoMessage() {}
+
+func (x *AttendanceRate) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[91]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AttendanceRate.ProtoReflect.Descriptor instead.
+func (*AttendanceRate) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{91}
+}
+
+func (x *AttendanceRate) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *AttendanceRate) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *AttendanceRate) GetAttended() int32 {
+	if x != nil {
+		return x.Attended
+	}
+	return 0
+}
+
+func (x *AttendanceRate) GetAbsent() int32 {
+	if x != nil {
+		return x.Absent
+	}
+	return 0
+}
+
+func (x *AttendanceRate) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *AttendanceRate) GetRate() float64 {
+	if x != nil {
+		return x.Rate
+	}
+	return 0
+}
+
+type GetAttendanceRatesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rates         []*AttendanceRate      `protobuf:"bytes,1,rep,name=rates,proto3" json:"rates"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAttendanceRatesResponse) Reset() {
+	*x = GetAttendanceRatesResponse{}
+	mi := &file_education_proto_msgTypes[92]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAttendanceRatesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAttendanceRatesResponse) ProtoMessage() {}
+
+func (x *GetAttendanceRatesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[92]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAttendanceRatesResponse.ProtoReflect.Descriptor instead.
+func (*GetAttendanceRatesResponse) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{92}
+}
+
+func (x *GetAttendanceRatesResponse) GetRates() []*AttendanceRate {
+	if x != nil {
+		return x.Rates
+	}
+	return nil
+}
+
+type GetAbsenceStreaksRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	GroupId   string                 `protobuf:"bytes,1,opt,name=groupId,proto3" json:"groupId"`
+	TeacherId string                 `protobuf:"bytes,2,opt,name=teacherId,proto3" json:"teacherId"`
+	// streaks shorter than it are left out, 2 when 0
+	MinStreak     int32  `protobuf:"varint,3,opt,name=minStreak,proto3" json:"minStreak"`
+	ActionById    string `protobuf:"bytes,4,opt,name=actionById,proto3" json:"actionById"`
+	ActionByRole  string `protobuf:"bytes,5,opt,name=actionByRole,proto3" json:"actionByRole"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAbsenceStreaksRequest) Reset() {
+	*x = GetAbsenceStreaksRequest{}
+	mi := &file_education_proto_msgTypes[93]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAbsenceStreaksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAbsenceStreaksRequest) ProtoMessage() {}
+
+func (x *GetAbsenceStreaksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[93]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAbsenceStreaksRequest.ProtoReflect.Descriptor instead.
+func (*GetAbsenceStreaksRequest) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{93}
+}
+
+func (x *GetAbsenceStreaksRequest) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+func (x *GetAbsenceStreaksRequest) GetTeacherId() string {
+	if x != nil {
+		return x.TeacherId
+	}
+	return ""
+}
+
+func (x *GetAbsenceStreaksRequest) GetMinStreak() int32 {
+	if x != nil {
+		return x.MinStreak
+	}
+	return 0
+}
+
+func (x *GetAbsenceStreaksRequest) GetActionById() string {
+	if x != nil {
+		return x.ActionById
+	}
+	return ""
+}
+
+func (x *GetAbsenceStreaksRequest) GetActionByRole() string {
+	if x != nil {
+		return x.ActionByRole
+	}
+	return ""
+}
+
+// AbsenceStreak is how many of the last lessons in a row a student missed, absences made up later do not count
+type AbsenceStreak struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	StudentId      string                 `protobuf:"bytes,1,opt,name=studentId,proto3" json:"studentId"`
+	StudentName    string                 `protobuf:"bytes,2,opt,name=studentName,proto3" json:"studentName"`
+	GroupId        int64                  `protobuf:"varint,3,opt,name=groupId,proto3" json:"groupId"`
+	GroupName      string                 `protobuf:"bytes,4,opt,name=groupName,proto3" json:"groupName"`
+	Streak         int32                  `protobuf:"varint,5,opt,name=streak,proto3" json:"streak"`
+	LastAbsentDate string                 `protobuf:"bytes,6,opt,name=lastAbsentDate,proto3" json:"lastAbsentDate"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *AbsenceStreak) Reset() {
+	*x = AbsenceStreak{}
+	mi := &file_education_proto_msgTypes[94]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AbsenceStreak) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AbsenceStreak) ProtoMessage() {}
+
+func (x *AbsenceStreak) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[94]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AbsenceStreak.ProtoReflect.Descriptor instead.
+func (*AbsenceStreak) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{94}
+}
+
+func (x *AbsenceStreak) GetStudentId() string {
+	if x != nil {
+		return x.StudentId
+	}
+	return ""
+}
+
+func (x *AbsenceStreak) GetStudentName() string {
+	if x != nil {
+		return x.StudentName
+	}
+	return ""
+}
+
+func (x *AbsenceStreak) GetGroupId() int64 {
+	if x != nil {
+		return x.GroupId
+	}
+	return 0
+}
+
+func (x *AbsenceStreak) GetGroupName() string {
+	if x != nil {
+		return x.GroupName
+	}
+	return ""
+}
+
+func (x *AbsenceStreak) GetStreak() int32 {
+	if x != nil {
+		return x.Streak
+	}
+	return 0
+}
+
+func (x *AbsenceStreak) GetLastAbsentDate() string {
+	if x != nil {
+		return x.LastAbsentDate
+	}
+	return ""
+}
+
+type GetAbsenceStreaksResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Streaks       []*AbsenceStreak       `protobuf:"bytes,1,rep,name=streaks,proto3" json:"streaks"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAbsenceStreaksResponse) Reset() {
+	*x = GetAbsenceStreaksResponse{}
+	mi := &file_education_proto_msgTypes[95]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAbsenceStreaksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAbsenceStreaksResponse) ProtoMessage() {}
+
+func (x *GetAbsenceStreaksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[95]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAbsenceStreaksResponse.ProtoReflect.Descriptor instead.
+func (*GetAbsenceStreaksResponse) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{95}
+}
+
+func (x *GetAbsenceStreaksResponse) GetStreaks() []*AbsenceStreak {
+	if x != nil {
+		return x.Streaks
+	}
+	return nil
+}
+
+type GetAttendanceTrendRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	From  string                 `protobuf:"bytes,1,opt,name=from,proto3" json:"from"`
+	Till  string                 `protobuf:"bytes,2,opt,name=till,proto3" json:"till"`
+	// DAY, WEEK or MONTH
+	Interval      string `protobuf:"bytes,3,opt,name=interval,proto3" json:"interval"`
+	GroupId       string `protobuf:"bytes,4,opt,name=groupId,proto3" json:"groupId"`
+	TeacherId     string `protobuf:"bytes,5,opt,name=teacherId,proto3" json:"teacherId"`
+	CourseId      string `protobuf:"bytes,6,opt,name=courseId,proto3" json:"courseId"`
+	ActionById    string `protobuf:"bytes,7,opt,name=actionById,proto3" json:"actionById"`
+	ActionByRole  string `protobuf:"bytes,8,opt,name=actionByRole,proto3" json:"actionByRole"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAttendanceTrendRequest) Reset() {
+	*x = GetAttendanceTrendRequest{}
+	mi := &file_education_proto_msgTypes[96]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAttendanceTrendRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAttendanceTrendRequest) ProtoMessage() {}
+
+func (x *GetAttendanceTrendRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[96]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAttendanceTrendRequest.ProtoReflect.Descriptor instead.
+func (*GetAttendanceTrendRequest) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{96}
+}
+
+func (x *GetAttendanceTrendRequest) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *GetAttendanceTrendRequest) GetTill() string {
+	if x != nil {
+		return x.Till
+	}
+	return ""
+}
+
+func (x *GetAttendanceTrendRequest) GetInterval() string {
+	if x != nil {
+		return x.Interval
+	}
+	return ""
+}
+
+func (x *GetAttendanceTrendRequest) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+func (x *GetAttendanceTrendRequest) GetTeacherId() string {
+	if x != nil {
+		return x.TeacherId
+	}
+	return ""
+}
+
+func (x *GetAttendanceTrendRequest) GetCourseId() string {
+	if x != nil {
+		return x.CourseId
+	}
+	return ""
+}
+
+func (x *GetAttendanceTrendRequest) GetActionById() string {
+	if x != nil {
+		return x.ActionById
+	}
+	return ""
+}
+
+func (x *GetAttendanceTrendRequest) GetActionByRole() string {
+	if x != nil {
+		return x.ActionByRole
+	}
+	return ""
+}
+
+type AttendanceTrendPoint struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// first day of the interval
+	Period        string  `protobuf:"bytes,1,opt,name=period,proto3" json:"period"`
+	Attended      int32   `protobuf:"varint,2,opt,name=attended,proto3" json:"attended"`
+	Total         int32   `protobuf:"varint,3,opt,name=total,proto3" json:"total"`
+	Rate          float64 `protobuf:"fixed64,4,opt,name=rate,proto3" json:"rate"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AttendanceTrendPoint) Reset() {
+	*x = AttendanceTrendPoint{}
+	mi := &file_education_proto_msgTypes[97]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AttendanceTrendPoint) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AttendanceTrendPoint) ProtoMessage() {}
+
+func (x *AttendanceTrendPoint) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[97]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AttendanceTrendPoint.ProtoReflect.Descriptor instead.
+func (*AttendanceTrendPoint) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{97}
+}
+
+func (x *AttendanceTrendPoint) GetPeriod() string {
+	if x != nil {
+		return x.Period
+	}
+	return ""
+}
+
+func (x *AttendanceTrendPoint) GetAttended() int32 {
+	if x != nil {
+		return x.Attended
+	}
+	return 0
+}
+
+func (x *AttendanceTrendPoint) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *AttendanceTrendPoint) GetRate() float64 {
+	if x != nil {
+		return x.Rate
+	}
+	return 0
+}
+
+type GetAttendanceTrendResponse struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Points        []*AttendanceTrendPoint `protobuf:"bytes,1,rep,name=points,proto3" json:"points"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAttendanceTrendResponse) Reset() {
+	*x = GetAttendanceTrendResponse{}
+	mi := &file_education_proto_msgTypes[98]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAttendanceTrendResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAttendanceTrendResponse) ProtoMessage() {}
+
+func (x *GetAttendanceTrendResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[98]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAttendanceTrendResponse.ProtoReflect.Descriptor instead.
+func (*GetAttendanceTrendResponse) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{98}
+}
+
+func (x *GetAttendanceTrendResponse) GetPoints() []*AttendanceTrendPoint {
+	if x != nil {
+		return x.Points
+	}
+	return nil
+}
+
+// AtRiskPolicy is when the daily job flags a student as at risk, a rule set to 0 is off
+type AtRiskPolicy struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AbsenceStreak int32                  `protobuf:"varint,1,opt,name=absenceStreak,proto3" json:"absenceStreak"`
+	// attendance percent this month below which a student is flagged
+	MinRate int32 `protobuf:"varint,2,opt,name=minRate,proto3" json:"minRate"`
+	// marked lessons this month before the rate is judged
+	MinLessons int32 `protobuf:"varint,3,opt,name=minLessons,proto3" json:"minLessons"`
+	// sms the parents with the AT_RISK_ALERT template when it is active
+	SmsParents    bool   `protobuf:"varint,4,opt,name=smsParents,proto3" json:"smsParents"`
+	ActionById    string `protobuf:"bytes,5,opt,name=actionById,proto3" json:"actionById"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AtRiskPolicy) Reset() {
+	*x = AtRiskPolicy{}
+	mi := &file_education_proto_msgTypes[99]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AtRiskPolicy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AtRiskPolicy) ProtoMessage() {}
+
+func (x *AtRiskPolicy) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[99]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AtRiskPolicy.ProtoReflect.Descriptor instead.
+func (*AtRiskPolicy) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{99}
+}
+
+func (x *AtRiskPolicy) GetAbsenceStreak() int32 {
+	if x != nil {
+		return x.AbsenceStreak
+	}
+	return 0
+}
+
+func (x *AtRiskPolicy) GetMinRate() int32 {
+	if x != nil {
+		return x.MinRate
+	}
+	return 0
+}
+
+func (x *AtRiskPolicy) GetMinLessons() int32 {
+	if x != nil {
+		return x.MinLessons
+	}
+	return 0
+}
+
+func (x *AtRiskPolicy) GetSmsParents() bool {
+	if x != nil {
+		return x.SmsParents
+	}
+	return false
+}
+
+func (x *AtRiskPolicy) GetActionById() string {
+	if x != nil {
+		return x.ActionById
+	}
+	return ""
+}
+
+type GetAtRiskStudentsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// OPEN when empty
+	Status        string `protobuf:"bytes,1,opt,name=status,proto3" json:"status"`
+	GroupId       string `protobuf:"bytes,2,opt,name=groupId,proto3" json:"groupId"`
+	ActionById    string `protobuf:"bytes,3,opt,name=actionById,proto3" json:"actionById"`
+	ActionByRole  string `protobuf:"bytes,4,opt,name=actionByRole,proto3" json:"actionByRole"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAtRiskStudentsRequest) Reset() {
+	*x = GetAtRiskStudentsRequest{}
+	mi := &file_education_proto_msgTypes[100]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAtRiskStudentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAtRiskStudentsRequest) ProtoMessage() {}
+
+func (x *GetAtRiskStudentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[100]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAtRiskStudentsRequest.ProtoReflect.Descriptor instead.
+func (*GetAtRiskStudentsRequest) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{100}
+}
+
+func (x *GetAtRiskStudentsRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *GetAtRiskStudentsRequest) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+func (x *GetAtRiskStudentsRequest) GetActionById() string {
+	if x != nil {
+		return x.ActionById
+	}
+	return ""
+}
+
+func (x *GetAtRiskStudentsRequest) GetActionByRole() string {
+	if x != nil {
+		return x.ActionByRole
+	}
+	return ""
+}
+
+type AtRiskStudent struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Id          string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id"`
+	StudentId   string                 `protobuf:"bytes,2,opt,name=studentId,proto3" json:"studentId"`
+	StudentName string                 `protobuf:"bytes,3,opt,name=studentName,proto3" json:"studentName"`
+	GroupId     int64                  `protobuf:"varint,4,opt,name=groupId,proto3" json:"groupId"`
+	GroupName   string                 `protobuf:"bytes,5,opt,name=groupName,proto3" json:"groupName"`
+	// ABSENCE_STREAK or LOW_RATE
+	Reason         string  `protobuf:"bytes,6,opt,name=reason,proto3" json:"reason"`
+	AbsenceStreak  int32   `protobuf:"varint,7,opt,name=absenceStreak,proto3" json:"absenceStreak"`
+	AttendanceRate float64 `protobuf:"fixed64,8,opt,name=attendanceRate,proto3" json:"attendanceRate"`
+	// OPEN until the student is no longer at risk
+	Status        string `protobuf:"bytes,9,opt,name=status,proto3" json:"status"`
+	FlaggedAt     string `protobuf:"bytes,10,opt,name=flaggedAt,proto3" json:"flaggedAt"`
+	ResolvedAt    string `protobuf:"bytes,11,opt,name=resolvedAt,proto3" json:"resolvedAt"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AtRiskStudent) Reset() {
+	*x = AtRiskStudent{}
+	mi := &file_education_proto_msgTypes[101]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AtRiskStudent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AtRiskStudent) ProtoMessage() {}
+
+func (x *AtRiskStudent) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[101]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AtRiskStudent.ProtoReflect.Descriptor instead.
+func (*AtRiskStudent) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{101}
+}
+
+func (x *AtRiskStudent) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *AtRiskStudent) GetStudentId() string {
+	if x != nil {
+		return x.StudentId
+	}
+	return ""
+}
+
+func (x *AtRiskStudent) GetStudentName() string {
+	if x != nil {
+		return x.StudentName
+	}
+	return ""
+}
+
+func (x *AtRiskStudent) GetGroupId() int64 {
+	if x != nil {
+		return x.GroupId
+	}
+	return 0
+}
+
+func (x *AtRiskStudent) GetGroupName() string {
+	if x != nil {
+		return x.GroupName
+	}
+	return ""
+}
+
+func (x *AtRiskStudent) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *AtRiskStudent) GetAbsenceStreak() int32 {
+	if x != nil {
+		return x.AbsenceStreak
+	}
+	return 0
+}
+
+func (x *AtRiskStudent) GetAttendanceRate() float64 {
+	if x != nil {
+		return x.AttendanceRate
+	}
+	return 0
+}
+
+func (x *AtRiskStudent) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *AtRiskStudent) GetFlaggedAt() string {
+	if x != nil {
+		return x.FlaggedAt
+	}
+	return ""
+}
+
+func (x *AtRiskStudent) GetResolvedAt() string {
+	if x != nil {
+		return x.ResolvedAt
+	}
+	return ""
+}
+
+type GetAtRiskStudentsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Students      []*AtRiskStudent       `protobuf:"bytes,1,rep,name=students,proto3" json:"students"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAtRiskStudentsResponse) Reset() {
+	*x = GetAtRiskStudentsResponse{}
+	mi := &file_education_proto_msgTypes[102]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAtRiskStudentsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAtRiskStudentsResponse) ProtoMessage() {}
+
+func (x *GetAtRiskStudentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[102]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAtRiskStudentsResponse.ProtoReflect.Descriptor instead.
+func (*GetAtRiskStudentsResponse) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{102}
+}
+
+func (x *GetAtRiskStudentsResponse) GetStudents() []*AtRiskStudent {
+	if x != nil {
+		return x.Students
+	}
+	return nil
+}
+
+type GetNotificationsRequest struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	UnreadOnly bool                   `protobuf:"varint,1,opt,name=unreadOnly,proto3" json:"unreadOnly"`
+	// 50 when 0
+	Limit         int32  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit"`
+	ActionById    string `protobuf:"bytes,3,opt,name=actionById,proto3" json:"actionById"`
+	ActionByRole  string `protobuf:"bytes,4,opt,name=actionByRole,proto3" json:"actionByRole"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetNotificationsRequest) Reset() {
+	*x = GetNotificationsRequest{}
+	mi := &file_education_proto_msgTypes[103]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetNotificationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetNotificationsRequest) ProtoMessage() {}
+
+func (x *GetNotificationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[103]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetNotificationsRequest.ProtoReflect.Descriptor instead.
+func (*GetNotificationsRequest) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{103}
+}
+
+func (x *GetNotificationsRequest) GetUnreadOnly() bool {
+	if x != nil {
+		return x.UnreadOnly
+	}
+	return false
+}
+
+func (x *GetNotificationsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *GetNotificationsRequest) GetActionById() string {
+	if x != nil {
+		return x.ActionById
+	}
+	return ""
+}
+
+func (x *GetNotificationsRequest) GetActionByRole() string {
+	if x != nil {
+		return x.ActionByRole
+	}
+	return ""
+}
+
+type Notification struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id"`
+	// AT_RISK_STUDENT or WAITLIST_SEAT_OFFERED
+	Type          string `protobuf:"bytes,2,opt,name=type,proto3" json:"type"`
+	Title         string `protobuf:"bytes,3,opt,name=title,proto3" json:"title"`
+	Body          string `protobuf:"bytes,4,opt,name=body,proto3" json:"body"`
+	StudentId     string `protobuf:"bytes,5,opt,name=studentId,proto3" json:"studentId"`
+	GroupId       int64  `protobuf:"varint,6,opt,name=groupId,proto3" json:"groupId"`
+	Read          bool   `protobuf:"varint,7,opt,name=read,proto3" json:"read"`
+	CreatedAt     string `protobuf:"bytes,8,opt,name=createdAt,proto3" json:"createdAt"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Notification) Reset() {
+	*x = Notification{}
+	mi := &file_education_proto_msgTypes[104]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Notification) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Notification) ProtoMessage() {}
+
+func (x *Notification) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[104]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Notification.ProtoReflect.Descriptor instead.
+func (*Notification) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{104}
+}
+
+func (x *Notification) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Notification) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *Notification) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *Notification) GetBody() string {
+	if x != nil {
+		return x.Body
+	}
+	return ""
+}
+
+func (x *Notification) GetStudentId() string {
+	if x != nil {
+		return x.StudentId
+	}
+	return ""
+}
+
+func (x *Notification) GetGroupId() int64 {
+	if x != nil {
+		return x.GroupId
+	}
+	return 0
+}
+
+func (x *Notification) GetRead() bool {
+	if x != nil {
+		return x.Read
+	}
+	return false
+}
+
+func (x *Notification) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+type GetNotificationsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Notifications []*Notification        `protobuf:"bytes,1,rep,name=notifications,proto3" json:"notifications"`
+	Unread        int32                  `protobuf:"varint,2,opt,name=unread,proto3" json:"unread"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetNotificationsResponse) Reset() {
+	*x = GetNotificationsResponse{}
+	mi := &file_education_proto_msgTypes[105]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetGroupByTeacherAbs) String() string {
+func (x *GetNotificationsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetGroupByTeacherAbs) ProtoMessage() {}
+func (*GetNotificationsResponse) ProtoMessage() {}
 
-func (x *GetGroupByTeacherAbs) ProtoReflect() protoreflect.Message {
-	mi := &file_education_proto_msgTypes[30]
+func (x *GetNotificationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[105]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2311,111 +8081,173 @@ func (x *GetGroupByTeacherAbs) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetGroupByTeacherAbs.ProtoReflect.Descriptor instead.
-func (*GetGroupByTeacherAbs) Descriptor() ([]byte, []int) {
-	return file_education_proto_rawDescGZIP(), []int{30}
+// Deprecated: Use GetNotificationsResponse.ProtoReflect.Descriptor instead.
+func (*GetNotificationsResponse) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{105}
 }
 
-func (x *GetGroupByTeacherAbs) GetId() string {
+func (x *GetNotificationsResponse) GetNotifications() []*Notification {
 	if x != nil {
-		return x.Id
+		return x.Notifications
 	}
-	return ""
+	return nil
 }
 
-func (x *GetGroupByTeacherAbs) GetName() string {
+func (x *GetNotificationsResponse) GetUnread() int32 {
 	if x != nil {
-		return x.Name
+		return x.Unread
 	}
-	return ""
+	return 0
 }
 
-func (x *GetGroupByTeacherAbs) GetCourseName() string {
-	if x != nil {
-		return x.CourseName
-	}
-	return ""
+type MarkNotificationsReadRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// every notification of the user when empty
+	Ids           []string `protobuf:"bytes,1,rep,name=ids,proto3" json:"ids"`
+	ActionById    string   `protobuf:"bytes,2,opt,name=actionById,proto3" json:"actionById"`
+	ActionByRole  string   `protobuf:"bytes,3,opt,name=actionByRole,proto3" json:"actionByRole"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetGroupByTeacherAbs) GetRoomName() string {
+func (x *MarkNotificationsReadRequest) Reset() {
+	*x = MarkNotificationsReadRequest{}
+	mi := &file_education_proto_msgTypes[106]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MarkNotificationsReadRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarkNotificationsReadRequest) ProtoMessage() {}
+
+func (x *MarkNotificationsReadRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[106]
 	if x != nil {
-		return x.RoomName
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *GetGroupByTeacherAbs) GetLessonStartTime() string {
+// Deprecated: Use MarkNotificationsReadRequest.ProtoReflect.Descriptor instead.
+func (*MarkNotificationsReadRequest) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{106}
+}
+
+func (x *MarkNotificationsReadRequest) GetIds() []string {
 	if x != nil {
-		return x.LessonStartTime
+		return x.Ids
 	}
-	return ""
+	return nil
 }
 
-func (x *GetGroupByTeacherAbs) GetDayType() string {
+func (x *MarkNotificationsReadRequest) GetActionById() string {
 	if x != nil {
-		return x.DayType
+		return x.ActionById
 	}
 	return ""
 }
 
-func (x *GetGroupByTeacherAbs) GetGroupStartAt() string {
+func (x *MarkNotificationsReadRequest) GetActionByRole() string {
 	if x != nil {
-		return x.GroupStartAt
+		return x.ActionByRole
 	}
 	return ""
 }
 
-func (x *GetGroupByTeacherAbs) GetGroupEndAt() string {
+type GetGroupWaitlistRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	GroupId string                 `protobuf:"bytes,1,opt,name=groupId,proto3" json:"groupId"`
+	// finished entries (ENROLLED, EXPIRED, CANCELLED) are listed as well
+	WithHistory   bool `protobuf:"varint,2,opt,name=withHistory,proto3" json:"withHistory"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetGroupWaitlistRequest) Reset() {
+	*x = GetGroupWaitlistRequest{}
+	mi := &file_education_proto_msgTypes[107]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetGroupWaitlistRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGroupWaitlistRequest) ProtoMessage() {}
+
+func (x *GetGroupWaitlistRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[107]
 	if x != nil {
-		return x.GroupEndAt
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *GetGroupByTeacherAbs) GetActiveStudentCount() int32 {
+// Deprecated: Use GetGroupWaitlistRequest.ProtoReflect.Descriptor instead.
+func (*GetGroupWaitlistRequest) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{107}
+}
+
+func (x *GetGroupWaitlistRequest) GetGroupId() string {
 	if x != nil {
-		return x.ActiveStudentCount
+		return x.GroupId
 	}
-	return 0
+	return ""
 }
 
-func (x *GetGroupByTeacherAbs) GetStudents() []*AbsStudent {
+func (x *GetGroupWaitlistRequest) GetWithHistory() bool {
 	if x != nil {
-		return x.Students
+		return x.WithHistory
 	}
-	return nil
+	return false
 }
 
-type CreateGroupRequest struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	Name            string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name"`
-	CourseId        int32                  `protobuf:"varint,2,opt,name=courseId,proto3" json:"courseId"`
-	TeacherId       string                 `protobuf:"bytes,3,opt,name=teacherId,proto3" json:"teacherId"`
-	Type            string                 `protobuf:"bytes,4,opt,name=type,proto3" json:"type"`
-	Days            []string               `protobuf:"bytes,5,rep,name=days,proto3" json:"days"`
-	RoomId          int32                  `protobuf:"varint,6,opt,name=roomId,proto3" json:"roomId"`
-	LessonStartTime string                 `protobuf:"bytes,7,opt,name=lessonStartTime,proto3" json:"lessonStartTime"`
-	GroupStartDate  string                 `protobuf:"bytes,8,opt,name=groupStartDate,proto3" json:"groupStartDate"`
-	GroupEndDate    string                 `protobuf:"bytes,9,opt,name=groupEndDate,proto3" json:"groupEndDate"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+type WaitlistEntry struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	Id           string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id"`
+	StudentId    string                 `protobuf:"bytes,2,opt,name=studentId,proto3" json:"studentId"`
+	StudentName  string                 `protobuf:"bytes,3,opt,name=studentName,proto3" json:"studentName"`
+	StudentPhone string                 `protobuf:"bytes,4,opt,name=studentPhone,proto3" json:"studentPhone"`
+	// place among the WAITING students, 0 for the others
+	Position int32 `protobuf:"varint,5,opt,name=position,proto3" json:"position"`
+	// WAITING, OFFERED, ENROLLED, EXPIRED or CANCELLED
+	Status    string `protobuf:"bytes,6,opt,name=status,proto3" json:"status"`
+	OfferedAt string `protobuf:"bytes,7,opt,name=offeredAt,proto3" json:"offeredAt"`
+	// an OFFERED seat is kept for the student until this time
+	HoldUntil     string `protobuf:"bytes,8,opt,name=holdUntil,proto3" json:"holdUntil"`
+	CreatedByName string `protobuf:"bytes,9,opt,name=createdByName,proto3" json:"createdByName"`
+	CreatedAt     string `protobuf:"bytes,10,opt,name=createdAt,proto3" json:"createdAt"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateGroupRequest) Reset() {
-	*x = CreateGroupRequest{}
-	mi := &file_education_proto_msgTypes[31]
+func (x *WaitlistEntry) Reset() {
+	*x = WaitlistEntry{}
+	mi := &file_education_proto_msgTypes[108]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateGroupRequest) String() string {
+func (x *WaitlistEntry) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateGroupRequest) ProtoMessage() {}
+func (*WaitlistEntry) ProtoMessage() {}
 
-func (x *CreateGroupRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_education_proto_msgTypes[31]
+func (x *WaitlistEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[108]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2426,98 +8258,108 @@ func (x *CreateGroupRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateGroupRequest.ProtoReflect.Descriptor instead.
-func (*CreateGroupRequest) Descriptor() ([]byte, []int) {
-	return file_education_proto_rawDescGZIP(), []int{31}
+// Deprecated: Use WaitlistEntry.ProtoReflect.Descriptor instead.
+func (*WaitlistEntry) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{108}
 }
 
-func (x *CreateGroupRequest) GetName() string {
+func (x *WaitlistEntry) GetId() string {
 	if x != nil {
-		return x.Name
+		return x.Id
 	}
 	return ""
 }
 
-func (x *CreateGroupRequest) GetCourseId() int32 {
+func (x *WaitlistEntry) GetStudentId() string {
 	if x != nil {
-		return x.CourseId
+		return x.StudentId
 	}
-	return 0
+	return ""
 }
 
-func (x *CreateGroupRequest) GetTeacherId() string {
+func (x *WaitlistEntry) GetStudentName() string {
 	if x != nil {
-		return x.TeacherId
+		return x.StudentName
 	}
 	return ""
 }
 
-func (x *CreateGroupRequest) GetType() string {
+func (x *WaitlistEntry) GetStudentPhone() string {
 	if x != nil {
-		return x.Type
+		return x.StudentPhone
 	}
 	return ""
 }
 
-func (x *CreateGroupRequest) GetDays() []string {
+func (x *WaitlistEntry) GetPosition() int32 {
 	if x != nil {
-		return x.Days
+		return x.Position
 	}
-	return nil
+	return 0
 }
 
-func (x *CreateGroupRequest) GetRoomId() int32 {
+func (x *WaitlistEntry) GetStatus() string {
 	if x != nil {
-		return x.RoomId
+		return x.Status
 	}
-	return 0
+	return ""
 }
 
-func (x *CreateGroupRequest) GetLessonStartTime() string {
+func (x *WaitlistEntry) GetOfferedAt() string {
 	if x != nil {
-		return x.LessonStartTime
+		return x.OfferedAt
 	}
 	return ""
 }
 
-func (x *CreateGroupRequest) GetGroupStartDate() string {
+func (x *WaitlistEntry) GetHoldUntil() string {
 	if x != nil {
-		return x.GroupStartDate
+		return x.HoldUntil
 	}
 	return ""
 }
 
-func (x *CreateGroupRequest) GetGroupEndDate() string {
+func (x *WaitlistEntry) GetCreatedByName() string {
 	if x != nil {
-		return x.GroupEndDate
+		return x.CreatedByName
 	}
 	return ""
 }
 
-type GetGroupByIdRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id"`
-	ActionRole    string                 `protobuf:"bytes,2,opt,name=actionRole,proto3" json:"actionRole"`
-	ActionId      string                 `protobuf:"bytes,3,opt,name=actionId,proto3" json:"actionId"`
+func (x *WaitlistEntry) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+type GetGroupWaitlistResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 0 when neither the group nor its room limits the seats
+	Capacity int32 `protobuf:"varint,1,opt,name=capacity,proto3" json:"capacity"`
+	Enrolled int32 `protobuf:"varint,2,opt,name=enrolled,proto3" json:"enrolled"`
+	// seats held for OFFERED students
+	Held          int32            `protobuf:"varint,3,opt,name=held,proto3" json:"held"`
+	Entries       []*WaitlistEntry `protobuf:"bytes,4,rep,name=entries,proto3" json:"entries"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetGroupByIdRequest) Reset() {
-	*x = GetGroupByIdRequest{}
-	mi := &file_education_proto_msgTypes[32]
+func (x *GetGroupWaitlistResponse) Reset() {
+	*x = GetGroupWaitlistResponse{}
+	mi := &file_education_proto_msgTypes[109]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetGroupByIdRequest) String() string {
+func (x *GetGroupWaitlistResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetGroupByIdRequest) ProtoMessage() {}
+func (*GetGroupWaitlistResponse) ProtoMessage() {}
 
-func (x *GetGroupByIdRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_education_proto_msgTypes[32]
+func (x *GetGroupWaitlistResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[109]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2528,63 +8370,114 @@ func (x *GetGroupByIdRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetGroupByIdRequest.ProtoReflect.Descriptor instead.
-func (*GetGroupByIdRequest) Descriptor() ([]byte, []int) {
-	return file_education_proto_rawDescGZIP(), []int{32}
+// Deprecated: Use GetGroupWaitlistResponse.ProtoReflect.Descriptor instead.
+func (*GetGroupWaitlistResponse) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{109}
 }
 
-func (x *GetGroupByIdRequest) GetId() string {
+func (x *GetGroupWaitlistResponse) GetCapacity() int32 {
 	if x != nil {
-		return x.Id
+		return x.Capacity
 	}
-	return ""
+	return 0
 }
 
-func (x *GetGroupByIdRequest) GetActionRole() string {
+func (x *GetGroupWaitlistResponse) GetEnrolled() int32 {
 	if x != nil {
-		return x.ActionRole
+		return x.Enrolled
 	}
-	return ""
+	return 0
 }
 
-func (x *GetGroupByIdRequest) GetActionId() string {
+func (x *GetGroupWaitlistResponse) GetHeld() int32 {
 	if x != nil {
-		return x.ActionId
+		return x.Held
+	}
+	return 0
+}
+
+func (x *GetGroupWaitlistResponse) GetEntries() []*WaitlistEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+type MoveWaitlistEntryRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id"`
+	// new place among the WAITING students, starting from 1
+	Position      int32 `protobuf:"varint,2,opt,name=position,proto3" json:"position"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MoveWaitlistEntryRequest) Reset() {
+	*x = MoveWaitlistEntryRequest{}
+	mi := &file_education_proto_msgTypes[110]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MoveWaitlistEntryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MoveWaitlistEntryRequest) ProtoMessage() {}
+
+func (x *MoveWaitlistEntryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[110]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MoveWaitlistEntryRequest.ProtoReflect.Descriptor instead.
+func (*MoveWaitlistEntryRequest) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{110}
+}
+
+func (x *MoveWaitlistEntryRequest) GetId() string {
+	if x != nil {
+		return x.Id
 	}
 	return ""
 }
 
-type GetUpdateGroupAbs struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	Id              string                 `protobuf:"bytes,10,opt,name=id,proto3" json:"id"`
-	Name            string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name"`
-	CourseId        int32                  `protobuf:"varint,2,opt,name=courseId,proto3" json:"courseId"`
-	TeacherId       string                 `protobuf:"bytes,3,opt,name=teacherId,proto3" json:"teacherId"`
-	Type            string                 `protobuf:"bytes,4,opt,name=type,proto3" json:"type"`
-	Days            []string               `protobuf:"bytes,5,rep,name=days,proto3" json:"days"`
-	RoomId          int32                  `protobuf:"varint,6,opt,name=roomId,proto3" json:"roomId"`
-	LessonStartTime string                 `protobuf:"bytes,7,opt,name=lessonStartTime,proto3" json:"lessonStartTime"`
-	GroupStartDate  string                 `protobuf:"bytes,8,opt,name=groupStartDate,proto3" json:"groupStartDate"`
-	GroupEndDate    string                 `protobuf:"bytes,9,opt,name=groupEndDate,proto3" json:"groupEndDate"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+func (x *MoveWaitlistEntryRequest) GetPosition() int32 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
 }
 
-func (x *GetUpdateGroupAbs) Reset() {
-	*x = GetUpdateGroupAbs{}
-	mi := &file_education_proto_msgTypes[33]
+type WaitlistEntryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WaitlistEntryRequest) Reset() {
+	*x = WaitlistEntryRequest{}
+	mi := &file_education_proto_msgTypes[111]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetUpdateGroupAbs) String() string {
+func (x *WaitlistEntryRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetUpdateGroupAbs) ProtoMessage() {}
+func (*WaitlistEntryRequest) ProtoMessage() {}
 
-func (x *GetUpdateGroupAbs) ProtoReflect() protoreflect.Message {
-	mi := &file_education_proto_msgTypes[33]
+func (x *WaitlistEntryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[111]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2595,103 +8488,131 @@ func (x *GetUpdateGroupAbs) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetUpdateGroupAbs.ProtoReflect.Descriptor instead.
-func (*GetUpdateGroupAbs) Descriptor() ([]byte, []int) {
-	return file_education_proto_rawDescGZIP(), []int{33}
+// Deprecated: Use WaitlistEntryRequest.ProtoReflect.Descriptor instead.
+func (*WaitlistEntryRequest) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{111}
 }
 
-func (x *GetUpdateGroupAbs) GetId() string {
+func (x *WaitlistEntryRequest) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-func (x *GetUpdateGroupAbs) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
+type AcceptWaitlistOfferRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id"`
+	CreatedDate   string                 `protobuf:"bytes,2,opt,name=createdDate,proto3" json:"createdDate"`
+	CreatedBy     string                 `protobuf:"bytes,3,opt,name=createdBy,proto3" json:"createdBy"`
+	CreatedByName string                 `protobuf:"bytes,4,opt,name=createdByName,proto3" json:"createdByName"`
+	PromoCode     string                 `protobuf:"bytes,5,opt,name=promoCode,proto3" json:"promoCode"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetUpdateGroupAbs) GetCourseId() int32 {
-	if x != nil {
-		return x.CourseId
-	}
-	return 0
+func (x *AcceptWaitlistOfferRequest) Reset() {
+	*x = AcceptWaitlistOfferRequest{}
+	mi := &file_education_proto_msgTypes[112]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetUpdateGroupAbs) GetTeacherId() string {
-	if x != nil {
-		return x.TeacherId
-	}
-	return ""
+func (x *AcceptWaitlistOfferRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *GetUpdateGroupAbs) GetType() string {
+func (*AcceptWaitlistOfferRequest) ProtoMessage() {}
+
+func (x *AcceptWaitlistOfferRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[112]
 	if x != nil {
-		return x.Type
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *GetUpdateGroupAbs) GetDays() []string {
+// Deprecated: Use AcceptWaitlistOfferRequest.ProtoReflect.Descriptor instead.
+func (*AcceptWaitlistOfferRequest) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{112}
+}
+
+func (x *AcceptWaitlistOfferRequest) GetId() string {
 	if x != nil {
-		return x.Days
+		return x.Id
 	}
-	return nil
+	return ""
 }
 
-func (x *GetUpdateGroupAbs) GetRoomId() int32 {
+func (x *AcceptWaitlistOfferRequest) GetCreatedDate() string {
 	if x != nil {
-		return x.RoomId
+		return x.CreatedDate
 	}
-	return 0
+	return ""
 }
 
-func (x *GetUpdateGroupAbs) GetLessonStartTime() string {
+func (x *AcceptWaitlistOfferRequest) GetCreatedBy() string {
 	if x != nil {
-		return x.LessonStartTime
+		return x.CreatedBy
 	}
 	return ""
 }
 
-func (x *GetUpdateGroupAbs) GetGroupStartDate() string {
+func (x *AcceptWaitlistOfferRequest) GetCreatedByName() string {
 	if x != nil {
-		return x.GroupStartDate
+		return x.CreatedByName
 	}
 	return ""
 }
 
-func (x *GetUpdateGroupAbs) GetGroupEndDate() string {
+func (x *AcceptWaitlistOfferRequest) GetPromoCode() string {
 	if x != nil {
-		return x.GroupEndDate
+		return x.PromoCode
 	}
 	return ""
 }
 
-type GetGroupsByCourseResponse struct {
-	state         protoimpl.MessageState         `protogen:"open.v1"`
-	Groups        []*GetGroupByCourseAbsResponse `protobuf:"bytes,1,rep,name=groups,proto3" json:"groups"`
+type AbsHoliday struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id"`
+	Title string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title"`
+	// yyyy-MM-dd, a one day holiday has equal dates
+	FromDate string `protobuf:"bytes,3,opt,name=fromDate,proto3" json:"fromDate"`
+	TillDate string `protobuf:"bytes,4,opt,name=tillDate,proto3" json:"tillDate"`
+	// repeats every year on the same days
+	Recurring bool `protobuf:"varint,5,opt,name=recurring,proto3" json:"recurring"`
+	// COMPANY closes every group, ROOM the groups of roomId, GROUP only groupId
+	Scope     string `protobuf:"bytes,6,opt,name=scope,proto3" json:"scope"`
+	RoomId    int32  `protobuf:"varint,7,opt,name=roomId,proto3" json:"roomId"`
+	GroupId   int64  `protobuf:"varint,8,opt,name=groupId,proto3" json:"groupId"`
+	RoomName  string `protobuf:"bytes,9,opt,name=roomName,proto3" json:"roomName"`
+	GroupName string `protobuf:"bytes,10,opt,name=groupName,proto3" json:"groupName"`
+	// preloaded public holiday, it can be switched off but not deleted
+	IsNational    bool `protobuf:"varint,11,opt,name=isNational,proto3" json:"isNational"`
+	IsActive      bool `protobuf:"varint,12,opt,name=isActive,proto3" json:"isActive"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetGroupsByCourseResponse) Reset() {
-	*x = GetGroupsByCourseResponse{}
-	mi := &file_education_proto_msgTypes[34]
+func (x *AbsHoliday) Reset() {
+	*x = AbsHoliday{}
+	mi := &file_education_proto_msgTypes[113]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetGroupsByCourseResponse) String() string {
+func (x *AbsHoliday) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetGroupsByCourseResponse) ProtoMessage() {}
+func (*AbsHoliday) ProtoMessage() {}
 
-func (x *GetGroupsByCourseResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_education_proto_msgTypes[34]
+func (x *AbsHoliday) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[113]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2702,145 +8623,119 @@ func (x *GetGroupsByCourseResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetGroupsByCourseResponse.ProtoReflect.Descriptor instead.
-func (*GetGroupsByCourseResponse) Descriptor() ([]byte, []int) {
-	return file_education_proto_rawDescGZIP(), []int{34}
+// Deprecated: Use AbsHoliday.ProtoReflect.Descriptor instead.
+func (*AbsHoliday) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{113}
 }
 
-func (x *GetGroupsByCourseResponse) GetGroups() []*GetGroupByCourseAbsResponse {
+func (x *AbsHoliday) GetId() string {
 	if x != nil {
-		return x.Groups
+		return x.Id
 	}
-	return nil
-}
-
-type GetGroupByCourseAbsResponse struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	Id              string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id"`
-	TeacherName     string                 `protobuf:"bytes,2,opt,name=teacherName,proto3" json:"teacherName"`
-	GroupStartDate  string                 `protobuf:"bytes,3,opt,name=groupStartDate,proto3" json:"groupStartDate"`
-	GroupEndDate    string                 `protobuf:"bytes,4,opt,name=groupEndDate,proto3" json:"groupEndDate"`
-	DateType        string                 `protobuf:"bytes,5,opt,name=dateType,proto3" json:"dateType"`
-	LessonStartTime string                 `protobuf:"bytes,6,opt,name=lessonStartTime,proto3" json:"lessonStartTime"`
-	GroupName       string                 `protobuf:"bytes,7,opt,name=groupName,proto3" json:"groupName"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+	return ""
 }
 
-func (x *GetGroupByCourseAbsResponse) Reset() {
-	*x = GetGroupByCourseAbsResponse{}
-	mi := &file_education_proto_msgTypes[35]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *AbsHoliday) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
 }
 
-func (x *GetGroupByCourseAbsResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *AbsHoliday) GetFromDate() string {
+	if x != nil {
+		return x.FromDate
+	}
+	return ""
 }
 
-func (*GetGroupByCourseAbsResponse) ProtoMessage() {}
-
-func (x *GetGroupByCourseAbsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_education_proto_msgTypes[35]
+func (x *AbsHoliday) GetTillDate() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.TillDate
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use GetGroupByCourseAbsResponse.ProtoReflect.Descriptor instead.
-func (*GetGroupByCourseAbsResponse) Descriptor() ([]byte, []int) {
-	return file_education_proto_rawDescGZIP(), []int{35}
+func (x *AbsHoliday) GetRecurring() bool {
+	if x != nil {
+		return x.Recurring
+	}
+	return false
 }
 
-func (x *GetGroupByCourseAbsResponse) GetId() string {
+func (x *AbsHoliday) GetScope() string {
 	if x != nil {
-		return x.Id
+		return x.Scope
 	}
 	return ""
 }
 
-func (x *GetGroupByCourseAbsResponse) GetTeacherName() string {
+func (x *AbsHoliday) GetRoomId() int32 {
 	if x != nil {
-		return x.TeacherName
+		return x.RoomId
 	}
-	return ""
+	return 0
 }
 
-func (x *GetGroupByCourseAbsResponse) GetGroupStartDate() string {
+func (x *AbsHoliday) GetGroupId() int64 {
 	if x != nil {
-		return x.GroupStartDate
+		return x.GroupId
 	}
-	return ""
+	return 0
 }
 
-func (x *GetGroupByCourseAbsResponse) GetGroupEndDate() string {
+func (x *AbsHoliday) GetRoomName() string {
 	if x != nil {
-		return x.GroupEndDate
+		return x.RoomName
 	}
 	return ""
 }
 
-func (x *GetGroupByCourseAbsResponse) GetDateType() string {
+func (x *AbsHoliday) GetGroupName() string {
 	if x != nil {
-		return x.DateType
+		return x.GroupName
 	}
 	return ""
 }
 
-func (x *GetGroupByCourseAbsResponse) GetLessonStartTime() string {
+func (x *AbsHoliday) GetIsNational() bool {
 	if x != nil {
-		return x.LessonStartTime
+		return x.IsNational
 	}
-	return ""
+	return false
 }
 
-func (x *GetGroupByCourseAbsResponse) GetGroupName() string {
+func (x *AbsHoliday) GetIsActive() bool {
 	if x != nil {
-		return x.GroupName
+		return x.IsActive
 	}
-	return ""
+	return false
 }
 
-type GetGroupAbsResponse struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	Id              string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id"`
-	Course          *AbsCourse             `protobuf:"bytes,2,opt,name=course,proto3" json:"course"`
-	TeacherName     string                 `protobuf:"bytes,3,opt,name=teacherName,proto3" json:"teacherName"`
-	TeacherId       string                 `protobuf:"bytes,14,opt,name=teacherId,proto3" json:"teacherId"`
-	LessonStartTime string                 `protobuf:"bytes,4,opt,name=lessonStartTime,proto3" json:"lessonStartTime"`
-	Room            *AbsRoom               `protobuf:"bytes,5,opt,name=room,proto3" json:"room"`
-	StudentCount    int32                  `protobuf:"varint,6,opt,name=studentCount,proto3" json:"studentCount"`
-	Name            string                 `protobuf:"bytes,7,opt,name=name,proto3" json:"name"`
-	IsArchived      bool                   `protobuf:"varint,8,opt,name=isArchived,proto3" json:"isArchived"`
-	CreatedAt       string                 `protobuf:"bytes,9,opt,name=createdAt,proto3" json:"createdAt"`
-	StartDate       string                 `protobuf:"bytes,10,opt,name=startDate,proto3" json:"startDate"`
-	EndDate         string                 `protobuf:"bytes,11,opt,name=endDate,proto3" json:"endDate"`
-	Days            []string               `protobuf:"bytes,12,rep,name=days,proto3" json:"days"`
-	DateType        string                 `protobuf:"bytes,13,opt,name=dateType,proto3" json:"dateType"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+type GetHolidaysRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// yyyy-MM-dd, holidays overlapping the period, all when empty
+	From          string `protobuf:"bytes,1,opt,name=from,proto3" json:"from"`
+	Till          string `protobuf:"bytes,2,opt,name=till,proto3" json:"till"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetGroupAbsResponse) Reset() {
-	*x = GetGroupAbsResponse{}
-	mi := &file_education_proto_msgTypes[36]
+func (x *GetHolidaysRequest) Reset() {
+	*x = GetHolidaysRequest{}
+	mi := &file_education_proto_msgTypes[114]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetGroupAbsResponse) String() string {
+func (x *GetHolidaysRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetGroupAbsResponse) ProtoMessage() {}
+func (*GetHolidaysRequest) ProtoMessage() {}
 
-func (x *GetGroupAbsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_education_proto_msgTypes[36]
+func (x *GetHolidaysRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[114]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2851,132 +8746,203 @@ func (x *GetGroupAbsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetGroupAbsResponse.ProtoReflect.Descriptor instead.
-func (*GetGroupAbsResponse) Descriptor() ([]byte, []int) {
-	return file_education_proto_rawDescGZIP(), []int{36}
+// Deprecated: Use GetHolidaysRequest.ProtoReflect.Descriptor instead.
+func (*GetHolidaysRequest) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{114}
 }
 
-func (x *GetGroupAbsResponse) GetId() string {
+func (x *GetHolidaysRequest) GetFrom() string {
 	if x != nil {
-		return x.Id
+		return x.From
 	}
 	return ""
 }
 
-func (x *GetGroupAbsResponse) GetCourse() *AbsCourse {
+func (x *GetHolidaysRequest) GetTill() string {
 	if x != nil {
-		return x.Course
+		return x.Till
 	}
-	return nil
+	return ""
 }
 
-func (x *GetGroupAbsResponse) GetTeacherName() string {
-	if x != nil {
-		return x.TeacherName
-	}
-	return ""
+type GetHolidaysResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Holidays      []*AbsHoliday          `protobuf:"bytes,1,rep,name=holidays,proto3" json:"holidays"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetGroupAbsResponse) GetTeacherId() string {
-	if x != nil {
-		return x.TeacherId
-	}
-	return ""
+func (x *GetHolidaysResponse) Reset() {
+	*x = GetHolidaysResponse{}
+	mi := &file_education_proto_msgTypes[115]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetGroupAbsResponse) GetLessonStartTime() string {
+func (x *GetHolidaysResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetHolidaysResponse) ProtoMessage() {}
+
+func (x *GetHolidaysResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[115]
 	if x != nil {
-		return x.LessonStartTime
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *GetGroupAbsResponse) GetRoom() *AbsRoom {
+// Deprecated: Use GetHolidaysResponse.ProtoReflect.Descriptor instead.
+func (*GetHolidaysResponse) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{115}
+}
+
+func (x *GetHolidaysResponse) GetHolidays() []*AbsHoliday {
 	if x != nil {
-		return x.Room
+		return x.Holidays
 	}
 	return nil
 }
 
-func (x *GetGroupAbsResponse) GetStudentCount() int32 {
-	if x != nil {
-		return x.StudentCount
-	}
-	return 0
+type GetGroupHolidaysRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GroupId       string                 `protobuf:"bytes,1,opt,name=groupId,proto3" json:"groupId"`
+	From          string                 `protobuf:"bytes,2,opt,name=from,proto3" json:"from"`
+	Till          string                 `protobuf:"bytes,3,opt,name=till,proto3" json:"till"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetGroupAbsResponse) GetName() string {
+func (x *GetGroupHolidaysRequest) Reset() {
+	*x = GetGroupHolidaysRequest{}
+	mi := &file_education_proto_msgTypes[116]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetGroupHolidaysRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGroupHolidaysRequest) ProtoMessage() {}
+
+func (x *GetGroupHolidaysRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[116]
 	if x != nil {
-		return x.Name
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *GetGroupAbsResponse) GetIsArchived() bool {
-	if x != nil {
-		return x.IsArchived
-	}
-	return false
+// Deprecated: Use GetGroupHolidaysRequest.ProtoReflect.Descriptor instead.
+func (*GetGroupHolidaysRequest) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{116}
 }
 
-func (x *GetGroupAbsResponse) GetCreatedAt() string {
+func (x *GetGroupHolidaysRequest) GetGroupId() string {
 	if x != nil {
-		return x.CreatedAt
+		return x.GroupId
 	}
 	return ""
 }
 
-func (x *GetGroupAbsResponse) GetStartDate() string {
+func (x *GetGroupHolidaysRequest) GetFrom() string {
 	if x != nil {
-		return x.StartDate
+		return x.From
 	}
 	return ""
 }
 
-func (x *GetGroupAbsResponse) GetEndDate() string {
+func (x *GetGroupHolidaysRequest) GetTill() string {
 	if x != nil {
-		return x.EndDate
+		return x.Till
 	}
 	return ""
 }
 
-func (x *GetGroupAbsResponse) GetDays() []string {
+type GetGroupHolidaysResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// days without lessons of the group in the period
+	Dates         []string `protobuf:"bytes,1,rep,name=dates,proto3" json:"dates"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetGroupHolidaysResponse) Reset() {
+	*x = GetGroupHolidaysResponse{}
+	mi := &file_education_proto_msgTypes[117]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetGroupHolidaysResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGroupHolidaysResponse) ProtoMessage() {}
+
+func (x *GetGroupHolidaysResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[117]
 	if x != nil {
-		return x.Days
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *GetGroupAbsResponse) GetDateType() string {
+// Deprecated: Use GetGroupHolidaysResponse.ProtoReflect.Descriptor instead.
+func (*GetGroupHolidaysResponse) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{117}
+}
+
+func (x *GetGroupHolidaysResponse) GetDates() []string {
 	if x != nil {
-		return x.DateType
+		return x.Dates
 	}
-	return ""
+	return nil
 }
 
-type GetGroupsResponse struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	Groups         []*GetGroupAbsResponse `protobuf:"bytes,1,rep,name=groups,proto3" json:"groups"`
-	TotalPageCount int32                  `protobuf:"varint,2,opt,name=totalPageCount,proto3" json:"totalPageCount"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+type GetTimetableRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// yyyy-MM-dd, at most 93 days apart
+	From string `protobuf:"bytes,1,opt,name=from,proto3" json:"from"`
+	Till string `protobuf:"bytes,2,opt,name=till,proto3" json:"till"`
+	// filters, zero or empty values are not applied
+	RoomId        int32  `protobuf:"varint,3,opt,name=roomId,proto3" json:"roomId"`
+	TeacherId     string `protobuf:"bytes,4,opt,name=teacherId,proto3" json:"teacherId"`
+	CourseId      int32  `protobuf:"varint,5,opt,name=courseId,proto3" json:"courseId"`
+	GroupId       string `protobuf:"bytes,6,opt,name=groupId,proto3" json:"groupId"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetGroupsResponse) Reset() {
-	*x = GetGroupsResponse{}
-	mi := &file_education_proto_msgTypes[37]
+func (x *GetTimetableRequest) Reset() {
+	*x = GetTimetableRequest{}
+	mi := &file_education_proto_msgTypes[118]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetGroupsResponse) String() string {
+func (x *GetTimetableRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetGroupsResponse) ProtoMessage() {}
+func (*GetTimetableRequest) ProtoMessage() {}
 
-func (x *GetGroupsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_education_proto_msgTypes[37]
+func (x *GetTimetableRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[118]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2987,55 +8953,90 @@ func (x *GetGroupsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetGroupsResponse.ProtoReflect.Descriptor instead.
-func (*GetGroupsResponse) Descriptor() ([]byte, []int) {
-	return file_education_proto_rawDescGZIP(), []int{37}
+// Deprecated: Use GetTimetableRequest.ProtoReflect.Descriptor instead.
+func (*GetTimetableRequest) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{118}
 }
 
-func (x *GetGroupsResponse) GetGroups() []*GetGroupAbsResponse {
+func (x *GetTimetableRequest) GetFrom() string {
 	if x != nil {
-		return x.Groups
+		return x.From
 	}
-	return nil
+	return ""
 }
 
-func (x *GetGroupsResponse) GetTotalPageCount() int32 {
+func (x *GetTimetableRequest) GetTill() string {
 	if x != nil {
-		return x.TotalPageCount
+		return x.Till
+	}
+	return ""
+}
+
+func (x *GetTimetableRequest) GetRoomId() int32 {
+	if x != nil {
+		return x.RoomId
 	}
 	return 0
 }
 
-type GetGroupsRequest struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	IsArchived     bool                   `protobuf:"varint,1,opt,name=isArchived,proto3" json:"isArchived"`
-	TeacherId      *string                `protobuf:"bytes,2,opt,name=teacherId,proto3,oneof" json:"teacherId"`
-	CourseId       *int32                 `protobuf:"varint,3,opt,name=courseId,proto3,oneof" json:"courseId"`
-	DateType       *string                `protobuf:"bytes,4,opt,name=dateType,proto3,oneof" json:"dateType"`
-	StartDate      *string                `protobuf:"bytes,5,opt,name=startDate,proto3,oneof" json:"startDate"`
-	EndDate        *string                `protobuf:"bytes,6,opt,name=endDate,proto3,oneof" json:"endDate"`
-	Page           *PageRequest           `protobuf:"bytes,7,opt,name=page,proto3" json:"page"`
-	OrderBy        string                 `protobuf:"bytes,8,opt,name=orderBy,proto3" json:"orderBy"`
-	OrderDirection string                 `protobuf:"bytes,9,opt,name=orderDirection,proto3" json:"orderDirection"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+func (x *GetTimetableRequest) GetTeacherId() string {
+	if x != nil {
+		return x.TeacherId
+	}
+	return ""
 }
 
-func (x *GetGroupsRequest) Reset() {
-	*x = GetGroupsRequest{}
-	mi := &file_education_proto_msgTypes[38]
+func (x *GetTimetableRequest) GetCourseId() int32 {
+	if x != nil {
+		return x.CourseId
+	}
+	return 0
+}
+
+func (x *GetTimetableRequest) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+type TimetableLesson struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	GroupId     int64                  `protobuf:"varint,1,opt,name=groupId,proto3" json:"groupId"`
+	GroupName   string                 `protobuf:"bytes,2,opt,name=groupName,proto3" json:"groupName"`
+	CourseId    int32                  `protobuf:"varint,3,opt,name=courseId,proto3" json:"courseId"`
+	CourseName  string                 `protobuf:"bytes,4,opt,name=courseName,proto3" json:"courseName"`
+	TeacherId   string                 `protobuf:"bytes,5,opt,name=teacherId,proto3" json:"teacherId"`
+	TeacherName string                 `protobuf:"bytes,6,opt,name=teacherName,proto3" json:"teacherName"`
+	RoomId      int32                  `protobuf:"varint,7,opt,name=roomId,proto3" json:"roomId"`
+	RoomName    string                 `protobuf:"bytes,8,opt,name=roomName,proto3" json:"roomName"`
+	// yyyy-MM-dd
+	Date string `protobuf:"bytes,9,opt,name=date,proto3" json:"date"`
+	// HH:mm, endTime adds the lesson duration of the course
+	StartTime string `protobuf:"bytes,10,opt,name=startTime,proto3" json:"startTime"`
+	EndTime   string `protobuf:"bytes,11,opt,name=endTime,proto3" json:"endTime"`
+	// realDate is the date the lesson was moved from when transferred is set
+	Transferred   bool   `protobuf:"varint,12,opt,name=transferred,proto3" json:"transferred"`
+	RealDate      string `protobuf:"bytes,13,opt,name=realDate,proto3" json:"realDate"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TimetableLesson) Reset() {
+	*x = TimetableLesson{}
+	mi := &file_education_proto_msgTypes[119]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetGroupsRequest) String() string {
+func (x *TimetableLesson) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetGroupsRequest) ProtoMessage() {}
+func (*TimetableLesson) ProtoMessage() {}
 
-func (x *GetGroupsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_education_proto_msgTypes[38]
+func (x *TimetableLesson) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[119]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3046,156 +9047,124 @@ func (x *GetGroupsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetGroupsRequest.ProtoReflect.Descriptor instead.
-func (*GetGroupsRequest) Descriptor() ([]byte, []int) {
-	return file_education_proto_rawDescGZIP(), []int{38}
+// Deprecated: Use TimetableLesson.ProtoReflect.Descriptor instead.
+func (*TimetableLesson) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{119}
 }
 
-func (x *GetGroupsRequest) GetIsArchived() bool {
+func (x *TimetableLesson) GetGroupId() int64 {
 	if x != nil {
-		return x.IsArchived
+		return x.GroupId
 	}
-	return false
+	return 0
 }
 
-func (x *GetGroupsRequest) GetTeacherId() string {
-	if x != nil && x.TeacherId != nil {
-		return *x.TeacherId
+func (x *TimetableLesson) GetGroupName() string {
+	if x != nil {
+		return x.GroupName
 	}
 	return ""
 }
 
-func (x *GetGroupsRequest) GetCourseId() int32 {
-	if x != nil && x.CourseId != nil {
-		return *x.CourseId
+func (x *TimetableLesson) GetCourseId() int32 {
+	if x != nil {
+		return x.CourseId
 	}
 	return 0
 }
 
-func (x *GetGroupsRequest) GetDateType() string {
-	if x != nil && x.DateType != nil {
-		return *x.DateType
+func (x *TimetableLesson) GetCourseName() string {
+	if x != nil {
+		return x.CourseName
 	}
 	return ""
 }
 
-func (x *GetGroupsRequest) GetStartDate() string {
-	if x != nil && x.StartDate != nil {
-		return *x.StartDate
+func (x *TimetableLesson) GetTeacherId() string {
+	if x != nil {
+		return x.TeacherId
 	}
 	return ""
 }
 
-func (x *GetGroupsRequest) GetEndDate() string {
-	if x != nil && x.EndDate != nil {
-		return *x.EndDate
+func (x *TimetableLesson) GetTeacherName() string {
+	if x != nil {
+		return x.TeacherName
 	}
 	return ""
 }
 
-func (x *GetGroupsRequest) GetPage() *PageRequest {
+func (x *TimetableLesson) GetRoomId() int32 {
 	if x != nil {
-		return x.Page
+		return x.RoomId
 	}
-	return nil
+	return 0
 }
 
-func (x *GetGroupsRequest) GetOrderBy() string {
+func (x *TimetableLesson) GetRoomName() string {
 	if x != nil {
-		return x.OrderBy
+		return x.RoomName
 	}
 	return ""
 }
 
-func (x *GetGroupsRequest) GetOrderDirection() string {
+func (x *TimetableLesson) GetDate() string {
 	if x != nil {
-		return x.OrderDirection
+		return x.Date
 	}
 	return ""
 }
 
-type CalculateTeacherSalaryRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	From          string                 `protobuf:"bytes,1,opt,name=from,proto3" json:"from"`
-	To            string                 `protobuf:"bytes,2,opt,name=to,proto3" json:"to"`
-	TeacherId     string                 `protobuf:"bytes,3,opt,name=teacherId,proto3" json:"teacherId"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *CalculateTeacherSalaryRequest) Reset() {
-	*x = CalculateTeacherSalaryRequest{}
-	mi := &file_education_proto_msgTypes[39]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *CalculateTeacherSalaryRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*CalculateTeacherSalaryRequest) ProtoMessage() {}
-
-func (x *CalculateTeacherSalaryRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_education_proto_msgTypes[39]
+func (x *TimetableLesson) GetStartTime() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.StartTime
 	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use CalculateTeacherSalaryRequest.ProtoReflect.Descriptor instead.
-func (*CalculateTeacherSalaryRequest) Descriptor() ([]byte, []int) {
-	return file_education_proto_rawDescGZIP(), []int{39}
+	return ""
 }
 
-func (x *CalculateTeacherSalaryRequest) GetFrom() string {
+func (x *TimetableLesson) GetEndTime() string {
 	if x != nil {
-		return x.From
+		return x.EndTime
 	}
 	return ""
 }
 
-func (x *CalculateTeacherSalaryRequest) GetTo() string {
+func (x *TimetableLesson) GetTransferred() bool {
 	if x != nil {
-		return x.To
+		return x.Transferred
 	}
-	return ""
+	return false
 }
 
-func (x *CalculateTeacherSalaryRequest) GetTeacherId() string {
+func (x *TimetableLesson) GetRealDate() string {
 	if x != nil {
-		return x.TeacherId
+		return x.RealDate
 	}
 	return ""
 }
 
-type CalculateTeacherSalaryResponse struct {
+type GetTimetableResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Salaries      []*AbsCalculateSalary  `protobuf:"bytes,1,rep,name=salaries,proto3" json:"salaries"`
+	Lessons       []*TimetableLesson     `protobuf:"bytes,1,rep,name=lessons,proto3" json:"lessons"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CalculateTeacherSalaryResponse) Reset() {
-	*x = CalculateTeacherSalaryResponse{}
-	mi := &file_education_proto_msgTypes[40]
+func (x *GetTimetableResponse) Reset() {
+	*x = GetTimetableResponse{}
+	mi := &file_education_proto_msgTypes[120]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CalculateTeacherSalaryResponse) String() string {
+func (x *GetTimetableResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CalculateTeacherSalaryResponse) ProtoMessage() {}
+func (*GetTimetableResponse) ProtoMessage() {}
 
-func (x *CalculateTeacherSalaryResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_education_proto_msgTypes[40]
+func (x *GetTimetableResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[120]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3206,43 +9175,43 @@ func (x *CalculateTeacherSalaryResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CalculateTeacherSalaryResponse.ProtoReflect.Descriptor instead.
-func (*CalculateTeacherSalaryResponse) Descriptor() ([]byte, []int) {
-	return file_education_proto_rawDescGZIP(), []int{40}
+// Deprecated: Use GetTimetableResponse.ProtoReflect.Descriptor instead.
+func (*GetTimetableResponse) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{120}
 }
 
-func (x *CalculateTeacherSalaryResponse) GetSalaries() []*AbsCalculateSalary {
+func (x *GetTimetableResponse) GetLessons() []*TimetableLesson {
 	if x != nil {
-		return x.Salaries
+		return x.Lessons
 	}
 	return nil
 }
 
-type AbsCalculateSalary struct {
-	state                     protoimpl.MessageState `protogen:"open.v1"`
-	GroupId                   string                 `protobuf:"bytes,1,opt,name=groupId,proto3" json:"groupId"`
-	GroupName                 string                 `protobuf:"bytes,2,opt,name=groupName,proto3" json:"groupName"`
-	CommonLessonCountInPeriod int32                  `protobuf:"varint,3,opt,name=commonLessonCountInPeriod,proto3" json:"commonLessonCountInPeriod"`
-	Salaries                  []*StudentSalary       `protobuf:"bytes,4,rep,name=salaries,proto3" json:"salaries"`
-	unknownFields             protoimpl.UnknownFields
-	sizeCache                 protoimpl.SizeCache
+type CreateCalendarFeedRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// TEACHER or ROOM
+	OwnerType string `protobuf:"bytes,1,opt,name=ownerType,proto3" json:"ownerType"`
+	// teacher id or room id
+	OwnerId       string `protobuf:"bytes,2,opt,name=ownerId,proto3" json:"ownerId"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AbsCalculateSalary) Reset() {
-	*x = AbsCalculateSalary{}
-	mi := &file_education_proto_msgTypes[41]
+func (x *CreateCalendarFeedRequest) Reset() {
+	*x = CreateCalendarFeedRequest{}
+	mi := &file_education_proto_msgTypes[121]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AbsCalculateSalary) String() string {
+func (x *CreateCalendarFeedRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AbsCalculateSalary) ProtoMessage() {}
+func (*CreateCalendarFeedRequest) ProtoMessage() {}
 
-func (x *AbsCalculateSalary) ProtoReflect() protoreflect.Message {
-	mi := &file_education_proto_msgTypes[41]
+func (x *CreateCalendarFeedRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[121]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3252,68 +9221,55 @@ func (x *AbsCalculateSalary) ProtoReflect() protoreflect.Message {
 	}
 	return mi.MessageOf(x)
 }
-
-// Deprecated: Use AbsCalculateSalary.ProtoReflect.Descriptor instead.
-func (*AbsCalculateSalary) Descriptor() ([]byte, []int) {
-	return file_education_proto_rawDescGZIP(), []int{41}
-}
-
-func (x *AbsCalculateSalary) GetGroupId() string {
-	if x != nil {
-		return x.GroupId
-	}
-	return ""
-}
-
-func (x *AbsCalculateSalary) GetGroupName() string {
-	if x != nil {
-		return x.GroupName
-	}
-	return ""
+
+// Deprecated: Use CreateCalendarFeedRequest.ProtoReflect.Descriptor instead.
+func (*CreateCalendarFeedRequest) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{121}
 }
 
-func (x *AbsCalculateSalary) GetCommonLessonCountInPeriod() int32 {
+func (x *CreateCalendarFeedRequest) GetOwnerType() string {
 	if x != nil {
-		return x.CommonLessonCountInPeriod
+		return x.OwnerType
 	}
-	return 0
+	return ""
 }
 
-func (x *AbsCalculateSalary) GetSalaries() []*StudentSalary {
+func (x *CreateCalendarFeedRequest) GetOwnerId() string {
 	if x != nil {
-		return x.Salaries
+		return x.OwnerId
 	}
-	return nil
+	return ""
 }
 
-type StudentSalary struct {
-	state                    protoimpl.MessageState `protogen:"open.v1"`
-	StudentId                string                 `protobuf:"bytes,1,opt,name=studentId,proto3" json:"studentId"`
-	StudentName              string                 `protobuf:"bytes,2,opt,name=studentName,proto3" json:"studentName"`
-	PassedLessonCount        int32                  `protobuf:"varint,3,opt,name=passedLessonCount,proto3" json:"passedLessonCount"`
-	CalculatedSalaryInPeriod int32                  `protobuf:"varint,4,opt,name=calculatedSalaryInPeriod,proto3" json:"calculatedSalaryInPeriod"`
-	PriceType                string                 `protobuf:"bytes,5,opt,name=priceType,proto3" json:"priceType"`
-	TotalCount               float64                `protobuf:"fixed64,6,opt,name=totalCount,proto3" json:"totalCount"`
-	CoursePrice              float64                `protobuf:"fixed64,7,opt,name=coursePrice,proto3" json:"coursePrice"`
-	unknownFields            protoimpl.UnknownFields
-	sizeCache                protoimpl.SizeCache
+type CalendarFeed struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Id        string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id"`
+	OwnerType string                 `protobuf:"bytes,2,opt,name=ownerType,proto3" json:"ownerType"`
+	OwnerId   string                 `protobuf:"bytes,3,opt,name=ownerId,proto3" json:"ownerId"`
+	OwnerName string                 `protobuf:"bytes,4,opt,name=ownerName,proto3" json:"ownerName"`
+	Token     string                 `protobuf:"bytes,5,opt,name=token,proto3" json:"token"`
+	CreatedAt string                 `protobuf:"bytes,6,opt,name=createdAt,proto3" json:"createdAt"`
+	// url is the .ics address, it is filled in by the gateway
+	Url           string `protobuf:"bytes,7,opt,name=url,proto3" json:"url"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *StudentSalary) Reset() {
-	*x = StudentSalary{}
-	mi := &file_education_proto_msgTypes[42]
+func (x *CalendarFeed) Reset() {
+	*x = CalendarFeed{}
+	mi := &file_education_proto_msgTypes[122]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *StudentSalary) String() string {
+func (x *CalendarFeed) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StudentSalary) ProtoMessage() {}
+func (*CalendarFeed) ProtoMessage() {}
 
-func (x *StudentSalary) ProtoReflect() protoreflect.Message {
-	mi := &file_education_proto_msgTypes[42]
+func (x *CalendarFeed) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[122]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3324,87 +9280,83 @@ func (x *StudentSalary) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StudentSalary.ProtoReflect.Descriptor instead.
-func (*StudentSalary) Descriptor() ([]byte, []int) {
-	return file_education_proto_rawDescGZIP(), []int{42}
+// Deprecated: Use CalendarFeed.ProtoReflect.Descriptor instead.
+func (*CalendarFeed) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{122}
 }
 
-func (x *StudentSalary) GetStudentId() string {
+func (x *CalendarFeed) GetId() string {
 	if x != nil {
-		return x.StudentId
+		return x.Id
 	}
 	return ""
 }
 
-func (x *StudentSalary) GetStudentName() string {
+func (x *CalendarFeed) GetOwnerType() string {
 	if x != nil {
-		return x.StudentName
+		return x.OwnerType
 	}
 	return ""
 }
 
-func (x *StudentSalary) GetPassedLessonCount() int32 {
+func (x *CalendarFeed) GetOwnerId() string {
 	if x != nil {
-		return x.PassedLessonCount
+		return x.OwnerId
 	}
-	return 0
+	return ""
 }
 
-func (x *StudentSalary) GetCalculatedSalaryInPeriod() int32 {
+func (x *CalendarFeed) GetOwnerName() string {
 	if x != nil {
-		return x.CalculatedSalaryInPeriod
+		return x.OwnerName
 	}
-	return 0
+	return ""
 }
 
-func (x *StudentSalary) GetPriceType() string {
+func (x *CalendarFeed) GetToken() string {
 	if x != nil {
-		return x.PriceType
+		return x.Token
 	}
 	return ""
 }
 
-func (x *StudentSalary) GetTotalCount() float64 {
+func (x *CalendarFeed) GetCreatedAt() string {
 	if x != nil {
-		return x.TotalCount
+		return x.CreatedAt
 	}
-	return 0
+	return ""
 }
 
-func (x *StudentSalary) GetCoursePrice() float64 {
+func (x *CalendarFeed) GetUrl() string {
 	if x != nil {
-		return x.CoursePrice
+		return x.Url
 	}
-	return 0
+	return ""
 }
 
-type GetAttendanceRequest struct {
+type GetCalendarFeedsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	GroupId       string                 `protobuf:"bytes,1,opt,name=groupId,proto3" json:"groupId"`
-	From          string                 `protobuf:"bytes,2,opt,name=from,proto3" json:"from"`
-	Till          string                 `protobuf:"bytes,3,opt,name=till,proto3" json:"till"`
-	WithOutdated  bool                   `protobuf:"varint,4,opt,name=withOutdated,proto3" json:"withOutdated"`
-	ActionId      string                 `protobuf:"bytes,5,opt,name=actionId,proto3" json:"actionId"`
-	ActionRole    string                 `protobuf:"bytes,6,opt,name=actionRole,proto3" json:"actionRole"`
+	OwnerType     string                 `protobuf:"bytes,1,opt,name=ownerType,proto3" json:"ownerType"`
+	OwnerId       string                 `protobuf:"bytes,2,opt,name=ownerId,proto3" json:"ownerId"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetAttendanceRequest) Reset() {
-	*x = GetAttendanceRequest{}
-	mi := &file_education_proto_msgTypes[43]
+func (x *GetCalendarFeedsRequest) Reset() {
+	*x = GetCalendarFeedsRequest{}
+	mi := &file_education_proto_msgTypes[123]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetAttendanceRequest) String() string {
+func (x *GetCalendarFeedsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetAttendanceRequest) ProtoMessage() {}
+func (*GetCalendarFeedsRequest) ProtoMessage() {}
 
-func (x *GetAttendanceRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_education_proto_msgTypes[43]
+func (x *GetCalendarFeedsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[123]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3415,76 +9367,91 @@ func (x *GetAttendanceRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetAttendanceRequest.ProtoReflect.Descriptor instead.
-func (*GetAttendanceRequest) Descriptor() ([]byte, []int) {
-	return file_education_proto_rawDescGZIP(), []int{43}
+// Deprecated: Use GetCalendarFeedsRequest.ProtoReflect.Descriptor instead.
+func (*GetCalendarFeedsRequest) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{123}
 }
 
-func (x *GetAttendanceRequest) GetGroupId() string {
+func (x *GetCalendarFeedsRequest) GetOwnerType() string {
 	if x != nil {
-		return x.GroupId
+		return x.OwnerType
 	}
 	return ""
 }
 
-func (x *GetAttendanceRequest) GetFrom() string {
+func (x *GetCalendarFeedsRequest) GetOwnerId() string {
 	if x != nil {
-		return x.From
+		return x.OwnerId
 	}
 	return ""
 }
 
-func (x *GetAttendanceRequest) GetTill() string {
-	if x != nil {
-		return x.Till
-	}
-	return ""
+type GetCalendarFeedsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Feeds         []*CalendarFeed        `protobuf:"bytes,1,rep,name=feeds,proto3" json:"feeds"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetAttendanceRequest) GetWithOutdated() bool {
-	if x != nil {
-		return x.WithOutdated
-	}
-	return false
+func (x *GetCalendarFeedsResponse) Reset() {
+	*x = GetCalendarFeedsResponse{}
+	mi := &file_education_proto_msgTypes[124]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetAttendanceRequest) GetActionId() string {
+func (x *GetCalendarFeedsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCalendarFeedsResponse) ProtoMessage() {}
+
+func (x *GetCalendarFeedsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[124]
 	if x != nil {
-		return x.ActionId
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *GetAttendanceRequest) GetActionRole() string {
+// Deprecated: Use GetCalendarFeedsResponse.ProtoReflect.Descriptor instead.
+func (*GetCalendarFeedsResponse) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{124}
+}
+
+func (x *GetCalendarFeedsResponse) GetFeeds() []*CalendarFeed {
 	if x != nil {
-		return x.ActionRole
+		return x.Feeds
 	}
-	return ""
+	return nil
 }
 
-type GetAttendanceResponse struct {
+type GetCalendarFeedTimetableRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Days          []*Day                 `protobuf:"bytes,1,rep,name=days,proto3" json:"days"`
-	Students      []*Student             `protobuf:"bytes,2,rep,name=students,proto3" json:"students"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetAttendanceResponse) Reset() {
-	*x = GetAttendanceResponse{}
-	mi := &file_education_proto_msgTypes[44]
+func (x *GetCalendarFeedTimetableRequest) Reset() {
+	*x = GetCalendarFeedTimetableRequest{}
+	mi := &file_education_proto_msgTypes[125]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetAttendanceResponse) String() string {
+func (x *GetCalendarFeedTimetableRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetAttendanceResponse) ProtoMessage() {}
+func (*GetCalendarFeedTimetableRequest) ProtoMessage() {}
 
-func (x *GetAttendanceResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_education_proto_msgTypes[44]
+func (x *GetCalendarFeedTimetableRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[125]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3495,48 +9462,41 @@ func (x *GetAttendanceResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetAttendanceResponse.ProtoReflect.Descriptor instead.
-func (*GetAttendanceResponse) Descriptor() ([]byte, []int) {
-	return file_education_proto_rawDescGZIP(), []int{44}
-}
-
-func (x *GetAttendanceResponse) GetDays() []*Day {
-	if x != nil {
-		return x.Days
-	}
-	return nil
+// Deprecated: Use GetCalendarFeedTimetableRequest.ProtoReflect.Descriptor instead.
+func (*GetCalendarFeedTimetableRequest) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{125}
 }
 
-func (x *GetAttendanceResponse) GetStudents() []*Student {
+func (x *GetCalendarFeedTimetableRequest) GetToken() string {
 	if x != nil {
-		return x.Students
+		return x.Token
 	}
-	return nil
+	return ""
 }
 
-type Day struct {
+type CalendarFeedTimetable struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Date          string                 `protobuf:"bytes,1,opt,name=date,proto3" json:"date"`
-	TransferDate  string                 `protobuf:"bytes,2,opt,name=transferDate,proto3" json:"transferDate"`
+	Feed          *CalendarFeed          `protobuf:"bytes,1,opt,name=feed,proto3" json:"feed"`
+	Lessons       []*TimetableLesson     `protobuf:"bytes,2,rep,name=lessons,proto3" json:"lessons"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Day) Reset() {
-	*x = Day{}
-	mi := &file_education_proto_msgTypes[45]
+func (x *CalendarFeedTimetable) Reset() {
+	*x = CalendarFeedTimetable{}
+	mi := &file_education_proto_msgTypes[126]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Day) String() string {
+func (x *CalendarFeedTimetable) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Day) ProtoMessage() {}
+func (*CalendarFeedTimetable) ProtoMessage() {}
 
-func (x *Day) ProtoReflect() protoreflect.Message {
-	mi := &file_education_proto_msgTypes[45]
+func (x *CalendarFeedTimetable) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[126]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3547,58 +9507,62 @@ func (x *Day) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Day.ProtoReflect.Descriptor instead.
-func (*Day) Descriptor() ([]byte, []int) {
-	return file_education_proto_rawDescGZIP(), []int{45}
+// Deprecated: Use CalendarFeedTimetable.ProtoReflect.Descriptor instead.
+func (*CalendarFeedTimetable) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{126}
 }
 
-func (x *Day) GetDate() string {
+func (x *CalendarFeedTimetable) GetFeed() *CalendarFeed {
 	if x != nil {
-		return x.Date
+		return x.Feed
 	}
-	return ""
+	return nil
 }
 
-func (x *Day) GetTransferDate() string {
+func (x *CalendarFeedTimetable) GetLessons() []*TimetableLesson {
 	if x != nil {
-		return x.TransferDate
+		return x.Lessons
 	}
-	return ""
+	return nil
 }
 
-type Student struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,9,opt,name=id,proto3" json:"id"`
-	ActivatedAt   string                 `protobuf:"bytes,1,opt,name=activatedAt,proto3" json:"activatedAt"`
-	AddedAt       string                 `protobuf:"bytes,2,opt,name=addedAt,proto3" json:"addedAt"`
-	Attendance    []*Attendance          `protobuf:"bytes,3,rep,name=attendance,proto3" json:"attendance"`
-	Balance       float64                `protobuf:"fixed64,4,opt,name=balance,proto3" json:"balance"`
-	CreatedAt     string                 `protobuf:"bytes,5,opt,name=createdAt,proto3" json:"createdAt"`
-	DateOfBirth   string                 `protobuf:"bytes,6,opt,name=dateOfBirth,proto3" json:"dateOfBirth"`
-	FreezeDetail  *FreezeDetail          `protobuf:"bytes,7,opt,name=freezeDetail,proto3" json:"freezeDetail"`
-	Gender        bool                   `protobuf:"varint,8,opt,name=gender,proto3" json:"gender"`
-	Condition     string                 `protobuf:"bytes,10,opt,name=condition,proto3" json:"condition"`
-	Name          string                 `protobuf:"bytes,11,opt,name=name,proto3" json:"name"`
-	Phone         string                 `protobuf:"bytes,12,opt,name=phone,proto3" json:"phone"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type AbsLesson struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Id        string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id"`
+	GroupId   int64                  `protobuf:"varint,2,opt,name=groupId,proto3" json:"groupId"`
+	GroupName string                 `protobuf:"bytes,3,opt,name=groupName,proto3" json:"groupName"`
+	// yyyy-MM-dd, realDate is the date the lesson was moved from when it was transferred
+	Date     string `protobuf:"bytes,4,opt,name=date,proto3" json:"date"`
+	RealDate string `protobuf:"bytes,5,opt,name=realDate,proto3" json:"realDate"`
+	// teacherId is who teaches the lesson, substitute is set when it is not the teacher of the group
+	TeacherId       string   `protobuf:"bytes,6,opt,name=teacherId,proto3" json:"teacherId"`
+	TeacherName     string   `protobuf:"bytes,7,opt,name=teacherName,proto3" json:"teacherName"`
+	Substitute      bool     `protobuf:"varint,8,opt,name=substitute,proto3" json:"substitute"`
+	Topic           string   `protobuf:"bytes,9,opt,name=topic,proto3" json:"topic"`
+	Notes           string   `protobuf:"bytes,10,opt,name=notes,proto3" json:"notes"`
+	Homework        string   `protobuf:"bytes,11,opt,name=homework,proto3" json:"homework"`
+	Materials       []string `protobuf:"bytes,12,rep,name=materials,proto3" json:"materials"`
+	AttendanceCount int32    `protobuf:"varint,13,opt,name=attendanceCount,proto3" json:"attendanceCount"`
+	UpdatedAt       string   `protobuf:"bytes,14,opt,name=updatedAt,proto3" json:"updatedAt"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
-func (x *Student) Reset() {
-	*x = Student{}
-	mi := &file_education_proto_msgTypes[46]
+func (x *AbsLesson) Reset() {
+	*x = AbsLesson{}
+	mi := &file_education_proto_msgTypes[127]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Student) String() string {
+func (x *AbsLesson) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Student) ProtoMessage() {}
+func (*AbsLesson) ProtoMessage() {}
 
-func (x *Student) ProtoReflect() protoreflect.Message {
-	mi := &file_education_proto_msgTypes[46]
+func (x *AbsLesson) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[127]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3609,121 +9573,134 @@ func (x *Student) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Student.ProtoReflect.Descriptor instead.
-func (*Student) Descriptor() ([]byte, []int) {
-	return file_education_proto_rawDescGZIP(), []int{46}
+// Deprecated: Use AbsLesson.ProtoReflect.Descriptor instead.
+func (*AbsLesson) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{127}
 }
 
-func (x *Student) GetId() string {
+func (x *AbsLesson) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-func (x *Student) GetActivatedAt() string {
+func (x *AbsLesson) GetGroupId() int64 {
 	if x != nil {
-		return x.ActivatedAt
+		return x.GroupId
 	}
-	return ""
+	return 0
 }
 
-func (x *Student) GetAddedAt() string {
+func (x *AbsLesson) GetGroupName() string {
 	if x != nil {
-		return x.AddedAt
+		return x.GroupName
 	}
 	return ""
 }
 
-func (x *Student) GetAttendance() []*Attendance {
+func (x *AbsLesson) GetDate() string {
 	if x != nil {
-		return x.Attendance
+		return x.Date
 	}
-	return nil
+	return ""
 }
 
-func (x *Student) GetBalance() float64 {
+func (x *AbsLesson) GetRealDate() string {
 	if x != nil {
-		return x.Balance
+		return x.RealDate
 	}
-	return 0
+	return ""
 }
 
-func (x *Student) GetCreatedAt() string {
+func (x *AbsLesson) GetTeacherId() string {
 	if x != nil {
-		return x.CreatedAt
+		return x.TeacherId
 	}
 	return ""
 }
 
-func (x *Student) GetDateOfBirth() string {
+func (x *AbsLesson) GetTeacherName() string {
 	if x != nil {
-		return x.DateOfBirth
+		return x.TeacherName
 	}
 	return ""
 }
 
-func (x *Student) GetFreezeDetail() *FreezeDetail {
+func (x *AbsLesson) GetSubstitute() bool {
 	if x != nil {
-		return x.FreezeDetail
+		return x.Substitute
 	}
-	return nil
+	return false
 }
 
-func (x *Student) GetGender() bool {
+func (x *AbsLesson) GetTopic() string {
 	if x != nil {
-		return x.Gender
+		return x.Topic
 	}
-	return false
+	return ""
 }
 
-func (x *Student) GetCondition() string {
+func (x *AbsLesson) GetNotes() string {
 	if x != nil {
-		return x.Condition
+		return x.Notes
 	}
 	return ""
 }
 
-func (x *Student) GetName() string {
+func (x *AbsLesson) GetHomework() string {
 	if x != nil {
-		return x.Name
+		return x.Homework
 	}
 	return ""
 }
 
-func (x *Student) GetPhone() string {
+func (x *AbsLesson) GetMaterials() []string {
 	if x != nil {
-		return x.Phone
+		return x.Materials
+	}
+	return nil
+}
+
+func (x *AbsLesson) GetAttendanceCount() int32 {
+	if x != nil {
+		return x.AttendanceCount
+	}
+	return 0
+}
+
+func (x *AbsLesson) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
 	}
 	return ""
 }
 
-type Attendance struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id"`
-	AttendDate    string                 `protobuf:"bytes,2,opt,name=attend_date,json=attendDate,proto3" json:"attend_date"`
-	IsCome        bool                   `protobuf:"varint,3,opt,name=isCome,proto3" json:"isCome"`
-	StudentId     string                 `protobuf:"bytes,4,opt,name=studentId,proto3" json:"studentId"`
-	TeacherId     string                 `protobuf:"bytes,5,opt,name=teacherId,proto3" json:"teacherId"`
+type GetLessonsRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	GroupId string                 `protobuf:"bytes,1,opt,name=groupId,proto3" json:"groupId"`
+	// yyyy-MM-dd, at most 93 days apart
+	From          string `protobuf:"bytes,2,opt,name=from,proto3" json:"from"`
+	Till          string `protobuf:"bytes,3,opt,name=till,proto3" json:"till"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Attendance) Reset() {
-	*x = Attendance{}
-	mi := &file_education_proto_msgTypes[47]
+func (x *GetLessonsRequest) Reset() {
+	*x = GetLessonsRequest{}
+	mi := &file_education_proto_msgTypes[128]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Attendance) String() string {
+func (x *GetLessonsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Attendance) ProtoMessage() {}
+func (*GetLessonsRequest) ProtoMessage() {}
 
-func (x *Attendance) ProtoReflect() protoreflect.Message {
-	mi := &file_education_proto_msgTypes[47]
+func (x *GetLessonsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[128]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3731,72 +9708,57 @@ func (x *Attendance) ProtoReflect() protoreflect.Message {
 		}
 		return ms
 	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use Attendance.ProtoReflect.Descriptor instead.
-func (*Attendance) Descriptor() ([]byte, []int) {
-	return file_education_proto_rawDescGZIP(), []int{47}
-}
-
-func (x *Attendance) GetId() string {
-	if x != nil {
-		return x.Id
-	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *Attendance) GetAttendDate() string {
-	if x != nil {
-		return x.AttendDate
-	}
-	return ""
+// Deprecated: Use GetLessonsRequest.ProtoReflect.Descriptor instead.
+func (*GetLessonsRequest) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{128}
 }
 
-func (x *Attendance) GetIsCome() bool {
+func (x *GetLessonsRequest) GetGroupId() string {
 	if x != nil {
-		return x.IsCome
+		return x.GroupId
 	}
-	return false
+	return ""
 }
 
-func (x *Attendance) GetStudentId() string {
+func (x *GetLessonsRequest) GetFrom() string {
 	if x != nil {
-		return x.StudentId
+		return x.From
 	}
 	return ""
 }
 
-func (x *Attendance) GetTeacherId() string {
+func (x *GetLessonsRequest) GetTill() string {
 	if x != nil {
-		return x.TeacherId
+		return x.Till
 	}
 	return ""
 }
 
-type FreezeDetail struct {
+type GetLessonsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Reason        string                 `protobuf:"bytes,1,opt,name=reason,proto3" json:"reason"`
-	TillDate      string                 `protobuf:"bytes,2,opt,name=till_date,json=tillDate,proto3" json:"till_date"`
+	Lessons       []*AbsLesson           `protobuf:"bytes,1,rep,name=lessons,proto3" json:"lessons"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *FreezeDetail) Reset() {
-	*x = FreezeDetail{}
-	mi := &file_education_proto_msgTypes[48]
+func (x *GetLessonsResponse) Reset() {
+	*x = GetLessonsResponse{}
+	mi := &file_education_proto_msgTypes[129]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *FreezeDetail) String() string {
+func (x *GetLessonsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FreezeDetail) ProtoMessage() {}
+func (*GetLessonsResponse) ProtoMessage() {}
 
-func (x *FreezeDetail) ProtoReflect() protoreflect.Message {
-	mi := &file_education_proto_msgTypes[48]
+func (x *GetLessonsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[129]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3807,53 +9769,48 @@ func (x *FreezeDetail) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FreezeDetail.ProtoReflect.Descriptor instead.
-func (*FreezeDetail) Descriptor() ([]byte, []int) {
-	return file_education_proto_rawDescGZIP(), []int{48}
-}
-
-func (x *FreezeDetail) GetReason() string {
-	if x != nil {
-		return x.Reason
-	}
-	return ""
+// Deprecated: Use GetLessonsResponse.ProtoReflect.Descriptor instead.
+func (*GetLessonsResponse) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{129}
 }
 
-func (x *FreezeDetail) GetTillDate() string {
+func (x *GetLessonsResponse) GetLessons() []*AbsLesson {
 	if x != nil {
-		return x.TillDate
+		return x.Lessons
 	}
-	return ""
-}
-
-type SetAttendanceRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	AttendDate    string                 `protobuf:"bytes,1,opt,name=attendDate,proto3" json:"attendDate"`
-	GroupId       string                 `protobuf:"bytes,2,opt,name=groupId,proto3" json:"groupId"`
-	Status        int32                  `protobuf:"varint,3,opt,name=status,proto3" json:"status"`
-	StudentId     string                 `protobuf:"bytes,4,opt,name=studentId,proto3" json:"studentId"`
-	TeacherId     string                 `protobuf:"bytes,5,opt,name=teacherId,proto3" json:"teacherId"`
-	ActionById    string                 `protobuf:"bytes,6,opt,name=actionById,proto3" json:"actionById"`
-	ActionByRole  string                 `protobuf:"bytes,7,opt,name=actionByRole,proto3" json:"actionByRole"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	return nil
 }
 
-func (x *SetAttendanceRequest) Reset() {
-	*x = SetAttendanceRequest{}
-	mi := &file_education_proto_msgTypes[49]
+type UpdateLessonRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Id        string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id"`
+	Topic     string                 `protobuf:"bytes,2,opt,name=topic,proto3" json:"topic"`
+	Notes     string                 `protobuf:"bytes,3,opt,name=notes,proto3" json:"notes"`
+	Homework  string                 `protobuf:"bytes,4,opt,name=homework,proto3" json:"homework"`
+	Materials []string               `protobuf:"bytes,5,rep,name=materials,proto3" json:"materials"`
+	// empty gives the lesson back to the teacher of the group, teachers can not change it
+	SubstituteTeacherId string `protobuf:"bytes,6,opt,name=substituteTeacherId,proto3" json:"substituteTeacherId"`
+	ActionById          string `protobuf:"bytes,7,opt,name=actionById,proto3" json:"actionById"`
+	ActionByRole        string `protobuf:"bytes,8,opt,name=actionByRole,proto3" json:"actionByRole"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *UpdateLessonRequest) Reset() {
+	*x = UpdateLessonRequest{}
+	mi := &file_education_proto_msgTypes[130]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SetAttendanceRequest) String() string {
+func (x *UpdateLessonRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SetAttendanceRequest) ProtoMessage() {}
+func (*UpdateLessonRequest) ProtoMessage() {}
 
-func (x *SetAttendanceRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_education_proto_msgTypes[49]
+func (x *UpdateLessonRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[130]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3864,54 +9821,61 @@ func (x *SetAttendanceRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SetAttendanceRequest.ProtoReflect.Descriptor instead.
-func (*SetAttendanceRequest) Descriptor() ([]byte, []int) {
-	return file_education_proto_rawDescGZIP(), []int{49}
+// Deprecated: Use UpdateLessonRequest.ProtoReflect.Descriptor instead.
+func (*UpdateLessonRequest) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{130}
 }
 
-func (x *SetAttendanceRequest) GetAttendDate() string {
+func (x *UpdateLessonRequest) GetId() string {
 	if x != nil {
-		return x.AttendDate
+		return x.Id
 	}
 	return ""
 }
 
-func (x *SetAttendanceRequest) GetGroupId() string {
+func (x *UpdateLessonRequest) GetTopic() string {
 	if x != nil {
-		return x.GroupId
+		return x.Topic
 	}
 	return ""
 }
 
-func (x *SetAttendanceRequest) GetStatus() int32 {
+func (x *UpdateLessonRequest) GetNotes() string {
 	if x != nil {
-		return x.Status
+		return x.Notes
 	}
-	return 0
+	return ""
 }
 
-func (x *SetAttendanceRequest) GetStudentId() string {
+func (x *UpdateLessonRequest) GetHomework() string {
 	if x != nil {
-		return x.StudentId
+		return x.Homework
 	}
 	return ""
 }
 
-func (x *SetAttendanceRequest) GetTeacherId() string {
+func (x *UpdateLessonRequest) GetMaterials() []string {
 	if x != nil {
-		return x.TeacherId
+		return x.Materials
+	}
+	return nil
+}
+
+func (x *UpdateLessonRequest) GetSubstituteTeacherId() string {
+	if x != nil {
+		return x.SubstituteTeacherId
 	}
 	return ""
 }
 
-func (x *SetAttendanceRequest) GetActionById() string {
+func (x *UpdateLessonRequest) GetActionById() string {
 	if x != nil {
 		return x.ActionById
 	}
 	return ""
 }
 
-func (x *SetAttendanceRequest) GetActionByRole() string {
+func (x *UpdateLessonRequest) GetActionByRole() string {
 	if x != nil {
 		return x.ActionByRole
 	}
@@ -3934,7 +9898,7 @@ type ChangeUserBalanceHistoryRequest struct {
 
 func (x *ChangeUserBalanceHistoryRequest) Reset() {
 	*x = ChangeUserBalanceHistoryRequest{}
-	mi := &file_education_proto_msgTypes[50]
+	mi := &file_education_proto_msgTypes[131]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3946,7 +9910,7 @@ func (x *ChangeUserBalanceHistoryRequest) String() string {
 func (*ChangeUserBalanceHistoryRequest) ProtoMessage() {}
 
 func (x *ChangeUserBalanceHistoryRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_education_proto_msgTypes[50]
+	mi := &file_education_proto_msgTypes[131]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3959,7 +9923,7 @@ func (x *ChangeUserBalanceHistoryRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ChangeUserBalanceHistoryRequest.ProtoReflect.Descriptor instead.
 func (*ChangeUserBalanceHistoryRequest) Descriptor() ([]byte, []int) {
-	return file_education_proto_rawDescGZIP(), []int{50}
+	return file_education_proto_rawDescGZIP(), []int{131}
 }
 
 func (x *ChangeUserBalanceHistoryRequest) GetStudentId() string {
@@ -4030,7 +9994,7 @@ type DeleteStudentRequest struct {
 
 func (x *DeleteStudentRequest) Reset() {
 	*x = DeleteStudentRequest{}
-	mi := &file_education_proto_msgTypes[51]
+	mi := &file_education_proto_msgTypes[132]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4042,7 +10006,7 @@ func (x *DeleteStudentRequest) String() string {
 func (*DeleteStudentRequest) ProtoMessage() {}
 
 func (x *DeleteStudentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_education_proto_msgTypes[51]
+	mi := &file_education_proto_msgTypes[132]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4055,7 +10019,7 @@ func (x *DeleteStudentRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteStudentRequest.ProtoReflect.Descriptor instead.
 func (*DeleteStudentRequest) Descriptor() ([]byte, []int) {
-	return file_education_proto_rawDescGZIP(), []int{51}
+	return file_education_proto_rawDescGZIP(), []int{132}
 }
 
 func (x *DeleteStudentRequest) GetStudentId() string {
@@ -4095,7 +10059,7 @@ type GetStudentsByGroupIdResponse struct {
 
 func (x *GetStudentsByGroupIdResponse) Reset() {
 	*x = GetStudentsByGroupIdResponse{}
-	mi := &file_education_proto_msgTypes[52]
+	mi := &file_education_proto_msgTypes[133]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4107,7 +10071,7 @@ func (x *GetStudentsByGroupIdResponse) String() string {
 func (*GetStudentsByGroupIdResponse) ProtoMessage() {}
 
 func (x *GetStudentsByGroupIdResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_education_proto_msgTypes[52]
+	mi := &file_education_proto_msgTypes[133]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4120,7 +10084,7 @@ func (x *GetStudentsByGroupIdResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetStudentsByGroupIdResponse.ProtoReflect.Descriptor instead.
 func (*GetStudentsByGroupIdResponse) Descriptor() ([]byte, []int) {
-	return file_education_proto_rawDescGZIP(), []int{52}
+	return file_education_proto_rawDescGZIP(), []int{133}
 }
 
 func (x *GetStudentsByGroupIdResponse) GetStudents() []*AbsStudent {
@@ -4140,7 +10104,7 @@ type GetStudentsByGroupIdRequest struct {
 
 func (x *GetStudentsByGroupIdRequest) Reset() {
 	*x = GetStudentsByGroupIdRequest{}
-	mi := &file_education_proto_msgTypes[53]
+	mi := &file_education_proto_msgTypes[134]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4152,7 +10116,7 @@ func (x *GetStudentsByGroupIdRequest) String() string {
 func (*GetStudentsByGroupIdRequest) ProtoMessage() {}
 
 func (x *GetStudentsByGroupIdRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_education_proto_msgTypes[53]
+	mi := &file_education_proto_msgTypes[134]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4165,7 +10129,7 @@ func (x *GetStudentsByGroupIdRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetStudentsByGroupIdRequest.ProtoReflect.Descriptor instead.
 func (*GetStudentsByGroupIdRequest) Descriptor() ([]byte, []int) {
-	return file_education_proto_rawDescGZIP(), []int{53}
+	return file_education_proto_rawDescGZIP(), []int{134}
 }
 
 func (x *GetStudentsByGroupIdRequest) GetGroupId() string {
@@ -4198,7 +10162,7 @@ type ChangeConditionStudentRequest struct {
 
 func (x *ChangeConditionStudentRequest) Reset() {
 	*x = ChangeConditionStudentRequest{}
-	mi := &file_education_proto_msgTypes[54]
+	mi := &file_education_proto_msgTypes[135]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4210,7 +10174,7 @@ func (x *ChangeConditionStudentRequest) String() string {
 func (*ChangeConditionStudentRequest) ProtoMessage() {}
 
 func (x *ChangeConditionStudentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_education_proto_msgTypes[54]
+	mi := &file_education_proto_msgTypes[135]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4223,7 +10187,7 @@ func (x *ChangeConditionStudentRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ChangeConditionStudentRequest.ProtoReflect.Descriptor instead.
 func (*ChangeConditionStudentRequest) Descriptor() ([]byte, []int) {
-	return file_education_proto_rawDescGZIP(), []int{54}
+	return file_education_proto_rawDescGZIP(), []int{135}
 }
 
 func (x *ChangeConditionStudentRequest) GetStudentId() string {
@@ -4283,17 +10247,21 @@ func (x *ChangeConditionStudentRequest) GetComment() string {
 }
 
 type TransferLessonRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	From          string                 `protobuf:"bytes,1,opt,name=from,proto3" json:"from"`
-	To            string                 `protobuf:"bytes,2,opt,name=to,proto3" json:"to"`
-	GroupId       string                 `protobuf:"bytes,3,opt,name=groupId,proto3" json:"groupId"`
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	From    string                 `protobuf:"bytes,1,opt,name=from,proto3" json:"from"`
+	To      string                 `protobuf:"bytes,2,opt,name=to,proto3" json:"to"`
+	GroupId string                 `protobuf:"bytes,3,opt,name=groupId,proto3" json:"groupId"`
+	// moves the lesson although the room or teacher is already booked on the new date, the override is audited
+	Force         bool   `protobuf:"varint,4,opt,name=force,proto3" json:"force"`
+	ActionById    string `protobuf:"bytes,5,opt,name=actionById,proto3" json:"actionById"`
+	ActionByName  string `protobuf:"bytes,6,opt,name=actionByName,proto3" json:"actionByName"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *TransferLessonRequest) Reset() {
 	*x = TransferLessonRequest{}
-	mi := &file_education_proto_msgTypes[55]
+	mi := &file_education_proto_msgTypes[136]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4305,7 +10273,7 @@ func (x *TransferLessonRequest) String() string {
 func (*TransferLessonRequest) ProtoMessage() {}
 
 func (x *TransferLessonRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_education_proto_msgTypes[55]
+	mi := &file_education_proto_msgTypes[136]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4318,7 +10286,7 @@ func (x *TransferLessonRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TransferLessonRequest.ProtoReflect.Descriptor instead.
 func (*TransferLessonRequest) Descriptor() ([]byte, []int) {
-	return file_education_proto_rawDescGZIP(), []int{55}
+	return file_education_proto_rawDescGZIP(), []int{136}
 }
 
 func (x *TransferLessonRequest) GetFrom() string {
@@ -4342,6 +10310,27 @@ func (x *TransferLessonRequest) GetGroupId() string {
 	return ""
 }
 
+func (x *TransferLessonRequest) GetForce() bool {
+	if x != nil {
+		return x.Force
+	}
+	return false
+}
+
+func (x *TransferLessonRequest) GetActionById() string {
+	if x != nil {
+		return x.ActionById
+	}
+	return ""
+}
+
+func (x *TransferLessonRequest) GetActionByName() string {
+	if x != nil {
+		return x.ActionByName
+	}
+	return ""
+}
+
 type GetHistoryGroupResponse struct {
 	state           protoimpl.MessageState `protogen:"open.v1"`
 	GroupHistory    []*AbsHistory          `protobuf:"bytes,1,rep,name=groupHistory,proto3" json:"groupHistory"`
@@ -4352,7 +10341,7 @@ type GetHistoryGroupResponse struct {
 
 func (x *GetHistoryGroupResponse) Reset() {
 	*x = GetHistoryGroupResponse{}
-	mi := &file_education_proto_msgTypes[56]
+	mi := &file_education_proto_msgTypes[137]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4364,7 +10353,7 @@ func (x *GetHistoryGroupResponse) String() string {
 func (*GetHistoryGroupResponse) ProtoMessage() {}
 
 func (x *GetHistoryGroupResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_education_proto_msgTypes[56]
+	mi := &file_education_proto_msgTypes[137]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4377,7 +10366,7 @@ func (x *GetHistoryGroupResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetHistoryGroupResponse.ProtoReflect.Descriptor instead.
 func (*GetHistoryGroupResponse) Descriptor() ([]byte, []int) {
-	return file_education_proto_rawDescGZIP(), []int{56}
+	return file_education_proto_rawDescGZIP(), []int{137}
 }
 
 func (x *GetHistoryGroupResponse) GetGroupHistory() []*AbsHistory {
@@ -4404,7 +10393,7 @@ type GetHistoryStudentResponse struct {
 
 func (x *GetHistoryStudentResponse) Reset() {
 	*x = GetHistoryStudentResponse{}
-	mi := &file_education_proto_msgTypes[57]
+	mi := &file_education_proto_msgTypes[138]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4416,7 +10405,7 @@ func (x *GetHistoryStudentResponse) String() string {
 func (*GetHistoryStudentResponse) ProtoMessage() {}
 
 func (x *GetHistoryStudentResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_education_proto_msgTypes[57]
+	mi := &file_education_proto_msgTypes[138]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4429,7 +10418,7 @@ func (x *GetHistoryStudentResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetHistoryStudentResponse.ProtoReflect.Descriptor instead.
 func (*GetHistoryStudentResponse) Descriptor() ([]byte, []int) {
-	return file_education_proto_rawDescGZIP(), []int{57}
+	return file_education_proto_rawDescGZIP(), []int{138}
 }
 
 func (x *GetHistoryStudentResponse) GetStudentHistory() []*AbsHistory {
@@ -4461,7 +10450,7 @@ type AbsStudentHistory struct {
 
 func (x *AbsStudentHistory) Reset() {
 	*x = AbsStudentHistory{}
-	mi := &file_education_proto_msgTypes[58]
+	mi := &file_education_proto_msgTypes[139]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4473,7 +10462,7 @@ func (x *AbsStudentHistory) String() string {
 func (*AbsStudentHistory) ProtoMessage() {}
 
 func (x *AbsStudentHistory) ProtoReflect() protoreflect.Message {
-	mi := &file_education_proto_msgTypes[58]
+	mi := &file_education_proto_msgTypes[139]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4486,7 +10475,7 @@ func (x *AbsStudentHistory) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AbsStudentHistory.ProtoReflect.Descriptor instead.
 func (*AbsStudentHistory) Descriptor() ([]byte, []int) {
-	return file_education_proto_rawDescGZIP(), []int{58}
+	return file_education_proto_rawDescGZIP(), []int{139}
 }
 
 func (x *AbsStudentHistory) GetStudent() *AbsStudent {
@@ -4556,7 +10545,7 @@ type AbsGroup struct {
 
 func (x *AbsGroup) Reset() {
 	*x = AbsGroup{}
-	mi := &file_education_proto_msgTypes[59]
+	mi := &file_education_proto_msgTypes[140]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4568,7 +10557,7 @@ func (x *AbsGroup) String() string {
 func (*AbsGroup) ProtoMessage() {}
 
 func (x *AbsGroup) ProtoReflect() protoreflect.Message {
-	mi := &file_education_proto_msgTypes[59]
+	mi := &file_education_proto_msgTypes[140]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4581,7 +10570,7 @@ func (x *AbsGroup) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AbsGroup.ProtoReflect.Descriptor instead.
 func (*AbsGroup) Descriptor() ([]byte, []int) {
-	return file_education_proto_rawDescGZIP(), []int{59}
+	return file_education_proto_rawDescGZIP(), []int{140}
 }
 
 func (x *AbsGroup) GetId() string {
@@ -4666,7 +10655,7 @@ type AbsHistory struct {
 
 func (x *AbsHistory) Reset() {
 	*x = AbsHistory{}
-	mi := &file_education_proto_msgTypes[60]
+	mi := &file_education_proto_msgTypes[141]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4678,7 +10667,7 @@ func (x *AbsHistory) String() string {
 func (*AbsHistory) ProtoMessage() {}
 
 func (x *AbsHistory) ProtoReflect() protoreflect.Message {
-	mi := &file_education_proto_msgTypes[60]
+	mi := &file_education_proto_msgTypes[141]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4691,7 +10680,7 @@ func (x *AbsHistory) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AbsHistory.ProtoReflect.Descriptor instead.
 func (*AbsHistory) Descriptor() ([]byte, []int) {
-	return file_education_proto_rawDescGZIP(), []int{60}
+	return file_education_proto_rawDescGZIP(), []int{141}
 }
 
 func (x *AbsHistory) GetId() string {
@@ -4731,7 +10720,7 @@ type SearchStudentRequest struct {
 
 func (x *SearchStudentRequest) Reset() {
 	*x = SearchStudentRequest{}
-	mi := &file_education_proto_msgTypes[61]
+	mi := &file_education_proto_msgTypes[142]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4743,7 +10732,7 @@ func (x *SearchStudentRequest) String() string {
 func (*SearchStudentRequest) ProtoMessage() {}
 
 func (x *SearchStudentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_education_proto_msgTypes[61]
+	mi := &file_education_proto_msgTypes[142]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4756,7 +10745,7 @@ func (x *SearchStudentRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SearchStudentRequest.ProtoReflect.Descriptor instead.
 func (*SearchStudentRequest) Descriptor() ([]byte, []int) {
-	return file_education_proto_rawDescGZIP(), []int{61}
+	return file_education_proto_rawDescGZIP(), []int{142}
 }
 
 func (x *SearchStudentRequest) GetValue() string {
@@ -4775,7 +10764,7 @@ type SearchStudentResponse struct {
 
 func (x *SearchStudentResponse) Reset() {
 	*x = SearchStudentResponse{}
-	mi := &file_education_proto_msgTypes[62]
+	mi := &file_education_proto_msgTypes[143]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4787,7 +10776,7 @@ func (x *SearchStudentResponse) String() string {
 func (*SearchStudentResponse) ProtoMessage() {}
 
 func (x *SearchStudentResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_education_proto_msgTypes[62]
+	mi := &file_education_proto_msgTypes[143]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4800,7 +10789,7 @@ func (x *SearchStudentResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SearchStudentResponse.ProtoReflect.Descriptor instead.
 func (*SearchStudentResponse) Descriptor() ([]byte, []int) {
-	return file_education_proto_rawDescGZIP(), []int{62}
+	return file_education_proto_rawDescGZIP(), []int{143}
 }
 
 func (x *SearchStudentResponse) GetStudents() []*AbsStudent {
@@ -4821,7 +10810,7 @@ type AbsStudent struct {
 
 func (x *AbsStudent) Reset() {
 	*x = AbsStudent{}
-	mi := &file_education_proto_msgTypes[63]
+	mi := &file_education_proto_msgTypes[144]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4833,7 +10822,7 @@ func (x *AbsStudent) String() string {
 func (*AbsStudent) ProtoMessage() {}
 
 func (x *AbsStudent) ProtoReflect() protoreflect.Message {
-	mi := &file_education_proto_msgTypes[63]
+	mi := &file_education_proto_msgTypes[144]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4846,7 +10835,7 @@ func (x *AbsStudent) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AbsStudent.ProtoReflect.Descriptor instead.
 func (*AbsStudent) Descriptor() ([]byte, []int) {
-	return file_education_proto_rawDescGZIP(), []int{63}
+	return file_education_proto_rawDescGZIP(), []int{144}
 }
 
 func (x *AbsStudent) GetId() string {
@@ -4885,7 +10874,7 @@ type GetAllStudentRequest struct {
 
 func (x *GetAllStudentRequest) Reset() {
 	*x = GetAllStudentRequest{}
-	mi := &file_education_proto_msgTypes[64]
+	mi := &file_education_proto_msgTypes[145]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4897,7 +10886,7 @@ func (x *GetAllStudentRequest) String() string {
 func (*GetAllStudentRequest) ProtoMessage() {}
 
 func (x *GetAllStudentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_education_proto_msgTypes[64]
+	mi := &file_education_proto_msgTypes[145]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4910,7 +10899,7 @@ func (x *GetAllStudentRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetAllStudentRequest.ProtoReflect.Descriptor instead.
 func (*GetAllStudentRequest) Descriptor() ([]byte, []int) {
-	return file_education_proto_rawDescGZIP(), []int{64}
+	return file_education_proto_rawDescGZIP(), []int{145}
 }
 
 func (x *GetAllStudentRequest) GetCondition() string {
@@ -4972,7 +10961,7 @@ type GetAllStudentResponse struct {
 
 func (x *GetAllStudentResponse) Reset() {
 	*x = GetAllStudentResponse{}
-	mi := &file_education_proto_msgTypes[65]
+	mi := &file_education_proto_msgTypes[146]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4984,7 +10973,7 @@ func (x *GetAllStudentResponse) String() string {
 func (*GetAllStudentResponse) ProtoMessage() {}
 
 func (x *GetAllStudentResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_education_proto_msgTypes[65]
+	mi := &file_education_proto_msgTypes[146]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4997,7 +10986,7 @@ func (x *GetAllStudentResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetAllStudentResponse.ProtoReflect.Descriptor instead.
 func (*GetAllStudentResponse) Descriptor() ([]byte, []int) {
-	return file_education_proto_rawDescGZIP(), []int{65}
+	return file_education_proto_rawDescGZIP(), []int{146}
 }
 
 func (x *GetAllStudentResponse) GetResponse() []*GetGroupsAbsForStudent {
@@ -5035,7 +11024,7 @@ type GetGroupsAbsForStudent struct {
 
 func (x *GetGroupsAbsForStudent) Reset() {
 	*x = GetGroupsAbsForStudent{}
-	mi := &file_education_proto_msgTypes[66]
+	mi := &file_education_proto_msgTypes[147]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5047,7 +11036,7 @@ func (x *GetGroupsAbsForStudent) String() string {
 func (*GetGroupsAbsForStudent) ProtoMessage() {}
 
 func (x *GetGroupsAbsForStudent) ProtoReflect() protoreflect.Message {
-	mi := &file_education_proto_msgTypes[66]
+	mi := &file_education_proto_msgTypes[147]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5060,7 +11049,7 @@ func (x *GetGroupsAbsForStudent) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetGroupsAbsForStudent.ProtoReflect.Descriptor instead.
 func (*GetGroupsAbsForStudent) Descriptor() ([]byte, []int) {
-	return file_education_proto_rawDescGZIP(), []int{66}
+	return file_education_proto_rawDescGZIP(), []int{147}
 }
 
 func (x *GetGroupsAbsForStudent) GetId() string {
@@ -5174,7 +11163,7 @@ type GroupGetAllStudentAbs struct {
 
 func (x *GroupGetAllStudentAbs) Reset() {
 	*x = GroupGetAllStudentAbs{}
-	mi := &file_education_proto_msgTypes[67]
+	mi := &file_education_proto_msgTypes[148]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5186,7 +11175,7 @@ func (x *GroupGetAllStudentAbs) String() string {
 func (*GroupGetAllStudentAbs) ProtoMessage() {}
 
 func (x *GroupGetAllStudentAbs) ProtoReflect() protoreflect.Message {
-	mi := &file_education_proto_msgTypes[67]
+	mi := &file_education_proto_msgTypes[148]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5199,7 +11188,7 @@ func (x *GroupGetAllStudentAbs) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GroupGetAllStudentAbs.ProtoReflect.Descriptor instead.
 func (*GroupGetAllStudentAbs) Descriptor() ([]byte, []int) {
-	return file_education_proto_rawDescGZIP(), []int{67}
+	return file_education_proto_rawDescGZIP(), []int{148}
 }
 
 func (x *GroupGetAllStudentAbs) GetId() string {
@@ -5305,7 +11294,7 @@ type CreateStudentRequest struct {
 
 func (x *CreateStudentRequest) Reset() {
 	*x = CreateStudentRequest{}
-	mi := &file_education_proto_msgTypes[68]
+	mi := &file_education_proto_msgTypes[149]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5317,7 +11306,7 @@ func (x *CreateStudentRequest) String() string {
 func (*CreateStudentRequest) ProtoMessage() {}
 
 func (x *CreateStudentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_education_proto_msgTypes[68]
+	mi := &file_education_proto_msgTypes[149]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5330,7 +11319,7 @@ func (x *CreateStudentRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateStudentRequest.ProtoReflect.Descriptor instead.
 func (*CreateStudentRequest) Descriptor() ([]byte, []int) {
-	return file_education_proto_rawDescGZIP(), []int{68}
+	return file_education_proto_rawDescGZIP(), []int{149}
 }
 
 func (x *CreateStudentRequest) GetPhoneNumber() string {
@@ -5426,7 +11415,7 @@ type UpdateStudentRequest struct {
 
 func (x *UpdateStudentRequest) Reset() {
 	*x = UpdateStudentRequest{}
-	mi := &file_education_proto_msgTypes[69]
+	mi := &file_education_proto_msgTypes[150]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5438,7 +11427,7 @@ func (x *UpdateStudentRequest) String() string {
 func (*UpdateStudentRequest) ProtoMessage() {}
 
 func (x *UpdateStudentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_education_proto_msgTypes[69]
+	mi := &file_education_proto_msgTypes[150]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5451,7 +11440,7 @@ func (x *UpdateStudentRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateStudentRequest.ProtoReflect.Descriptor instead.
 func (*UpdateStudentRequest) Descriptor() ([]byte, []int) {
-	return file_education_proto_rawDescGZIP(), []int{69}
+	return file_education_proto_rawDescGZIP(), []int{150}
 }
 
 func (x *UpdateStudentRequest) GetStudentId() string {
@@ -5516,13 +11505,15 @@ type AddToGroupRequest struct {
 	GroupId       string                 `protobuf:"bytes,2,opt,name=groupId,proto3" json:"groupId"`
 	StudentIds    []string               `protobuf:"bytes,3,rep,name=student_ids,json=studentIds,proto3" json:"student_ids"`
 	CreatedBy     string                 `protobuf:"bytes,4,opt,name=createdBy,proto3" json:"createdBy"`
+	PromoCode     string                 `protobuf:"bytes,5,opt,name=promoCode,proto3" json:"promoCode"`
+	CreatedByName string                 `protobuf:"bytes,6,opt,name=createdByName,proto3" json:"createdByName"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *AddToGroupRequest) Reset() {
 	*x = AddToGroupRequest{}
-	mi := &file_education_proto_msgTypes[70]
+	mi := &file_education_proto_msgTypes[151]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5534,7 +11525,7 @@ func (x *AddToGroupRequest) String() string {
 func (*AddToGroupRequest) ProtoMessage() {}
 
 func (x *AddToGroupRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_education_proto_msgTypes[70]
+	mi := &file_education_proto_msgTypes[151]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5547,7 +11538,7 @@ func (x *AddToGroupRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AddToGroupRequest.ProtoReflect.Descriptor instead.
 func (*AddToGroupRequest) Descriptor() ([]byte, []int) {
-	return file_education_proto_rawDescGZIP(), []int{70}
+	return file_education_proto_rawDescGZIP(), []int{151}
 }
 
 func (x *AddToGroupRequest) GetCreatedDate() string {
@@ -5561,21 +11552,150 @@ func (x *AddToGroupRequest) GetGroupId() string {
 	if x != nil {
 		return x.GroupId
 	}
-	return ""
+	return ""
+}
+
+func (x *AddToGroupRequest) GetStudentIds() []string {
+	if x != nil {
+		return x.StudentIds
+	}
+	return nil
+}
+
+func (x *AddToGroupRequest) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+func (x *AddToGroupRequest) GetPromoCode() string {
+	if x != nil {
+		return x.PromoCode
+	}
+	return ""
+}
+
+func (x *AddToGroupRequest) GetCreatedByName() string {
+	if x != nil {
+		return x.CreatedByName
+	}
+	return ""
+}
+
+type AddToGroupResult struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	StudentId string                 `protobuf:"bytes,1,opt,name=studentId,proto3" json:"studentId"`
+	// ADDED, WAITLISTED when the group is full or FAILED
+	Status string `protobuf:"bytes,2,opt,name=status,proto3" json:"status"`
+	// the reason of a failure, or the discounts that could not be applied
+	Message string `protobuf:"bytes,3,opt,name=message,proto3" json:"message"`
+	// place in the waitlist of a WAITLISTED student
+	Position      int32 `protobuf:"varint,4,opt,name=position,proto3" json:"position"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddToGroupResult) Reset() {
+	*x = AddToGroupResult{}
+	mi := &file_education_proto_msgTypes[152]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddToGroupResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddToGroupResult) ProtoMessage() {}
+
+func (x *AddToGroupResult) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[152]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddToGroupResult.ProtoReflect.Descriptor instead.
+func (*AddToGroupResult) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{152}
+}
+
+func (x *AddToGroupResult) GetStudentId() string {
+	if x != nil {
+		return x.StudentId
+	}
+	return ""
+}
+
+func (x *AddToGroupResult) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *AddToGroupResult) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *AddToGroupResult) GetPosition() int32 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
+type AddToGroupResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Results       []*AddToGroupResult    `protobuf:"bytes,1,rep,name=results,proto3" json:"results"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddToGroupResponse) Reset() {
+	*x = AddToGroupResponse{}
+	mi := &file_education_proto_msgTypes[153]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddToGroupResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddToGroupResponse) ProtoMessage() {}
+
+func (x *AddToGroupResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[153]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-func (x *AddToGroupRequest) GetStudentIds() []string {
-	if x != nil {
-		return x.StudentIds
-	}
-	return nil
+// Deprecated: Use AddToGroupResponse.ProtoReflect.Descriptor instead.
+func (*AddToGroupResponse) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{153}
 }
 
-func (x *AddToGroupRequest) GetCreatedBy() string {
+func (x *AddToGroupResponse) GetResults() []*AddToGroupResult {
 	if x != nil {
-		return x.CreatedBy
+		return x.Results
 	}
-	return ""
+	return nil
 }
 
 type GetStudentByIdResponse struct {
@@ -5596,7 +11716,7 @@ type GetStudentByIdResponse struct {
 
 func (x *GetStudentByIdResponse) Reset() {
 	*x = GetStudentByIdResponse{}
-	mi := &file_education_proto_msgTypes[71]
+	mi := &file_education_proto_msgTypes[154]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5608,7 +11728,7 @@ func (x *GetStudentByIdResponse) String() string {
 func (*GetStudentByIdResponse) ProtoMessage() {}
 
 func (x *GetStudentByIdResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_education_proto_msgTypes[71]
+	mi := &file_education_proto_msgTypes[154]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5621,7 +11741,7 @@ func (x *GetStudentByIdResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetStudentByIdResponse.ProtoReflect.Descriptor instead.
 func (*GetStudentByIdResponse) Descriptor() ([]byte, []int) {
-	return file_education_proto_rawDescGZIP(), []int{71}
+	return file_education_proto_rawDescGZIP(), []int{154}
 }
 
 func (x *GetStudentByIdResponse) GetId() string {
@@ -5703,7 +11823,7 @@ type NoteStudentByAbsRequest struct {
 
 func (x *NoteStudentByAbsRequest) Reset() {
 	*x = NoteStudentByAbsRequest{}
-	mi := &file_education_proto_msgTypes[72]
+	mi := &file_education_proto_msgTypes[155]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5715,7 +11835,7 @@ func (x *NoteStudentByAbsRequest) String() string {
 func (*NoteStudentByAbsRequest) ProtoMessage() {}
 
 func (x *NoteStudentByAbsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_education_proto_msgTypes[72]
+	mi := &file_education_proto_msgTypes[155]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5728,7 +11848,7 @@ func (x *NoteStudentByAbsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use NoteStudentByAbsRequest.ProtoReflect.Descriptor instead.
 func (*NoteStudentByAbsRequest) Descriptor() ([]byte, []int) {
-	return file_education_proto_rawDescGZIP(), []int{72}
+	return file_education_proto_rawDescGZIP(), []int{155}
 }
 
 func (x *NoteStudentByAbsRequest) GetId() string {
@@ -5760,7 +11880,7 @@ type GetGroupStudent struct {
 
 func (x *GetGroupStudent) Reset() {
 	*x = GetGroupStudent{}
-	mi := &file_education_proto_msgTypes[73]
+	mi := &file_education_proto_msgTypes[156]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5772,7 +11892,7 @@ func (x *GetGroupStudent) String() string {
 func (*GetGroupStudent) ProtoMessage() {}
 
 func (x *GetGroupStudent) ProtoReflect() protoreflect.Message {
-	mi := &file_education_proto_msgTypes[73]
+	mi := &file_education_proto_msgTypes[156]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5785,7 +11905,7 @@ func (x *GetGroupStudent) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetGroupStudent.ProtoReflect.Descriptor instead.
 func (*GetGroupStudent) Descriptor() ([]byte, []int) {
-	return file_education_proto_rawDescGZIP(), []int{73}
+	return file_education_proto_rawDescGZIP(), []int{156}
 }
 
 func (x *GetGroupStudent) GetId() string {
@@ -5853,61 +11973,363 @@ func (x *GetGroupStudent) GetGroupEndDate() string {
 
 func (x *GetGroupStudent) GetStudentCondition() string {
 	if x != nil {
-		return x.StudentCondition
+		return x.StudentCondition
+	}
+	return ""
+}
+
+func (x *GetGroupStudent) GetStudentActivatedAt() string {
+	if x != nil {
+		return x.StudentActivatedAt
+	}
+	return ""
+}
+
+func (x *GetGroupStudent) GetStudentAddedAt() string {
+	if x != nil {
+		return x.StudentAddedAt
+	}
+	return ""
+}
+
+func (x *GetGroupStudent) GetTeacherName() string {
+	if x != nil {
+		return x.TeacherName
+	}
+	return ""
+}
+
+func (x *GetGroupStudent) GetPriceForStudent() float64 {
+	if x != nil {
+		return x.PriceForStudent
+	}
+	return 0
+}
+
+type GetNotesByStudent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Notes         []*AbsNote             `protobuf:"bytes,1,rep,name=notes,proto3" json:"notes"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetNotesByStudent) Reset() {
+	*x = GetNotesByStudent{}
+	mi := &file_education_proto_msgTypes[157]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetNotesByStudent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetNotesByStudent) ProtoMessage() {}
+
+func (x *GetNotesByStudent) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[157]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetNotesByStudent.ProtoReflect.Descriptor instead.
+func (*GetNotesByStudent) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{157}
+}
+
+func (x *GetNotesByStudent) GetNotes() []*AbsNote {
+	if x != nil {
+		return x.Notes
+	}
+	return nil
+}
+
+type AbsNote struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id"`
+	Comment       string                 `protobuf:"bytes,2,opt,name=comment,proto3" json:"comment"`
+	CreatedAt     string                 `protobuf:"bytes,3,opt,name=createdAt,proto3" json:"createdAt"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AbsNote) Reset() {
+	*x = AbsNote{}
+	mi := &file_education_proto_msgTypes[158]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AbsNote) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AbsNote) ProtoMessage() {}
+
+func (x *AbsNote) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[158]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AbsNote.ProtoReflect.Descriptor instead.
+func (*AbsNote) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{158}
+}
+
+func (x *AbsNote) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *AbsNote) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+func (x *AbsNote) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+type CreateNoteRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Note          string                 `protobuf:"bytes,1,opt,name=note,proto3" json:"note"`
+	StudentId     string                 `protobuf:"bytes,2,opt,name=studentId,proto3" json:"studentId"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateNoteRequest) Reset() {
+	*x = CreateNoteRequest{}
+	mi := &file_education_proto_msgTypes[159]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateNoteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateNoteRequest) ProtoMessage() {}
+
+func (x *CreateNoteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[159]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateNoteRequest.ProtoReflect.Descriptor instead.
+func (*CreateNoteRequest) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{159}
+}
+
+func (x *CreateNoteRequest) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+func (x *CreateNoteRequest) GetStudentId() string {
+	if x != nil {
+		return x.StudentId
+	}
+	return ""
+}
+
+type SellLessonPackageRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	StudentId string                 `protobuf:"bytes,1,opt,name=studentId,proto3" json:"studentId"`
+	GroupId   string                 `protobuf:"bytes,2,opt,name=groupId,proto3" json:"groupId"`
+	// lessons and price default to the course package when zero
+	Lessons       int32   `protobuf:"varint,3,opt,name=lessons,proto3" json:"lessons"`
+	Price         float64 `protobuf:"fixed64,4,opt,name=price,proto3" json:"price"`
+	Date          string  `protobuf:"bytes,5,opt,name=date,proto3" json:"date"`
+	ActionById    string  `protobuf:"bytes,6,opt,name=actionById,proto3" json:"actionById"`
+	ActionByName  string  `protobuf:"bytes,7,opt,name=actionByName,proto3" json:"actionByName"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SellLessonPackageRequest) Reset() {
+	*x = SellLessonPackageRequest{}
+	mi := &file_education_proto_msgTypes[160]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SellLessonPackageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SellLessonPackageRequest) ProtoMessage() {}
+
+func (x *SellLessonPackageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[160]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SellLessonPackageRequest.ProtoReflect.Descriptor instead.
+func (*SellLessonPackageRequest) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{160}
+}
+
+func (x *SellLessonPackageRequest) GetStudentId() string {
+	if x != nil {
+		return x.StudentId
+	}
+	return ""
+}
+
+func (x *SellLessonPackageRequest) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+func (x *SellLessonPackageRequest) GetLessons() int32 {
+	if x != nil {
+		return x.Lessons
+	}
+	return 0
+}
+
+func (x *SellLessonPackageRequest) GetPrice() float64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *SellLessonPackageRequest) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *SellLessonPackageRequest) GetActionById() string {
+	if x != nil {
+		return x.ActionById
 	}
 	return ""
 }
 
-func (x *GetGroupStudent) GetStudentActivatedAt() string {
+func (x *SellLessonPackageRequest) GetActionByName() string {
 	if x != nil {
-		return x.StudentActivatedAt
+		return x.ActionByName
 	}
 	return ""
 }
 
-func (x *GetGroupStudent) GetStudentAddedAt() string {
+type GetLessonPackagesRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	StudentId string                 `protobuf:"bytes,1,opt,name=studentId,proto3" json:"studentId"`
+	// optional
+	GroupId       string `protobuf:"bytes,2,opt,name=groupId,proto3" json:"groupId"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetLessonPackagesRequest) Reset() {
+	*x = GetLessonPackagesRequest{}
+	mi := &file_education_proto_msgTypes[161]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetLessonPackagesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLessonPackagesRequest) ProtoMessage() {}
+
+func (x *GetLessonPackagesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[161]
 	if x != nil {
-		return x.StudentAddedAt
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *GetGroupStudent) GetTeacherName() string {
+// Deprecated: Use GetLessonPackagesRequest.ProtoReflect.Descriptor instead.
+func (*GetLessonPackagesRequest) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{161}
+}
+
+func (x *GetLessonPackagesRequest) GetStudentId() string {
 	if x != nil {
-		return x.TeacherName
+		return x.StudentId
 	}
 	return ""
 }
 
-func (x *GetGroupStudent) GetPriceForStudent() float64 {
+func (x *GetLessonPackagesRequest) GetGroupId() string {
 	if x != nil {
-		return x.PriceForStudent
+		return x.GroupId
 	}
-	return 0
+	return ""
 }
 
-type GetNotesByStudent struct {
+type GetLessonPackagesResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Notes         []*AbsNote             `protobuf:"bytes,1,rep,name=notes,proto3" json:"notes"`
+	Packages      []*AbsLessonPackage    `protobuf:"bytes,1,rep,name=packages,proto3" json:"packages"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetNotesByStudent) Reset() {
-	*x = GetNotesByStudent{}
-	mi := &file_education_proto_msgTypes[74]
+func (x *GetLessonPackagesResponse) Reset() {
+	*x = GetLessonPackagesResponse{}
+	mi := &file_education_proto_msgTypes[162]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetNotesByStudent) String() string {
+func (x *GetLessonPackagesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetNotesByStudent) ProtoMessage() {}
+func (*GetLessonPackagesResponse) ProtoMessage() {}
 
-func (x *GetNotesByStudent) ProtoReflect() protoreflect.Message {
-	mi := &file_education_proto_msgTypes[74]
+func (x *GetLessonPackagesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[162]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5918,42 +12340,51 @@ func (x *GetNotesByStudent) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetNotesByStudent.ProtoReflect.Descriptor instead.
-func (*GetNotesByStudent) Descriptor() ([]byte, []int) {
-	return file_education_proto_rawDescGZIP(), []int{74}
+// Deprecated: Use GetLessonPackagesResponse.ProtoReflect.Descriptor instead.
+func (*GetLessonPackagesResponse) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{162}
 }
 
-func (x *GetNotesByStudent) GetNotes() []*AbsNote {
+func (x *GetLessonPackagesResponse) GetPackages() []*AbsLessonPackage {
 	if x != nil {
-		return x.Notes
+		return x.Packages
 	}
 	return nil
 }
 
-type AbsNote struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id"`
-	Comment       string                 `protobuf:"bytes,2,opt,name=comment,proto3" json:"comment"`
-	CreatedAt     string                 `protobuf:"bytes,3,opt,name=createdAt,proto3" json:"createdAt"`
+type AbsLessonPackage struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	Id           string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id"`
+	StudentId    string                 `protobuf:"bytes,2,opt,name=studentId,proto3" json:"studentId"`
+	GroupId      string                 `protobuf:"bytes,3,opt,name=groupId,proto3" json:"groupId"`
+	GroupName    string                 `protobuf:"bytes,4,opt,name=groupName,proto3" json:"groupName"`
+	LessonsTotal int32                  `protobuf:"varint,5,opt,name=lessonsTotal,proto3" json:"lessonsTotal"`
+	LessonsLeft  int32                  `protobuf:"varint,6,opt,name=lessonsLeft,proto3" json:"lessonsLeft"`
+	Price        float64                `protobuf:"fixed64,7,opt,name=price,proto3" json:"price"`
+	PurchasedAt  string                 `protobuf:"bytes,8,opt,name=purchasedAt,proto3" json:"purchasedAt"`
+	ExpiresAt    string                 `protobuf:"bytes,9,opt,name=expiresAt,proto3" json:"expiresAt"`
+	// ACTIVE, USED or EXPIRED
+	Status        string `protobuf:"bytes,10,opt,name=status,proto3" json:"status"`
+	CreatedByName string `protobuf:"bytes,11,opt,name=createdByName,proto3" json:"createdByName"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AbsNote) Reset() {
-	*x = AbsNote{}
-	mi := &file_education_proto_msgTypes[75]
+func (x *AbsLessonPackage) Reset() {
+	*x = AbsLessonPackage{}
+	mi := &file_education_proto_msgTypes[163]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AbsNote) String() string {
+func (x *AbsLessonPackage) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AbsNote) ProtoMessage() {}
+func (*AbsLessonPackage) ProtoMessage() {}
 
-func (x *AbsNote) ProtoReflect() protoreflect.Message {
-	mi := &file_education_proto_msgTypes[75]
+func (x *AbsLessonPackage) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[163]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5964,80 +12395,84 @@ func (x *AbsNote) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AbsNote.ProtoReflect.Descriptor instead.
-func (*AbsNote) Descriptor() ([]byte, []int) {
-	return file_education_proto_rawDescGZIP(), []int{75}
+// Deprecated: Use AbsLessonPackage.ProtoReflect.Descriptor instead.
+func (*AbsLessonPackage) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{163}
 }
 
-func (x *AbsNote) GetId() string {
+func (x *AbsLessonPackage) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-func (x *AbsNote) GetComment() string {
+func (x *AbsLessonPackage) GetStudentId() string {
 	if x != nil {
-		return x.Comment
+		return x.StudentId
 	}
 	return ""
 }
 
-func (x *AbsNote) GetCreatedAt() string {
+func (x *AbsLessonPackage) GetGroupId() string {
 	if x != nil {
-		return x.CreatedAt
+		return x.GroupId
 	}
 	return ""
 }
 
-type CreateNoteRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Note          string                 `protobuf:"bytes,1,opt,name=note,proto3" json:"note"`
-	StudentId     string                 `protobuf:"bytes,2,opt,name=studentId,proto3" json:"studentId"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *AbsLessonPackage) GetGroupName() string {
+	if x != nil {
+		return x.GroupName
+	}
+	return ""
 }
 
-func (x *CreateNoteRequest) Reset() {
-	*x = CreateNoteRequest{}
-	mi := &file_education_proto_msgTypes[76]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *AbsLessonPackage) GetLessonsTotal() int32 {
+	if x != nil {
+		return x.LessonsTotal
+	}
+	return 0
 }
 
-func (x *CreateNoteRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *AbsLessonPackage) GetLessonsLeft() int32 {
+	if x != nil {
+		return x.LessonsLeft
+	}
+	return 0
 }
 
-func (*CreateNoteRequest) ProtoMessage() {}
+func (x *AbsLessonPackage) GetPrice() float64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
 
-func (x *CreateNoteRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_education_proto_msgTypes[76]
+func (x *AbsLessonPackage) GetPurchasedAt() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.PurchasedAt
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use CreateNoteRequest.ProtoReflect.Descriptor instead.
-func (*CreateNoteRequest) Descriptor() ([]byte, []int) {
-	return file_education_proto_rawDescGZIP(), []int{76}
+func (x *AbsLessonPackage) GetExpiresAt() string {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return ""
 }
 
-func (x *CreateNoteRequest) GetNote() string {
+func (x *AbsLessonPackage) GetStatus() string {
 	if x != nil {
-		return x.Note
+		return x.Status
 	}
 	return ""
 }
 
-func (x *CreateNoteRequest) GetStudentId() string {
+func (x *AbsLessonPackage) GetCreatedByName() string {
 	if x != nil {
-		return x.StudentId
+		return x.CreatedByName
 	}
 	return ""
 }
@@ -6052,7 +12487,7 @@ type GetSmsLogRequest struct {
 
 func (x *GetSmsLogRequest) Reset() {
 	*x = GetSmsLogRequest{}
-	mi := &file_education_proto_msgTypes[77]
+	mi := &file_education_proto_msgTypes[164]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6064,7 +12499,7 @@ func (x *GetSmsLogRequest) String() string {
 func (*GetSmsLogRequest) ProtoMessage() {}
 
 func (x *GetSmsLogRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_education_proto_msgTypes[77]
+	mi := &file_education_proto_msgTypes[164]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6077,7 +12512,7 @@ func (x *GetSmsLogRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetSmsLogRequest.ProtoReflect.Descriptor instead.
 func (*GetSmsLogRequest) Descriptor() ([]byte, []int) {
-	return file_education_proto_rawDescGZIP(), []int{77}
+	return file_education_proto_rawDescGZIP(), []int{164}
 }
 
 func (x *GetSmsLogRequest) GetPageRequest() *PageRequest {
@@ -6104,7 +12539,7 @@ type GetSmsLogResponse struct {
 
 func (x *GetSmsLogResponse) Reset() {
 	*x = GetSmsLogResponse{}
-	mi := &file_education_proto_msgTypes[78]
+	mi := &file_education_proto_msgTypes[165]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6116,7 +12551,7 @@ func (x *GetSmsLogResponse) String() string {
 func (*GetSmsLogResponse) ProtoMessage() {}
 
 func (x *GetSmsLogResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_education_proto_msgTypes[78]
+	mi := &file_education_proto_msgTypes[165]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6129,7 +12564,7 @@ func (x *GetSmsLogResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetSmsLogResponse.ProtoReflect.Descriptor instead.
 func (*GetSmsLogResponse) Descriptor() ([]byte, []int) {
-	return file_education_proto_rawDescGZIP(), []int{78}
+	return file_education_proto_rawDescGZIP(), []int{165}
 }
 
 func (x *GetSmsLogResponse) GetDatas() []*SmsLogList {
@@ -6158,7 +12593,7 @@ type SmsLogList struct {
 
 func (x *SmsLogList) Reset() {
 	*x = SmsLogList{}
-	mi := &file_education_proto_msgTypes[79]
+	mi := &file_education_proto_msgTypes[166]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6170,7 +12605,7 @@ func (x *SmsLogList) String() string {
 func (*SmsLogList) ProtoMessage() {}
 
 func (x *SmsLogList) ProtoReflect() protoreflect.Message {
-	mi := &file_education_proto_msgTypes[79]
+	mi := &file_education_proto_msgTypes[166]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6183,7 +12618,7 @@ func (x *SmsLogList) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SmsLogList.ProtoReflect.Descriptor instead.
 func (*SmsLogList) Descriptor() ([]byte, []int) {
-	return file_education_proto_rawDescGZIP(), []int{79}
+	return file_education_proto_rawDescGZIP(), []int{166}
 }
 
 func (x *SmsLogList) GetCreatorName() string {
@@ -6226,7 +12661,7 @@ type AddSmsRequest struct {
 
 func (x *AddSmsRequest) Reset() {
 	*x = AddSmsRequest{}
-	mi := &file_education_proto_msgTypes[80]
+	mi := &file_education_proto_msgTypes[167]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6238,7 +12673,7 @@ func (x *AddSmsRequest) String() string {
 func (*AddSmsRequest) ProtoMessage() {}
 
 func (x *AddSmsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_education_proto_msgTypes[80]
+	mi := &file_education_proto_msgTypes[167]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6251,7 +12686,7 @@ func (x *AddSmsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AddSmsRequest.ProtoReflect.Descriptor instead.
 func (*AddSmsRequest) Descriptor() ([]byte, []int) {
-	return file_education_proto_rawDescGZIP(), []int{80}
+	return file_education_proto_rawDescGZIP(), []int{167}
 }
 
 func (x *AddSmsRequest) GetCompanyId() string {
@@ -6291,7 +12726,7 @@ type GetSmsTransactionDetailResponse struct {
 
 func (x *GetSmsTransactionDetailResponse) Reset() {
 	*x = GetSmsTransactionDetailResponse{}
-	mi := &file_education_proto_msgTypes[81]
+	mi := &file_education_proto_msgTypes[168]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6303,7 +12738,7 @@ func (x *GetSmsTransactionDetailResponse) String() string {
 func (*GetSmsTransactionDetailResponse) ProtoMessage() {}
 
 func (x *GetSmsTransactionDetailResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_education_proto_msgTypes[81]
+	mi := &file_education_proto_msgTypes[168]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6316,7 +12751,7 @@ func (x *GetSmsTransactionDetailResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetSmsTransactionDetailResponse.ProtoReflect.Descriptor instead.
 func (*GetSmsTransactionDetailResponse) Descriptor() ([]byte, []int) {
-	return file_education_proto_rawDescGZIP(), []int{81}
+	return file_education_proto_rawDescGZIP(), []int{168}
 }
 
 func (x *GetSmsTransactionDetailResponse) GetDatas() []*GetSmsTransactionList {
@@ -6338,7 +12773,7 @@ type GetSmsTransactionList struct {
 
 func (x *GetSmsTransactionList) Reset() {
 	*x = GetSmsTransactionList{}
-	mi := &file_education_proto_msgTypes[82]
+	mi := &file_education_proto_msgTypes[169]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6350,7 +12785,7 @@ func (x *GetSmsTransactionList) String() string {
 func (*GetSmsTransactionList) ProtoMessage() {}
 
 func (x *GetSmsTransactionList) ProtoReflect() protoreflect.Message {
-	mi := &file_education_proto_msgTypes[82]
+	mi := &file_education_proto_msgTypes[169]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6363,7 +12798,7 @@ func (x *GetSmsTransactionList) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetSmsTransactionList.ProtoReflect.Descriptor instead.
 func (*GetSmsTransactionList) Descriptor() ([]byte, []int) {
-	return file_education_proto_rawDescGZIP(), []int{82}
+	return file_education_proto_rawDescGZIP(), []int{169}
 }
 
 func (x *GetSmsTransactionList) GetTransactionId() int32 {
@@ -6403,7 +12838,7 @@ type GetSmsTemplateRequest struct {
 
 func (x *GetSmsTemplateRequest) Reset() {
 	*x = GetSmsTemplateRequest{}
-	mi := &file_education_proto_msgTypes[83]
+	mi := &file_education_proto_msgTypes[170]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6415,7 +12850,7 @@ func (x *GetSmsTemplateRequest) String() string {
 func (*GetSmsTemplateRequest) ProtoMessage() {}
 
 func (x *GetSmsTemplateRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_education_proto_msgTypes[83]
+	mi := &file_education_proto_msgTypes[170]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6428,7 +12863,7 @@ func (x *GetSmsTemplateRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetSmsTemplateRequest.ProtoReflect.Descriptor instead.
 func (*GetSmsTemplateRequest) Descriptor() ([]byte, []int) {
-	return file_education_proto_rawDescGZIP(), []int{83}
+	return file_education_proto_rawDescGZIP(), []int{170}
 }
 
 func (x *GetSmsTemplateRequest) GetSmsType() string {
@@ -6449,7 +12884,7 @@ type GetSmsTemplateResponse struct {
 
 func (x *GetSmsTemplateResponse) Reset() {
 	*x = GetSmsTemplateResponse{}
-	mi := &file_education_proto_msgTypes[84]
+	mi := &file_education_proto_msgTypes[171]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6461,7 +12896,7 @@ func (x *GetSmsTemplateResponse) String() string {
 func (*GetSmsTemplateResponse) ProtoMessage() {}
 
 func (x *GetSmsTemplateResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_education_proto_msgTypes[84]
+	mi := &file_education_proto_msgTypes[171]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6474,7 +12909,7 @@ func (x *GetSmsTemplateResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetSmsTemplateResponse.ProtoReflect.Descriptor instead.
 func (*GetSmsTemplateResponse) Descriptor() ([]byte, []int) {
-	return file_education_proto_rawDescGZIP(), []int{84}
+	return file_education_proto_rawDescGZIP(), []int{171}
 }
 
 func (x *GetSmsTemplateResponse) GetDatas() []*SmsTemplateList {
@@ -6510,7 +12945,7 @@ type SmsTemplateList struct {
 
 func (x *SmsTemplateList) Reset() {
 	*x = SmsTemplateList{}
-	mi := &file_education_proto_msgTypes[85]
+	mi := &file_education_proto_msgTypes[172]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6522,7 +12957,7 @@ func (x *SmsTemplateList) String() string {
 func (*SmsTemplateList) ProtoMessage() {}
 
 func (x *SmsTemplateList) ProtoReflect() protoreflect.Message {
-	mi := &file_education_proto_msgTypes[85]
+	mi := &file_education_proto_msgTypes[172]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6535,7 +12970,7 @@ func (x *SmsTemplateList) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SmsTemplateList.ProtoReflect.Descriptor instead.
 func (*SmsTemplateList) Descriptor() ([]byte, []int) {
-	return file_education_proto_rawDescGZIP(), []int{85}
+	return file_education_proto_rawDescGZIP(), []int{172}
 }
 
 func (x *SmsTemplateList) GetActionName() string {
@@ -6582,7 +13017,7 @@ type SetSmsTemplateRequest struct {
 
 func (x *SetSmsTemplateRequest) Reset() {
 	*x = SetSmsTemplateRequest{}
-	mi := &file_education_proto_msgTypes[86]
+	mi := &file_education_proto_msgTypes[173]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6594,7 +13029,7 @@ func (x *SetSmsTemplateRequest) String() string {
 func (*SetSmsTemplateRequest) ProtoMessage() {}
 
 func (x *SetSmsTemplateRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_education_proto_msgTypes[86]
+	mi := &file_education_proto_msgTypes[173]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6607,7 +13042,7 @@ func (x *SetSmsTemplateRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SetSmsTemplateRequest.ProtoReflect.Descriptor instead.
 func (*SetSmsTemplateRequest) Descriptor() ([]byte, []int) {
-	return file_education_proto_rawDescGZIP(), []int{86}
+	return file_education_proto_rawDescGZIP(), []int{173}
 }
 
 func (x *SetSmsTemplateRequest) GetAction() string {
@@ -6679,7 +13114,7 @@ type SendSmsDirectlyRequest struct {
 
 func (x *SendSmsDirectlyRequest) Reset() {
 	*x = SendSmsDirectlyRequest{}
-	mi := &file_education_proto_msgTypes[87]
+	mi := &file_education_proto_msgTypes[174]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6691,7 +13126,7 @@ func (x *SendSmsDirectlyRequest) String() string {
 func (*SendSmsDirectlyRequest) ProtoMessage() {}
 
 func (x *SendSmsDirectlyRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_education_proto_msgTypes[87]
+	mi := &file_education_proto_msgTypes[174]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6704,7 +13139,7 @@ func (x *SendSmsDirectlyRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SendSmsDirectlyRequest.ProtoReflect.Descriptor instead.
 func (*SendSmsDirectlyRequest) Descriptor() ([]byte, []int) {
-	return file_education_proto_rawDescGZIP(), []int{87}
+	return file_education_proto_rawDescGZIP(), []int{174}
 }
 
 func (x *SendSmsDirectlyRequest) GetSmsValue() string {
@@ -6929,7 +13364,50 @@ const file_education_proto_rawDesc = "" +
 	"\vdescription\x18\x05 \x01(\tR\vdescription\x12\"\n" +
 	"\fstudentCount\x18\x06 \x01(\x05R\fstudentCount\"&\n" +
 	"\x14GetCourseByIdRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\"l\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\x93\x02\n" +
+	"\rCourseBilling\x12\x1a\n" +
+	"\bcourseId\x18\x01 \x01(\tR\bcourseId\x12 \n" +
+	"\vbillingMode\x18\x02 \x01(\tR\vbillingMode\x12 \n" +
+	"\vlessonPrice\x18\x03 \x01(\x01R\vlessonPrice\x12&\n" +
+	"\x0epackageLessons\x18\x04 \x01(\x05R\x0epackageLessons\x12\"\n" +
+	"\fpackagePrice\x18\x05 \x01(\x01R\fpackagePrice\x12*\n" +
+	"\x10packageValidDays\x18\x06 \x01(\x05R\x10packageValidDays\x12*\n" +
+	"\x10lowCreditLessons\x18\a \x01(\x05R\x10lowCreditLessons\"\xea\x01\n" +
+	"\x1aScheduleCoursePriceRequest\x12\x1a\n" +
+	"\bcourseId\x18\x01 \x01(\tR\bcourseId\x12\x14\n" +
+	"\x05price\x18\x02 \x01(\x01R\x05price\x12$\n" +
+	"\reffectiveFrom\x18\x03 \x01(\tR\reffectiveFrom\x120\n" +
+	"\x13grandfatherExisting\x18\x04 \x01(\bR\x13grandfatherExisting\x12\x1e\n" +
+	"\n" +
+	"actionById\x18\x05 \x01(\tR\n" +
+	"actionById\x12\"\n" +
+	"\factionByName\x18\x06 \x01(\tR\factionByName\"\xd6\x01\n" +
+	"\x12CoursePriceVersion\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
+	"\x05price\x18\x02 \x01(\x01R\x05price\x12$\n" +
+	"\reffectiveFrom\x18\x03 \x01(\tR\reffectiveFrom\x120\n" +
+	"\x13grandfatherExisting\x18\x04 \x01(\bR\x13grandfatherExisting\x12$\n" +
+	"\rcreatedByName\x18\x05 \x01(\tR\rcreatedByName\x12\x1c\n" +
+	"\tcreatedAt\x18\x06 \x01(\tR\tcreatedAt\"\x90\x01\n" +
+	"\x1dGetCoursePriceHistoryResponse\x129\n" +
+	"\bversions\x18\x01 \x03(\v2\x1d.education.CoursePriceVersionR\bversions\x124\n" +
+	"\x05locks\x18\x02 \x03(\v2\x1e.education.EnrollmentPriceLockR\x05locks\"\xc3\x01\n" +
+	"\x13EnrollmentPriceLock\x12\x1c\n" +
+	"\tstudentId\x18\x01 \x01(\tR\tstudentId\x12 \n" +
+	"\vstudentName\x18\x02 \x01(\tR\vstudentName\x12\x18\n" +
+	"\agroupId\x18\x03 \x01(\tR\agroupId\x12\x1c\n" +
+	"\tgroupName\x18\x04 \x01(\tR\tgroupName\x12\x14\n" +
+	"\x05price\x18\x05 \x01(\x01R\x05price\x12\x1e\n" +
+	"\n" +
+	"lockedFrom\x18\x06 \x01(\tR\n" +
+	"lockedFrom\"\x8d\x01\n" +
+	"\x1dSetEnrollmentPriceLockRequest\x12\x1c\n" +
+	"\tstudentId\x18\x01 \x01(\tR\tstudentId\x12\x18\n" +
+	"\agroupId\x18\x02 \x01(\tR\agroupId\x12\x14\n" +
+	"\x05price\x18\x03 \x01(\x01R\x05price\x12\x1e\n" +
+	"\n" +
+	"lockedFrom\x18\x04 \x01(\tR\n" +
+	"lockedFrom\"l\n" +
 	"\x1eGetLeftAfterTrialPeriodRequest\x12\x12\n" +
 	"\x04from\x18\x01 \x01(\tR\x04from\x12\x0e\n" +
 	"\x02to\x18\x02 \x01(\tR\x02to\x12\x12\n" +
@@ -6976,7 +13454,7 @@ const file_education_proto_rawDesc = "" +
 	"groupEndAt\x18\a \x01(\tR\n" +
 	"groupEndAt\x12.\n" +
 	"\x12activeStudentCount\x18\b \x01(\x05R\x12activeStudentCount\x121\n" +
-	"\bstudents\x18\t \x03(\v2\x15.education.AbsStudentR\bstudents\"\x98\x02\n" +
+	"\bstudents\x18\t \x03(\v2\x15.education.AbsStudentR\bstudents\"\xf2\x02\n" +
 	"\x12CreateGroupRequest\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1a\n" +
 	"\bcourseId\x18\x02 \x01(\x05R\bcourseId\x12\x1c\n" +
@@ -6986,13 +13464,19 @@ const file_education_proto_rawDesc = "" +
 	"\x06roomId\x18\x06 \x01(\x05R\x06roomId\x12(\n" +
 	"\x0flessonStartTime\x18\a \x01(\tR\x0flessonStartTime\x12&\n" +
 	"\x0egroupStartDate\x18\b \x01(\tR\x0egroupStartDate\x12\"\n" +
-	"\fgroupEndDate\x18\t \x01(\tR\fgroupEndDate\"a\n" +
+	"\fgroupEndDate\x18\t \x01(\tR\fgroupEndDate\x12\x14\n" +
+	"\x05force\x18\n" +
+	" \x01(\bR\x05force\x12\x1e\n" +
+	"\n" +
+	"actionById\x18\v \x01(\tR\n" +
+	"actionById\x12\"\n" +
+	"\factionByName\x18\f \x01(\tR\factionByName\"a\n" +
 	"\x13GetGroupByIdRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1e\n" +
 	"\n" +
 	"actionRole\x18\x02 \x01(\tR\n" +
 	"actionRole\x12\x1a\n" +
-	"\bactionId\x18\x03 \x01(\tR\bactionId\"\xa7\x02\n" +
+	"\bactionId\x18\x03 \x01(\tR\bactionId\"\x81\x03\n" +
 	"\x11GetUpdateGroupAbs\x12\x0e\n" +
 	"\x02id\x18\n" +
 	" \x01(\tR\x02id\x12\x12\n" +
@@ -7004,7 +13488,12 @@ const file_education_proto_rawDesc = "" +
 	"\x06roomId\x18\x06 \x01(\x05R\x06roomId\x12(\n" +
 	"\x0flessonStartTime\x18\a \x01(\tR\x0flessonStartTime\x12&\n" +
 	"\x0egroupStartDate\x18\b \x01(\tR\x0egroupStartDate\x12\"\n" +
-	"\fgroupEndDate\x18\t \x01(\tR\fgroupEndDate\"[\n" +
+	"\fgroupEndDate\x18\t \x01(\tR\fgroupEndDate\x12\x14\n" +
+	"\x05force\x18\v \x01(\bR\x05force\x12\x1e\n" +
+	"\n" +
+	"actionById\x18\f \x01(\tR\n" +
+	"actionById\x12\"\n" +
+	"\factionByName\x18\r \x01(\tR\factionByName\"[\n" +
 	"\x19GetGroupsByCourseResponse\x12>\n" +
 	"\x06groups\x18\x01 \x03(\v2&.education.GetGroupByCourseAbsResponseR\x06groups\"\xff\x01\n" +
 	"\x1bGetGroupByCourseAbsResponse\x12\x0e\n" +
@@ -7055,18 +13544,68 @@ const file_education_proto_rawDesc = "" +
 	"\n" +
 	"_startDateB\n" +
 	"\n" +
-	"\b_endDate\"a\n" +
+	"\b_endDate\"[\n" +
+	"\x1dUpdateGroupBillingModeRequest\x12\x18\n" +
+	"\agroupId\x18\x01 \x01(\tR\agroupId\x12 \n" +
+	"\vbillingMode\x18\x02 \x01(\tR\vbillingMode\"[\n" +
+	"\x1dUpdateGroupMaxStudentsRequest\x12\x18\n" +
+	"\agroupId\x18\x01 \x01(\tR\agroupId\x12 \n" +
+	"\vmaxStudents\x18\x02 \x01(\x05R\vmaxStudents\"\x98\x02\n" +
+	"\x10ScheduleConflict\x12\x12\n" +
+	"\x04kind\x18\x01 \x01(\tR\x04kind\x12\x18\n" +
+	"\agroupId\x18\x02 \x01(\x03R\agroupId\x12\x1c\n" +
+	"\tgroupName\x18\x03 \x01(\tR\tgroupName\x12\x16\n" +
+	"\x06roomId\x18\x04 \x01(\x05R\x06roomId\x12\x1c\n" +
+	"\tteacherId\x18\x05 \x01(\tR\tteacherId\x12\x12\n" +
+	"\x04days\x18\x06 \x03(\tR\x04days\x12\x1c\n" +
+	"\tstartTime\x18\a \x01(\tR\tstartTime\x12\x18\n" +
+	"\aendTime\x18\b \x01(\tR\aendTime\x12\x1a\n" +
+	"\bfromDate\x18\t \x01(\tR\bfromDate\x12\x1a\n" +
+	"\btillDate\x18\n" +
+	" \x01(\tR\btillDate\"N\n" +
+	"\x11ScheduleConflicts\x129\n" +
+	"\tconflicts\x18\x01 \x03(\v2\x1b.education.ScheduleConflictR\tconflicts\"\xa9\x02\n" +
+	"\x1dCheckScheduleConflictsRequest\x12\x18\n" +
+	"\agroupId\x18\x01 \x01(\tR\agroupId\x12\x1a\n" +
+	"\bcourseId\x18\x02 \x01(\x05R\bcourseId\x12\x1c\n" +
+	"\tteacherId\x18\x03 \x01(\tR\tteacherId\x12\x16\n" +
+	"\x06roomId\x18\x04 \x01(\x05R\x06roomId\x12\x12\n" +
+	"\x04type\x18\x05 \x01(\tR\x04type\x12\x12\n" +
+	"\x04days\x18\x06 \x03(\tR\x04days\x12(\n" +
+	"\x0flessonStartTime\x18\a \x01(\tR\x0flessonStartTime\x12&\n" +
+	"\x0egroupStartDate\x18\b \x01(\tR\x0egroupStartDate\x12\"\n" +
+	"\fgroupEndDate\x18\t \x01(\tR\fgroupEndDate\"M\n" +
+	"#GetScheduleConflictOverridesRequest\x12\x12\n" +
+	"\x04from\x18\x01 \x01(\tR\x04from\x12\x12\n" +
+	"\x04till\x18\x02 \x01(\tR\x04till\"\x97\x02\n" +
+	"\x18ScheduleConflictOverride\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x18\n" +
+	"\agroupId\x18\x02 \x01(\x03R\agroupId\x12\x1c\n" +
+	"\tgroupName\x18\x03 \x01(\tR\tgroupName\x12\x16\n" +
+	"\x06action\x18\x04 \x01(\tR\x06action\x129\n" +
+	"\tconflicts\x18\x05 \x03(\v2\x1b.education.ScheduleConflictR\tconflicts\x12\x1e\n" +
+	"\n" +
+	"forcedById\x18\x06 \x01(\tR\n" +
+	"forcedById\x12\"\n" +
+	"\fforcedByName\x18\a \x01(\tR\fforcedByName\x12\x1c\n" +
+	"\tcreatedAt\x18\b \x01(\tR\tcreatedAt\"i\n" +
+	"$GetScheduleConflictOverridesResponse\x12A\n" +
+	"\toverrides\x18\x01 \x03(\v2#.education.ScheduleConflictOverrideR\toverrides\"\x81\x01\n" +
 	"\x1dCalculateTeacherSalaryRequest\x12\x12\n" +
 	"\x04from\x18\x01 \x01(\tR\x04from\x12\x0e\n" +
 	"\x02to\x18\x02 \x01(\tR\x02to\x12\x1c\n" +
-	"\tteacherId\x18\x03 \x01(\tR\tteacherId\"[\n" +
+	"\tteacherId\x18\x03 \x01(\tR\tteacherId\x12\x1e\n" +
+	"\n" +
+	"teacherIds\x18\x04 \x03(\tR\n" +
+	"teacherIds\"[\n" +
 	"\x1eCalculateTeacherSalaryResponse\x129\n" +
-	"\bsalaries\x18\x01 \x03(\v2\x1d.education.AbsCalculateSalaryR\bsalaries\"\xc0\x01\n" +
+	"\bsalaries\x18\x01 \x03(\v2\x1d.education.AbsCalculateSalaryR\bsalaries\"\xde\x01\n" +
 	"\x12AbsCalculateSalary\x12\x18\n" +
 	"\agroupId\x18\x01 \x01(\tR\agroupId\x12\x1c\n" +
 	"\tgroupName\x18\x02 \x01(\tR\tgroupName\x12<\n" +
 	"\x19commonLessonCountInPeriod\x18\x03 \x01(\x05R\x19commonLessonCountInPeriod\x124\n" +
-	"\bsalaries\x18\x04 \x03(\v2\x18.education.StudentSalaryR\bsalaries\"\x99\x02\n" +
+	"\bsalaries\x18\x04 \x03(\v2\x18.education.StudentSalaryR\bsalaries\x12\x1c\n" +
+	"\tteacherId\x18\x05 \x01(\tR\tteacherId\"\x99\x02\n" +
 	"\rStudentSalary\x12\x1c\n" +
 	"\tstudentId\x18\x01 \x01(\tR\tstudentId\x12 \n" +
 	"\vstudentName\x18\x02 \x01(\tR\vstudentName\x12,\n" +
@@ -7107,7 +13646,7 @@ const file_education_proto_rawDesc = "" +
 	"\tcondition\x18\n" +
 	" \x01(\tR\tcondition\x12\x12\n" +
 	"\x04name\x18\v \x01(\tR\x04name\x12\x14\n" +
-	"\x05phone\x18\f \x01(\tR\x05phone\"\x91\x01\n" +
+	"\x05phone\x18\f \x01(\tR\x05phone\"\xcf\x02\n" +
 	"\n" +
 	"Attendance\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1f\n" +
@@ -7115,10 +13654,21 @@ const file_education_proto_rawDesc = "" +
 	"attendDate\x12\x16\n" +
 	"\x06isCome\x18\x03 \x01(\bR\x06isCome\x12\x1c\n" +
 	"\tstudentId\x18\x04 \x01(\tR\tstudentId\x12\x1c\n" +
-	"\tteacherId\x18\x05 \x01(\tR\tteacherId\"C\n" +
+	"\tteacherId\x18\x05 \x01(\tR\tteacherId\x12\x16\n" +
+	"\x06status\x18\x06 \x01(\x05R\x06status\x12\x1e\n" +
+	"\n" +
+	"statusName\x18\a \x01(\tR\n" +
+	"statusName\x12$\n" +
+	"\rmakeupGroupId\x18\b \x01(\tR\rmakeupGroupId\x12\x1e\n" +
+	"\n" +
+	"makeupDate\x18\t \x01(\tR\n" +
+	"makeupDate\x12 \n" +
+	"\vselfCheckIn\x18\n" +
+	" \x01(\bR\vselfCheckIn\x12\x1c\n" +
+	"\tconfirmed\x18\v \x01(\bR\tconfirmed\"C\n" +
 	"\fFreezeDetail\x12\x16\n" +
 	"\x06reason\x18\x01 \x01(\tR\x06reason\x12\x1b\n" +
-	"\ttill_date\x18\x02 \x01(\tR\btillDate\"\xe8\x01\n" +
+	"\ttill_date\x18\x02 \x01(\tR\btillDate\"\xae\x02\n" +
 	"\x14SetAttendanceRequest\x12\x1e\n" +
 	"\n" +
 	"attendDate\x18\x01 \x01(\tR\n" +
@@ -7130,7 +13680,438 @@ const file_education_proto_rawDesc = "" +
 	"\n" +
 	"actionById\x18\x06 \x01(\tR\n" +
 	"actionById\x12\"\n" +
-	"\factionByRole\x18\a \x01(\tR\factionByRole\"\x90\x02\n" +
+	"\factionByRole\x18\a \x01(\tR\factionByRole\x12$\n" +
+	"\rmakeupGroupId\x18\b \x01(\tR\rmakeupGroupId\x12\x1e\n" +
+	"\n" +
+	"makeupDate\x18\t \x01(\tR\n" +
+	"makeupDate\"\xed\x01\n" +
+	"\x19SetGroupAttendanceRequest\x12\x1e\n" +
+	"\n" +
+	"attendDate\x18\x01 \x01(\tR\n" +
+	"attendDate\x12\x18\n" +
+	"\agroupId\x18\x02 \x01(\tR\agroupId\x12\x1c\n" +
+	"\tteacherId\x18\x03 \x01(\tR\tteacherId\x124\n" +
+	"\x05marks\x18\x04 \x03(\v2\x1e.education.GroupAttendanceMarkR\x05marks\x12\x1e\n" +
+	"\n" +
+	"actionById\x18\x05 \x01(\tR\n" +
+	"actionById\x12\"\n" +
+	"\factionByRole\x18\x06 \x01(\tR\factionByRole\"\x91\x01\n" +
+	"\x13GroupAttendanceMark\x12\x1c\n" +
+	"\tstudentId\x18\x01 \x01(\tR\tstudentId\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\x05R\x06status\x12$\n" +
+	"\rmakeupGroupId\x18\x03 \x01(\tR\rmakeupGroupId\x12\x1e\n" +
+	"\n" +
+	"makeupDate\x18\x04 \x01(\tR\n" +
+	"makeupDate\"\x81\x01\n" +
+	"\x15GroupAttendanceResult\x12\x1c\n" +
+	"\tstudentId\x18\x01 \x01(\tR\tstudentId\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\x05R\x06status\x12\x18\n" +
+	"\asuccess\x18\x03 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x04 \x01(\tR\amessage\"\x8e\x01\n" +
+	"\x1aSetGroupAttendanceResponse\x12:\n" +
+	"\aresults\x18\x01 \x03(\v2 .education.GroupAttendanceResultR\aresults\x12\x1c\n" +
+	"\tsucceeded\x18\x02 \x01(\x05R\tsucceeded\x12\x16\n" +
+	"\x06failed\x18\x03 \x01(\x05R\x06failed\"\x81\x02\n" +
+	"\x13AbsAttendanceStatus\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04code\x18\x02 \x01(\x05R\x04code\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x1a\n" +
+	"\bbillable\x18\x04 \x01(\bR\bbillable\x12 \n" +
+	"\vpaysTeacher\x18\x05 \x01(\bR\vpaysTeacher\x12\x1e\n" +
+	"\n" +
+	"absenceSms\x18\x06 \x01(\bR\n" +
+	"absenceSms\x12\x1a\n" +
+	"\bisMakeup\x18\a \x01(\bR\bisMakeup\x12\x1c\n" +
+	"\tisDefault\x18\b \x01(\bR\tisDefault\x12\x1a\n" +
+	"\bisActive\x18\t \x01(\bR\bisActive\"B\n" +
+	"\x1cGetAttendanceStatusesRequest\x12\"\n" +
+	"\fwithInactive\x18\x01 \x01(\bR\fwithInactive\"[\n" +
+	"\x1dGetAttendanceStatusesResponse\x12:\n" +
+	"\bstatuses\x18\x01 \x03(\v2\x1e.education.AbsAttendanceStatusR\bstatuses\"\xda\x01\n" +
+	"\x10AttendancePolicy\x12\"\n" +
+	"\feditDaysBack\x18\x01 \x01(\x05R\feditDaysBack\x12\x1e\n" +
+	"\n" +
+	"cutoffHour\x18\x02 \x01(\x05R\n" +
+	"cutoffHour\x12\x1a\n" +
+	"\btimezone\x18\x03 \x01(\tR\btimezone\x12 \n" +
+	"\vallowFuture\x18\x04 \x01(\bR\vallowFuture\x12$\n" +
+	"\roverrideRoles\x18\x05 \x03(\tR\roverrideRoles\x12\x1e\n" +
+	"\n" +
+	"actionById\x18\x06 \x01(\tR\n" +
+	"actionById\"\xb6\x01\n" +
+	"\x1eRequestAttendanceUnlockRequest\x12\x18\n" +
+	"\agroupId\x18\x01 \x01(\tR\agroupId\x12\x1e\n" +
+	"\n" +
+	"attendDate\x18\x02 \x01(\tR\n" +
+	"attendDate\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\x12\x1e\n" +
+	"\n" +
+	"actionById\x18\x04 \x01(\tR\n" +
+	"actionById\x12\"\n" +
+	"\factionByName\x18\x05 \x01(\tR\factionByName\"\xfd\x02\n" +
+	"\x17AttendanceUnlockRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x18\n" +
+	"\agroupId\x18\x02 \x01(\x03R\agroupId\x12\x1c\n" +
+	"\tgroupName\x18\x03 \x01(\tR\tgroupName\x12\x1e\n" +
+	"\n" +
+	"attendDate\x18\x04 \x01(\tR\n" +
+	"attendDate\x12\x1c\n" +
+	"\tteacherId\x18\x05 \x01(\tR\tteacherId\x12 \n" +
+	"\vteacherName\x18\x06 \x01(\tR\vteacherName\x12\x16\n" +
+	"\x06reason\x18\a \x01(\tR\x06reason\x12\x16\n" +
+	"\x06status\x18\b \x01(\tR\x06status\x12&\n" +
+	"\x0ereviewedByName\x18\t \x01(\tR\x0ereviewedByName\x12\x1e\n" +
+	"\n" +
+	"reviewedAt\x18\n" +
+	" \x01(\tR\n" +
+	"reviewedAt\x12$\n" +
+	"\runlockedUntil\x18\v \x01(\tR\runlockedUntil\x12\x1c\n" +
+	"\tcreatedAt\x18\f \x01(\tR\tcreatedAt\"\x80\x01\n" +
+	"\"GetAttendanceUnlockRequestsRequest\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\tR\x06status\x12\x1e\n" +
+	"\n" +
+	"actionById\x18\x02 \x01(\tR\n" +
+	"actionById\x12\"\n" +
+	"\factionByRole\x18\x03 \x01(\tR\factionByRole\"e\n" +
+	"#GetAttendanceUnlockRequestsResponse\x12>\n" +
+	"\brequests\x18\x01 \x03(\v2\".education.AttendanceUnlockRequestR\brequests\"\xa3\x01\n" +
+	"\x1dReviewAttendanceUnlockRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x18\n" +
+	"\aapprove\x18\x02 \x01(\bR\aapprove\x12\x14\n" +
+	"\x05hours\x18\x03 \x01(\x05R\x05hours\x12\x1e\n" +
+	"\n" +
+	"actionById\x18\x04 \x01(\tR\n" +
+	"actionById\x12\"\n" +
+	"\factionByName\x18\x05 \x01(\tR\factionByName\"Q\n" +
+	"\x1bSendStudentLoginCodeRequest\x12\x1c\n" +
+	"\tsubdomain\x18\x01 \x01(\tR\tsubdomain\x12\x14\n" +
+	"\x05phone\x18\x02 \x01(\tR\x05phone\"\x7f\n" +
+	"\x1dVerifyStudentLoginCodeRequest\x12\x1c\n" +
+	"\tsubdomain\x18\x01 \x01(\tR\tsubdomain\x12\x14\n" +
+	"\x05phone\x18\x02 \x01(\tR\x05phone\x12\x12\n" +
+	"\x04code\x18\x03 \x01(\tR\x04code\x12\x16\n" +
+	"\x06device\x18\x04 \x01(\tR\x06device\"\xa2\x01\n" +
+	"\x0eStudentSession\x12\x1c\n" +
+	"\tstudentId\x18\x01 \x01(\tR\tstudentId\x12 \n" +
+	"\vstudentName\x18\x02 \x01(\tR\vstudentName\x12\x1c\n" +
+	"\tcompanyId\x18\x03 \x01(\tR\tcompanyId\x12\x14\n" +
+	"\x05token\x18\x04 \x01(\tR\x05token\x12\x1c\n" +
+	"\texpiresAt\x18\x05 \x01(\tR\texpiresAt\"W\n" +
+	"\x1eVerifyStudentLoginCodeResponse\x125\n" +
+	"\bsessions\x18\x01 \x03(\v2\x19.education.StudentSessionR\bsessions\"5\n" +
+	"\x1dValidateStudentSessionRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\"w\n" +
+	"\x15GetCheckInCodeRequest\x12\x1a\n" +
+	"\blessonId\x18\x01 \x01(\tR\blessonId\x12\x1e\n" +
+	"\n" +
+	"actionById\x18\x02 \x01(\tR\n" +
+	"actionById\x12\"\n" +
+	"\factionByRole\x18\x03 \x01(\tR\factionByRole\"\xb9\x01\n" +
+	"\vCheckInCode\x12\x1a\n" +
+	"\blessonId\x18\x01 \x01(\tR\blessonId\x12\x1c\n" +
+	"\tgroupName\x18\x02 \x01(\tR\tgroupName\x12\x1e\n" +
+	"\n" +
+	"lessonDate\x18\x03 \x01(\tR\n" +
+	"lessonDate\x12\x14\n" +
+	"\x05token\x18\x04 \x01(\tR\x05token\x12\x1c\n" +
+	"\texpiresAt\x18\x05 \x01(\tR\texpiresAt\x12\x1c\n" +
+	"\trefreshIn\x18\x06 \x01(\x05R\trefreshIn\"\\\n" +
+	"\x0eCheckInRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\x12\x1c\n" +
+	"\tstudentId\x18\x02 \x01(\tR\tstudentId\x12\x16\n" +
+	"\x06device\x18\x03 \x01(\tR\x06device\"\xc5\x01\n" +
+	"\x0fCheckInResponse\x12\x1a\n" +
+	"\blessonId\x18\x01 \x01(\tR\blessonId\x12\x1c\n" +
+	"\tgroupName\x18\x02 \x01(\tR\tgroupName\x12\x1e\n" +
+	"\n" +
+	"lessonDate\x18\x03 \x01(\tR\n" +
+	"lessonDate\x12 \n" +
+	"\vcheckedInAt\x18\x04 \x01(\tR\vcheckedInAt\x12\x1c\n" +
+	"\tconfirmed\x18\x05 \x01(\bR\tconfirmed\x12\x18\n" +
+	"\amessage\x18\x06 \x01(\tR\amessage\"z\n" +
+	"\x18GetLessonCheckInsRequest\x12\x1a\n" +
+	"\blessonId\x18\x01 \x01(\tR\blessonId\x12\x1e\n" +
+	"\n" +
+	"actionById\x18\x02 \x01(\tR\n" +
+	"actionById\x12\"\n" +
+	"\factionByRole\x18\x03 \x01(\tR\factionByRole\"\xa7\x01\n" +
+	"\rLessonCheckIn\x12\x1c\n" +
+	"\tstudentId\x18\x01 \x01(\tR\tstudentId\x12 \n" +
+	"\vstudentName\x18\x02 \x01(\tR\vstudentName\x12 \n" +
+	"\vcheckedInAt\x18\x03 \x01(\tR\vcheckedInAt\x12\x16\n" +
+	"\x06device\x18\x04 \x01(\tR\x06device\x12\x1c\n" +
+	"\tconfirmed\x18\x05 \x01(\bR\tconfirmed\"Q\n" +
+	"\x19GetLessonCheckInsResponse\x124\n" +
+	"\bcheckIns\x18\x01 \x03(\v2\x18.education.LessonCheckInR\bcheckIns\"\xb0\x01\n" +
+	"\x16ConfirmCheckInsRequest\x12\x1a\n" +
+	"\blessonId\x18\x01 \x01(\tR\blessonId\x12\x1e\n" +
+	"\n" +
+	"studentIds\x18\x02 \x03(\tR\n" +
+	"studentIds\x12\x16\n" +
+	"\x06reject\x18\x03 \x01(\bR\x06reject\x12\x1e\n" +
+	"\n" +
+	"actionById\x18\x04 \x01(\tR\n" +
+	"actionById\x12\"\n" +
+	"\factionByRole\x18\x05 \x01(\tR\factionByRole\"\xeb\x01\n" +
+	"\x19GetAttendanceRatesRequest\x12\x12\n" +
+	"\x04from\x18\x01 \x01(\tR\x04from\x12\x12\n" +
+	"\x04till\x18\x02 \x01(\tR\x04till\x12\x0e\n" +
+	"\x02by\x18\x03 \x01(\tR\x02by\x12\x18\n" +
+	"\agroupId\x18\x04 \x01(\tR\agroupId\x12\x1c\n" +
+	"\tteacherId\x18\x05 \x01(\tR\tteacherId\x12\x1a\n" +
+	"\bcourseId\x18\x06 \x01(\tR\bcourseId\x12\x1e\n" +
+	"\n" +
+	"actionById\x18\a \x01(\tR\n" +
+	"actionById\x12\"\n" +
+	"\factionByRole\x18\b \x01(\tR\factionByRole\"\x92\x01\n" +
+	"\x0eAttendanceRate\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1a\n" +
+	"\battended\x18\x03 \x01(\x05R\battended\x12\x16\n" +
+	"\x06absent\x18\x04 \x01(\x05R\x06absent\x12\x14\n" +
+	"\x05total\x18\x05 \x01(\x05R\x05total\x12\x12\n" +
+	"\x04rate\x18\x06 \x01(\x01R\x04rate\"M\n" +
+	"\x1aGetAttendanceRatesResponse\x12/\n" +
+	"\x05rates\x18\x01 \x03(\v2\x19.education.AttendanceRateR\x05rates\"\xb4\x01\n" +
+	"\x18GetAbsenceStreaksRequest\x12\x18\n" +
+	"\agroupId\x18\x01 \x01(\tR\agroupId\x12\x1c\n" +
+	"\tteacherId\x18\x02 \x01(\tR\tteacherId\x12\x1c\n" +
+	"\tminStreak\x18\x03 \x01(\x05R\tminStreak\x12\x1e\n" +
+	"\n" +
+	"actionById\x18\x04 \x01(\tR\n" +
+	"actionById\x12\"\n" +
+	"\factionByRole\x18\x05 \x01(\tR\factionByRole\"\xc7\x01\n" +
+	"\rAbsenceStreak\x12\x1c\n" +
+	"\tstudentId\x18\x01 \x01(\tR\tstudentId\x12 \n" +
+	"\vstudentName\x18\x02 \x01(\tR\vstudentName\x12\x18\n" +
+	"\agroupId\x18\x03 \x01(\x03R\agroupId\x12\x1c\n" +
+	"\tgroupName\x18\x04 \x01(\tR\tgroupName\x12\x16\n" +
+	"\x06streak\x18\x05 \x01(\x05R\x06streak\x12&\n" +
+	"\x0elastAbsentDate\x18\x06 \x01(\tR\x0elastAbsentDate\"O\n" +
+	"\x19GetAbsenceStreaksResponse\x122\n" +
+	"\astreaks\x18\x01 \x03(\v2\x18.education.AbsenceStreakR\astreaks\"\xf7\x01\n" +
+	"\x19GetAttendanceTrendRequest\x12\x12\n" +
+	"\x04from\x18\x01 \x01(\tR\x04from\x12\x12\n" +
+	"\x04till\x18\x02 \x01(\tR\x04till\x12\x1a\n" +
+	"\binterval\x18\x03 \x01(\tR\binterval\x12\x18\n" +
+	"\agroupId\x18\x04 \x01(\tR\agroupId\x12\x1c\n" +
+	"\tteacherId\x18\x05 \x01(\tR\tteacherId\x12\x1a\n" +
+	"\bcourseId\x18\x06 \x01(\tR\bcourseId\x12\x1e\n" +
+	"\n" +
+	"actionById\x18\a \x01(\tR\n" +
+	"actionById\x12\"\n" +
+	"\factionByRole\x18\b \x01(\tR\factionByRole\"t\n" +
+	"\x14AttendanceTrendPoint\x12\x16\n" +
+	"\x06period\x18\x01 \x01(\tR\x06period\x12\x1a\n" +
+	"\battended\x18\x02 \x01(\x05R\battended\x12\x14\n" +
+	"\x05total\x18\x03 \x01(\x05R\x05total\x12\x12\n" +
+	"\x04rate\x18\x04 \x01(\x01R\x04rate\"U\n" +
+	"\x1aGetAttendanceTrendResponse\x127\n" +
+	"\x06points\x18\x01 \x03(\v2\x1f.education.AttendanceTrendPointR\x06points\"\xae\x01\n" +
+	"\fAtRiskPolicy\x12$\n" +
+	"\rabsenceStreak\x18\x01 \x01(\x05R\rabsenceStreak\x12\x18\n" +
+	"\aminRate\x18\x02 \x01(\x05R\aminRate\x12\x1e\n" +
+	"\n" +
+	"minLessons\x18\x03 \x01(\x05R\n" +
+	"minLessons\x12\x1e\n" +
+	"\n" +
+	"smsParents\x18\x04 \x01(\bR\n" +
+	"smsParents\x12\x1e\n" +
+	"\n" +
+	"actionById\x18\x05 \x01(\tR\n" +
+	"actionById\"\x90\x01\n" +
+	"\x18GetAtRiskStudentsRequest\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\tR\x06status\x12\x18\n" +
+	"\agroupId\x18\x02 \x01(\tR\agroupId\x12\x1e\n" +
+	"\n" +
+	"actionById\x18\x03 \x01(\tR\n" +
+	"actionById\x12\"\n" +
+	"\factionByRole\x18\x04 \x01(\tR\factionByRole\"\xd3\x02\n" +
+	"\rAtRiskStudent\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1c\n" +
+	"\tstudentId\x18\x02 \x01(\tR\tstudentId\x12 \n" +
+	"\vstudentName\x18\x03 \x01(\tR\vstudentName\x12\x18\n" +
+	"\agroupId\x18\x04 \x01(\x03R\agroupId\x12\x1c\n" +
+	"\tgroupName\x18\x05 \x01(\tR\tgroupName\x12\x16\n" +
+	"\x06reason\x18\x06 \x01(\tR\x06reason\x12$\n" +
+	"\rabsenceStreak\x18\a \x01(\x05R\rabsenceStreak\x12&\n" +
+	"\x0eattendanceRate\x18\b \x01(\x01R\x0eattendanceRate\x12\x16\n" +
+	"\x06status\x18\t \x01(\tR\x06status\x12\x1c\n" +
+	"\tflaggedAt\x18\n" +
+	" \x01(\tR\tflaggedAt\x12\x1e\n" +
+	"\n" +
+	"resolvedAt\x18\v \x01(\tR\n" +
+	"resolvedAt\"Q\n" +
+	"\x19GetAtRiskStudentsResponse\x124\n" +
+	"\bstudents\x18\x01 \x03(\v2\x18.education.AtRiskStudentR\bstudents\"\x93\x01\n" +
+	"\x17GetNotificationsRequest\x12\x1e\n" +
+	"\n" +
+	"unreadOnly\x18\x01 \x01(\bR\n" +
+	"unreadOnly\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\x12\x1e\n" +
+	"\n" +
+	"actionById\x18\x03 \x01(\tR\n" +
+	"actionById\x12\"\n" +
+	"\factionByRole\x18\x04 \x01(\tR\factionByRole\"\xc6\x01\n" +
+	"\fNotification\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04type\x18\x02 \x01(\tR\x04type\x12\x14\n" +
+	"\x05title\x18\x03 \x01(\tR\x05title\x12\x12\n" +
+	"\x04body\x18\x04 \x01(\tR\x04body\x12\x1c\n" +
+	"\tstudentId\x18\x05 \x01(\tR\tstudentId\x12\x18\n" +
+	"\agroupId\x18\x06 \x01(\x03R\agroupId\x12\x12\n" +
+	"\x04read\x18\a \x01(\bR\x04read\x12\x1c\n" +
+	"\tcreatedAt\x18\b \x01(\tR\tcreatedAt\"q\n" +
+	"\x18GetNotificationsResponse\x12=\n" +
+	"\rnotifications\x18\x01 \x03(\v2\x17.education.NotificationR\rnotifications\x12\x16\n" +
+	"\x06unread\x18\x02 \x01(\x05R\x06unread\"t\n" +
+	"\x1cMarkNotificationsReadRequest\x12\x10\n" +
+	"\x03ids\x18\x01 \x03(\tR\x03ids\x12\x1e\n" +
+	"\n" +
+	"actionById\x18\x02 \x01(\tR\n" +
+	"actionById\x12\"\n" +
+	"\factionByRole\x18\x03 \x01(\tR\factionByRole\"U\n" +
+	"\x17GetGroupWaitlistRequest\x12\x18\n" +
+	"\agroupId\x18\x01 \x01(\tR\agroupId\x12 \n" +
+	"\vwithHistory\x18\x02 \x01(\bR\vwithHistory\"\xb7\x02\n" +
+	"\rWaitlistEntry\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1c\n" +
+	"\tstudentId\x18\x02 \x01(\tR\tstudentId\x12 \n" +
+	"\vstudentName\x18\x03 \x01(\tR\vstudentName\x12\"\n" +
+	"\fstudentPhone\x18\x04 \x01(\tR\fstudentPhone\x12\x1a\n" +
+	"\bposition\x18\x05 \x01(\x05R\bposition\x12\x16\n" +
+	"\x06status\x18\x06 \x01(\tR\x06status\x12\x1c\n" +
+	"\tofferedAt\x18\a \x01(\tR\tofferedAt\x12\x1c\n" +
+	"\tholdUntil\x18\b \x01(\tR\tholdUntil\x12$\n" +
+	"\rcreatedByName\x18\t \x01(\tR\rcreatedByName\x12\x1c\n" +
+	"\tcreatedAt\x18\n" +
+	" \x01(\tR\tcreatedAt\"\x9a\x01\n" +
+	"\x18GetGroupWaitlistResponse\x12\x1a\n" +
+	"\bcapacity\x18\x01 \x01(\x05R\bcapacity\x12\x1a\n" +
+	"\benrolled\x18\x02 \x01(\x05R\benrolled\x12\x12\n" +
+	"\x04held\x18\x03 \x01(\x05R\x04held\x122\n" +
+	"\aentries\x18\x04 \x03(\v2\x18.education.WaitlistEntryR\aentries\"F\n" +
+	"\x18MoveWaitlistEntryRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1a\n" +
+	"\bposition\x18\x02 \x01(\x05R\bposition\"&\n" +
+	"\x14WaitlistEntryRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\xb0\x01\n" +
+	"\x1aAcceptWaitlistOfferRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12 \n" +
+	"\vcreatedDate\x18\x02 \x01(\tR\vcreatedDate\x12\x1c\n" +
+	"\tcreatedBy\x18\x03 \x01(\tR\tcreatedBy\x12$\n" +
+	"\rcreatedByName\x18\x04 \x01(\tR\rcreatedByName\x12\x1c\n" +
+	"\tpromoCode\x18\x05 \x01(\tR\tpromoCode\"\xc6\x02\n" +
+	"\n" +
+	"AbsHoliday\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x12\x1a\n" +
+	"\bfromDate\x18\x03 \x01(\tR\bfromDate\x12\x1a\n" +
+	"\btillDate\x18\x04 \x01(\tR\btillDate\x12\x1c\n" +
+	"\trecurring\x18\x05 \x01(\bR\trecurring\x12\x14\n" +
+	"\x05scope\x18\x06 \x01(\tR\x05scope\x12\x16\n" +
+	"\x06roomId\x18\a \x01(\x05R\x06roomId\x12\x18\n" +
+	"\agroupId\x18\b \x01(\x03R\agroupId\x12\x1a\n" +
+	"\broomName\x18\t \x01(\tR\broomName\x12\x1c\n" +
+	"\tgroupName\x18\n" +
+	" \x01(\tR\tgroupName\x12\x1e\n" +
+	"\n" +
+	"isNational\x18\v \x01(\bR\n" +
+	"isNational\x12\x1a\n" +
+	"\bisActive\x18\f \x01(\bR\bisActive\"<\n" +
+	"\x12GetHolidaysRequest\x12\x12\n" +
+	"\x04from\x18\x01 \x01(\tR\x04from\x12\x12\n" +
+	"\x04till\x18\x02 \x01(\tR\x04till\"H\n" +
+	"\x13GetHolidaysResponse\x121\n" +
+	"\bholidays\x18\x01 \x03(\v2\x15.education.AbsHolidayR\bholidays\"[\n" +
+	"\x17GetGroupHolidaysRequest\x12\x18\n" +
+	"\agroupId\x18\x01 \x01(\tR\agroupId\x12\x12\n" +
+	"\x04from\x18\x02 \x01(\tR\x04from\x12\x12\n" +
+	"\x04till\x18\x03 \x01(\tR\x04till\"0\n" +
+	"\x18GetGroupHolidaysResponse\x12\x14\n" +
+	"\x05dates\x18\x01 \x03(\tR\x05dates\"\xa9\x01\n" +
+	"\x13GetTimetableRequest\x12\x12\n" +
+	"\x04from\x18\x01 \x01(\tR\x04from\x12\x12\n" +
+	"\x04till\x18\x02 \x01(\tR\x04till\x12\x16\n" +
+	"\x06roomId\x18\x03 \x01(\x05R\x06roomId\x12\x1c\n" +
+	"\tteacherId\x18\x04 \x01(\tR\tteacherId\x12\x1a\n" +
+	"\bcourseId\x18\x05 \x01(\x05R\bcourseId\x12\x18\n" +
+	"\agroupId\x18\x06 \x01(\tR\agroupId\"\x83\x03\n" +
+	"\x0fTimetableLesson\x12\x18\n" +
+	"\agroupId\x18\x01 \x01(\x03R\agroupId\x12\x1c\n" +
+	"\tgroupName\x18\x02 \x01(\tR\tgroupName\x12\x1a\n" +
+	"\bcourseId\x18\x03 \x01(\x05R\bcourseId\x12\x1e\n" +
+	"\n" +
+	"courseName\x18\x04 \x01(\tR\n" +
+	"courseName\x12\x1c\n" +
+	"\tteacherId\x18\x05 \x01(\tR\tteacherId\x12 \n" +
+	"\vteacherName\x18\x06 \x01(\tR\vteacherName\x12\x16\n" +
+	"\x06roomId\x18\a \x01(\x05R\x06roomId\x12\x1a\n" +
+	"\broomName\x18\b \x01(\tR\broomName\x12\x12\n" +
+	"\x04date\x18\t \x01(\tR\x04date\x12\x1c\n" +
+	"\tstartTime\x18\n" +
+	" \x01(\tR\tstartTime\x12\x18\n" +
+	"\aendTime\x18\v \x01(\tR\aendTime\x12 \n" +
+	"\vtransferred\x18\f \x01(\bR\vtransferred\x12\x1a\n" +
+	"\brealDate\x18\r \x01(\tR\brealDate\"L\n" +
+	"\x14GetTimetableResponse\x124\n" +
+	"\alessons\x18\x01 \x03(\v2\x1a.education.TimetableLessonR\alessons\"S\n" +
+	"\x19CreateCalendarFeedRequest\x12\x1c\n" +
+	"\townerType\x18\x01 \x01(\tR\townerType\x12\x18\n" +
+	"\aownerId\x18\x02 \x01(\tR\aownerId\"\xba\x01\n" +
+	"\fCalendarFeed\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1c\n" +
+	"\townerType\x18\x02 \x01(\tR\townerType\x12\x18\n" +
+	"\aownerId\x18\x03 \x01(\tR\aownerId\x12\x1c\n" +
+	"\townerName\x18\x04 \x01(\tR\townerName\x12\x14\n" +
+	"\x05token\x18\x05 \x01(\tR\x05token\x12\x1c\n" +
+	"\tcreatedAt\x18\x06 \x01(\tR\tcreatedAt\x12\x10\n" +
+	"\x03url\x18\a \x01(\tR\x03url\"Q\n" +
+	"\x17GetCalendarFeedsRequest\x12\x1c\n" +
+	"\townerType\x18\x01 \x01(\tR\townerType\x12\x18\n" +
+	"\aownerId\x18\x02 \x01(\tR\aownerId\"I\n" +
+	"\x18GetCalendarFeedsResponse\x12-\n" +
+	"\x05feeds\x18\x01 \x03(\v2\x17.education.CalendarFeedR\x05feeds\"7\n" +
+	"\x1fGetCalendarFeedTimetableRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\"z\n" +
+	"\x15CalendarFeedTimetable\x12+\n" +
+	"\x04feed\x18\x01 \x01(\v2\x17.education.CalendarFeedR\x04feed\x124\n" +
+	"\alessons\x18\x02 \x03(\v2\x1a.education.TimetableLessonR\alessons\"\x91\x03\n" +
+	"\tAbsLesson\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x18\n" +
+	"\agroupId\x18\x02 \x01(\x03R\agroupId\x12\x1c\n" +
+	"\tgroupName\x18\x03 \x01(\tR\tgroupName\x12\x12\n" +
+	"\x04date\x18\x04 \x01(\tR\x04date\x12\x1a\n" +
+	"\brealDate\x18\x05 \x01(\tR\brealDate\x12\x1c\n" +
+	"\tteacherId\x18\x06 \x01(\tR\tteacherId\x12 \n" +
+	"\vteacherName\x18\a \x01(\tR\vteacherName\x12\x1e\n" +
+	"\n" +
+	"substitute\x18\b \x01(\bR\n" +
+	"substitute\x12\x14\n" +
+	"\x05topic\x18\t \x01(\tR\x05topic\x12\x14\n" +
+	"\x05notes\x18\n" +
+	" \x01(\tR\x05notes\x12\x1a\n" +
+	"\bhomework\x18\v \x01(\tR\bhomework\x12\x1c\n" +
+	"\tmaterials\x18\f \x03(\tR\tmaterials\x12(\n" +
+	"\x0fattendanceCount\x18\r \x01(\x05R\x0fattendanceCount\x12\x1c\n" +
+	"\tupdatedAt\x18\x0e \x01(\tR\tupdatedAt\"U\n" +
+	"\x11GetLessonsRequest\x12\x18\n" +
+	"\agroupId\x18\x01 \x01(\tR\agroupId\x12\x12\n" +
+	"\x04from\x18\x02 \x01(\tR\x04from\x12\x12\n" +
+	"\x04till\x18\x03 \x01(\tR\x04till\"D\n" +
+	"\x12GetLessonsResponse\x12.\n" +
+	"\alessons\x18\x01 \x03(\v2\x14.education.AbsLessonR\alessons\"\x81\x02\n" +
+	"\x13UpdateLessonRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
+	"\x05topic\x18\x02 \x01(\tR\x05topic\x12\x14\n" +
+	"\x05notes\x18\x03 \x01(\tR\x05notes\x12\x1a\n" +
+	"\bhomework\x18\x04 \x01(\tR\bhomework\x12\x1c\n" +
+	"\tmaterials\x18\x05 \x03(\tR\tmaterials\x120\n" +
+	"\x13substituteTeacherId\x18\x06 \x01(\tR\x13substituteTeacherId\x12\x1e\n" +
+	"\n" +
+	"actionById\x18\a \x01(\tR\n" +
+	"actionById\x12\"\n" +
+	"\factionByRole\x18\b \x01(\tR\factionByRole\"\x90\x02\n" +
 	"\x1fChangeUserBalanceHistoryRequest\x12\x1c\n" +
 	"\tstudentId\x18\x01 \x01(\tR\tstudentId\x12\x16\n" +
 	"\x06amount\x18\x02 \x01(\tR\x06amount\x12\x1c\n" +
@@ -7162,11 +14143,16 @@ const file_education_proto_rawDesc = "" +
 	"actionById\x18\x06 \x01(\tR\n" +
 	"actionById\x12\"\n" +
 	"\factionByName\x18\a \x01(\tR\factionByName\x12\x18\n" +
-	"\acomment\x18\b \x01(\tR\acomment\"U\n" +
+	"\acomment\x18\b \x01(\tR\acomment\"\xaf\x01\n" +
 	"\x15TransferLessonRequest\x12\x12\n" +
 	"\x04from\x18\x01 \x01(\tR\x04from\x12\x0e\n" +
 	"\x02to\x18\x02 \x01(\tR\x02to\x12\x18\n" +
-	"\agroupId\x18\x03 \x01(\tR\agroupId\"\x9c\x01\n" +
+	"\agroupId\x18\x03 \x01(\tR\agroupId\x12\x14\n" +
+	"\x05force\x18\x04 \x01(\bR\x05force\x12\x1e\n" +
+	"\n" +
+	"actionById\x18\x05 \x01(\tR\n" +
+	"actionById\x12\"\n" +
+	"\factionByName\x18\x06 \x01(\tR\factionByName\"\x9c\x01\n" +
 	"\x17GetHistoryGroupResponse\x129\n" +
 	"\fgroupHistory\x18\x01 \x03(\v2\x15.education.AbsHistoryR\fgroupHistory\x12F\n" +
 	"\x0fstudentsHistory\x18\x02 \x03(\v2\x1c.education.AbsStudentHistoryR\x0fstudentsHistory\"\xa6\x01\n" +
@@ -7279,13 +14265,22 @@ const file_education_proto_rawDesc = "" +
 	"\aaddress\x18\x06 \x01(\tR\aaddress\x12\x1e\n" +
 	"\n" +
 	"passportId\x18\a \x01(\tR\n" +
-	"passportId\"\x8e\x01\n" +
+	"passportId\"\xd2\x01\n" +
 	"\x11AddToGroupRequest\x12 \n" +
 	"\vcreatedDate\x18\x01 \x01(\tR\vcreatedDate\x12\x18\n" +
 	"\agroupId\x18\x02 \x01(\tR\agroupId\x12\x1f\n" +
 	"\vstudent_ids\x18\x03 \x03(\tR\n" +
 	"studentIds\x12\x1c\n" +
-	"\tcreatedBy\x18\x04 \x01(\tR\tcreatedBy\"\xc4\x02\n" +
+	"\tcreatedBy\x18\x04 \x01(\tR\tcreatedBy\x12\x1c\n" +
+	"\tpromoCode\x18\x05 \x01(\tR\tpromoCode\x12$\n" +
+	"\rcreatedByName\x18\x06 \x01(\tR\rcreatedByName\"~\n" +
+	"\x10AddToGroupResult\x12\x1c\n" +
+	"\tstudentId\x18\x01 \x01(\tR\tstudentId\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\x12\x18\n" +
+	"\amessage\x18\x03 \x01(\tR\amessage\x12\x1a\n" +
+	"\bposition\x18\x04 \x01(\x05R\bposition\"K\n" +
+	"\x12AddToGroupResponse\x125\n" +
+	"\aresults\x18\x01 \x03(\v2\x1b.education.AddToGroupResultR\aresults\"\xc4\x02\n" +
 	"\x16GetStudentByIdResponse\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12\x16\n" +
@@ -7323,7 +14318,35 @@ const file_education_proto_rawDesc = "" +
 	"\tcreatedAt\x18\x03 \x01(\tR\tcreatedAt\"E\n" +
 	"\x11CreateNoteRequest\x12\x12\n" +
 	"\x04note\x18\x01 \x01(\tR\x04note\x12\x1c\n" +
-	"\tstudentId\x18\x02 \x01(\tR\tstudentId\"g\n" +
+	"\tstudentId\x18\x02 \x01(\tR\tstudentId\"\xda\x01\n" +
+	"\x18SellLessonPackageRequest\x12\x1c\n" +
+	"\tstudentId\x18\x01 \x01(\tR\tstudentId\x12\x18\n" +
+	"\agroupId\x18\x02 \x01(\tR\agroupId\x12\x18\n" +
+	"\alessons\x18\x03 \x01(\x05R\alessons\x12\x14\n" +
+	"\x05price\x18\x04 \x01(\x01R\x05price\x12\x12\n" +
+	"\x04date\x18\x05 \x01(\tR\x04date\x12\x1e\n" +
+	"\n" +
+	"actionById\x18\x06 \x01(\tR\n" +
+	"actionById\x12\"\n" +
+	"\factionByName\x18\a \x01(\tR\factionByName\"R\n" +
+	"\x18GetLessonPackagesRequest\x12\x1c\n" +
+	"\tstudentId\x18\x01 \x01(\tR\tstudentId\x12\x18\n" +
+	"\agroupId\x18\x02 \x01(\tR\agroupId\"T\n" +
+	"\x19GetLessonPackagesResponse\x127\n" +
+	"\bpackages\x18\x01 \x03(\v2\x1b.education.AbsLessonPackageR\bpackages\"\xd2\x02\n" +
+	"\x10AbsLessonPackage\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1c\n" +
+	"\tstudentId\x18\x02 \x01(\tR\tstudentId\x12\x18\n" +
+	"\agroupId\x18\x03 \x01(\tR\agroupId\x12\x1c\n" +
+	"\tgroupName\x18\x04 \x01(\tR\tgroupName\x12\"\n" +
+	"\flessonsTotal\x18\x05 \x01(\x05R\flessonsTotal\x12 \n" +
+	"\vlessonsLeft\x18\x06 \x01(\x05R\vlessonsLeft\x12\x14\n" +
+	"\x05price\x18\a \x01(\x01R\x05price\x12 \n" +
+	"\vpurchasedAt\x18\b \x01(\tR\vpurchasedAt\x12\x1c\n" +
+	"\texpiresAt\x18\t \x01(\tR\texpiresAt\x12\x16\n" +
+	"\x06status\x18\n" +
+	" \x01(\tR\x06status\x12$\n" +
+	"\rcreatedByName\x18\v \x01(\tR\rcreatedByName\"g\n" +
 	"\x10GetSmsLogRequest\x125\n" +
 	"\vpageRequest\x18\x01 \x01(\v2\x13.common.PageRequestR\vpageRequest\x12\x1c\n" +
 	"\tstudentId\x18\x02 \x01(\tR\tstudentId\"\\\n" +
@@ -7404,14 +14427,19 @@ const file_education_proto_rawDesc = "" +
 	"\n" +
 	"UpdateRoom\x12\x12.education.AbsRoom\x1a\x13.common.AbsResponse\x12;\n" +
 	"\n" +
-	"DeleteRoom\x12\x18.common.DeleteAbsRequest\x1a\x13.common.AbsResponse2\xe7\x02\n" +
+	"DeleteRoom\x12\x18.common.DeleteAbsRequest\x1a\x13.common.AbsResponse2\x8c\x06\n" +
 	"\rCourseService\x12C\n" +
 	"\fCreateCourse\x12\x1e.education.CreateCourseRequest\x1a\x13.common.AbsResponse\x12C\n" +
 	"\n" +
 	"GetCourses\x12\x16.google.protobuf.Empty\x1a\x1d.education.GetUpdateCourseAbs\x12R\n" +
 	"\rGetCourseById\x12\x1f.education.GetCourseByIdRequest\x1a .education.GetCourseByIdResponse\x129\n" +
 	"\fUpdateCourse\x12\x14.education.AbsCourse\x1a\x13.common.AbsResponse\x12=\n" +
-	"\fDeleteCourse\x12\x18.common.DeleteAbsRequest\x1a\x13.common.AbsResponse2\x8a\x06\n" +
+	"\fDeleteCourse\x12\x18.common.DeleteAbsRequest\x1a\x13.common.AbsResponse\x12M\n" +
+	"\x10GetCourseBilling\x12\x1f.education.GetCourseByIdRequest\x1a\x18.education.CourseBilling\x12D\n" +
+	"\x13UpdateCourseBilling\x12\x18.education.CourseBilling\x1a\x13.common.AbsResponse\x12Q\n" +
+	"\x13ScheduleCoursePrice\x12%.education.ScheduleCoursePriceRequest\x1a\x13.common.AbsResponse\x12b\n" +
+	"\x15GetCoursePriceHistory\x12\x1f.education.GetCourseByIdRequest\x1a(.education.GetCoursePriceHistoryResponse\x12W\n" +
+	"\x16SetEnrollmentPriceLock\x12(.education.SetEnrollmentPriceLockRequest\x1a\x13.common.AbsResponse2\x9f\t\n" +
 	"\fGroupService\x12A\n" +
 	"\vCreateGroup\x12\x1d.education.CreateGroupRequest\x1a\x13.common.AbsResponse\x12F\n" +
 	"\tGetGroups\x12\x1b.education.GetGroupsRequest\x1a\x1c.education.GetGroupsResponse\x12N\n" +
@@ -7421,19 +14449,74 @@ const file_education_proto_rawDesc = "" +
 	"\vDeleteGroup\x12\x18.common.DeleteAbsRequest\x1a\x13.common.AbsResponse\x12e\n" +
 	"\x14GetGroupsByTeacherId\x12&.education.GetGroupsByTeacherIdRequest\x1a%.education.GetGroupsByTeacherResponse\x12i\n" +
 	"\x1dGetCommonInformationEducation\x12\x16.google.protobuf.Empty\x1a0.education.GetCommonInformationEducationResponse\x12p\n" +
-	"\x17GetLeftAfterTrialPeriod\x12).education.GetLeftAfterTrialPeriodRequest\x1a*.education.GetLeftAfterTrialPeriodResponse2\xa9\x02\n" +
+	"\x17GetLeftAfterTrialPeriod\x12).education.GetLeftAfterTrialPeriodRequest\x1a*.education.GetLeftAfterTrialPeriodResponse\x12W\n" +
+	"\x16UpdateGroupBillingMode\x12(.education.UpdateGroupBillingModeRequest\x1a\x13.common.AbsResponse\x12W\n" +
+	"\x16UpdateGroupMaxStudents\x12(.education.UpdateGroupMaxStudentsRequest\x1a\x13.common.AbsResponse\x12`\n" +
+	"\x16CheckScheduleConflicts\x12(.education.CheckScheduleConflictsRequest\x1a\x1c.education.ScheduleConflicts\x12\x7f\n" +
+	"\x1cGetScheduleConflictOverrides\x12..education.GetScheduleConflictOverridesRequest\x1a/.education.GetScheduleConflictOverridesResponse2\x8c\x03\n" +
 	"\x11AttendanceService\x12R\n" +
 	"\rGetAttendance\x12\x1f.education.GetAttendanceRequest\x1a .education.GetAttendanceResponse\x12E\n" +
-	"\rSetAttendance\x12\x1f.education.SetAttendanceRequest\x1a\x13.common.AbsResponse\x12y\n" +
-	"\"CalculateTeacherSalaryByAttendance\x12(.education.CalculateTeacherSalaryRequest\x1a).education.CalculateTeacherSalaryResponse2\xc4\n" +
+	"\rSetAttendance\x12\x1f.education.SetAttendanceRequest\x1a\x13.common.AbsResponse\x12a\n" +
+	"\x12SetGroupAttendance\x12$.education.SetGroupAttendanceRequest\x1a%.education.SetGroupAttendanceResponse\x12y\n" +
+	"\"CalculateTeacherSalaryByAttendance\x12(.education.CalculateTeacherSalaryRequest\x1a).education.CalculateTeacherSalaryResponse2\xec\x02\n" +
+	"\x17AttendanceStatusService\x12M\n" +
+	"\x16CreateAttendanceStatus\x12\x1e.education.AbsAttendanceStatus\x1a\x13.common.AbsResponse\x12M\n" +
+	"\x16UpdateAttendanceStatus\x12\x1e.education.AbsAttendanceStatus\x1a\x13.common.AbsResponse\x12G\n" +
+	"\x16DeleteAttendanceStatus\x12\x18.common.DeleteAbsRequest\x1a\x13.common.AbsResponse\x12j\n" +
+	"\x15GetAttendanceStatuses\x12'.education.GetAttendanceStatusesRequest\x1a(.education.GetAttendanceStatusesResponse2\xe3\x03\n" +
+	"\x17AttendancePolicyService\x12J\n" +
+	"\x13GetAttendancePolicy\x12\x16.google.protobuf.Empty\x1a\x1b.education.AttendancePolicy\x12J\n" +
+	"\x16UpdateAttendancePolicy\x12\x1b.education.AttendancePolicy\x1a\x13.common.AbsResponse\x12Y\n" +
+	"\x17RequestAttendanceUnlock\x12).education.RequestAttendanceUnlockRequest\x1a\x13.common.AbsResponse\x12|\n" +
+	"\x1bGetAttendanceUnlockRequests\x12-.education.GetAttendanceUnlockRequestsRequest\x1a..education.GetAttendanceUnlockRequestsResponse\x12W\n" +
+	"\x16ReviewAttendanceUnlock\x12(.education.ReviewAttendanceUnlockRequest\x1a\x13.common.AbsResponse2\xfe\x04\n" +
+	"\x0eCheckInService\x12S\n" +
+	"\x14SendStudentLoginCode\x12&.education.SendStudentLoginCodeRequest\x1a\x13.common.AbsResponse\x12m\n" +
+	"\x16VerifyStudentLoginCode\x12(.education.VerifyStudentLoginCodeRequest\x1a).education.VerifyStudentLoginCodeResponse\x12]\n" +
+	"\x16ValidateStudentSession\x12(.education.ValidateStudentSessionRequest\x1a\x19.education.StudentSession\x12J\n" +
+	"\x0eGetCheckInCode\x12 .education.GetCheckInCodeRequest\x1a\x16.education.CheckInCode\x12@\n" +
+	"\aCheckIn\x12\x19.education.CheckInRequest\x1a\x1a.education.CheckInResponse\x12^\n" +
+	"\x11GetLessonCheckIns\x12#.education.GetLessonCheckInsRequest\x1a$.education.GetLessonCheckInsResponse\x12[\n" +
+	"\x0fConfirmCheckIns\x12!.education.ConfirmCheckInsRequest\x1a%.education.SetGroupAttendanceResponse2\xaa\x04\n" +
+	"\x1aAttendanceAnalyticsService\x12a\n" +
+	"\x12GetAttendanceRates\x12$.education.GetAttendanceRatesRequest\x1a%.education.GetAttendanceRatesResponse\x12^\n" +
+	"\x11GetAbsenceStreaks\x12#.education.GetAbsenceStreaksRequest\x1a$.education.GetAbsenceStreaksResponse\x12a\n" +
+	"\x12GetAttendanceTrend\x12$.education.GetAttendanceTrendRequest\x1a%.education.GetAttendanceTrendResponse\x12B\n" +
+	"\x0fGetAtRiskPolicy\x12\x16.google.protobuf.Empty\x1a\x17.education.AtRiskPolicy\x12B\n" +
+	"\x12UpdateAtRiskPolicy\x12\x17.education.AtRiskPolicy\x1a\x13.common.AbsResponse\x12^\n" +
+	"\x11GetAtRiskStudents\x12#.education.GetAtRiskStudentsRequest\x1a$.education.GetAtRiskStudentsResponse2\xc9\x01\n" +
+	"\x13NotificationService\x12[\n" +
+	"\x10GetNotifications\x12\".education.GetNotificationsRequest\x1a#.education.GetNotificationsResponse\x12U\n" +
+	"\x15MarkNotificationsRead\x12'.education.MarkNotificationsReadRequest\x1a\x13.common.AbsResponse2\xec\x02\n" +
+	"\x14GroupWaitlistService\x12[\n" +
+	"\x10GetGroupWaitlist\x12\".education.GetGroupWaitlistRequest\x1a#.education.GetGroupWaitlistResponse\x12M\n" +
+	"\x11MoveWaitlistEntry\x12#.education.MoveWaitlistEntryRequest\x1a\x13.common.AbsResponse\x12K\n" +
+	"\x13CancelWaitlistEntry\x12\x1f.education.WaitlistEntryRequest\x1a\x13.common.AbsResponse\x12[\n" +
+	"\x13AcceptWaitlistOffer\x12%.education.AcceptWaitlistOfferRequest\x1a\x1d.education.AddToGroupResponse2\xf5\x02\n" +
+	"\x0eHolidayService\x12;\n" +
+	"\rCreateHoliday\x12\x15.education.AbsHoliday\x1a\x13.common.AbsResponse\x12;\n" +
+	"\rUpdateHoliday\x12\x15.education.AbsHoliday\x1a\x13.common.AbsResponse\x12>\n" +
+	"\rDeleteHoliday\x12\x18.common.DeleteAbsRequest\x1a\x13.common.AbsResponse\x12L\n" +
+	"\vGetHolidays\x12\x1d.education.GetHolidaysRequest\x1a\x1e.education.GetHolidaysResponse\x12[\n" +
+	"\x10GetGroupHolidays\x12\".education.GetGroupHolidaysRequest\x1a#.education.GetGroupHolidaysResponse2\xc4\x03\n" +
+	"\x10TimetableService\x12O\n" +
+	"\fGetTimetable\x12\x1e.education.GetTimetableRequest\x1a\x1f.education.GetTimetableResponse\x12S\n" +
+	"\x12CreateCalendarFeed\x12$.education.CreateCalendarFeedRequest\x1a\x17.education.CalendarFeed\x12[\n" +
+	"\x10GetCalendarFeeds\x12\".education.GetCalendarFeedsRequest\x1a#.education.GetCalendarFeedsResponse\x12C\n" +
+	"\x12RevokeCalendarFeed\x12\x18.common.DeleteAbsRequest\x1a\x13.common.AbsResponse\x12h\n" +
+	"\x18GetCalendarFeedTimetable\x12*.education.GetCalendarFeedTimetableRequest\x1a .education.CalendarFeedTimetable2\xe1\x01\n" +
+	"\rLessonService\x12I\n" +
 	"\n" +
+	"GetLessons\x12\x1c.education.GetLessonsRequest\x1a\x1d.education.GetLessonsResponse\x12?\n" +
+	"\rGetLessonById\x12\x18.common.DeleteAbsRequest\x1a\x14.education.AbsLesson\x12D\n" +
+	"\fUpdateLesson\x12\x1e.education.UpdateLessonRequest\x1a\x14.education.AbsLesson2\xfd\v\n" +
 	"\x0eStudentService\x12R\n" +
 	"\rGetAllStudent\x12\x1f.education.GetAllStudentRequest\x1a .education.GetAllStudentResponse\x12E\n" +
 	"\rCreateStudent\x12\x1f.education.CreateStudentRequest\x1a\x13.common.AbsResponse\x12E\n" +
 	"\rUpdateStudent\x12\x1f.education.UpdateStudentRequest\x1a\x13.common.AbsResponse\x12E\n" +
-	"\rDeleteStudent\x12\x1f.education.DeleteStudentRequest\x1a\x13.common.AbsResponse\x12?\n" +
+	"\rDeleteStudent\x12\x1f.education.DeleteStudentRequest\x1a\x13.common.AbsResponse\x12I\n" +
 	"\n" +
-	"AddToGroup\x12\x1c.education.AddToGroupRequest\x1a\x13.common.AbsResponse\x12W\n" +
+	"AddToGroup\x12\x1c.education.AddToGroupRequest\x1a\x1d.education.AddToGroupResponse\x12W\n" +
 	"\x0eGetStudentById\x12\".education.NoteStudentByAbsRequest\x1a!.education.GetStudentByIdResponse\x12T\n" +
 	"\x10GetNoteByStudent\x12\".education.NoteStudentByAbsRequest\x1a\x1c.education.GetNotesByStudent\x12I\n" +
 	"\x14CreateNoteForStudent\x12\x1c.education.CreateNoteRequest\x1a\x13.common.AbsResponse\x12L\n" +
@@ -7444,7 +14527,9 @@ const file_education_proto_rawDesc = "" +
 	"\x12TransferLessonDate\x12 .education.TransferLessonRequest\x1a\x13.common.AbsResponse\x12W\n" +
 	"\x16ChangeConditionStudent\x12(.education.ChangeConditionStudentRequest\x1a\x13.common.AbsResponse\x12g\n" +
 	"\x14GetStudentsByGroupId\x12&.education.GetStudentsByGroupIdRequest\x1a'.education.GetStudentsByGroupIdResponse\x12[\n" +
-	"\x18ChangeUserBalanceHistory\x12*.education.ChangeUserBalanceHistoryRequest\x1a\x13.common.AbsResponse2\x91\x04\n" +
+	"\x18ChangeUserBalanceHistory\x12*.education.ChangeUserBalanceHistoryRequest\x1a\x13.common.AbsResponse\x12M\n" +
+	"\x11SellLessonPackage\x12#.education.SellLessonPackageRequest\x1a\x13.common.AbsResponse\x12^\n" +
+	"\x11GetLessonPackages\x12#.education.GetLessonPackagesRequest\x1a$.education.GetLessonPackagesResponse2\x91\x04\n" +
 	"\n" +
 	"SmsService\x12G\n" +
 	"\n" +
@@ -7468,7 +14553,7 @@ func file_education_proto_rawDescGZIP() []byte {
 	return file_education_proto_rawDescData
 }
 
-var file_education_proto_msgTypes = make([]protoimpl.MessageInfo, 89)
+var file_education_proto_msgTypes = make([]protoimpl.MessageInfo, 176)
 var file_education_proto_goTypes = []any{
 	(*GetStatisticResponse)(nil),                  // 0: education.GetStatisticResponse
 	(*OtherDetails)(nil),                          // 1: education.OtherDetails
@@ -7494,81 +14579,168 @@ var file_education_proto_goTypes = []any{
 	(*AbsCourse)(nil),                             // 21: education.AbsCourse
 	(*GetCourseByIdResponse)(nil),                 // 22: education.GetCourseByIdResponse
 	(*GetCourseByIdRequest)(nil),                  // 23: education.GetCourseByIdRequest
-	(*GetLeftAfterTrialPeriodRequest)(nil),        // 24: education.GetLeftAfterTrialPeriodRequest
-	(*GetLeftAfterTrialPeriodResponse)(nil),       // 25: education.GetLeftAfterTrialPeriodResponse
-	(*AbsGetLeftAfter)(nil),                       // 26: education.AbsGetLeftAfter
-	(*GetCommonInformationEducationResponse)(nil), // 27: education.GetCommonInformationEducationResponse
-	(*GetGroupsByTeacherIdRequest)(nil),           // 28: education.GetGroupsByTeacherIdRequest
-	(*GetGroupsByTeacherResponse)(nil),            // 29: education.GetGroupsByTeacherResponse
-	(*GetGroupByTeacherAbs)(nil),                  // 30: education.GetGroupByTeacherAbs
-	(*CreateGroupRequest)(nil),                    // 31: education.CreateGroupRequest
-	(*GetGroupByIdRequest)(nil),                   // 32: education.GetGroupByIdRequest
-	(*GetUpdateGroupAbs)(nil),                     // 33: education.GetUpdateGroupAbs
-	(*GetGroupsByCourseResponse)(nil),             // 34: education.GetGroupsByCourseResponse
-	(*GetGroupByCourseAbsResponse)(nil),           // 35: education.GetGroupByCourseAbsResponse
-	(*GetGroupAbsResponse)(nil),                   // 36: education.GetGroupAbsResponse
-	(*GetGroupsResponse)(nil),                     // 37: education.GetGroupsResponse
-	(*GetGroupsRequest)(nil),                      // 38: education.GetGroupsRequest
-	(*CalculateTeacherSalaryRequest)(nil),         // 39: education.CalculateTeacherSalaryRequest
-	(*CalculateTeacherSalaryResponse)(nil),        // 40: education.CalculateTeacherSalaryResponse
-	(*AbsCalculateSalary)(nil),                    // 41: education.AbsCalculateSalary
-	(*StudentSalary)(nil),                         // 42: education.StudentSalary
-	(*GetAttendanceRequest)(nil),                  // 43: education.GetAttendanceRequest
-	(*GetAttendanceResponse)(nil),                 // 44: education.GetAttendanceResponse
-	(*Day)(nil),                                   // 45: education.Day
-	(*Student)(nil),                               // 46: education.Student
-	(*Attendance)(nil),                            // 47: education.Attendance
-	(*FreezeDetail)(nil),                          // 48: education.FreezeDetail
-	(*SetAttendanceRequest)(nil),                  // 49: education.SetAttendanceRequest
-	(*ChangeUserBalanceHistoryRequest)(nil),       // 50: education.ChangeUserBalanceHistoryRequest
-	(*DeleteStudentRequest)(nil),                  // 51: education.DeleteStudentRequest
-	(*GetStudentsByGroupIdResponse)(nil),          // 52: education.GetStudentsByGroupIdResponse
-	(*GetStudentsByGroupIdRequest)(nil),           // 53: education.GetStudentsByGroupIdRequest
-	(*ChangeConditionStudentRequest)(nil),         // 54: education.ChangeConditionStudentRequest
-	(*TransferLessonRequest)(nil),                 // 55: education.TransferLessonRequest
-	(*GetHistoryGroupResponse)(nil),               // 56: education.GetHistoryGroupResponse
-	(*GetHistoryStudentResponse)(nil),             // 57: education.GetHistoryStudentResponse
-	(*AbsStudentHistory)(nil),                     // 58: education.AbsStudentHistory
-	(*AbsGroup)(nil),                              // 59: education.AbsGroup
-	(*AbsHistory)(nil),                            // 60: education.AbsHistory
-	(*SearchStudentRequest)(nil),                  // 61: education.SearchStudentRequest
-	(*SearchStudentResponse)(nil),                 // 62: education.SearchStudentResponse
-	(*AbsStudent)(nil),                            // 63: education.AbsStudent
-	(*GetAllStudentRequest)(nil),                  // 64: education.GetAllStudentRequest
-	(*GetAllStudentResponse)(nil),                 // 65: education.GetAllStudentResponse
-	(*GetGroupsAbsForStudent)(nil),                // 66: education.GetGroupsAbsForStudent
-	(*GroupGetAllStudentAbs)(nil),                 // 67: education.GroupGetAllStudentAbs
-	(*CreateStudentRequest)(nil),                  // 68: education.CreateStudentRequest
-	(*UpdateStudentRequest)(nil),                  // 69: education.UpdateStudentRequest
-	(*AddToGroupRequest)(nil),                     // 70: education.AddToGroupRequest
-	(*GetStudentByIdResponse)(nil),                // 71: education.GetStudentByIdResponse
-	(*NoteStudentByAbsRequest)(nil),               // 72: education.NoteStudentByAbsRequest
-	(*GetGroupStudent)(nil),                       // 73: education.GetGroupStudent
-	(*GetNotesByStudent)(nil),                     // 74: education.GetNotesByStudent
-	(*AbsNote)(nil),                               // 75: education.AbsNote
-	(*CreateNoteRequest)(nil),                     // 76: education.CreateNoteRequest
-	(*GetSmsLogRequest)(nil),                      // 77: education.GetSmsLogRequest
-	(*GetSmsLogResponse)(nil),                     // 78: education.GetSmsLogResponse
-	(*SmsLogList)(nil),                            // 79: education.SmsLogList
-	(*AddSmsRequest)(nil),                         // 80: education.AddSmsRequest
-	(*GetSmsTransactionDetailResponse)(nil),       // 81: education.GetSmsTransactionDetailResponse
-	(*GetSmsTransactionList)(nil),                 // 82: education.GetSmsTransactionList
-	(*GetSmsTemplateRequest)(nil),                 // 83: education.GetSmsTemplateRequest
-	(*GetSmsTemplateResponse)(nil),                // 84: education.GetSmsTemplateResponse
-	(*SmsTemplateList)(nil),                       // 85: education.SmsTemplateList
-	(*SetSmsTemplateRequest)(nil),                 // 86: education.SetSmsTemplateRequest
-	(*SendSmsDirectlyRequest)(nil),                // 87: education.SendSmsDirectlyRequest
-	nil,                                           // 88: education.OtherDetails.DetailsEntry
-	(*PageRequest)(nil),                           // 89: common.PageRequest
-	(*emptypb.Empty)(nil),                         // 90: google.protobuf.Empty
-	(*DeleteAbsRequest)(nil),                      // 91: common.DeleteAbsRequest
-	(*AbsResponse)(nil),                           // 92: common.AbsResponse
+	(*CourseBilling)(nil),                         // 24: education.CourseBilling
+	(*ScheduleCoursePriceRequest)(nil),            // 25: education.ScheduleCoursePriceRequest
+	(*CoursePriceVersion)(nil),                    // 26: education.CoursePriceVersion
+	(*GetCoursePriceHistoryResponse)(nil),         // 27: education.GetCoursePriceHistoryResponse
+	(*EnrollmentPriceLock)(nil),                   // 28: education.EnrollmentPriceLock
+	(*SetEnrollmentPriceLockRequest)(nil),         // 29: education.SetEnrollmentPriceLockRequest
+	(*GetLeftAfterTrialPeriodRequest)(nil),        // 30: education.GetLeftAfterTrialPeriodRequest
+	(*GetLeftAfterTrialPeriodResponse)(nil),       // 31: education.GetLeftAfterTrialPeriodResponse
+	(*AbsGetLeftAfter)(nil),                       // 32: education.AbsGetLeftAfter
+	(*GetCommonInformationEducationResponse)(nil), // 33: education.GetCommonInformationEducationResponse
+	(*GetGroupsByTeacherIdRequest)(nil),           // 34: education.GetGroupsByTeacherIdRequest
+	(*GetGroupsByTeacherResponse)(nil),            // 35: education.GetGroupsByTeacherResponse
+	(*GetGroupByTeacherAbs)(nil),                  // 36: education.GetGroupByTeacherAbs
+	(*CreateGroupRequest)(nil),                    // 37: education.CreateGroupRequest
+	(*GetGroupByIdRequest)(nil),                   // 38: education.GetGroupByIdRequest
+	(*GetUpdateGroupAbs)(nil),                     // 39: education.GetUpdateGroupAbs
+	(*GetGroupsByCourseResponse)(nil),             // 40: education.GetGroupsByCourseResponse
+	(*GetGroupByCourseAbsResponse)(nil),           // 41: education.GetGroupByCourseAbsResponse
+	(*GetGroupAbsResponse)(nil),                   // 42: education.GetGroupAbsResponse
+	(*GetGroupsResponse)(nil),                     // 43: education.GetGroupsResponse
+	(*GetGroupsRequest)(nil),                      // 44: education.GetGroupsRequest
+	(*UpdateGroupBillingModeRequest)(nil),         // 45: education.UpdateGroupBillingModeRequest
+	(*UpdateGroupMaxStudentsRequest)(nil),         // 46: education.UpdateGroupMaxStudentsRequest
+	(*ScheduleConflict)(nil),                      // 47: education.ScheduleConflict
+	(*ScheduleConflicts)(nil),                     // 48: education.ScheduleConflicts
+	(*CheckScheduleConflictsRequest)(nil),         // 49: education.CheckScheduleConflictsRequest
+	(*GetScheduleConflictOverridesRequest)(nil),   // 50: education.GetScheduleConflictOverridesRequest
+	(*ScheduleConflictOverride)(nil),              // 51: education.ScheduleConflictOverride
+	(*GetScheduleConflictOverridesResponse)(nil),  // 52: education.GetScheduleConflictOverridesResponse
+	(*CalculateTeacherSalaryRequest)(nil),         // 53: education.CalculateTeacherSalaryRequest
+	(*CalculateTeacherSalaryResponse)(nil),        // 54: education.CalculateTeacherSalaryResponse
+	(*AbsCalculateSalary)(nil),                    // 55: education.AbsCalculateSalary
+	(*StudentSalary)(nil),                         // 56: education.StudentSalary
+	(*GetAttendanceRequest)(nil),                  // 57: education.GetAttendanceRequest
+	(*GetAttendanceResponse)(nil),                 // 58: education.GetAttendanceResponse
+	(*Day)(nil),                                   // 59: education.Day
+	(*Student)(nil),                               // 60: education.Student
+	(*Attendance)(nil),                            // 61: education.Attendance
+	(*FreezeDetail)(nil),                          // 62: education.FreezeDetail
+	(*SetAttendanceRequest)(nil),                  // 63: education.SetAttendanceRequest
+	(*SetGroupAttendanceRequest)(nil),             // 64: education.SetGroupAttendanceRequest
+	(*GroupAttendanceMark)(nil),                   // 65: education.GroupAttendanceMark
+	(*GroupAttendanceResult)(nil),                 // 66: education.GroupAttendanceResult
+	(*SetGroupAttendanceResponse)(nil),            // 67: education.SetGroupAttendanceResponse
+	(*AbsAttendanceStatus)(nil),                   // 68: education.AbsAttendanceStatus
+	(*GetAttendanceStatusesRequest)(nil),          // 69: education.GetAttendanceStatusesRequest
+	(*GetAttendanceStatusesResponse)(nil),         // 70: education.GetAttendanceStatusesResponse
+	(*AttendancePolicy)(nil),                      // 71: education.AttendancePolicy
+	(*RequestAttendanceUnlockRequest)(nil),        // 72: education.RequestAttendanceUnlockRequest
+	(*AttendanceUnlockRequest)(nil),               // 73: education.AttendanceUnlockRequest
+	(*GetAttendanceUnlockRequestsRequest)(nil),    // 74: education.GetAttendanceUnlockRequestsRequest
+	(*GetAttendanceUnlockRequestsResponse)(nil),   // 75: education.GetAttendanceUnlockRequestsResponse
+	(*ReviewAttendanceUnlockRequest)(nil),         // 76: education.ReviewAttendanceUnlockRequest
+	(*SendStudentLoginCodeRequest)(nil),           // 77: education.SendStudentLoginCodeRequest
+	(*VerifyStudentLoginCodeRequest)(nil),         // 78: education.VerifyStudentLoginCodeRequest
+	(*StudentSession)(nil),                        // 79: education.StudentSession
+	(*VerifyStudentLoginCodeResponse)(nil),        // 80: education.VerifyStudentLoginCodeResponse
+	(*ValidateStudentSessionRequest)(nil),         // 81: education.ValidateStudentSessionRequest
+	(*GetCheckInCodeRequest)(nil),                 // 82: education.GetCheckInCodeRequest
+	(*CheckInCode)(nil),                           // 83: education.CheckInCode
+	(*CheckInRequest)(nil),                        // 84: education.CheckInRequest
+	(*CheckInResponse)(nil),                       // 85: education.CheckInResponse
+	(*GetLessonCheckInsRequest)(nil),              // 86: education.GetLessonCheckInsRequest
+	(*LessonCheckIn)(nil),                         // 87: education.LessonCheckIn
+	(*GetLessonCheckInsResponse)(nil),             // 88: education.GetLessonCheckInsResponse
+	(*ConfirmCheckInsRequest)(nil),                // 89: education.ConfirmCheckInsRequest
+	(*GetAttendanceRatesRequest)(nil),             // 90: education.GetAttendanceRatesRequest
+	(*AttendanceRate)(nil),                        // 91: education.AttendanceRate
+	(*GetAttendanceRatesResponse)(nil),            // 92: education.GetAttendanceRatesResponse
+	(*GetAbsenceStreaksRequest)(nil),              // 93: education.GetAbsenceStreaksRequest
+	(*AbsenceStreak)(nil),                         // 94: education.AbsenceStreak
+	(*GetAbsenceStreaksResponse)(nil),             // 95: education.GetAbsenceStreaksResponse
+	(*GetAttendanceTrendRequest)(nil),             // 96: education.GetAttendanceTrendRequest
+	(*AttendanceTrendPoint)(nil),                  // 97: education.AttendanceTrendPoint
+	(*GetAttendanceTrendResponse)(nil),            // 98: education.GetAttendanceTrendResponse
+	(*AtRiskPolicy)(nil),                          // 99: education.AtRiskPolicy
+	(*GetAtRiskStudentsRequest)(nil),              // 100: education.GetAtRiskStudentsRequest
+	(*AtRiskStudent)(nil),                         // 101: education.AtRiskStudent
+	(*GetAtRiskStudentsResponse)(nil),             // 102: education.GetAtRiskStudentsResponse
+	(*GetNotificationsRequest)(nil),               // 103: education.GetNotificationsRequest
+	(*Notification)(nil),                          // 104: education.Notification
+	(*GetNotificationsResponse)(nil),              // 105: education.GetNotificationsResponse
+	(*MarkNotificationsReadRequest)(nil),          // 106: education.MarkNotificationsReadRequest
+	(*GetGroupWaitlistRequest)(nil),               // 107: education.GetGroupWaitlistRequest
+	(*WaitlistEntry)(nil),                         // 108: education.WaitlistEntry
+	(*GetGroupWaitlistResponse)(nil),              // 109: education.GetGroupWaitlistResponse
+	(*MoveWaitlistEntryRequest)(nil),              // 110: education.MoveWaitlistEntryRequest
+	(*WaitlistEntryRequest)(nil),                  // 111: education.WaitlistEntryRequest
+	(*AcceptWaitlistOfferRequest)(nil),            // 112: education.AcceptWaitlistOfferRequest
+	(*AbsHoliday)(nil),                            // 113: education.AbsHoliday
+	(*GetHolidaysRequest)(nil),                    // 114: education.GetHolidaysRequest
+	(*GetHolidaysResponse)(nil),                   // 115: education.GetHolidaysResponse
+	(*GetGroupHolidaysRequest)(nil),               // 116: education.GetGroupHolidaysRequest
+	(*GetGroupHolidaysResponse)(nil),              // 117: education.GetGroupHolidaysResponse
+	(*GetTimetableRequest)(nil),                   // 118: education.GetTimetableRequest
+	(*TimetableLesson)(nil),                       // 119: education.TimetableLesson
+	(*GetTimetableResponse)(nil),                  // 120: education.GetTimetableResponse
+	(*CreateCalendarFeedRequest)(nil),             // 121: education.CreateCalendarFeedRequest
+	(*CalendarFeed)(nil),                          // 122: education.CalendarFeed
+	(*GetCalendarFeedsRequest)(nil),               // 123: education.GetCalendarFeedsRequest
+	(*GetCalendarFeedsResponse)(nil),              // 124: education.GetCalendarFeedsResponse
+	(*GetCalendarFeedTimetableRequest)(nil),       // 125: education.GetCalendarFeedTimetableRequest
+	(*CalendarFeedTimetable)(nil),                 // 126: education.CalendarFeedTimetable
+	(*AbsLesson)(nil),                             // 127: education.AbsLesson
+	(*GetLessonsRequest)(nil),                     // 128: education.GetLessonsRequest
+	(*GetLessonsResponse)(nil),                    // 129: education.GetLessonsResponse
+	(*UpdateLessonRequest)(nil),                   // 130: education.UpdateLessonRequest
+	(*ChangeUserBalanceHistoryRequest)(nil),       // 131: education.ChangeUserBalanceHistoryRequest
+	(*DeleteStudentRequest)(nil),                  // 132: education.DeleteStudentRequest
+	(*GetStudentsByGroupIdResponse)(nil),          // 133: education.GetStudentsByGroupIdResponse
+	(*GetStudentsByGroupIdRequest)(nil),           // 134: education.GetStudentsByGroupIdRequest
+	(*ChangeConditionStudentRequest)(nil),         // 135: education.ChangeConditionStudentRequest
+	(*TransferLessonRequest)(nil),                 // 136: education.TransferLessonRequest
+	(*GetHistoryGroupResponse)(nil),               // 137: education.GetHistoryGroupResponse
+	(*GetHistoryStudentResponse)(nil),             // 138: education.GetHistoryStudentResponse
+	(*AbsStudentHistory)(nil),                     // 139: education.AbsStudentHistory
+	(*AbsGroup)(nil),                              // 140: education.AbsGroup
+	(*AbsHistory)(nil),                            // 141: education.AbsHistory
+	(*SearchStudentRequest)(nil),                  // 142: education.SearchStudentRequest
+	(*SearchStudentResponse)(nil),                 // 143: education.SearchStudentResponse
+	(*AbsStudent)(nil),                            // 144: education.AbsStudent
+	(*GetAllStudentRequest)(nil),                  // 145: education.GetAllStudentRequest
+	(*GetAllStudentResponse)(nil),                 // 146: education.GetAllStudentResponse
+	(*GetGroupsAbsForStudent)(nil),                // 147: education.GetGroupsAbsForStudent
+	(*GroupGetAllStudentAbs)(nil),                 // 148: education.GroupGetAllStudentAbs
+	(*CreateStudentRequest)(nil),                  // 149: education.CreateStudentRequest
+	(*UpdateStudentRequest)(nil),                  // 150: education.UpdateStudentRequest
+	(*AddToGroupRequest)(nil),                     // 151: education.AddToGroupRequest
+	(*AddToGroupResult)(nil),                      // 152: education.AddToGroupResult
+	(*AddToGroupResponse)(nil),                    // 153: education.AddToGroupResponse
+	(*GetStudentByIdResponse)(nil),                // 154: education.GetStudentByIdResponse
+	(*NoteStudentByAbsRequest)(nil),               // 155: education.NoteStudentByAbsRequest
+	(*GetGroupStudent)(nil),                       // 156: education.GetGroupStudent
+	(*GetNotesByStudent)(nil),                     // 157: education.GetNotesByStudent
+	(*AbsNote)(nil),                               // 158: education.AbsNote
+	(*CreateNoteRequest)(nil),                     // 159: education.CreateNoteRequest
+	(*SellLessonPackageRequest)(nil),              // 160: education.SellLessonPackageRequest
+	(*GetLessonPackagesRequest)(nil),              // 161: education.GetLessonPackagesRequest
+	(*GetLessonPackagesResponse)(nil),             // 162: education.GetLessonPackagesResponse
+	(*AbsLessonPackage)(nil),                      // 163: education.AbsLessonPackage
+	(*GetSmsLogRequest)(nil),                      // 164: education.GetSmsLogRequest
+	(*GetSmsLogResponse)(nil),                     // 165: education.GetSmsLogResponse
+	(*SmsLogList)(nil),                            // 166: education.SmsLogList
+	(*AddSmsRequest)(nil),                         // 167: education.AddSmsRequest
+	(*GetSmsTransactionDetailResponse)(nil),       // 168: education.GetSmsTransactionDetailResponse
+	(*GetSmsTransactionList)(nil),                 // 169: education.GetSmsTransactionList
+	(*GetSmsTemplateRequest)(nil),                 // 170: education.GetSmsTemplateRequest
+	(*GetSmsTemplateResponse)(nil),                // 171: education.GetSmsTemplateResponse
+	(*SmsTemplateList)(nil),                       // 172: education.SmsTemplateList
+	(*SetSmsTemplateRequest)(nil),                 // 173: education.SetSmsTemplateRequest
+	(*SendSmsDirectlyRequest)(nil),                // 174: education.SendSmsDirectlyRequest
+	nil,                                           // 175: education.OtherDetails.DetailsEntry
+	(*PageRequest)(nil),                           // 176: common.PageRequest
+	(*emptypb.Empty)(nil),                         // 177: google.protobuf.Empty
+	(*DeleteAbsRequest)(nil),                      // 178: common.DeleteAbsRequest
+	(*AbsResponse)(nil),                           // 179: common.AbsResponse
 }
 var file_education_proto_depIdxs = []int32{
 	2,   // 0: education.GetStatisticResponse.details:type_name -> education.CompanyCommonDetails
 	1,   // 1: education.GetStatisticResponse.registerDetails:type_name -> education.OtherDetails
 	1,   // 2: education.GetStatisticResponse.paymentDetails:type_name -> education.OtherDetails
-	88,  // 3: education.OtherDetails.details:type_name -> education.OtherDetails.DetailsEntry
+	175, // 3: education.OtherDetails.details:type_name -> education.OtherDetails.DetailsEntry
 	8,   // 4: education.GetAllResponse.items:type_name -> education.GetCompanyResponse
 	9,   // 5: education.GetCompanyResponse.tariff:type_name -> education.Tariff
 	9,   // 6: education.TariffList.items:type_name -> education.Tariff
@@ -7576,161 +14748,292 @@ var file_education_proto_depIdxs = []int32{
 	15,  // 8: education.CompanyFinanceList.items:type_name -> education.CompanyFinanceForList
 	18,  // 9: education.GetUpdateRoomAbs.rooms:type_name -> education.AbsRoom
 	21,  // 10: education.GetUpdateCourseAbs.courses:type_name -> education.AbsCourse
-	26,  // 11: education.GetLeftAfterTrialPeriodResponse.items:type_name -> education.AbsGetLeftAfter
-	30,  // 12: education.GetGroupsByTeacherResponse.groups:type_name -> education.GetGroupByTeacherAbs
-	63,  // 13: education.GetGroupByTeacherAbs.students:type_name -> education.AbsStudent
-	35,  // 14: education.GetGroupsByCourseResponse.groups:type_name -> education.GetGroupByCourseAbsResponse
-	21,  // 15: education.GetGroupAbsResponse.course:type_name -> education.AbsCourse
-	18,  // 16: education.GetGroupAbsResponse.room:type_name -> education.AbsRoom
-	36,  // 17: education.GetGroupsResponse.groups:type_name -> education.GetGroupAbsResponse
-	89,  // 18: education.GetGroupsRequest.page:type_name -> common.PageRequest
-	41,  // 19: education.CalculateTeacherSalaryResponse.salaries:type_name -> education.AbsCalculateSalary
-	42,  // 20: education.AbsCalculateSalary.salaries:type_name -> education.StudentSalary
-	45,  // 21: education.GetAttendanceResponse.days:type_name -> education.Day
-	46,  // 22: education.GetAttendanceResponse.students:type_name -> education.Student
-	47,  // 23: education.Student.attendance:type_name -> education.Attendance
-	48,  // 24: education.Student.freezeDetail:type_name -> education.FreezeDetail
-	63,  // 25: education.GetStudentsByGroupIdResponse.students:type_name -> education.AbsStudent
-	60,  // 26: education.GetHistoryGroupResponse.groupHistory:type_name -> education.AbsHistory
-	58,  // 27: education.GetHistoryGroupResponse.studentsHistory:type_name -> education.AbsStudentHistory
-	60,  // 28: education.GetHistoryStudentResponse.studentHistory:type_name -> education.AbsHistory
-	58,  // 29: education.GetHistoryStudentResponse.conditionsHistory:type_name -> education.AbsStudentHistory
-	63,  // 30: education.AbsStudentHistory.student:type_name -> education.AbsStudent
-	59,  // 31: education.AbsStudentHistory.group:type_name -> education.AbsGroup
-	21,  // 32: education.AbsGroup.course:type_name -> education.AbsCourse
-	63,  // 33: education.SearchStudentResponse.students:type_name -> education.AbsStudent
-	66,  // 34: education.GetAllStudentResponse.response:type_name -> education.GetGroupsAbsForStudent
-	67,  // 35: education.GetGroupsAbsForStudent.groups:type_name -> education.GroupGetAllStudentAbs
-	21,  // 36: education.GroupGetAllStudentAbs.course:type_name -> education.AbsCourse
-	73,  // 37: education.GetStudentByIdResponse.groups:type_name -> education.GetGroupStudent
-	18,  // 38: education.GetGroupStudent.room:type_name -> education.AbsRoom
-	21,  // 39: education.GetGroupStudent.course:type_name -> education.AbsCourse
-	75,  // 40: education.GetNotesByStudent.notes:type_name -> education.AbsNote
-	89,  // 41: education.GetSmsLogRequest.pageRequest:type_name -> common.PageRequest
-	79,  // 42: education.GetSmsLogResponse.datas:type_name -> education.SmsLogList
-	82,  // 43: education.GetSmsTransactionDetailResponse.datas:type_name -> education.GetSmsTransactionList
-	85,  // 44: education.GetSmsTemplateResponse.datas:type_name -> education.SmsTemplateList
-	7,   // 45: education.CompanyService.GetCompanyBySubdomain:input_type -> education.GetCompanyRequest
-	6,   // 46: education.CompanyService.CreateCompany:input_type -> education.CreateCompanyRequest
-	89,  // 47: education.CompanyService.GetAll:input_type -> common.PageRequest
-	4,   // 48: education.CompanyService.UpdateCompany:input_type -> education.UpdateCompanyRequest
-	3,   // 49: education.CompanyService.GetStatistic:input_type -> education.GetStatisticRequest
-	9,   // 50: education.TariffService.Create:input_type -> education.Tariff
-	9,   // 51: education.TariffService.Update:input_type -> education.Tariff
-	9,   // 52: education.TariffService.Delete:input_type -> education.Tariff
-	90,  // 53: education.TariffService.Get:input_type -> google.protobuf.Empty
-	11,  // 54: education.CompanyFinanceService.Create:input_type -> education.CompanyFinance
-	91,  // 55: education.CompanyFinanceService.Delete:input_type -> common.DeleteAbsRequest
-	89,  // 56: education.CompanyFinanceService.GetAll:input_type -> common.PageRequest
-	89,  // 57: education.CompanyFinanceService.GetByCompany:input_type -> common.PageRequest
-	11,  // 58: education.CompanyFinanceService.UpdateByCompany:input_type -> education.CompanyFinance
-	16,  // 59: education.RoomService.CreateRoom:input_type -> education.CreateRoomRequest
-	90,  // 60: education.RoomService.GetRooms:input_type -> google.protobuf.Empty
-	18,  // 61: education.RoomService.UpdateRoom:input_type -> education.AbsRoom
-	91,  // 62: education.RoomService.DeleteRoom:input_type -> common.DeleteAbsRequest
-	19,  // 63: education.CourseService.CreateCourse:input_type -> education.CreateCourseRequest
-	90,  // 64: education.CourseService.GetCourses:input_type -> google.protobuf.Empty
-	23,  // 65: education.CourseService.GetCourseById:input_type -> education.GetCourseByIdRequest
-	21,  // 66: education.CourseService.UpdateCourse:input_type -> education.AbsCourse
-	91,  // 67: education.CourseService.DeleteCourse:input_type -> common.DeleteAbsRequest
-	31,  // 68: education.GroupService.CreateGroup:input_type -> education.CreateGroupRequest
-	38,  // 69: education.GroupService.GetGroups:input_type -> education.GetGroupsRequest
-	32,  // 70: education.GroupService.GetGroupById:input_type -> education.GetGroupByIdRequest
-	32,  // 71: education.GroupService.GetGroupsByCourseId:input_type -> education.GetGroupByIdRequest
-	33,  // 72: education.GroupService.UpdateGroup:input_type -> education.GetUpdateGroupAbs
-	91,  // 73: education.GroupService.DeleteGroup:input_type -> common.DeleteAbsRequest
-	28,  // 74: education.GroupService.GetGroupsByTeacherId:input_type -> education.GetGroupsByTeacherIdRequest
-	90,  // 75: education.GroupService.GetCommonInformationEducation:input_type -> google.protobuf.Empty
-	24,  // 76: education.GroupService.GetLeftAfterTrialPeriod:input_type -> education.GetLeftAfterTrialPeriodRequest
-	43,  // 77: education.AttendanceService.GetAttendance:input_type -> education.GetAttendanceRequest
-	49,  // 78: education.AttendanceService.SetAttendance:input_type -> education.SetAttendanceRequest
-	39,  // 79: education.AttendanceService.CalculateTeacherSalaryByAttendance:input_type -> education.CalculateTeacherSalaryRequest
-	64,  // 80: education.StudentService.GetAllStudent:input_type -> education.GetAllStudentRequest
-	68,  // 81: education.StudentService.CreateStudent:input_type -> education.CreateStudentRequest
-	69,  // 82: education.StudentService.UpdateStudent:input_type -> education.UpdateStudentRequest
-	51,  // 83: education.StudentService.DeleteStudent:input_type -> education.DeleteStudentRequest
-	70,  // 84: education.StudentService.AddToGroup:input_type -> education.AddToGroupRequest
-	72,  // 85: education.StudentService.GetStudentById:input_type -> education.NoteStudentByAbsRequest
-	72,  // 86: education.StudentService.GetNoteByStudent:input_type -> education.NoteStudentByAbsRequest
-	76,  // 87: education.StudentService.CreateNoteForStudent:input_type -> education.CreateNoteRequest
-	72,  // 88: education.StudentService.DeleteStudentNote:input_type -> education.NoteStudentByAbsRequest
-	61,  // 89: education.StudentService.SearchStudent:input_type -> education.SearchStudentRequest
-	72,  // 90: education.StudentService.GetHistoryGroupById:input_type -> education.NoteStudentByAbsRequest
-	72,  // 91: education.StudentService.GetHistoryStudentById:input_type -> education.NoteStudentByAbsRequest
-	55,  // 92: education.StudentService.TransferLessonDate:input_type -> education.TransferLessonRequest
-	54,  // 93: education.StudentService.ChangeConditionStudent:input_type -> education.ChangeConditionStudentRequest
-	53,  // 94: education.StudentService.GetStudentsByGroupId:input_type -> education.GetStudentsByGroupIdRequest
-	50,  // 95: education.StudentService.ChangeUserBalanceHistory:input_type -> education.ChangeUserBalanceHistoryRequest
-	77,  // 96: education.SmsService.GetSmsLogs:input_type -> education.GetSmsLogRequest
-	80,  // 97: education.SmsService.AddSms:input_type -> education.AddSmsRequest
-	91,  // 98: education.SmsService.DeleteSms:input_type -> common.DeleteAbsRequest
-	89,  // 99: education.SmsService.GetSmsTransactionDetail:input_type -> common.PageRequest
-	83,  // 100: education.SmsService.GetSmsTemplate:input_type -> education.GetSmsTemplateRequest
-	86,  // 101: education.SmsService.SetSmsTemplate:input_type -> education.SetSmsTemplateRequest
-	87,  // 102: education.SmsService.SendSmsDirectly:input_type -> education.SendSmsDirectlyRequest
-	8,   // 103: education.CompanyService.GetCompanyBySubdomain:output_type -> education.GetCompanyResponse
-	92,  // 104: education.CompanyService.CreateCompany:output_type -> common.AbsResponse
-	5,   // 105: education.CompanyService.GetAll:output_type -> education.GetAllResponse
-	92,  // 106: education.CompanyService.UpdateCompany:output_type -> common.AbsResponse
-	0,   // 107: education.CompanyService.GetStatistic:output_type -> education.GetStatisticResponse
-	9,   // 108: education.TariffService.Create:output_type -> education.Tariff
-	9,   // 109: education.TariffService.Update:output_type -> education.Tariff
-	9,   // 110: education.TariffService.Delete:output_type -> education.Tariff
-	10,  // 111: education.TariffService.Get:output_type -> education.TariffList
-	11,  // 112: education.CompanyFinanceService.Create:output_type -> education.CompanyFinance
-	92,  // 113: education.CompanyFinanceService.Delete:output_type -> common.AbsResponse
-	14,  // 114: education.CompanyFinanceService.GetAll:output_type -> education.CompanyFinanceList
-	13,  // 115: education.CompanyFinanceService.GetByCompany:output_type -> education.CompanyFinanceSelfList
-	11,  // 116: education.CompanyFinanceService.UpdateByCompany:output_type -> education.CompanyFinance
-	92,  // 117: education.RoomService.CreateRoom:output_type -> common.AbsResponse
-	17,  // 118: education.RoomService.GetRooms:output_type -> education.GetUpdateRoomAbs
-	92,  // 119: education.RoomService.UpdateRoom:output_type -> common.AbsResponse
-	92,  // 120: education.RoomService.DeleteRoom:output_type -> common.AbsResponse
-	92,  // 121: education.CourseService.CreateCourse:output_type -> common.AbsResponse
-	20,  // 122: education.CourseService.GetCourses:output_type -> education.GetUpdateCourseAbs
-	22,  // 123: education.CourseService.GetCourseById:output_type -> education.GetCourseByIdResponse
-	92,  // 124: education.CourseService.UpdateCourse:output_type -> common.AbsResponse
-	92,  // 125: education.CourseService.DeleteCourse:output_type -> common.AbsResponse
-	92,  // 126: education.GroupService.CreateGroup:output_type -> common.AbsResponse
-	37,  // 127: education.GroupService.GetGroups:output_type -> education.GetGroupsResponse
-	36,  // 128: education.GroupService.GetGroupById:output_type -> education.GetGroupAbsResponse
-	34,  // 129: education.GroupService.GetGroupsByCourseId:output_type -> education.GetGroupsByCourseResponse
-	92,  // 130: education.GroupService.UpdateGroup:output_type -> common.AbsResponse
-	92,  // 131: education.GroupService.DeleteGroup:output_type -> common.AbsResponse
-	29,  // 132: education.GroupService.GetGroupsByTeacherId:output_type -> education.GetGroupsByTeacherResponse
-	27,  // 133: education.GroupService.GetCommonInformationEducation:output_type -> education.GetCommonInformationEducationResponse
-	25,  // 134: education.GroupService.GetLeftAfterTrialPeriod:output_type -> education.GetLeftAfterTrialPeriodResponse
-	44,  // 135: education.AttendanceService.GetAttendance:output_type -> education.GetAttendanceResponse
-	92,  // 136: education.AttendanceService.SetAttendance:output_type -> common.AbsResponse
-	40,  // 137: education.AttendanceService.CalculateTeacherSalaryByAttendance:output_type -> education.CalculateTeacherSalaryResponse
-	65,  // 138: education.StudentService.GetAllStudent:output_type -> education.GetAllStudentResponse
-	92,  // 139: education.StudentService.CreateStudent:output_type -> common.AbsResponse
-	92,  // 140: education.StudentService.UpdateStudent:output_type -> common.AbsResponse
-	92,  // 141: education.StudentService.DeleteStudent:output_type -> common.AbsResponse
-	92,  // 142: education.StudentService.AddToGroup:output_type -> common.AbsResponse
-	71,  // 143: education.StudentService.GetStudentById:output_type -> education.GetStudentByIdResponse
-	74,  // 144: education.StudentService.GetNoteByStudent:output_type -> education.GetNotesByStudent
-	92,  // 145: education.StudentService.CreateNoteForStudent:output_type -> common.AbsResponse
-	92,  // 146: education.StudentService.DeleteStudentNote:output_type -> common.AbsResponse
-	62,  // 147: education.StudentService.SearchStudent:output_type -> education.SearchStudentResponse
-	56,  // 148: education.StudentService.GetHistoryGroupById:output_type -> education.GetHistoryGroupResponse
-	57,  // 149: education.StudentService.GetHistoryStudentById:output_type -> education.GetHistoryStudentResponse
-	92,  // 150: education.StudentService.TransferLessonDate:output_type -> common.AbsResponse
-	92,  // 151: education.StudentService.ChangeConditionStudent:output_type -> common.AbsResponse
-	52,  // 152: education.StudentService.GetStudentsByGroupId:output_type -> education.GetStudentsByGroupIdResponse
-	92,  // 153: education.StudentService.ChangeUserBalanceHistory:output_type -> common.AbsResponse
-	78,  // 154: education.SmsService.GetSmsLogs:output_type -> education.GetSmsLogResponse
-	92,  // 155: education.SmsService.AddSms:output_type -> common.AbsResponse
-	92,  // 156: education.SmsService.DeleteSms:output_type -> common.AbsResponse
-	81,  // 157: education.SmsService.GetSmsTransactionDetail:output_type -> education.GetSmsTransactionDetailResponse
-	84,  // 158: education.SmsService.GetSmsTemplate:output_type -> education.GetSmsTemplateResponse
-	92,  // 159: education.SmsService.SetSmsTemplate:output_type -> common.AbsResponse
-	92,  // 160: education.SmsService.SendSmsDirectly:output_type -> common.AbsResponse
-	103, // [103:161] is the sub-list for method output_type
-	45,  // [45:103] is the sub-list for method input_type
-	45,  // [45:45] is the sub-list for extension type_name
-	45,  // [45:45] is the sub-list for extension extendee
-	0,   // [0:45] is the sub-list for field type_name
+	26,  // 11: education.GetCoursePriceHistoryResponse.versions:type_name -> education.CoursePriceVersion
+	28,  // 12: education.GetCoursePriceHistoryResponse.locks:type_name -> education.EnrollmentPriceLock
+	32,  // 13: education.GetLeftAfterTrialPeriodResponse.items:type_name -> education.AbsGetLeftAfter
+	36,  // 14: education.GetGroupsByTeacherResponse.groups:type_name -> education.GetGroupByTeacherAbs
+	144, // 15: education.GetGroupByTeacherAbs.students:type_name -> education.AbsStudent
+	41,  // 16: education.GetGroupsByCourseResponse.groups:type_name -> education.GetGroupByCourseAbsResponse
+	21,  // 17: education.GetGroupAbsResponse.course:type_name -> education.AbsCourse
+	18,  // 18: education.GetGroupAbsResponse.room:type_name -> education.AbsRoom
+	42,  // 19: education.GetGroupsResponse.groups:type_name -> education.GetGroupAbsResponse
+	176, // 20: education.GetGroupsRequest.page:type_name -> common.PageRequest
+	47,  // 21: education.ScheduleConflicts.conflicts:type_name -> education.ScheduleConflict
+	47,  // 22: education.ScheduleConflictOverride.conflicts:type_name -> education.ScheduleConflict
+	51,  // 23: education.GetScheduleConflictOverridesResponse.overrides:type_name -> education.ScheduleConflictOverride
+	55,  // 24: education.CalculateTeacherSalaryResponse.salaries:type_name -> education.AbsCalculateSalary
+	56,  // 25: education.AbsCalculateSalary.salaries:type_name -> education.StudentSalary
+	59,  // 26: education.GetAttendanceResponse.days:type_name -> education.Day
+	60,  // 27: education.GetAttendanceResponse.students:type_name -> education.Student
+	61,  // 28: education.Student.attendance:type_name -> education.Attendance
+	62,  // 29: education.Student.freezeDetail:type_name -> education.FreezeDetail
+	65,  // 30: education.SetGroupAttendanceRequest.marks:type_name -> education.GroupAttendanceMark
+	66,  // 31: education.SetGroupAttendanceResponse.results:type_name -> education.GroupAttendanceResult
+	68,  // 32: education.GetAttendanceStatusesResponse.statuses:type_name -> education.AbsAttendanceStatus
+	73,  // 33: education.GetAttendanceUnlockRequestsResponse.requests:type_name -> education.AttendanceUnlockRequest
+	79,  // 34: education.VerifyStudentLoginCodeResponse.sessions:type_name -> education.StudentSession
+	87,  // 35: education.GetLessonCheckInsResponse.checkIns:type_name -> education.LessonCheckIn
+	91,  // 36: education.GetAttendanceRatesResponse.rates:type_name -> education.AttendanceRate
+	94,  // 37: education.GetAbsenceStreaksResponse.streaks:type_name -> education.AbsenceStreak
+	97,  // 38: education.GetAttendanceTrendResponse.points:type_name -> education.AttendanceTrendPoint
+	101, // 39: education.GetAtRiskStudentsResponse.students:type_name -> education.AtRiskStudent
+	104, // 40: education.GetNotificationsResponse.notifications:type_name -> education.Notification
+	108, // 41: education.GetGroupWaitlistResponse.entries:type_name -> education.WaitlistEntry
+	113, // 42: education.GetHolidaysResponse.holidays:type_name -> education.AbsHoliday
+	119, // 43: education.GetTimetableResponse.lessons:type_name -> education.TimetableLesson
+	122, // 44: education.GetCalendarFeedsResponse.feeds:type_name -> education.CalendarFeed
+	122, // 45: education.CalendarFeedTimetable.feed:type_name -> education.CalendarFeed
+	119, // 46: education.CalendarFeedTimetable.lessons:type_name -> education.TimetableLesson
+	127, // 47: education.GetLessonsResponse.lessons:type_name -> education.AbsLesson
+	144, // 48: education.GetStudentsByGroupIdResponse.students:type_name -> education.AbsStudent
+	141, // 49: education.GetHistoryGroupResponse.groupHistory:type_name -> education.AbsHistory
+	139, // 50: education.GetHistoryGroupResponse.studentsHistory:type_name -> education.AbsStudentHistory
+	141, // 51: education.GetHistoryStudentResponse.studentHistory:type_name -> education.AbsHistory
+	139, // 52: education.GetHistoryStudentResponse.conditionsHistory:type_name -> education.AbsStudentHistory
+	144, // 53: education.AbsStudentHistory.student:type_name -> education.AbsStudent
+	140, // 54: education.AbsStudentHistory.group:type_name -> education.AbsGroup
+	21,  // 55: education.AbsGroup.course:type_name -> education.AbsCourse
+	144, // 56: education.SearchStudentResponse.students:type_name -> education.AbsStudent
+	147, // 57: education.GetAllStudentResponse.response:type_name -> education.GetGroupsAbsForStudent
+	148, // 58: education.GetGroupsAbsForStudent.groups:type_name -> education.GroupGetAllStudentAbs
+	21,  // 59: education.GroupGetAllStudentAbs.course:type_name -> education.AbsCourse
+	152, // 60: education.AddToGroupResponse.results:type_name -> education.AddToGroupResult
+	156, // 61: education.GetStudentByIdResponse.groups:type_name -> education.GetGroupStudent
+	18,  // 62: education.GetGroupStudent.room:type_name -> education.AbsRoom
+	21,  // 63: education.GetGroupStudent.course:type_name -> education.AbsCourse
+	158, // 64: education.GetNotesByStudent.notes:type_name -> education.AbsNote
+	163, // 65: education.GetLessonPackagesResponse.packages:type_name -> education.AbsLessonPackage
+	176, // 66: education.GetSmsLogRequest.pageRequest:type_name -> common.PageRequest
+	166, // 67: education.GetSmsLogResponse.datas:type_name -> education.SmsLogList
+	169, // 68: education.GetSmsTransactionDetailResponse.datas:type_name -> education.GetSmsTransactionList
+	172, // 69: education.GetSmsTemplateResponse.datas:type_name -> education.SmsTemplateList
+	7,   // 70: education.CompanyService.GetCompanyBySubdomain:input_type -> education.GetCompanyRequest
+	6,   // 71: education.CompanyService.CreateCompany:input_type -> education.CreateCompanyRequest
+	176, // 72: education.CompanyService.GetAll:input_type -> common.PageRequest
+	4,   // 73: education.CompanyService.UpdateCompany:input_type -> education.UpdateCompanyRequest
+	3,   // 74: education.CompanyService.GetStatistic:input_type -> education.GetStatisticRequest
+	9,   // 75: education.TariffService.Create:input_type -> education.Tariff
+	9,   // 76: education.TariffService.Update:input_type -> education.Tariff
+	9,   // 77: education.TariffService.Delete:input_type -> education.Tariff
+	177, // 78: education.TariffService.Get:input_type -> google.protobuf.Empty
+	11,  // 79: education.CompanyFinanceService.Create:input_type -> education.CompanyFinance
+	178, // 80: education.CompanyFinanceService.Delete:input_type -> common.DeleteAbsRequest
+	176, // 81: education.CompanyFinanceService.GetAll:input_type -> common.PageRequest
+	176, // 82: education.CompanyFinanceService.GetByCompany:input_type -> common.PageRequest
+	11,  // 83: education.CompanyFinanceService.UpdateByCompany:input_type -> education.CompanyFinance
+	16,  // 84: education.RoomService.CreateRoom:input_type -> education.CreateRoomRequest
+	177, // 85: education.RoomService.GetRooms:input_type -> google.protobuf.Empty
+	18,  // 86: education.RoomService.UpdateRoom:input_type -> education.AbsRoom
+	178, // 87: education.RoomService.DeleteRoom:input_type -> common.DeleteAbsRequest
+	19,  // 88: education.CourseService.CreateCourse:input_type -> education.CreateCourseRequest
+	177, // 89: education.CourseService.GetCourses:input_type -> google.protobuf.Empty
+	23,  // 90: education.CourseService.GetCourseById:input_type -> education.GetCourseByIdRequest
+	21,  // 91: education.CourseService.UpdateCourse:input_type -> education.AbsCourse
+	178, // 92: education.CourseService.DeleteCourse:input_type -> common.DeleteAbsRequest
+	23,  // 93: education.CourseService.GetCourseBilling:input_type -> education.GetCourseByIdRequest
+	24,  // 94: education.CourseService.UpdateCourseBilling:input_type -> education.CourseBilling
+	25,  // 95: education.CourseService.ScheduleCoursePrice:input_type -> education.ScheduleCoursePriceRequest
+	23,  // 96: education.CourseService.GetCoursePriceHistory:input_type -> education.GetCourseByIdRequest
+	29,  // 97: education.CourseService.SetEnrollmentPriceLock:input_type -> education.SetEnrollmentPriceLockRequest
+	37,  // 98: education.GroupService.CreateGroup:input_type -> education.CreateGroupRequest
+	44,  // 99: education.GroupService.GetGroups:input_type -> education.GetGroupsRequest
+	38,  // 100: education.GroupService.GetGroupById:input_type -> education.GetGroupByIdRequest
+	38,  // 101: education.GroupService.GetGroupsByCourseId:input_type -> education.GetGroupByIdRequest
+	39,  // 102: education.GroupService.UpdateGroup:input_type -> education.GetUpdateGroupAbs
+	178, // 103: education.GroupService.DeleteGroup:input_type -> common.DeleteAbsRequest
+	34,  // 104: education.GroupService.GetGroupsByTeacherId:input_type -> education.GetGroupsByTeacherIdRequest
+	177, // 105: education.GroupService.GetCommonInformationEducation:input_type -> google.protobuf.Empty
+	30,  // 106: education.GroupService.GetLeftAfterTrialPeriod:input_type -> education.GetLeftAfterTrialPeriodRequest
+	45,  // 107: education.GroupService.UpdateGroupBillingMode:input_type -> education.UpdateGroupBillingModeRequest
+	46,  // 108: education.GroupService.UpdateGroupMaxStudents:input_type -> education.UpdateGroupMaxStudentsRequest
+	49,  // 109: education.GroupService.CheckScheduleConflicts:input_type -> education.CheckScheduleConflictsRequest
+	50,  // 110: education.GroupService.GetScheduleConflictOverrides:input_type -> education.GetScheduleConflictOverridesRequest
+	57,  // 111: education.AttendanceService.GetAttendance:input_type -> education.GetAttendanceRequest
+	63,  // 112: education.AttendanceService.SetAttendance:input_type -> education.SetAttendanceRequest
+	64,  // 113: education.AttendanceService.SetGroupAttendance:input_type -> education.SetGroupAttendanceRequest
+	53,  // 114: education.AttendanceService.CalculateTeacherSalaryByAttendance:input_type -> education.CalculateTeacherSalaryRequest
+	68,  // 115: education.AttendanceStatusService.CreateAttendanceStatus:input_type -> education.AbsAttendanceStatus
+	68,  // 116: education.AttendanceStatusService.UpdateAttendanceStatus:input_type -> education.AbsAttendanceStatus
+	178, // 117: education.AttendanceStatusService.DeleteAttendanceStatus:input_type -> common.DeleteAbsRequest
+	69,  // 118: education.AttendanceStatusService.GetAttendanceStatuses:input_type -> education.GetAttendanceStatusesRequest
+	177, // 119: education.AttendancePolicyService.GetAttendancePolicy:input_type -> google.protobuf.Empty
+	71,  // 120: education.AttendancePolicyService.UpdateAttendancePolicy:input_type -> education.AttendancePolicy
+	72,  // 121: education.AttendancePolicyService.RequestAttendanceUnlock:input_type -> education.RequestAttendanceUnlockRequest
+	74,  // 122: education.AttendancePolicyService.GetAttendanceUnlockRequests:input_type -> education.GetAttendanceUnlockRequestsRequest
+	76,  // 123: education.AttendancePolicyService.ReviewAttendanceUnlock:input_type -> education.ReviewAttendanceUnlockRequest
+	77,  // 124: education.CheckInService.SendStudentLoginCode:input_type -> education.SendStudentLoginCodeRequest
+	78,  // 125: education.CheckInService.VerifyStudentLoginCode:input_type -> education.VerifyStudentLoginCodeRequest
+	81,  // 126: education.CheckInService.ValidateStudentSession:input_type -> education.ValidateStudentSessionRequest
+	82,  // 127: education.CheckInService.GetCheckInCode:input_type -> education.GetCheckInCodeRequest
+	84,  // 128: education.CheckInService.CheckIn:input_type -> education.CheckInRequest
+	86,  // 129: education.CheckInService.GetLessonCheckIns:input_type -> education.GetLessonCheckInsRequest
+	89,  // 130: education.CheckInService.ConfirmCheckIns:input_type -> education.ConfirmCheckInsRequest
+	90,  // 131: education.AttendanceAnalyticsService.GetAttendanceRates:input_type -> education.GetAttendanceRatesRequest
+	93,  // 132: education.AttendanceAnalyticsService.GetAbsenceStreaks:input_type -> education.GetAbsenceStreaksRequest
+	96,  // 133: education.AttendanceAnalyticsService.GetAttendanceTrend:input_type -> education.GetAttendanceTrendRequest
+	177, // 134: education.AttendanceAnalyticsService.GetAtRiskPolicy:input_type -> google.protobuf.Empty
+	99,  // 135: education.AttendanceAnalyticsService.UpdateAtRiskPolicy:input_type -> education.AtRiskPolicy
+	100, // 136: education.AttendanceAnalyticsService.GetAtRiskStudents:input_type -> education.GetAtRiskStudentsRequest
+	103, // 137: education.NotificationService.GetNotifications:input_type -> education.GetNotificationsRequest
+	106, // 138: education.NotificationService.MarkNotificationsRead:input_type -> education.MarkNotificationsReadRequest
+	107, // 139: education.GroupWaitlistService.GetGroupWaitlist:input_type -> education.GetGroupWaitlistRequest
+	110, // 140: education.GroupWaitlistService.MoveWaitlistEntry:input_type -> education.MoveWaitlistEntryRequest
+	111, // 141: education.GroupWaitlistService.CancelWaitlistEntry:input_type -> education.WaitlistEntryRequest
+	112, // 142: education.GroupWaitlistService.AcceptWaitlistOffer:input_type -> education.AcceptWaitlistOfferRequest
+	113, // 143: education.HolidayService.CreateHoliday:input_type -> education.AbsHoliday
+	113, // 144: education.HolidayService.UpdateHoliday:input_type -> education.AbsHoliday
+	178, // 145: education.HolidayService.DeleteHoliday:input_type -> common.DeleteAbsRequest
+	114, // 146: education.HolidayService.GetHolidays:input_type -> education.GetHolidaysRequest
+	116, // 147: education.HolidayService.GetGroupHolidays:input_type -> education.GetGroupHolidaysRequest
+	118, // 148: education.TimetableService.GetTimetable:input_type -> education.GetTimetableRequest
+	121, // 149: education.TimetableService.CreateCalendarFeed:input_type -> education.CreateCalendarFeedRequest
+	123, // 150: education.TimetableService.GetCalendarFeeds:input_type -> education.GetCalendarFeedsRequest
+	178, // 151: education.TimetableService.RevokeCalendarFeed:input_type -> common.DeleteAbsRequest
+	125, // 152: education.TimetableService.GetCalendarFeedTimetable:input_type -> education.GetCalendarFeedTimetableRequest
+	128, // 153: education.LessonService.GetLessons:input_type -> education.GetLessonsRequest
+	178, // 154: education.LessonService.GetLessonById:input_type -> common.DeleteAbsRequest
+	130, // 155: education.LessonService.UpdateLesson:input_type -> education.UpdateLessonRequest
+	145, // 156: education.StudentService.GetAllStudent:input_type -> education.GetAllStudentRequest
+	149, // 157: education.StudentService.CreateStudent:input_type -> education.CreateStudentRequest
+	150, // 158: education.StudentService.UpdateStudent:input_type -> education.UpdateStudentRequest
+	132, // 159: education.StudentService.DeleteStudent:input_type -> education.DeleteStudentRequest
+	151, // 160: education.StudentService.AddToGroup:input_type -> education.AddToGroupRequest
+	155, // 161: education.StudentService.GetStudentById:input_type -> education.NoteStudentByAbsRequest
+	155, // 162: education.StudentService.GetNoteByStudent:input_type -> education.NoteStudentByAbsRequest
+	159, // 163: education.StudentService.CreateNoteForStudent:input_type -> education.CreateNoteRequest
+	155, // 164: education.StudentService.DeleteStudentNote:input_type -> education.NoteStudentByAbsRequest
+	142, // 165: education.StudentService.SearchStudent:input_type -> education.SearchStudentRequest
+	155, // 166: education.StudentService.GetHistoryGroupById:input_type -> education.NoteStudentByAbsRequest
+	155, // 167: education.StudentService.GetHistoryStudentById:input_type -> education.NoteStudentByAbsRequest
+	136, // 168: education.StudentService.TransferLessonDate:input_type -> education.TransferLessonRequest
+	135, // 169: education.StudentService.ChangeConditionStudent:input_type -> education.ChangeConditionStudentRequest
+	134, // 170: education.StudentService.GetStudentsByGroupId:input_type -> education.GetStudentsByGroupIdRequest
+	131, // 171: education.StudentService.ChangeUserBalanceHistory:input_type -> education.ChangeUserBalanceHistoryRequest
+	160, // 172: education.StudentService.SellLessonPackage:input_type -> education.SellLessonPackageRequest
+	161, // 173: education.StudentService.GetLessonPackages:input_type -> education.GetLessonPackagesRequest
+	164, // 174: education.SmsService.GetSmsLogs:input_type -> education.GetSmsLogRequest
+	167, // 175: education.SmsService.AddSms:input_type -> education.AddSmsRequest
+	178, // 176: education.SmsService.DeleteSms:input_type -> common.DeleteAbsRequest
+	176, // 177: education.SmsService.GetSmsTransactionDetail:input_type -> common.PageRequest
+	170, // 178: education.SmsService.GetSmsTemplate:input_type -> education.GetSmsTemplateRequest
+	173, // 179: education.SmsService.SetSmsTemplate:input_type -> education.SetSmsTemplateRequest
+	174, // 180: education.SmsService.SendSmsDirectly:input_type -> education.SendSmsDirectlyRequest
+	8,   // 181: education.CompanyService.GetCompanyBySubdomain:output_type -> education.GetCompanyResponse
+	179, // 182: education.CompanyService.CreateCompany:output_type -> common.AbsResponse
+	5,   // 183: education.CompanyService.GetAll:output_type -> education.GetAllResponse
+	179, // 184: education.CompanyService.UpdateCompany:output_type -> common.AbsResponse
+	0,   // 185: education.CompanyService.GetStatistic:output_type -> education.GetStatisticResponse
+	9,   // 186: education.TariffService.Create:output_type -> education.Tariff
+	9,   // 187: education.TariffService.Update:output_type -> education.Tariff
+	9,   // 188: education.TariffService.Delete:output_type -> education.Tariff
+	10,  // 189: education.TariffService.Get:output_type -> education.TariffList
+	11,  // 190: education.CompanyFinanceService.Create:output_type -> education.CompanyFinance
+	179, // 191: education.CompanyFinanceService.Delete:output_type -> common.AbsResponse
+	14,  // 192: education.CompanyFinanceService.GetAll:output_type -> education.CompanyFinanceList
+	13,  // 193: education.CompanyFinanceService.GetByCompany:output_type -> education.CompanyFinanceSelfList
+	11,  // 194: education.CompanyFinanceService.UpdateByCompany:output_type -> education.CompanyFinance
+	179, // 195: education.RoomService.CreateRoom:output_type -> common.AbsResponse
+	17,  // 196: education.RoomService.GetRooms:output_type -> education.GetUpdateRoomAbs
+	179, // 197: education.RoomService.UpdateRoom:output_type -> common.AbsResponse
+	179, // 198: education.RoomService.DeleteRoom:output_type -> common.AbsResponse
+	179, // 199: education.CourseService.CreateCourse:output_type -> common.AbsResponse
+	20,  // 200: education.CourseService.GetCourses:output_type -> education.GetUpdateCourseAbs
+	22,  // 201: education.CourseService.GetCourseById:output_type -> education.GetCourseByIdResponse
+	179, // 202: education.CourseService.UpdateCourse:output_type -> common.AbsResponse
+	179, // 203: education.CourseService.DeleteCourse:output_type -> common.AbsResponse
+	24,  // 204: education.CourseService.GetCourseBilling:output_type -> education.CourseBilling
+	179, // 205: education.CourseService.UpdateCourseBilling:output_type -> common.AbsResponse
+	179, // 206: education.CourseService.ScheduleCoursePrice:output_type -> common.AbsResponse
+	27,  // 207: education.CourseService.GetCoursePriceHistory:output_type -> education.GetCoursePriceHistoryResponse
+	179, // 208: education.CourseService.SetEnrollmentPriceLock:output_type -> common.AbsResponse
+	179, // 209: education.GroupService.CreateGroup:output_type -> common.AbsResponse
+	43,  // 210: education.GroupService.GetGroups:output_type -> education.GetGroupsResponse
+	42,  // 211: education.GroupService.GetGroupById:output_type -> education.GetGroupAbsResponse
+	40,  // 212: education.GroupService.GetGroupsByCourseId:output_type -> education.GetGroupsByCourseResponse
+	179, // 213: education.GroupService.UpdateGroup:output_type -> common.AbsResponse
+	179, // 214: education.GroupService.DeleteGroup:output_type -> common.AbsResponse
+	35,  // 215: education.GroupService.GetGroupsByTeacherId:output_type -> education.GetGroupsByTeacherResponse
+	33,  // 216: education.GroupService.GetCommonInformationEducation:output_type -> education.GetCommonInformationEducationResponse
+	31,  // 217: education.GroupService.GetLeftAfterTrialPeriod:output_type -> education.GetLeftAfterTrialPeriodResponse
+	179, // 218: education.GroupService.UpdateGroupBillingMode:output_type -> common.AbsResponse
+	179, // 219: education.GroupService.UpdateGroupMaxStudents:output_type -> common.AbsResponse
+	48,  // 220: education.GroupService.CheckScheduleConflicts:output_type -> education.ScheduleConflicts
+	52,  // 221: education.GroupService.GetScheduleConflictOverrides:output_type -> education.GetScheduleConflictOverridesResponse
+	58,  // 222: education.AttendanceService.GetAttendance:output_type -> education.GetAttendanceResponse
+	179, // 223: education.AttendanceService.SetAttendance:output_type -> common.AbsResponse
+	67,  // 224: education.AttendanceService.SetGroupAttendance:output_type -> education.SetGroupAttendanceResponse
+	54,  // 225: education.AttendanceService.CalculateTeacherSalaryByAttendance:output_type -> education.CalculateTeacherSalaryResponse
+	179, // 226: education.AttendanceStatusService.CreateAttendanceStatus:output_type -> common.AbsResponse
+	179, // 227: education.AttendanceStatusService.UpdateAttendanceStatus:output_type -> common.AbsResponse
+	179, // 228: education.AttendanceStatusService.DeleteAttendanceStatus:output_type -> common.AbsResponse
+	70,  // 229: education.AttendanceStatusService.GetAttendanceStatuses:output_type -> education.GetAttendanceStatusesResponse
+	71,  // 230: education.AttendancePolicyService.GetAttendancePolicy:output_type -> education.AttendancePolicy
+	179, // 231: education.AttendancePolicyService.UpdateAttendancePolicy:output_type -> common.AbsResponse
+	179, // 232: education.AttendancePolicyService.RequestAttendanceUnlock:output_type -> common.AbsResponse
+	75,  // 233: education.AttendancePolicyService.GetAttendanceUnlockRequests:output_type -> education.GetAttendanceUnlockRequestsResponse
+	179, // 234: education.AttendancePolicyService.ReviewAttendanceUnlock:output_type -> common.AbsResponse
+	179, // 235: education.CheckInService.SendStudentLoginCode:output_type -> common.AbsResponse
+	80,  // 236: education.CheckInService.VerifyStudentLoginCode:output_type -> education.VerifyStudentLoginCodeResponse
+	79,  // 237: education.CheckInService.ValidateStudentSession:output_type -> education.StudentSession
+	83,  // 238: education.CheckInService.GetCheckInCode:output_type -> education.CheckInCode
+	85,  // 239: education.CheckInService.CheckIn:output_type -> education.CheckInResponse
+	88,  // 240: education.CheckInService.GetLessonCheckIns:output_type -> education.GetLessonCheckInsResponse
+	67,  // 241: education.CheckInService.ConfirmCheckIns:output_type -> education.SetGroupAttendanceResponse
+	92,  // 242: education.AttendanceAnalyticsService.GetAttendanceRates:output_type -> education.GetAttendanceRatesResponse
+	95,  // 243: education.AttendanceAnalyticsService.GetAbsenceStreaks:output_type -> education.GetAbsenceStreaksResponse
+	98,  // 244: education.AttendanceAnalyticsService.GetAttendanceTrend:output_type -> education.GetAttendanceTrendResponse
+	99,  // 245: education.AttendanceAnalyticsService.GetAtRiskPolicy:output_type -> education.AtRiskPolicy
+	179, // 246: education.AttendanceAnalyticsService.UpdateAtRiskPolicy:output_type -> common.AbsResponse
+	102, // 247: education.AttendanceAnalyticsService.GetAtRiskStudents:output_type -> education.GetAtRiskStudentsResponse
+	105, // 248: education.NotificationService.GetNotifications:output_type -> education.GetNotificationsResponse
+	179, // 249: education.NotificationService.MarkNotificationsRead:output_type -> common.AbsResponse
+	109, // 250: education.GroupWaitlistService.GetGroupWaitlist:output_type -> education.GetGroupWaitlistResponse
+	179, // 251: education.GroupWaitlistService.MoveWaitlistEntry:output_type -> common.AbsResponse
+	179, // 252: education.GroupWaitlistService.CancelWaitlistEntry:output_type -> common.AbsResponse
+	153, // 253: education.GroupWaitlistService.AcceptWaitlistOffer:output_type -> education.AddToGroupResponse
+	179, // 254: education.HolidayService.CreateHoliday:output_type -> common.AbsResponse
+	179, // 255: education.HolidayService.UpdateHoliday:output_type -> common.AbsResponse
+	179, // 256: education.HolidayService.DeleteHoliday:output_type -> common.AbsResponse
+	115, // 257: education.HolidayService.GetHolidays:output_type -> education.GetHolidaysResponse
+	117, // 258: education.HolidayService.GetGroupHolidays:output_type -> education.GetGroupHolidaysResponse
+	120, // 259: education.TimetableService.GetTimetable:output_type -> education.GetTimetableResponse
+	122, // 260: education.TimetableService.CreateCalendarFeed:output_type -> education.CalendarFeed
+	124, // 261: education.TimetableService.GetCalendarFeeds:output_type -> education.GetCalendarFeedsResponse
+	179, // 262: education.TimetableService.RevokeCalendarFeed:output_type -> common.AbsResponse
+	126, // 263: education.TimetableService.GetCalendarFeedTimetable:output_type -> education.CalendarFeedTimetable
+	129, // 264: education.LessonService.GetLessons:output_type -> education.GetLessonsResponse
+	127, // 265: education.LessonService.GetLessonById:output_type -> education.AbsLesson
+	127, // 266: education.LessonService.UpdateLesson:output_type -> education.AbsLesson
+	146, // 267: education.StudentService.GetAllStudent:output_type -> education.GetAllStudentResponse
+	179, // 268: education.StudentService.CreateStudent:output_type -> common.AbsResponse
+	179, // 269: education.StudentService.UpdateStudent:output_type -> common.AbsResponse
+	179, // 270: education.StudentService.DeleteStudent:output_type -> common.AbsResponse
+	153, // 271: education.StudentService.AddToGroup:output_type -> education.AddToGroupResponse
+	154, // 272: education.StudentService.GetStudentById:output_type -> education.GetStudentByIdResponse
+	157, // 273: education.StudentService.GetNoteByStudent:output_type -> education.GetNotesByStudent
+	179, // 274: education.StudentService.CreateNoteForStudent:output_type -> common.AbsResponse
+	179, // 275: education.StudentService.DeleteStudentNote:output_type -> common.AbsResponse
+	143, // 276: education.StudentService.SearchStudent:output_type -> education.SearchStudentResponse
+	137, // 277: education.StudentService.GetHistoryGroupById:output_type -> education.GetHistoryGroupResponse
+	138, // 278: education.StudentService.GetHistoryStudentById:output_type -> education.GetHistoryStudentResponse
+	179, // 279: education.StudentService.TransferLessonDate:output_type -> common.AbsResponse
+	179, // 280: education.StudentService.ChangeConditionStudent:output_type -> common.AbsResponse
+	133, // 281: education.StudentService.GetStudentsByGroupId:output_type -> education.GetStudentsByGroupIdResponse
+	179, // 282: education.StudentService.ChangeUserBalanceHistory:output_type -> common.AbsResponse
+	179, // 283: education.StudentService.SellLessonPackage:output_type -> common.AbsResponse
+	162, // 284: education.StudentService.GetLessonPackages:output_type -> education.GetLessonPackagesResponse
+	165, // 285: education.SmsService.GetSmsLogs:output_type -> education.GetSmsLogResponse
+	179, // 286: education.SmsService.AddSms:output_type -> common.AbsResponse
+	179, // 287: education.SmsService.DeleteSms:output_type -> common.AbsResponse
+	168, // 288: education.SmsService.GetSmsTransactionDetail:output_type -> education.GetSmsTransactionDetailResponse
+	171, // 289: education.SmsService.GetSmsTemplate:output_type -> education.GetSmsTemplateResponse
+	179, // 290: education.SmsService.SetSmsTemplate:output_type -> common.AbsResponse
+	179, // 291: education.SmsService.SendSmsDirectly:output_type -> common.AbsResponse
+	181, // [181:292] is the sub-list for method output_type
+	70,  // [70:181] is the sub-list for method input_type
+	70,  // [70:70] is the sub-list for extension type_name
+	70,  // [70:70] is the sub-list for extension extendee
+	0,   // [0:70] is the sub-list for field type_name
 }
 
 func init() { file_education_proto_init() }
@@ -7739,16 +15042,16 @@ func file_education_proto_init() {
 		return
 	}
 	file_common_proto_init()
-	file_education_proto_msgTypes[38].OneofWrappers = []any{}
+	file_education_proto_msgTypes[44].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_education_proto_rawDesc), len(file_education_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   89,
+			NumMessages:   176,
 			NumExtensions: 0,
-			NumServices:   9,
+			NumServices:   18,
 		},
 		GoTypes:           file_education_proto_goTypes,
 		DependencyIndexes: file_education_proto_depIdxs,
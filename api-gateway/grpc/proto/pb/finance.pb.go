@@ -22,28 +22,32 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
-type GetHistoryDiscountRequest struct {
+type ApplyDiscountRulesRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	StudentId     string                 `protobuf:"bytes,1,opt,name=studentId,proto3" json:"studentId"`
 	GroupId       string                 `protobuf:"bytes,2,opt,name=groupId,proto3" json:"groupId"`
+	PromoCode     string                 `protobuf:"bytes,3,opt,name=promoCode,proto3" json:"promoCode"`
+	Date          string                 `protobuf:"bytes,4,opt,name=date,proto3" json:"date"`
+	ActionById    string                 `protobuf:"bytes,5,opt,name=actionById,proto3" json:"actionById"`
+	ActionByName  string                 `protobuf:"bytes,6,opt,name=actionByName,proto3" json:"actionByName"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetHistoryDiscountRequest) Reset() {
-	*x = GetHistoryDiscountRequest{}
+func (x *ApplyDiscountRulesRequest) Reset() {
+	*x = ApplyDiscountRulesRequest{}
 	mi := &file_finance_proto_msgTypes[0]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetHistoryDiscountRequest) String() string {
+func (x *ApplyDiscountRulesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetHistoryDiscountRequest) ProtoMessage() {}
+func (*ApplyDiscountRulesRequest) ProtoMessage() {}
 
-func (x *GetHistoryDiscountRequest) ProtoReflect() protoreflect.Message {
+func (x *ApplyDiscountRulesRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_finance_proto_msgTypes[0]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -55,46 +59,78 @@ func (x *GetHistoryDiscountRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetHistoryDiscountRequest.ProtoReflect.Descriptor instead.
-func (*GetHistoryDiscountRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ApplyDiscountRulesRequest.ProtoReflect.Descriptor instead.
+func (*ApplyDiscountRulesRequest) Descriptor() ([]byte, []int) {
 	return file_finance_proto_rawDescGZIP(), []int{0}
 }
 
-func (x *GetHistoryDiscountRequest) GetStudentId() string {
+func (x *ApplyDiscountRulesRequest) GetStudentId() string {
 	if x != nil {
 		return x.StudentId
 	}
 	return ""
 }
 
-func (x *GetHistoryDiscountRequest) GetGroupId() string {
+func (x *ApplyDiscountRulesRequest) GetGroupId() string {
 	if x != nil {
 		return x.GroupId
 	}
 	return ""
 }
 
-type GetHistoryDiscountResponse struct {
+func (x *ApplyDiscountRulesRequest) GetPromoCode() string {
+	if x != nil {
+		return x.PromoCode
+	}
+	return ""
+}
+
+func (x *ApplyDiscountRulesRequest) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *ApplyDiscountRulesRequest) GetActionById() string {
+	if x != nil {
+		return x.ActionById
+	}
+	return ""
+}
+
+func (x *ApplyDiscountRulesRequest) GetActionByName() string {
+	if x != nil {
+		return x.ActionByName
+	}
+	return ""
+}
+
+type ApplyDiscountRulesResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Discounts     []*AbsHistoryDiscount  `protobuf:"bytes,1,rep,name=discounts,proto3" json:"discounts"`
+	Applied       bool                   `protobuf:"varint,1,opt,name=applied,proto3" json:"applied"`
+	RuleId        string                 `protobuf:"bytes,2,opt,name=ruleId,proto3" json:"ruleId"`
+	RuleName      string                 `protobuf:"bytes,3,opt,name=ruleName,proto3" json:"ruleName"`
+	Discount      float64                `protobuf:"fixed64,4,opt,name=discount,proto3" json:"discount"`
+	Message       string                 `protobuf:"bytes,5,opt,name=message,proto3" json:"message"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetHistoryDiscountResponse) Reset() {
-	*x = GetHistoryDiscountResponse{}
+func (x *ApplyDiscountRulesResponse) Reset() {
+	*x = ApplyDiscountRulesResponse{}
 	mi := &file_finance_proto_msgTypes[1]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetHistoryDiscountResponse) String() string {
+func (x *ApplyDiscountRulesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetHistoryDiscountResponse) ProtoMessage() {}
+func (*ApplyDiscountRulesResponse) ProtoMessage() {}
 
-func (x *GetHistoryDiscountResponse) ProtoReflect() protoreflect.Message {
+func (x *ApplyDiscountRulesResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_finance_proto_msgTypes[1]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -106,49 +142,88 @@ func (x *GetHistoryDiscountResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetHistoryDiscountResponse.ProtoReflect.Descriptor instead.
-func (*GetHistoryDiscountResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ApplyDiscountRulesResponse.ProtoReflect.Descriptor instead.
+func (*ApplyDiscountRulesResponse) Descriptor() ([]byte, []int) {
 	return file_finance_proto_rawDescGZIP(), []int{1}
 }
 
-func (x *GetHistoryDiscountResponse) GetDiscounts() []*AbsHistoryDiscount {
+func (x *ApplyDiscountRulesResponse) GetApplied() bool {
 	if x != nil {
-		return x.Discounts
+		return x.Applied
 	}
-	return nil
+	return false
 }
 
-type AbsHistoryDiscount struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	GroupId       string                 `protobuf:"bytes,1,opt,name=groupId,proto3" json:"groupId"`
-	GroupName     string                 `protobuf:"bytes,10,opt,name=groupName,proto3" json:"groupName"`
-	StudentId     string                 `protobuf:"bytes,2,opt,name=studentId,proto3" json:"studentId"`
-	StudentName   string                 `protobuf:"bytes,11,opt,name=studentName,proto3" json:"studentName"`
-	DiscountPrice string                 `protobuf:"bytes,3,opt,name=discountPrice,proto3" json:"discountPrice"`
-	Comment       string                 `protobuf:"bytes,4,opt,name=comment,proto3" json:"comment"`
-	StartDate     string                 `protobuf:"bytes,5,opt,name=startDate,proto3" json:"startDate"`
-	EndDate       string                 `protobuf:"bytes,6,opt,name=endDate,proto3" json:"endDate"`
-	WithTeacher   bool                   `protobuf:"varint,7,opt,name=withTeacher,proto3" json:"withTeacher"`
-	Action        string                 `protobuf:"bytes,8,opt,name=action,proto3" json:"action"`
-	CreatedAt     string                 `protobuf:"bytes,9,opt,name=createdAt,proto3" json:"createdAt"`
+func (x *ApplyDiscountRulesResponse) GetRuleId() string {
+	if x != nil {
+		return x.RuleId
+	}
+	return ""
+}
+
+func (x *ApplyDiscountRulesResponse) GetRuleName() string {
+	if x != nil {
+		return x.RuleName
+	}
+	return ""
+}
+
+func (x *ApplyDiscountRulesResponse) GetDiscount() float64 {
+	if x != nil {
+		return x.Discount
+	}
+	return 0
+}
+
+func (x *ApplyDiscountRulesResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type AbsDiscountRule struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id"`
+	Name  string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name"`
+	// TEMPLATE, SIBLING, MULTI_COURSE or PROMO_CODE
+	RuleType string `protobuf:"bytes,3,opt,name=ruleType,proto3" json:"ruleType"`
+	// PERCENT or FIXED
+	ValueType   string  `protobuf:"bytes,4,opt,name=valueType,proto3" json:"valueType"`
+	Value       float64 `protobuf:"fixed64,5,opt,name=value,proto3" json:"value"`
+	WithTeacher bool    `protobuf:"varint,6,opt,name=withTeacher,proto3" json:"withTeacher"`
+	// 0 keeps the discount until the group end date
+	DurationMonths int32  `protobuf:"varint,7,opt,name=durationMonths,proto3" json:"durationMonths"`
+	PromoCode      string `protobuf:"bytes,8,opt,name=promoCode,proto3" json:"promoCode"`
+	// 0 means unlimited
+	UsageLimit int32  `protobuf:"varint,9,opt,name=usageLimit,proto3" json:"usageLimit"`
+	UsedCount  int32  `protobuf:"varint,10,opt,name=usedCount,proto3" json:"usedCount"`
+	ValidFrom  string `protobuf:"bytes,11,opt,name=validFrom,proto3" json:"validFrom"`
+	ValidUntil string `protobuf:"bytes,12,opt,name=validUntil,proto3" json:"validUntil"`
+	// MULTI_COURSE applies from this many courses, 2 by default
+	MinCourses int32 `protobuf:"varint,13,opt,name=minCourses,proto3" json:"minCourses"`
+	// empty applies to every course
+	CourseId      string `protobuf:"bytes,14,opt,name=courseId,proto3" json:"courseId"`
+	IsActive      bool   `protobuf:"varint,15,opt,name=isActive,proto3" json:"isActive"`
+	CreatedAt     string `protobuf:"bytes,16,opt,name=createdAt,proto3" json:"createdAt"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AbsHistoryDiscount) Reset() {
-	*x = AbsHistoryDiscount{}
+func (x *AbsDiscountRule) Reset() {
+	*x = AbsDiscountRule{}
 	mi := &file_finance_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AbsHistoryDiscount) String() string {
+func (x *AbsDiscountRule) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AbsHistoryDiscount) ProtoMessage() {}
+func (*AbsDiscountRule) ProtoMessage() {}
 
-func (x *AbsHistoryDiscount) ProtoReflect() protoreflect.Message {
+func (x *AbsDiscountRule) ProtoReflect() protoreflect.Message {
 	mi := &file_finance_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -160,115 +235,144 @@ func (x *AbsHistoryDiscount) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AbsHistoryDiscount.ProtoReflect.Descriptor instead.
-func (*AbsHistoryDiscount) Descriptor() ([]byte, []int) {
+// Deprecated: Use AbsDiscountRule.ProtoReflect.Descriptor instead.
+func (*AbsDiscountRule) Descriptor() ([]byte, []int) {
 	return file_finance_proto_rawDescGZIP(), []int{2}
 }
 
-func (x *AbsHistoryDiscount) GetGroupId() string {
+func (x *AbsDiscountRule) GetId() string {
 	if x != nil {
-		return x.GroupId
+		return x.Id
 	}
 	return ""
 }
 
-func (x *AbsHistoryDiscount) GetGroupName() string {
+func (x *AbsDiscountRule) GetName() string {
 	if x != nil {
-		return x.GroupName
+		return x.Name
 	}
 	return ""
 }
 
-func (x *AbsHistoryDiscount) GetStudentId() string {
+func (x *AbsDiscountRule) GetRuleType() string {
 	if x != nil {
-		return x.StudentId
+		return x.RuleType
 	}
 	return ""
 }
 
-func (x *AbsHistoryDiscount) GetStudentName() string {
+func (x *AbsDiscountRule) GetValueType() string {
 	if x != nil {
-		return x.StudentName
+		return x.ValueType
 	}
 	return ""
 }
 
-func (x *AbsHistoryDiscount) GetDiscountPrice() string {
+func (x *AbsDiscountRule) GetValue() float64 {
 	if x != nil {
-		return x.DiscountPrice
+		return x.Value
 	}
-	return ""
+	return 0
 }
 
-func (x *AbsHistoryDiscount) GetComment() string {
+func (x *AbsDiscountRule) GetWithTeacher() bool {
 	if x != nil {
-		return x.Comment
+		return x.WithTeacher
+	}
+	return false
+}
+
+func (x *AbsDiscountRule) GetDurationMonths() int32 {
+	if x != nil {
+		return x.DurationMonths
+	}
+	return 0
+}
+
+func (x *AbsDiscountRule) GetPromoCode() string {
+	if x != nil {
+		return x.PromoCode
 	}
 	return ""
 }
 
-func (x *AbsHistoryDiscount) GetStartDate() string {
+func (x *AbsDiscountRule) GetUsageLimit() int32 {
 	if x != nil {
-		return x.StartDate
+		return x.UsageLimit
+	}
+	return 0
+}
+
+func (x *AbsDiscountRule) GetUsedCount() int32 {
+	if x != nil {
+		return x.UsedCount
+	}
+	return 0
+}
+
+func (x *AbsDiscountRule) GetValidFrom() string {
+	if x != nil {
+		return x.ValidFrom
 	}
 	return ""
 }
 
-func (x *AbsHistoryDiscount) GetEndDate() string {
+func (x *AbsDiscountRule) GetValidUntil() string {
 	if x != nil {
-		return x.EndDate
+		return x.ValidUntil
 	}
 	return ""
 }
 
-func (x *AbsHistoryDiscount) GetWithTeacher() bool {
+func (x *AbsDiscountRule) GetMinCourses() int32 {
 	if x != nil {
-		return x.WithTeacher
+		return x.MinCourses
 	}
-	return false
+	return 0
 }
 
-func (x *AbsHistoryDiscount) GetAction() string {
+func (x *AbsDiscountRule) GetCourseId() string {
 	if x != nil {
-		return x.Action
+		return x.CourseId
 	}
 	return ""
 }
 
-func (x *AbsHistoryDiscount) GetCreatedAt() string {
+func (x *AbsDiscountRule) GetIsActive() bool {
+	if x != nil {
+		return x.IsActive
+	}
+	return false
+}
+
+func (x *AbsDiscountRule) GetCreatedAt() string {
 	if x != nil {
 		return x.CreatedAt
 	}
 	return ""
 }
 
-type AbsDiscountRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	GroupId       string                 `protobuf:"bytes,1,opt,name=groupId,proto3" json:"groupId"`
-	StudentId     string                 `protobuf:"bytes,2,opt,name=studentId,proto3" json:"studentId"`
-	DiscountPrice string                 `protobuf:"bytes,3,opt,name=discountPrice,proto3" json:"discountPrice"`
-	Comment       string                 `protobuf:"bytes,4,opt,name=comment,proto3" json:"comment"`
-	StartDate     string                 `protobuf:"bytes,5,opt,name=startDate,proto3" json:"startDate"`
-	EndDate       string                 `protobuf:"bytes,6,opt,name=endDate,proto3" json:"endDate"`
-	WithTeacher   bool                   `protobuf:"varint,7,opt,name=withTeacher,proto3" json:"withTeacher"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type GetDiscountRulesRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	IncludeInactive bool                   `protobuf:"varint,1,opt,name=includeInactive,proto3" json:"includeInactive"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
-func (x *AbsDiscountRequest) Reset() {
-	*x = AbsDiscountRequest{}
+func (x *GetDiscountRulesRequest) Reset() {
+	*x = GetDiscountRulesRequest{}
 	mi := &file_finance_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AbsDiscountRequest) String() string {
+func (x *GetDiscountRulesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AbsDiscountRequest) ProtoMessage() {}
+func (*GetDiscountRulesRequest) ProtoMessage() {}
 
-func (x *AbsDiscountRequest) ProtoReflect() protoreflect.Message {
+func (x *GetDiscountRulesRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_finance_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -280,81 +384,39 @@ func (x *AbsDiscountRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AbsDiscountRequest.ProtoReflect.Descriptor instead.
-func (*AbsDiscountRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetDiscountRulesRequest.ProtoReflect.Descriptor instead.
+func (*GetDiscountRulesRequest) Descriptor() ([]byte, []int) {
 	return file_finance_proto_rawDescGZIP(), []int{3}
 }
 
-func (x *AbsDiscountRequest) GetGroupId() string {
-	if x != nil {
-		return x.GroupId
-	}
-	return ""
-}
-
-func (x *AbsDiscountRequest) GetStudentId() string {
-	if x != nil {
-		return x.StudentId
-	}
-	return ""
-}
-
-func (x *AbsDiscountRequest) GetDiscountPrice() string {
-	if x != nil {
-		return x.DiscountPrice
-	}
-	return ""
-}
-
-func (x *AbsDiscountRequest) GetComment() string {
-	if x != nil {
-		return x.Comment
-	}
-	return ""
-}
-
-func (x *AbsDiscountRequest) GetStartDate() string {
-	if x != nil {
-		return x.StartDate
-	}
-	return ""
-}
-
-func (x *AbsDiscountRequest) GetEndDate() string {
-	if x != nil {
-		return x.EndDate
-	}
-	return ""
-}
-
-func (x *AbsDiscountRequest) GetWithTeacher() bool {
+func (x *GetDiscountRulesRequest) GetIncludeInactive() bool {
 	if x != nil {
-		return x.WithTeacher
+		return x.IncludeInactive
 	}
 	return false
 }
 
-type GetInformationDiscountRequest struct {
+type GetDiscountRulesResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	GroupId       string                 `protobuf:"bytes,1,opt,name=groupId,proto3" json:"groupId"`
+	Rules         []*AbsDiscountRule     `protobuf:"bytes,1,rep,name=rules,proto3" json:"rules"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetInformationDiscountRequest) Reset() {
-	*x = GetInformationDiscountRequest{}
+func (x *GetDiscountRulesResponse) Reset() {
+	*x = GetDiscountRulesResponse{}
 	mi := &file_finance_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetInformationDiscountRequest) String() string {
+func (x *GetDiscountRulesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetInformationDiscountRequest) ProtoMessage() {}
+func (*GetDiscountRulesResponse) ProtoMessage() {}
 
-func (x *GetInformationDiscountRequest) ProtoReflect() protoreflect.Message {
+func (x *GetDiscountRulesResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_finance_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -366,39 +428,44 @@ func (x *GetInformationDiscountRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetInformationDiscountRequest.ProtoReflect.Descriptor instead.
-func (*GetInformationDiscountRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetDiscountRulesResponse.ProtoReflect.Descriptor instead.
+func (*GetDiscountRulesResponse) Descriptor() ([]byte, []int) {
 	return file_finance_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *GetInformationDiscountRequest) GetGroupId() string {
+func (x *GetDiscountRulesResponse) GetRules() []*AbsDiscountRule {
 	if x != nil {
-		return x.GroupId
+		return x.Rules
 	}
-	return ""
+	return nil
 }
 
-type GetInformationDiscountResponse struct {
+type ApplyDiscountTemplateRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Discounts     []*AbsStudentDiscount  `protobuf:"bytes,1,rep,name=discounts,proto3" json:"discounts"`
+	RuleId        string                 `protobuf:"bytes,1,opt,name=ruleId,proto3" json:"ruleId"`
+	StudentId     string                 `protobuf:"bytes,2,opt,name=studentId,proto3" json:"studentId"`
+	GroupId       string                 `protobuf:"bytes,3,opt,name=groupId,proto3" json:"groupId"`
+	StartDate     string                 `protobuf:"bytes,4,opt,name=startDate,proto3" json:"startDate"`
+	ActionById    string                 `protobuf:"bytes,5,opt,name=actionById,proto3" json:"actionById"`
+	ActionByName  string                 `protobuf:"bytes,6,opt,name=actionByName,proto3" json:"actionByName"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetInformationDiscountResponse) Reset() {
-	*x = GetInformationDiscountResponse{}
+func (x *ApplyDiscountTemplateRequest) Reset() {
+	*x = ApplyDiscountTemplateRequest{}
 	mi := &file_finance_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetInformationDiscountResponse) String() string {
+func (x *ApplyDiscountTemplateRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetInformationDiscountResponse) ProtoMessage() {}
+func (*ApplyDiscountTemplateRequest) ProtoMessage() {}
 
-func (x *GetInformationDiscountResponse) ProtoReflect() protoreflect.Message {
+func (x *ApplyDiscountTemplateRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_finance_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -410,47 +477,75 @@ func (x *GetInformationDiscountResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetInformationDiscountResponse.ProtoReflect.Descriptor instead.
-func (*GetInformationDiscountResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ApplyDiscountTemplateRequest.ProtoReflect.Descriptor instead.
+func (*ApplyDiscountTemplateRequest) Descriptor() ([]byte, []int) {
 	return file_finance_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *GetInformationDiscountResponse) GetDiscounts() []*AbsStudentDiscount {
+func (x *ApplyDiscountTemplateRequest) GetRuleId() string {
 	if x != nil {
-		return x.Discounts
+		return x.RuleId
 	}
-	return nil
-}
-
-type AbsStudentDiscount struct {
-	state              protoimpl.MessageState `protogen:"open.v1"`
-	StudentId          string                 `protobuf:"bytes,1,opt,name=studentId,proto3" json:"studentId"`
-	StudentName        string                 `protobuf:"bytes,2,opt,name=studentName,proto3" json:"studentName"`
-	StudentPhoneNumber string                 `protobuf:"bytes,3,opt,name=studentPhoneNumber,proto3" json:"studentPhoneNumber"`
-	Discount           string                 `protobuf:"bytes,4,opt,name=discount,proto3" json:"discount"`
-	Cause              string                 `protobuf:"bytes,5,opt,name=cause,proto3" json:"cause"`
-	CreatedAt          string                 `protobuf:"bytes,6,opt,name=createdAt,proto3" json:"createdAt"`
-	StartAt            string                 `protobuf:"bytes,7,opt,name=startAt,proto3" json:"startAt"`
-	EndAt              string                 `protobuf:"bytes,8,opt,name=endAt,proto3" json:"endAt"`
-	WithTeacher        bool                   `protobuf:"varint,9,opt,name=withTeacher,proto3" json:"withTeacher"`
-	unknownFields      protoimpl.UnknownFields
-	sizeCache          protoimpl.SizeCache
+	return ""
 }
 
-func (x *AbsStudentDiscount) Reset() {
-	*x = AbsStudentDiscount{}
-	mi := &file_finance_proto_msgTypes[6]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *ApplyDiscountTemplateRequest) GetStudentId() string {
+	if x != nil {
+		return x.StudentId
+	}
+	return ""
 }
 
-func (x *AbsStudentDiscount) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *ApplyDiscountTemplateRequest) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
 }
 
-func (*AbsStudentDiscount) ProtoMessage() {}
+func (x *ApplyDiscountTemplateRequest) GetStartDate() string {
+	if x != nil {
+		return x.StartDate
+	}
+	return ""
+}
 
-func (x *AbsStudentDiscount) ProtoReflect() protoreflect.Message {
+func (x *ApplyDiscountTemplateRequest) GetActionById() string {
+	if x != nil {
+		return x.ActionById
+	}
+	return ""
+}
+
+func (x *ApplyDiscountTemplateRequest) GetActionByName() string {
+	if x != nil {
+		return x.ActionByName
+	}
+	return ""
+}
+
+type GetHistoryDiscountRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StudentId     string                 `protobuf:"bytes,1,opt,name=studentId,proto3" json:"studentId"`
+	GroupId       string                 `protobuf:"bytes,2,opt,name=groupId,proto3" json:"groupId"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetHistoryDiscountRequest) Reset() {
+	*x = GetHistoryDiscountRequest{}
+	mi := &file_finance_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetHistoryDiscountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetHistoryDiscountRequest) ProtoMessage() {}
+
+func (x *GetHistoryDiscountRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_finance_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -462,148 +557,7594 @@ func (x *AbsStudentDiscount) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AbsStudentDiscount.ProtoReflect.Descriptor instead.
-func (*AbsStudentDiscount) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetHistoryDiscountRequest.ProtoReflect.Descriptor instead.
+func (*GetHistoryDiscountRequest) Descriptor() ([]byte, []int) {
 	return file_finance_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *AbsStudentDiscount) GetStudentId() string {
+func (x *GetHistoryDiscountRequest) GetStudentId() string {
 	if x != nil {
 		return x.StudentId
 	}
 	return ""
 }
 
-func (x *AbsStudentDiscount) GetStudentName() string {
+func (x *GetHistoryDiscountRequest) GetGroupId() string {
 	if x != nil {
-		return x.StudentName
+		return x.GroupId
 	}
 	return ""
 }
 
-func (x *AbsStudentDiscount) GetStudentPhoneNumber() string {
+type GetHistoryDiscountResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Discounts     []*AbsHistoryDiscount  `protobuf:"bytes,1,rep,name=discounts,proto3" json:"discounts"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetHistoryDiscountResponse) Reset() {
+	*x = GetHistoryDiscountResponse{}
+	mi := &file_finance_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetHistoryDiscountResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetHistoryDiscountResponse) ProtoMessage() {}
+
+func (x *GetHistoryDiscountResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[7]
 	if x != nil {
-		return x.StudentPhoneNumber
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetHistoryDiscountResponse.ProtoReflect.Descriptor instead.
+func (*GetHistoryDiscountResponse) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *GetHistoryDiscountResponse) GetDiscounts() []*AbsHistoryDiscount {
+	if x != nil {
+		return x.Discounts
+	}
+	return nil
+}
+
+type AbsHistoryDiscount struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GroupId       string                 `protobuf:"bytes,1,opt,name=groupId,proto3" json:"groupId"`
+	GroupName     string                 `protobuf:"bytes,10,opt,name=groupName,proto3" json:"groupName"`
+	StudentId     string                 `protobuf:"bytes,2,opt,name=studentId,proto3" json:"studentId"`
+	StudentName   string                 `protobuf:"bytes,11,opt,name=studentName,proto3" json:"studentName"`
+	DiscountPrice string                 `protobuf:"bytes,3,opt,name=discountPrice,proto3" json:"discountPrice"`
+	Comment       string                 `protobuf:"bytes,4,opt,name=comment,proto3" json:"comment"`
+	StartDate     string                 `protobuf:"bytes,5,opt,name=startDate,proto3" json:"startDate"`
+	EndDate       string                 `protobuf:"bytes,6,opt,name=endDate,proto3" json:"endDate"`
+	WithTeacher   bool                   `protobuf:"varint,7,opt,name=withTeacher,proto3" json:"withTeacher"`
+	Action        string                 `protobuf:"bytes,8,opt,name=action,proto3" json:"action"`
+	CreatedAt     string                 `protobuf:"bytes,9,opt,name=createdAt,proto3" json:"createdAt"`
+	RuleId        string                 `protobuf:"bytes,12,opt,name=ruleId,proto3" json:"ruleId"`
+	RuleName      string                 `protobuf:"bytes,13,opt,name=ruleName,proto3" json:"ruleName"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AbsHistoryDiscount) Reset() {
+	*x = AbsHistoryDiscount{}
+	mi := &file_finance_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AbsHistoryDiscount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AbsHistoryDiscount) ProtoMessage() {}
+
+func (x *AbsHistoryDiscount) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AbsHistoryDiscount.ProtoReflect.Descriptor instead.
+func (*AbsHistoryDiscount) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *AbsHistoryDiscount) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
 	}
 	return ""
 }
 
-func (x *AbsStudentDiscount) GetDiscount() string {
+func (x *AbsHistoryDiscount) GetGroupName() string {
 	if x != nil {
-		return x.Discount
+		return x.GroupName
 	}
 	return ""
 }
 
-func (x *AbsStudentDiscount) GetCause() string {
+func (x *AbsHistoryDiscount) GetStudentId() string {
 	if x != nil {
-		return x.Cause
+		return x.StudentId
 	}
 	return ""
 }
 
-func (x *AbsStudentDiscount) GetCreatedAt() string {
+func (x *AbsHistoryDiscount) GetStudentName() string {
 	if x != nil {
-		return x.CreatedAt
+		return x.StudentName
 	}
 	return ""
 }
 
-func (x *AbsStudentDiscount) GetStartAt() string {
+func (x *AbsHistoryDiscount) GetDiscountPrice() string {
 	if x != nil {
-		return x.StartAt
+		return x.DiscountPrice
 	}
 	return ""
 }
 
-func (x *AbsStudentDiscount) GetEndAt() string {
+func (x *AbsHistoryDiscount) GetComment() string {
 	if x != nil {
-		return x.EndAt
+		return x.Comment
 	}
 	return ""
 }
 
-func (x *AbsStudentDiscount) GetWithTeacher() bool {
+func (x *AbsHistoryDiscount) GetStartDate() string {
+	if x != nil {
+		return x.StartDate
+	}
+	return ""
+}
+
+func (x *AbsHistoryDiscount) GetEndDate() string {
+	if x != nil {
+		return x.EndDate
+	}
+	return ""
+}
+
+func (x *AbsHistoryDiscount) GetWithTeacher() bool {
 	if x != nil {
 		return x.WithTeacher
 	}
 	return false
 }
 
-type CreateCategoryRequest struct {
+func (x *AbsHistoryDiscount) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *AbsHistoryDiscount) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *AbsHistoryDiscount) GetRuleId() string {
+	if x != nil {
+		return x.RuleId
+	}
+	return ""
+}
+
+func (x *AbsHistoryDiscount) GetRuleName() string {
+	if x != nil {
+		return x.RuleName
+	}
+	return ""
+}
+
+type AbsDiscountRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name"`
-	Desc          string                 `protobuf:"bytes,2,opt,name=desc,proto3" json:"desc"`
+	GroupId       string                 `protobuf:"bytes,1,opt,name=groupId,proto3" json:"groupId"`
+	StudentId     string                 `protobuf:"bytes,2,opt,name=studentId,proto3" json:"studentId"`
+	DiscountPrice string                 `protobuf:"bytes,3,opt,name=discountPrice,proto3" json:"discountPrice"`
+	Comment       string                 `protobuf:"bytes,4,opt,name=comment,proto3" json:"comment"`
+	StartDate     string                 `protobuf:"bytes,5,opt,name=startDate,proto3" json:"startDate"`
+	EndDate       string                 `protobuf:"bytes,6,opt,name=endDate,proto3" json:"endDate"`
+	WithTeacher   bool                   `protobuf:"varint,7,opt,name=withTeacher,proto3" json:"withTeacher"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateCategoryRequest) Reset() {
-	*x = CreateCategoryRequest{}
-	mi := &file_finance_proto_msgTypes[7]
+func (x *AbsDiscountRequest) Reset() {
+	*x = AbsDiscountRequest{}
+	mi := &file_finance_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateCategoryRequest) String() string {
+func (x *AbsDiscountRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateCategoryRequest) ProtoMessage() {}
-
-func (x *CreateCategoryRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_finance_proto_msgTypes[7]
+func (*AbsDiscountRequest) ProtoMessage() {}
+
+func (x *AbsDiscountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AbsDiscountRequest.ProtoReflect.Descriptor instead.
+func (*AbsDiscountRequest) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *AbsDiscountRequest) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+func (x *AbsDiscountRequest) GetStudentId() string {
+	if x != nil {
+		return x.StudentId
+	}
+	return ""
+}
+
+func (x *AbsDiscountRequest) GetDiscountPrice() string {
+	if x != nil {
+		return x.DiscountPrice
+	}
+	return ""
+}
+
+func (x *AbsDiscountRequest) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+func (x *AbsDiscountRequest) GetStartDate() string {
+	if x != nil {
+		return x.StartDate
+	}
+	return ""
+}
+
+func (x *AbsDiscountRequest) GetEndDate() string {
+	if x != nil {
+		return x.EndDate
+	}
+	return ""
+}
+
+func (x *AbsDiscountRequest) GetWithTeacher() bool {
+	if x != nil {
+		return x.WithTeacher
+	}
+	return false
+}
+
+type GetInformationDiscountRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GroupId       string                 `protobuf:"bytes,1,opt,name=groupId,proto3" json:"groupId"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetInformationDiscountRequest) Reset() {
+	*x = GetInformationDiscountRequest{}
+	mi := &file_finance_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetInformationDiscountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetInformationDiscountRequest) ProtoMessage() {}
+
+func (x *GetInformationDiscountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetInformationDiscountRequest.ProtoReflect.Descriptor instead.
+func (*GetInformationDiscountRequest) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *GetInformationDiscountRequest) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+type GetInformationDiscountResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Discounts     []*AbsStudentDiscount  `protobuf:"bytes,1,rep,name=discounts,proto3" json:"discounts"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetInformationDiscountResponse) Reset() {
+	*x = GetInformationDiscountResponse{}
+	mi := &file_finance_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetInformationDiscountResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetInformationDiscountResponse) ProtoMessage() {}
+
+func (x *GetInformationDiscountResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetInformationDiscountResponse.ProtoReflect.Descriptor instead.
+func (*GetInformationDiscountResponse) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *GetInformationDiscountResponse) GetDiscounts() []*AbsStudentDiscount {
+	if x != nil {
+		return x.Discounts
+	}
+	return nil
+}
+
+type AbsStudentDiscount struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	StudentId          string                 `protobuf:"bytes,1,opt,name=studentId,proto3" json:"studentId"`
+	StudentName        string                 `protobuf:"bytes,2,opt,name=studentName,proto3" json:"studentName"`
+	StudentPhoneNumber string                 `protobuf:"bytes,3,opt,name=studentPhoneNumber,proto3" json:"studentPhoneNumber"`
+	Discount           string                 `protobuf:"bytes,4,opt,name=discount,proto3" json:"discount"`
+	Cause              string                 `protobuf:"bytes,5,opt,name=cause,proto3" json:"cause"`
+	CreatedAt          string                 `protobuf:"bytes,6,opt,name=createdAt,proto3" json:"createdAt"`
+	StartAt            string                 `protobuf:"bytes,7,opt,name=startAt,proto3" json:"startAt"`
+	EndAt              string                 `protobuf:"bytes,8,opt,name=endAt,proto3" json:"endAt"`
+	WithTeacher        bool                   `protobuf:"varint,9,opt,name=withTeacher,proto3" json:"withTeacher"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *AbsStudentDiscount) Reset() {
+	*x = AbsStudentDiscount{}
+	mi := &file_finance_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AbsStudentDiscount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AbsStudentDiscount) ProtoMessage() {}
+
+func (x *AbsStudentDiscount) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AbsStudentDiscount.ProtoReflect.Descriptor instead.
+func (*AbsStudentDiscount) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *AbsStudentDiscount) GetStudentId() string {
+	if x != nil {
+		return x.StudentId
+	}
+	return ""
+}
+
+func (x *AbsStudentDiscount) GetStudentName() string {
+	if x != nil {
+		return x.StudentName
+	}
+	return ""
+}
+
+func (x *AbsStudentDiscount) GetStudentPhoneNumber() string {
+	if x != nil {
+		return x.StudentPhoneNumber
+	}
+	return ""
+}
+
+func (x *AbsStudentDiscount) GetDiscount() string {
+	if x != nil {
+		return x.Discount
+	}
+	return ""
+}
+
+func (x *AbsStudentDiscount) GetCause() string {
+	if x != nil {
+		return x.Cause
+	}
+	return ""
+}
+
+func (x *AbsStudentDiscount) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *AbsStudentDiscount) GetStartAt() string {
+	if x != nil {
+		return x.StartAt
+	}
+	return ""
+}
+
+func (x *AbsStudentDiscount) GetEndAt() string {
+	if x != nil {
+		return x.EndAt
+	}
+	return ""
+}
+
+func (x *AbsStudentDiscount) GetWithTeacher() bool {
+	if x != nil {
+		return x.WithTeacher
+	}
+	return false
+}
+
+type CreateCategoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name"`
+	Desc          string                 `protobuf:"bytes,2,opt,name=desc,proto3" json:"desc"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateCategoryRequest) Reset() {
+	*x = CreateCategoryRequest{}
+	mi := &file_finance_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateCategoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCategoryRequest) ProtoMessage() {}
+
+func (x *CreateCategoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCategoryRequest.ProtoReflect.Descriptor instead.
+func (*CreateCategoryRequest) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *CreateCategoryRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateCategoryRequest) GetDesc() string {
+	if x != nil {
+		return x.Desc
+	}
+	return ""
+}
+
+type GetAllCategoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Categories    []*AbsCategory         `protobuf:"bytes,1,rep,name=categories,proto3" json:"categories"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAllCategoryRequest) Reset() {
+	*x = GetAllCategoryRequest{}
+	mi := &file_finance_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAllCategoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAllCategoryRequest) ProtoMessage() {}
+
+func (x *GetAllCategoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAllCategoryRequest.ProtoReflect.Descriptor instead.
+func (*GetAllCategoryRequest) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *GetAllCategoryRequest) GetCategories() []*AbsCategory {
+	if x != nil {
+		return x.Categories
+	}
+	return nil
+}
+
+type AbsCategory struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name"`
+	Desc          string                 `protobuf:"bytes,3,opt,name=desc,proto3" json:"desc"`
+	MonthlyBudget float64                `protobuf:"fixed64,4,opt,name=monthlyBudget,proto3" json:"monthlyBudget"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AbsCategory) Reset() {
+	*x = AbsCategory{}
+	mi := &file_finance_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AbsCategory) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AbsCategory) ProtoMessage() {}
+
+func (x *AbsCategory) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AbsCategory.ProtoReflect.Descriptor instead.
+func (*AbsCategory) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *AbsCategory) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *AbsCategory) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *AbsCategory) GetDesc() string {
+	if x != nil {
+		return x.Desc
+	}
+	return ""
+}
+
+func (x *AbsCategory) GetMonthlyBudget() float64 {
+	if x != nil {
+		return x.MonthlyBudget
+	}
+	return 0
+}
+
+type SetCategoryBudgetRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CategoryId    string                 `protobuf:"bytes,1,opt,name=categoryId,proto3" json:"categoryId"`
+	MonthlyBudget float64                `protobuf:"fixed64,2,opt,name=monthlyBudget,proto3" json:"monthlyBudget"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetCategoryBudgetRequest) Reset() {
+	*x = SetCategoryBudgetRequest{}
+	mi := &file_finance_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetCategoryBudgetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetCategoryBudgetRequest) ProtoMessage() {}
+
+func (x *SetCategoryBudgetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetCategoryBudgetRequest.ProtoReflect.Descriptor instead.
+func (*SetCategoryBudgetRequest) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *SetCategoryBudgetRequest) GetCategoryId() string {
+	if x != nil {
+		return x.CategoryId
+	}
+	return ""
+}
+
+func (x *SetCategoryBudgetRequest) GetMonthlyBudget() float64 {
+	if x != nil {
+		return x.MonthlyBudget
+	}
+	return 0
+}
+
+type GetAllExpenseDiagramResponse struct {
+	state                  protoimpl.MessageState `protogen:"open.v1"`
+	UserOrCategories       []string               `protobuf:"bytes,1,rep,name=userOrCategories,proto3" json:"userOrCategories"`
+	UserOrCategoriesAmount []string               `protobuf:"bytes,2,rep,name=userOrCategoriesAmount,proto3" json:"userOrCategoriesAmount"`
+	MonthAmount            []string               `protobuf:"bytes,3,rep,name=monthAmount,proto3" json:"monthAmount"`
+	Months                 []string               `protobuf:"bytes,4,rep,name=months,proto3" json:"months"`
+	AmountCommonExpense    string                 `protobuf:"bytes,5,opt,name=amountCommonExpense,proto3" json:"amountCommonExpense"`
+	Budgets                []*CategoryBudget      `protobuf:"bytes,6,rep,name=budgets,proto3" json:"budgets"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *GetAllExpenseDiagramResponse) Reset() {
+	*x = GetAllExpenseDiagramResponse{}
+	mi := &file_finance_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAllExpenseDiagramResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAllExpenseDiagramResponse) ProtoMessage() {}
+
+func (x *GetAllExpenseDiagramResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAllExpenseDiagramResponse.ProtoReflect.Descriptor instead.
+func (*GetAllExpenseDiagramResponse) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *GetAllExpenseDiagramResponse) GetUserOrCategories() []string {
+	if x != nil {
+		return x.UserOrCategories
+	}
+	return nil
+}
+
+func (x *GetAllExpenseDiagramResponse) GetUserOrCategoriesAmount() []string {
+	if x != nil {
+		return x.UserOrCategoriesAmount
+	}
+	return nil
+}
+
+func (x *GetAllExpenseDiagramResponse) GetMonthAmount() []string {
+	if x != nil {
+		return x.MonthAmount
+	}
+	return nil
+}
+
+func (x *GetAllExpenseDiagramResponse) GetMonths() []string {
+	if x != nil {
+		return x.Months
+	}
+	return nil
+}
+
+func (x *GetAllExpenseDiagramResponse) GetAmountCommonExpense() string {
+	if x != nil {
+		return x.AmountCommonExpense
+	}
+	return ""
+}
+
+func (x *GetAllExpenseDiagramResponse) GetBudgets() []*CategoryBudget {
+	if x != nil {
+		return x.Budgets
+	}
+	return nil
+}
+
+type CategoryBudget struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CategoryId    string                 `protobuf:"bytes,1,opt,name=categoryId,proto3" json:"categoryId"`
+	CategoryName  string                 `protobuf:"bytes,2,opt,name=categoryName,proto3" json:"categoryName"`
+	Month         string                 `protobuf:"bytes,3,opt,name=month,proto3" json:"month"`
+	Budget        float64                `protobuf:"fixed64,4,opt,name=budget,proto3" json:"budget"`
+	Actual        float64                `protobuf:"fixed64,5,opt,name=actual,proto3" json:"actual"`
+	Percent       float64                `protobuf:"fixed64,6,opt,name=percent,proto3" json:"percent"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CategoryBudget) Reset() {
+	*x = CategoryBudget{}
+	mi := &file_finance_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CategoryBudget) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CategoryBudget) ProtoMessage() {}
+
+func (x *CategoryBudget) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CategoryBudget.ProtoReflect.Descriptor instead.
+func (*CategoryBudget) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *CategoryBudget) GetCategoryId() string {
+	if x != nil {
+		return x.CategoryId
+	}
+	return ""
+}
+
+func (x *CategoryBudget) GetCategoryName() string {
+	if x != nil {
+		return x.CategoryName
+	}
+	return ""
+}
+
+func (x *CategoryBudget) GetMonth() string {
+	if x != nil {
+		return x.Month
+	}
+	return ""
+}
+
+func (x *CategoryBudget) GetBudget() float64 {
+	if x != nil {
+		return x.Budget
+	}
+	return 0
+}
+
+func (x *CategoryBudget) GetActual() float64 {
+	if x != nil {
+		return x.Actual
+	}
+	return 0
+}
+
+func (x *CategoryBudget) GetPercent() float64 {
+	if x != nil {
+		return x.Percent
+	}
+	return 0
+}
+
+type GetAllExpenseDiagramRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	From          string                 `protobuf:"bytes,1,opt,name=from,proto3" json:"from"`
+	To            string                 `protobuf:"bytes,2,opt,name=to,proto3" json:"to"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAllExpenseDiagramRequest) Reset() {
+	*x = GetAllExpenseDiagramRequest{}
+	mi := &file_finance_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAllExpenseDiagramRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAllExpenseDiagramRequest) ProtoMessage() {}
+
+func (x *GetAllExpenseDiagramRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAllExpenseDiagramRequest.ProtoReflect.Descriptor instead.
+func (*GetAllExpenseDiagramRequest) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *GetAllExpenseDiagramRequest) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *GetAllExpenseDiagramRequest) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+type GetAllExpenseRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	From          string                 `protobuf:"bytes,1,opt,name=from,proto3" json:"from"`
+	To            string                 `protobuf:"bytes,2,opt,name=to,proto3" json:"to"`
+	Type          string                 `protobuf:"bytes,3,opt,name=type,proto3" json:"type"`
+	Id            string                 `protobuf:"bytes,5,opt,name=id,proto3" json:"id"`
+	PageReq       *PageRequest           `protobuf:"bytes,4,opt,name=pageReq,proto3" json:"pageReq"`
+	Status        string                 `protobuf:"bytes,6,opt,name=status,proto3" json:"status"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAllExpenseRequest) Reset() {
+	*x = GetAllExpenseRequest{}
+	mi := &file_finance_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAllExpenseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAllExpenseRequest) ProtoMessage() {}
+
+func (x *GetAllExpenseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAllExpenseRequest.ProtoReflect.Descriptor instead.
+func (*GetAllExpenseRequest) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *GetAllExpenseRequest) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *GetAllExpenseRequest) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+func (x *GetAllExpenseRequest) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *GetAllExpenseRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *GetAllExpenseRequest) GetPageReq() *PageRequest {
+	if x != nil {
+		return x.PageReq
+	}
+	return nil
+}
+
+func (x *GetAllExpenseRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type GetAllExpenseResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	TotalPageCount int32                  `protobuf:"varint,1,opt,name=totalPageCount,proto3" json:"totalPageCount"`
+	Expenses       []*GetAllExpenseAbs    `protobuf:"bytes,2,rep,name=expenses,proto3" json:"expenses"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetAllExpenseResponse) Reset() {
+	*x = GetAllExpenseResponse{}
+	mi := &file_finance_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAllExpenseResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAllExpenseResponse) ProtoMessage() {}
+
+func (x *GetAllExpenseResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAllExpenseResponse.ProtoReflect.Descriptor instead.
+func (*GetAllExpenseResponse) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *GetAllExpenseResponse) GetTotalPageCount() int32 {
+	if x != nil {
+		return x.TotalPageCount
+	}
+	return 0
+}
+
+func (x *GetAllExpenseResponse) GetExpenses() []*GetAllExpenseAbs {
+	if x != nil {
+		return x.Expenses
+	}
+	return nil
+}
+
+type GetAllExpenseAbs struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id"`
+	GivenDate     string                 `protobuf:"bytes,2,opt,name=givenDate,proto3" json:"givenDate"`
+	Category      *AbsCategory           `protobuf:"bytes,3,opt,name=category,proto3" json:"category"`
+	User          *GetUserByIdResponse   `protobuf:"bytes,4,opt,name=user,proto3" json:"user"`
+	ExpenseType   string                 `protobuf:"bytes,5,opt,name=expenseType,proto3" json:"expenseType"`
+	Sum           string                 `protobuf:"bytes,6,opt,name=sum,proto3" json:"sum"`
+	Creator       *GetUserByIdResponse   `protobuf:"bytes,7,opt,name=creator,proto3" json:"creator"`
+	PaymentType   string                 `protobuf:"bytes,8,opt,name=paymentType,proto3" json:"paymentType"`
+	CreatedAt     string                 `protobuf:"bytes,9,opt,name=createdAt,proto3" json:"createdAt"`
+	Title         string                 `protobuf:"bytes,10,opt,name=title,proto3" json:"title"`
+	Status        string                 `protobuf:"bytes,11,opt,name=status,proto3" json:"status"`
+	ApproverRole  string                 `protobuf:"bytes,12,opt,name=approverRole,proto3" json:"approverRole"`
+	VendorId      string                 `protobuf:"bytes,13,opt,name=vendorId,proto3" json:"vendorId"`
+	VendorName    string                 `protobuf:"bytes,14,opt,name=vendorName,proto3" json:"vendorName"`
+	ReceiptCount  int32                  `protobuf:"varint,15,opt,name=receiptCount,proto3" json:"receiptCount"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAllExpenseAbs) Reset() {
+	*x = GetAllExpenseAbs{}
+	mi := &file_finance_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAllExpenseAbs) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAllExpenseAbs) ProtoMessage() {}
+
+func (x *GetAllExpenseAbs) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAllExpenseAbs.ProtoReflect.Descriptor instead.
+func (*GetAllExpenseAbs) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *GetAllExpenseAbs) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *GetAllExpenseAbs) GetGivenDate() string {
+	if x != nil {
+		return x.GivenDate
+	}
+	return ""
+}
+
+func (x *GetAllExpenseAbs) GetCategory() *AbsCategory {
+	if x != nil {
+		return x.Category
+	}
+	return nil
+}
+
+func (x *GetAllExpenseAbs) GetUser() *GetUserByIdResponse {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+func (x *GetAllExpenseAbs) GetExpenseType() string {
+	if x != nil {
+		return x.ExpenseType
+	}
+	return ""
+}
+
+func (x *GetAllExpenseAbs) GetSum() string {
+	if x != nil {
+		return x.Sum
+	}
+	return ""
+}
+
+func (x *GetAllExpenseAbs) GetCreator() *GetUserByIdResponse {
+	if x != nil {
+		return x.Creator
+	}
+	return nil
+}
+
+func (x *GetAllExpenseAbs) GetPaymentType() string {
+	if x != nil {
+		return x.PaymentType
+	}
+	return ""
+}
+
+func (x *GetAllExpenseAbs) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *GetAllExpenseAbs) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *GetAllExpenseAbs) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *GetAllExpenseAbs) GetApproverRole() string {
+	if x != nil {
+		return x.ApproverRole
+	}
+	return ""
+}
+
+func (x *GetAllExpenseAbs) GetVendorId() string {
+	if x != nil {
+		return x.VendorId
+	}
+	return ""
+}
+
+func (x *GetAllExpenseAbs) GetVendorName() string {
+	if x != nil {
+		return x.VendorName
+	}
+	return ""
+}
+
+func (x *GetAllExpenseAbs) GetReceiptCount() int32 {
+	if x != nil {
+		return x.ReceiptCount
+	}
+	return 0
+}
+
+type CreateExpenseRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Title         string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title"`
+	GivenDate     string                 `protobuf:"bytes,2,opt,name=givenDate,proto3" json:"givenDate"`
+	ExpenseType   string                 `protobuf:"bytes,3,opt,name=expenseType,proto3" json:"expenseType"`
+	CategoryId    string                 `protobuf:"bytes,4,opt,name=categoryId,proto3" json:"categoryId"`
+	UserId        string                 `protobuf:"bytes,5,opt,name=userId,proto3" json:"userId"`
+	Sum           string                 `protobuf:"bytes,6,opt,name=sum,proto3" json:"sum"`
+	CreatedById   string                 `protobuf:"bytes,7,opt,name=createdById,proto3" json:"createdById"`
+	PaymentMethod string                 `protobuf:"bytes,8,opt,name=paymentMethod,proto3" json:"paymentMethod"`
+	VendorId      string                 `protobuf:"bytes,9,opt,name=vendorId,proto3" json:"vendorId"`
+	AsDraft       bool                   `protobuf:"varint,10,opt,name=asDraft,proto3" json:"asDraft"`
+	CreatedByName string                 `protobuf:"bytes,11,opt,name=createdByName,proto3" json:"createdByName"`
+	CreatedByRole string                 `protobuf:"bytes,12,opt,name=createdByRole,proto3" json:"createdByRole"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateExpenseRequest) Reset() {
+	*x = CreateExpenseRequest{}
+	mi := &file_finance_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateExpenseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateExpenseRequest) ProtoMessage() {}
+
+func (x *CreateExpenseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateExpenseRequest.ProtoReflect.Descriptor instead.
+func (*CreateExpenseRequest) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *CreateExpenseRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *CreateExpenseRequest) GetGivenDate() string {
+	if x != nil {
+		return x.GivenDate
+	}
+	return ""
+}
+
+func (x *CreateExpenseRequest) GetExpenseType() string {
+	if x != nil {
+		return x.ExpenseType
+	}
+	return ""
+}
+
+func (x *CreateExpenseRequest) GetCategoryId() string {
+	if x != nil {
+		return x.CategoryId
+	}
+	return ""
+}
+
+func (x *CreateExpenseRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *CreateExpenseRequest) GetSum() string {
+	if x != nil {
+		return x.Sum
+	}
+	return ""
+}
+
+func (x *CreateExpenseRequest) GetCreatedById() string {
+	if x != nil {
+		return x.CreatedById
+	}
+	return ""
+}
+
+func (x *CreateExpenseRequest) GetPaymentMethod() string {
+	if x != nil {
+		return x.PaymentMethod
+	}
+	return ""
+}
+
+func (x *CreateExpenseRequest) GetVendorId() string {
+	if x != nil {
+		return x.VendorId
+	}
+	return ""
+}
+
+func (x *CreateExpenseRequest) GetAsDraft() bool {
+	if x != nil {
+		return x.AsDraft
+	}
+	return false
+}
+
+func (x *CreateExpenseRequest) GetCreatedByName() string {
+	if x != nil {
+		return x.CreatedByName
+	}
+	return ""
+}
+
+func (x *CreateExpenseRequest) GetCreatedByRole() string {
+	if x != nil {
+		return x.CreatedByRole
+	}
+	return ""
+}
+
+type CreateRecurringExpenseRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Title         string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title"`
+	ExpenseType   string                 `protobuf:"bytes,2,opt,name=expenseType,proto3" json:"expenseType"`
+	CategoryId    string                 `protobuf:"bytes,3,opt,name=categoryId,proto3" json:"categoryId"`
+	UserId        string                 `protobuf:"bytes,4,opt,name=userId,proto3" json:"userId"`
+	Sum           string                 `protobuf:"bytes,5,opt,name=sum,proto3" json:"sum"`
+	PaymentMethod string                 `protobuf:"bytes,6,opt,name=paymentMethod,proto3" json:"paymentMethod"`
+	Schedule      string                 `protobuf:"bytes,7,opt,name=schedule,proto3" json:"schedule"`
+	Day           int32                  `protobuf:"varint,8,opt,name=day,proto3" json:"day"`
+	StartDate     string                 `protobuf:"bytes,9,opt,name=startDate,proto3" json:"startDate"`
+	EndDate       string                 `protobuf:"bytes,10,opt,name=endDate,proto3" json:"endDate"`
+	CreatedById   string                 `protobuf:"bytes,11,opt,name=createdById,proto3" json:"createdById"`
+	VendorId      string                 `protobuf:"bytes,12,opt,name=vendorId,proto3" json:"vendorId"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateRecurringExpenseRequest) Reset() {
+	*x = CreateRecurringExpenseRequest{}
+	mi := &file_finance_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateRecurringExpenseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateRecurringExpenseRequest) ProtoMessage() {}
+
+func (x *CreateRecurringExpenseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateRecurringExpenseRequest.ProtoReflect.Descriptor instead.
+func (*CreateRecurringExpenseRequest) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *CreateRecurringExpenseRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *CreateRecurringExpenseRequest) GetExpenseType() string {
+	if x != nil {
+		return x.ExpenseType
+	}
+	return ""
+}
+
+func (x *CreateRecurringExpenseRequest) GetCategoryId() string {
+	if x != nil {
+		return x.CategoryId
+	}
+	return ""
+}
+
+func (x *CreateRecurringExpenseRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *CreateRecurringExpenseRequest) GetSum() string {
+	if x != nil {
+		return x.Sum
+	}
+	return ""
+}
+
+func (x *CreateRecurringExpenseRequest) GetPaymentMethod() string {
+	if x != nil {
+		return x.PaymentMethod
+	}
+	return ""
+}
+
+func (x *CreateRecurringExpenseRequest) GetSchedule() string {
+	if x != nil {
+		return x.Schedule
+	}
+	return ""
+}
+
+func (x *CreateRecurringExpenseRequest) GetDay() int32 {
+	if x != nil {
+		return x.Day
+	}
+	return 0
+}
+
+func (x *CreateRecurringExpenseRequest) GetStartDate() string {
+	if x != nil {
+		return x.StartDate
+	}
+	return ""
+}
+
+func (x *CreateRecurringExpenseRequest) GetEndDate() string {
+	if x != nil {
+		return x.EndDate
+	}
+	return ""
+}
+
+func (x *CreateRecurringExpenseRequest) GetCreatedById() string {
+	if x != nil {
+		return x.CreatedById
+	}
+	return ""
+}
+
+func (x *CreateRecurringExpenseRequest) GetVendorId() string {
+	if x != nil {
+		return x.VendorId
+	}
+	return ""
+}
+
+type GetRecurringExpensesResponse struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	RecurringExpenses []*AbsRecurringExpense `protobuf:"bytes,1,rep,name=recurringExpenses,proto3" json:"recurringExpenses"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *GetRecurringExpensesResponse) Reset() {
+	*x = GetRecurringExpensesResponse{}
+	mi := &file_finance_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRecurringExpensesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRecurringExpensesResponse) ProtoMessage() {}
+
+func (x *GetRecurringExpensesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRecurringExpensesResponse.ProtoReflect.Descriptor instead.
+func (*GetRecurringExpensesResponse) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *GetRecurringExpensesResponse) GetRecurringExpenses() []*AbsRecurringExpense {
+	if x != nil {
+		return x.RecurringExpenses
+	}
+	return nil
+}
+
+type AbsRecurringExpense struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id"`
+	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title"`
+	ExpenseType   string                 `protobuf:"bytes,3,opt,name=expenseType,proto3" json:"expenseType"`
+	CategoryId    string                 `protobuf:"bytes,4,opt,name=categoryId,proto3" json:"categoryId"`
+	CategoryName  string                 `protobuf:"bytes,5,opt,name=categoryName,proto3" json:"categoryName"`
+	UserId        string                 `protobuf:"bytes,6,opt,name=userId,proto3" json:"userId"`
+	Sum           float64                `protobuf:"fixed64,7,opt,name=sum,proto3" json:"sum"`
+	PaymentMethod string                 `protobuf:"bytes,8,opt,name=paymentMethod,proto3" json:"paymentMethod"`
+	Schedule      string                 `protobuf:"bytes,9,opt,name=schedule,proto3" json:"schedule"`
+	Day           int32                  `protobuf:"varint,10,opt,name=day,proto3" json:"day"`
+	StartDate     string                 `protobuf:"bytes,11,opt,name=startDate,proto3" json:"startDate"`
+	EndDate       string                 `protobuf:"bytes,12,opt,name=endDate,proto3" json:"endDate"`
+	NextRunDate   string                 `protobuf:"bytes,13,opt,name=nextRunDate,proto3" json:"nextRunDate"`
+	IsActive      bool                   `protobuf:"varint,14,opt,name=isActive,proto3" json:"isActive"`
+	CreatedAt     string                 `protobuf:"bytes,15,opt,name=createdAt,proto3" json:"createdAt"`
+	VendorId      string                 `protobuf:"bytes,16,opt,name=vendorId,proto3" json:"vendorId"`
+	VendorName    string                 `protobuf:"bytes,17,opt,name=vendorName,proto3" json:"vendorName"`
+	// occurrences that fell into a closed accounting period and were not posted, yyyy-MM-dd
+	SkippedDates  []string `protobuf:"bytes,18,rep,name=skippedDates,proto3" json:"skippedDates"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AbsRecurringExpense) Reset() {
+	*x = AbsRecurringExpense{}
+	mi := &file_finance_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AbsRecurringExpense) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AbsRecurringExpense) ProtoMessage() {}
+
+func (x *AbsRecurringExpense) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AbsRecurringExpense.ProtoReflect.Descriptor instead.
+func (*AbsRecurringExpense) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *AbsRecurringExpense) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *AbsRecurringExpense) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *AbsRecurringExpense) GetExpenseType() string {
+	if x != nil {
+		return x.ExpenseType
+	}
+	return ""
+}
+
+func (x *AbsRecurringExpense) GetCategoryId() string {
+	if x != nil {
+		return x.CategoryId
+	}
+	return ""
+}
+
+func (x *AbsRecurringExpense) GetCategoryName() string {
+	if x != nil {
+		return x.CategoryName
+	}
+	return ""
+}
+
+func (x *AbsRecurringExpense) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *AbsRecurringExpense) GetSum() float64 {
+	if x != nil {
+		return x.Sum
+	}
+	return 0
+}
+
+func (x *AbsRecurringExpense) GetPaymentMethod() string {
+	if x != nil {
+		return x.PaymentMethod
+	}
+	return ""
+}
+
+func (x *AbsRecurringExpense) GetSchedule() string {
+	if x != nil {
+		return x.Schedule
+	}
+	return ""
+}
+
+func (x *AbsRecurringExpense) GetDay() int32 {
+	if x != nil {
+		return x.Day
+	}
+	return 0
+}
+
+func (x *AbsRecurringExpense) GetStartDate() string {
+	if x != nil {
+		return x.StartDate
+	}
+	return ""
+}
+
+func (x *AbsRecurringExpense) GetEndDate() string {
+	if x != nil {
+		return x.EndDate
+	}
+	return ""
+}
+
+func (x *AbsRecurringExpense) GetNextRunDate() string {
+	if x != nil {
+		return x.NextRunDate
+	}
+	return ""
+}
+
+func (x *AbsRecurringExpense) GetIsActive() bool {
+	if x != nil {
+		return x.IsActive
+	}
+	return false
+}
+
+func (x *AbsRecurringExpense) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *AbsRecurringExpense) GetVendorId() string {
+	if x != nil {
+		return x.VendorId
+	}
+	return ""
+}
+
+func (x *AbsRecurringExpense) GetVendorName() string {
+	if x != nil {
+		return x.VendorName
+	}
+	return ""
+}
+
+func (x *AbsRecurringExpense) GetSkippedDates() []string {
+	if x != nil {
+		return x.SkippedDates
+	}
+	return nil
+}
+
+type GetBudgetAlertsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Period        string                 `protobuf:"bytes,1,opt,name=period,proto3" json:"period"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetBudgetAlertsRequest) Reset() {
+	*x = GetBudgetAlertsRequest{}
+	mi := &file_finance_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBudgetAlertsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBudgetAlertsRequest) ProtoMessage() {}
+
+func (x *GetBudgetAlertsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBudgetAlertsRequest.ProtoReflect.Descriptor instead.
+func (*GetBudgetAlertsRequest) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *GetBudgetAlertsRequest) GetPeriod() string {
+	if x != nil {
+		return x.Period
+	}
+	return ""
+}
+
+type GetBudgetAlertsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Alerts        []*AbsBudgetAlert      `protobuf:"bytes,1,rep,name=alerts,proto3" json:"alerts"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetBudgetAlertsResponse) Reset() {
+	*x = GetBudgetAlertsResponse{}
+	mi := &file_finance_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBudgetAlertsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBudgetAlertsResponse) ProtoMessage() {}
+
+func (x *GetBudgetAlertsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBudgetAlertsResponse.ProtoReflect.Descriptor instead.
+func (*GetBudgetAlertsResponse) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *GetBudgetAlertsResponse) GetAlerts() []*AbsBudgetAlert {
+	if x != nil {
+		return x.Alerts
+	}
+	return nil
+}
+
+type AbsBudgetAlert struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CategoryId    string                 `protobuf:"bytes,1,opt,name=categoryId,proto3" json:"categoryId"`
+	CategoryName  string                 `protobuf:"bytes,2,opt,name=categoryName,proto3" json:"categoryName"`
+	Period        string                 `protobuf:"bytes,3,opt,name=period,proto3" json:"period"`
+	Threshold     int32                  `protobuf:"varint,4,opt,name=threshold,proto3" json:"threshold"`
+	Budget        float64                `protobuf:"fixed64,5,opt,name=budget,proto3" json:"budget"`
+	Actual        float64                `protobuf:"fixed64,6,opt,name=actual,proto3" json:"actual"`
+	CreatedAt     string                 `protobuf:"bytes,7,opt,name=createdAt,proto3" json:"createdAt"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AbsBudgetAlert) Reset() {
+	*x = AbsBudgetAlert{}
+	mi := &file_finance_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AbsBudgetAlert) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AbsBudgetAlert) ProtoMessage() {}
+
+func (x *AbsBudgetAlert) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AbsBudgetAlert.ProtoReflect.Descriptor instead.
+func (*AbsBudgetAlert) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *AbsBudgetAlert) GetCategoryId() string {
+	if x != nil {
+		return x.CategoryId
+	}
+	return ""
+}
+
+func (x *AbsBudgetAlert) GetCategoryName() string {
+	if x != nil {
+		return x.CategoryName
+	}
+	return ""
+}
+
+func (x *AbsBudgetAlert) GetPeriod() string {
+	if x != nil {
+		return x.Period
+	}
+	return ""
+}
+
+func (x *AbsBudgetAlert) GetThreshold() int32 {
+	if x != nil {
+		return x.Threshold
+	}
+	return 0
+}
+
+func (x *AbsBudgetAlert) GetBudget() float64 {
+	if x != nil {
+		return x.Budget
+	}
+	return 0
+}
+
+func (x *AbsBudgetAlert) GetActual() float64 {
+	if x != nil {
+		return x.Actual
+	}
+	return 0
+}
+
+func (x *AbsBudgetAlert) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+type GetExpenseByIdRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetExpenseByIdRequest) Reset() {
+	*x = GetExpenseByIdRequest{}
+	mi := &file_finance_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetExpenseByIdRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetExpenseByIdRequest) ProtoMessage() {}
+
+func (x *GetExpenseByIdRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetExpenseByIdRequest.ProtoReflect.Descriptor instead.
+func (*GetExpenseByIdRequest) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *GetExpenseByIdRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type ExpenseDetail struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Expense       *GetAllExpenseAbs       `protobuf:"bytes,1,opt,name=expense,proto3" json:"expense"`
+	Receipts      []*ExpenseReceipt       `protobuf:"bytes,2,rep,name=receipts,proto3" json:"receipts"`
+	History       []*ExpenseStatusHistory `protobuf:"bytes,3,rep,name=history,proto3" json:"history"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExpenseDetail) Reset() {
+	*x = ExpenseDetail{}
+	mi := &file_finance_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExpenseDetail) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExpenseDetail) ProtoMessage() {}
+
+func (x *ExpenseDetail) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExpenseDetail.ProtoReflect.Descriptor instead.
+func (*ExpenseDetail) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *ExpenseDetail) GetExpense() *GetAllExpenseAbs {
+	if x != nil {
+		return x.Expense
+	}
+	return nil
+}
+
+func (x *ExpenseDetail) GetReceipts() []*ExpenseReceipt {
+	if x != nil {
+		return x.Receipts
+	}
+	return nil
+}
+
+func (x *ExpenseDetail) GetHistory() []*ExpenseStatusHistory {
+	if x != nil {
+		return x.History
+	}
+	return nil
+}
+
+type ExpenseReceipt struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id"`
+	FileUrl       string                 `protobuf:"bytes,2,opt,name=fileUrl,proto3" json:"fileUrl"`
+	FileName      string                 `protobuf:"bytes,3,opt,name=fileName,proto3" json:"fileName"`
+	UploadedById  string                 `protobuf:"bytes,4,opt,name=uploadedById,proto3" json:"uploadedById"`
+	CreatedAt     string                 `protobuf:"bytes,5,opt,name=createdAt,proto3" json:"createdAt"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExpenseReceipt) Reset() {
+	*x = ExpenseReceipt{}
+	mi := &file_finance_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExpenseReceipt) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExpenseReceipt) ProtoMessage() {}
+
+func (x *ExpenseReceipt) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExpenseReceipt.ProtoReflect.Descriptor instead.
+func (*ExpenseReceipt) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *ExpenseReceipt) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ExpenseReceipt) GetFileUrl() string {
+	if x != nil {
+		return x.FileUrl
+	}
+	return ""
+}
+
+func (x *ExpenseReceipt) GetFileName() string {
+	if x != nil {
+		return x.FileName
+	}
+	return ""
+}
+
+func (x *ExpenseReceipt) GetUploadedById() string {
+	if x != nil {
+		return x.UploadedById
+	}
+	return ""
+}
+
+func (x *ExpenseReceipt) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+type ExpenseStatusHistory struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FromStatus    string                 `protobuf:"bytes,1,opt,name=fromStatus,proto3" json:"fromStatus"`
+	ToStatus      string                 `protobuf:"bytes,2,opt,name=toStatus,proto3" json:"toStatus"`
+	Comment       string                 `protobuf:"bytes,3,opt,name=comment,proto3" json:"comment"`
+	ActionById    string                 `protobuf:"bytes,4,opt,name=actionById,proto3" json:"actionById"`
+	ActionByName  string                 `protobuf:"bytes,5,opt,name=actionByName,proto3" json:"actionByName"`
+	CreatedAt     string                 `protobuf:"bytes,6,opt,name=createdAt,proto3" json:"createdAt"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExpenseStatusHistory) Reset() {
+	*x = ExpenseStatusHistory{}
+	mi := &file_finance_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExpenseStatusHistory) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExpenseStatusHistory) ProtoMessage() {}
+
+func (x *ExpenseStatusHistory) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExpenseStatusHistory.ProtoReflect.Descriptor instead.
+func (*ExpenseStatusHistory) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *ExpenseStatusHistory) GetFromStatus() string {
+	if x != nil {
+		return x.FromStatus
+	}
+	return ""
+}
+
+func (x *ExpenseStatusHistory) GetToStatus() string {
+	if x != nil {
+		return x.ToStatus
+	}
+	return ""
+}
+
+func (x *ExpenseStatusHistory) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+func (x *ExpenseStatusHistory) GetActionById() string {
+	if x != nil {
+		return x.ActionById
+	}
+	return ""
+}
+
+func (x *ExpenseStatusHistory) GetActionByName() string {
+	if x != nil {
+		return x.ActionByName
+	}
+	return ""
+}
+
+func (x *ExpenseStatusHistory) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+type ExpenseActionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id"`
+	Comment       string                 `protobuf:"bytes,2,opt,name=comment,proto3" json:"comment"`
+	ActionById    string                 `protobuf:"bytes,3,opt,name=actionById,proto3" json:"actionById"`
+	ActionByName  string                 `protobuf:"bytes,4,opt,name=actionByName,proto3" json:"actionByName"`
+	ActionByRole  string                 `protobuf:"bytes,5,opt,name=actionByRole,proto3" json:"actionByRole"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExpenseActionRequest) Reset() {
+	*x = ExpenseActionRequest{}
+	mi := &file_finance_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExpenseActionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExpenseActionRequest) ProtoMessage() {}
+
+func (x *ExpenseActionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExpenseActionRequest.ProtoReflect.Descriptor instead.
+func (*ExpenseActionRequest) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *ExpenseActionRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ExpenseActionRequest) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+func (x *ExpenseActionRequest) GetActionById() string {
+	if x != nil {
+		return x.ActionById
+	}
+	return ""
+}
+
+func (x *ExpenseActionRequest) GetActionByName() string {
+	if x != nil {
+		return x.ActionByName
+	}
+	return ""
+}
+
+func (x *ExpenseActionRequest) GetActionByRole() string {
+	if x != nil {
+		return x.ActionByRole
+	}
+	return ""
+}
+
+type AddExpenseReceiptRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ExpenseId     string                 `protobuf:"bytes,1,opt,name=expenseId,proto3" json:"expenseId"`
+	FileUrl       string                 `protobuf:"bytes,2,opt,name=fileUrl,proto3" json:"fileUrl"`
+	FileName      string                 `protobuf:"bytes,3,opt,name=fileName,proto3" json:"fileName"`
+	UploadedById  string                 `protobuf:"bytes,4,opt,name=uploadedById,proto3" json:"uploadedById"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddExpenseReceiptRequest) Reset() {
+	*x = AddExpenseReceiptRequest{}
+	mi := &file_finance_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddExpenseReceiptRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddExpenseReceiptRequest) ProtoMessage() {}
+
+func (x *AddExpenseReceiptRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddExpenseReceiptRequest.ProtoReflect.Descriptor instead.
+func (*AddExpenseReceiptRequest) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *AddExpenseReceiptRequest) GetExpenseId() string {
+	if x != nil {
+		return x.ExpenseId
+	}
+	return ""
+}
+
+func (x *AddExpenseReceiptRequest) GetFileUrl() string {
+	if x != nil {
+		return x.FileUrl
+	}
+	return ""
+}
+
+func (x *AddExpenseReceiptRequest) GetFileName() string {
+	if x != nil {
+		return x.FileName
+	}
+	return ""
+}
+
+func (x *AddExpenseReceiptRequest) GetUploadedById() string {
+	if x != nil {
+		return x.UploadedById
+	}
+	return ""
+}
+
+type ExpenseApprovalSetting struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	FinancistThreshold float64                `protobuf:"fixed64,1,opt,name=financistThreshold,proto3" json:"financistThreshold"`
+	CeoThreshold       float64                `protobuf:"fixed64,2,opt,name=ceoThreshold,proto3" json:"ceoThreshold"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *ExpenseApprovalSetting) Reset() {
+	*x = ExpenseApprovalSetting{}
+	mi := &file_finance_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExpenseApprovalSetting) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExpenseApprovalSetting) ProtoMessage() {}
+
+func (x *ExpenseApprovalSetting) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExpenseApprovalSetting.ProtoReflect.Descriptor instead.
+func (*ExpenseApprovalSetting) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *ExpenseApprovalSetting) GetFinancistThreshold() float64 {
+	if x != nil {
+		return x.FinancistThreshold
+	}
+	return 0
+}
+
+func (x *ExpenseApprovalSetting) GetCeoThreshold() float64 {
+	if x != nil {
+		return x.CeoThreshold
+	}
+	return 0
+}
+
+type CreateVendorRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name"`
+	Phone         string                 `protobuf:"bytes,2,opt,name=phone,proto3" json:"phone"`
+	Tin           string                 `protobuf:"bytes,3,opt,name=tin,proto3" json:"tin"`
+	Comment       string                 `protobuf:"bytes,4,opt,name=comment,proto3" json:"comment"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateVendorRequest) Reset() {
+	*x = CreateVendorRequest{}
+	mi := &file_finance_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateVendorRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateVendorRequest) ProtoMessage() {}
+
+func (x *CreateVendorRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateVendorRequest.ProtoReflect.Descriptor instead.
+func (*CreateVendorRequest) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *CreateVendorRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateVendorRequest) GetPhone() string {
+	if x != nil {
+		return x.Phone
+	}
+	return ""
+}
+
+func (x *CreateVendorRequest) GetTin() string {
+	if x != nil {
+		return x.Tin
+	}
+	return ""
+}
+
+func (x *CreateVendorRequest) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+type AbsVendor struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id"`
+	Name            string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name"`
+	Phone           string                 `protobuf:"bytes,3,opt,name=phone,proto3" json:"phone"`
+	Tin             string                 `protobuf:"bytes,4,opt,name=tin,proto3" json:"tin"`
+	Comment         string                 `protobuf:"bytes,5,opt,name=comment,proto3" json:"comment"`
+	IsActive        bool                   `protobuf:"varint,6,opt,name=isActive,proto3" json:"isActive"`
+	TotalSpent      float64                `protobuf:"fixed64,7,opt,name=totalSpent,proto3" json:"totalSpent"`
+	ExpenseCount    int32                  `protobuf:"varint,8,opt,name=expenseCount,proto3" json:"expenseCount"`
+	LastExpenseDate string                 `protobuf:"bytes,9,opt,name=lastExpenseDate,proto3" json:"lastExpenseDate"`
+	CreatedAt       string                 `protobuf:"bytes,10,opt,name=createdAt,proto3" json:"createdAt"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *AbsVendor) Reset() {
+	*x = AbsVendor{}
+	mi := &file_finance_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AbsVendor) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AbsVendor) ProtoMessage() {}
+
+func (x *AbsVendor) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AbsVendor.ProtoReflect.Descriptor instead.
+func (*AbsVendor) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *AbsVendor) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *AbsVendor) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *AbsVendor) GetPhone() string {
+	if x != nil {
+		return x.Phone
+	}
+	return ""
+}
+
+func (x *AbsVendor) GetTin() string {
+	if x != nil {
+		return x.Tin
+	}
+	return ""
+}
+
+func (x *AbsVendor) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+func (x *AbsVendor) GetIsActive() bool {
+	if x != nil {
+		return x.IsActive
+	}
+	return false
+}
+
+func (x *AbsVendor) GetTotalSpent() float64 {
+	if x != nil {
+		return x.TotalSpent
+	}
+	return 0
+}
+
+func (x *AbsVendor) GetExpenseCount() int32 {
+	if x != nil {
+		return x.ExpenseCount
+	}
+	return 0
+}
+
+func (x *AbsVendor) GetLastExpenseDate() string {
+	if x != nil {
+		return x.LastExpenseDate
+	}
+	return ""
+}
+
+func (x *AbsVendor) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+type GetVendorsRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	IncludeInactive bool                   `protobuf:"varint,1,opt,name=includeInactive,proto3" json:"includeInactive"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *GetVendorsRequest) Reset() {
+	*x = GetVendorsRequest{}
+	mi := &file_finance_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetVendorsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetVendorsRequest) ProtoMessage() {}
+
+func (x *GetVendorsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetVendorsRequest.ProtoReflect.Descriptor instead.
+func (*GetVendorsRequest) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *GetVendorsRequest) GetIncludeInactive() bool {
+	if x != nil {
+		return x.IncludeInactive
+	}
+	return false
+}
+
+type GetVendorsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Vendors       []*AbsVendor           `protobuf:"bytes,1,rep,name=vendors,proto3" json:"vendors"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetVendorsResponse) Reset() {
+	*x = GetVendorsResponse{}
+	mi := &file_finance_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetVendorsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetVendorsResponse) ProtoMessage() {}
+
+func (x *GetVendorsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetVendorsResponse.ProtoReflect.Descriptor instead.
+func (*GetVendorsResponse) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *GetVendorsResponse) GetVendors() []*AbsVendor {
+	if x != nil {
+		return x.Vendors
+	}
+	return nil
+}
+
+type GetVendorSpendHistoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	VendorId      string                 `protobuf:"bytes,1,opt,name=vendorId,proto3" json:"vendorId"`
+	From          string                 `protobuf:"bytes,2,opt,name=from,proto3" json:"from"`
+	To            string                 `protobuf:"bytes,3,opt,name=to,proto3" json:"to"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetVendorSpendHistoryRequest) Reset() {
+	*x = GetVendorSpendHistoryRequest{}
+	mi := &file_finance_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetVendorSpendHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetVendorSpendHistoryRequest) ProtoMessage() {}
+
+func (x *GetVendorSpendHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetVendorSpendHistoryRequest.ProtoReflect.Descriptor instead.
+func (*GetVendorSpendHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *GetVendorSpendHistoryRequest) GetVendorId() string {
+	if x != nil {
+		return x.VendorId
+	}
+	return ""
+}
+
+func (x *GetVendorSpendHistoryRequest) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *GetVendorSpendHistoryRequest) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+type GetVendorSpendHistoryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Vendor        *AbsVendor             `protobuf:"bytes,1,opt,name=vendor,proto3" json:"vendor"`
+	Months        []*VendorMonthSpend    `protobuf:"bytes,2,rep,name=months,proto3" json:"months"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetVendorSpendHistoryResponse) Reset() {
+	*x = GetVendorSpendHistoryResponse{}
+	mi := &file_finance_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetVendorSpendHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetVendorSpendHistoryResponse) ProtoMessage() {}
+
+func (x *GetVendorSpendHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetVendorSpendHistoryResponse.ProtoReflect.Descriptor instead.
+func (*GetVendorSpendHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *GetVendorSpendHistoryResponse) GetVendor() *AbsVendor {
+	if x != nil {
+		return x.Vendor
+	}
+	return nil
+}
+
+func (x *GetVendorSpendHistoryResponse) GetMonths() []*VendorMonthSpend {
+	if x != nil {
+		return x.Months
+	}
+	return nil
+}
+
+type VendorMonthSpend struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Month         string                 `protobuf:"bytes,1,opt,name=month,proto3" json:"month"`
+	Amount        float64                `protobuf:"fixed64,2,opt,name=amount,proto3" json:"amount"`
+	ExpenseCount  int32                  `protobuf:"varint,3,opt,name=expenseCount,proto3" json:"expenseCount"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VendorMonthSpend) Reset() {
+	*x = VendorMonthSpend{}
+	mi := &file_finance_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VendorMonthSpend) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VendorMonthSpend) ProtoMessage() {}
+
+func (x *VendorMonthSpend) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VendorMonthSpend.ProtoReflect.Descriptor instead.
+func (*VendorMonthSpend) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *VendorMonthSpend) GetMonth() string {
+	if x != nil {
+		return x.Month
+	}
+	return ""
+}
+
+func (x *VendorMonthSpend) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *VendorMonthSpend) GetExpenseCount() int32 {
+	if x != nil {
+		return x.ExpenseCount
+	}
+	return 0
+}
+
+type GetIncomeChartRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	From          string                 `protobuf:"bytes,1,opt,name=from,proto3" json:"from"`
+	To            string                 `protobuf:"bytes,2,opt,name=to,proto3" json:"to"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetIncomeChartRequest) Reset() {
+	*x = GetIncomeChartRequest{}
+	mi := &file_finance_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetIncomeChartRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetIncomeChartRequest) ProtoMessage() {}
+
+func (x *GetIncomeChartRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetIncomeChartRequest.ProtoReflect.Descriptor instead.
+func (*GetIncomeChartRequest) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *GetIncomeChartRequest) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *GetIncomeChartRequest) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+type GetIncomeChartResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Response      []*AbsIncomeChart      `protobuf:"bytes,1,rep,name=response,proto3" json:"response"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetIncomeChartResponse) Reset() {
+	*x = GetIncomeChartResponse{}
+	mi := &file_finance_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetIncomeChartResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetIncomeChartResponse) ProtoMessage() {}
+
+func (x *GetIncomeChartResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetIncomeChartResponse.ProtoReflect.Descriptor instead.
+func (*GetIncomeChartResponse) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *GetIncomeChartResponse) GetResponse() []*AbsIncomeChart {
+	if x != nil {
+		return x.Response
+	}
+	return nil
+}
+
+type AbsIncomeChart struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SpecificMonth string                 `protobuf:"bytes,1,opt,name=specificMonth,proto3" json:"specificMonth"`
+	Balance       string                 `protobuf:"bytes,2,opt,name=balance,proto3" json:"balance"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AbsIncomeChart) Reset() {
+	*x = AbsIncomeChart{}
+	mi := &file_finance_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AbsIncomeChart) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AbsIncomeChart) ProtoMessage() {}
+
+func (x *AbsIncomeChart) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AbsIncomeChart.ProtoReflect.Descriptor instead.
+func (*AbsIncomeChart) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *AbsIncomeChart) GetSpecificMonth() string {
+	if x != nil {
+		return x.SpecificMonth
+	}
+	return ""
+}
+
+func (x *AbsIncomeChart) GetBalance() string {
+	if x != nil {
+		return x.Balance
+	}
+	return ""
+}
+
+type GetCommonInformationResponse struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	DebtorsCount      int32                  `protobuf:"varint,1,opt,name=debtorsCount,proto3" json:"debtorsCount"`
+	PayInCurrentMonth int32                  `protobuf:"varint,2,opt,name=payInCurrentMonth,proto3" json:"payInCurrentMonth"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *GetCommonInformationResponse) Reset() {
+	*x = GetCommonInformationResponse{}
+	mi := &file_finance_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCommonInformationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCommonInformationResponse) ProtoMessage() {}
+
+func (x *GetCommonInformationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCommonInformationResponse.ProtoReflect.Descriptor instead.
+func (*GetCommonInformationResponse) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *GetCommonInformationResponse) GetDebtorsCount() int32 {
+	if x != nil {
+		return x.DebtorsCount
+	}
+	return 0
+}
+
+func (x *GetCommonInformationResponse) GetPayInCurrentMonth() int32 {
+	if x != nil {
+		return x.PayInCurrentMonth
+	}
+	return 0
+}
+
+type GetAllDebtsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PageParam     *PageRequest           `protobuf:"bytes,1,opt,name=pageParam,proto3" json:"pageParam"`
+	From          string                 `protobuf:"bytes,2,opt,name=from,proto3" json:"from"`
+	To            string                 `protobuf:"bytes,3,opt,name=to,proto3" json:"to"`
+	AmountFrom    int64                  `protobuf:"varint,4,opt,name=amountFrom,proto3" json:"amountFrom"`
+	AmountTo      int64                  `protobuf:"varint,5,opt,name=amountTo,proto3" json:"amountTo"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAllDebtsRequest) Reset() {
+	*x = GetAllDebtsRequest{}
+	mi := &file_finance_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAllDebtsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAllDebtsRequest) ProtoMessage() {}
+
+func (x *GetAllDebtsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAllDebtsRequest.ProtoReflect.Descriptor instead.
+func (*GetAllDebtsRequest) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *GetAllDebtsRequest) GetPageParam() *PageRequest {
+	if x != nil {
+		return x.PageParam
+	}
+	return nil
+}
+
+func (x *GetAllDebtsRequest) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *GetAllDebtsRequest) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+func (x *GetAllDebtsRequest) GetAmountFrom() int64 {
+	if x != nil {
+		return x.AmountFrom
+	}
+	return 0
+}
+
+func (x *GetAllDebtsRequest) GetAmountTo() int64 {
+	if x != nil {
+		return x.AmountTo
+	}
+	return 0
+}
+
+type GetAllDebtsInformationResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	TotalPageCount int32                  `protobuf:"varint,2,opt,name=totalPageCount,proto3" json:"totalPageCount"`
+	Debts          []*AbsDebtsInformation `protobuf:"bytes,1,rep,name=debts,proto3" json:"debts"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetAllDebtsInformationResponse) Reset() {
+	*x = GetAllDebtsInformationResponse{}
+	mi := &file_finance_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAllDebtsInformationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAllDebtsInformationResponse) ProtoMessage() {}
+
+func (x *GetAllDebtsInformationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAllDebtsInformationResponse.ProtoReflect.Descriptor instead.
+func (*GetAllDebtsInformationResponse) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *GetAllDebtsInformationResponse) GetTotalPageCount() int32 {
+	if x != nil {
+		return x.TotalPageCount
+	}
+	return 0
+}
+
+func (x *GetAllDebtsInformationResponse) GetDebts() []*AbsDebtsInformation {
+	if x != nil {
+		return x.Debts
+	}
+	return nil
+}
+
+type AbsDebtsInformation struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DebtorId      string                 `protobuf:"bytes,1,opt,name=debtorId,proto3" json:"debtorId"`
+	DebtorName    string                 `protobuf:"bytes,2,opt,name=debtorName,proto3" json:"debtorName"`
+	PhoneNumber   string                 `protobuf:"bytes,3,opt,name=phoneNumber,proto3" json:"phoneNumber"`
+	Balance       string                 `protobuf:"bytes,4,opt,name=balance,proto3" json:"balance"`
+	TotalOnPeriod string                 `protobuf:"bytes,5,opt,name=totalOnPeriod,proto3" json:"totalOnPeriod"`
+	Groups        []*DebtorGroup         `protobuf:"bytes,6,rep,name=groups,proto3" json:"groups"`
+	Comments      []*DebtorComment       `protobuf:"bytes,7,rep,name=comments,proto3" json:"comments"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AbsDebtsInformation) Reset() {
+	*x = AbsDebtsInformation{}
+	mi := &file_finance_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AbsDebtsInformation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AbsDebtsInformation) ProtoMessage() {}
+
+func (x *AbsDebtsInformation) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AbsDebtsInformation.ProtoReflect.Descriptor instead.
+func (*AbsDebtsInformation) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *AbsDebtsInformation) GetDebtorId() string {
+	if x != nil {
+		return x.DebtorId
+	}
+	return ""
+}
+
+func (x *AbsDebtsInformation) GetDebtorName() string {
+	if x != nil {
+		return x.DebtorName
+	}
+	return ""
+}
+
+func (x *AbsDebtsInformation) GetPhoneNumber() string {
+	if x != nil {
+		return x.PhoneNumber
+	}
+	return ""
+}
+
+func (x *AbsDebtsInformation) GetBalance() string {
+	if x != nil {
+		return x.Balance
+	}
+	return ""
+}
+
+func (x *AbsDebtsInformation) GetTotalOnPeriod() string {
+	if x != nil {
+		return x.TotalOnPeriod
+	}
+	return ""
+}
+
+func (x *AbsDebtsInformation) GetGroups() []*DebtorGroup {
+	if x != nil {
+		return x.Groups
+	}
+	return nil
+}
+
+func (x *AbsDebtsInformation) GetComments() []*DebtorComment {
+	if x != nil {
+		return x.Comments
+	}
+	return nil
+}
+
+type DebtorGroup struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GroupId       string                 `protobuf:"bytes,1,opt,name=groupId,proto3" json:"groupId"`
+	GroupName     string                 `protobuf:"bytes,2,opt,name=groupName,proto3" json:"groupName"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DebtorGroup) Reset() {
+	*x = DebtorGroup{}
+	mi := &file_finance_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DebtorGroup) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DebtorGroup) ProtoMessage() {}
+
+func (x *DebtorGroup) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DebtorGroup.ProtoReflect.Descriptor instead.
+func (*DebtorGroup) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *DebtorGroup) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+func (x *DebtorGroup) GetGroupName() string {
+	if x != nil {
+		return x.GroupName
+	}
+	return ""
+}
+
+type DebtorComment struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CommentId     string                 `protobuf:"bytes,1,opt,name=commentId,proto3" json:"commentId"`
+	Comment       string                 `protobuf:"bytes,2,opt,name=comment,proto3" json:"comment"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DebtorComment) Reset() {
+	*x = DebtorComment{}
+	mi := &file_finance_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DebtorComment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DebtorComment) ProtoMessage() {}
+
+func (x *DebtorComment) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DebtorComment.ProtoReflect.Descriptor instead.
+func (*DebtorComment) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *DebtorComment) GetCommentId() string {
+	if x != nil {
+		return x.CommentId
+	}
+	return ""
+}
+
+func (x *DebtorComment) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+type GetAllStudentPaymentsChartResponse struct {
+	state         protoimpl.MessageState    `protogen:"open.v1"`
+	Cash          string                    `protobuf:"bytes,1,opt,name=cash,proto3" json:"cash"`
+	Payme         string                    `protobuf:"bytes,2,opt,name=payme,proto3" json:"payme"`
+	Click         string                    `protobuf:"bytes,3,opt,name=click,proto3" json:"click"`
+	TotalRevenue  string                    `protobuf:"bytes,4,opt,name=totalRevenue,proto3" json:"totalRevenue"`
+	PaymentsChart []*AbsTakeOfChartResponse `protobuf:"bytes,5,rep,name=paymentsChart,proto3" json:"paymentsChart"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAllStudentPaymentsChartResponse) Reset() {
+	*x = GetAllStudentPaymentsChartResponse{}
+	mi := &file_finance_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAllStudentPaymentsChartResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAllStudentPaymentsChartResponse) ProtoMessage() {}
+
+func (x *GetAllStudentPaymentsChartResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAllStudentPaymentsChartResponse.ProtoReflect.Descriptor instead.
+func (*GetAllStudentPaymentsChartResponse) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *GetAllStudentPaymentsChartResponse) GetCash() string {
+	if x != nil {
+		return x.Cash
+	}
+	return ""
+}
+
+func (x *GetAllStudentPaymentsChartResponse) GetPayme() string {
+	if x != nil {
+		return x.Payme
+	}
+	return ""
+}
+
+func (x *GetAllStudentPaymentsChartResponse) GetClick() string {
+	if x != nil {
+		return x.Click
+	}
+	return ""
+}
+
+func (x *GetAllStudentPaymentsChartResponse) GetTotalRevenue() string {
+	if x != nil {
+		return x.TotalRevenue
+	}
+	return ""
+}
+
+func (x *GetAllStudentPaymentsChartResponse) GetPaymentsChart() []*AbsTakeOfChartResponse {
+	if x != nil {
+		return x.PaymentsChart
+	}
+	return nil
+}
+
+type GetAllStudentPaymentsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Page          *PageRequest           `protobuf:"bytes,6,opt,name=page,proto3" json:"page"`
+	From          string                 `protobuf:"bytes,1,opt,name=from,proto3" json:"from"`
+	To            string                 `protobuf:"bytes,2,opt,name=to,proto3" json:"to"`
+	Filters       []*Filters             `protobuf:"bytes,4,rep,name=filters,proto3" json:"filters"`
+	Sorts         []*SortBy              `protobuf:"bytes,5,rep,name=sorts,proto3" json:"sorts"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAllStudentPaymentsRequest) Reset() {
+	*x = GetAllStudentPaymentsRequest{}
+	mi := &file_finance_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAllStudentPaymentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAllStudentPaymentsRequest) ProtoMessage() {}
+
+func (x *GetAllStudentPaymentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAllStudentPaymentsRequest.ProtoReflect.Descriptor instead.
+func (*GetAllStudentPaymentsRequest) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *GetAllStudentPaymentsRequest) GetPage() *PageRequest {
+	if x != nil {
+		return x.Page
+	}
+	return nil
+}
+
+func (x *GetAllStudentPaymentsRequest) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *GetAllStudentPaymentsRequest) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+func (x *GetAllStudentPaymentsRequest) GetFilters() []*Filters {
+	if x != nil {
+		return x.Filters
+	}
+	return nil
+}
+
+func (x *GetAllStudentPaymentsRequest) GetSorts() []*SortBy {
+	if x != nil {
+		return x.Sorts
+	}
+	return nil
+}
+
+type Filters struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Field         string                 `protobuf:"bytes,1,opt,name=field,proto3" json:"field"`
+	Type          string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type"`
+	Value         string                 `protobuf:"bytes,3,opt,name=value,proto3" json:"value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Filters) Reset() {
+	*x = Filters{}
+	mi := &file_finance_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Filters) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Filters) ProtoMessage() {}
+
+func (x *Filters) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Filters.ProtoReflect.Descriptor instead.
+func (*Filters) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *Filters) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+func (x *Filters) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *Filters) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+type SortBy struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Field         string                 `protobuf:"bytes,1,opt,name=field,proto3" json:"field"`
+	Type          string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SortBy) Reset() {
+	*x = SortBy{}
+	mi := &file_finance_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SortBy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SortBy) ProtoMessage() {}
+
+func (x *SortBy) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SortBy.ProtoReflect.Descriptor instead.
+func (*SortBy) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *SortBy) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+func (x *SortBy) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+type GetAllStudentPaymentsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Payments      []*AbsStudentPayments  `protobuf:"bytes,1,rep,name=payments,proto3" json:"payments"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAllStudentPaymentsResponse) Reset() {
+	*x = GetAllStudentPaymentsResponse{}
+	mi := &file_finance_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAllStudentPaymentsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAllStudentPaymentsResponse) ProtoMessage() {}
+
+func (x *GetAllStudentPaymentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAllStudentPaymentsResponse.ProtoReflect.Descriptor instead.
+func (*GetAllStudentPaymentsResponse) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *GetAllStudentPaymentsResponse) GetPayments() []*AbsStudentPayments {
+	if x != nil {
+		return x.Payments
+	}
+	return nil
+}
+
+type AbsStudentPayments struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GivenDate     string                 `protobuf:"bytes,1,opt,name=givenDate,proto3" json:"givenDate"`
+	StudentId     string                 `protobuf:"bytes,2,opt,name=studentId,proto3" json:"studentId"`
+	StudentName   string                 `protobuf:"bytes,3,opt,name=studentName,proto3" json:"studentName"`
+	Amount        string                 `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount"`
+	Method        string                 `protobuf:"bytes,5,opt,name=method,proto3" json:"method"`
+	Comment       string                 `protobuf:"bytes,6,opt,name=comment,proto3" json:"comment"`
+	CreatorName   string                 `protobuf:"bytes,7,opt,name=creatorName,proto3" json:"creatorName"`
+	CreatorId     string                 `protobuf:"bytes,8,opt,name=creatorId,proto3" json:"creatorId"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AbsStudentPayments) Reset() {
+	*x = AbsStudentPayments{}
+	mi := &file_finance_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AbsStudentPayments) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AbsStudentPayments) ProtoMessage() {}
+
+func (x *AbsStudentPayments) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AbsStudentPayments.ProtoReflect.Descriptor instead.
+func (*AbsStudentPayments) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *AbsStudentPayments) GetGivenDate() string {
+	if x != nil {
+		return x.GivenDate
+	}
+	return ""
+}
+
+func (x *AbsStudentPayments) GetStudentId() string {
+	if x != nil {
+		return x.StudentId
+	}
+	return ""
+}
+
+func (x *AbsStudentPayments) GetStudentName() string {
+	if x != nil {
+		return x.StudentName
+	}
+	return ""
+}
+
+func (x *AbsStudentPayments) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *AbsStudentPayments) GetMethod() string {
+	if x != nil {
+		return x.Method
+	}
+	return ""
+}
+
+func (x *AbsStudentPayments) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+func (x *AbsStudentPayments) GetCreatorName() string {
+	if x != nil {
+		return x.CreatorName
+	}
+	return ""
+}
+
+func (x *AbsStudentPayments) GetCreatorId() string {
+	if x != nil {
+		return x.CreatorId
+	}
+	return ""
+}
+
+type GetAllPaymentTakeOffChartResponse struct {
+	state         protoimpl.MessageState    `protogen:"open.v1"`
+	ChartResponse []*AbsTakeOfChartResponse `protobuf:"bytes,1,rep,name=chartResponse,proto3" json:"chartResponse"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAllPaymentTakeOffChartResponse) Reset() {
+	*x = GetAllPaymentTakeOffChartResponse{}
+	mi := &file_finance_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAllPaymentTakeOffChartResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAllPaymentTakeOffChartResponse) ProtoMessage() {}
+
+func (x *GetAllPaymentTakeOffChartResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAllPaymentTakeOffChartResponse.ProtoReflect.Descriptor instead.
+func (*GetAllPaymentTakeOffChartResponse) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *GetAllPaymentTakeOffChartResponse) GetChartResponse() []*AbsTakeOfChartResponse {
+	if x != nil {
+		return x.ChartResponse
+	}
+	return nil
+}
+
+type AbsTakeOfChartResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	YearMonth     string                 `protobuf:"bytes,1,opt,name=yearMonth,proto3" json:"yearMonth"`
+	Amount        string                 `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AbsTakeOfChartResponse) Reset() {
+	*x = AbsTakeOfChartResponse{}
+	mi := &file_finance_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AbsTakeOfChartResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AbsTakeOfChartResponse) ProtoMessage() {}
+
+func (x *AbsTakeOfChartResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AbsTakeOfChartResponse.ProtoReflect.Descriptor instead.
+func (*AbsTakeOfChartResponse) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *AbsTakeOfChartResponse) GetYearMonth() string {
+	if x != nil {
+		return x.YearMonth
+	}
+	return ""
+}
+
+func (x *AbsTakeOfChartResponse) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+type GetAllPaymentTakeOffRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	From          string                 `protobuf:"bytes,1,opt,name=from,proto3" json:"from"`
+	To            string                 `protobuf:"bytes,2,opt,name=to,proto3" json:"to"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAllPaymentTakeOffRequest) Reset() {
+	*x = GetAllPaymentTakeOffRequest{}
+	mi := &file_finance_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAllPaymentTakeOffRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAllPaymentTakeOffRequest) ProtoMessage() {}
+
+func (x *GetAllPaymentTakeOffRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAllPaymentTakeOffRequest.ProtoReflect.Descriptor instead.
+func (*GetAllPaymentTakeOffRequest) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *GetAllPaymentTakeOffRequest) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *GetAllPaymentTakeOffRequest) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+type GetAllPaymentTakeOffResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Pennies       []*AbsPaymentTakeOff   `protobuf:"bytes,1,rep,name=pennies,proto3" json:"pennies"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAllPaymentTakeOffResponse) Reset() {
+	*x = GetAllPaymentTakeOffResponse{}
+	mi := &file_finance_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAllPaymentTakeOffResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAllPaymentTakeOffResponse) ProtoMessage() {}
+
+func (x *GetAllPaymentTakeOffResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAllPaymentTakeOffResponse.ProtoReflect.Descriptor instead.
+func (*GetAllPaymentTakeOffResponse) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *GetAllPaymentTakeOffResponse) GetPennies() []*AbsPaymentTakeOff {
+	if x != nil {
+		return x.Pennies
+	}
+	return nil
+}
+
+type AbsPaymentTakeOff struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PaymentId     string                 `protobuf:"bytes,1,opt,name=paymentId,proto3" json:"paymentId"`
+	GivenDate     string                 `protobuf:"bytes,2,opt,name=givenDate,proto3" json:"givenDate"`
+	StudentName   string                 `protobuf:"bytes,3,opt,name=studentName,proto3" json:"studentName"`
+	StudentId     string                 `protobuf:"bytes,4,opt,name=studentId,proto3" json:"studentId"`
+	Comment       string                 `protobuf:"bytes,5,opt,name=comment,proto3" json:"comment"`
+	CreatorId     string                 `protobuf:"bytes,6,opt,name=creatorId,proto3" json:"creatorId"`
+	CreatorName   string                 `protobuf:"bytes,7,opt,name=creatorName,proto3" json:"creatorName"`
+	Sum           string                 `protobuf:"bytes,8,opt,name=sum,proto3" json:"sum"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AbsPaymentTakeOff) Reset() {
+	*x = AbsPaymentTakeOff{}
+	mi := &file_finance_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AbsPaymentTakeOff) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AbsPaymentTakeOff) ProtoMessage() {}
+
+func (x *AbsPaymentTakeOff) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AbsPaymentTakeOff.ProtoReflect.Descriptor instead.
+func (*AbsPaymentTakeOff) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *AbsPaymentTakeOff) GetPaymentId() string {
+	if x != nil {
+		return x.PaymentId
+	}
+	return ""
+}
+
+func (x *AbsPaymentTakeOff) GetGivenDate() string {
+	if x != nil {
+		return x.GivenDate
+	}
+	return ""
+}
+
+func (x *AbsPaymentTakeOff) GetStudentName() string {
+	if x != nil {
+		return x.StudentName
+	}
+	return ""
+}
+
+func (x *AbsPaymentTakeOff) GetStudentId() string {
+	if x != nil {
+		return x.StudentId
+	}
+	return ""
+}
+
+func (x *AbsPaymentTakeOff) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+func (x *AbsPaymentTakeOff) GetCreatorId() string {
+	if x != nil {
+		return x.CreatorId
+	}
+	return ""
+}
+
+func (x *AbsPaymentTakeOff) GetCreatorName() string {
+	if x != nil {
+		return x.CreatorName
+	}
+	return ""
+}
+
+func (x *AbsPaymentTakeOff) GetSum() string {
+	if x != nil {
+		return x.Sum
+	}
+	return ""
+}
+
+type GetAllPaymentsByMonthRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=userId,proto3" json:"userId"`
+	Month         string                 `protobuf:"bytes,2,opt,name=month,proto3" json:"month"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAllPaymentsByMonthRequest) Reset() {
+	*x = GetAllPaymentsByMonthRequest{}
+	mi := &file_finance_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAllPaymentsByMonthRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAllPaymentsByMonthRequest) ProtoMessage() {}
+
+func (x *GetAllPaymentsByMonthRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAllPaymentsByMonthRequest.ProtoReflect.Descriptor instead.
+func (*GetAllPaymentsByMonthRequest) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *GetAllPaymentsByMonthRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GetAllPaymentsByMonthRequest) GetMonth() string {
+	if x != nil {
+		return x.Month
+	}
+	return ""
+}
+
+type GetAllPaymentsByMonthResponse struct {
+	state         protoimpl.MessageState              `protogen:"open.v1"`
+	Payments      []*AbsGetAllPaymentsByMonthResponse `protobuf:"bytes,1,rep,name=payments,proto3" json:"payments"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAllPaymentsByMonthResponse) Reset() {
+	*x = GetAllPaymentsByMonthResponse{}
+	mi := &file_finance_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAllPaymentsByMonthResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAllPaymentsByMonthResponse) ProtoMessage() {}
+
+func (x *GetAllPaymentsByMonthResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAllPaymentsByMonthResponse.ProtoReflect.Descriptor instead.
+func (*GetAllPaymentsByMonthResponse) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *GetAllPaymentsByMonthResponse) GetPayments() []*AbsGetAllPaymentsByMonthResponse {
+	if x != nil {
+		return x.Payments
+	}
+	return nil
+}
+
+type AbsGetAllPaymentsByMonthResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GivenDate     string                 `protobuf:"bytes,1,opt,name=givenDate,proto3" json:"givenDate"`
+	PaymentType   string                 `protobuf:"bytes,2,opt,name=payment_type,json=paymentType,proto3" json:"payment_type"`
+	Amount        string                 `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount"`
+	Comment       string                 `protobuf:"bytes,4,opt,name=comment,proto3" json:"comment"`
+	CreatedById   string                 `protobuf:"bytes,5,opt,name=created_by_id,json=createdById,proto3" json:"created_by_id"`
+	CreatedByName string                 `protobuf:"bytes,6,opt,name=created_by_name,json=createdByName,proto3" json:"created_by_name"`
+	CreatedAt     string                 `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at"`
+	PaymentId     string                 `protobuf:"bytes,8,opt,name=paymentId,proto3" json:"paymentId"`
+	GroupId       string                 `protobuf:"bytes,9,opt,name=groupId,proto3" json:"groupId"`
+	GroupName     string                 `protobuf:"bytes,10,opt,name=groupName,proto3" json:"groupName"`
+	Method        string                 `protobuf:"bytes,11,opt,name=method,proto3" json:"method"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AbsGetAllPaymentsByMonthResponse) Reset() {
+	*x = AbsGetAllPaymentsByMonthResponse{}
+	mi := &file_finance_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AbsGetAllPaymentsByMonthResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AbsGetAllPaymentsByMonthResponse) ProtoMessage() {}
+
+func (x *AbsGetAllPaymentsByMonthResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AbsGetAllPaymentsByMonthResponse.ProtoReflect.Descriptor instead.
+func (*AbsGetAllPaymentsByMonthResponse) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *AbsGetAllPaymentsByMonthResponse) GetGivenDate() string {
+	if x != nil {
+		return x.GivenDate
+	}
+	return ""
+}
+
+func (x *AbsGetAllPaymentsByMonthResponse) GetPaymentType() string {
+	if x != nil {
+		return x.PaymentType
+	}
+	return ""
+}
+
+func (x *AbsGetAllPaymentsByMonthResponse) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *AbsGetAllPaymentsByMonthResponse) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+func (x *AbsGetAllPaymentsByMonthResponse) GetCreatedById() string {
+	if x != nil {
+		return x.CreatedById
+	}
+	return ""
+}
+
+func (x *AbsGetAllPaymentsByMonthResponse) GetCreatedByName() string {
+	if x != nil {
+		return x.CreatedByName
+	}
+	return ""
+}
+
+func (x *AbsGetAllPaymentsByMonthResponse) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *AbsGetAllPaymentsByMonthResponse) GetPaymentId() string {
+	if x != nil {
+		return x.PaymentId
+	}
+	return ""
+}
+
+func (x *AbsGetAllPaymentsByMonthResponse) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+func (x *AbsGetAllPaymentsByMonthResponse) GetGroupName() string {
+	if x != nil {
+		return x.GroupName
+	}
+	return ""
+}
+
+func (x *AbsGetAllPaymentsByMonthResponse) GetMethod() string {
+	if x != nil {
+		return x.Method
+	}
+	return ""
+}
+
+type GetMonthlyStatusResponse struct {
+	state         protoimpl.MessageState         `protogen:"open.v1"`
+	MonthStatus   []*AbsGetMonthlyStatusResponse `protobuf:"bytes,1,rep,name=monthStatus,proto3" json:"monthStatus"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMonthlyStatusResponse) Reset() {
+	*x = GetMonthlyStatusResponse{}
+	mi := &file_finance_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMonthlyStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMonthlyStatusResponse) ProtoMessage() {}
+
+func (x *GetMonthlyStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMonthlyStatusResponse.ProtoReflect.Descriptor instead.
+func (*GetMonthlyStatusResponse) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *GetMonthlyStatusResponse) GetMonthStatus() []*AbsGetMonthlyStatusResponse {
+	if x != nil {
+		return x.MonthStatus
+	}
+	return nil
+}
+
+type AbsGetMonthlyStatusResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Month         string                 `protobuf:"bytes,1,opt,name=month,proto3" json:"month"`
+	Balance       string                 `protobuf:"bytes,2,opt,name=balance,proto3" json:"balance"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AbsGetMonthlyStatusResponse) Reset() {
+	*x = AbsGetMonthlyStatusResponse{}
+	mi := &file_finance_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AbsGetMonthlyStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AbsGetMonthlyStatusResponse) ProtoMessage() {}
+
+func (x *AbsGetMonthlyStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AbsGetMonthlyStatusResponse.ProtoReflect.Descriptor instead.
+func (*AbsGetMonthlyStatusResponse) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *AbsGetMonthlyStatusResponse) GetMonth() string {
+	if x != nil {
+		return x.Month
+	}
+	return ""
+}
+
+func (x *AbsGetMonthlyStatusResponse) GetBalance() string {
+	if x != nil {
+		return x.Balance
+	}
+	return ""
+}
+
+type GetMonthlyStatusRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=userId,proto3" json:"userId"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMonthlyStatusRequest) Reset() {
+	*x = GetMonthlyStatusRequest{}
+	mi := &file_finance_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMonthlyStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMonthlyStatusRequest) ProtoMessage() {}
+
+func (x *GetMonthlyStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMonthlyStatusRequest.ProtoReflect.Descriptor instead.
+func (*GetMonthlyStatusRequest) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *GetMonthlyStatusRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type PaymentAddRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Comment       string                 `protobuf:"bytes,1,opt,name=comment,proto3" json:"comment"`
+	Date          string                 `protobuf:"bytes,2,opt,name=date,proto3" json:"date"`
+	Method        string                 `protobuf:"bytes,3,opt,name=method,proto3" json:"method"`
+	Sum           string                 `protobuf:"bytes,4,opt,name=sum,proto3" json:"sum"`
+	UserId        string                 `protobuf:"bytes,5,opt,name=userId,proto3" json:"userId"`
+	Type          string                 `protobuf:"bytes,6,opt,name=type,proto3" json:"type"`
+	ActionById    string                 `protobuf:"bytes,7,opt,name=actionById,proto3" json:"actionById"`
+	ActionByName  string                 `protobuf:"bytes,8,opt,name=actionByName,proto3" json:"actionByName"`
+	GroupId       string                 `protobuf:"bytes,9,opt,name=groupId,proto3" json:"groupId"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PaymentAddRequest) Reset() {
+	*x = PaymentAddRequest{}
+	mi := &file_finance_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PaymentAddRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PaymentAddRequest) ProtoMessage() {}
+
+func (x *PaymentAddRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PaymentAddRequest.ProtoReflect.Descriptor instead.
+func (*PaymentAddRequest) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *PaymentAddRequest) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+func (x *PaymentAddRequest) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *PaymentAddRequest) GetMethod() string {
+	if x != nil {
+		return x.Method
+	}
+	return ""
+}
+
+func (x *PaymentAddRequest) GetSum() string {
+	if x != nil {
+		return x.Sum
+	}
+	return ""
+}
+
+func (x *PaymentAddRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *PaymentAddRequest) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *PaymentAddRequest) GetActionById() string {
+	if x != nil {
+		return x.ActionById
+	}
+	return ""
+}
+
+func (x *PaymentAddRequest) GetActionByName() string {
+	if x != nil {
+		return x.ActionByName
+	}
+	return ""
+}
+
+func (x *PaymentAddRequest) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+type PaymentUpdateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Debit         string                 `protobuf:"bytes,1,opt,name=debit,proto3" json:"debit"`
+	Method        string                 `protobuf:"bytes,2,opt,name=method,proto3" json:"method"`
+	Date          string                 `protobuf:"bytes,3,opt,name=date,proto3" json:"date"`
+	Comment       string                 `protobuf:"bytes,4,opt,name=comment,proto3" json:"comment"`
+	UserId        string                 `protobuf:"bytes,5,opt,name=userId,proto3" json:"userId"`
+	PaymentId     string                 `protobuf:"bytes,6,opt,name=paymentId,proto3" json:"paymentId"`
+	ActionById    string                 `protobuf:"bytes,7,opt,name=actionById,proto3" json:"actionById"`
+	ActionByName  string                 `protobuf:"bytes,8,opt,name=actionByName,proto3" json:"actionByName"`
+	GroupId       string                 `protobuf:"bytes,9,opt,name=groupId,proto3" json:"groupId"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PaymentUpdateRequest) Reset() {
+	*x = PaymentUpdateRequest{}
+	mi := &file_finance_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PaymentUpdateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PaymentUpdateRequest) ProtoMessage() {}
+
+func (x *PaymentUpdateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[71]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PaymentUpdateRequest.ProtoReflect.Descriptor instead.
+func (*PaymentUpdateRequest) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *PaymentUpdateRequest) GetDebit() string {
+	if x != nil {
+		return x.Debit
+	}
+	return ""
+}
+
+func (x *PaymentUpdateRequest) GetMethod() string {
+	if x != nil {
+		return x.Method
+	}
+	return ""
+}
+
+func (x *PaymentUpdateRequest) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *PaymentUpdateRequest) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+func (x *PaymentUpdateRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *PaymentUpdateRequest) GetPaymentId() string {
+	if x != nil {
+		return x.PaymentId
+	}
+	return ""
+}
+
+func (x *PaymentUpdateRequest) GetActionById() string {
+	if x != nil {
+		return x.ActionById
+	}
+	return ""
+}
+
+func (x *PaymentUpdateRequest) GetActionByName() string {
+	if x != nil {
+		return x.ActionByName
+	}
+	return ""
+}
+
+func (x *PaymentUpdateRequest) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+type PaymentReturnRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PaymentId     string                 `protobuf:"bytes,1,opt,name=paymentId,proto3" json:"paymentId"`
+	ActionById    string                 `protobuf:"bytes,2,opt,name=actionById,proto3" json:"actionById"`
+	ActionByName  string                 `protobuf:"bytes,3,opt,name=actionByName,proto3" json:"actionByName"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PaymentReturnRequest) Reset() {
+	*x = PaymentReturnRequest{}
+	mi := &file_finance_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PaymentReturnRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PaymentReturnRequest) ProtoMessage() {}
+
+func (x *PaymentReturnRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[72]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PaymentReturnRequest.ProtoReflect.Descriptor instead.
+func (*PaymentReturnRequest) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *PaymentReturnRequest) GetPaymentId() string {
+	if x != nil {
+		return x.PaymentId
+	}
+	return ""
+}
+
+func (x *PaymentReturnRequest) GetActionById() string {
+	if x != nil {
+		return x.ActionById
+	}
+	return ""
+}
+
+func (x *PaymentReturnRequest) GetActionByName() string {
+	if x != nil {
+		return x.ActionByName
+	}
+	return ""
+}
+
+type GetTeachersSalaryRequest struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Salaries      []*AbsGetTeachersSalary `protobuf:"bytes,1,rep,name=salaries,proto3" json:"salaries"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTeachersSalaryRequest) Reset() {
+	*x = GetTeachersSalaryRequest{}
+	mi := &file_finance_proto_msgTypes[73]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTeachersSalaryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTeachersSalaryRequest) ProtoMessage() {}
+
+func (x *GetTeachersSalaryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[73]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTeachersSalaryRequest.ProtoReflect.Descriptor instead.
+func (*GetTeachersSalaryRequest) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *GetTeachersSalaryRequest) GetSalaries() []*AbsGetTeachersSalary {
+	if x != nil {
+		return x.Salaries
+	}
+	return nil
+}
+
+type AbsGetTeachersSalary struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TeacherId     string                 `protobuf:"bytes,1,opt,name=teacherId,proto3" json:"teacherId"`
+	Type          string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type"`
+	Amount        int32                  `protobuf:"varint,3,opt,name=amount,proto3" json:"amount"`
+	TeacherName   string                 `protobuf:"bytes,4,opt,name=teacherName,proto3" json:"teacherName"`
+	Id            string                 `protobuf:"bytes,5,opt,name=id,proto3" json:"id"`
+	CourseId      string                 `protobuf:"bytes,6,opt,name=courseId,proto3" json:"courseId"`
+	GroupId       string                 `protobuf:"bytes,7,opt,name=groupId,proto3" json:"groupId"`
+	EffectiveFrom string                 `protobuf:"bytes,8,opt,name=effectiveFrom,proto3" json:"effectiveFrom"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AbsGetTeachersSalary) Reset() {
+	*x = AbsGetTeachersSalary{}
+	mi := &file_finance_proto_msgTypes[74]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AbsGetTeachersSalary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AbsGetTeachersSalary) ProtoMessage() {}
+
+func (x *AbsGetTeachersSalary) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[74]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AbsGetTeachersSalary.ProtoReflect.Descriptor instead.
+func (*AbsGetTeachersSalary) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *AbsGetTeachersSalary) GetTeacherId() string {
+	if x != nil {
+		return x.TeacherId
+	}
+	return ""
+}
+
+func (x *AbsGetTeachersSalary) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *AbsGetTeachersSalary) GetAmount() int32 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *AbsGetTeachersSalary) GetTeacherName() string {
+	if x != nil {
+		return x.TeacherName
+	}
+	return ""
+}
+
+func (x *AbsGetTeachersSalary) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *AbsGetTeachersSalary) GetCourseId() string {
+	if x != nil {
+		return x.CourseId
+	}
+	return ""
+}
+
+func (x *AbsGetTeachersSalary) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+func (x *AbsGetTeachersSalary) GetEffectiveFrom() string {
+	if x != nil {
+		return x.EffectiveFrom
+	}
+	return ""
+}
+
+type DeleteTeacherSalaryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TeacherId     string                 `protobuf:"bytes,1,opt,name=teacherId,proto3" json:"teacherId"`
+	Id            string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteTeacherSalaryRequest) Reset() {
+	*x = DeleteTeacherSalaryRequest{}
+	mi := &file_finance_proto_msgTypes[75]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteTeacherSalaryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteTeacherSalaryRequest) ProtoMessage() {}
+
+func (x *DeleteTeacherSalaryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[75]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteTeacherSalaryRequest.ProtoReflect.Descriptor instead.
+func (*DeleteTeacherSalaryRequest) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *DeleteTeacherSalaryRequest) GetTeacherId() string {
+	if x != nil {
+		return x.TeacherId
+	}
+	return ""
+}
+
+func (x *DeleteTeacherSalaryRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type CreateTeacherSalaryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TeacherId     string                 `protobuf:"bytes,1,opt,name=teacherId,proto3" json:"teacherId"`
+	Type          string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type"`
+	Amount        int32                  `protobuf:"varint,3,opt,name=amount,proto3" json:"amount"`
+	CourseId      string                 `protobuf:"bytes,4,opt,name=courseId,proto3" json:"courseId"`
+	GroupId       string                 `protobuf:"bytes,5,opt,name=groupId,proto3" json:"groupId"`
+	EffectiveFrom string                 `protobuf:"bytes,6,opt,name=effectiveFrom,proto3" json:"effectiveFrom"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateTeacherSalaryRequest) Reset() {
+	*x = CreateTeacherSalaryRequest{}
+	mi := &file_finance_proto_msgTypes[76]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateTeacherSalaryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTeacherSalaryRequest) ProtoMessage() {}
+
+func (x *CreateTeacherSalaryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[76]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTeacherSalaryRequest.ProtoReflect.Descriptor instead.
+func (*CreateTeacherSalaryRequest) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *CreateTeacherSalaryRequest) GetTeacherId() string {
+	if x != nil {
+		return x.TeacherId
+	}
+	return ""
+}
+
+func (x *CreateTeacherSalaryRequest) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *CreateTeacherSalaryRequest) GetAmount() int32 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *CreateTeacherSalaryRequest) GetCourseId() string {
+	if x != nil {
+		return x.CourseId
+	}
+	return ""
+}
+
+func (x *CreateTeacherSalaryRequest) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+func (x *CreateTeacherSalaryRequest) GetEffectiveFrom() string {
+	if x != nil {
+		return x.EffectiveFrom
+	}
+	return ""
+}
+
+type ResolveTeacherSalaryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TeacherId     string                 `protobuf:"bytes,1,opt,name=teacherId,proto3" json:"teacherId"`
+	GroupId       string                 `protobuf:"bytes,2,opt,name=groupId,proto3" json:"groupId"`
+	CourseId      string                 `protobuf:"bytes,3,opt,name=courseId,proto3" json:"courseId"`
+	Date          string                 `protobuf:"bytes,4,opt,name=date,proto3" json:"date"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResolveTeacherSalaryRequest) Reset() {
+	*x = ResolveTeacherSalaryRequest{}
+	mi := &file_finance_proto_msgTypes[77]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResolveTeacherSalaryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResolveTeacherSalaryRequest) ProtoMessage() {}
+
+func (x *ResolveTeacherSalaryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[77]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResolveTeacherSalaryRequest.ProtoReflect.Descriptor instead.
+func (*ResolveTeacherSalaryRequest) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *ResolveTeacherSalaryRequest) GetTeacherId() string {
+	if x != nil {
+		return x.TeacherId
+	}
+	return ""
+}
+
+func (x *ResolveTeacherSalaryRequest) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+func (x *ResolveTeacherSalaryRequest) GetCourseId() string {
+	if x != nil {
+		return x.CourseId
+	}
+	return ""
+}
+
+func (x *ResolveTeacherSalaryRequest) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+type AccountingPeriodRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Period        string                 `protobuf:"bytes,1,opt,name=period,proto3" json:"period"`
+	Comment       string                 `protobuf:"bytes,2,opt,name=comment,proto3" json:"comment"`
+	ActionById    string                 `protobuf:"bytes,3,opt,name=actionById,proto3" json:"actionById"`
+	ActionByName  string                 `protobuf:"bytes,4,opt,name=actionByName,proto3" json:"actionByName"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AccountingPeriodRequest) Reset() {
+	*x = AccountingPeriodRequest{}
+	mi := &file_finance_proto_msgTypes[78]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AccountingPeriodRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccountingPeriodRequest) ProtoMessage() {}
+
+func (x *AccountingPeriodRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[78]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccountingPeriodRequest.ProtoReflect.Descriptor instead.
+func (*AccountingPeriodRequest) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *AccountingPeriodRequest) GetPeriod() string {
+	if x != nil {
+		return x.Period
+	}
+	return ""
+}
+
+func (x *AccountingPeriodRequest) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+func (x *AccountingPeriodRequest) GetActionById() string {
+	if x != nil {
+		return x.ActionById
+	}
+	return ""
+}
+
+func (x *AccountingPeriodRequest) GetActionByName() string {
+	if x != nil {
+		return x.ActionByName
+	}
+	return ""
+}
+
+type GetAllPeriodsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Periods       []*AbsAccountingPeriod `protobuf:"bytes,1,rep,name=periods,proto3" json:"periods"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAllPeriodsResponse) Reset() {
+	*x = GetAllPeriodsResponse{}
+	mi := &file_finance_proto_msgTypes[79]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAllPeriodsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAllPeriodsResponse) ProtoMessage() {}
+
+func (x *GetAllPeriodsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[79]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAllPeriodsResponse.ProtoReflect.Descriptor instead.
+func (*GetAllPeriodsResponse) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *GetAllPeriodsResponse) GetPeriods() []*AbsAccountingPeriod {
+	if x != nil {
+		return x.Periods
+	}
+	return nil
+}
+
+type AbsAccountingPeriod struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Period        string                 `protobuf:"bytes,1,opt,name=period,proto3" json:"period"`
+	IsClosed      bool                   `protobuf:"varint,2,opt,name=isClosed,proto3" json:"isClosed"`
+	ClosedById    string                 `protobuf:"bytes,3,opt,name=closedById,proto3" json:"closedById"`
+	ClosedByName  string                 `protobuf:"bytes,4,opt,name=closedByName,proto3" json:"closedByName"`
+	ClosedAt      string                 `protobuf:"bytes,5,opt,name=closedAt,proto3" json:"closedAt"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AbsAccountingPeriod) Reset() {
+	*x = AbsAccountingPeriod{}
+	mi := &file_finance_proto_msgTypes[80]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AbsAccountingPeriod) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AbsAccountingPeriod) ProtoMessage() {}
+
+func (x *AbsAccountingPeriod) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[80]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AbsAccountingPeriod.ProtoReflect.Descriptor instead.
+func (*AbsAccountingPeriod) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *AbsAccountingPeriod) GetPeriod() string {
+	if x != nil {
+		return x.Period
+	}
+	return ""
+}
+
+func (x *AbsAccountingPeriod) GetIsClosed() bool {
+	if x != nil {
+		return x.IsClosed
+	}
+	return false
+}
+
+func (x *AbsAccountingPeriod) GetClosedById() string {
+	if x != nil {
+		return x.ClosedById
+	}
+	return ""
+}
+
+func (x *AbsAccountingPeriod) GetClosedByName() string {
+	if x != nil {
+		return x.ClosedByName
+	}
+	return ""
+}
+
+func (x *AbsAccountingPeriod) GetClosedAt() string {
+	if x != nil {
+		return x.ClosedAt
+	}
+	return ""
+}
+
+type GetPeriodHistoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Period        string                 `protobuf:"bytes,1,opt,name=period,proto3" json:"period"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPeriodHistoryRequest) Reset() {
+	*x = GetPeriodHistoryRequest{}
+	mi := &file_finance_proto_msgTypes[81]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPeriodHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPeriodHistoryRequest) ProtoMessage() {}
+
+func (x *GetPeriodHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[81]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPeriodHistoryRequest.ProtoReflect.Descriptor instead.
+func (*GetPeriodHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *GetPeriodHistoryRequest) GetPeriod() string {
+	if x != nil {
+		return x.Period
+	}
+	return ""
+}
+
+type GetPeriodHistoryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Histories     []*AbsPeriodHistory    `protobuf:"bytes,1,rep,name=histories,proto3" json:"histories"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPeriodHistoryResponse) Reset() {
+	*x = GetPeriodHistoryResponse{}
+	mi := &file_finance_proto_msgTypes[82]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPeriodHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPeriodHistoryResponse) ProtoMessage() {}
+
+func (x *GetPeriodHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[82]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPeriodHistoryResponse.ProtoReflect.Descriptor instead.
+func (*GetPeriodHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *GetPeriodHistoryResponse) GetHistories() []*AbsPeriodHistory {
+	if x != nil {
+		return x.Histories
+	}
+	return nil
+}
+
+type AbsPeriodHistory struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id"`
+	Period        string                 `protobuf:"bytes,2,opt,name=period,proto3" json:"period"`
+	Action        string                 `protobuf:"bytes,3,opt,name=action,proto3" json:"action"`
+	Comment       string                 `protobuf:"bytes,4,opt,name=comment,proto3" json:"comment"`
+	ActionById    string                 `protobuf:"bytes,5,opt,name=actionById,proto3" json:"actionById"`
+	ActionByName  string                 `protobuf:"bytes,6,opt,name=actionByName,proto3" json:"actionByName"`
+	CreatedAt     string                 `protobuf:"bytes,7,opt,name=createdAt,proto3" json:"createdAt"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AbsPeriodHistory) Reset() {
+	*x = AbsPeriodHistory{}
+	mi := &file_finance_proto_msgTypes[83]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AbsPeriodHistory) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AbsPeriodHistory) ProtoMessage() {}
+
+func (x *AbsPeriodHistory) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[83]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AbsPeriodHistory.ProtoReflect.Descriptor instead.
+func (*AbsPeriodHistory) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *AbsPeriodHistory) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *AbsPeriodHistory) GetPeriod() string {
+	if x != nil {
+		return x.Period
+	}
+	return ""
+}
+
+func (x *AbsPeriodHistory) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *AbsPeriodHistory) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+func (x *AbsPeriodHistory) GetActionById() string {
+	if x != nil {
+		return x.ActionById
+	}
+	return ""
+}
+
+func (x *AbsPeriodHistory) GetActionByName() string {
+	if x != nil {
+		return x.ActionByName
+	}
+	return ""
+}
+
+func (x *AbsPeriodHistory) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+type CheckPeriodRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Date          string                 `protobuf:"bytes,1,opt,name=date,proto3" json:"date"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckPeriodRequest) Reset() {
+	*x = CheckPeriodRequest{}
+	mi := &file_finance_proto_msgTypes[84]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckPeriodRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckPeriodRequest) ProtoMessage() {}
+
+func (x *CheckPeriodRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[84]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckPeriodRequest.ProtoReflect.Descriptor instead.
+func (*CheckPeriodRequest) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *CheckPeriodRequest) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+type CheckPeriodResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	IsClosed      bool                   `protobuf:"varint,1,opt,name=isClosed,proto3" json:"isClosed"`
+	Period        string                 `protobuf:"bytes,2,opt,name=period,proto3" json:"period"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckPeriodResponse) Reset() {
+	*x = CheckPeriodResponse{}
+	mi := &file_finance_proto_msgTypes[85]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckPeriodResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckPeriodResponse) ProtoMessage() {}
+
+func (x *CheckPeriodResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[85]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckPeriodResponse.ProtoReflect.Descriptor instead.
+func (*CheckPeriodResponse) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *CheckPeriodResponse) GetIsClosed() bool {
+	if x != nil {
+		return x.IsClosed
+	}
+	return false
+}
+
+func (x *CheckPeriodResponse) GetPeriod() string {
+	if x != nil {
+		return x.Period
+	}
+	return ""
+}
+
+type CreatePayrollRunRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Period        string                 `protobuf:"bytes,1,opt,name=period,proto3" json:"period"`
+	ActionById    string                 `protobuf:"bytes,2,opt,name=actionById,proto3" json:"actionById"`
+	ActionByName  string                 `protobuf:"bytes,3,opt,name=actionByName,proto3" json:"actionByName"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreatePayrollRunRequest) Reset() {
+	*x = CreatePayrollRunRequest{}
+	mi := &file_finance_proto_msgTypes[86]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreatePayrollRunRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePayrollRunRequest) ProtoMessage() {}
+
+func (x *CreatePayrollRunRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[86]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePayrollRunRequest.ProtoReflect.Descriptor instead.
+func (*CreatePayrollRunRequest) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *CreatePayrollRunRequest) GetPeriod() string {
+	if x != nil {
+		return x.Period
+	}
+	return ""
+}
+
+func (x *CreatePayrollRunRequest) GetActionById() string {
+	if x != nil {
+		return x.ActionById
+	}
+	return ""
+}
+
+func (x *CreatePayrollRunRequest) GetActionByName() string {
+	if x != nil {
+		return x.ActionByName
+	}
+	return ""
+}
+
+type PayrollRunIdRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RunId         string                 `protobuf:"bytes,1,opt,name=runId,proto3" json:"runId"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PayrollRunIdRequest) Reset() {
+	*x = PayrollRunIdRequest{}
+	mi := &file_finance_proto_msgTypes[87]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PayrollRunIdRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PayrollRunIdRequest) ProtoMessage() {}
+
+func (x *PayrollRunIdRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[87]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PayrollRunIdRequest.ProtoReflect.Descriptor instead.
+func (*PayrollRunIdRequest) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *PayrollRunIdRequest) GetRunId() string {
+	if x != nil {
+		return x.RunId
+	}
+	return ""
+}
+
+type GetPayrollRunsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Runs          []*AbsPayrollRun       `protobuf:"bytes,1,rep,name=runs,proto3" json:"runs"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPayrollRunsResponse) Reset() {
+	*x = GetPayrollRunsResponse{}
+	mi := &file_finance_proto_msgTypes[88]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPayrollRunsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPayrollRunsResponse) ProtoMessage() {}
+
+func (x *GetPayrollRunsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[88]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPayrollRunsResponse.ProtoReflect.Descriptor instead.
+func (*GetPayrollRunsResponse) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{88}
+}
+
+func (x *GetPayrollRunsResponse) GetRuns() []*AbsPayrollRun {
+	if x != nil {
+		return x.Runs
+	}
+	return nil
+}
+
+type AbsPayrollRun struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id"`
+	Period         string                 `protobuf:"bytes,2,opt,name=period,proto3" json:"period"`
+	Status         string                 `protobuf:"bytes,3,opt,name=status,proto3" json:"status"`
+	CreatedByName  string                 `protobuf:"bytes,4,opt,name=createdByName,proto3" json:"createdByName"`
+	ApprovedByName string                 `protobuf:"bytes,5,opt,name=approvedByName,proto3" json:"approvedByName"`
+	ApprovedAt     string                 `protobuf:"bytes,6,opt,name=approvedAt,proto3" json:"approvedAt"`
+	PaidByName     string                 `protobuf:"bytes,7,opt,name=paidByName,proto3" json:"paidByName"`
+	PaidAt         string                 `protobuf:"bytes,8,opt,name=paidAt,proto3" json:"paidAt"`
+	CreatedAt      string                 `protobuf:"bytes,9,opt,name=createdAt,proto3" json:"createdAt"`
+	TotalAmount    string                 `protobuf:"bytes,10,opt,name=totalAmount,proto3" json:"totalAmount"`
+	Items          []*AbsPayrollItem      `protobuf:"bytes,11,rep,name=items,proto3" json:"items"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *AbsPayrollRun) Reset() {
+	*x = AbsPayrollRun{}
+	mi := &file_finance_proto_msgTypes[89]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AbsPayrollRun) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AbsPayrollRun) ProtoMessage() {}
+
+func (x *AbsPayrollRun) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[89]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AbsPayrollRun.ProtoReflect.Descriptor instead.
+func (*AbsPayrollRun) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{89}
+}
+
+func (x *AbsPayrollRun) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *AbsPayrollRun) GetPeriod() string {
+	if x != nil {
+		return x.Period
+	}
+	return ""
+}
+
+func (x *AbsPayrollRun) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *AbsPayrollRun) GetCreatedByName() string {
+	if x != nil {
+		return x.CreatedByName
+	}
+	return ""
+}
+
+func (x *AbsPayrollRun) GetApprovedByName() string {
+	if x != nil {
+		return x.ApprovedByName
+	}
+	return ""
+}
+
+func (x *AbsPayrollRun) GetApprovedAt() string {
+	if x != nil {
+		return x.ApprovedAt
+	}
+	return ""
+}
+
+func (x *AbsPayrollRun) GetPaidByName() string {
+	if x != nil {
+		return x.PaidByName
+	}
+	return ""
+}
+
+func (x *AbsPayrollRun) GetPaidAt() string {
+	if x != nil {
+		return x.PaidAt
+	}
+	return ""
+}
+
+func (x *AbsPayrollRun) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *AbsPayrollRun) GetTotalAmount() string {
+	if x != nil {
+		return x.TotalAmount
+	}
+	return ""
+}
+
+func (x *AbsPayrollRun) GetItems() []*AbsPayrollItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type AbsPayrollItem struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id"`
+	TeacherId     string                 `protobuf:"bytes,2,opt,name=teacherId,proto3" json:"teacherId"`
+	TeacherName   string                 `protobuf:"bytes,3,opt,name=teacherName,proto3" json:"teacherName"`
+	SalaryAmount  string                 `protobuf:"bytes,4,opt,name=salaryAmount,proto3" json:"salaryAmount"`
+	BonusAmount   string                 `protobuf:"bytes,5,opt,name=bonusAmount,proto3" json:"bonusAmount"`
+	PenaltyAmount string                 `protobuf:"bytes,6,opt,name=penaltyAmount,proto3" json:"penaltyAmount"`
+	AdvanceAmount string                 `protobuf:"bytes,7,opt,name=advanceAmount,proto3" json:"advanceAmount"`
+	TotalAmount   string                 `protobuf:"bytes,8,opt,name=totalAmount,proto3" json:"totalAmount"`
+	ExpenseId     string                 `protobuf:"bytes,9,opt,name=expenseId,proto3" json:"expenseId"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AbsPayrollItem) Reset() {
+	*x = AbsPayrollItem{}
+	mi := &file_finance_proto_msgTypes[90]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AbsPayrollItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AbsPayrollItem) ProtoMessage() {}
+
+func (x *AbsPayrollItem) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[90]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AbsPayrollItem.ProtoReflect.Descriptor instead.
+func (*AbsPayrollItem) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{90}
+}
+
+func (x *AbsPayrollItem) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *AbsPayrollItem) GetTeacherId() string {
+	if x != nil {
+		return x.TeacherId
+	}
+	return ""
+}
+
+func (x *AbsPayrollItem) GetTeacherName() string {
+	if x != nil {
+		return x.TeacherName
+	}
+	return ""
+}
+
+func (x *AbsPayrollItem) GetSalaryAmount() string {
+	if x != nil {
+		return x.SalaryAmount
+	}
+	return ""
+}
+
+func (x *AbsPayrollItem) GetBonusAmount() string {
+	if x != nil {
+		return x.BonusAmount
+	}
+	return ""
+}
+
+func (x *AbsPayrollItem) GetPenaltyAmount() string {
+	if x != nil {
+		return x.PenaltyAmount
+	}
+	return ""
+}
+
+func (x *AbsPayrollItem) GetAdvanceAmount() string {
+	if x != nil {
+		return x.AdvanceAmount
+	}
+	return ""
+}
+
+func (x *AbsPayrollItem) GetTotalAmount() string {
+	if x != nil {
+		return x.TotalAmount
+	}
+	return ""
+}
+
+func (x *AbsPayrollItem) GetExpenseId() string {
+	if x != nil {
+		return x.ExpenseId
+	}
+	return ""
+}
+
+type AddPayrollAdjustmentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RunId         string                 `protobuf:"bytes,1,opt,name=runId,proto3" json:"runId"`
+	TeacherId     string                 `protobuf:"bytes,2,opt,name=teacherId,proto3" json:"teacherId"`
+	Type          string                 `protobuf:"bytes,3,opt,name=type,proto3" json:"type"`
+	Amount        string                 `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount"`
+	Comment       string                 `protobuf:"bytes,5,opt,name=comment,proto3" json:"comment"`
+	ActionById    string                 `protobuf:"bytes,6,opt,name=actionById,proto3" json:"actionById"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddPayrollAdjustmentRequest) Reset() {
+	*x = AddPayrollAdjustmentRequest{}
+	mi := &file_finance_proto_msgTypes[91]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddPayrollAdjustmentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddPayrollAdjustmentRequest) ProtoMessage() {}
+
+func (x *AddPayrollAdjustmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[91]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddPayrollAdjustmentRequest.ProtoReflect.Descriptor instead.
+func (*AddPayrollAdjustmentRequest) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{91}
+}
+
+func (x *AddPayrollAdjustmentRequest) GetRunId() string {
+	if x != nil {
+		return x.RunId
+	}
+	return ""
+}
+
+func (x *AddPayrollAdjustmentRequest) GetTeacherId() string {
+	if x != nil {
+		return x.TeacherId
+	}
+	return ""
+}
+
+func (x *AddPayrollAdjustmentRequest) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *AddPayrollAdjustmentRequest) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *AddPayrollAdjustmentRequest) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+func (x *AddPayrollAdjustmentRequest) GetActionById() string {
+	if x != nil {
+		return x.ActionById
+	}
+	return ""
+}
+
+type DeletePayrollAdjustmentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeletePayrollAdjustmentRequest) Reset() {
+	*x = DeletePayrollAdjustmentRequest{}
+	mi := &file_finance_proto_msgTypes[92]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeletePayrollAdjustmentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeletePayrollAdjustmentRequest) ProtoMessage() {}
+
+func (x *DeletePayrollAdjustmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[92]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeletePayrollAdjustmentRequest.ProtoReflect.Descriptor instead.
+func (*DeletePayrollAdjustmentRequest) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{92}
+}
+
+func (x *DeletePayrollAdjustmentRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type PayrollRunActionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RunId         string                 `protobuf:"bytes,1,opt,name=runId,proto3" json:"runId"`
+	ActionById    string                 `protobuf:"bytes,2,opt,name=actionById,proto3" json:"actionById"`
+	ActionByName  string                 `protobuf:"bytes,3,opt,name=actionByName,proto3" json:"actionByName"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PayrollRunActionRequest) Reset() {
+	*x = PayrollRunActionRequest{}
+	mi := &file_finance_proto_msgTypes[93]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PayrollRunActionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PayrollRunActionRequest) ProtoMessage() {}
+
+func (x *PayrollRunActionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[93]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PayrollRunActionRequest.ProtoReflect.Descriptor instead.
+func (*PayrollRunActionRequest) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{93}
+}
+
+func (x *PayrollRunActionRequest) GetRunId() string {
+	if x != nil {
+		return x.RunId
+	}
+	return ""
+}
+
+func (x *PayrollRunActionRequest) GetActionById() string {
+	if x != nil {
+		return x.ActionById
+	}
+	return ""
+}
+
+func (x *PayrollRunActionRequest) GetActionByName() string {
+	if x != nil {
+		return x.ActionByName
+	}
+	return ""
+}
+
+type PayPayrollRunRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RunId         string                 `protobuf:"bytes,1,opt,name=runId,proto3" json:"runId"`
+	PaymentMethod string                 `protobuf:"bytes,2,opt,name=paymentMethod,proto3" json:"paymentMethod"`
+	GivenDate     string                 `protobuf:"bytes,3,opt,name=givenDate,proto3" json:"givenDate"`
+	ActionById    string                 `protobuf:"bytes,4,opt,name=actionById,proto3" json:"actionById"`
+	ActionByName  string                 `protobuf:"bytes,5,opt,name=actionByName,proto3" json:"actionByName"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PayPayrollRunRequest) Reset() {
+	*x = PayPayrollRunRequest{}
+	mi := &file_finance_proto_msgTypes[94]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PayPayrollRunRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PayPayrollRunRequest) ProtoMessage() {}
+
+func (x *PayPayrollRunRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[94]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PayPayrollRunRequest.ProtoReflect.Descriptor instead.
+func (*PayPayrollRunRequest) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{94}
+}
+
+func (x *PayPayrollRunRequest) GetRunId() string {
+	if x != nil {
+		return x.RunId
+	}
+	return ""
+}
+
+func (x *PayPayrollRunRequest) GetPaymentMethod() string {
+	if x != nil {
+		return x.PaymentMethod
+	}
+	return ""
+}
+
+func (x *PayPayrollRunRequest) GetGivenDate() string {
+	if x != nil {
+		return x.GivenDate
+	}
+	return ""
+}
+
+func (x *PayPayrollRunRequest) GetActionById() string {
+	if x != nil {
+		return x.ActionById
+	}
+	return ""
+}
+
+func (x *PayPayrollRunRequest) GetActionByName() string {
+	if x != nil {
+		return x.ActionByName
+	}
+	return ""
+}
+
+type GetPayslipRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RunId         string                 `protobuf:"bytes,1,opt,name=runId,proto3" json:"runId"`
+	TeacherId     string                 `protobuf:"bytes,2,opt,name=teacherId,proto3" json:"teacherId"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPayslipRequest) Reset() {
+	*x = GetPayslipRequest{}
+	mi := &file_finance_proto_msgTypes[95]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPayslipRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPayslipRequest) ProtoMessage() {}
+
+func (x *GetPayslipRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[95]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPayslipRequest.ProtoReflect.Descriptor instead.
+func (*GetPayslipRequest) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{95}
+}
+
+func (x *GetPayslipRequest) GetRunId() string {
+	if x != nil {
+		return x.RunId
+	}
+	return ""
+}
+
+func (x *GetPayslipRequest) GetTeacherId() string {
+	if x != nil {
+		return x.TeacherId
+	}
+	return ""
+}
+
+type GetTeacherPayslipsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TeacherId     string                 `protobuf:"bytes,1,opt,name=teacherId,proto3" json:"teacherId"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTeacherPayslipsRequest) Reset() {
+	*x = GetTeacherPayslipsRequest{}
+	mi := &file_finance_proto_msgTypes[96]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTeacherPayslipsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTeacherPayslipsRequest) ProtoMessage() {}
+
+func (x *GetTeacherPayslipsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[96]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTeacherPayslipsRequest.ProtoReflect.Descriptor instead.
+func (*GetTeacherPayslipsRequest) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{96}
+}
+
+func (x *GetTeacherPayslipsRequest) GetTeacherId() string {
+	if x != nil {
+		return x.TeacherId
+	}
+	return ""
+}
+
+type GetTeacherPayslipsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Payslips      []*Payslip             `protobuf:"bytes,1,rep,name=payslips,proto3" json:"payslips"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTeacherPayslipsResponse) Reset() {
+	*x = GetTeacherPayslipsResponse{}
+	mi := &file_finance_proto_msgTypes[97]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTeacherPayslipsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTeacherPayslipsResponse) ProtoMessage() {}
+
+func (x *GetTeacherPayslipsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[97]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTeacherPayslipsResponse.ProtoReflect.Descriptor instead.
+func (*GetTeacherPayslipsResponse) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{97}
+}
+
+func (x *GetTeacherPayslipsResponse) GetPayslips() []*Payslip {
+	if x != nil {
+		return x.Payslips
+	}
+	return nil
+}
+
+type Payslip struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	RunId         string                  `protobuf:"bytes,1,opt,name=runId,proto3" json:"runId"`
+	Period        string                  `protobuf:"bytes,2,opt,name=period,proto3" json:"period"`
+	Status        string                  `protobuf:"bytes,3,opt,name=status,proto3" json:"status"`
+	Item          *AbsPayrollItem         `protobuf:"bytes,4,opt,name=item,proto3" json:"item"`
+	Groups        []*PayslipGroup         `protobuf:"bytes,5,rep,name=groups,proto3" json:"groups"`
+	Adjustments   []*AbsPayrollAdjustment `protobuf:"bytes,6,rep,name=adjustments,proto3" json:"adjustments"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Payslip) Reset() {
+	*x = Payslip{}
+	mi := &file_finance_proto_msgTypes[98]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Payslip) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Payslip) ProtoMessage() {}
+
+func (x *Payslip) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[98]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Payslip.ProtoReflect.Descriptor instead.
+func (*Payslip) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{98}
+}
+
+func (x *Payslip) GetRunId() string {
+	if x != nil {
+		return x.RunId
+	}
+	return ""
+}
+
+func (x *Payslip) GetPeriod() string {
+	if x != nil {
+		return x.Period
+	}
+	return ""
+}
+
+func (x *Payslip) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Payslip) GetItem() *AbsPayrollItem {
+	if x != nil {
+		return x.Item
+	}
+	return nil
+}
+
+func (x *Payslip) GetGroups() []*PayslipGroup {
+	if x != nil {
+		return x.Groups
+	}
+	return nil
+}
+
+func (x *Payslip) GetAdjustments() []*AbsPayrollAdjustment {
+	if x != nil {
+		return x.Adjustments
+	}
+	return nil
+}
+
+type PayslipGroup struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	GroupId           string                 `protobuf:"bytes,1,opt,name=groupId,proto3" json:"groupId"`
+	GroupName         string                 `protobuf:"bytes,2,opt,name=groupName,proto3" json:"groupName"`
+	CommonLessonCount int32                  `protobuf:"varint,3,opt,name=commonLessonCount,proto3" json:"commonLessonCount"`
+	Amount            string                 `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount"`
+	Students          []*PayslipStudent      `protobuf:"bytes,5,rep,name=students,proto3" json:"students"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *PayslipGroup) Reset() {
+	*x = PayslipGroup{}
+	mi := &file_finance_proto_msgTypes[99]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PayslipGroup) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PayslipGroup) ProtoMessage() {}
+
+func (x *PayslipGroup) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[99]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PayslipGroup.ProtoReflect.Descriptor instead.
+func (*PayslipGroup) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{99}
+}
+
+func (x *PayslipGroup) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+func (x *PayslipGroup) GetGroupName() string {
+	if x != nil {
+		return x.GroupName
+	}
+	return ""
+}
+
+func (x *PayslipGroup) GetCommonLessonCount() int32 {
+	if x != nil {
+		return x.CommonLessonCount
+	}
+	return 0
+}
+
+func (x *PayslipGroup) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *PayslipGroup) GetStudents() []*PayslipStudent {
+	if x != nil {
+		return x.Students
+	}
+	return nil
+}
+
+type PayslipStudent struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	StudentId         string                 `protobuf:"bytes,1,opt,name=studentId,proto3" json:"studentId"`
+	StudentName       string                 `protobuf:"bytes,2,opt,name=studentName,proto3" json:"studentName"`
+	PassedLessonCount int32                  `protobuf:"varint,3,opt,name=passedLessonCount,proto3" json:"passedLessonCount"`
+	Amount            string                 `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount"`
+	PriceType         string                 `protobuf:"bytes,5,opt,name=priceType,proto3" json:"priceType"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *PayslipStudent) Reset() {
+	*x = PayslipStudent{}
+	mi := &file_finance_proto_msgTypes[100]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PayslipStudent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PayslipStudent) ProtoMessage() {}
+
+func (x *PayslipStudent) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[100]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PayslipStudent.ProtoReflect.Descriptor instead.
+func (*PayslipStudent) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{100}
+}
+
+func (x *PayslipStudent) GetStudentId() string {
+	if x != nil {
+		return x.StudentId
+	}
+	return ""
+}
+
+func (x *PayslipStudent) GetStudentName() string {
+	if x != nil {
+		return x.StudentName
+	}
+	return ""
+}
+
+func (x *PayslipStudent) GetPassedLessonCount() int32 {
+	if x != nil {
+		return x.PassedLessonCount
+	}
+	return 0
+}
+
+func (x *PayslipStudent) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *PayslipStudent) GetPriceType() string {
+	if x != nil {
+		return x.PriceType
+	}
+	return ""
+}
+
+type AbsPayrollAdjustment struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id"`
+	Type          string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type"`
+	Amount        string                 `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount"`
+	Comment       string                 `protobuf:"bytes,4,opt,name=comment,proto3" json:"comment"`
+	CreatedAt     string                 `protobuf:"bytes,5,opt,name=createdAt,proto3" json:"createdAt"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AbsPayrollAdjustment) Reset() {
+	*x = AbsPayrollAdjustment{}
+	mi := &file_finance_proto_msgTypes[101]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AbsPayrollAdjustment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AbsPayrollAdjustment) ProtoMessage() {}
+
+func (x *AbsPayrollAdjustment) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[101]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AbsPayrollAdjustment.ProtoReflect.Descriptor instead.
+func (*AbsPayrollAdjustment) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{101}
+}
+
+func (x *AbsPayrollAdjustment) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *AbsPayrollAdjustment) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *AbsPayrollAdjustment) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *AbsPayrollAdjustment) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+func (x *AbsPayrollAdjustment) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+type CreateInstallmentPlanRequest struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	StudentId            string                 `protobuf:"bytes,1,opt,name=studentId,proto3" json:"studentId"`
+	GroupId              string                 `protobuf:"bytes,2,opt,name=groupId,proto3" json:"groupId"`
+	PlanType             string                 `protobuf:"bytes,3,opt,name=planType,proto3" json:"planType"`
+	TotalAmount          string                 `protobuf:"bytes,4,opt,name=totalAmount,proto3" json:"totalAmount"`
+	StartDate            string                 `protobuf:"bytes,5,opt,name=startDate,proto3" json:"startDate"`
+	MonthsCount          int32                  `protobuf:"varint,6,opt,name=monthsCount,proto3" json:"monthsCount"`
+	Installments         []*InstallmentInput    `protobuf:"bytes,7,rep,name=installments,proto3" json:"installments"`
+	LateFeeAmount        string                 `protobuf:"bytes,8,opt,name=lateFeeAmount,proto3" json:"lateFeeAmount"`
+	GraceDays            int32                  `protobuf:"varint,9,opt,name=graceDays,proto3" json:"graceDays"`
+	EarlyDiscountPercent float64                `protobuf:"fixed64,10,opt,name=earlyDiscountPercent,proto3" json:"earlyDiscountPercent"`
+	EarlyDays            int32                  `protobuf:"varint,11,opt,name=earlyDays,proto3" json:"earlyDays"`
+	Comment              string                 `protobuf:"bytes,12,opt,name=comment,proto3" json:"comment"`
+	ActionById           string                 `protobuf:"bytes,13,opt,name=actionById,proto3" json:"actionById"`
+	ActionByName         string                 `protobuf:"bytes,14,opt,name=actionByName,proto3" json:"actionByName"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *CreateInstallmentPlanRequest) Reset() {
+	*x = CreateInstallmentPlanRequest{}
+	mi := &file_finance_proto_msgTypes[102]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateInstallmentPlanRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateInstallmentPlanRequest) ProtoMessage() {}
+
+func (x *CreateInstallmentPlanRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[102]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateInstallmentPlanRequest.ProtoReflect.Descriptor instead.
+func (*CreateInstallmentPlanRequest) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{102}
+}
+
+func (x *CreateInstallmentPlanRequest) GetStudentId() string {
+	if x != nil {
+		return x.StudentId
+	}
+	return ""
+}
+
+func (x *CreateInstallmentPlanRequest) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+func (x *CreateInstallmentPlanRequest) GetPlanType() string {
+	if x != nil {
+		return x.PlanType
+	}
+	return ""
+}
+
+func (x *CreateInstallmentPlanRequest) GetTotalAmount() string {
+	if x != nil {
+		return x.TotalAmount
+	}
+	return ""
+}
+
+func (x *CreateInstallmentPlanRequest) GetStartDate() string {
+	if x != nil {
+		return x.StartDate
+	}
+	return ""
+}
+
+func (x *CreateInstallmentPlanRequest) GetMonthsCount() int32 {
+	if x != nil {
+		return x.MonthsCount
+	}
+	return 0
+}
+
+func (x *CreateInstallmentPlanRequest) GetInstallments() []*InstallmentInput {
+	if x != nil {
+		return x.Installments
+	}
+	return nil
+}
+
+func (x *CreateInstallmentPlanRequest) GetLateFeeAmount() string {
+	if x != nil {
+		return x.LateFeeAmount
+	}
+	return ""
+}
+
+func (x *CreateInstallmentPlanRequest) GetGraceDays() int32 {
+	if x != nil {
+		return x.GraceDays
+	}
+	return 0
+}
+
+func (x *CreateInstallmentPlanRequest) GetEarlyDiscountPercent() float64 {
+	if x != nil {
+		return x.EarlyDiscountPercent
+	}
+	return 0
+}
+
+func (x *CreateInstallmentPlanRequest) GetEarlyDays() int32 {
+	if x != nil {
+		return x.EarlyDays
+	}
+	return 0
+}
+
+func (x *CreateInstallmentPlanRequest) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+func (x *CreateInstallmentPlanRequest) GetActionById() string {
+	if x != nil {
+		return x.ActionById
+	}
+	return ""
+}
+
+func (x *CreateInstallmentPlanRequest) GetActionByName() string {
+	if x != nil {
+		return x.ActionByName
+	}
+	return ""
+}
+
+type InstallmentInput struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DueDate       string                 `protobuf:"bytes,1,opt,name=dueDate,proto3" json:"dueDate"`
+	Amount        string                 `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InstallmentInput) Reset() {
+	*x = InstallmentInput{}
+	mi := &file_finance_proto_msgTypes[103]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InstallmentInput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InstallmentInput) ProtoMessage() {}
+
+func (x *InstallmentInput) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[103]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InstallmentInput.ProtoReflect.Descriptor instead.
+func (*InstallmentInput) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{103}
+}
+
+func (x *InstallmentInput) GetDueDate() string {
+	if x != nil {
+		return x.DueDate
+	}
+	return ""
+}
+
+func (x *InstallmentInput) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+type GetInstallmentPlansRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StudentId     string                 `protobuf:"bytes,1,opt,name=studentId,proto3" json:"studentId"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetInstallmentPlansRequest) Reset() {
+	*x = GetInstallmentPlansRequest{}
+	mi := &file_finance_proto_msgTypes[104]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetInstallmentPlansRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetInstallmentPlansRequest) ProtoMessage() {}
+
+func (x *GetInstallmentPlansRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[104]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetInstallmentPlansRequest.ProtoReflect.Descriptor instead.
+func (*GetInstallmentPlansRequest) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{104}
+}
+
+func (x *GetInstallmentPlansRequest) GetStudentId() string {
+	if x != nil {
+		return x.StudentId
+	}
+	return ""
+}
+
+type GetInstallmentPlansResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Plans         []*AbsInstallmentPlan  `protobuf:"bytes,1,rep,name=plans,proto3" json:"plans"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetInstallmentPlansResponse) Reset() {
+	*x = GetInstallmentPlansResponse{}
+	mi := &file_finance_proto_msgTypes[105]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetInstallmentPlansResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetInstallmentPlansResponse) ProtoMessage() {}
+
+func (x *GetInstallmentPlansResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[105]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetInstallmentPlansResponse.ProtoReflect.Descriptor instead.
+func (*GetInstallmentPlansResponse) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{105}
+}
+
+func (x *GetInstallmentPlansResponse) GetPlans() []*AbsInstallmentPlan {
+	if x != nil {
+		return x.Plans
+	}
+	return nil
+}
+
+type InstallmentPlanIdRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PlanId        string                 `protobuf:"bytes,1,opt,name=planId,proto3" json:"planId"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InstallmentPlanIdRequest) Reset() {
+	*x = InstallmentPlanIdRequest{}
+	mi := &file_finance_proto_msgTypes[106]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InstallmentPlanIdRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InstallmentPlanIdRequest) ProtoMessage() {}
+
+func (x *InstallmentPlanIdRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[106]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InstallmentPlanIdRequest.ProtoReflect.Descriptor instead.
+func (*InstallmentPlanIdRequest) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{106}
+}
+
+func (x *InstallmentPlanIdRequest) GetPlanId() string {
+	if x != nil {
+		return x.PlanId
+	}
+	return ""
+}
+
+type CancelInstallmentPlanRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PlanId        string                 `protobuf:"bytes,1,opt,name=planId,proto3" json:"planId"`
+	ActionById    string                 `protobuf:"bytes,2,opt,name=actionById,proto3" json:"actionById"`
+	ActionByName  string                 `protobuf:"bytes,3,opt,name=actionByName,proto3" json:"actionByName"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelInstallmentPlanRequest) Reset() {
+	*x = CancelInstallmentPlanRequest{}
+	mi := &file_finance_proto_msgTypes[107]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelInstallmentPlanRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelInstallmentPlanRequest) ProtoMessage() {}
+
+func (x *CancelInstallmentPlanRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[107]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelInstallmentPlanRequest.ProtoReflect.Descriptor instead.
+func (*CancelInstallmentPlanRequest) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{107}
+}
+
+func (x *CancelInstallmentPlanRequest) GetPlanId() string {
+	if x != nil {
+		return x.PlanId
+	}
+	return ""
+}
+
+func (x *CancelInstallmentPlanRequest) GetActionById() string {
+	if x != nil {
+		return x.ActionById
+	}
+	return ""
+}
+
+func (x *CancelInstallmentPlanRequest) GetActionByName() string {
+	if x != nil {
+		return x.ActionByName
+	}
+	return ""
+}
+
+type AbsInstallmentPlan struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	Id                   string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id"`
+	StudentId            string                 `protobuf:"bytes,2,opt,name=studentId,proto3" json:"studentId"`
+	GroupId              string                 `protobuf:"bytes,3,opt,name=groupId,proto3" json:"groupId"`
+	PlanType             string                 `protobuf:"bytes,4,opt,name=planType,proto3" json:"planType"`
+	TotalAmount          string                 `protobuf:"bytes,5,opt,name=totalAmount,proto3" json:"totalAmount"`
+	PaidAmount           string                 `protobuf:"bytes,6,opt,name=paidAmount,proto3" json:"paidAmount"`
+	LateFeeAmount        string                 `protobuf:"bytes,7,opt,name=lateFeeAmount,proto3" json:"lateFeeAmount"`
+	GraceDays            int32                  `protobuf:"varint,8,opt,name=graceDays,proto3" json:"graceDays"`
+	EarlyDiscountPercent float64                `protobuf:"fixed64,9,opt,name=earlyDiscountPercent,proto3" json:"earlyDiscountPercent"`
+	EarlyDays            int32                  `protobuf:"varint,10,opt,name=earlyDays,proto3" json:"earlyDays"`
+	Status               string                 `protobuf:"bytes,11,opt,name=status,proto3" json:"status"`
+	Comment              string                 `protobuf:"bytes,12,opt,name=comment,proto3" json:"comment"`
+	CreatedByName        string                 `protobuf:"bytes,13,opt,name=createdByName,proto3" json:"createdByName"`
+	CreatedAt            string                 `protobuf:"bytes,14,opt,name=createdAt,proto3" json:"createdAt"`
+	Installments         []*AbsInstallment      `protobuf:"bytes,15,rep,name=installments,proto3" json:"installments"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *AbsInstallmentPlan) Reset() {
+	*x = AbsInstallmentPlan{}
+	mi := &file_finance_proto_msgTypes[108]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AbsInstallmentPlan) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AbsInstallmentPlan) ProtoMessage() {}
+
+func (x *AbsInstallmentPlan) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[108]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AbsInstallmentPlan.ProtoReflect.Descriptor instead.
+func (*AbsInstallmentPlan) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{108}
+}
+
+func (x *AbsInstallmentPlan) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *AbsInstallmentPlan) GetStudentId() string {
+	if x != nil {
+		return x.StudentId
+	}
+	return ""
+}
+
+func (x *AbsInstallmentPlan) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+func (x *AbsInstallmentPlan) GetPlanType() string {
+	if x != nil {
+		return x.PlanType
+	}
+	return ""
+}
+
+func (x *AbsInstallmentPlan) GetTotalAmount() string {
+	if x != nil {
+		return x.TotalAmount
+	}
+	return ""
+}
+
+func (x *AbsInstallmentPlan) GetPaidAmount() string {
+	if x != nil {
+		return x.PaidAmount
+	}
+	return ""
+}
+
+func (x *AbsInstallmentPlan) GetLateFeeAmount() string {
+	if x != nil {
+		return x.LateFeeAmount
+	}
+	return ""
+}
+
+func (x *AbsInstallmentPlan) GetGraceDays() int32 {
+	if x != nil {
+		return x.GraceDays
+	}
+	return 0
+}
+
+func (x *AbsInstallmentPlan) GetEarlyDiscountPercent() float64 {
+	if x != nil {
+		return x.EarlyDiscountPercent
+	}
+	return 0
+}
+
+func (x *AbsInstallmentPlan) GetEarlyDays() int32 {
+	if x != nil {
+		return x.EarlyDays
+	}
+	return 0
+}
+
+func (x *AbsInstallmentPlan) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *AbsInstallmentPlan) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+func (x *AbsInstallmentPlan) GetCreatedByName() string {
+	if x != nil {
+		return x.CreatedByName
+	}
+	return ""
+}
+
+func (x *AbsInstallmentPlan) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *AbsInstallmentPlan) GetInstallments() []*AbsInstallment {
+	if x != nil {
+		return x.Installments
+	}
+	return nil
+}
+
+type AbsInstallment struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Id               string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id"`
+	DueDate          string                 `protobuf:"bytes,2,opt,name=dueDate,proto3" json:"dueDate"`
+	Amount           string                 `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount"`
+	PaidAmount       string                 `protobuf:"bytes,4,opt,name=paidAmount,proto3" json:"paidAmount"`
+	DiscountAmount   string                 `protobuf:"bytes,5,opt,name=discountAmount,proto3" json:"discountAmount"`
+	IsCharged        bool                   `protobuf:"varint,6,opt,name=isCharged,proto3" json:"isCharged"`
+	IsLateFeeApplied bool                   `protobuf:"varint,7,opt,name=isLateFeeApplied,proto3" json:"isLateFeeApplied"`
+	PaidAt           string                 `protobuf:"bytes,8,opt,name=paidAt,proto3" json:"paidAt"`
+	Status           string                 `protobuf:"bytes,9,opt,name=status,proto3" json:"status"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *AbsInstallment) Reset() {
+	*x = AbsInstallment{}
+	mi := &file_finance_proto_msgTypes[109]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AbsInstallment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AbsInstallment) ProtoMessage() {}
+
+func (x *AbsInstallment) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[109]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AbsInstallment.ProtoReflect.Descriptor instead.
+func (*AbsInstallment) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{109}
+}
+
+func (x *AbsInstallment) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *AbsInstallment) GetDueDate() string {
+	if x != nil {
+		return x.DueDate
+	}
+	return ""
+}
+
+func (x *AbsInstallment) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *AbsInstallment) GetPaidAmount() string {
+	if x != nil {
+		return x.PaidAmount
+	}
+	return ""
+}
+
+func (x *AbsInstallment) GetDiscountAmount() string {
+	if x != nil {
+		return x.DiscountAmount
+	}
+	return ""
+}
+
+func (x *AbsInstallment) GetIsCharged() bool {
+	if x != nil {
+		return x.IsCharged
+	}
+	return false
+}
+
+func (x *AbsInstallment) GetIsLateFeeApplied() bool {
+	if x != nil {
+		return x.IsLateFeeApplied
+	}
+	return false
+}
+
+func (x *AbsInstallment) GetPaidAt() string {
+	if x != nil {
+		return x.PaidAt
+	}
+	return ""
+}
+
+func (x *AbsInstallment) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type ChargeInstallmentsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StudentId     string                 `protobuf:"bytes,1,opt,name=studentId,proto3" json:"studentId"`
+	GroupId       string                 `protobuf:"bytes,2,opt,name=groupId,proto3" json:"groupId"`
+	Date          string                 `protobuf:"bytes,3,opt,name=date,proto3" json:"date"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChargeInstallmentsRequest) Reset() {
+	*x = ChargeInstallmentsRequest{}
+	mi := &file_finance_proto_msgTypes[110]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChargeInstallmentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChargeInstallmentsRequest) ProtoMessage() {}
+
+func (x *ChargeInstallmentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[110]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChargeInstallmentsRequest.ProtoReflect.Descriptor instead.
+func (*ChargeInstallmentsRequest) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{110}
+}
+
+func (x *ChargeInstallmentsRequest) GetStudentId() string {
+	if x != nil {
+		return x.StudentId
+	}
+	return ""
+}
+
+func (x *ChargeInstallmentsRequest) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+func (x *ChargeInstallmentsRequest) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+type ChargeInstallmentsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	HasPlan       bool                   `protobuf:"varint,1,opt,name=hasPlan,proto3" json:"hasPlan"`
+	ChargedCount  int32                  `protobuf:"varint,2,opt,name=chargedCount,proto3" json:"chargedCount"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChargeInstallmentsResponse) Reset() {
+	*x = ChargeInstallmentsResponse{}
+	mi := &file_finance_proto_msgTypes[111]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChargeInstallmentsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChargeInstallmentsResponse) ProtoMessage() {}
+
+func (x *ChargeInstallmentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[111]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChargeInstallmentsResponse.ProtoReflect.Descriptor instead.
+func (*ChargeInstallmentsResponse) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{111}
+}
+
+func (x *ChargeInstallmentsResponse) GetHasPlan() bool {
+	if x != nil {
+		return x.HasPlan
+	}
+	return false
+}
+
+func (x *ChargeInstallmentsResponse) GetChargedCount() int32 {
+	if x != nil {
+		return x.ChargedCount
+	}
+	return 0
+}
+
+type GetOverdueInstallmentsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Date          string                 `protobuf:"bytes,1,opt,name=date,proto3" json:"date"`
+	Bucket        string                 `protobuf:"bytes,2,opt,name=bucket,proto3" json:"bucket"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetOverdueInstallmentsRequest) Reset() {
+	*x = GetOverdueInstallmentsRequest{}
+	mi := &file_finance_proto_msgTypes[112]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetOverdueInstallmentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOverdueInstallmentsRequest) ProtoMessage() {}
+
+func (x *GetOverdueInstallmentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[112]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOverdueInstallmentsRequest.ProtoReflect.Descriptor instead.
+func (*GetOverdueInstallmentsRequest) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{112}
+}
+
+func (x *GetOverdueInstallmentsRequest) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *GetOverdueInstallmentsRequest) GetBucket() string {
+	if x != nil {
+		return x.Bucket
+	}
+	return ""
+}
+
+type GetOverdueInstallmentsResponse struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	Installments  []*AbsOverdueInstallment `protobuf:"bytes,1,rep,name=installments,proto3" json:"installments"`
+	Buckets       []*OverdueBucket         `protobuf:"bytes,2,rep,name=buckets,proto3" json:"buckets"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetOverdueInstallmentsResponse) Reset() {
+	*x = GetOverdueInstallmentsResponse{}
+	mi := &file_finance_proto_msgTypes[113]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetOverdueInstallmentsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOverdueInstallmentsResponse) ProtoMessage() {}
+
+func (x *GetOverdueInstallmentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[113]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOverdueInstallmentsResponse.ProtoReflect.Descriptor instead.
+func (*GetOverdueInstallmentsResponse) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{113}
+}
+
+func (x *GetOverdueInstallmentsResponse) GetInstallments() []*AbsOverdueInstallment {
+	if x != nil {
+		return x.Installments
+	}
+	return nil
+}
+
+func (x *GetOverdueInstallmentsResponse) GetBuckets() []*OverdueBucket {
+	if x != nil {
+		return x.Buckets
+	}
+	return nil
+}
+
+type AbsOverdueInstallment struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	InstallmentId    string                 `protobuf:"bytes,1,opt,name=installmentId,proto3" json:"installmentId"`
+	PlanId           string                 `protobuf:"bytes,2,opt,name=planId,proto3" json:"planId"`
+	StudentId        string                 `protobuf:"bytes,3,opt,name=studentId,proto3" json:"studentId"`
+	StudentName      string                 `protobuf:"bytes,4,opt,name=studentName,proto3" json:"studentName"`
+	PhoneNumber      string                 `protobuf:"bytes,5,opt,name=phoneNumber,proto3" json:"phoneNumber"`
+	GroupId          string                 `protobuf:"bytes,6,opt,name=groupId,proto3" json:"groupId"`
+	DueDate          string                 `protobuf:"bytes,7,opt,name=dueDate,proto3" json:"dueDate"`
+	Amount           string                 `protobuf:"bytes,8,opt,name=amount,proto3" json:"amount"`
+	RemainingAmount  string                 `protobuf:"bytes,9,opt,name=remainingAmount,proto3" json:"remainingAmount"`
+	DaysOverdue      int32                  `protobuf:"varint,10,opt,name=daysOverdue,proto3" json:"daysOverdue"`
+	Bucket           string                 `protobuf:"bytes,11,opt,name=bucket,proto3" json:"bucket"`
+	IsLateFeeApplied bool                   `protobuf:"varint,12,opt,name=isLateFeeApplied,proto3" json:"isLateFeeApplied"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *AbsOverdueInstallment) Reset() {
+	*x = AbsOverdueInstallment{}
+	mi := &file_finance_proto_msgTypes[114]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AbsOverdueInstallment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AbsOverdueInstallment) ProtoMessage() {}
+
+func (x *AbsOverdueInstallment) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[114]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AbsOverdueInstallment.ProtoReflect.Descriptor instead.
+func (*AbsOverdueInstallment) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{114}
+}
+
+func (x *AbsOverdueInstallment) GetInstallmentId() string {
+	if x != nil {
+		return x.InstallmentId
+	}
+	return ""
+}
+
+func (x *AbsOverdueInstallment) GetPlanId() string {
+	if x != nil {
+		return x.PlanId
+	}
+	return ""
+}
+
+func (x *AbsOverdueInstallment) GetStudentId() string {
+	if x != nil {
+		return x.StudentId
+	}
+	return ""
+}
+
+func (x *AbsOverdueInstallment) GetStudentName() string {
+	if x != nil {
+		return x.StudentName
+	}
+	return ""
+}
+
+func (x *AbsOverdueInstallment) GetPhoneNumber() string {
+	if x != nil {
+		return x.PhoneNumber
+	}
+	return ""
+}
+
+func (x *AbsOverdueInstallment) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+func (x *AbsOverdueInstallment) GetDueDate() string {
+	if x != nil {
+		return x.DueDate
+	}
+	return ""
+}
+
+func (x *AbsOverdueInstallment) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *AbsOverdueInstallment) GetRemainingAmount() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.RemainingAmount
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use CreateCategoryRequest.ProtoReflect.Descriptor instead.
-func (*CreateCategoryRequest) Descriptor() ([]byte, []int) {
-	return file_finance_proto_rawDescGZIP(), []int{7}
+func (x *AbsOverdueInstallment) GetDaysOverdue() int32 {
+	if x != nil {
+		return x.DaysOverdue
+	}
+	return 0
 }
 
-func (x *CreateCategoryRequest) GetName() string {
+func (x *AbsOverdueInstallment) GetBucket() string {
 	if x != nil {
-		return x.Name
+		return x.Bucket
 	}
 	return ""
 }
 
-func (x *CreateCategoryRequest) GetDesc() string {
+func (x *AbsOverdueInstallment) GetIsLateFeeApplied() bool {
 	if x != nil {
-		return x.Desc
+		return x.IsLateFeeApplied
 	}
-	return ""
+	return false
 }
 
-type GetAllCategoryRequest struct {
+type OverdueBucket struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Categories    []*AbsCategory         `protobuf:"bytes,1,rep,name=categories,proto3" json:"categories"`
+	Bucket        string                 `protobuf:"bytes,1,opt,name=bucket,proto3" json:"bucket"`
+	Count         int32                  `protobuf:"varint,2,opt,name=count,proto3" json:"count"`
+	Amount        string                 `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetAllCategoryRequest) Reset() {
-	*x = GetAllCategoryRequest{}
-	mi := &file_finance_proto_msgTypes[8]
+func (x *OverdueBucket) Reset() {
+	*x = OverdueBucket{}
+	mi := &file_finance_proto_msgTypes[115]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetAllCategoryRequest) String() string {
+func (x *OverdueBucket) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetAllCategoryRequest) ProtoMessage() {}
+func (*OverdueBucket) ProtoMessage() {}
 
-func (x *GetAllCategoryRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_finance_proto_msgTypes[8]
+func (x *OverdueBucket) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[115]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -614,42 +8155,57 @@ func (x *GetAllCategoryRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetAllCategoryRequest.ProtoReflect.Descriptor instead.
-func (*GetAllCategoryRequest) Descriptor() ([]byte, []int) {
-	return file_finance_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use OverdueBucket.ProtoReflect.Descriptor instead.
+func (*OverdueBucket) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{115}
 }
 
-func (x *GetAllCategoryRequest) GetCategories() []*AbsCategory {
+func (x *OverdueBucket) GetBucket() string {
 	if x != nil {
-		return x.Categories
+		return x.Bucket
 	}
-	return nil
+	return ""
 }
 
-type AbsCategory struct {
+func (x *OverdueBucket) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *OverdueBucket) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+type GetDebtAgingRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name"`
-	Desc          string                 `protobuf:"bytes,3,opt,name=desc,proto3" json:"desc"`
+	Bucket        string                 `protobuf:"bytes,1,opt,name=bucket,proto3" json:"bucket"`
+	AssignedToId  string                 `protobuf:"bytes,2,opt,name=assignedToId,proto3" json:"assignedToId"`
+	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page"`
+	Size          int32                  `protobuf:"varint,4,opt,name=size,proto3" json:"size"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AbsCategory) Reset() {
-	*x = AbsCategory{}
-	mi := &file_finance_proto_msgTypes[9]
+func (x *GetDebtAgingRequest) Reset() {
+	*x = GetDebtAgingRequest{}
+	mi := &file_finance_proto_msgTypes[116]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AbsCategory) String() string {
+func (x *GetDebtAgingRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AbsCategory) ProtoMessage() {}
+func (*GetDebtAgingRequest) ProtoMessage() {}
 
-func (x *AbsCategory) ProtoReflect() protoreflect.Message {
-	mi := &file_finance_proto_msgTypes[9]
+func (x *GetDebtAgingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[116]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -660,58 +8216,63 @@ func (x *AbsCategory) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AbsCategory.ProtoReflect.Descriptor instead.
-func (*AbsCategory) Descriptor() ([]byte, []int) {
-	return file_finance_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use GetDebtAgingRequest.ProtoReflect.Descriptor instead.
+func (*GetDebtAgingRequest) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{116}
 }
 
-func (x *AbsCategory) GetId() string {
+func (x *GetDebtAgingRequest) GetBucket() string {
 	if x != nil {
-		return x.Id
+		return x.Bucket
 	}
 	return ""
 }
 
-func (x *AbsCategory) GetName() string {
+func (x *GetDebtAgingRequest) GetAssignedToId() string {
 	if x != nil {
-		return x.Name
+		return x.AssignedToId
 	}
 	return ""
 }
 
-func (x *AbsCategory) GetDesc() string {
+func (x *GetDebtAgingRequest) GetPage() int32 {
 	if x != nil {
-		return x.Desc
+		return x.Page
 	}
-	return ""
+	return 0
 }
 
-type GetAllExpenseDiagramResponse struct {
-	state                  protoimpl.MessageState `protogen:"open.v1"`
-	UserOrCategories       []string               `protobuf:"bytes,1,rep,name=userOrCategories,proto3" json:"userOrCategories"`
-	UserOrCategoriesAmount []string               `protobuf:"bytes,2,rep,name=userOrCategoriesAmount,proto3" json:"userOrCategoriesAmount"`
-	MonthAmount            []string               `protobuf:"bytes,3,rep,name=monthAmount,proto3" json:"monthAmount"`
-	Months                 []string               `protobuf:"bytes,4,rep,name=months,proto3" json:"months"`
-	AmountCommonExpense    string                 `protobuf:"bytes,5,opt,name=amountCommonExpense,proto3" json:"amountCommonExpense"`
-	unknownFields          protoimpl.UnknownFields
-	sizeCache              protoimpl.SizeCache
+func (x *GetDebtAgingRequest) GetSize() int32 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
 }
 
-func (x *GetAllExpenseDiagramResponse) Reset() {
-	*x = GetAllExpenseDiagramResponse{}
-	mi := &file_finance_proto_msgTypes[10]
+type GetDebtAgingResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Debtors        []*AbsAgedDebtor       `protobuf:"bytes,1,rep,name=debtors,proto3" json:"debtors"`
+	Buckets        []*AgingBucket         `protobuf:"bytes,2,rep,name=buckets,proto3" json:"buckets"`
+	TotalPageCount int32                  `protobuf:"varint,3,opt,name=totalPageCount,proto3" json:"totalPageCount"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetDebtAgingResponse) Reset() {
+	*x = GetDebtAgingResponse{}
+	mi := &file_finance_proto_msgTypes[117]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetAllExpenseDiagramResponse) String() string {
+func (x *GetDebtAgingResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetAllExpenseDiagramResponse) ProtoMessage() {}
+func (*GetDebtAgingResponse) ProtoMessage() {}
 
-func (x *GetAllExpenseDiagramResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_finance_proto_msgTypes[10]
+func (x *GetDebtAgingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[117]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -722,69 +8283,67 @@ func (x *GetAllExpenseDiagramResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetAllExpenseDiagramResponse.ProtoReflect.Descriptor instead.
-func (*GetAllExpenseDiagramResponse) Descriptor() ([]byte, []int) {
-	return file_finance_proto_rawDescGZIP(), []int{10}
-}
-
-func (x *GetAllExpenseDiagramResponse) GetUserOrCategories() []string {
-	if x != nil {
-		return x.UserOrCategories
-	}
-	return nil
-}
-
-func (x *GetAllExpenseDiagramResponse) GetUserOrCategoriesAmount() []string {
-	if x != nil {
-		return x.UserOrCategoriesAmount
-	}
-	return nil
+// Deprecated: Use GetDebtAgingResponse.ProtoReflect.Descriptor instead.
+func (*GetDebtAgingResponse) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{117}
 }
 
-func (x *GetAllExpenseDiagramResponse) GetMonthAmount() []string {
+func (x *GetDebtAgingResponse) GetDebtors() []*AbsAgedDebtor {
 	if x != nil {
-		return x.MonthAmount
+		return x.Debtors
 	}
 	return nil
 }
 
-func (x *GetAllExpenseDiagramResponse) GetMonths() []string {
+func (x *GetDebtAgingResponse) GetBuckets() []*AgingBucket {
 	if x != nil {
-		return x.Months
+		return x.Buckets
 	}
 	return nil
 }
 
-func (x *GetAllExpenseDiagramResponse) GetAmountCommonExpense() string {
+func (x *GetDebtAgingResponse) GetTotalPageCount() int32 {
 	if x != nil {
-		return x.AmountCommonExpense
+		return x.TotalPageCount
 	}
-	return ""
+	return 0
 }
 
-type GetAllExpenseDiagramRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	From          string                 `protobuf:"bytes,1,opt,name=from,proto3" json:"from"`
-	To            string                 `protobuf:"bytes,2,opt,name=to,proto3" json:"to"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type AbsAgedDebtor struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	StudentId          string                 `protobuf:"bytes,1,opt,name=studentId,proto3" json:"studentId"`
+	StudentName        string                 `protobuf:"bytes,2,opt,name=studentName,proto3" json:"studentName"`
+	PhoneNumber        string                 `protobuf:"bytes,3,opt,name=phoneNumber,proto3" json:"phoneNumber"`
+	Balance            string                 `protobuf:"bytes,4,opt,name=balance,proto3" json:"balance"`
+	DebtSince          string                 `protobuf:"bytes,5,opt,name=debtSince,proto3" json:"debtSince"`
+	DaysOverdue        int32                  `protobuf:"varint,6,opt,name=daysOverdue,proto3" json:"daysOverdue"`
+	Bucket             string                 `protobuf:"bytes,7,opt,name=bucket,proto3" json:"bucket"`
+	AssignedToId       string                 `protobuf:"bytes,8,opt,name=assignedToId,proto3" json:"assignedToId"`
+	AssignedToName     string                 `protobuf:"bytes,9,opt,name=assignedToName,proto3" json:"assignedToName"`
+	FreezeFlagged      bool                   `protobuf:"varint,10,opt,name=freezeFlagged,proto3" json:"freezeFlagged"`
+	LastContactAt      string                 `protobuf:"bytes,11,opt,name=lastContactAt,proto3" json:"lastContactAt"`
+	PromisedAmount     string                 `protobuf:"bytes,12,opt,name=promisedAmount,proto3" json:"promisedAmount"`
+	PromisedDate       string                 `protobuf:"bytes,13,opt,name=promisedDate,proto3" json:"promisedDate"`
+	LastReminderBucket string                 `protobuf:"bytes,14,opt,name=lastReminderBucket,proto3" json:"lastReminderBucket"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
 }
 
-func (x *GetAllExpenseDiagramRequest) Reset() {
-	*x = GetAllExpenseDiagramRequest{}
-	mi := &file_finance_proto_msgTypes[11]
+func (x *AbsAgedDebtor) Reset() {
+	*x = AbsAgedDebtor{}
+	mi := &file_finance_proto_msgTypes[118]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetAllExpenseDiagramRequest) String() string {
+func (x *AbsAgedDebtor) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetAllExpenseDiagramRequest) ProtoMessage() {}
+func (*AbsAgedDebtor) ProtoMessage() {}
 
-func (x *GetAllExpenseDiagramRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_finance_proto_msgTypes[11]
+func (x *AbsAgedDebtor) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[118]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -795,124 +8354,133 @@ func (x *GetAllExpenseDiagramRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetAllExpenseDiagramRequest.ProtoReflect.Descriptor instead.
-func (*GetAllExpenseDiagramRequest) Descriptor() ([]byte, []int) {
-	return file_finance_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use AbsAgedDebtor.ProtoReflect.Descriptor instead.
+func (*AbsAgedDebtor) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{118}
 }
 
-func (x *GetAllExpenseDiagramRequest) GetFrom() string {
+func (x *AbsAgedDebtor) GetStudentId() string {
 	if x != nil {
-		return x.From
+		return x.StudentId
 	}
 	return ""
 }
 
-func (x *GetAllExpenseDiagramRequest) GetTo() string {
+func (x *AbsAgedDebtor) GetStudentName() string {
 	if x != nil {
-		return x.To
+		return x.StudentName
 	}
 	return ""
 }
 
-type GetAllExpenseRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	From          string                 `protobuf:"bytes,1,opt,name=from,proto3" json:"from"`
-	To            string                 `protobuf:"bytes,2,opt,name=to,proto3" json:"to"`
-	Type          string                 `protobuf:"bytes,3,opt,name=type,proto3" json:"type"`
-	Id            string                 `protobuf:"bytes,5,opt,name=id,proto3" json:"id"`
-	PageReq       *PageRequest           `protobuf:"bytes,4,opt,name=pageReq,proto3" json:"pageReq"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *AbsAgedDebtor) GetPhoneNumber() string {
+	if x != nil {
+		return x.PhoneNumber
+	}
+	return ""
 }
 
-func (x *GetAllExpenseRequest) Reset() {
-	*x = GetAllExpenseRequest{}
-	mi := &file_finance_proto_msgTypes[12]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *AbsAgedDebtor) GetBalance() string {
+	if x != nil {
+		return x.Balance
+	}
+	return ""
 }
 
-func (x *GetAllExpenseRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *AbsAgedDebtor) GetDebtSince() string {
+	if x != nil {
+		return x.DebtSince
+	}
+	return ""
 }
 
-func (*GetAllExpenseRequest) ProtoMessage() {}
+func (x *AbsAgedDebtor) GetDaysOverdue() int32 {
+	if x != nil {
+		return x.DaysOverdue
+	}
+	return 0
+}
 
-func (x *GetAllExpenseRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_finance_proto_msgTypes[12]
+func (x *AbsAgedDebtor) GetBucket() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Bucket
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use GetAllExpenseRequest.ProtoReflect.Descriptor instead.
-func (*GetAllExpenseRequest) Descriptor() ([]byte, []int) {
-	return file_finance_proto_rawDescGZIP(), []int{12}
+func (x *AbsAgedDebtor) GetAssignedToId() string {
+	if x != nil {
+		return x.AssignedToId
+	}
+	return ""
 }
 
-func (x *GetAllExpenseRequest) GetFrom() string {
+func (x *AbsAgedDebtor) GetAssignedToName() string {
 	if x != nil {
-		return x.From
+		return x.AssignedToName
 	}
 	return ""
 }
 
-func (x *GetAllExpenseRequest) GetTo() string {
+func (x *AbsAgedDebtor) GetFreezeFlagged() bool {
 	if x != nil {
-		return x.To
+		return x.FreezeFlagged
+	}
+	return false
+}
+
+func (x *AbsAgedDebtor) GetLastContactAt() string {
+	if x != nil {
+		return x.LastContactAt
 	}
 	return ""
 }
 
-func (x *GetAllExpenseRequest) GetType() string {
+func (x *AbsAgedDebtor) GetPromisedAmount() string {
 	if x != nil {
-		return x.Type
+		return x.PromisedAmount
 	}
 	return ""
 }
 
-func (x *GetAllExpenseRequest) GetId() string {
+func (x *AbsAgedDebtor) GetPromisedDate() string {
 	if x != nil {
-		return x.Id
+		return x.PromisedDate
 	}
 	return ""
 }
 
-func (x *GetAllExpenseRequest) GetPageReq() *PageRequest {
+func (x *AbsAgedDebtor) GetLastReminderBucket() string {
 	if x != nil {
-		return x.PageReq
+		return x.LastReminderBucket
 	}
-	return nil
+	return ""
 }
 
-type GetAllExpenseResponse struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	TotalPageCount int32                  `protobuf:"varint,1,opt,name=totalPageCount,proto3" json:"totalPageCount"`
-	Expenses       []*GetAllExpenseAbs    `protobuf:"bytes,2,rep,name=expenses,proto3" json:"expenses"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+type AgingBucket struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Bucket        string                 `protobuf:"bytes,1,opt,name=bucket,proto3" json:"bucket"`
+	Count         int32                  `protobuf:"varint,2,opt,name=count,proto3" json:"count"`
+	Amount        string                 `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetAllExpenseResponse) Reset() {
-	*x = GetAllExpenseResponse{}
-	mi := &file_finance_proto_msgTypes[13]
+func (x *AgingBucket) Reset() {
+	*x = AgingBucket{}
+	mi := &file_finance_proto_msgTypes[119]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetAllExpenseResponse) String() string {
+func (x *AgingBucket) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetAllExpenseResponse) ProtoMessage() {}
+func (*AgingBucket) ProtoMessage() {}
 
-func (x *GetAllExpenseResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_finance_proto_msgTypes[13]
+func (x *AgingBucket) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[119]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -923,56 +8491,55 @@ func (x *GetAllExpenseResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetAllExpenseResponse.ProtoReflect.Descriptor instead.
-func (*GetAllExpenseResponse) Descriptor() ([]byte, []int) {
-	return file_finance_proto_rawDescGZIP(), []int{13}
+// Deprecated: Use AgingBucket.ProtoReflect.Descriptor instead.
+func (*AgingBucket) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{119}
 }
 
-func (x *GetAllExpenseResponse) GetTotalPageCount() int32 {
+func (x *AgingBucket) GetBucket() string {
 	if x != nil {
-		return x.TotalPageCount
+		return x.Bucket
 	}
-	return 0
+	return ""
 }
 
-func (x *GetAllExpenseResponse) GetExpenses() []*GetAllExpenseAbs {
+func (x *AgingBucket) GetCount() int32 {
 	if x != nil {
-		return x.Expenses
+		return x.Count
 	}
-	return nil
+	return 0
 }
 
-type GetAllExpenseAbs struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id"`
-	GivenDate     string                 `protobuf:"bytes,2,opt,name=givenDate,proto3" json:"givenDate"`
-	Category      *AbsCategory           `protobuf:"bytes,3,opt,name=category,proto3" json:"category"`
-	User          *GetUserByIdResponse   `protobuf:"bytes,4,opt,name=user,proto3" json:"user"`
-	ExpenseType   string                 `protobuf:"bytes,5,opt,name=expenseType,proto3" json:"expenseType"`
-	Sum           string                 `protobuf:"bytes,6,opt,name=sum,proto3" json:"sum"`
-	Creator       *GetUserByIdResponse   `protobuf:"bytes,7,opt,name=creator,proto3" json:"creator"`
-	PaymentType   string                 `protobuf:"bytes,8,opt,name=paymentType,proto3" json:"paymentType"`
-	CreatedAt     string                 `protobuf:"bytes,9,opt,name=createdAt,proto3" json:"createdAt"`
-	Title         string                 `protobuf:"bytes,10,opt,name=title,proto3" json:"title"`
+func (x *AgingBucket) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+type AssignDebtorRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StudentId     string                 `protobuf:"bytes,1,opt,name=studentId,proto3" json:"studentId"`
+	AssignedToId  string                 `protobuf:"bytes,2,opt,name=assignedToId,proto3" json:"assignedToId"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetAllExpenseAbs) Reset() {
-	*x = GetAllExpenseAbs{}
-	mi := &file_finance_proto_msgTypes[14]
+func (x *AssignDebtorRequest) Reset() {
+	*x = AssignDebtorRequest{}
+	mi := &file_finance_proto_msgTypes[120]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetAllExpenseAbs) String() string {
+func (x *AssignDebtorRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetAllExpenseAbs) ProtoMessage() {}
+func (*AssignDebtorRequest) ProtoMessage() {}
 
-func (x *GetAllExpenseAbs) ProtoReflect() protoreflect.Message {
-	mi := &file_finance_proto_msgTypes[14]
+func (x *AssignDebtorRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[120]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -983,110 +8550,139 @@ func (x *GetAllExpenseAbs) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetAllExpenseAbs.ProtoReflect.Descriptor instead.
-func (*GetAllExpenseAbs) Descriptor() ([]byte, []int) {
-	return file_finance_proto_rawDescGZIP(), []int{14}
+// Deprecated: Use AssignDebtorRequest.ProtoReflect.Descriptor instead.
+func (*AssignDebtorRequest) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{120}
 }
 
-func (x *GetAllExpenseAbs) GetId() string {
+func (x *AssignDebtorRequest) GetStudentId() string {
 	if x != nil {
-		return x.Id
+		return x.StudentId
 	}
 	return ""
 }
 
-func (x *GetAllExpenseAbs) GetGivenDate() string {
+func (x *AssignDebtorRequest) GetAssignedToId() string {
 	if x != nil {
-		return x.GivenDate
+		return x.AssignedToId
 	}
 	return ""
 }
 
-func (x *GetAllExpenseAbs) GetCategory() *AbsCategory {
+type AddCollectionActivityRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	StudentId      string                 `protobuf:"bytes,1,opt,name=studentId,proto3" json:"studentId"`
+	Type           string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type"`
+	Comment        string                 `protobuf:"bytes,3,opt,name=comment,proto3" json:"comment"`
+	PromisedAmount string                 `protobuf:"bytes,4,opt,name=promisedAmount,proto3" json:"promisedAmount"`
+	PromisedDate   string                 `protobuf:"bytes,5,opt,name=promisedDate,proto3" json:"promisedDate"`
+	ActionById     string                 `protobuf:"bytes,6,opt,name=actionById,proto3" json:"actionById"`
+	ActionByName   string                 `protobuf:"bytes,7,opt,name=actionByName,proto3" json:"actionByName"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *AddCollectionActivityRequest) Reset() {
+	*x = AddCollectionActivityRequest{}
+	mi := &file_finance_proto_msgTypes[121]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddCollectionActivityRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddCollectionActivityRequest) ProtoMessage() {}
+
+func (x *AddCollectionActivityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[121]
 	if x != nil {
-		return x.Category
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *GetAllExpenseAbs) GetUser() *GetUserByIdResponse {
+// Deprecated: Use AddCollectionActivityRequest.ProtoReflect.Descriptor instead.
+func (*AddCollectionActivityRequest) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{121}
+}
+
+func (x *AddCollectionActivityRequest) GetStudentId() string {
 	if x != nil {
-		return x.User
+		return x.StudentId
 	}
-	return nil
+	return ""
 }
 
-func (x *GetAllExpenseAbs) GetExpenseType() string {
+func (x *AddCollectionActivityRequest) GetType() string {
 	if x != nil {
-		return x.ExpenseType
+		return x.Type
 	}
 	return ""
 }
 
-func (x *GetAllExpenseAbs) GetSum() string {
+func (x *AddCollectionActivityRequest) GetComment() string {
 	if x != nil {
-		return x.Sum
+		return x.Comment
 	}
 	return ""
 }
 
-func (x *GetAllExpenseAbs) GetCreator() *GetUserByIdResponse {
+func (x *AddCollectionActivityRequest) GetPromisedAmount() string {
 	if x != nil {
-		return x.Creator
+		return x.PromisedAmount
 	}
-	return nil
+	return ""
 }
 
-func (x *GetAllExpenseAbs) GetPaymentType() string {
+func (x *AddCollectionActivityRequest) GetPromisedDate() string {
 	if x != nil {
-		return x.PaymentType
+		return x.PromisedDate
 	}
 	return ""
 }
 
-func (x *GetAllExpenseAbs) GetCreatedAt() string {
+func (x *AddCollectionActivityRequest) GetActionById() string {
 	if x != nil {
-		return x.CreatedAt
+		return x.ActionById
 	}
 	return ""
 }
 
-func (x *GetAllExpenseAbs) GetTitle() string {
+func (x *AddCollectionActivityRequest) GetActionByName() string {
 	if x != nil {
-		return x.Title
+		return x.ActionByName
 	}
 	return ""
 }
 
-type CreateExpenseRequest struct {
+type GetCollectionActivitiesRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Title         string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title"`
-	GivenDate     string                 `protobuf:"bytes,2,opt,name=givenDate,proto3" json:"givenDate"`
-	ExpenseType   string                 `protobuf:"bytes,3,opt,name=expenseType,proto3" json:"expenseType"`
-	CategoryId    string                 `protobuf:"bytes,4,opt,name=categoryId,proto3" json:"categoryId"`
-	UserId        string                 `protobuf:"bytes,5,opt,name=userId,proto3" json:"userId"`
-	Sum           string                 `protobuf:"bytes,6,opt,name=sum,proto3" json:"sum"`
-	CreatedById   string                 `protobuf:"bytes,7,opt,name=createdById,proto3" json:"createdById"`
-	PaymentMethod string                 `protobuf:"bytes,8,opt,name=paymentMethod,proto3" json:"paymentMethod"`
+	StudentId     string                 `protobuf:"bytes,1,opt,name=studentId,proto3" json:"studentId"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateExpenseRequest) Reset() {
-	*x = CreateExpenseRequest{}
-	mi := &file_finance_proto_msgTypes[15]
+func (x *GetCollectionActivitiesRequest) Reset() {
+	*x = GetCollectionActivitiesRequest{}
+	mi := &file_finance_proto_msgTypes[122]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateExpenseRequest) String() string {
+func (x *GetCollectionActivitiesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateExpenseRequest) ProtoMessage() {}
+func (*GetCollectionActivitiesRequest) ProtoMessage() {}
 
-func (x *CreateExpenseRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_finance_proto_msgTypes[15]
+func (x *GetCollectionActivitiesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[122]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1097,141 +8693,193 @@ func (x *CreateExpenseRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateExpenseRequest.ProtoReflect.Descriptor instead.
-func (*CreateExpenseRequest) Descriptor() ([]byte, []int) {
-	return file_finance_proto_rawDescGZIP(), []int{15}
+// Deprecated: Use GetCollectionActivitiesRequest.ProtoReflect.Descriptor instead.
+func (*GetCollectionActivitiesRequest) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{122}
 }
 
-func (x *CreateExpenseRequest) GetTitle() string {
+func (x *GetCollectionActivitiesRequest) GetStudentId() string {
 	if x != nil {
-		return x.Title
+		return x.StudentId
 	}
 	return ""
 }
 
-func (x *CreateExpenseRequest) GetGivenDate() string {
+type GetCollectionActivitiesResponse struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	Activities    []*AbsCollectionActivity `protobuf:"bytes,1,rep,name=activities,proto3" json:"activities"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCollectionActivitiesResponse) Reset() {
+	*x = GetCollectionActivitiesResponse{}
+	mi := &file_finance_proto_msgTypes[123]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCollectionActivitiesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCollectionActivitiesResponse) ProtoMessage() {}
+
+func (x *GetCollectionActivitiesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[123]
 	if x != nil {
-		return x.GivenDate
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *CreateExpenseRequest) GetExpenseType() string {
+// Deprecated: Use GetCollectionActivitiesResponse.ProtoReflect.Descriptor instead.
+func (*GetCollectionActivitiesResponse) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{123}
+}
+
+func (x *GetCollectionActivitiesResponse) GetActivities() []*AbsCollectionActivity {
 	if x != nil {
-		return x.ExpenseType
+		return x.Activities
 	}
-	return ""
+	return nil
 }
 
-func (x *CreateExpenseRequest) GetCategoryId() string {
+type AbsCollectionActivity struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id"`
+	StudentId      string                 `protobuf:"bytes,2,opt,name=studentId,proto3" json:"studentId"`
+	Type           string                 `protobuf:"bytes,3,opt,name=type,proto3" json:"type"`
+	Comment        string                 `protobuf:"bytes,4,opt,name=comment,proto3" json:"comment"`
+	PromisedAmount string                 `protobuf:"bytes,5,opt,name=promisedAmount,proto3" json:"promisedAmount"`
+	PromisedDate   string                 `protobuf:"bytes,6,opt,name=promisedDate,proto3" json:"promisedDate"`
+	CreatedById    string                 `protobuf:"bytes,7,opt,name=createdById,proto3" json:"createdById"`
+	CreatedByName  string                 `protobuf:"bytes,8,opt,name=createdByName,proto3" json:"createdByName"`
+	CreatedAt      string                 `protobuf:"bytes,9,opt,name=createdAt,proto3" json:"createdAt"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *AbsCollectionActivity) Reset() {
+	*x = AbsCollectionActivity{}
+	mi := &file_finance_proto_msgTypes[124]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AbsCollectionActivity) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AbsCollectionActivity) ProtoMessage() {}
+
+func (x *AbsCollectionActivity) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[124]
 	if x != nil {
-		return x.CategoryId
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *CreateExpenseRequest) GetUserId() string {
+// Deprecated: Use AbsCollectionActivity.ProtoReflect.Descriptor instead.
+func (*AbsCollectionActivity) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{124}
+}
+
+func (x *AbsCollectionActivity) GetId() string {
 	if x != nil {
-		return x.UserId
+		return x.Id
 	}
 	return ""
 }
 
-func (x *CreateExpenseRequest) GetSum() string {
+func (x *AbsCollectionActivity) GetStudentId() string {
 	if x != nil {
-		return x.Sum
+		return x.StudentId
 	}
 	return ""
 }
 
-func (x *CreateExpenseRequest) GetCreatedById() string {
+func (x *AbsCollectionActivity) GetType() string {
 	if x != nil {
-		return x.CreatedById
+		return x.Type
 	}
 	return ""
 }
 
-func (x *CreateExpenseRequest) GetPaymentMethod() string {
+func (x *AbsCollectionActivity) GetComment() string {
 	if x != nil {
-		return x.PaymentMethod
+		return x.Comment
 	}
 	return ""
 }
 
-type GetIncomeChartRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	From          string                 `protobuf:"bytes,1,opt,name=from,proto3" json:"from"`
-	To            string                 `protobuf:"bytes,2,opt,name=to,proto3" json:"to"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *GetIncomeChartRequest) Reset() {
-	*x = GetIncomeChartRequest{}
-	mi := &file_finance_proto_msgTypes[16]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *GetIncomeChartRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *AbsCollectionActivity) GetPromisedAmount() string {
+	if x != nil {
+		return x.PromisedAmount
+	}
+	return ""
 }
 
-func (*GetIncomeChartRequest) ProtoMessage() {}
-
-func (x *GetIncomeChartRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_finance_proto_msgTypes[16]
+func (x *AbsCollectionActivity) GetPromisedDate() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.PromisedDate
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use GetIncomeChartRequest.ProtoReflect.Descriptor instead.
-func (*GetIncomeChartRequest) Descriptor() ([]byte, []int) {
-	return file_finance_proto_rawDescGZIP(), []int{16}
+func (x *AbsCollectionActivity) GetCreatedById() string {
+	if x != nil {
+		return x.CreatedById
+	}
+	return ""
 }
 
-func (x *GetIncomeChartRequest) GetFrom() string {
+func (x *AbsCollectionActivity) GetCreatedByName() string {
 	if x != nil {
-		return x.From
+		return x.CreatedByName
 	}
 	return ""
 }
 
-func (x *GetIncomeChartRequest) GetTo() string {
+func (x *AbsCollectionActivity) GetCreatedAt() string {
 	if x != nil {
-		return x.To
+		return x.CreatedAt
 	}
 	return ""
 }
 
-type GetIncomeChartResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Response      []*AbsIncomeChart      `protobuf:"bytes,1,rep,name=response,proto3" json:"response"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type CollectionSetting struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	FreezeAfterDays  int32                  `protobuf:"varint,1,opt,name=freezeAfterDays,proto3" json:"freezeAfterDays"`
+	RemindersEnabled bool                   `protobuf:"varint,2,opt,name=remindersEnabled,proto3" json:"remindersEnabled"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
-func (x *GetIncomeChartResponse) Reset() {
-	*x = GetIncomeChartResponse{}
-	mi := &file_finance_proto_msgTypes[17]
+func (x *CollectionSetting) Reset() {
+	*x = CollectionSetting{}
+	mi := &file_finance_proto_msgTypes[125]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetIncomeChartResponse) String() string {
+func (x *CollectionSetting) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetIncomeChartResponse) ProtoMessage() {}
+func (*CollectionSetting) ProtoMessage() {}
 
-func (x *GetIncomeChartResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_finance_proto_msgTypes[17]
+func (x *CollectionSetting) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[125]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1242,41 +8890,49 @@ func (x *GetIncomeChartResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetIncomeChartResponse.ProtoReflect.Descriptor instead.
-func (*GetIncomeChartResponse) Descriptor() ([]byte, []int) {
-	return file_finance_proto_rawDescGZIP(), []int{17}
+// Deprecated: Use CollectionSetting.ProtoReflect.Descriptor instead.
+func (*CollectionSetting) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{125}
 }
 
-func (x *GetIncomeChartResponse) GetResponse() []*AbsIncomeChart {
+func (x *CollectionSetting) GetFreezeAfterDays() int32 {
 	if x != nil {
-		return x.Response
+		return x.FreezeAfterDays
 	}
-	return nil
+	return 0
 }
 
-type AbsIncomeChart struct {
+func (x *CollectionSetting) GetRemindersEnabled() bool {
+	if x != nil {
+		return x.RemindersEnabled
+	}
+	return false
+}
+
+type GetProfitAndLossRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	SpecificMonth string                 `protobuf:"bytes,1,opt,name=specificMonth,proto3" json:"specificMonth"`
-	Balance       string                 `protobuf:"bytes,2,opt,name=balance,proto3" json:"balance"`
+	From          string                 `protobuf:"bytes,1,opt,name=from,proto3" json:"from"`
+	To            string                 `protobuf:"bytes,2,opt,name=to,proto3" json:"to"`
+	GroupBy       string                 `protobuf:"bytes,3,opt,name=groupBy,proto3" json:"groupBy"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AbsIncomeChart) Reset() {
-	*x = AbsIncomeChart{}
-	mi := &file_finance_proto_msgTypes[18]
+func (x *GetProfitAndLossRequest) Reset() {
+	*x = GetProfitAndLossRequest{}
+	mi := &file_finance_proto_msgTypes[126]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AbsIncomeChart) String() string {
+func (x *GetProfitAndLossRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AbsIncomeChart) ProtoMessage() {}
+func (*GetProfitAndLossRequest) ProtoMessage() {}
 
-func (x *AbsIncomeChart) ProtoReflect() protoreflect.Message {
-	mi := &file_finance_proto_msgTypes[18]
+func (x *GetProfitAndLossRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[126]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1287,48 +8943,57 @@ func (x *AbsIncomeChart) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AbsIncomeChart.ProtoReflect.Descriptor instead.
-func (*AbsIncomeChart) Descriptor() ([]byte, []int) {
-	return file_finance_proto_rawDescGZIP(), []int{18}
+// Deprecated: Use GetProfitAndLossRequest.ProtoReflect.Descriptor instead.
+func (*GetProfitAndLossRequest) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{126}
 }
 
-func (x *AbsIncomeChart) GetSpecificMonth() string {
+func (x *GetProfitAndLossRequest) GetFrom() string {
 	if x != nil {
-		return x.SpecificMonth
+		return x.From
 	}
 	return ""
 }
 
-func (x *AbsIncomeChart) GetBalance() string {
+func (x *GetProfitAndLossRequest) GetTo() string {
 	if x != nil {
-		return x.Balance
+		return x.To
 	}
 	return ""
 }
 
-type GetCommonInformationResponse struct {
-	state             protoimpl.MessageState `protogen:"open.v1"`
-	DebtorsCount      int32                  `protobuf:"varint,1,opt,name=debtorsCount,proto3" json:"debtorsCount"`
-	PayInCurrentMonth int32                  `protobuf:"varint,2,opt,name=payInCurrentMonth,proto3" json:"payInCurrentMonth"`
-	unknownFields     protoimpl.UnknownFields
-	sizeCache         protoimpl.SizeCache
+func (x *GetProfitAndLossRequest) GetGroupBy() string {
+	if x != nil {
+		return x.GroupBy
+	}
+	return ""
+}
+
+type GetProfitAndLossResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Months        []*PnlMonth            `protobuf:"bytes,1,rep,name=months,proto3" json:"months"`
+	Total         *PnlMonth              `protobuf:"bytes,2,opt,name=total,proto3" json:"total"`
+	GroupBy       string                 `protobuf:"bytes,3,opt,name=groupBy,proto3" json:"groupBy"`
+	Breakdown     []*PnlBreakdown        `protobuf:"bytes,4,rep,name=breakdown,proto3" json:"breakdown"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetCommonInformationResponse) Reset() {
-	*x = GetCommonInformationResponse{}
-	mi := &file_finance_proto_msgTypes[19]
+func (x *GetProfitAndLossResponse) Reset() {
+	*x = GetProfitAndLossResponse{}
+	mi := &file_finance_proto_msgTypes[127]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetCommonInformationResponse) String() string {
+func (x *GetProfitAndLossResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetCommonInformationResponse) ProtoMessage() {}
+func (*GetProfitAndLossResponse) ProtoMessage() {}
 
-func (x *GetCommonInformationResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_finance_proto_msgTypes[19]
+func (x *GetProfitAndLossResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[127]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1339,51 +9004,68 @@ func (x *GetCommonInformationResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetCommonInformationResponse.ProtoReflect.Descriptor instead.
-func (*GetCommonInformationResponse) Descriptor() ([]byte, []int) {
-	return file_finance_proto_rawDescGZIP(), []int{19}
+// Deprecated: Use GetProfitAndLossResponse.ProtoReflect.Descriptor instead.
+func (*GetProfitAndLossResponse) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{127}
 }
 
-func (x *GetCommonInformationResponse) GetDebtorsCount() int32 {
+func (x *GetProfitAndLossResponse) GetMonths() []*PnlMonth {
 	if x != nil {
-		return x.DebtorsCount
+		return x.Months
 	}
-	return 0
+	return nil
 }
 
-func (x *GetCommonInformationResponse) GetPayInCurrentMonth() int32 {
+func (x *GetProfitAndLossResponse) GetTotal() *PnlMonth {
 	if x != nil {
-		return x.PayInCurrentMonth
+		return x.Total
 	}
-	return 0
+	return nil
 }
 
-type GetAllDebtsRequest struct {
+func (x *GetProfitAndLossResponse) GetGroupBy() string {
+	if x != nil {
+		return x.GroupBy
+	}
+	return ""
+}
+
+func (x *GetProfitAndLossResponse) GetBreakdown() []*PnlBreakdown {
+	if x != nil {
+		return x.Breakdown
+	}
+	return nil
+}
+
+type PnlMonth struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	PageParam     *PageRequest           `protobuf:"bytes,1,opt,name=pageParam,proto3" json:"pageParam"`
-	From          string                 `protobuf:"bytes,2,opt,name=from,proto3" json:"from"`
-	To            string                 `protobuf:"bytes,3,opt,name=to,proto3" json:"to"`
-	AmountFrom    int64                  `protobuf:"varint,4,opt,name=amountFrom,proto3" json:"amountFrom"`
-	AmountTo      int64                  `protobuf:"varint,5,opt,name=amountTo,proto3" json:"amountTo"`
+	Period        string                 `protobuf:"bytes,1,opt,name=period,proto3" json:"period"`
+	Revenue       float64                `protobuf:"fixed64,2,opt,name=revenue,proto3" json:"revenue"`
+	Payments      float64                `protobuf:"fixed64,3,opt,name=payments,proto3" json:"payments"`
+	Discounts     float64                `protobuf:"fixed64,4,opt,name=discounts,proto3" json:"discounts"`
+	TeacherCost   float64                `protobuf:"fixed64,5,opt,name=teacherCost,proto3" json:"teacherCost"`
+	Expenses      float64                `protobuf:"fixed64,6,opt,name=expenses,proto3" json:"expenses"`
+	GrossProfit   float64                `protobuf:"fixed64,7,opt,name=grossProfit,proto3" json:"grossProfit"`
+	NetProfit     float64                `protobuf:"fixed64,8,opt,name=netProfit,proto3" json:"netProfit"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetAllDebtsRequest) Reset() {
-	*x = GetAllDebtsRequest{}
-	mi := &file_finance_proto_msgTypes[20]
+func (x *PnlMonth) Reset() {
+	*x = PnlMonth{}
+	mi := &file_finance_proto_msgTypes[128]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetAllDebtsRequest) String() string {
+func (x *PnlMonth) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetAllDebtsRequest) ProtoMessage() {}
+func (*PnlMonth) ProtoMessage() {}
 
-func (x *GetAllDebtsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_finance_proto_msgTypes[20]
+func (x *PnlMonth) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[128]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1394,69 +9076,95 @@ func (x *GetAllDebtsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetAllDebtsRequest.ProtoReflect.Descriptor instead.
-func (*GetAllDebtsRequest) Descriptor() ([]byte, []int) {
-	return file_finance_proto_rawDescGZIP(), []int{20}
+// Deprecated: Use PnlMonth.ProtoReflect.Descriptor instead.
+func (*PnlMonth) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{128}
 }
 
-func (x *GetAllDebtsRequest) GetPageParam() *PageRequest {
+func (x *PnlMonth) GetPeriod() string {
 	if x != nil {
-		return x.PageParam
+		return x.Period
 	}
-	return nil
+	return ""
 }
 
-func (x *GetAllDebtsRequest) GetFrom() string {
+func (x *PnlMonth) GetRevenue() float64 {
 	if x != nil {
-		return x.From
+		return x.Revenue
 	}
-	return ""
+	return 0
 }
 
-func (x *GetAllDebtsRequest) GetTo() string {
+func (x *PnlMonth) GetPayments() float64 {
 	if x != nil {
-		return x.To
+		return x.Payments
 	}
-	return ""
+	return 0
 }
 
-func (x *GetAllDebtsRequest) GetAmountFrom() int64 {
+func (x *PnlMonth) GetDiscounts() float64 {
 	if x != nil {
-		return x.AmountFrom
+		return x.Discounts
 	}
 	return 0
 }
 
-func (x *GetAllDebtsRequest) GetAmountTo() int64 {
+func (x *PnlMonth) GetTeacherCost() float64 {
 	if x != nil {
-		return x.AmountTo
+		return x.TeacherCost
 	}
 	return 0
 }
 
-type GetAllDebtsInformationResponse struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	TotalPageCount int32                  `protobuf:"varint,2,opt,name=totalPageCount,proto3" json:"totalPageCount"`
-	Debts          []*AbsDebtsInformation `protobuf:"bytes,1,rep,name=debts,proto3" json:"debts"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+func (x *PnlMonth) GetExpenses() float64 {
+	if x != nil {
+		return x.Expenses
+	}
+	return 0
 }
 
-func (x *GetAllDebtsInformationResponse) Reset() {
-	*x = GetAllDebtsInformationResponse{}
-	mi := &file_finance_proto_msgTypes[21]
+func (x *PnlMonth) GetGrossProfit() float64 {
+	if x != nil {
+		return x.GrossProfit
+	}
+	return 0
+}
+
+func (x *PnlMonth) GetNetProfit() float64 {
+	if x != nil {
+		return x.NetProfit
+	}
+	return 0
+}
+
+type PnlBreakdown struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Period        string                 `protobuf:"bytes,1,opt,name=period,proto3" json:"period"`
+	Id            string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name"`
+	Revenue       float64                `protobuf:"fixed64,4,opt,name=revenue,proto3" json:"revenue"`
+	Discounts     float64                `protobuf:"fixed64,5,opt,name=discounts,proto3" json:"discounts"`
+	TeacherCost   float64                `protobuf:"fixed64,6,opt,name=teacherCost,proto3" json:"teacherCost"`
+	GrossProfit   float64                `protobuf:"fixed64,7,opt,name=grossProfit,proto3" json:"grossProfit"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PnlBreakdown) Reset() {
+	*x = PnlBreakdown{}
+	mi := &file_finance_proto_msgTypes[129]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetAllDebtsInformationResponse) String() string {
+func (x *PnlBreakdown) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetAllDebtsInformationResponse) ProtoMessage() {}
+func (*PnlBreakdown) ProtoMessage() {}
 
-func (x *GetAllDebtsInformationResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_finance_proto_msgTypes[21]
+func (x *PnlBreakdown) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[129]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1467,53 +9175,86 @@ func (x *GetAllDebtsInformationResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetAllDebtsInformationResponse.ProtoReflect.Descriptor instead.
-func (*GetAllDebtsInformationResponse) Descriptor() ([]byte, []int) {
-	return file_finance_proto_rawDescGZIP(), []int{21}
+// Deprecated: Use PnlBreakdown.ProtoReflect.Descriptor instead.
+func (*PnlBreakdown) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{129}
 }
 
-func (x *GetAllDebtsInformationResponse) GetTotalPageCount() int32 {
+func (x *PnlBreakdown) GetPeriod() string {
 	if x != nil {
-		return x.TotalPageCount
+		return x.Period
+	}
+	return ""
+}
+
+func (x *PnlBreakdown) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *PnlBreakdown) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PnlBreakdown) GetRevenue() float64 {
+	if x != nil {
+		return x.Revenue
 	}
 	return 0
 }
 
-func (x *GetAllDebtsInformationResponse) GetDebts() []*AbsDebtsInformation {
+func (x *PnlBreakdown) GetDiscounts() float64 {
 	if x != nil {
-		return x.Debts
+		return x.Discounts
 	}
-	return nil
+	return 0
 }
 
-type AbsDebtsInformation struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	DebtorId      string                 `protobuf:"bytes,1,opt,name=debtorId,proto3" json:"debtorId"`
-	DebtorName    string                 `protobuf:"bytes,2,opt,name=debtorName,proto3" json:"debtorName"`
-	PhoneNumber   string                 `protobuf:"bytes,3,opt,name=phoneNumber,proto3" json:"phoneNumber"`
-	Balance       string                 `protobuf:"bytes,4,opt,name=balance,proto3" json:"balance"`
-	TotalOnPeriod string                 `protobuf:"bytes,5,opt,name=totalOnPeriod,proto3" json:"totalOnPeriod"`
-	Groups        []*DebtorGroup         `protobuf:"bytes,6,rep,name=groups,proto3" json:"groups"`
-	Comments      []*DebtorComment       `protobuf:"bytes,7,rep,name=comments,proto3" json:"comments"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *PnlBreakdown) GetTeacherCost() float64 {
+	if x != nil {
+		return x.TeacherCost
+	}
+	return 0
 }
 
-func (x *AbsDebtsInformation) Reset() {
-	*x = AbsDebtsInformation{}
-	mi := &file_finance_proto_msgTypes[22]
+func (x *PnlBreakdown) GetGrossProfit() float64 {
+	if x != nil {
+		return x.GrossProfit
+	}
+	return 0
+}
+
+type OneCSetting struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	OrganizationName string                 `protobuf:"bytes,1,opt,name=organizationName,proto3" json:"organizationName"`
+	Inn              string                 `protobuf:"bytes,2,opt,name=inn,proto3" json:"inn"`
+	BankName         string                 `protobuf:"bytes,3,opt,name=bankName,proto3" json:"bankName"`
+	Bik              string                 `protobuf:"bytes,4,opt,name=bik,proto3" json:"bik"`
+	Mappings         []*OneCAccountMapping  `protobuf:"bytes,5,rep,name=mappings,proto3" json:"mappings"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *OneCSetting) Reset() {
+	*x = OneCSetting{}
+	mi := &file_finance_proto_msgTypes[130]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AbsDebtsInformation) String() string {
+func (x *OneCSetting) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AbsDebtsInformation) ProtoMessage() {}
+func (*OneCSetting) ProtoMessage() {}
 
-func (x *AbsDebtsInformation) ProtoReflect() protoreflect.Message {
-	mi := &file_finance_proto_msgTypes[22]
+func (x *OneCSetting) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[130]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1524,83 +9265,70 @@ func (x *AbsDebtsInformation) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AbsDebtsInformation.ProtoReflect.Descriptor instead.
-func (*AbsDebtsInformation) Descriptor() ([]byte, []int) {
-	return file_finance_proto_rawDescGZIP(), []int{22}
-}
-
-func (x *AbsDebtsInformation) GetDebtorId() string {
-	if x != nil {
-		return x.DebtorId
-	}
-	return ""
+// Deprecated: Use OneCSetting.ProtoReflect.Descriptor instead.
+func (*OneCSetting) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{130}
 }
 
-func (x *AbsDebtsInformation) GetDebtorName() string {
+func (x *OneCSetting) GetOrganizationName() string {
 	if x != nil {
-		return x.DebtorName
+		return x.OrganizationName
 	}
 	return ""
 }
 
-func (x *AbsDebtsInformation) GetPhoneNumber() string {
+func (x *OneCSetting) GetInn() string {
 	if x != nil {
-		return x.PhoneNumber
+		return x.Inn
 	}
 	return ""
 }
 
-func (x *AbsDebtsInformation) GetBalance() string {
+func (x *OneCSetting) GetBankName() string {
 	if x != nil {
-		return x.Balance
+		return x.BankName
 	}
 	return ""
 }
 
-func (x *AbsDebtsInformation) GetTotalOnPeriod() string {
+func (x *OneCSetting) GetBik() string {
 	if x != nil {
-		return x.TotalOnPeriod
+		return x.Bik
 	}
 	return ""
 }
 
-func (x *AbsDebtsInformation) GetGroups() []*DebtorGroup {
-	if x != nil {
-		return x.Groups
-	}
-	return nil
-}
-
-func (x *AbsDebtsInformation) GetComments() []*DebtorComment {
+func (x *OneCSetting) GetMappings() []*OneCAccountMapping {
 	if x != nil {
-		return x.Comments
+		return x.Mappings
 	}
 	return nil
 }
 
-type DebtorGroup struct {
+type OneCAccountMapping struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	GroupId       string                 `protobuf:"bytes,1,opt,name=groupId,proto3" json:"groupId"`
-	GroupName     string                 `protobuf:"bytes,2,opt,name=groupName,proto3" json:"groupName"`
+	SourceType    string                 `protobuf:"bytes,1,opt,name=sourceType,proto3" json:"sourceType"`
+	SourceKey     string                 `protobuf:"bytes,2,opt,name=sourceKey,proto3" json:"sourceKey"`
+	Account       string                 `protobuf:"bytes,3,opt,name=account,proto3" json:"account"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DebtorGroup) Reset() {
-	*x = DebtorGroup{}
-	mi := &file_finance_proto_msgTypes[23]
+func (x *OneCAccountMapping) Reset() {
+	*x = OneCAccountMapping{}
+	mi := &file_finance_proto_msgTypes[131]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DebtorGroup) String() string {
+func (x *OneCAccountMapping) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DebtorGroup) ProtoMessage() {}
+func (*OneCAccountMapping) ProtoMessage() {}
 
-func (x *DebtorGroup) ProtoReflect() protoreflect.Message {
-	mi := &file_finance_proto_msgTypes[23]
+func (x *OneCAccountMapping) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[131]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1611,48 +9339,55 @@ func (x *DebtorGroup) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DebtorGroup.ProtoReflect.Descriptor instead.
-func (*DebtorGroup) Descriptor() ([]byte, []int) {
-	return file_finance_proto_rawDescGZIP(), []int{23}
+// Deprecated: Use OneCAccountMapping.ProtoReflect.Descriptor instead.
+func (*OneCAccountMapping) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{131}
 }
 
-func (x *DebtorGroup) GetGroupId() string {
+func (x *OneCAccountMapping) GetSourceType() string {
 	if x != nil {
-		return x.GroupId
+		return x.SourceType
 	}
 	return ""
 }
 
-func (x *DebtorGroup) GetGroupName() string {
+func (x *OneCAccountMapping) GetSourceKey() string {
 	if x != nil {
-		return x.GroupName
+		return x.SourceKey
 	}
 	return ""
 }
 
-type DebtorComment struct {
+func (x *OneCAccountMapping) GetAccount() string {
+	if x != nil {
+		return x.Account
+	}
+	return ""
+}
+
+type ExportOneCRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	CommentId     string                 `protobuf:"bytes,1,opt,name=commentId,proto3" json:"commentId"`
-	Comment       string                 `protobuf:"bytes,2,opt,name=comment,proto3" json:"comment"`
+	From          string                 `protobuf:"bytes,1,opt,name=from,proto3" json:"from"`
+	To            string                 `protobuf:"bytes,2,opt,name=to,proto3" json:"to"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DebtorComment) Reset() {
-	*x = DebtorComment{}
-	mi := &file_finance_proto_msgTypes[24]
+func (x *ExportOneCRequest) Reset() {
+	*x = ExportOneCRequest{}
+	mi := &file_finance_proto_msgTypes[132]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DebtorComment) String() string {
+func (x *ExportOneCRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DebtorComment) ProtoMessage() {}
+func (*ExportOneCRequest) ProtoMessage() {}
 
-func (x *DebtorComment) ProtoReflect() protoreflect.Message {
-	mi := &file_finance_proto_msgTypes[24]
+func (x *ExportOneCRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[132]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1663,51 +9398,49 @@ func (x *DebtorComment) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DebtorComment.ProtoReflect.Descriptor instead.
-func (*DebtorComment) Descriptor() ([]byte, []int) {
-	return file_finance_proto_rawDescGZIP(), []int{24}
+// Deprecated: Use ExportOneCRequest.ProtoReflect.Descriptor instead.
+func (*ExportOneCRequest) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{132}
 }
 
-func (x *DebtorComment) GetCommentId() string {
+func (x *ExportOneCRequest) GetFrom() string {
 	if x != nil {
-		return x.CommentId
+		return x.From
 	}
 	return ""
 }
 
-func (x *DebtorComment) GetComment() string {
+func (x *ExportOneCRequest) GetTo() string {
 	if x != nil {
-		return x.Comment
+		return x.To
 	}
 	return ""
 }
 
-type GetAllStudentPaymentsChartResponse struct {
-	state         protoimpl.MessageState    `protogen:"open.v1"`
-	Cash          string                    `protobuf:"bytes,1,opt,name=cash,proto3" json:"cash"`
-	Payme         string                    `protobuf:"bytes,2,opt,name=payme,proto3" json:"payme"`
-	Click         string                    `protobuf:"bytes,3,opt,name=click,proto3" json:"click"`
-	TotalRevenue  string                    `protobuf:"bytes,4,opt,name=totalRevenue,proto3" json:"totalRevenue"`
-	PaymentsChart []*AbsTakeOfChartResponse `protobuf:"bytes,5,rep,name=paymentsChart,proto3" json:"paymentsChart"`
+type ExportOneCResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FileName      string                 `protobuf:"bytes,1,opt,name=fileName,proto3" json:"fileName"`
+	Content       []byte                 `protobuf:"bytes,2,opt,name=content,proto3" json:"content"`
+	DocumentCount int32                  `protobuf:"varint,3,opt,name=documentCount,proto3" json:"documentCount"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetAllStudentPaymentsChartResponse) Reset() {
-	*x = GetAllStudentPaymentsChartResponse{}
-	mi := &file_finance_proto_msgTypes[25]
+func (x *ExportOneCResponse) Reset() {
+	*x = ExportOneCResponse{}
+	mi := &file_finance_proto_msgTypes[133]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetAllStudentPaymentsChartResponse) String() string {
+func (x *ExportOneCResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetAllStudentPaymentsChartResponse) ProtoMessage() {}
+func (*ExportOneCResponse) ProtoMessage() {}
 
-func (x *GetAllStudentPaymentsChartResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_finance_proto_msgTypes[25]
+func (x *ExportOneCResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[133]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1718,72 +9451,61 @@ func (x *GetAllStudentPaymentsChartResponse) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetAllStudentPaymentsChartResponse.ProtoReflect.Descriptor instead.
-func (*GetAllStudentPaymentsChartResponse) Descriptor() ([]byte, []int) {
-	return file_finance_proto_rawDescGZIP(), []int{25}
-}
-
-func (x *GetAllStudentPaymentsChartResponse) GetCash() string {
-	if x != nil {
-		return x.Cash
-	}
-	return ""
-}
-
-func (x *GetAllStudentPaymentsChartResponse) GetPayme() string {
-	if x != nil {
-		return x.Payme
-	}
-	return ""
+// Deprecated: Use ExportOneCResponse.ProtoReflect.Descriptor instead.
+func (*ExportOneCResponse) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{133}
 }
 
-func (x *GetAllStudentPaymentsChartResponse) GetClick() string {
+func (x *ExportOneCResponse) GetFileName() string {
 	if x != nil {
-		return x.Click
+		return x.FileName
 	}
 	return ""
 }
 
-func (x *GetAllStudentPaymentsChartResponse) GetTotalRevenue() string {
+func (x *ExportOneCResponse) GetContent() []byte {
 	if x != nil {
-		return x.TotalRevenue
+		return x.Content
 	}
-	return ""
+	return nil
 }
 
-func (x *GetAllStudentPaymentsChartResponse) GetPaymentsChart() []*AbsTakeOfChartResponse {
+func (x *ExportOneCResponse) GetDocumentCount() int32 {
 	if x != nil {
-		return x.PaymentsChart
+		return x.DocumentCount
 	}
-	return nil
+	return 0
 }
 
-type GetAllStudentPaymentsRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Page          *PageRequest           `protobuf:"bytes,6,opt,name=page,proto3" json:"page"`
-	From          string                 `protobuf:"bytes,1,opt,name=from,proto3" json:"from"`
-	To            string                 `protobuf:"bytes,2,opt,name=to,proto3" json:"to"`
-	Filters       []*Filters             `protobuf:"bytes,4,rep,name=filters,proto3" json:"filters"`
-	Sorts         []*SortBy              `protobuf:"bytes,5,rep,name=sorts,proto3" json:"sorts"`
+type ImportStatementRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// CSV, XLSX, PAYME or CLICK
+	Source string `protobuf:"bytes,1,opt,name=source,proto3" json:"source"`
+	// payment method of the created payments, CASH, CLICK or PAYME
+	Method        string `protobuf:"bytes,2,opt,name=method,proto3" json:"method"`
+	FileName      string `protobuf:"bytes,3,opt,name=fileName,proto3" json:"fileName"`
+	Content       []byte `protobuf:"bytes,4,opt,name=content,proto3" json:"content"`
+	ActionById    string `protobuf:"bytes,5,opt,name=actionById,proto3" json:"actionById"`
+	ActionByName  string `protobuf:"bytes,6,opt,name=actionByName,proto3" json:"actionByName"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetAllStudentPaymentsRequest) Reset() {
-	*x = GetAllStudentPaymentsRequest{}
-	mi := &file_finance_proto_msgTypes[26]
+func (x *ImportStatementRequest) Reset() {
+	*x = ImportStatementRequest{}
+	mi := &file_finance_proto_msgTypes[134]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetAllStudentPaymentsRequest) String() string {
+func (x *ImportStatementRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetAllStudentPaymentsRequest) ProtoMessage() {}
+func (*ImportStatementRequest) ProtoMessage() {}
 
-func (x *GetAllStudentPaymentsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_finance_proto_msgTypes[26]
+func (x *ImportStatementRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[134]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1794,70 +9516,87 @@ func (x *GetAllStudentPaymentsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetAllStudentPaymentsRequest.ProtoReflect.Descriptor instead.
-func (*GetAllStudentPaymentsRequest) Descriptor() ([]byte, []int) {
-	return file_finance_proto_rawDescGZIP(), []int{26}
+// Deprecated: Use ImportStatementRequest.ProtoReflect.Descriptor instead.
+func (*ImportStatementRequest) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{134}
 }
 
-func (x *GetAllStudentPaymentsRequest) GetPage() *PageRequest {
+func (x *ImportStatementRequest) GetSource() string {
 	if x != nil {
-		return x.Page
+		return x.Source
 	}
-	return nil
+	return ""
 }
 
-func (x *GetAllStudentPaymentsRequest) GetFrom() string {
+func (x *ImportStatementRequest) GetMethod() string {
 	if x != nil {
-		return x.From
+		return x.Method
 	}
 	return ""
 }
 
-func (x *GetAllStudentPaymentsRequest) GetTo() string {
+func (x *ImportStatementRequest) GetFileName() string {
 	if x != nil {
-		return x.To
+		return x.FileName
 	}
 	return ""
 }
 
-func (x *GetAllStudentPaymentsRequest) GetFilters() []*Filters {
+func (x *ImportStatementRequest) GetContent() []byte {
 	if x != nil {
-		return x.Filters
+		return x.Content
 	}
 	return nil
 }
 
-func (x *GetAllStudentPaymentsRequest) GetSorts() []*SortBy {
+func (x *ImportStatementRequest) GetActionById() string {
 	if x != nil {
-		return x.Sorts
+		return x.ActionById
 	}
-	return nil
+	return ""
 }
 
-type Filters struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Field         string                 `protobuf:"bytes,1,opt,name=field,proto3" json:"field"`
-	Type          string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type"`
-	Value         string                 `protobuf:"bytes,3,opt,name=value,proto3" json:"value"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *ImportStatementRequest) GetActionByName() string {
+	if x != nil {
+		return x.ActionByName
+	}
+	return ""
 }
 
-func (x *Filters) Reset() {
-	*x = Filters{}
-	mi := &file_finance_proto_msgTypes[27]
+type ReconciliationSession struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id"`
+	Source          string                 `protobuf:"bytes,2,opt,name=source,proto3" json:"source"`
+	Method          string                 `protobuf:"bytes,3,opt,name=method,proto3" json:"method"`
+	FileName        string                 `protobuf:"bytes,4,opt,name=fileName,proto3" json:"fileName"`
+	CreatedByName   string                 `protobuf:"bytes,5,opt,name=createdByName,proto3" json:"createdByName"`
+	CreatedAt       string                 `protobuf:"bytes,6,opt,name=createdAt,proto3" json:"createdAt"`
+	TotalCount      int32                  `protobuf:"varint,7,opt,name=totalCount,proto3" json:"totalCount"`
+	MatchedCount    int32                  `protobuf:"varint,8,opt,name=matchedCount,proto3" json:"matchedCount"`
+	UnmatchedCount  int32                  `protobuf:"varint,9,opt,name=unmatchedCount,proto3" json:"unmatchedCount"`
+	SuspiciousCount int32                  `protobuf:"varint,10,opt,name=suspiciousCount,proto3" json:"suspiciousCount"`
+	CreatedCount    int32                  `protobuf:"varint,11,opt,name=createdCount,proto3" json:"createdCount"`
+	TotalAmount     float64                `protobuf:"fixed64,12,opt,name=totalAmount,proto3" json:"totalAmount"`
+	Lines           []*ReconciliationLine  `protobuf:"bytes,13,rep,name=lines,proto3" json:"lines"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ReconciliationSession) Reset() {
+	*x = ReconciliationSession{}
+	mi := &file_finance_proto_msgTypes[135]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Filters) String() string {
+func (x *ReconciliationSession) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Filters) ProtoMessage() {}
+func (*ReconciliationSession) ProtoMessage() {}
 
-func (x *Filters) ProtoReflect() protoreflect.Message {
-	mi := &file_finance_proto_msgTypes[27]
+func (x *ReconciliationSession) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[135]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1868,106 +9607,137 @@ func (x *Filters) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Filters.ProtoReflect.Descriptor instead.
-func (*Filters) Descriptor() ([]byte, []int) {
-	return file_finance_proto_rawDescGZIP(), []int{27}
+// Deprecated: Use ReconciliationSession.ProtoReflect.Descriptor instead.
+func (*ReconciliationSession) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{135}
 }
 
-func (x *Filters) GetField() string {
+func (x *ReconciliationSession) GetId() string {
 	if x != nil {
-		return x.Field
+		return x.Id
 	}
 	return ""
 }
 
-func (x *Filters) GetType() string {
+func (x *ReconciliationSession) GetSource() string {
 	if x != nil {
-		return x.Type
+		return x.Source
 	}
 	return ""
 }
 
-func (x *Filters) GetValue() string {
+func (x *ReconciliationSession) GetMethod() string {
 	if x != nil {
-		return x.Value
+		return x.Method
 	}
 	return ""
 }
 
-type SortBy struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Field         string                 `protobuf:"bytes,1,opt,name=field,proto3" json:"field"`
-	Type          string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *ReconciliationSession) GetFileName() string {
+	if x != nil {
+		return x.FileName
+	}
+	return ""
 }
 
-func (x *SortBy) Reset() {
-	*x = SortBy{}
-	mi := &file_finance_proto_msgTypes[28]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *ReconciliationSession) GetCreatedByName() string {
+	if x != nil {
+		return x.CreatedByName
+	}
+	return ""
 }
 
-func (x *SortBy) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *ReconciliationSession) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
 }
 
-func (*SortBy) ProtoMessage() {}
+func (x *ReconciliationSession) GetTotalCount() int32 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
 
-func (x *SortBy) ProtoReflect() protoreflect.Message {
-	mi := &file_finance_proto_msgTypes[28]
+func (x *ReconciliationSession) GetMatchedCount() int32 {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.MatchedCount
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use SortBy.ProtoReflect.Descriptor instead.
-func (*SortBy) Descriptor() ([]byte, []int) {
-	return file_finance_proto_rawDescGZIP(), []int{28}
+func (x *ReconciliationSession) GetUnmatchedCount() int32 {
+	if x != nil {
+		return x.UnmatchedCount
+	}
+	return 0
 }
 
-func (x *SortBy) GetField() string {
+func (x *ReconciliationSession) GetSuspiciousCount() int32 {
 	if x != nil {
-		return x.Field
+		return x.SuspiciousCount
 	}
-	return ""
+	return 0
 }
 
-func (x *SortBy) GetType() string {
+func (x *ReconciliationSession) GetCreatedCount() int32 {
 	if x != nil {
-		return x.Type
+		return x.CreatedCount
 	}
-	return ""
+	return 0
 }
 
-type GetAllStudentPaymentsResponse struct {
+func (x *ReconciliationSession) GetTotalAmount() float64 {
+	if x != nil {
+		return x.TotalAmount
+	}
+	return 0
+}
+
+func (x *ReconciliationSession) GetLines() []*ReconciliationLine {
+	if x != nil {
+		return x.Lines
+	}
+	return nil
+}
+
+type ReconciliationLine struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Payments      []*AbsStudentPayments  `protobuf:"bytes,1,rep,name=payments,proto3" json:"payments"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id"`
+	LineNo        int32                  `protobuf:"varint,2,opt,name=lineNo,proto3" json:"lineNo"`
+	TransactionId string                 `protobuf:"bytes,3,opt,name=transactionId,proto3" json:"transactionId"`
+	Amount        float64                `protobuf:"fixed64,4,opt,name=amount,proto3" json:"amount"`
+	PaidAt        string                 `protobuf:"bytes,5,opt,name=paidAt,proto3" json:"paidAt"`
+	Phone         string                 `protobuf:"bytes,6,opt,name=phone,proto3" json:"phone"`
+	PayerName     string                 `protobuf:"bytes,7,opt,name=payerName,proto3" json:"payerName"`
+	Description   string                 `protobuf:"bytes,8,opt,name=description,proto3" json:"description"`
+	// MATCHED, UNMATCHED, SUSPICIOUS, CREATED or IGNORED
+	Status        string `protobuf:"bytes,9,opt,name=status,proto3" json:"status"`
+	PaymentId     string `protobuf:"bytes,10,opt,name=paymentId,proto3" json:"paymentId"`
+	StudentId     string `protobuf:"bytes,11,opt,name=studentId,proto3" json:"studentId"`
+	StudentName   string `protobuf:"bytes,12,opt,name=studentName,proto3" json:"studentName"`
+	Note          string `protobuf:"bytes,13,opt,name=note,proto3" json:"note"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetAllStudentPaymentsResponse) Reset() {
-	*x = GetAllStudentPaymentsResponse{}
-	mi := &file_finance_proto_msgTypes[29]
+func (x *ReconciliationLine) Reset() {
+	*x = ReconciliationLine{}
+	mi := &file_finance_proto_msgTypes[136]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetAllStudentPaymentsResponse) String() string {
+func (x *ReconciliationLine) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetAllStudentPaymentsResponse) ProtoMessage() {}
+func (*ReconciliationLine) ProtoMessage() {}
 
-func (x *GetAllStudentPaymentsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_finance_proto_msgTypes[29]
+func (x *ReconciliationLine) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[136]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1978,140 +9748,177 @@ func (x *GetAllStudentPaymentsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetAllStudentPaymentsResponse.ProtoReflect.Descriptor instead.
-func (*GetAllStudentPaymentsResponse) Descriptor() ([]byte, []int) {
-	return file_finance_proto_rawDescGZIP(), []int{29}
+// Deprecated: Use ReconciliationLine.ProtoReflect.Descriptor instead.
+func (*ReconciliationLine) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{136}
 }
 
-func (x *GetAllStudentPaymentsResponse) GetPayments() []*AbsStudentPayments {
+func (x *ReconciliationLine) GetId() string {
 	if x != nil {
-		return x.Payments
+		return x.Id
 	}
-	return nil
-}
-
-type AbsStudentPayments struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	GivenDate     string                 `protobuf:"bytes,1,opt,name=givenDate,proto3" json:"givenDate"`
-	StudentId     string                 `protobuf:"bytes,2,opt,name=studentId,proto3" json:"studentId"`
-	StudentName   string                 `protobuf:"bytes,3,opt,name=studentName,proto3" json:"studentName"`
-	Amount        string                 `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount"`
-	Method        string                 `protobuf:"bytes,5,opt,name=method,proto3" json:"method"`
-	Comment       string                 `protobuf:"bytes,6,opt,name=comment,proto3" json:"comment"`
-	CreatorName   string                 `protobuf:"bytes,7,opt,name=creatorName,proto3" json:"creatorName"`
-	CreatorId     string                 `protobuf:"bytes,8,opt,name=creatorId,proto3" json:"creatorId"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	return ""
 }
 
-func (x *AbsStudentPayments) Reset() {
-	*x = AbsStudentPayments{}
-	mi := &file_finance_proto_msgTypes[30]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *ReconciliationLine) GetLineNo() int32 {
+	if x != nil {
+		return x.LineNo
+	}
+	return 0
 }
 
-func (x *AbsStudentPayments) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *ReconciliationLine) GetTransactionId() string {
+	if x != nil {
+		return x.TransactionId
+	}
+	return ""
 }
 
-func (*AbsStudentPayments) ProtoMessage() {}
-
-func (x *AbsStudentPayments) ProtoReflect() protoreflect.Message {
-	mi := &file_finance_proto_msgTypes[30]
+func (x *ReconciliationLine) GetAmount() float64 {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Amount
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use AbsStudentPayments.ProtoReflect.Descriptor instead.
-func (*AbsStudentPayments) Descriptor() ([]byte, []int) {
-	return file_finance_proto_rawDescGZIP(), []int{30}
+func (x *ReconciliationLine) GetPaidAt() string {
+	if x != nil {
+		return x.PaidAt
+	}
+	return ""
 }
 
-func (x *AbsStudentPayments) GetGivenDate() string {
+func (x *ReconciliationLine) GetPhone() string {
 	if x != nil {
-		return x.GivenDate
+		return x.Phone
 	}
 	return ""
 }
 
-func (x *AbsStudentPayments) GetStudentId() string {
+func (x *ReconciliationLine) GetPayerName() string {
 	if x != nil {
-		return x.StudentId
+		return x.PayerName
 	}
 	return ""
 }
 
-func (x *AbsStudentPayments) GetStudentName() string {
+func (x *ReconciliationLine) GetDescription() string {
 	if x != nil {
-		return x.StudentName
+		return x.Description
 	}
 	return ""
 }
 
-func (x *AbsStudentPayments) GetAmount() string {
+func (x *ReconciliationLine) GetStatus() string {
 	if x != nil {
-		return x.Amount
+		return x.Status
 	}
 	return ""
 }
 
-func (x *AbsStudentPayments) GetMethod() string {
+func (x *ReconciliationLine) GetPaymentId() string {
 	if x != nil {
-		return x.Method
+		return x.PaymentId
 	}
 	return ""
 }
 
-func (x *AbsStudentPayments) GetComment() string {
+func (x *ReconciliationLine) GetStudentId() string {
 	if x != nil {
-		return x.Comment
+		return x.StudentId
 	}
 	return ""
 }
 
-func (x *AbsStudentPayments) GetCreatorName() string {
+func (x *ReconciliationLine) GetStudentName() string {
 	if x != nil {
-		return x.CreatorName
+		return x.StudentName
 	}
 	return ""
 }
 
-func (x *AbsStudentPayments) GetCreatorId() string {
+func (x *ReconciliationLine) GetNote() string {
 	if x != nil {
-		return x.CreatorId
+		return x.Note
 	}
 	return ""
 }
 
-type GetAllPaymentTakeOffChartResponse struct {
-	state         protoimpl.MessageState    `protogen:"open.v1"`
-	ChartResponse []*AbsTakeOfChartResponse `protobuf:"bytes,1,rep,name=chartResponse,proto3" json:"chartResponse"`
+type GetReconciliationSessionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Page          int32                  `protobuf:"varint,1,opt,name=page,proto3" json:"page"`
+	Size          int32                  `protobuf:"varint,2,opt,name=size,proto3" json:"size"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetAllPaymentTakeOffChartResponse) Reset() {
-	*x = GetAllPaymentTakeOffChartResponse{}
-	mi := &file_finance_proto_msgTypes[31]
+func (x *GetReconciliationSessionsRequest) Reset() {
+	*x = GetReconciliationSessionsRequest{}
+	mi := &file_finance_proto_msgTypes[137]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetReconciliationSessionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetReconciliationSessionsRequest) ProtoMessage() {}
+
+func (x *GetReconciliationSessionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[137]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetReconciliationSessionsRequest.ProtoReflect.Descriptor instead.
+func (*GetReconciliationSessionsRequest) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{137}
+}
+
+func (x *GetReconciliationSessionsRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *GetReconciliationSessionsRequest) GetSize() int32 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+type GetReconciliationSessionsResponse struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	Sessions      []*ReconciliationSession `protobuf:"bytes,1,rep,name=sessions,proto3" json:"sessions"`
+	TotalCount    int32                    `protobuf:"varint,2,opt,name=totalCount,proto3" json:"totalCount"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetReconciliationSessionsResponse) Reset() {
+	*x = GetReconciliationSessionsResponse{}
+	mi := &file_finance_proto_msgTypes[138]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetAllPaymentTakeOffChartResponse) String() string {
+func (x *GetReconciliationSessionsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetAllPaymentTakeOffChartResponse) ProtoMessage() {}
+func (*GetReconciliationSessionsResponse) ProtoMessage() {}
 
-func (x *GetAllPaymentTakeOffChartResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_finance_proto_msgTypes[31]
+func (x *GetReconciliationSessionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[138]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2122,41 +9929,48 @@ func (x *GetAllPaymentTakeOffChartResponse) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetAllPaymentTakeOffChartResponse.ProtoReflect.Descriptor instead.
-func (*GetAllPaymentTakeOffChartResponse) Descriptor() ([]byte, []int) {
-	return file_finance_proto_rawDescGZIP(), []int{31}
+// Deprecated: Use GetReconciliationSessionsResponse.ProtoReflect.Descriptor instead.
+func (*GetReconciliationSessionsResponse) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{138}
 }
 
-func (x *GetAllPaymentTakeOffChartResponse) GetChartResponse() []*AbsTakeOfChartResponse {
+func (x *GetReconciliationSessionsResponse) GetSessions() []*ReconciliationSession {
 	if x != nil {
-		return x.ChartResponse
+		return x.Sessions
 	}
 	return nil
 }
 
-type AbsTakeOfChartResponse struct {
+func (x *GetReconciliationSessionsResponse) GetTotalCount() int32 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+type GetReconciliationSessionRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	YearMonth     string                 `protobuf:"bytes,1,opt,name=yearMonth,proto3" json:"yearMonth"`
-	Amount        string                 `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id"`
+	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AbsTakeOfChartResponse) Reset() {
-	*x = AbsTakeOfChartResponse{}
-	mi := &file_finance_proto_msgTypes[32]
+func (x *GetReconciliationSessionRequest) Reset() {
+	*x = GetReconciliationSessionRequest{}
+	mi := &file_finance_proto_msgTypes[139]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AbsTakeOfChartResponse) String() string {
+func (x *GetReconciliationSessionRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AbsTakeOfChartResponse) ProtoMessage() {}
+func (*GetReconciliationSessionRequest) ProtoMessage() {}
 
-func (x *AbsTakeOfChartResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_finance_proto_msgTypes[32]
+func (x *GetReconciliationSessionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[139]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2167,48 +9981,51 @@ func (x *AbsTakeOfChartResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AbsTakeOfChartResponse.ProtoReflect.Descriptor instead.
-func (*AbsTakeOfChartResponse) Descriptor() ([]byte, []int) {
-	return file_finance_proto_rawDescGZIP(), []int{32}
+// Deprecated: Use GetReconciliationSessionRequest.ProtoReflect.Descriptor instead.
+func (*GetReconciliationSessionRequest) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{139}
 }
 
-func (x *AbsTakeOfChartResponse) GetYearMonth() string {
+func (x *GetReconciliationSessionRequest) GetId() string {
 	if x != nil {
-		return x.YearMonth
+		return x.Id
 	}
 	return ""
 }
 
-func (x *AbsTakeOfChartResponse) GetAmount() string {
+func (x *GetReconciliationSessionRequest) GetStatus() string {
 	if x != nil {
-		return x.Amount
+		return x.Status
 	}
 	return ""
 }
 
-type GetAllPaymentTakeOffRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	From          string                 `protobuf:"bytes,1,opt,name=from,proto3" json:"from"`
-	To            string                 `protobuf:"bytes,2,opt,name=to,proto3" json:"to"`
+type CreateMissingPaymentsRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	SessionId string                 `protobuf:"bytes,1,opt,name=sessionId,proto3" json:"sessionId"`
+	// empty means every unmatched line with a recognised student
+	Lines         []*ReconciliationLineStudent `protobuf:"bytes,2,rep,name=lines,proto3" json:"lines"`
+	ActionById    string                       `protobuf:"bytes,3,opt,name=actionById,proto3" json:"actionById"`
+	ActionByName  string                       `protobuf:"bytes,4,opt,name=actionByName,proto3" json:"actionByName"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetAllPaymentTakeOffRequest) Reset() {
-	*x = GetAllPaymentTakeOffRequest{}
-	mi := &file_finance_proto_msgTypes[33]
+func (x *CreateMissingPaymentsRequest) Reset() {
+	*x = CreateMissingPaymentsRequest{}
+	mi := &file_finance_proto_msgTypes[140]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetAllPaymentTakeOffRequest) String() string {
+func (x *CreateMissingPaymentsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetAllPaymentTakeOffRequest) ProtoMessage() {}
+func (*CreateMissingPaymentsRequest) ProtoMessage() {}
 
-func (x *GetAllPaymentTakeOffRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_finance_proto_msgTypes[33]
+func (x *CreateMissingPaymentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[140]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2219,47 +10036,63 @@ func (x *GetAllPaymentTakeOffRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetAllPaymentTakeOffRequest.ProtoReflect.Descriptor instead.
-func (*GetAllPaymentTakeOffRequest) Descriptor() ([]byte, []int) {
-	return file_finance_proto_rawDescGZIP(), []int{33}
+// Deprecated: Use CreateMissingPaymentsRequest.ProtoReflect.Descriptor instead.
+func (*CreateMissingPaymentsRequest) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{140}
 }
 
-func (x *GetAllPaymentTakeOffRequest) GetFrom() string {
+func (x *CreateMissingPaymentsRequest) GetSessionId() string {
 	if x != nil {
-		return x.From
+		return x.SessionId
 	}
 	return ""
 }
 
-func (x *GetAllPaymentTakeOffRequest) GetTo() string {
+func (x *CreateMissingPaymentsRequest) GetLines() []*ReconciliationLineStudent {
 	if x != nil {
-		return x.To
+		return x.Lines
+	}
+	return nil
+}
+
+func (x *CreateMissingPaymentsRequest) GetActionById() string {
+	if x != nil {
+		return x.ActionById
 	}
 	return ""
 }
 
-type GetAllPaymentTakeOffResponse struct {
+func (x *CreateMissingPaymentsRequest) GetActionByName() string {
+	if x != nil {
+		return x.ActionByName
+	}
+	return ""
+}
+
+type ReconciliationLineStudent struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Pennies       []*AbsPaymentTakeOff   `protobuf:"bytes,1,rep,name=pennies,proto3" json:"pennies"`
+	LineId        string                 `protobuf:"bytes,1,opt,name=lineId,proto3" json:"lineId"`
+	StudentId     string                 `protobuf:"bytes,2,opt,name=studentId,proto3" json:"studentId"`
+	GroupId       string                 `protobuf:"bytes,3,opt,name=groupId,proto3" json:"groupId"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetAllPaymentTakeOffResponse) Reset() {
-	*x = GetAllPaymentTakeOffResponse{}
-	mi := &file_finance_proto_msgTypes[34]
+func (x *ReconciliationLineStudent) Reset() {
+	*x = ReconciliationLineStudent{}
+	mi := &file_finance_proto_msgTypes[141]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetAllPaymentTakeOffResponse) String() string {
+func (x *ReconciliationLineStudent) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetAllPaymentTakeOffResponse) ProtoMessage() {}
+func (*ReconciliationLineStudent) ProtoMessage() {}
 
-func (x *GetAllPaymentTakeOffResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_finance_proto_msgTypes[34]
+func (x *ReconciliationLineStudent) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[141]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2270,47 +10103,54 @@ func (x *GetAllPaymentTakeOffResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetAllPaymentTakeOffResponse.ProtoReflect.Descriptor instead.
-func (*GetAllPaymentTakeOffResponse) Descriptor() ([]byte, []int) {
-	return file_finance_proto_rawDescGZIP(), []int{34}
+// Deprecated: Use ReconciliationLineStudent.ProtoReflect.Descriptor instead.
+func (*ReconciliationLineStudent) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{141}
 }
 
-func (x *GetAllPaymentTakeOffResponse) GetPennies() []*AbsPaymentTakeOff {
+func (x *ReconciliationLineStudent) GetLineId() string {
 	if x != nil {
-		return x.Pennies
+		return x.LineId
 	}
-	return nil
+	return ""
 }
 
-type AbsPaymentTakeOff struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	PaymentId     string                 `protobuf:"bytes,1,opt,name=paymentId,proto3" json:"paymentId"`
-	GivenDate     string                 `protobuf:"bytes,2,opt,name=givenDate,proto3" json:"givenDate"`
-	StudentName   string                 `protobuf:"bytes,3,opt,name=studentName,proto3" json:"studentName"`
-	StudentId     string                 `protobuf:"bytes,4,opt,name=studentId,proto3" json:"studentId"`
-	Comment       string                 `protobuf:"bytes,5,opt,name=comment,proto3" json:"comment"`
-	CreatorId     string                 `protobuf:"bytes,6,opt,name=creatorId,proto3" json:"creatorId"`
-	CreatorName   string                 `protobuf:"bytes,7,opt,name=creatorName,proto3" json:"creatorName"`
-	Sum           string                 `protobuf:"bytes,8,opt,name=sum,proto3" json:"sum"`
+func (x *ReconciliationLineStudent) GetStudentId() string {
+	if x != nil {
+		return x.StudentId
+	}
+	return ""
+}
+
+func (x *ReconciliationLineStudent) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+type CreateMissingPaymentsResponse struct {
+	state         protoimpl.MessageState      `protogen:"open.v1"`
+	Results       []*ReconciliationLineResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AbsPaymentTakeOff) Reset() {
-	*x = AbsPaymentTakeOff{}
-	mi := &file_finance_proto_msgTypes[35]
+func (x *CreateMissingPaymentsResponse) Reset() {
+	*x = CreateMissingPaymentsResponse{}
+	mi := &file_finance_proto_msgTypes[142]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AbsPaymentTakeOff) String() string {
+func (x *CreateMissingPaymentsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AbsPaymentTakeOff) ProtoMessage() {}
+func (*CreateMissingPaymentsResponse) ProtoMessage() {}
 
-func (x *AbsPaymentTakeOff) ProtoReflect() protoreflect.Message {
-	mi := &file_finance_proto_msgTypes[35]
+func (x *CreateMissingPaymentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[142]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2321,90 +10161,111 @@ func (x *AbsPaymentTakeOff) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AbsPaymentTakeOff.ProtoReflect.Descriptor instead.
-func (*AbsPaymentTakeOff) Descriptor() ([]byte, []int) {
-	return file_finance_proto_rawDescGZIP(), []int{35}
+// Deprecated: Use CreateMissingPaymentsResponse.ProtoReflect.Descriptor instead.
+func (*CreateMissingPaymentsResponse) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{142}
 }
 
-func (x *AbsPaymentTakeOff) GetPaymentId() string {
+func (x *CreateMissingPaymentsResponse) GetResults() []*ReconciliationLineResult {
 	if x != nil {
-		return x.PaymentId
+		return x.Results
 	}
-	return ""
+	return nil
 }
 
-func (x *AbsPaymentTakeOff) GetGivenDate() string {
-	if x != nil {
-		return x.GivenDate
-	}
-	return ""
+type ReconciliationLineResult struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	LineId        string                 `protobuf:"bytes,1,opt,name=lineId,proto3" json:"lineId"`
+	Success       bool                   `protobuf:"varint,2,opt,name=success,proto3" json:"success"`
+	PaymentId     string                 `protobuf:"bytes,3,opt,name=paymentId,proto3" json:"paymentId"`
+	Message       string                 `protobuf:"bytes,4,opt,name=message,proto3" json:"message"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AbsPaymentTakeOff) GetStudentName() string {
-	if x != nil {
-		return x.StudentName
-	}
-	return ""
+func (x *ReconciliationLineResult) Reset() {
+	*x = ReconciliationLineResult{}
+	mi := &file_finance_proto_msgTypes[143]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *AbsPaymentTakeOff) GetStudentId() string {
+func (x *ReconciliationLineResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReconciliationLineResult) ProtoMessage() {}
+
+func (x *ReconciliationLineResult) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[143]
 	if x != nil {
-		return x.StudentId
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *AbsPaymentTakeOff) GetComment() string {
+// Deprecated: Use ReconciliationLineResult.ProtoReflect.Descriptor instead.
+func (*ReconciliationLineResult) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{143}
+}
+
+func (x *ReconciliationLineResult) GetLineId() string {
 	if x != nil {
-		return x.Comment
+		return x.LineId
 	}
 	return ""
 }
 
-func (x *AbsPaymentTakeOff) GetCreatorId() string {
+func (x *ReconciliationLineResult) GetSuccess() bool {
 	if x != nil {
-		return x.CreatorId
+		return x.Success
 	}
-	return ""
+	return false
 }
 
-func (x *AbsPaymentTakeOff) GetCreatorName() string {
+func (x *ReconciliationLineResult) GetPaymentId() string {
 	if x != nil {
-		return x.CreatorName
+		return x.PaymentId
 	}
 	return ""
 }
 
-func (x *AbsPaymentTakeOff) GetSum() string {
+func (x *ReconciliationLineResult) GetMessage() string {
 	if x != nil {
-		return x.Sum
+		return x.Message
 	}
 	return ""
 }
 
-type GetAllPaymentsByMonthRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        string                 `protobuf:"bytes,1,opt,name=userId,proto3" json:"userId"`
-	Month         string                 `protobuf:"bytes,2,opt,name=month,proto3" json:"month"`
+type ResolveReconciliationLineRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	LineId string                 `protobuf:"bytes,1,opt,name=lineId,proto3" json:"lineId"`
+	// MATCHED links the line to paymentId, IGNORED drops it from the unmatched list
+	Status        string `protobuf:"bytes,2,opt,name=status,proto3" json:"status"`
+	PaymentId     string `protobuf:"bytes,3,opt,name=paymentId,proto3" json:"paymentId"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetAllPaymentsByMonthRequest) Reset() {
-	*x = GetAllPaymentsByMonthRequest{}
-	mi := &file_finance_proto_msgTypes[36]
+func (x *ResolveReconciliationLineRequest) Reset() {
+	*x = ResolveReconciliationLineRequest{}
+	mi := &file_finance_proto_msgTypes[144]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetAllPaymentsByMonthRequest) String() string {
+func (x *ResolveReconciliationLineRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetAllPaymentsByMonthRequest) ProtoMessage() {}
+func (*ResolveReconciliationLineRequest) ProtoMessage() {}
 
-func (x *GetAllPaymentsByMonthRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_finance_proto_msgTypes[36]
+func (x *ResolveReconciliationLineRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[144]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2415,47 +10276,59 @@ func (x *GetAllPaymentsByMonthRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetAllPaymentsByMonthRequest.ProtoReflect.Descriptor instead.
-func (*GetAllPaymentsByMonthRequest) Descriptor() ([]byte, []int) {
-	return file_finance_proto_rawDescGZIP(), []int{36}
+// Deprecated: Use ResolveReconciliationLineRequest.ProtoReflect.Descriptor instead.
+func (*ResolveReconciliationLineRequest) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{144}
 }
 
-func (x *GetAllPaymentsByMonthRequest) GetUserId() string {
+func (x *ResolveReconciliationLineRequest) GetLineId() string {
 	if x != nil {
-		return x.UserId
+		return x.LineId
 	}
 	return ""
 }
 
-func (x *GetAllPaymentsByMonthRequest) GetMonth() string {
+func (x *ResolveReconciliationLineRequest) GetStatus() string {
 	if x != nil {
-		return x.Month
+		return x.Status
 	}
 	return ""
 }
 
-type GetAllPaymentsByMonthResponse struct {
-	state         protoimpl.MessageState              `protogen:"open.v1"`
-	Payments      []*AbsGetAllPaymentsByMonthResponse `protobuf:"bytes,1,rep,name=payments,proto3" json:"payments"`
+func (x *ResolveReconciliationLineRequest) GetPaymentId() string {
+	if x != nil {
+		return x.PaymentId
+	}
+	return ""
+}
+
+type CreateChargeItemRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Name  string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name"`
+	Price float64                `protobuf:"fixed64,2,opt,name=price,proto3" json:"price"`
+	// physical items keep stock and can not be sold beyond it
+	IsPhysical    bool   `protobuf:"varint,3,opt,name=isPhysical,proto3" json:"isPhysical"`
+	Stock         int32  `protobuf:"varint,4,opt,name=stock,proto3" json:"stock"`
+	Comment       string `protobuf:"bytes,5,opt,name=comment,proto3" json:"comment"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetAllPaymentsByMonthResponse) Reset() {
-	*x = GetAllPaymentsByMonthResponse{}
-	mi := &file_finance_proto_msgTypes[37]
+func (x *CreateChargeItemRequest) Reset() {
+	*x = CreateChargeItemRequest{}
+	mi := &file_finance_proto_msgTypes[145]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetAllPaymentsByMonthResponse) String() string {
+func (x *CreateChargeItemRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetAllPaymentsByMonthResponse) ProtoMessage() {}
+func (*CreateChargeItemRequest) ProtoMessage() {}
 
-func (x *GetAllPaymentsByMonthResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_finance_proto_msgTypes[37]
+func (x *CreateChargeItemRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[145]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2466,50 +10339,75 @@ func (x *GetAllPaymentsByMonthResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetAllPaymentsByMonthResponse.ProtoReflect.Descriptor instead.
-func (*GetAllPaymentsByMonthResponse) Descriptor() ([]byte, []int) {
-	return file_finance_proto_rawDescGZIP(), []int{37}
+// Deprecated: Use CreateChargeItemRequest.ProtoReflect.Descriptor instead.
+func (*CreateChargeItemRequest) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{145}
 }
 
-func (x *GetAllPaymentsByMonthResponse) GetPayments() []*AbsGetAllPaymentsByMonthResponse {
+func (x *CreateChargeItemRequest) GetName() string {
 	if x != nil {
-		return x.Payments
+		return x.Name
 	}
-	return nil
+	return ""
 }
 
-type AbsGetAllPaymentsByMonthResponse struct {
+func (x *CreateChargeItemRequest) GetPrice() float64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *CreateChargeItemRequest) GetIsPhysical() bool {
+	if x != nil {
+		return x.IsPhysical
+	}
+	return false
+}
+
+func (x *CreateChargeItemRequest) GetStock() int32 {
+	if x != nil {
+		return x.Stock
+	}
+	return 0
+}
+
+func (x *CreateChargeItemRequest) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+type AbsChargeItem struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	GivenDate     string                 `protobuf:"bytes,1,opt,name=givenDate,proto3" json:"givenDate"`
-	PaymentType   string                 `protobuf:"bytes,2,opt,name=payment_type,json=paymentType,proto3" json:"payment_type"`
-	Amount        string                 `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount"`
-	Comment       string                 `protobuf:"bytes,4,opt,name=comment,proto3" json:"comment"`
-	CreatedById   string                 `protobuf:"bytes,5,opt,name=created_by_id,json=createdById,proto3" json:"created_by_id"`
-	CreatedByName string                 `protobuf:"bytes,6,opt,name=created_by_name,json=createdByName,proto3" json:"created_by_name"`
-	CreatedAt     string                 `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at"`
-	PaymentId     string                 `protobuf:"bytes,8,opt,name=paymentId,proto3" json:"paymentId"`
-	GroupId       string                 `protobuf:"bytes,9,opt,name=groupId,proto3" json:"groupId"`
-	GroupName     string                 `protobuf:"bytes,10,opt,name=groupName,proto3" json:"groupName"`
-	Method        string                 `protobuf:"bytes,11,opt,name=method,proto3" json:"method"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name"`
+	Price         float64                `protobuf:"fixed64,3,opt,name=price,proto3" json:"price"`
+	IsPhysical    bool                   `protobuf:"varint,4,opt,name=isPhysical,proto3" json:"isPhysical"`
+	Stock         int32                  `protobuf:"varint,5,opt,name=stock,proto3" json:"stock"`
+	Comment       string                 `protobuf:"bytes,6,opt,name=comment,proto3" json:"comment"`
+	IsActive      bool                   `protobuf:"varint,7,opt,name=isActive,proto3" json:"isActive"`
+	CreatedAt     string                 `protobuf:"bytes,8,opt,name=createdAt,proto3" json:"createdAt"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AbsGetAllPaymentsByMonthResponse) Reset() {
-	*x = AbsGetAllPaymentsByMonthResponse{}
-	mi := &file_finance_proto_msgTypes[38]
+func (x *AbsChargeItem) Reset() {
+	*x = AbsChargeItem{}
+	mi := &file_finance_proto_msgTypes[146]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AbsGetAllPaymentsByMonthResponse) String() string {
+func (x *AbsChargeItem) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AbsGetAllPaymentsByMonthResponse) ProtoMessage() {}
+func (*AbsChargeItem) ProtoMessage() {}
 
-func (x *AbsGetAllPaymentsByMonthResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_finance_proto_msgTypes[38]
+func (x *AbsChargeItem) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[146]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2520,110 +10418,133 @@ func (x *AbsGetAllPaymentsByMonthResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AbsGetAllPaymentsByMonthResponse.ProtoReflect.Descriptor instead.
-func (*AbsGetAllPaymentsByMonthResponse) Descriptor() ([]byte, []int) {
-	return file_finance_proto_rawDescGZIP(), []int{38}
-}
-
-func (x *AbsGetAllPaymentsByMonthResponse) GetGivenDate() string {
-	if x != nil {
-		return x.GivenDate
-	}
-	return ""
-}
-
-func (x *AbsGetAllPaymentsByMonthResponse) GetPaymentType() string {
+// Deprecated: Use AbsChargeItem.ProtoReflect.Descriptor instead.
+func (*AbsChargeItem) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{146}
+}
+
+func (x *AbsChargeItem) GetId() string {
 	if x != nil {
-		return x.PaymentType
+		return x.Id
 	}
 	return ""
 }
 
-func (x *AbsGetAllPaymentsByMonthResponse) GetAmount() string {
+func (x *AbsChargeItem) GetName() string {
 	if x != nil {
-		return x.Amount
+		return x.Name
 	}
 	return ""
 }
 
-func (x *AbsGetAllPaymentsByMonthResponse) GetComment() string {
+func (x *AbsChargeItem) GetPrice() float64 {
 	if x != nil {
-		return x.Comment
+		return x.Price
 	}
-	return ""
+	return 0
 }
 
-func (x *AbsGetAllPaymentsByMonthResponse) GetCreatedById() string {
+func (x *AbsChargeItem) GetIsPhysical() bool {
 	if x != nil {
-		return x.CreatedById
+		return x.IsPhysical
 	}
-	return ""
+	return false
 }
 
-func (x *AbsGetAllPaymentsByMonthResponse) GetCreatedByName() string {
+func (x *AbsChargeItem) GetStock() int32 {
 	if x != nil {
-		return x.CreatedByName
+		return x.Stock
 	}
-	return ""
+	return 0
 }
 
-func (x *AbsGetAllPaymentsByMonthResponse) GetCreatedAt() string {
+func (x *AbsChargeItem) GetComment() string {
 	if x != nil {
-		return x.CreatedAt
+		return x.Comment
 	}
 	return ""
 }
 
-func (x *AbsGetAllPaymentsByMonthResponse) GetPaymentId() string {
+func (x *AbsChargeItem) GetIsActive() bool {
 	if x != nil {
-		return x.PaymentId
+		return x.IsActive
 	}
-	return ""
+	return false
 }
 
-func (x *AbsGetAllPaymentsByMonthResponse) GetGroupId() string {
+func (x *AbsChargeItem) GetCreatedAt() string {
 	if x != nil {
-		return x.GroupId
+		return x.CreatedAt
 	}
 	return ""
 }
 
-func (x *AbsGetAllPaymentsByMonthResponse) GetGroupName() string {
+type GetChargeItemsRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	IncludeInactive bool                   `protobuf:"varint,1,opt,name=includeInactive,proto3" json:"includeInactive"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *GetChargeItemsRequest) Reset() {
+	*x = GetChargeItemsRequest{}
+	mi := &file_finance_proto_msgTypes[147]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetChargeItemsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetChargeItemsRequest) ProtoMessage() {}
+
+func (x *GetChargeItemsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[147]
 	if x != nil {
-		return x.GroupName
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *AbsGetAllPaymentsByMonthResponse) GetMethod() string {
+// Deprecated: Use GetChargeItemsRequest.ProtoReflect.Descriptor instead.
+func (*GetChargeItemsRequest) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{147}
+}
+
+func (x *GetChargeItemsRequest) GetIncludeInactive() bool {
 	if x != nil {
-		return x.Method
+		return x.IncludeInactive
 	}
-	return ""
+	return false
 }
 
-type GetMonthlyStatusResponse struct {
-	state         protoimpl.MessageState         `protogen:"open.v1"`
-	MonthStatus   []*AbsGetMonthlyStatusResponse `protobuf:"bytes,1,rep,name=monthStatus,proto3" json:"monthStatus"`
+type GetChargeItemsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*AbsChargeItem       `protobuf:"bytes,1,rep,name=items,proto3" json:"items"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetMonthlyStatusResponse) Reset() {
-	*x = GetMonthlyStatusResponse{}
-	mi := &file_finance_proto_msgTypes[39]
+func (x *GetChargeItemsResponse) Reset() {
+	*x = GetChargeItemsResponse{}
+	mi := &file_finance_proto_msgTypes[148]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetMonthlyStatusResponse) String() string {
+func (x *GetChargeItemsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetMonthlyStatusResponse) ProtoMessage() {}
+func (*GetChargeItemsResponse) ProtoMessage() {}
 
-func (x *GetMonthlyStatusResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_finance_proto_msgTypes[39]
+func (x *GetChargeItemsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[148]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2634,41 +10555,47 @@ func (x *GetMonthlyStatusResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetMonthlyStatusResponse.ProtoReflect.Descriptor instead.
-func (*GetMonthlyStatusResponse) Descriptor() ([]byte, []int) {
-	return file_finance_proto_rawDescGZIP(), []int{39}
+// Deprecated: Use GetChargeItemsResponse.ProtoReflect.Descriptor instead.
+func (*GetChargeItemsResponse) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{148}
 }
 
-func (x *GetMonthlyStatusResponse) GetMonthStatus() []*AbsGetMonthlyStatusResponse {
+func (x *GetChargeItemsResponse) GetItems() []*AbsChargeItem {
 	if x != nil {
-		return x.MonthStatus
+		return x.Items
 	}
 	return nil
 }
 
-type AbsGetMonthlyStatusResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Month         string                 `protobuf:"bytes,1,opt,name=month,proto3" json:"month"`
-	Balance       string                 `protobuf:"bytes,2,opt,name=balance,proto3" json:"balance"`
+type ChargeStudentRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	StudentId string                 `protobuf:"bytes,1,opt,name=studentId,proto3" json:"studentId"`
+	// optional, the group the charge is shown under in the student balance history
+	GroupId       string               `protobuf:"bytes,2,opt,name=groupId,proto3" json:"groupId"`
+	Date          string               `protobuf:"bytes,3,opt,name=date,proto3" json:"date"`
+	Comment       string               `protobuf:"bytes,4,opt,name=comment,proto3" json:"comment"`
+	Lines         []*ChargeStudentLine `protobuf:"bytes,5,rep,name=lines,proto3" json:"lines"`
+	ActionById    string               `protobuf:"bytes,6,opt,name=actionById,proto3" json:"actionById"`
+	ActionByName  string               `protobuf:"bytes,7,opt,name=actionByName,proto3" json:"actionByName"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AbsGetMonthlyStatusResponse) Reset() {
-	*x = AbsGetMonthlyStatusResponse{}
-	mi := &file_finance_proto_msgTypes[40]
+func (x *ChargeStudentRequest) Reset() {
+	*x = ChargeStudentRequest{}
+	mi := &file_finance_proto_msgTypes[149]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AbsGetMonthlyStatusResponse) String() string {
+func (x *ChargeStudentRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AbsGetMonthlyStatusResponse) ProtoMessage() {}
+func (*ChargeStudentRequest) ProtoMessage() {}
 
-func (x *AbsGetMonthlyStatusResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_finance_proto_msgTypes[40]
+func (x *ChargeStudentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[149]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2679,47 +10606,83 @@ func (x *AbsGetMonthlyStatusResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AbsGetMonthlyStatusResponse.ProtoReflect.Descriptor instead.
-func (*AbsGetMonthlyStatusResponse) Descriptor() ([]byte, []int) {
-	return file_finance_proto_rawDescGZIP(), []int{40}
+// Deprecated: Use ChargeStudentRequest.ProtoReflect.Descriptor instead.
+func (*ChargeStudentRequest) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{149}
 }
 
-func (x *AbsGetMonthlyStatusResponse) GetMonth() string {
+func (x *ChargeStudentRequest) GetStudentId() string {
 	if x != nil {
-		return x.Month
+		return x.StudentId
 	}
 	return ""
 }
 
-func (x *AbsGetMonthlyStatusResponse) GetBalance() string {
+func (x *ChargeStudentRequest) GetGroupId() string {
 	if x != nil {
-		return x.Balance
+		return x.GroupId
 	}
 	return ""
 }
 
-type GetMonthlyStatusRequest struct {
+func (x *ChargeStudentRequest) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *ChargeStudentRequest) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+func (x *ChargeStudentRequest) GetLines() []*ChargeStudentLine {
+	if x != nil {
+		return x.Lines
+	}
+	return nil
+}
+
+func (x *ChargeStudentRequest) GetActionById() string {
+	if x != nil {
+		return x.ActionById
+	}
+	return ""
+}
+
+func (x *ChargeStudentRequest) GetActionByName() string {
+	if x != nil {
+		return x.ActionByName
+	}
+	return ""
+}
+
+type ChargeStudentLine struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        string                 `protobuf:"bytes,1,opt,name=userId,proto3" json:"userId"`
+	ItemId        string                 `protobuf:"bytes,1,opt,name=itemId,proto3" json:"itemId"`
+	Quantity      int32                  `protobuf:"varint,2,opt,name=quantity,proto3" json:"quantity"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetMonthlyStatusRequest) Reset() {
-	*x = GetMonthlyStatusRequest{}
-	mi := &file_finance_proto_msgTypes[41]
+func (x *ChargeStudentLine) Reset() {
+	*x = ChargeStudentLine{}
+	mi := &file_finance_proto_msgTypes[150]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetMonthlyStatusRequest) String() string {
+func (x *ChargeStudentLine) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetMonthlyStatusRequest) ProtoMessage() {}
+func (*ChargeStudentLine) ProtoMessage() {}
 
-func (x *GetMonthlyStatusRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_finance_proto_msgTypes[41]
+func (x *ChargeStudentLine) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[150]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2730,48 +10693,47 @@ func (x *GetMonthlyStatusRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetMonthlyStatusRequest.ProtoReflect.Descriptor instead.
-func (*GetMonthlyStatusRequest) Descriptor() ([]byte, []int) {
-	return file_finance_proto_rawDescGZIP(), []int{41}
+// Deprecated: Use ChargeStudentLine.ProtoReflect.Descriptor instead.
+func (*ChargeStudentLine) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{150}
 }
 
-func (x *GetMonthlyStatusRequest) GetUserId() string {
+func (x *ChargeStudentLine) GetItemId() string {
 	if x != nil {
-		return x.UserId
+		return x.ItemId
 	}
 	return ""
 }
 
-type PaymentAddRequest struct {
+func (x *ChargeStudentLine) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+type GetStudentChargesRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Comment       string                 `protobuf:"bytes,1,opt,name=comment,proto3" json:"comment"`
-	Date          string                 `protobuf:"bytes,2,opt,name=date,proto3" json:"date"`
-	Method        string                 `protobuf:"bytes,3,opt,name=method,proto3" json:"method"`
-	Sum           string                 `protobuf:"bytes,4,opt,name=sum,proto3" json:"sum"`
-	UserId        string                 `protobuf:"bytes,5,opt,name=userId,proto3" json:"userId"`
-	Type          string                 `protobuf:"bytes,6,opt,name=type,proto3" json:"type"`
-	ActionById    string                 `protobuf:"bytes,7,opt,name=actionById,proto3" json:"actionById"`
-	ActionByName  string                 `protobuf:"bytes,8,opt,name=actionByName,proto3" json:"actionByName"`
-	GroupId       string                 `protobuf:"bytes,9,opt,name=groupId,proto3" json:"groupId"`
+	StudentId     string                 `protobuf:"bytes,1,opt,name=studentId,proto3" json:"studentId"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *PaymentAddRequest) Reset() {
-	*x = PaymentAddRequest{}
-	mi := &file_finance_proto_msgTypes[42]
+func (x *GetStudentChargesRequest) Reset() {
+	*x = GetStudentChargesRequest{}
+	mi := &file_finance_proto_msgTypes[151]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PaymentAddRequest) String() string {
+func (x *GetStudentChargesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PaymentAddRequest) ProtoMessage() {}
+func (*GetStudentChargesRequest) ProtoMessage() {}
 
-func (x *PaymentAddRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_finance_proto_msgTypes[42]
+func (x *GetStudentChargesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[151]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2782,104 +10744,92 @@ func (x *PaymentAddRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PaymentAddRequest.ProtoReflect.Descriptor instead.
-func (*PaymentAddRequest) Descriptor() ([]byte, []int) {
-	return file_finance_proto_rawDescGZIP(), []int{42}
-}
-
-func (x *PaymentAddRequest) GetComment() string {
-	if x != nil {
-		return x.Comment
-	}
-	return ""
+// Deprecated: Use GetStudentChargesRequest.ProtoReflect.Descriptor instead.
+func (*GetStudentChargesRequest) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{151}
 }
 
-func (x *PaymentAddRequest) GetDate() string {
+func (x *GetStudentChargesRequest) GetStudentId() string {
 	if x != nil {
-		return x.Date
+		return x.StudentId
 	}
 	return ""
 }
 
-func (x *PaymentAddRequest) GetMethod() string {
-	if x != nil {
-		return x.Method
-	}
-	return ""
+type GetStudentChargesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Charges       []*AbsStudentCharge    `protobuf:"bytes,1,rep,name=charges,proto3" json:"charges"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *PaymentAddRequest) GetSum() string {
-	if x != nil {
-		return x.Sum
-	}
-	return ""
+func (x *GetStudentChargesResponse) Reset() {
+	*x = GetStudentChargesResponse{}
+	mi := &file_finance_proto_msgTypes[152]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *PaymentAddRequest) GetUserId() string {
-	if x != nil {
-		return x.UserId
-	}
-	return ""
+func (x *GetStudentChargesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *PaymentAddRequest) GetType() string {
-	if x != nil {
-		return x.Type
-	}
-	return ""
-}
+func (*GetStudentChargesResponse) ProtoMessage() {}
 
-func (x *PaymentAddRequest) GetActionById() string {
+func (x *GetStudentChargesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[152]
 	if x != nil {
-		return x.ActionById
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *PaymentAddRequest) GetActionByName() string {
-	if x != nil {
-		return x.ActionByName
-	}
-	return ""
+// Deprecated: Use GetStudentChargesResponse.ProtoReflect.Descriptor instead.
+func (*GetStudentChargesResponse) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{152}
 }
 
-func (x *PaymentAddRequest) GetGroupId() string {
+func (x *GetStudentChargesResponse) GetCharges() []*AbsStudentCharge {
 	if x != nil {
-		return x.GroupId
+		return x.Charges
 	}
-	return ""
+	return nil
 }
 
-type PaymentUpdateRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Debit         string                 `protobuf:"bytes,1,opt,name=debit,proto3" json:"debit"`
-	Method        string                 `protobuf:"bytes,2,opt,name=method,proto3" json:"method"`
-	Date          string                 `protobuf:"bytes,3,opt,name=date,proto3" json:"date"`
-	Comment       string                 `protobuf:"bytes,4,opt,name=comment,proto3" json:"comment"`
-	UserId        string                 `protobuf:"bytes,5,opt,name=userId,proto3" json:"userId"`
-	PaymentId     string                 `protobuf:"bytes,6,opt,name=paymentId,proto3" json:"paymentId"`
-	ActionById    string                 `protobuf:"bytes,7,opt,name=actionById,proto3" json:"actionById"`
-	ActionByName  string                 `protobuf:"bytes,8,opt,name=actionByName,proto3" json:"actionByName"`
-	GroupId       string                 `protobuf:"bytes,9,opt,name=groupId,proto3" json:"groupId"`
+type AbsStudentCharge struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Id            string                  `protobuf:"bytes,1,opt,name=id,proto3" json:"id"`
+	StudentId     string                  `protobuf:"bytes,2,opt,name=studentId,proto3" json:"studentId"`
+	PaymentId     string                  `protobuf:"bytes,3,opt,name=paymentId,proto3" json:"paymentId"`
+	GivenDate     string                  `protobuf:"bytes,4,opt,name=givenDate,proto3" json:"givenDate"`
+	TotalAmount   float64                 `protobuf:"fixed64,5,opt,name=totalAmount,proto3" json:"totalAmount"`
+	Comment       string                  `protobuf:"bytes,6,opt,name=comment,proto3" json:"comment"`
+	CreatedByName string                  `protobuf:"bytes,7,opt,name=createdByName,proto3" json:"createdByName"`
+	CreatedAt     string                  `protobuf:"bytes,8,opt,name=createdAt,proto3" json:"createdAt"`
+	Lines         []*AbsStudentChargeLine `protobuf:"bytes,9,rep,name=lines,proto3" json:"lines"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *PaymentUpdateRequest) Reset() {
-	*x = PaymentUpdateRequest{}
-	mi := &file_finance_proto_msgTypes[43]
+func (x *AbsStudentCharge) Reset() {
+	*x = AbsStudentCharge{}
+	mi := &file_finance_proto_msgTypes[153]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PaymentUpdateRequest) String() string {
+func (x *AbsStudentCharge) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PaymentUpdateRequest) ProtoMessage() {}
+func (*AbsStudentCharge) ProtoMessage() {}
 
-func (x *PaymentUpdateRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_finance_proto_msgTypes[43]
+func (x *AbsStudentCharge) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[153]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2890,98 +10840,100 @@ func (x *PaymentUpdateRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PaymentUpdateRequest.ProtoReflect.Descriptor instead.
-func (*PaymentUpdateRequest) Descriptor() ([]byte, []int) {
-	return file_finance_proto_rawDescGZIP(), []int{43}
+// Deprecated: Use AbsStudentCharge.ProtoReflect.Descriptor instead.
+func (*AbsStudentCharge) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{153}
 }
 
-func (x *PaymentUpdateRequest) GetDebit() string {
+func (x *AbsStudentCharge) GetId() string {
 	if x != nil {
-		return x.Debit
+		return x.Id
 	}
 	return ""
 }
 
-func (x *PaymentUpdateRequest) GetMethod() string {
+func (x *AbsStudentCharge) GetStudentId() string {
 	if x != nil {
-		return x.Method
+		return x.StudentId
 	}
 	return ""
 }
 
-func (x *PaymentUpdateRequest) GetDate() string {
+func (x *AbsStudentCharge) GetPaymentId() string {
 	if x != nil {
-		return x.Date
+		return x.PaymentId
 	}
 	return ""
 }
 
-func (x *PaymentUpdateRequest) GetComment() string {
+func (x *AbsStudentCharge) GetGivenDate() string {
 	if x != nil {
-		return x.Comment
+		return x.GivenDate
 	}
 	return ""
 }
 
-func (x *PaymentUpdateRequest) GetUserId() string {
+func (x *AbsStudentCharge) GetTotalAmount() float64 {
 	if x != nil {
-		return x.UserId
+		return x.TotalAmount
 	}
-	return ""
+	return 0
 }
 
-func (x *PaymentUpdateRequest) GetPaymentId() string {
+func (x *AbsStudentCharge) GetComment() string {
 	if x != nil {
-		return x.PaymentId
+		return x.Comment
 	}
 	return ""
 }
 
-func (x *PaymentUpdateRequest) GetActionById() string {
+func (x *AbsStudentCharge) GetCreatedByName() string {
 	if x != nil {
-		return x.ActionById
+		return x.CreatedByName
 	}
 	return ""
 }
 
-func (x *PaymentUpdateRequest) GetActionByName() string {
+func (x *AbsStudentCharge) GetCreatedAt() string {
 	if x != nil {
-		return x.ActionByName
+		return x.CreatedAt
 	}
 	return ""
 }
 
-func (x *PaymentUpdateRequest) GetGroupId() string {
+func (x *AbsStudentCharge) GetLines() []*AbsStudentChargeLine {
 	if x != nil {
-		return x.GroupId
+		return x.Lines
 	}
-	return ""
+	return nil
 }
 
-type PaymentReturnRequest struct {
+type AbsStudentChargeLine struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	PaymentId     string                 `protobuf:"bytes,1,opt,name=paymentId,proto3" json:"paymentId"`
-	ActionById    string                 `protobuf:"bytes,2,opt,name=actionById,proto3" json:"actionById"`
-	ActionByName  string                 `protobuf:"bytes,3,opt,name=actionByName,proto3" json:"actionByName"`
+	ItemId        string                 `protobuf:"bytes,1,opt,name=itemId,proto3" json:"itemId"`
+	ItemName      string                 `protobuf:"bytes,2,opt,name=itemName,proto3" json:"itemName"`
+	Quantity      int32                  `protobuf:"varint,3,opt,name=quantity,proto3" json:"quantity"`
+	UnitPrice     float64                `protobuf:"fixed64,4,opt,name=unitPrice,proto3" json:"unitPrice"`
+	Amount        float64                `protobuf:"fixed64,5,opt,name=amount,proto3" json:"amount"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *PaymentReturnRequest) Reset() {
-	*x = PaymentReturnRequest{}
-	mi := &file_finance_proto_msgTypes[44]
+func (x *AbsStudentChargeLine) Reset() {
+	*x = AbsStudentChargeLine{}
+	mi := &file_finance_proto_msgTypes[154]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PaymentReturnRequest) String() string {
+func (x *AbsStudentChargeLine) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PaymentReturnRequest) ProtoMessage() {}
+func (*AbsStudentChargeLine) ProtoMessage() {}
 
-func (x *PaymentReturnRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_finance_proto_msgTypes[44]
+func (x *AbsStudentChargeLine) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[154]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2992,54 +10944,69 @@ func (x *PaymentReturnRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PaymentReturnRequest.ProtoReflect.Descriptor instead.
-func (*PaymentReturnRequest) Descriptor() ([]byte, []int) {
-	return file_finance_proto_rawDescGZIP(), []int{44}
+// Deprecated: Use AbsStudentChargeLine.ProtoReflect.Descriptor instead.
+func (*AbsStudentChargeLine) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{154}
 }
 
-func (x *PaymentReturnRequest) GetPaymentId() string {
+func (x *AbsStudentChargeLine) GetItemId() string {
 	if x != nil {
-		return x.PaymentId
+		return x.ItemId
 	}
 	return ""
 }
 
-func (x *PaymentReturnRequest) GetActionById() string {
+func (x *AbsStudentChargeLine) GetItemName() string {
 	if x != nil {
-		return x.ActionById
+		return x.ItemName
 	}
 	return ""
 }
 
-func (x *PaymentReturnRequest) GetActionByName() string {
+func (x *AbsStudentChargeLine) GetQuantity() int32 {
 	if x != nil {
-		return x.ActionByName
+		return x.Quantity
 	}
-	return ""
+	return 0
 }
 
-type GetTeachersSalaryRequest struct {
-	state         protoimpl.MessageState  `protogen:"open.v1"`
-	Salaries      []*AbsGetTeachersSalary `protobuf:"bytes,1,rep,name=salaries,proto3" json:"salaries"`
+func (x *AbsStudentChargeLine) GetUnitPrice() float64 {
+	if x != nil {
+		return x.UnitPrice
+	}
+	return 0
+}
+
+func (x *AbsStudentChargeLine) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+type GetChargeItemSalesReportRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	From          string                 `protobuf:"bytes,1,opt,name=from,proto3" json:"from"`
+	To            string                 `protobuf:"bytes,2,opt,name=to,proto3" json:"to"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetTeachersSalaryRequest) Reset() {
-	*x = GetTeachersSalaryRequest{}
-	mi := &file_finance_proto_msgTypes[45]
+func (x *GetChargeItemSalesReportRequest) Reset() {
+	*x = GetChargeItemSalesReportRequest{}
+	mi := &file_finance_proto_msgTypes[155]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetTeachersSalaryRequest) String() string {
+func (x *GetChargeItemSalesReportRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetTeachersSalaryRequest) ProtoMessage() {}
+func (*GetChargeItemSalesReportRequest) ProtoMessage() {}
 
-func (x *GetTeachersSalaryRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_finance_proto_msgTypes[45]
+func (x *GetChargeItemSalesReportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[155]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3050,43 +11017,49 @@ func (x *GetTeachersSalaryRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetTeachersSalaryRequest.ProtoReflect.Descriptor instead.
-func (*GetTeachersSalaryRequest) Descriptor() ([]byte, []int) {
-	return file_finance_proto_rawDescGZIP(), []int{45}
+// Deprecated: Use GetChargeItemSalesReportRequest.ProtoReflect.Descriptor instead.
+func (*GetChargeItemSalesReportRequest) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{155}
 }
 
-func (x *GetTeachersSalaryRequest) GetSalaries() []*AbsGetTeachersSalary {
+func (x *GetChargeItemSalesReportRequest) GetFrom() string {
 	if x != nil {
-		return x.Salaries
+		return x.From
 	}
-	return nil
+	return ""
 }
 
-type AbsGetTeachersSalary struct {
+func (x *GetChargeItemSalesReportRequest) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+type GetChargeItemSalesReportResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	TeacherId     string                 `protobuf:"bytes,1,opt,name=teacherId,proto3" json:"teacherId"`
-	Type          string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type"`
-	Amount        int32                  `protobuf:"varint,3,opt,name=amount,proto3" json:"amount"`
-	TeacherName   string                 `protobuf:"bytes,4,opt,name=teacherName,proto3" json:"teacherName"`
+	Items         []*ChargeItemSales     `protobuf:"bytes,1,rep,name=items,proto3" json:"items"`
+	TotalQuantity int32                  `protobuf:"varint,2,opt,name=totalQuantity,proto3" json:"totalQuantity"`
+	TotalAmount   float64                `protobuf:"fixed64,3,opt,name=totalAmount,proto3" json:"totalAmount"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AbsGetTeachersSalary) Reset() {
-	*x = AbsGetTeachersSalary{}
-	mi := &file_finance_proto_msgTypes[46]
+func (x *GetChargeItemSalesReportResponse) Reset() {
+	*x = GetChargeItemSalesReportResponse{}
+	mi := &file_finance_proto_msgTypes[156]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AbsGetTeachersSalary) String() string {
+func (x *GetChargeItemSalesReportResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AbsGetTeachersSalary) ProtoMessage() {}
+func (*GetChargeItemSalesReportResponse) ProtoMessage() {}
 
-func (x *AbsGetTeachersSalary) ProtoReflect() protoreflect.Message {
-	mi := &file_finance_proto_msgTypes[46]
+func (x *GetChargeItemSalesReportResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[156]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3097,61 +11070,61 @@ func (x *AbsGetTeachersSalary) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AbsGetTeachersSalary.ProtoReflect.Descriptor instead.
-func (*AbsGetTeachersSalary) Descriptor() ([]byte, []int) {
-	return file_finance_proto_rawDescGZIP(), []int{46}
-}
-
-func (x *AbsGetTeachersSalary) GetTeacherId() string {
-	if x != nil {
-		return x.TeacherId
-	}
-	return ""
+// Deprecated: Use GetChargeItemSalesReportResponse.ProtoReflect.Descriptor instead.
+func (*GetChargeItemSalesReportResponse) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{156}
 }
 
-func (x *AbsGetTeachersSalary) GetType() string {
+func (x *GetChargeItemSalesReportResponse) GetItems() []*ChargeItemSales {
 	if x != nil {
-		return x.Type
+		return x.Items
 	}
-	return ""
+	return nil
 }
 
-func (x *AbsGetTeachersSalary) GetAmount() int32 {
+func (x *GetChargeItemSalesReportResponse) GetTotalQuantity() int32 {
 	if x != nil {
-		return x.Amount
+		return x.TotalQuantity
 	}
 	return 0
 }
 
-func (x *AbsGetTeachersSalary) GetTeacherName() string {
+func (x *GetChargeItemSalesReportResponse) GetTotalAmount() float64 {
 	if x != nil {
-		return x.TeacherName
+		return x.TotalAmount
 	}
-	return ""
+	return 0
 }
 
-type DeleteTeacherSalaryRequest struct {
+type ChargeItemSales struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	TeacherId     string                 `protobuf:"bytes,1,opt,name=teacherId,proto3" json:"teacherId"`
+	ItemId        string                 `protobuf:"bytes,1,opt,name=itemId,proto3" json:"itemId"`
+	ItemName      string                 `protobuf:"bytes,2,opt,name=itemName,proto3" json:"itemName"`
+	IsPhysical    bool                   `protobuf:"varint,3,opt,name=isPhysical,proto3" json:"isPhysical"`
+	Stock         int32                  `protobuf:"varint,4,opt,name=stock,proto3" json:"stock"`
+	Quantity      int32                  `protobuf:"varint,5,opt,name=quantity,proto3" json:"quantity"`
+	Amount        float64                `protobuf:"fixed64,6,opt,name=amount,proto3" json:"amount"`
+	ChargeCount   int32                  `protobuf:"varint,7,opt,name=chargeCount,proto3" json:"chargeCount"`
+	StudentCount  int32                  `protobuf:"varint,8,opt,name=studentCount,proto3" json:"studentCount"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteTeacherSalaryRequest) Reset() {
-	*x = DeleteTeacherSalaryRequest{}
-	mi := &file_finance_proto_msgTypes[47]
+func (x *ChargeItemSales) Reset() {
+	*x = ChargeItemSales{}
+	mi := &file_finance_proto_msgTypes[157]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteTeacherSalaryRequest) String() string {
+func (x *ChargeItemSales) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteTeacherSalaryRequest) ProtoMessage() {}
+func (*ChargeItemSales) ProtoMessage() {}
 
-func (x *DeleteTeacherSalaryRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_finance_proto_msgTypes[47]
+func (x *ChargeItemSales) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[157]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3162,74 +11135,63 @@ func (x *DeleteTeacherSalaryRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteTeacherSalaryRequest.ProtoReflect.Descriptor instead.
-func (*DeleteTeacherSalaryRequest) Descriptor() ([]byte, []int) {
-	return file_finance_proto_rawDescGZIP(), []int{47}
+// Deprecated: Use ChargeItemSales.ProtoReflect.Descriptor instead.
+func (*ChargeItemSales) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{157}
 }
 
-func (x *DeleteTeacherSalaryRequest) GetTeacherId() string {
+func (x *ChargeItemSales) GetItemId() string {
 	if x != nil {
-		return x.TeacherId
+		return x.ItemId
 	}
 	return ""
 }
 
-type CreateTeacherSalaryRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	TeacherId     string                 `protobuf:"bytes,1,opt,name=teacherId,proto3" json:"teacherId"`
-	Type          string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type"`
-	Amount        int32                  `protobuf:"varint,3,opt,name=amount,proto3" json:"amount"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *CreateTeacherSalaryRequest) Reset() {
-	*x = CreateTeacherSalaryRequest{}
-	mi := &file_finance_proto_msgTypes[48]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *ChargeItemSales) GetItemName() string {
+	if x != nil {
+		return x.ItemName
+	}
+	return ""
 }
 
-func (x *CreateTeacherSalaryRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *ChargeItemSales) GetIsPhysical() bool {
+	if x != nil {
+		return x.IsPhysical
+	}
+	return false
 }
 
-func (*CreateTeacherSalaryRequest) ProtoMessage() {}
-
-func (x *CreateTeacherSalaryRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_finance_proto_msgTypes[48]
+func (x *ChargeItemSales) GetStock() int32 {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Stock
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use CreateTeacherSalaryRequest.ProtoReflect.Descriptor instead.
-func (*CreateTeacherSalaryRequest) Descriptor() ([]byte, []int) {
-	return file_finance_proto_rawDescGZIP(), []int{48}
+func (x *ChargeItemSales) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
 }
 
-func (x *CreateTeacherSalaryRequest) GetTeacherId() string {
+func (x *ChargeItemSales) GetAmount() float64 {
 	if x != nil {
-		return x.TeacherId
+		return x.Amount
 	}
-	return ""
+	return 0
 }
 
-func (x *CreateTeacherSalaryRequest) GetType() string {
+func (x *ChargeItemSales) GetChargeCount() int32 {
 	if x != nil {
-		return x.Type
+		return x.ChargeCount
 	}
-	return ""
+	return 0
 }
 
-func (x *CreateTeacherSalaryRequest) GetAmount() int32 {
+func (x *ChargeItemSales) GetStudentCount() int32 {
 	if x != nil {
-		return x.Amount
+		return x.StudentCount
 	}
 	return 0
 }
@@ -3239,12 +11201,64 @@ var File_finance_proto protoreflect.FileDescriptor
 const file_finance_proto_rawDesc = "" +
 	"\n" +
 	"\rfinance.proto\x12\afinance\x1a\fcommon.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a\n" +
-	"user.proto\"S\n" +
+	"user.proto\"\xc9\x01\n" +
+	"\x19ApplyDiscountRulesRequest\x12\x1c\n" +
+	"\tstudentId\x18\x01 \x01(\tR\tstudentId\x12\x18\n" +
+	"\agroupId\x18\x02 \x01(\tR\agroupId\x12\x1c\n" +
+	"\tpromoCode\x18\x03 \x01(\tR\tpromoCode\x12\x12\n" +
+	"\x04date\x18\x04 \x01(\tR\x04date\x12\x1e\n" +
+	"\n" +
+	"actionById\x18\x05 \x01(\tR\n" +
+	"actionById\x12\"\n" +
+	"\factionByName\x18\x06 \x01(\tR\factionByName\"\xa0\x01\n" +
+	"\x1aApplyDiscountRulesResponse\x12\x18\n" +
+	"\aapplied\x18\x01 \x01(\bR\aapplied\x12\x16\n" +
+	"\x06ruleId\x18\x02 \x01(\tR\x06ruleId\x12\x1a\n" +
+	"\bruleName\x18\x03 \x01(\tR\bruleName\x12\x1a\n" +
+	"\bdiscount\x18\x04 \x01(\x01R\bdiscount\x12\x18\n" +
+	"\amessage\x18\x05 \x01(\tR\amessage\"\xdf\x03\n" +
+	"\x0fAbsDiscountRule\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1a\n" +
+	"\bruleType\x18\x03 \x01(\tR\bruleType\x12\x1c\n" +
+	"\tvalueType\x18\x04 \x01(\tR\tvalueType\x12\x14\n" +
+	"\x05value\x18\x05 \x01(\x01R\x05value\x12 \n" +
+	"\vwithTeacher\x18\x06 \x01(\bR\vwithTeacher\x12&\n" +
+	"\x0edurationMonths\x18\a \x01(\x05R\x0edurationMonths\x12\x1c\n" +
+	"\tpromoCode\x18\b \x01(\tR\tpromoCode\x12\x1e\n" +
+	"\n" +
+	"usageLimit\x18\t \x01(\x05R\n" +
+	"usageLimit\x12\x1c\n" +
+	"\tusedCount\x18\n" +
+	" \x01(\x05R\tusedCount\x12\x1c\n" +
+	"\tvalidFrom\x18\v \x01(\tR\tvalidFrom\x12\x1e\n" +
+	"\n" +
+	"validUntil\x18\f \x01(\tR\n" +
+	"validUntil\x12\x1e\n" +
+	"\n" +
+	"minCourses\x18\r \x01(\x05R\n" +
+	"minCourses\x12\x1a\n" +
+	"\bcourseId\x18\x0e \x01(\tR\bcourseId\x12\x1a\n" +
+	"\bisActive\x18\x0f \x01(\bR\bisActive\x12\x1c\n" +
+	"\tcreatedAt\x18\x10 \x01(\tR\tcreatedAt\"C\n" +
+	"\x17GetDiscountRulesRequest\x12(\n" +
+	"\x0fincludeInactive\x18\x01 \x01(\bR\x0fincludeInactive\"J\n" +
+	"\x18GetDiscountRulesResponse\x12.\n" +
+	"\x05rules\x18\x01 \x03(\v2\x18.finance.AbsDiscountRuleR\x05rules\"\xd0\x01\n" +
+	"\x1cApplyDiscountTemplateRequest\x12\x16\n" +
+	"\x06ruleId\x18\x01 \x01(\tR\x06ruleId\x12\x1c\n" +
+	"\tstudentId\x18\x02 \x01(\tR\tstudentId\x12\x18\n" +
+	"\agroupId\x18\x03 \x01(\tR\agroupId\x12\x1c\n" +
+	"\tstartDate\x18\x04 \x01(\tR\tstartDate\x12\x1e\n" +
+	"\n" +
+	"actionById\x18\x05 \x01(\tR\n" +
+	"actionById\x12\"\n" +
+	"\factionByName\x18\x06 \x01(\tR\factionByName\"S\n" +
 	"\x19GetHistoryDiscountRequest\x12\x1c\n" +
 	"\tstudentId\x18\x01 \x01(\tR\tstudentId\x12\x18\n" +
 	"\agroupId\x18\x02 \x01(\tR\agroupId\"W\n" +
 	"\x1aGetHistoryDiscountResponse\x129\n" +
-	"\tdiscounts\x18\x01 \x03(\v2\x1b.finance.AbsHistoryDiscountR\tdiscounts\"\xdc\x02\n" +
+	"\tdiscounts\x18\x01 \x03(\v2\x1b.finance.AbsHistoryDiscountR\tdiscounts\"\x90\x03\n" +
 	"\x12AbsHistoryDiscount\x12\x18\n" +
 	"\agroupId\x18\x01 \x01(\tR\agroupId\x12\x1c\n" +
 	"\tgroupName\x18\n" +
@@ -3257,7 +11271,9 @@ const file_finance_proto_rawDesc = "" +
 	"\aendDate\x18\x06 \x01(\tR\aendDate\x12 \n" +
 	"\vwithTeacher\x18\a \x01(\bR\vwithTeacher\x12\x16\n" +
 	"\x06action\x18\b \x01(\tR\x06action\x12\x1c\n" +
-	"\tcreatedAt\x18\t \x01(\tR\tcreatedAt\"\xe6\x01\n" +
+	"\tcreatedAt\x18\t \x01(\tR\tcreatedAt\x12\x16\n" +
+	"\x06ruleId\x18\f \x01(\tR\x06ruleId\x12\x1a\n" +
+	"\bruleName\x18\r \x01(\tR\bruleName\"\xe6\x01\n" +
 	"\x12AbsDiscountRequest\x12\x18\n" +
 	"\agroupId\x18\x01 \x01(\tR\agroupId\x12\x1c\n" +
 	"\tstudentId\x18\x02 \x01(\tR\tstudentId\x12$\n" +
@@ -3286,29 +11302,46 @@ const file_finance_proto_rawDesc = "" +
 	"\x15GetAllCategoryRequest\x124\n" +
 	"\n" +
 	"categories\x18\x01 \x03(\v2\x14.finance.AbsCategoryR\n" +
-	"categories\"E\n" +
+	"categories\"k\n" +
 	"\vAbsCategory\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12\x12\n" +
-	"\x04desc\x18\x03 \x01(\tR\x04desc\"\xee\x01\n" +
+	"\x04desc\x18\x03 \x01(\tR\x04desc\x12$\n" +
+	"\rmonthlyBudget\x18\x04 \x01(\x01R\rmonthlyBudget\"`\n" +
+	"\x18SetCategoryBudgetRequest\x12\x1e\n" +
+	"\n" +
+	"categoryId\x18\x01 \x01(\tR\n" +
+	"categoryId\x12$\n" +
+	"\rmonthlyBudget\x18\x02 \x01(\x01R\rmonthlyBudget\"\xa1\x02\n" +
 	"\x1cGetAllExpenseDiagramResponse\x12*\n" +
 	"\x10userOrCategories\x18\x01 \x03(\tR\x10userOrCategories\x126\n" +
 	"\x16userOrCategoriesAmount\x18\x02 \x03(\tR\x16userOrCategoriesAmount\x12 \n" +
 	"\vmonthAmount\x18\x03 \x03(\tR\vmonthAmount\x12\x16\n" +
 	"\x06months\x18\x04 \x03(\tR\x06months\x120\n" +
-	"\x13amountCommonExpense\x18\x05 \x01(\tR\x13amountCommonExpense\"A\n" +
+	"\x13amountCommonExpense\x18\x05 \x01(\tR\x13amountCommonExpense\x121\n" +
+	"\abudgets\x18\x06 \x03(\v2\x17.finance.CategoryBudgetR\abudgets\"\xb4\x01\n" +
+	"\x0eCategoryBudget\x12\x1e\n" +
+	"\n" +
+	"categoryId\x18\x01 \x01(\tR\n" +
+	"categoryId\x12\"\n" +
+	"\fcategoryName\x18\x02 \x01(\tR\fcategoryName\x12\x14\n" +
+	"\x05month\x18\x03 \x01(\tR\x05month\x12\x16\n" +
+	"\x06budget\x18\x04 \x01(\x01R\x06budget\x12\x16\n" +
+	"\x06actual\x18\x05 \x01(\x01R\x06actual\x12\x18\n" +
+	"\apercent\x18\x06 \x01(\x01R\apercent\"A\n" +
 	"\x1bGetAllExpenseDiagramRequest\x12\x12\n" +
 	"\x04from\x18\x01 \x01(\tR\x04from\x12\x0e\n" +
-	"\x02to\x18\x02 \x01(\tR\x02to\"\x8d\x01\n" +
+	"\x02to\x18\x02 \x01(\tR\x02to\"\xa5\x01\n" +
 	"\x14GetAllExpenseRequest\x12\x12\n" +
 	"\x04from\x18\x01 \x01(\tR\x04from\x12\x0e\n" +
 	"\x02to\x18\x02 \x01(\tR\x02to\x12\x12\n" +
 	"\x04type\x18\x03 \x01(\tR\x04type\x12\x0e\n" +
 	"\x02id\x18\x05 \x01(\tR\x02id\x12-\n" +
-	"\apageReq\x18\x04 \x01(\v2\x13.common.PageRequestR\apageReq\"v\n" +
+	"\apageReq\x18\x04 \x01(\v2\x13.common.PageRequestR\apageReq\x12\x16\n" +
+	"\x06status\x18\x06 \x01(\tR\x06status\"v\n" +
 	"\x15GetAllExpenseResponse\x12&\n" +
 	"\x0etotalPageCount\x18\x01 \x01(\x05R\x0etotalPageCount\x125\n" +
-	"\bexpenses\x18\x02 \x03(\v2\x19.finance.GetAllExpenseAbsR\bexpenses\"\xe0\x02\n" +
+	"\bexpenses\x18\x02 \x03(\v2\x19.finance.GetAllExpenseAbsR\bexpenses\"\xfc\x03\n" +
 	"\x10GetAllExpenseAbs\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1c\n" +
 	"\tgivenDate\x18\x02 \x01(\tR\tgivenDate\x120\n" +
@@ -3320,7 +11353,14 @@ const file_finance_proto_rawDesc = "" +
 	"\vpaymentType\x18\b \x01(\tR\vpaymentType\x12\x1c\n" +
 	"\tcreatedAt\x18\t \x01(\tR\tcreatedAt\x12\x14\n" +
 	"\x05title\x18\n" +
-	" \x01(\tR\x05title\"\xfe\x01\n" +
+	" \x01(\tR\x05title\x12\x16\n" +
+	"\x06status\x18\v \x01(\tR\x06status\x12\"\n" +
+	"\fapproverRole\x18\f \x01(\tR\fapproverRole\x12\x1a\n" +
+	"\bvendorId\x18\r \x01(\tR\bvendorId\x12\x1e\n" +
+	"\n" +
+	"vendorName\x18\x0e \x01(\tR\n" +
+	"vendorName\x12\"\n" +
+	"\freceiptCount\x18\x0f \x01(\x05R\freceiptCount\"\x80\x03\n" +
 	"\x14CreateExpenseRequest\x12\x14\n" +
 	"\x05title\x18\x01 \x01(\tR\x05title\x12\x1c\n" +
 	"\tgivenDate\x18\x02 \x01(\tR\tgivenDate\x12 \n" +
@@ -3331,7 +11371,141 @@ const file_finance_proto_rawDesc = "" +
 	"\x06userId\x18\x05 \x01(\tR\x06userId\x12\x10\n" +
 	"\x03sum\x18\x06 \x01(\tR\x03sum\x12 \n" +
 	"\vcreatedById\x18\a \x01(\tR\vcreatedById\x12$\n" +
-	"\rpaymentMethod\x18\b \x01(\tR\rpaymentMethod\";\n" +
+	"\rpaymentMethod\x18\b \x01(\tR\rpaymentMethod\x12\x1a\n" +
+	"\bvendorId\x18\t \x01(\tR\bvendorId\x12\x18\n" +
+	"\aasDraft\x18\n" +
+	" \x01(\bR\aasDraft\x12$\n" +
+	"\rcreatedByName\x18\v \x01(\tR\rcreatedByName\x12$\n" +
+	"\rcreatedByRole\x18\f \x01(\tR\rcreatedByRole\"\xeb\x02\n" +
+	"\x1dCreateRecurringExpenseRequest\x12\x14\n" +
+	"\x05title\x18\x01 \x01(\tR\x05title\x12 \n" +
+	"\vexpenseType\x18\x02 \x01(\tR\vexpenseType\x12\x1e\n" +
+	"\n" +
+	"categoryId\x18\x03 \x01(\tR\n" +
+	"categoryId\x12\x16\n" +
+	"\x06userId\x18\x04 \x01(\tR\x06userId\x12\x10\n" +
+	"\x03sum\x18\x05 \x01(\tR\x03sum\x12$\n" +
+	"\rpaymentMethod\x18\x06 \x01(\tR\rpaymentMethod\x12\x1a\n" +
+	"\bschedule\x18\a \x01(\tR\bschedule\x12\x10\n" +
+	"\x03day\x18\b \x01(\x05R\x03day\x12\x1c\n" +
+	"\tstartDate\x18\t \x01(\tR\tstartDate\x12\x18\n" +
+	"\aendDate\x18\n" +
+	" \x01(\tR\aendDate\x12 \n" +
+	"\vcreatedById\x18\v \x01(\tR\vcreatedById\x12\x1a\n" +
+	"\bvendorId\x18\f \x01(\tR\bvendorId\"j\n" +
+	"\x1cGetRecurringExpensesResponse\x12J\n" +
+	"\x11recurringExpenses\x18\x01 \x03(\v2\x1c.finance.AbsRecurringExpenseR\x11recurringExpenses\"\x93\x04\n" +
+	"\x13AbsRecurringExpense\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x12 \n" +
+	"\vexpenseType\x18\x03 \x01(\tR\vexpenseType\x12\x1e\n" +
+	"\n" +
+	"categoryId\x18\x04 \x01(\tR\n" +
+	"categoryId\x12\"\n" +
+	"\fcategoryName\x18\x05 \x01(\tR\fcategoryName\x12\x16\n" +
+	"\x06userId\x18\x06 \x01(\tR\x06userId\x12\x10\n" +
+	"\x03sum\x18\a \x01(\x01R\x03sum\x12$\n" +
+	"\rpaymentMethod\x18\b \x01(\tR\rpaymentMethod\x12\x1a\n" +
+	"\bschedule\x18\t \x01(\tR\bschedule\x12\x10\n" +
+	"\x03day\x18\n" +
+	" \x01(\x05R\x03day\x12\x1c\n" +
+	"\tstartDate\x18\v \x01(\tR\tstartDate\x12\x18\n" +
+	"\aendDate\x18\f \x01(\tR\aendDate\x12 \n" +
+	"\vnextRunDate\x18\r \x01(\tR\vnextRunDate\x12\x1a\n" +
+	"\bisActive\x18\x0e \x01(\bR\bisActive\x12\x1c\n" +
+	"\tcreatedAt\x18\x0f \x01(\tR\tcreatedAt\x12\x1a\n" +
+	"\bvendorId\x18\x10 \x01(\tR\bvendorId\x12\x1e\n" +
+	"\n" +
+	"vendorName\x18\x11 \x01(\tR\n" +
+	"vendorName\x12\"\n" +
+	"\fskippedDates\x18\x12 \x03(\tR\fskippedDates\"0\n" +
+	"\x16GetBudgetAlertsRequest\x12\x16\n" +
+	"\x06period\x18\x01 \x01(\tR\x06period\"J\n" +
+	"\x17GetBudgetAlertsResponse\x12/\n" +
+	"\x06alerts\x18\x01 \x03(\v2\x17.finance.AbsBudgetAlertR\x06alerts\"\xd8\x01\n" +
+	"\x0eAbsBudgetAlert\x12\x1e\n" +
+	"\n" +
+	"categoryId\x18\x01 \x01(\tR\n" +
+	"categoryId\x12\"\n" +
+	"\fcategoryName\x18\x02 \x01(\tR\fcategoryName\x12\x16\n" +
+	"\x06period\x18\x03 \x01(\tR\x06period\x12\x1c\n" +
+	"\tthreshold\x18\x04 \x01(\x05R\tthreshold\x12\x16\n" +
+	"\x06budget\x18\x05 \x01(\x01R\x06budget\x12\x16\n" +
+	"\x06actual\x18\x06 \x01(\x01R\x06actual\x12\x1c\n" +
+	"\tcreatedAt\x18\a \x01(\tR\tcreatedAt\"'\n" +
+	"\x15GetExpenseByIdRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\xb2\x01\n" +
+	"\rExpenseDetail\x123\n" +
+	"\aexpense\x18\x01 \x01(\v2\x19.finance.GetAllExpenseAbsR\aexpense\x123\n" +
+	"\breceipts\x18\x02 \x03(\v2\x17.finance.ExpenseReceiptR\breceipts\x127\n" +
+	"\ahistory\x18\x03 \x03(\v2\x1d.finance.ExpenseStatusHistoryR\ahistory\"\x98\x01\n" +
+	"\x0eExpenseReceipt\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x18\n" +
+	"\afileUrl\x18\x02 \x01(\tR\afileUrl\x12\x1a\n" +
+	"\bfileName\x18\x03 \x01(\tR\bfileName\x12\"\n" +
+	"\fuploadedById\x18\x04 \x01(\tR\fuploadedById\x12\x1c\n" +
+	"\tcreatedAt\x18\x05 \x01(\tR\tcreatedAt\"\xce\x01\n" +
+	"\x14ExpenseStatusHistory\x12\x1e\n" +
+	"\n" +
+	"fromStatus\x18\x01 \x01(\tR\n" +
+	"fromStatus\x12\x1a\n" +
+	"\btoStatus\x18\x02 \x01(\tR\btoStatus\x12\x18\n" +
+	"\acomment\x18\x03 \x01(\tR\acomment\x12\x1e\n" +
+	"\n" +
+	"actionById\x18\x04 \x01(\tR\n" +
+	"actionById\x12\"\n" +
+	"\factionByName\x18\x05 \x01(\tR\factionByName\x12\x1c\n" +
+	"\tcreatedAt\x18\x06 \x01(\tR\tcreatedAt\"\xa8\x01\n" +
+	"\x14ExpenseActionRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x18\n" +
+	"\acomment\x18\x02 \x01(\tR\acomment\x12\x1e\n" +
+	"\n" +
+	"actionById\x18\x03 \x01(\tR\n" +
+	"actionById\x12\"\n" +
+	"\factionByName\x18\x04 \x01(\tR\factionByName\x12\"\n" +
+	"\factionByRole\x18\x05 \x01(\tR\factionByRole\"\x92\x01\n" +
+	"\x18AddExpenseReceiptRequest\x12\x1c\n" +
+	"\texpenseId\x18\x01 \x01(\tR\texpenseId\x12\x18\n" +
+	"\afileUrl\x18\x02 \x01(\tR\afileUrl\x12\x1a\n" +
+	"\bfileName\x18\x03 \x01(\tR\bfileName\x12\"\n" +
+	"\fuploadedById\x18\x04 \x01(\tR\fuploadedById\"l\n" +
+	"\x16ExpenseApprovalSetting\x12.\n" +
+	"\x12financistThreshold\x18\x01 \x01(\x01R\x12financistThreshold\x12\"\n" +
+	"\fceoThreshold\x18\x02 \x01(\x01R\fceoThreshold\"k\n" +
+	"\x13CreateVendorRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x14\n" +
+	"\x05phone\x18\x02 \x01(\tR\x05phone\x12\x10\n" +
+	"\x03tin\x18\x03 \x01(\tR\x03tin\x12\x18\n" +
+	"\acomment\x18\x04 \x01(\tR\acomment\"\x99\x02\n" +
+	"\tAbsVendor\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x14\n" +
+	"\x05phone\x18\x03 \x01(\tR\x05phone\x12\x10\n" +
+	"\x03tin\x18\x04 \x01(\tR\x03tin\x12\x18\n" +
+	"\acomment\x18\x05 \x01(\tR\acomment\x12\x1a\n" +
+	"\bisActive\x18\x06 \x01(\bR\bisActive\x12\x1e\n" +
+	"\n" +
+	"totalSpent\x18\a \x01(\x01R\n" +
+	"totalSpent\x12\"\n" +
+	"\fexpenseCount\x18\b \x01(\x05R\fexpenseCount\x12(\n" +
+	"\x0flastExpenseDate\x18\t \x01(\tR\x0flastExpenseDate\x12\x1c\n" +
+	"\tcreatedAt\x18\n" +
+	" \x01(\tR\tcreatedAt\"=\n" +
+	"\x11GetVendorsRequest\x12(\n" +
+	"\x0fincludeInactive\x18\x01 \x01(\bR\x0fincludeInactive\"B\n" +
+	"\x12GetVendorsResponse\x12,\n" +
+	"\avendors\x18\x01 \x03(\v2\x12.finance.AbsVendorR\avendors\"^\n" +
+	"\x1cGetVendorSpendHistoryRequest\x12\x1a\n" +
+	"\bvendorId\x18\x01 \x01(\tR\bvendorId\x12\x12\n" +
+	"\x04from\x18\x02 \x01(\tR\x04from\x12\x0e\n" +
+	"\x02to\x18\x03 \x01(\tR\x02to\"~\n" +
+	"\x1dGetVendorSpendHistoryResponse\x12*\n" +
+	"\x06vendor\x18\x01 \x01(\v2\x12.finance.AbsVendorR\x06vendor\x121\n" +
+	"\x06months\x18\x02 \x03(\v2\x19.finance.VendorMonthSpendR\x06months\"d\n" +
+	"\x10VendorMonthSpend\x12\x14\n" +
+	"\x05month\x18\x01 \x01(\tR\x05month\x12\x16\n" +
+	"\x06amount\x18\x02 \x01(\x01R\x06amount\x12\"\n" +
+	"\fexpenseCount\x18\x03 \x01(\x05R\fexpenseCount\";\n" +
 	"\x15GetIncomeChartRequest\x12\x12\n" +
 	"\x04from\x18\x01 \x01(\tR\x04from\x12\x0e\n" +
 	"\x02to\x18\x02 \x01(\tR\x02to\"M\n" +
@@ -3476,35 +11650,555 @@ const file_finance_proto_rawDesc = "" +
 	"actionById\x12\"\n" +
 	"\factionByName\x18\x03 \x01(\tR\factionByName\"U\n" +
 	"\x18GetTeachersSalaryRequest\x129\n" +
-	"\bsalaries\x18\x01 \x03(\v2\x1d.finance.AbsGetTeachersSalaryR\bsalaries\"\x82\x01\n" +
+	"\bsalaries\x18\x01 \x03(\v2\x1d.finance.AbsGetTeachersSalaryR\bsalaries\"\xee\x01\n" +
 	"\x14AbsGetTeachersSalary\x12\x1c\n" +
 	"\tteacherId\x18\x01 \x01(\tR\tteacherId\x12\x12\n" +
 	"\x04type\x18\x02 \x01(\tR\x04type\x12\x16\n" +
 	"\x06amount\x18\x03 \x01(\x05R\x06amount\x12 \n" +
-	"\vteacherName\x18\x04 \x01(\tR\vteacherName\":\n" +
+	"\vteacherName\x18\x04 \x01(\tR\vteacherName\x12\x0e\n" +
+	"\x02id\x18\x05 \x01(\tR\x02id\x12\x1a\n" +
+	"\bcourseId\x18\x06 \x01(\tR\bcourseId\x12\x18\n" +
+	"\agroupId\x18\a \x01(\tR\agroupId\x12$\n" +
+	"\reffectiveFrom\x18\b \x01(\tR\reffectiveFrom\"J\n" +
 	"\x1aDeleteTeacherSalaryRequest\x12\x1c\n" +
-	"\tteacherId\x18\x01 \x01(\tR\tteacherId\"f\n" +
+	"\tteacherId\x18\x01 \x01(\tR\tteacherId\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\tR\x02id\"\xc2\x01\n" +
 	"\x1aCreateTeacherSalaryRequest\x12\x1c\n" +
 	"\tteacherId\x18\x01 \x01(\tR\tteacherId\x12\x12\n" +
 	"\x04type\x18\x02 \x01(\tR\x04type\x12\x16\n" +
-	"\x06amount\x18\x03 \x01(\x05R\x06amount2\xe6\x02\n" +
+	"\x06amount\x18\x03 \x01(\x05R\x06amount\x12\x1a\n" +
+	"\bcourseId\x18\x04 \x01(\tR\bcourseId\x12\x18\n" +
+	"\agroupId\x18\x05 \x01(\tR\agroupId\x12$\n" +
+	"\reffectiveFrom\x18\x06 \x01(\tR\reffectiveFrom\"\x85\x01\n" +
+	"\x1bResolveTeacherSalaryRequest\x12\x1c\n" +
+	"\tteacherId\x18\x01 \x01(\tR\tteacherId\x12\x18\n" +
+	"\agroupId\x18\x02 \x01(\tR\agroupId\x12\x1a\n" +
+	"\bcourseId\x18\x03 \x01(\tR\bcourseId\x12\x12\n" +
+	"\x04date\x18\x04 \x01(\tR\x04date\"\x8f\x01\n" +
+	"\x17AccountingPeriodRequest\x12\x16\n" +
+	"\x06period\x18\x01 \x01(\tR\x06period\x12\x18\n" +
+	"\acomment\x18\x02 \x01(\tR\acomment\x12\x1e\n" +
+	"\n" +
+	"actionById\x18\x03 \x01(\tR\n" +
+	"actionById\x12\"\n" +
+	"\factionByName\x18\x04 \x01(\tR\factionByName\"O\n" +
+	"\x15GetAllPeriodsResponse\x126\n" +
+	"\aperiods\x18\x01 \x03(\v2\x1c.finance.AbsAccountingPeriodR\aperiods\"\xa9\x01\n" +
+	"\x13AbsAccountingPeriod\x12\x16\n" +
+	"\x06period\x18\x01 \x01(\tR\x06period\x12\x1a\n" +
+	"\bisClosed\x18\x02 \x01(\bR\bisClosed\x12\x1e\n" +
+	"\n" +
+	"closedById\x18\x03 \x01(\tR\n" +
+	"closedById\x12\"\n" +
+	"\fclosedByName\x18\x04 \x01(\tR\fclosedByName\x12\x1a\n" +
+	"\bclosedAt\x18\x05 \x01(\tR\bclosedAt\"1\n" +
+	"\x17GetPeriodHistoryRequest\x12\x16\n" +
+	"\x06period\x18\x01 \x01(\tR\x06period\"S\n" +
+	"\x18GetPeriodHistoryResponse\x127\n" +
+	"\thistories\x18\x01 \x03(\v2\x19.finance.AbsPeriodHistoryR\thistories\"\xce\x01\n" +
+	"\x10AbsPeriodHistory\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x16\n" +
+	"\x06period\x18\x02 \x01(\tR\x06period\x12\x16\n" +
+	"\x06action\x18\x03 \x01(\tR\x06action\x12\x18\n" +
+	"\acomment\x18\x04 \x01(\tR\acomment\x12\x1e\n" +
+	"\n" +
+	"actionById\x18\x05 \x01(\tR\n" +
+	"actionById\x12\"\n" +
+	"\factionByName\x18\x06 \x01(\tR\factionByName\x12\x1c\n" +
+	"\tcreatedAt\x18\a \x01(\tR\tcreatedAt\"(\n" +
+	"\x12CheckPeriodRequest\x12\x12\n" +
+	"\x04date\x18\x01 \x01(\tR\x04date\"I\n" +
+	"\x13CheckPeriodResponse\x12\x1a\n" +
+	"\bisClosed\x18\x01 \x01(\bR\bisClosed\x12\x16\n" +
+	"\x06period\x18\x02 \x01(\tR\x06period\"u\n" +
+	"\x17CreatePayrollRunRequest\x12\x16\n" +
+	"\x06period\x18\x01 \x01(\tR\x06period\x12\x1e\n" +
+	"\n" +
+	"actionById\x18\x02 \x01(\tR\n" +
+	"actionById\x12\"\n" +
+	"\factionByName\x18\x03 \x01(\tR\factionByName\"+\n" +
+	"\x13PayrollRunIdRequest\x12\x14\n" +
+	"\x05runId\x18\x01 \x01(\tR\x05runId\"D\n" +
+	"\x16GetPayrollRunsResponse\x12*\n" +
+	"\x04runs\x18\x01 \x03(\v2\x16.finance.AbsPayrollRunR\x04runs\"\xe4\x02\n" +
+	"\rAbsPayrollRun\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x16\n" +
+	"\x06period\x18\x02 \x01(\tR\x06period\x12\x16\n" +
+	"\x06status\x18\x03 \x01(\tR\x06status\x12$\n" +
+	"\rcreatedByName\x18\x04 \x01(\tR\rcreatedByName\x12&\n" +
+	"\x0eapprovedByName\x18\x05 \x01(\tR\x0eapprovedByName\x12\x1e\n" +
+	"\n" +
+	"approvedAt\x18\x06 \x01(\tR\n" +
+	"approvedAt\x12\x1e\n" +
+	"\n" +
+	"paidByName\x18\a \x01(\tR\n" +
+	"paidByName\x12\x16\n" +
+	"\x06paidAt\x18\b \x01(\tR\x06paidAt\x12\x1c\n" +
+	"\tcreatedAt\x18\t \x01(\tR\tcreatedAt\x12 \n" +
+	"\vtotalAmount\x18\n" +
+	" \x01(\tR\vtotalAmount\x12-\n" +
+	"\x05items\x18\v \x03(\v2\x17.finance.AbsPayrollItemR\x05items\"\xb2\x02\n" +
+	"\x0eAbsPayrollItem\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1c\n" +
+	"\tteacherId\x18\x02 \x01(\tR\tteacherId\x12 \n" +
+	"\vteacherName\x18\x03 \x01(\tR\vteacherName\x12\"\n" +
+	"\fsalaryAmount\x18\x04 \x01(\tR\fsalaryAmount\x12 \n" +
+	"\vbonusAmount\x18\x05 \x01(\tR\vbonusAmount\x12$\n" +
+	"\rpenaltyAmount\x18\x06 \x01(\tR\rpenaltyAmount\x12$\n" +
+	"\radvanceAmount\x18\a \x01(\tR\radvanceAmount\x12 \n" +
+	"\vtotalAmount\x18\b \x01(\tR\vtotalAmount\x12\x1c\n" +
+	"\texpenseId\x18\t \x01(\tR\texpenseId\"\xb7\x01\n" +
+	"\x1bAddPayrollAdjustmentRequest\x12\x14\n" +
+	"\x05runId\x18\x01 \x01(\tR\x05runId\x12\x1c\n" +
+	"\tteacherId\x18\x02 \x01(\tR\tteacherId\x12\x12\n" +
+	"\x04type\x18\x03 \x01(\tR\x04type\x12\x16\n" +
+	"\x06amount\x18\x04 \x01(\tR\x06amount\x12\x18\n" +
+	"\acomment\x18\x05 \x01(\tR\acomment\x12\x1e\n" +
+	"\n" +
+	"actionById\x18\x06 \x01(\tR\n" +
+	"actionById\"0\n" +
+	"\x1eDeletePayrollAdjustmentRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"s\n" +
+	"\x17PayrollRunActionRequest\x12\x14\n" +
+	"\x05runId\x18\x01 \x01(\tR\x05runId\x12\x1e\n" +
+	"\n" +
+	"actionById\x18\x02 \x01(\tR\n" +
+	"actionById\x12\"\n" +
+	"\factionByName\x18\x03 \x01(\tR\factionByName\"\xb4\x01\n" +
+	"\x14PayPayrollRunRequest\x12\x14\n" +
+	"\x05runId\x18\x01 \x01(\tR\x05runId\x12$\n" +
+	"\rpaymentMethod\x18\x02 \x01(\tR\rpaymentMethod\x12\x1c\n" +
+	"\tgivenDate\x18\x03 \x01(\tR\tgivenDate\x12\x1e\n" +
+	"\n" +
+	"actionById\x18\x04 \x01(\tR\n" +
+	"actionById\x12\"\n" +
+	"\factionByName\x18\x05 \x01(\tR\factionByName\"G\n" +
+	"\x11GetPayslipRequest\x12\x14\n" +
+	"\x05runId\x18\x01 \x01(\tR\x05runId\x12\x1c\n" +
+	"\tteacherId\x18\x02 \x01(\tR\tteacherId\"9\n" +
+	"\x19GetTeacherPayslipsRequest\x12\x1c\n" +
+	"\tteacherId\x18\x01 \x01(\tR\tteacherId\"J\n" +
+	"\x1aGetTeacherPayslipsResponse\x12,\n" +
+	"\bpayslips\x18\x01 \x03(\v2\x10.finance.PayslipR\bpayslips\"\xec\x01\n" +
+	"\aPayslip\x12\x14\n" +
+	"\x05runId\x18\x01 \x01(\tR\x05runId\x12\x16\n" +
+	"\x06period\x18\x02 \x01(\tR\x06period\x12\x16\n" +
+	"\x06status\x18\x03 \x01(\tR\x06status\x12+\n" +
+	"\x04item\x18\x04 \x01(\v2\x17.finance.AbsPayrollItemR\x04item\x12-\n" +
+	"\x06groups\x18\x05 \x03(\v2\x15.finance.PayslipGroupR\x06groups\x12?\n" +
+	"\vadjustments\x18\x06 \x03(\v2\x1d.finance.AbsPayrollAdjustmentR\vadjustments\"\xc1\x01\n" +
+	"\fPayslipGroup\x12\x18\n" +
+	"\agroupId\x18\x01 \x01(\tR\agroupId\x12\x1c\n" +
+	"\tgroupName\x18\x02 \x01(\tR\tgroupName\x12,\n" +
+	"\x11commonLessonCount\x18\x03 \x01(\x05R\x11commonLessonCount\x12\x16\n" +
+	"\x06amount\x18\x04 \x01(\tR\x06amount\x123\n" +
+	"\bstudents\x18\x05 \x03(\v2\x17.finance.PayslipStudentR\bstudents\"\xb4\x01\n" +
+	"\x0ePayslipStudent\x12\x1c\n" +
+	"\tstudentId\x18\x01 \x01(\tR\tstudentId\x12 \n" +
+	"\vstudentName\x18\x02 \x01(\tR\vstudentName\x12,\n" +
+	"\x11passedLessonCount\x18\x03 \x01(\x05R\x11passedLessonCount\x12\x16\n" +
+	"\x06amount\x18\x04 \x01(\tR\x06amount\x12\x1c\n" +
+	"\tpriceType\x18\x05 \x01(\tR\tpriceType\"\x8a\x01\n" +
+	"\x14AbsPayrollAdjustment\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04type\x18\x02 \x01(\tR\x04type\x12\x16\n" +
+	"\x06amount\x18\x03 \x01(\tR\x06amount\x12\x18\n" +
+	"\acomment\x18\x04 \x01(\tR\acomment\x12\x1c\n" +
+	"\tcreatedAt\x18\x05 \x01(\tR\tcreatedAt\"\x87\x04\n" +
+	"\x1cCreateInstallmentPlanRequest\x12\x1c\n" +
+	"\tstudentId\x18\x01 \x01(\tR\tstudentId\x12\x18\n" +
+	"\agroupId\x18\x02 \x01(\tR\agroupId\x12\x1a\n" +
+	"\bplanType\x18\x03 \x01(\tR\bplanType\x12 \n" +
+	"\vtotalAmount\x18\x04 \x01(\tR\vtotalAmount\x12\x1c\n" +
+	"\tstartDate\x18\x05 \x01(\tR\tstartDate\x12 \n" +
+	"\vmonthsCount\x18\x06 \x01(\x05R\vmonthsCount\x12=\n" +
+	"\finstallments\x18\a \x03(\v2\x19.finance.InstallmentInputR\finstallments\x12$\n" +
+	"\rlateFeeAmount\x18\b \x01(\tR\rlateFeeAmount\x12\x1c\n" +
+	"\tgraceDays\x18\t \x01(\x05R\tgraceDays\x122\n" +
+	"\x14earlyDiscountPercent\x18\n" +
+	" \x01(\x01R\x14earlyDiscountPercent\x12\x1c\n" +
+	"\tearlyDays\x18\v \x01(\x05R\tearlyDays\x12\x18\n" +
+	"\acomment\x18\f \x01(\tR\acomment\x12\x1e\n" +
+	"\n" +
+	"actionById\x18\r \x01(\tR\n" +
+	"actionById\x12\"\n" +
+	"\factionByName\x18\x0e \x01(\tR\factionByName\"D\n" +
+	"\x10InstallmentInput\x12\x18\n" +
+	"\adueDate\x18\x01 \x01(\tR\adueDate\x12\x16\n" +
+	"\x06amount\x18\x02 \x01(\tR\x06amount\":\n" +
+	"\x1aGetInstallmentPlansRequest\x12\x1c\n" +
+	"\tstudentId\x18\x01 \x01(\tR\tstudentId\"P\n" +
+	"\x1bGetInstallmentPlansResponse\x121\n" +
+	"\x05plans\x18\x01 \x03(\v2\x1b.finance.AbsInstallmentPlanR\x05plans\"2\n" +
+	"\x18InstallmentPlanIdRequest\x12\x16\n" +
+	"\x06planId\x18\x01 \x01(\tR\x06planId\"z\n" +
+	"\x1cCancelInstallmentPlanRequest\x12\x16\n" +
+	"\x06planId\x18\x01 \x01(\tR\x06planId\x12\x1e\n" +
+	"\n" +
+	"actionById\x18\x02 \x01(\tR\n" +
+	"actionById\x12\"\n" +
+	"\factionByName\x18\x03 \x01(\tR\factionByName\"\x83\x04\n" +
+	"\x12AbsInstallmentPlan\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1c\n" +
+	"\tstudentId\x18\x02 \x01(\tR\tstudentId\x12\x18\n" +
+	"\agroupId\x18\x03 \x01(\tR\agroupId\x12\x1a\n" +
+	"\bplanType\x18\x04 \x01(\tR\bplanType\x12 \n" +
+	"\vtotalAmount\x18\x05 \x01(\tR\vtotalAmount\x12\x1e\n" +
+	"\n" +
+	"paidAmount\x18\x06 \x01(\tR\n" +
+	"paidAmount\x12$\n" +
+	"\rlateFeeAmount\x18\a \x01(\tR\rlateFeeAmount\x12\x1c\n" +
+	"\tgraceDays\x18\b \x01(\x05R\tgraceDays\x122\n" +
+	"\x14earlyDiscountPercent\x18\t \x01(\x01R\x14earlyDiscountPercent\x12\x1c\n" +
+	"\tearlyDays\x18\n" +
+	" \x01(\x05R\tearlyDays\x12\x16\n" +
+	"\x06status\x18\v \x01(\tR\x06status\x12\x18\n" +
+	"\acomment\x18\f \x01(\tR\acomment\x12$\n" +
+	"\rcreatedByName\x18\r \x01(\tR\rcreatedByName\x12\x1c\n" +
+	"\tcreatedAt\x18\x0e \x01(\tR\tcreatedAt\x12;\n" +
+	"\finstallments\x18\x0f \x03(\v2\x17.finance.AbsInstallmentR\finstallments\"\x94\x02\n" +
+	"\x0eAbsInstallment\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x18\n" +
+	"\adueDate\x18\x02 \x01(\tR\adueDate\x12\x16\n" +
+	"\x06amount\x18\x03 \x01(\tR\x06amount\x12\x1e\n" +
+	"\n" +
+	"paidAmount\x18\x04 \x01(\tR\n" +
+	"paidAmount\x12&\n" +
+	"\x0ediscountAmount\x18\x05 \x01(\tR\x0ediscountAmount\x12\x1c\n" +
+	"\tisCharged\x18\x06 \x01(\bR\tisCharged\x12*\n" +
+	"\x10isLateFeeApplied\x18\a \x01(\bR\x10isLateFeeApplied\x12\x16\n" +
+	"\x06paidAt\x18\b \x01(\tR\x06paidAt\x12\x16\n" +
+	"\x06status\x18\t \x01(\tR\x06status\"g\n" +
+	"\x19ChargeInstallmentsRequest\x12\x1c\n" +
+	"\tstudentId\x18\x01 \x01(\tR\tstudentId\x12\x18\n" +
+	"\agroupId\x18\x02 \x01(\tR\agroupId\x12\x12\n" +
+	"\x04date\x18\x03 \x01(\tR\x04date\"Z\n" +
+	"\x1aChargeInstallmentsResponse\x12\x18\n" +
+	"\ahasPlan\x18\x01 \x01(\bR\ahasPlan\x12\"\n" +
+	"\fchargedCount\x18\x02 \x01(\x05R\fchargedCount\"K\n" +
+	"\x1dGetOverdueInstallmentsRequest\x12\x12\n" +
+	"\x04date\x18\x01 \x01(\tR\x04date\x12\x16\n" +
+	"\x06bucket\x18\x02 \x01(\tR\x06bucket\"\x96\x01\n" +
+	"\x1eGetOverdueInstallmentsResponse\x12B\n" +
+	"\finstallments\x18\x01 \x03(\v2\x1e.finance.AbsOverdueInstallmentR\finstallments\x120\n" +
+	"\abuckets\x18\x02 \x03(\v2\x16.finance.OverdueBucketR\abuckets\"\x93\x03\n" +
+	"\x15AbsOverdueInstallment\x12$\n" +
+	"\rinstallmentId\x18\x01 \x01(\tR\rinstallmentId\x12\x16\n" +
+	"\x06planId\x18\x02 \x01(\tR\x06planId\x12\x1c\n" +
+	"\tstudentId\x18\x03 \x01(\tR\tstudentId\x12 \n" +
+	"\vstudentName\x18\x04 \x01(\tR\vstudentName\x12 \n" +
+	"\vphoneNumber\x18\x05 \x01(\tR\vphoneNumber\x12\x18\n" +
+	"\agroupId\x18\x06 \x01(\tR\agroupId\x12\x18\n" +
+	"\adueDate\x18\a \x01(\tR\adueDate\x12\x16\n" +
+	"\x06amount\x18\b \x01(\tR\x06amount\x12(\n" +
+	"\x0fremainingAmount\x18\t \x01(\tR\x0fremainingAmount\x12 \n" +
+	"\vdaysOverdue\x18\n" +
+	" \x01(\x05R\vdaysOverdue\x12\x16\n" +
+	"\x06bucket\x18\v \x01(\tR\x06bucket\x12*\n" +
+	"\x10isLateFeeApplied\x18\f \x01(\bR\x10isLateFeeApplied\"U\n" +
+	"\rOverdueBucket\x12\x16\n" +
+	"\x06bucket\x18\x01 \x01(\tR\x06bucket\x12\x14\n" +
+	"\x05count\x18\x02 \x01(\x05R\x05count\x12\x16\n" +
+	"\x06amount\x18\x03 \x01(\tR\x06amount\"y\n" +
+	"\x13GetDebtAgingRequest\x12\x16\n" +
+	"\x06bucket\x18\x01 \x01(\tR\x06bucket\x12\"\n" +
+	"\fassignedToId\x18\x02 \x01(\tR\fassignedToId\x12\x12\n" +
+	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x12\n" +
+	"\x04size\x18\x04 \x01(\x05R\x04size\"\xa0\x01\n" +
+	"\x14GetDebtAgingResponse\x120\n" +
+	"\adebtors\x18\x01 \x03(\v2\x16.finance.AbsAgedDebtorR\adebtors\x12.\n" +
+	"\abuckets\x18\x02 \x03(\v2\x14.finance.AgingBucketR\abuckets\x12&\n" +
+	"\x0etotalPageCount\x18\x03 \x01(\x05R\x0etotalPageCount\"\xf7\x03\n" +
+	"\rAbsAgedDebtor\x12\x1c\n" +
+	"\tstudentId\x18\x01 \x01(\tR\tstudentId\x12 \n" +
+	"\vstudentName\x18\x02 \x01(\tR\vstudentName\x12 \n" +
+	"\vphoneNumber\x18\x03 \x01(\tR\vphoneNumber\x12\x18\n" +
+	"\abalance\x18\x04 \x01(\tR\abalance\x12\x1c\n" +
+	"\tdebtSince\x18\x05 \x01(\tR\tdebtSince\x12 \n" +
+	"\vdaysOverdue\x18\x06 \x01(\x05R\vdaysOverdue\x12\x16\n" +
+	"\x06bucket\x18\a \x01(\tR\x06bucket\x12\"\n" +
+	"\fassignedToId\x18\b \x01(\tR\fassignedToId\x12&\n" +
+	"\x0eassignedToName\x18\t \x01(\tR\x0eassignedToName\x12$\n" +
+	"\rfreezeFlagged\x18\n" +
+	" \x01(\bR\rfreezeFlagged\x12$\n" +
+	"\rlastContactAt\x18\v \x01(\tR\rlastContactAt\x12&\n" +
+	"\x0epromisedAmount\x18\f \x01(\tR\x0epromisedAmount\x12\"\n" +
+	"\fpromisedDate\x18\r \x01(\tR\fpromisedDate\x12.\n" +
+	"\x12lastReminderBucket\x18\x0e \x01(\tR\x12lastReminderBucket\"S\n" +
+	"\vAgingBucket\x12\x16\n" +
+	"\x06bucket\x18\x01 \x01(\tR\x06bucket\x12\x14\n" +
+	"\x05count\x18\x02 \x01(\x05R\x05count\x12\x16\n" +
+	"\x06amount\x18\x03 \x01(\tR\x06amount\"W\n" +
+	"\x13AssignDebtorRequest\x12\x1c\n" +
+	"\tstudentId\x18\x01 \x01(\tR\tstudentId\x12\"\n" +
+	"\fassignedToId\x18\x02 \x01(\tR\fassignedToId\"\xfa\x01\n" +
+	"\x1cAddCollectionActivityRequest\x12\x1c\n" +
+	"\tstudentId\x18\x01 \x01(\tR\tstudentId\x12\x12\n" +
+	"\x04type\x18\x02 \x01(\tR\x04type\x12\x18\n" +
+	"\acomment\x18\x03 \x01(\tR\acomment\x12&\n" +
+	"\x0epromisedAmount\x18\x04 \x01(\tR\x0epromisedAmount\x12\"\n" +
+	"\fpromisedDate\x18\x05 \x01(\tR\fpromisedDate\x12\x1e\n" +
+	"\n" +
+	"actionById\x18\x06 \x01(\tR\n" +
+	"actionById\x12\"\n" +
+	"\factionByName\x18\a \x01(\tR\factionByName\">\n" +
+	"\x1eGetCollectionActivitiesRequest\x12\x1c\n" +
+	"\tstudentId\x18\x01 \x01(\tR\tstudentId\"a\n" +
+	"\x1fGetCollectionActivitiesResponse\x12>\n" +
+	"\n" +
+	"activities\x18\x01 \x03(\v2\x1e.finance.AbsCollectionActivityR\n" +
+	"activities\"\xa5\x02\n" +
+	"\x15AbsCollectionActivity\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1c\n" +
+	"\tstudentId\x18\x02 \x01(\tR\tstudentId\x12\x12\n" +
+	"\x04type\x18\x03 \x01(\tR\x04type\x12\x18\n" +
+	"\acomment\x18\x04 \x01(\tR\acomment\x12&\n" +
+	"\x0epromisedAmount\x18\x05 \x01(\tR\x0epromisedAmount\x12\"\n" +
+	"\fpromisedDate\x18\x06 \x01(\tR\fpromisedDate\x12 \n" +
+	"\vcreatedById\x18\a \x01(\tR\vcreatedById\x12$\n" +
+	"\rcreatedByName\x18\b \x01(\tR\rcreatedByName\x12\x1c\n" +
+	"\tcreatedAt\x18\t \x01(\tR\tcreatedAt\"i\n" +
+	"\x11CollectionSetting\x12(\n" +
+	"\x0ffreezeAfterDays\x18\x01 \x01(\x05R\x0ffreezeAfterDays\x12*\n" +
+	"\x10remindersEnabled\x18\x02 \x01(\bR\x10remindersEnabled\"W\n" +
+	"\x17GetProfitAndLossRequest\x12\x12\n" +
+	"\x04from\x18\x01 \x01(\tR\x04from\x12\x0e\n" +
+	"\x02to\x18\x02 \x01(\tR\x02to\x12\x18\n" +
+	"\agroupBy\x18\x03 \x01(\tR\agroupBy\"\xbd\x01\n" +
+	"\x18GetProfitAndLossResponse\x12)\n" +
+	"\x06months\x18\x01 \x03(\v2\x11.finance.PnlMonthR\x06months\x12'\n" +
+	"\x05total\x18\x02 \x01(\v2\x11.finance.PnlMonthR\x05total\x12\x18\n" +
+	"\agroupBy\x18\x03 \x01(\tR\agroupBy\x123\n" +
+	"\tbreakdown\x18\x04 \x03(\v2\x15.finance.PnlBreakdownR\tbreakdown\"\xf4\x01\n" +
+	"\bPnlMonth\x12\x16\n" +
+	"\x06period\x18\x01 \x01(\tR\x06period\x12\x18\n" +
+	"\arevenue\x18\x02 \x01(\x01R\arevenue\x12\x1a\n" +
+	"\bpayments\x18\x03 \x01(\x01R\bpayments\x12\x1c\n" +
+	"\tdiscounts\x18\x04 \x01(\x01R\tdiscounts\x12 \n" +
+	"\vteacherCost\x18\x05 \x01(\x01R\vteacherCost\x12\x1a\n" +
+	"\bexpenses\x18\x06 \x01(\x01R\bexpenses\x12 \n" +
+	"\vgrossProfit\x18\a \x01(\x01R\vgrossProfit\x12\x1c\n" +
+	"\tnetProfit\x18\b \x01(\x01R\tnetProfit\"\xc6\x01\n" +
+	"\fPnlBreakdown\x12\x16\n" +
+	"\x06period\x18\x01 \x01(\tR\x06period\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x18\n" +
+	"\arevenue\x18\x04 \x01(\x01R\arevenue\x12\x1c\n" +
+	"\tdiscounts\x18\x05 \x01(\x01R\tdiscounts\x12 \n" +
+	"\vteacherCost\x18\x06 \x01(\x01R\vteacherCost\x12 \n" +
+	"\vgrossProfit\x18\a \x01(\x01R\vgrossProfit\"\xb2\x01\n" +
+	"\vOneCSetting\x12*\n" +
+	"\x10organizationName\x18\x01 \x01(\tR\x10organizationName\x12\x10\n" +
+	"\x03inn\x18\x02 \x01(\tR\x03inn\x12\x1a\n" +
+	"\bbankName\x18\x03 \x01(\tR\bbankName\x12\x10\n" +
+	"\x03bik\x18\x04 \x01(\tR\x03bik\x127\n" +
+	"\bmappings\x18\x05 \x03(\v2\x1b.finance.OneCAccountMappingR\bmappings\"l\n" +
+	"\x12OneCAccountMapping\x12\x1e\n" +
+	"\n" +
+	"sourceType\x18\x01 \x01(\tR\n" +
+	"sourceType\x12\x1c\n" +
+	"\tsourceKey\x18\x02 \x01(\tR\tsourceKey\x12\x18\n" +
+	"\aaccount\x18\x03 \x01(\tR\aaccount\"7\n" +
+	"\x11ExportOneCRequest\x12\x12\n" +
+	"\x04from\x18\x01 \x01(\tR\x04from\x12\x0e\n" +
+	"\x02to\x18\x02 \x01(\tR\x02to\"p\n" +
+	"\x12ExportOneCResponse\x12\x1a\n" +
+	"\bfileName\x18\x01 \x01(\tR\bfileName\x12\x18\n" +
+	"\acontent\x18\x02 \x01(\fR\acontent\x12$\n" +
+	"\rdocumentCount\x18\x03 \x01(\x05R\rdocumentCount\"\xc2\x01\n" +
+	"\x16ImportStatementRequest\x12\x16\n" +
+	"\x06source\x18\x01 \x01(\tR\x06source\x12\x16\n" +
+	"\x06method\x18\x02 \x01(\tR\x06method\x12\x1a\n" +
+	"\bfileName\x18\x03 \x01(\tR\bfileName\x12\x18\n" +
+	"\acontent\x18\x04 \x01(\fR\acontent\x12\x1e\n" +
+	"\n" +
+	"actionById\x18\x05 \x01(\tR\n" +
+	"actionById\x12\"\n" +
+	"\factionByName\x18\x06 \x01(\tR\factionByName\"\xc6\x03\n" +
+	"\x15ReconciliationSession\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x16\n" +
+	"\x06source\x18\x02 \x01(\tR\x06source\x12\x16\n" +
+	"\x06method\x18\x03 \x01(\tR\x06method\x12\x1a\n" +
+	"\bfileName\x18\x04 \x01(\tR\bfileName\x12$\n" +
+	"\rcreatedByName\x18\x05 \x01(\tR\rcreatedByName\x12\x1c\n" +
+	"\tcreatedAt\x18\x06 \x01(\tR\tcreatedAt\x12\x1e\n" +
+	"\n" +
+	"totalCount\x18\a \x01(\x05R\n" +
+	"totalCount\x12\"\n" +
+	"\fmatchedCount\x18\b \x01(\x05R\fmatchedCount\x12&\n" +
+	"\x0eunmatchedCount\x18\t \x01(\x05R\x0eunmatchedCount\x12(\n" +
+	"\x0fsuspiciousCount\x18\n" +
+	" \x01(\x05R\x0fsuspiciousCount\x12\"\n" +
+	"\fcreatedCount\x18\v \x01(\x05R\fcreatedCount\x12 \n" +
+	"\vtotalAmount\x18\f \x01(\x01R\vtotalAmount\x121\n" +
+	"\x05lines\x18\r \x03(\v2\x1b.finance.ReconciliationLineR\x05lines\"\xf2\x02\n" +
+	"\x12ReconciliationLine\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x16\n" +
+	"\x06lineNo\x18\x02 \x01(\x05R\x06lineNo\x12$\n" +
+	"\rtransactionId\x18\x03 \x01(\tR\rtransactionId\x12\x16\n" +
+	"\x06amount\x18\x04 \x01(\x01R\x06amount\x12\x16\n" +
+	"\x06paidAt\x18\x05 \x01(\tR\x06paidAt\x12\x14\n" +
+	"\x05phone\x18\x06 \x01(\tR\x05phone\x12\x1c\n" +
+	"\tpayerName\x18\a \x01(\tR\tpayerName\x12 \n" +
+	"\vdescription\x18\b \x01(\tR\vdescription\x12\x16\n" +
+	"\x06status\x18\t \x01(\tR\x06status\x12\x1c\n" +
+	"\tpaymentId\x18\n" +
+	" \x01(\tR\tpaymentId\x12\x1c\n" +
+	"\tstudentId\x18\v \x01(\tR\tstudentId\x12 \n" +
+	"\vstudentName\x18\f \x01(\tR\vstudentName\x12\x12\n" +
+	"\x04note\x18\r \x01(\tR\x04note\"J\n" +
+	" GetReconciliationSessionsRequest\x12\x12\n" +
+	"\x04page\x18\x01 \x01(\x05R\x04page\x12\x12\n" +
+	"\x04size\x18\x02 \x01(\x05R\x04size\"\x7f\n" +
+	"!GetReconciliationSessionsResponse\x12:\n" +
+	"\bsessions\x18\x01 \x03(\v2\x1e.finance.ReconciliationSessionR\bsessions\x12\x1e\n" +
+	"\n" +
+	"totalCount\x18\x02 \x01(\x05R\n" +
+	"totalCount\"I\n" +
+	"\x1fGetReconciliationSessionRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\"\xba\x01\n" +
+	"\x1cCreateMissingPaymentsRequest\x12\x1c\n" +
+	"\tsessionId\x18\x01 \x01(\tR\tsessionId\x128\n" +
+	"\x05lines\x18\x02 \x03(\v2\".finance.ReconciliationLineStudentR\x05lines\x12\x1e\n" +
+	"\n" +
+	"actionById\x18\x03 \x01(\tR\n" +
+	"actionById\x12\"\n" +
+	"\factionByName\x18\x04 \x01(\tR\factionByName\"k\n" +
+	"\x19ReconciliationLineStudent\x12\x16\n" +
+	"\x06lineId\x18\x01 \x01(\tR\x06lineId\x12\x1c\n" +
+	"\tstudentId\x18\x02 \x01(\tR\tstudentId\x12\x18\n" +
+	"\agroupId\x18\x03 \x01(\tR\agroupId\"\\\n" +
+	"\x1dCreateMissingPaymentsResponse\x12;\n" +
+	"\aresults\x18\x01 \x03(\v2!.finance.ReconciliationLineResultR\aresults\"\x84\x01\n" +
+	"\x18ReconciliationLineResult\x12\x16\n" +
+	"\x06lineId\x18\x01 \x01(\tR\x06lineId\x12\x18\n" +
+	"\asuccess\x18\x02 \x01(\bR\asuccess\x12\x1c\n" +
+	"\tpaymentId\x18\x03 \x01(\tR\tpaymentId\x12\x18\n" +
+	"\amessage\x18\x04 \x01(\tR\amessage\"p\n" +
+	" ResolveReconciliationLineRequest\x12\x16\n" +
+	"\x06lineId\x18\x01 \x01(\tR\x06lineId\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\x12\x1c\n" +
+	"\tpaymentId\x18\x03 \x01(\tR\tpaymentId\"\x93\x01\n" +
+	"\x17CreateChargeItemRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x14\n" +
+	"\x05price\x18\x02 \x01(\x01R\x05price\x12\x1e\n" +
+	"\n" +
+	"isPhysical\x18\x03 \x01(\bR\n" +
+	"isPhysical\x12\x14\n" +
+	"\x05stock\x18\x04 \x01(\x05R\x05stock\x12\x18\n" +
+	"\acomment\x18\x05 \x01(\tR\acomment\"\xd3\x01\n" +
+	"\rAbsChargeItem\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x14\n" +
+	"\x05price\x18\x03 \x01(\x01R\x05price\x12\x1e\n" +
+	"\n" +
+	"isPhysical\x18\x04 \x01(\bR\n" +
+	"isPhysical\x12\x14\n" +
+	"\x05stock\x18\x05 \x01(\x05R\x05stock\x12\x18\n" +
+	"\acomment\x18\x06 \x01(\tR\acomment\x12\x1a\n" +
+	"\bisActive\x18\a \x01(\bR\bisActive\x12\x1c\n" +
+	"\tcreatedAt\x18\b \x01(\tR\tcreatedAt\"A\n" +
+	"\x15GetChargeItemsRequest\x12(\n" +
+	"\x0fincludeInactive\x18\x01 \x01(\bR\x0fincludeInactive\"F\n" +
+	"\x16GetChargeItemsResponse\x12,\n" +
+	"\x05items\x18\x01 \x03(\v2\x16.finance.AbsChargeItemR\x05items\"\xf2\x01\n" +
+	"\x14ChargeStudentRequest\x12\x1c\n" +
+	"\tstudentId\x18\x01 \x01(\tR\tstudentId\x12\x18\n" +
+	"\agroupId\x18\x02 \x01(\tR\agroupId\x12\x12\n" +
+	"\x04date\x18\x03 \x01(\tR\x04date\x12\x18\n" +
+	"\acomment\x18\x04 \x01(\tR\acomment\x120\n" +
+	"\x05lines\x18\x05 \x03(\v2\x1a.finance.ChargeStudentLineR\x05lines\x12\x1e\n" +
+	"\n" +
+	"actionById\x18\x06 \x01(\tR\n" +
+	"actionById\x12\"\n" +
+	"\factionByName\x18\a \x01(\tR\factionByName\"G\n" +
+	"\x11ChargeStudentLine\x12\x16\n" +
+	"\x06itemId\x18\x01 \x01(\tR\x06itemId\x12\x1a\n" +
+	"\bquantity\x18\x02 \x01(\x05R\bquantity\"8\n" +
+	"\x18GetStudentChargesRequest\x12\x1c\n" +
+	"\tstudentId\x18\x01 \x01(\tR\tstudentId\"P\n" +
+	"\x19GetStudentChargesResponse\x123\n" +
+	"\acharges\x18\x01 \x03(\v2\x19.finance.AbsStudentChargeR\acharges\"\xb1\x02\n" +
+	"\x10AbsStudentCharge\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1c\n" +
+	"\tstudentId\x18\x02 \x01(\tR\tstudentId\x12\x1c\n" +
+	"\tpaymentId\x18\x03 \x01(\tR\tpaymentId\x12\x1c\n" +
+	"\tgivenDate\x18\x04 \x01(\tR\tgivenDate\x12 \n" +
+	"\vtotalAmount\x18\x05 \x01(\x01R\vtotalAmount\x12\x18\n" +
+	"\acomment\x18\x06 \x01(\tR\acomment\x12$\n" +
+	"\rcreatedByName\x18\a \x01(\tR\rcreatedByName\x12\x1c\n" +
+	"\tcreatedAt\x18\b \x01(\tR\tcreatedAt\x123\n" +
+	"\x05lines\x18\t \x03(\v2\x1d.finance.AbsStudentChargeLineR\x05lines\"\x9c\x01\n" +
+	"\x14AbsStudentChargeLine\x12\x16\n" +
+	"\x06itemId\x18\x01 \x01(\tR\x06itemId\x12\x1a\n" +
+	"\bitemName\x18\x02 \x01(\tR\bitemName\x12\x1a\n" +
+	"\bquantity\x18\x03 \x01(\x05R\bquantity\x12\x1c\n" +
+	"\tunitPrice\x18\x04 \x01(\x01R\tunitPrice\x12\x16\n" +
+	"\x06amount\x18\x05 \x01(\x01R\x06amount\"E\n" +
+	"\x1fGetChargeItemSalesReportRequest\x12\x12\n" +
+	"\x04from\x18\x01 \x01(\tR\x04from\x12\x0e\n" +
+	"\x02to\x18\x02 \x01(\tR\x02to\"\x9a\x01\n" +
+	" GetChargeItemSalesReportResponse\x12.\n" +
+	"\x05items\x18\x01 \x03(\v2\x18.finance.ChargeItemSalesR\x05items\x12$\n" +
+	"\rtotalQuantity\x18\x02 \x01(\x05R\rtotalQuantity\x12 \n" +
+	"\vtotalAmount\x18\x03 \x01(\x01R\vtotalAmount\"\xf5\x01\n" +
+	"\x0fChargeItemSales\x12\x16\n" +
+	"\x06itemId\x18\x01 \x01(\tR\x06itemId\x12\x1a\n" +
+	"\bitemName\x18\x02 \x01(\tR\bitemName\x12\x1e\n" +
+	"\n" +
+	"isPhysical\x18\x03 \x01(\bR\n" +
+	"isPhysical\x12\x14\n" +
+	"\x05stock\x18\x04 \x01(\x05R\x05stock\x12\x1a\n" +
+	"\bquantity\x18\x05 \x01(\x05R\bquantity\x12\x16\n" +
+	"\x06amount\x18\x06 \x01(\x01R\x06amount\x12 \n" +
+	"\vchargeCount\x18\a \x01(\x05R\vchargeCount\x12\"\n" +
+	"\fstudentCount\x18\b \x01(\x05R\fstudentCount2\x8d\x06\n" +
 	"\x0fDiscountService\x12l\n" +
 	"\x19GetAllInformationDiscount\x12&.finance.GetInformationDiscountRequest\x1a'.finance.GetInformationDiscountResponse\x12B\n" +
 	"\x0eCreateDiscount\x12\x1b.finance.AbsDiscountRequest\x1a\x13.common.AbsResponse\x12B\n" +
 	"\x0eDeleteDiscount\x12\x1b.finance.AbsDiscountRequest\x1a\x13.common.AbsResponse\x12]\n" +
-	"\x12GetHistoryDiscount\x12\".finance.GetHistoryDiscountRequest\x1a#.finance.GetHistoryDiscountResponse2\xe3\x01\n" +
+	"\x12GetHistoryDiscount\x12\".finance.GetHistoryDiscountRequest\x1a#.finance.GetHistoryDiscountResponse\x12C\n" +
+	"\x12CreateDiscountRule\x12\x18.finance.AbsDiscountRule\x1a\x13.common.AbsResponse\x12C\n" +
+	"\x12UpdateDiscountRule\x12\x18.finance.AbsDiscountRule\x1a\x13.common.AbsResponse\x12W\n" +
+	"\x10GetDiscountRules\x12 .finance.GetDiscountRulesRequest\x1a!.finance.GetDiscountRulesResponse\x12]\n" +
+	"\x12ApplyDiscountRules\x12\".finance.ApplyDiscountRulesRequest\x1a#.finance.ApplyDiscountRulesResponse\x12c\n" +
+	"\x15ApplyDiscountTemplate\x12%.finance.ApplyDiscountTemplateRequest\x1a#.finance.ApplyDiscountRulesResponse2\xb0\x02\n" +
 	"\x0fCategoryService\x12E\n" +
 	"\x0eCreateCategory\x12\x1e.finance.CreateCategoryRequest\x1a\x13.common.AbsResponse\x12?\n" +
 	"\x0eDeleteCategory\x12\x18.common.DeleteAbsRequest\x1a\x13.common.AbsResponse\x12H\n" +
-	"\x0eGetAllCategory\x12\x16.google.protobuf.Empty\x1a\x1e.finance.GetAllCategoryRequest2\xca\x02\n" +
+	"\x0eGetAllCategory\x12\x16.google.protobuf.Empty\x1a\x1e.finance.GetAllCategoryRequest\x12K\n" +
+	"\x11SetCategoryBudget\x12!.finance.SetCategoryBudgetRequest\x1a\x13.common.AbsResponse2\xfe\n" +
+	"\n" +
 	"\x0eExpenseService\x12C\n" +
 	"\rCreateExpense\x12\x1d.finance.CreateExpenseRequest\x1a\x13.common.AbsResponse\x12>\n" +
 	"\rDeleteExpense\x12\x18.common.DeleteAbsRequest\x1a\x13.common.AbsResponse\x12N\n" +
 	"\rGetAllExpense\x12\x1d.finance.GetAllExpenseRequest\x1a\x1e.finance.GetAllExpenseResponse\x12c\n" +
-	"\x14GetAllExpenseDiagram\x12$.finance.GetAllExpenseDiagramRequest\x1a%.finance.GetAllExpenseDiagramResponse2\xd9\b\n" +
+	"\x14GetAllExpenseDiagram\x12$.finance.GetAllExpenseDiagramRequest\x1a%.finance.GetAllExpenseDiagramResponse\x12U\n" +
+	"\x16CreateRecurringExpense\x12&.finance.CreateRecurringExpenseRequest\x1a\x13.common.AbsResponse\x12U\n" +
+	"\x14GetRecurringExpenses\x12\x16.google.protobuf.Empty\x1a%.finance.GetRecurringExpensesResponse\x12G\n" +
+	"\x16DeleteRecurringExpense\x12\x18.common.DeleteAbsRequest\x1a\x13.common.AbsResponse\x12D\n" +
+	"\x15PostRecurringExpenses\x12\x16.google.protobuf.Empty\x1a\x13.common.AbsResponse\x12T\n" +
+	"\x0fGetBudgetAlerts\x12\x1f.finance.GetBudgetAlertsRequest\x1a .finance.GetBudgetAlertsResponse\x12H\n" +
+	"\x0eGetExpenseById\x12\x1e.finance.GetExpenseByIdRequest\x1a\x16.finance.ExpenseDetail\x12C\n" +
+	"\rSubmitExpense\x12\x1d.finance.ExpenseActionRequest\x1a\x13.common.AbsResponse\x12D\n" +
+	"\x0eApproveExpense\x12\x1d.finance.ExpenseActionRequest\x1a\x13.common.AbsResponse\x12C\n" +
+	"\rRejectExpense\x12\x1d.finance.ExpenseActionRequest\x1a\x13.common.AbsResponse\x12E\n" +
+	"\x0fMarkExpensePaid\x12\x1d.finance.ExpenseActionRequest\x1a\x13.common.AbsResponse\x12K\n" +
+	"\x11AddExpenseReceipt\x12!.finance.AddExpenseReceiptRequest\x1a\x13.common.AbsResponse\x12E\n" +
+	"\x14DeleteExpenseReceipt\x12\x18.common.DeleteAbsRequest\x1a\x13.common.AbsResponse\x12T\n" +
+	"\x19GetExpenseApprovalSetting\x12\x16.google.protobuf.Empty\x1a\x1f.finance.ExpenseApprovalSetting\x12T\n" +
+	"\x1cUpdateExpenseApprovalSetting\x12\x1f.finance.ExpenseApprovalSetting\x1a\x13.common.AbsResponse2\xba\x02\n" +
+	"\rVendorService\x12A\n" +
+	"\fCreateVendor\x12\x1c.finance.CreateVendorRequest\x1a\x13.common.AbsResponse\x127\n" +
+	"\fUpdateVendor\x12\x12.finance.AbsVendor\x1a\x13.common.AbsResponse\x12E\n" +
+	"\n" +
+	"GetVendors\x12\x1a.finance.GetVendorsRequest\x1a\x1b.finance.GetVendorsResponse\x12f\n" +
+	"\x15GetVendorSpendHistory\x12%.finance.GetVendorSpendHistoryRequest\x1a&.finance.GetVendorSpendHistoryResponse2\x9c\t\n" +
 	"\x0ePaymentService\x12=\n" +
 	"\n" +
-	"PaymentAdd\x12\x1a.finance.PaymentAddRequest\x1a\x13.common.AbsResponse\x12C\n" +
+	"PaymentAdd\x12\x1a.finance.PaymentAddRequest\x1a\x13.common.AbsResponse\x12A\n" +
+	"\x0ePaymentTakeOff\x12\x1a.finance.PaymentAddRequest\x1a\x13.common.AbsResponse\x12C\n" +
 	"\rPaymentReturn\x12\x1d.finance.PaymentReturnRequest\x1a\x13.common.AbsResponse\x12C\n" +
 	"\rPaymentUpdate\x12\x1d.finance.PaymentUpdateRequest\x1a\x13.common.AbsResponse\x12W\n" +
 	"\x10GetMonthlyStatus\x12 .finance.GetMonthlyStatusRequest\x1a!.finance.GetMonthlyStatusResponse\x12f\n" +
@@ -3515,12 +12209,68 @@ const file_finance_proto_rawDesc = "" +
 	"\x1aGetAllStudentPaymentsChart\x12%.finance.GetAllStudentPaymentsRequest\x1a+.finance.GetAllStudentPaymentsChartResponse\x12^\n" +
 	"\x16GetAllDebtsInformation\x12\x1b.finance.GetAllDebtsRequest\x1a'.finance.GetAllDebtsInformationResponse\x12\\\n" +
 	"\x1bGetCommonFinanceInformation\x12\x16.google.protobuf.Empty\x1a%.finance.GetCommonInformationResponse\x12Q\n" +
-	"\x0eGetIncomeChart\x12\x1e.finance.GetIncomeChartRequest\x1a\x1f.finance.GetIncomeChartResponse2\xea\x02\n" +
+	"\x0eGetIncomeChart\x12\x1e.finance.GetIncomeChartRequest\x1a\x1f.finance.GetIncomeChartResponse2\xc7\x03\n" +
 	"\x14TeacherSalaryService\x12O\n" +
 	"\x13CreateTeacherSalary\x12#.finance.CreateTeacherSalaryRequest\x1a\x13.common.AbsResponse\x12O\n" +
 	"\x13DeleteTeacherSalary\x12#.finance.DeleteTeacherSalaryRequest\x1a\x13.common.AbsResponse\x12M\n" +
 	"\x10GetTeacherSalary\x12\x16.google.protobuf.Empty\x1a!.finance.GetTeachersSalaryRequest\x12a\n" +
-	"\x1bGetTeacherSalaryByTeacherID\x12#.finance.DeleteTeacherSalaryRequest\x1a\x1d.finance.AbsGetTeachersSalaryB\x0fZ\rgrpc/proto/pbb\x06proto3"
+	"\x1bGetTeacherSalaryByTeacherID\x12#.finance.DeleteTeacherSalaryRequest\x1a\x1d.finance.AbsGetTeachersSalary\x12[\n" +
+	"\x14ResolveTeacherSalary\x12$.finance.ResolveTeacherSalaryRequest\x1a\x1d.finance.AbsGetTeachersSalary2\x92\x03\n" +
+	"\x17AccountingPeriodService\x12D\n" +
+	"\vClosePeriod\x12 .finance.AccountingPeriodRequest\x1a\x13.common.AbsResponse\x12E\n" +
+	"\fReopenPeriod\x12 .finance.AccountingPeriodRequest\x1a\x13.common.AbsResponse\x12G\n" +
+	"\rGetAllPeriods\x12\x16.google.protobuf.Empty\x1a\x1e.finance.GetAllPeriodsResponse\x12W\n" +
+	"\x10GetPeriodHistory\x12 .finance.GetPeriodHistoryRequest\x1a!.finance.GetPeriodHistoryResponse\x12H\n" +
+	"\vCheckPeriod\x12\x1b.finance.CheckPeriodRequest\x1a\x1c.finance.CheckPeriodResponse2\x90\x06\n" +
+	"\x0ePayrollService\x12I\n" +
+	"\x10CreatePayrollRun\x12 .finance.CreatePayrollRunRequest\x1a\x13.common.AbsResponse\x12I\n" +
+	"\x0eGetPayrollRuns\x12\x16.google.protobuf.Empty\x1a\x1f.finance.GetPayrollRunsResponse\x12I\n" +
+	"\x11GetPayrollRunById\x12\x1c.finance.PayrollRunIdRequest\x1a\x16.finance.AbsPayrollRun\x12E\n" +
+	"\x10DeletePayrollRun\x12\x1c.finance.PayrollRunIdRequest\x1a\x13.common.AbsResponse\x12Q\n" +
+	"\x14AddPayrollAdjustment\x12$.finance.AddPayrollAdjustmentRequest\x1a\x13.common.AbsResponse\x12W\n" +
+	"\x17DeletePayrollAdjustment\x12'.finance.DeletePayrollAdjustmentRequest\x1a\x13.common.AbsResponse\x12J\n" +
+	"\x11ApprovePayrollRun\x12 .finance.PayrollRunActionRequest\x1a\x13.common.AbsResponse\x12C\n" +
+	"\rPayPayrollRun\x12\x1d.finance.PayPayrollRunRequest\x1a\x13.common.AbsResponse\x12:\n" +
+	"\n" +
+	"GetPayslip\x12\x1a.finance.GetPayslipRequest\x1a\x10.finance.Payslip\x12]\n" +
+	"\x12GetTeacherPayslips\x12\".finance.GetTeacherPayslipsRequest\x1a#.finance.GetTeacherPayslipsResponse2\xd3\x05\n" +
+	"\x12InstallmentService\x12S\n" +
+	"\x15CreateInstallmentPlan\x12%.finance.CreateInstallmentPlanRequest\x1a\x13.common.AbsResponse\x12`\n" +
+	"\x13GetInstallmentPlans\x12#.finance.GetInstallmentPlansRequest\x1a$.finance.GetInstallmentPlansResponse\x12X\n" +
+	"\x16GetInstallmentPlanById\x12!.finance.InstallmentPlanIdRequest\x1a\x1b.finance.AbsInstallmentPlan\x12S\n" +
+	"\x15CancelInstallmentPlan\x12%.finance.CancelInstallmentPlanRequest\x1a\x13.common.AbsResponse\x12]\n" +
+	"\x12ChargeInstallments\x12\".finance.ChargeInstallmentsRequest\x1a#.finance.ChargeInstallmentsResponse\x12G\n" +
+	"\x18ApplyInstallmentLateFees\x12\x16.google.protobuf.Empty\x1a\x13.common.AbsResponse\x12D\n" +
+	"\x15ChargeDueInstallments\x12\x16.google.protobuf.Empty\x1a\x13.common.AbsResponse\x12i\n" +
+	"\x16GetOverdueInstallments\x12&.finance.GetOverdueInstallmentsRequest\x1a'.finance.GetOverdueInstallmentsResponse2\xbd\x04\n" +
+	"\x11CollectionService\x12K\n" +
+	"\fGetDebtAging\x12\x1c.finance.GetDebtAgingRequest\x1a\x1d.finance.GetDebtAgingResponse\x12A\n" +
+	"\fAssignDebtor\x12\x1c.finance.AssignDebtorRequest\x1a\x13.common.AbsResponse\x12S\n" +
+	"\x15AddCollectionActivity\x12%.finance.AddCollectionActivityRequest\x1a\x13.common.AbsResponse\x12l\n" +
+	"\x17GetCollectionActivities\x12'.finance.GetCollectionActivitiesRequest\x1a(.finance.GetCollectionActivitiesResponse\x12J\n" +
+	"\x14GetCollectionSetting\x12\x16.google.protobuf.Empty\x1a\x1a.finance.CollectionSetting\x12J\n" +
+	"\x17UpdateCollectionSetting\x12\x1a.finance.CollectionSetting\x1a\x13.common.AbsResponse\x12=\n" +
+	"\x0eRunCollections\x12\x16.google.protobuf.Empty\x1a\x13.common.AbsResponse2h\n" +
+	"\rReportService\x12W\n" +
+	"\x10GetProfitAndLoss\x12 .finance.GetProfitAndLossRequest\x1a!.finance.GetProfitAndLossResponse2\xda\x01\n" +
+	"\x11OneCExportService\x12>\n" +
+	"\x0eGetOneCSetting\x12\x16.google.protobuf.Empty\x1a\x14.finance.OneCSetting\x12>\n" +
+	"\x11UpdateOneCSetting\x12\x14.finance.OneCSetting\x1a\x13.common.AbsResponse\x12E\n" +
+	"\n" +
+	"ExportOneC\x12\x1a.finance.ExportOneCRequest\x1a\x1b.finance.ExportOneCResponse2\x8a\x04\n" +
+	"\x15ReconciliationService\x12R\n" +
+	"\x0fImportStatement\x12\x1f.finance.ImportStatementRequest\x1a\x1e.finance.ReconciliationSession\x12r\n" +
+	"\x19GetReconciliationSessions\x12).finance.GetReconciliationSessionsRequest\x1a*.finance.GetReconciliationSessionsResponse\x12d\n" +
+	"\x18GetReconciliationSession\x12(.finance.GetReconciliationSessionRequest\x1a\x1e.finance.ReconciliationSession\x12f\n" +
+	"\x15CreateMissingPayments\x12%.finance.CreateMissingPaymentsRequest\x1a&.finance.CreateMissingPaymentsResponse\x12[\n" +
+	"\x19ResolveReconciliationLine\x12).finance.ResolveReconciliationLineRequest\x1a\x13.common.AbsResponse2\x80\x04\n" +
+	"\rChargeService\x12I\n" +
+	"\x10CreateChargeItem\x12 .finance.CreateChargeItemRequest\x1a\x13.common.AbsResponse\x12?\n" +
+	"\x10UpdateChargeItem\x12\x16.finance.AbsChargeItem\x1a\x13.common.AbsResponse\x12Q\n" +
+	"\x0eGetChargeItems\x12\x1e.finance.GetChargeItemsRequest\x1a\x1f.finance.GetChargeItemsResponse\x12C\n" +
+	"\rChargeStudent\x12\x1d.finance.ChargeStudentRequest\x1a\x13.common.AbsResponse\x12Z\n" +
+	"\x11GetStudentCharges\x12!.finance.GetStudentChargesRequest\x1a\".finance.GetStudentChargesResponse\x12o\n" +
+	"\x18GetChargeItemSalesReport\x12(.finance.GetChargeItemSalesReportRequest\x1a).finance.GetChargeItemSalesReportResponseB\x0fZ\rgrpc/proto/pbb\x06proto3"
 
 var (
 	file_finance_proto_rawDescOnce sync.Once
@@ -3534,146 +12284,437 @@ func file_finance_proto_rawDescGZIP() []byte {
 	return file_finance_proto_rawDescData
 }
 
-var file_finance_proto_msgTypes = make([]protoimpl.MessageInfo, 49)
+var file_finance_proto_msgTypes = make([]protoimpl.MessageInfo, 158)
 var file_finance_proto_goTypes = []any{
-	(*GetHistoryDiscountRequest)(nil),          // 0: finance.GetHistoryDiscountRequest
-	(*GetHistoryDiscountResponse)(nil),         // 1: finance.GetHistoryDiscountResponse
-	(*AbsHistoryDiscount)(nil),                 // 2: finance.AbsHistoryDiscount
-	(*AbsDiscountRequest)(nil),                 // 3: finance.AbsDiscountRequest
-	(*GetInformationDiscountRequest)(nil),      // 4: finance.GetInformationDiscountRequest
-	(*GetInformationDiscountResponse)(nil),     // 5: finance.GetInformationDiscountResponse
-	(*AbsStudentDiscount)(nil),                 // 6: finance.AbsStudentDiscount
-	(*CreateCategoryRequest)(nil),              // 7: finance.CreateCategoryRequest
-	(*GetAllCategoryRequest)(nil),              // 8: finance.GetAllCategoryRequest
-	(*AbsCategory)(nil),                        // 9: finance.AbsCategory
-	(*GetAllExpenseDiagramResponse)(nil),       // 10: finance.GetAllExpenseDiagramResponse
-	(*GetAllExpenseDiagramRequest)(nil),        // 11: finance.GetAllExpenseDiagramRequest
-	(*GetAllExpenseRequest)(nil),               // 12: finance.GetAllExpenseRequest
-	(*GetAllExpenseResponse)(nil),              // 13: finance.GetAllExpenseResponse
-	(*GetAllExpenseAbs)(nil),                   // 14: finance.GetAllExpenseAbs
-	(*CreateExpenseRequest)(nil),               // 15: finance.CreateExpenseRequest
-	(*GetIncomeChartRequest)(nil),              // 16: finance.GetIncomeChartRequest
-	(*GetIncomeChartResponse)(nil),             // 17: finance.GetIncomeChartResponse
-	(*AbsIncomeChart)(nil),                     // 18: finance.AbsIncomeChart
-	(*GetCommonInformationResponse)(nil),       // 19: finance.GetCommonInformationResponse
-	(*GetAllDebtsRequest)(nil),                 // 20: finance.GetAllDebtsRequest
-	(*GetAllDebtsInformationResponse)(nil),     // 21: finance.GetAllDebtsInformationResponse
-	(*AbsDebtsInformation)(nil),                // 22: finance.AbsDebtsInformation
-	(*DebtorGroup)(nil),                        // 23: finance.DebtorGroup
-	(*DebtorComment)(nil),                      // 24: finance.DebtorComment
-	(*GetAllStudentPaymentsChartResponse)(nil), // 25: finance.GetAllStudentPaymentsChartResponse
-	(*GetAllStudentPaymentsRequest)(nil),       // 26: finance.GetAllStudentPaymentsRequest
-	(*Filters)(nil),                            // 27: finance.Filters
-	(*SortBy)(nil),                             // 28: finance.SortBy
-	(*GetAllStudentPaymentsResponse)(nil),      // 29: finance.GetAllStudentPaymentsResponse
-	(*AbsStudentPayments)(nil),                 // 30: finance.AbsStudentPayments
-	(*GetAllPaymentTakeOffChartResponse)(nil),  // 31: finance.GetAllPaymentTakeOffChartResponse
-	(*AbsTakeOfChartResponse)(nil),             // 32: finance.AbsTakeOfChartResponse
-	(*GetAllPaymentTakeOffRequest)(nil),        // 33: finance.GetAllPaymentTakeOffRequest
-	(*GetAllPaymentTakeOffResponse)(nil),       // 34: finance.GetAllPaymentTakeOffResponse
-	(*AbsPaymentTakeOff)(nil),                  // 35: finance.AbsPaymentTakeOff
-	(*GetAllPaymentsByMonthRequest)(nil),       // 36: finance.GetAllPaymentsByMonthRequest
-	(*GetAllPaymentsByMonthResponse)(nil),      // 37: finance.GetAllPaymentsByMonthResponse
-	(*AbsGetAllPaymentsByMonthResponse)(nil),   // 38: finance.AbsGetAllPaymentsByMonthResponse
-	(*GetMonthlyStatusResponse)(nil),           // 39: finance.GetMonthlyStatusResponse
-	(*AbsGetMonthlyStatusResponse)(nil),        // 40: finance.AbsGetMonthlyStatusResponse
-	(*GetMonthlyStatusRequest)(nil),            // 41: finance.GetMonthlyStatusRequest
-	(*PaymentAddRequest)(nil),                  // 42: finance.PaymentAddRequest
-	(*PaymentUpdateRequest)(nil),               // 43: finance.PaymentUpdateRequest
-	(*PaymentReturnRequest)(nil),               // 44: finance.PaymentReturnRequest
-	(*GetTeachersSalaryRequest)(nil),           // 45: finance.GetTeachersSalaryRequest
-	(*AbsGetTeachersSalary)(nil),               // 46: finance.AbsGetTeachersSalary
-	(*DeleteTeacherSalaryRequest)(nil),         // 47: finance.DeleteTeacherSalaryRequest
-	(*CreateTeacherSalaryRequest)(nil),         // 48: finance.CreateTeacherSalaryRequest
-	(*PageRequest)(nil),                        // 49: common.PageRequest
-	(*GetUserByIdResponse)(nil),                // 50: user.GetUserByIdResponse
-	(*DeleteAbsRequest)(nil),                   // 51: common.DeleteAbsRequest
-	(*emptypb.Empty)(nil),                      // 52: google.protobuf.Empty
-	(*AbsResponse)(nil),                        // 53: common.AbsResponse
+	(*ApplyDiscountRulesRequest)(nil),          // 0: finance.ApplyDiscountRulesRequest
+	(*ApplyDiscountRulesResponse)(nil),         // 1: finance.ApplyDiscountRulesResponse
+	(*AbsDiscountRule)(nil),                    // 2: finance.AbsDiscountRule
+	(*GetDiscountRulesRequest)(nil),            // 3: finance.GetDiscountRulesRequest
+	(*GetDiscountRulesResponse)(nil),           // 4: finance.GetDiscountRulesResponse
+	(*ApplyDiscountTemplateRequest)(nil),       // 5: finance.ApplyDiscountTemplateRequest
+	(*GetHistoryDiscountRequest)(nil),          // 6: finance.GetHistoryDiscountRequest
+	(*GetHistoryDiscountResponse)(nil),         // 7: finance.GetHistoryDiscountResponse
+	(*AbsHistoryDiscount)(nil),                 // 8: finance.AbsHistoryDiscount
+	(*AbsDiscountRequest)(nil),                 // 9: finance.AbsDiscountRequest
+	(*GetInformationDiscountRequest)(nil),      // 10: finance.GetInformationDiscountRequest
+	(*GetInformationDiscountResponse)(nil),     // 11: finance.GetInformationDiscountResponse
+	(*AbsStudentDiscount)(nil),                 // 12: finance.AbsStudentDiscount
+	(*CreateCategoryRequest)(nil),              // 13: finance.CreateCategoryRequest
+	(*GetAllCategoryRequest)(nil),              // 14: finance.GetAllCategoryRequest
+	(*AbsCategory)(nil),                        // 15: finance.AbsCategory
+	(*SetCategoryBudgetRequest)(nil),           // 16: finance.SetCategoryBudgetRequest
+	(*GetAllExpenseDiagramResponse)(nil),       // 17: finance.GetAllExpenseDiagramResponse
+	(*CategoryBudget)(nil),                     // 18: finance.CategoryBudget
+	(*GetAllExpenseDiagramRequest)(nil),        // 19: finance.GetAllExpenseDiagramRequest
+	(*GetAllExpenseRequest)(nil),               // 20: finance.GetAllExpenseRequest
+	(*GetAllExpenseResponse)(nil),              // 21: finance.GetAllExpenseResponse
+	(*GetAllExpenseAbs)(nil),                   // 22: finance.GetAllExpenseAbs
+	(*CreateExpenseRequest)(nil),               // 23: finance.CreateExpenseRequest
+	(*CreateRecurringExpenseRequest)(nil),      // 24: finance.CreateRecurringExpenseRequest
+	(*GetRecurringExpensesResponse)(nil),       // 25: finance.GetRecurringExpensesResponse
+	(*AbsRecurringExpense)(nil),                // 26: finance.AbsRecurringExpense
+	(*GetBudgetAlertsRequest)(nil),             // 27: finance.GetBudgetAlertsRequest
+	(*GetBudgetAlertsResponse)(nil),            // 28: finance.GetBudgetAlertsResponse
+	(*AbsBudgetAlert)(nil),                     // 29: finance.AbsBudgetAlert
+	(*GetExpenseByIdRequest)(nil),              // 30: finance.GetExpenseByIdRequest
+	(*ExpenseDetail)(nil),                      // 31: finance.ExpenseDetail
+	(*ExpenseReceipt)(nil),                     // 32: finance.ExpenseReceipt
+	(*ExpenseStatusHistory)(nil),               // 33: finance.ExpenseStatusHistory
+	(*ExpenseActionRequest)(nil),               // 34: finance.ExpenseActionRequest
+	(*AddExpenseReceiptRequest)(nil),           // 35: finance.AddExpenseReceiptRequest
+	(*ExpenseApprovalSetting)(nil),             // 36: finance.ExpenseApprovalSetting
+	(*CreateVendorRequest)(nil),                // 37: finance.CreateVendorRequest
+	(*AbsVendor)(nil),                          // 38: finance.AbsVendor
+	(*GetVendorsRequest)(nil),                  // 39: finance.GetVendorsRequest
+	(*GetVendorsResponse)(nil),                 // 40: finance.GetVendorsResponse
+	(*GetVendorSpendHistoryRequest)(nil),       // 41: finance.GetVendorSpendHistoryRequest
+	(*GetVendorSpendHistoryResponse)(nil),      // 42: finance.GetVendorSpendHistoryResponse
+	(*VendorMonthSpend)(nil),                   // 43: finance.VendorMonthSpend
+	(*GetIncomeChartRequest)(nil),              // 44: finance.GetIncomeChartRequest
+	(*GetIncomeChartResponse)(nil),             // 45: finance.GetIncomeChartResponse
+	(*AbsIncomeChart)(nil),                     // 46: finance.AbsIncomeChart
+	(*GetCommonInformationResponse)(nil),       // 47: finance.GetCommonInformationResponse
+	(*GetAllDebtsRequest)(nil),                 // 48: finance.GetAllDebtsRequest
+	(*GetAllDebtsInformationResponse)(nil),     // 49: finance.GetAllDebtsInformationResponse
+	(*AbsDebtsInformation)(nil),                // 50: finance.AbsDebtsInformation
+	(*DebtorGroup)(nil),                        // 51: finance.DebtorGroup
+	(*DebtorComment)(nil),                      // 52: finance.DebtorComment
+	(*GetAllStudentPaymentsChartResponse)(nil), // 53: finance.GetAllStudentPaymentsChartResponse
+	(*GetAllStudentPaymentsRequest)(nil),       // 54: finance.GetAllStudentPaymentsRequest
+	(*Filters)(nil),                            // 55: finance.Filters
+	(*SortBy)(nil),                             // 56: finance.SortBy
+	(*GetAllStudentPaymentsResponse)(nil),      // 57: finance.GetAllStudentPaymentsResponse
+	(*AbsStudentPayments)(nil),                 // 58: finance.AbsStudentPayments
+	(*GetAllPaymentTakeOffChartResponse)(nil),  // 59: finance.GetAllPaymentTakeOffChartResponse
+	(*AbsTakeOfChartResponse)(nil),             // 60: finance.AbsTakeOfChartResponse
+	(*GetAllPaymentTakeOffRequest)(nil),        // 61: finance.GetAllPaymentTakeOffRequest
+	(*GetAllPaymentTakeOffResponse)(nil),       // 62: finance.GetAllPaymentTakeOffResponse
+	(*AbsPaymentTakeOff)(nil),                  // 63: finance.AbsPaymentTakeOff
+	(*GetAllPaymentsByMonthRequest)(nil),       // 64: finance.GetAllPaymentsByMonthRequest
+	(*GetAllPaymentsByMonthResponse)(nil),      // 65: finance.GetAllPaymentsByMonthResponse
+	(*AbsGetAllPaymentsByMonthResponse)(nil),   // 66: finance.AbsGetAllPaymentsByMonthResponse
+	(*GetMonthlyStatusResponse)(nil),           // 67: finance.GetMonthlyStatusResponse
+	(*AbsGetMonthlyStatusResponse)(nil),        // 68: finance.AbsGetMonthlyStatusResponse
+	(*GetMonthlyStatusRequest)(nil),            // 69: finance.GetMonthlyStatusRequest
+	(*PaymentAddRequest)(nil),                  // 70: finance.PaymentAddRequest
+	(*PaymentUpdateRequest)(nil),               // 71: finance.PaymentUpdateRequest
+	(*PaymentReturnRequest)(nil),               // 72: finance.PaymentReturnRequest
+	(*GetTeachersSalaryRequest)(nil),           // 73: finance.GetTeachersSalaryRequest
+	(*AbsGetTeachersSalary)(nil),               // 74: finance.AbsGetTeachersSalary
+	(*DeleteTeacherSalaryRequest)(nil),         // 75: finance.DeleteTeacherSalaryRequest
+	(*CreateTeacherSalaryRequest)(nil),         // 76: finance.CreateTeacherSalaryRequest
+	(*ResolveTeacherSalaryRequest)(nil),        // 77: finance.ResolveTeacherSalaryRequest
+	(*AccountingPeriodRequest)(nil),            // 78: finance.AccountingPeriodRequest
+	(*GetAllPeriodsResponse)(nil),              // 79: finance.GetAllPeriodsResponse
+	(*AbsAccountingPeriod)(nil),                // 80: finance.AbsAccountingPeriod
+	(*GetPeriodHistoryRequest)(nil),            // 81: finance.GetPeriodHistoryRequest
+	(*GetPeriodHistoryResponse)(nil),           // 82: finance.GetPeriodHistoryResponse
+	(*AbsPeriodHistory)(nil),                   // 83: finance.AbsPeriodHistory
+	(*CheckPeriodRequest)(nil),                 // 84: finance.CheckPeriodRequest
+	(*CheckPeriodResponse)(nil),                // 85: finance.CheckPeriodResponse
+	(*CreatePayrollRunRequest)(nil),            // 86: finance.CreatePayrollRunRequest
+	(*PayrollRunIdRequest)(nil),                // 87: finance.PayrollRunIdRequest
+	(*GetPayrollRunsResponse)(nil),             // 88: finance.GetPayrollRunsResponse
+	(*AbsPayrollRun)(nil),                      // 89: finance.AbsPayrollRun
+	(*AbsPayrollItem)(nil),                     // 90: finance.AbsPayrollItem
+	(*AddPayrollAdjustmentRequest)(nil),        // 91: finance.AddPayrollAdjustmentRequest
+	(*DeletePayrollAdjustmentRequest)(nil),     // 92: finance.DeletePayrollAdjustmentRequest
+	(*PayrollRunActionRequest)(nil),            // 93: finance.PayrollRunActionRequest
+	(*PayPayrollRunRequest)(nil),               // 94: finance.PayPayrollRunRequest
+	(*GetPayslipRequest)(nil),                  // 95: finance.GetPayslipRequest
+	(*GetTeacherPayslipsRequest)(nil),          // 96: finance.GetTeacherPayslipsRequest
+	(*GetTeacherPayslipsResponse)(nil),         // 97: finance.GetTeacherPayslipsResponse
+	(*Payslip)(nil),                            // 98: finance.Payslip
+	(*PayslipGroup)(nil),                       // 99: finance.PayslipGroup
+	(*PayslipStudent)(nil),                     // 100: finance.PayslipStudent
+	(*AbsPayrollAdjustment)(nil),               // 101: finance.AbsPayrollAdjustment
+	(*CreateInstallmentPlanRequest)(nil),       // 102: finance.CreateInstallmentPlanRequest
+	(*InstallmentInput)(nil),                   // 103: finance.InstallmentInput
+	(*GetInstallmentPlansRequest)(nil),         // 104: finance.GetInstallmentPlansRequest
+	(*GetInstallmentPlansResponse)(nil),        // 105: finance.GetInstallmentPlansResponse
+	(*InstallmentPlanIdRequest)(nil),           // 106: finance.InstallmentPlanIdRequest
+	(*CancelInstallmentPlanRequest)(nil),       // 107: finance.CancelInstallmentPlanRequest
+	(*AbsInstallmentPlan)(nil),                 // 108: finance.AbsInstallmentPlan
+	(*AbsInstallment)(nil),                     // 109: finance.AbsInstallment
+	(*ChargeInstallmentsRequest)(nil),          // 110: finance.ChargeInstallmentsRequest
+	(*ChargeInstallmentsResponse)(nil),         // 111: finance.ChargeInstallmentsResponse
+	(*GetOverdueInstallmentsRequest)(nil),      // 112: finance.GetOverdueInstallmentsRequest
+	(*GetOverdueInstallmentsResponse)(nil),     // 113: finance.GetOverdueInstallmentsResponse
+	(*AbsOverdueInstallment)(nil),              // 114: finance.AbsOverdueInstallment
+	(*OverdueBucket)(nil),                      // 115: finance.OverdueBucket
+	(*GetDebtAgingRequest)(nil),                // 116: finance.GetDebtAgingRequest
+	(*GetDebtAgingResponse)(nil),               // 117: finance.GetDebtAgingResponse
+	(*AbsAgedDebtor)(nil),                      // 118: finance.AbsAgedDebtor
+	(*AgingBucket)(nil),                        // 119: finance.AgingBucket
+	(*AssignDebtorRequest)(nil),                // 120: finance.AssignDebtorRequest
+	(*AddCollectionActivityRequest)(nil),       // 121: finance.AddCollectionActivityRequest
+	(*GetCollectionActivitiesRequest)(nil),     // 122: finance.GetCollectionActivitiesRequest
+	(*GetCollectionActivitiesResponse)(nil),    // 123: finance.GetCollectionActivitiesResponse
+	(*AbsCollectionActivity)(nil),              // 124: finance.AbsCollectionActivity
+	(*CollectionSetting)(nil),                  // 125: finance.CollectionSetting
+	(*GetProfitAndLossRequest)(nil),            // 126: finance.GetProfitAndLossRequest
+	(*GetProfitAndLossResponse)(nil),           // 127: finance.GetProfitAndLossResponse
+	(*PnlMonth)(nil),                           // 128: finance.PnlMonth
+	(*PnlBreakdown)(nil),                       // 129: finance.PnlBreakdown
+	(*OneCSetting)(nil),                        // 130: finance.OneCSetting
+	(*OneCAccountMapping)(nil),                 // 131: finance.OneCAccountMapping
+	(*ExportOneCRequest)(nil),                  // 132: finance.ExportOneCRequest
+	(*ExportOneCResponse)(nil),                 // 133: finance.ExportOneCResponse
+	(*ImportStatementRequest)(nil),             // 134: finance.ImportStatementRequest
+	(*ReconciliationSession)(nil),              // 135: finance.ReconciliationSession
+	(*ReconciliationLine)(nil),                 // 136: finance.ReconciliationLine
+	(*GetReconciliationSessionsRequest)(nil),   // 137: finance.GetReconciliationSessionsRequest
+	(*GetReconciliationSessionsResponse)(nil),  // 138: finance.GetReconciliationSessionsResponse
+	(*GetReconciliationSessionRequest)(nil),    // 139: finance.GetReconciliationSessionRequest
+	(*CreateMissingPaymentsRequest)(nil),       // 140: finance.CreateMissingPaymentsRequest
+	(*ReconciliationLineStudent)(nil),          // 141: finance.ReconciliationLineStudent
+	(*CreateMissingPaymentsResponse)(nil),      // 142: finance.CreateMissingPaymentsResponse
+	(*ReconciliationLineResult)(nil),           // 143: finance.ReconciliationLineResult
+	(*ResolveReconciliationLineRequest)(nil),   // 144: finance.ResolveReconciliationLineRequest
+	(*CreateChargeItemRequest)(nil),            // 145: finance.CreateChargeItemRequest
+	(*AbsChargeItem)(nil),                      // 146: finance.AbsChargeItem
+	(*GetChargeItemsRequest)(nil),              // 147: finance.GetChargeItemsRequest
+	(*GetChargeItemsResponse)(nil),             // 148: finance.GetChargeItemsResponse
+	(*ChargeStudentRequest)(nil),               // 149: finance.ChargeStudentRequest
+	(*ChargeStudentLine)(nil),                  // 150: finance.ChargeStudentLine
+	(*GetStudentChargesRequest)(nil),           // 151: finance.GetStudentChargesRequest
+	(*GetStudentChargesResponse)(nil),          // 152: finance.GetStudentChargesResponse
+	(*AbsStudentCharge)(nil),                   // 153: finance.AbsStudentCharge
+	(*AbsStudentChargeLine)(nil),               // 154: finance.AbsStudentChargeLine
+	(*GetChargeItemSalesReportRequest)(nil),    // 155: finance.GetChargeItemSalesReportRequest
+	(*GetChargeItemSalesReportResponse)(nil),   // 156: finance.GetChargeItemSalesReportResponse
+	(*ChargeItemSales)(nil),                    // 157: finance.ChargeItemSales
+	(*PageRequest)(nil),                        // 158: common.PageRequest
+	(*GetUserByIdResponse)(nil),                // 159: user.GetUserByIdResponse
+	(*DeleteAbsRequest)(nil),                   // 160: common.DeleteAbsRequest
+	(*emptypb.Empty)(nil),                      // 161: google.protobuf.Empty
+	(*AbsResponse)(nil),                        // 162: common.AbsResponse
 }
 var file_finance_proto_depIdxs = []int32{
-	2,  // 0: finance.GetHistoryDiscountResponse.discounts:type_name -> finance.AbsHistoryDiscount
-	6,  // 1: finance.GetInformationDiscountResponse.discounts:type_name -> finance.AbsStudentDiscount
-	9,  // 2: finance.GetAllCategoryRequest.categories:type_name -> finance.AbsCategory
-	49, // 3: finance.GetAllExpenseRequest.pageReq:type_name -> common.PageRequest
-	14, // 4: finance.GetAllExpenseResponse.expenses:type_name -> finance.GetAllExpenseAbs
-	9,  // 5: finance.GetAllExpenseAbs.category:type_name -> finance.AbsCategory
-	50, // 6: finance.GetAllExpenseAbs.user:type_name -> user.GetUserByIdResponse
-	50, // 7: finance.GetAllExpenseAbs.creator:type_name -> user.GetUserByIdResponse
-	18, // 8: finance.GetIncomeChartResponse.response:type_name -> finance.AbsIncomeChart
-	49, // 9: finance.GetAllDebtsRequest.pageParam:type_name -> common.PageRequest
-	22, // 10: finance.GetAllDebtsInformationResponse.debts:type_name -> finance.AbsDebtsInformation
-	23, // 11: finance.AbsDebtsInformation.groups:type_name -> finance.DebtorGroup
-	24, // 12: finance.AbsDebtsInformation.comments:type_name -> finance.DebtorComment
-	32, // 13: finance.GetAllStudentPaymentsChartResponse.paymentsChart:type_name -> finance.AbsTakeOfChartResponse
-	49, // 14: finance.GetAllStudentPaymentsRequest.page:type_name -> common.PageRequest
-	27, // 15: finance.GetAllStudentPaymentsRequest.filters:type_name -> finance.Filters
-	28, // 16: finance.GetAllStudentPaymentsRequest.sorts:type_name -> finance.SortBy
-	30, // 17: finance.GetAllStudentPaymentsResponse.payments:type_name -> finance.AbsStudentPayments
-	32, // 18: finance.GetAllPaymentTakeOffChartResponse.chartResponse:type_name -> finance.AbsTakeOfChartResponse
-	35, // 19: finance.GetAllPaymentTakeOffResponse.pennies:type_name -> finance.AbsPaymentTakeOff
-	38, // 20: finance.GetAllPaymentsByMonthResponse.payments:type_name -> finance.AbsGetAllPaymentsByMonthResponse
-	40, // 21: finance.GetMonthlyStatusResponse.monthStatus:type_name -> finance.AbsGetMonthlyStatusResponse
-	46, // 22: finance.GetTeachersSalaryRequest.salaries:type_name -> finance.AbsGetTeachersSalary
-	4,  // 23: finance.DiscountService.GetAllInformationDiscount:input_type -> finance.GetInformationDiscountRequest
-	3,  // 24: finance.DiscountService.CreateDiscount:input_type -> finance.AbsDiscountRequest
-	3,  // 25: finance.DiscountService.DeleteDiscount:input_type -> finance.AbsDiscountRequest
-	0,  // 26: finance.DiscountService.GetHistoryDiscount:input_type -> finance.GetHistoryDiscountRequest
-	7,  // 27: finance.CategoryService.CreateCategory:input_type -> finance.CreateCategoryRequest
-	51, // 28: finance.CategoryService.DeleteCategory:input_type -> common.DeleteAbsRequest
-	52, // 29: finance.CategoryService.GetAllCategory:input_type -> google.protobuf.Empty
-	15, // 30: finance.ExpenseService.CreateExpense:input_type -> finance.CreateExpenseRequest
-	51, // 31: finance.ExpenseService.DeleteExpense:input_type -> common.DeleteAbsRequest
-	12, // 32: finance.ExpenseService.GetAllExpense:input_type -> finance.GetAllExpenseRequest
-	11, // 33: finance.ExpenseService.GetAllExpenseDiagram:input_type -> finance.GetAllExpenseDiagramRequest
-	42, // 34: finance.PaymentService.PaymentAdd:input_type -> finance.PaymentAddRequest
-	44, // 35: finance.PaymentService.PaymentReturn:input_type -> finance.PaymentReturnRequest
-	43, // 36: finance.PaymentService.PaymentUpdate:input_type -> finance.PaymentUpdateRequest
-	41, // 37: finance.PaymentService.GetMonthlyStatus:input_type -> finance.GetMonthlyStatusRequest
-	36, // 38: finance.PaymentService.GetAllPaymentsByMonth:input_type -> finance.GetAllPaymentsByMonthRequest
-	33, // 39: finance.PaymentService.GetAllPaymentTakeOff:input_type -> finance.GetAllPaymentTakeOffRequest
-	33, // 40: finance.PaymentService.GetAllPaymentTakeOffChart:input_type -> finance.GetAllPaymentTakeOffRequest
-	26, // 41: finance.PaymentService.GetAllStudentPayments:input_type -> finance.GetAllStudentPaymentsRequest
-	26, // 42: finance.PaymentService.GetAllStudentPaymentsChart:input_type -> finance.GetAllStudentPaymentsRequest
-	20, // 43: finance.PaymentService.GetAllDebtsInformation:input_type -> finance.GetAllDebtsRequest
-	52, // 44: finance.PaymentService.GetCommonFinanceInformation:input_type -> google.protobuf.Empty
-	16, // 45: finance.PaymentService.GetIncomeChart:input_type -> finance.GetIncomeChartRequest
-	48, // 46: finance.TeacherSalaryService.CreateTeacherSalary:input_type -> finance.CreateTeacherSalaryRequest
-	47, // 47: finance.TeacherSalaryService.DeleteTeacherSalary:input_type -> finance.DeleteTeacherSalaryRequest
-	52, // 48: finance.TeacherSalaryService.GetTeacherSalary:input_type -> google.protobuf.Empty
-	47, // 49: finance.TeacherSalaryService.GetTeacherSalaryByTeacherID:input_type -> finance.DeleteTeacherSalaryRequest
-	5,  // 50: finance.DiscountService.GetAllInformationDiscount:output_type -> finance.GetInformationDiscountResponse
-	53, // 51: finance.DiscountService.CreateDiscount:output_type -> common.AbsResponse
-	53, // 52: finance.DiscountService.DeleteDiscount:output_type -> common.AbsResponse
-	1,  // 53: finance.DiscountService.GetHistoryDiscount:output_type -> finance.GetHistoryDiscountResponse
-	53, // 54: finance.CategoryService.CreateCategory:output_type -> common.AbsResponse
-	53, // 55: finance.CategoryService.DeleteCategory:output_type -> common.AbsResponse
-	8,  // 56: finance.CategoryService.GetAllCategory:output_type -> finance.GetAllCategoryRequest
-	53, // 57: finance.ExpenseService.CreateExpense:output_type -> common.AbsResponse
-	53, // 58: finance.ExpenseService.DeleteExpense:output_type -> common.AbsResponse
-	13, // 59: finance.ExpenseService.GetAllExpense:output_type -> finance.GetAllExpenseResponse
-	10, // 60: finance.ExpenseService.GetAllExpenseDiagram:output_type -> finance.GetAllExpenseDiagramResponse
-	53, // 61: finance.PaymentService.PaymentAdd:output_type -> common.AbsResponse
-	53, // 62: finance.PaymentService.PaymentReturn:output_type -> common.AbsResponse
-	53, // 63: finance.PaymentService.PaymentUpdate:output_type -> common.AbsResponse
-	39, // 64: finance.PaymentService.GetMonthlyStatus:output_type -> finance.GetMonthlyStatusResponse
-	37, // 65: finance.PaymentService.GetAllPaymentsByMonth:output_type -> finance.GetAllPaymentsByMonthResponse
-	34, // 66: finance.PaymentService.GetAllPaymentTakeOff:output_type -> finance.GetAllPaymentTakeOffResponse
-	31, // 67: finance.PaymentService.GetAllPaymentTakeOffChart:output_type -> finance.GetAllPaymentTakeOffChartResponse
-	29, // 68: finance.PaymentService.GetAllStudentPayments:output_type -> finance.GetAllStudentPaymentsResponse
-	25, // 69: finance.PaymentService.GetAllStudentPaymentsChart:output_type -> finance.GetAllStudentPaymentsChartResponse
-	21, // 70: finance.PaymentService.GetAllDebtsInformation:output_type -> finance.GetAllDebtsInformationResponse
-	19, // 71: finance.PaymentService.GetCommonFinanceInformation:output_type -> finance.GetCommonInformationResponse
-	17, // 72: finance.PaymentService.GetIncomeChart:output_type -> finance.GetIncomeChartResponse
-	53, // 73: finance.TeacherSalaryService.CreateTeacherSalary:output_type -> common.AbsResponse
-	53, // 74: finance.TeacherSalaryService.DeleteTeacherSalary:output_type -> common.AbsResponse
-	45, // 75: finance.TeacherSalaryService.GetTeacherSalary:output_type -> finance.GetTeachersSalaryRequest
-	46, // 76: finance.TeacherSalaryService.GetTeacherSalaryByTeacherID:output_type -> finance.AbsGetTeachersSalary
-	50, // [50:77] is the sub-list for method output_type
-	23, // [23:50] is the sub-list for method input_type
-	23, // [23:23] is the sub-list for extension type_name
-	23, // [23:23] is the sub-list for extension extendee
-	0,  // [0:23] is the sub-list for field type_name
+	2,   // 0: finance.GetDiscountRulesResponse.rules:type_name -> finance.AbsDiscountRule
+	8,   // 1: finance.GetHistoryDiscountResponse.discounts:type_name -> finance.AbsHistoryDiscount
+	12,  // 2: finance.GetInformationDiscountResponse.discounts:type_name -> finance.AbsStudentDiscount
+	15,  // 3: finance.GetAllCategoryRequest.categories:type_name -> finance.AbsCategory
+	18,  // 4: finance.GetAllExpenseDiagramResponse.budgets:type_name -> finance.CategoryBudget
+	158, // 5: finance.GetAllExpenseRequest.pageReq:type_name -> common.PageRequest
+	22,  // 6: finance.GetAllExpenseResponse.expenses:type_name -> finance.GetAllExpenseAbs
+	15,  // 7: finance.GetAllExpenseAbs.category:type_name -> finance.AbsCategory
+	159, // 8: finance.GetAllExpenseAbs.user:type_name -> user.GetUserByIdResponse
+	159, // 9: finance.GetAllExpenseAbs.creator:type_name -> user.GetUserByIdResponse
+	26,  // 10: finance.GetRecurringExpensesResponse.recurringExpenses:type_name -> finance.AbsRecurringExpense
+	29,  // 11: finance.GetBudgetAlertsResponse.alerts:type_name -> finance.AbsBudgetAlert
+	22,  // 12: finance.ExpenseDetail.expense:type_name -> finance.GetAllExpenseAbs
+	32,  // 13: finance.ExpenseDetail.receipts:type_name -> finance.ExpenseReceipt
+	33,  // 14: finance.ExpenseDetail.history:type_name -> finance.ExpenseStatusHistory
+	38,  // 15: finance.GetVendorsResponse.vendors:type_name -> finance.AbsVendor
+	38,  // 16: finance.GetVendorSpendHistoryResponse.vendor:type_name -> finance.AbsVendor
+	43,  // 17: finance.GetVendorSpendHistoryResponse.months:type_name -> finance.VendorMonthSpend
+	46,  // 18: finance.GetIncomeChartResponse.response:type_name -> finance.AbsIncomeChart
+	158, // 19: finance.GetAllDebtsRequest.pageParam:type_name -> common.PageRequest
+	50,  // 20: finance.GetAllDebtsInformationResponse.debts:type_name -> finance.AbsDebtsInformation
+	51,  // 21: finance.AbsDebtsInformation.groups:type_name -> finance.DebtorGroup
+	52,  // 22: finance.AbsDebtsInformation.comments:type_name -> finance.DebtorComment
+	60,  // 23: finance.GetAllStudentPaymentsChartResponse.paymentsChart:type_name -> finance.AbsTakeOfChartResponse
+	158, // 24: finance.GetAllStudentPaymentsRequest.page:type_name -> common.PageRequest
+	55,  // 25: finance.GetAllStudentPaymentsRequest.filters:type_name -> finance.Filters
+	56,  // 26: finance.GetAllStudentPaymentsRequest.sorts:type_name -> finance.SortBy
+	58,  // 27: finance.GetAllStudentPaymentsResponse.payments:type_name -> finance.AbsStudentPayments
+	60,  // 28: finance.GetAllPaymentTakeOffChartResponse.chartResponse:type_name -> finance.AbsTakeOfChartResponse
+	63,  // 29: finance.GetAllPaymentTakeOffResponse.pennies:type_name -> finance.AbsPaymentTakeOff
+	66,  // 30: finance.GetAllPaymentsByMonthResponse.payments:type_name -> finance.AbsGetAllPaymentsByMonthResponse
+	68,  // 31: finance.GetMonthlyStatusResponse.monthStatus:type_name -> finance.AbsGetMonthlyStatusResponse
+	74,  // 32: finance.GetTeachersSalaryRequest.salaries:type_name -> finance.AbsGetTeachersSalary
+	80,  // 33: finance.GetAllPeriodsResponse.periods:type_name -> finance.AbsAccountingPeriod
+	83,  // 34: finance.GetPeriodHistoryResponse.histories:type_name -> finance.AbsPeriodHistory
+	89,  // 35: finance.GetPayrollRunsResponse.runs:type_name -> finance.AbsPayrollRun
+	90,  // 36: finance.AbsPayrollRun.items:type_name -> finance.AbsPayrollItem
+	98,  // 37: finance.GetTeacherPayslipsResponse.payslips:type_name -> finance.Payslip
+	90,  // 38: finance.Payslip.item:type_name -> finance.AbsPayrollItem
+	99,  // 39: finance.Payslip.groups:type_name -> finance.PayslipGroup
+	101, // 40: finance.Payslip.adjustments:type_name -> finance.AbsPayrollAdjustment
+	100, // 41: finance.PayslipGroup.students:type_name -> finance.PayslipStudent
+	103, // 42: finance.CreateInstallmentPlanRequest.installments:type_name -> finance.InstallmentInput
+	108, // 43: finance.GetInstallmentPlansResponse.plans:type_name -> finance.AbsInstallmentPlan
+	109, // 44: finance.AbsInstallmentPlan.installments:type_name -> finance.AbsInstallment
+	114, // 45: finance.GetOverdueInstallmentsResponse.installments:type_name -> finance.AbsOverdueInstallment
+	115, // 46: finance.GetOverdueInstallmentsResponse.buckets:type_name -> finance.OverdueBucket
+	118, // 47: finance.GetDebtAgingResponse.debtors:type_name -> finance.AbsAgedDebtor
+	119, // 48: finance.GetDebtAgingResponse.buckets:type_name -> finance.AgingBucket
+	124, // 49: finance.GetCollectionActivitiesResponse.activities:type_name -> finance.AbsCollectionActivity
+	128, // 50: finance.GetProfitAndLossResponse.months:type_name -> finance.PnlMonth
+	128, // 51: finance.GetProfitAndLossResponse.total:type_name -> finance.PnlMonth
+	129, // 52: finance.GetProfitAndLossResponse.breakdown:type_name -> finance.PnlBreakdown
+	131, // 53: finance.OneCSetting.mappings:type_name -> finance.OneCAccountMapping
+	136, // 54: finance.ReconciliationSession.lines:type_name -> finance.ReconciliationLine
+	135, // 55: finance.GetReconciliationSessionsResponse.sessions:type_name -> finance.ReconciliationSession
+	141, // 56: finance.CreateMissingPaymentsRequest.lines:type_name -> finance.ReconciliationLineStudent
+	143, // 57: finance.CreateMissingPaymentsResponse.results:type_name -> finance.ReconciliationLineResult
+	146, // 58: finance.GetChargeItemsResponse.items:type_name -> finance.AbsChargeItem
+	150, // 59: finance.ChargeStudentRequest.lines:type_name -> finance.ChargeStudentLine
+	153, // 60: finance.GetStudentChargesResponse.charges:type_name -> finance.AbsStudentCharge
+	154, // 61: finance.AbsStudentCharge.lines:type_name -> finance.AbsStudentChargeLine
+	157, // 62: finance.GetChargeItemSalesReportResponse.items:type_name -> finance.ChargeItemSales
+	10,  // 63: finance.DiscountService.GetAllInformationDiscount:input_type -> finance.GetInformationDiscountRequest
+	9,   // 64: finance.DiscountService.CreateDiscount:input_type -> finance.AbsDiscountRequest
+	9,   // 65: finance.DiscountService.DeleteDiscount:input_type -> finance.AbsDiscountRequest
+	6,   // 66: finance.DiscountService.GetHistoryDiscount:input_type -> finance.GetHistoryDiscountRequest
+	2,   // 67: finance.DiscountService.CreateDiscountRule:input_type -> finance.AbsDiscountRule
+	2,   // 68: finance.DiscountService.UpdateDiscountRule:input_type -> finance.AbsDiscountRule
+	3,   // 69: finance.DiscountService.GetDiscountRules:input_type -> finance.GetDiscountRulesRequest
+	0,   // 70: finance.DiscountService.ApplyDiscountRules:input_type -> finance.ApplyDiscountRulesRequest
+	5,   // 71: finance.DiscountService.ApplyDiscountTemplate:input_type -> finance.ApplyDiscountTemplateRequest
+	13,  // 72: finance.CategoryService.CreateCategory:input_type -> finance.CreateCategoryRequest
+	160, // 73: finance.CategoryService.DeleteCategory:input_type -> common.DeleteAbsRequest
+	161, // 74: finance.CategoryService.GetAllCategory:input_type -> google.protobuf.Empty
+	16,  // 75: finance.CategoryService.SetCategoryBudget:input_type -> finance.SetCategoryBudgetRequest
+	23,  // 76: finance.ExpenseService.CreateExpense:input_type -> finance.CreateExpenseRequest
+	160, // 77: finance.ExpenseService.DeleteExpense:input_type -> common.DeleteAbsRequest
+	20,  // 78: finance.ExpenseService.GetAllExpense:input_type -> finance.GetAllExpenseRequest
+	19,  // 79: finance.ExpenseService.GetAllExpenseDiagram:input_type -> finance.GetAllExpenseDiagramRequest
+	24,  // 80: finance.ExpenseService.CreateRecurringExpense:input_type -> finance.CreateRecurringExpenseRequest
+	161, // 81: finance.ExpenseService.GetRecurringExpenses:input_type -> google.protobuf.Empty
+	160, // 82: finance.ExpenseService.DeleteRecurringExpense:input_type -> common.DeleteAbsRequest
+	161, // 83: finance.ExpenseService.PostRecurringExpenses:input_type -> google.protobuf.Empty
+	27,  // 84: finance.ExpenseService.GetBudgetAlerts:input_type -> finance.GetBudgetAlertsRequest
+	30,  // 85: finance.ExpenseService.GetExpenseById:input_type -> finance.GetExpenseByIdRequest
+	34,  // 86: finance.ExpenseService.SubmitExpense:input_type -> finance.ExpenseActionRequest
+	34,  // 87: finance.ExpenseService.ApproveExpense:input_type -> finance.ExpenseActionRequest
+	34,  // 88: finance.ExpenseService.RejectExpense:input_type -> finance.ExpenseActionRequest
+	34,  // 89: finance.ExpenseService.MarkExpensePaid:input_type -> finance.ExpenseActionRequest
+	35,  // 90: finance.ExpenseService.AddExpenseReceipt:input_type -> finance.AddExpenseReceiptRequest
+	160, // 91: finance.ExpenseService.DeleteExpenseReceipt:input_type -> common.DeleteAbsRequest
+	161, // 92: finance.ExpenseService.GetExpenseApprovalSetting:input_type -> google.protobuf.Empty
+	36,  // 93: finance.ExpenseService.UpdateExpenseApprovalSetting:input_type -> finance.ExpenseApprovalSetting
+	37,  // 94: finance.VendorService.CreateVendor:input_type -> finance.CreateVendorRequest
+	38,  // 95: finance.VendorService.UpdateVendor:input_type -> finance.AbsVendor
+	39,  // 96: finance.VendorService.GetVendors:input_type -> finance.GetVendorsRequest
+	41,  // 97: finance.VendorService.GetVendorSpendHistory:input_type -> finance.GetVendorSpendHistoryRequest
+	70,  // 98: finance.PaymentService.PaymentAdd:input_type -> finance.PaymentAddRequest
+	70,  // 99: finance.PaymentService.PaymentTakeOff:input_type -> finance.PaymentAddRequest
+	72,  // 100: finance.PaymentService.PaymentReturn:input_type -> finance.PaymentReturnRequest
+	71,  // 101: finance.PaymentService.PaymentUpdate:input_type -> finance.PaymentUpdateRequest
+	69,  // 102: finance.PaymentService.GetMonthlyStatus:input_type -> finance.GetMonthlyStatusRequest
+	64,  // 103: finance.PaymentService.GetAllPaymentsByMonth:input_type -> finance.GetAllPaymentsByMonthRequest
+	61,  // 104: finance.PaymentService.GetAllPaymentTakeOff:input_type -> finance.GetAllPaymentTakeOffRequest
+	61,  // 105: finance.PaymentService.GetAllPaymentTakeOffChart:input_type -> finance.GetAllPaymentTakeOffRequest
+	54,  // 106: finance.PaymentService.GetAllStudentPayments:input_type -> finance.GetAllStudentPaymentsRequest
+	54,  // 107: finance.PaymentService.GetAllStudentPaymentsChart:input_type -> finance.GetAllStudentPaymentsRequest
+	48,  // 108: finance.PaymentService.GetAllDebtsInformation:input_type -> finance.GetAllDebtsRequest
+	161, // 109: finance.PaymentService.GetCommonFinanceInformation:input_type -> google.protobuf.Empty
+	44,  // 110: finance.PaymentService.GetIncomeChart:input_type -> finance.GetIncomeChartRequest
+	76,  // 111: finance.TeacherSalaryService.CreateTeacherSalary:input_type -> finance.CreateTeacherSalaryRequest
+	75,  // 112: finance.TeacherSalaryService.DeleteTeacherSalary:input_type -> finance.DeleteTeacherSalaryRequest
+	161, // 113: finance.TeacherSalaryService.GetTeacherSalary:input_type -> google.protobuf.Empty
+	75,  // 114: finance.TeacherSalaryService.GetTeacherSalaryByTeacherID:input_type -> finance.DeleteTeacherSalaryRequest
+	77,  // 115: finance.TeacherSalaryService.ResolveTeacherSalary:input_type -> finance.ResolveTeacherSalaryRequest
+	78,  // 116: finance.AccountingPeriodService.ClosePeriod:input_type -> finance.AccountingPeriodRequest
+	78,  // 117: finance.AccountingPeriodService.ReopenPeriod:input_type -> finance.AccountingPeriodRequest
+	161, // 118: finance.AccountingPeriodService.GetAllPeriods:input_type -> google.protobuf.Empty
+	81,  // 119: finance.AccountingPeriodService.GetPeriodHistory:input_type -> finance.GetPeriodHistoryRequest
+	84,  // 120: finance.AccountingPeriodService.CheckPeriod:input_type -> finance.CheckPeriodRequest
+	86,  // 121: finance.PayrollService.CreatePayrollRun:input_type -> finance.CreatePayrollRunRequest
+	161, // 122: finance.PayrollService.GetPayrollRuns:input_type -> google.protobuf.Empty
+	87,  // 123: finance.PayrollService.GetPayrollRunById:input_type -> finance.PayrollRunIdRequest
+	87,  // 124: finance.PayrollService.DeletePayrollRun:input_type -> finance.PayrollRunIdRequest
+	91,  // 125: finance.PayrollService.AddPayrollAdjustment:input_type -> finance.AddPayrollAdjustmentRequest
+	92,  // 126: finance.PayrollService.DeletePayrollAdjustment:input_type -> finance.DeletePayrollAdjustmentRequest
+	93,  // 127: finance.PayrollService.ApprovePayrollRun:input_type -> finance.PayrollRunActionRequest
+	94,  // 128: finance.PayrollService.PayPayrollRun:input_type -> finance.PayPayrollRunRequest
+	95,  // 129: finance.PayrollService.GetPayslip:input_type -> finance.GetPayslipRequest
+	96,  // 130: finance.PayrollService.GetTeacherPayslips:input_type -> finance.GetTeacherPayslipsRequest
+	102, // 131: finance.InstallmentService.CreateInstallmentPlan:input_type -> finance.CreateInstallmentPlanRequest
+	104, // 132: finance.InstallmentService.GetInstallmentPlans:input_type -> finance.GetInstallmentPlansRequest
+	106, // 133: finance.InstallmentService.GetInstallmentPlanById:input_type -> finance.InstallmentPlanIdRequest
+	107, // 134: finance.InstallmentService.CancelInstallmentPlan:input_type -> finance.CancelInstallmentPlanRequest
+	110, // 135: finance.InstallmentService.ChargeInstallments:input_type -> finance.ChargeInstallmentsRequest
+	161, // 136: finance.InstallmentService.ApplyInstallmentLateFees:input_type -> google.protobuf.Empty
+	161, // 137: finance.InstallmentService.ChargeDueInstallments:input_type -> google.protobuf.Empty
+	112, // 138: finance.InstallmentService.GetOverdueInstallments:input_type -> finance.GetOverdueInstallmentsRequest
+	116, // 139: finance.CollectionService.GetDebtAging:input_type -> finance.GetDebtAgingRequest
+	120, // 140: finance.CollectionService.AssignDebtor:input_type -> finance.AssignDebtorRequest
+	121, // 141: finance.CollectionService.AddCollectionActivity:input_type -> finance.AddCollectionActivityRequest
+	122, // 142: finance.CollectionService.GetCollectionActivities:input_type -> finance.GetCollectionActivitiesRequest
+	161, // 143: finance.CollectionService.GetCollectionSetting:input_type -> google.protobuf.Empty
+	125, // 144: finance.CollectionService.UpdateCollectionSetting:input_type -> finance.CollectionSetting
+	161, // 145: finance.CollectionService.RunCollections:input_type -> google.protobuf.Empty
+	126, // 146: finance.ReportService.GetProfitAndLoss:input_type -> finance.GetProfitAndLossRequest
+	161, // 147: finance.OneCExportService.GetOneCSetting:input_type -> google.protobuf.Empty
+	130, // 148: finance.OneCExportService.UpdateOneCSetting:input_type -> finance.OneCSetting
+	132, // 149: finance.OneCExportService.ExportOneC:input_type -> finance.ExportOneCRequest
+	134, // 150: finance.ReconciliationService.ImportStatement:input_type -> finance.ImportStatementRequest
+	137, // 151: finance.ReconciliationService.GetReconciliationSessions:input_type -> finance.GetReconciliationSessionsRequest
+	139, // 152: finance.ReconciliationService.GetReconciliationSession:input_type -> finance.GetReconciliationSessionRequest
+	140, // 153: finance.ReconciliationService.CreateMissingPayments:input_type -> finance.CreateMissingPaymentsRequest
+	144, // 154: finance.ReconciliationService.ResolveReconciliationLine:input_type -> finance.ResolveReconciliationLineRequest
+	145, // 155: finance.ChargeService.CreateChargeItem:input_type -> finance.CreateChargeItemRequest
+	146, // 156: finance.ChargeService.UpdateChargeItem:input_type -> finance.AbsChargeItem
+	147, // 157: finance.ChargeService.GetChargeItems:input_type -> finance.GetChargeItemsRequest
+	149, // 158: finance.ChargeService.ChargeStudent:input_type -> finance.ChargeStudentRequest
+	151, // 159: finance.ChargeService.GetStudentCharges:input_type -> finance.GetStudentChargesRequest
+	155, // 160: finance.ChargeService.GetChargeItemSalesReport:input_type -> finance.GetChargeItemSalesReportRequest
+	11,  // 161: finance.DiscountService.GetAllInformationDiscount:output_type -> finance.GetInformationDiscountResponse
+	162, // 162: finance.DiscountService.CreateDiscount:output_type -> common.AbsResponse
+	162, // 163: finance.DiscountService.DeleteDiscount:output_type -> common.AbsResponse
+	7,   // 164: finance.DiscountService.GetHistoryDiscount:output_type -> finance.GetHistoryDiscountResponse
+	162, // 165: finance.DiscountService.CreateDiscountRule:output_type -> common.AbsResponse
+	162, // 166: finance.DiscountService.UpdateDiscountRule:output_type -> common.AbsResponse
+	4,   // 167: finance.DiscountService.GetDiscountRules:output_type -> finance.GetDiscountRulesResponse
+	1,   // 168: finance.DiscountService.ApplyDiscountRules:output_type -> finance.ApplyDiscountRulesResponse
+	1,   // 169: finance.DiscountService.ApplyDiscountTemplate:output_type -> finance.ApplyDiscountRulesResponse
+	162, // 170: finance.CategoryService.CreateCategory:output_type -> common.AbsResponse
+	162, // 171: finance.CategoryService.DeleteCategory:output_type -> common.AbsResponse
+	14,  // 172: finance.CategoryService.GetAllCategory:output_type -> finance.GetAllCategoryRequest
+	162, // 173: finance.CategoryService.SetCategoryBudget:output_type -> common.AbsResponse
+	162, // 174: finance.ExpenseService.CreateExpense:output_type -> common.AbsResponse
+	162, // 175: finance.ExpenseService.DeleteExpense:output_type -> common.AbsResponse
+	21,  // 176: finance.ExpenseService.GetAllExpense:output_type -> finance.GetAllExpenseResponse
+	17,  // 177: finance.ExpenseService.GetAllExpenseDiagram:output_type -> finance.GetAllExpenseDiagramResponse
+	162, // 178: finance.ExpenseService.CreateRecurringExpense:output_type -> common.AbsResponse
+	25,  // 179: finance.ExpenseService.GetRecurringExpenses:output_type -> finance.GetRecurringExpensesResponse
+	162, // 180: finance.ExpenseService.DeleteRecurringExpense:output_type -> common.AbsResponse
+	162, // 181: finance.ExpenseService.PostRecurringExpenses:output_type -> common.AbsResponse
+	28,  // 182: finance.ExpenseService.GetBudgetAlerts:output_type -> finance.GetBudgetAlertsResponse
+	31,  // 183: finance.ExpenseService.GetExpenseById:output_type -> finance.ExpenseDetail
+	162, // 184: finance.ExpenseService.SubmitExpense:output_type -> common.AbsResponse
+	162, // 185: finance.ExpenseService.ApproveExpense:output_type -> common.AbsResponse
+	162, // 186: finance.ExpenseService.RejectExpense:output_type -> common.AbsResponse
+	162, // 187: finance.ExpenseService.MarkExpensePaid:output_type -> common.AbsResponse
+	162, // 188: finance.ExpenseService.AddExpenseReceipt:output_type -> common.AbsResponse
+	162, // 189: finance.ExpenseService.DeleteExpenseReceipt:output_type -> common.AbsResponse
+	36,  // 190: finance.ExpenseService.GetExpenseApprovalSetting:output_type -> finance.ExpenseApprovalSetting
+	162, // 191: finance.ExpenseService.UpdateExpenseApprovalSetting:output_type -> common.AbsResponse
+	162, // 192: finance.VendorService.CreateVendor:output_type -> common.AbsResponse
+	162, // 193: finance.VendorService.UpdateVendor:output_type -> common.AbsResponse
+	40,  // 194: finance.VendorService.GetVendors:output_type -> finance.GetVendorsResponse
+	42,  // 195: finance.VendorService.GetVendorSpendHistory:output_type -> finance.GetVendorSpendHistoryResponse
+	162, // 196: finance.PaymentService.PaymentAdd:output_type -> common.AbsResponse
+	162, // 197: finance.PaymentService.PaymentTakeOff:output_type -> common.AbsResponse
+	162, // 198: finance.PaymentService.PaymentReturn:output_type -> common.AbsResponse
+	162, // 199: finance.PaymentService.PaymentUpdate:output_type -> common.AbsResponse
+	67,  // 200: finance.PaymentService.GetMonthlyStatus:output_type -> finance.GetMonthlyStatusResponse
+	65,  // 201: finance.PaymentService.GetAllPaymentsByMonth:output_type -> finance.GetAllPaymentsByMonthResponse
+	62,  // 202: finance.PaymentService.GetAllPaymentTakeOff:output_type -> finance.GetAllPaymentTakeOffResponse
+	59,  // 203: finance.PaymentService.GetAllPaymentTakeOffChart:output_type -> finance.GetAllPaymentTakeOffChartResponse
+	57,  // 204: finance.PaymentService.GetAllStudentPayments:output_type -> finance.GetAllStudentPaymentsResponse
+	53,  // 205: finance.PaymentService.GetAllStudentPaymentsChart:output_type -> finance.GetAllStudentPaymentsChartResponse
+	49,  // 206: finance.PaymentService.GetAllDebtsInformation:output_type -> finance.GetAllDebtsInformationResponse
+	47,  // 207: finance.PaymentService.GetCommonFinanceInformation:output_type -> finance.GetCommonInformationResponse
+	45,  // 208: finance.PaymentService.GetIncomeChart:output_type -> finance.GetIncomeChartResponse
+	162, // 209: finance.TeacherSalaryService.CreateTeacherSalary:output_type -> common.AbsResponse
+	162, // 210: finance.TeacherSalaryService.DeleteTeacherSalary:output_type -> common.AbsResponse
+	73,  // 211: finance.TeacherSalaryService.GetTeacherSalary:output_type -> finance.GetTeachersSalaryRequest
+	74,  // 212: finance.TeacherSalaryService.GetTeacherSalaryByTeacherID:output_type -> finance.AbsGetTeachersSalary
+	74,  // 213: finance.TeacherSalaryService.ResolveTeacherSalary:output_type -> finance.AbsGetTeachersSalary
+	162, // 214: finance.AccountingPeriodService.ClosePeriod:output_type -> common.AbsResponse
+	162, // 215: finance.AccountingPeriodService.ReopenPeriod:output_type -> common.AbsResponse
+	79,  // 216: finance.AccountingPeriodService.GetAllPeriods:output_type -> finance.GetAllPeriodsResponse
+	82,  // 217: finance.AccountingPeriodService.GetPeriodHistory:output_type -> finance.GetPeriodHistoryResponse
+	85,  // 218: finance.AccountingPeriodService.CheckPeriod:output_type -> finance.CheckPeriodResponse
+	162, // 219: finance.PayrollService.CreatePayrollRun:output_type -> common.AbsResponse
+	88,  // 220: finance.PayrollService.GetPayrollRuns:output_type -> finance.GetPayrollRunsResponse
+	89,  // 221: finance.PayrollService.GetPayrollRunById:output_type -> finance.AbsPayrollRun
+	162, // 222: finance.PayrollService.DeletePayrollRun:output_type -> common.AbsResponse
+	162, // 223: finance.PayrollService.AddPayrollAdjustment:output_type -> common.AbsResponse
+	162, // 224: finance.PayrollService.DeletePayrollAdjustment:output_type -> common.AbsResponse
+	162, // 225: finance.PayrollService.ApprovePayrollRun:output_type -> common.AbsResponse
+	162, // 226: finance.PayrollService.PayPayrollRun:output_type -> common.AbsResponse
+	98,  // 227: finance.PayrollService.GetPayslip:output_type -> finance.Payslip
+	97,  // 228: finance.PayrollService.GetTeacherPayslips:output_type -> finance.GetTeacherPayslipsResponse
+	162, // 229: finance.InstallmentService.CreateInstallmentPlan:output_type -> common.AbsResponse
+	105, // 230: finance.InstallmentService.GetInstallmentPlans:output_type -> finance.GetInstallmentPlansResponse
+	108, // 231: finance.InstallmentService.GetInstallmentPlanById:output_type -> finance.AbsInstallmentPlan
+	162, // 232: finance.InstallmentService.CancelInstallmentPlan:output_type -> common.AbsResponse
+	111, // 233: finance.InstallmentService.ChargeInstallments:output_type -> finance.ChargeInstallmentsResponse
+	162, // 234: finance.InstallmentService.ApplyInstallmentLateFees:output_type -> common.AbsResponse
+	162, // 235: finance.InstallmentService.ChargeDueInstallments:output_type -> common.AbsResponse
+	113, // 236: finance.InstallmentService.GetOverdueInstallments:output_type -> finance.GetOverdueInstallmentsResponse
+	117, // 237: finance.CollectionService.GetDebtAging:output_type -> finance.GetDebtAgingResponse
+	162, // 238: finance.CollectionService.AssignDebtor:output_type -> common.AbsResponse
+	162, // 239: finance.CollectionService.AddCollectionActivity:output_type -> common.AbsResponse
+	123, // 240: finance.CollectionService.GetCollectionActivities:output_type -> finance.GetCollectionActivitiesResponse
+	125, // 241: finance.CollectionService.GetCollectionSetting:output_type -> finance.CollectionSetting
+	162, // 242: finance.CollectionService.UpdateCollectionSetting:output_type -> common.AbsResponse
+	162, // 243: finance.CollectionService.RunCollections:output_type -> common.AbsResponse
+	127, // 244: finance.ReportService.GetProfitAndLoss:output_type -> finance.GetProfitAndLossResponse
+	130, // 245: finance.OneCExportService.GetOneCSetting:output_type -> finance.OneCSetting
+	162, // 246: finance.OneCExportService.UpdateOneCSetting:output_type -> common.AbsResponse
+	133, // 247: finance.OneCExportService.ExportOneC:output_type -> finance.ExportOneCResponse
+	135, // 248: finance.ReconciliationService.ImportStatement:output_type -> finance.ReconciliationSession
+	138, // 249: finance.ReconciliationService.GetReconciliationSessions:output_type -> finance.GetReconciliationSessionsResponse
+	135, // 250: finance.ReconciliationService.GetReconciliationSession:output_type -> finance.ReconciliationSession
+	142, // 251: finance.ReconciliationService.CreateMissingPayments:output_type -> finance.CreateMissingPaymentsResponse
+	162, // 252: finance.ReconciliationService.ResolveReconciliationLine:output_type -> common.AbsResponse
+	162, // 253: finance.ChargeService.CreateChargeItem:output_type -> common.AbsResponse
+	162, // 254: finance.ChargeService.UpdateChargeItem:output_type -> common.AbsResponse
+	148, // 255: finance.ChargeService.GetChargeItems:output_type -> finance.GetChargeItemsResponse
+	162, // 256: finance.ChargeService.ChargeStudent:output_type -> common.AbsResponse
+	152, // 257: finance.ChargeService.GetStudentCharges:output_type -> finance.GetStudentChargesResponse
+	156, // 258: finance.ChargeService.GetChargeItemSalesReport:output_type -> finance.GetChargeItemSalesReportResponse
+	161, // [161:259] is the sub-list for method output_type
+	63,  // [63:161] is the sub-list for method input_type
+	63,  // [63:63] is the sub-list for extension type_name
+	63,  // [63:63] is the sub-list for extension extendee
+	0,   // [0:63] is the sub-list for field type_name
 }
 
 func init() { file_finance_proto_init() }
@@ -3689,9 +12730,9 @@ func file_finance_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_finance_proto_rawDesc), len(file_finance_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   49,
+			NumMessages:   158,
 			NumExtensions: 0,
-			NumServices:   5,
+			NumServices:   14,
 		},
 		GoTypes:           file_finance_proto_goTypes,
 		DependencyIndexes: file_finance_proto_depIdxs,
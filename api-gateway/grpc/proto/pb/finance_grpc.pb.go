@@ -24,6 +24,11 @@ const (
 	DiscountService_CreateDiscount_FullMethodName            = "/finance.DiscountService/CreateDiscount"
 	DiscountService_DeleteDiscount_FullMethodName            = "/finance.DiscountService/DeleteDiscount"
 	DiscountService_GetHistoryDiscount_FullMethodName        = "/finance.DiscountService/GetHistoryDiscount"
+	DiscountService_CreateDiscountRule_FullMethodName        = "/finance.DiscountService/CreateDiscountRule"
+	DiscountService_UpdateDiscountRule_FullMethodName        = "/finance.DiscountService/UpdateDiscountRule"
+	DiscountService_GetDiscountRules_FullMethodName          = "/finance.DiscountService/GetDiscountRules"
+	DiscountService_ApplyDiscountRules_FullMethodName        = "/finance.DiscountService/ApplyDiscountRules"
+	DiscountService_ApplyDiscountTemplate_FullMethodName     = "/finance.DiscountService/ApplyDiscountTemplate"
 )
 
 // DiscountServiceClient is the client API for DiscountService service.
@@ -36,6 +41,11 @@ type DiscountServiceClient interface {
 	CreateDiscount(ctx context.Context, in *AbsDiscountRequest, opts ...grpc.CallOption) (*AbsResponse, error)
 	DeleteDiscount(ctx context.Context, in *AbsDiscountRequest, opts ...grpc.CallOption) (*AbsResponse, error)
 	GetHistoryDiscount(ctx context.Context, in *GetHistoryDiscountRequest, opts ...grpc.CallOption) (*GetHistoryDiscountResponse, error)
+	CreateDiscountRule(ctx context.Context, in *AbsDiscountRule, opts ...grpc.CallOption) (*AbsResponse, error)
+	UpdateDiscountRule(ctx context.Context, in *AbsDiscountRule, opts ...grpc.CallOption) (*AbsResponse, error)
+	GetDiscountRules(ctx context.Context, in *GetDiscountRulesRequest, opts ...grpc.CallOption) (*GetDiscountRulesResponse, error)
+	ApplyDiscountRules(ctx context.Context, in *ApplyDiscountRulesRequest, opts ...grpc.CallOption) (*ApplyDiscountRulesResponse, error)
+	ApplyDiscountTemplate(ctx context.Context, in *ApplyDiscountTemplateRequest, opts ...grpc.CallOption) (*ApplyDiscountRulesResponse, error)
 }
 
 type discountServiceClient struct {
@@ -86,6 +96,56 @@ func (c *discountServiceClient) GetHistoryDiscount(ctx context.Context, in *GetH
 	return out, nil
 }
 
+func (c *discountServiceClient) CreateDiscountRule(ctx context.Context, in *AbsDiscountRule, opts ...grpc.CallOption) (*AbsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AbsResponse)
+	err := c.cc.Invoke(ctx, DiscountService_CreateDiscountRule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *discountServiceClient) UpdateDiscountRule(ctx context.Context, in *AbsDiscountRule, opts ...grpc.CallOption) (*AbsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AbsResponse)
+	err := c.cc.Invoke(ctx, DiscountService_UpdateDiscountRule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *discountServiceClient) GetDiscountRules(ctx context.Context, in *GetDiscountRulesRequest, opts ...grpc.CallOption) (*GetDiscountRulesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetDiscountRulesResponse)
+	err := c.cc.Invoke(ctx, DiscountService_GetDiscountRules_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *discountServiceClient) ApplyDiscountRules(ctx context.Context, in *ApplyDiscountRulesRequest, opts ...grpc.CallOption) (*ApplyDiscountRulesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ApplyDiscountRulesResponse)
+	err := c.cc.Invoke(ctx, DiscountService_ApplyDiscountRules_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *discountServiceClient) ApplyDiscountTemplate(ctx context.Context, in *ApplyDiscountTemplateRequest, opts ...grpc.CallOption) (*ApplyDiscountRulesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ApplyDiscountRulesResponse)
+	err := c.cc.Invoke(ctx, DiscountService_ApplyDiscountTemplate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // DiscountServiceServer is the server API for DiscountService service.
 // All implementations must embed UnimplementedDiscountServiceServer
 // for forward compatibility.
@@ -96,6 +156,11 @@ type DiscountServiceServer interface {
 	CreateDiscount(context.Context, *AbsDiscountRequest) (*AbsResponse, error)
 	DeleteDiscount(context.Context, *AbsDiscountRequest) (*AbsResponse, error)
 	GetHistoryDiscount(context.Context, *GetHistoryDiscountRequest) (*GetHistoryDiscountResponse, error)
+	CreateDiscountRule(context.Context, *AbsDiscountRule) (*AbsResponse, error)
+	UpdateDiscountRule(context.Context, *AbsDiscountRule) (*AbsResponse, error)
+	GetDiscountRules(context.Context, *GetDiscountRulesRequest) (*GetDiscountRulesResponse, error)
+	ApplyDiscountRules(context.Context, *ApplyDiscountRulesRequest) (*ApplyDiscountRulesResponse, error)
+	ApplyDiscountTemplate(context.Context, *ApplyDiscountTemplateRequest) (*ApplyDiscountRulesResponse, error)
 	mustEmbedUnimplementedDiscountServiceServer()
 }
 
@@ -118,6 +183,21 @@ func (UnimplementedDiscountServiceServer) DeleteDiscount(context.Context, *AbsDi
 func (UnimplementedDiscountServiceServer) GetHistoryDiscount(context.Context, *GetHistoryDiscountRequest) (*GetHistoryDiscountResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetHistoryDiscount not implemented")
 }
+func (UnimplementedDiscountServiceServer) CreateDiscountRule(context.Context, *AbsDiscountRule) (*AbsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateDiscountRule not implemented")
+}
+func (UnimplementedDiscountServiceServer) UpdateDiscountRule(context.Context, *AbsDiscountRule) (*AbsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateDiscountRule not implemented")
+}
+func (UnimplementedDiscountServiceServer) GetDiscountRules(context.Context, *GetDiscountRulesRequest) (*GetDiscountRulesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDiscountRules not implemented")
+}
+func (UnimplementedDiscountServiceServer) ApplyDiscountRules(context.Context, *ApplyDiscountRulesRequest) (*ApplyDiscountRulesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApplyDiscountRules not implemented")
+}
+func (UnimplementedDiscountServiceServer) ApplyDiscountTemplate(context.Context, *ApplyDiscountTemplateRequest) (*ApplyDiscountRulesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApplyDiscountTemplate not implemented")
+}
 func (UnimplementedDiscountServiceServer) mustEmbedUnimplementedDiscountServiceServer() {}
 func (UnimplementedDiscountServiceServer) testEmbeddedByValue()                         {}
 
@@ -211,6 +291,96 @@ func _DiscountService_GetHistoryDiscount_Handler(srv interface{}, ctx context.Co
 	return interceptor(ctx, in, info, handler)
 }
 
+func _DiscountService_CreateDiscountRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AbsDiscountRule)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DiscountServiceServer).CreateDiscountRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DiscountService_CreateDiscountRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DiscountServiceServer).CreateDiscountRule(ctx, req.(*AbsDiscountRule))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DiscountService_UpdateDiscountRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AbsDiscountRule)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DiscountServiceServer).UpdateDiscountRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DiscountService_UpdateDiscountRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DiscountServiceServer).UpdateDiscountRule(ctx, req.(*AbsDiscountRule))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DiscountService_GetDiscountRules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDiscountRulesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DiscountServiceServer).GetDiscountRules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DiscountService_GetDiscountRules_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DiscountServiceServer).GetDiscountRules(ctx, req.(*GetDiscountRulesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DiscountService_ApplyDiscountRules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApplyDiscountRulesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DiscountServiceServer).ApplyDiscountRules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DiscountService_ApplyDiscountRules_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DiscountServiceServer).ApplyDiscountRules(ctx, req.(*ApplyDiscountRulesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DiscountService_ApplyDiscountTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApplyDiscountTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DiscountServiceServer).ApplyDiscountTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DiscountService_ApplyDiscountTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DiscountServiceServer).ApplyDiscountTemplate(ctx, req.(*ApplyDiscountTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // DiscountService_ServiceDesc is the grpc.ServiceDesc for DiscountService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -234,15 +404,36 @@ var DiscountService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetHistoryDiscount",
 			Handler:    _DiscountService_GetHistoryDiscount_Handler,
 		},
+		{
+			MethodName: "CreateDiscountRule",
+			Handler:    _DiscountService_CreateDiscountRule_Handler,
+		},
+		{
+			MethodName: "UpdateDiscountRule",
+			Handler:    _DiscountService_UpdateDiscountRule_Handler,
+		},
+		{
+			MethodName: "GetDiscountRules",
+			Handler:    _DiscountService_GetDiscountRules_Handler,
+		},
+		{
+			MethodName: "ApplyDiscountRules",
+			Handler:    _DiscountService_ApplyDiscountRules_Handler,
+		},
+		{
+			MethodName: "ApplyDiscountTemplate",
+			Handler:    _DiscountService_ApplyDiscountTemplate_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "finance.proto",
 }
 
 const (
-	CategoryService_CreateCategory_FullMethodName = "/finance.CategoryService/CreateCategory"
-	CategoryService_DeleteCategory_FullMethodName = "/finance.CategoryService/DeleteCategory"
-	CategoryService_GetAllCategory_FullMethodName = "/finance.CategoryService/GetAllCategory"
+	CategoryService_CreateCategory_FullMethodName    = "/finance.CategoryService/CreateCategory"
+	CategoryService_DeleteCategory_FullMethodName    = "/finance.CategoryService/DeleteCategory"
+	CategoryService_GetAllCategory_FullMethodName    = "/finance.CategoryService/GetAllCategory"
+	CategoryService_SetCategoryBudget_FullMethodName = "/finance.CategoryService/SetCategoryBudget"
 )
 
 // CategoryServiceClient is the client API for CategoryService service.
@@ -254,6 +445,7 @@ type CategoryServiceClient interface {
 	CreateCategory(ctx context.Context, in *CreateCategoryRequest, opts ...grpc.CallOption) (*AbsResponse, error)
 	DeleteCategory(ctx context.Context, in *DeleteAbsRequest, opts ...grpc.CallOption) (*AbsResponse, error)
 	GetAllCategory(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetAllCategoryRequest, error)
+	SetCategoryBudget(ctx context.Context, in *SetCategoryBudgetRequest, opts ...grpc.CallOption) (*AbsResponse, error)
 }
 
 type categoryServiceClient struct {
@@ -294,6 +486,16 @@ func (c *categoryServiceClient) GetAllCategory(ctx context.Context, in *emptypb.
 	return out, nil
 }
 
+func (c *categoryServiceClient) SetCategoryBudget(ctx context.Context, in *SetCategoryBudgetRequest, opts ...grpc.CallOption) (*AbsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AbsResponse)
+	err := c.cc.Invoke(ctx, CategoryService_SetCategoryBudget_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // CategoryServiceServer is the server API for CategoryService service.
 // All implementations must embed UnimplementedCategoryServiceServer
 // for forward compatibility.
@@ -303,6 +505,7 @@ type CategoryServiceServer interface {
 	CreateCategory(context.Context, *CreateCategoryRequest) (*AbsResponse, error)
 	DeleteCategory(context.Context, *DeleteAbsRequest) (*AbsResponse, error)
 	GetAllCategory(context.Context, *emptypb.Empty) (*GetAllCategoryRequest, error)
+	SetCategoryBudget(context.Context, *SetCategoryBudgetRequest) (*AbsResponse, error)
 	mustEmbedUnimplementedCategoryServiceServer()
 }
 
@@ -322,6 +525,9 @@ func (UnimplementedCategoryServiceServer) DeleteCategory(context.Context, *Delet
 func (UnimplementedCategoryServiceServer) GetAllCategory(context.Context, *emptypb.Empty) (*GetAllCategoryRequest, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetAllCategory not implemented")
 }
+func (UnimplementedCategoryServiceServer) SetCategoryBudget(context.Context, *SetCategoryBudgetRequest) (*AbsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetCategoryBudget not implemented")
+}
 func (UnimplementedCategoryServiceServer) mustEmbedUnimplementedCategoryServiceServer() {}
 func (UnimplementedCategoryServiceServer) testEmbeddedByValue()                         {}
 
@@ -397,6 +603,24 @@ func _CategoryService_GetAllCategory_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _CategoryService_SetCategoryBudget_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetCategoryBudgetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CategoryServiceServer).SetCategoryBudget(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CategoryService_SetCategoryBudget_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CategoryServiceServer).SetCategoryBudget(ctx, req.(*SetCategoryBudgetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // CategoryService_ServiceDesc is the grpc.ServiceDesc for CategoryService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -416,16 +640,34 @@ var CategoryService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetAllCategory",
 			Handler:    _CategoryService_GetAllCategory_Handler,
 		},
+		{
+			MethodName: "SetCategoryBudget",
+			Handler:    _CategoryService_SetCategoryBudget_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "finance.proto",
 }
 
 const (
-	ExpenseService_CreateExpense_FullMethodName        = "/finance.ExpenseService/CreateExpense"
-	ExpenseService_DeleteExpense_FullMethodName        = "/finance.ExpenseService/DeleteExpense"
-	ExpenseService_GetAllExpense_FullMethodName        = "/finance.ExpenseService/GetAllExpense"
-	ExpenseService_GetAllExpenseDiagram_FullMethodName = "/finance.ExpenseService/GetAllExpenseDiagram"
+	ExpenseService_CreateExpense_FullMethodName                = "/finance.ExpenseService/CreateExpense"
+	ExpenseService_DeleteExpense_FullMethodName                = "/finance.ExpenseService/DeleteExpense"
+	ExpenseService_GetAllExpense_FullMethodName                = "/finance.ExpenseService/GetAllExpense"
+	ExpenseService_GetAllExpenseDiagram_FullMethodName         = "/finance.ExpenseService/GetAllExpenseDiagram"
+	ExpenseService_CreateRecurringExpense_FullMethodName       = "/finance.ExpenseService/CreateRecurringExpense"
+	ExpenseService_GetRecurringExpenses_FullMethodName         = "/finance.ExpenseService/GetRecurringExpenses"
+	ExpenseService_DeleteRecurringExpense_FullMethodName       = "/finance.ExpenseService/DeleteRecurringExpense"
+	ExpenseService_PostRecurringExpenses_FullMethodName        = "/finance.ExpenseService/PostRecurringExpenses"
+	ExpenseService_GetBudgetAlerts_FullMethodName              = "/finance.ExpenseService/GetBudgetAlerts"
+	ExpenseService_GetExpenseById_FullMethodName               = "/finance.ExpenseService/GetExpenseById"
+	ExpenseService_SubmitExpense_FullMethodName                = "/finance.ExpenseService/SubmitExpense"
+	ExpenseService_ApproveExpense_FullMethodName               = "/finance.ExpenseService/ApproveExpense"
+	ExpenseService_RejectExpense_FullMethodName                = "/finance.ExpenseService/RejectExpense"
+	ExpenseService_MarkExpensePaid_FullMethodName              = "/finance.ExpenseService/MarkExpensePaid"
+	ExpenseService_AddExpenseReceipt_FullMethodName            = "/finance.ExpenseService/AddExpenseReceipt"
+	ExpenseService_DeleteExpenseReceipt_FullMethodName         = "/finance.ExpenseService/DeleteExpenseReceipt"
+	ExpenseService_GetExpenseApprovalSetting_FullMethodName    = "/finance.ExpenseService/GetExpenseApprovalSetting"
+	ExpenseService_UpdateExpenseApprovalSetting_FullMethodName = "/finance.ExpenseService/UpdateExpenseApprovalSetting"
 )
 
 // ExpenseServiceClient is the client API for ExpenseService service.
@@ -438,6 +680,20 @@ type ExpenseServiceClient interface {
 	DeleteExpense(ctx context.Context, in *DeleteAbsRequest, opts ...grpc.CallOption) (*AbsResponse, error)
 	GetAllExpense(ctx context.Context, in *GetAllExpenseRequest, opts ...grpc.CallOption) (*GetAllExpenseResponse, error)
 	GetAllExpenseDiagram(ctx context.Context, in *GetAllExpenseDiagramRequest, opts ...grpc.CallOption) (*GetAllExpenseDiagramResponse, error)
+	CreateRecurringExpense(ctx context.Context, in *CreateRecurringExpenseRequest, opts ...grpc.CallOption) (*AbsResponse, error)
+	GetRecurringExpenses(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetRecurringExpensesResponse, error)
+	DeleteRecurringExpense(ctx context.Context, in *DeleteAbsRequest, opts ...grpc.CallOption) (*AbsResponse, error)
+	PostRecurringExpenses(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*AbsResponse, error)
+	GetBudgetAlerts(ctx context.Context, in *GetBudgetAlertsRequest, opts ...grpc.CallOption) (*GetBudgetAlertsResponse, error)
+	GetExpenseById(ctx context.Context, in *GetExpenseByIdRequest, opts ...grpc.CallOption) (*ExpenseDetail, error)
+	SubmitExpense(ctx context.Context, in *ExpenseActionRequest, opts ...grpc.CallOption) (*AbsResponse, error)
+	ApproveExpense(ctx context.Context, in *ExpenseActionRequest, opts ...grpc.CallOption) (*AbsResponse, error)
+	RejectExpense(ctx context.Context, in *ExpenseActionRequest, opts ...grpc.CallOption) (*AbsResponse, error)
+	MarkExpensePaid(ctx context.Context, in *ExpenseActionRequest, opts ...grpc.CallOption) (*AbsResponse, error)
+	AddExpenseReceipt(ctx context.Context, in *AddExpenseReceiptRequest, opts ...grpc.CallOption) (*AbsResponse, error)
+	DeleteExpenseReceipt(ctx context.Context, in *DeleteAbsRequest, opts ...grpc.CallOption) (*AbsResponse, error)
+	GetExpenseApprovalSetting(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ExpenseApprovalSetting, error)
+	UpdateExpenseApprovalSetting(ctx context.Context, in *ExpenseApprovalSetting, opts ...grpc.CallOption) (*AbsResponse, error)
 }
 
 type expenseServiceClient struct {
@@ -488,6 +744,146 @@ func (c *expenseServiceClient) GetAllExpenseDiagram(ctx context.Context, in *Get
 	return out, nil
 }
 
+func (c *expenseServiceClient) CreateRecurringExpense(ctx context.Context, in *CreateRecurringExpenseRequest, opts ...grpc.CallOption) (*AbsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AbsResponse)
+	err := c.cc.Invoke(ctx, ExpenseService_CreateRecurringExpense_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *expenseServiceClient) GetRecurringExpenses(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetRecurringExpensesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetRecurringExpensesResponse)
+	err := c.cc.Invoke(ctx, ExpenseService_GetRecurringExpenses_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *expenseServiceClient) DeleteRecurringExpense(ctx context.Context, in *DeleteAbsRequest, opts ...grpc.CallOption) (*AbsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AbsResponse)
+	err := c.cc.Invoke(ctx, ExpenseService_DeleteRecurringExpense_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *expenseServiceClient) PostRecurringExpenses(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*AbsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AbsResponse)
+	err := c.cc.Invoke(ctx, ExpenseService_PostRecurringExpenses_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *expenseServiceClient) GetBudgetAlerts(ctx context.Context, in *GetBudgetAlertsRequest, opts ...grpc.CallOption) (*GetBudgetAlertsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetBudgetAlertsResponse)
+	err := c.cc.Invoke(ctx, ExpenseService_GetBudgetAlerts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *expenseServiceClient) GetExpenseById(ctx context.Context, in *GetExpenseByIdRequest, opts ...grpc.CallOption) (*ExpenseDetail, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExpenseDetail)
+	err := c.cc.Invoke(ctx, ExpenseService_GetExpenseById_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *expenseServiceClient) SubmitExpense(ctx context.Context, in *ExpenseActionRequest, opts ...grpc.CallOption) (*AbsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AbsResponse)
+	err := c.cc.Invoke(ctx, ExpenseService_SubmitExpense_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *expenseServiceClient) ApproveExpense(ctx context.Context, in *ExpenseActionRequest, opts ...grpc.CallOption) (*AbsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AbsResponse)
+	err := c.cc.Invoke(ctx, ExpenseService_ApproveExpense_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *expenseServiceClient) RejectExpense(ctx context.Context, in *ExpenseActionRequest, opts ...grpc.CallOption) (*AbsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AbsResponse)
+	err := c.cc.Invoke(ctx, ExpenseService_RejectExpense_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *expenseServiceClient) MarkExpensePaid(ctx context.Context, in *ExpenseActionRequest, opts ...grpc.CallOption) (*AbsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AbsResponse)
+	err := c.cc.Invoke(ctx, ExpenseService_MarkExpensePaid_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *expenseServiceClient) AddExpenseReceipt(ctx context.Context, in *AddExpenseReceiptRequest, opts ...grpc.CallOption) (*AbsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AbsResponse)
+	err := c.cc.Invoke(ctx, ExpenseService_AddExpenseReceipt_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *expenseServiceClient) DeleteExpenseReceipt(ctx context.Context, in *DeleteAbsRequest, opts ...grpc.CallOption) (*AbsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AbsResponse)
+	err := c.cc.Invoke(ctx, ExpenseService_DeleteExpenseReceipt_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *expenseServiceClient) GetExpenseApprovalSetting(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ExpenseApprovalSetting, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExpenseApprovalSetting)
+	err := c.cc.Invoke(ctx, ExpenseService_GetExpenseApprovalSetting_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *expenseServiceClient) UpdateExpenseApprovalSetting(ctx context.Context, in *ExpenseApprovalSetting, opts ...grpc.CallOption) (*AbsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AbsResponse)
+	err := c.cc.Invoke(ctx, ExpenseService_UpdateExpenseApprovalSetting_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ExpenseServiceServer is the server API for ExpenseService service.
 // All implementations must embed UnimplementedExpenseServiceServer
 // for forward compatibility.
@@ -498,6 +894,20 @@ type ExpenseServiceServer interface {
 	DeleteExpense(context.Context, *DeleteAbsRequest) (*AbsResponse, error)
 	GetAllExpense(context.Context, *GetAllExpenseRequest) (*GetAllExpenseResponse, error)
 	GetAllExpenseDiagram(context.Context, *GetAllExpenseDiagramRequest) (*GetAllExpenseDiagramResponse, error)
+	CreateRecurringExpense(context.Context, *CreateRecurringExpenseRequest) (*AbsResponse, error)
+	GetRecurringExpenses(context.Context, *emptypb.Empty) (*GetRecurringExpensesResponse, error)
+	DeleteRecurringExpense(context.Context, *DeleteAbsRequest) (*AbsResponse, error)
+	PostRecurringExpenses(context.Context, *emptypb.Empty) (*AbsResponse, error)
+	GetBudgetAlerts(context.Context, *GetBudgetAlertsRequest) (*GetBudgetAlertsResponse, error)
+	GetExpenseById(context.Context, *GetExpenseByIdRequest) (*ExpenseDetail, error)
+	SubmitExpense(context.Context, *ExpenseActionRequest) (*AbsResponse, error)
+	ApproveExpense(context.Context, *ExpenseActionRequest) (*AbsResponse, error)
+	RejectExpense(context.Context, *ExpenseActionRequest) (*AbsResponse, error)
+	MarkExpensePaid(context.Context, *ExpenseActionRequest) (*AbsResponse, error)
+	AddExpenseReceipt(context.Context, *AddExpenseReceiptRequest) (*AbsResponse, error)
+	DeleteExpenseReceipt(context.Context, *DeleteAbsRequest) (*AbsResponse, error)
+	GetExpenseApprovalSetting(context.Context, *emptypb.Empty) (*ExpenseApprovalSetting, error)
+	UpdateExpenseApprovalSetting(context.Context, *ExpenseApprovalSetting) (*AbsResponse, error)
 	mustEmbedUnimplementedExpenseServiceServer()
 }
 
@@ -520,13 +930,55 @@ func (UnimplementedExpenseServiceServer) GetAllExpense(context.Context, *GetAllE
 func (UnimplementedExpenseServiceServer) GetAllExpenseDiagram(context.Context, *GetAllExpenseDiagramRequest) (*GetAllExpenseDiagramResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetAllExpenseDiagram not implemented")
 }
-func (UnimplementedExpenseServiceServer) mustEmbedUnimplementedExpenseServiceServer() {}
-func (UnimplementedExpenseServiceServer) testEmbeddedByValue()                        {}
-
-// UnsafeExpenseServiceServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to ExpenseServiceServer will
-// result in compilation errors.
-type UnsafeExpenseServiceServer interface {
+func (UnimplementedExpenseServiceServer) CreateRecurringExpense(context.Context, *CreateRecurringExpenseRequest) (*AbsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateRecurringExpense not implemented")
+}
+func (UnimplementedExpenseServiceServer) GetRecurringExpenses(context.Context, *emptypb.Empty) (*GetRecurringExpensesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRecurringExpenses not implemented")
+}
+func (UnimplementedExpenseServiceServer) DeleteRecurringExpense(context.Context, *DeleteAbsRequest) (*AbsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteRecurringExpense not implemented")
+}
+func (UnimplementedExpenseServiceServer) PostRecurringExpenses(context.Context, *emptypb.Empty) (*AbsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PostRecurringExpenses not implemented")
+}
+func (UnimplementedExpenseServiceServer) GetBudgetAlerts(context.Context, *GetBudgetAlertsRequest) (*GetBudgetAlertsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBudgetAlerts not implemented")
+}
+func (UnimplementedExpenseServiceServer) GetExpenseById(context.Context, *GetExpenseByIdRequest) (*ExpenseDetail, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetExpenseById not implemented")
+}
+func (UnimplementedExpenseServiceServer) SubmitExpense(context.Context, *ExpenseActionRequest) (*AbsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitExpense not implemented")
+}
+func (UnimplementedExpenseServiceServer) ApproveExpense(context.Context, *ExpenseActionRequest) (*AbsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApproveExpense not implemented")
+}
+func (UnimplementedExpenseServiceServer) RejectExpense(context.Context, *ExpenseActionRequest) (*AbsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RejectExpense not implemented")
+}
+func (UnimplementedExpenseServiceServer) MarkExpensePaid(context.Context, *ExpenseActionRequest) (*AbsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MarkExpensePaid not implemented")
+}
+func (UnimplementedExpenseServiceServer) AddExpenseReceipt(context.Context, *AddExpenseReceiptRequest) (*AbsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddExpenseReceipt not implemented")
+}
+func (UnimplementedExpenseServiceServer) DeleteExpenseReceipt(context.Context, *DeleteAbsRequest) (*AbsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteExpenseReceipt not implemented")
+}
+func (UnimplementedExpenseServiceServer) GetExpenseApprovalSetting(context.Context, *emptypb.Empty) (*ExpenseApprovalSetting, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetExpenseApprovalSetting not implemented")
+}
+func (UnimplementedExpenseServiceServer) UpdateExpenseApprovalSetting(context.Context, *ExpenseApprovalSetting) (*AbsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateExpenseApprovalSetting not implemented")
+}
+func (UnimplementedExpenseServiceServer) mustEmbedUnimplementedExpenseServiceServer() {}
+func (UnimplementedExpenseServiceServer) testEmbeddedByValue()                        {}
+
+// UnsafeExpenseServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ExpenseServiceServer will
+// result in compilation errors.
+type UnsafeExpenseServiceServer interface {
 	mustEmbedUnimplementedExpenseServiceServer()
 }
 
@@ -613,190 +1065,731 @@ func _ExpenseService_GetAllExpenseDiagram_Handler(srv interface{}, ctx context.C
 	return interceptor(ctx, in, info, handler)
 }
 
-// ExpenseService_ServiceDesc is the grpc.ServiceDesc for ExpenseService service.
-// It's only intended for direct use with grpc.RegisterService,
-// and not to be introspected or modified (even as a copy)
-var ExpenseService_ServiceDesc = grpc.ServiceDesc{
-	ServiceName: "finance.ExpenseService",
-	HandlerType: (*ExpenseServiceServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "CreateExpense",
-			Handler:    _ExpenseService_CreateExpense_Handler,
-		},
-		{
-			MethodName: "DeleteExpense",
-			Handler:    _ExpenseService_DeleteExpense_Handler,
-		},
-		{
-			MethodName: "GetAllExpense",
-			Handler:    _ExpenseService_GetAllExpense_Handler,
-		},
-		{
-			MethodName: "GetAllExpenseDiagram",
-			Handler:    _ExpenseService_GetAllExpenseDiagram_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "finance.proto",
-}
-
-const (
-	PaymentService_PaymentAdd_FullMethodName                  = "/finance.PaymentService/PaymentAdd"
-	PaymentService_PaymentReturn_FullMethodName               = "/finance.PaymentService/PaymentReturn"
-	PaymentService_PaymentUpdate_FullMethodName               = "/finance.PaymentService/PaymentUpdate"
-	PaymentService_GetMonthlyStatus_FullMethodName            = "/finance.PaymentService/GetMonthlyStatus"
-	PaymentService_GetAllPaymentsByMonth_FullMethodName       = "/finance.PaymentService/GetAllPaymentsByMonth"
-	PaymentService_GetAllPaymentTakeOff_FullMethodName        = "/finance.PaymentService/GetAllPaymentTakeOff"
-	PaymentService_GetAllPaymentTakeOffChart_FullMethodName   = "/finance.PaymentService/GetAllPaymentTakeOffChart"
-	PaymentService_GetAllStudentPayments_FullMethodName       = "/finance.PaymentService/GetAllStudentPayments"
-	PaymentService_GetAllStudentPaymentsChart_FullMethodName  = "/finance.PaymentService/GetAllStudentPaymentsChart"
-	PaymentService_GetAllDebtsInformation_FullMethodName      = "/finance.PaymentService/GetAllDebtsInformation"
-	PaymentService_GetCommonFinanceInformation_FullMethodName = "/finance.PaymentService/GetCommonFinanceInformation"
-	PaymentService_GetIncomeChart_FullMethodName              = "/finance.PaymentService/GetIncomeChart"
-)
-
-// PaymentServiceClient is the client API for PaymentService service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
-//
-// expense service end
-// payment service start
-type PaymentServiceClient interface {
-	PaymentAdd(ctx context.Context, in *PaymentAddRequest, opts ...grpc.CallOption) (*AbsResponse, error)
-	PaymentReturn(ctx context.Context, in *PaymentReturnRequest, opts ...grpc.CallOption) (*AbsResponse, error)
-	PaymentUpdate(ctx context.Context, in *PaymentUpdateRequest, opts ...grpc.CallOption) (*AbsResponse, error)
-	GetMonthlyStatus(ctx context.Context, in *GetMonthlyStatusRequest, opts ...grpc.CallOption) (*GetMonthlyStatusResponse, error)
-	GetAllPaymentsByMonth(ctx context.Context, in *GetAllPaymentsByMonthRequest, opts ...grpc.CallOption) (*GetAllPaymentsByMonthResponse, error)
-	GetAllPaymentTakeOff(ctx context.Context, in *GetAllPaymentTakeOffRequest, opts ...grpc.CallOption) (*GetAllPaymentTakeOffResponse, error)
-	GetAllPaymentTakeOffChart(ctx context.Context, in *GetAllPaymentTakeOffRequest, opts ...grpc.CallOption) (*GetAllPaymentTakeOffChartResponse, error)
-	GetAllStudentPayments(ctx context.Context, in *GetAllStudentPaymentsRequest, opts ...grpc.CallOption) (*GetAllStudentPaymentsResponse, error)
-	GetAllStudentPaymentsChart(ctx context.Context, in *GetAllStudentPaymentsRequest, opts ...grpc.CallOption) (*GetAllStudentPaymentsChartResponse, error)
-	GetAllDebtsInformation(ctx context.Context, in *GetAllDebtsRequest, opts ...grpc.CallOption) (*GetAllDebtsInformationResponse, error)
-	GetCommonFinanceInformation(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetCommonInformationResponse, error)
-	GetIncomeChart(ctx context.Context, in *GetIncomeChartRequest, opts ...grpc.CallOption) (*GetIncomeChartResponse, error)
-}
-
-type paymentServiceClient struct {
-	cc grpc.ClientConnInterface
-}
-
-func NewPaymentServiceClient(cc grpc.ClientConnInterface) PaymentServiceClient {
-	return &paymentServiceClient{cc}
-}
-
-func (c *paymentServiceClient) PaymentAdd(ctx context.Context, in *PaymentAddRequest, opts ...grpc.CallOption) (*AbsResponse, error) {
-	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(AbsResponse)
-	err := c.cc.Invoke(ctx, PaymentService_PaymentAdd_FullMethodName, in, out, cOpts...)
-	if err != nil {
+func _ExpenseService_CreateRecurringExpense_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateRecurringExpenseRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(ExpenseServiceServer).CreateRecurringExpense(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ExpenseService_CreateRecurringExpense_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExpenseServiceServer).CreateRecurringExpense(ctx, req.(*CreateRecurringExpenseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *paymentServiceClient) PaymentReturn(ctx context.Context, in *PaymentReturnRequest, opts ...grpc.CallOption) (*AbsResponse, error) {
-	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(AbsResponse)
-	err := c.cc.Invoke(ctx, PaymentService_PaymentReturn_FullMethodName, in, out, cOpts...)
-	if err != nil {
+func _ExpenseService_GetRecurringExpenses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(ExpenseServiceServer).GetRecurringExpenses(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ExpenseService_GetRecurringExpenses_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExpenseServiceServer).GetRecurringExpenses(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *paymentServiceClient) PaymentUpdate(ctx context.Context, in *PaymentUpdateRequest, opts ...grpc.CallOption) (*AbsResponse, error) {
-	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(AbsResponse)
-	err := c.cc.Invoke(ctx, PaymentService_PaymentUpdate_FullMethodName, in, out, cOpts...)
-	if err != nil {
+func _ExpenseService_DeleteRecurringExpense_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteAbsRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(ExpenseServiceServer).DeleteRecurringExpense(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ExpenseService_DeleteRecurringExpense_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExpenseServiceServer).DeleteRecurringExpense(ctx, req.(*DeleteAbsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *paymentServiceClient) GetMonthlyStatus(ctx context.Context, in *GetMonthlyStatusRequest, opts ...grpc.CallOption) (*GetMonthlyStatusResponse, error) {
-	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(GetMonthlyStatusResponse)
-	err := c.cc.Invoke(ctx, PaymentService_GetMonthlyStatus_FullMethodName, in, out, cOpts...)
-	if err != nil {
+func _ExpenseService_PostRecurringExpenses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(ExpenseServiceServer).PostRecurringExpenses(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ExpenseService_PostRecurringExpenses_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExpenseServiceServer).PostRecurringExpenses(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *paymentServiceClient) GetAllPaymentsByMonth(ctx context.Context, in *GetAllPaymentsByMonthRequest, opts ...grpc.CallOption) (*GetAllPaymentsByMonthResponse, error) {
-	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(GetAllPaymentsByMonthResponse)
-	err := c.cc.Invoke(ctx, PaymentService_GetAllPaymentsByMonth_FullMethodName, in, out, cOpts...)
-	if err != nil {
+func _ExpenseService_GetBudgetAlerts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBudgetAlertsRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(ExpenseServiceServer).GetBudgetAlerts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ExpenseService_GetBudgetAlerts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExpenseServiceServer).GetBudgetAlerts(ctx, req.(*GetBudgetAlertsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *paymentServiceClient) GetAllPaymentTakeOff(ctx context.Context, in *GetAllPaymentTakeOffRequest, opts ...grpc.CallOption) (*GetAllPaymentTakeOffResponse, error) {
-	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(GetAllPaymentTakeOffResponse)
-	err := c.cc.Invoke(ctx, PaymentService_GetAllPaymentTakeOff_FullMethodName, in, out, cOpts...)
-	if err != nil {
+func _ExpenseService_GetExpenseById_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetExpenseByIdRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(ExpenseServiceServer).GetExpenseById(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ExpenseService_GetExpenseById_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExpenseServiceServer).GetExpenseById(ctx, req.(*GetExpenseByIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *paymentServiceClient) GetAllPaymentTakeOffChart(ctx context.Context, in *GetAllPaymentTakeOffRequest, opts ...grpc.CallOption) (*GetAllPaymentTakeOffChartResponse, error) {
-	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(GetAllPaymentTakeOffChartResponse)
-	err := c.cc.Invoke(ctx, PaymentService_GetAllPaymentTakeOffChart_FullMethodName, in, out, cOpts...)
-	if err != nil {
+func _ExpenseService_SubmitExpense_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExpenseActionRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(ExpenseServiceServer).SubmitExpense(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ExpenseService_SubmitExpense_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExpenseServiceServer).SubmitExpense(ctx, req.(*ExpenseActionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *paymentServiceClient) GetAllStudentPayments(ctx context.Context, in *GetAllStudentPaymentsRequest, opts ...grpc.CallOption) (*GetAllStudentPaymentsResponse, error) {
-	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(GetAllStudentPaymentsResponse)
-	err := c.cc.Invoke(ctx, PaymentService_GetAllStudentPayments_FullMethodName, in, out, cOpts...)
-	if err != nil {
+func _ExpenseService_ApproveExpense_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExpenseActionRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(ExpenseServiceServer).ApproveExpense(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ExpenseService_ApproveExpense_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExpenseServiceServer).ApproveExpense(ctx, req.(*ExpenseActionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *paymentServiceClient) GetAllStudentPaymentsChart(ctx context.Context, in *GetAllStudentPaymentsRequest, opts ...grpc.CallOption) (*GetAllStudentPaymentsChartResponse, error) {
-	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(GetAllStudentPaymentsChartResponse)
-	err := c.cc.Invoke(ctx, PaymentService_GetAllStudentPaymentsChart_FullMethodName, in, out, cOpts...)
-	if err != nil {
+func _ExpenseService_RejectExpense_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExpenseActionRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(ExpenseServiceServer).RejectExpense(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ExpenseService_RejectExpense_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExpenseServiceServer).RejectExpense(ctx, req.(*ExpenseActionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *paymentServiceClient) GetAllDebtsInformation(ctx context.Context, in *GetAllDebtsRequest, opts ...grpc.CallOption) (*GetAllDebtsInformationResponse, error) {
-	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(GetAllDebtsInformationResponse)
-	err := c.cc.Invoke(ctx, PaymentService_GetAllDebtsInformation_FullMethodName, in, out, cOpts...)
-	if err != nil {
+func _ExpenseService_MarkExpensePaid_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExpenseActionRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(ExpenseServiceServer).MarkExpensePaid(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ExpenseService_MarkExpensePaid_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExpenseServiceServer).MarkExpensePaid(ctx, req.(*ExpenseActionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *paymentServiceClient) GetCommonFinanceInformation(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetCommonInformationResponse, error) {
-	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(GetCommonInformationResponse)
-	err := c.cc.Invoke(ctx, PaymentService_GetCommonFinanceInformation_FullMethodName, in, out, cOpts...)
-	if err != nil {
+func _ExpenseService_AddExpenseReceipt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddExpenseReceiptRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
-}
-
-func (c *paymentServiceClient) GetIncomeChart(ctx context.Context, in *GetIncomeChartRequest, opts ...grpc.CallOption) (*GetIncomeChartResponse, error) {
-	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	if interceptor == nil {
+		return srv.(ExpenseServiceServer).AddExpenseReceipt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ExpenseService_AddExpenseReceipt_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExpenseServiceServer).AddExpenseReceipt(ctx, req.(*AddExpenseReceiptRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExpenseService_DeleteExpenseReceipt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteAbsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExpenseServiceServer).DeleteExpenseReceipt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ExpenseService_DeleteExpenseReceipt_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExpenseServiceServer).DeleteExpenseReceipt(ctx, req.(*DeleteAbsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExpenseService_GetExpenseApprovalSetting_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExpenseServiceServer).GetExpenseApprovalSetting(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ExpenseService_GetExpenseApprovalSetting_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExpenseServiceServer).GetExpenseApprovalSetting(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExpenseService_UpdateExpenseApprovalSetting_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExpenseApprovalSetting)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExpenseServiceServer).UpdateExpenseApprovalSetting(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ExpenseService_UpdateExpenseApprovalSetting_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExpenseServiceServer).UpdateExpenseApprovalSetting(ctx, req.(*ExpenseApprovalSetting))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ExpenseService_ServiceDesc is the grpc.ServiceDesc for ExpenseService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ExpenseService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "finance.ExpenseService",
+	HandlerType: (*ExpenseServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateExpense",
+			Handler:    _ExpenseService_CreateExpense_Handler,
+		},
+		{
+			MethodName: "DeleteExpense",
+			Handler:    _ExpenseService_DeleteExpense_Handler,
+		},
+		{
+			MethodName: "GetAllExpense",
+			Handler:    _ExpenseService_GetAllExpense_Handler,
+		},
+		{
+			MethodName: "GetAllExpenseDiagram",
+			Handler:    _ExpenseService_GetAllExpenseDiagram_Handler,
+		},
+		{
+			MethodName: "CreateRecurringExpense",
+			Handler:    _ExpenseService_CreateRecurringExpense_Handler,
+		},
+		{
+			MethodName: "GetRecurringExpenses",
+			Handler:    _ExpenseService_GetRecurringExpenses_Handler,
+		},
+		{
+			MethodName: "DeleteRecurringExpense",
+			Handler:    _ExpenseService_DeleteRecurringExpense_Handler,
+		},
+		{
+			MethodName: "PostRecurringExpenses",
+			Handler:    _ExpenseService_PostRecurringExpenses_Handler,
+		},
+		{
+			MethodName: "GetBudgetAlerts",
+			Handler:    _ExpenseService_GetBudgetAlerts_Handler,
+		},
+		{
+			MethodName: "GetExpenseById",
+			Handler:    _ExpenseService_GetExpenseById_Handler,
+		},
+		{
+			MethodName: "SubmitExpense",
+			Handler:    _ExpenseService_SubmitExpense_Handler,
+		},
+		{
+			MethodName: "ApproveExpense",
+			Handler:    _ExpenseService_ApproveExpense_Handler,
+		},
+		{
+			MethodName: "RejectExpense",
+			Handler:    _ExpenseService_RejectExpense_Handler,
+		},
+		{
+			MethodName: "MarkExpensePaid",
+			Handler:    _ExpenseService_MarkExpensePaid_Handler,
+		},
+		{
+			MethodName: "AddExpenseReceipt",
+			Handler:    _ExpenseService_AddExpenseReceipt_Handler,
+		},
+		{
+			MethodName: "DeleteExpenseReceipt",
+			Handler:    _ExpenseService_DeleteExpenseReceipt_Handler,
+		},
+		{
+			MethodName: "GetExpenseApprovalSetting",
+			Handler:    _ExpenseService_GetExpenseApprovalSetting_Handler,
+		},
+		{
+			MethodName: "UpdateExpenseApprovalSetting",
+			Handler:    _ExpenseService_UpdateExpenseApprovalSetting_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "finance.proto",
+}
+
+const (
+	VendorService_CreateVendor_FullMethodName          = "/finance.VendorService/CreateVendor"
+	VendorService_UpdateVendor_FullMethodName          = "/finance.VendorService/UpdateVendor"
+	VendorService_GetVendors_FullMethodName            = "/finance.VendorService/GetVendors"
+	VendorService_GetVendorSpendHistory_FullMethodName = "/finance.VendorService/GetVendorSpendHistory"
+)
+
+// VendorServiceClient is the client API for VendorService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// vendor service start
+type VendorServiceClient interface {
+	CreateVendor(ctx context.Context, in *CreateVendorRequest, opts ...grpc.CallOption) (*AbsResponse, error)
+	UpdateVendor(ctx context.Context, in *AbsVendor, opts ...grpc.CallOption) (*AbsResponse, error)
+	GetVendors(ctx context.Context, in *GetVendorsRequest, opts ...grpc.CallOption) (*GetVendorsResponse, error)
+	GetVendorSpendHistory(ctx context.Context, in *GetVendorSpendHistoryRequest, opts ...grpc.CallOption) (*GetVendorSpendHistoryResponse, error)
+}
+
+type vendorServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewVendorServiceClient(cc grpc.ClientConnInterface) VendorServiceClient {
+	return &vendorServiceClient{cc}
+}
+
+func (c *vendorServiceClient) CreateVendor(ctx context.Context, in *CreateVendorRequest, opts ...grpc.CallOption) (*AbsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AbsResponse)
+	err := c.cc.Invoke(ctx, VendorService_CreateVendor_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vendorServiceClient) UpdateVendor(ctx context.Context, in *AbsVendor, opts ...grpc.CallOption) (*AbsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AbsResponse)
+	err := c.cc.Invoke(ctx, VendorService_UpdateVendor_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vendorServiceClient) GetVendors(ctx context.Context, in *GetVendorsRequest, opts ...grpc.CallOption) (*GetVendorsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetVendorsResponse)
+	err := c.cc.Invoke(ctx, VendorService_GetVendors_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vendorServiceClient) GetVendorSpendHistory(ctx context.Context, in *GetVendorSpendHistoryRequest, opts ...grpc.CallOption) (*GetVendorSpendHistoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetVendorSpendHistoryResponse)
+	err := c.cc.Invoke(ctx, VendorService_GetVendorSpendHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// VendorServiceServer is the server API for VendorService service.
+// All implementations must embed UnimplementedVendorServiceServer
+// for forward compatibility.
+//
+// vendor service start
+type VendorServiceServer interface {
+	CreateVendor(context.Context, *CreateVendorRequest) (*AbsResponse, error)
+	UpdateVendor(context.Context, *AbsVendor) (*AbsResponse, error)
+	GetVendors(context.Context, *GetVendorsRequest) (*GetVendorsResponse, error)
+	GetVendorSpendHistory(context.Context, *GetVendorSpendHistoryRequest) (*GetVendorSpendHistoryResponse, error)
+	mustEmbedUnimplementedVendorServiceServer()
+}
+
+// UnimplementedVendorServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedVendorServiceServer struct{}
+
+func (UnimplementedVendorServiceServer) CreateVendor(context.Context, *CreateVendorRequest) (*AbsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateVendor not implemented")
+}
+func (UnimplementedVendorServiceServer) UpdateVendor(context.Context, *AbsVendor) (*AbsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateVendor not implemented")
+}
+func (UnimplementedVendorServiceServer) GetVendors(context.Context, *GetVendorsRequest) (*GetVendorsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetVendors not implemented")
+}
+func (UnimplementedVendorServiceServer) GetVendorSpendHistory(context.Context, *GetVendorSpendHistoryRequest) (*GetVendorSpendHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetVendorSpendHistory not implemented")
+}
+func (UnimplementedVendorServiceServer) mustEmbedUnimplementedVendorServiceServer() {}
+func (UnimplementedVendorServiceServer) testEmbeddedByValue()                       {}
+
+// UnsafeVendorServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to VendorServiceServer will
+// result in compilation errors.
+type UnsafeVendorServiceServer interface {
+	mustEmbedUnimplementedVendorServiceServer()
+}
+
+func RegisterVendorServiceServer(s grpc.ServiceRegistrar, srv VendorServiceServer) {
+	// If the following call pancis, it indicates UnimplementedVendorServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&VendorService_ServiceDesc, srv)
+}
+
+func _VendorService_CreateVendor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateVendorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VendorServiceServer).CreateVendor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VendorService_CreateVendor_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VendorServiceServer).CreateVendor(ctx, req.(*CreateVendorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VendorService_UpdateVendor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AbsVendor)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VendorServiceServer).UpdateVendor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VendorService_UpdateVendor_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VendorServiceServer).UpdateVendor(ctx, req.(*AbsVendor))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VendorService_GetVendors_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetVendorsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VendorServiceServer).GetVendors(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VendorService_GetVendors_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VendorServiceServer).GetVendors(ctx, req.(*GetVendorsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VendorService_GetVendorSpendHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetVendorSpendHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VendorServiceServer).GetVendorSpendHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VendorService_GetVendorSpendHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VendorServiceServer).GetVendorSpendHistory(ctx, req.(*GetVendorSpendHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// VendorService_ServiceDesc is the grpc.ServiceDesc for VendorService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var VendorService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "finance.VendorService",
+	HandlerType: (*VendorServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateVendor",
+			Handler:    _VendorService_CreateVendor_Handler,
+		},
+		{
+			MethodName: "UpdateVendor",
+			Handler:    _VendorService_UpdateVendor_Handler,
+		},
+		{
+			MethodName: "GetVendors",
+			Handler:    _VendorService_GetVendors_Handler,
+		},
+		{
+			MethodName: "GetVendorSpendHistory",
+			Handler:    _VendorService_GetVendorSpendHistory_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "finance.proto",
+}
+
+const (
+	PaymentService_PaymentAdd_FullMethodName                  = "/finance.PaymentService/PaymentAdd"
+	PaymentService_PaymentTakeOff_FullMethodName              = "/finance.PaymentService/PaymentTakeOff"
+	PaymentService_PaymentReturn_FullMethodName               = "/finance.PaymentService/PaymentReturn"
+	PaymentService_PaymentUpdate_FullMethodName               = "/finance.PaymentService/PaymentUpdate"
+	PaymentService_GetMonthlyStatus_FullMethodName            = "/finance.PaymentService/GetMonthlyStatus"
+	PaymentService_GetAllPaymentsByMonth_FullMethodName       = "/finance.PaymentService/GetAllPaymentsByMonth"
+	PaymentService_GetAllPaymentTakeOff_FullMethodName        = "/finance.PaymentService/GetAllPaymentTakeOff"
+	PaymentService_GetAllPaymentTakeOffChart_FullMethodName   = "/finance.PaymentService/GetAllPaymentTakeOffChart"
+	PaymentService_GetAllStudentPayments_FullMethodName       = "/finance.PaymentService/GetAllStudentPayments"
+	PaymentService_GetAllStudentPaymentsChart_FullMethodName  = "/finance.PaymentService/GetAllStudentPaymentsChart"
+	PaymentService_GetAllDebtsInformation_FullMethodName      = "/finance.PaymentService/GetAllDebtsInformation"
+	PaymentService_GetCommonFinanceInformation_FullMethodName = "/finance.PaymentService/GetCommonFinanceInformation"
+	PaymentService_GetIncomeChart_FullMethodName              = "/finance.PaymentService/GetIncomeChart"
+)
+
+// PaymentServiceClient is the client API for PaymentService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// vendor service end
+// payment service start
+type PaymentServiceClient interface {
+	PaymentAdd(ctx context.Context, in *PaymentAddRequest, opts ...grpc.CallOption) (*AbsResponse, error)
+	// same as PaymentAdd with type TAKE_OFF, message holds the id of the created payment
+	PaymentTakeOff(ctx context.Context, in *PaymentAddRequest, opts ...grpc.CallOption) (*AbsResponse, error)
+	PaymentReturn(ctx context.Context, in *PaymentReturnRequest, opts ...grpc.CallOption) (*AbsResponse, error)
+	PaymentUpdate(ctx context.Context, in *PaymentUpdateRequest, opts ...grpc.CallOption) (*AbsResponse, error)
+	GetMonthlyStatus(ctx context.Context, in *GetMonthlyStatusRequest, opts ...grpc.CallOption) (*GetMonthlyStatusResponse, error)
+	GetAllPaymentsByMonth(ctx context.Context, in *GetAllPaymentsByMonthRequest, opts ...grpc.CallOption) (*GetAllPaymentsByMonthResponse, error)
+	GetAllPaymentTakeOff(ctx context.Context, in *GetAllPaymentTakeOffRequest, opts ...grpc.CallOption) (*GetAllPaymentTakeOffResponse, error)
+	GetAllPaymentTakeOffChart(ctx context.Context, in *GetAllPaymentTakeOffRequest, opts ...grpc.CallOption) (*GetAllPaymentTakeOffChartResponse, error)
+	GetAllStudentPayments(ctx context.Context, in *GetAllStudentPaymentsRequest, opts ...grpc.CallOption) (*GetAllStudentPaymentsResponse, error)
+	GetAllStudentPaymentsChart(ctx context.Context, in *GetAllStudentPaymentsRequest, opts ...grpc.CallOption) (*GetAllStudentPaymentsChartResponse, error)
+	GetAllDebtsInformation(ctx context.Context, in *GetAllDebtsRequest, opts ...grpc.CallOption) (*GetAllDebtsInformationResponse, error)
+	GetCommonFinanceInformation(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetCommonInformationResponse, error)
+	GetIncomeChart(ctx context.Context, in *GetIncomeChartRequest, opts ...grpc.CallOption) (*GetIncomeChartResponse, error)
+}
+
+type paymentServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewPaymentServiceClient(cc grpc.ClientConnInterface) PaymentServiceClient {
+	return &paymentServiceClient{cc}
+}
+
+func (c *paymentServiceClient) PaymentAdd(ctx context.Context, in *PaymentAddRequest, opts ...grpc.CallOption) (*AbsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AbsResponse)
+	err := c.cc.Invoke(ctx, PaymentService_PaymentAdd_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *paymentServiceClient) PaymentTakeOff(ctx context.Context, in *PaymentAddRequest, opts ...grpc.CallOption) (*AbsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AbsResponse)
+	err := c.cc.Invoke(ctx, PaymentService_PaymentTakeOff_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *paymentServiceClient) PaymentReturn(ctx context.Context, in *PaymentReturnRequest, opts ...grpc.CallOption) (*AbsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AbsResponse)
+	err := c.cc.Invoke(ctx, PaymentService_PaymentReturn_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *paymentServiceClient) PaymentUpdate(ctx context.Context, in *PaymentUpdateRequest, opts ...grpc.CallOption) (*AbsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AbsResponse)
+	err := c.cc.Invoke(ctx, PaymentService_PaymentUpdate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *paymentServiceClient) GetMonthlyStatus(ctx context.Context, in *GetMonthlyStatusRequest, opts ...grpc.CallOption) (*GetMonthlyStatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetMonthlyStatusResponse)
+	err := c.cc.Invoke(ctx, PaymentService_GetMonthlyStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *paymentServiceClient) GetAllPaymentsByMonth(ctx context.Context, in *GetAllPaymentsByMonthRequest, opts ...grpc.CallOption) (*GetAllPaymentsByMonthResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetAllPaymentsByMonthResponse)
+	err := c.cc.Invoke(ctx, PaymentService_GetAllPaymentsByMonth_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *paymentServiceClient) GetAllPaymentTakeOff(ctx context.Context, in *GetAllPaymentTakeOffRequest, opts ...grpc.CallOption) (*GetAllPaymentTakeOffResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetAllPaymentTakeOffResponse)
+	err := c.cc.Invoke(ctx, PaymentService_GetAllPaymentTakeOff_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *paymentServiceClient) GetAllPaymentTakeOffChart(ctx context.Context, in *GetAllPaymentTakeOffRequest, opts ...grpc.CallOption) (*GetAllPaymentTakeOffChartResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetAllPaymentTakeOffChartResponse)
+	err := c.cc.Invoke(ctx, PaymentService_GetAllPaymentTakeOffChart_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *paymentServiceClient) GetAllStudentPayments(ctx context.Context, in *GetAllStudentPaymentsRequest, opts ...grpc.CallOption) (*GetAllStudentPaymentsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetAllStudentPaymentsResponse)
+	err := c.cc.Invoke(ctx, PaymentService_GetAllStudentPayments_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *paymentServiceClient) GetAllStudentPaymentsChart(ctx context.Context, in *GetAllStudentPaymentsRequest, opts ...grpc.CallOption) (*GetAllStudentPaymentsChartResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetAllStudentPaymentsChartResponse)
+	err := c.cc.Invoke(ctx, PaymentService_GetAllStudentPaymentsChart_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *paymentServiceClient) GetAllDebtsInformation(ctx context.Context, in *GetAllDebtsRequest, opts ...grpc.CallOption) (*GetAllDebtsInformationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetAllDebtsInformationResponse)
+	err := c.cc.Invoke(ctx, PaymentService_GetAllDebtsInformation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *paymentServiceClient) GetCommonFinanceInformation(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetCommonInformationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetCommonInformationResponse)
+	err := c.cc.Invoke(ctx, PaymentService_GetCommonFinanceInformation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *paymentServiceClient) GetIncomeChart(ctx context.Context, in *GetIncomeChartRequest, opts ...grpc.CallOption) (*GetIncomeChartResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetIncomeChartResponse)
 	err := c.cc.Invoke(ctx, PaymentService_GetIncomeChart_FullMethodName, in, out, cOpts...)
 	if err != nil {
@@ -805,362 +1798,2606 @@ func (c *paymentServiceClient) GetIncomeChart(ctx context.Context, in *GetIncome
 	return out, nil
 }
 
-// PaymentServiceServer is the server API for PaymentService service.
-// All implementations must embed UnimplementedPaymentServiceServer
+// PaymentServiceServer is the server API for PaymentService service.
+// All implementations must embed UnimplementedPaymentServiceServer
+// for forward compatibility.
+//
+// vendor service end
+// payment service start
+type PaymentServiceServer interface {
+	PaymentAdd(context.Context, *PaymentAddRequest) (*AbsResponse, error)
+	// same as PaymentAdd with type TAKE_OFF, message holds the id of the created payment
+	PaymentTakeOff(context.Context, *PaymentAddRequest) (*AbsResponse, error)
+	PaymentReturn(context.Context, *PaymentReturnRequest) (*AbsResponse, error)
+	PaymentUpdate(context.Context, *PaymentUpdateRequest) (*AbsResponse, error)
+	GetMonthlyStatus(context.Context, *GetMonthlyStatusRequest) (*GetMonthlyStatusResponse, error)
+	GetAllPaymentsByMonth(context.Context, *GetAllPaymentsByMonthRequest) (*GetAllPaymentsByMonthResponse, error)
+	GetAllPaymentTakeOff(context.Context, *GetAllPaymentTakeOffRequest) (*GetAllPaymentTakeOffResponse, error)
+	GetAllPaymentTakeOffChart(context.Context, *GetAllPaymentTakeOffRequest) (*GetAllPaymentTakeOffChartResponse, error)
+	GetAllStudentPayments(context.Context, *GetAllStudentPaymentsRequest) (*GetAllStudentPaymentsResponse, error)
+	GetAllStudentPaymentsChart(context.Context, *GetAllStudentPaymentsRequest) (*GetAllStudentPaymentsChartResponse, error)
+	GetAllDebtsInformation(context.Context, *GetAllDebtsRequest) (*GetAllDebtsInformationResponse, error)
+	GetCommonFinanceInformation(context.Context, *emptypb.Empty) (*GetCommonInformationResponse, error)
+	GetIncomeChart(context.Context, *GetIncomeChartRequest) (*GetIncomeChartResponse, error)
+	mustEmbedUnimplementedPaymentServiceServer()
+}
+
+// UnimplementedPaymentServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedPaymentServiceServer struct{}
+
+func (UnimplementedPaymentServiceServer) PaymentAdd(context.Context, *PaymentAddRequest) (*AbsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PaymentAdd not implemented")
+}
+func (UnimplementedPaymentServiceServer) PaymentTakeOff(context.Context, *PaymentAddRequest) (*AbsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PaymentTakeOff not implemented")
+}
+func (UnimplementedPaymentServiceServer) PaymentReturn(context.Context, *PaymentReturnRequest) (*AbsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PaymentReturn not implemented")
+}
+func (UnimplementedPaymentServiceServer) PaymentUpdate(context.Context, *PaymentUpdateRequest) (*AbsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PaymentUpdate not implemented")
+}
+func (UnimplementedPaymentServiceServer) GetMonthlyStatus(context.Context, *GetMonthlyStatusRequest) (*GetMonthlyStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMonthlyStatus not implemented")
+}
+func (UnimplementedPaymentServiceServer) GetAllPaymentsByMonth(context.Context, *GetAllPaymentsByMonthRequest) (*GetAllPaymentsByMonthResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAllPaymentsByMonth not implemented")
+}
+func (UnimplementedPaymentServiceServer) GetAllPaymentTakeOff(context.Context, *GetAllPaymentTakeOffRequest) (*GetAllPaymentTakeOffResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAllPaymentTakeOff not implemented")
+}
+func (UnimplementedPaymentServiceServer) GetAllPaymentTakeOffChart(context.Context, *GetAllPaymentTakeOffRequest) (*GetAllPaymentTakeOffChartResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAllPaymentTakeOffChart not implemented")
+}
+func (UnimplementedPaymentServiceServer) GetAllStudentPayments(context.Context, *GetAllStudentPaymentsRequest) (*GetAllStudentPaymentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAllStudentPayments not implemented")
+}
+func (UnimplementedPaymentServiceServer) GetAllStudentPaymentsChart(context.Context, *GetAllStudentPaymentsRequest) (*GetAllStudentPaymentsChartResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAllStudentPaymentsChart not implemented")
+}
+func (UnimplementedPaymentServiceServer) GetAllDebtsInformation(context.Context, *GetAllDebtsRequest) (*GetAllDebtsInformationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAllDebtsInformation not implemented")
+}
+func (UnimplementedPaymentServiceServer) GetCommonFinanceInformation(context.Context, *emptypb.Empty) (*GetCommonInformationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCommonFinanceInformation not implemented")
+}
+func (UnimplementedPaymentServiceServer) GetIncomeChart(context.Context, *GetIncomeChartRequest) (*GetIncomeChartResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetIncomeChart not implemented")
+}
+func (UnimplementedPaymentServiceServer) mustEmbedUnimplementedPaymentServiceServer() {}
+func (UnimplementedPaymentServiceServer) testEmbeddedByValue()                        {}
+
+// UnsafePaymentServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to PaymentServiceServer will
+// result in compilation errors.
+type UnsafePaymentServiceServer interface {
+	mustEmbedUnimplementedPaymentServiceServer()
+}
+
+func RegisterPaymentServiceServer(s grpc.ServiceRegistrar, srv PaymentServiceServer) {
+	// If the following call pancis, it indicates UnimplementedPaymentServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&PaymentService_ServiceDesc, srv)
+}
+
+func _PaymentService_PaymentAdd_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PaymentAddRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaymentServiceServer).PaymentAdd(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PaymentService_PaymentAdd_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaymentServiceServer).PaymentAdd(ctx, req.(*PaymentAddRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PaymentService_PaymentTakeOff_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PaymentAddRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaymentServiceServer).PaymentTakeOff(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PaymentService_PaymentTakeOff_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaymentServiceServer).PaymentTakeOff(ctx, req.(*PaymentAddRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PaymentService_PaymentReturn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PaymentReturnRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaymentServiceServer).PaymentReturn(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PaymentService_PaymentReturn_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaymentServiceServer).PaymentReturn(ctx, req.(*PaymentReturnRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PaymentService_PaymentUpdate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PaymentUpdateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaymentServiceServer).PaymentUpdate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PaymentService_PaymentUpdate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaymentServiceServer).PaymentUpdate(ctx, req.(*PaymentUpdateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PaymentService_GetMonthlyStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMonthlyStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaymentServiceServer).GetMonthlyStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PaymentService_GetMonthlyStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaymentServiceServer).GetMonthlyStatus(ctx, req.(*GetMonthlyStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PaymentService_GetAllPaymentsByMonth_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAllPaymentsByMonthRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaymentServiceServer).GetAllPaymentsByMonth(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PaymentService_GetAllPaymentsByMonth_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaymentServiceServer).GetAllPaymentsByMonth(ctx, req.(*GetAllPaymentsByMonthRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PaymentService_GetAllPaymentTakeOff_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAllPaymentTakeOffRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaymentServiceServer).GetAllPaymentTakeOff(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PaymentService_GetAllPaymentTakeOff_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaymentServiceServer).GetAllPaymentTakeOff(ctx, req.(*GetAllPaymentTakeOffRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PaymentService_GetAllPaymentTakeOffChart_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAllPaymentTakeOffRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaymentServiceServer).GetAllPaymentTakeOffChart(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PaymentService_GetAllPaymentTakeOffChart_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaymentServiceServer).GetAllPaymentTakeOffChart(ctx, req.(*GetAllPaymentTakeOffRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PaymentService_GetAllStudentPayments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAllStudentPaymentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaymentServiceServer).GetAllStudentPayments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PaymentService_GetAllStudentPayments_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaymentServiceServer).GetAllStudentPayments(ctx, req.(*GetAllStudentPaymentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PaymentService_GetAllStudentPaymentsChart_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAllStudentPaymentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaymentServiceServer).GetAllStudentPaymentsChart(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PaymentService_GetAllStudentPaymentsChart_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaymentServiceServer).GetAllStudentPaymentsChart(ctx, req.(*GetAllStudentPaymentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PaymentService_GetAllDebtsInformation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAllDebtsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaymentServiceServer).GetAllDebtsInformation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PaymentService_GetAllDebtsInformation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaymentServiceServer).GetAllDebtsInformation(ctx, req.(*GetAllDebtsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PaymentService_GetCommonFinanceInformation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaymentServiceServer).GetCommonFinanceInformation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PaymentService_GetCommonFinanceInformation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaymentServiceServer).GetCommonFinanceInformation(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PaymentService_GetIncomeChart_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetIncomeChartRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaymentServiceServer).GetIncomeChart(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PaymentService_GetIncomeChart_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaymentServiceServer).GetIncomeChart(ctx, req.(*GetIncomeChartRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// PaymentService_ServiceDesc is the grpc.ServiceDesc for PaymentService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var PaymentService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "finance.PaymentService",
+	HandlerType: (*PaymentServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "PaymentAdd",
+			Handler:    _PaymentService_PaymentAdd_Handler,
+		},
+		{
+			MethodName: "PaymentTakeOff",
+			Handler:    _PaymentService_PaymentTakeOff_Handler,
+		},
+		{
+			MethodName: "PaymentReturn",
+			Handler:    _PaymentService_PaymentReturn_Handler,
+		},
+		{
+			MethodName: "PaymentUpdate",
+			Handler:    _PaymentService_PaymentUpdate_Handler,
+		},
+		{
+			MethodName: "GetMonthlyStatus",
+			Handler:    _PaymentService_GetMonthlyStatus_Handler,
+		},
+		{
+			MethodName: "GetAllPaymentsByMonth",
+			Handler:    _PaymentService_GetAllPaymentsByMonth_Handler,
+		},
+		{
+			MethodName: "GetAllPaymentTakeOff",
+			Handler:    _PaymentService_GetAllPaymentTakeOff_Handler,
+		},
+		{
+			MethodName: "GetAllPaymentTakeOffChart",
+			Handler:    _PaymentService_GetAllPaymentTakeOffChart_Handler,
+		},
+		{
+			MethodName: "GetAllStudentPayments",
+			Handler:    _PaymentService_GetAllStudentPayments_Handler,
+		},
+		{
+			MethodName: "GetAllStudentPaymentsChart",
+			Handler:    _PaymentService_GetAllStudentPaymentsChart_Handler,
+		},
+		{
+			MethodName: "GetAllDebtsInformation",
+			Handler:    _PaymentService_GetAllDebtsInformation_Handler,
+		},
+		{
+			MethodName: "GetCommonFinanceInformation",
+			Handler:    _PaymentService_GetCommonFinanceInformation_Handler,
+		},
+		{
+			MethodName: "GetIncomeChart",
+			Handler:    _PaymentService_GetIncomeChart_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "finance.proto",
+}
+
+const (
+	TeacherSalaryService_CreateTeacherSalary_FullMethodName         = "/finance.TeacherSalaryService/CreateTeacherSalary"
+	TeacherSalaryService_DeleteTeacherSalary_FullMethodName         = "/finance.TeacherSalaryService/DeleteTeacherSalary"
+	TeacherSalaryService_GetTeacherSalary_FullMethodName            = "/finance.TeacherSalaryService/GetTeacherSalary"
+	TeacherSalaryService_GetTeacherSalaryByTeacherID_FullMethodName = "/finance.TeacherSalaryService/GetTeacherSalaryByTeacherID"
+	TeacherSalaryService_ResolveTeacherSalary_FullMethodName        = "/finance.TeacherSalaryService/ResolveTeacherSalary"
+)
+
+// TeacherSalaryServiceClient is the client API for TeacherSalaryService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// teacher salary service start
+type TeacherSalaryServiceClient interface {
+	CreateTeacherSalary(ctx context.Context, in *CreateTeacherSalaryRequest, opts ...grpc.CallOption) (*AbsResponse, error)
+	DeleteTeacherSalary(ctx context.Context, in *DeleteTeacherSalaryRequest, opts ...grpc.CallOption) (*AbsResponse, error)
+	GetTeacherSalary(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetTeachersSalaryRequest, error)
+	GetTeacherSalaryByTeacherID(ctx context.Context, in *DeleteTeacherSalaryRequest, opts ...grpc.CallOption) (*AbsGetTeachersSalary, error)
+	ResolveTeacherSalary(ctx context.Context, in *ResolveTeacherSalaryRequest, opts ...grpc.CallOption) (*AbsGetTeachersSalary, error)
+}
+
+type teacherSalaryServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewTeacherSalaryServiceClient(cc grpc.ClientConnInterface) TeacherSalaryServiceClient {
+	return &teacherSalaryServiceClient{cc}
+}
+
+func (c *teacherSalaryServiceClient) CreateTeacherSalary(ctx context.Context, in *CreateTeacherSalaryRequest, opts ...grpc.CallOption) (*AbsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AbsResponse)
+	err := c.cc.Invoke(ctx, TeacherSalaryService_CreateTeacherSalary_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *teacherSalaryServiceClient) DeleteTeacherSalary(ctx context.Context, in *DeleteTeacherSalaryRequest, opts ...grpc.CallOption) (*AbsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AbsResponse)
+	err := c.cc.Invoke(ctx, TeacherSalaryService_DeleteTeacherSalary_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *teacherSalaryServiceClient) GetTeacherSalary(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetTeachersSalaryRequest, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetTeachersSalaryRequest)
+	err := c.cc.Invoke(ctx, TeacherSalaryService_GetTeacherSalary_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *teacherSalaryServiceClient) GetTeacherSalaryByTeacherID(ctx context.Context, in *DeleteTeacherSalaryRequest, opts ...grpc.CallOption) (*AbsGetTeachersSalary, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AbsGetTeachersSalary)
+	err := c.cc.Invoke(ctx, TeacherSalaryService_GetTeacherSalaryByTeacherID_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *teacherSalaryServiceClient) ResolveTeacherSalary(ctx context.Context, in *ResolveTeacherSalaryRequest, opts ...grpc.CallOption) (*AbsGetTeachersSalary, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AbsGetTeachersSalary)
+	err := c.cc.Invoke(ctx, TeacherSalaryService_ResolveTeacherSalary_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// TeacherSalaryServiceServer is the server API for TeacherSalaryService service.
+// All implementations must embed UnimplementedTeacherSalaryServiceServer
+// for forward compatibility.
+//
+// teacher salary service start
+type TeacherSalaryServiceServer interface {
+	CreateTeacherSalary(context.Context, *CreateTeacherSalaryRequest) (*AbsResponse, error)
+	DeleteTeacherSalary(context.Context, *DeleteTeacherSalaryRequest) (*AbsResponse, error)
+	GetTeacherSalary(context.Context, *emptypb.Empty) (*GetTeachersSalaryRequest, error)
+	GetTeacherSalaryByTeacherID(context.Context, *DeleteTeacherSalaryRequest) (*AbsGetTeachersSalary, error)
+	ResolveTeacherSalary(context.Context, *ResolveTeacherSalaryRequest) (*AbsGetTeachersSalary, error)
+	mustEmbedUnimplementedTeacherSalaryServiceServer()
+}
+
+// UnimplementedTeacherSalaryServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedTeacherSalaryServiceServer struct{}
+
+func (UnimplementedTeacherSalaryServiceServer) CreateTeacherSalary(context.Context, *CreateTeacherSalaryRequest) (*AbsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateTeacherSalary not implemented")
+}
+func (UnimplementedTeacherSalaryServiceServer) DeleteTeacherSalary(context.Context, *DeleteTeacherSalaryRequest) (*AbsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteTeacherSalary not implemented")
+}
+func (UnimplementedTeacherSalaryServiceServer) GetTeacherSalary(context.Context, *emptypb.Empty) (*GetTeachersSalaryRequest, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTeacherSalary not implemented")
+}
+func (UnimplementedTeacherSalaryServiceServer) GetTeacherSalaryByTeacherID(context.Context, *DeleteTeacherSalaryRequest) (*AbsGetTeachersSalary, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTeacherSalaryByTeacherID not implemented")
+}
+func (UnimplementedTeacherSalaryServiceServer) ResolveTeacherSalary(context.Context, *ResolveTeacherSalaryRequest) (*AbsGetTeachersSalary, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResolveTeacherSalary not implemented")
+}
+func (UnimplementedTeacherSalaryServiceServer) mustEmbedUnimplementedTeacherSalaryServiceServer() {}
+func (UnimplementedTeacherSalaryServiceServer) testEmbeddedByValue()                              {}
+
+// UnsafeTeacherSalaryServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to TeacherSalaryServiceServer will
+// result in compilation errors.
+type UnsafeTeacherSalaryServiceServer interface {
+	mustEmbedUnimplementedTeacherSalaryServiceServer()
+}
+
+func RegisterTeacherSalaryServiceServer(s grpc.ServiceRegistrar, srv TeacherSalaryServiceServer) {
+	// If the following call pancis, it indicates UnimplementedTeacherSalaryServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&TeacherSalaryService_ServiceDesc, srv)
+}
+
+func _TeacherSalaryService_CreateTeacherSalary_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateTeacherSalaryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TeacherSalaryServiceServer).CreateTeacherSalary(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TeacherSalaryService_CreateTeacherSalary_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TeacherSalaryServiceServer).CreateTeacherSalary(ctx, req.(*CreateTeacherSalaryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TeacherSalaryService_DeleteTeacherSalary_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteTeacherSalaryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TeacherSalaryServiceServer).DeleteTeacherSalary(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TeacherSalaryService_DeleteTeacherSalary_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TeacherSalaryServiceServer).DeleteTeacherSalary(ctx, req.(*DeleteTeacherSalaryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TeacherSalaryService_GetTeacherSalary_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TeacherSalaryServiceServer).GetTeacherSalary(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TeacherSalaryService_GetTeacherSalary_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TeacherSalaryServiceServer).GetTeacherSalary(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TeacherSalaryService_GetTeacherSalaryByTeacherID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteTeacherSalaryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TeacherSalaryServiceServer).GetTeacherSalaryByTeacherID(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TeacherSalaryService_GetTeacherSalaryByTeacherID_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TeacherSalaryServiceServer).GetTeacherSalaryByTeacherID(ctx, req.(*DeleteTeacherSalaryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TeacherSalaryService_ResolveTeacherSalary_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResolveTeacherSalaryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TeacherSalaryServiceServer).ResolveTeacherSalary(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TeacherSalaryService_ResolveTeacherSalary_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TeacherSalaryServiceServer).ResolveTeacherSalary(ctx, req.(*ResolveTeacherSalaryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// TeacherSalaryService_ServiceDesc is the grpc.ServiceDesc for TeacherSalaryService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var TeacherSalaryService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "finance.TeacherSalaryService",
+	HandlerType: (*TeacherSalaryServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateTeacherSalary",
+			Handler:    _TeacherSalaryService_CreateTeacherSalary_Handler,
+		},
+		{
+			MethodName: "DeleteTeacherSalary",
+			Handler:    _TeacherSalaryService_DeleteTeacherSalary_Handler,
+		},
+		{
+			MethodName: "GetTeacherSalary",
+			Handler:    _TeacherSalaryService_GetTeacherSalary_Handler,
+		},
+		{
+			MethodName: "GetTeacherSalaryByTeacherID",
+			Handler:    _TeacherSalaryService_GetTeacherSalaryByTeacherID_Handler,
+		},
+		{
+			MethodName: "ResolveTeacherSalary",
+			Handler:    _TeacherSalaryService_ResolveTeacherSalary_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "finance.proto",
+}
+
+const (
+	AccountingPeriodService_ClosePeriod_FullMethodName      = "/finance.AccountingPeriodService/ClosePeriod"
+	AccountingPeriodService_ReopenPeriod_FullMethodName     = "/finance.AccountingPeriodService/ReopenPeriod"
+	AccountingPeriodService_GetAllPeriods_FullMethodName    = "/finance.AccountingPeriodService/GetAllPeriods"
+	AccountingPeriodService_GetPeriodHistory_FullMethodName = "/finance.AccountingPeriodService/GetPeriodHistory"
+	AccountingPeriodService_CheckPeriod_FullMethodName      = "/finance.AccountingPeriodService/CheckPeriod"
+)
+
+// AccountingPeriodServiceClient is the client API for AccountingPeriodService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// accounting period service start
+type AccountingPeriodServiceClient interface {
+	ClosePeriod(ctx context.Context, in *AccountingPeriodRequest, opts ...grpc.CallOption) (*AbsResponse, error)
+	ReopenPeriod(ctx context.Context, in *AccountingPeriodRequest, opts ...grpc.CallOption) (*AbsResponse, error)
+	GetAllPeriods(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetAllPeriodsResponse, error)
+	GetPeriodHistory(ctx context.Context, in *GetPeriodHistoryRequest, opts ...grpc.CallOption) (*GetPeriodHistoryResponse, error)
+	CheckPeriod(ctx context.Context, in *CheckPeriodRequest, opts ...grpc.CallOption) (*CheckPeriodResponse, error)
+}
+
+type accountingPeriodServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewAccountingPeriodServiceClient(cc grpc.ClientConnInterface) AccountingPeriodServiceClient {
+	return &accountingPeriodServiceClient{cc}
+}
+
+func (c *accountingPeriodServiceClient) ClosePeriod(ctx context.Context, in *AccountingPeriodRequest, opts ...grpc.CallOption) (*AbsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AbsResponse)
+	err := c.cc.Invoke(ctx, AccountingPeriodService_ClosePeriod_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountingPeriodServiceClient) ReopenPeriod(ctx context.Context, in *AccountingPeriodRequest, opts ...grpc.CallOption) (*AbsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AbsResponse)
+	err := c.cc.Invoke(ctx, AccountingPeriodService_ReopenPeriod_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountingPeriodServiceClient) GetAllPeriods(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetAllPeriodsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetAllPeriodsResponse)
+	err := c.cc.Invoke(ctx, AccountingPeriodService_GetAllPeriods_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountingPeriodServiceClient) GetPeriodHistory(ctx context.Context, in *GetPeriodHistoryRequest, opts ...grpc.CallOption) (*GetPeriodHistoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetPeriodHistoryResponse)
+	err := c.cc.Invoke(ctx, AccountingPeriodService_GetPeriodHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountingPeriodServiceClient) CheckPeriod(ctx context.Context, in *CheckPeriodRequest, opts ...grpc.CallOption) (*CheckPeriodResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CheckPeriodResponse)
+	err := c.cc.Invoke(ctx, AccountingPeriodService_CheckPeriod_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// AccountingPeriodServiceServer is the server API for AccountingPeriodService service.
+// All implementations must embed UnimplementedAccountingPeriodServiceServer
+// for forward compatibility.
+//
+// accounting period service start
+type AccountingPeriodServiceServer interface {
+	ClosePeriod(context.Context, *AccountingPeriodRequest) (*AbsResponse, error)
+	ReopenPeriod(context.Context, *AccountingPeriodRequest) (*AbsResponse, error)
+	GetAllPeriods(context.Context, *emptypb.Empty) (*GetAllPeriodsResponse, error)
+	GetPeriodHistory(context.Context, *GetPeriodHistoryRequest) (*GetPeriodHistoryResponse, error)
+	CheckPeriod(context.Context, *CheckPeriodRequest) (*CheckPeriodResponse, error)
+	mustEmbedUnimplementedAccountingPeriodServiceServer()
+}
+
+// UnimplementedAccountingPeriodServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedAccountingPeriodServiceServer struct{}
+
+func (UnimplementedAccountingPeriodServiceServer) ClosePeriod(context.Context, *AccountingPeriodRequest) (*AbsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClosePeriod not implemented")
+}
+func (UnimplementedAccountingPeriodServiceServer) ReopenPeriod(context.Context, *AccountingPeriodRequest) (*AbsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReopenPeriod not implemented")
+}
+func (UnimplementedAccountingPeriodServiceServer) GetAllPeriods(context.Context, *emptypb.Empty) (*GetAllPeriodsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAllPeriods not implemented")
+}
+func (UnimplementedAccountingPeriodServiceServer) GetPeriodHistory(context.Context, *GetPeriodHistoryRequest) (*GetPeriodHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPeriodHistory not implemented")
+}
+func (UnimplementedAccountingPeriodServiceServer) CheckPeriod(context.Context, *CheckPeriodRequest) (*CheckPeriodResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckPeriod not implemented")
+}
+func (UnimplementedAccountingPeriodServiceServer) mustEmbedUnimplementedAccountingPeriodServiceServer() {
+}
+func (UnimplementedAccountingPeriodServiceServer) testEmbeddedByValue() {}
+
+// UnsafeAccountingPeriodServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to AccountingPeriodServiceServer will
+// result in compilation errors.
+type UnsafeAccountingPeriodServiceServer interface {
+	mustEmbedUnimplementedAccountingPeriodServiceServer()
+}
+
+func RegisterAccountingPeriodServiceServer(s grpc.ServiceRegistrar, srv AccountingPeriodServiceServer) {
+	// If the following call pancis, it indicates UnimplementedAccountingPeriodServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&AccountingPeriodService_ServiceDesc, srv)
+}
+
+func _AccountingPeriodService_ClosePeriod_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AccountingPeriodRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountingPeriodServiceServer).ClosePeriod(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AccountingPeriodService_ClosePeriod_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountingPeriodServiceServer).ClosePeriod(ctx, req.(*AccountingPeriodRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccountingPeriodService_ReopenPeriod_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AccountingPeriodRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountingPeriodServiceServer).ReopenPeriod(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AccountingPeriodService_ReopenPeriod_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountingPeriodServiceServer).ReopenPeriod(ctx, req.(*AccountingPeriodRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccountingPeriodService_GetAllPeriods_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountingPeriodServiceServer).GetAllPeriods(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AccountingPeriodService_GetAllPeriods_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountingPeriodServiceServer).GetAllPeriods(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccountingPeriodService_GetPeriodHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPeriodHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountingPeriodServiceServer).GetPeriodHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AccountingPeriodService_GetPeriodHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountingPeriodServiceServer).GetPeriodHistory(ctx, req.(*GetPeriodHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccountingPeriodService_CheckPeriod_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckPeriodRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountingPeriodServiceServer).CheckPeriod(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AccountingPeriodService_CheckPeriod_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountingPeriodServiceServer).CheckPeriod(ctx, req.(*CheckPeriodRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// AccountingPeriodService_ServiceDesc is the grpc.ServiceDesc for AccountingPeriodService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var AccountingPeriodService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "finance.AccountingPeriodService",
+	HandlerType: (*AccountingPeriodServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ClosePeriod",
+			Handler:    _AccountingPeriodService_ClosePeriod_Handler,
+		},
+		{
+			MethodName: "ReopenPeriod",
+			Handler:    _AccountingPeriodService_ReopenPeriod_Handler,
+		},
+		{
+			MethodName: "GetAllPeriods",
+			Handler:    _AccountingPeriodService_GetAllPeriods_Handler,
+		},
+		{
+			MethodName: "GetPeriodHistory",
+			Handler:    _AccountingPeriodService_GetPeriodHistory_Handler,
+		},
+		{
+			MethodName: "CheckPeriod",
+			Handler:    _AccountingPeriodService_CheckPeriod_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "finance.proto",
+}
+
+const (
+	PayrollService_CreatePayrollRun_FullMethodName        = "/finance.PayrollService/CreatePayrollRun"
+	PayrollService_GetPayrollRuns_FullMethodName          = "/finance.PayrollService/GetPayrollRuns"
+	PayrollService_GetPayrollRunById_FullMethodName       = "/finance.PayrollService/GetPayrollRunById"
+	PayrollService_DeletePayrollRun_FullMethodName        = "/finance.PayrollService/DeletePayrollRun"
+	PayrollService_AddPayrollAdjustment_FullMethodName    = "/finance.PayrollService/AddPayrollAdjustment"
+	PayrollService_DeletePayrollAdjustment_FullMethodName = "/finance.PayrollService/DeletePayrollAdjustment"
+	PayrollService_ApprovePayrollRun_FullMethodName       = "/finance.PayrollService/ApprovePayrollRun"
+	PayrollService_PayPayrollRun_FullMethodName           = "/finance.PayrollService/PayPayrollRun"
+	PayrollService_GetPayslip_FullMethodName              = "/finance.PayrollService/GetPayslip"
+	PayrollService_GetTeacherPayslips_FullMethodName      = "/finance.PayrollService/GetTeacherPayslips"
+)
+
+// PayrollServiceClient is the client API for PayrollService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// payroll service start
+type PayrollServiceClient interface {
+	CreatePayrollRun(ctx context.Context, in *CreatePayrollRunRequest, opts ...grpc.CallOption) (*AbsResponse, error)
+	GetPayrollRuns(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetPayrollRunsResponse, error)
+	GetPayrollRunById(ctx context.Context, in *PayrollRunIdRequest, opts ...grpc.CallOption) (*AbsPayrollRun, error)
+	DeletePayrollRun(ctx context.Context, in *PayrollRunIdRequest, opts ...grpc.CallOption) (*AbsResponse, error)
+	AddPayrollAdjustment(ctx context.Context, in *AddPayrollAdjustmentRequest, opts ...grpc.CallOption) (*AbsResponse, error)
+	DeletePayrollAdjustment(ctx context.Context, in *DeletePayrollAdjustmentRequest, opts ...grpc.CallOption) (*AbsResponse, error)
+	ApprovePayrollRun(ctx context.Context, in *PayrollRunActionRequest, opts ...grpc.CallOption) (*AbsResponse, error)
+	PayPayrollRun(ctx context.Context, in *PayPayrollRunRequest, opts ...grpc.CallOption) (*AbsResponse, error)
+	GetPayslip(ctx context.Context, in *GetPayslipRequest, opts ...grpc.CallOption) (*Payslip, error)
+	GetTeacherPayslips(ctx context.Context, in *GetTeacherPayslipsRequest, opts ...grpc.CallOption) (*GetTeacherPayslipsResponse, error)
+}
+
+type payrollServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewPayrollServiceClient(cc grpc.ClientConnInterface) PayrollServiceClient {
+	return &payrollServiceClient{cc}
+}
+
+func (c *payrollServiceClient) CreatePayrollRun(ctx context.Context, in *CreatePayrollRunRequest, opts ...grpc.CallOption) (*AbsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AbsResponse)
+	err := c.cc.Invoke(ctx, PayrollService_CreatePayrollRun_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *payrollServiceClient) GetPayrollRuns(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetPayrollRunsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetPayrollRunsResponse)
+	err := c.cc.Invoke(ctx, PayrollService_GetPayrollRuns_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *payrollServiceClient) GetPayrollRunById(ctx context.Context, in *PayrollRunIdRequest, opts ...grpc.CallOption) (*AbsPayrollRun, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AbsPayrollRun)
+	err := c.cc.Invoke(ctx, PayrollService_GetPayrollRunById_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *payrollServiceClient) DeletePayrollRun(ctx context.Context, in *PayrollRunIdRequest, opts ...grpc.CallOption) (*AbsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AbsResponse)
+	err := c.cc.Invoke(ctx, PayrollService_DeletePayrollRun_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *payrollServiceClient) AddPayrollAdjustment(ctx context.Context, in *AddPayrollAdjustmentRequest, opts ...grpc.CallOption) (*AbsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AbsResponse)
+	err := c.cc.Invoke(ctx, PayrollService_AddPayrollAdjustment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *payrollServiceClient) DeletePayrollAdjustment(ctx context.Context, in *DeletePayrollAdjustmentRequest, opts ...grpc.CallOption) (*AbsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AbsResponse)
+	err := c.cc.Invoke(ctx, PayrollService_DeletePayrollAdjustment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *payrollServiceClient) ApprovePayrollRun(ctx context.Context, in *PayrollRunActionRequest, opts ...grpc.CallOption) (*AbsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AbsResponse)
+	err := c.cc.Invoke(ctx, PayrollService_ApprovePayrollRun_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *payrollServiceClient) PayPayrollRun(ctx context.Context, in *PayPayrollRunRequest, opts ...grpc.CallOption) (*AbsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AbsResponse)
+	err := c.cc.Invoke(ctx, PayrollService_PayPayrollRun_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *payrollServiceClient) GetPayslip(ctx context.Context, in *GetPayslipRequest, opts ...grpc.CallOption) (*Payslip, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Payslip)
+	err := c.cc.Invoke(ctx, PayrollService_GetPayslip_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *payrollServiceClient) GetTeacherPayslips(ctx context.Context, in *GetTeacherPayslipsRequest, opts ...grpc.CallOption) (*GetTeacherPayslipsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetTeacherPayslipsResponse)
+	err := c.cc.Invoke(ctx, PayrollService_GetTeacherPayslips_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// PayrollServiceServer is the server API for PayrollService service.
+// All implementations must embed UnimplementedPayrollServiceServer
+// for forward compatibility.
+//
+// payroll service start
+type PayrollServiceServer interface {
+	CreatePayrollRun(context.Context, *CreatePayrollRunRequest) (*AbsResponse, error)
+	GetPayrollRuns(context.Context, *emptypb.Empty) (*GetPayrollRunsResponse, error)
+	GetPayrollRunById(context.Context, *PayrollRunIdRequest) (*AbsPayrollRun, error)
+	DeletePayrollRun(context.Context, *PayrollRunIdRequest) (*AbsResponse, error)
+	AddPayrollAdjustment(context.Context, *AddPayrollAdjustmentRequest) (*AbsResponse, error)
+	DeletePayrollAdjustment(context.Context, *DeletePayrollAdjustmentRequest) (*AbsResponse, error)
+	ApprovePayrollRun(context.Context, *PayrollRunActionRequest) (*AbsResponse, error)
+	PayPayrollRun(context.Context, *PayPayrollRunRequest) (*AbsResponse, error)
+	GetPayslip(context.Context, *GetPayslipRequest) (*Payslip, error)
+	GetTeacherPayslips(context.Context, *GetTeacherPayslipsRequest) (*GetTeacherPayslipsResponse, error)
+	mustEmbedUnimplementedPayrollServiceServer()
+}
+
+// UnimplementedPayrollServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedPayrollServiceServer struct{}
+
+func (UnimplementedPayrollServiceServer) CreatePayrollRun(context.Context, *CreatePayrollRunRequest) (*AbsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreatePayrollRun not implemented")
+}
+func (UnimplementedPayrollServiceServer) GetPayrollRuns(context.Context, *emptypb.Empty) (*GetPayrollRunsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPayrollRuns not implemented")
+}
+func (UnimplementedPayrollServiceServer) GetPayrollRunById(context.Context, *PayrollRunIdRequest) (*AbsPayrollRun, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPayrollRunById not implemented")
+}
+func (UnimplementedPayrollServiceServer) DeletePayrollRun(context.Context, *PayrollRunIdRequest) (*AbsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeletePayrollRun not implemented")
+}
+func (UnimplementedPayrollServiceServer) AddPayrollAdjustment(context.Context, *AddPayrollAdjustmentRequest) (*AbsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddPayrollAdjustment not implemented")
+}
+func (UnimplementedPayrollServiceServer) DeletePayrollAdjustment(context.Context, *DeletePayrollAdjustmentRequest) (*AbsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeletePayrollAdjustment not implemented")
+}
+func (UnimplementedPayrollServiceServer) ApprovePayrollRun(context.Context, *PayrollRunActionRequest) (*AbsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApprovePayrollRun not implemented")
+}
+func (UnimplementedPayrollServiceServer) PayPayrollRun(context.Context, *PayPayrollRunRequest) (*AbsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PayPayrollRun not implemented")
+}
+func (UnimplementedPayrollServiceServer) GetPayslip(context.Context, *GetPayslipRequest) (*Payslip, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPayslip not implemented")
+}
+func (UnimplementedPayrollServiceServer) GetTeacherPayslips(context.Context, *GetTeacherPayslipsRequest) (*GetTeacherPayslipsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTeacherPayslips not implemented")
+}
+func (UnimplementedPayrollServiceServer) mustEmbedUnimplementedPayrollServiceServer() {}
+func (UnimplementedPayrollServiceServer) testEmbeddedByValue()                        {}
+
+// UnsafePayrollServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to PayrollServiceServer will
+// result in compilation errors.
+type UnsafePayrollServiceServer interface {
+	mustEmbedUnimplementedPayrollServiceServer()
+}
+
+func RegisterPayrollServiceServer(s grpc.ServiceRegistrar, srv PayrollServiceServer) {
+	// If the following call pancis, it indicates UnimplementedPayrollServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&PayrollService_ServiceDesc, srv)
+}
+
+func _PayrollService_CreatePayrollRun_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreatePayrollRunRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PayrollServiceServer).CreatePayrollRun(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PayrollService_CreatePayrollRun_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PayrollServiceServer).CreatePayrollRun(ctx, req.(*CreatePayrollRunRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PayrollService_GetPayrollRuns_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PayrollServiceServer).GetPayrollRuns(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PayrollService_GetPayrollRuns_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PayrollServiceServer).GetPayrollRuns(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PayrollService_GetPayrollRunById_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PayrollRunIdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PayrollServiceServer).GetPayrollRunById(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PayrollService_GetPayrollRunById_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PayrollServiceServer).GetPayrollRunById(ctx, req.(*PayrollRunIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PayrollService_DeletePayrollRun_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PayrollRunIdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PayrollServiceServer).DeletePayrollRun(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PayrollService_DeletePayrollRun_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PayrollServiceServer).DeletePayrollRun(ctx, req.(*PayrollRunIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PayrollService_AddPayrollAdjustment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddPayrollAdjustmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PayrollServiceServer).AddPayrollAdjustment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PayrollService_AddPayrollAdjustment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PayrollServiceServer).AddPayrollAdjustment(ctx, req.(*AddPayrollAdjustmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PayrollService_DeletePayrollAdjustment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeletePayrollAdjustmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PayrollServiceServer).DeletePayrollAdjustment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PayrollService_DeletePayrollAdjustment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PayrollServiceServer).DeletePayrollAdjustment(ctx, req.(*DeletePayrollAdjustmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PayrollService_ApprovePayrollRun_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PayrollRunActionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PayrollServiceServer).ApprovePayrollRun(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PayrollService_ApprovePayrollRun_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PayrollServiceServer).ApprovePayrollRun(ctx, req.(*PayrollRunActionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PayrollService_PayPayrollRun_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PayPayrollRunRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PayrollServiceServer).PayPayrollRun(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PayrollService_PayPayrollRun_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PayrollServiceServer).PayPayrollRun(ctx, req.(*PayPayrollRunRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PayrollService_GetPayslip_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPayslipRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PayrollServiceServer).GetPayslip(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PayrollService_GetPayslip_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PayrollServiceServer).GetPayslip(ctx, req.(*GetPayslipRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PayrollService_GetTeacherPayslips_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTeacherPayslipsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PayrollServiceServer).GetTeacherPayslips(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PayrollService_GetTeacherPayslips_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PayrollServiceServer).GetTeacherPayslips(ctx, req.(*GetTeacherPayslipsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// PayrollService_ServiceDesc is the grpc.ServiceDesc for PayrollService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var PayrollService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "finance.PayrollService",
+	HandlerType: (*PayrollServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreatePayrollRun",
+			Handler:    _PayrollService_CreatePayrollRun_Handler,
+		},
+		{
+			MethodName: "GetPayrollRuns",
+			Handler:    _PayrollService_GetPayrollRuns_Handler,
+		},
+		{
+			MethodName: "GetPayrollRunById",
+			Handler:    _PayrollService_GetPayrollRunById_Handler,
+		},
+		{
+			MethodName: "DeletePayrollRun",
+			Handler:    _PayrollService_DeletePayrollRun_Handler,
+		},
+		{
+			MethodName: "AddPayrollAdjustment",
+			Handler:    _PayrollService_AddPayrollAdjustment_Handler,
+		},
+		{
+			MethodName: "DeletePayrollAdjustment",
+			Handler:    _PayrollService_DeletePayrollAdjustment_Handler,
+		},
+		{
+			MethodName: "ApprovePayrollRun",
+			Handler:    _PayrollService_ApprovePayrollRun_Handler,
+		},
+		{
+			MethodName: "PayPayrollRun",
+			Handler:    _PayrollService_PayPayrollRun_Handler,
+		},
+		{
+			MethodName: "GetPayslip",
+			Handler:    _PayrollService_GetPayslip_Handler,
+		},
+		{
+			MethodName: "GetTeacherPayslips",
+			Handler:    _PayrollService_GetTeacherPayslips_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "finance.proto",
+}
+
+const (
+	InstallmentService_CreateInstallmentPlan_FullMethodName    = "/finance.InstallmentService/CreateInstallmentPlan"
+	InstallmentService_GetInstallmentPlans_FullMethodName      = "/finance.InstallmentService/GetInstallmentPlans"
+	InstallmentService_GetInstallmentPlanById_FullMethodName   = "/finance.InstallmentService/GetInstallmentPlanById"
+	InstallmentService_CancelInstallmentPlan_FullMethodName    = "/finance.InstallmentService/CancelInstallmentPlan"
+	InstallmentService_ChargeInstallments_FullMethodName       = "/finance.InstallmentService/ChargeInstallments"
+	InstallmentService_ApplyInstallmentLateFees_FullMethodName = "/finance.InstallmentService/ApplyInstallmentLateFees"
+	InstallmentService_ChargeDueInstallments_FullMethodName    = "/finance.InstallmentService/ChargeDueInstallments"
+	InstallmentService_GetOverdueInstallments_FullMethodName   = "/finance.InstallmentService/GetOverdueInstallments"
+)
+
+// InstallmentServiceClient is the client API for InstallmentService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// installment service start
+type InstallmentServiceClient interface {
+	CreateInstallmentPlan(ctx context.Context, in *CreateInstallmentPlanRequest, opts ...grpc.CallOption) (*AbsResponse, error)
+	GetInstallmentPlans(ctx context.Context, in *GetInstallmentPlansRequest, opts ...grpc.CallOption) (*GetInstallmentPlansResponse, error)
+	GetInstallmentPlanById(ctx context.Context, in *InstallmentPlanIdRequest, opts ...grpc.CallOption) (*AbsInstallmentPlan, error)
+	CancelInstallmentPlan(ctx context.Context, in *CancelInstallmentPlanRequest, opts ...grpc.CallOption) (*AbsResponse, error)
+	ChargeInstallments(ctx context.Context, in *ChargeInstallmentsRequest, opts ...grpc.CallOption) (*ChargeInstallmentsResponse, error)
+	ApplyInstallmentLateFees(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*AbsResponse, error)
+	ChargeDueInstallments(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*AbsResponse, error)
+	GetOverdueInstallments(ctx context.Context, in *GetOverdueInstallmentsRequest, opts ...grpc.CallOption) (*GetOverdueInstallmentsResponse, error)
+}
+
+type installmentServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewInstallmentServiceClient(cc grpc.ClientConnInterface) InstallmentServiceClient {
+	return &installmentServiceClient{cc}
+}
+
+func (c *installmentServiceClient) CreateInstallmentPlan(ctx context.Context, in *CreateInstallmentPlanRequest, opts ...grpc.CallOption) (*AbsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AbsResponse)
+	err := c.cc.Invoke(ctx, InstallmentService_CreateInstallmentPlan_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *installmentServiceClient) GetInstallmentPlans(ctx context.Context, in *GetInstallmentPlansRequest, opts ...grpc.CallOption) (*GetInstallmentPlansResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetInstallmentPlansResponse)
+	err := c.cc.Invoke(ctx, InstallmentService_GetInstallmentPlans_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *installmentServiceClient) GetInstallmentPlanById(ctx context.Context, in *InstallmentPlanIdRequest, opts ...grpc.CallOption) (*AbsInstallmentPlan, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AbsInstallmentPlan)
+	err := c.cc.Invoke(ctx, InstallmentService_GetInstallmentPlanById_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *installmentServiceClient) CancelInstallmentPlan(ctx context.Context, in *CancelInstallmentPlanRequest, opts ...grpc.CallOption) (*AbsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AbsResponse)
+	err := c.cc.Invoke(ctx, InstallmentService_CancelInstallmentPlan_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *installmentServiceClient) ChargeInstallments(ctx context.Context, in *ChargeInstallmentsRequest, opts ...grpc.CallOption) (*ChargeInstallmentsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ChargeInstallmentsResponse)
+	err := c.cc.Invoke(ctx, InstallmentService_ChargeInstallments_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *installmentServiceClient) ApplyInstallmentLateFees(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*AbsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AbsResponse)
+	err := c.cc.Invoke(ctx, InstallmentService_ApplyInstallmentLateFees_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *installmentServiceClient) ChargeDueInstallments(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*AbsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AbsResponse)
+	err := c.cc.Invoke(ctx, InstallmentService_ChargeDueInstallments_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *installmentServiceClient) GetOverdueInstallments(ctx context.Context, in *GetOverdueInstallmentsRequest, opts ...grpc.CallOption) (*GetOverdueInstallmentsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetOverdueInstallmentsResponse)
+	err := c.cc.Invoke(ctx, InstallmentService_GetOverdueInstallments_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// InstallmentServiceServer is the server API for InstallmentService service.
+// All implementations must embed UnimplementedInstallmentServiceServer
+// for forward compatibility.
+//
+// installment service start
+type InstallmentServiceServer interface {
+	CreateInstallmentPlan(context.Context, *CreateInstallmentPlanRequest) (*AbsResponse, error)
+	GetInstallmentPlans(context.Context, *GetInstallmentPlansRequest) (*GetInstallmentPlansResponse, error)
+	GetInstallmentPlanById(context.Context, *InstallmentPlanIdRequest) (*AbsInstallmentPlan, error)
+	CancelInstallmentPlan(context.Context, *CancelInstallmentPlanRequest) (*AbsResponse, error)
+	ChargeInstallments(context.Context, *ChargeInstallmentsRequest) (*ChargeInstallmentsResponse, error)
+	ApplyInstallmentLateFees(context.Context, *emptypb.Empty) (*AbsResponse, error)
+	ChargeDueInstallments(context.Context, *emptypb.Empty) (*AbsResponse, error)
+	GetOverdueInstallments(context.Context, *GetOverdueInstallmentsRequest) (*GetOverdueInstallmentsResponse, error)
+	mustEmbedUnimplementedInstallmentServiceServer()
+}
+
+// UnimplementedInstallmentServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedInstallmentServiceServer struct{}
+
+func (UnimplementedInstallmentServiceServer) CreateInstallmentPlan(context.Context, *CreateInstallmentPlanRequest) (*AbsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateInstallmentPlan not implemented")
+}
+func (UnimplementedInstallmentServiceServer) GetInstallmentPlans(context.Context, *GetInstallmentPlansRequest) (*GetInstallmentPlansResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetInstallmentPlans not implemented")
+}
+func (UnimplementedInstallmentServiceServer) GetInstallmentPlanById(context.Context, *InstallmentPlanIdRequest) (*AbsInstallmentPlan, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetInstallmentPlanById not implemented")
+}
+func (UnimplementedInstallmentServiceServer) CancelInstallmentPlan(context.Context, *CancelInstallmentPlanRequest) (*AbsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelInstallmentPlan not implemented")
+}
+func (UnimplementedInstallmentServiceServer) ChargeInstallments(context.Context, *ChargeInstallmentsRequest) (*ChargeInstallmentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChargeInstallments not implemented")
+}
+func (UnimplementedInstallmentServiceServer) ApplyInstallmentLateFees(context.Context, *emptypb.Empty) (*AbsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApplyInstallmentLateFees not implemented")
+}
+func (UnimplementedInstallmentServiceServer) ChargeDueInstallments(context.Context, *emptypb.Empty) (*AbsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChargeDueInstallments not implemented")
+}
+func (UnimplementedInstallmentServiceServer) GetOverdueInstallments(context.Context, *GetOverdueInstallmentsRequest) (*GetOverdueInstallmentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOverdueInstallments not implemented")
+}
+func (UnimplementedInstallmentServiceServer) mustEmbedUnimplementedInstallmentServiceServer() {}
+func (UnimplementedInstallmentServiceServer) testEmbeddedByValue()                            {}
+
+// UnsafeInstallmentServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to InstallmentServiceServer will
+// result in compilation errors.
+type UnsafeInstallmentServiceServer interface {
+	mustEmbedUnimplementedInstallmentServiceServer()
+}
+
+func RegisterInstallmentServiceServer(s grpc.ServiceRegistrar, srv InstallmentServiceServer) {
+	// If the following call pancis, it indicates UnimplementedInstallmentServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&InstallmentService_ServiceDesc, srv)
+}
+
+func _InstallmentService_CreateInstallmentPlan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateInstallmentPlanRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InstallmentServiceServer).CreateInstallmentPlan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InstallmentService_CreateInstallmentPlan_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InstallmentServiceServer).CreateInstallmentPlan(ctx, req.(*CreateInstallmentPlanRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InstallmentService_GetInstallmentPlans_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetInstallmentPlansRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InstallmentServiceServer).GetInstallmentPlans(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InstallmentService_GetInstallmentPlans_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InstallmentServiceServer).GetInstallmentPlans(ctx, req.(*GetInstallmentPlansRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InstallmentService_GetInstallmentPlanById_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InstallmentPlanIdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InstallmentServiceServer).GetInstallmentPlanById(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InstallmentService_GetInstallmentPlanById_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InstallmentServiceServer).GetInstallmentPlanById(ctx, req.(*InstallmentPlanIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InstallmentService_CancelInstallmentPlan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelInstallmentPlanRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InstallmentServiceServer).CancelInstallmentPlan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InstallmentService_CancelInstallmentPlan_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InstallmentServiceServer).CancelInstallmentPlan(ctx, req.(*CancelInstallmentPlanRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InstallmentService_ChargeInstallments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChargeInstallmentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InstallmentServiceServer).ChargeInstallments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InstallmentService_ChargeInstallments_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InstallmentServiceServer).ChargeInstallments(ctx, req.(*ChargeInstallmentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InstallmentService_ApplyInstallmentLateFees_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InstallmentServiceServer).ApplyInstallmentLateFees(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InstallmentService_ApplyInstallmentLateFees_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InstallmentServiceServer).ApplyInstallmentLateFees(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InstallmentService_ChargeDueInstallments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InstallmentServiceServer).ChargeDueInstallments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InstallmentService_ChargeDueInstallments_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InstallmentServiceServer).ChargeDueInstallments(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InstallmentService_GetOverdueInstallments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOverdueInstallmentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InstallmentServiceServer).GetOverdueInstallments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InstallmentService_GetOverdueInstallments_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InstallmentServiceServer).GetOverdueInstallments(ctx, req.(*GetOverdueInstallmentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// InstallmentService_ServiceDesc is the grpc.ServiceDesc for InstallmentService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var InstallmentService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "finance.InstallmentService",
+	HandlerType: (*InstallmentServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateInstallmentPlan",
+			Handler:    _InstallmentService_CreateInstallmentPlan_Handler,
+		},
+		{
+			MethodName: "GetInstallmentPlans",
+			Handler:    _InstallmentService_GetInstallmentPlans_Handler,
+		},
+		{
+			MethodName: "GetInstallmentPlanById",
+			Handler:    _InstallmentService_GetInstallmentPlanById_Handler,
+		},
+		{
+			MethodName: "CancelInstallmentPlan",
+			Handler:    _InstallmentService_CancelInstallmentPlan_Handler,
+		},
+		{
+			MethodName: "ChargeInstallments",
+			Handler:    _InstallmentService_ChargeInstallments_Handler,
+		},
+		{
+			MethodName: "ApplyInstallmentLateFees",
+			Handler:    _InstallmentService_ApplyInstallmentLateFees_Handler,
+		},
+		{
+			MethodName: "ChargeDueInstallments",
+			Handler:    _InstallmentService_ChargeDueInstallments_Handler,
+		},
+		{
+			MethodName: "GetOverdueInstallments",
+			Handler:    _InstallmentService_GetOverdueInstallments_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "finance.proto",
+}
+
+const (
+	CollectionService_GetDebtAging_FullMethodName            = "/finance.CollectionService/GetDebtAging"
+	CollectionService_AssignDebtor_FullMethodName            = "/finance.CollectionService/AssignDebtor"
+	CollectionService_AddCollectionActivity_FullMethodName   = "/finance.CollectionService/AddCollectionActivity"
+	CollectionService_GetCollectionActivities_FullMethodName = "/finance.CollectionService/GetCollectionActivities"
+	CollectionService_GetCollectionSetting_FullMethodName    = "/finance.CollectionService/GetCollectionSetting"
+	CollectionService_UpdateCollectionSetting_FullMethodName = "/finance.CollectionService/UpdateCollectionSetting"
+	CollectionService_RunCollections_FullMethodName          = "/finance.CollectionService/RunCollections"
+)
+
+// CollectionServiceClient is the client API for CollectionService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// collection service start
+type CollectionServiceClient interface {
+	GetDebtAging(ctx context.Context, in *GetDebtAgingRequest, opts ...grpc.CallOption) (*GetDebtAgingResponse, error)
+	AssignDebtor(ctx context.Context, in *AssignDebtorRequest, opts ...grpc.CallOption) (*AbsResponse, error)
+	AddCollectionActivity(ctx context.Context, in *AddCollectionActivityRequest, opts ...grpc.CallOption) (*AbsResponse, error)
+	GetCollectionActivities(ctx context.Context, in *GetCollectionActivitiesRequest, opts ...grpc.CallOption) (*GetCollectionActivitiesResponse, error)
+	GetCollectionSetting(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*CollectionSetting, error)
+	UpdateCollectionSetting(ctx context.Context, in *CollectionSetting, opts ...grpc.CallOption) (*AbsResponse, error)
+	RunCollections(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*AbsResponse, error)
+}
+
+type collectionServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewCollectionServiceClient(cc grpc.ClientConnInterface) CollectionServiceClient {
+	return &collectionServiceClient{cc}
+}
+
+func (c *collectionServiceClient) GetDebtAging(ctx context.Context, in *GetDebtAgingRequest, opts ...grpc.CallOption) (*GetDebtAgingResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetDebtAgingResponse)
+	err := c.cc.Invoke(ctx, CollectionService_GetDebtAging_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *collectionServiceClient) AssignDebtor(ctx context.Context, in *AssignDebtorRequest, opts ...grpc.CallOption) (*AbsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AbsResponse)
+	err := c.cc.Invoke(ctx, CollectionService_AssignDebtor_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *collectionServiceClient) AddCollectionActivity(ctx context.Context, in *AddCollectionActivityRequest, opts ...grpc.CallOption) (*AbsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AbsResponse)
+	err := c.cc.Invoke(ctx, CollectionService_AddCollectionActivity_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *collectionServiceClient) GetCollectionActivities(ctx context.Context, in *GetCollectionActivitiesRequest, opts ...grpc.CallOption) (*GetCollectionActivitiesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetCollectionActivitiesResponse)
+	err := c.cc.Invoke(ctx, CollectionService_GetCollectionActivities_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *collectionServiceClient) GetCollectionSetting(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*CollectionSetting, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CollectionSetting)
+	err := c.cc.Invoke(ctx, CollectionService_GetCollectionSetting_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *collectionServiceClient) UpdateCollectionSetting(ctx context.Context, in *CollectionSetting, opts ...grpc.CallOption) (*AbsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AbsResponse)
+	err := c.cc.Invoke(ctx, CollectionService_UpdateCollectionSetting_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *collectionServiceClient) RunCollections(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*AbsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AbsResponse)
+	err := c.cc.Invoke(ctx, CollectionService_RunCollections_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// CollectionServiceServer is the server API for CollectionService service.
+// All implementations must embed UnimplementedCollectionServiceServer
+// for forward compatibility.
+//
+// collection service start
+type CollectionServiceServer interface {
+	GetDebtAging(context.Context, *GetDebtAgingRequest) (*GetDebtAgingResponse, error)
+	AssignDebtor(context.Context, *AssignDebtorRequest) (*AbsResponse, error)
+	AddCollectionActivity(context.Context, *AddCollectionActivityRequest) (*AbsResponse, error)
+	GetCollectionActivities(context.Context, *GetCollectionActivitiesRequest) (*GetCollectionActivitiesResponse, error)
+	GetCollectionSetting(context.Context, *emptypb.Empty) (*CollectionSetting, error)
+	UpdateCollectionSetting(context.Context, *CollectionSetting) (*AbsResponse, error)
+	RunCollections(context.Context, *emptypb.Empty) (*AbsResponse, error)
+	mustEmbedUnimplementedCollectionServiceServer()
+}
+
+// UnimplementedCollectionServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedCollectionServiceServer struct{}
+
+func (UnimplementedCollectionServiceServer) GetDebtAging(context.Context, *GetDebtAgingRequest) (*GetDebtAgingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDebtAging not implemented")
+}
+func (UnimplementedCollectionServiceServer) AssignDebtor(context.Context, *AssignDebtorRequest) (*AbsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AssignDebtor not implemented")
+}
+func (UnimplementedCollectionServiceServer) AddCollectionActivity(context.Context, *AddCollectionActivityRequest) (*AbsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddCollectionActivity not implemented")
+}
+func (UnimplementedCollectionServiceServer) GetCollectionActivities(context.Context, *GetCollectionActivitiesRequest) (*GetCollectionActivitiesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCollectionActivities not implemented")
+}
+func (UnimplementedCollectionServiceServer) GetCollectionSetting(context.Context, *emptypb.Empty) (*CollectionSetting, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCollectionSetting not implemented")
+}
+func (UnimplementedCollectionServiceServer) UpdateCollectionSetting(context.Context, *CollectionSetting) (*AbsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateCollectionSetting not implemented")
+}
+func (UnimplementedCollectionServiceServer) RunCollections(context.Context, *emptypb.Empty) (*AbsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RunCollections not implemented")
+}
+func (UnimplementedCollectionServiceServer) mustEmbedUnimplementedCollectionServiceServer() {}
+func (UnimplementedCollectionServiceServer) testEmbeddedByValue()                           {}
+
+// UnsafeCollectionServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to CollectionServiceServer will
+// result in compilation errors.
+type UnsafeCollectionServiceServer interface {
+	mustEmbedUnimplementedCollectionServiceServer()
+}
+
+func RegisterCollectionServiceServer(s grpc.ServiceRegistrar, srv CollectionServiceServer) {
+	// If the following call pancis, it indicates UnimplementedCollectionServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&CollectionService_ServiceDesc, srv)
+}
+
+func _CollectionService_GetDebtAging_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDebtAgingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CollectionServiceServer).GetDebtAging(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CollectionService_GetDebtAging_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CollectionServiceServer).GetDebtAging(ctx, req.(*GetDebtAgingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CollectionService_AssignDebtor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AssignDebtorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CollectionServiceServer).AssignDebtor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CollectionService_AssignDebtor_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CollectionServiceServer).AssignDebtor(ctx, req.(*AssignDebtorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CollectionService_AddCollectionActivity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddCollectionActivityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CollectionServiceServer).AddCollectionActivity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CollectionService_AddCollectionActivity_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CollectionServiceServer).AddCollectionActivity(ctx, req.(*AddCollectionActivityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CollectionService_GetCollectionActivities_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCollectionActivitiesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CollectionServiceServer).GetCollectionActivities(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CollectionService_GetCollectionActivities_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CollectionServiceServer).GetCollectionActivities(ctx, req.(*GetCollectionActivitiesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CollectionService_GetCollectionSetting_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CollectionServiceServer).GetCollectionSetting(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CollectionService_GetCollectionSetting_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CollectionServiceServer).GetCollectionSetting(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CollectionService_UpdateCollectionSetting_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CollectionSetting)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CollectionServiceServer).UpdateCollectionSetting(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CollectionService_UpdateCollectionSetting_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CollectionServiceServer).UpdateCollectionSetting(ctx, req.(*CollectionSetting))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CollectionService_RunCollections_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CollectionServiceServer).RunCollections(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CollectionService_RunCollections_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CollectionServiceServer).RunCollections(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// CollectionService_ServiceDesc is the grpc.ServiceDesc for CollectionService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var CollectionService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "finance.CollectionService",
+	HandlerType: (*CollectionServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetDebtAging",
+			Handler:    _CollectionService_GetDebtAging_Handler,
+		},
+		{
+			MethodName: "AssignDebtor",
+			Handler:    _CollectionService_AssignDebtor_Handler,
+		},
+		{
+			MethodName: "AddCollectionActivity",
+			Handler:    _CollectionService_AddCollectionActivity_Handler,
+		},
+		{
+			MethodName: "GetCollectionActivities",
+			Handler:    _CollectionService_GetCollectionActivities_Handler,
+		},
+		{
+			MethodName: "GetCollectionSetting",
+			Handler:    _CollectionService_GetCollectionSetting_Handler,
+		},
+		{
+			MethodName: "UpdateCollectionSetting",
+			Handler:    _CollectionService_UpdateCollectionSetting_Handler,
+		},
+		{
+			MethodName: "RunCollections",
+			Handler:    _CollectionService_RunCollections_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "finance.proto",
+}
+
+const (
+	ReportService_GetProfitAndLoss_FullMethodName = "/finance.ReportService/GetProfitAndLoss"
+)
+
+// ReportServiceClient is the client API for ReportService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// report service start
+type ReportServiceClient interface {
+	GetProfitAndLoss(ctx context.Context, in *GetProfitAndLossRequest, opts ...grpc.CallOption) (*GetProfitAndLossResponse, error)
+}
+
+type reportServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewReportServiceClient(cc grpc.ClientConnInterface) ReportServiceClient {
+	return &reportServiceClient{cc}
+}
+
+func (c *reportServiceClient) GetProfitAndLoss(ctx context.Context, in *GetProfitAndLossRequest, opts ...grpc.CallOption) (*GetProfitAndLossResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetProfitAndLossResponse)
+	err := c.cc.Invoke(ctx, ReportService_GetProfitAndLoss_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ReportServiceServer is the server API for ReportService service.
+// All implementations must embed UnimplementedReportServiceServer
 // for forward compatibility.
 //
-// expense service end
-// payment service start
-type PaymentServiceServer interface {
-	PaymentAdd(context.Context, *PaymentAddRequest) (*AbsResponse, error)
-	PaymentReturn(context.Context, *PaymentReturnRequest) (*AbsResponse, error)
-	PaymentUpdate(context.Context, *PaymentUpdateRequest) (*AbsResponse, error)
-	GetMonthlyStatus(context.Context, *GetMonthlyStatusRequest) (*GetMonthlyStatusResponse, error)
-	GetAllPaymentsByMonth(context.Context, *GetAllPaymentsByMonthRequest) (*GetAllPaymentsByMonthResponse, error)
-	GetAllPaymentTakeOff(context.Context, *GetAllPaymentTakeOffRequest) (*GetAllPaymentTakeOffResponse, error)
-	GetAllPaymentTakeOffChart(context.Context, *GetAllPaymentTakeOffRequest) (*GetAllPaymentTakeOffChartResponse, error)
-	GetAllStudentPayments(context.Context, *GetAllStudentPaymentsRequest) (*GetAllStudentPaymentsResponse, error)
-	GetAllStudentPaymentsChart(context.Context, *GetAllStudentPaymentsRequest) (*GetAllStudentPaymentsChartResponse, error)
-	GetAllDebtsInformation(context.Context, *GetAllDebtsRequest) (*GetAllDebtsInformationResponse, error)
-	GetCommonFinanceInformation(context.Context, *emptypb.Empty) (*GetCommonInformationResponse, error)
-	GetIncomeChart(context.Context, *GetIncomeChartRequest) (*GetIncomeChartResponse, error)
-	mustEmbedUnimplementedPaymentServiceServer()
+// report service start
+type ReportServiceServer interface {
+	GetProfitAndLoss(context.Context, *GetProfitAndLossRequest) (*GetProfitAndLossResponse, error)
+	mustEmbedUnimplementedReportServiceServer()
 }
 
-// UnimplementedPaymentServiceServer must be embedded to have
+// UnimplementedReportServiceServer must be embedded to have
 // forward compatible implementations.
 //
 // NOTE: this should be embedded by value instead of pointer to avoid a nil
 // pointer dereference when methods are called.
-type UnimplementedPaymentServiceServer struct{}
+type UnimplementedReportServiceServer struct{}
 
-func (UnimplementedPaymentServiceServer) PaymentAdd(context.Context, *PaymentAddRequest) (*AbsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method PaymentAdd not implemented")
-}
-func (UnimplementedPaymentServiceServer) PaymentReturn(context.Context, *PaymentReturnRequest) (*AbsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method PaymentReturn not implemented")
-}
-func (UnimplementedPaymentServiceServer) PaymentUpdate(context.Context, *PaymentUpdateRequest) (*AbsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method PaymentUpdate not implemented")
-}
-func (UnimplementedPaymentServiceServer) GetMonthlyStatus(context.Context, *GetMonthlyStatusRequest) (*GetMonthlyStatusResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetMonthlyStatus not implemented")
-}
-func (UnimplementedPaymentServiceServer) GetAllPaymentsByMonth(context.Context, *GetAllPaymentsByMonthRequest) (*GetAllPaymentsByMonthResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetAllPaymentsByMonth not implemented")
-}
-func (UnimplementedPaymentServiceServer) GetAllPaymentTakeOff(context.Context, *GetAllPaymentTakeOffRequest) (*GetAllPaymentTakeOffResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetAllPaymentTakeOff not implemented")
-}
-func (UnimplementedPaymentServiceServer) GetAllPaymentTakeOffChart(context.Context, *GetAllPaymentTakeOffRequest) (*GetAllPaymentTakeOffChartResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetAllPaymentTakeOffChart not implemented")
-}
-func (UnimplementedPaymentServiceServer) GetAllStudentPayments(context.Context, *GetAllStudentPaymentsRequest) (*GetAllStudentPaymentsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetAllStudentPayments not implemented")
-}
-func (UnimplementedPaymentServiceServer) GetAllStudentPaymentsChart(context.Context, *GetAllStudentPaymentsRequest) (*GetAllStudentPaymentsChartResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetAllStudentPaymentsChart not implemented")
-}
-func (UnimplementedPaymentServiceServer) GetAllDebtsInformation(context.Context, *GetAllDebtsRequest) (*GetAllDebtsInformationResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetAllDebtsInformation not implemented")
+func (UnimplementedReportServiceServer) GetProfitAndLoss(context.Context, *GetProfitAndLossRequest) (*GetProfitAndLossResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProfitAndLoss not implemented")
 }
-func (UnimplementedPaymentServiceServer) GetCommonFinanceInformation(context.Context, *emptypb.Empty) (*GetCommonInformationResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetCommonFinanceInformation not implemented")
-}
-func (UnimplementedPaymentServiceServer) GetIncomeChart(context.Context, *GetIncomeChartRequest) (*GetIncomeChartResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetIncomeChart not implemented")
-}
-func (UnimplementedPaymentServiceServer) mustEmbedUnimplementedPaymentServiceServer() {}
-func (UnimplementedPaymentServiceServer) testEmbeddedByValue()                        {}
+func (UnimplementedReportServiceServer) mustEmbedUnimplementedReportServiceServer() {}
+func (UnimplementedReportServiceServer) testEmbeddedByValue()                       {}
 
-// UnsafePaymentServiceServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to PaymentServiceServer will
+// UnsafeReportServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ReportServiceServer will
 // result in compilation errors.
-type UnsafePaymentServiceServer interface {
-	mustEmbedUnimplementedPaymentServiceServer()
+type UnsafeReportServiceServer interface {
+	mustEmbedUnimplementedReportServiceServer()
 }
 
-func RegisterPaymentServiceServer(s grpc.ServiceRegistrar, srv PaymentServiceServer) {
-	// If the following call pancis, it indicates UnimplementedPaymentServiceServer was
+func RegisterReportServiceServer(s grpc.ServiceRegistrar, srv ReportServiceServer) {
+	// If the following call pancis, it indicates UnimplementedReportServiceServer was
 	// embedded by pointer and is nil.  This will cause panics if an
 	// unimplemented method is ever invoked, so we test this at initialization
 	// time to prevent it from happening at runtime later due to I/O.
 	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
 		t.testEmbeddedByValue()
 	}
-	s.RegisterService(&PaymentService_ServiceDesc, srv)
+	s.RegisterService(&ReportService_ServiceDesc, srv)
 }
 
-func _PaymentService_PaymentAdd_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(PaymentAddRequest)
+func _ReportService_GetProfitAndLoss_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetProfitAndLossRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(PaymentServiceServer).PaymentAdd(ctx, in)
+		return srv.(ReportServiceServer).GetProfitAndLoss(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: PaymentService_PaymentAdd_FullMethodName,
+		FullMethod: ReportService_GetProfitAndLoss_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PaymentServiceServer).PaymentAdd(ctx, req.(*PaymentAddRequest))
+		return srv.(ReportServiceServer).GetProfitAndLoss(ctx, req.(*GetProfitAndLossRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _PaymentService_PaymentReturn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(PaymentReturnRequest)
-	if err := dec(in); err != nil {
+// ReportService_ServiceDesc is the grpc.ServiceDesc for ReportService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ReportService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "finance.ReportService",
+	HandlerType: (*ReportServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetProfitAndLoss",
+			Handler:    _ReportService_GetProfitAndLoss_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "finance.proto",
+}
+
+const (
+	OneCExportService_GetOneCSetting_FullMethodName    = "/finance.OneCExportService/GetOneCSetting"
+	OneCExportService_UpdateOneCSetting_FullMethodName = "/finance.OneCExportService/UpdateOneCSetting"
+	OneCExportService_ExportOneC_FullMethodName        = "/finance.OneCExportService/ExportOneC"
+)
+
+// OneCExportServiceClient is the client API for OneCExportService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// one c export service start
+type OneCExportServiceClient interface {
+	GetOneCSetting(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*OneCSetting, error)
+	UpdateOneCSetting(ctx context.Context, in *OneCSetting, opts ...grpc.CallOption) (*AbsResponse, error)
+	ExportOneC(ctx context.Context, in *ExportOneCRequest, opts ...grpc.CallOption) (*ExportOneCResponse, error)
+}
+
+type oneCExportServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewOneCExportServiceClient(cc grpc.ClientConnInterface) OneCExportServiceClient {
+	return &oneCExportServiceClient{cc}
+}
+
+func (c *oneCExportServiceClient) GetOneCSetting(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*OneCSetting, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(OneCSetting)
+	err := c.cc.Invoke(ctx, OneCExportService_GetOneCSetting_FullMethodName, in, out, cOpts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(PaymentServiceServer).PaymentReturn(ctx, in)
+	return out, nil
+}
+
+func (c *oneCExportServiceClient) UpdateOneCSetting(ctx context.Context, in *OneCSetting, opts ...grpc.CallOption) (*AbsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AbsResponse)
+	err := c.cc.Invoke(ctx, OneCExportService_UpdateOneCSetting_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: PaymentService_PaymentReturn_FullMethodName,
+	return out, nil
+}
+
+func (c *oneCExportServiceClient) ExportOneC(ctx context.Context, in *ExportOneCRequest, opts ...grpc.CallOption) (*ExportOneCResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExportOneCResponse)
+	err := c.cc.Invoke(ctx, OneCExportService_ExportOneC_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PaymentServiceServer).PaymentReturn(ctx, req.(*PaymentReturnRequest))
+	return out, nil
+}
+
+// OneCExportServiceServer is the server API for OneCExportService service.
+// All implementations must embed UnimplementedOneCExportServiceServer
+// for forward compatibility.
+//
+// one c export service start
+type OneCExportServiceServer interface {
+	GetOneCSetting(context.Context, *emptypb.Empty) (*OneCSetting, error)
+	UpdateOneCSetting(context.Context, *OneCSetting) (*AbsResponse, error)
+	ExportOneC(context.Context, *ExportOneCRequest) (*ExportOneCResponse, error)
+	mustEmbedUnimplementedOneCExportServiceServer()
+}
+
+// UnimplementedOneCExportServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedOneCExportServiceServer struct{}
+
+func (UnimplementedOneCExportServiceServer) GetOneCSetting(context.Context, *emptypb.Empty) (*OneCSetting, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOneCSetting not implemented")
+}
+func (UnimplementedOneCExportServiceServer) UpdateOneCSetting(context.Context, *OneCSetting) (*AbsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateOneCSetting not implemented")
+}
+func (UnimplementedOneCExportServiceServer) ExportOneC(context.Context, *ExportOneCRequest) (*ExportOneCResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportOneC not implemented")
+}
+func (UnimplementedOneCExportServiceServer) mustEmbedUnimplementedOneCExportServiceServer() {}
+func (UnimplementedOneCExportServiceServer) testEmbeddedByValue()                           {}
+
+// UnsafeOneCExportServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to OneCExportServiceServer will
+// result in compilation errors.
+type UnsafeOneCExportServiceServer interface {
+	mustEmbedUnimplementedOneCExportServiceServer()
+}
+
+func RegisterOneCExportServiceServer(s grpc.ServiceRegistrar, srv OneCExportServiceServer) {
+	// If the following call pancis, it indicates UnimplementedOneCExportServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
 	}
-	return interceptor(ctx, in, info, handler)
+	s.RegisterService(&OneCExportService_ServiceDesc, srv)
 }
 
-func _PaymentService_PaymentUpdate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(PaymentUpdateRequest)
+func _OneCExportService_GetOneCSetting_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(PaymentServiceServer).PaymentUpdate(ctx, in)
+		return srv.(OneCExportServiceServer).GetOneCSetting(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: PaymentService_PaymentUpdate_FullMethodName,
+		FullMethod: OneCExportService_GetOneCSetting_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PaymentServiceServer).PaymentUpdate(ctx, req.(*PaymentUpdateRequest))
+		return srv.(OneCExportServiceServer).GetOneCSetting(ctx, req.(*emptypb.Empty))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _PaymentService_GetMonthlyStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetMonthlyStatusRequest)
+func _OneCExportService_UpdateOneCSetting_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OneCSetting)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(PaymentServiceServer).GetMonthlyStatus(ctx, in)
+		return srv.(OneCExportServiceServer).UpdateOneCSetting(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: PaymentService_GetMonthlyStatus_FullMethodName,
+		FullMethod: OneCExportService_UpdateOneCSetting_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PaymentServiceServer).GetMonthlyStatus(ctx, req.(*GetMonthlyStatusRequest))
+		return srv.(OneCExportServiceServer).UpdateOneCSetting(ctx, req.(*OneCSetting))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _PaymentService_GetAllPaymentsByMonth_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetAllPaymentsByMonthRequest)
+func _OneCExportService_ExportOneC_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportOneCRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(PaymentServiceServer).GetAllPaymentsByMonth(ctx, in)
+		return srv.(OneCExportServiceServer).ExportOneC(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: PaymentService_GetAllPaymentsByMonth_FullMethodName,
+		FullMethod: OneCExportService_ExportOneC_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PaymentServiceServer).GetAllPaymentsByMonth(ctx, req.(*GetAllPaymentsByMonthRequest))
+		return srv.(OneCExportServiceServer).ExportOneC(ctx, req.(*ExportOneCRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _PaymentService_GetAllPaymentTakeOff_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetAllPaymentTakeOffRequest)
-	if err := dec(in); err != nil {
+// OneCExportService_ServiceDesc is the grpc.ServiceDesc for OneCExportService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var OneCExportService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "finance.OneCExportService",
+	HandlerType: (*OneCExportServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetOneCSetting",
+			Handler:    _OneCExportService_GetOneCSetting_Handler,
+		},
+		{
+			MethodName: "UpdateOneCSetting",
+			Handler:    _OneCExportService_UpdateOneCSetting_Handler,
+		},
+		{
+			MethodName: "ExportOneC",
+			Handler:    _OneCExportService_ExportOneC_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "finance.proto",
+}
+
+const (
+	ReconciliationService_ImportStatement_FullMethodName           = "/finance.ReconciliationService/ImportStatement"
+	ReconciliationService_GetReconciliationSessions_FullMethodName = "/finance.ReconciliationService/GetReconciliationSessions"
+	ReconciliationService_GetReconciliationSession_FullMethodName  = "/finance.ReconciliationService/GetReconciliationSession"
+	ReconciliationService_CreateMissingPayments_FullMethodName     = "/finance.ReconciliationService/CreateMissingPayments"
+	ReconciliationService_ResolveReconciliationLine_FullMethodName = "/finance.ReconciliationService/ResolveReconciliationLine"
+)
+
+// ReconciliationServiceClient is the client API for ReconciliationService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// reconciliation service start
+type ReconciliationServiceClient interface {
+	ImportStatement(ctx context.Context, in *ImportStatementRequest, opts ...grpc.CallOption) (*ReconciliationSession, error)
+	GetReconciliationSessions(ctx context.Context, in *GetReconciliationSessionsRequest, opts ...grpc.CallOption) (*GetReconciliationSessionsResponse, error)
+	GetReconciliationSession(ctx context.Context, in *GetReconciliationSessionRequest, opts ...grpc.CallOption) (*ReconciliationSession, error)
+	CreateMissingPayments(ctx context.Context, in *CreateMissingPaymentsRequest, opts ...grpc.CallOption) (*CreateMissingPaymentsResponse, error)
+	ResolveReconciliationLine(ctx context.Context, in *ResolveReconciliationLineRequest, opts ...grpc.CallOption) (*AbsResponse, error)
+}
+
+type reconciliationServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewReconciliationServiceClient(cc grpc.ClientConnInterface) ReconciliationServiceClient {
+	return &reconciliationServiceClient{cc}
+}
+
+func (c *reconciliationServiceClient) ImportStatement(ctx context.Context, in *ImportStatementRequest, opts ...grpc.CallOption) (*ReconciliationSession, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReconciliationSession)
+	err := c.cc.Invoke(ctx, ReconciliationService_ImportStatement_FullMethodName, in, out, cOpts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(PaymentServiceServer).GetAllPaymentTakeOff(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: PaymentService_GetAllPaymentTakeOff_FullMethodName,
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PaymentServiceServer).GetAllPaymentTakeOff(ctx, req.(*GetAllPaymentTakeOffRequest))
+	return out, nil
+}
+
+func (c *reconciliationServiceClient) GetReconciliationSessions(ctx context.Context, in *GetReconciliationSessionsRequest, opts ...grpc.CallOption) (*GetReconciliationSessionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetReconciliationSessionsResponse)
+	err := c.cc.Invoke(ctx, ReconciliationService_GetReconciliationSessions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
 	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _PaymentService_GetAllPaymentTakeOffChart_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetAllPaymentTakeOffRequest)
-	if err := dec(in); err != nil {
+func (c *reconciliationServiceClient) GetReconciliationSession(ctx context.Context, in *GetReconciliationSessionRequest, opts ...grpc.CallOption) (*ReconciliationSession, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReconciliationSession)
+	err := c.cc.Invoke(ctx, ReconciliationService_GetReconciliationSession_FullMethodName, in, out, cOpts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(PaymentServiceServer).GetAllPaymentTakeOffChart(ctx, in)
+	return out, nil
+}
+
+func (c *reconciliationServiceClient) CreateMissingPayments(ctx context.Context, in *CreateMissingPaymentsRequest, opts ...grpc.CallOption) (*CreateMissingPaymentsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateMissingPaymentsResponse)
+	err := c.cc.Invoke(ctx, ReconciliationService_CreateMissingPayments_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: PaymentService_GetAllPaymentTakeOffChart_FullMethodName,
+	return out, nil
+}
+
+func (c *reconciliationServiceClient) ResolveReconciliationLine(ctx context.Context, in *ResolveReconciliationLineRequest, opts ...grpc.CallOption) (*AbsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AbsResponse)
+	err := c.cc.Invoke(ctx, ReconciliationService_ResolveReconciliationLine_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PaymentServiceServer).GetAllPaymentTakeOffChart(ctx, req.(*GetAllPaymentTakeOffRequest))
+	return out, nil
+}
+
+// ReconciliationServiceServer is the server API for ReconciliationService service.
+// All implementations must embed UnimplementedReconciliationServiceServer
+// for forward compatibility.
+//
+// reconciliation service start
+type ReconciliationServiceServer interface {
+	ImportStatement(context.Context, *ImportStatementRequest) (*ReconciliationSession, error)
+	GetReconciliationSessions(context.Context, *GetReconciliationSessionsRequest) (*GetReconciliationSessionsResponse, error)
+	GetReconciliationSession(context.Context, *GetReconciliationSessionRequest) (*ReconciliationSession, error)
+	CreateMissingPayments(context.Context, *CreateMissingPaymentsRequest) (*CreateMissingPaymentsResponse, error)
+	ResolveReconciliationLine(context.Context, *ResolveReconciliationLineRequest) (*AbsResponse, error)
+	mustEmbedUnimplementedReconciliationServiceServer()
+}
+
+// UnimplementedReconciliationServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedReconciliationServiceServer struct{}
+
+func (UnimplementedReconciliationServiceServer) ImportStatement(context.Context, *ImportStatementRequest) (*ReconciliationSession, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportStatement not implemented")
+}
+func (UnimplementedReconciliationServiceServer) GetReconciliationSessions(context.Context, *GetReconciliationSessionsRequest) (*GetReconciliationSessionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetReconciliationSessions not implemented")
+}
+func (UnimplementedReconciliationServiceServer) GetReconciliationSession(context.Context, *GetReconciliationSessionRequest) (*ReconciliationSession, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetReconciliationSession not implemented")
+}
+func (UnimplementedReconciliationServiceServer) CreateMissingPayments(context.Context, *CreateMissingPaymentsRequest) (*CreateMissingPaymentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateMissingPayments not implemented")
+}
+func (UnimplementedReconciliationServiceServer) ResolveReconciliationLine(context.Context, *ResolveReconciliationLineRequest) (*AbsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResolveReconciliationLine not implemented")
+}
+func (UnimplementedReconciliationServiceServer) mustEmbedUnimplementedReconciliationServiceServer() {}
+func (UnimplementedReconciliationServiceServer) testEmbeddedByValue()                               {}
+
+// UnsafeReconciliationServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ReconciliationServiceServer will
+// result in compilation errors.
+type UnsafeReconciliationServiceServer interface {
+	mustEmbedUnimplementedReconciliationServiceServer()
+}
+
+func RegisterReconciliationServiceServer(s grpc.ServiceRegistrar, srv ReconciliationServiceServer) {
+	// If the following call pancis, it indicates UnimplementedReconciliationServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
 	}
-	return interceptor(ctx, in, info, handler)
+	s.RegisterService(&ReconciliationService_ServiceDesc, srv)
 }
 
-func _PaymentService_GetAllStudentPayments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetAllStudentPaymentsRequest)
+func _ReconciliationService_ImportStatement_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportStatementRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(PaymentServiceServer).GetAllStudentPayments(ctx, in)
+		return srv.(ReconciliationServiceServer).ImportStatement(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: PaymentService_GetAllStudentPayments_FullMethodName,
+		FullMethod: ReconciliationService_ImportStatement_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PaymentServiceServer).GetAllStudentPayments(ctx, req.(*GetAllStudentPaymentsRequest))
+		return srv.(ReconciliationServiceServer).ImportStatement(ctx, req.(*ImportStatementRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _PaymentService_GetAllStudentPaymentsChart_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetAllStudentPaymentsRequest)
+func _ReconciliationService_GetReconciliationSessions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetReconciliationSessionsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(PaymentServiceServer).GetAllStudentPaymentsChart(ctx, in)
+		return srv.(ReconciliationServiceServer).GetReconciliationSessions(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: PaymentService_GetAllStudentPaymentsChart_FullMethodName,
+		FullMethod: ReconciliationService_GetReconciliationSessions_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PaymentServiceServer).GetAllStudentPaymentsChart(ctx, req.(*GetAllStudentPaymentsRequest))
+		return srv.(ReconciliationServiceServer).GetReconciliationSessions(ctx, req.(*GetReconciliationSessionsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _PaymentService_GetAllDebtsInformation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetAllDebtsRequest)
+func _ReconciliationService_GetReconciliationSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetReconciliationSessionRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(PaymentServiceServer).GetAllDebtsInformation(ctx, in)
+		return srv.(ReconciliationServiceServer).GetReconciliationSession(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: PaymentService_GetAllDebtsInformation_FullMethodName,
+		FullMethod: ReconciliationService_GetReconciliationSession_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PaymentServiceServer).GetAllDebtsInformation(ctx, req.(*GetAllDebtsRequest))
+		return srv.(ReconciliationServiceServer).GetReconciliationSession(ctx, req.(*GetReconciliationSessionRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _PaymentService_GetCommonFinanceInformation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(emptypb.Empty)
+func _ReconciliationService_CreateMissingPayments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateMissingPaymentsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(PaymentServiceServer).GetCommonFinanceInformation(ctx, in)
+		return srv.(ReconciliationServiceServer).CreateMissingPayments(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: PaymentService_GetCommonFinanceInformation_FullMethodName,
+		FullMethod: ReconciliationService_CreateMissingPayments_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PaymentServiceServer).GetCommonFinanceInformation(ctx, req.(*emptypb.Empty))
+		return srv.(ReconciliationServiceServer).CreateMissingPayments(ctx, req.(*CreateMissingPaymentsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _PaymentService_GetIncomeChart_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetIncomeChartRequest)
+func _ReconciliationService_ResolveReconciliationLine_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResolveReconciliationLineRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(PaymentServiceServer).GetIncomeChart(ctx, in)
+		return srv.(ReconciliationServiceServer).ResolveReconciliationLine(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: PaymentService_GetIncomeChart_FullMethodName,
+		FullMethod: ReconciliationService_ResolveReconciliationLine_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PaymentServiceServer).GetIncomeChart(ctx, req.(*GetIncomeChartRequest))
+		return srv.(ReconciliationServiceServer).ResolveReconciliationLine(ctx, req.(*ResolveReconciliationLineRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-// PaymentService_ServiceDesc is the grpc.ServiceDesc for PaymentService service.
+// ReconciliationService_ServiceDesc is the grpc.ServiceDesc for ReconciliationService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
-var PaymentService_ServiceDesc = grpc.ServiceDesc{
-	ServiceName: "finance.PaymentService",
-	HandlerType: (*PaymentServiceServer)(nil),
+var ReconciliationService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "finance.ReconciliationService",
+	HandlerType: (*ReconciliationServiceServer)(nil),
 	Methods: []grpc.MethodDesc{
 		{
-			MethodName: "PaymentAdd",
-			Handler:    _PaymentService_PaymentAdd_Handler,
-		},
-		{
-			MethodName: "PaymentReturn",
-			Handler:    _PaymentService_PaymentReturn_Handler,
-		},
-		{
-			MethodName: "PaymentUpdate",
-			Handler:    _PaymentService_PaymentUpdate_Handler,
-		},
-		{
-			MethodName: "GetMonthlyStatus",
-			Handler:    _PaymentService_GetMonthlyStatus_Handler,
-		},
-		{
-			MethodName: "GetAllPaymentsByMonth",
-			Handler:    _PaymentService_GetAllPaymentsByMonth_Handler,
-		},
-		{
-			MethodName: "GetAllPaymentTakeOff",
-			Handler:    _PaymentService_GetAllPaymentTakeOff_Handler,
-		},
-		{
-			MethodName: "GetAllPaymentTakeOffChart",
-			Handler:    _PaymentService_GetAllPaymentTakeOffChart_Handler,
-		},
-		{
-			MethodName: "GetAllStudentPayments",
-			Handler:    _PaymentService_GetAllStudentPayments_Handler,
+			MethodName: "ImportStatement",
+			Handler:    _ReconciliationService_ImportStatement_Handler,
 		},
 		{
-			MethodName: "GetAllStudentPaymentsChart",
-			Handler:    _PaymentService_GetAllStudentPaymentsChart_Handler,
+			MethodName: "GetReconciliationSessions",
+			Handler:    _ReconciliationService_GetReconciliationSessions_Handler,
 		},
 		{
-			MethodName: "GetAllDebtsInformation",
-			Handler:    _PaymentService_GetAllDebtsInformation_Handler,
+			MethodName: "GetReconciliationSession",
+			Handler:    _ReconciliationService_GetReconciliationSession_Handler,
 		},
 		{
-			MethodName: "GetCommonFinanceInformation",
-			Handler:    _PaymentService_GetCommonFinanceInformation_Handler,
+			MethodName: "CreateMissingPayments",
+			Handler:    _ReconciliationService_CreateMissingPayments_Handler,
 		},
 		{
-			MethodName: "GetIncomeChart",
-			Handler:    _PaymentService_GetIncomeChart_Handler,
+			MethodName: "ResolveReconciliationLine",
+			Handler:    _ReconciliationService_ResolveReconciliationLine_Handler,
 		},
 	},
 	Streams:  []grpc.StreamDesc{},
@@ -1168,219 +4405,295 @@ var PaymentService_ServiceDesc = grpc.ServiceDesc{
 }
 
 const (
-	TeacherSalaryService_CreateTeacherSalary_FullMethodName         = "/finance.TeacherSalaryService/CreateTeacherSalary"
-	TeacherSalaryService_DeleteTeacherSalary_FullMethodName         = "/finance.TeacherSalaryService/DeleteTeacherSalary"
-	TeacherSalaryService_GetTeacherSalary_FullMethodName            = "/finance.TeacherSalaryService/GetTeacherSalary"
-	TeacherSalaryService_GetTeacherSalaryByTeacherID_FullMethodName = "/finance.TeacherSalaryService/GetTeacherSalaryByTeacherID"
+	ChargeService_CreateChargeItem_FullMethodName         = "/finance.ChargeService/CreateChargeItem"
+	ChargeService_UpdateChargeItem_FullMethodName         = "/finance.ChargeService/UpdateChargeItem"
+	ChargeService_GetChargeItems_FullMethodName           = "/finance.ChargeService/GetChargeItems"
+	ChargeService_ChargeStudent_FullMethodName            = "/finance.ChargeService/ChargeStudent"
+	ChargeService_GetStudentCharges_FullMethodName        = "/finance.ChargeService/GetStudentCharges"
+	ChargeService_GetChargeItemSalesReport_FullMethodName = "/finance.ChargeService/GetChargeItemSalesReport"
 )
 
-// TeacherSalaryServiceClient is the client API for TeacherSalaryService service.
+// ChargeServiceClient is the client API for ChargeService service.
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 //
-// teacher salary service start
-type TeacherSalaryServiceClient interface {
-	CreateTeacherSalary(ctx context.Context, in *CreateTeacherSalaryRequest, opts ...grpc.CallOption) (*AbsResponse, error)
-	DeleteTeacherSalary(ctx context.Context, in *DeleteTeacherSalaryRequest, opts ...grpc.CallOption) (*AbsResponse, error)
-	GetTeacherSalary(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetTeachersSalaryRequest, error)
-	GetTeacherSalaryByTeacherID(ctx context.Context, in *DeleteTeacherSalaryRequest, opts ...grpc.CallOption) (*AbsGetTeachersSalary, error)
+// charge service start
+type ChargeServiceClient interface {
+	CreateChargeItem(ctx context.Context, in *CreateChargeItemRequest, opts ...grpc.CallOption) (*AbsResponse, error)
+	UpdateChargeItem(ctx context.Context, in *AbsChargeItem, opts ...grpc.CallOption) (*AbsResponse, error)
+	GetChargeItems(ctx context.Context, in *GetChargeItemsRequest, opts ...grpc.CallOption) (*GetChargeItemsResponse, error)
+	ChargeStudent(ctx context.Context, in *ChargeStudentRequest, opts ...grpc.CallOption) (*AbsResponse, error)
+	GetStudentCharges(ctx context.Context, in *GetStudentChargesRequest, opts ...grpc.CallOption) (*GetStudentChargesResponse, error)
+	GetChargeItemSalesReport(ctx context.Context, in *GetChargeItemSalesReportRequest, opts ...grpc.CallOption) (*GetChargeItemSalesReportResponse, error)
 }
 
-type teacherSalaryServiceClient struct {
+type chargeServiceClient struct {
 	cc grpc.ClientConnInterface
 }
 
-func NewTeacherSalaryServiceClient(cc grpc.ClientConnInterface) TeacherSalaryServiceClient {
-	return &teacherSalaryServiceClient{cc}
+func NewChargeServiceClient(cc grpc.ClientConnInterface) ChargeServiceClient {
+	return &chargeServiceClient{cc}
 }
 
-func (c *teacherSalaryServiceClient) CreateTeacherSalary(ctx context.Context, in *CreateTeacherSalaryRequest, opts ...grpc.CallOption) (*AbsResponse, error) {
+func (c *chargeServiceClient) CreateChargeItem(ctx context.Context, in *CreateChargeItemRequest, opts ...grpc.CallOption) (*AbsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(AbsResponse)
-	err := c.cc.Invoke(ctx, TeacherSalaryService_CreateTeacherSalary_FullMethodName, in, out, cOpts...)
+	err := c.cc.Invoke(ctx, ChargeService_CreateChargeItem_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *teacherSalaryServiceClient) DeleteTeacherSalary(ctx context.Context, in *DeleteTeacherSalaryRequest, opts ...grpc.CallOption) (*AbsResponse, error) {
+func (c *chargeServiceClient) UpdateChargeItem(ctx context.Context, in *AbsChargeItem, opts ...grpc.CallOption) (*AbsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(AbsResponse)
-	err := c.cc.Invoke(ctx, TeacherSalaryService_DeleteTeacherSalary_FullMethodName, in, out, cOpts...)
+	err := c.cc.Invoke(ctx, ChargeService_UpdateChargeItem_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *teacherSalaryServiceClient) GetTeacherSalary(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetTeachersSalaryRequest, error) {
+func (c *chargeServiceClient) GetChargeItems(ctx context.Context, in *GetChargeItemsRequest, opts ...grpc.CallOption) (*GetChargeItemsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(GetTeachersSalaryRequest)
-	err := c.cc.Invoke(ctx, TeacherSalaryService_GetTeacherSalary_FullMethodName, in, out, cOpts...)
+	out := new(GetChargeItemsResponse)
+	err := c.cc.Invoke(ctx, ChargeService_GetChargeItems_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *teacherSalaryServiceClient) GetTeacherSalaryByTeacherID(ctx context.Context, in *DeleteTeacherSalaryRequest, opts ...grpc.CallOption) (*AbsGetTeachersSalary, error) {
+func (c *chargeServiceClient) ChargeStudent(ctx context.Context, in *ChargeStudentRequest, opts ...grpc.CallOption) (*AbsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(AbsGetTeachersSalary)
-	err := c.cc.Invoke(ctx, TeacherSalaryService_GetTeacherSalaryByTeacherID_FullMethodName, in, out, cOpts...)
+	out := new(AbsResponse)
+	err := c.cc.Invoke(ctx, ChargeService_ChargeStudent_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-// TeacherSalaryServiceServer is the server API for TeacherSalaryService service.
-// All implementations must embed UnimplementedTeacherSalaryServiceServer
+func (c *chargeServiceClient) GetStudentCharges(ctx context.Context, in *GetStudentChargesRequest, opts ...grpc.CallOption) (*GetStudentChargesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetStudentChargesResponse)
+	err := c.cc.Invoke(ctx, ChargeService_GetStudentCharges_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chargeServiceClient) GetChargeItemSalesReport(ctx context.Context, in *GetChargeItemSalesReportRequest, opts ...grpc.CallOption) (*GetChargeItemSalesReportResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetChargeItemSalesReportResponse)
+	err := c.cc.Invoke(ctx, ChargeService_GetChargeItemSalesReport_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ChargeServiceServer is the server API for ChargeService service.
+// All implementations must embed UnimplementedChargeServiceServer
 // for forward compatibility.
 //
-// teacher salary service start
-type TeacherSalaryServiceServer interface {
-	CreateTeacherSalary(context.Context, *CreateTeacherSalaryRequest) (*AbsResponse, error)
-	DeleteTeacherSalary(context.Context, *DeleteTeacherSalaryRequest) (*AbsResponse, error)
-	GetTeacherSalary(context.Context, *emptypb.Empty) (*GetTeachersSalaryRequest, error)
-	GetTeacherSalaryByTeacherID(context.Context, *DeleteTeacherSalaryRequest) (*AbsGetTeachersSalary, error)
-	mustEmbedUnimplementedTeacherSalaryServiceServer()
+// charge service start
+type ChargeServiceServer interface {
+	CreateChargeItem(context.Context, *CreateChargeItemRequest) (*AbsResponse, error)
+	UpdateChargeItem(context.Context, *AbsChargeItem) (*AbsResponse, error)
+	GetChargeItems(context.Context, *GetChargeItemsRequest) (*GetChargeItemsResponse, error)
+	ChargeStudent(context.Context, *ChargeStudentRequest) (*AbsResponse, error)
+	GetStudentCharges(context.Context, *GetStudentChargesRequest) (*GetStudentChargesResponse, error)
+	GetChargeItemSalesReport(context.Context, *GetChargeItemSalesReportRequest) (*GetChargeItemSalesReportResponse, error)
+	mustEmbedUnimplementedChargeServiceServer()
 }
 
-// UnimplementedTeacherSalaryServiceServer must be embedded to have
+// UnimplementedChargeServiceServer must be embedded to have
 // forward compatible implementations.
 //
 // NOTE: this should be embedded by value instead of pointer to avoid a nil
 // pointer dereference when methods are called.
-type UnimplementedTeacherSalaryServiceServer struct{}
+type UnimplementedChargeServiceServer struct{}
 
-func (UnimplementedTeacherSalaryServiceServer) CreateTeacherSalary(context.Context, *CreateTeacherSalaryRequest) (*AbsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateTeacherSalary not implemented")
+func (UnimplementedChargeServiceServer) CreateChargeItem(context.Context, *CreateChargeItemRequest) (*AbsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateChargeItem not implemented")
 }
-func (UnimplementedTeacherSalaryServiceServer) DeleteTeacherSalary(context.Context, *DeleteTeacherSalaryRequest) (*AbsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteTeacherSalary not implemented")
+func (UnimplementedChargeServiceServer) UpdateChargeItem(context.Context, *AbsChargeItem) (*AbsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateChargeItem not implemented")
 }
-func (UnimplementedTeacherSalaryServiceServer) GetTeacherSalary(context.Context, *emptypb.Empty) (*GetTeachersSalaryRequest, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetTeacherSalary not implemented")
+func (UnimplementedChargeServiceServer) GetChargeItems(context.Context, *GetChargeItemsRequest) (*GetChargeItemsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetChargeItems not implemented")
 }
-func (UnimplementedTeacherSalaryServiceServer) GetTeacherSalaryByTeacherID(context.Context, *DeleteTeacherSalaryRequest) (*AbsGetTeachersSalary, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetTeacherSalaryByTeacherID not implemented")
+func (UnimplementedChargeServiceServer) ChargeStudent(context.Context, *ChargeStudentRequest) (*AbsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChargeStudent not implemented")
 }
-func (UnimplementedTeacherSalaryServiceServer) mustEmbedUnimplementedTeacherSalaryServiceServer() {}
-func (UnimplementedTeacherSalaryServiceServer) testEmbeddedByValue()                              {}
+func (UnimplementedChargeServiceServer) GetStudentCharges(context.Context, *GetStudentChargesRequest) (*GetStudentChargesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStudentCharges not implemented")
+}
+func (UnimplementedChargeServiceServer) GetChargeItemSalesReport(context.Context, *GetChargeItemSalesReportRequest) (*GetChargeItemSalesReportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetChargeItemSalesReport not implemented")
+}
+func (UnimplementedChargeServiceServer) mustEmbedUnimplementedChargeServiceServer() {}
+func (UnimplementedChargeServiceServer) testEmbeddedByValue()                       {}
 
-// UnsafeTeacherSalaryServiceServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to TeacherSalaryServiceServer will
+// UnsafeChargeServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ChargeServiceServer will
 // result in compilation errors.
-type UnsafeTeacherSalaryServiceServer interface {
-	mustEmbedUnimplementedTeacherSalaryServiceServer()
+type UnsafeChargeServiceServer interface {
+	mustEmbedUnimplementedChargeServiceServer()
 }
 
-func RegisterTeacherSalaryServiceServer(s grpc.ServiceRegistrar, srv TeacherSalaryServiceServer) {
-	// If the following call pancis, it indicates UnimplementedTeacherSalaryServiceServer was
+func RegisterChargeServiceServer(s grpc.ServiceRegistrar, srv ChargeServiceServer) {
+	// If the following call pancis, it indicates UnimplementedChargeServiceServer was
 	// embedded by pointer and is nil.  This will cause panics if an
 	// unimplemented method is ever invoked, so we test this at initialization
 	// time to prevent it from happening at runtime later due to I/O.
 	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
 		t.testEmbeddedByValue()
 	}
-	s.RegisterService(&TeacherSalaryService_ServiceDesc, srv)
+	s.RegisterService(&ChargeService_ServiceDesc, srv)
 }
 
-func _TeacherSalaryService_CreateTeacherSalary_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateTeacherSalaryRequest)
+func _ChargeService_CreateChargeItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateChargeItemRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(TeacherSalaryServiceServer).CreateTeacherSalary(ctx, in)
+		return srv.(ChargeServiceServer).CreateChargeItem(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: TeacherSalaryService_CreateTeacherSalary_FullMethodName,
+		FullMethod: ChargeService_CreateChargeItem_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(TeacherSalaryServiceServer).CreateTeacherSalary(ctx, req.(*CreateTeacherSalaryRequest))
+		return srv.(ChargeServiceServer).CreateChargeItem(ctx, req.(*CreateChargeItemRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _TeacherSalaryService_DeleteTeacherSalary_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteTeacherSalaryRequest)
+func _ChargeService_UpdateChargeItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AbsChargeItem)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(TeacherSalaryServiceServer).DeleteTeacherSalary(ctx, in)
+		return srv.(ChargeServiceServer).UpdateChargeItem(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: TeacherSalaryService_DeleteTeacherSalary_FullMethodName,
+		FullMethod: ChargeService_UpdateChargeItem_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(TeacherSalaryServiceServer).DeleteTeacherSalary(ctx, req.(*DeleteTeacherSalaryRequest))
+		return srv.(ChargeServiceServer).UpdateChargeItem(ctx, req.(*AbsChargeItem))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _TeacherSalaryService_GetTeacherSalary_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(emptypb.Empty)
+func _ChargeService_GetChargeItems_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetChargeItemsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(TeacherSalaryServiceServer).GetTeacherSalary(ctx, in)
+		return srv.(ChargeServiceServer).GetChargeItems(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: TeacherSalaryService_GetTeacherSalary_FullMethodName,
+		FullMethod: ChargeService_GetChargeItems_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(TeacherSalaryServiceServer).GetTeacherSalary(ctx, req.(*emptypb.Empty))
+		return srv.(ChargeServiceServer).GetChargeItems(ctx, req.(*GetChargeItemsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _TeacherSalaryService_GetTeacherSalaryByTeacherID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteTeacherSalaryRequest)
+func _ChargeService_ChargeStudent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChargeStudentRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(TeacherSalaryServiceServer).GetTeacherSalaryByTeacherID(ctx, in)
+		return srv.(ChargeServiceServer).ChargeStudent(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: TeacherSalaryService_GetTeacherSalaryByTeacherID_FullMethodName,
+		FullMethod: ChargeService_ChargeStudent_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(TeacherSalaryServiceServer).GetTeacherSalaryByTeacherID(ctx, req.(*DeleteTeacherSalaryRequest))
+		return srv.(ChargeServiceServer).ChargeStudent(ctx, req.(*ChargeStudentRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-// TeacherSalaryService_ServiceDesc is the grpc.ServiceDesc for TeacherSalaryService service.
+func _ChargeService_GetStudentCharges_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetStudentChargesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChargeServiceServer).GetStudentCharges(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChargeService_GetStudentCharges_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChargeServiceServer).GetStudentCharges(ctx, req.(*GetStudentChargesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChargeService_GetChargeItemSalesReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetChargeItemSalesReportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChargeServiceServer).GetChargeItemSalesReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChargeService_GetChargeItemSalesReport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChargeServiceServer).GetChargeItemSalesReport(ctx, req.(*GetChargeItemSalesReportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ChargeService_ServiceDesc is the grpc.ServiceDesc for ChargeService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
-var TeacherSalaryService_ServiceDesc = grpc.ServiceDesc{
-	ServiceName: "finance.TeacherSalaryService",
-	HandlerType: (*TeacherSalaryServiceServer)(nil),
+var ChargeService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "finance.ChargeService",
+	HandlerType: (*ChargeServiceServer)(nil),
 	Methods: []grpc.MethodDesc{
 		{
-			MethodName: "CreateTeacherSalary",
-			Handler:    _TeacherSalaryService_CreateTeacherSalary_Handler,
+			MethodName: "CreateChargeItem",
+			Handler:    _ChargeService_CreateChargeItem_Handler,
 		},
 		{
-			MethodName: "DeleteTeacherSalary",
-			Handler:    _TeacherSalaryService_DeleteTeacherSalary_Handler,
+			MethodName: "UpdateChargeItem",
+			Handler:    _ChargeService_UpdateChargeItem_Handler,
 		},
 		{
-			MethodName: "GetTeacherSalary",
-			Handler:    _TeacherSalaryService_GetTeacherSalary_Handler,
+			MethodName: "GetChargeItems",
+			Handler:    _ChargeService_GetChargeItems_Handler,
 		},
 		{
-			MethodName: "GetTeacherSalaryByTeacherID",
-			Handler:    _TeacherSalaryService_GetTeacherSalaryByTeacherID_Handler,
+			MethodName: "ChargeStudent",
+			Handler:    _ChargeService_ChargeStudent_Handler,
+		},
+		{
+			MethodName: "GetStudentCharges",
+			Handler:    _ChargeService_GetStudentCharges_Handler,
+		},
+		{
+			MethodName: "GetChargeItemSalesReport",
+			Handler:    _ChargeService_GetChargeItemSalesReport_Handler,
 		},
 	},
 	Streams:  []grpc.StreamDesc{},
@@ -1147,17 +1147,21 @@ func (x *SetDataResponse) GetLessonStartTime() string {
 }
 
 type ChangeToSetRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name"`
-	RoomId        string                 `protobuf:"bytes,2,opt,name=roomId,proto3" json:"roomId"`
-	CourseId      string                 `protobuf:"bytes,3,opt,name=courseId,proto3" json:"courseId"`
-	TeacherId     string                 `protobuf:"bytes,4,opt,name=teacherId,proto3" json:"teacherId"`
-	DateType      string                 `protobuf:"bytes,5,opt,name=dateType,proto3" json:"dateType"`
-	Days          []string               `protobuf:"bytes,6,rep,name=days,proto3" json:"days"`
-	StartTime     string                 `protobuf:"bytes,7,opt,name=startTime,proto3" json:"startTime"`
-	StartDate     string                 `protobuf:"bytes,8,opt,name=startDate,proto3" json:"startDate"`
-	EndDate       string                 `protobuf:"bytes,9,opt,name=end_date,json=endDate,proto3" json:"end_date"`
-	SetId         string                 `protobuf:"bytes,10,opt,name=setId,proto3" json:"setId"`
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Name      string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name"`
+	RoomId    string                 `protobuf:"bytes,2,opt,name=roomId,proto3" json:"roomId"`
+	CourseId  string                 `protobuf:"bytes,3,opt,name=courseId,proto3" json:"courseId"`
+	TeacherId string                 `protobuf:"bytes,4,opt,name=teacherId,proto3" json:"teacherId"`
+	DateType  string                 `protobuf:"bytes,5,opt,name=dateType,proto3" json:"dateType"`
+	Days      []string               `protobuf:"bytes,6,rep,name=days,proto3" json:"days"`
+	StartTime string                 `protobuf:"bytes,7,opt,name=startTime,proto3" json:"startTime"`
+	StartDate string                 `protobuf:"bytes,8,opt,name=startDate,proto3" json:"startDate"`
+	EndDate   string                 `protobuf:"bytes,9,opt,name=end_date,json=endDate,proto3" json:"end_date"`
+	SetId     string                 `protobuf:"bytes,10,opt,name=setId,proto3" json:"setId"`
+	// saves the group although its room or teacher is already booked, the override is audited
+	Force         bool   `protobuf:"varint,11,opt,name=force,proto3" json:"force"`
+	ActionById    string `protobuf:"bytes,12,opt,name=actionById,proto3" json:"actionById"`
+	ActionByName  string `protobuf:"bytes,13,opt,name=actionByName,proto3" json:"actionByName"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -1262,6 +1266,27 @@ func (x *ChangeToSetRequest) GetSetId() string {
 	return ""
 }
 
+func (x *ChangeToSetRequest) GetForce() bool {
+	if x != nil {
+		return x.Force
+	}
+	return false
+}
+
+func (x *ChangeToSetRequest) GetActionById() string {
+	if x != nil {
+		return x.ActionById
+	}
+	return ""
+}
+
+func (x *ChangeToSetRequest) GetActionByName() string {
+	if x != nil {
+		return x.ActionByName
+	}
+	return ""
+}
+
 type CreateLeadDataRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name"`
@@ -1612,7 +1637,7 @@ const file_lead_proto_rawDesc = "" +
 	"courseName\x12\x1a\n" +
 	"\bdateType\x18\x06 \x01(\tR\bdateType\x12\x14\n" +
 	"\x05dates\x18\a \x03(\tR\x05dates\x12(\n" +
-	"\x0flessonStartTime\x18\b \x01(\tR\x0flessonStartTime\"\x97\x02\n" +
+	"\x0flessonStartTime\x18\b \x01(\tR\x0flessonStartTime\"\xf1\x02\n" +
 	"\x12ChangeToSetRequest\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12\x16\n" +
 	"\x06roomId\x18\x02 \x01(\tR\x06roomId\x12\x1a\n" +
@@ -1624,7 +1649,12 @@ const file_lead_proto_rawDesc = "" +
 	"\tstartDate\x18\b \x01(\tR\tstartDate\x12\x19\n" +
 	"\bend_date\x18\t \x01(\tR\aendDate\x12\x14\n" +
 	"\x05setId\x18\n" +
-	" \x01(\tR\x05setId\"\x9d\x01\n" +
+	" \x01(\tR\x05setId\x12\x14\n" +
+	"\x05force\x18\v \x01(\bR\x05force\x12\x1e\n" +
+	"\n" +
+	"actionById\x18\f \x01(\tR\n" +
+	"actionById\x12\"\n" +
+	"\factionByName\x18\r \x01(\tR\factionByName\"\x9d\x01\n" +
 	"\x15CreateLeadDataRequest\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12 \n" +
 	"\vphoneNumber\x18\x02 \x01(\tR\vphoneNumber\x12\x16\n" +
@@ -18,6 +18,15 @@ type EducationClient struct {
 	tariffClient         pb.TariffServiceClient
 	companyFinanceClient pb.CompanyFinanceServiceClient
 	smsServiceClient     pb.SmsServiceClient
+	holidayClient        pb.HolidayServiceClient
+	timetableClient      pb.TimetableServiceClient
+	lessonClient         pb.LessonServiceClient
+	attendanceStatus     pb.AttendanceStatusServiceClient
+	attendancePolicy     pb.AttendancePolicyServiceClient
+	checkInClient        pb.CheckInServiceClient
+	analyticsClient      pb.AttendanceAnalyticsServiceClient
+	notificationClient   pb.NotificationServiceClient
+	waitlistClient       pb.GroupWaitlistServiceClient
 }
 
 func NewEducationClient(addr string) (*EducationClient, error) {
@@ -35,7 +44,16 @@ func NewEducationClient(addr string) (*EducationClient, error) {
 	tariffClient := pb.NewTariffServiceClient(conn)
 	companyFinanceClient := pb.NewCompanyFinanceServiceClient(conn)
 	smsServiceClient := pb.NewSmsServiceClient(conn)
-	return &EducationClient{roomClient: roomClient, courseClient: courseClient, groupClient: groupClient, attendanceClient: attendanceClient, studentClient: studentClient, companyClient: companyClient, tariffClient: tariffClient, companyFinanceClient: companyFinanceClient, smsServiceClient: smsServiceClient}, nil
+	holidayClient := pb.NewHolidayServiceClient(conn)
+	timetableClient := pb.NewTimetableServiceClient(conn)
+	lessonClient := pb.NewLessonServiceClient(conn)
+	attendanceStatus := pb.NewAttendanceStatusServiceClient(conn)
+	attendancePolicy := pb.NewAttendancePolicyServiceClient(conn)
+	checkInClient := pb.NewCheckInServiceClient(conn)
+	analyticsClient := pb.NewAttendanceAnalyticsServiceClient(conn)
+	notificationClient := pb.NewNotificationServiceClient(conn)
+	waitlistClient := pb.NewGroupWaitlistServiceClient(conn)
+	return &EducationClient{roomClient: roomClient, courseClient: courseClient, groupClient: groupClient, attendanceClient: attendanceClient, studentClient: studentClient, companyClient: companyClient, tariffClient: tariffClient, companyFinanceClient: companyFinanceClient, smsServiceClient: smsServiceClient, holidayClient: holidayClient, timetableClient: timetableClient, lessonClient: lessonClient, attendanceStatus: attendanceStatus, attendancePolicy: attendancePolicy, checkInClient: checkInClient, analyticsClient: analyticsClient, notificationClient: notificationClient, waitlistClient: waitlistClient}, nil
 }
 
 // Education Service method client
@@ -58,6 +76,58 @@ func (lc *EducationClient) GetRoom(ctx context.Context) (*pb.GetUpdateRoomAbs, e
 	return lc.roomClient.GetRooms(ctx, &emptypb.Empty{})
 }
 
+func (lc *EducationClient) CreateHoliday(ctx context.Context, req *pb.AbsHoliday) (*pb.AbsResponse, error) {
+	return lc.holidayClient.CreateHoliday(ctx, req)
+}
+
+func (lc *EducationClient) UpdateHoliday(ctx context.Context, req *pb.AbsHoliday) (*pb.AbsResponse, error) {
+	return lc.holidayClient.UpdateHoliday(ctx, req)
+}
+
+func (lc *EducationClient) DeleteHoliday(ctx context.Context, id string) (*pb.AbsResponse, error) {
+	return lc.holidayClient.DeleteHoliday(ctx, &pb.DeleteAbsRequest{Id: id})
+}
+
+func (lc *EducationClient) GetHolidays(ctx context.Context, from, till string) (*pb.GetHolidaysResponse, error) {
+	return lc.holidayClient.GetHolidays(ctx, &pb.GetHolidaysRequest{From: from, Till: till})
+}
+
+func (lc *EducationClient) GetGroupHolidays(ctx context.Context, groupId, from, till string) (*pb.GetGroupHolidaysResponse, error) {
+	return lc.holidayClient.GetGroupHolidays(ctx, &pb.GetGroupHolidaysRequest{GroupId: groupId, From: from, Till: till})
+}
+
+func (lc *EducationClient) GetTimetable(ctx context.Context, req *pb.GetTimetableRequest) (*pb.GetTimetableResponse, error) {
+	return lc.timetableClient.GetTimetable(ctx, req)
+}
+
+func (lc *EducationClient) CreateCalendarFeed(ctx context.Context, req *pb.CreateCalendarFeedRequest) (*pb.CalendarFeed, error) {
+	return lc.timetableClient.CreateCalendarFeed(ctx, req)
+}
+
+func (lc *EducationClient) GetCalendarFeeds(ctx context.Context, req *pb.GetCalendarFeedsRequest) (*pb.GetCalendarFeedsResponse, error) {
+	return lc.timetableClient.GetCalendarFeeds(ctx, req)
+}
+
+func (lc *EducationClient) RevokeCalendarFeed(ctx context.Context, id string) (*pb.AbsResponse, error) {
+	return lc.timetableClient.RevokeCalendarFeed(ctx, &pb.DeleteAbsRequest{Id: id})
+}
+
+func (lc *EducationClient) GetCalendarFeedTimetable(ctx context.Context, token string) (*pb.CalendarFeedTimetable, error) {
+	return lc.timetableClient.GetCalendarFeedTimetable(ctx, &pb.GetCalendarFeedTimetableRequest{Token: token})
+}
+
+func (lc *EducationClient) GetLessons(ctx context.Context, req *pb.GetLessonsRequest) (*pb.GetLessonsResponse, error) {
+	return lc.lessonClient.GetLessons(ctx, req)
+}
+
+func (lc *EducationClient) GetLessonById(ctx context.Context, id string) (*pb.AbsLesson, error) {
+	return lc.lessonClient.GetLessonById(ctx, &pb.DeleteAbsRequest{Id: id})
+}
+
+func (lc *EducationClient) UpdateLesson(ctx context.Context, req *pb.UpdateLessonRequest) (*pb.AbsLesson, error) {
+	return lc.lessonClient.UpdateLesson(ctx, req)
+}
+
 func (lc *EducationClient) CreateCourse(ctx context.Context, req *pb.CreateCourseRequest) (*pb.AbsResponse, error) {
 	return lc.courseClient.CreateCourse(ctx, req)
 }
@@ -66,6 +136,34 @@ func (lc *EducationClient) UpdateCourse(ctx context.Context, req *pb.AbsCourse)
 	return lc.courseClient.UpdateCourse(ctx, req)
 }
 
+func (lc *EducationClient) GetCourseBilling(ctx context.Context, id string) (*pb.CourseBilling, error) {
+	return lc.courseClient.GetCourseBilling(ctx, &pb.GetCourseByIdRequest{Id: id})
+}
+
+func (lc *EducationClient) UpdateCourseBilling(ctx context.Context, req *pb.CourseBilling) (*pb.AbsResponse, error) {
+	return lc.courseClient.UpdateCourseBilling(ctx, req)
+}
+
+func (lc *EducationClient) ScheduleCoursePrice(ctx context.Context, req *pb.ScheduleCoursePriceRequest) (*pb.AbsResponse, error) {
+	return lc.courseClient.ScheduleCoursePrice(ctx, req)
+}
+
+func (lc *EducationClient) GetCoursePriceHistory(ctx context.Context, id string) (*pb.GetCoursePriceHistoryResponse, error) {
+	return lc.courseClient.GetCoursePriceHistory(ctx, &pb.GetCourseByIdRequest{Id: id})
+}
+
+func (lc *EducationClient) SetEnrollmentPriceLock(ctx context.Context, req *pb.SetEnrollmentPriceLockRequest) (*pb.AbsResponse, error) {
+	return lc.courseClient.SetEnrollmentPriceLock(ctx, req)
+}
+
+func (lc *EducationClient) UpdateGroupBillingMode(ctx context.Context, req *pb.UpdateGroupBillingModeRequest) (*pb.AbsResponse, error) {
+	return lc.groupClient.UpdateGroupBillingMode(ctx, req)
+}
+
+func (lc *EducationClient) UpdateGroupMaxStudents(ctx context.Context, req *pb.UpdateGroupMaxStudentsRequest) (*pb.AbsResponse, error) {
+	return lc.groupClient.UpdateGroupMaxStudents(ctx, req)
+}
+
 func (lc *EducationClient) DeleteCourse(ctx context.Context, id string) (*pb.AbsResponse, error) {
 	req := pb.DeleteAbsRequest{
 		Id: id,
@@ -85,6 +183,14 @@ func (lc *EducationClient) CreateGroup(ctx context.Context, req *pb.CreateGroupR
 	return lc.groupClient.CreateGroup(ctx, req)
 }
 
+func (lc *EducationClient) CheckScheduleConflicts(ctx context.Context, req *pb.CheckScheduleConflictsRequest) (*pb.ScheduleConflicts, error) {
+	return lc.groupClient.CheckScheduleConflicts(ctx, req)
+}
+
+func (lc *EducationClient) GetScheduleConflictOverrides(ctx context.Context, req *pb.GetScheduleConflictOverridesRequest) (*pb.GetScheduleConflictOverridesResponse, error) {
+	return lc.groupClient.GetScheduleConflictOverrides(ctx, req)
+}
+
 func (lc *EducationClient) UpdateGroup(ctx context.Context, req *pb.GetUpdateGroupAbs) (*pb.AbsResponse, error) {
 	return lc.groupClient.UpdateGroup(ctx, req)
 }
@@ -109,6 +215,122 @@ func (lc *EducationClient) SetAttendanceByGroup(ctx context.Context, req *pb.Set
 	return lc.attendanceClient.SetAttendance(ctx, req)
 }
 
+func (lc *EducationClient) SetGroupAttendance(ctx context.Context, req *pb.SetGroupAttendanceRequest) (*pb.SetGroupAttendanceResponse, error) {
+	return lc.attendanceClient.SetGroupAttendance(ctx, req)
+}
+
+func (lc *EducationClient) CreateAttendanceStatus(ctx context.Context, req *pb.AbsAttendanceStatus) (*pb.AbsResponse, error) {
+	return lc.attendanceStatus.CreateAttendanceStatus(ctx, req)
+}
+
+func (lc *EducationClient) UpdateAttendanceStatus(ctx context.Context, req *pb.AbsAttendanceStatus) (*pb.AbsResponse, error) {
+	return lc.attendanceStatus.UpdateAttendanceStatus(ctx, req)
+}
+
+func (lc *EducationClient) DeleteAttendanceStatus(ctx context.Context, id string) (*pb.AbsResponse, error) {
+	return lc.attendanceStatus.DeleteAttendanceStatus(ctx, &pb.DeleteAbsRequest{Id: id})
+}
+
+func (lc *EducationClient) GetAttendanceStatuses(ctx context.Context, withInactive bool) (*pb.GetAttendanceStatusesResponse, error) {
+	return lc.attendanceStatus.GetAttendanceStatuses(ctx, &pb.GetAttendanceStatusesRequest{WithInactive: withInactive})
+}
+
+func (lc *EducationClient) GetAttendancePolicy(ctx context.Context) (*pb.AttendancePolicy, error) {
+	return lc.attendancePolicy.GetAttendancePolicy(ctx, &emptypb.Empty{})
+}
+
+func (lc *EducationClient) UpdateAttendancePolicy(ctx context.Context, req *pb.AttendancePolicy) (*pb.AbsResponse, error) {
+	return lc.attendancePolicy.UpdateAttendancePolicy(ctx, req)
+}
+
+func (lc *EducationClient) RequestAttendanceUnlock(ctx context.Context, req *pb.RequestAttendanceUnlockRequest) (*pb.AbsResponse, error) {
+	return lc.attendancePolicy.RequestAttendanceUnlock(ctx, req)
+}
+
+func (lc *EducationClient) GetAttendanceUnlockRequests(ctx context.Context, req *pb.GetAttendanceUnlockRequestsRequest) (*pb.GetAttendanceUnlockRequestsResponse, error) {
+	return lc.attendancePolicy.GetAttendanceUnlockRequests(ctx, req)
+}
+
+func (lc *EducationClient) ReviewAttendanceUnlock(ctx context.Context, req *pb.ReviewAttendanceUnlockRequest) (*pb.AbsResponse, error) {
+	return lc.attendancePolicy.ReviewAttendanceUnlock(ctx, req)
+}
+
+func (lc *EducationClient) SendStudentLoginCode(ctx context.Context, req *pb.SendStudentLoginCodeRequest) (*pb.AbsResponse, error) {
+	return lc.checkInClient.SendStudentLoginCode(ctx, req)
+}
+
+func (lc *EducationClient) VerifyStudentLoginCode(ctx context.Context, req *pb.VerifyStudentLoginCodeRequest) (*pb.VerifyStudentLoginCodeResponse, error) {
+	return lc.checkInClient.VerifyStudentLoginCode(ctx, req)
+}
+
+func (lc *EducationClient) ValidateStudentSession(ctx context.Context, token string) (*pb.StudentSession, error) {
+	return lc.checkInClient.ValidateStudentSession(ctx, &pb.ValidateStudentSessionRequest{Token: token})
+}
+
+func (lc *EducationClient) GetCheckInCode(ctx context.Context, req *pb.GetCheckInCodeRequest) (*pb.CheckInCode, error) {
+	return lc.checkInClient.GetCheckInCode(ctx, req)
+}
+
+func (lc *EducationClient) CheckIn(ctx context.Context, req *pb.CheckInRequest) (*pb.CheckInResponse, error) {
+	return lc.checkInClient.CheckIn(ctx, req)
+}
+
+func (lc *EducationClient) GetLessonCheckIns(ctx context.Context, req *pb.GetLessonCheckInsRequest) (*pb.GetLessonCheckInsResponse, error) {
+	return lc.checkInClient.GetLessonCheckIns(ctx, req)
+}
+
+func (lc *EducationClient) ConfirmCheckIns(ctx context.Context, req *pb.ConfirmCheckInsRequest) (*pb.SetGroupAttendanceResponse, error) {
+	return lc.checkInClient.ConfirmCheckIns(ctx, req)
+}
+
+func (lc *EducationClient) GetAttendanceRates(ctx context.Context, req *pb.GetAttendanceRatesRequest) (*pb.GetAttendanceRatesResponse, error) {
+	return lc.analyticsClient.GetAttendanceRates(ctx, req)
+}
+
+func (lc *EducationClient) GetAbsenceStreaks(ctx context.Context, req *pb.GetAbsenceStreaksRequest) (*pb.GetAbsenceStreaksResponse, error) {
+	return lc.analyticsClient.GetAbsenceStreaks(ctx, req)
+}
+
+func (lc *EducationClient) GetAttendanceTrend(ctx context.Context, req *pb.GetAttendanceTrendRequest) (*pb.GetAttendanceTrendResponse, error) {
+	return lc.analyticsClient.GetAttendanceTrend(ctx, req)
+}
+
+func (lc *EducationClient) GetAtRiskPolicy(ctx context.Context) (*pb.AtRiskPolicy, error) {
+	return lc.analyticsClient.GetAtRiskPolicy(ctx, &emptypb.Empty{})
+}
+
+func (lc *EducationClient) UpdateAtRiskPolicy(ctx context.Context, req *pb.AtRiskPolicy) (*pb.AbsResponse, error) {
+	return lc.analyticsClient.UpdateAtRiskPolicy(ctx, req)
+}
+
+func (lc *EducationClient) GetAtRiskStudents(ctx context.Context, req *pb.GetAtRiskStudentsRequest) (*pb.GetAtRiskStudentsResponse, error) {
+	return lc.analyticsClient.GetAtRiskStudents(ctx, req)
+}
+
+func (lc *EducationClient) GetNotifications(ctx context.Context, req *pb.GetNotificationsRequest) (*pb.GetNotificationsResponse, error) {
+	return lc.notificationClient.GetNotifications(ctx, req)
+}
+
+func (lc *EducationClient) MarkNotificationsRead(ctx context.Context, req *pb.MarkNotificationsReadRequest) (*pb.AbsResponse, error) {
+	return lc.notificationClient.MarkNotificationsRead(ctx, req)
+}
+
+func (lc *EducationClient) GetGroupWaitlist(ctx context.Context, req *pb.GetGroupWaitlistRequest) (*pb.GetGroupWaitlistResponse, error) {
+	return lc.waitlistClient.GetGroupWaitlist(ctx, req)
+}
+
+func (lc *EducationClient) MoveWaitlistEntry(ctx context.Context, req *pb.MoveWaitlistEntryRequest) (*pb.AbsResponse, error) {
+	return lc.waitlistClient.MoveWaitlistEntry(ctx, req)
+}
+
+func (lc *EducationClient) CancelWaitlistEntry(ctx context.Context, id string) (*pb.AbsResponse, error) {
+	return lc.waitlistClient.CancelWaitlistEntry(ctx, &pb.WaitlistEntryRequest{Id: id})
+}
+
+func (lc *EducationClient) AcceptWaitlistOffer(ctx context.Context, req *pb.AcceptWaitlistOfferRequest) (*pb.AddToGroupResponse, error) {
+	return lc.waitlistClient.AcceptWaitlistOffer(ctx, req)
+}
+
 func (lc *EducationClient) GetGroupByCourseId(ctx context.Context, courseId string) (*pb.GetGroupsByCourseResponse, error) {
 	return lc.groupClient.GetGroupsByCourseId(ctx, &pb.GetGroupByIdRequest{Id: courseId})
 }
@@ -129,7 +351,7 @@ func (lc *EducationClient) CreateStudent(ctx context.Context, p *pb.CreateStuden
 	return response, nil
 }
 
-func (lc *EducationClient) AddStudentToGroup(ctx context.Context, p *pb.AddToGroupRequest) (*pb.AbsResponse, error) {
+func (lc *EducationClient) AddStudentToGroup(ctx context.Context, p *pb.AddToGroupRequest) (*pb.AddToGroupResponse, error) {
 	return lc.studentClient.AddToGroup(ctx, p)
 }
 
@@ -177,6 +399,14 @@ func (lc *EducationClient) ChangeConditionStudent(ctx context.Context, p *pb.Cha
 	return lc.studentClient.ChangeConditionStudent(ctx, p)
 }
 
+func (lc *EducationClient) SellLessonPackage(ctx context.Context, req *pb.SellLessonPackageRequest) (*pb.AbsResponse, error) {
+	return lc.studentClient.SellLessonPackage(ctx, req)
+}
+
+func (lc *EducationClient) GetLessonPackages(ctx context.Context, studentId, groupId string) (*pb.GetLessonPackagesResponse, error) {
+	return lc.studentClient.GetLessonPackages(ctx, &pb.GetLessonPackagesRequest{StudentId: studentId, GroupId: groupId})
+}
+
 func (lc *EducationClient) GetInformationByTeacher(ctx context.Context, teacherId string, isArchived bool) (*pb.GetGroupsByTeacherResponse, error) {
 	return lc.groupClient.GetGroupsByTeacherId(ctx, &pb.GetGroupsByTeacherIdRequest{
 		TeacherId:  teacherId,
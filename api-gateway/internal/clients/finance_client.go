@@ -11,11 +11,20 @@ import (
 )
 
 type FinanceClient struct {
-	discountClient      pb.DiscountServiceClient
-	categoryClient      pb.CategoryServiceClient
-	expenseClient       pb.ExpenseServiceClient
-	paymentClient       pb.PaymentServiceClient
-	teacherSalaryClient pb.TeacherSalaryServiceClient
+	discountClient       pb.DiscountServiceClient
+	categoryClient       pb.CategoryServiceClient
+	expenseClient        pb.ExpenseServiceClient
+	paymentClient        pb.PaymentServiceClient
+	teacherSalaryClient  pb.TeacherSalaryServiceClient
+	periodClient         pb.AccountingPeriodServiceClient
+	payrollClient        pb.PayrollServiceClient
+	installmentClient    pb.InstallmentServiceClient
+	collectionClient     pb.CollectionServiceClient
+	reportClient         pb.ReportServiceClient
+	vendorClient         pb.VendorServiceClient
+	oneCExportClient     pb.OneCExportServiceClient
+	reconciliationClient pb.ReconciliationServiceClient
+	chargeClient         pb.ChargeServiceClient
 }
 
 func (fc *FinanceClient) GetDiscountsInformationByGroupId(ctx context.Context, groupId string) (*pb.GetInformationDiscountResponse, error) {
@@ -24,6 +33,21 @@ func (fc *FinanceClient) GetDiscountsInformationByGroupId(ctx context.Context, g
 func (fc *FinanceClient) CreateDiscount(ctx context.Context, req *pb.AbsDiscountRequest) (*pb.AbsResponse, error) {
 	return fc.discountClient.CreateDiscount(ctx, req)
 }
+func (fc *FinanceClient) CreateDiscountRule(ctx context.Context, req *pb.AbsDiscountRule) (*pb.AbsResponse, error) {
+	return fc.discountClient.CreateDiscountRule(ctx, req)
+}
+func (fc *FinanceClient) UpdateDiscountRule(ctx context.Context, req *pb.AbsDiscountRule) (*pb.AbsResponse, error) {
+	return fc.discountClient.UpdateDiscountRule(ctx, req)
+}
+func (fc *FinanceClient) GetDiscountRules(ctx context.Context, includeInactive bool) (*pb.GetDiscountRulesResponse, error) {
+	return fc.discountClient.GetDiscountRules(ctx, &pb.GetDiscountRulesRequest{IncludeInactive: includeInactive})
+}
+func (fc *FinanceClient) ApplyDiscountRules(ctx context.Context, req *pb.ApplyDiscountRulesRequest) (*pb.ApplyDiscountRulesResponse, error) {
+	return fc.discountClient.ApplyDiscountRules(ctx, req)
+}
+func (fc *FinanceClient) ApplyDiscountTemplate(ctx context.Context, req *pb.ApplyDiscountTemplateRequest) (*pb.ApplyDiscountRulesResponse, error) {
+	return fc.discountClient.ApplyDiscountTemplate(ctx, req)
+}
 func (fc *FinanceClient) DeleteDiscount(ctx context.Context, groupId string, studentId string) (*pb.AbsResponse, error) {
 	return fc.discountClient.DeleteDiscount(ctx, &pb.AbsDiscountRequest{
 		GroupId:       groupId,
@@ -47,12 +71,13 @@ func (fc *FinanceClient) CreateExpense(ctx context.Context, req *pb.CreateExpens
 func (fc *FinanceClient) DeleteExpense(ctx context.Context, id string) (*pb.AbsResponse, error) {
 	return fc.expenseClient.DeleteExpense(ctx, &pb.DeleteAbsRequest{Id: id})
 }
-func (fc *FinanceClient) GetAllInformation(ctx context.Context, id string, idType string, page int64, size int64, from string, to string) (*pb.GetAllExpenseResponse, error) {
+func (fc *FinanceClient) GetAllInformation(ctx context.Context, id string, idType string, page int64, size int64, from string, to string, status string) (*pb.GetAllExpenseResponse, error) {
 	return fc.expenseClient.GetAllExpense(ctx, &pb.GetAllExpenseRequest{
-		From: from,
-		To:   to,
-		Type: idType,
-		Id:   id,
+		From:   from,
+		To:     to,
+		Type:   idType,
+		Id:     id,
+		Status: status,
 		PageReq: &pb.PageRequest{
 			Page: int32(page),
 			Size: int32(size),
@@ -105,8 +130,8 @@ func (fc *FinanceClient) GetSalaryAllTeacher(ctx context.Context) (*pb.GetTeache
 func (fc *FinanceClient) AddSalaryTeacher(ctx context.Context, req *pb.CreateTeacherSalaryRequest) (*pb.AbsResponse, error) {
 	return fc.teacherSalaryClient.CreateTeacherSalary(ctx, req)
 }
-func (fc *FinanceClient) DeleteTeacherSalary(ctx context.Context, teacherId string) (*pb.AbsResponse, error) {
-	return fc.teacherSalaryClient.DeleteTeacherSalary(ctx, &pb.DeleteTeacherSalaryRequest{TeacherId: teacherId})
+func (fc *FinanceClient) DeleteTeacherSalary(ctx context.Context, teacherId, id string) (*pb.AbsResponse, error) {
+	return fc.teacherSalaryClient.DeleteTeacherSalary(ctx, &pb.DeleteTeacherSalaryRequest{TeacherId: teacherId, Id: id})
 }
 func (fc *FinanceClient) GetAllTakeOfPayment(from string, to string, ctx context.Context) (*pb.GetAllPaymentTakeOffResponse, error) {
 	return fc.paymentClient.GetAllPaymentTakeOff(ctx, &pb.GetAllPaymentTakeOffRequest{
@@ -166,6 +191,207 @@ func (fc *FinanceClient) GetChartIncome(ctx context.Context, from string, to str
 func (fc *FinanceClient) GetTableGroups(ctx context.Context) (interface{}, error) {
 	return nil, nil
 }
+func (fc *FinanceClient) ClosePeriod(ctx context.Context, req *pb.AccountingPeriodRequest) (*pb.AbsResponse, error) {
+	return fc.periodClient.ClosePeriod(ctx, req)
+}
+func (fc *FinanceClient) ReopenPeriod(ctx context.Context, req *pb.AccountingPeriodRequest) (*pb.AbsResponse, error) {
+	return fc.periodClient.ReopenPeriod(ctx, req)
+}
+func (fc *FinanceClient) GetAllPeriods(ctx context.Context) (*pb.GetAllPeriodsResponse, error) {
+	return fc.periodClient.GetAllPeriods(ctx, &emptypb.Empty{})
+}
+func (fc *FinanceClient) GetPeriodHistory(ctx context.Context, period string) (*pb.GetPeriodHistoryResponse, error) {
+	return fc.periodClient.GetPeriodHistory(ctx, &pb.GetPeriodHistoryRequest{Period: period})
+}
+func (fc *FinanceClient) CreatePayrollRun(ctx context.Context, req *pb.CreatePayrollRunRequest) (*pb.AbsResponse, error) {
+	return fc.payrollClient.CreatePayrollRun(ctx, req)
+}
+func (fc *FinanceClient) GetPayrollRuns(ctx context.Context) (*pb.GetPayrollRunsResponse, error) {
+	return fc.payrollClient.GetPayrollRuns(ctx, &emptypb.Empty{})
+}
+func (fc *FinanceClient) GetPayrollRunById(ctx context.Context, runId string) (*pb.AbsPayrollRun, error) {
+	return fc.payrollClient.GetPayrollRunById(ctx, &pb.PayrollRunIdRequest{RunId: runId})
+}
+func (fc *FinanceClient) DeletePayrollRun(ctx context.Context, runId string) (*pb.AbsResponse, error) {
+	return fc.payrollClient.DeletePayrollRun(ctx, &pb.PayrollRunIdRequest{RunId: runId})
+}
+func (fc *FinanceClient) AddPayrollAdjustment(ctx context.Context, req *pb.AddPayrollAdjustmentRequest) (*pb.AbsResponse, error) {
+	return fc.payrollClient.AddPayrollAdjustment(ctx, req)
+}
+func (fc *FinanceClient) DeletePayrollAdjustment(ctx context.Context, id string) (*pb.AbsResponse, error) {
+	return fc.payrollClient.DeletePayrollAdjustment(ctx, &pb.DeletePayrollAdjustmentRequest{Id: id})
+}
+func (fc *FinanceClient) ApprovePayrollRun(ctx context.Context, req *pb.PayrollRunActionRequest) (*pb.AbsResponse, error) {
+	return fc.payrollClient.ApprovePayrollRun(ctx, req)
+}
+func (fc *FinanceClient) PayPayrollRun(ctx context.Context, req *pb.PayPayrollRunRequest) (*pb.AbsResponse, error) {
+	return fc.payrollClient.PayPayrollRun(ctx, req)
+}
+func (fc *FinanceClient) GetPayslip(ctx context.Context, runId, teacherId string) (*pb.Payslip, error) {
+	return fc.payrollClient.GetPayslip(ctx, &pb.GetPayslipRequest{RunId: runId, TeacherId: teacherId})
+}
+func (fc *FinanceClient) GetTeacherPayslips(ctx context.Context, teacherId string) (*pb.GetTeacherPayslipsResponse, error) {
+	return fc.payrollClient.GetTeacherPayslips(ctx, &pb.GetTeacherPayslipsRequest{TeacherId: teacherId})
+}
+func (fc *FinanceClient) CreateInstallmentPlan(ctx context.Context, req *pb.CreateInstallmentPlanRequest) (*pb.AbsResponse, error) {
+	return fc.installmentClient.CreateInstallmentPlan(ctx, req)
+}
+func (fc *FinanceClient) GetInstallmentPlans(ctx context.Context, studentId string) (*pb.GetInstallmentPlansResponse, error) {
+	return fc.installmentClient.GetInstallmentPlans(ctx, &pb.GetInstallmentPlansRequest{StudentId: studentId})
+}
+func (fc *FinanceClient) GetInstallmentPlanById(ctx context.Context, planId string) (*pb.AbsInstallmentPlan, error) {
+	return fc.installmentClient.GetInstallmentPlanById(ctx, &pb.InstallmentPlanIdRequest{PlanId: planId})
+}
+func (fc *FinanceClient) CancelInstallmentPlan(ctx context.Context, req *pb.CancelInstallmentPlanRequest) (*pb.AbsResponse, error) {
+	return fc.installmentClient.CancelInstallmentPlan(ctx, req)
+}
+func (fc *FinanceClient) GetOverdueInstallments(ctx context.Context, date, bucket string) (*pb.GetOverdueInstallmentsResponse, error) {
+	return fc.installmentClient.GetOverdueInstallments(ctx, &pb.GetOverdueInstallmentsRequest{Date: date, Bucket: bucket})
+}
+func (fc *FinanceClient) GetDebtAging(ctx context.Context, page, size, bucket, assignedToId string) (*pb.GetDebtAgingResponse, error) {
+	pageInt, err := strconv.Atoi(page)
+	if err != nil {
+		return nil, err
+	}
+	sizeInt, err := strconv.Atoi(size)
+	if err != nil {
+		return nil, err
+	}
+	return fc.collectionClient.GetDebtAging(ctx, &pb.GetDebtAgingRequest{
+		Bucket:       bucket,
+		AssignedToId: assignedToId,
+		Page:         int32(pageInt),
+		Size:         int32(sizeInt),
+	})
+}
+func (fc *FinanceClient) AssignDebtor(ctx context.Context, req *pb.AssignDebtorRequest) (*pb.AbsResponse, error) {
+	return fc.collectionClient.AssignDebtor(ctx, req)
+}
+func (fc *FinanceClient) AddCollectionActivity(ctx context.Context, req *pb.AddCollectionActivityRequest) (*pb.AbsResponse, error) {
+	return fc.collectionClient.AddCollectionActivity(ctx, req)
+}
+func (fc *FinanceClient) GetCollectionActivities(ctx context.Context, studentId string) (*pb.GetCollectionActivitiesResponse, error) {
+	return fc.collectionClient.GetCollectionActivities(ctx, &pb.GetCollectionActivitiesRequest{StudentId: studentId})
+}
+func (fc *FinanceClient) GetCollectionSetting(ctx context.Context) (*pb.CollectionSetting, error) {
+	return fc.collectionClient.GetCollectionSetting(ctx, &emptypb.Empty{})
+}
+func (fc *FinanceClient) UpdateCollectionSetting(ctx context.Context, req *pb.CollectionSetting) (*pb.AbsResponse, error) {
+	return fc.collectionClient.UpdateCollectionSetting(ctx, req)
+}
+func (fc *FinanceClient) RunCollections(ctx context.Context) (*pb.AbsResponse, error) {
+	return fc.collectionClient.RunCollections(ctx, &emptypb.Empty{})
+}
+func (fc *FinanceClient) GetProfitAndLoss(ctx context.Context, from, to, groupBy string) (*pb.GetProfitAndLossResponse, error) {
+	return fc.reportClient.GetProfitAndLoss(ctx, &pb.GetProfitAndLossRequest{From: from, To: to, GroupBy: groupBy})
+}
+func (fc *FinanceClient) SetCategoryBudget(ctx context.Context, req *pb.SetCategoryBudgetRequest) (*pb.AbsResponse, error) {
+	return fc.categoryClient.SetCategoryBudget(ctx, req)
+}
+func (fc *FinanceClient) CreateRecurringExpense(ctx context.Context, req *pb.CreateRecurringExpenseRequest) (*pb.AbsResponse, error) {
+	return fc.expenseClient.CreateRecurringExpense(ctx, req)
+}
+func (fc *FinanceClient) GetRecurringExpenses(ctx context.Context) (*pb.GetRecurringExpensesResponse, error) {
+	return fc.expenseClient.GetRecurringExpenses(ctx, &emptypb.Empty{})
+}
+func (fc *FinanceClient) DeleteRecurringExpense(ctx context.Context, id string) (*pb.AbsResponse, error) {
+	return fc.expenseClient.DeleteRecurringExpense(ctx, &pb.DeleteAbsRequest{Id: id})
+}
+func (fc *FinanceClient) PostRecurringExpenses(ctx context.Context) (*pb.AbsResponse, error) {
+	return fc.expenseClient.PostRecurringExpenses(ctx, &emptypb.Empty{})
+}
+func (fc *FinanceClient) GetBudgetAlerts(ctx context.Context, period string) (*pb.GetBudgetAlertsResponse, error) {
+	return fc.expenseClient.GetBudgetAlerts(ctx, &pb.GetBudgetAlertsRequest{Period: period})
+}
+func (fc *FinanceClient) GetExpenseById(ctx context.Context, id string) (*pb.ExpenseDetail, error) {
+	return fc.expenseClient.GetExpenseById(ctx, &pb.GetExpenseByIdRequest{Id: id})
+}
+func (fc *FinanceClient) SubmitExpense(ctx context.Context, req *pb.ExpenseActionRequest) (*pb.AbsResponse, error) {
+	return fc.expenseClient.SubmitExpense(ctx, req)
+}
+func (fc *FinanceClient) ApproveExpense(ctx context.Context, req *pb.ExpenseActionRequest) (*pb.AbsResponse, error) {
+	return fc.expenseClient.ApproveExpense(ctx, req)
+}
+func (fc *FinanceClient) RejectExpense(ctx context.Context, req *pb.ExpenseActionRequest) (*pb.AbsResponse, error) {
+	return fc.expenseClient.RejectExpense(ctx, req)
+}
+func (fc *FinanceClient) MarkExpensePaid(ctx context.Context, req *pb.ExpenseActionRequest) (*pb.AbsResponse, error) {
+	return fc.expenseClient.MarkExpensePaid(ctx, req)
+}
+func (fc *FinanceClient) AddExpenseReceipt(ctx context.Context, req *pb.AddExpenseReceiptRequest) (*pb.AbsResponse, error) {
+	return fc.expenseClient.AddExpenseReceipt(ctx, req)
+}
+func (fc *FinanceClient) DeleteExpenseReceipt(ctx context.Context, id string) (*pb.AbsResponse, error) {
+	return fc.expenseClient.DeleteExpenseReceipt(ctx, &pb.DeleteAbsRequest{Id: id})
+}
+func (fc *FinanceClient) GetExpenseApprovalSetting(ctx context.Context) (*pb.ExpenseApprovalSetting, error) {
+	return fc.expenseClient.GetExpenseApprovalSetting(ctx, &emptypb.Empty{})
+}
+func (fc *FinanceClient) UpdateExpenseApprovalSetting(ctx context.Context, req *pb.ExpenseApprovalSetting) (*pb.AbsResponse, error) {
+	return fc.expenseClient.UpdateExpenseApprovalSetting(ctx, req)
+}
+func (fc *FinanceClient) CreateVendor(ctx context.Context, req *pb.CreateVendorRequest) (*pb.AbsResponse, error) {
+	return fc.vendorClient.CreateVendor(ctx, req)
+}
+func (fc *FinanceClient) UpdateVendor(ctx context.Context, req *pb.AbsVendor) (*pb.AbsResponse, error) {
+	return fc.vendorClient.UpdateVendor(ctx, req)
+}
+func (fc *FinanceClient) GetVendors(ctx context.Context, includeInactive bool) (*pb.GetVendorsResponse, error) {
+	return fc.vendorClient.GetVendors(ctx, &pb.GetVendorsRequest{IncludeInactive: includeInactive})
+}
+func (fc *FinanceClient) GetVendorSpendHistory(ctx context.Context, vendorId, from, to string) (*pb.GetVendorSpendHistoryResponse, error) {
+	return fc.vendorClient.GetVendorSpendHistory(ctx, &pb.GetVendorSpendHistoryRequest{VendorId: vendorId, From: from, To: to})
+}
+func (fc *FinanceClient) GetOneCSetting(ctx context.Context) (*pb.OneCSetting, error) {
+	return fc.oneCExportClient.GetOneCSetting(ctx, &emptypb.Empty{})
+}
+func (fc *FinanceClient) UpdateOneCSetting(ctx context.Context, req *pb.OneCSetting) (*pb.AbsResponse, error) {
+	return fc.oneCExportClient.UpdateOneCSetting(ctx, req)
+}
+func (fc *FinanceClient) ExportOneC(ctx context.Context, from, to string) (*pb.ExportOneCResponse, error) {
+	return fc.oneCExportClient.ExportOneC(ctx, &pb.ExportOneCRequest{From: from, To: to})
+}
+func (fc *FinanceClient) ImportStatement(ctx context.Context, req *pb.ImportStatementRequest) (*pb.ReconciliationSession, error) {
+	return fc.reconciliationClient.ImportStatement(ctx, req)
+}
+func (fc *FinanceClient) GetReconciliationSessions(ctx context.Context, page, size string) (*pb.GetReconciliationSessionsResponse, error) {
+	pageInt, err := strconv.Atoi(page)
+	if err != nil {
+		return nil, err
+	}
+	sizeInt, err := strconv.Atoi(size)
+	if err != nil {
+		return nil, err
+	}
+	return fc.reconciliationClient.GetReconciliationSessions(ctx, &pb.GetReconciliationSessionsRequest{Page: int32(pageInt), Size: int32(sizeInt)})
+}
+func (fc *FinanceClient) GetReconciliationSession(ctx context.Context, id, lineStatus string) (*pb.ReconciliationSession, error) {
+	return fc.reconciliationClient.GetReconciliationSession(ctx, &pb.GetReconciliationSessionRequest{Id: id, Status: lineStatus})
+}
+func (fc *FinanceClient) CreateMissingPayments(ctx context.Context, req *pb.CreateMissingPaymentsRequest) (*pb.CreateMissingPaymentsResponse, error) {
+	return fc.reconciliationClient.CreateMissingPayments(ctx, req)
+}
+func (fc *FinanceClient) ResolveReconciliationLine(ctx context.Context, req *pb.ResolveReconciliationLineRequest) (*pb.AbsResponse, error) {
+	return fc.reconciliationClient.ResolveReconciliationLine(ctx, req)
+}
+func (fc *FinanceClient) CreateChargeItem(ctx context.Context, req *pb.CreateChargeItemRequest) (*pb.AbsResponse, error) {
+	return fc.chargeClient.CreateChargeItem(ctx, req)
+}
+func (fc *FinanceClient) UpdateChargeItem(ctx context.Context, req *pb.AbsChargeItem) (*pb.AbsResponse, error) {
+	return fc.chargeClient.UpdateChargeItem(ctx, req)
+}
+func (fc *FinanceClient) GetChargeItems(ctx context.Context, includeInactive bool) (*pb.GetChargeItemsResponse, error) {
+	return fc.chargeClient.GetChargeItems(ctx, &pb.GetChargeItemsRequest{IncludeInactive: includeInactive})
+}
+func (fc *FinanceClient) ChargeStudent(ctx context.Context, req *pb.ChargeStudentRequest) (*pb.AbsResponse, error) {
+	return fc.chargeClient.ChargeStudent(ctx, req)
+}
+func (fc *FinanceClient) GetStudentCharges(ctx context.Context, studentId string) (*pb.GetStudentChargesResponse, error) {
+	return fc.chargeClient.GetStudentCharges(ctx, &pb.GetStudentChargesRequest{StudentId: studentId})
+}
+func (fc *FinanceClient) GetChargeItemSalesReport(ctx context.Context, from, to string) (*pb.GetChargeItemSalesReportResponse, error) {
+	return fc.chargeClient.GetChargeItemSalesReport(ctx, &pb.GetChargeItemSalesReportRequest{From: from, To: to})
+}
 func NewFinanceClient(addr string) (*FinanceClient, error) {
 	conn, err := grpc.Dial(addr, grpc.WithInsecure(), grpc.WithBlock())
 	if err != nil {
@@ -176,5 +402,14 @@ func NewFinanceClient(addr string) (*FinanceClient, error) {
 	expenseClient := pb.NewExpenseServiceClient(conn)
 	paymentClient := pb.NewPaymentServiceClient(conn)
 	teacherClient := pb.NewTeacherSalaryServiceClient(conn)
-	return &FinanceClient{discountClient: discountClient, categoryClient: categoryClient, expenseClient: expenseClient, paymentClient: paymentClient, teacherSalaryClient: teacherClient}, nil
+	periodClient := pb.NewAccountingPeriodServiceClient(conn)
+	payrollClient := pb.NewPayrollServiceClient(conn)
+	installmentClient := pb.NewInstallmentServiceClient(conn)
+	collectionClient := pb.NewCollectionServiceClient(conn)
+	reportClient := pb.NewReportServiceClient(conn)
+	vendorClient := pb.NewVendorServiceClient(conn)
+	oneCExportClient := pb.NewOneCExportServiceClient(conn)
+	reconciliationClient := pb.NewReconciliationServiceClient(conn)
+	chargeClient := pb.NewChargeServiceClient(conn)
+	return &FinanceClient{discountClient: discountClient, categoryClient: categoryClient, expenseClient: expenseClient, paymentClient: paymentClient, teacherSalaryClient: teacherClient, periodClient: periodClient, payrollClient: payrollClient, installmentClient: installmentClient, collectionClient: collectionClient, reportClient: reportClient, vendorClient: vendorClient, oneCExportClient: oneCExportClient, reconciliationClient: reconciliationClient, chargeClient: chargeClient}, nil
 }
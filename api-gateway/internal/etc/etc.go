@@ -63,3 +63,27 @@ func NewTimeoutContextManual(ctx context.Context, companyId string) (context.Con
 	ctx = metadata.NewOutgoingContext(ctx, md)
 	return context.WithTimeout(ctx, time.Second*15)
 }
+
+// StudentAuthMiddleware lets in students holding a session of the student login, it sets the session as "student"
+func StudentAuthMiddleware(educationClient *client.EducationClient) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		const bearerPrefix = "Bearer "
+		authHeader := ctx.GetHeader("Authorization")
+		if !strings.HasPrefix(authHeader, bearerPrefix) {
+			ctx.JSON(http.StatusUnauthorized, gin.H{"error": "Authorization header is missing"})
+			ctx.Abort()
+			return
+		}
+		ctxR, cancel := context.WithTimeout(ctx, time.Second*15)
+		defer cancel()
+		student, err := educationClient.ValidateStudentSession(ctxR, strings.TrimPrefix(authHeader, bearerPrefix))
+		if err != nil {
+			ctx.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid or expired student session"})
+			ctx.Abort()
+			return
+		}
+		ctx.Set("student", student)
+		ctx.Set("company_id", student.CompanyId)
+		ctx.Next()
+	}
+}
@@ -6,10 +6,12 @@ import (
 	"api-gateway/internal/utils"
 	"fmt"
 	"github.com/gin-gonic/gin"
+	"github.com/spf13/cast"
 	"net/http"
 	"os"
 	"path/filepath"
 	"strconv"
+	"strings"
 	"time"
 )
 
@@ -117,6 +119,380 @@ func GetAllRoom(ctx *gin.Context) {
 	return
 }
 
+// CreateHoliday godoc
+// @Summary ADMIN , CEO
+// @Description Adds a holiday or closure on which groups have no lessons, lessons are not billed and attendance can not be marked. scope is COMPANY, ROOM (with roomId) or GROUP (with groupId). recurring holidays repeat every year. Returns the holiday id in message
+// @Tags holidays
+// @Accept json
+// @Produce json
+// @Security Bearer
+// @Param request body pb.AbsHoliday true "Holiday"
+// @Success 200 {object} utils.AbsResponse
+// @Failure 400 {object} utils.AbsResponse
+// @Failure 409 {object} utils.AbsResponse
+// @Router /api/holiday/create [post]
+func CreateHoliday(ctx *gin.Context) {
+	ctxR, cancel := etc.NewTimoutContext(ctx)
+	defer cancel()
+	req := pb.AbsHoliday{}
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		utils.RespondError(ctx, http.StatusBadRequest, err.Error())
+		return
+	}
+	resp, err := educationClient.CreateHoliday(ctxR, &req)
+	if err != nil {
+		utils.RespondError(ctx, http.StatusConflict, err.Error())
+		return
+	}
+	utils.RespondSuccess(ctx, resp.Status, resp.Message)
+}
+
+// UpdateHoliday godoc
+// @Summary ADMIN , CEO
+// @Description Edits a holiday. Preloaded national holidays only take isActive, switch one off when the centre works that day
+// @Tags holidays
+// @Accept json
+// @Produce json
+// @Security Bearer
+// @Param request body pb.AbsHoliday true "Holiday"
+// @Success 200 {object} utils.AbsResponse
+// @Failure 400 {object} utils.AbsResponse
+// @Failure 409 {object} utils.AbsResponse
+// @Router /api/holiday/update [put]
+func UpdateHoliday(ctx *gin.Context) {
+	ctxR, cancel := etc.NewTimoutContext(ctx)
+	defer cancel()
+	req := pb.AbsHoliday{}
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		utils.RespondError(ctx, http.StatusBadRequest, err.Error())
+		return
+	}
+	resp, err := educationClient.UpdateHoliday(ctxR, &req)
+	if err != nil {
+		utils.RespondError(ctx, http.StatusConflict, err.Error())
+		return
+	}
+	utils.RespondSuccess(ctx, resp.Status, resp.Message)
+}
+
+// DeleteHoliday godoc
+// @Summary ADMIN , CEO
+// @Description Deletes a company holiday, national holidays can only be switched off
+// @Tags holidays
+// @Produce json
+// @Security Bearer
+// @Param id path string true "Holiday ID"
+// @Success 200 {object} utils.AbsResponse
+// @Failure 409 {object} utils.AbsResponse
+// @Router /api/holiday/delete/{id} [delete]
+func DeleteHoliday(ctx *gin.Context) {
+	ctxR, cancel := etc.NewTimoutContext(ctx)
+	defer cancel()
+	resp, err := educationClient.DeleteHoliday(ctxR, ctx.Param("id"))
+	if err != nil {
+		utils.RespondError(ctx, http.StatusConflict, err.Error())
+		return
+	}
+	utils.RespondSuccess(ctx, resp.Status, resp.Message)
+}
+
+// GetHolidays godoc
+// @Summary ADMIN , CEO , FINANCIST , TEACHER
+// @Description Holidays of the company overlapping from-till (yyyy-MM-dd), recurring holidays are always listed, all holidays when the period is empty
+// @Tags holidays
+// @Produce json
+// @Security Bearer
+// @Param from query string false "From date"
+// @Param till query string false "Till date"
+// @Success 200 {object} pb.GetHolidaysResponse
+// @Failure 409 {object} utils.AbsResponse
+// @Router /api/holiday/get-all [get]
+func GetHolidays(ctx *gin.Context) {
+	ctxR, cancel := etc.NewTimoutContext(ctx)
+	defer cancel()
+	resp, err := educationClient.GetHolidays(ctxR, ctx.Query("from"), ctx.Query("till"))
+	if err != nil {
+		utils.RespondError(ctx, http.StatusConflict, err.Error())
+		return
+	}
+	ctx.JSON(http.StatusOK, resp)
+}
+
+// GetGroupHolidays godoc
+// @Summary ADMIN , CEO , FINANCIST , TEACHER
+// @Description Days between from and till (yyyy-MM-dd) on which the group has no lessons because of a company, room or group holiday
+// @Tags holidays
+// @Produce json
+// @Security Bearer
+// @Param groupId path string true "Group ID"
+// @Param from query string true "From date"
+// @Param till query string true "Till date"
+// @Success 200 {object} pb.GetGroupHolidaysResponse
+// @Failure 409 {object} utils.AbsResponse
+// @Router /api/holiday/group/{groupId} [get]
+func GetGroupHolidays(ctx *gin.Context) {
+	ctxR, cancel := etc.NewTimoutContext(ctx)
+	defer cancel()
+	resp, err := educationClient.GetGroupHolidays(ctxR, ctx.Param("groupId"), ctx.Query("from"), ctx.Query("till"))
+	if err != nil {
+		utils.RespondError(ctx, http.StatusConflict, err.Error())
+		return
+	}
+	ctx.JSON(http.StatusOK, resp)
+}
+
+// GetTimetable godoc
+// @Summary ADMIN , CEO , FINANCIST , TEACHER
+// @Description Lessons of the groups between from and till (yyyy-MM-dd, at most 93 days) with holidays left out and transferred lessons on their new date. Teachers only see their own lessons.
+// @Tags timetable
+// @Produce json
+// @Security Bearer
+// @Param from query string true "From date"
+// @Param till query string true "Till date"
+// @Param roomId query int false "Room ID"
+// @Param teacherId query string false "Teacher ID"
+// @Param courseId query int false "Course ID"
+// @Param groupId query string false "Group ID"
+// @Success 200 {object} pb.GetTimetableResponse
+// @Failure 409 {object} utils.AbsResponse
+// @Router /api/timetable/get [get]
+func GetTimetable(ctx *gin.Context) {
+	ctxR, cancel := etc.NewTimoutContext(ctx)
+	defer cancel()
+	user, err := utils.GetUserFromContext(ctx)
+	if err != nil {
+		utils.RespondError(ctx, http.StatusUnauthorized, err.Error())
+		return
+	}
+	req := pb.GetTimetableRequest{
+		From:      ctx.Query("from"),
+		Till:      ctx.Query("till"),
+		RoomId:    cast.ToInt32(ctx.Query("roomId")),
+		TeacherId: ctx.Query("teacherId"),
+		CourseId:  cast.ToInt32(ctx.Query("courseId")),
+		GroupId:   ctx.Query("groupId"),
+	}
+	if user.Role == "TEACHER" {
+		req.TeacherId = user.Id
+	}
+	resp, err := educationClient.GetTimetable(ctxR, &req)
+	if err != nil {
+		utils.RespondError(ctx, http.StatusConflict, err.Error())
+		return
+	}
+	ctx.JSON(http.StatusOK, resp)
+}
+
+// CreateCalendarFeed godoc
+// @Summary ADMIN , CEO , TEACHER
+// @Description Creates a tokenised .ics feed url of a teacher or room to subscribe to in a calendar app. Teachers can only create a feed of their own lessons.
+// @Tags timetable
+// @Accept json
+// @Produce json
+// @Security Bearer
+// @Param request body pb.CreateCalendarFeedRequest true "ownerType is TEACHER or ROOM"
+// @Success 200 {object} pb.CalendarFeed
+// @Failure 400 {object} utils.AbsResponse
+// @Failure 409 {object} utils.AbsResponse
+// @Router /api/timetable/feed/create [post]
+func CreateCalendarFeed(ctx *gin.Context) {
+	ctxR, cancel := etc.NewTimoutContext(ctx)
+	defer cancel()
+	req := pb.CreateCalendarFeedRequest{}
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		utils.RespondError(ctx, http.StatusBadRequest, err.Error())
+		return
+	}
+	user, err := utils.GetUserFromContext(ctx)
+	if err != nil {
+		utils.RespondError(ctx, http.StatusUnauthorized, err.Error())
+		return
+	}
+	if user.Role == "TEACHER" {
+		req.OwnerType = "TEACHER"
+		req.OwnerId = user.Id
+	}
+	resp, err := educationClient.CreateCalendarFeed(ctxR, &req)
+	if err != nil {
+		utils.RespondError(ctx, http.StatusConflict, err.Error())
+		return
+	}
+	resp.Url = calendarFeedUrl(ctx, resp.Token)
+	ctx.JSON(http.StatusOK, resp)
+}
+
+// GetCalendarFeeds godoc
+// @Summary ADMIN , CEO , TEACHER
+// @Description Active calendar feeds, optionally of one teacher or room. Teachers only see their own feeds.
+// @Tags timetable
+// @Produce json
+// @Security Bearer
+// @Param ownerType query string false "TEACHER or ROOM"
+// @Param ownerId query string false "Teacher or room ID"
+// @Success 200 {object} pb.GetCalendarFeedsResponse
+// @Failure 409 {object} utils.AbsResponse
+// @Router /api/timetable/feed/get-all [get]
+func GetCalendarFeeds(ctx *gin.Context) {
+	ctxR, cancel := etc.NewTimoutContext(ctx)
+	defer cancel()
+	user, err := utils.GetUserFromContext(ctx)
+	if err != nil {
+		utils.RespondError(ctx, http.StatusUnauthorized, err.Error())
+		return
+	}
+	req := pb.GetCalendarFeedsRequest{OwnerType: ctx.Query("ownerType"), OwnerId: ctx.Query("ownerId")}
+	if user.Role == "TEACHER" {
+		req.OwnerType = "TEACHER"
+		req.OwnerId = user.Id
+	}
+	resp, err := educationClient.GetCalendarFeeds(ctxR, &req)
+	if err != nil {
+		utils.RespondError(ctx, http.StatusConflict, err.Error())
+		return
+	}
+	for _, feed := range resp.Feeds {
+		feed.Url = calendarFeedUrl(ctx, feed.Token)
+	}
+	ctx.JSON(http.StatusOK, resp)
+}
+
+// RevokeCalendarFeed godoc
+// @Summary ADMIN , CEO
+// @Description Revokes a calendar feed, its url stops working
+// @Tags timetable
+// @Produce json
+// @Security Bearer
+// @Param id path string true "Feed ID"
+// @Success 200 {object} utils.AbsResponse
+// @Failure 409 {object} utils.AbsResponse
+// @Router /api/timetable/feed/revoke/{id} [delete]
+func RevokeCalendarFeed(ctx *gin.Context) {
+	ctxR, cancel := etc.NewTimoutContext(ctx)
+	defer cancel()
+	resp, err := educationClient.RevokeCalendarFeed(ctxR, ctx.Param("id"))
+	if err != nil {
+		utils.RespondError(ctx, http.StatusConflict, err.Error())
+		return
+	}
+	utils.RespondSuccess(ctx, resp.Status, resp.Message)
+}
+
+// GetCalendarFeedIcs godoc
+// @Summary PUBLIC
+// @Description iCalendar feed of a teacher or room, the token in the url is the only credential so calendar apps can subscribe without logging in
+// @Tags timetable
+// @Produce text/calendar
+// @Param file path string true "Feed token followed by .ics"
+// @Success 200 {string} string "iCalendar document"
+// @Failure 404 {object} utils.AbsResponse
+// @Router /api/timetable/feed/ics/{file} [get]
+func GetCalendarFeedIcs(ctx *gin.Context) {
+	ctxR, cancel := etc.NewTimoutContext(ctx)
+	defer cancel()
+	token := strings.TrimSuffix(ctx.Param("file"), ".ics")
+	resp, err := educationClient.GetCalendarFeedTimetable(ctxR, token)
+	if err != nil {
+		utils.RespondError(ctx, http.StatusNotFound, err.Error())
+		return
+	}
+	ctx.Header("Content-Disposition", "inline; filename=timetable.ics")
+	ctx.Data(http.StatusOK, "text/calendar; charset=utf-8", []byte(utils.TimetableCalendar(resp.Feed.OwnerName, resp.Lessons)))
+}
+
+func calendarFeedUrl(ctx *gin.Context, token string) string {
+	scheme := "http"
+	if ctx.Request.TLS != nil {
+		scheme = "https"
+	}
+	if forwarded := ctx.GetHeader("X-Forwarded-Proto"); forwarded != "" {
+		scheme = forwarded
+	}
+	return scheme + "://" + ctx.Request.Host + "/api/timetable/feed/ics/" + token + ".ics"
+}
+
+// GetLessons godoc
+// @Summary ADMIN , CEO , FINANCIST , TEACHER
+// @Description Lessons of a group between from and till (yyyy-MM-dd, at most 93 days) with topic, homework, materials and the teacher who teaches them
+// @Tags lessons
+// @Produce json
+// @Security Bearer
+// @Param groupId path string true "Group ID"
+// @Param from query string true "From date"
+// @Param till query string true "Till date"
+// @Success 200 {object} pb.GetLessonsResponse
+// @Failure 409 {object} utils.AbsResponse
+// @Router /api/lesson/get-all/{groupId} [get]
+func GetLessons(ctx *gin.Context) {
+	ctxR, cancel := etc.NewTimoutContext(ctx)
+	defer cancel()
+	resp, err := educationClient.GetLessons(ctxR, &pb.GetLessonsRequest{
+		GroupId: ctx.Param("groupId"),
+		From:    ctx.Query("from"),
+		Till:    ctx.Query("till"),
+	})
+	if err != nil {
+		utils.RespondError(ctx, http.StatusConflict, err.Error())
+		return
+	}
+	ctx.JSON(http.StatusOK, resp)
+}
+
+// GetLessonById godoc
+// @Summary ADMIN , CEO , FINANCIST , TEACHER
+// @Description Get a lesson by its ID
+// @Tags lessons
+// @Produce json
+// @Security Bearer
+// @Param id path string true "Lesson ID"
+// @Success 200 {object} pb.AbsLesson
+// @Failure 409 {object} utils.AbsResponse
+// @Router /api/lesson/get-by-id/{id} [get]
+func GetLessonById(ctx *gin.Context) {
+	ctxR, cancel := etc.NewTimoutContext(ctx)
+	defer cancel()
+	resp, err := educationClient.GetLessonById(ctxR, ctx.Param("id"))
+	if err != nil {
+		utils.RespondError(ctx, http.StatusConflict, err.Error())
+		return
+	}
+	ctx.JSON(http.StatusOK, resp)
+}
+
+// UpdateLesson godoc
+// @Summary ADMIN , CEO , TEACHER
+// @Description Saves topic, notes, homework and materials of a lesson. Admins can set a substitute teacher who is paid for the lesson instead of the teacher of the group, an empty substituteTeacherId gives it back.
+// @Tags lessons
+// @Accept json
+// @Produce json
+// @Security Bearer
+// @Param request body pb.UpdateLessonRequest true "Lesson"
+// @Success 200 {object} pb.AbsLesson
+// @Failure 400 {object} utils.AbsResponse
+// @Failure 409 {object} utils.AbsResponse
+// @Router /api/lesson/update [put]
+func UpdateLesson(ctx *gin.Context) {
+	ctxR, cancel := etc.NewTimoutContext(ctx)
+	defer cancel()
+	req := pb.UpdateLessonRequest{}
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		utils.RespondError(ctx, http.StatusBadRequest, err.Error())
+		return
+	}
+	user, err := utils.GetUserFromContext(ctx)
+	if err != nil {
+		utils.RespondError(ctx, http.StatusUnauthorized, err.Error())
+		return
+	}
+	req.ActionById = user.Id
+	req.ActionByRole = user.Role
+	resp, err := educationClient.UpdateLesson(ctxR, &req)
+	if err != nil {
+		utils.RespondError(ctx, http.StatusConflict, err.Error())
+		return
+	}
+	ctx.JSON(http.StatusOK, resp)
+}
+
 // CreateCourse godoc
 // @Summary ADMIN , CEO
 // @Description Create a new course based on the provided request data
@@ -221,184 +597,1264 @@ func GetAllCourse(ctx *gin.Context) {
 	return
 }
 
-// GetCourseById godoc
-// @Summary ADMIN , CEO
-// @Description Retrieves a course by its ID for admin users.
-// @Tags courses
+// GetCourseById godoc
+// @Summary ADMIN , CEO
+// @Description Retrieves a course by its ID for admin users.
+// @Tags courses
+// @Produce json
+// @Security Bearer
+// @Param id path string true "Course ID"
+// @Success 200 {object} pb.AbsCourse "Successful response with course details"
+// @Failure 500 {object} utils.AbsResponse "Internal server error"
+// @Router /api/course/get-by-id/{id} [get]
+func GetCourseById(ctx *gin.Context) {
+	ctxR, cancel := etc.NewTimoutContext(ctx)
+	defer cancel()
+	id := ctx.Param("id")
+	resp, err := educationClient.GetCourseById(ctxR, id)
+	if err != nil {
+		utils.RespondError(ctx, http.StatusInternalServerError, err.Error())
+		return
+	}
+	ctx.JSON(http.StatusOK, resp)
+	return
+}
+
+// GetCourseBilling godoc
+// @Summary ADMIN , CEO , FINANCIST
+// @Description Billing mode of a course with the lesson and package prices
+// @Tags courses
+// @Produce json
+// @Security Bearer
+// @Param id path string true "Course ID"
+// @Success 200 {object} pb.CourseBilling
+// @Failure 409 {object} utils.AbsResponse
+// @Router /api/course/billing/{id} [get]
+func GetCourseBilling(ctx *gin.Context) {
+	ctxR, cancel := etc.NewTimoutContext(ctx)
+	defer cancel()
+	resp, err := educationClient.GetCourseBilling(ctxR, ctx.Param("id"))
+	if err != nil {
+		utils.RespondError(ctx, http.StatusConflict, err.Error())
+		return
+	}
+	ctx.JSON(http.StatusOK, resp)
+}
+
+// UpdateCourseBilling godoc
+// @Summary ADMIN , CEO , FINANCIST
+// @Description Sets how a course is billed. MONTHLY takes the course price every month, PER_LESSON charges lessonPrice per attended lesson, PACKAGE sells packages of packageLessons lessons for packagePrice valid packageValidDays days (0 never expires) and consumes a lesson per attendance. Students get the low credit sms when lowCreditLessons lessons are left
+// @Tags courses
+// @Accept json
+// @Produce json
+// @Security Bearer
+// @Param request body pb.CourseBilling true "Course billing"
+// @Success 200 {object} utils.AbsResponse
+// @Failure 400 {object} utils.AbsResponse
+// @Failure 409 {object} utils.AbsResponse
+// @Router /api/course/billing [put]
+func UpdateCourseBilling(ctx *gin.Context) {
+	ctxR, cancel := etc.NewTimoutContext(ctx)
+	defer cancel()
+	req := pb.CourseBilling{}
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		utils.RespondError(ctx, http.StatusBadRequest, err.Error())
+		return
+	}
+	resp, err := educationClient.UpdateCourseBilling(ctxR, &req)
+	if err != nil {
+		utils.RespondError(ctx, http.StatusConflict, err.Error())
+		return
+	}
+	utils.RespondSuccess(ctx, resp.Status, resp.Message)
+}
+
+// ScheduleCoursePrice godoc
+// @Summary ADMIN , CEO , FINANCIST
+// @Description Adds a course price version used for lessons and monthly billing from effectiveFrom (yyyy-MM-dd, today when empty). Earlier months keep the price they were charged with. With grandfatherExisting students enrolled now keep paying their current price. Returns the version id in message
+// @Tags courses
+// @Accept json
+// @Produce json
+// @Security Bearer
+// @Param request body pb.ScheduleCoursePriceRequest true "Course price"
+// @Success 200 {object} utils.AbsResponse
+// @Failure 400 {object} utils.AbsResponse
+// @Failure 409 {object} utils.AbsResponse
+// @Router /api/course/price [post]
+func ScheduleCoursePrice(ctx *gin.Context) {
+	ctxR, cancel := etc.NewTimoutContext(ctx)
+	defer cancel()
+	req := pb.ScheduleCoursePriceRequest{}
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		utils.RespondError(ctx, http.StatusBadRequest, err.Error())
+		return
+	}
+	user, err := utils.GetUserFromContext(ctx)
+	if err != nil {
+		utils.RespondError(ctx, http.StatusUnauthorized, err.Error())
+		return
+	}
+	req.ActionById = user.Id
+	req.ActionByName = user.Name
+	resp, err := educationClient.ScheduleCoursePrice(ctxR, &req)
+	if err != nil {
+		utils.RespondError(ctx, http.StatusConflict, err.Error())
+		return
+	}
+	utils.RespondSuccess(ctx, resp.Status, resp.Message)
+}
+
+// GetCoursePriceHistory godoc
+// @Summary ADMIN , CEO , FINANCIST
+// @Description Price versions of a course, newest first, with the enrollments paying a grandfathered price
+// @Tags courses
+// @Produce json
+// @Security Bearer
+// @Param id path string true "Course ID"
+// @Success 200 {object} pb.GetCoursePriceHistoryResponse
+// @Failure 409 {object} utils.AbsResponse
+// @Router /api/course/price/{id} [get]
+func GetCoursePriceHistory(ctx *gin.Context) {
+	ctxR, cancel := etc.NewTimoutContext(ctx)
+	defer cancel()
+	resp, err := educationClient.GetCoursePriceHistory(ctxR, ctx.Param("id"))
+	if err != nil {
+		utils.RespondError(ctx, http.StatusConflict, err.Error())
+		return
+	}
+	ctx.JSON(http.StatusOK, resp)
+}
+
+// SetEnrollmentPriceLock godoc
+// @Summary ADMIN , CEO , FINANCIST
+// @Description Keeps one student of a group on a fixed monthly price from lockedFrom (yyyy-MM-dd, today when empty) regardless of course price changes. price 0 removes the lock
+// @Tags courses
+// @Accept json
+// @Produce json
+// @Security Bearer
+// @Param request body pb.SetEnrollmentPriceLockRequest true "Enrollment price lock"
+// @Success 200 {object} utils.AbsResponse
+// @Failure 400 {object} utils.AbsResponse
+// @Failure 409 {object} utils.AbsResponse
+// @Router /api/course/price/lock [put]
+func SetEnrollmentPriceLock(ctx *gin.Context) {
+	ctxR, cancel := etc.NewTimoutContext(ctx)
+	defer cancel()
+	req := pb.SetEnrollmentPriceLockRequest{}
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		utils.RespondError(ctx, http.StatusBadRequest, err.Error())
+		return
+	}
+	resp, err := educationClient.SetEnrollmentPriceLock(ctxR, &req)
+	if err != nil {
+		utils.RespondError(ctx, http.StatusConflict, err.Error())
+		return
+	}
+	utils.RespondSuccess(ctx, resp.Status, resp.Message)
+}
+
+// CreateGroup godoc
+// @Summary ADMIN , CEO
+// @Description Create a new group with provided details.
+// @Tags groups
+// @Accept json
+// @Produce json
+// @Security Bearer
+// @Param group body pb.CreateGroupRequest true "Group Data"
+// @Success 200 {object} utils.AbsResponse "Group successfully created"
+// @Failure 400 {object} utils.AbsResponse "Bad request"
+// @Failure 409 {object} utils.ScheduleConflictResponse "Room or teacher already booked, send force to save anyway"
+// @Failure 500 {object} utils.AbsResponse "Internal server error"
+// @Router /api/group/create [post]
+func CreateGroup(ctx *gin.Context) {
+	ctxR, cancel := etc.NewTimoutContext(ctx)
+	defer cancel()
+	var req pb.CreateGroupRequest
+	err := ctx.ShouldBindJSON(&req)
+	if err != nil {
+		utils.RespondError(ctx, http.StatusBadRequest, err.Error())
+		return
+	}
+	user, err := utils.GetUserFromContext(ctx)
+	if err != nil {
+		utils.RespondError(ctx, http.StatusUnauthorized, err.Error())
+		return
+	}
+	req.ActionById = user.Id
+	req.ActionByName = user.Name
+	resp, err := educationClient.CreateGroup(ctxR, &req)
+	if err != nil {
+		if utils.RespondScheduleConflict(ctx, err) {
+			return
+		}
+		utils.RespondError(ctx, http.StatusInternalServerError, err.Error())
+		return
+	}
+	utils.RespondSuccess(ctx, resp.Status, resp.Message)
+	return
+}
+
+// UpdateGroup godoc
+// @Summary ADMIN , CEO
+// @Description Update details of an existing group.
+// @Tags groups
+// @Accept json
+// @Produce json
+// @Security Bearer
+// @Param group body pb.GetUpdateGroupAbs true "Group Data"
+// @Success 200 {object} utils.AbsResponse "Group successfully updated"
+// @Failure 400 {object} utils.AbsResponse "Bad request"
+// @Failure 409 {object} utils.ScheduleConflictResponse "Room or teacher already booked, send force to save anyway"
+// @Failure 500 {object} utils.AbsResponse "Internal server error"
+// @Router /api/group/update [put]
+func UpdateGroup(ctx *gin.Context) {
+	ctxR, cancel := etc.NewTimoutContext(ctx)
+	defer cancel()
+	var req *pb.GetUpdateGroupAbs
+	err := ctx.ShouldBindJSON(&req)
+	if err != nil {
+		utils.RespondError(ctx, http.StatusBadRequest, err.Error())
+		return
+	}
+	user, err := utils.GetUserFromContext(ctx)
+	if err != nil {
+		utils.RespondError(ctx, http.StatusUnauthorized, err.Error())
+		return
+	}
+	req.ActionById = user.Id
+	req.ActionByName = user.Name
+	resp, err := educationClient.UpdateGroup(ctxR, req)
+	if err != nil {
+		if utils.RespondScheduleConflict(ctx, err) {
+			return
+		}
+		utils.RespondError(ctx, http.StatusInternalServerError, err.Error())
+		return
+	}
+	utils.RespondSuccess(ctx, resp.Status, resp.Message)
+	return
+}
+
+// CheckScheduleConflicts godoc
+// @Summary ADMIN , CEO , FINANCIST
+// @Description Lists the groups whose room or teacher is already booked at the lesson time of a group being created or edited.
+// @Tags groups
+// @Accept json
+// @Produce json
+// @Security Bearer
+// @Param group body pb.CheckScheduleConflictsRequest true "Group schedule, groupId is empty for a new group"
+// @Success 200 {object} pb.ScheduleConflicts
+// @Failure 400 {object} utils.AbsResponse "Bad request"
+// @Failure 409 {object} utils.AbsResponse "Conflict"
+// @Router /api/group/check-conflicts [post]
+func CheckScheduleConflicts(ctx *gin.Context) {
+	ctxR, cancel := etc.NewTimoutContext(ctx)
+	defer cancel()
+	req := pb.CheckScheduleConflictsRequest{}
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		utils.RespondError(ctx, http.StatusBadRequest, err.Error())
+		return
+	}
+	resp, err := educationClient.CheckScheduleConflicts(ctxR, &req)
+	if err != nil {
+		utils.RespondError(ctx, http.StatusConflict, err.Error())
+		return
+	}
+	ctx.JSON(http.StatusOK, resp)
+}
+
+// GetScheduleConflictOverrides godoc
+// @Summary ADMIN , CEO
+// @Description Lists groups and lesson transfers saved with force although the room or teacher was booked.
+// @Tags groups
+// @Produce json
+// @Security Bearer
+// @Param from query string false "From date (YYYY-MM-DD)"
+// @Param till query string false "Till date (YYYY-MM-DD)"
+// @Success 200 {object} pb.GetScheduleConflictOverridesResponse
+// @Failure 409 {object} utils.AbsResponse "Conflict"
+// @Router /api/group/conflict-overrides [get]
+func GetScheduleConflictOverrides(ctx *gin.Context) {
+	ctxR, cancel := etc.NewTimoutContext(ctx)
+	defer cancel()
+	resp, err := educationClient.GetScheduleConflictOverrides(ctxR, &pb.GetScheduleConflictOverridesRequest{
+		From: ctx.Query("from"),
+		Till: ctx.Query("till"),
+	})
+	if err != nil {
+		utils.RespondError(ctx, http.StatusConflict, err.Error())
+		return
+	}
+	ctx.JSON(http.StatusOK, resp)
+}
+
+// DeleteGroup godoc
+// @Summary ADMIN , CEO
+// @Description Delete a group by its ID.
+// @Tags groups
+// @Produce json
+// @Security Bearer
+// @Param id path string true "Group ID"
+// @Success 200 {object} utils.AbsResponse "Group successfully deleted"
+// @Failure 500 {object} utils.AbsResponse "Internal server error"
+// @Router /api/group/delete/{id} [delete]
+func DeleteGroup(ctx *gin.Context) {
+	ctxR, cancel := etc.NewTimoutContext(ctx)
+	defer cancel()
+	id := ctx.Param("id")
+	resp, err := educationClient.DeleteGroup(ctxR, id)
+	if err != nil {
+		utils.RespondError(ctx, http.StatusInternalServerError, err.Error())
+		return
+	}
+	utils.RespondSuccess(ctx, resp.Status, resp.Message)
+	return
+}
+
+// GetAllGroup godoc
+// @Summary ADMIN , CEO
+// @Description Retrieve a list of all groups.
+// @Tags groups
+// @Produce json
+// @Security Bearer
+// @Param group body pb.GetGroupsRequest true "Group Data"
+// @Success 200 {array} pb.GetGroupsResponse "List of groups"
+// @Failure 400 {object} utils.AbsResponse "Bad Request"
+// @Failure 500 {object} utils.AbsResponse "Internal server error"
+// @Router /api/group/get-all [post]
+func GetAllGroup(ctx *gin.Context) {
+	var (
+		req *pb.GetGroupsRequest
+	)
+	ctxR, cancel := etc.NewTimoutContext(ctx)
+	defer cancel()
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		utils.RespondError(ctx, http.StatusBadRequest, err.Error())
+		return
+	}
+	resp, err := educationClient.GetAllGroup(ctxR, req)
+	if err != nil {
+		utils.RespondError(ctx, http.StatusInternalServerError, err.Error())
+		return
+	}
+	ctx.JSON(http.StatusOK, &resp)
+}
+
+// GetGroupById godoc
+// @Summary ADMIN
+// @Description Retrieve details of a group by its ID.
+// @Tags groups
+// @Produce json
+// @Security Bearer
+// @Param id path string true "Group ID"
+// @Success 200 {object} pb.GetGroupAbsResponse "Group details"
+// @Failure 500 {object} utils.AbsResponse "Internal server error"
+// @Router /api/group/get-by-id/{id} [get]
+func GetGroupById(ctx *gin.Context) {
+	ctxR, cancel := etc.NewTimoutContext(ctx)
+	defer cancel()
+	id := ctx.Param("id")
+	user, err := utils.GetUserFromContext(ctx)
+	if err != nil {
+		utils.RespondError(ctx, http.StatusUnauthorized, err.Error())
+		return
+	}
+	resp, err := educationClient.GetGroupById(ctxR, id, user.Id, user.Role)
+	if err != nil {
+		utils.RespondError(ctx, http.StatusInternalServerError, err.Error())
+		return
+	}
+	ctx.JSON(http.StatusOK, &resp)
+	return
+}
+
+// SetAttendance godoc
+// @Summary TEACHER
+// @Description Record attendance for a student in a group on a specific date. status is a code from /api/attendance/status/get-all, -1 deletes the attendance. A makeup status takes makeupGroupId and makeupDate of the absence it makes up.
+// @Tags attendance
+// @Produce json
+// @Security Bearer
+// @Param attendance body pb.SetAttendanceRequest true "Attendance details"
+// @Success 200 {object} utils.AbsResponse "Attendance recorded successfully"
+// @Failure 400 {object} utils.AbsResponse "Invalid request"
+// @Failure 500 {object} utils.AbsResponse "Internal server error"
+// @Router /api/attendance/set [post]
+func SetAttendance(ctx *gin.Context) {
+	var req pb.SetAttendanceRequest
+	err := ctx.ShouldBindJSON(&req)
+	if err != nil {
+		utils.RespondError(ctx, http.StatusBadRequest, err.Error())
+		return
+	}
+	user, err := utils.GetUserFromContext(ctx)
+	if err != nil {
+		utils.RespondError(ctx, http.StatusUnauthorized, err.Error())
+		return
+	}
+	req.ActionById = user.Id
+	req.ActionByRole = user.Role
+	ctxR, cancelFunc := etc.NewTimoutContext(ctx)
+	defer cancelFunc()
+	resp, err := educationClient.SetAttendanceByGroup(ctxR, &req)
+	if err != nil {
+		utils.RespondError(ctx, http.StatusInternalServerError, err.Error())
+		return
+	}
+	utils.RespondSuccess(ctx, resp.Status, resp.Message)
+	return
+}
+
+// SetGroupAttendance godoc
+// @Summary ADMIN , CEO , TEACHER , FINANCIST
+// @Description Record attendance for the whole roster of a group on one date. Each student gets its own result, status -1 deletes the attendance.
+// @Tags attendance
+// @Produce json
+// @Security Bearer
+// @Param attendance body pb.SetGroupAttendanceRequest true "Roster attendance"
+// @Success 200 {object} pb.SetGroupAttendanceResponse
+// @Failure 400 {object} utils.AbsResponse "Invalid request"
+// @Failure 409 {object} utils.AbsResponse "Attendance could not be recorded"
+// @Router /api/attendance/set-group [post]
+func SetGroupAttendance(ctx *gin.Context) {
+	var req pb.SetGroupAttendanceRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		utils.RespondError(ctx, http.StatusBadRequest, err.Error())
+		return
+	}
+	user, err := utils.GetUserFromContext(ctx)
+	if err != nil {
+		utils.RespondError(ctx, http.StatusUnauthorized, err.Error())
+		return
+	}
+	req.ActionById = user.Id
+	req.ActionByRole = user.Role
+	ctxR, cancelFunc := etc.NewTimoutContext(ctx)
+	defer cancelFunc()
+	resp, err := educationClient.SetGroupAttendance(ctxR, &req)
+	if err != nil {
+		utils.RespondError(ctx, http.StatusConflict, err.Error())
+		return
+	}
+	ctx.JSON(http.StatusOK, resp)
+}
+
+// CreateAttendanceStatus godoc
+// @Summary ADMIN , CEO
+// @Description Adds an attendance status. billable charges the lesson in groups not billed monthly, paysTeacher counts the student in the teacher salary, absenceSms sends the not participate sms and isMakeup marks attending a lesson that makes up an absence. Returns the status code to mark attendance with in message
+// @Tags attendance
+// @Accept json
+// @Produce json
+// @Security Bearer
+// @Param request body pb.AbsAttendanceStatus true "Attendance status"
+// @Success 200 {object} utils.AbsResponse
+// @Failure 400 {object} utils.AbsResponse
+// @Failure 409 {object} utils.AbsResponse
+// @Router /api/attendance/status/create [post]
+func CreateAttendanceStatus(ctx *gin.Context) {
+	ctxR, cancel := etc.NewTimoutContext(ctx)
+	defer cancel()
+	req := pb.AbsAttendanceStatus{}
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		utils.RespondError(ctx, http.StatusBadRequest, err.Error())
+		return
+	}
+	resp, err := educationClient.CreateAttendanceStatus(ctxR, &req)
+	if err != nil {
+		utils.RespondError(ctx, http.StatusConflict, err.Error())
+		return
+	}
+	utils.RespondSuccess(ctx, resp.Status, resp.Message)
+}
+
+// UpdateAttendanceStatus godoc
+// @Summary ADMIN , CEO
+// @Description Edits the name and flags of an attendance status, the flags apply to attendance marked before too. Switch a status off with isActive
+// @Tags attendance
+// @Accept json
+// @Produce json
+// @Security Bearer
+// @Param request body pb.AbsAttendanceStatus true "Attendance status"
+// @Success 200 {object} utils.AbsResponse
+// @Failure 400 {object} utils.AbsResponse
+// @Failure 409 {object} utils.AbsResponse
+// @Router /api/attendance/status/update [put]
+func UpdateAttendanceStatus(ctx *gin.Context) {
+	ctxR, cancel := etc.NewTimoutContext(ctx)
+	defer cancel()
+	req := pb.AbsAttendanceStatus{}
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		utils.RespondError(ctx, http.StatusBadRequest, err.Error())
+		return
+	}
+	resp, err := educationClient.UpdateAttendanceStatus(ctxR, &req)
+	if err != nil {
+		utils.RespondError(ctx, http.StatusConflict, err.Error())
+		return
+	}
+	utils.RespondSuccess(ctx, resp.Status, resp.Message)
+}
+
+// DeleteAttendanceStatus godoc
+// @Summary ADMIN , CEO
+// @Description Deletes an attendance status nothing was marked with, preloaded and used statuses can only be switched off
+// @Tags attendance
+// @Produce json
+// @Security Bearer
+// @Param id path string true "Attendance status ID"
+// @Success 200 {object} utils.AbsResponse
+// @Failure 409 {object} utils.AbsResponse
+// @Router /api/attendance/status/delete/{id} [delete]
+func DeleteAttendanceStatus(ctx *gin.Context) {
+	ctxR, cancel := etc.NewTimoutContext(ctx)
+	defer cancel()
+	resp, err := educationClient.DeleteAttendanceStatus(ctxR, ctx.Param("id"))
+	if err != nil {
+		utils.RespondError(ctx, http.StatusConflict, err.Error())
+		return
+	}
+	utils.RespondSuccess(ctx, resp.Status, resp.Message)
+}
+
+// GetAttendanceStatuses godoc
+// @Summary ADMIN , CEO , FINANCIST , TEACHER
+// @Description Attendance statuses of the company, switched off ones only with withInactive
+// @Tags attendance
+// @Produce json
+// @Security Bearer
+// @Param withInactive query bool false "Include switched off statuses"
+// @Success 200 {object} pb.GetAttendanceStatusesResponse
+// @Failure 409 {object} utils.AbsResponse
+// @Router /api/attendance/status/get-all [get]
+func GetAttendanceStatuses(ctx *gin.Context) {
+	ctxR, cancel := etc.NewTimoutContext(ctx)
+	defer cancel()
+	resp, err := educationClient.GetAttendanceStatuses(ctxR, cast.ToBool(ctx.Query("withInactive")))
+	if err != nil {
+		utils.RespondError(ctx, http.StatusConflict, err.Error())
+		return
+	}
+	ctx.JSON(http.StatusOK, resp)
+}
+
+// GetAttendancePolicy godoc
+// @Summary ADMIN , CEO , FINANCIST , TEACHER
+// @Description When teachers may mark attendance: editDaysBack days back until cutoffHour in timezone, future dates with allowFuture. overrideRoles mark any date
+// @Tags attendance
+// @Produce json
+// @Security Bearer
+// @Success 200 {object} pb.AttendancePolicy
+// @Failure 409 {object} utils.AbsResponse
+// @Router /api/attendance/policy [get]
+func GetAttendancePolicy(ctx *gin.Context) {
+	ctxR, cancel := etc.NewTimoutContext(ctx)
+	defer cancel()
+	resp, err := educationClient.GetAttendancePolicy(ctxR)
+	if err != nil {
+		utils.RespondError(ctx, http.StatusConflict, err.Error())
+		return
+	}
+	ctx.JSON(http.StatusOK, resp)
+}
+
+// UpdateAttendancePolicy godoc
+// @Summary CEO
+// @Description Sets the attendance policy of the company. cutoffHour is 0-24, timezone an IANA name like Asia/Tashkent, overrideRoles any of CEO, ADMIN, FINANCIST, TEACHER
+// @Tags attendance
+// @Accept json
+// @Produce json
+// @Security Bearer
+// @Param request body pb.AttendancePolicy true "Attendance policy"
+// @Success 200 {object} utils.AbsResponse
+// @Failure 400 {object} utils.AbsResponse
+// @Failure 409 {object} utils.AbsResponse
+// @Router /api/attendance/policy [put]
+func UpdateAttendancePolicy(ctx *gin.Context) {
+	ctxR, cancel := etc.NewTimoutContext(ctx)
+	defer cancel()
+	req := pb.AttendancePolicy{}
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		utils.RespondError(ctx, http.StatusBadRequest, err.Error())
+		return
+	}
+	user, err := utils.GetUserFromContext(ctx)
+	if err != nil {
+		utils.RespondError(ctx, http.StatusUnauthorized, err.Error())
+		return
+	}
+	req.ActionById = user.Id
+	resp, err := educationClient.UpdateAttendancePolicy(ctxR, &req)
+	if err != nil {
+		utils.RespondError(ctx, http.StatusConflict, err.Error())
+		return
+	}
+	utils.RespondSuccess(ctx, resp.Status, resp.Message)
+}
+
+// RequestAttendanceUnlock godoc
+// @Summary TEACHER
+// @Description Asks an admin to open a date the attendance policy closed for the teacher. Returns the request id in message
+// @Tags attendance
+// @Accept json
+// @Produce json
+// @Security Bearer
+// @Param request body pb.RequestAttendanceUnlockRequest true "Unlock request"
+// @Success 200 {object} utils.AbsResponse
+// @Failure 400 {object} utils.AbsResponse
+// @Failure 409 {object} utils.AbsResponse
+// @Router /api/attendance/unlock/request [post]
+func RequestAttendanceUnlock(ctx *gin.Context) {
+	ctxR, cancel := etc.NewTimoutContext(ctx)
+	defer cancel()
+	req := pb.RequestAttendanceUnlockRequest{}
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		utils.RespondError(ctx, http.StatusBadRequest, err.Error())
+		return
+	}
+	user, err := utils.GetUserFromContext(ctx)
+	if err != nil {
+		utils.RespondError(ctx, http.StatusUnauthorized, err.Error())
+		return
+	}
+	req.ActionById = user.Id
+	req.ActionByName = user.Name
+	resp, err := educationClient.RequestAttendanceUnlock(ctxR, &req)
+	if err != nil {
+		utils.RespondError(ctx, http.StatusConflict, err.Error())
+		return
+	}
+	utils.RespondSuccess(ctx, resp.Status, resp.Message)
+}
+
+// GetAttendanceUnlockRequests godoc
+// @Summary ADMIN , CEO , TEACHER
+// @Description Unlock requests of the company, newest first. A teacher only gets the requests of its own
+// @Tags attendance
+// @Produce json
+// @Security Bearer
+// @Param status query string false "PENDING, APPROVED or REJECTED"
+// @Success 200 {object} pb.GetAttendanceUnlockRequestsResponse
+// @Failure 409 {object} utils.AbsResponse
+// @Router /api/attendance/unlock/get-all [get]
+func GetAttendanceUnlockRequests(ctx *gin.Context) {
+	ctxR, cancel := etc.NewTimoutContext(ctx)
+	defer cancel()
+	user, err := utils.GetUserFromContext(ctx)
+	if err != nil {
+		utils.RespondError(ctx, http.StatusUnauthorized, err.Error())
+		return
+	}
+	resp, err := educationClient.GetAttendanceUnlockRequests(ctxR, &pb.GetAttendanceUnlockRequestsRequest{
+		Status:       ctx.Query("status"),
+		ActionById:   user.Id,
+		ActionByRole: user.Role,
+	})
+	if err != nil {
+		utils.RespondError(ctx, http.StatusConflict, err.Error())
+		return
+	}
+	ctx.JSON(http.StatusOK, resp)
+}
+
+// ReviewAttendanceUnlock godoc
+// @Summary ADMIN , CEO
+// @Description Approves or rejects a pending unlock request, an approved date stays open for the teacher for hours (24 by default)
+// @Tags attendance
+// @Accept json
+// @Produce json
+// @Security Bearer
+// @Param request body pb.ReviewAttendanceUnlockRequest true "Review"
+// @Success 200 {object} utils.AbsResponse
+// @Failure 400 {object} utils.AbsResponse
+// @Failure 409 {object} utils.AbsResponse
+// @Router /api/attendance/unlock/review [put]
+func ReviewAttendanceUnlock(ctx *gin.Context) {
+	ctxR, cancel := etc.NewTimoutContext(ctx)
+	defer cancel()
+	req := pb.ReviewAttendanceUnlockRequest{}
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		utils.RespondError(ctx, http.StatusBadRequest, err.Error())
+		return
+	}
+	user, err := utils.GetUserFromContext(ctx)
+	if err != nil {
+		utils.RespondError(ctx, http.StatusUnauthorized, err.Error())
+		return
+	}
+	req.ActionById = user.Id
+	req.ActionByName = user.Name
+	resp, err := educationClient.ReviewAttendanceUnlock(ctxR, &req)
+	if err != nil {
+		utils.RespondError(ctx, http.StatusConflict, err.Error())
+		return
+	}
+	utils.RespondSuccess(ctx, resp.Status, resp.Message)
+}
+
+// GetAttendanceRates godoc
+// @Summary ADMIN , CEO , FINANCIST , TEACHER
+// @Description Attendance rate of the marked lessons between from and till per student, group, teacher or course. A teacher only gets its own lessons
+// @Tags attendance
+// @Produce json
+// @Security Bearer
+// @Param from query string true "yyyy-MM-dd"
+// @Param till query string true "yyyy-MM-dd"
+// @Param by query string true "STUDENT, GROUP, TEACHER or COURSE"
+// @Param groupId query string false "Group ID"
+// @Param teacherId query string false "Teacher ID"
+// @Param courseId query string false "Course ID"
+// @Success 200 {object} pb.GetAttendanceRatesResponse
+// @Failure 409 {object} utils.AbsResponse
+// @Router /api/attendance/analytics/rates [get]
+func GetAttendanceRates(ctx *gin.Context) {
+	ctxR, cancel := etc.NewTimoutContext(ctx)
+	defer cancel()
+	user, err := utils.GetUserFromContext(ctx)
+	if err != nil {
+		utils.RespondError(ctx, http.StatusUnauthorized, err.Error())
+		return
+	}
+	resp, err := educationClient.GetAttendanceRates(ctxR, &pb.GetAttendanceRatesRequest{
+		From:         ctx.Query("from"),
+		Till:         ctx.Query("till"),
+		By:           ctx.Query("by"),
+		GroupId:      ctx.Query("groupId"),
+		TeacherId:    ctx.Query("teacherId"),
+		CourseId:     ctx.Query("courseId"),
+		ActionById:   user.Id,
+		ActionByRole: user.Role,
+	})
+	if err != nil {
+		utils.RespondError(ctx, http.StatusConflict, err.Error())
+		return
+	}
+	ctx.JSON(http.StatusOK, resp)
+}
+
+// GetAbsenceStreaks godoc
+// @Summary ADMIN , CEO , FINANCIST , TEACHER
+// @Description Active students who missed their last lessons in a row, longest streak first
+// @Tags attendance
+// @Produce json
+// @Security Bearer
+// @Param groupId query string false "Group ID"
+// @Param teacherId query string false "Teacher ID"
+// @Param minStreak query int false "Shortest streak returned, 2 by default"
+// @Success 200 {object} pb.GetAbsenceStreaksResponse
+// @Failure 409 {object} utils.AbsResponse
+// @Router /api/attendance/analytics/streaks [get]
+func GetAbsenceStreaks(ctx *gin.Context) {
+	ctxR, cancel := etc.NewTimoutContext(ctx)
+	defer cancel()
+	user, err := utils.GetUserFromContext(ctx)
+	if err != nil {
+		utils.RespondError(ctx, http.StatusUnauthorized, err.Error())
+		return
+	}
+	resp, err := educationClient.GetAbsenceStreaks(ctxR, &pb.GetAbsenceStreaksRequest{
+		GroupId:      ctx.Query("groupId"),
+		TeacherId:    ctx.Query("teacherId"),
+		MinStreak:    cast.ToInt32(ctx.Query("minStreak")),
+		ActionById:   user.Id,
+		ActionByRole: user.Role,
+	})
+	if err != nil {
+		utils.RespondError(ctx, http.StatusConflict, err.Error())
+		return
+	}
+	ctx.JSON(http.StatusOK, resp)
+}
+
+// GetAttendanceTrend godoc
+// @Summary ADMIN , CEO , FINANCIST , TEACHER
+// @Description Attendance rate per day, week or month between from and till for charts
+// @Tags attendance
+// @Produce json
+// @Security Bearer
+// @Param from query string true "yyyy-MM-dd"
+// @Param till query string true "yyyy-MM-dd"
+// @Param interval query string true "DAY, WEEK or MONTH"
+// @Param groupId query string false "Group ID"
+// @Param teacherId query string false "Teacher ID"
+// @Param courseId query string false "Course ID"
+// @Success 200 {object} pb.GetAttendanceTrendResponse
+// @Failure 409 {object} utils.AbsResponse
+// @Router /api/attendance/analytics/trend [get]
+func GetAttendanceTrend(ctx *gin.Context) {
+	ctxR, cancel := etc.NewTimoutContext(ctx)
+	defer cancel()
+	user, err := utils.GetUserFromContext(ctx)
+	if err != nil {
+		utils.RespondError(ctx, http.StatusUnauthorized, err.Error())
+		return
+	}
+	resp, err := educationClient.GetAttendanceTrend(ctxR, &pb.GetAttendanceTrendRequest{
+		From:         ctx.Query("from"),
+		Till:         ctx.Query("till"),
+		Interval:     ctx.Query("interval"),
+		GroupId:      ctx.Query("groupId"),
+		TeacherId:    ctx.Query("teacherId"),
+		CourseId:     ctx.Query("courseId"),
+		ActionById:   user.Id,
+		ActionByRole: user.Role,
+	})
+	if err != nil {
+		utils.RespondError(ctx, http.StatusConflict, err.Error())
+		return
+	}
+	ctx.JSON(http.StatusOK, resp)
+}
+
+// GetAtRiskPolicy godoc
+// @Summary ADMIN , CEO
+// @Description When the daily job flags a student as at risk, a rule set to 0 is off
+// @Tags attendance
+// @Produce json
+// @Security Bearer
+// @Success 200 {object} pb.AtRiskPolicy
+// @Failure 409 {object} utils.AbsResponse
+// @Router /api/attendance/at-risk/policy [get]
+func GetAtRiskPolicy(ctx *gin.Context) {
+	ctxR, cancel := etc.NewTimoutContext(ctx)
+	defer cancel()
+	resp, err := educationClient.GetAtRiskPolicy(ctxR)
+	if err != nil {
+		utils.RespondError(ctx, http.StatusConflict, err.Error())
+		return
+	}
+	ctx.JSON(http.StatusOK, resp)
+}
+
+// UpdateAtRiskPolicy godoc
+// @Summary CEO
+// @Description Sets the at risk rules of the company. smsParents texts the AT_RISK_ALERT template, (STREAK) and (RATE) are filled in
+// @Tags attendance
+// @Accept json
+// @Produce json
+// @Security Bearer
+// @Param request body pb.AtRiskPolicy true "At risk policy"
+// @Success 200 {object} utils.AbsResponse
+// @Failure 400 {object} utils.AbsResponse
+// @Failure 409 {object} utils.AbsResponse
+// @Router /api/attendance/at-risk/policy [put]
+func UpdateAtRiskPolicy(ctx *gin.Context) {
+	ctxR, cancel := etc.NewTimoutContext(ctx)
+	defer cancel()
+	req := pb.AtRiskPolicy{}
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		utils.RespondError(ctx, http.StatusBadRequest, err.Error())
+		return
+	}
+	user, err := utils.GetUserFromContext(ctx)
+	if err != nil {
+		utils.RespondError(ctx, http.StatusUnauthorized, err.Error())
+		return
+	}
+	req.ActionById = user.Id
+	resp, err := educationClient.UpdateAtRiskPolicy(ctxR, &req)
+	if err != nil {
+		utils.RespondError(ctx, http.StatusConflict, err.Error())
+		return
+	}
+	utils.RespondSuccess(ctx, resp.Status, resp.Message)
+}
+
+// GetAtRiskStudents godoc
+// @Summary ADMIN , CEO , TEACHER
+// @Description Students flagged by the daily at risk job, newest first. A teacher only gets the students of its groups
+// @Tags attendance
+// @Produce json
+// @Security Bearer
+// @Param status query string false "OPEN (default) or RESOLVED"
+// @Param groupId query string false "Group ID"
+// @Success 200 {object} pb.GetAtRiskStudentsResponse
+// @Failure 409 {object} utils.AbsResponse
+// @Router /api/attendance/at-risk/get-all [get]
+func GetAtRiskStudents(ctx *gin.Context) {
+	ctxR, cancel := etc.NewTimoutContext(ctx)
+	defer cancel()
+	user, err := utils.GetUserFromContext(ctx)
+	if err != nil {
+		utils.RespondError(ctx, http.StatusUnauthorized, err.Error())
+		return
+	}
+	resp, err := educationClient.GetAtRiskStudents(ctxR, &pb.GetAtRiskStudentsRequest{
+		Status:       ctx.Query("status"),
+		GroupId:      ctx.Query("groupId"),
+		ActionById:   user.Id,
+		ActionByRole: user.Role,
+	})
+	if err != nil {
+		utils.RespondError(ctx, http.StatusConflict, err.Error())
+		return
+	}
+	ctx.JSON(http.StatusOK, resp)
+}
+
+// GetNotifications godoc
+// @Summary ADMIN , CEO , FINANCIST , TEACHER
+// @Description In app notifications of the user, newest first, with the count of the unread ones
+// @Tags notification
+// @Produce json
+// @Security Bearer
+// @Param unreadOnly query bool false "Only unread notifications"
+// @Param limit query int false "50 by default"
+// @Success 200 {object} pb.GetNotificationsResponse
+// @Failure 409 {object} utils.AbsResponse
+// @Router /api/notification/get-all [get]
+func GetNotifications(ctx *gin.Context) {
+	ctxR, cancel := etc.NewTimoutContext(ctx)
+	defer cancel()
+	user, err := utils.GetUserFromContext(ctx)
+	if err != nil {
+		utils.RespondError(ctx, http.StatusUnauthorized, err.Error())
+		return
+	}
+	resp, err := educationClient.GetNotifications(ctxR, &pb.GetNotificationsRequest{
+		UnreadOnly:   cast.ToBool(ctx.Query("unreadOnly")),
+		Limit:        cast.ToInt32(ctx.Query("limit")),
+		ActionById:   user.Id,
+		ActionByRole: user.Role,
+	})
+	if err != nil {
+		utils.RespondError(ctx, http.StatusConflict, err.Error())
+		return
+	}
+	ctx.JSON(http.StatusOK, resp)
+}
+
+// MarkNotificationsRead godoc
+// @Summary ADMIN , CEO , FINANCIST , TEACHER
+// @Description Marks the notifications read for the user, every notification when ids is empty
+// @Tags notification
+// @Accept json
+// @Produce json
+// @Security Bearer
+// @Param request body pb.MarkNotificationsReadRequest true "Notification ids"
+// @Success 200 {object} utils.AbsResponse
+// @Failure 400 {object} utils.AbsResponse
+// @Failure 409 {object} utils.AbsResponse
+// @Router /api/notification/read [put]
+func MarkNotificationsRead(ctx *gin.Context) {
+	ctxR, cancel := etc.NewTimoutContext(ctx)
+	defer cancel()
+	req := pb.MarkNotificationsReadRequest{}
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		utils.RespondError(ctx, http.StatusBadRequest, err.Error())
+		return
+	}
+	user, err := utils.GetUserFromContext(ctx)
+	if err != nil {
+		utils.RespondError(ctx, http.StatusUnauthorized, err.Error())
+		return
+	}
+	req.ActionById = user.Id
+	req.ActionByRole = user.Role
+	resp, err := educationClient.MarkNotificationsRead(ctxR, &req)
+	if err != nil {
+		utils.RespondError(ctx, http.StatusConflict, err.Error())
+		return
+	}
+	utils.RespondSuccess(ctx, resp.Status, resp.Message)
+}
+
+// GetGroupWaitlist godoc
+// @Summary ADMIN , CEO , FINANCIST
+// @Description Seats of the group and its waitlist, OFFERED students first and then the WAITING ones in order
+// @Tags waitlist
+// @Produce json
+// @Security Bearer
+// @Param groupId path string true "Group ID"
+// @Param withHistory query bool false "List ENROLLED, EXPIRED and CANCELLED entries as well"
+// @Success 200 {object} pb.GetGroupWaitlistResponse
+// @Failure 409 {object} utils.AbsResponse
+// @Router /api/waitlist/group/{groupId} [get]
+func GetGroupWaitlist(ctx *gin.Context) {
+	ctxR, cancel := etc.NewTimoutContext(ctx)
+	defer cancel()
+	resp, err := educationClient.GetGroupWaitlist(ctxR, &pb.GetGroupWaitlistRequest{
+		GroupId:     ctx.Param("groupId"),
+		WithHistory: cast.ToBool(ctx.Query("withHistory")),
+	})
+	if err != nil {
+		utils.RespondError(ctx, http.StatusConflict, err.Error())
+		return
+	}
+	ctx.JSON(http.StatusOK, resp)
+}
+
+// MoveWaitlistEntry godoc
+// @Summary ADMIN , CEO , FINANCIST
+// @Description Moves a WAITING student to another place of the group waitlist
+// @Tags waitlist
+// @Accept json
+// @Produce json
+// @Security Bearer
+// @Param request body pb.MoveWaitlistEntryRequest true "New place, starting from 1"
+// @Success 200 {object} utils.AbsResponse
+// @Failure 400 {object} utils.AbsResponse
+// @Failure 409 {object} utils.AbsResponse
+// @Router /api/waitlist/move [put]
+func MoveWaitlistEntry(ctx *gin.Context) {
+	ctxR, cancel := etc.NewTimoutContext(ctx)
+	defer cancel()
+	req := pb.MoveWaitlistEntryRequest{}
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		utils.RespondError(ctx, http.StatusBadRequest, err.Error())
+		return
+	}
+	resp, err := educationClient.MoveWaitlistEntry(ctxR, &req)
+	if err != nil {
+		utils.RespondError(ctx, http.StatusConflict, err.Error())
+		return
+	}
+	utils.RespondSuccess(ctx, resp.Status, resp.Message)
+}
+
+// CancelWaitlistEntry godoc
+// @Summary ADMIN , CEO , FINANCIST
+// @Description Takes the student off the waitlist, a seat it was offered goes to the next student
+// @Tags waitlist
+// @Produce json
+// @Security Bearer
+// @Param id path string true "Waitlist entry ID"
+// @Success 200 {object} utils.AbsResponse
+// @Failure 409 {object} utils.AbsResponse
+// @Router /api/waitlist/cancel/{id} [delete]
+func CancelWaitlistEntry(ctx *gin.Context) {
+	ctxR, cancel := etc.NewTimoutContext(ctx)
+	defer cancel()
+	resp, err := educationClient.CancelWaitlistEntry(ctxR, ctx.Param("id"))
+	if err != nil {
+		utils.RespondError(ctx, http.StatusConflict, err.Error())
+		return
+	}
+	utils.RespondSuccess(ctx, resp.Status, resp.Message)
+}
+
+// AcceptWaitlistOffer godoc
+// @Summary ADMIN , CEO , FINANCIST
+// @Description Enrolls an OFFERED student into the seat held for it, the offer must not be expired. createdDate is today when empty
+// @Tags waitlist
+// @Accept json
 // @Produce json
 // @Security Bearer
-// @Param id path string true "Course ID"
-// @Success 200 {object} pb.AbsCourse "Successful response with course details"
-// @Failure 500 {object} utils.AbsResponse "Internal server error"
-// @Router /api/course/get-by-id/{id} [get]
-func GetCourseById(ctx *gin.Context) {
+// @Param request body pb.AcceptWaitlistOfferRequest true "Offer to accept"
+// @Success 200 {object} pb.AddToGroupResponse
+// @Failure 400 {object} utils.AbsResponse
+// @Failure 409 {object} utils.AbsResponse
+// @Router /api/waitlist/accept [post]
+func AcceptWaitlistOffer(ctx *gin.Context) {
 	ctxR, cancel := etc.NewTimoutContext(ctx)
 	defer cancel()
-	id := ctx.Param("id")
-	resp, err := educationClient.GetCourseById(ctxR, id)
+	req := pb.AcceptWaitlistOfferRequest{}
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		utils.RespondError(ctx, http.StatusBadRequest, err.Error())
+		return
+	}
+	user, err := utils.GetUserFromContext(ctx)
 	if err != nil {
-		utils.RespondError(ctx, http.StatusInternalServerError, err.Error())
+		utils.RespondError(ctx, http.StatusUnauthorized, err.Error())
+		return
+	}
+	req.CreatedBy = user.Id
+	req.CreatedByName = user.Name
+	resp, err := educationClient.AcceptWaitlistOffer(ctxR, &req)
+	if err != nil {
+		utils.RespondError(ctx, http.StatusConflict, err.Error())
 		return
 	}
 	ctx.JSON(http.StatusOK, resp)
-	return
 }
 
-// CreateGroup godoc
-// @Summary ADMIN , CEO
-// @Description Create a new group with provided details.
-// @Tags groups
+// SendStudentLoginCode godoc
+// @Summary STUDENT
+// @Description Sends a one time login code by sms to the phone of an active student of the company
+// @Tags check-in
 // @Accept json
 // @Produce json
-// @Security Bearer
-// @Param group body pb.CreateGroupRequest true "Group Data"
-// @Success 200 {object} utils.AbsResponse "Group successfully created"
-// @Failure 400 {object} utils.AbsResponse "Bad request"
-// @Failure 500 {object} utils.AbsResponse "Internal server error"
-// @Router /api/group/create [post]
-func CreateGroup(ctx *gin.Context) {
+// @Param request body pb.SendStudentLoginCodeRequest true "Company subdomain and phone"
+// @Success 200 {object} utils.AbsResponse
+// @Failure 400 {object} utils.AbsResponse
+// @Failure 409 {object} utils.AbsResponse
+// @Router /api/student-auth/send-code [post]
+func SendStudentLoginCode(ctx *gin.Context) {
 	ctxR, cancel := etc.NewTimoutContext(ctx)
 	defer cancel()
-	var req pb.CreateGroupRequest
-	err := ctx.ShouldBindJSON(&req)
-	if err != nil {
+	req := pb.SendStudentLoginCodeRequest{}
+	if err := ctx.ShouldBindJSON(&req); err != nil {
 		utils.RespondError(ctx, http.StatusBadRequest, err.Error())
 		return
 	}
-	resp, err := educationClient.CreateGroup(ctxR, &req)
+	resp, err := educationClient.SendStudentLoginCode(ctxR, &req)
 	if err != nil {
-		utils.RespondError(ctx, http.StatusInternalServerError, err.Error())
+		utils.RespondError(ctx, http.StatusConflict, err.Error())
 		return
 	}
 	utils.RespondSuccess(ctx, resp.Status, resp.Message)
-	return
 }
 
-// UpdateGroup godoc
-// @Summary ADMIN , CEO
-// @Description Update details of an existing group.
-// @Tags groups
+// VerifyStudentLoginCode godoc
+// @Summary STUDENT
+// @Description Checks the sms code and returns a session token for every student of the phone
+// @Tags check-in
 // @Accept json
 // @Produce json
-// @Security Bearer
-// @Param group body pb.GetUpdateGroupAbs true "Group Data"
-// @Success 200 {object} utils.AbsResponse "Group successfully updated"
-// @Failure 400 {object} utils.AbsResponse "Bad request"
-// @Failure 500 {object} utils.AbsResponse "Internal server error"
-// @Router /api/group/update [put]
-func UpdateGroup(ctx *gin.Context) {
+// @Param request body pb.VerifyStudentLoginCodeRequest true "Company subdomain, phone and code"
+// @Success 200 {object} pb.VerifyStudentLoginCodeResponse
+// @Failure 400 {object} utils.AbsResponse
+// @Failure 401 {object} utils.AbsResponse
+// @Router /api/student-auth/verify [post]
+func VerifyStudentLoginCode(ctx *gin.Context) {
 	ctxR, cancel := etc.NewTimoutContext(ctx)
 	defer cancel()
-	var req *pb.GetUpdateGroupAbs
-	err := ctx.ShouldBindJSON(&req)
-	if err != nil {
+	req := pb.VerifyStudentLoginCodeRequest{}
+	if err := ctx.ShouldBindJSON(&req); err != nil {
 		utils.RespondError(ctx, http.StatusBadRequest, err.Error())
 		return
 	}
-	resp, err := educationClient.UpdateGroup(ctxR, req)
+	if req.Device == "" {
+		req.Device = ctx.GetHeader("User-Agent")
+	}
+	resp, err := educationClient.VerifyStudentLoginCode(ctxR, &req)
 	if err != nil {
-		utils.RespondError(ctx, http.StatusInternalServerError, err.Error())
+		utils.RespondError(ctx, http.StatusUnauthorized, err.Error())
 		return
 	}
-	utils.RespondSuccess(ctx, resp.Status, resp.Message)
-	return
+	ctx.JSON(http.StatusOK, resp)
 }
 
-// DeleteGroup godoc
-// @Summary ADMIN , CEO
-// @Description Delete a group by its ID.
-// @Tags groups
+// GetStudentSession godoc
+// @Summary STUDENT
+// @Description The student of the session token
+// @Tags check-in
 // @Produce json
 // @Security Bearer
-// @Param id path string true "Group ID"
-// @Success 200 {object} utils.AbsResponse "Group successfully deleted"
-// @Failure 500 {object} utils.AbsResponse "Internal server error"
-// @Router /api/group/delete/{id} [delete]
-func DeleteGroup(ctx *gin.Context) {
+// @Success 200 {object} pb.StudentSession
+// @Failure 401 {object} utils.AbsResponse
+// @Router /api/student-auth/me [get]
+func GetStudentSession(ctx *gin.Context) {
+	student, err := utils.GetStudentFromContext(ctx)
+	if err != nil {
+		utils.RespondError(ctx, http.StatusUnauthorized, err.Error())
+		return
+	}
+	ctx.JSON(http.StatusOK, student)
+}
+
+// GetCheckInCode godoc
+// @Summary ADMIN , CEO , TEACHER
+// @Description Signed check in code of today's lesson for the room tablet to show as a QR code, ask for the next one after refreshIn seconds
+// @Tags check-in
+// @Produce json
+// @Security Bearer
+// @Param lessonId path string true "Lesson ID"
+// @Success 200 {object} pb.CheckInCode
+// @Failure 409 {object} utils.AbsResponse
+// @Router /api/check-in/code/{lessonId} [get]
+func GetCheckInCode(ctx *gin.Context) {
 	ctxR, cancel := etc.NewTimoutContext(ctx)
 	defer cancel()
-	id := ctx.Param("id")
-	resp, err := educationClient.DeleteGroup(ctxR, id)
+	user, err := utils.GetUserFromContext(ctx)
 	if err != nil {
-		utils.RespondError(ctx, http.StatusInternalServerError, err.Error())
+		utils.RespondError(ctx, http.StatusUnauthorized, err.Error())
 		return
 	}
-	utils.RespondSuccess(ctx, resp.Status, resp.Message)
-	return
+	resp, err := educationClient.GetCheckInCode(ctxR, &pb.GetCheckInCodeRequest{
+		LessonId:     ctx.Param("lessonId"),
+		ActionById:   user.Id,
+		ActionByRole: user.Role,
+	})
+	if err != nil {
+		utils.RespondError(ctx, http.StatusConflict, err.Error())
+		return
+	}
+	ctx.JSON(http.StatusOK, resp)
 }
 
-// GetAllGroup godoc
-// @Summary ADMIN , CEO
-// @Description Retrieve a list of all groups.
-// @Tags groups
+// CheckIn godoc
+// @Summary STUDENT
+// @Description Marks the student present on the lesson of a scanned QR code, the teacher confirms it before it is billed
+// @Tags check-in
+// @Accept json
 // @Produce json
 // @Security Bearer
-// @Param group body pb.GetGroupsRequest true "Group Data"
-// @Success 200 {array} pb.GetGroupsResponse "List of groups"
-// @Failure 400 {object} utils.AbsResponse "Bad Request"
-// @Failure 500 {object} utils.AbsResponse "Internal server error"
-// @Router /api/group/get-all [post]
-func GetAllGroup(ctx *gin.Context) {
-	var (
-		req *pb.GetGroupsRequest
-	)
+// @Param request body pb.CheckInRequest true "Scanned token"
+// @Success 200 {object} pb.CheckInResponse
+// @Failure 400 {object} utils.AbsResponse
+// @Failure 409 {object} utils.AbsResponse
+// @Router /api/check-in/scan [post]
+func CheckIn(ctx *gin.Context) {
 	ctxR, cancel := etc.NewTimoutContext(ctx)
 	defer cancel()
+	req := pb.CheckInRequest{}
 	if err := ctx.ShouldBindJSON(&req); err != nil {
 		utils.RespondError(ctx, http.StatusBadRequest, err.Error())
 		return
 	}
-	resp, err := educationClient.GetAllGroup(ctxR, req)
+	student, err := utils.GetStudentFromContext(ctx)
 	if err != nil {
-		utils.RespondError(ctx, http.StatusInternalServerError, err.Error())
+		utils.RespondError(ctx, http.StatusUnauthorized, err.Error())
 		return
 	}
-	ctx.JSON(http.StatusOK, &resp)
+	req.StudentId = student.StudentId
+	if req.Device == "" {
+		req.Device = ctx.GetHeader("User-Agent")
+	}
+	resp, err := educationClient.CheckIn(ctxR, &req)
+	if err != nil {
+		utils.RespondError(ctx, http.StatusConflict, err.Error())
+		return
+	}
+	ctx.JSON(http.StatusOK, resp)
 }
 
-// GetGroupById godoc
-// @Summary ADMIN
-// @Description Retrieve details of a group by its ID.
-// @Tags groups
+// GetLessonCheckIns godoc
+// @Summary ADMIN , CEO , TEACHER
+// @Description Students who checked in on the lesson, in the order they did
+// @Tags check-in
 // @Produce json
 // @Security Bearer
-// @Param id path string true "Group ID"
-// @Success 200 {object} pb.GetGroupAbsResponse "Group details"
-// @Failure 500 {object} utils.AbsResponse "Internal server error"
-// @Router /api/group/get-by-id/{id} [get]
-func GetGroupById(ctx *gin.Context) {
+// @Param lessonId path string true "Lesson ID"
+// @Success 200 {object} pb.GetLessonCheckInsResponse
+// @Failure 409 {object} utils.AbsResponse
+// @Router /api/check-in/lesson/{lessonId} [get]
+func GetLessonCheckIns(ctx *gin.Context) {
 	ctxR, cancel := etc.NewTimoutContext(ctx)
 	defer cancel()
-	id := ctx.Param("id")
 	user, err := utils.GetUserFromContext(ctx)
 	if err != nil {
 		utils.RespondError(ctx, http.StatusUnauthorized, err.Error())
 		return
 	}
-	resp, err := educationClient.GetGroupById(ctxR, id, user.Id, user.Role)
+	resp, err := educationClient.GetLessonCheckIns(ctxR, &pb.GetLessonCheckInsRequest{
+		LessonId:     ctx.Param("lessonId"),
+		ActionById:   user.Id,
+		ActionByRole: user.Role,
+	})
 	if err != nil {
-		utils.RespondError(ctx, http.StatusInternalServerError, err.Error())
+		utils.RespondError(ctx, http.StatusConflict, err.Error())
 		return
 	}
-	ctx.JSON(http.StatusOK, &resp)
-	return
+	ctx.JSON(http.StatusOK, resp)
 }
 
-// SetAttendance godoc
-// @Summary TEACHER
-// @Description Record attendance for a student in a group on a specific date.
-// @Tags attendance
+// ConfirmCheckIns godoc
+// @Summary ADMIN , CEO , TEACHER
+// @Description Confirms the waiting check ins of the students so they are billed, or deletes them when reject is set. Returns a result per student
+// @Tags check-in
+// @Accept json
 // @Produce json
 // @Security Bearer
-// @Param attendance body pb.SetAttendanceRequest true "Attendance details"
-// @Success 200 {object} utils.AbsResponse "Attendance recorded successfully"
-// @Failure 400 {object} utils.AbsResponse "Invalid request"
-// @Failure 500 {object} utils.AbsResponse "Internal server error"
-// @Router /api/attendance/set [post]
-func SetAttendance(ctx *gin.Context) {
-	var req pb.SetAttendanceRequest
-	err := ctx.ShouldBindJSON(&req)
-	if err != nil {
+// @Param request body pb.ConfirmCheckInsRequest true "Lesson and students"
+// @Success 200 {object} pb.SetGroupAttendanceResponse
+// @Failure 400 {object} utils.AbsResponse
+// @Failure 409 {object} utils.AbsResponse
+// @Router /api/check-in/confirm [post]
+func ConfirmCheckIns(ctx *gin.Context) {
+	ctxR, cancel := etc.NewTimoutContext(ctx)
+	defer cancel()
+	req := pb.ConfirmCheckInsRequest{}
+	if err := ctx.ShouldBindJSON(&req); err != nil {
 		utils.RespondError(ctx, http.StatusBadRequest, err.Error())
 		return
 	}
@@ -409,15 +1865,12 @@ func SetAttendance(ctx *gin.Context) {
 	}
 	req.ActionById = user.Id
 	req.ActionByRole = user.Role
-	ctxR, cancelFunc := etc.NewTimoutContext(ctx)
-	defer cancelFunc()
-	resp, err := educationClient.SetAttendanceByGroup(ctxR, &req)
+	resp, err := educationClient.ConfirmCheckIns(ctxR, &req)
 	if err != nil {
-		utils.RespondError(ctx, http.StatusInternalServerError, err.Error())
+		utils.RespondError(ctx, http.StatusConflict, err.Error())
 		return
 	}
-	utils.RespondSuccess(ctx, resp.Status, resp.Message)
-	return
+	ctx.JSON(http.StatusOK, resp)
 }
 
 // GetAttendance godoc
@@ -540,11 +1993,12 @@ func CreateStudent(ctx *gin.Context) {
 
 // AddStudentToGroup godoc
 // @Summary ADMIN
+// @Description Enrolls students into the group while it has free seats (the group maximum, or the capacity of its room), the rest are put on the group waitlist. Sibling and multi-course discount rules are applied automatically, promoCode applies a promo code to every enrolled student. Every student gets a result: ADDED, WAITLISTED with its place, or FAILED with the reason, discounts that could not be applied are listed in the message of an ADDED student
 // @Tags students
 // @Produce json
 // @Security Bearer
 // @Param request body pb.AddToGroupRequest true "Add student to group details"
-// @Success 200 {object} utils.AbsResponse "Success message"
+// @Success 200 {object} pb.AddToGroupResponse "Result of every student"
 // @Failure 400 {object} utils.AbsResponse "Invalid request"
 // @Failure 500 {object} utils.AbsResponse "Internal server error"
 // @Router /api/student/add-to-group [post]
@@ -562,12 +2016,13 @@ func AddStudentToGroup(ctx *gin.Context) {
 		return
 	}
 	req.CreatedBy = user.Id
+	req.CreatedByName = user.Name
 	response, err := educationClient.AddStudentToGroup(ctxR, &req)
 	if err != nil {
 		utils.RespondError(ctx, http.StatusInternalServerError, err.Error())
 		return
 	}
-	utils.RespondSuccess(ctx, response.Status, response.Message)
+	ctx.JSON(http.StatusOK, response)
 	return
 }
 
@@ -811,6 +2266,7 @@ func GetHistoryStudent(ctx *gin.Context) {
 // @Param request body pb.TransferLessonRequest true "Transfer Lesson Request"
 // @Success 200 {object} utils.AbsResponse "Status and message"
 // @Failure 400 {object} utils.AbsResponse "Bad request"
+// @Failure 409 {object} utils.ScheduleConflictResponse "Room or teacher already booked on the new date, send force to move anyway"
 // @Failure 500 {object} utils.AbsResponse "Internal server error"
 // @Router /api/group/transfer-date [post]
 func TransferLessonDate(ctx *gin.Context) {
@@ -822,8 +2278,18 @@ func TransferLessonDate(ctx *gin.Context) {
 		utils.RespondError(ctx, http.StatusBadRequest, err.Error())
 		return
 	}
+	user, err := utils.GetUserFromContext(ctx)
+	if err != nil {
+		utils.RespondError(ctx, http.StatusUnauthorized, err.Error())
+		return
+	}
+	req.ActionById = user.Id
+	req.ActionByName = user.Name
 	resp, err := educationClient.TransferLessonDate(ctxR, &req)
 	if err != nil {
+		if utils.RespondScheduleConflict(ctx, err) {
+			return
+		}
 		utils.RespondError(ctx, http.StatusInternalServerError, err.Error())
 		return
 	}
@@ -868,6 +2334,63 @@ func ChangeConditionStudent(ctx *gin.Context) {
 	return
 }
 
+// SellLessonPackage godoc
+// @Summary ADMIN , CEO , FINANCIST
+// @Description Sells a prepaid lesson package of a PACKAGE billed group to a student, the price is taken off the student balance at once. lessons and price default to the course package. Returns the package id in message
+// @Tags students
+// @Accept json
+// @Produce json
+// @Security Bearer
+// @Param request body pb.SellLessonPackageRequest true "Lesson package"
+// @Success 200 {object} utils.AbsResponse
+// @Failure 400 {object} utils.AbsResponse
+// @Failure 409 {object} utils.AbsResponse
+// @Router /api/student/lesson-package/sell [post]
+func SellLessonPackage(ctx *gin.Context) {
+	ctxR, cancel := etc.NewTimoutContext(ctx)
+	defer cancel()
+	req := pb.SellLessonPackageRequest{}
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		utils.RespondError(ctx, http.StatusBadRequest, err.Error())
+		return
+	}
+	user, err := utils.GetUserFromContext(ctx)
+	if err != nil {
+		utils.RespondError(ctx, http.StatusUnauthorized, err.Error())
+		return
+	}
+	req.ActionById = user.Id
+	req.ActionByName = user.Name
+	resp, err := educationClient.SellLessonPackage(ctxR, &req)
+	if err != nil {
+		utils.RespondError(ctx, http.StatusConflict, err.Error())
+		return
+	}
+	utils.RespondSuccess(ctx, resp.Status, resp.Message)
+}
+
+// GetLessonPackages godoc
+// @Summary ADMIN , CEO , FINANCIST
+// @Description Lesson packages of a student with the lessons left, newest first
+// @Tags students
+// @Produce json
+// @Security Bearer
+// @Param studentId path string true "Student ID"
+// @Param groupId query string false "Group ID"
+// @Success 200 {object} pb.GetLessonPackagesResponse
+// @Failure 409 {object} utils.AbsResponse
+// @Router /api/student/lesson-package/{studentId} [get]
+func GetLessonPackages(ctx *gin.Context) {
+	ctxR, cancel := etc.NewTimoutContext(ctx)
+	defer cancel()
+	resp, err := educationClient.GetLessonPackages(ctxR, ctx.Param("studentId"), ctx.Query("groupId"))
+	if err != nil {
+		utils.RespondError(ctx, http.StatusConflict, err.Error())
+		return
+	}
+	ctx.JSON(http.StatusOK, resp)
+}
+
 // GetInformationByTeacher godoc
 // @Summary ADMIN , TEACHER , CEO
 // @Description Get information about a specific teacher by their ID, with an option to filter archived data.
@@ -1018,6 +2541,62 @@ func LeftAfterTrial(ctx *gin.Context) {
 	ctx.JSON(http.StatusOK, resp)
 }
 
+// UpdateGroupBillingMode godoc
+// @Summary ADMIN , CEO , FINANCIST
+// @Description Overrides the billing mode of the course for one group, an empty billingMode uses the course mode again
+// @Tags groups
+// @Accept json
+// @Produce json
+// @Security Bearer
+// @Param request body pb.UpdateGroupBillingModeRequest true "Group billing mode"
+// @Success 200 {object} utils.AbsResponse
+// @Failure 400 {object} utils.AbsResponse
+// @Failure 409 {object} utils.AbsResponse
+// @Router /api/group/billing-mode [put]
+func UpdateGroupBillingMode(ctx *gin.Context) {
+	ctxR, cancel := etc.NewTimoutContext(ctx)
+	defer cancel()
+	req := pb.UpdateGroupBillingModeRequest{}
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		utils.RespondError(ctx, http.StatusBadRequest, err.Error())
+		return
+	}
+	resp, err := educationClient.UpdateGroupBillingMode(ctxR, &req)
+	if err != nil {
+		utils.RespondError(ctx, http.StatusConflict, err.Error())
+		return
+	}
+	utils.RespondSuccess(ctx, resp.Status, resp.Message)
+}
+
+// UpdateGroupMaxStudents godoc
+// @Summary ADMIN , CEO , FINANCIST
+// @Description Limits the seats of the group below or above the capacity of its room, 0 uses the room capacity again. Seats freed by the change are offered to the group waitlist
+// @Tags groups
+// @Accept json
+// @Produce json
+// @Security Bearer
+// @Param request body pb.UpdateGroupMaxStudentsRequest true "Group seats"
+// @Success 200 {object} utils.AbsResponse
+// @Failure 400 {object} utils.AbsResponse
+// @Failure 409 {object} utils.AbsResponse
+// @Router /api/group/max-students [put]
+func UpdateGroupMaxStudents(ctx *gin.Context) {
+	ctxR, cancel := etc.NewTimoutContext(ctx)
+	defer cancel()
+	req := pb.UpdateGroupMaxStudentsRequest{}
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		utils.RespondError(ctx, http.StatusBadRequest, err.Error())
+		return
+	}
+	resp, err := educationClient.UpdateGroupMaxStudents(ctxR, &req)
+	if err != nil {
+		utils.RespondError(ctx, http.StatusConflict, err.Error())
+		return
+	}
+	utils.RespondSuccess(ctx, resp.Status, resp.Message)
+}
+
 // CompanyCreate
 // @Summary SUPER_CEO
 // @Description Create a new company
@@ -4,8 +4,15 @@ import (
 	"api-gateway/grpc/proto/pb"
 	"api-gateway/internal/etc"
 	"api-gateway/internal/utils"
+	"bytes"
+	"context"
+	"encoding/csv"
+	"fmt"
 	"github.com/gin-gonic/gin"
 	"github.com/shopspring/decimal"
+	"github.com/xuri/excelize/v2"
+	"html/template"
+	"io"
 	"net/http"
 	"strconv"
 )
@@ -167,7 +174,7 @@ func GetAllCategories(ctx *gin.Context) {
 
 // CreateExpense godoc
 // @Summary      ADMIN , CEO
-// @Description  Creates a new expense entry with details provided in the request body.
+// @Description  Creates a new expense entry with details provided in the request body. With asDraft the expense is saved as DRAFT, otherwise it is submitted: expenses reaching the company approval thresholds wait for CEO or FINANCIST approval (PENDING_APPROVAL), the rest are booked as PAID. Returns the expense id in message.
 // @Tags         expense
 // @Accept       json
 // @Produce      json
@@ -191,6 +198,8 @@ func CreateExpense(ctx *gin.Context) {
 		return
 	}
 	req.CreatedById = user.Id
+	req.CreatedByName = user.Name
+	req.CreatedByRole = user.Role
 	resp, err := financeClient.CreateExpense(ctxR, &req)
 	if err != nil {
 		utils.RespondError(ctx, http.StatusInternalServerError, err.Error())
@@ -232,8 +241,9 @@ func DeleteExpense(ctx *gin.Context) {
 // @Param        to       path      string true  "End date"
 // @Param        page     query     int    true  "Page number"
 // @Param        size     query     int    true  "Page size"
-// @Param        id       query     string false "ID to filter by user or creator"
-// @Param        type     query     string false "Filter type (USER or CATEGORY)"
+// @Param        id       query     string false "ID to filter by user, category or vendor"
+// @Param        type     query     string false "Filter type (USER, CATEGORY or VENDOR)"
+// @Param        status   query     string false "Filter by status (DRAFT, PENDING_APPROVAL, APPROVED, REJECTED, PAID)"
 // @Success      200      {object}  pb.GetAllExpenseResponse
 // @Failure      400      {object}  utils.AbsResponse  "Invalid request parameter"
 // @Failure      500      {object}  utils.AbsResponse
@@ -256,8 +266,8 @@ func GetAllInformation(ctx *gin.Context) {
 	}
 	id := ctx.Query("id")
 	idType := ctx.Query("type")
-	if idType == "USER" || idType == "CATEGORY" || idType == "" {
-		resp, err := financeClient.GetAllInformation(ctxR, id, idType, page, size, from, to)
+	if idType == "USER" || idType == "CATEGORY" || idType == "VENDOR" || idType == "" {
+		resp, err := financeClient.GetAllInformation(ctxR, id, idType, page, size, from, to, ctx.Query("status"))
 		if err != nil {
 			utils.RespondError(ctx, http.StatusInternalServerError, err.Error())
 			return
@@ -272,7 +282,7 @@ func GetAllInformation(ctx *gin.Context) {
 
 // GetChartDiagram godoc
 // @Summary ADMIN , CEO
-// @Description Queries the finance service for expense data between 'from' and 'to' dates, returning it as a chart diagram.
+// @Description Queries the finance service for expense data between 'from' and 'to' dates, returning it as a chart diagram. Budgets holds budget vs actual spend of every budgeted category per month.
 // @Tags expense
 // @Accept json
 // @Produce json
@@ -546,6 +556,7 @@ func AddSalaryTeacher(ctx *gin.Context) {
 // @Tags salary
 // @Produce json
 // @Param teacherID path string true "Teacher ID"
+// @Param id query string false "Salary rule ID, deletes all rules of the teacher when empty"
 // @Success 200 {object} utils.AbsResponse "Salary deleted successfully"
 // @Failure 409 {object} utils.AbsResponse "Conflict error"
 // @Security Bearer
@@ -554,7 +565,7 @@ func DeleteTeacherSalary(ctx *gin.Context) {
 	ctxR, cancel := etc.NewTimoutContext(ctx)
 	defer cancel()
 	teacherId := ctx.Param("teacherID")
-	resp, err := financeClient.DeleteTeacherSalary(ctxR, teacherId)
+	resp, err := financeClient.DeleteTeacherSalary(ctxR, teacherId, ctx.Query("id"))
 	if err != nil {
 		utils.RespondError(ctx, http.StatusConflict, err.Error())
 		return
@@ -741,3 +752,1911 @@ func CalculateSalary(ctx *gin.Context) {
 	ctx.JSON(http.StatusOK, resp)
 	return
 }
+
+// ClosePeriod godoc
+// @Summary CEO , FINANCIST
+// @Description Closes the accounting period (month). After closing, payments, expenses, discounts and attendance dated in this month can not be changed
+// @Tags period
+// @Accept json
+// @Produce json
+// @Param request body pb.AccountingPeriodRequest true "Period in YYYY-MM format"
+// @Success 200 {object} utils.AbsResponse
+// @Failure 400 {object} utils.AbsResponse
+// @Failure 409 {object} utils.AbsResponse
+// @Security Bearer
+// @Router /api/finance/period/close [post]
+func ClosePeriod(ctx *gin.Context) {
+	ctxR, cancel := etc.NewTimoutContext(ctx)
+	defer cancel()
+	user, err := utils.GetUserFromContext(ctx)
+	if err != nil {
+		utils.RespondError(ctx, http.StatusUnauthorized, err.Error())
+		return
+	}
+	req := pb.AccountingPeriodRequest{}
+	if err = ctx.ShouldBindJSON(&req); err != nil {
+		utils.RespondError(ctx, http.StatusBadRequest, err.Error())
+		return
+	}
+	req.ActionById = user.Id
+	req.ActionByName = user.Name
+	resp, err := financeClient.ClosePeriod(ctxR, &req)
+	if err != nil {
+		utils.RespondError(ctx, http.StatusConflict, err.Error())
+		return
+	}
+	utils.RespondSuccess(ctx, resp.Status, resp.Message)
+	return
+}
+
+// ReopenPeriod godoc
+// @Summary CEO
+// @Description Reopens a closed accounting period (month)
+// @Tags period
+// @Accept json
+// @Produce json
+// @Param request body pb.AccountingPeriodRequest true "Period in YYYY-MM format"
+// @Success 200 {object} utils.AbsResponse
+// @Failure 400 {object} utils.AbsResponse
+// @Failure 409 {object} utils.AbsResponse
+// @Security Bearer
+// @Router /api/finance/period/reopen [post]
+func ReopenPeriod(ctx *gin.Context) {
+	ctxR, cancel := etc.NewTimoutContext(ctx)
+	defer cancel()
+	user, err := utils.GetUserFromContext(ctx)
+	if err != nil {
+		utils.RespondError(ctx, http.StatusUnauthorized, err.Error())
+		return
+	}
+	req := pb.AccountingPeriodRequest{}
+	if err = ctx.ShouldBindJSON(&req); err != nil {
+		utils.RespondError(ctx, http.StatusBadRequest, err.Error())
+		return
+	}
+	req.ActionById = user.Id
+	req.ActionByName = user.Name
+	resp, err := financeClient.ReopenPeriod(ctxR, &req)
+	if err != nil {
+		utils.RespondError(ctx, http.StatusConflict, err.Error())
+		return
+	}
+	utils.RespondSuccess(ctx, resp.Status, resp.Message)
+	return
+}
+
+// GetAllPeriods godoc
+// @Summary CEO , FINANCIST
+// @Description Retrieves all closed and reopened accounting periods
+// @Tags period
+// @Produce json
+// @Success 200 {object} pb.GetAllPeriodsResponse
+// @Failure 409 {object} utils.AbsResponse
+// @Security Bearer
+// @Router /api/finance/period/get-all [get]
+func GetAllPeriods(ctx *gin.Context) {
+	ctxR, cancel := etc.NewTimoutContext(ctx)
+	defer cancel()
+	resp, err := financeClient.GetAllPeriods(ctxR)
+	if err != nil {
+		utils.RespondError(ctx, http.StatusConflict, err.Error())
+		return
+	}
+	ctx.JSON(http.StatusOK, resp)
+	return
+}
+
+// GetPeriodHistory godoc
+// @Summary CEO , FINANCIST
+// @Description Retrieves the close/reopen audit of accounting periods
+// @Tags period
+// @Produce json
+// @Param period query string false "Period in YYYY-MM format"
+// @Success 200 {object} pb.GetPeriodHistoryResponse
+// @Failure 409 {object} utils.AbsResponse
+// @Security Bearer
+// @Router /api/finance/period/history [get]
+func GetPeriodHistory(ctx *gin.Context) {
+	ctxR, cancel := etc.NewTimoutContext(ctx)
+	defer cancel()
+	resp, err := financeClient.GetPeriodHistory(ctxR, ctx.Query("period"))
+	if err != nil {
+		utils.RespondError(ctx, http.StatusConflict, err.Error())
+		return
+	}
+	ctx.JSON(http.StatusOK, resp)
+	return
+}
+
+// CreatePayrollRun godoc
+// @Summary CEO , FINANCIST
+// @Description Creates a DRAFT payroll run for the month. Salary of every teacher is calculated from attendance and stored with per group and per student breakdown
+// @Tags payroll
+// @Accept json
+// @Produce json
+// @Param request body pb.CreatePayrollRunRequest true "Period in YYYY-MM format"
+// @Success 200 {object} utils.AbsResponse "message contains created payroll run id"
+// @Failure 400 {object} utils.AbsResponse
+// @Failure 409 {object} utils.AbsResponse
+// @Security Bearer
+// @Router /api/finance/payroll/create [post]
+func CreatePayrollRun(ctx *gin.Context) {
+	ctxR, cancel := etc.NewTimoutContext(ctx)
+	defer cancel()
+	user, err := utils.GetUserFromContext(ctx)
+	if err != nil {
+		utils.RespondError(ctx, http.StatusUnauthorized, err.Error())
+		return
+	}
+	req := pb.CreatePayrollRunRequest{}
+	if err = ctx.ShouldBindJSON(&req); err != nil {
+		utils.RespondError(ctx, http.StatusBadRequest, err.Error())
+		return
+	}
+	req.ActionById = user.Id
+	req.ActionByName = user.Name
+	resp, err := financeClient.CreatePayrollRun(ctxR, &req)
+	if err != nil {
+		utils.RespondError(ctx, http.StatusConflict, err.Error())
+		return
+	}
+	utils.RespondSuccess(ctx, resp.Status, resp.Message)
+	return
+}
+
+// GetPayrollRuns godoc
+// @Summary CEO , FINANCIST
+// @Description Retrieves all payroll runs with totals
+// @Tags payroll
+// @Produce json
+// @Success 200 {object} pb.GetPayrollRunsResponse
+// @Failure 409 {object} utils.AbsResponse
+// @Security Bearer
+// @Router /api/finance/payroll/get-all [get]
+func GetPayrollRuns(ctx *gin.Context) {
+	ctxR, cancel := etc.NewTimoutContext(ctx)
+	defer cancel()
+	resp, err := financeClient.GetPayrollRuns(ctxR)
+	if err != nil {
+		utils.RespondError(ctx, http.StatusConflict, err.Error())
+		return
+	}
+	ctx.JSON(http.StatusOK, resp)
+	return
+}
+
+// GetPayrollRunById godoc
+// @Summary CEO , FINANCIST
+// @Description Retrieves a payroll run with salary, bonus, penalty and advance of every teacher
+// @Tags payroll
+// @Produce json
+// @Param runId path string true "Payroll run ID"
+// @Success 200 {object} pb.AbsPayrollRun
+// @Failure 409 {object} utils.AbsResponse
+// @Security Bearer
+// @Router /api/finance/payroll/get-by-id/{runId} [get]
+func GetPayrollRunById(ctx *gin.Context) {
+	ctxR, cancel := etc.NewTimoutContext(ctx)
+	defer cancel()
+	resp, err := financeClient.GetPayrollRunById(ctxR, ctx.Param("runId"))
+	if err != nil {
+		utils.RespondError(ctx, http.StatusConflict, err.Error())
+		return
+	}
+	ctx.JSON(http.StatusOK, resp)
+	return
+}
+
+// DeletePayrollRun godoc
+// @Summary CEO , FINANCIST
+// @Description Deletes a DRAFT payroll run
+// @Tags payroll
+// @Produce json
+// @Param runId path string true "Payroll run ID"
+// @Success 200 {object} utils.AbsResponse
+// @Failure 409 {object} utils.AbsResponse
+// @Security Bearer
+// @Router /api/finance/payroll/delete/{runId} [delete]
+func DeletePayrollRun(ctx *gin.Context) {
+	ctxR, cancel := etc.NewTimoutContext(ctx)
+	defer cancel()
+	resp, err := financeClient.DeletePayrollRun(ctxR, ctx.Param("runId"))
+	if err != nil {
+		utils.RespondError(ctx, http.StatusConflict, err.Error())
+		return
+	}
+	utils.RespondSuccess(ctx, resp.Status, resp.Message)
+	return
+}
+
+// AddPayrollAdjustment godoc
+// @Summary CEO , FINANCIST
+// @Description Adds BONUS, PENALTY or ADVANCE adjustment to a teacher in a DRAFT payroll run
+// @Tags payroll
+// @Accept json
+// @Produce json
+// @Param request body pb.AddPayrollAdjustmentRequest true "Adjustment"
+// @Success 200 {object} utils.AbsResponse
+// @Failure 400 {object} utils.AbsResponse
+// @Failure 409 {object} utils.AbsResponse
+// @Security Bearer
+// @Router /api/finance/payroll/adjustment/add [post]
+func AddPayrollAdjustment(ctx *gin.Context) {
+	ctxR, cancel := etc.NewTimoutContext(ctx)
+	defer cancel()
+	user, err := utils.GetUserFromContext(ctx)
+	if err != nil {
+		utils.RespondError(ctx, http.StatusUnauthorized, err.Error())
+		return
+	}
+	req := pb.AddPayrollAdjustmentRequest{}
+	if err = ctx.ShouldBindJSON(&req); err != nil {
+		utils.RespondError(ctx, http.StatusBadRequest, err.Error())
+		return
+	}
+	req.ActionById = user.Id
+	resp, err := financeClient.AddPayrollAdjustment(ctxR, &req)
+	if err != nil {
+		utils.RespondError(ctx, http.StatusConflict, err.Error())
+		return
+	}
+	utils.RespondSuccess(ctx, resp.Status, resp.Message)
+	return
+}
+
+// DeletePayrollAdjustment godoc
+// @Summary CEO , FINANCIST
+// @Description Deletes an adjustment from a DRAFT payroll run
+// @Tags payroll
+// @Produce json
+// @Param id path string true "Adjustment ID"
+// @Success 200 {object} utils.AbsResponse
+// @Failure 409 {object} utils.AbsResponse
+// @Security Bearer
+// @Router /api/finance/payroll/adjustment/delete/{id} [delete]
+func DeletePayrollAdjustment(ctx *gin.Context) {
+	ctxR, cancel := etc.NewTimoutContext(ctx)
+	defer cancel()
+	resp, err := financeClient.DeletePayrollAdjustment(ctxR, ctx.Param("id"))
+	if err != nil {
+		utils.RespondError(ctx, http.StatusConflict, err.Error())
+		return
+	}
+	utils.RespondSuccess(ctx, resp.Status, resp.Message)
+	return
+}
+
+// ApprovePayrollRun godoc
+// @Summary CEO
+// @Description Approves a DRAFT payroll run. Approved payslips become visible to teachers
+// @Tags payroll
+// @Produce json
+// @Param runId path string true "Payroll run ID"
+// @Success 200 {object} utils.AbsResponse
+// @Failure 409 {object} utils.AbsResponse
+// @Security Bearer
+// @Router /api/finance/payroll/approve/{runId} [post]
+func ApprovePayrollRun(ctx *gin.Context) {
+	ctxR, cancel := etc.NewTimoutContext(ctx)
+	defer cancel()
+	user, err := utils.GetUserFromContext(ctx)
+	if err != nil {
+		utils.RespondError(ctx, http.StatusUnauthorized, err.Error())
+		return
+	}
+	resp, err := financeClient.ApprovePayrollRun(ctxR, &pb.PayrollRunActionRequest{
+		RunId:        ctx.Param("runId"),
+		ActionById:   user.Id,
+		ActionByName: user.Name,
+	})
+	if err != nil {
+		utils.RespondError(ctx, http.StatusConflict, err.Error())
+		return
+	}
+	utils.RespondSuccess(ctx, resp.Status, resp.Message)
+	return
+}
+
+// PayPayrollRun godoc
+// @Summary CEO , FINANCIST
+// @Description Pays an APPROVED payroll run. Every teacher payout is posted as an expense
+// @Tags payroll
+// @Accept json
+// @Produce json
+// @Param request body pb.PayPayrollRunRequest true "Payment details"
+// @Success 200 {object} utils.AbsResponse
+// @Failure 400 {object} utils.AbsResponse
+// @Failure 409 {object} utils.AbsResponse
+// @Security Bearer
+// @Router /api/finance/payroll/pay [post]
+func PayPayrollRun(ctx *gin.Context) {
+	ctxR, cancel := etc.NewTimoutContext(ctx)
+	defer cancel()
+	user, err := utils.GetUserFromContext(ctx)
+	if err != nil {
+		utils.RespondError(ctx, http.StatusUnauthorized, err.Error())
+		return
+	}
+	req := pb.PayPayrollRunRequest{}
+	if err = ctx.ShouldBindJSON(&req); err != nil {
+		utils.RespondError(ctx, http.StatusBadRequest, err.Error())
+		return
+	}
+	req.ActionById = user.Id
+	req.ActionByName = user.Name
+	resp, err := financeClient.PayPayrollRun(ctxR, &req)
+	if err != nil {
+		utils.RespondError(ctx, http.StatusConflict, err.Error())
+		return
+	}
+	utils.RespondSuccess(ctx, resp.Status, resp.Message)
+	return
+}
+
+// GetPayslip godoc
+// @Summary CEO , FINANCIST
+// @Description Retrieves the payslip of a teacher in the payroll run
+// @Tags payroll
+// @Produce json
+// @Param runId path string true "Payroll run ID"
+// @Param teacherId path string true "Teacher ID"
+// @Success 200 {object} pb.Payslip
+// @Failure 409 {object} utils.AbsResponse
+// @Security Bearer
+// @Router /api/finance/payroll/payslip/{runId}/{teacherId} [get]
+func GetPayslip(ctx *gin.Context) {
+	ctxR, cancel := etc.NewTimoutContext(ctx)
+	defer cancel()
+	resp, err := financeClient.GetPayslip(ctxR, ctx.Param("runId"), ctx.Param("teacherId"))
+	if err != nil {
+		utils.RespondError(ctx, http.StatusConflict, err.Error())
+		return
+	}
+	ctx.JSON(http.StatusOK, resp)
+	return
+}
+
+// PrintPayslip godoc
+// @Summary CEO , FINANCIST
+// @Description Renders the payslip of a teacher as printable html page
+// @Tags payroll
+// @Produce html
+// @Param runId path string true "Payroll run ID"
+// @Param teacherId path string true "Teacher ID"
+// @Success 200 {string} string "html page"
+// @Failure 409 {object} utils.AbsResponse
+// @Security Bearer
+// @Router /api/finance/payroll/payslip/{runId}/{teacherId}/print [get]
+func PrintPayslip(ctx *gin.Context) {
+	ctxR, cancel := etc.NewTimoutContext(ctx)
+	defer cancel()
+	resp, err := financeClient.GetPayslip(ctxR, ctx.Param("runId"), ctx.Param("teacherId"))
+	if err != nil {
+		utils.RespondError(ctx, http.StatusConflict, err.Error())
+		return
+	}
+	renderPayslip(ctx, resp)
+}
+
+// GetMyPayslips godoc
+// @Summary TEACHER
+// @Description Retrieves approved and paid payslips of the current teacher
+// @Tags payroll
+// @Produce json
+// @Success 200 {object} pb.GetTeacherPayslipsResponse
+// @Failure 409 {object} utils.AbsResponse
+// @Security Bearer
+// @Router /api/finance/payroll/my-payslips [get]
+func GetMyPayslips(ctx *gin.Context) {
+	ctxR, cancel := etc.NewTimoutContext(ctx)
+	defer cancel()
+	user, err := utils.GetUserFromContext(ctx)
+	if err != nil {
+		utils.RespondError(ctx, http.StatusUnauthorized, err.Error())
+		return
+	}
+	resp, err := financeClient.GetTeacherPayslips(ctxR, user.Id)
+	if err != nil {
+		utils.RespondError(ctx, http.StatusConflict, err.Error())
+		return
+	}
+	ctx.JSON(http.StatusOK, resp)
+	return
+}
+
+// PrintMyPayslip godoc
+// @Summary TEACHER
+// @Description Renders the payslip of the current teacher as printable html page
+// @Tags payroll
+// @Produce html
+// @Param runId path string true "Payroll run ID"
+// @Success 200 {string} string "html page"
+// @Failure 409 {object} utils.AbsResponse
+// @Security Bearer
+// @Router /api/finance/payroll/my-payslips/{runId}/print [get]
+func PrintMyPayslip(ctx *gin.Context) {
+	ctxR, cancel := etc.NewTimoutContext(ctx)
+	defer cancel()
+	user, err := utils.GetUserFromContext(ctx)
+	if err != nil {
+		utils.RespondError(ctx, http.StatusUnauthorized, err.Error())
+		return
+	}
+	resp, err := financeClient.GetPayslip(ctxR, ctx.Param("runId"), user.Id)
+	if err != nil {
+		utils.RespondError(ctx, http.StatusConflict, err.Error())
+		return
+	}
+	if resp.Status == "DRAFT" {
+		utils.RespondError(ctx, http.StatusConflict, "payslip is not approved yet")
+		return
+	}
+	renderPayslip(ctx, resp)
+}
+
+var payslipTemplate = template.Must(template.New("payslip").Parse(`<!DOCTYPE html>
+<html>
+<head>
+<meta charset="utf-8">
+<title>{{.Item.TeacherName}} - {{.Period}}</title>
+<style>
+body { font-family: Arial, sans-serif; margin: 24px; }
+table { border-collapse: collapse; width: 100%; margin-bottom: 16px; }
+th, td { border: 1px solid #999; padding: 4px 8px; text-align: left; }
+</style>
+</head>
+<body>
+<h2>Hisob varaqasi: {{.Period}}</h2>
+<p>O'qituvchi: <b>{{.Item.TeacherName}}</b> &nbsp; Holati: {{.Status}}</p>
+{{range .Groups}}
+<h4>{{.GroupName}} (darslar soni: {{.CommonLessonCount}}, jami: {{.Amount}})</h4>
+<table>
+<tr><th>O'quvchi</th><th>Qatnashgan darslar</th><th>Turi</th><th>Summa</th></tr>
+{{range .Students}}<tr><td>{{.StudentName}}</td><td>{{.PassedLessonCount}}</td><td>{{.PriceType}}</td><td>{{.Amount}}</td></tr>
+{{end}}</table>
+{{end}}
+{{if .Adjustments}}
+<h4>Qo'shimcha hisob-kitoblar</h4>
+<table>
+<tr><th>Turi</th><th>Izoh</th><th>Summa</th><th>Sana</th></tr>
+{{range .Adjustments}}<tr><td>{{.Type}}</td><td>{{.Comment}}</td><td>{{.Amount}}</td><td>{{.CreatedAt}}</td></tr>
+{{end}}</table>
+{{end}}
+<table>
+<tr><th>Hisoblangan maosh</th><td>{{.Item.SalaryAmount}}</td></tr>
+<tr><th>Bonus</th><td>{{.Item.BonusAmount}}</td></tr>
+<tr><th>Jarima</th><td>{{.Item.PenaltyAmount}}</td></tr>
+<tr><th>Avans</th><td>{{.Item.AdvanceAmount}}</td></tr>
+<tr><th>To'lanadi</th><td><b>{{.Item.TotalAmount}}</b></td></tr>
+</table>
+</body>
+</html>`))
+
+func renderPayslip(ctx *gin.Context, payslip *pb.Payslip) {
+	var buf bytes.Buffer
+	if err := payslipTemplate.Execute(&buf, payslip); err != nil {
+		utils.RespondError(ctx, http.StatusInternalServerError, err.Error())
+		return
+	}
+	ctx.Data(http.StatusOK, "text/html; charset=utf-8", buf.Bytes())
+}
+
+// CreateInstallmentPlan godoc
+// @Summary CEO , ADMIN , FINANCIST
+// @Description Creates a payment plan for a student in a group. planType is FULL_PREPAY (one installment of totalAmount on startDate), MONTHLY (totalAmount split over monthsCount months from startDate) or CUSTOM (installments list). Installments due today or earlier are charged right away, later ones are charged by the monthly charge job instead of the course price
+// @Tags installment
+// @Accept json
+// @Produce json
+// @Param request body pb.CreateInstallmentPlanRequest true "Installment plan"
+// @Success 200 {object} utils.AbsResponse
+// @Failure 400 {object} utils.AbsResponse
+// @Failure 409 {object} utils.AbsResponse
+// @Security Bearer
+// @Router /api/finance/installment/create [post]
+func CreateInstallmentPlan(ctx *gin.Context) {
+	ctxR, cancel := etc.NewTimoutContext(ctx)
+	defer cancel()
+	user, err := utils.GetUserFromContext(ctx)
+	if err != nil {
+		utils.RespondError(ctx, http.StatusUnauthorized, err.Error())
+		return
+	}
+	req := pb.CreateInstallmentPlanRequest{}
+	if err = ctx.ShouldBindJSON(&req); err != nil {
+		utils.RespondError(ctx, http.StatusBadRequest, err.Error())
+		return
+	}
+	req.ActionById = user.Id
+	req.ActionByName = user.Name
+	resp, err := financeClient.CreateInstallmentPlan(ctxR, &req)
+	if err != nil {
+		utils.RespondError(ctx, http.StatusConflict, err.Error())
+		return
+	}
+	utils.RespondSuccess(ctx, resp.Status, resp.Message)
+	return
+}
+
+// GetInstallmentPlans godoc
+// @Summary CEO , ADMIN , FINANCIST
+// @Description Retrieves installment plans with their schedule, optionally only for one student
+// @Tags installment
+// @Produce json
+// @Param studentId query string false "Student ID"
+// @Success 200 {object} pb.GetInstallmentPlansResponse
+// @Failure 409 {object} utils.AbsResponse
+// @Security Bearer
+// @Router /api/finance/installment/get-all [get]
+func GetInstallmentPlans(ctx *gin.Context) {
+	ctxR, cancel := etc.NewTimoutContext(ctx)
+	defer cancel()
+	resp, err := financeClient.GetInstallmentPlans(ctxR, ctx.Query("studentId"))
+	if err != nil {
+		utils.RespondError(ctx, http.StatusConflict, err.Error())
+		return
+	}
+	ctx.JSON(http.StatusOK, resp)
+	return
+}
+
+// GetInstallmentPlanById godoc
+// @Summary CEO , ADMIN , FINANCIST
+// @Description Retrieves an installment plan with due dates, paid amounts and status of every installment
+// @Tags installment
+// @Produce json
+// @Param planId path string true "Installment plan ID"
+// @Success 200 {object} pb.AbsInstallmentPlan
+// @Failure 409 {object} utils.AbsResponse
+// @Security Bearer
+// @Router /api/finance/installment/get-by-id/{planId} [get]
+func GetInstallmentPlanById(ctx *gin.Context) {
+	ctxR, cancel := etc.NewTimoutContext(ctx)
+	defer cancel()
+	resp, err := financeClient.GetInstallmentPlanById(ctxR, ctx.Param("planId"))
+	if err != nil {
+		utils.RespondError(ctx, http.StatusConflict, err.Error())
+		return
+	}
+	ctx.JSON(http.StatusOK, resp)
+	return
+}
+
+// CancelInstallmentPlan godoc
+// @Summary CEO , FINANCIST
+// @Description Cancels an active installment plan. Already charged installments stay on the student balance and the monthly job goes back to charging the course price
+// @Tags installment
+// @Produce json
+// @Param planId path string true "Installment plan ID"
+// @Success 200 {object} utils.AbsResponse
+// @Failure 409 {object} utils.AbsResponse
+// @Security Bearer
+// @Router /api/finance/installment/cancel/{planId} [put]
+func CancelInstallmentPlan(ctx *gin.Context) {
+	ctxR, cancel := etc.NewTimoutContext(ctx)
+	defer cancel()
+	user, err := utils.GetUserFromContext(ctx)
+	if err != nil {
+		utils.RespondError(ctx, http.StatusUnauthorized, err.Error())
+		return
+	}
+	resp, err := financeClient.CancelInstallmentPlan(ctxR, &pb.CancelInstallmentPlanRequest{
+		PlanId:       ctx.Param("planId"),
+		ActionById:   user.Id,
+		ActionByName: user.Name,
+	})
+	if err != nil {
+		utils.RespondError(ctx, http.StatusConflict, err.Error())
+		return
+	}
+	utils.RespondSuccess(ctx, resp.Status, resp.Message)
+	return
+}
+
+// GetOverdueInstallments godoc
+// @Summary CEO , ADMIN , FINANCIST
+// @Description Debtor report by overdue installments. Every unpaid installment past its due date is listed with days overdue and its age bucket (0-30, 31-60, 61-90, 90+), totals are returned per bucket
+// @Tags installment
+// @Produce json
+// @Param date query string false "Report date in YYYY-MM-DD format, today by default"
+// @Param bucket query string false "Age bucket filter: 0-30, 31-60, 61-90 or 90+"
+// @Success 200 {object} pb.GetOverdueInstallmentsResponse
+// @Failure 409 {object} utils.AbsResponse
+// @Security Bearer
+// @Router /api/finance/installment/overdue [get]
+func GetOverdueInstallments(ctx *gin.Context) {
+	ctxR, cancel := etc.NewTimoutContext(ctx)
+	defer cancel()
+	resp, err := financeClient.GetOverdueInstallments(ctxR, ctx.Query("date"), ctx.Query("bucket"))
+	if err != nil {
+		utils.RespondError(ctx, http.StatusConflict, err.Error())
+		return
+	}
+	ctx.JSON(http.StatusOK, resp)
+	return
+}
+
+// GetDebtAging godoc
+// @Summary CEO , ADMIN , FINANCIST
+// @Description Debt aging report. Age of every debt is counted from the oldest take-off not covered by payments, but not earlier than the moment the balance went below zero. Debtors are grouped into 0-30, 31-60, 61-90 and 90+ day buckets with assignee, last contact and last promise to pay
+// @Tags collection
+// @Produce json
+// @Param page path string true "page"
+// @Param size path string true "size"
+// @Param bucket query string false "Age bucket filter: 0-30, 31-60, 61-90 or 90+"
+// @Param assignedToId query string false "Only debtors assigned to this staff member"
+// @Success 200 {object} pb.GetDebtAgingResponse
+// @Failure 409 {object} utils.AbsResponse
+// @Security Bearer
+// @Router /api/finance/collection/aging/{page}/{size} [get]
+func GetDebtAging(ctx *gin.Context) {
+	ctxR, cancel := etc.NewTimoutContext(ctx)
+	defer cancel()
+	resp, err := financeClient.GetDebtAging(ctxR, ctx.Param("page"), ctx.Param("size"), ctx.Query("bucket"), ctx.Query("assignedToId"))
+	if err != nil {
+		utils.RespondError(ctx, http.StatusConflict, err.Error())
+		return
+	}
+	ctx.JSON(http.StatusOK, resp)
+	return
+}
+
+// AssignDebtor godoc
+// @Summary CEO , FINANCIST
+// @Description Assigns a debtor to a staff member responsible for collecting the debt
+// @Tags collection
+// @Accept json
+// @Produce json
+// @Param request body pb.AssignDebtorRequest true "Student and staff member"
+// @Success 200 {object} utils.AbsResponse
+// @Failure 400 {object} utils.AbsResponse
+// @Failure 409 {object} utils.AbsResponse
+// @Security Bearer
+// @Router /api/finance/collection/assign [post]
+func AssignDebtor(ctx *gin.Context) {
+	ctxR, cancel := etc.NewTimoutContext(ctx)
+	defer cancel()
+	req := pb.AssignDebtorRequest{}
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		utils.RespondError(ctx, http.StatusBadRequest, err.Error())
+		return
+	}
+	resp, err := financeClient.AssignDebtor(ctxR, &req)
+	if err != nil {
+		utils.RespondError(ctx, http.StatusConflict, err.Error())
+		return
+	}
+	utils.RespondSuccess(ctx, resp.Status, resp.Message)
+	return
+}
+
+// AddCollectionActivity godoc
+// @Summary CEO , ADMIN , FINANCIST
+// @Description Logs a contact attempt with a debtor. type is CALL, SMS, MEETING, PROMISE or NOTE; PROMISE requires promisedAmount and promisedDate (YYYY-MM-DD)
+// @Tags collection
+// @Accept json
+// @Produce json
+// @Param request body pb.AddCollectionActivityRequest true "Activity"
+// @Success 200 {object} utils.AbsResponse
+// @Failure 400 {object} utils.AbsResponse
+// @Failure 409 {object} utils.AbsResponse
+// @Security Bearer
+// @Router /api/finance/collection/activity [post]
+func AddCollectionActivity(ctx *gin.Context) {
+	ctxR, cancel := etc.NewTimoutContext(ctx)
+	defer cancel()
+	user, err := utils.GetUserFromContext(ctx)
+	if err != nil {
+		utils.RespondError(ctx, http.StatusUnauthorized, err.Error())
+		return
+	}
+	req := pb.AddCollectionActivityRequest{}
+	if err = ctx.ShouldBindJSON(&req); err != nil {
+		utils.RespondError(ctx, http.StatusBadRequest, err.Error())
+		return
+	}
+	req.ActionById = user.Id
+	req.ActionByName = user.Name
+	resp, err := financeClient.AddCollectionActivity(ctxR, &req)
+	if err != nil {
+		utils.RespondError(ctx, http.StatusConflict, err.Error())
+		return
+	}
+	utils.RespondSuccess(ctx, resp.Status, resp.Message)
+	return
+}
+
+// GetCollectionActivities godoc
+// @Summary CEO , ADMIN , FINANCIST
+// @Description Retrieves contact attempts, reminders and promises to pay of a debtor, newest first
+// @Tags collection
+// @Produce json
+// @Param studentId path string true "Student ID"
+// @Success 200 {object} pb.GetCollectionActivitiesResponse
+// @Failure 409 {object} utils.AbsResponse
+// @Security Bearer
+// @Router /api/finance/collection/activity/{studentId} [get]
+func GetCollectionActivities(ctx *gin.Context) {
+	ctxR, cancel := etc.NewTimoutContext(ctx)
+	defer cancel()
+	resp, err := financeClient.GetCollectionActivities(ctxR, ctx.Param("studentId"))
+	if err != nil {
+		utils.RespondError(ctx, http.StatusConflict, err.Error())
+		return
+	}
+	ctx.JSON(http.StatusOK, resp)
+	return
+}
+
+// GetCollectionSetting godoc
+// @Summary CEO , FINANCIST
+// @Description Retrieves collection settings of the company
+// @Tags collection
+// @Produce json
+// @Success 200 {object} pb.CollectionSetting
+// @Failure 409 {object} utils.AbsResponse
+// @Security Bearer
+// @Router /api/finance/collection/setting [get]
+func GetCollectionSetting(ctx *gin.Context) {
+	ctxR, cancel := etc.NewTimoutContext(ctx)
+	defer cancel()
+	resp, err := financeClient.GetCollectionSetting(ctxR)
+	if err != nil {
+		utils.RespondError(ctx, http.StatusConflict, err.Error())
+		return
+	}
+	ctx.JSON(http.StatusOK, resp)
+	return
+}
+
+// UpdateCollectionSetting godoc
+// @Summary CEO
+// @Description Updates collection settings. Debtors overdue for freezeAfterDays or more are flagged to freeze (0 disables), remindersEnabled turns escalating DEBT_REMINDER_* SMS templates on or off
+// @Tags collection
+// @Accept json
+// @Produce json
+// @Param request body pb.CollectionSetting true "Collection setting"
+// @Success 200 {object} utils.AbsResponse
+// @Failure 400 {object} utils.AbsResponse
+// @Failure 409 {object} utils.AbsResponse
+// @Security Bearer
+// @Router /api/finance/collection/setting [put]
+func UpdateCollectionSetting(ctx *gin.Context) {
+	ctxR, cancel := etc.NewTimoutContext(ctx)
+	defer cancel()
+	req := pb.CollectionSetting{}
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		utils.RespondError(ctx, http.StatusBadRequest, err.Error())
+		return
+	}
+	resp, err := financeClient.UpdateCollectionSetting(ctxR, &req)
+	if err != nil {
+		utils.RespondError(ctx, http.StatusConflict, err.Error())
+		return
+	}
+	utils.RespondSuccess(ctx, resp.Status, resp.Message)
+	return
+}
+
+// RunCollections godoc
+// @Summary CEO , FINANCIST
+// @Description Runs the collections job right away instead of waiting for the nightly run: sends reminder SMS for debtors who moved into a new age bucket and flags long overdue debtors to freeze
+// @Tags collection
+// @Produce json
+// @Success 200 {object} utils.AbsResponse
+// @Failure 409 {object} utils.AbsResponse
+// @Security Bearer
+// @Router /api/finance/collection/run [post]
+func RunCollections(ctx *gin.Context) {
+	ctxR, cancel := etc.NewTimoutContext(ctx)
+	defer cancel()
+	resp, err := financeClient.RunCollections(ctxR)
+	if err != nil {
+		utils.RespondError(ctx, http.StatusConflict, err.Error())
+		return
+	}
+	utils.RespondSuccess(ctx, resp.Status, resp.Message)
+	return
+}
+
+// GetProfitAndLoss godoc
+// @Summary CEO , FINANCIST
+// @Description Monthly profit and loss statement. Revenue is the sum of take-offs, payments are student payments minus refunds, teacher cost comes from payroll runs or from attendance when the month has no run, expenses exclude payroll expenses. With groupBy the revenue, discounts and teacher cost are broken down per course, group or teacher. format=csv or format=xlsx downloads the statement as a file
+// @Tags report
+// @Produce json
+// @Produce text/csv
+// @Produce application/vnd.openxmlformats-officedocument.spreadsheetml.sheet
+// @Param from query string false "First month in YYYY-MM format, equals to by default"
+// @Param to query string false "Last month in YYYY-MM format, current month by default"
+// @Param groupBy query string false "Drill down: COURSE, GROUP or TEACHER"
+// @Param format query string false "json (default), csv or xlsx"
+// @Success 200 {object} pb.GetProfitAndLossResponse
+// @Failure 400 {object} utils.AbsResponse
+// @Failure 409 {object} utils.AbsResponse
+// @Security Bearer
+// @Router /api/finance/report/pnl [get]
+func GetProfitAndLoss(ctx *gin.Context) {
+	format := ctx.DefaultQuery("format", "json")
+	if format != "json" && format != "csv" && format != "xlsx" {
+		utils.RespondError(ctx, http.StatusBadRequest, "format must be json, csv or xlsx")
+		return
+	}
+	ctxR, cancel := etc.NewTimoutContext(ctx)
+	defer cancel()
+	resp, err := financeClient.GetProfitAndLoss(ctxR, ctx.Query("from"), ctx.Query("to"), ctx.Query("groupBy"))
+	if err != nil {
+		utils.RespondError(ctx, http.StatusConflict, err.Error())
+		return
+	}
+	switch format {
+	case "csv":
+		var buf bytes.Buffer
+		writer := csv.NewWriter(&buf)
+		if err := writer.WriteAll(profitAndLossTable(resp)); err != nil {
+			utils.RespondError(ctx, http.StatusInternalServerError, err.Error())
+			return
+		}
+		ctx.Header("Content-Disposition", `attachment; filename="profit-and-loss.csv"`)
+		ctx.Data(http.StatusOK, "text/csv; charset=utf-8", buf.Bytes())
+	case "xlsx":
+		file := excelize.NewFile()
+		defer file.Close()
+		sheet := file.GetSheetName(0)
+		for i, row := range profitAndLossTable(resp) {
+			cell, _ := excelize.CoordinatesToCellName(1, i+1)
+			values := make([]interface{}, len(row))
+			for j, value := range row {
+				if number, err := strconv.ParseFloat(value, 64); err == nil && j > 0 {
+					values[j] = number
+				} else {
+					values[j] = value
+				}
+			}
+			if err := file.SetSheetRow(sheet, cell, &values); err != nil {
+				utils.RespondError(ctx, http.StatusInternalServerError, err.Error())
+				return
+			}
+		}
+		var buf bytes.Buffer
+		if err := file.Write(&buf); err != nil {
+			utils.RespondError(ctx, http.StatusInternalServerError, err.Error())
+			return
+		}
+		ctx.Header("Content-Disposition", `attachment; filename="profit-and-loss.xlsx"`)
+		ctx.Data(http.StatusOK, "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet", buf.Bytes())
+	default:
+		ctx.JSON(http.StatusOK, resp)
+	}
+	return
+}
+
+// profitAndLossTable flattens the statement into rows shared by the csv and xlsx exports
+func profitAndLossTable(resp *pb.GetProfitAndLossResponse) [][]string {
+	amount := func(value float64) string {
+		return strconv.FormatFloat(value, 'f', 2, 64)
+	}
+	table := [][]string{{"Period", "Revenue", "Payments", "Discounts", "Teacher cost", "Expenses", "Gross profit", "Net profit"}}
+	months := append(resp.Months, resp.Total)
+	for _, month := range months {
+		if month == nil {
+			continue
+		}
+		table = append(table, []string{month.Period, amount(month.Revenue), amount(month.Payments), amount(month.Discounts),
+			amount(month.TeacherCost), amount(month.Expenses), amount(month.GrossProfit), amount(month.NetProfit)})
+	}
+	if len(resp.Breakdown) == 0 {
+		return table
+	}
+	table = append(table, []string{}, []string{"Period", resp.GroupBy, "Revenue", "Discounts", "Teacher cost", "Gross profit"})
+	for _, row := range resp.Breakdown {
+		table = append(table, []string{row.Period, row.Name, amount(row.Revenue), amount(row.Discounts), amount(row.TeacherCost), amount(row.GrossProfit)})
+	}
+	return table
+}
+
+// SetCategoryBudget godoc
+// @Summary CEO , FINANCIST
+// @Description Sets the monthly budget of an expense category. Zero removes the budget. CEO and FINANCIST get an sms when spend of the month crosses 80% and 100% of the budget
+// @Tags category
+// @Accept json
+// @Produce json
+// @Param request body pb.SetCategoryBudgetRequest true "Category and monthly budget"
+// @Success 200 {object} utils.AbsResponse
+// @Failure 400 {object} utils.AbsResponse
+// @Failure 409 {object} utils.AbsResponse
+// @Security Bearer
+// @Router /api/finance/category/budget [put]
+func SetCategoryBudget(ctx *gin.Context) {
+	ctxR, cancel := etc.NewTimoutContext(ctx)
+	defer cancel()
+	req := pb.SetCategoryBudgetRequest{}
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		utils.RespondError(ctx, http.StatusBadRequest, err.Error())
+		return
+	}
+	resp, err := financeClient.SetCategoryBudget(ctxR, &req)
+	if err != nil {
+		utils.RespondError(ctx, http.StatusConflict, err.Error())
+		return
+	}
+	utils.RespondSuccess(ctx, resp.Status, resp.Message)
+	return
+}
+
+// CreateRecurringExpense godoc
+// @Summary CEO , FINANCIST
+// @Description Creates a recurring expense (rent, utilities, subscriptions) that is posted automatically. Schedule is MONTHLY with day 1-31 (last day of shorter months) or WEEKLY with day 1 (monday) - 7 (sunday). endDate is optional. Returns the id in message
+// @Tags expense
+// @Accept json
+// @Produce json
+// @Param request body pb.CreateRecurringExpenseRequest true "Recurring expense"
+// @Success 200 {object} utils.AbsResponse
+// @Failure 400 {object} utils.AbsResponse
+// @Failure 409 {object} utils.AbsResponse
+// @Security Bearer
+// @Router /api/finance/expense/recurring/create [post]
+func CreateRecurringExpense(ctx *gin.Context) {
+	ctxR, cancel := etc.NewTimoutContext(ctx)
+	defer cancel()
+	req := pb.CreateRecurringExpenseRequest{}
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		utils.RespondError(ctx, http.StatusBadRequest, err.Error())
+		return
+	}
+	user, err := utils.GetUserFromContext(ctx)
+	if err != nil {
+		utils.RespondError(ctx, http.StatusInternalServerError, err.Error())
+		return
+	}
+	req.CreatedById = user.Id
+	resp, err := financeClient.CreateRecurringExpense(ctxR, &req)
+	if err != nil {
+		utils.RespondError(ctx, http.StatusConflict, err.Error())
+		return
+	}
+	utils.RespondSuccess(ctx, resp.Status, resp.Message)
+	return
+}
+
+// GetRecurringExpenses godoc
+// @Summary ADMIN , CEO , FINANCIST
+// @Description Lists recurring expenses with their next run date, active ones first. skippedDates are occurrences in closed accounting periods that were not posted
+// @Tags expense
+// @Produce json
+// @Success 200 {object} pb.GetRecurringExpensesResponse
+// @Failure 409 {object} utils.AbsResponse
+// @Security Bearer
+// @Router /api/finance/expense/recurring/get-all [get]
+func GetRecurringExpenses(ctx *gin.Context) {
+	ctxR, cancel := etc.NewTimoutContext(ctx)
+	defer cancel()
+	resp, err := financeClient.GetRecurringExpenses(ctxR)
+	if err != nil {
+		utils.RespondError(ctx, http.StatusConflict, err.Error())
+		return
+	}
+	ctx.JSON(http.StatusOK, resp)
+	return
+}
+
+// DeleteRecurringExpense godoc
+// @Summary CEO , FINANCIST
+// @Description Stops a recurring expense. Expenses it has already posted are kept
+// @Tags expense
+// @Produce json
+// @Param id path string true "Recurring expense ID"
+// @Success 200 {object} utils.AbsResponse
+// @Failure 409 {object} utils.AbsResponse
+// @Security Bearer
+// @Router /api/finance/expense/recurring/delete/{id} [delete]
+func DeleteRecurringExpense(ctx *gin.Context) {
+	ctxR, cancel := etc.NewTimoutContext(ctx)
+	defer cancel()
+	resp, err := financeClient.DeleteRecurringExpense(ctxR, ctx.Param("id"))
+	if err != nil {
+		utils.RespondError(ctx, http.StatusConflict, err.Error())
+		return
+	}
+	utils.RespondSuccess(ctx, resp.Status, resp.Message)
+	return
+}
+
+// PostRecurringExpenses godoc
+// @Summary CEO , FINANCIST
+// @Description Posts all due recurring expenses now. The scheduler runs the same every day, occurrences in closed accounting periods are not posted and are listed in skippedDates of the recurring expense
+// @Tags expense
+// @Produce json
+// @Success 200 {object} utils.AbsResponse
+// @Failure 409 {object} utils.AbsResponse
+// @Security Bearer
+// @Router /api/finance/expense/recurring/post [post]
+func PostRecurringExpenses(ctx *gin.Context) {
+	ctxR, cancel := etc.NewTimoutContext(ctx)
+	defer cancel()
+	resp, err := financeClient.PostRecurringExpenses(ctxR)
+	if err != nil {
+		utils.RespondError(ctx, http.StatusConflict, err.Error())
+		return
+	}
+	utils.RespondSuccess(ctx, resp.Status, resp.Message)
+	return
+}
+
+// GetBudgetAlerts godoc
+// @Summary CEO , FINANCIST
+// @Description Category budget alerts raised when the monthly spend crossed 80% or 100% of the budget, newest first
+// @Tags expense
+// @Produce json
+// @Param period query string false "Month in YYYY-MM format, all recent alerts by default"
+// @Success 200 {object} pb.GetBudgetAlertsResponse
+// @Failure 409 {object} utils.AbsResponse
+// @Security Bearer
+// @Router /api/finance/expense/budget-alerts [get]
+func GetBudgetAlerts(ctx *gin.Context) {
+	ctxR, cancel := etc.NewTimoutContext(ctx)
+	defer cancel()
+	resp, err := financeClient.GetBudgetAlerts(ctxR, ctx.Query("period"))
+	if err != nil {
+		utils.RespondError(ctx, http.StatusConflict, err.Error())
+		return
+	}
+	ctx.JSON(http.StatusOK, resp)
+	return
+}
+
+// GetExpenseById godoc
+// @Summary ADMIN , CEO , FINANCIST
+// @Description Expense with its status, vendor, attached receipts and status history
+// @Tags expense
+// @Produce json
+// @Param id path string true "Expense ID"
+// @Success 200 {object} pb.ExpenseDetail
+// @Failure 409 {object} utils.AbsResponse
+// @Security Bearer
+// @Router /api/finance/expense/get-by-id/{id} [get]
+func GetExpenseById(ctx *gin.Context) {
+	ctxR, cancel := etc.NewTimoutContext(ctx)
+	defer cancel()
+	resp, err := financeClient.GetExpenseById(ctxR, ctx.Param("id"))
+	if err != nil {
+		utils.RespondError(ctx, http.StatusConflict, err.Error())
+		return
+	}
+	ctx.JSON(http.StatusOK, resp)
+	return
+}
+
+// SubmitExpense godoc
+// @Summary ADMIN , CEO , FINANCIST
+// @Description Submits a DRAFT or REJECTED expense. It goes to PENDING_APPROVAL when it reaches an approval threshold the submitter may not approve, otherwise it is booked as PAID. Returns the new status in message
+// @Tags expense
+// @Accept json
+// @Produce json
+// @Param id path string true "Expense ID"
+// @Param request body pb.ExpenseActionRequest false "Optional comment"
+// @Success 200 {object} utils.AbsResponse
+// @Failure 409 {object} utils.AbsResponse
+// @Security Bearer
+// @Router /api/finance/expense/submit/{id} [put]
+func SubmitExpense(ctx *gin.Context) {
+	expenseAction(ctx, financeClient.SubmitExpense)
+}
+
+// ApproveExpense godoc
+// @Summary CEO , FINANCIST
+// @Description Approves an expense waiting for approval. FINANCIST may approve only expenses routed to FINANCIST, CEO may approve any
+// @Tags expense
+// @Accept json
+// @Produce json
+// @Param id path string true "Expense ID"
+// @Param request body pb.ExpenseActionRequest false "Optional comment"
+// @Success 200 {object} utils.AbsResponse
+// @Failure 409 {object} utils.AbsResponse
+// @Security Bearer
+// @Router /api/finance/expense/approve/{id} [put]
+func ApproveExpense(ctx *gin.Context) {
+	expenseAction(ctx, financeClient.ApproveExpense)
+}
+
+// RejectExpense godoc
+// @Summary CEO , FINANCIST
+// @Description Rejects an expense waiting for approval, comment with the reason is required. A rejected expense may be corrected and submitted again
+// @Tags expense
+// @Accept json
+// @Produce json
+// @Param id path string true "Expense ID"
+// @Param request body pb.ExpenseActionRequest true "Rejection reason in comment"
+// @Success 200 {object} utils.AbsResponse
+// @Failure 409 {object} utils.AbsResponse
+// @Security Bearer
+// @Router /api/finance/expense/reject/{id} [put]
+func RejectExpense(ctx *gin.Context) {
+	expenseAction(ctx, financeClient.RejectExpense)
+}
+
+// MarkExpensePaid godoc
+// @Summary CEO , FINANCIST
+// @Description Marks an approved expense as paid
+// @Tags expense
+// @Accept json
+// @Produce json
+// @Param id path string true "Expense ID"
+// @Param request body pb.ExpenseActionRequest false "Optional comment"
+// @Success 200 {object} utils.AbsResponse
+// @Failure 409 {object} utils.AbsResponse
+// @Security Bearer
+// @Router /api/finance/expense/mark-paid/{id} [put]
+func MarkExpensePaid(ctx *gin.Context) {
+	expenseAction(ctx, financeClient.MarkExpensePaid)
+}
+
+// expenseAction fills the expense id and the acting user into the request and runs the status change
+func expenseAction(ctx *gin.Context, action func(context.Context, *pb.ExpenseActionRequest) (*pb.AbsResponse, error)) {
+	ctxR, cancel := etc.NewTimoutContext(ctx)
+	defer cancel()
+	req := pb.ExpenseActionRequest{}
+	if ctx.Request.ContentLength > 0 {
+		if err := ctx.ShouldBindJSON(&req); err != nil {
+			utils.RespondError(ctx, http.StatusBadRequest, err.Error())
+			return
+		}
+	}
+	user, err := utils.GetUserFromContext(ctx)
+	if err != nil {
+		utils.RespondError(ctx, http.StatusInternalServerError, err.Error())
+		return
+	}
+	req.Id = ctx.Param("id")
+	req.ActionById = user.Id
+	req.ActionByName = user.Name
+	req.ActionByRole = user.Role
+	resp, err := action(ctxR, &req)
+	if err != nil {
+		utils.RespondError(ctx, http.StatusConflict, err.Error())
+		return
+	}
+	utils.RespondSuccess(ctx, resp.Status, resp.Message)
+}
+
+// AddExpenseReceipt godoc
+// @Summary ADMIN , CEO , FINANCIST
+// @Description Attaches a receipt (photo or PDF) to an expense. Upload the file through /api/image/upload first and pass the returned url as fileUrl. Returns the receipt id in message
+// @Tags expense
+// @Accept json
+// @Produce json
+// @Param request body pb.AddExpenseReceiptRequest true "Expense and uploaded file"
+// @Success 200 {object} utils.AbsResponse
+// @Failure 400 {object} utils.AbsResponse
+// @Failure 409 {object} utils.AbsResponse
+// @Security Bearer
+// @Router /api/finance/expense/receipt [post]
+func AddExpenseReceipt(ctx *gin.Context) {
+	ctxR, cancel := etc.NewTimoutContext(ctx)
+	defer cancel()
+	req := pb.AddExpenseReceiptRequest{}
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		utils.RespondError(ctx, http.StatusBadRequest, err.Error())
+		return
+	}
+	user, err := utils.GetUserFromContext(ctx)
+	if err != nil {
+		utils.RespondError(ctx, http.StatusInternalServerError, err.Error())
+		return
+	}
+	req.UploadedById = user.Id
+	resp, err := financeClient.AddExpenseReceipt(ctxR, &req)
+	if err != nil {
+		utils.RespondError(ctx, http.StatusConflict, err.Error())
+		return
+	}
+	utils.RespondSuccess(ctx, resp.Status, resp.Message)
+	return
+}
+
+// DeleteExpenseReceipt godoc
+// @Summary ADMIN , CEO , FINANCIST
+// @Description Removes a receipt from an expense
+// @Tags expense
+// @Produce json
+// @Param id path string true "Receipt ID"
+// @Success 200 {object} utils.AbsResponse
+// @Failure 409 {object} utils.AbsResponse
+// @Security Bearer
+// @Router /api/finance/expense/receipt/{id} [delete]
+func DeleteExpenseReceipt(ctx *gin.Context) {
+	ctxR, cancel := etc.NewTimoutContext(ctx)
+	defer cancel()
+	resp, err := financeClient.DeleteExpenseReceipt(ctxR, ctx.Param("id"))
+	if err != nil {
+		utils.RespondError(ctx, http.StatusConflict, err.Error())
+		return
+	}
+	utils.RespondSuccess(ctx, resp.Status, resp.Message)
+	return
+}
+
+// GetExpenseApprovalSetting godoc
+// @Summary ADMIN , CEO , FINANCIST
+// @Description Expense approval thresholds of the company, zero means the approval step is off
+// @Tags expense
+// @Produce json
+// @Success 200 {object} pb.ExpenseApprovalSetting
+// @Failure 409 {object} utils.AbsResponse
+// @Security Bearer
+// @Router /api/finance/expense/approval-setting [get]
+func GetExpenseApprovalSetting(ctx *gin.Context) {
+	ctxR, cancel := etc.NewTimoutContext(ctx)
+	defer cancel()
+	resp, err := financeClient.GetExpenseApprovalSetting(ctxR)
+	if err != nil {
+		utils.RespondError(ctx, http.StatusConflict, err.Error())
+		return
+	}
+	ctx.JSON(http.StatusOK, resp)
+	return
+}
+
+// UpdateExpenseApprovalSetting godoc
+// @Summary CEO
+// @Description Sets expense approval thresholds. Expenses reaching ceoThreshold need CEO approval, those reaching financistThreshold need FINANCIST or CEO approval. Zero turns the step off
+// @Tags expense
+// @Accept json
+// @Produce json
+// @Param request body pb.ExpenseApprovalSetting true "Thresholds"
+// @Success 200 {object} utils.AbsResponse
+// @Failure 400 {object} utils.AbsResponse
+// @Failure 409 {object} utils.AbsResponse
+// @Security Bearer
+// @Router /api/finance/expense/approval-setting [put]
+func UpdateExpenseApprovalSetting(ctx *gin.Context) {
+	ctxR, cancel := etc.NewTimoutContext(ctx)
+	defer cancel()
+	req := pb.ExpenseApprovalSetting{}
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		utils.RespondError(ctx, http.StatusBadRequest, err.Error())
+		return
+	}
+	resp, err := financeClient.UpdateExpenseApprovalSetting(ctxR, &req)
+	if err != nil {
+		utils.RespondError(ctx, http.StatusConflict, err.Error())
+		return
+	}
+	utils.RespondSuccess(ctx, resp.Status, resp.Message)
+	return
+}
+
+// CreateVendor godoc
+// @Summary ADMIN , CEO , FINANCIST
+// @Description Creates a vendor that expenses can be linked to. Returns the vendor id in message
+// @Tags vendor
+// @Accept json
+// @Produce json
+// @Param request body pb.CreateVendorRequest true "Vendor"
+// @Success 200 {object} utils.AbsResponse
+// @Failure 400 {object} utils.AbsResponse
+// @Failure 409 {object} utils.AbsResponse
+// @Security Bearer
+// @Router /api/finance/vendor/create [post]
+func CreateVendor(ctx *gin.Context) {
+	ctxR, cancel := etc.NewTimoutContext(ctx)
+	defer cancel()
+	req := pb.CreateVendorRequest{}
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		utils.RespondError(ctx, http.StatusBadRequest, err.Error())
+		return
+	}
+	resp, err := financeClient.CreateVendor(ctxR, &req)
+	if err != nil {
+		utils.RespondError(ctx, http.StatusConflict, err.Error())
+		return
+	}
+	utils.RespondSuccess(ctx, resp.Status, resp.Message)
+	return
+}
+
+// UpdateVendor godoc
+// @Summary ADMIN , CEO , FINANCIST
+// @Description Updates vendor details, isActive=false hides the vendor from the default list
+// @Tags vendor
+// @Accept json
+// @Produce json
+// @Param request body pb.AbsVendor true "Vendor"
+// @Success 200 {object} utils.AbsResponse
+// @Failure 400 {object} utils.AbsResponse
+// @Failure 409 {object} utils.AbsResponse
+// @Security Bearer
+// @Router /api/finance/vendor/update [put]
+func UpdateVendor(ctx *gin.Context) {
+	ctxR, cancel := etc.NewTimoutContext(ctx)
+	defer cancel()
+	req := pb.AbsVendor{}
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		utils.RespondError(ctx, http.StatusBadRequest, err.Error())
+		return
+	}
+	resp, err := financeClient.UpdateVendor(ctxR, &req)
+	if err != nil {
+		utils.RespondError(ctx, http.StatusConflict, err.Error())
+		return
+	}
+	utils.RespondSuccess(ctx, resp.Status, resp.Message)
+	return
+}
+
+// GetVendors godoc
+// @Summary ADMIN , CEO , FINANCIST
+// @Description Vendors with total approved spend, expense count and last expense date
+// @Tags vendor
+// @Produce json
+// @Param includeInactive query bool false "Include inactive vendors"
+// @Success 200 {object} pb.GetVendorsResponse
+// @Failure 409 {object} utils.AbsResponse
+// @Security Bearer
+// @Router /api/finance/vendor/get-all [get]
+func GetVendors(ctx *gin.Context) {
+	ctxR, cancel := etc.NewTimoutContext(ctx)
+	defer cancel()
+	resp, err := financeClient.GetVendors(ctxR, ctx.Query("includeInactive") == "true")
+	if err != nil {
+		utils.RespondError(ctx, http.StatusConflict, err.Error())
+		return
+	}
+	ctx.JSON(http.StatusOK, resp)
+	return
+}
+
+// GetVendorSpendHistory godoc
+// @Summary CEO , FINANCIST
+// @Description Monthly spend with a vendor. Single expenses are listed by /api/finance/expense/get-all-information with type=VENDOR
+// @Tags vendor
+// @Produce json
+// @Param vendorId path string true "Vendor ID"
+// @Param from query string false "Start date (YYYY-MM-DD)"
+// @Param to query string false "End date (YYYY-MM-DD)"
+// @Success 200 {object} pb.GetVendorSpendHistoryResponse
+// @Failure 409 {object} utils.AbsResponse
+// @Security Bearer
+// @Router /api/finance/vendor/spend-history/{vendorId} [get]
+func GetVendorSpendHistory(ctx *gin.Context) {
+	ctxR, cancel := etc.NewTimoutContext(ctx)
+	defer cancel()
+	resp, err := financeClient.GetVendorSpendHistory(ctxR, ctx.Param("vendorId"), ctx.Query("from"), ctx.Query("to"))
+	if err != nil {
+		utils.RespondError(ctx, http.StatusConflict, err.Error())
+		return
+	}
+	ctx.JSON(http.StatusOK, resp)
+	return
+}
+
+// ExportOneC godoc
+// @Summary CEO , FINANCIST
+// @Description Downloads student payments, refunds, paid expenses and salary payouts of the date range as a 1CClientBankExchange file in Windows-1251. Accounts come from the company 1C setting
+// @Tags export
+// @Produce octet-stream
+// @Param from query string true "Start date (format: YYYY-MM-DD)"
+// @Param to query string true "End date (format: YYYY-MM-DD)"
+// @Success 200 {file} file "1C exchange file"
+// @Failure 409 {object} utils.AbsResponse
+// @Security Bearer
+// @Router /api/finance/export/1c [get]
+func ExportOneC(ctx *gin.Context) {
+	ctxR, cancel := etc.NewTimoutContext(ctx)
+	defer cancel()
+	resp, err := financeClient.ExportOneC(ctxR, ctx.Query("from"), ctx.Query("to"))
+	if err != nil {
+		utils.RespondError(ctx, http.StatusConflict, err.Error())
+		return
+	}
+	ctx.Header("Content-Disposition", fmt.Sprintf(`attachment; filename="%s"`, resp.FileName))
+	ctx.Data(http.StatusOK, "text/plain; charset=windows-1251", resp.Content)
+}
+
+// GetOneCSetting godoc
+// @Summary CEO , FINANCIST
+// @Description Organization requisites and account mappings used by the 1C export
+// @Tags export
+// @Produce json
+// @Success 200 {object} pb.OneCSetting
+// @Failure 409 {object} utils.AbsResponse
+// @Security Bearer
+// @Router /api/finance/export/1c/setting [get]
+func GetOneCSetting(ctx *gin.Context) {
+	ctxR, cancel := etc.NewTimoutContext(ctx)
+	defer cancel()
+	resp, err := financeClient.GetOneCSetting(ctxR)
+	if err != nil {
+		utils.RespondError(ctx, http.StatusConflict, err.Error())
+		return
+	}
+	ctx.JSON(http.StatusOK, resp)
+}
+
+// UpdateOneCSetting godoc
+// @Summary CEO , FINANCIST
+// @Description Replaces organization requisites and account mappings of the 1C export. sourceType is PAYMENT_METHOD (sourceKey CASH, CLICK or PAYME), STUDENT, EXPENSE_CATEGORY (sourceKey is the category id, empty for the default), EXPENSE_USER or SALARY
+// @Tags export
+// @Accept json
+// @Produce json
+// @Param request body pb.OneCSetting true "1C setting"
+// @Success 200 {object} utils.AbsResponse
+// @Failure 400 {object} utils.AbsResponse
+// @Failure 409 {object} utils.AbsResponse
+// @Security Bearer
+// @Router /api/finance/export/1c/setting [put]
+func UpdateOneCSetting(ctx *gin.Context) {
+	ctxR, cancel := etc.NewTimoutContext(ctx)
+	defer cancel()
+	req := pb.OneCSetting{}
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		utils.RespondError(ctx, http.StatusBadRequest, err.Error())
+		return
+	}
+	resp, err := financeClient.UpdateOneCSetting(ctxR, &req)
+	if err != nil {
+		utils.RespondError(ctx, http.StatusConflict, err.Error())
+		return
+	}
+	utils.RespondSuccess(ctx, resp.Status, resp.Message)
+}
+
+// ImportStatement godoc
+// @Summary CEO , ADMIN , FINANCIST
+// @Description Uploads a bank or provider statement and reconciles it with student payments. Lines are matched by provider transaction id, then by amount and date (3 days window) narrowed by payer phone. The response is the reconciliation session with MATCHED, UNMATCHED and SUSPICIOUS lines
+// @Tags reconciliation
+// @Accept multipart/form-data
+// @Produce json
+// @Param file formData file true "Statement file, CSV or XLSX up to 3MB"
+// @Param source formData string true "CSV, XLSX, PAYME or CLICK"
+// @Param method formData string false "Payment method of the statement: CASH, CLICK or PAYME. PAYME and CLICK sources default to their own method"
+// @Success 200 {object} pb.ReconciliationSession
+// @Failure 400 {object} utils.AbsResponse
+// @Failure 409 {object} utils.AbsResponse
+// @Security Bearer
+// @Router /api/finance/reconciliation/import [post]
+func ImportStatement(ctx *gin.Context) {
+	file, header, err := ctx.Request.FormFile("file")
+	if err != nil {
+		utils.RespondError(ctx, http.StatusBadRequest, "Failed to read statement file: "+err.Error())
+		return
+	}
+	defer file.Close()
+	const maxFileSize = 3 << 20
+	if header.Size > maxFileSize {
+		utils.RespondError(ctx, http.StatusBadRequest, "File is too large. Maximum size is 3MB")
+		return
+	}
+	content, err := io.ReadAll(file)
+	if err != nil {
+		utils.RespondError(ctx, http.StatusBadRequest, "Failed to read statement file: "+err.Error())
+		return
+	}
+	user, err := utils.GetUserFromContext(ctx)
+	if err != nil {
+		utils.RespondError(ctx, http.StatusInternalServerError, err.Error())
+		return
+	}
+	ctxR, cancel := etc.NewTimoutContext(ctx)
+	defer cancel()
+	resp, err := financeClient.ImportStatement(ctxR, &pb.ImportStatementRequest{
+		Source:       ctx.PostForm("source"),
+		Method:       ctx.PostForm("method"),
+		FileName:     header.Filename,
+		Content:      content,
+		ActionById:   user.Id,
+		ActionByName: user.Name,
+	})
+	if err != nil {
+		utils.RespondError(ctx, http.StatusConflict, err.Error())
+		return
+	}
+	ctx.JSON(http.StatusOK, resp)
+}
+
+// GetReconciliationSessions godoc
+// @Summary CEO , ADMIN , FINANCIST
+// @Description Imported statements with matched, unmatched, suspicious and created line counts, newest first
+// @Tags reconciliation
+// @Produce json
+// @Param page path string true "Page"
+// @Param size path string true "Size"
+// @Success 200 {object} pb.GetReconciliationSessionsResponse
+// @Failure 409 {object} utils.AbsResponse
+// @Security Bearer
+// @Router /api/finance/reconciliation/sessions/{page}/{size} [get]
+func GetReconciliationSessions(ctx *gin.Context) {
+	ctxR, cancel := etc.NewTimoutContext(ctx)
+	defer cancel()
+	resp, err := financeClient.GetReconciliationSessions(ctxR, ctx.Param("page"), ctx.Param("size"))
+	if err != nil {
+		utils.RespondError(ctx, http.StatusConflict, err.Error())
+		return
+	}
+	ctx.JSON(http.StatusOK, resp)
+}
+
+// GetReconciliationSession godoc
+// @Summary CEO , ADMIN , FINANCIST
+// @Description Reconciliation session with its lines
+// @Tags reconciliation
+// @Produce json
+// @Param id path string true "Session ID"
+// @Param status query string false "Only lines of status MATCHED, UNMATCHED, SUSPICIOUS, CREATED or IGNORED"
+// @Success 200 {object} pb.ReconciliationSession
+// @Failure 409 {object} utils.AbsResponse
+// @Security Bearer
+// @Router /api/finance/reconciliation/session/{id} [get]
+func GetReconciliationSession(ctx *gin.Context) {
+	ctxR, cancel := etc.NewTimoutContext(ctx)
+	defer cancel()
+	resp, err := financeClient.GetReconciliationSession(ctxR, ctx.Param("id"), ctx.Query("status"))
+	if err != nil {
+		utils.RespondError(ctx, http.StatusConflict, err.Error())
+		return
+	}
+	ctx.JSON(http.StatusOK, resp)
+}
+
+// CreateMissingPayments godoc
+// @Summary CEO , ADMIN , FINANCIST
+// @Description Creates student payments for unmatched or suspicious lines. With empty lines every unmatched line whose payer phone belongs to a single student is paid. studentId and groupId of a line override the recognised student. Each line reports its own result
+// @Tags reconciliation
+// @Accept json
+// @Produce json
+// @Param request body pb.CreateMissingPaymentsRequest true "Session and lines"
+// @Success 200 {object} pb.CreateMissingPaymentsResponse
+// @Failure 400 {object} utils.AbsResponse
+// @Failure 409 {object} utils.AbsResponse
+// @Security Bearer
+// @Router /api/finance/reconciliation/create-payments [post]
+func CreateMissingPayments(ctx *gin.Context) {
+	ctxR, cancel := etc.NewTimoutContext(ctx)
+	defer cancel()
+	req := pb.CreateMissingPaymentsRequest{}
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		utils.RespondError(ctx, http.StatusBadRequest, err.Error())
+		return
+	}
+	user, err := utils.GetUserFromContext(ctx)
+	if err != nil {
+		utils.RespondError(ctx, http.StatusInternalServerError, err.Error())
+		return
+	}
+	req.ActionById = user.Id
+	req.ActionByName = user.Name
+	resp, err := financeClient.CreateMissingPayments(ctxR, &req)
+	if err != nil {
+		utils.RespondError(ctx, http.StatusConflict, err.Error())
+		return
+	}
+	ctx.JSON(http.StatusOK, resp)
+}
+
+// ResolveReconciliationLine godoc
+// @Summary CEO , ADMIN , FINANCIST
+// @Description Resolves a line by hand: MATCHED links it to paymentId, IGNORED removes it from the unmatched list
+// @Tags reconciliation
+// @Accept json
+// @Produce json
+// @Param request body pb.ResolveReconciliationLineRequest true "Line resolution"
+// @Success 200 {object} utils.AbsResponse
+// @Failure 400 {object} utils.AbsResponse
+// @Failure 409 {object} utils.AbsResponse
+// @Security Bearer
+// @Router /api/finance/reconciliation/resolve [put]
+func ResolveReconciliationLine(ctx *gin.Context) {
+	ctxR, cancel := etc.NewTimoutContext(ctx)
+	defer cancel()
+	req := pb.ResolveReconciliationLineRequest{}
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		utils.RespondError(ctx, http.StatusBadRequest, err.Error())
+		return
+	}
+	resp, err := financeClient.ResolveReconciliationLine(ctxR, &req)
+	if err != nil {
+		utils.RespondError(ctx, http.StatusConflict, err.Error())
+		return
+	}
+	utils.RespondSuccess(ctx, resp.Status, resp.Message)
+}
+
+// CreateDiscountRule godoc
+// @Summary CEO , FINANCIST
+// @Description Creates a reusable discount rule. ruleType TEMPLATE is applied by hand, SIBLING applies to a student whose family phone is shared with an earlier registered active student, MULTI_COURSE applies from minCourses courses, PROMO_CODE applies with promoCode until usageLimit or validUntil. valueType PERCENT takes value percent off the course price, FIXED takes value off. Returns the rule id in message
+// @Tags discount
+// @Accept json
+// @Produce json
+// @Param request body pb.AbsDiscountRule true "Discount rule"
+// @Success 200 {object} utils.AbsResponse
+// @Failure 400 {object} utils.AbsResponse
+// @Failure 409 {object} utils.AbsResponse
+// @Security Bearer
+// @Router /api/finance/discount/rule/create [post]
+func CreateDiscountRule(ctx *gin.Context) {
+	ctxR, cancel := etc.NewTimoutContext(ctx)
+	defer cancel()
+	req := pb.AbsDiscountRule{}
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		utils.RespondError(ctx, http.StatusBadRequest, err.Error())
+		return
+	}
+	resp, err := financeClient.CreateDiscountRule(ctxR, &req)
+	if err != nil {
+		utils.RespondError(ctx, http.StatusConflict, err.Error())
+		return
+	}
+	utils.RespondSuccess(ctx, resp.Status, resp.Message)
+}
+
+// UpdateDiscountRule godoc
+// @Summary CEO , FINANCIST
+// @Description Updates a discount rule, isActive=false stops it from being applied. Discounts already given keep their amount and period
+// @Tags discount
+// @Accept json
+// @Produce json
+// @Param request body pb.AbsDiscountRule true "Discount rule"
+// @Success 200 {object} utils.AbsResponse
+// @Failure 400 {object} utils.AbsResponse
+// @Failure 409 {object} utils.AbsResponse
+// @Security Bearer
+// @Router /api/finance/discount/rule/update [put]
+func UpdateDiscountRule(ctx *gin.Context) {
+	ctxR, cancel := etc.NewTimoutContext(ctx)
+	defer cancel()
+	req := pb.AbsDiscountRule{}
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		utils.RespondError(ctx, http.StatusBadRequest, err.Error())
+		return
+	}
+	resp, err := financeClient.UpdateDiscountRule(ctxR, &req)
+	if err != nil {
+		utils.RespondError(ctx, http.StatusConflict, err.Error())
+		return
+	}
+	utils.RespondSuccess(ctx, resp.Status, resp.Message)
+}
+
+// GetDiscountRules godoc
+// @Summary ADMIN , CEO , FINANCIST
+// @Description Discount rules with their usage counts
+// @Tags discount
+// @Produce json
+// @Param includeInactive query bool false "Include inactive rules"
+// @Success 200 {object} pb.GetDiscountRulesResponse
+// @Failure 409 {object} utils.AbsResponse
+// @Security Bearer
+// @Router /api/finance/discount/rule/get-all [get]
+func GetDiscountRules(ctx *gin.Context) {
+	ctxR, cancel := etc.NewTimoutContext(ctx)
+	defer cancel()
+	resp, err := financeClient.GetDiscountRules(ctxR, ctx.Query("includeInactive") == "true")
+	if err != nil {
+		utils.RespondError(ctx, http.StatusConflict, err.Error())
+		return
+	}
+	ctx.JSON(http.StatusOK, resp)
+}
+
+// ApplyDiscountRules godoc
+// @Summary ADMIN , CEO , FINANCIST
+// @Description Applies the most generous matching sibling, multi-course or promo code rule to the student in the group. Nothing is applied when the student already has a discount in the group
+// @Tags discount
+// @Accept json
+// @Produce json
+// @Param request body pb.ApplyDiscountRulesRequest true "Student, group and optional promo code"
+// @Success 200 {object} pb.ApplyDiscountRulesResponse
+// @Failure 400 {object} utils.AbsResponse
+// @Failure 409 {object} utils.AbsResponse
+// @Security Bearer
+// @Router /api/finance/discount/rule/apply [post]
+func ApplyDiscountRules(ctx *gin.Context) {
+	ctxR, cancel := etc.NewTimoutContext(ctx)
+	defer cancel()
+	req := pb.ApplyDiscountRulesRequest{}
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		utils.RespondError(ctx, http.StatusBadRequest, err.Error())
+		return
+	}
+	user, err := utils.GetUserFromContext(ctx)
+	if err != nil {
+		utils.RespondError(ctx, http.StatusInternalServerError, err.Error())
+		return
+	}
+	req.ActionById = user.Id
+	req.ActionByName = user.Name
+	resp, err := financeClient.ApplyDiscountRules(ctxR, &req)
+	if err != nil {
+		utils.RespondError(ctx, http.StatusConflict, err.Error())
+		return
+	}
+	ctx.JSON(http.StatusOK, resp)
+}
+
+// ApplyDiscountTemplate godoc
+// @Summary ADMIN , CEO , FINANCIST
+// @Description Gives the student a discount from the chosen rule starting at startDate (today by default)
+// @Tags discount
+// @Accept json
+// @Produce json
+// @Param request body pb.ApplyDiscountTemplateRequest true "Rule, student and group"
+// @Success 200 {object} pb.ApplyDiscountRulesResponse
+// @Failure 400 {object} utils.AbsResponse
+// @Failure 409 {object} utils.AbsResponse
+// @Security Bearer
+// @Router /api/finance/discount/rule/apply-template [post]
+func ApplyDiscountTemplate(ctx *gin.Context) {
+	ctxR, cancel := etc.NewTimoutContext(ctx)
+	defer cancel()
+	req := pb.ApplyDiscountTemplateRequest{}
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		utils.RespondError(ctx, http.StatusBadRequest, err.Error())
+		return
+	}
+	user, err := utils.GetUserFromContext(ctx)
+	if err != nil {
+		utils.RespondError(ctx, http.StatusInternalServerError, err.Error())
+		return
+	}
+	req.ActionById = user.Id
+	req.ActionByName = user.Name
+	resp, err := financeClient.ApplyDiscountTemplate(ctxR, &req)
+	if err != nil {
+		utils.RespondError(ctx, http.StatusConflict, err.Error())
+		return
+	}
+	ctx.JSON(http.StatusOK, resp)
+}
+
+// CreateChargeItem godoc
+// @Summary CEO , ADMIN , FINANCIST
+// @Description Adds a chargeable item to the catalog. Physical items keep stock and can not be sold beyond it. Returns the item id in message
+// @Tags charge
+// @Accept json
+// @Produce json
+// @Param request body pb.CreateChargeItemRequest true "Charge item"
+// @Success 200 {object} utils.AbsResponse
+// @Failure 400 {object} utils.AbsResponse
+// @Failure 409 {object} utils.AbsResponse
+// @Security Bearer
+// @Router /api/finance/charge/item/create [post]
+func CreateChargeItem(ctx *gin.Context) {
+	ctxR, cancel := etc.NewTimoutContext(ctx)
+	defer cancel()
+	req := pb.CreateChargeItemRequest{}
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		utils.RespondError(ctx, http.StatusBadRequest, err.Error())
+		return
+	}
+	resp, err := financeClient.CreateChargeItem(ctxR, &req)
+	if err != nil {
+		utils.RespondError(ctx, http.StatusConflict, err.Error())
+		return
+	}
+	utils.RespondSuccess(ctx, resp.Status, resp.Message)
+}
+
+// UpdateChargeItem godoc
+// @Summary CEO , ADMIN , FINANCIST
+// @Description Updates a catalog item, stock is replaced with the given value. isActive=false stops selling the item
+// @Tags charge
+// @Accept json
+// @Produce json
+// @Param request body pb.AbsChargeItem true "Charge item"
+// @Success 200 {object} utils.AbsResponse
+// @Failure 400 {object} utils.AbsResponse
+// @Failure 409 {object} utils.AbsResponse
+// @Security Bearer
+// @Router /api/finance/charge/item/update [put]
+func UpdateChargeItem(ctx *gin.Context) {
+	ctxR, cancel := etc.NewTimoutContext(ctx)
+	defer cancel()
+	req := pb.AbsChargeItem{}
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		utils.RespondError(ctx, http.StatusBadRequest, err.Error())
+		return
+	}
+	resp, err := financeClient.UpdateChargeItem(ctxR, &req)
+	if err != nil {
+		utils.RespondError(ctx, http.StatusConflict, err.Error())
+		return
+	}
+	utils.RespondSuccess(ctx, resp.Status, resp.Message)
+}
+
+// GetChargeItems godoc
+// @Summary CEO , ADMIN , FINANCIST
+// @Description Catalog of chargeable items with the current stock
+// @Tags charge
+// @Produce json
+// @Param includeInactive query bool false "Include inactive items"
+// @Success 200 {object} pb.GetChargeItemsResponse
+// @Failure 409 {object} utils.AbsResponse
+// @Security Bearer
+// @Router /api/finance/charge/item/get-all [get]
+func GetChargeItems(ctx *gin.Context) {
+	ctxR, cancel := etc.NewTimoutContext(ctx)
+	defer cancel()
+	resp, err := financeClient.GetChargeItems(ctxR, ctx.Query("includeInactive") == "true")
+	if err != nil {
+		utils.RespondError(ctx, http.StatusConflict, err.Error())
+		return
+	}
+	ctx.JSON(http.StatusOK, resp)
+}
+
+// ChargeStudent godoc
+// @Summary CEO , ADMIN , FINANCIST
+// @Description Sells one or more catalog items to a student. The total is taken off the student balance as one TAKE_OFF payment, returning that payment puts the items back to stock. Returns the charge id in message
+// @Tags charge
+// @Accept json
+// @Produce json
+// @Param request body pb.ChargeStudentRequest true "Charge"
+// @Success 200 {object} utils.AbsResponse
+// @Failure 400 {object} utils.AbsResponse
+// @Failure 409 {object} utils.AbsResponse
+// @Security Bearer
+// @Router /api/finance/charge/student [post]
+func ChargeStudent(ctx *gin.Context) {
+	ctxR, cancel := etc.NewTimoutContext(ctx)
+	defer cancel()
+	user, err := utils.GetUserFromContext(ctx)
+	if err != nil {
+		utils.RespondError(ctx, http.StatusUnauthorized, err.Error())
+		return
+	}
+	req := pb.ChargeStudentRequest{}
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		utils.RespondError(ctx, http.StatusBadRequest, err.Error())
+		return
+	}
+	req.ActionById = user.Id
+	req.ActionByName = user.Name
+	resp, err := financeClient.ChargeStudent(ctxR, &req)
+	if err != nil {
+		utils.RespondError(ctx, http.StatusConflict, err.Error())
+		return
+	}
+	utils.RespondSuccess(ctx, resp.Status, resp.Message)
+}
+
+// GetStudentCharges godoc
+// @Summary CEO , ADMIN , FINANCIST
+// @Description Item sales charged to a student, newest first
+// @Tags charge
+// @Produce json
+// @Param studentId path string true "Student ID"
+// @Success 200 {object} pb.GetStudentChargesResponse
+// @Failure 409 {object} utils.AbsResponse
+// @Security Bearer
+// @Router /api/finance/charge/student/{studentId} [get]
+func GetStudentCharges(ctx *gin.Context) {
+	ctxR, cancel := etc.NewTimoutContext(ctx)
+	defer cancel()
+	resp, err := financeClient.GetStudentCharges(ctxR, ctx.Param("studentId"))
+	if err != nil {
+		utils.RespondError(ctx, http.StatusConflict, err.Error())
+		return
+	}
+	ctx.JSON(http.StatusOK, resp)
+}
+
+// GetChargeItemSalesReport godoc
+// @Summary CEO , FINANCIST
+// @Description Sold quantity, amount, sale count and buyer count per catalog item in the period
+// @Tags charge
+// @Produce json
+// @Param from query string false "Start date (YYYY-MM-DD)"
+// @Param to query string false "End date (YYYY-MM-DD)"
+// @Success 200 {object} pb.GetChargeItemSalesReportResponse
+// @Failure 409 {object} utils.AbsResponse
+// @Security Bearer
+// @Router /api/finance/charge/report [get]
+func GetChargeItemSalesReport(ctx *gin.Context) {
+	ctxR, cancel := etc.NewTimoutContext(ctx)
+	defer cancel()
+	resp, err := financeClient.GetChargeItemSalesReport(ctxR, ctx.Query("from"), ctx.Query("to"))
+	if err != nil {
+		utils.RespondError(ctx, http.StatusConflict, err.Error())
+		return
+	}
+	ctx.JSON(http.StatusOK, resp)
+}
@@ -431,7 +431,7 @@ func GetAllLead(ctx *gin.Context) {
 // @Param request body pb.ChangeToSetRequest true "Request to change set to group"
 // @Success 200 {object} utils.AbsResponse
 // @Failure 400 {object} utils.AbsResponse
-// @Failure 409 {object} utils.AbsResponse
+// @Failure 409 {object} utils.ScheduleConflictResponse "Room or teacher already booked, send force to create the group anyway"
 // @Router /api/set/change-to-group [patch]
 func ChangeToSet(ctx *gin.Context) {
 	ctxR, cancel := etc.NewTimoutContext(ctx)
@@ -442,8 +442,18 @@ func ChangeToSet(ctx *gin.Context) {
 		utils.RespondError(ctx, http.StatusBadRequest, err.Error())
 		return
 	}
+	user, err := utils.GetUserFromContext(ctx)
+	if err != nil {
+		utils.RespondError(ctx, http.StatusUnauthorized, err.Error())
+		return
+	}
+	req.ActionById = user.Id
+	req.ActionByName = user.Name
 	resp, err := leadClient.ChangeSetToGroup(ctxR, &req)
 	if err != nil {
+		if utils.RespondScheduleConflict(ctx, err) {
+			return
+		}
 		utils.RespondError(ctx, http.StatusConflict, err.Error())
 		return
 	}
@@ -7,7 +7,7 @@ import (
 	"github.com/gin-gonic/gin"
 )
 
-func EducationRoutes(api *gin.RouterGroup, userClient *client.UserClient) {
+func EducationRoutes(api *gin.RouterGroup, userClient *client.UserClient, educationClient *client.EducationClient) {
 	api.GET("/common-information-company", etc.AuthMiddleware([]string{"ADMIN", "CEO", "FINANCIST"}, userClient), handlers.GetCommonInformationCompany)
 	api.GET("/get-chart-income", etc.AuthMiddleware([]string{"CEO", "FINANCIST"}, userClient), handlers.GetChartIncome)
 	api.GET("/get-table-groups", etc.AuthMiddleware([]string{"CEO", "ADMIN", "TEACHER", "FINANCIST"}, userClient), handlers.GetTableGroups)
@@ -59,6 +59,54 @@ func EducationRoutes(api *gin.RouterGroup, userClient *client.UserClient) {
 		room.GET("/get-all", etc.AuthMiddleware([]string{"ADMIN", "CEO", "FINANCIST"}, userClient), handlers.GetAllRoom)
 	}
 
+	holiday := api.Group("/holiday")
+	{
+		holiday.POST("/create", etc.AuthMiddleware([]string{"ADMIN", "CEO"}, userClient), handlers.CreateHoliday)
+		holiday.PUT("/update", etc.AuthMiddleware([]string{"ADMIN", "CEO"}, userClient), handlers.UpdateHoliday)
+		holiday.DELETE("/delete/:id", etc.AuthMiddleware([]string{"ADMIN", "CEO"}, userClient), handlers.DeleteHoliday)
+		holiday.GET("/get-all", etc.AuthMiddleware([]string{"ADMIN", "CEO", "FINANCIST", "TEACHER"}, userClient), handlers.GetHolidays)
+		holiday.GET("/group/:groupId", etc.AuthMiddleware([]string{"ADMIN", "CEO", "FINANCIST", "TEACHER"}, userClient), handlers.GetGroupHolidays)
+	}
+	timetable := api.Group("/timetable")
+	{
+		timetable.GET("/get", etc.AuthMiddleware([]string{"ADMIN", "CEO", "FINANCIST", "TEACHER"}, userClient), handlers.GetTimetable)
+		timetable.POST("/feed/create", etc.AuthMiddleware([]string{"ADMIN", "CEO", "TEACHER"}, userClient), handlers.CreateCalendarFeed)
+		timetable.GET("/feed/get-all", etc.AuthMiddleware([]string{"ADMIN", "CEO", "TEACHER"}, userClient), handlers.GetCalendarFeeds)
+		timetable.DELETE("/feed/revoke/:id", etc.AuthMiddleware([]string{"ADMIN", "CEO"}, userClient), handlers.RevokeCalendarFeed)
+		timetable.GET("/feed/ics/:file", handlers.GetCalendarFeedIcs)
+	}
+	lesson := api.Group("/lesson")
+	{
+		lesson.GET("/get-all/:groupId", etc.AuthMiddleware([]string{"ADMIN", "CEO", "FINANCIST", "TEACHER"}, userClient), handlers.GetLessons)
+		lesson.GET("/get-by-id/:id", etc.AuthMiddleware([]string{"ADMIN", "CEO", "FINANCIST", "TEACHER"}, userClient), handlers.GetLessonById)
+		lesson.PUT("/update", etc.AuthMiddleware([]string{"ADMIN", "CEO", "TEACHER"}, userClient), handlers.UpdateLesson)
+	}
+	studentAuth := api.Group("/student-auth")
+	{
+		studentAuth.POST("/send-code", handlers.SendStudentLoginCode)
+		studentAuth.POST("/verify", handlers.VerifyStudentLoginCode)
+		studentAuth.GET("/me", etc.StudentAuthMiddleware(educationClient), handlers.GetStudentSession)
+	}
+	notification := api.Group("/notification")
+	{
+		notification.GET("/get-all", etc.AuthMiddleware([]string{"ADMIN", "CEO", "FINANCIST", "TEACHER"}, userClient), handlers.GetNotifications)
+		notification.PUT("/read", etc.AuthMiddleware([]string{"ADMIN", "CEO", "FINANCIST", "TEACHER"}, userClient), handlers.MarkNotificationsRead)
+	}
+	waitlist := api.Group("/waitlist")
+	{
+		waitlist.GET("/group/:groupId", etc.AuthMiddleware([]string{"ADMIN", "CEO", "FINANCIST"}, userClient), handlers.GetGroupWaitlist)
+		waitlist.PUT("/move", etc.AuthMiddleware([]string{"ADMIN", "CEO", "FINANCIST"}, userClient), handlers.MoveWaitlistEntry)
+		waitlist.DELETE("/cancel/:id", etc.AuthMiddleware([]string{"ADMIN", "CEO", "FINANCIST"}, userClient), handlers.CancelWaitlistEntry)
+		waitlist.POST("/accept", etc.AuthMiddleware([]string{"ADMIN", "CEO", "FINANCIST"}, userClient), handlers.AcceptWaitlistOffer)
+	}
+	checkIn := api.Group("/check-in")
+	{
+		checkIn.GET("/code/:lessonId", etc.AuthMiddleware([]string{"ADMIN", "CEO", "TEACHER"}, userClient), handlers.GetCheckInCode)
+		checkIn.POST("/scan", etc.StudentAuthMiddleware(educationClient), handlers.CheckIn)
+		checkIn.GET("/lesson/:lessonId", etc.AuthMiddleware([]string{"ADMIN", "CEO", "TEACHER"}, userClient), handlers.GetLessonCheckIns)
+		checkIn.POST("/confirm", etc.AuthMiddleware([]string{"ADMIN", "CEO", "TEACHER"}, userClient), handlers.ConfirmCheckIns)
+	}
+
 	course := api.Group("/course")
 	{
 		course.POST("/create", etc.AuthMiddleware([]string{"ADMIN", "CEO", "FINANCIST"}, userClient), handlers.CreateCourse)
@@ -66,6 +114,11 @@ func EducationRoutes(api *gin.RouterGroup, userClient *client.UserClient) {
 		course.DELETE("/delete/:id", etc.AuthMiddleware([]string{"ADMIN", "CEO", "FINANCIST"}, userClient), handlers.DeleteCourse)
 		course.GET("/get-all", etc.AuthMiddleware([]string{"ADMIN", "CEO", "SUPER_CEO", "FINANCIST"}, userClient), handlers.GetAllCourse)
 		course.GET("/get-by-id/:id", etc.AuthMiddleware([]string{"ADMIN", "CEO", "FINANCIST"}, userClient), handlers.GetCourseById)
+		course.GET("/billing/:id", etc.AuthMiddleware([]string{"ADMIN", "CEO", "FINANCIST"}, userClient), handlers.GetCourseBilling)
+		course.PUT("/billing", etc.AuthMiddleware([]string{"ADMIN", "CEO", "FINANCIST"}, userClient), handlers.UpdateCourseBilling)
+		course.POST("/price", etc.AuthMiddleware([]string{"ADMIN", "CEO", "FINANCIST"}, userClient), handlers.ScheduleCoursePrice)
+		course.GET("/price/:id", etc.AuthMiddleware([]string{"ADMIN", "CEO", "FINANCIST"}, userClient), handlers.GetCoursePriceHistory)
+		course.PUT("/price/lock", etc.AuthMiddleware([]string{"ADMIN", "CEO", "FINANCIST"}, userClient), handlers.SetEnrollmentPriceLock)
 	}
 
 	group := api.Group("/group")
@@ -79,11 +132,31 @@ func EducationRoutes(api *gin.RouterGroup, userClient *client.UserClient) {
 		group.POST("/transfer-date", etc.AuthMiddleware([]string{"ADMIN", "CEO", "FINANCIST"}, userClient), handlers.TransferLessonDate)
 		group.GET("/get-by-teacher/:teacherId", etc.AuthMiddleware([]string{"ADMIN", "CEO", "TEACHER", "FINANCIST"}, userClient), handlers.GetInformationByTeacher)
 		group.GET("/left-after-trial/:from/:to", etc.AuthMiddleware([]string{"ADMIN", "CEO", "FINANCIST"}, userClient), handlers.LeftAfterTrial)
+		group.PUT("/billing-mode", etc.AuthMiddleware([]string{"ADMIN", "CEO", "FINANCIST"}, userClient), handlers.UpdateGroupBillingMode)
+		group.PUT("/max-students", etc.AuthMiddleware([]string{"ADMIN", "CEO", "FINANCIST"}, userClient), handlers.UpdateGroupMaxStudents)
+		group.POST("/check-conflicts", etc.AuthMiddleware([]string{"ADMIN", "CEO", "FINANCIST"}, userClient), handlers.CheckScheduleConflicts)
+		group.GET("/conflict-overrides", etc.AuthMiddleware([]string{"ADMIN", "CEO"}, userClient), handlers.GetScheduleConflictOverrides)
 	}
 
 	attendance := api.Group("/attendance")
 	{
 		attendance.POST("/set", etc.AuthMiddleware([]string{"ADMIN", "CEO", "TEACHER", "FINANCIST"}, userClient), handlers.SetAttendance)
+		attendance.POST("/set-group", etc.AuthMiddleware([]string{"ADMIN", "CEO", "TEACHER", "FINANCIST"}, userClient), handlers.SetGroupAttendance)
+		attendance.POST("/status/create", etc.AuthMiddleware([]string{"ADMIN", "CEO"}, userClient), handlers.CreateAttendanceStatus)
+		attendance.PUT("/status/update", etc.AuthMiddleware([]string{"ADMIN", "CEO"}, userClient), handlers.UpdateAttendanceStatus)
+		attendance.DELETE("/status/delete/:id", etc.AuthMiddleware([]string{"ADMIN", "CEO"}, userClient), handlers.DeleteAttendanceStatus)
+		attendance.GET("/status/get-all", etc.AuthMiddleware([]string{"ADMIN", "CEO", "FINANCIST", "TEACHER"}, userClient), handlers.GetAttendanceStatuses)
+		attendance.GET("/policy", etc.AuthMiddleware([]string{"ADMIN", "CEO", "FINANCIST", "TEACHER"}, userClient), handlers.GetAttendancePolicy)
+		attendance.PUT("/policy", etc.AuthMiddleware([]string{"CEO"}, userClient), handlers.UpdateAttendancePolicy)
+		attendance.POST("/unlock/request", etc.AuthMiddleware([]string{"TEACHER"}, userClient), handlers.RequestAttendanceUnlock)
+		attendance.GET("/unlock/get-all", etc.AuthMiddleware([]string{"ADMIN", "CEO", "TEACHER"}, userClient), handlers.GetAttendanceUnlockRequests)
+		attendance.PUT("/unlock/review", etc.AuthMiddleware([]string{"ADMIN", "CEO"}, userClient), handlers.ReviewAttendanceUnlock)
+		attendance.GET("/analytics/rates", etc.AuthMiddleware([]string{"ADMIN", "CEO", "FINANCIST", "TEACHER"}, userClient), handlers.GetAttendanceRates)
+		attendance.GET("/analytics/streaks", etc.AuthMiddleware([]string{"ADMIN", "CEO", "FINANCIST", "TEACHER"}, userClient), handlers.GetAbsenceStreaks)
+		attendance.GET("/analytics/trend", etc.AuthMiddleware([]string{"ADMIN", "CEO", "FINANCIST", "TEACHER"}, userClient), handlers.GetAttendanceTrend)
+		attendance.GET("/at-risk/policy", etc.AuthMiddleware([]string{"ADMIN", "CEO"}, userClient), handlers.GetAtRiskPolicy)
+		attendance.PUT("/at-risk/policy", etc.AuthMiddleware([]string{"CEO"}, userClient), handlers.UpdateAtRiskPolicy)
+		attendance.GET("/at-risk/get-all", etc.AuthMiddleware([]string{"ADMIN", "CEO", "TEACHER"}, userClient), handlers.GetAtRiskStudents)
 		attendance.POST("/get-attendance", etc.AuthMiddleware([]string{"ADMIN", "CEO", "TEACHER", "FINANCIST"}, userClient), handlers.GetAttendance)
 	}
 
@@ -97,6 +170,8 @@ func EducationRoutes(api *gin.RouterGroup, userClient *client.UserClient) {
 		student.DELETE("/delete/:id", etc.AuthMiddleware([]string{"ADMIN", "CEO", "FINANCIST"}, userClient), handlers.DeleteStudent)
 		student.POST("/add-to-group", etc.AuthMiddleware([]string{"ADMIN", "CEO", "FINANCIST"}, userClient), handlers.AddStudentToGroup)
 		student.PUT("/change-condition", etc.AuthMiddleware([]string{"ADMIN", "CEO", "FINANCIST"}, userClient), handlers.ChangeConditionStudent)
+		student.POST("/lesson-package/sell", etc.AuthMiddleware([]string{"ADMIN", "CEO", "FINANCIST"}, userClient), handlers.SellLessonPackage)
+		student.GET("/lesson-package/:studentId", etc.AuthMiddleware([]string{"ADMIN", "CEO", "FINANCIST"}, userClient), handlers.GetLessonPackages)
 
 		studentNote := student.Group("/note")
 		{
@@ -16,12 +16,18 @@ func FinanceRoutes(api *gin.RouterGroup, userClient *client.UserClient) {
 			discount.POST("/create", etc.AuthMiddleware([]string{"ADMIN", "CEO", "FINANCIST"}, userClient), handlers.CreateDiscount)
 			discount.DELETE("/delete", etc.AuthMiddleware([]string{"ADMIN", "CEO", "FINANCIST"}, userClient), handlers.DeleteDiscount)
 			discount.GET("/history/:userId", etc.AuthMiddleware([]string{"ADMIN", "CEO", "FINANCIST"}, userClient), handlers.GetHistoryDiscount)
+			discount.POST("/rule/create", etc.AuthMiddleware([]string{"CEO", "FINANCIST"}, userClient), handlers.CreateDiscountRule)
+			discount.PUT("/rule/update", etc.AuthMiddleware([]string{"CEO", "FINANCIST"}, userClient), handlers.UpdateDiscountRule)
+			discount.GET("/rule/get-all", etc.AuthMiddleware([]string{"ADMIN", "CEO", "FINANCIST"}, userClient), handlers.GetDiscountRules)
+			discount.POST("/rule/apply", etc.AuthMiddleware([]string{"ADMIN", "CEO", "FINANCIST"}, userClient), handlers.ApplyDiscountRules)
+			discount.POST("/rule/apply-template", etc.AuthMiddleware([]string{"ADMIN", "CEO", "FINANCIST"}, userClient), handlers.ApplyDiscountTemplate)
 		}
 		category := finance.Group("/category")
 		{
 			category.POST("/create", etc.AuthMiddleware([]string{"ADMIN", "CEO", "FINANCIST"}, userClient), handlers.CreateCategory)
 			category.DELETE("/delete/:categoryId", etc.AuthMiddleware([]string{"ADMIN", "CEO", "FINANCIST"}, userClient), handlers.DeleteCategory)
 			category.GET("/get-all", etc.AuthMiddleware([]string{"ADMIN", "CEO", "FINANCIST"}, userClient), handlers.GetAllCategories)
+			category.PUT("/budget", etc.AuthMiddleware([]string{"CEO", "FINANCIST"}, userClient), handlers.SetCategoryBudget)
 		}
 		expense := finance.Group("/expense")
 		{
@@ -29,6 +35,20 @@ func FinanceRoutes(api *gin.RouterGroup, userClient *client.UserClient) {
 			expense.DELETE("/delete/:id", etc.AuthMiddleware([]string{"ADMIN", "CEO", "FINANCIST"}, userClient), handlers.DeleteExpense)
 			expense.GET("/get-all-information/:from/:to", etc.AuthMiddleware([]string{"ADMIN", "CEO", "FINANCIST"}, userClient), handlers.GetAllInformation)
 			expense.GET("/get-chart-diagram/:from/:to", etc.AuthMiddleware([]string{"ADMIN", "CEO", "FINANCIST"}, userClient), handlers.GetChartDiagram)
+			expense.POST("/recurring/create", etc.AuthMiddleware([]string{"CEO", "FINANCIST"}, userClient), handlers.CreateRecurringExpense)
+			expense.GET("/recurring/get-all", etc.AuthMiddleware([]string{"ADMIN", "CEO", "FINANCIST"}, userClient), handlers.GetRecurringExpenses)
+			expense.DELETE("/recurring/delete/:id", etc.AuthMiddleware([]string{"CEO", "FINANCIST"}, userClient), handlers.DeleteRecurringExpense)
+			expense.POST("/recurring/post", etc.AuthMiddleware([]string{"CEO", "FINANCIST"}, userClient), handlers.PostRecurringExpenses)
+			expense.GET("/budget-alerts", etc.AuthMiddleware([]string{"CEO", "FINANCIST"}, userClient), handlers.GetBudgetAlerts)
+			expense.GET("/get-by-id/:id", etc.AuthMiddleware([]string{"ADMIN", "CEO", "FINANCIST"}, userClient), handlers.GetExpenseById)
+			expense.PUT("/submit/:id", etc.AuthMiddleware([]string{"ADMIN", "CEO", "FINANCIST"}, userClient), handlers.SubmitExpense)
+			expense.PUT("/approve/:id", etc.AuthMiddleware([]string{"CEO", "FINANCIST"}, userClient), handlers.ApproveExpense)
+			expense.PUT("/reject/:id", etc.AuthMiddleware([]string{"CEO", "FINANCIST"}, userClient), handlers.RejectExpense)
+			expense.PUT("/mark-paid/:id", etc.AuthMiddleware([]string{"CEO", "FINANCIST"}, userClient), handlers.MarkExpensePaid)
+			expense.POST("/receipt", etc.AuthMiddleware([]string{"ADMIN", "CEO", "FINANCIST"}, userClient), handlers.AddExpenseReceipt)
+			expense.DELETE("/receipt/:id", etc.AuthMiddleware([]string{"ADMIN", "CEO", "FINANCIST"}, userClient), handlers.DeleteExpenseReceipt)
+			expense.GET("/approval-setting", etc.AuthMiddleware([]string{"ADMIN", "CEO", "FINANCIST"}, userClient), handlers.GetExpenseApprovalSetting)
+			expense.PUT("/approval-setting", etc.AuthMiddleware([]string{"CEO"}, userClient), handlers.UpdateExpenseApprovalSetting)
 		}
 		payment := finance.Group("/payment")
 		{
@@ -50,5 +70,79 @@ func FinanceRoutes(api *gin.RouterGroup, userClient *client.UserClient) {
 			salary.DELETE("/delete/:teacherID", etc.AuthMiddleware([]string{"CEO", "FINANCIST"}, userClient), handlers.DeleteTeacherSalary)
 			salary.GET("/calculate/:from/:to", etc.AuthMiddleware([]string{"CEO", "FINANCIST"}, userClient), handlers.CalculateSalary)
 		}
+		period := finance.Group("/period")
+		{
+			period.POST("/close", etc.AuthMiddleware([]string{"CEO", "FINANCIST"}, userClient), handlers.ClosePeriod)
+			period.POST("/reopen", etc.AuthMiddleware([]string{"CEO"}, userClient), handlers.ReopenPeriod)
+			period.GET("/get-all", etc.AuthMiddleware([]string{"CEO", "FINANCIST"}, userClient), handlers.GetAllPeriods)
+			period.GET("/history", etc.AuthMiddleware([]string{"CEO", "FINANCIST"}, userClient), handlers.GetPeriodHistory)
+		}
+		payroll := finance.Group("/payroll")
+		{
+			payroll.POST("/create", etc.AuthMiddleware([]string{"CEO", "FINANCIST"}, userClient), handlers.CreatePayrollRun)
+			payroll.GET("/get-all", etc.AuthMiddleware([]string{"CEO", "FINANCIST"}, userClient), handlers.GetPayrollRuns)
+			payroll.GET("/get-by-id/:runId", etc.AuthMiddleware([]string{"CEO", "FINANCIST"}, userClient), handlers.GetPayrollRunById)
+			payroll.DELETE("/delete/:runId", etc.AuthMiddleware([]string{"CEO", "FINANCIST"}, userClient), handlers.DeletePayrollRun)
+			payroll.POST("/adjustment/add", etc.AuthMiddleware([]string{"CEO", "FINANCIST"}, userClient), handlers.AddPayrollAdjustment)
+			payroll.DELETE("/adjustment/delete/:id", etc.AuthMiddleware([]string{"CEO", "FINANCIST"}, userClient), handlers.DeletePayrollAdjustment)
+			payroll.POST("/approve/:runId", etc.AuthMiddleware([]string{"CEO"}, userClient), handlers.ApprovePayrollRun)
+			payroll.POST("/pay", etc.AuthMiddleware([]string{"CEO", "FINANCIST"}, userClient), handlers.PayPayrollRun)
+			payroll.GET("/payslip/:runId/:teacherId", etc.AuthMiddleware([]string{"CEO", "FINANCIST"}, userClient), handlers.GetPayslip)
+			payroll.GET("/payslip/:runId/:teacherId/print", etc.AuthMiddleware([]string{"CEO", "FINANCIST"}, userClient), handlers.PrintPayslip)
+			payroll.GET("/my-payslips", etc.AuthMiddleware([]string{"TEACHER"}, userClient), handlers.GetMyPayslips)
+			payroll.GET("/my-payslips/:runId/print", etc.AuthMiddleware([]string{"TEACHER"}, userClient), handlers.PrintMyPayslip)
+		}
+		installment := finance.Group("/installment")
+		{
+			installment.POST("/create", etc.AuthMiddleware([]string{"CEO", "ADMIN", "FINANCIST"}, userClient), handlers.CreateInstallmentPlan)
+			installment.GET("/get-all", etc.AuthMiddleware([]string{"CEO", "ADMIN", "FINANCIST"}, userClient), handlers.GetInstallmentPlans)
+			installment.GET("/get-by-id/:planId", etc.AuthMiddleware([]string{"CEO", "ADMIN", "FINANCIST"}, userClient), handlers.GetInstallmentPlanById)
+			installment.PUT("/cancel/:planId", etc.AuthMiddleware([]string{"CEO", "FINANCIST"}, userClient), handlers.CancelInstallmentPlan)
+			installment.GET("/overdue", etc.AuthMiddleware([]string{"CEO", "ADMIN", "FINANCIST"}, userClient), handlers.GetOverdueInstallments)
+		}
+		collection := finance.Group("/collection")
+		{
+			collection.GET("/aging/:page/:size", etc.AuthMiddleware([]string{"CEO", "ADMIN", "FINANCIST"}, userClient), handlers.GetDebtAging)
+			collection.POST("/assign", etc.AuthMiddleware([]string{"CEO", "FINANCIST"}, userClient), handlers.AssignDebtor)
+			collection.POST("/activity", etc.AuthMiddleware([]string{"CEO", "ADMIN", "FINANCIST"}, userClient), handlers.AddCollectionActivity)
+			collection.GET("/activity/:studentId", etc.AuthMiddleware([]string{"CEO", "ADMIN", "FINANCIST"}, userClient), handlers.GetCollectionActivities)
+			collection.GET("/setting", etc.AuthMiddleware([]string{"CEO", "FINANCIST"}, userClient), handlers.GetCollectionSetting)
+			collection.PUT("/setting", etc.AuthMiddleware([]string{"CEO"}, userClient), handlers.UpdateCollectionSetting)
+			collection.POST("/run", etc.AuthMiddleware([]string{"CEO", "FINANCIST"}, userClient), handlers.RunCollections)
+		}
+		vendor := finance.Group("/vendor")
+		{
+			vendor.POST("/create", etc.AuthMiddleware([]string{"ADMIN", "CEO", "FINANCIST"}, userClient), handlers.CreateVendor)
+			vendor.PUT("/update", etc.AuthMiddleware([]string{"ADMIN", "CEO", "FINANCIST"}, userClient), handlers.UpdateVendor)
+			vendor.GET("/get-all", etc.AuthMiddleware([]string{"ADMIN", "CEO", "FINANCIST"}, userClient), handlers.GetVendors)
+			vendor.GET("/spend-history/:vendorId", etc.AuthMiddleware([]string{"CEO", "FINANCIST"}, userClient), handlers.GetVendorSpendHistory)
+		}
+		report := finance.Group("/report")
+		{
+			report.GET("/pnl", etc.AuthMiddleware([]string{"CEO", "FINANCIST"}, userClient), handlers.GetProfitAndLoss)
+		}
+		export := finance.Group("/export")
+		{
+			export.GET("/1c", etc.AuthMiddleware([]string{"CEO", "FINANCIST"}, userClient), handlers.ExportOneC)
+			export.GET("/1c/setting", etc.AuthMiddleware([]string{"CEO", "FINANCIST"}, userClient), handlers.GetOneCSetting)
+			export.PUT("/1c/setting", etc.AuthMiddleware([]string{"CEO", "FINANCIST"}, userClient), handlers.UpdateOneCSetting)
+		}
+		reconciliation := finance.Group("/reconciliation")
+		{
+			reconciliation.POST("/import", etc.AuthMiddleware([]string{"CEO", "ADMIN", "FINANCIST"}, userClient), handlers.ImportStatement)
+			reconciliation.GET("/sessions/:page/:size", etc.AuthMiddleware([]string{"CEO", "ADMIN", "FINANCIST"}, userClient), handlers.GetReconciliationSessions)
+			reconciliation.GET("/session/:id", etc.AuthMiddleware([]string{"CEO", "ADMIN", "FINANCIST"}, userClient), handlers.GetReconciliationSession)
+			reconciliation.POST("/create-payments", etc.AuthMiddleware([]string{"CEO", "ADMIN", "FINANCIST"}, userClient), handlers.CreateMissingPayments)
+			reconciliation.PUT("/resolve", etc.AuthMiddleware([]string{"CEO", "ADMIN", "FINANCIST"}, userClient), handlers.ResolveReconciliationLine)
+		}
+		charge := finance.Group("/charge")
+		{
+			charge.POST("/item/create", etc.AuthMiddleware([]string{"CEO", "ADMIN", "FINANCIST"}, userClient), handlers.CreateChargeItem)
+			charge.PUT("/item/update", etc.AuthMiddleware([]string{"CEO", "ADMIN", "FINANCIST"}, userClient), handlers.UpdateChargeItem)
+			charge.GET("/item/get-all", etc.AuthMiddleware([]string{"CEO", "ADMIN", "FINANCIST"}, userClient), handlers.GetChargeItems)
+			charge.POST("/student", etc.AuthMiddleware([]string{"CEO", "ADMIN", "FINANCIST"}, userClient), handlers.ChargeStudent)
+			charge.GET("/student/:studentId", etc.AuthMiddleware([]string{"CEO", "ADMIN", "FINANCIST"}, userClient), handlers.GetStudentCharges)
+			charge.GET("/report", etc.AuthMiddleware([]string{"CEO", "FINANCIST"}, userClient), handlers.GetChargeItemSalesReport)
+		}
 	}
 }
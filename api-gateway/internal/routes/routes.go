@@ -7,12 +7,12 @@ import (
 	ginSwagger "github.com/swaggo/gin-swagger"
 )
 
-func SetUpRoutes(r *gin.Engine, userClient *client.UserClient) {
+func SetUpRoutes(r *gin.Engine, userClient *client.UserClient, educationClient *client.EducationClient) {
 	r.GET("/swagger/*any", ginSwagger.WrapHandler(swaggerFiles.Handler))
 	api := r.Group("/api")
 	{
 		LeadRoutes(api, userClient)
-		EducationRoutes(api, userClient)
+		EducationRoutes(api, userClient, educationClient)
 		UserRoutes(api, userClient)
 		FinanceRoutes(api, userClient)
 	}
@@ -0,0 +1,97 @@
+package utils
+
+import (
+	"api-gateway/grpc/proto/pb"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// calendarTimezone is where lessons take place, start times of groups are local times of it
+const calendarTimezone = "Asia/Tashkent"
+
+// TimetableCalendar renders lessons as an iCalendar (RFC 5545) document calendar apps can subscribe to
+func TimetableCalendar(name string, lessons []*pb.TimetableLesson) string {
+	var b strings.Builder
+	line := func(format string, args ...any) {
+		b.WriteString(foldCalendarLine(fmt.Sprintf(format, args...)))
+		b.WriteString("\r\n")
+	}
+	line("BEGIN:VCALENDAR")
+	line("VERSION:2.0")
+	line("PRODID:-//modme//timetable//UZ")
+	line("CALSCALE:GREGORIAN")
+	line("METHOD:PUBLISH")
+	line("X-WR-CALNAME:%s", escapeCalendarText(name))
+	line("X-WR-TIMEZONE:%s", calendarTimezone)
+	line("BEGIN:VTIMEZONE")
+	line("TZID:%s", calendarTimezone)
+	line("BEGIN:STANDARD")
+	line("DTSTART:19700101T000000")
+	line("TZOFFSETFROM:+0500")
+	line("TZOFFSETTO:+0500")
+	line("TZNAME:UZT")
+	line("END:STANDARD")
+	line("END:VTIMEZONE")
+	stamp := time.Now().UTC().Format("20060102T150405Z")
+	for _, lesson := range lessons {
+		start, end, ok := lessonTimes(lesson)
+		if !ok {
+			continue
+		}
+		line("BEGIN:VEVENT")
+		line("UID:%d-%s@modme", lesson.GroupId, strings.ReplaceAll(lesson.Date, "-", ""))
+		line("DTSTAMP:%s", stamp)
+		line("DTSTART;TZID=%s:%s", calendarTimezone, start)
+		line("DTEND;TZID=%s:%s", calendarTimezone, end)
+		line("SUMMARY:%s", escapeCalendarText(lesson.GroupName+" - "+lesson.CourseName))
+		if lesson.RoomName != "" {
+			line("LOCATION:%s", escapeCalendarText(lesson.RoomName))
+		}
+		description := "O'qituvchi: " + lesson.TeacherName
+		if lesson.Transferred {
+			description += "\nKo'chirilgan dars, asl sanasi " + lesson.RealDate
+		}
+		line("DESCRIPTION:%s", escapeCalendarText(description))
+		line("END:VEVENT")
+	}
+	line("END:VCALENDAR")
+	return b.String()
+}
+
+func lessonTimes(lesson *pb.TimetableLesson) (string, string, bool) {
+	date, err := time.Parse("2006-01-02", lesson.Date)
+	if err != nil {
+		return "", "", false
+	}
+	start, err := time.Parse("15:04", lesson.StartTime)
+	if err != nil {
+		return "", "", false
+	}
+	end, err := time.Parse("15:04", lesson.EndTime)
+	if err != nil {
+		end = start.Add(time.Hour)
+	}
+	day := date.Format("20060102")
+	return day + "T" + start.Format("150405"), day + "T" + end.Format("150405"), true
+}
+
+func escapeCalendarText(text string) string {
+	return strings.NewReplacer(`\`, `\\`, ";", `\;`, ",", `\,`, "\n", `\n`).Replace(text)
+}
+
+// foldCalendarLine splits lines longer than 75 octets, continuation lines start with a space
+func foldCalendarLine(line string) string {
+	var b strings.Builder
+	width := 0
+	for _, r := range line {
+		size := len(string(r))
+		if width+size > 75 {
+			b.WriteString("\r\n ")
+			width = 1
+		}
+		b.WriteRune(r)
+		width += size
+	}
+	return b.String()
+}
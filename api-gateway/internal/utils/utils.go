@@ -4,6 +4,8 @@ import (
 	"api-gateway/grpc/proto/pb"
 	"errors"
 	"github.com/gin-gonic/gin"
+	"google.golang.org/grpc/status"
+	"net/http"
 )
 
 // AbsResponse represents an error API response
@@ -26,6 +28,33 @@ func RespondError(ctx *gin.Context, statusCode int32, message string) {
 	})
 }
 
+// ScheduleConflictResponse is returned when a room or teacher is already booked, send the request again with force to
+// save it anyway
+type ScheduleConflictResponse struct {
+	Status    int32                  `json:"statusCode"`
+	Message   string                 `json:"message"`
+	Conflicts []*pb.ScheduleConflict `json:"conflicts"`
+}
+
+// RespondScheduleConflict writes a 409 with the conflicts of err and reports whether err carried any
+func RespondScheduleConflict(ctx *gin.Context, err error) bool {
+	st, ok := status.FromError(err)
+	if !ok {
+		return false
+	}
+	for _, detail := range st.Details() {
+		if conflicts, ok := detail.(*pb.ScheduleConflicts); ok {
+			ctx.JSON(http.StatusConflict, ScheduleConflictResponse{
+				Status:    http.StatusConflict,
+				Message:   st.Message(),
+				Conflicts: conflicts.Conflicts,
+			})
+			return true
+		}
+	}
+	return false
+}
+
 func GetUserFromContext(c *gin.Context) (*pb.GetUserByIdResponse, error) {
 	var userInterface any
 	var exists bool
@@ -40,3 +69,15 @@ func GetUserFromContext(c *gin.Context) (*pb.GetUserByIdResponse, error) {
 	}
 	return user, nil
 }
+
+func GetStudentFromContext(c *gin.Context) (*pb.StudentSession, error) {
+	studentInterface, exists := c.Get("student")
+	if !exists {
+		return nil, errors.New("student not found in Gin context")
+	}
+	student, ok := studentInterface.(*pb.StudentSession)
+	if !ok {
+		return nil, errors.New("error while converting student")
+	}
+	return student, nil
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"education-service/proto/pb"
 	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/emptypb"
 	"strconv"
 )
 
@@ -11,6 +12,10 @@ type FinanceClient struct {
 	discountClient      pb.DiscountServiceClient
 	paymentClient       pb.PaymentServiceClient
 	teacherSalaryClient pb.TeacherSalaryServiceClient
+	periodClient        pb.AccountingPeriodServiceClient
+	installmentClient   pb.InstallmentServiceClient
+	collectionClient    pb.CollectionServiceClient
+	expenseClient       pb.ExpenseServiceClient
 }
 
 func NewFinanceClient(addr string) (*FinanceClient, error) {
@@ -22,7 +27,11 @@ func NewFinanceClient(addr string) (*FinanceClient, error) {
 	discountClient := pb.NewDiscountServiceClient(conn)
 	paymentClient := pb.NewPaymentServiceClient(conn)
 	teacherSalaryClient := pb.NewTeacherSalaryServiceClient(conn)
-	return &FinanceClient{discountClient: discountClient, paymentClient: paymentClient, teacherSalaryClient: teacherSalaryClient}, nil
+	periodClient := pb.NewAccountingPeriodServiceClient(conn)
+	installmentClient := pb.NewInstallmentServiceClient(conn)
+	collectionClient := pb.NewCollectionServiceClient(conn)
+	expenseClient := pb.NewExpenseServiceClient(conn)
+	return &FinanceClient{discountClient: discountClient, paymentClient: paymentClient, teacherSalaryClient: teacherSalaryClient, periodClient: periodClient, installmentClient: installmentClient, collectionClient: collectionClient, expenseClient: expenseClient}, nil
 
 }
 
@@ -38,6 +47,30 @@ func (fc *FinanceClient) GetDiscountByStudentId(ctx context.Context, studentId,
 	return &discountAmount, resp.DiscountOwner
 }
 
+// GetDiscountsByStudentIds returns the running discounts of the students in the group keyed by student id
+func (fc *FinanceClient) GetDiscountsByStudentIds(ctx context.Context, groupId string, studentIds []string) (map[string]*pb.StudentGroupDiscount, error) {
+	resp, err := fc.discountClient.GetDiscountsByStudentIds(ctx, &pb.GetDiscountsByStudentIdsRequest{GroupId: groupId, StudentIds: studentIds})
+	if err != nil {
+		return nil, err
+	}
+	discounts := make(map[string]*pb.StudentGroupDiscount, len(resp.Discounts))
+	for _, discount := range resp.Discounts {
+		discounts[discount.StudentId] = discount
+	}
+	return discounts, nil
+}
+
+func (fc *FinanceClient) ApplyDiscountRules(ctx context.Context, studentId, groupId, promoCode, date, actionById, actionByName string) (*pb.ApplyDiscountRulesResponse, error) {
+	return fc.discountClient.ApplyDiscountRules(ctx, &pb.ApplyDiscountRulesRequest{
+		StudentId:    studentId,
+		GroupId:      groupId,
+		PromoCode:    promoCode,
+		Date:         date,
+		ActionById:   actionById,
+		ActionByName: actionByName,
+	})
+}
+
 func (fc *FinanceClient) PaymentAdd(ctx context.Context, comment, date, method, sum, userId, paymentType, actionById, actionByName, groupId, studentconditiondate string) (*pb.AbsResponse, error) {
 	return fc.paymentClient.PaymentAdd(ctx, &pb.PaymentAddRequest{
 		Comment:              comment,
@@ -53,6 +86,58 @@ func (fc *FinanceClient) PaymentAdd(ctx context.Context, comment, date, method,
 	})
 }
 
+// PaymentTakeOff takes the sum off the student balance and returns the finance payment id
+func (fc *FinanceClient) PaymentTakeOff(ctx context.Context, comment, date, sum, userId, actionById, actionByName, groupId string) (string, error) {
+	resp, err := fc.paymentClient.PaymentTakeOff(ctx, &pb.PaymentAddRequest{
+		Comment:              comment,
+		Date:                 date,
+		Method:               "CASH",
+		Sum:                  sum,
+		UserId:               userId,
+		Type:                 "TAKE_OFF",
+		ActionById:           actionById,
+		ActionByName:         actionByName,
+		GroupId:              groupId,
+		Studentconditiondate: date,
+	})
+	if err != nil {
+		return "", err
+	}
+	return resp.Message, nil
+}
+
+func (fc *FinanceClient) PaymentReturn(ctx context.Context, paymentId, actionById, actionByName string) (*pb.AbsResponse, error) {
+	return fc.paymentClient.PaymentReturn(ctx, &pb.PaymentReturnRequest{PaymentId: paymentId, ActionById: actionById, ActionByName: actionByName})
+}
+
 func (fc *FinanceClient) GetTeacherSalaryByTeacherID(ctx context.Context, teacherId string) (*pb.AbsGetTeachersSalary, error) {
 	return fc.teacherSalaryClient.GetTeacherSalaryByTeacherID(ctx, &pb.DeleteTeacherSalaryRequest{TeacherId: teacherId})
 }
+
+func (fc *FinanceClient) ResolveTeacherSalary(ctx context.Context, teacherId, groupId, courseId, date string) (*pb.AbsGetTeachersSalary, error) {
+	return fc.teacherSalaryClient.ResolveTeacherSalary(ctx, &pb.ResolveTeacherSalaryRequest{TeacherId: teacherId, GroupId: groupId, CourseId: courseId, Date: date})
+}
+
+func (fc *FinanceClient) CheckPeriod(ctx context.Context, date string) (*pb.CheckPeriodResponse, error) {
+	return fc.periodClient.CheckPeriod(ctx, &pb.CheckPeriodRequest{Date: date})
+}
+
+func (fc *FinanceClient) ChargeInstallments(ctx context.Context, studentId, groupId, date string) (*pb.ChargeInstallmentsResponse, error) {
+	return fc.installmentClient.ChargeInstallments(ctx, &pb.ChargeInstallmentsRequest{StudentId: studentId, GroupId: groupId, Date: date})
+}
+
+func (fc *FinanceClient) ApplyInstallmentLateFees(ctx context.Context) (*pb.AbsResponse, error) {
+	return fc.installmentClient.ApplyInstallmentLateFees(ctx, &emptypb.Empty{})
+}
+
+func (fc *FinanceClient) ChargeDueInstallments(ctx context.Context) (*pb.AbsResponse, error) {
+	return fc.installmentClient.ChargeDueInstallments(ctx, &emptypb.Empty{})
+}
+
+func (fc *FinanceClient) RunCollections(ctx context.Context) (*pb.AbsResponse, error) {
+	return fc.collectionClient.RunCollections(ctx, &emptypb.Empty{})
+}
+
+func (fc *FinanceClient) PostRecurringExpenses(ctx context.Context) (*pb.AbsResponse, error) {
+	return fc.expenseClient.PostRecurringExpenses(ctx, &emptypb.Empty{})
+}
@@ -0,0 +1,220 @@
+// Package pricing turns a group schedule into lesson dates and splits monthly course prices over them. It is the only
+// place proration rules live: a month costs the monthly price, every lesson of the month costs an equal share of it and
+// a student is charged or refunded for the lessons that fall inside the enrollment.
+package pricing
+
+import (
+	"errors"
+	"sort"
+	"time"
+)
+
+const dateLayout = "2006-01-02"
+
+// ErrNoLessons is returned when a month has no lessons to split the price over
+var ErrNoLessons = errors.New("no lessons scheduled for the month")
+
+var weekdays = map[string]time.Weekday{
+	"DUSHANBA":   time.Monday,
+	"SESHANBA":   time.Tuesday,
+	"CHORSHANBA": time.Wednesday,
+	"PAYSHANBA":  time.Thursday,
+	"JUMA":       time.Friday,
+	"SHANBA":     time.Saturday,
+	"YAKSHANBA":  time.Sunday,
+}
+
+// dateTypeDays are the weekdays of odd (TOQ) and even (JUFT) day groups saved without a days list
+var dateTypeDays = map[string][]time.Weekday{
+	"TOQ":  {time.Monday, time.Wednesday, time.Friday},
+	"JUFT": {time.Tuesday, time.Thursday, time.Saturday},
+}
+
+// Transfer moves the lesson of RealDate to TransferDate
+type Transfer struct {
+	RealDate     time.Time
+	TransferDate time.Time
+}
+
+// Schedule is when a group has lessons, a zero EndDate means the group has no end
+type Schedule struct {
+	DateType  string
+	Days      []string
+	StartDate time.Time
+	EndDate   time.Time
+	Transfers []Transfer
+	Holidays  []time.Time
+}
+
+// Enrollment bounds the lessons a student pays for, zero dates are open bounds
+type Enrollment struct {
+	From time.Time
+	Till time.Time
+}
+
+// Discount takes Amount off the monthly price for the lessons between From and Till, zero dates are open bounds
+type Discount struct {
+	Amount float64
+	From   time.Time
+	Till   time.Time
+}
+
+// Lesson is one lesson of a month with its share of the monthly price
+type Lesson struct {
+	Date     time.Time
+	Price    float64
+	Discount float64
+	// Enrolled is false for lessons outside the enrollment, they are not part of the quote amount
+	Enrolled bool
+}
+
+// Quote is a month of a group priced for one student
+type Quote struct {
+	Lessons []Lesson
+	// LessonPrice is the monthly price divided by the lessons of the month
+	LessonPrice float64
+	// Amount is what the enrolled lessons cost after discounts, Discount is the part of the discounts used by them
+	Amount   float64
+	Discount float64
+}
+
+// LessonDates returns the lesson dates of the schedule between from and till inclusive, sorted. Holidays are skipped,
+// a transferred lesson takes place on its transfer date only.
+func (s Schedule) LessonDates(from, till time.Time) []time.Time {
+	from, till = day(from), day(till)
+	lessonDays := s.Weekdays()
+	moved := make(map[string]bool, len(s.Transfers))
+	for _, transfer := range s.Transfers {
+		moved[day(transfer.RealDate).Format(dateLayout)] = true
+	}
+	holidays := make(map[string]bool, len(s.Holidays))
+	for _, holiday := range s.Holidays {
+		holidays[day(holiday).Format(dateLayout)] = true
+	}
+
+	seen := make(map[string]bool)
+	var dates []time.Time
+	add := func(date time.Time) {
+		key := date.Format(dateLayout)
+		if !seen[key] {
+			seen[key] = true
+			dates = append(dates, date)
+		}
+	}
+
+	start, end := from, till
+	if !s.StartDate.IsZero() && day(s.StartDate).After(start) {
+		start = day(s.StartDate)
+	}
+	if !s.EndDate.IsZero() && day(s.EndDate).Before(end) {
+		end = day(s.EndDate)
+	}
+	for date := start; !date.After(end); date = date.AddDate(0, 0, 1) {
+		key := date.Format(dateLayout)
+		if lessonDays[date.Weekday()] && !holidays[key] && !moved[key] {
+			add(date)
+		}
+	}
+	for _, transfer := range s.Transfers {
+		date := day(transfer.TransferDate)
+		if !date.Before(from) && !date.After(till) {
+			add(date)
+		}
+	}
+	sort.Slice(dates, func(i, j int) bool { return dates[i].Before(dates[j]) })
+	return dates
+}
+
+// QuoteMonth splits monthlyPrice over the lessons of the month that contains date and prices the ones inside the
+// enrollment, discounts are split the same way over the lessons they cover
+func QuoteMonth(schedule Schedule, monthlyPrice float64, date time.Time, enrollment Enrollment, discounts []Discount) (Quote, error) {
+	monthStart := time.Date(date.Year(), date.Month(), 1, 0, 0, 0, 0, date.Location())
+	monthEnd := monthStart.AddDate(0, 1, -1)
+	dates := schedule.LessonDates(monthStart, monthEnd)
+	if len(dates) == 0 {
+		return Quote{}, ErrNoLessons
+	}
+	count := float64(len(dates))
+	quote := Quote{LessonPrice: monthlyPrice / count}
+	for _, lessonDate := range dates {
+		lesson := Lesson{
+			Date:     lessonDate,
+			Price:    quote.LessonPrice,
+			Enrolled: within(lessonDate, enrollment.From, enrollment.Till),
+		}
+		for _, discount := range discounts {
+			if within(lessonDate, discount.From, discount.Till) {
+				lesson.Discount += discount.Amount / count
+			}
+		}
+		if lesson.Discount > lesson.Price {
+			lesson.Discount = lesson.Price
+		}
+		if lesson.Enrolled {
+			quote.Amount += lesson.Price - lesson.Discount
+			quote.Discount += lesson.Discount
+		}
+		quote.Lessons = append(quote.Lessons, lesson)
+	}
+	return quote, nil
+}
+
+// ProrateMonth is the part of monthlyPrice a month costs when the group ends inside it: the price is cut by the days
+// of the month left after the end date. Months the group runs through cost the full price.
+func ProrateMonth(schedule Schedule, monthlyPrice float64, date time.Time) float64 {
+	if schedule.EndDate.IsZero() {
+		return monthlyPrice
+	}
+	monthStart := time.Date(date.Year(), date.Month(), 1, 0, 0, 0, 0, time.UTC)
+	monthEnd := monthStart.AddDate(0, 1, -1)
+	end := day(schedule.EndDate)
+	if end.Before(monthStart) || !end.Before(monthEnd) {
+		return monthlyPrice
+	}
+	return monthlyPrice * float64(end.Day()) / float64(monthEnd.Day())
+}
+
+// CountLessons is the number of lessons of the schedule between from and till inclusive
+func CountLessons(schedule Schedule, from, till time.Time) int {
+	return len(schedule.LessonDates(from, till))
+}
+
+// Weekdays are the days of the week the group has lessons on
+func (s Schedule) Weekdays() map[time.Weekday]bool {
+	result := make(map[time.Weekday]bool)
+	for _, name := range s.Days {
+		if weekday, ok := weekdays[name]; ok {
+			result[weekday] = true
+		}
+	}
+	if len(result) == 0 {
+		for _, weekday := range dateTypeDays[s.DateType] {
+			result[weekday] = true
+		}
+	}
+	return result
+}
+
+// DayName is the name groups.days uses for a weekday
+func DayName(weekday time.Weekday) string {
+	for name, day := range weekdays {
+		if day == weekday {
+			return name
+		}
+	}
+	return ""
+}
+
+func within(date, from, till time.Time) bool {
+	if !from.IsZero() && date.Before(day(from)) {
+		return false
+	}
+	if !till.IsZero() && date.After(day(till)) {
+		return false
+	}
+	return true
+}
+
+func day(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
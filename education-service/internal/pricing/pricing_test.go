@@ -0,0 +1,320 @@
+package pricing
+
+import (
+	"errors"
+	"math"
+	"testing"
+	"time"
+)
+
+func date(value string) time.Time {
+	parsed, err := time.Parse(dateLayout, value)
+	if err != nil {
+		panic(err)
+	}
+	return parsed
+}
+
+func dates(values ...string) []time.Time {
+	var result []time.Time
+	for _, value := range values {
+		result = append(result, date(value))
+	}
+	return result
+}
+
+func TestLessonDates(t *testing.T) {
+	tests := []struct {
+		name     string
+		schedule Schedule
+		from     string
+		till     string
+		want     []string
+	}{
+		{
+			name:     "odd days without days list",
+			schedule: Schedule{DateType: "TOQ", StartDate: date("2026-01-01")},
+			from:     "2026-03-01",
+			till:     "2026-03-31",
+			want: []string{"2026-03-02", "2026-03-04", "2026-03-06", "2026-03-09", "2026-03-11", "2026-03-13", "2026-03-16",
+				"2026-03-18", "2026-03-20", "2026-03-23", "2026-03-25", "2026-03-27", "2026-03-30"},
+		},
+		{
+			name:     "even days in february",
+			schedule: Schedule{DateType: "JUFT", StartDate: date("2026-01-01")},
+			from:     "2026-02-01",
+			till:     "2026-02-28",
+			want: []string{"2026-02-03", "2026-02-05", "2026-02-07", "2026-02-10", "2026-02-12", "2026-02-14", "2026-02-17",
+				"2026-02-19", "2026-02-21", "2026-02-24", "2026-02-26", "2026-02-28"},
+		},
+		{
+			name:     "days list wins over date type",
+			schedule: Schedule{DateType: "TOQ", Days: []string{"DUSHANBA", "PAYSHANBA"}, StartDate: date("2026-01-01")},
+			from:     "2026-02-01",
+			till:     "2026-02-28",
+			want:     []string{"2026-02-02", "2026-02-05", "2026-02-09", "2026-02-12", "2026-02-16", "2026-02-19", "2026-02-23", "2026-02-26"},
+		},
+		{
+			name:     "range across month boundary",
+			schedule: Schedule{DateType: "TOQ", StartDate: date("2026-01-01")},
+			from:     "2026-02-25",
+			till:     "2026-03-04",
+			want:     []string{"2026-02-25", "2026-02-27", "2026-03-02", "2026-03-04"},
+		},
+		{
+			name:     "group starts mid month",
+			schedule: Schedule{DateType: "TOQ", StartDate: date("2026-03-16")},
+			from:     "2026-03-01",
+			till:     "2026-03-31",
+			want:     []string{"2026-03-16", "2026-03-18", "2026-03-20", "2026-03-23", "2026-03-25", "2026-03-27", "2026-03-30"},
+		},
+		{
+			name:     "group ends mid month",
+			schedule: Schedule{DateType: "TOQ", StartDate: date("2026-01-01"), EndDate: date("2026-03-11")},
+			from:     "2026-03-01",
+			till:     "2026-03-31",
+			want:     []string{"2026-03-02", "2026-03-04", "2026-03-06", "2026-03-09", "2026-03-11"},
+		},
+		{
+			name: "lesson transferred inside the range",
+			schedule: Schedule{DateType: "TOQ", StartDate: date("2026-01-01"),
+				Transfers: []Transfer{{RealDate: date("2026-03-04"), TransferDate: date("2026-03-05")}}},
+			from: "2026-03-01",
+			till: "2026-03-07",
+			want: []string{"2026-03-02", "2026-03-05", "2026-03-06"},
+		},
+		{
+			name: "lesson transferred into the next month",
+			schedule: Schedule{DateType: "TOQ", StartDate: date("2026-01-01"),
+				Transfers: []Transfer{{RealDate: date("2026-03-30"), TransferDate: date("2026-04-01")}}},
+			from: "2026-03-25",
+			till: "2026-04-03",
+			want: []string{"2026-03-25", "2026-03-27", "2026-04-01", "2026-04-03"},
+		},
+		{
+			name:     "holiday is skipped",
+			schedule: Schedule{DateType: "TOQ", StartDate: date("2026-01-01"), Holidays: dates("2026-03-20")},
+			from:     "2026-03-16",
+			till:     "2026-03-22",
+			want:     []string{"2026-03-16", "2026-03-18"},
+		},
+		{
+			name: "lesson transferred onto a holiday still takes place",
+			schedule: Schedule{DateType: "TOQ", StartDate: date("2026-01-01"), Holidays: dates("2026-03-21"),
+				Transfers: []Transfer{{RealDate: date("2026-03-20"), TransferDate: date("2026-03-21")}}},
+			from: "2026-03-16",
+			till: "2026-03-22",
+			want: []string{"2026-03-16", "2026-03-18", "2026-03-21"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.schedule.LessonDates(date(tt.from), date(tt.till))
+			if len(got) != len(tt.want) {
+				t.Fatalf("got %d lessons %v, want %d %v", len(got), got, len(tt.want), tt.want)
+			}
+			for i := range got {
+				if got[i].Format(dateLayout) != tt.want[i] {
+					t.Errorf("lesson %d = %s, want %s", i, got[i].Format(dateLayout), tt.want[i])
+				}
+			}
+			if count := CountLessons(tt.schedule, date(tt.from), date(tt.till)); count != len(tt.want) {
+				t.Errorf("CountLessons = %d, want %d", count, len(tt.want))
+			}
+		})
+	}
+}
+
+func TestQuoteMonth(t *testing.T) {
+	toq := Schedule{DateType: "TOQ", StartDate: date("2026-01-01")}
+	juft := Schedule{DateType: "JUFT", StartDate: date("2026-01-01")}
+	tests := []struct {
+		name         string
+		schedule     Schedule
+		price        float64
+		date         string
+		enrollment   Enrollment
+		discounts    []Discount
+		wantLessons  int
+		wantLesson   float64
+		wantAmount   float64
+		wantDiscount float64
+		wantErr      error
+	}{
+		{
+			name:        "full month",
+			schedule:    toq,
+			price:       1300000,
+			date:        "2026-03-10",
+			wantLessons: 13,
+			wantLesson:  100000,
+			wantAmount:  1300000,
+		},
+		{
+			name:        "joined mid month",
+			schedule:    toq,
+			price:       1300000,
+			date:        "2026-03-01",
+			enrollment:  Enrollment{From: date("2026-03-16")},
+			wantLessons: 13,
+			wantLesson:  100000,
+			wantAmount:  700000,
+		},
+		{
+			name:        "left after the first week",
+			schedule:    toq,
+			price:       1300000,
+			date:        "2026-03-31",
+			enrollment:  Enrollment{Till: date("2026-03-06")},
+			wantLessons: 13,
+			wantLesson:  100000,
+			wantAmount:  300000,
+		},
+		{
+			name:        "last days of february",
+			schedule:    juft,
+			price:       1200000,
+			date:        "2026-02-28",
+			enrollment:  Enrollment{From: date("2026-02-24")},
+			wantLessons: 12,
+			wantLesson:  100000,
+			wantAmount:  300000,
+		},
+		{
+			name:        "group ending mid month splits the price it is given over its remaining lessons",
+			schedule:    Schedule{DateType: "TOQ", StartDate: date("2026-01-01"), EndDate: date("2026-03-11")},
+			price:       1000000,
+			date:        "2026-03-01",
+			wantLessons: 5,
+			wantLesson:  200000,
+			wantAmount:  1000000,
+		},
+		{
+			name: "lesson transferred out of the month",
+			schedule: Schedule{DateType: "TOQ", StartDate: date("2026-01-01"),
+				Transfers: []Transfer{{RealDate: date("2026-03-30"), TransferDate: date("2026-04-01")}}},
+			price:       1200000,
+			date:        "2026-03-01",
+			enrollment:  Enrollment{From: date("2026-03-28")},
+			wantLessons: 12,
+			wantLesson:  100000,
+			wantAmount:  0,
+		},
+		{
+			name:         "monthly discount",
+			schedule:     toq,
+			price:        1300000,
+			date:         "2026-03-01",
+			discounts:    []Discount{{Amount: 260000}},
+			wantLessons:  13,
+			wantLesson:   100000,
+			wantAmount:   1040000,
+			wantDiscount: 260000,
+		},
+		{
+			name:         "discount starting mid month",
+			schedule:     toq,
+			price:        1300000,
+			date:         "2026-03-01",
+			discounts:    []Discount{{Amount: 260000, From: date("2026-03-16")}},
+			wantLessons:  13,
+			wantLesson:   100000,
+			wantAmount:   1160000,
+			wantDiscount: 140000,
+		},
+		{
+			name:         "discount bigger than the price",
+			schedule:     toq,
+			price:        1300000,
+			date:         "2026-03-01",
+			discounts:    []Discount{{Amount: 1000000}, {Amount: 500000}},
+			wantLessons:  13,
+			wantLesson:   100000,
+			wantAmount:   0,
+			wantDiscount: 1300000,
+		},
+		{
+			name:     "month before the group starts",
+			schedule: Schedule{DateType: "TOQ", StartDate: date("2026-04-01")},
+			price:    1300000,
+			date:     "2026-03-01",
+			wantErr:  ErrNoLessons,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			quote, err := QuoteMonth(tt.schedule, tt.price, date(tt.date), tt.enrollment, tt.discounts)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("err = %v, want %v", err, tt.wantErr)
+			}
+			if tt.wantErr != nil {
+				return
+			}
+			if len(quote.Lessons) != tt.wantLessons {
+				t.Errorf("lessons = %d, want %d", len(quote.Lessons), tt.wantLessons)
+			}
+			if math.Abs(quote.LessonPrice-tt.wantLesson) > 0.01 {
+				t.Errorf("lesson price = %.2f, want %.2f", quote.LessonPrice, tt.wantLesson)
+			}
+			if math.Abs(quote.Amount-tt.wantAmount) > 0.01 {
+				t.Errorf("amount = %.2f, want %.2f", quote.Amount, tt.wantAmount)
+			}
+			if math.Abs(quote.Discount-tt.wantDiscount) > 0.01 {
+				t.Errorf("discount = %.2f, want %.2f", quote.Discount, tt.wantDiscount)
+			}
+		})
+	}
+}
+
+func TestProrateMonth(t *testing.T) {
+	tests := []struct {
+		name     string
+		schedule Schedule
+		price    float64
+		date     string
+		want     float64
+	}{
+		{
+			name:     "group without end",
+			schedule: Schedule{DateType: "TOQ", StartDate: date("2026-01-01")},
+			price:    1240000,
+			date:     "2026-03-10",
+			want:     1240000,
+		},
+		{
+			name:     "group ends after the month",
+			schedule: Schedule{DateType: "TOQ", StartDate: date("2026-01-01"), EndDate: date("2026-05-31")},
+			price:    1240000,
+			date:     "2026-03-10",
+			want:     1240000,
+		},
+		{
+			name:     "group ends on the last day of the month",
+			schedule: Schedule{DateType: "TOQ", StartDate: date("2026-01-01"), EndDate: date("2026-03-31")},
+			price:    1240000,
+			date:     "2026-03-10",
+			want:     1240000,
+		},
+		{
+			name:     "group ends mid month",
+			schedule: Schedule{DateType: "TOQ", StartDate: date("2026-01-01"), EndDate: date("2026-03-11")},
+			price:    1240000,
+			date:     "2026-03-01",
+			want:     440000,
+		},
+		{
+			name:     "group ends mid february",
+			schedule: Schedule{DateType: "JUFT", StartDate: date("2026-01-01"), EndDate: date("2026-02-14")},
+			price:    1200000,
+			date:     "2026-02-20",
+			want:     600000,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ProrateMonth(tt.schedule, tt.price, date(tt.date)); math.Abs(got-tt.want) > 0.01 {
+				t.Errorf("ProrateMonth = %.2f, want %.2f", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,486 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"education-service/internal/clients"
+	"education-service/proto/pb"
+	"errors"
+	"fmt"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"math"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	AtRiskAbsenceStreak = "ABSENCE_STREAK"
+	AtRiskLowRate       = "LOW_RATE"
+	AtRiskOpen          = "OPEN"
+	AtRiskResolved      = "RESOLVED"
+
+	defaultMinAbsenceStreak = 2
+	// maxAnalyticsDays keeps an analytics request to about a year
+	maxAnalyticsDays = 366
+)
+
+// attendanceRateKeys are the columns attendance rates are grouped by
+var attendanceRateKeys = map[string]string{
+	"STUDENT": "s.id::text, s.name",
+	"GROUP":   "g.id::text, g.name",
+	"TEACHER": "a.teacher_id::text, ''",
+	"COURSE":  "c.id::text, c.title",
+}
+
+var attendanceTrendIntervals = map[string]string{
+	"DAY":   "day",
+	"WEEK":  "week",
+	"MONTH": "month",
+}
+
+// attendedMark is what every attendance analytic counts as attended: a billable mark, or an absence a makeup made up
+// later. Makeup marks are left out by countedMark so a lesson made up is not counted twice.
+const (
+	attendedMark = `(st.billable OR EXISTS(SELECT 1 FROM attendance m WHERE m.makeup_group_id = a.group_id AND m.student_id = a.student_id AND m.makeup_date = a.attend_date))`
+	countedMark  = `a.makeup_group_id IS NULL`
+)
+
+// attendanceAnalyticsFrom is the attendance analytics count, filtered by company, period, group, teacher and course
+const attendanceAnalyticsFrom = `FROM attendance a
+	JOIN attendance_status st ON st.company_id = a.company_id AND st.code = a.status
+	JOIN groups g ON g.id = a.group_id
+	JOIN students s ON s.id = a.student_id
+	JOIN courses c ON c.id = g.course_id
+	WHERE a.company_id = $1 AND a.attend_date BETWEEN $2 AND $3 AND ` + countedMark + `
+		AND (NULLIF($4, '') IS NULL OR a.group_id::text = $4)
+		AND (NULLIF($5, '') IS NULL OR a.teacher_id::text = $5)
+		AND (NULLIF($6, '') IS NULL OR g.course_id::text = $6)`
+
+type AttendanceAnalyticsRepository struct {
+	db         *sql.DB
+	userClient *clients.UserClient
+	policyRepo *AttendancePolicyRepository
+}
+
+// atRisk is a student the at risk policy flags in a group
+type atRisk struct {
+	studentId   string
+	studentName string
+	groupId     string
+	groupName   string
+	reason      string
+	streak      int32
+	rate        float64
+}
+
+func defaultAtRiskPolicy() *pb.AtRiskPolicy {
+	return &pb.AtRiskPolicy{
+		AbsenceStreak: 3,
+		MinRate:       60,
+		MinLessons:    4,
+		SmsParents:    false,
+	}
+}
+
+func (r *AttendanceAnalyticsRepository) GetAttendanceRates(ctx context.Context, companyId string, req *pb.GetAttendanceRatesRequest) (*pb.GetAttendanceRatesResponse, error) {
+	key, ok := attendanceRateKeys[req.By]
+	if !ok {
+		return nil, status.Errorf(codes.InvalidArgument, "by must be STUDENT, GROUP, TEACHER or COURSE")
+	}
+	from, till, err := analyticsPeriod(req.From, req.Till)
+	if err != nil {
+		return nil, err
+	}
+	teacherId := analyticsTeacher(req.TeacherId, req.ActionById, req.ActionByRole)
+	rows, err := r.db.Query(`SELECT `+key+`, COUNT(*) FILTER (WHERE `+attendedMark+`), COUNT(*) `+attendanceAnalyticsFrom+` GROUP BY 1, 2`,
+		companyId, from, till, req.GroupId, teacherId, req.CourseId)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get attendance rates: %v", err)
+	}
+	defer rows.Close()
+	var response pb.GetAttendanceRatesResponse
+	for rows.Next() {
+		var rate pb.AttendanceRate
+		if err := rows.Scan(&rate.Id, &rate.Name, &rate.Attended, &rate.Total); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to scan attendance rate: %v", err)
+		}
+		rate.Absent = rate.Total - rate.Attended
+		rate.Rate = attendanceRate(rate.Attended, rate.Total)
+		response.Rates = append(response.Rates, &rate)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	if req.By == "TEACHER" && r.userClient != nil {
+		for _, rate := range response.Rates {
+			rate.Name, _ = r.userClient.GetTeacherById(ctx, rate.Id)
+		}
+	}
+	sort.Slice(response.Rates, func(i, j int) bool {
+		return response.Rates[i].Name < response.Rates[j].Name
+	})
+	return &response, nil
+}
+
+func (r *AttendanceAnalyticsRepository) GetAbsenceStreaks(companyId string, req *pb.GetAbsenceStreaksRequest) (*pb.GetAbsenceStreaksResponse, error) {
+	minStreak := req.MinStreak
+	if minStreak <= 0 {
+		minStreak = defaultMinAbsenceStreak
+	}
+	streaks, err := r.absenceStreaks(companyId, req.GroupId, analyticsTeacher(req.TeacherId, req.ActionById, req.ActionByRole), minStreak)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "%v", err)
+	}
+	return &pb.GetAbsenceStreaksResponse{Streaks: streaks}, nil
+}
+
+func (r *AttendanceAnalyticsRepository) GetAttendanceTrend(companyId string, req *pb.GetAttendanceTrendRequest) (*pb.GetAttendanceTrendResponse, error) {
+	unit, ok := attendanceTrendIntervals[req.Interval]
+	if !ok {
+		return nil, status.Errorf(codes.InvalidArgument, "interval must be DAY, WEEK or MONTH")
+	}
+	from, till, err := analyticsPeriod(req.From, req.Till)
+	if err != nil {
+		return nil, err
+	}
+	teacherId := analyticsTeacher(req.TeacherId, req.ActionById, req.ActionByRole)
+	rows, err := r.db.Query(`SELECT date_trunc('`+unit+`', a.attend_date)::date, COUNT(*) FILTER (WHERE `+attendedMark+`), COUNT(*) `+attendanceAnalyticsFrom+` GROUP BY 1 ORDER BY 1`,
+		companyId, from, till, req.GroupId, teacherId, req.CourseId)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get attendance trend: %v", err)
+	}
+	defer rows.Close()
+	var response pb.GetAttendanceTrendResponse
+	for rows.Next() {
+		var (
+			point  pb.AttendanceTrendPoint
+			period time.Time
+		)
+		if err := rows.Scan(&period, &point.Attended, &point.Total); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to scan attendance trend: %v", err)
+		}
+		point.Period = period.Format("2006-01-02")
+		point.Rate = attendanceRate(point.Attended, point.Total)
+		response.Points = append(response.Points, &point)
+	}
+	return &response, rows.Err()
+}
+
+func (r *AttendanceAnalyticsRepository) GetAtRiskPolicy(companyId string) (*pb.AtRiskPolicy, error) {
+	policy := defaultAtRiskPolicy()
+	err := r.db.QueryRow(`SELECT absence_streak, min_rate, min_lessons, sms_parents FROM at_risk_policy WHERE company_id = $1`, companyId).
+		Scan(&policy.AbsenceStreak, &policy.MinRate, &policy.MinLessons, &policy.SmsParents)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return nil, status.Errorf(codes.Internal, "failed to get at risk policy: %v", err)
+	}
+	return policy, nil
+}
+
+func (r *AttendanceAnalyticsRepository) UpdateAtRiskPolicy(companyId string, req *pb.AtRiskPolicy) (*pb.AbsResponse, error) {
+	if req.AbsenceStreak < 0 || req.MinLessons < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "absenceStreak and minLessons cannot be negative")
+	}
+	if req.MinRate < 0 || req.MinRate > 100 {
+		return nil, status.Errorf(codes.InvalidArgument, "minRate must be between 0 and 100")
+	}
+	_, err := r.db.Exec(`INSERT INTO at_risk_policy (company_id, absence_streak, min_rate, min_lessons, sms_parents, updated_by, updated_at)
+		VALUES ($1, $2, $3, $4, $5, NULLIF($6, '')::uuid, NOW())
+		ON CONFLICT (company_id) DO UPDATE SET absence_streak = EXCLUDED.absence_streak, min_rate = EXCLUDED.min_rate, min_lessons = EXCLUDED.min_lessons,
+			sms_parents = EXCLUDED.sms_parents, updated_by = EXCLUDED.updated_by, updated_at = NOW()`,
+		companyId, req.AbsenceStreak, req.MinRate, req.MinLessons, req.SmsParents, req.ActionById)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to update at risk policy: %v", err)
+	}
+	return &pb.AbsResponse{Status: http.StatusOK, Message: "at risk policy updated"}, nil
+}
+
+func (r *AttendanceAnalyticsRepository) GetAtRiskStudents(companyId string, req *pb.GetAtRiskStudentsRequest) (*pb.GetAtRiskStudentsResponse, error) {
+	flagStatus := req.Status
+	if flagStatus == "" {
+		flagStatus = AtRiskOpen
+	}
+	rows, err := r.db.Query(`SELECT ar.id, ar.student_id, s.name, ar.group_id, g.name, ar.reason, COALESCE(ar.absence_streak, 0), COALESCE(ar.attendance_rate, 0),
+			ar.status, ar.flagged_at, ar.resolved_at
+		FROM at_risk_student ar
+		JOIN students s ON s.id = ar.student_id
+		JOIN groups g ON g.id = ar.group_id
+		WHERE ar.company_id = $1 AND ar.status = $2 AND (NULLIF($3, '') IS NULL OR ar.group_id::text = $3) AND (NULLIF($4, '') IS NULL OR g.teacher_id::text = $4)
+		ORDER BY ar.flagged_at DESC`, companyId, flagStatus, req.GroupId, analyticsTeacher("", req.ActionById, req.ActionByRole))
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get at risk students: %v", err)
+	}
+	defer rows.Close()
+	var response pb.GetAtRiskStudentsResponse
+	for rows.Next() {
+		var (
+			student    pb.AtRiskStudent
+			flaggedAt  time.Time
+			resolvedAt sql.NullTime
+		)
+		err := rows.Scan(&student.Id, &student.StudentId, &student.StudentName, &student.GroupId, &student.GroupName, &student.Reason, &student.AbsenceStreak,
+			&student.AttendanceRate, &student.Status, &flaggedAt, &resolvedAt)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to scan at risk student: %v", err)
+		}
+		student.FlaggedAt = flaggedAt.Format("2006-01-02 15:04:05")
+		if resolvedAt.Valid {
+			student.ResolvedAt = resolvedAt.Time.Format("2006-01-02 15:04:05")
+		}
+		response.Students = append(response.Students, &student)
+	}
+	return &response, rows.Err()
+}
+
+// AtRiskAlert is the daily job that flags the students the at risk policy of their company catches, a new flag
+// notifies the teacher and the admins and texts the parents when the company wants it. Flags of students no longer
+// at risk are resolved.
+func (r *AttendanceAnalyticsRepository) AtRiskAlert() {
+	rows, err := r.db.Query(`SELECT id FROM company`)
+	if err != nil {
+		fmt.Println("[AtRiskAlert] failed to fetch companies:", err)
+		return
+	}
+	var companyIds []string
+	for rows.Next() {
+		var companyId string
+		if err := rows.Scan(&companyId); err == nil {
+			companyIds = append(companyIds, companyId)
+		}
+	}
+	rows.Close()
+	for _, companyId := range companyIds {
+		if err := r.flagAtRiskStudents(companyId); err != nil {
+			fmt.Printf("[AtRiskAlert] company %s: %v\n", companyId, err)
+		}
+	}
+}
+
+func (r *AttendanceAnalyticsRepository) flagAtRiskStudents(companyId string) error {
+	policy, err := r.GetAtRiskPolicy(companyId)
+	if err != nil {
+		return err
+	}
+	current := make(map[string]atRisk)
+	if policy.AbsenceStreak > 0 {
+		streaks, err := r.absenceStreaks(companyId, "", "", policy.AbsenceStreak)
+		if err != nil {
+			return err
+		}
+		for _, streak := range streaks {
+			flag := atRisk{studentId: streak.StudentId, studentName: streak.StudentName, groupId: strconv.FormatInt(streak.GroupId, 10), groupName: streak.GroupName,
+				reason: AtRiskAbsenceStreak, streak: streak.Streak}
+			current[flag.studentId+"|"+flag.groupId+"|"+flag.reason] = flag
+		}
+	}
+	if policy.MinRate > 0 {
+		lowRates, err := r.lowMonthRates(companyId, policy)
+		if err != nil {
+			return err
+		}
+		for _, flag := range lowRates {
+			current[flag.studentId+"|"+flag.groupId+"|"+flag.reason] = flag
+		}
+	}
+
+	rows, err := r.db.Query(`SELECT id, student_id, group_id, reason FROM at_risk_student WHERE company_id = $1 AND status = 'OPEN'`, companyId)
+	if err != nil {
+		return fmt.Errorf("error while getting at risk students %v", err)
+	}
+	open := make(map[string]string)
+	for rows.Next() {
+		var id, studentId, groupId, reason string
+		if err := rows.Scan(&id, &studentId, &groupId, &reason); err != nil {
+			rows.Close()
+			return fmt.Errorf("error while scanning at risk student %v", err)
+		}
+		open[studentId+"|"+groupId+"|"+reason] = id
+	}
+	rows.Close()
+	for key, id := range open {
+		flag, stillAtRisk := current[key]
+		if !stillAtRisk {
+			r.db.Exec(`UPDATE at_risk_student SET status = 'RESOLVED', resolved_at = NOW() WHERE id = $1`, id)
+			continue
+		}
+		r.db.Exec(`UPDATE at_risk_student SET absence_streak = NULLIF($1, 0), attendance_rate = NULLIF($2, 0::double precision) WHERE id = $3`, flag.streak, flag.rate, id)
+		delete(current, key)
+	}
+
+	texted := make(map[string]bool)
+	for _, flag := range current {
+		_, err := r.db.Exec(`INSERT INTO at_risk_student (id, student_id, group_id, reason, absence_streak, attendance_rate, company_id)
+			VALUES ($1, $2, $3, $4, NULLIF($5, 0), NULLIF($6, 0::double precision), $7)`,
+			uuid.New(), flag.studentId, flag.groupId, flag.reason, flag.streak, flag.rate, companyId)
+		if err != nil {
+			fmt.Println("[AtRiskAlert] failed to flag student:", err)
+			continue
+		}
+		body := fmt.Sprintf("%s (%s) ketma-ket %d ta darsni qoldirdi", flag.studentName, flag.groupName, flag.streak)
+		if flag.reason == AtRiskLowRate {
+			body = fmt.Sprintf("%s (%s) bu oy darslarning %.0f%% qismiga qatnashdi", flag.studentName, flag.groupName, flag.rate)
+		}
+		var teacherId string
+		r.db.QueryRow(`SELECT teacher_id FROM groups WHERE id = $1`, flag.groupId).Scan(&teacherId)
+		err = createNotification(r.db, companyId, notification{
+			kind:           NotificationAtRiskStudent,
+			title:          "Xavf ostidagi talaba",
+			body:           body,
+			studentId:      flag.studentId,
+			groupId:        flag.groupId,
+			recipientId:    teacherId,
+			recipientRoles: []string{"ADMIN", "CEO"},
+		})
+		if err != nil {
+			fmt.Println("[AtRiskAlert]", err)
+		}
+		// parents get one sms a day for a student whatever the number of rules it broke
+		if policy.SmsParents && !texted[flag.studentId+"|"+flag.groupId] {
+			texted[flag.studentId+"|"+flag.groupId] = true
+			sendActionSms(r.db, companyId, flag.studentId, flag.groupId, "AT_RISK_ALERT",
+				strings.NewReplacer("(STREAK)", strconv.Itoa(int(flag.streak)), "(RATE)", fmt.Sprintf("%.0f", flag.rate)), true)
+		}
+	}
+	return nil
+}
+
+// absenceStreaks counts the lessons in a row each active student of an active group missed up to the last marked
+// one, attended as attendedMark has it.
+func (r *AttendanceAnalyticsRepository) absenceStreaks(companyId, groupId, teacherId string, minStreak int32) ([]*pb.AbsenceStreak, error) {
+	rows, err := r.db.Query(`WITH marks AS (
+			SELECT a.student_id, a.group_id, a.attend_date,
+				`+attendedMark+` AS attended,
+				ROW_NUMBER() OVER (PARTITION BY a.student_id, a.group_id ORDER BY a.attend_date DESC) AS rn
+			FROM attendance a
+			JOIN attendance_status st ON st.company_id = a.company_id AND st.code = a.status
+			JOIN group_students gs ON gs.group_id = a.group_id AND gs.student_id = a.student_id AND gs.condition = 'ACTIVE'
+			JOIN groups g ON g.id = a.group_id
+			WHERE a.company_id = $1 AND `+countedMark+` AND NOT g.is_archived
+				AND (NULLIF($2, '') IS NULL OR a.group_id::text = $2)
+				AND (NULLIF($3, '') IS NULL OR g.teacher_id::text = $3)
+		), streaks AS (
+			SELECT student_id, group_id, COALESCE(MIN(rn) FILTER (WHERE attended), COUNT(*) + 1) - 1 AS streak,
+				MAX(attend_date) FILTER (WHERE NOT attended) AS last_absent
+			FROM marks
+			GROUP BY student_id, group_id
+		)
+		SELECT s.id, s.name, g.id, g.name, sk.streak, sk.last_absent
+		FROM streaks sk
+		JOIN students s ON s.id = sk.student_id
+		JOIN groups g ON g.id = sk.group_id
+		WHERE sk.streak >= $4 AND s.condition = 'ACTIVE'
+		ORDER BY sk.streak DESC, s.name`, companyId, groupId, teacherId, minStreak)
+	if err != nil {
+		return nil, fmt.Errorf("error while getting absence streaks %v", err)
+	}
+	defer rows.Close()
+	var streaks []*pb.AbsenceStreak
+	for rows.Next() {
+		var (
+			streak     pb.AbsenceStreak
+			lastAbsent time.Time
+		)
+		if err := rows.Scan(&streak.StudentId, &streak.StudentName, &streak.GroupId, &streak.GroupName, &streak.Streak, &lastAbsent); err != nil {
+			return nil, fmt.Errorf("error while scanning absence streak %v", err)
+		}
+		streak.LastAbsentDate = lastAbsent.Format("2006-01-02")
+		streaks = append(streaks, &streak)
+	}
+	return streaks, rows.Err()
+}
+
+// lowMonthRates returns the active students whose attendance in a group this month is below the policy, the month
+// is the one of the company attendance policy timezone
+func (r *AttendanceAnalyticsRepository) lowMonthRates(companyId string, policy *pb.AtRiskPolicy) ([]atRisk, error) {
+	attendancePolicy, err := r.policyRepo.GetAttendancePolicy(companyId)
+	if err != nil {
+		return nil, err
+	}
+	location, err := time.LoadLocation(attendancePolicy.Timezone)
+	if err != nil {
+		location = time.UTC
+	}
+	now := time.Now().In(location)
+	monthStart := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, location).Format("2006-01-02")
+	rows, err := r.db.Query(`SELECT s.id, s.name, g.id::text, g.name,
+			COUNT(*) FILTER (WHERE `+attendedMark+`),
+			COUNT(*)
+		FROM attendance a
+		JOIN attendance_status st ON st.company_id = a.company_id AND st.code = a.status
+		JOIN group_students gs ON gs.group_id = a.group_id AND gs.student_id = a.student_id AND gs.condition = 'ACTIVE'
+		JOIN groups g ON g.id = a.group_id
+		JOIN students s ON s.id = a.student_id
+		WHERE a.company_id = $1 AND a.attend_date >= $2 AND `+countedMark+` AND NOT g.is_archived AND s.condition = 'ACTIVE'
+		GROUP BY s.id, s.name, g.id, g.name`, companyId, monthStart)
+	if err != nil {
+		return nil, fmt.Errorf("error while getting attendance rates %v", err)
+	}
+	defer rows.Close()
+	var flags []atRisk
+	for rows.Next() {
+		var (
+			flag            = atRisk{reason: AtRiskLowRate}
+			attended, total int32
+		)
+		if err := rows.Scan(&flag.studentId, &flag.studentName, &flag.groupId, &flag.groupName, &attended, &total); err != nil {
+			return nil, fmt.Errorf("error while scanning attendance rate %v", err)
+		}
+		var low bool
+		if flag.rate, low = lowAttendance(policy, attended, total); low {
+			flags = append(flags, flag)
+		}
+	}
+	return flags, rows.Err()
+}
+
+// lowAttendance tells whether attended out of total marked lessons is below the minimum rate of the policy, fewer
+// lessons than minLessons are too few to judge
+func lowAttendance(policy *pb.AtRiskPolicy, attended, total int32) (float64, bool) {
+	rate := attendanceRate(attended, total)
+	if policy.MinRate <= 0 || total == 0 || total < policy.MinLessons {
+		return rate, false
+	}
+	return rate, rate < float64(policy.MinRate)
+}
+
+// attendanceRate is the percent of the lessons attended rounded to one decimal
+func attendanceRate(attended, total int32) float64 {
+	if total == 0 {
+		return 0
+	}
+	return math.Round(float64(attended)*1000/float64(total)) / 10
+}
+
+func analyticsPeriod(from, till string) (string, string, error) {
+	fromDate, err := time.Parse("2006-01-02", from)
+	if err != nil {
+		return "", "", status.Errorf(codes.InvalidArgument, "invalid from date %s", from)
+	}
+	tillDate, err := time.Parse("2006-01-02", till)
+	if err != nil {
+		return "", "", status.Errorf(codes.InvalidArgument, "invalid till date %s", till)
+	}
+	if tillDate.Before(fromDate) {
+		return "", "", status.Errorf(codes.InvalidArgument, "till can not be before from")
+	}
+	if tillDate.Sub(fromDate) > maxAnalyticsDays*24*time.Hour {
+		return "", "", status.Errorf(codes.InvalidArgument, "analytics range can not be longer than %d days", maxAnalyticsDays)
+	}
+	return from, till, nil
+}
+
+// analyticsTeacher keeps a teacher to its own lessons
+func analyticsTeacher(teacherId, actionById, actionByRole string) string {
+	if actionByRole == "TEACHER" {
+		return actionById
+	}
+	return teacherId
+}
+
+func NewAttendanceAnalyticsRepository(db *sql.DB, userClient *clients.UserClient, policyRepo *AttendancePolicyRepository) *AttendanceAnalyticsRepository {
+	return &AttendanceAnalyticsRepository{db: db, userClient: userClient, policyRepo: policyRepo}
+}
@@ -0,0 +1,32 @@
+package repository
+
+import (
+	"education-service/proto/pb"
+	"testing"
+)
+
+func TestLowAttendance(t *testing.T) {
+	policy := &pb.AtRiskPolicy{MinRate: 60, MinLessons: 4}
+	tests := []struct {
+		name            string
+		policy          *pb.AtRiskPolicy
+		attended, total int32
+		wantRate        float64
+		wantLow         bool
+	}{
+		{name: "below the minimum", policy: policy, attended: 2, total: 5, wantRate: 40, wantLow: true},
+		{name: "at the minimum", policy: policy, attended: 3, total: 5, wantRate: 60},
+		{name: "too few lessons", policy: policy, attended: 0, total: 3, wantRate: 0},
+		{name: "no lessons", policy: policy, attended: 0, total: 0, wantRate: 0},
+		{name: "rule switched off", policy: &pb.AtRiskPolicy{MinLessons: 4}, attended: 1, total: 10, wantRate: 10},
+		{name: "rounded to one decimal", policy: policy, attended: 1, total: 6, wantRate: 16.7, wantLow: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rate, low := lowAttendance(tt.policy, tt.attended, tt.total)
+			if rate != tt.wantRate || low != tt.wantLow {
+				t.Errorf("lowAttendance() = %v, %v, want %v, %v", rate, low, tt.wantRate, tt.wantLow)
+			}
+		})
+	}
+}
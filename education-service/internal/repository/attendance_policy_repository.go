@@ -0,0 +1,236 @@
+package repository
+
+import (
+	"database/sql"
+	"education-service/internal/utils"
+	"education-service/proto/pb"
+	"errors"
+	"fmt"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"net/http"
+	"time"
+)
+
+const (
+	UnlockPending  = "PENDING"
+	UnlockApproved = "APPROVED"
+	UnlockRejected = "REJECTED"
+
+	// defaultUnlockHours is how long an approved unlock keeps a date open when the reviewer does not say
+	defaultUnlockHours = 24
+)
+
+type AttendancePolicyRepository struct {
+	db *sql.DB
+}
+
+// defaultAttendancePolicy keeps the rules attendance had before companies could set them: today, and yesterday until
+// noon, for teachers while CEO and ADMIN mark any date
+func defaultAttendancePolicy() *pb.AttendancePolicy {
+	return &pb.AttendancePolicy{
+		EditDaysBack:  1,
+		CutoffHour:    12,
+		Timezone:      "Asia/Tashkent",
+		AllowFuture:   false,
+		OverrideRoles: []string{"CEO", "ADMIN"},
+	}
+}
+
+func (r *AttendancePolicyRepository) GetAttendancePolicy(companyId string) (*pb.AttendancePolicy, error) {
+	policy := defaultAttendancePolicy()
+	err := r.db.QueryRow(`SELECT edit_days_back, cutoff_hour, timezone, allow_future, override_roles FROM attendance_policy WHERE company_id = $1`, companyId).
+		Scan(&policy.EditDaysBack, &policy.CutoffHour, &policy.Timezone, &policy.AllowFuture, pq.Array(&policy.OverrideRoles))
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return nil, status.Errorf(codes.Internal, "failed to get attendance policy: %v", err)
+	}
+	return policy, nil
+}
+
+func (r *AttendancePolicyRepository) UpdateAttendancePolicy(companyId string, req *pb.AttendancePolicy) (*pb.AbsResponse, error) {
+	if req.EditDaysBack < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "editDaysBack cannot be negative")
+	}
+	if req.CutoffHour < 0 || req.CutoffHour > 24 {
+		return nil, status.Errorf(codes.InvalidArgument, "cutoffHour must be between 0 and 24")
+	}
+	if _, err := time.LoadLocation(req.Timezone); err != nil || req.Timezone == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid timezone %s", req.Timezone)
+	}
+	roles := make([]string, 0, len(req.OverrideRoles))
+	for _, role := range req.OverrideRoles {
+		if role != "CEO" && role != "ADMIN" && role != "FINANCIST" && role != "TEACHER" {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid role %s", role)
+		}
+		roles = append(roles, role)
+	}
+	_, err := r.db.Exec(`INSERT INTO attendance_policy (company_id, edit_days_back, cutoff_hour, timezone, allow_future, override_roles, updated_by, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, NULLIF($7, '')::uuid, NOW())
+		ON CONFLICT (company_id) DO UPDATE SET edit_days_back = EXCLUDED.edit_days_back, cutoff_hour = EXCLUDED.cutoff_hour, timezone = EXCLUDED.timezone,
+			allow_future = EXCLUDED.allow_future, override_roles = EXCLUDED.override_roles, updated_by = EXCLUDED.updated_by, updated_at = NOW()`,
+		companyId, req.EditDaysBack, req.CutoffHour, req.Timezone, req.AllowFuture, pq.Array(roles), req.ActionById)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to update attendance policy: %v", err)
+	}
+	return &pb.AbsResponse{Status: http.StatusOK, Message: "attendance policy updated"}, nil
+}
+
+// CanOverrideAttendancePolicy tells whether role marks attendance regardless of the policy
+func CanOverrideAttendancePolicy(policy *pb.AttendancePolicy, role string) bool {
+	for _, overrideRole := range policy.OverrideRoles {
+		if overrideRole == role {
+			return true
+		}
+	}
+	return false
+}
+
+// CheckAttendanceDate tells whether the policy lets actionById mark attendance of the group on date, a date outside
+// the window can still be marked while an approved unlock request of the teacher keeps it open
+func (r *AttendancePolicyRepository) CheckAttendanceDate(policy *pb.AttendancePolicy, groupId, date, actionById string) error {
+	open, err := attendanceDateOpen(policy, date, time.Now())
+	if err != nil || open {
+		return err
+	}
+	var unlocked bool
+	err = r.db.QueryRow(`SELECT EXISTS(SELECT 1 FROM attendance_unlock_request
+			WHERE group_id::text = $1 AND attend_date = $2 AND teacher_id::text = $3 AND status = 'APPROVED' AND unlocked_until > NOW())`,
+		groupId, date, actionById).Scan(&unlocked)
+	if err != nil {
+		return fmt.Errorf("error while checking attendance unlock %v", err)
+	}
+	if !unlocked {
+		return status.Errorf(codes.FailedPrecondition, "%s sanasi uchun davomat yopilgan, ochish uchun adminga so'rov yuboring", date)
+	}
+	return nil
+}
+
+// attendanceDateOpen applies the edit window of the policy to date at now. Today is always open, a past day stays
+// open until cutoffHour of the day editDaysBack days after it.
+func attendanceDateOpen(policy *pb.AttendancePolicy, date string, now time.Time) (bool, error) {
+	location, err := time.LoadLocation(policy.Timezone)
+	if err != nil {
+		location = time.UTC
+	}
+	attendDate, err := time.ParseInLocation("2006-01-02", date, location)
+	if err != nil {
+		return false, errors.New("invalid attendance date format")
+	}
+	now = now.In(location)
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, location)
+	switch {
+	case attendDate.Equal(today):
+		return true, nil
+	case attendDate.After(today):
+		return policy.AllowFuture, nil
+	}
+	deadline := attendDate.AddDate(0, 0, int(policy.EditDaysBack)).Add(time.Duration(policy.CutoffHour) * time.Hour)
+	return now.Before(deadline), nil
+}
+
+// RequestAttendanceUnlock asks an admin to open a date the policy closed for the teacher
+func (r *AttendancePolicyRepository) RequestAttendanceUnlock(companyId string, req *pb.RequestAttendanceUnlockRequest) (*pb.AbsResponse, error) {
+	if _, err := time.Parse("2006-01-02", req.AttendDate); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid attendance date %s", req.AttendDate)
+	}
+	if !utils.CheckLessonTeacher(r.db, req.GroupId, req.AttendDate, "TEACHER", req.ActionById) {
+		return nil, status.Errorf(codes.PermissionDenied, "bu guruh sizga tegishli emas")
+	}
+	policy, err := r.GetAttendancePolicy(companyId)
+	if err != nil {
+		return nil, err
+	}
+	open, err := attendanceDateOpen(policy, req.AttendDate, time.Now())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	if open {
+		return nil, status.Errorf(codes.FailedPrecondition, "%s sanasi ochiq, davomatni belgilash mumkin", req.AttendDate)
+	}
+	var pending bool
+	err = r.db.QueryRow(`SELECT EXISTS(SELECT 1 FROM attendance_unlock_request
+			WHERE group_id::text = $1 AND attend_date = $2 AND teacher_id::text = $3 AND (status = 'PENDING' OR (status = 'APPROVED' AND unlocked_until > NOW())))`,
+		req.GroupId, req.AttendDate, req.ActionById).Scan(&pending)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to check unlock requests: %v", err)
+	}
+	if pending {
+		return nil, status.Errorf(codes.AlreadyExists, "bu sana uchun so'rov allaqachon yuborilgan")
+	}
+	id := uuid.New()
+	_, err = r.db.Exec(`INSERT INTO attendance_unlock_request (id, group_id, attend_date, teacher_id, teacher_name, reason, company_id)
+		VALUES ($1, $2, $3, $4, $5, NULLIF($6, ''), $7)`,
+		id, req.GroupId, req.AttendDate, req.ActionById, req.ActionByName, req.Reason, companyId)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to create unlock request: %v", err)
+	}
+	return &pb.AbsResponse{Status: http.StatusOK, Message: id.String()}, nil
+}
+
+func (r *AttendancePolicyRepository) GetAttendanceUnlockRequests(companyId string, req *pb.GetAttendanceUnlockRequestsRequest) (*pb.GetAttendanceUnlockRequestsResponse, error) {
+	teacherId := ""
+	if req.ActionByRole == "TEACHER" {
+		teacherId = req.ActionById
+	}
+	rows, err := r.db.Query(`SELECT u.id, u.group_id, g.name, u.attend_date, u.teacher_id, COALESCE(u.teacher_name, ''), COALESCE(u.reason, ''), u.status,
+			COALESCE(u.reviewed_by_name, ''), u.reviewed_at, u.unlocked_until, u.created_at
+		FROM attendance_unlock_request u
+		JOIN groups g ON g.id = u.group_id
+		WHERE u.company_id = $1 AND (NULLIF($2, '') IS NULL OR u.status = $2) AND (NULLIF($3, '') IS NULL OR u.teacher_id::text = $3)
+		ORDER BY u.created_at DESC`, companyId, req.Status, teacherId)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get unlock requests: %v", err)
+	}
+	defer rows.Close()
+	var response pb.GetAttendanceUnlockRequestsResponse
+	for rows.Next() {
+		var (
+			request                   pb.AttendanceUnlockRequest
+			attendDate, createdAt     time.Time
+			reviewedAt, unlockedUntil sql.NullTime
+		)
+		err := rows.Scan(&request.Id, &request.GroupId, &request.GroupName, &attendDate, &request.TeacherId, &request.TeacherName, &request.Reason, &request.Status,
+			&request.ReviewedByName, &reviewedAt, &unlockedUntil, &createdAt)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to scan unlock request: %v", err)
+		}
+		request.AttendDate = attendDate.Format("2006-01-02")
+		request.CreatedAt = createdAt.Format("2006-01-02 15:04:05")
+		if reviewedAt.Valid {
+			request.ReviewedAt = reviewedAt.Time.Format("2006-01-02 15:04:05")
+		}
+		if unlockedUntil.Valid {
+			request.UnlockedUntil = unlockedUntil.Time.Format("2006-01-02 15:04:05")
+		}
+		response.Requests = append(response.Requests, &request)
+	}
+	return &response, rows.Err()
+}
+
+// ReviewAttendanceUnlock approves or rejects a pending request, an approval opens the date for hours
+func (r *AttendancePolicyRepository) ReviewAttendanceUnlock(companyId string, req *pb.ReviewAttendanceUnlockRequest) (*pb.AbsResponse, error) {
+	reviewed, hours := UnlockRejected, req.Hours
+	if req.Approve {
+		reviewed = UnlockApproved
+		if hours <= 0 {
+			hours = defaultUnlockHours
+		}
+	}
+	result, err := r.db.Exec(`UPDATE attendance_unlock_request SET status = $1, reviewed_by_id = NULLIF($2, '')::uuid, reviewed_by_name = $3, reviewed_at = NOW(),
+			unlocked_until = CASE WHEN $1 = 'APPROVED' THEN NOW() + make_interval(hours => $4) END
+		WHERE id::text = $5 AND company_id = $6 AND status = 'PENDING'`,
+		reviewed, req.ActionById, req.ActionByName, hours, req.Id, companyId)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to review unlock request: %v", err)
+	}
+	if updated, _ := result.RowsAffected(); updated == 0 {
+		return nil, status.Errorf(codes.NotFound, "pending unlock request not found")
+	}
+	return &pb.AbsResponse{Status: http.StatusOK, Message: "unlock request " + reviewed}, nil
+}
+
+func NewAttendancePolicyRepository(db *sql.DB) *AttendancePolicyRepository {
+	return &AttendancePolicyRepository{db: db}
+}
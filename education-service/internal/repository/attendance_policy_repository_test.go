@@ -0,0 +1,51 @@
+package repository
+
+import (
+	"education-service/proto/pb"
+	"testing"
+	"time"
+)
+
+func TestAttendanceDateOpen(t *testing.T) {
+	tashkent, err := time.LoadLocation("Asia/Tashkent")
+	if err != nil {
+		t.Skip("timezone database is not available")
+	}
+	policy := func(daysBack, cutoffHour int32, allowFuture bool) *pb.AttendancePolicy {
+		return &pb.AttendancePolicy{EditDaysBack: daysBack, CutoffHour: cutoffHour, Timezone: "Asia/Tashkent", AllowFuture: allowFuture}
+	}
+	morning := time.Date(2025, 3, 12, 9, 0, 0, 0, tashkent)
+	afternoon := time.Date(2025, 3, 12, 15, 0, 0, 0, tashkent)
+	tests := []struct {
+		name   string
+		policy *pb.AttendancePolicy
+		date   string
+		now    time.Time
+		open   bool
+	}{
+		{"today", policy(0, 0, false), "2025-03-12", afternoon, true},
+		{"yesterday before the cutoff", policy(1, 12, false), "2025-03-11", morning, true},
+		{"yesterday after the cutoff", policy(1, 12, false), "2025-03-11", afternoon, false},
+		{"yesterday with today only", policy(0, 24, false), "2025-03-11", morning, false},
+		{"last day of the window closes at midnight", policy(2, 24, false), "2025-03-10", afternoon, true},
+		{"older than the window", policy(2, 24, false), "2025-03-09", afternoon, false},
+		{"future not allowed", policy(1, 12, false), "2025-03-13", morning, false},
+		{"future allowed", policy(1, 12, true), "2025-03-13", morning, true},
+		// 01:00 in Tashkent is still the previous day in UTC, the day is counted in the company timezone
+		{"day of the company timezone", policy(0, 0, false), "2025-03-12", time.Date(2025, 3, 11, 20, 0, 0, 0, time.UTC), true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			open, err := attendanceDateOpen(tt.policy, tt.date, tt.now)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if open != tt.open {
+				t.Errorf("attendanceDateOpen(%s) = %v, want %v", tt.date, open, tt.open)
+			}
+		})
+	}
+	if _, err := attendanceDateOpen(policy(1, 12, false), "12.03.2025", morning); err == nil {
+		t.Error("expected an error for an invalid date")
+	}
+}
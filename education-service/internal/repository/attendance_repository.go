@@ -4,6 +4,7 @@ import (
 	"context"
 	"database/sql"
 	"education-service/internal/clients"
+	"education-service/internal/pricing"
 	"education-service/internal/utils"
 	"education-service/proto/pb"
 	"errors"
@@ -12,6 +13,7 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"sort"
+	"strconv"
 	"time"
 )
 
@@ -67,85 +69,251 @@ func (r *AttendanceRepository) ensureFinanceClient() error {
 func NewAttendanceRepository(db *sql.DB, financeClientChan chan *clients.FinanceClient) *AttendanceRepository {
 	return &AttendanceRepository{db: db, financeClientChan: financeClientChan}
 }
-func (r *AttendanceRepository) CreateAttendance(ctx context.Context, companyId, groupId string, studentId string, teacherId string, attendDate string, status int32, actionById, actionByRole string) error {
+func (r *AttendanceRepository) CreateAttendance(ctx context.Context, companyId, groupId string, studentId string, teacherId string, attendDate string, status int32, makeupGroupId, makeupDate, actionById, actionByRole string) error {
 	if err := r.ensureFinanceClient(); err != nil {
 		return fmt.Errorf("error while ensuring finance client %v", err)
 	}
+	holiday, err := utils.IsGroupHoliday(r.db, groupId, attendDate)
+	if err != nil {
+		return fmt.Errorf("error while checking group holiday %v", err)
+	}
+	if holiday {
+		var transferred bool
+		err := r.db.QueryRow(`SELECT EXISTS(SELECT 1 FROM transfer_lesson WHERE group_id = $1 AND transfer_date = $2)`, groupId, attendDate).Scan(&transferred)
+		if err != nil {
+			return fmt.Errorf("error while checking transferred lesson %v", err)
+		}
+		if !transferred {
+			return fmt.Errorf("%s dam olish kuni, guruhda dars yo'q", attendDate)
+		}
+	}
 
-	var (
-		isDiscounted bool
-		price        float64
-		priceType    string
-		totalCount   int
-		coursePrice  float64
-	)
 	ctx, c := utils.NewTimoutContext(ctx, companyId)
 	defer c()
-	resp, err := r.financeClient.GetTeacherSalaryByTeacherID(ctx, teacherId)
-	if err != nil {
-		return errors.New("error while getting teacher salary information")
+	if err := r.checkPeriodIsOpen(ctx, attendDate); err != nil {
+		return err
 	}
-	if !utils.CheckGroupAndTeacher(r.db, groupId, "TEACHER", teacherId) {
+	if !utils.CheckLessonTeacher(r.db, groupId, attendDate, "TEACHER", teacherId) {
 		return fmt.Errorf("oops this teacherid not the same for this group")
 	}
+	statuses, err := companyAttendanceStatuses(r.db, companyId)
+	if err != nil {
+		return err
+	}
+	markStatus, err := markableStatus(statuses, status)
+	if err != nil {
+		return err
+	}
+	makeup := makeupLink{groupId: makeupGroupId, date: makeupDate}
+	if err := r.checkMakeup(companyId, groupId, studentId, attendDate, markStatus, makeup, statuses); err != nil {
+		return err
+	}
+	// the teacher of the lesson is paid for it, a substitute when one taught it
+	lessonId, lessonTeacherId, err := ensureLesson(r.db, groupId, attendDate)
+	if err != nil {
+		return err
+	}
+	billing, err := getGroupBilling(r.db, groupId)
+	if err != nil {
+		return err
+	}
+	salary, err := r.resolveLessonSalary(ctx, groupId, studentId, lessonTeacherId, attendDate, billing)
+	if err != nil {
+		return err
+	}
+	query := `
+     	INSERT INTO attendance (is_discounted, discount_owner,  price , group_id , student_id , teacher_id, attend_date, status , created_at , created_by , creator_role , company_id , price_type , total_count , course_price, lesson_id, makeup_group_id, makeup_date)
+        VALUES ($1, $2, $3, $4, $5 , $6 , $7 , $8, $9 , $10 , $11 , $12 , $13 , $14, $15, $16, NULLIF($17, '')::bigint, NULLIF($18, '')::date)
+        ON CONFLICT DO NOTHING
+    `
+	result, err := r.db.Exec(query, salary.isDiscounted, salary.discountOwner, salary.price, groupId, studentId, lessonTeacherId, attendDate, status, time.Now(), actionById, actionByRole, companyId, salary.priceType, salary.totalCount, salary.coursePrice, lessonId,
+		makeup.groupId, makeup.date)
+	if err != nil {
+		return fmt.Errorf("error while creating attendance %v", err)
+	}
+	if inserted, _ := result.RowsAffected(); inserted == 1 && markStatus.billable {
+		billGroupId, billDate, billBilling, err := r.billingTarget(groupId, attendDate, makeup, billing)
+		if err == nil {
+			err = r.billAttendance(ctx, companyId, billGroupId, studentId, billDate, billBilling)
+		}
+		if err != nil {
+			r.db.Exec(`DELETE FROM attendance WHERE group_id = $1 AND student_id = $2 AND attend_date = $3`, groupId, studentId, attendDate)
+			return err
+		}
+	}
+	return nil
+}
+
+// lessonSalary is what the teacher of a lesson earns for one student attending it
+type lessonSalary struct {
+	isDiscounted  bool
+	discountOwner string
+	price         float64
+	priceType     string
+	totalCount    int
+	coursePrice   float64
+}
+
+// resolveLessonSalary prices an attendance for teacherId with the salary settings of that teacher
+func (r *AttendanceRepository) resolveLessonSalary(ctx context.Context, groupId, studentId, teacherId, attendDate string, billing groupBilling) (lessonSalary, error) {
+	resp, err := r.teacherSalaryRule(ctx, groupId, teacherId, attendDate)
+	if err != nil {
+		return lessonSalary{}, err
+	}
 	discountAmount, discountOwner := r.financeClient.GetDiscountByStudentId(ctx, studentId, groupId)
+	return r.priceLessonSalary(groupId, studentId, attendDate, billing, resp, discountAmount, discountOwner)
+}
 
-	if resp.Type == "FIXED" {
-		priceType = "FIXED"
-		totalCount = int(resp.Amount)
-		f := float64(totalCount)
+// teacherSalaryRule is how teacherId is paid for a lesson of the group on attendDate
+func (r *AttendanceRepository) teacherSalaryRule(ctx context.Context, groupId, teacherId, attendDate string) (*pb.AbsGetTeachersSalary, error) {
+	var courseId string
+	if err := r.db.QueryRow(`SELECT course_id FROM groups WHERE id = $1`, groupId).Scan(&courseId); err != nil {
+		return nil, fmt.Errorf("error while getting group course %v", err)
+	}
+	resp, err := r.financeClient.ResolveTeacherSalary(ctx, teacherId, groupId, courseId, attendDate)
+	if err != nil {
+		return nil, errors.New("error while getting teacher salary information")
+	}
+	return resp, nil
+}
+
+// priceLessonSalary applies the salary rule of the teacher and the discount of the student to one attendance
+func (r *AttendanceRepository) priceLessonSalary(groupId, studentId, attendDate string, billing groupBilling, resp *pb.AbsGetTeachersSalary, discountAmount *float64, discountOwner string) (lessonSalary, error) {
+	var err error
+	salary := lessonSalary{discountOwner: discountOwner}
+
+	if resp.Type == "PER_LESSON" {
+		// teacher is paid a flat amount per attended lesson, student discounts do not affect it
+		salary.priceType = "PER_LESSON"
+		salary.totalCount = int(resp.Amount)
+		salary.price = float64(resp.Amount)
+	} else if resp.Type == "FIXED" {
+		salary.priceType = "FIXED"
+		salary.totalCount = int(resp.Amount)
+		f := float64(salary.totalCount)
 		if discountAmount != nil {
-			isDiscounted = true
-			priceType = "FIXED_DISCOUNT"
+			salary.isDiscounted = true
+			salary.priceType = "FIXED_DISCOUNT"
 		}
 		if discountOwner != "TEACHER" {
-			if err = utils.CalculateMoneyForLesson(r.db, &price, studentId, groupId, attendDate, nil, &coursePrice, &f); err != nil {
-				return errors.New("error while getting calculate money")
+			if err = utils.CalculateMoneyForLesson(r.db, &salary.price, studentId, groupId, attendDate, nil, &salary.coursePrice, &f); err != nil {
+				return salary, errors.New("error while getting calculate money")
 			}
-			totalCount = int(f)
+			salary.totalCount = int(f)
 		} else {
-			if err = utils.CalculateMoneyForLesson(r.db, &price, studentId, groupId, attendDate, discountAmount, &coursePrice, &f); err != nil {
-				return errors.New("error while getting calculate money")
+			if err = utils.CalculateMoneyForLesson(r.db, &salary.price, studentId, groupId, attendDate, discountAmount, &salary.coursePrice, &f); err != nil {
+				return salary, errors.New("error while getting calculate money")
 			}
-			totalCount = int(f)
+			salary.totalCount = int(f)
 		}
 	} else {
-		priceType = "PERCENT"
-		totalCount = int(resp.Amount)
+		salary.priceType = "PERCENT"
+		salary.totalCount = int(resp.Amount)
 		if discountAmount != nil {
-			isDiscounted = true
-			priceType = "PERCENT_DISCOUNT"
+			salary.isDiscounted = true
+			salary.priceType = "PERCENT_DISCOUNT"
 		}
 		if discountOwner != "TEACHER" {
-			if err = utils.CalculateMoneyForLesson(r.db, &price, studentId, groupId, attendDate, nil, &coursePrice, nil); err != nil {
-				return errors.New("error while getting calculate money")
+			if err = utils.CalculateMoneyForLesson(r.db, &salary.price, studentId, groupId, attendDate, nil, &salary.coursePrice, nil); err != nil {
+				return salary, errors.New("error while getting calculate money")
 			}
 		} else {
-			if err = utils.CalculateMoneyForLesson(r.db, &price, studentId, groupId, attendDate, discountAmount, &coursePrice, nil); err != nil {
-				return errors.New("error while getting calculate money")
+			if err = utils.CalculateMoneyForLesson(r.db, &salary.price, studentId, groupId, attendDate, discountAmount, &salary.coursePrice, nil); err != nil {
+				return salary, errors.New("error while getting calculate money")
 			}
 		}
+		if billing.mode != BillingMonthly {
+			// the teacher percent is taken from what the student actually pays for the lesson
+			salary.price = billing.perLessonPrice()
+			salary.coursePrice = salary.price
+		}
 	}
-	query := `
-     	INSERT INTO attendance (is_discounted, discount_owner,  price , group_id , student_id , teacher_id, attend_date, status , created_at , created_by , creator_role , company_id , price_type , total_count , course_price)
-        VALUES ($1, $2, $3, $4, $5 , $6 , $7 , $8, $9 , $10 , $11 , $12 , $13 , $14, $15)
-        ON CONFLICT DO NOTHING
-    `
-	_, err = r.db.Exec(query, isDiscounted, discountOwner, price, groupId, studentId, teacherId, attendDate, status, time.Now(), actionById, actionByRole, companyId, priceType, totalCount, coursePrice)
+	return salary, nil
+}
+
+// repriceLesson moves the salary of everyone who attended a lesson to teacherId, the teacher who taught it
+func (r *AttendanceRepository) repriceLesson(ctx context.Context, companyId, groupId, lessonId, attendDate, teacherId string) error {
+	rows, err := r.db.Query(`SELECT student_id FROM attendance WHERE group_id = $1 AND attend_date = $2`, groupId, attendDate)
 	if err != nil {
-		return fmt.Errorf("error while creating attendance %v", err)
+		return fmt.Errorf("error while getting lesson attendance %v", err)
+	}
+	var studentIds []string
+	for rows.Next() {
+		var studentId string
+		if err := rows.Scan(&studentId); err != nil {
+			rows.Close()
+			return fmt.Errorf("error while scanning lesson attendance %v", err)
+		}
+		studentIds = append(studentIds, studentId)
+	}
+	rows.Close()
+	if len(studentIds) == 0 {
+		return nil
+	}
+	if err := r.ensureFinanceClient(); err != nil {
+		return fmt.Errorf("error while ensuring finance client %v", err)
+	}
+	ctx, c := utils.NewTimoutContext(ctx, companyId)
+	defer c()
+	if err := r.checkPeriodIsOpen(ctx, attendDate); err != nil {
+		return err
+	}
+	billing, err := getGroupBilling(r.db, groupId)
+	if err != nil {
+		return err
+	}
+	salaries := make(map[string]lessonSalary, len(studentIds))
+	for _, studentId := range studentIds {
+		if salaries[studentId], err = r.resolveLessonSalary(ctx, groupId, studentId, teacherId, attendDate, billing); err != nil {
+			return err
+		}
+	}
+	tx, err := r.db.Begin()
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction %v", err)
+	}
+	defer func() {
+		if err != nil {
+			tx.Rollback()
+		} else {
+			tx.Commit()
+		}
+	}()
+	for studentId, salary := range salaries {
+		_, err = tx.Exec(`UPDATE attendance SET teacher_id = $1, is_discounted = $2, discount_owner = $3, price = $4, price_type = $5,
+				total_count = $6, course_price = $7, lesson_id = $8
+			WHERE group_id = $9 AND student_id = $10 AND attend_date = $11`,
+			teacherId, salary.isDiscounted, salary.discountOwner, salary.price, salary.priceType, salary.totalCount, salary.coursePrice, lessonId,
+			groupId, studentId, attendDate)
+		if err != nil {
+			return fmt.Errorf("error while repricing attendance %v", err)
+		}
 	}
-	return err
+	return nil
 }
-func (r *AttendanceRepository) DeleteAttendance(groupId string, studentId string, teacherId string, attendDate string) error {
-	if !utils.CheckGroupAndTeacher(r.db, groupId, "TEACHER", teacherId) {
+func (r *AttendanceRepository) DeleteAttendance(ctx context.Context, companyId, groupId string, studentId string, teacherId string, attendDate string) error {
+	if err := r.ensureFinanceClient(); err != nil {
+		return fmt.Errorf("error while ensuring finance client %v", err)
+	}
+	if !utils.CheckLessonTeacher(r.db, groupId, attendDate, "TEACHER", teacherId) {
 		return fmt.Errorf("oops this teacherid not the same for this group")
 	}
+	ctx, c := utils.NewTimoutContext(ctx, companyId)
+	defer c()
+	if err := r.checkPeriodIsOpen(ctx, attendDate); err != nil {
+		return err
+	}
+	if err := r.checkNotMadeUp(groupId, studentId, attendDate); err != nil {
+		return err
+	}
+	if err := r.unbillAttendance(ctx, companyId, groupId, studentId, attendDate); err != nil {
+		return err
+	}
 	query := `
         DELETE FROM attendance
         WHERE group_id = $1
           AND student_id = $2
-          AND teacher_id = $3
+          AND (teacher_id = $3 OR EXISTS(SELECT 1 FROM groups g WHERE g.id = group_id AND g.teacher_id = $3))
           AND attend_date = $4
     `
 	result, err := r.db.Exec(query, groupId, studentId, teacherId, attendDate)
@@ -161,9 +329,527 @@ func (r *AttendanceRepository) DeleteAttendance(groupId string, studentId string
 	}
 	return nil
 }
+
+// SetGroupAttendance marks the roster of a group for one date. The lesson, the salary rule of its teacher and the
+// discounts of the students are resolved once for the whole roster and the attendance rows are written in one
+// transaction, every mark gets its own result so one bad student does not fail the rest.
+func (r *AttendanceRepository) SetGroupAttendance(ctx context.Context, companyId string, req *pb.SetGroupAttendanceRequest) (*pb.SetGroupAttendanceResponse, error) {
+	if err := r.ensureFinanceClient(); err != nil {
+		return nil, fmt.Errorf("error while ensuring finance client %v", err)
+	}
+	holiday, err := utils.IsGroupHoliday(r.db, req.GroupId, req.AttendDate)
+	if err != nil {
+		return nil, fmt.Errorf("error while checking group holiday %v", err)
+	}
+	if holiday {
+		var transferred bool
+		err := r.db.QueryRow(`SELECT EXISTS(SELECT 1 FROM transfer_lesson WHERE group_id = $1 AND transfer_date = $2)`, req.GroupId, req.AttendDate).Scan(&transferred)
+		if err != nil {
+			return nil, fmt.Errorf("error while checking transferred lesson %v", err)
+		}
+		if !transferred {
+			return nil, fmt.Errorf("%s dam olish kuni, guruhda dars yo'q", req.AttendDate)
+		}
+	}
+	ctx, c := utils.NewTimoutContext(ctx, companyId)
+	defer c()
+	if err := r.checkPeriodIsOpen(ctx, req.AttendDate); err != nil {
+		return nil, err
+	}
+	if !utils.CheckLessonTeacher(r.db, req.GroupId, req.AttendDate, "TEACHER", req.TeacherId) {
+		return nil, fmt.Errorf("oops this teacherid not the same for this group")
+	}
+	lessonId, lessonTeacherId, err := ensureLesson(r.db, req.GroupId, req.AttendDate)
+	if err != nil {
+		return nil, err
+	}
+	billing, err := getGroupBilling(r.db, req.GroupId)
+	if err != nil {
+		return nil, err
+	}
+	salaryRule, err := r.teacherSalaryRule(ctx, req.GroupId, lessonTeacherId, req.AttendDate)
+	if err != nil {
+		return nil, err
+	}
+	var studentIds []string
+	for _, mark := range req.Marks {
+		studentIds = append(studentIds, mark.StudentId)
+	}
+	discounts, err := r.financeClient.GetDiscountsByStudentIds(ctx, req.GroupId, studentIds)
+	if err != nil {
+		return nil, fmt.Errorf("error while getting student discounts %v", err)
+	}
+	members, err := r.groupMembers(req.GroupId, studentIds)
+	if err != nil {
+		return nil, err
+	}
+	marked, err := r.markedOnLesson(req.GroupId, req.AttendDate)
+	if err != nil {
+		return nil, err
+	}
+	statuses, err := companyAttendanceStatuses(r.db, companyId)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &pb.SetGroupAttendanceResponse{}
+	fail := func(mark *pb.GroupAttendanceMark, message string) {
+		response.Results = append(response.Results, &pb.GroupAttendanceResult{StudentId: mark.StudentId, Status: mark.Status, Message: message})
+	}
+	// marks that pass the checks are written together, an attendance that stops being billed or is billed as another
+	// absence is unbilled before and billed again after the write. The unbilled ones are billed back when the write fails.
+	var (
+		pending  []*pb.GroupAttendanceMark
+		seen     = make(map[string]bool)
+		unbilled = make(map[string]bool)
+	)
+	for _, mark := range req.Marks {
+		current, isMarked := marked[mark.StudentId]
+		makeup := makeupLink{groupId: mark.MakeupGroupId, date: mark.MakeupDate}
+		if seen[mark.StudentId] {
+			fail(mark, "student is marked twice")
+			continue
+		}
+		seen[mark.StudentId] = true
+		if mark.Status == -1 && !isMarked {
+			fail(mark, "attendance record not found")
+			continue
+		}
+		var markStatus attendanceStatus
+		if mark.Status != -1 {
+			if markStatus, err = markableStatus(statuses, mark.Status); err != nil {
+				fail(mark, err.Error())
+				continue
+			}
+			if err := r.checkMakeup(companyId, req.GroupId, mark.StudentId, req.AttendDate, markStatus, makeup, statuses); err != nil {
+				fail(mark, err.Error())
+				continue
+			}
+		}
+		if !members[mark.StudentId] && makeup.groupId == "" {
+			fail(mark, "student is not in the group")
+			continue
+		}
+		if isMarked && current.confirmed && current.status == mark.Status && current.makeup == makeup {
+			response.Results = append(response.Results, &pb.GroupAttendanceResult{StudentId: mark.StudentId, Status: mark.Status, Success: true, Message: "unchanged"})
+			continue
+		}
+		if isMarked {
+			if err := r.checkNotMadeUp(req.GroupId, mark.StudentId, req.AttendDate); err != nil {
+				fail(mark, err.Error())
+				continue
+			}
+			if statuses[current.status].billable && (!markStatus.billable || current.makeup != makeup) {
+				if err := r.unbillAttendance(ctx, companyId, req.GroupId, mark.StudentId, req.AttendDate); err != nil {
+					fail(mark, err.Error())
+					continue
+				}
+				unbilled[mark.StudentId] = true
+			}
+		}
+		pending = append(pending, mark)
+	}
+
+	if err := r.writeGroupAttendance(req, companyId, lessonId, lessonTeacherId, billing, salaryRule, discounts, marked, pending); err != nil {
+		r.rebillAttendance(ctx, companyId, req, billing, marked, unbilled)
+		return nil, err
+	}
+	for _, mark := range pending {
+		message := "Attendance successfully created"
+		if mark.Status == -1 {
+			message = "Attendance successfully deleted"
+		}
+		current, isMarked := marked[mark.StudentId]
+		makeup := makeupLink{groupId: mark.MakeupGroupId, date: mark.MakeupDate}
+		wasBilled := isMarked && current.confirmed && statuses[current.status].billable && current.makeup == makeup
+		if mark.Status != -1 && statuses[mark.Status].billable && !wasBilled {
+			billGroupId, billDate, billBilling, err := r.billingTarget(req.GroupId, req.AttendDate, makeup, billing)
+			if err == nil {
+				err = r.billAttendance(ctx, companyId, billGroupId, mark.StudentId, billDate, billBilling)
+			}
+			if err != nil {
+				// the previous mark is restored unless it was billed as another absence and has been unbilled already
+				if isMarked && (!statuses[current.status].billable || !current.confirmed) {
+					r.db.Exec(`UPDATE attendance SET status = $1, makeup_group_id = NULLIF($2, '')::bigint, makeup_date = NULLIF($3, '')::date, confirmed = $4
+						WHERE group_id = $5 AND student_id = $6 AND attend_date = $7`,
+						current.status, current.makeup.groupId, current.makeup.date, current.confirmed, req.GroupId, mark.StudentId, req.AttendDate)
+				} else {
+					r.db.Exec(`DELETE FROM attendance WHERE group_id = $1 AND student_id = $2 AND attend_date = $3`, req.GroupId, mark.StudentId, req.AttendDate)
+				}
+				fail(mark, err.Error())
+				continue
+			}
+		}
+		response.Results = append(response.Results, &pb.GroupAttendanceResult{StudentId: mark.StudentId, Status: mark.Status, Success: true, Message: message})
+	}
+	for _, result := range response.Results {
+		if result.Success {
+			response.Succeeded++
+		} else {
+			response.Failed++
+		}
+	}
+	return response, nil
+}
+
+// rebillAttendance charges again the marks unbilled for a roster that could not be written, they keep their previous mark
+func (r *AttendanceRepository) rebillAttendance(ctx context.Context, companyId string, req *pb.SetGroupAttendanceRequest, billing groupBilling,
+	marked map[string]markedAttendance, unbilled map[string]bool) {
+	for studentId := range unbilled {
+		billGroupId, billDate, billBilling, err := r.billingTarget(req.GroupId, req.AttendDate, marked[studentId].makeup, billing)
+		if err == nil {
+			err = r.billAttendance(ctx, companyId, billGroupId, studentId, billDate, billBilling)
+		}
+		if err != nil {
+			fmt.Printf("error while billing attendance of student %s on %s again: %v\n", studentId, req.AttendDate, err)
+		}
+	}
+}
+
+// writeGroupAttendance saves the pending marks of a roster in one transaction
+func (r *AttendanceRepository) writeGroupAttendance(req *pb.SetGroupAttendanceRequest, companyId, lessonId, lessonTeacherId string, billing groupBilling,
+	salaryRule *pb.AbsGetTeachersSalary, discounts map[string]*pb.StudentGroupDiscount, marked map[string]markedAttendance, pending []*pb.GroupAttendanceMark) (err error) {
+	if len(pending) == 0 {
+		return nil
+	}
+	tx, err := r.db.Begin()
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction %v", err)
+	}
+	defer func() {
+		if err != nil {
+			tx.Rollback()
+		} else {
+			err = tx.Commit()
+		}
+	}()
+	for _, mark := range pending {
+		if mark.Status == -1 {
+			_, err = tx.Exec(`DELETE FROM attendance WHERE group_id = $1 AND student_id = $2 AND attend_date = $3`, req.GroupId, mark.StudentId, req.AttendDate)
+			if err != nil {
+				return fmt.Errorf("failed to delete attendance: %v", err)
+			}
+			continue
+		}
+		if _, isMarked := marked[mark.StudentId]; isMarked {
+			// marking a student who checked in confirms the check in
+			_, err = tx.Exec(`UPDATE attendance SET status = $1, created_by = $2, creator_role = $3, makeup_group_id = NULLIF($4, '')::bigint, makeup_date = NULLIF($5, '')::date, confirmed = true
+				WHERE group_id = $6 AND student_id = $7 AND attend_date = $8`,
+				mark.Status, req.ActionById, req.ActionByRole, mark.MakeupGroupId, mark.MakeupDate, req.GroupId, mark.StudentId, req.AttendDate)
+			if err != nil {
+				return fmt.Errorf("error while updating attendance %v", err)
+			}
+			continue
+		}
+		var (
+			discountAmount *float64
+			discountOwner  = "CENTER"
+		)
+		if discount, ok := discounts[mark.StudentId]; ok {
+			amount, parseErr := strconv.ParseFloat(discount.Amount, 64)
+			if parseErr == nil {
+				discountAmount, discountOwner = &amount, discount.DiscountOwner
+			}
+		}
+		var salary lessonSalary
+		salary, err = r.priceLessonSalary(req.GroupId, mark.StudentId, req.AttendDate, billing, salaryRule, discountAmount, discountOwner)
+		if err != nil {
+			return err
+		}
+		_, err = tx.Exec(`INSERT INTO attendance (is_discounted, discount_owner, price, group_id, student_id, teacher_id, attend_date, status, created_at, created_by, creator_role, company_id, price_type, total_count, course_price, lesson_id, makeup_group_id, makeup_date)
+			VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, NULLIF($17, '')::bigint, NULLIF($18, '')::date)`,
+			salary.isDiscounted, salary.discountOwner, salary.price, req.GroupId, mark.StudentId, lessonTeacherId, req.AttendDate, mark.Status, time.Now(),
+			req.ActionById, req.ActionByRole, companyId, salary.priceType, salary.totalCount, salary.coursePrice, lessonId, mark.MakeupGroupId, mark.MakeupDate)
+		if err != nil {
+			return fmt.Errorf("error while creating attendance %v", err)
+		}
+	}
+	return nil
+}
+
+// groupMembers tells which of the students belong to the group
+func (r *AttendanceRepository) groupMembers(groupId string, studentIds []string) (map[string]bool, error) {
+	rows, err := r.db.Query(`SELECT student_id FROM group_students WHERE group_id = $1 AND student_id::text = ANY($2)`, groupId, pq.Array(studentIds))
+	if err != nil {
+		return nil, fmt.Errorf("error while getting group students %v", err)
+	}
+	defer rows.Close()
+	members := make(map[string]bool)
+	for rows.Next() {
+		var studentId string
+		if err := rows.Scan(&studentId); err != nil {
+			return nil, fmt.Errorf("error while scanning group student %v", err)
+		}
+		members[studentId] = true
+	}
+	return members, rows.Err()
+}
+
+// markedAttendance is how a student is already marked on a lesson, a check in is not confirmed until a teacher does
+type markedAttendance struct {
+	status    int32
+	makeup    makeupLink
+	confirmed bool
+}
+
+// markedOnLesson returns the attendance of every student already marked in the group on attendDate
+func (r *AttendanceRepository) markedOnLesson(groupId, attendDate string) (map[string]markedAttendance, error) {
+	rows, err := r.db.Query(`SELECT student_id, status, COALESCE(makeup_group_id::text, ''), COALESCE(makeup_date::text, ''), confirmed
+		FROM attendance WHERE group_id = $1 AND attend_date = $2`, groupId, attendDate)
+	if err != nil {
+		return nil, fmt.Errorf("error while getting attendance %v", err)
+	}
+	defer rows.Close()
+	marked := make(map[string]markedAttendance)
+	for rows.Next() {
+		var (
+			studentId string
+			mark      markedAttendance
+		)
+		if err := rows.Scan(&studentId, &mark.status, &mark.makeup.groupId, &mark.makeup.date, &mark.confirmed); err != nil {
+			return nil, fmt.Errorf("error while scanning attendance %v", err)
+		}
+		marked[studentId] = mark
+	}
+	return marked, rows.Err()
+}
+
+// billAttendance consumes a package credit or charges the lesson price for an attended lesson of a group that is not
+// billed monthly, a package group falls back to the lesson price when the student has no credit left
+func (r *AttendanceRepository) billAttendance(ctx context.Context, companyId, groupId, studentId, attendDate string, billing groupBilling) error {
+	if billing.mode == BillingMonthly {
+		return nil
+	}
+	if billing.mode == BillingPackage {
+		consumed, err := r.consumeLessonCredit(companyId, groupId, studentId, attendDate, billing)
+		if err != nil || consumed {
+			return err
+		}
+	}
+	price := billing.perLessonPrice()
+	if price <= 0 {
+		return nil
+	}
+	comment := fmt.Sprintf("%s sanadagi dars uchun to'lov student balansidan yechib olindi", attendDate)
+	if billing.mode == BillingPackage {
+		comment = fmt.Sprintf("%s sanadagi dars uchun to'lov student balansidan yechib olindi, dars paketi tugagan", attendDate)
+	}
+	paymentId, err := r.financeClient.PaymentTakeOff(ctx, comment, attendDate, fmt.Sprintf("%.2f", price), studentId, "00000000-0000-0000-0000-000000000000", "TIZIM", groupId)
+	if err != nil {
+		return fmt.Errorf("error while charging lesson %v", err)
+	}
+	_, err = r.db.Exec(`INSERT INTO attendance_charge (group_id, student_id, attend_date, billing_mode, payment_id, amount, company_id)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)`, groupId, studentId, attendDate, BillingPerLesson, paymentId, price, companyId)
+	if err != nil {
+		r.financeClient.PaymentReturn(ctx, paymentId, "00000000-0000-0000-0000-000000000000", "TIZIM")
+		return fmt.Errorf("error while saving lesson charge %v", err)
+	}
+	return nil
+}
+
+// consumeLessonCredit takes one lesson off the package that expires first, false when the student has no usable package
+func (r *AttendanceRepository) consumeLessonCredit(companyId, groupId, studentId, attendDate string, billing groupBilling) (bool, error) {
+	tx, err := r.db.Begin()
+	if err != nil {
+		return false, fmt.Errorf("failed to begin transaction %v", err)
+	}
+	defer func() {
+		if err != nil {
+			tx.Rollback()
+		} else {
+			tx.Commit()
+		}
+	}()
+	var (
+		packageId    string
+		lessonsLeft  int32
+		lessonsTotal int32
+		packagePrice float64
+	)
+	err = tx.QueryRow(`SELECT id, lessons_left, lessons_total, price FROM lesson_package
+		WHERE student_id = $1 AND group_id = $2 AND company_id = $3 AND status = 'ACTIVE' AND lessons_left > 0
+		  AND (expires_at IS NULL OR expires_at >= $4)
+		ORDER BY expires_at NULLS LAST, purchased_at
+		LIMIT 1
+		FOR UPDATE`, studentId, groupId, companyId, attendDate).Scan(&packageId, &lessonsLeft, &lessonsTotal, &packagePrice)
+	if errors.Is(err, sql.ErrNoRows) {
+		err = nil
+		return false, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("error while getting lesson package %v", err)
+	}
+	lessonsLeft--
+	_, err = tx.Exec(`UPDATE lesson_package SET lessons_left = $1, status = CASE WHEN $1 = 0 THEN 'USED' ELSE status END WHERE id = $2`, lessonsLeft, packageId)
+	if err != nil {
+		return false, fmt.Errorf("error while consuming lesson credit %v", err)
+	}
+	_, err = tx.Exec(`INSERT INTO attendance_charge (group_id, student_id, attend_date, billing_mode, package_id, amount, company_id)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)`, groupId, studentId, attendDate, BillingPackage, packageId, packagePrice/float64(lessonsTotal), companyId)
+	if err != nil {
+		return false, fmt.Errorf("error while saving lesson charge %v", err)
+	}
+	var creditLeft int32
+	err = tx.QueryRow(`SELECT COALESCE(SUM(lessons_left), 0) FROM lesson_package
+		WHERE student_id = $1 AND group_id = $2 AND status = 'ACTIVE'`, studentId, groupId).Scan(&creditLeft)
+	if err != nil {
+		return false, fmt.Errorf("error while counting lesson credit %v", err)
+	}
+	if creditLeft <= billing.lowCreditLessons {
+		result, err := tx.Exec(`UPDATE lesson_package SET low_credit_notified = true WHERE id = $1 AND NOT low_credit_notified`, packageId)
+		if err == nil {
+			if notify, _ := result.RowsAffected(); notify == 1 {
+				go sendLessonPackageSms(r.db, companyId, studentId, groupId, "LOW_LESSON_CREDIT_ALERT", creditLeft, "")
+			}
+		}
+	}
+	return true, nil
+}
+
+// unbillAttendance gives back the package credit or returns the lesson charge of a deleted attendance
+func (r *AttendanceRepository) unbillAttendance(ctx context.Context, companyId, groupId, studentId, attendDate string) error {
+	var (
+		packageId                 sql.NullString
+		paymentId                 sql.NullString
+		makeupGroupId, makeupDate sql.NullString
+	)
+	// a makeup is charged as the absence it makes up
+	err := r.db.QueryRow(`SELECT makeup_group_id::text, makeup_date::text FROM attendance WHERE group_id = $1 AND student_id = $2 AND attend_date = $3`,
+		groupId, studentId, attendDate).Scan(&makeupGroupId, &makeupDate)
+	if err == nil && makeupGroupId.Valid {
+		groupId, attendDate = makeupGroupId.String, makeupDate.String
+	}
+	err = r.db.QueryRow(`SELECT package_id, payment_id FROM attendance_charge WHERE group_id = $1 AND student_id = $2 AND attend_date = $3`,
+		groupId, studentId, attendDate).Scan(&packageId, &paymentId)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("error while getting lesson charge %v", err)
+	}
+	if paymentId.Valid {
+		if _, err = r.financeClient.PaymentReturn(ctx, paymentId.String, "00000000-0000-0000-0000-000000000000", "TIZIM"); err != nil {
+			return fmt.Errorf("error while returning lesson charge %v", err)
+		}
+	}
+	if packageId.Valid {
+		_, err = r.db.Exec(`UPDATE lesson_package SET lessons_left = lessons_left + 1, status = CASE WHEN status = 'USED' THEN 'ACTIVE' ELSE status END
+			WHERE id = $1`, packageId.String)
+		if err != nil {
+			return fmt.Errorf("error while returning lesson credit %v", err)
+		}
+	}
+	_, err = r.db.Exec(`DELETE FROM attendance_charge WHERE group_id = $1 AND student_id = $2 AND attend_date = $3`, groupId, studentId, attendDate)
+	if err != nil {
+		return fmt.Errorf("error while deleting lesson charge %v", err)
+	}
+	return nil
+}
+
+// makeupLink names the absence a makeup attendance makes up, it is empty for any other attendance
+type makeupLink struct {
+	groupId string
+	date    string
+}
+
+// checkMakeup lets only a makeup status name an absence and checks the student really missed that lesson, was not
+// charged for it and did not make it up already
+func (r *AttendanceRepository) checkMakeup(companyId, groupId, studentId, attendDate string, markStatus attendanceStatus, makeup makeupLink, statuses map[int32]attendanceStatus) error {
+	if !markStatus.isMakeup {
+		if makeup.groupId != "" || makeup.date != "" {
+			return status.Errorf(codes.InvalidArgument, "only a makeup status can make up an absence")
+		}
+		return nil
+	}
+	if makeup.groupId == "" || makeup.date == "" {
+		return status.Errorf(codes.InvalidArgument, "makeup needs the group and the date of the absence")
+	}
+	if makeup.groupId == groupId && makeup.date == attendDate {
+		return status.Errorf(codes.InvalidArgument, "a lesson cannot make up itself")
+	}
+	var absence int32
+	err := r.db.QueryRow(`SELECT status FROM attendance WHERE group_id::text = $1 AND student_id::text = $2 AND attend_date = $3 AND company_id = $4`,
+		makeup.groupId, studentId, makeup.date, companyId).Scan(&absence)
+	if errors.Is(err, sql.ErrNoRows) {
+		return status.Errorf(codes.NotFound, "talaba %s sanada darsni qoldirmagan", makeup.date)
+	}
+	if err != nil {
+		return fmt.Errorf("error while getting absence %v", err)
+	}
+	if absenceStatus := statuses[absence]; absenceStatus.billable || absenceStatus.isMakeup {
+		return status.Errorf(codes.FailedPrecondition, "talaba %s sanadagi darsga qatnashgan", makeup.date)
+	}
+	var madeUp bool
+	err = r.db.QueryRow(`SELECT EXISTS(SELECT 1 FROM attendance WHERE makeup_group_id::text = $1 AND student_id::text = $2 AND makeup_date = $3
+			AND NOT (group_id::text = $4 AND attend_date = $5))`,
+		makeup.groupId, studentId, makeup.date, groupId, attendDate).Scan(&madeUp)
+	if err != nil {
+		return fmt.Errorf("error while checking makeup %v", err)
+	}
+	if madeUp {
+		return status.Errorf(codes.AlreadyExists, "%s sanadagi dars allaqachon qayta o'tilgan", makeup.date)
+	}
+	return nil
+}
+
+// checkNotMadeUp refuses to change an absence a makeup points to, the makeup has to go first
+func (r *AttendanceRepository) checkNotMadeUp(groupId, studentId, attendDate string) error {
+	var madeUp bool
+	err := r.db.QueryRow(`SELECT EXISTS(SELECT 1 FROM attendance WHERE makeup_group_id::text = $1 AND student_id::text = $2 AND makeup_date = $3)`,
+		groupId, studentId, attendDate).Scan(&madeUp)
+	if err != nil {
+		return fmt.Errorf("error while checking makeup %v", err)
+	}
+	if madeUp {
+		return status.Errorf(codes.FailedPrecondition, "bu dars qayta o'tilgan, avval qayta o'tilgan darsni o'chiring")
+	}
+	return nil
+}
+
+// billingTarget is the group, date and billing an attendance is charged under, a makeup is charged as the absence it
+// makes up so a package of the absence group is used
+func (r *AttendanceRepository) billingTarget(groupId, attendDate string, makeup makeupLink, billing groupBilling) (string, string, groupBilling, error) {
+	if makeup.groupId == "" {
+		return groupId, attendDate, billing, nil
+	}
+	absenceBilling, err := getGroupBilling(r.db, makeup.groupId)
+	if err != nil {
+		return "", "", billing, err
+	}
+	return makeup.groupId, makeup.date, absenceBilling, nil
+}
+
+// checkPeriodIsOpen rejects attendance changes dated in an accounting period closed by finance
+func (r *AttendanceRepository) checkPeriodIsOpen(ctx context.Context, attendDate string) error {
+	period, err := r.financeClient.CheckPeriod(ctx, attendDate)
+	if err != nil {
+		return fmt.Errorf("error while checking accounting period %v", err)
+	}
+	if period.IsClosed {
+		return status.Errorf(codes.FailedPrecondition, "%s oyi yopilgan, davomatni o'zgartirib bo'lmaydi", period.Period)
+	}
+	return nil
+}
 func (r *AttendanceRepository) GetAttendanceByGroupAndDateRange(companyId string, ctx context.Context, groupId string, fromDate time.Time, tillDate time.Time, withOutdated bool, actionRole, actionId string) (*pb.GetAttendanceResponse, error) {
+	// a substitute teacher sees the days of the lessons they are assigned to only
+	var substituteDates map[string]bool
 	if !utils.CheckGroupAndTeacher(r.db, groupId, actionRole, actionId) {
-		return nil, status.Errorf(codes.Aborted, "Ooops. this group not found in your groupList")
+		if actionRole != "TEACHER" {
+			return nil, status.Errorf(codes.Aborted, "Ooops. this group not found in your groupList")
+		}
+		dates, err := utils.SubstituteLessonDates(r.db, groupId, actionId, fromDate, tillDate)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "%v", err)
+		}
+		if len(dates) == 0 {
+			return nil, status.Errorf(codes.Aborted, "Ooops. this group not found in your groupList")
+		}
+		substituteDates = dates
+	}
+
+	statuses, err := companyAttendanceStatuses(r.db, companyId)
+	if err != nil {
+		return nil, err
 	}
 
 	response := &pb.GetAttendanceResponse{
@@ -171,55 +857,24 @@ func (r *AttendanceRepository) GetAttendanceByGroupAndDateRange(companyId string
 		Students: make([]*pb.Student, 0),
 	}
 
-	daysQuery := `
-        WITH RECURSIVE dates AS (
-            SELECT $1::date AS date
-            UNION ALL
-            SELECT date + 1
-            FROM dates
-            WHERE date < $2::date
-        ),
-        group_dates AS (
-            SELECT DISTINCT d.date::text, tl.transfer_date::text
-            FROM dates d
-            JOIN groups g ON g.id = $3::bigint
-            LEFT JOIN transfer_lesson tl ON tl.group_id = g.id AND tl.real_date = d.date
-            WHERE (
-                (d.date >= g.start_date AND d.date <= LEAST(g.end_date, $2::date))
-                AND EXTRACT(DOW FROM d.date) = ANY(
-                    SELECT CASE day
-                        WHEN 'DUSHANBA' THEN 1
-                        WHEN 'SESHANBA' THEN 2
-                        WHEN 'CHORSHANBA' THEN 3
-                        WHEN 'PAYSHANBA' THEN 4
-                        WHEN 'JUMA' THEN 5
-                        WHEN 'SHANBA' THEN 6
-                        WHEN 'YAKSHANBA' THEN 0
-                    END
-                    FROM unnest(g.days) AS day
-                )
-            )
-            ORDER BY d.date::text
-        )
-        SELECT date, transfer_date 
-        FROM group_dates
-        ORDER BY date, COALESCE(transfer_date, date)
-    `
-
-	rows, err := r.db.QueryContext(ctx, daysQuery, fromDate, tillDate, groupId)
+	schedule, err := utils.GroupSchedule(r.db, groupId)
 	if err != nil {
-		return nil, err
+		return nil, status.Errorf(codes.Internal, "%v", err)
 	}
-	defer rows.Close()
-
-	for rows.Next() {
-		var dateStr, transferDateStr sql.NullString
-		if err := rows.Scan(&dateStr, &transferDateStr); err != nil {
-			return nil, err
+	// a transferred lesson is listed on its real date with the date it moved to
+	transferDates := make(map[string]string, len(schedule.Transfers))
+	for _, transfer := range schedule.Transfers {
+		transferDates[transfer.RealDate.Format("2006-01-02")] = transfer.TransferDate.Format("2006-01-02")
+	}
+	schedule.Transfers = nil
+	for _, lessonDate := range schedule.LessonDates(fromDate, tillDate) {
+		date := lessonDate.Format("2006-01-02")
+		if substituteDates != nil && !substituteDates[date] {
+			continue
 		}
 		response.Days = append(response.Days, &pb.Day{
-			Date:         dateStr.String,
-			TransferDate: transferDateStr.String,
+			Date:         date,
+			TransferDate: transferDates[date],
 		})
 	}
 
@@ -230,7 +885,11 @@ func (r *AttendanceRepository) GetAttendanceByGroupAndDateRange(companyId string
                 a.attend_date::text,
                 a.status,
                 a.teacher_id,
-                a.created_at
+                a.created_at,
+                a.makeup_group_id::text,
+                a.makeup_date::text,
+                a.checked_in_at IS NOT NULL AS self_check_in,
+                a.confirmed
             FROM attendance a
             WHERE a.group_id = $1::bigint
             AND a.attend_date BETWEEN $2::date AND $3::date
@@ -266,7 +925,11 @@ func (r *AttendanceRepository) GetAttendanceByGroupAndDateRange(companyId string
             s.balance,
             s.created_at as student_created_at,
             s.condition as student_condition,
-            la.activated_at
+            la.activated_at,
+            sa.makeup_group_id,
+            sa.makeup_date,
+            sa.self_check_in,
+            sa.confirmed
         FROM group_students gs
         LEFT JOIN student_attendance sa ON gs.student_id = sa.student_id
         LEFT JOIN students s ON gs.student_id = s.id
@@ -276,7 +939,7 @@ func (r *AttendanceRepository) GetAttendanceByGroupAndDateRange(companyId string
         ORDER BY gs.created_at, sa.attend_date, sa.created_at
     `
 
-	rows, err = r.db.QueryContext(ctx, studentsQuery, groupId, fromDate, tillDate, withOutdated)
+	rows, err := r.db.QueryContext(ctx, studentsQuery, groupId, fromDate, tillDate, withOutdated)
 	if err != nil {
 		return nil, err
 	}
@@ -288,9 +951,9 @@ func (r *AttendanceRepository) GetAttendanceByGroupAndDateRange(companyId string
 		var (
 			studentId, name, phone, condition, studentCondition      string
 			dateOfBirth, addedAt, createdAt, activatedAt, attendDate sql.NullString
-			teacherId                                                sql.NullString
+			teacherId, makeupGroupId, makeupDate                     sql.NullString
 			status                                                   sql.NullInt32
-			gender                                                   sql.NullBool
+			gender, selfCheckIn, confirmed                           sql.NullBool
 			balance                                                  float64
 			attendanceCreatedAt                                      sql.NullTime
 		)
@@ -311,6 +974,10 @@ func (r *AttendanceRepository) GetAttendanceByGroupAndDateRange(companyId string
 			&createdAt,
 			&studentCondition,
 			&activatedAt,
+			&makeupGroupId,
+			&makeupDate,
+			&selfCheckIn,
+			&confirmed,
 		); err != nil {
 			return nil, err
 		}
@@ -333,12 +1000,18 @@ func (r *AttendanceRepository) GetAttendanceByGroupAndDateRange(companyId string
 			studentMap[studentId] = student
 		}
 
-		if attendDate.Valid && teacherId.Valid {
+		if attendDate.Valid && teacherId.Valid && (substituteDates == nil || substituteDates[attendDate.String]) {
 			attendance := &pb.Attendance{
-				AttendDate: attendDate.String,
-				IsCome:     status.Int32 == 1,
-				StudentId:  studentId,
-				TeacherId:  teacherId.String,
+				AttendDate:    attendDate.String,
+				IsCome:        statuses[status.Int32].billable,
+				StudentId:     studentId,
+				TeacherId:     teacherId.String,
+				Status:        status.Int32,
+				StatusName:    statuses[status.Int32].name,
+				MakeupGroupId: makeupGroupId.String,
+				MakeupDate:    makeupDate.String,
+				SelfCheckIn:   selfCheckIn.Bool,
+				Confirmed:     confirmed.Bool,
 			}
 			student.Attendance = append(student.Attendance, attendance)
 		}
@@ -361,42 +1034,27 @@ func (r *AttendanceRepository) GetAttendanceByGroupAndDateRange(companyId string
 	response.Students = students
 	return response, nil
 }
+
+// IsValidGroupDay tells whether date is a regular lesson day of the group, transferred lessons are checked by
+// IsHaveTransferredLesson
 func (r *AttendanceRepository) IsValidGroupDay(ctx context.Context, groupId string, date time.Time) (bool, error) {
-	query := `
-        SELECT EXISTS (
-            SELECT 1
-            FROM groups g
-            WHERE g.id = $1 AND
-            $2::date BETWEEN g.start_date AND g.end_date AND
-            EXTRACT(DOW FROM $2::date) = ANY(
-                SELECT CASE day
-                    WHEN 'DUSHANBA' THEN 1
-                    WHEN 'SESHANBA' THEN 2
-                    WHEN 'CHORSHANBA' THEN 3
-                    WHEN 'PAYSHANBA' THEN 4
-                    WHEN 'JUMA' THEN 5
-                    WHEN 'SHANBA' THEN 6
-                    WHEN 'YAKSHANBA' THEN 0
-                END
-                FROM unnest(g.days) AS day
-            )
-        )
-    `
-	var exists bool
-	err := r.db.QueryRowContext(ctx, query, groupId, date).Scan(&exists)
+	schedule, err := utils.GroupSchedule(r.db, groupId)
 	if err != nil {
 		return false, err
 	}
-	return exists, nil
+	schedule.Transfers = nil
+	return len(schedule.LessonDates(date, date)) > 0, nil
 }
-func (r *AttendanceRepository) IsHaveTransferredLesson(groupID string) bool {
+
+// IsHaveTransferredLesson tells whether a lesson of the group was transferred to date
+func (r *AttendanceRepository) IsHaveTransferredLesson(groupID string, date time.Time) bool {
 	query := `
 		SELECT COUNT(*) 
 		FROM transfer_lesson 
-		WHERE group_id = $1 AND transfer_date = CURRENT_DATE`
+		WHERE group_id = $1 AND transfer_date = $2::date`
 
 	var count int
-	err := r.db.QueryRow(query, groupID).Scan(&count)
+	err := r.db.QueryRow(query, groupID, date.Format("2006-01-02")).Scan(&count)
 	if err != nil {
 		return false
 	}
@@ -413,7 +1071,8 @@ func (r *AttendanceRepository) GetAllGroupsByTeacherId(teacherId, from, to strin
        start_date,
        end_date,
        is_archived,
-       created_at FROM groups where teacher_id=$1`, teacherId)
+       created_at FROM groups where teacher_id=$1
+		OR id IN (SELECT group_id FROM lessons WHERE substitute_teacher_id=$1 AND lesson_date BETWEEN $2 AND $3)`, teacherId, from, to)
 	if err != nil {
 		return nil
 	}
@@ -424,7 +1083,7 @@ func (r *AttendanceRepository) GetAllGroupsByTeacherId(teacherId, from, to strin
 		if err != nil {
 			return nil
 		}
-		group.LessonCountOnPeriod = r.lessonCounter(from, to, group.Id)
+		group.LessonCountOnPeriod = r.teacherLessonCounter(teacherId, from, to, group.Id)
 		response = append(response, group)
 	}
 
@@ -432,44 +1091,39 @@ func (r *AttendanceRepository) GetAllGroupsByTeacherId(teacherId, from, to strin
 }
 
 func (r *AttendanceRepository) lessonCounter(from, to, groupId string) int32 {
-	query := `
-        WITH RECURSIVE dates AS (
-            SELECT $1::date AS date
-            UNION ALL
-            SELECT date + 1
-            FROM dates
-            WHERE date < $2::date
-        ),
-        group_dates AS (
-            SELECT DISTINCT d.date
-            FROM dates d
-            JOIN groups g ON g.id = $3::bigint
-            WHERE
-                (d.date >= g.start_date AND d.date <= LEAST(g.end_date, $2::date))
-                AND EXTRACT(DOW FROM d.date) = ANY(
-                    SELECT CASE day
-                        WHEN 'DUSHANBA' THEN 1
-                        WHEN 'SESHANBA' THEN 2
-                        WHEN 'CHORSHANBA' THEN 3
-                        WHEN 'PAYSHANBA' THEN 4
-                        WHEN 'JUMA' THEN 5
-                        WHEN 'SHANBA' THEN 6
-                        WHEN 'YAKSHANBA' THEN 0
-                    END
-                    FROM unnest(g.days) AS day
-                )
-        )
-        SELECT COUNT(*)
-        FROM group_dates;
-    `
-
-	var lessonCount int32
-	err := r.db.QueryRow(query, from, to, groupId).Scan(&lessonCount)
+	fromDate, err := parseDate(from)
+	if err != nil {
+		return 0
+	}
+	toDate, err := parseDate(to)
 	if err != nil {
 		return 0
 	}
+	schedule, err := utils.GroupSchedule(r.db, groupId)
+	if err != nil {
+		return 0
+	}
+	return int32(pricing.CountLessons(schedule, fromDate, toDate))
+}
 
-	return lessonCount
+// teacherLessonCounter is how many lessons of the group teacherId taught in the period, lessons a substitute taught are
+// taken off the count of the teacher of the group
+func (r *AttendanceRepository) teacherLessonCounter(teacherId, from, to, groupId string) int32 {
+	var (
+		isGroupTeacher      bool
+		substituted, taught int32
+	)
+	err := r.db.QueryRow(`SELECT g.teacher_id::text = $2,
+			(SELECT count(*) FROM lessons WHERE group_id = g.id AND lesson_date BETWEEN $3 AND $4 AND substitute_teacher_id::text <> $2),
+			(SELECT count(*) FROM lessons WHERE group_id = g.id AND lesson_date BETWEEN $3 AND $4 AND substitute_teacher_id::text = $2)
+		FROM groups g WHERE g.id = $1`, groupId, teacherId, from, to).Scan(&isGroupTeacher, &substituted, &taught)
+	if err != nil {
+		return 0
+	}
+	if !isGroupTeacher {
+		return taught
+	}
+	return max(r.lessonCounter(from, to, groupId)-substituted, 0)
 }
 
 func (r *AttendanceRepository) GetAttendanceByTeacherAndGroup(companyId, teacherId string, groupId string, from string, to string) (map[string][]Attendance, error) {
@@ -483,7 +1137,7 @@ func (r *AttendanceRepository) GetAttendanceByTeacherAndGroup(companyId, teacher
 			s.name,
 			teacher_id,
 			attend_date,
-			status,
+			a.status,
 			a.created_at,
 			created_by,
 			creator_role,
@@ -494,12 +1148,15 @@ func (r *AttendanceRepository) GetAttendanceByTeacherAndGroup(companyId, teacher
 			attendance a
 		JOIN students s
 		ON a.student_id=s.id
+		JOIN attendance_status st
+		ON st.company_id=a.company_id AND st.code=a.status AND st.pays_teacher
 		WHERE 
 			teacher_id = $1 AND 
 			group_id = $2 AND 
 			attend_date BETWEEN $3 AND $4
 			AND
 		    creator_role='TEACHER'
+			AND a.confirmed
 			AND a.company_id=$5
 	`
 
@@ -0,0 +1,132 @@
+package repository
+
+import (
+	"database/sql"
+	"education-service/proto/pb"
+	"errors"
+	"fmt"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"net/http"
+	"strings"
+)
+
+type AttendanceStatusRepository struct {
+	db *sql.DB
+}
+
+// attendanceStatus is how a company treats an attendance marked with code
+type attendanceStatus struct {
+	code        int32
+	name        string
+	billable    bool
+	paysTeacher bool
+	absenceSms  bool
+	isMakeup    bool
+	isActive    bool
+}
+
+func (r *AttendanceStatusRepository) CreateAttendanceStatus(companyId string, req *pb.AbsAttendanceStatus) (*pb.AbsResponse, error) {
+	if strings.TrimSpace(req.Name) == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "status name is required")
+	}
+	var code int32
+	err := r.db.QueryRow(`INSERT INTO attendance_status (id, code, name, billable, pays_teacher, absence_sms, is_makeup, company_id)
+		SELECT $1, COALESCE(MAX(code), -1) + 1, $2, $3, $4, $5, $6, $7 FROM attendance_status WHERE company_id = $7
+		RETURNING code`,
+		uuid.New(), req.Name, req.Billable, req.PaysTeacher, req.AbsenceSms, req.IsMakeup, companyId).Scan(&code)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to create attendance status: %v", err)
+	}
+	return &pb.AbsResponse{Status: http.StatusOK, Message: fmt.Sprint(code)}, nil
+}
+
+// UpdateAttendanceStatus changes the name and the flags of a status, the flags apply to attendance marked before too
+func (r *AttendanceStatusRepository) UpdateAttendanceStatus(companyId string, req *pb.AbsAttendanceStatus) (*pb.AbsResponse, error) {
+	if strings.TrimSpace(req.Name) == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "status name is required")
+	}
+	result, err := r.db.Exec(`UPDATE attendance_status SET name = $1, billable = $2, pays_teacher = $3, absence_sms = $4, is_makeup = $5, is_active = $6
+		WHERE id::text = $7 AND company_id = $8`,
+		req.Name, req.Billable, req.PaysTeacher, req.AbsenceSms, req.IsMakeup, req.IsActive, req.Id, companyId)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to update attendance status: %v", err)
+	}
+	if updated, _ := result.RowsAffected(); updated == 0 {
+		return nil, status.Errorf(codes.NotFound, "attendance status not found")
+	}
+	return &pb.AbsResponse{Status: http.StatusOK, Message: "attendance status updated"}, nil
+}
+
+// DeleteAttendanceStatus deletes a status nothing was marked with, preloaded and used statuses can only be switched off
+func (r *AttendanceStatusRepository) DeleteAttendanceStatus(companyId, id string) (*pb.AbsResponse, error) {
+	var (
+		isDefault, used bool
+	)
+	err := r.db.QueryRow(`SELECT st.is_default, EXISTS(SELECT 1 FROM attendance a WHERE a.company_id = st.company_id AND a.status = st.code)
+		FROM attendance_status st WHERE st.id::text = $1 AND st.company_id = $2`, id, companyId).Scan(&isDefault, &used)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, status.Errorf(codes.NotFound, "attendance status not found")
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get attendance status: %v", err)
+	}
+	if isDefault || used {
+		return nil, status.Errorf(codes.FailedPrecondition, "bu holat ishlatilgan, uni o'chirib bo'lmaydi, faolsizlantiring")
+	}
+	if _, err = r.db.Exec(`DELETE FROM attendance_status WHERE id::text = $1`, id); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to delete attendance status: %v", err)
+	}
+	return &pb.AbsResponse{Status: http.StatusOK, Message: "attendance status deleted"}, nil
+}
+
+func (r *AttendanceStatusRepository) GetAttendanceStatuses(companyId string, req *pb.GetAttendanceStatusesRequest) (*pb.GetAttendanceStatusesResponse, error) {
+	rows, err := r.db.Query(`SELECT id, code, name, billable, pays_teacher, absence_sms, is_makeup, is_default, is_active
+		FROM attendance_status WHERE company_id = $1 AND ($2 OR is_active) ORDER BY code`, companyId, req.WithInactive)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get attendance statuses: %v", err)
+	}
+	defer rows.Close()
+	var response pb.GetAttendanceStatusesResponse
+	for rows.Next() {
+		var st pb.AbsAttendanceStatus
+		if err := rows.Scan(&st.Id, &st.Code, &st.Name, &st.Billable, &st.PaysTeacher, &st.AbsenceSms, &st.IsMakeup, &st.IsDefault, &st.IsActive); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to scan attendance status: %v", err)
+		}
+		response.Statuses = append(response.Statuses, &st)
+	}
+	return &response, rows.Err()
+}
+
+// companyAttendanceStatuses returns every status of the company keyed by code, inactive ones included so attendance
+// marked before a status was switched off is still understood
+func companyAttendanceStatuses(db *sql.DB, companyId string) (map[int32]attendanceStatus, error) {
+	rows, err := db.Query(`SELECT code, name, billable, pays_teacher, absence_sms, is_makeup, is_active FROM attendance_status WHERE company_id = $1`, companyId)
+	if err != nil {
+		return nil, fmt.Errorf("error while getting attendance statuses %v", err)
+	}
+	defer rows.Close()
+	statuses := make(map[int32]attendanceStatus)
+	for rows.Next() {
+		var st attendanceStatus
+		if err := rows.Scan(&st.code, &st.name, &st.billable, &st.paysTeacher, &st.absenceSms, &st.isMakeup, &st.isActive); err != nil {
+			return nil, fmt.Errorf("error while scanning attendance status %v", err)
+		}
+		statuses[st.code] = st
+	}
+	return statuses, rows.Err()
+}
+
+// markableStatus is the status a new mark uses, it must exist and be active
+func markableStatus(statuses map[int32]attendanceStatus, code int32) (attendanceStatus, error) {
+	st, ok := statuses[code]
+	if !ok || !st.isActive {
+		return st, status.Errorf(codes.InvalidArgument, "invalid attendance status %d", code)
+	}
+	return st, nil
+}
+
+func NewAttendanceStatusRepository(db *sql.DB) *AttendanceStatusRepository {
+	return &AttendanceStatusRepository{db: db}
+}
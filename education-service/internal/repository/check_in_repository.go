@@ -0,0 +1,544 @@
+package repository
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"database/sql"
+	"education-service/internal/utils"
+	"education-service/proto/pb"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"math/big"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// loginCodeMinutes is how long an sms login code works, loginCodeAttempts how many wrong tries it survives
+	loginCodeMinutes  = 5
+	loginCodeAttempts = 5
+	// loginCodesPerHour limits the codes sent to a phone so the login can not be used to send sms in bulk
+	loginCodesPerHour  = 5
+	studentSessionDays = 90
+
+	// a check in code works for checkInCodeSeconds and the tablet shows the next one after checkInRefreshSeconds, so
+	// a code scanned right before the switch still works
+	checkInCodeSeconds    = 30
+	checkInRefreshSeconds = 20
+)
+
+type CheckInRepository struct {
+	db             *sql.DB
+	attendanceRepo *AttendanceRepository
+	policyRepo     *AttendancePolicyRepository
+}
+
+// checkInLesson is the lesson a check in code is shown for
+type checkInLesson struct {
+	id        string
+	groupId   string
+	groupName string
+	date      string
+	secret    string
+}
+
+func (r *CheckInRepository) SendStudentLoginCode(req *pb.SendStudentLoginCodeRequest) (*pb.AbsResponse, error) {
+	phone := phoneDigits(req.Phone)
+	if len(phone) < 9 {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid phone number")
+	}
+	companyId, err := r.companyBySubdomain(req.Subdomain)
+	if err != nil {
+		return nil, err
+	}
+	var studentPhone string
+	err = r.db.QueryRow(`SELECT phone FROM students WHERE company_id = $1 AND condition = 'ACTIVE' AND regexp_replace(phone, '\D', '', 'g') = $2 LIMIT 1`,
+		companyId, phone).Scan(&studentPhone)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, status.Errorf(codes.NotFound, "bu raqam bilan talaba topilmadi")
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get student: %v", err)
+	}
+	var sent int
+	err = r.db.QueryRow(`SELECT COUNT(*) FROM student_login_code WHERE company_id = $1 AND phone = $2 AND created_at > NOW() - INTERVAL '1 hour'`,
+		companyId, phone).Scan(&sent)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to count login codes: %v", err)
+	}
+	if sent >= loginCodesPerHour {
+		return nil, status.Errorf(codes.ResourceExhausted, "juda ko'p urinish, birozdan keyin qayta urinib ko'ring")
+	}
+	number, err := rand.Int(rand.Reader, big.NewInt(1000000))
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to generate login code: %v", err)
+	}
+	code := fmt.Sprintf("%06d", number.Int64())
+	id := uuid.New()
+	_, err = r.db.Exec(`INSERT INTO student_login_code (id, phone, code_hash, expires_at, company_id) VALUES ($1, $2, $3, NOW() + make_interval(mins => $4), $5)`,
+		id, phone, hashSecret(phone+":"+code), loginCodeMinutes, companyId)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to save login code: %v", err)
+	}
+	if err := utils.SendSMS(studentPhone, fmt.Sprintf("Kirish kodi: %s. Kodni hech kimga bermang", code)); err != nil {
+		r.db.Exec(`DELETE FROM student_login_code WHERE id = $1`, id)
+		return nil, status.Errorf(codes.Internal, "failed to send login code: %v", err)
+	}
+	return &pb.AbsResponse{Status: http.StatusOK, Message: "login code sent"}, nil
+}
+
+// VerifyStudentLoginCode uses up the last code sent to the phone and opens a session for every active student of
+// the phone
+func (r *CheckInRepository) VerifyStudentLoginCode(req *pb.VerifyStudentLoginCodeRequest) (*pb.VerifyStudentLoginCodeResponse, error) {
+	phone := phoneDigits(req.Phone)
+	companyId, err := r.companyBySubdomain(req.Subdomain)
+	if err != nil {
+		return nil, err
+	}
+	var (
+		codeId, codeHash string
+		attempts         int
+	)
+	err = r.db.QueryRow(`SELECT id, code_hash, attempts FROM student_login_code
+		WHERE company_id = $1 AND phone = $2 AND NOT used AND expires_at > NOW()
+		ORDER BY created_at DESC LIMIT 1`, companyId, phone).Scan(&codeId, &codeHash, &attempts)
+	if errors.Is(err, sql.ErrNoRows) || (err == nil && attempts >= loginCodeAttempts) {
+		return nil, status.Errorf(codes.Unauthenticated, "kod eskirgan, yangi kod so'rang")
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get login code: %v", err)
+	}
+	if !hmac.Equal([]byte(codeHash), []byte(hashSecret(phone+":"+req.Code))) {
+		r.db.Exec(`UPDATE student_login_code SET attempts = attempts + 1 WHERE id = $1`, codeId)
+		return nil, status.Errorf(codes.Unauthenticated, "kod noto'g'ri")
+	}
+	if _, err := r.db.Exec(`UPDATE student_login_code SET used = true WHERE id = $1`, codeId); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to use login code: %v", err)
+	}
+
+	rows, err := r.db.Query(`SELECT id, name FROM students WHERE company_id = $1 AND condition = 'ACTIVE' AND regexp_replace(phone, '\D', '', 'g') = $2 ORDER BY name`,
+		companyId, phone)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get students: %v", err)
+	}
+	var sessions []*pb.StudentSession
+	for rows.Next() {
+		session := pb.StudentSession{CompanyId: companyId}
+		if err := rows.Scan(&session.StudentId, &session.StudentName); err != nil {
+			rows.Close()
+			return nil, status.Errorf(codes.Internal, "failed to scan student: %v", err)
+		}
+		sessions = append(sessions, &session)
+	}
+	rows.Close()
+	expiresAt := time.Now().AddDate(0, 0, studentSessionDays)
+	for _, session := range sessions {
+		secret := make([]byte, 32)
+		if _, err := rand.Read(secret); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to generate session token: %v", err)
+		}
+		session.Token = hex.EncodeToString(secret)
+		session.ExpiresAt = expiresAt.Format("2006-01-02 15:04:05")
+		_, err = r.db.Exec(`INSERT INTO student_session (id, student_id, token_hash, device, expires_at, company_id) VALUES ($1, $2, $3, NULLIF($4, ''), $5, $6)`,
+			uuid.New(), session.StudentId, hashSecret(session.Token), req.Device, expiresAt, companyId)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to create student session: %v", err)
+		}
+	}
+	return &pb.VerifyStudentLoginCodeResponse{Sessions: sessions}, nil
+}
+
+// ValidateStudentSession returns the student of a live session, a student that left the center loses the session
+func (r *CheckInRepository) ValidateStudentSession(req *pb.ValidateStudentSessionRequest) (*pb.StudentSession, error) {
+	var (
+		session   pb.StudentSession
+		expiresAt time.Time
+	)
+	err := r.db.QueryRow(`UPDATE student_session ss SET last_seen_at = NOW() FROM students s
+		WHERE ss.token_hash = $1 AND NOT ss.revoked AND ss.expires_at > NOW() AND s.id = ss.student_id AND s.condition = 'ACTIVE'
+		RETURNING s.id, s.name, ss.company_id, ss.expires_at`, hashSecret(req.Token)).
+		Scan(&session.StudentId, &session.StudentName, &session.CompanyId, &expiresAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, status.Errorf(codes.Unauthenticated, "session expired")
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to validate session: %v", err)
+	}
+	session.ExpiresAt = expiresAt.Format("2006-01-02 15:04:05")
+	return &session, nil
+}
+
+// GetCheckInCode signs a short lived code of today's lesson, the room tablet shows it as a QR code and asks for the
+// next one every refreshIn seconds
+func (r *CheckInRepository) GetCheckInCode(companyId string, req *pb.GetCheckInCodeRequest) (*pb.CheckInCode, error) {
+	lesson, err := r.lessonOfCompany(companyId, req.LessonId)
+	if err != nil {
+		return nil, err
+	}
+	if !utils.CheckLessonTeacher(r.db, lesson.groupId, lesson.date, req.ActionByRole, req.ActionById) {
+		return nil, status.Errorf(codes.PermissionDenied, "bu guruh sizga tegishli emas")
+	}
+	if err := r.checkLessonIsToday(companyId, lesson); err != nil {
+		return nil, err
+	}
+	if lesson.secret == "" {
+		secret := make([]byte, 32)
+		if _, err := rand.Read(secret); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to generate check in secret: %v", err)
+		}
+		err = r.db.QueryRow(`UPDATE lessons SET check_in_secret = COALESCE(check_in_secret, $1) WHERE id = $2 RETURNING check_in_secret`,
+			hex.EncodeToString(secret), lesson.id).Scan(&lesson.secret)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to save check in secret: %v", err)
+		}
+	}
+	expiresAt := time.Now().Add(checkInCodeSeconds * time.Second)
+	return &pb.CheckInCode{
+		LessonId:   lesson.id,
+		GroupName:  lesson.groupName,
+		LessonDate: lesson.date,
+		Token:      signCheckInToken(lesson.id, lesson.secret, expiresAt),
+		ExpiresAt:  expiresAt.Format("2006-01-02 15:04:05"),
+		RefreshIn:  checkInRefreshSeconds,
+	}, nil
+}
+
+// CheckIn marks the student present on the lesson of a scanned code. The attendance waits for the teacher to
+// confirm it before it is billed or paid.
+func (r *CheckInRepository) CheckIn(ctx context.Context, companyId string, req *pb.CheckInRequest) (*pb.CheckInResponse, error) {
+	lessonId, err := checkInTokenLesson(req.Token)
+	if err != nil {
+		return nil, err
+	}
+	lesson, err := r.lessonOfCompany(companyId, lessonId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "QR kod noto'g'ri")
+	}
+	if err := verifyCheckInToken(req.Token, lesson.secret, time.Now()); err != nil {
+		return nil, err
+	}
+	var member bool
+	err = r.db.QueryRow(`SELECT EXISTS(SELECT 1 FROM group_students WHERE group_id::text = $1 AND student_id::text = $2 AND condition = 'ACTIVE')`,
+		lesson.groupId, req.StudentId).Scan(&member)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to check group student: %v", err)
+	}
+	if !member {
+		return nil, status.Errorf(codes.PermissionDenied, "siz bu guruhda o'qimaysiz")
+	}
+	response := &pb.CheckInResponse{LessonId: lesson.id, GroupName: lesson.groupName, LessonDate: lesson.date}
+	if marked, err := r.checkedIn(lesson, req.StudentId, response); err != nil || marked {
+		return response, err
+	}
+
+	if err := r.attendanceRepo.ensureFinanceClient(); err != nil {
+		return nil, fmt.Errorf("error while ensuring finance client %v", err)
+	}
+	ctx, c := utils.NewTimoutContext(ctx, companyId)
+	defer c()
+	if err := r.attendanceRepo.checkPeriodIsOpen(ctx, lesson.date); err != nil {
+		return nil, err
+	}
+	statuses, err := companyAttendanceStatuses(r.db, companyId)
+	if err != nil {
+		return nil, err
+	}
+	present, ok := checkInStatus(statuses)
+	if !ok {
+		return nil, status.Errorf(codes.FailedPrecondition, "kelganlik holati sozlanmagan")
+	}
+	_, lessonTeacherId, err := ensureLesson(r.db, lesson.groupId, lesson.date)
+	if err != nil {
+		return nil, err
+	}
+	billing, err := getGroupBilling(r.db, lesson.groupId)
+	if err != nil {
+		return nil, err
+	}
+	salary, err := r.attendanceRepo.resolveLessonSalary(ctx, lesson.groupId, req.StudentId, lessonTeacherId, lesson.date, billing)
+	if err != nil {
+		return nil, err
+	}
+	_, err = r.db.Exec(`INSERT INTO attendance (is_discounted, discount_owner, price, group_id, student_id, teacher_id, attend_date, status, created_at, created_by, creator_role, company_id, price_type, total_count, course_price, lesson_id, checked_in_at, check_in_device, confirmed)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, 'STUDENT', $11, $12, $13, $14, $15, $9, NULLIF($16, ''), false)
+		ON CONFLICT DO NOTHING`,
+		salary.isDiscounted, salary.discountOwner, salary.price, lesson.groupId, req.StudentId, lessonTeacherId, lesson.date, present.code, time.Now(),
+		req.StudentId, companyId, salary.priceType, salary.totalCount, salary.coursePrice, lesson.id, req.Device)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to check in: %v", err)
+	}
+	// a teacher marking the student at the same time wins
+	if _, err := r.checkedIn(lesson, req.StudentId, response); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+// checkedIn fills response from the attendance the student already has on the lesson
+func (r *CheckInRepository) checkedIn(lesson checkInLesson, studentId string, response *pb.CheckInResponse) (bool, error) {
+	var checkedInAt sql.NullTime
+	err := r.db.QueryRow(`SELECT checked_in_at, confirmed FROM attendance WHERE group_id::text = $1 AND student_id::text = $2 AND attend_date = $3`,
+		lesson.groupId, studentId, lesson.date).Scan(&checkedInAt, &response.Confirmed)
+	if errors.Is(err, sql.ErrNoRows) {
+		return false, nil
+	}
+	if err != nil {
+		return false, status.Errorf(codes.Internal, "failed to get attendance: %v", err)
+	}
+	response.Message = "davomat allaqachon belgilangan"
+	if checkedInAt.Valid {
+		response.CheckedInAt = checkedInAt.Time.Format("2006-01-02 15:04:05")
+		if !response.Confirmed {
+			response.Message = "keldingiz, o'qituvchi tasdiqlashi kutilmoqda"
+		}
+	}
+	return true, nil
+}
+
+func (r *CheckInRepository) GetLessonCheckIns(companyId string, req *pb.GetLessonCheckInsRequest) (*pb.GetLessonCheckInsResponse, error) {
+	lesson, err := r.lessonOfCompany(companyId, req.LessonId)
+	if err != nil {
+		return nil, err
+	}
+	if !utils.CheckLessonTeacher(r.db, lesson.groupId, lesson.date, req.ActionByRole, req.ActionById) {
+		return nil, status.Errorf(codes.PermissionDenied, "bu guruh sizga tegishli emas")
+	}
+	rows, err := r.db.Query(`SELECT a.student_id, s.name, a.checked_in_at, COALESCE(a.check_in_device, ''), a.confirmed
+		FROM attendance a
+		JOIN students s ON s.id = a.student_id
+		WHERE a.group_id::text = $1 AND a.attend_date = $2 AND a.checked_in_at IS NOT NULL
+		ORDER BY a.checked_in_at`, lesson.groupId, lesson.date)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get check ins: %v", err)
+	}
+	defer rows.Close()
+	var response pb.GetLessonCheckInsResponse
+	for rows.Next() {
+		var (
+			checkIn     pb.LessonCheckIn
+			checkedInAt time.Time
+		)
+		if err := rows.Scan(&checkIn.StudentId, &checkIn.StudentName, &checkedInAt, &checkIn.Device, &checkIn.Confirmed); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to scan check in: %v", err)
+		}
+		checkIn.CheckedInAt = checkedInAt.Format("2006-01-02 15:04:05")
+		response.CheckIns = append(response.CheckIns, &checkIn)
+	}
+	return &response, rows.Err()
+}
+
+// ConfirmCheckIns confirms the waiting check ins of the students, a confirmed check in is billed like a marked
+// attendance and counts in the salary of whoever confirmed it. Rejected check ins are deleted.
+func (r *CheckInRepository) ConfirmCheckIns(ctx context.Context, companyId string, req *pb.ConfirmCheckInsRequest) (*pb.SetGroupAttendanceResponse, error) {
+	lesson, err := r.lessonOfCompany(companyId, req.LessonId)
+	if err != nil {
+		return nil, err
+	}
+	if !utils.CheckLessonTeacher(r.db, lesson.groupId, lesson.date, req.ActionByRole, req.ActionById) {
+		return nil, status.Errorf(codes.PermissionDenied, "bu guruh sizga tegishli emas")
+	}
+	// confirming marks the attendance, so the edit window of the policy applies as it does to SetAttendance
+	policy, err := r.policyRepo.GetAttendancePolicy(companyId)
+	if err != nil {
+		return nil, err
+	}
+	if !CanOverrideAttendancePolicy(policy, req.ActionByRole) {
+		if err := r.policyRepo.CheckAttendanceDate(policy, lesson.groupId, lesson.date, req.ActionById); err != nil {
+			return nil, err
+		}
+	}
+	if err := r.attendanceRepo.ensureFinanceClient(); err != nil {
+		return nil, fmt.Errorf("error while ensuring finance client %v", err)
+	}
+	ctx, c := utils.NewTimoutContext(ctx, companyId)
+	defer c()
+	if err := r.attendanceRepo.checkPeriodIsOpen(ctx, lesson.date); err != nil {
+		return nil, err
+	}
+	statuses, err := companyAttendanceStatuses(r.db, companyId)
+	if err != nil {
+		return nil, err
+	}
+	billing, err := getGroupBilling(r.db, lesson.groupId)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &pb.SetGroupAttendanceResponse{}
+	for _, studentId := range req.StudentIds {
+		result := &pb.GroupAttendanceResult{StudentId: studentId}
+		response.Results = append(response.Results, result)
+		if req.Reject {
+			deleted, err := r.db.Exec(`DELETE FROM attendance WHERE group_id::text = $1 AND student_id::text = $2 AND attend_date = $3 AND NOT confirmed`,
+				lesson.groupId, studentId, lesson.date)
+			if err != nil {
+				result.Message = err.Error()
+				continue
+			}
+			if count, _ := deleted.RowsAffected(); count == 0 {
+				result.Message = "tasdiqlanmagan check in topilmadi"
+				continue
+			}
+			result.Status, result.Success, result.Message = -1, true, "check in rejected"
+			continue
+		}
+		err := r.db.QueryRow(`UPDATE attendance SET confirmed = true, created_by = $1, creator_role = $2
+			WHERE group_id::text = $3 AND student_id::text = $4 AND attend_date = $5 AND NOT confirmed
+			RETURNING status`, req.ActionById, req.ActionByRole, lesson.groupId, studentId, lesson.date).Scan(&result.Status)
+		if errors.Is(err, sql.ErrNoRows) {
+			result.Message = "tasdiqlanmagan check in topilmadi"
+			continue
+		}
+		if err != nil {
+			result.Message = err.Error()
+			continue
+		}
+		if statuses[result.Status].billable {
+			if err := r.attendanceRepo.billAttendance(ctx, companyId, lesson.groupId, studentId, lesson.date, billing); err != nil {
+				r.db.Exec(`UPDATE attendance SET confirmed = false, created_by = student_id, creator_role = 'STUDENT'
+					WHERE group_id::text = $1 AND student_id::text = $2 AND attend_date = $3`, lesson.groupId, studentId, lesson.date)
+				result.Message = err.Error()
+				continue
+			}
+		}
+		result.Success, result.Message = true, "check in confirmed"
+	}
+	for _, result := range response.Results {
+		if result.Success {
+			response.Succeeded++
+		} else {
+			response.Failed++
+		}
+	}
+	return response, nil
+}
+
+func (r *CheckInRepository) companyBySubdomain(subdomain string) (string, error) {
+	var companyId string
+	err := r.db.QueryRow(`SELECT id FROM company WHERE subdomain = $1`, subdomain).Scan(&companyId)
+	if errors.Is(err, sql.ErrNoRows) {
+		return "", status.Errorf(codes.NotFound, "company not found")
+	}
+	if err != nil {
+		return "", status.Errorf(codes.Internal, "failed to get company: %v", err)
+	}
+	return companyId, nil
+}
+
+func (r *CheckInRepository) lessonOfCompany(companyId, lessonId string) (checkInLesson, error) {
+	var (
+		lesson     checkInLesson
+		lessonDate time.Time
+	)
+	err := r.db.QueryRow(`SELECT l.id, l.group_id, g.name, l.lesson_date, COALESCE(l.check_in_secret, '')
+		FROM lessons l
+		JOIN groups g ON g.id = l.group_id
+		WHERE l.id::text = $1 AND l.company_id = $2`, lessonId, companyId).
+		Scan(&lesson.id, &lesson.groupId, &lesson.groupName, &lessonDate, &lesson.secret)
+	if errors.Is(err, sql.ErrNoRows) {
+		return lesson, status.Errorf(codes.NotFound, "lesson not found")
+	}
+	if err != nil {
+		return lesson, status.Errorf(codes.Internal, "failed to get lesson: %v", err)
+	}
+	lesson.date = lessonDate.Format("2006-01-02")
+	return lesson, nil
+}
+
+// checkLessonIsToday keeps check in codes to the day of the lesson in the timezone of the attendance policy
+func (r *CheckInRepository) checkLessonIsToday(companyId string, lesson checkInLesson) error {
+	policy, err := r.policyRepo.GetAttendancePolicy(companyId)
+	if err != nil {
+		return err
+	}
+	location, err := time.LoadLocation(policy.Timezone)
+	if err != nil {
+		location = time.UTC
+	}
+	if time.Now().In(location).Format("2006-01-02") != lesson.date {
+		return status.Errorf(codes.FailedPrecondition, "check in faqat dars kuni ochiq")
+	}
+	return nil
+}
+
+// checkInStatus is the status a check in marks, the preloaded present status while it is active and the first
+// active billable status otherwise
+func checkInStatus(statuses map[int32]attendanceStatus) (attendanceStatus, bool) {
+	present, ok := statuses[1]
+	if ok && present.isActive && present.billable && !present.isMakeup {
+		return present, true
+	}
+	found := false
+	for _, st := range statuses {
+		if st.isActive && st.billable && !st.isMakeup && (!found || st.code < present.code) {
+			present, found = st, true
+		}
+	}
+	return present, found
+}
+
+// signCheckInToken builds the lesson id, the expiry and a signature of both made with the secret of the lesson
+func signCheckInToken(lessonId, secret string, expiresAt time.Time) string {
+	payload := lessonId + "." + strconv.FormatInt(expiresAt.Unix(), 10)
+	return payload + "." + checkInSignature(payload, secret)
+}
+
+func checkInSignature(payload, secret string) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(payload))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// checkInTokenLesson reads the lesson out of a token before its signature can be checked with the lesson secret
+func checkInTokenLesson(token string) (string, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 || parts[0] == "" {
+		return "", status.Errorf(codes.InvalidArgument, "QR kod noto'g'ri")
+	}
+	return parts[0], nil
+}
+
+func verifyCheckInToken(token, secret string, now time.Time) error {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 || secret == "" {
+		return status.Errorf(codes.InvalidArgument, "QR kod noto'g'ri")
+	}
+	payload := parts[0] + "." + parts[1]
+	if !hmac.Equal([]byte(parts[2]), []byte(checkInSignature(payload, secret))) {
+		return status.Errorf(codes.InvalidArgument, "QR kod noto'g'ri")
+	}
+	expiresAt, err := strconv.ParseInt(parts[1], 10, 64)
+	if err != nil {
+		return status.Errorf(codes.InvalidArgument, "QR kod noto'g'ri")
+	}
+	if now.Unix() > expiresAt {
+		return status.Errorf(codes.FailedPrecondition, "QR kod eskirgan, qaytadan skanerlang")
+	}
+	return nil
+}
+
+func phoneDigits(phone string) string {
+	return strings.Map(func(r rune) rune {
+		if r >= '0' && r <= '9' {
+			return r
+		}
+		return -1
+	}, phone)
+}
+
+func hashSecret(value string) string {
+	sum := sha256.Sum256([]byte(value))
+	return hex.EncodeToString(sum[:])
+}
+
+func NewCheckInRepository(db *sql.DB, attendanceRepo *AttendanceRepository, policyRepo *AttendancePolicyRepository) *CheckInRepository {
+	return &CheckInRepository{db: db, attendanceRepo: attendanceRepo, policyRepo: policyRepo}
+}
@@ -0,0 +1,39 @@
+package repository
+
+import (
+	"testing"
+	"time"
+)
+
+func TestVerifyCheckInToken(t *testing.T) {
+	now := time.Date(2024, 3, 4, 10, 0, 0, 0, time.UTC)
+	token := signCheckInToken("lesson-1", "secret", now.Add(checkInCodeSeconds*time.Second))
+
+	lessonId, err := checkInTokenLesson(token)
+	if err != nil || lessonId != "lesson-1" {
+		t.Fatalf("checkInTokenLesson() = %q, %v, want lesson-1", lessonId, err)
+	}
+	tests := []struct {
+		name    string
+		token   string
+		secret  string
+		now     time.Time
+		wantErr bool
+	}{
+		{name: "fresh code", token: token, secret: "secret", now: now},
+		{name: "just before expiry", token: token, secret: "secret", now: now.Add(checkInCodeSeconds * time.Second)},
+		{name: "expired code", token: token, secret: "secret", now: now.Add((checkInCodeSeconds + 1) * time.Second), wantErr: true},
+		{name: "secret of another lesson", token: token, secret: "other", now: now, wantErr: true},
+		{name: "expiry pushed forward", token: signCheckInToken("lesson-1", "secret", now.Add(time.Hour))[:len(token)-64] + token[len(token)-64:], secret: "secret", now: now, wantErr: true},
+		{name: "no secret yet", token: token, secret: "", now: now, wantErr: true},
+		{name: "malformed", token: "lesson-1", secret: "secret", now: now, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := verifyCheckInToken(tt.token, tt.secret, tt.now)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("verifyCheckInToken() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
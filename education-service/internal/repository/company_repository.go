@@ -88,7 +88,8 @@ func (r *CompanyRepository) CreateCompany(req *pb.CreateCompanyRequest) (*pb.Abs
 	if err := r.db.QueryRow(`SELECT EXISTS(SELECT 1 FROM company where company_phone =$1)`, req.CompanyPhone).Scan(&exists); err != nil || exists {
 		return nil, status.Error(codes.Aborted, "this phone number already have got in database")
 	}
-	_, err := r.db.Exec(`INSERT INTO company(title, avatar, start_time, end_time, company_phone, subdomain, valid_date, tariff_id, discount_id, is_demo) VALUES ($1,$2, $3, $4, $5, $6 , $7,  $8 , $9 , $10)`,
+	var companyId int64
+	err := r.db.QueryRow(`INSERT INTO company(title, avatar, start_time, end_time, company_phone, subdomain, valid_date, tariff_id, discount_id, is_demo) VALUES ($1,$2, $3, $4, $5, $6 , $7,  $8 , $9 , $10) RETURNING id`,
 		req.Title,
 		req.AvatarUrl,
 		req.StartTime,
@@ -99,10 +100,16 @@ func (r *CompanyRepository) CreateCompany(req *pb.CreateCompanyRequest) (*pb.Abs
 		req.TariffId,
 		req.DiscountId,
 		req.IsDemo,
-	)
+	).Scan(&companyId)
 	if err != nil {
 		return nil, err
 	}
+	if _, err = r.db.Exec(`SELECT seed_national_holidays($1)`, companyId); err != nil {
+		fmt.Println("error while seeding national holidays:", err)
+	}
+	if _, err = r.db.Exec(`SELECT seed_attendance_statuses($1)`, companyId); err != nil {
+		fmt.Println("error while seeding attendance statuses:", err)
+	}
 	return &pb.AbsResponse{
 		Status:  http.StatusOK,
 		Message: "company create",
@@ -3,7 +3,19 @@ package repository
 import (
 	"database/sql"
 	"education-service/proto/pb"
+	"errors"
 	"fmt"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"net/http"
+	"time"
+)
+
+const (
+	BillingMonthly   = "MONTHLY"
+	BillingPerLesson = "PER_LESSON"
+	BillingPackage   = "PACKAGE"
 )
 
 type CourseRepository struct {
@@ -15,20 +27,40 @@ func NewCourseRepository(db *sql.DB) *CourseRepository {
 }
 
 func (r *CourseRepository) CreateCourse(companyId, title, description string, durationLesson, courseDuration int32, price float64) error {
-	query := "INSERT INTO courses (title, duration_lesson, course_duration, price, description , company_id) VALUES ($1 , $2 , $3 , $4 , $5 , $6)"
-	_, err := r.db.Exec(query, title, durationLesson, courseDuration, price, description, companyId)
+	query := "INSERT INTO courses (title, duration_lesson, course_duration, price, description , company_id) VALUES ($1 , $2 , $3 , $4 , $5 , $6) RETURNING id"
+	var courseId int64
+	err := r.db.QueryRow(query, title, durationLesson, courseDuration, price, description, companyId).Scan(&courseId)
 	if err != nil {
 		return fmt.Errorf("failed to create course: %w", err)
 	}
+	// the first price version covers every date so earlier lessons never fall outside the history
+	_, err = r.db.Exec(`INSERT INTO course_price_history (id, course_id, price, effective_from, company_id) VALUES ($1, $2, $3, DATE '1970-01-01', $4)`,
+		uuid.New(), courseId, price, companyId)
+	if err != nil {
+		return fmt.Errorf("failed to save course price history: %w", err)
+	}
 	return nil
 }
 
 func (r *CourseRepository) UpdateCourse(companyId, title, description, id string, durationLesson, courseDuration int32, price float64) error {
+	var oldPrice float64
+	err := r.db.QueryRow("SELECT price FROM courses WHERE id = $1 and company_id=$2", id, companyId).Scan(&oldPrice)
+	if err != nil {
+		return fmt.Errorf("failed to update course: %w", err)
+	}
 	query := "UPDATE courses SET title=$1, duration_lesson=$2, course_duration=$3, price=$4, description=$5  WHERE id = $6 and company_id=$7"
-	_, err := r.db.Exec(query, title, durationLesson, courseDuration, price, description, id, companyId)
+	_, err = r.db.Exec(query, title, durationLesson, courseDuration, price, description, id, companyId)
 	if err != nil {
 		return fmt.Errorf("failed to update course: %w", err)
 	}
+	if oldPrice != price {
+		// a plain edit changes the price from today, earlier lessons keep the version they were priced with
+		_, err = r.db.Exec(`INSERT INTO course_price_history (id, course_id, price, effective_from, company_id) VALUES ($1, $2, $3, CURRENT_DATE, $4)`,
+			uuid.New(), id, price, companyId)
+		if err != nil {
+			return fmt.Errorf("failed to save course price history: %w", err)
+		}
+	}
 	return nil
 }
 
@@ -72,3 +104,235 @@ func (r *CourseRepository) GetCourseById(companyId, id string) (*pb.GetCourseByI
 	response.StudentCount = 5
 	return &response, nil
 }
+
+func (r *CourseRepository) GetCourseBilling(companyId, id string) (*pb.CourseBilling, error) {
+	var billing pb.CourseBilling
+	err := r.db.QueryRow(`SELECT id, billing_mode, lesson_price, package_lessons, package_price, package_valid_days, low_credit_lessons
+		FROM courses WHERE id = $1 AND company_id = $2`, id, companyId).
+		Scan(&billing.CourseId, &billing.BillingMode, &billing.LessonPrice, &billing.PackageLessons, &billing.PackagePrice, &billing.PackageValidDays, &billing.LowCreditLessons)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, status.Errorf(codes.NotFound, "course not found")
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get course billing: %v", err)
+	}
+	return &billing, nil
+}
+
+func (r *CourseRepository) UpdateCourseBilling(companyId string, req *pb.CourseBilling) (*pb.AbsResponse, error) {
+	switch req.BillingMode {
+	case BillingMonthly:
+	case BillingPerLesson:
+		if req.LessonPrice <= 0 {
+			return nil, status.Errorf(codes.InvalidArgument, "lessonPrice is required for PER_LESSON billing")
+		}
+	case BillingPackage:
+		if req.PackageLessons <= 0 || req.PackagePrice <= 0 {
+			return nil, status.Errorf(codes.InvalidArgument, "packageLessons and packagePrice are required for PACKAGE billing")
+		}
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "invalid billing mode %s", req.BillingMode)
+	}
+	if req.LessonPrice < 0 || req.PackageValidDays < 0 || req.LowCreditLessons < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "billing values can not be negative")
+	}
+	result, err := r.db.Exec(`UPDATE courses SET billing_mode = $1, lesson_price = $2, package_lessons = $3, package_price = $4, package_valid_days = $5, low_credit_lessons = $6
+		WHERE id = $7 AND company_id = $8`,
+		req.BillingMode, req.LessonPrice, req.PackageLessons, req.PackagePrice, req.PackageValidDays, req.LowCreditLessons, req.CourseId, companyId)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to update course billing: %v", err)
+	}
+	if affected, _ := result.RowsAffected(); affected == 0 {
+		return nil, status.Errorf(codes.NotFound, "course not found")
+	}
+	return &pb.AbsResponse{Status: http.StatusOK, Message: "course billing updated"}, nil
+}
+
+// ScheduleCoursePrice adds a course price version used from effectiveFrom on, with grandfatherExisting the students
+// enrolled in the course keep paying the price valid the day before
+func (r *CourseRepository) ScheduleCoursePrice(companyId string, req *pb.ScheduleCoursePriceRequest) (resp *pb.AbsResponse, err error) {
+	if req.Price <= 5000 {
+		return nil, status.Errorf(codes.InvalidArgument, "price must be greater than 5000")
+	}
+	effectiveFrom := time.Now()
+	if req.EffectiveFrom != "" {
+		effectiveFrom, err = time.Parse("2006-01-02", req.EffectiveFrom)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid effectiveFrom %s", req.EffectiveFrom)
+		}
+	}
+	effectiveDate := effectiveFrom.Format("2006-01-02")
+	tx, err := r.db.Begin()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to begin transaction: %v", err)
+	}
+	defer func() {
+		if err != nil {
+			tx.Rollback()
+		} else {
+			err = tx.Commit()
+		}
+	}()
+	var courseId int64
+	err = tx.QueryRow(`SELECT id FROM courses WHERE id = $1 AND company_id = $2 FOR UPDATE`, req.CourseId, companyId).Scan(&courseId)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, status.Errorf(codes.NotFound, "course not found")
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get course: %v", err)
+	}
+	if req.GrandfatherExisting {
+		_, err = tx.Exec(`UPDATE group_students gs
+			SET locked_price = COALESCE((SELECT h.price FROM course_price_history h
+					WHERE h.course_id = c.id AND h.effective_from < $2::date
+					ORDER BY h.effective_from DESC, h.created_at DESC LIMIT 1), c.price),
+				locked_price_from = $2::date
+			FROM groups g
+			JOIN courses c ON c.id = g.course_id
+			WHERE g.id = gs.group_id AND c.id = $1 AND gs.company_id = $3
+				AND gs.condition IN ('ACTIVE', 'FREEZE') AND gs.locked_price IS NULL`, courseId, effectiveDate, companyId)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to grandfather enrollments: %v", err)
+		}
+	}
+	id := uuid.New()
+	_, err = tx.Exec(`INSERT INTO course_price_history (id, course_id, price, effective_from, grandfather_existing, created_by_id, created_by_name, company_id)
+		VALUES ($1, $2, $3, $4, $5, NULLIF($6, '')::uuid, $7, $8)`,
+		id, courseId, req.Price, effectiveDate, req.GrandfatherExisting, req.ActionById, req.ActionByName, companyId)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to save course price: %v", err)
+	}
+	if !effectiveFrom.After(time.Now()) {
+		if _, err = tx.Exec(`UPDATE courses SET price = $1 WHERE id = $2`, req.Price, courseId); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to update course price: %v", err)
+		}
+	}
+	return &pb.AbsResponse{Status: http.StatusOK, Message: id.String()}, nil
+}
+
+func (r *CourseRepository) GetCoursePriceHistory(companyId, courseId string) (*pb.GetCoursePriceHistoryResponse, error) {
+	rows, err := r.db.Query(`SELECT id, price, effective_from, grandfather_existing, COALESCE(created_by_name, ''), created_at
+		FROM course_price_history WHERE course_id = $1 AND company_id = $2
+		ORDER BY effective_from DESC, created_at DESC`, courseId, companyId)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get course price history: %v", err)
+	}
+	defer rows.Close()
+	var response pb.GetCoursePriceHistoryResponse
+	for rows.Next() {
+		var (
+			version       pb.CoursePriceVersion
+			effectiveFrom time.Time
+			createdAt     time.Time
+		)
+		if err := rows.Scan(&version.Id, &version.Price, &effectiveFrom, &version.GrandfatherExisting, &version.CreatedByName, &createdAt); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to scan course price history: %v", err)
+		}
+		version.EffectiveFrom = effectiveFrom.Format("2006-01-02")
+		version.CreatedAt = createdAt.Format("2006-01-02 15:04:05")
+		response.Versions = append(response.Versions, &version)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to read course price history: %v", err)
+	}
+	lockRows, err := r.db.Query(`SELECT gs.student_id, s.name, g.id, g.name, gs.locked_price, gs.locked_price_from
+		FROM group_students gs
+		JOIN groups g ON g.id = gs.group_id
+		JOIN students s ON s.id = gs.student_id
+		WHERE g.course_id = $1 AND gs.company_id = $2 AND gs.locked_price IS NOT NULL
+		ORDER BY g.name, s.name`, courseId, companyId)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get enrollment price locks: %v", err)
+	}
+	defer lockRows.Close()
+	for lockRows.Next() {
+		var (
+			lock       pb.EnrollmentPriceLock
+			lockedFrom time.Time
+		)
+		if err := lockRows.Scan(&lock.StudentId, &lock.StudentName, &lock.GroupId, &lock.GroupName, &lock.Price, &lockedFrom); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to scan enrollment price lock: %v", err)
+		}
+		lock.LockedFrom = lockedFrom.Format("2006-01-02")
+		response.Locks = append(response.Locks, &lock)
+	}
+	return &response, lockRows.Err()
+}
+
+// SetEnrollmentPriceLock grandfathers one enrollment on a fixed monthly price, a zero price removes the lock
+func (r *CourseRepository) SetEnrollmentPriceLock(companyId string, req *pb.SetEnrollmentPriceLockRequest) (*pb.AbsResponse, error) {
+	if req.Price < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "price can not be negative")
+	}
+	lockedFrom := time.Now().Format("2006-01-02")
+	if req.LockedFrom != "" {
+		parsed, err := time.Parse("2006-01-02", req.LockedFrom)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid lockedFrom %s", req.LockedFrom)
+		}
+		lockedFrom = parsed.Format("2006-01-02")
+	}
+	var (
+		result sql.Result
+		err    error
+	)
+	if req.Price == 0 {
+		result, err = r.db.Exec(`UPDATE group_students SET locked_price = NULL, locked_price_from = NULL
+			WHERE student_id = $1 AND group_id = $2 AND company_id = $3`, req.StudentId, req.GroupId, companyId)
+	} else {
+		result, err = r.db.Exec(`UPDATE group_students SET locked_price = $1, locked_price_from = $2
+			WHERE student_id = $3 AND group_id = $4 AND company_id = $5`, req.Price, lockedFrom, req.StudentId, req.GroupId, companyId)
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to set enrollment price lock: %v", err)
+	}
+	if affected, _ := result.RowsAffected(); affected == 0 {
+		return nil, status.Errorf(codes.NotFound, "student is not enrolled in the group")
+	}
+	return &pb.AbsResponse{Status: http.StatusOK, Message: "enrollment price updated"}, nil
+}
+
+// CoursePriceActivator copies the price version effective today into courses.price once a scheduled price starts
+func (r *CourseRepository) CoursePriceActivator() {
+	_, err := r.db.Exec(`UPDATE courses c SET price = h.price
+		FROM (SELECT DISTINCT ON (course_id) course_id, price FROM course_price_history
+			WHERE effective_from <= CURRENT_DATE
+			ORDER BY course_id, effective_from DESC, created_at DESC) h
+		WHERE h.course_id = c.id AND c.price <> h.price`)
+	if err != nil {
+		fmt.Println("error while activating course prices:", err)
+	}
+}
+
+// groupBilling is the billing of a group, the group mode overrides the mode of its course
+type groupBilling struct {
+	mode             string
+	lessonPrice      float64
+	packageLessons   int32
+	packagePrice     float64
+	packageValidDays int32
+	lowCreditLessons int32
+}
+
+func getGroupBilling(db *sql.DB, groupId string) (groupBilling, error) {
+	var billing groupBilling
+	err := db.QueryRow(`SELECT COALESCE(g.billing_mode, c.billing_mode), c.lesson_price, c.package_lessons, c.package_price, c.package_valid_days, c.low_credit_lessons
+		FROM groups g JOIN courses c ON c.id = g.course_id
+		WHERE g.id = $1`, groupId).
+		Scan(&billing.mode, &billing.lessonPrice, &billing.packageLessons, &billing.packagePrice, &billing.packageValidDays, &billing.lowCreditLessons)
+	if err != nil {
+		return billing, fmt.Errorf("failed to get group billing: %v", err)
+	}
+	return billing, nil
+}
+
+// perLessonPrice is the price of a single lesson, a package lesson costs its share of the package price
+func (b groupBilling) perLessonPrice() float64 {
+	if b.lessonPrice > 0 {
+		return b.lessonPrice
+	}
+	if b.packageLessons > 0 {
+		return b.packagePrice / float64(b.packageLessons)
+	}
+	return 0
+}
@@ -6,13 +6,16 @@ import (
 	"education-service/internal/clients"
 	"education-service/internal/utils"
 	"education-service/proto/pb"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"github.com/lib/pq"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"log"
+	"net/http"
 	"strconv"
+	"time"
 )
 
 type GroupRepository struct {
@@ -23,25 +26,50 @@ type GroupRepository struct {
 func NewGroupRepository(db *sql.DB, userClient *clients.UserClient) *GroupRepository {
 	return &GroupRepository{db: db, userClient: userClient}
 }
-func (r *GroupRepository) CreateGroup(companyId string, name string, courseId int32, teacherId string, dateType string, days []string, roomId int32, lessonStartTime string, groupStartDate string, groupEndDate string) (string, error) {
+
+// CreateGroup saves a new group, a room or teacher already booked at its lesson time is refused unless force is set,
+// then the override is kept for audit
+func (r *GroupRepository) CreateGroup(companyId string, name string, courseId int32, teacherId string, dateType string, days []string, roomId int32, lessonStartTime string, groupStartDate string, groupEndDate string, force bool, actionById string, actionByName string) (string, error) {
+	conflicts, err := findScheduleConflicts(r.db, companyId, scheduleSlot{courseId: courseId, teacherId: teacherId, roomId: roomId, dateType: dateType,
+		days: days, startTime: lessonStartTime, startDate: groupStartDate, endDate: groupEndDate})
+	if err != nil {
+		return "", err
+	}
+	if len(conflicts) > 0 && !force {
+		return "", scheduleConflictError(conflicts)
+	}
 	query := `
 		INSERT INTO groups(course_id, teacher_id, room_id, date_type, days, start_time, start_date, end_date, is_archived, name, company_id) 
 		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10 , $11) 
 		RETURNING id`
 
 	var groupId string
-	err := r.db.QueryRow(query, courseId, teacherId, roomId, dateType, pq.Array(days), lessonStartTime, groupStartDate, groupEndDate, false, name, companyId).Scan(&groupId)
+	err = r.db.QueryRow(query, courseId, teacherId, roomId, dateType, pq.Array(days), lessonStartTime, groupStartDate, groupEndDate, false, name, companyId).Scan(&groupId)
 	if err != nil {
 		return "", err
 	}
+	if len(conflicts) > 0 {
+		recordConflictOverride(r.db, companyId, groupId, OverrideCreateGroup, conflicts, actionById, actionByName)
+	}
 	return groupId, nil
 }
-func (r *GroupRepository) UpdateGroup(companyId string, id string, name string, courseId int32, teacherId string, dateType string, days []string, roomId int32, lessonStartTime string, groupStartDate string, groupEndDate string) error {
+func (r *GroupRepository) UpdateGroup(companyId string, id string, name string, courseId int32, teacherId string, dateType string, days []string, roomId int32, lessonStartTime string, groupStartDate string, groupEndDate string, force bool, actionById string, actionByName string) error {
+	conflicts, err := findScheduleConflicts(r.db, companyId, scheduleSlot{groupId: id, courseId: courseId, teacherId: teacherId, roomId: roomId,
+		dateType: dateType, days: days, startTime: lessonStartTime, startDate: groupStartDate, endDate: groupEndDate})
+	if err != nil {
+		return err
+	}
+	if len(conflicts) > 0 && !force {
+		return scheduleConflictError(conflicts)
+	}
 	query := `UPDATE groups SET course_id=$1, teacher_id=$2, room_id=$3, date_type=$4, days=$5, start_time=$6, start_date=$7, end_date=$8, name=$9 WHERE id=$10 and company_id=$11`
-	_, err := r.db.Exec(query, courseId, teacherId, roomId, dateType, pq.Array(days), lessonStartTime, groupStartDate, groupEndDate, name, id, companyId)
+	_, err = r.db.Exec(query, courseId, teacherId, roomId, dateType, pq.Array(days), lessonStartTime, groupStartDate, groupEndDate, name, id, companyId)
 	if err != nil {
 		return err
 	}
+	if len(conflicts) > 0 {
+		recordConflictOverride(r.db, companyId, id, OverrideUpdateGroup, conflicts, actionById, actionByName)
+	}
 	return nil
 }
 func (r *GroupRepository) DeleteGroup(companyId string, id string) error {
@@ -496,3 +524,113 @@ func (r *GroupRepository) GetLeftAfterTrial(companyId string, from string, to st
 
 	return resp, nil
 }
+
+// GetGroupsByIds returns name, course and teacher of the requested groups in one query
+func (r *GroupRepository) GetGroupsByIds(ctx context.Context, companyId string, ids []string) (*pb.GetGroupsByIdsResponse, error) {
+	response := &pb.GetGroupsByIdsResponse{}
+	if len(ids) == 0 {
+		return response, nil
+	}
+	rows, err := r.db.Query(`SELECT g.id, g.name, g.course_id, COALESCE(c.title, ''), g.teacher_id
+		FROM groups g LEFT JOIN courses c ON g.course_id = c.id
+		WHERE g.company_id = $1 AND g.id::text = ANY($2)`, companyId, pq.Array(ids))
+	if err != nil {
+		return nil, fmt.Errorf("error querying database: %w", err)
+	}
+	defer rows.Close()
+	ctx, cancelFunc := utils.NewTimoutContext(ctx, companyId)
+	defer cancelFunc()
+	teacherNames := make(map[string]string)
+	for rows.Next() {
+		var group pb.GroupMeta
+		if err := rows.Scan(&group.Id, &group.Name, &group.CourseId, &group.CourseName, &group.TeacherId); err != nil {
+			return nil, fmt.Errorf("error scanning group: %w", err)
+		}
+		teacherName, ok := teacherNames[group.TeacherId]
+		if !ok {
+			teacherName, _ = r.userClient.GetTeacherById(ctx, group.TeacherId)
+			teacherNames[group.TeacherId] = teacherName
+		}
+		group.TeacherName = teacherName
+		response.Groups = append(response.Groups, &group)
+	}
+	return response, rows.Err()
+}
+
+func (r *GroupRepository) UpdateGroupBillingMode(companyId, groupId, billingMode string) (*pb.AbsResponse, error) {
+	var mode any
+	switch billingMode {
+	case "":
+	case BillingMonthly, BillingPerLesson, BillingPackage:
+		mode = billingMode
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "invalid billing mode %s", billingMode)
+	}
+	result, err := r.db.Exec(`UPDATE groups SET billing_mode = $1 WHERE id = $2 AND company_id = $3`, mode, groupId, companyId)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to update group billing mode: %v", err)
+	}
+	if affected, _ := result.RowsAffected(); affected == 0 {
+		return nil, status.Errorf(codes.NotFound, "group not found")
+	}
+	return &pb.AbsResponse{Status: http.StatusOK, Message: "group billing mode updated"}, nil
+}
+
+// UpdateGroupMaxStudents limits the seats of the group, 0 goes back to the capacity of its room. Seats the change frees
+// are offered to the waitlist.
+func (r *GroupRepository) UpdateGroupMaxStudents(companyId, groupId string, maxStudents int32) (*pb.AbsResponse, error) {
+	if maxStudents < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "maxStudents cannot be negative")
+	}
+	result, err := r.db.Exec(`UPDATE groups SET max_students = NULLIF($1, 0) WHERE id = $2 AND company_id = $3`, maxStudents, groupId, companyId)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to update group max students: %v", err)
+	}
+	if affected, _ := result.RowsAffected(); affected == 0 {
+		return nil, status.Errorf(codes.NotFound, "group not found")
+	}
+	go offerWaitlistSeats(r.db, companyId, groupId)
+	return &pb.AbsResponse{Status: http.StatusOK, Message: "group max students updated"}, nil
+}
+
+func (r *GroupRepository) CheckScheduleConflicts(companyId string, req *pb.CheckScheduleConflictsRequest) (*pb.ScheduleConflicts, error) {
+	conflicts, err := findScheduleConflicts(r.db, companyId, scheduleSlot{groupId: req.GroupId, courseId: req.CourseId, teacherId: req.TeacherId,
+		roomId: req.RoomId, dateType: req.Type, days: req.Days, startTime: req.LessonStartTime, startDate: req.GroupStartDate, endDate: req.GroupEndDate})
+	if err != nil {
+		return nil, err
+	}
+	return &pb.ScheduleConflicts{Conflicts: conflicts}, nil
+}
+
+func (r *GroupRepository) GetScheduleConflictOverrides(companyId string, req *pb.GetScheduleConflictOverridesRequest) (*pb.GetScheduleConflictOverridesResponse, error) {
+	rows, err := r.db.Query(`SELECT o.id, o.group_id, g.name, o.action, o.conflicts, COALESCE(o.forced_by_id::text, ''), COALESCE(o.forced_by_name, ''), o.created_at
+		FROM schedule_conflict_override o
+		JOIN groups g ON g.id = o.group_id
+		WHERE o.company_id = $1
+			AND (NULLIF($2, '') IS NULL OR o.created_at >= NULLIF($2, '')::date)
+			AND (NULLIF($3, '') IS NULL OR o.created_at < NULLIF($3, '')::date + 1)
+		ORDER BY o.created_at DESC`, companyId, req.From, req.Till)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get schedule conflict overrides: %v", err)
+	}
+	defer rows.Close()
+	var response pb.GetScheduleConflictOverridesResponse
+	for rows.Next() {
+		var (
+			override  pb.ScheduleConflictOverride
+			conflicts []byte
+			createdAt time.Time
+		)
+		err := rows.Scan(&override.Id, &override.GroupId, &override.GroupName, &override.Action, &conflicts, &override.ForcedById,
+			&override.ForcedByName, &createdAt)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to scan schedule conflict override: %v", err)
+		}
+		if err := json.Unmarshal(conflicts, &override.Conflicts); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to decode schedule conflicts: %v", err)
+		}
+		override.CreatedAt = createdAt.Format("2006-01-02 15:04:05")
+		response.Overrides = append(response.Overrides, &override)
+	}
+	return &response, rows.Err()
+}
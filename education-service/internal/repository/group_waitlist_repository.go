@@ -0,0 +1,355 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"education-service/proto/pb"
+	"errors"
+	"fmt"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"net/http"
+	"strings"
+	"time"
+)
+
+const (
+	WaitlistWaiting   = "WAITING"
+	WaitlistOffered   = "OFFERED"
+	WaitlistEnrolled  = "ENROLLED"
+	WaitlistExpired   = "EXPIRED"
+	WaitlistCancelled = "CANCELLED"
+
+	EnrollAdded      = "ADDED"
+	EnrollWaitlisted = "WAITLISTED"
+	EnrollFailed     = "FAILED"
+
+	// waitlistHoldHours is how long a freed seat is kept for the student it was offered to
+	waitlistHoldHours = 48
+)
+
+type GroupWaitlistRepository struct {
+	db          *sql.DB
+	studentRepo *StudentRepository
+}
+
+// groupSeats locks the group until tx ends and counts its seats. capacity is the group maximum or the capacity of its
+// room, 0 when neither limits it. taken counts the students in the group and the seats held for offers other than the
+// one of studentId.
+func groupSeats(tx *sql.Tx, groupId, studentId string) (capacity, taken int, err error) {
+	err = tx.QueryRow(`SELECT COALESCE(g.max_students, r.capacity, 0) FROM groups g LEFT JOIN rooms r ON r.id = g.room_id WHERE g.id = $1 FOR UPDATE OF g`, groupId).
+		Scan(&capacity)
+	if err != nil {
+		return 0, 0, fmt.Errorf("failed to get group capacity: %v", err)
+	}
+	err = tx.QueryRow(`SELECT (SELECT COUNT(*) FROM group_students WHERE group_id = $1 AND condition <> 'DELETE') +
+			(SELECT COUNT(*) FROM group_waitlist WHERE group_id = $1 AND status = 'OFFERED' AND hold_until > NOW() AND student_id::text <> $2)`,
+		groupId, studentId).Scan(&taken)
+	if err != nil {
+		return 0, 0, fmt.Errorf("failed to count group seats: %v", err)
+	}
+	return capacity, taken, nil
+}
+
+// waitlistAhead tells whether students wait for a seat of the group ahead of studentId, a seat that frees up then
+// belongs to the waitlist and only a student holding an offer takes it
+func waitlistAhead(tx *sql.Tx, groupId, studentId string) (bool, error) {
+	var ahead bool
+	err := tx.QueryRow(`SELECT EXISTS(SELECT 1 FROM group_waitlist WHERE group_id = $1 AND status = 'WAITING' AND student_id::text <> $2)
+			AND NOT EXISTS(SELECT 1 FROM group_waitlist WHERE group_id = $1 AND student_id::text = $2 AND status = 'OFFERED' AND hold_until > NOW())`,
+		groupId, studentId).Scan(&ahead)
+	if err != nil {
+		return false, fmt.Errorf("failed to check waitlist: %v", err)
+	}
+	return ahead, nil
+}
+
+// addToWaitlist puts the student at the end of the waitlist of the group and returns its place, a student already
+// waiting keeps its place
+func addToWaitlist(tx *sql.Tx, companyId, groupId, studentId, createdBy, createdByName string) (int32, error) {
+	var waiting bool
+	err := tx.QueryRow(`SELECT EXISTS(SELECT 1 FROM group_waitlist WHERE group_id = $1 AND student_id::text = $2 AND status in ('WAITING', 'OFFERED'))`,
+		groupId, studentId).Scan(&waiting)
+	if err != nil {
+		return 0, fmt.Errorf("failed to check waitlist: %v", err)
+	}
+	if !waiting {
+		_, err = tx.Exec(`INSERT INTO group_waitlist (id, group_id, student_id, position, created_by, created_by_name, company_id)
+			VALUES ($1, $2, $3, (SELECT COALESCE(MAX(position), 0) + 1 FROM group_waitlist WHERE group_id = $2), NULLIF($4, '')::uuid, $5, $6)`,
+			uuid.New(), groupId, studentId, createdBy, createdByName, companyId)
+		if err != nil {
+			return 0, fmt.Errorf("failed to add student to waitlist: %v", err)
+		}
+	}
+	var position int32
+	err = tx.QueryRow(`SELECT COUNT(*) FROM group_waitlist w
+		JOIN group_waitlist own ON own.group_id = w.group_id AND own.student_id::text = $2 AND own.status = 'WAITING'
+		WHERE w.group_id = $1 AND w.status = 'WAITING' AND w.position <= own.position`, groupId, studentId).Scan(&position)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get waitlist position: %v", err)
+	}
+	return position, nil
+}
+
+type waitlistOffer struct {
+	studentId string
+	holdUntil time.Time
+}
+
+// offerWaitlistSeats offers each free seat of the group to the next WAITING student, the seat is held for
+// waitlistHoldHours and the student gets the WAITLIST_SEAT_OFFER_ALERT sms
+func offerWaitlistSeats(db *sql.DB, companyId, groupId string) {
+	offers, err := holdWaitlistSeats(db, groupId)
+	if err != nil {
+		fmt.Println("[offerWaitlistSeats]", err)
+		return
+	}
+	if len(offers) == 0 {
+		return
+	}
+	var groupName string
+	db.QueryRow(`SELECT name FROM groups WHERE id = $1`, groupId).Scan(&groupName)
+	for _, offer := range offers {
+		holdUntil := offer.holdUntil.Format("2006-01-02 15:04")
+		err = createNotification(db, companyId, notification{
+			kind:           NotificationWaitlistSeatOffered,
+			title:          "Navbatdagi o'quvchiga joy taklif qilindi",
+			body:           fmt.Sprintf("%s guruhida bo'shagan joy %s gacha o'quvchi uchun band qilindi", groupName, holdUntil),
+			studentId:      offer.studentId,
+			groupId:        groupId,
+			recipientRoles: []string{"ADMIN", "CEO"},
+		})
+		if err != nil {
+			fmt.Println("[offerWaitlistSeats]", err)
+		}
+		sendActionSms(db, companyId, offer.studentId, groupId, "WAITLIST_SEAT_OFFER_ALERT", strings.NewReplacer("(HOLD_UNTIL)", holdUntil), false)
+	}
+}
+
+func holdWaitlistSeats(db *sql.DB, groupId string) (offers []waitlistOffer, err error) {
+	tx, err := db.Begin()
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %v", err)
+	}
+	defer func() {
+		if err != nil {
+			tx.Rollback()
+		} else {
+			err = tx.Commit()
+		}
+	}()
+	capacity, taken, err := groupSeats(tx, groupId, "")
+	if err != nil {
+		return nil, err
+	}
+	// a group without a limit has room for everybody waiting
+	free := capacity - taken
+	if capacity == 0 {
+		free = 0
+	} else if free <= 0 {
+		return nil, nil
+	}
+	rows, err := tx.Query(`UPDATE group_waitlist SET status = 'OFFERED', offered_at = NOW(), hold_until = NOW() + make_interval(hours => $2)
+		WHERE id IN (SELECT id FROM group_waitlist WHERE group_id = $1 AND status = 'WAITING' ORDER BY position LIMIT NULLIF($3::int, 0) FOR UPDATE)
+		RETURNING student_id, hold_until`, groupId, waitlistHoldHours, free)
+	if err != nil {
+		return nil, fmt.Errorf("failed to offer waitlist seats: %v", err)
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var offer waitlistOffer
+		if err = rows.Scan(&offer.studentId, &offer.holdUntil); err != nil {
+			return nil, fmt.Errorf("failed to scan waitlist offer: %v", err)
+		}
+		offers = append(offers, offer)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+	return offers, nil
+}
+
+// ExpireWaitlistOffers ends the offers whose hold passed and offers the free seats of every group with waiting
+// students, seats freed without a DELETE of the student are picked up here as well
+func (r *GroupWaitlistRepository) ExpireWaitlistOffers() {
+	if _, err := r.db.Exec(`UPDATE group_waitlist SET status = 'EXPIRED' WHERE status = 'OFFERED' AND hold_until <= NOW()`); err != nil {
+		fmt.Println("[ExpireWaitlistOffers]", err)
+		return
+	}
+	rows, err := r.db.Query(`SELECT DISTINCT company_id, group_id FROM group_waitlist WHERE status = 'WAITING'`)
+	if err != nil {
+		fmt.Println("[ExpireWaitlistOffers]", err)
+		return
+	}
+	var groups [][2]string
+	for rows.Next() {
+		var group [2]string
+		if err := rows.Scan(&group[0], &group[1]); err != nil {
+			fmt.Println("[ExpireWaitlistOffers]", err)
+			continue
+		}
+		groups = append(groups, group)
+	}
+	rows.Close()
+	for _, group := range groups {
+		offerWaitlistSeats(r.db, group[0], group[1])
+	}
+}
+
+func (r *GroupWaitlistRepository) GetGroupWaitlist(companyId string, req *pb.GetGroupWaitlistRequest) (*pb.GetGroupWaitlistResponse, error) {
+	var response pb.GetGroupWaitlistResponse
+	err := r.db.QueryRow(`SELECT COALESCE(g.max_students, r.capacity, 0),
+			(SELECT COUNT(*) FROM group_students gs WHERE gs.group_id = g.id AND gs.condition <> 'DELETE'),
+			(SELECT COUNT(*) FROM group_waitlist w WHERE w.group_id = g.id AND w.status = 'OFFERED' AND w.hold_until > NOW())
+		FROM groups g LEFT JOIN rooms r ON r.id = g.room_id
+		WHERE g.id::text = $1 AND g.company_id = $2`, req.GroupId, companyId).Scan(&response.Capacity, &response.Enrolled, &response.Held)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, status.Errorf(codes.NotFound, "group not found")
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get group seats: %v", err)
+	}
+	rows, err := r.db.Query(`SELECT w.id, w.student_id, s.name, s.phone, ROW_NUMBER() OVER (PARTITION BY w.status ORDER BY w.position), w.status,
+			w.offered_at, w.hold_until, COALESCE(w.created_by_name, ''), w.created_at
+		FROM group_waitlist w
+		JOIN students s ON s.id = w.student_id
+		WHERE w.group_id::text = $1 AND w.company_id = $2 AND ($3 OR w.status in ('WAITING', 'OFFERED'))
+		ORDER BY CASE w.status WHEN 'OFFERED' THEN 0 WHEN 'WAITING' THEN 1 ELSE 2 END, w.position, w.created_at DESC`,
+		req.GroupId, companyId, req.WithHistory)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get waitlist: %v", err)
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var (
+			entry                pb.WaitlistEntry
+			offeredAt, holdUntil sql.NullTime
+			createdAt            time.Time
+		)
+		err := rows.Scan(&entry.Id, &entry.StudentId, &entry.StudentName, &entry.StudentPhone, &entry.Position, &entry.Status,
+			&offeredAt, &holdUntil, &entry.CreatedByName, &createdAt)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to scan waitlist entry: %v", err)
+		}
+		if entry.Status != WaitlistWaiting {
+			entry.Position = 0
+		}
+		if offeredAt.Valid {
+			entry.OfferedAt = offeredAt.Time.Format("2006-01-02 15:04:05")
+		}
+		if holdUntil.Valid {
+			entry.HoldUntil = holdUntil.Time.Format("2006-01-02 15:04:05")
+		}
+		entry.CreatedAt = createdAt.Format("2006-01-02 15:04:05")
+		response.Entries = append(response.Entries, &entry)
+	}
+	return &response, rows.Err()
+}
+
+// MoveWaitlistEntry puts a waiting student at position of the waitlist, the others keep their order
+func (r *GroupWaitlistRepository) MoveWaitlistEntry(companyId string, req *pb.MoveWaitlistEntryRequest) (_ *pb.AbsResponse, err error) {
+	if req.Position < 1 {
+		return nil, status.Errorf(codes.InvalidArgument, "position starts from 1")
+	}
+	tx, err := r.db.Begin()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to begin transaction: %v", err)
+	}
+	defer func() {
+		if err != nil {
+			tx.Rollback()
+		} else {
+			err = tx.Commit()
+		}
+	}()
+	var groupId string
+	err = tx.QueryRow(`SELECT group_id FROM group_waitlist WHERE id::text = $1 AND company_id = $2 AND status = 'WAITING'`, req.Id, companyId).Scan(&groupId)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, status.Errorf(codes.NotFound, "waiting student not found")
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get waitlist entry: %v", err)
+	}
+	rows, err := tx.Query(`SELECT id FROM group_waitlist WHERE group_id = $1 AND status = 'WAITING' ORDER BY position FOR UPDATE`, groupId)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get waitlist: %v", err)
+	}
+	var ids []string
+	for rows.Next() {
+		var id string
+		if err = rows.Scan(&id); err != nil {
+			rows.Close()
+			return nil, status.Errorf(codes.Internal, "failed to scan waitlist entry: %v", err)
+		}
+		ids = append(ids, id)
+	}
+	rows.Close()
+	for i, id := range reorderWaitlist(ids, req.Id, int(req.Position)) {
+		if _, err = tx.Exec(`UPDATE group_waitlist SET position = $1 WHERE id = $2`, i+1, id); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to move waitlist entry: %v", err)
+		}
+	}
+	return &pb.AbsResponse{Status: http.StatusOK, Message: "waitlist entry moved"}, nil
+}
+
+// reorderWaitlist moves id to position (from 1) of ids, a position past the end puts it last
+func reorderWaitlist(ids []string, id string, position int) []string {
+	ordered := make([]string, 0, len(ids))
+	for _, other := range ids {
+		if other != id {
+			ordered = append(ordered, other)
+		}
+	}
+	if position > len(ordered)+1 {
+		position = len(ordered) + 1
+	}
+	ordered = append(ordered[:position-1], append([]string{id}, ordered[position-1:]...)...)
+	return ordered
+}
+
+// CancelWaitlistEntry takes the student off the waitlist, a seat it was offered goes to the next student
+func (r *GroupWaitlistRepository) CancelWaitlistEntry(companyId string, req *pb.WaitlistEntryRequest) (*pb.AbsResponse, error) {
+	var groupId string
+	var offered bool
+	err := r.db.QueryRow(`UPDATE group_waitlist w SET status = 'CANCELLED'
+		FROM group_waitlist old
+		WHERE w.id = old.id AND w.id::text = $1 AND w.company_id = $2 AND w.status in ('WAITING', 'OFFERED')
+		RETURNING w.group_id, old.status = 'OFFERED'`, req.Id, companyId).Scan(&groupId, &offered)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, status.Errorf(codes.NotFound, "waitlist entry not found")
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to cancel waitlist entry: %v", err)
+	}
+	if offered {
+		go offerWaitlistSeats(r.db, companyId, groupId)
+	}
+	return &pb.AbsResponse{Status: http.StatusOK, Message: "waitlist entry cancelled"}, nil
+}
+
+// AcceptWaitlistOffer enrolls the student into the seat held for it, from today when createdDate is empty
+func (r *GroupWaitlistRepository) AcceptWaitlistOffer(ctx context.Context, companyId string, req *pb.AcceptWaitlistOfferRequest) (*pb.AddToGroupResponse, error) {
+	var groupId, studentId string
+	err := r.db.QueryRow(`SELECT group_id, student_id FROM group_waitlist WHERE id::text = $1 AND company_id = $2 AND status = 'OFFERED' AND hold_until > NOW()`,
+		req.Id, companyId).Scan(&groupId, &studentId)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, status.Errorf(codes.FailedPrecondition, "taklif topilmadi yoki muddati o'tgan")
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get waitlist offer: %v", err)
+	}
+	createdDate := req.CreatedDate
+	if createdDate == "" {
+		createdDate = time.Now().Format("2006-01-02")
+	}
+	results, err := r.studentRepo.AddToGroup(ctx, companyId, groupId, []string{studentId}, createdDate, req.CreatedBy, req.CreatedByName, req.PromoCode)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.AddToGroupResponse{Results: results}, nil
+}
+
+func NewGroupWaitlistRepository(db *sql.DB, studentRepo *StudentRepository) *GroupWaitlistRepository {
+	return &GroupWaitlistRepository{db: db, studentRepo: studentRepo}
+}
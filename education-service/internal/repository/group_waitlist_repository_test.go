@@ -0,0 +1,29 @@
+package repository
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestReorderWaitlist(t *testing.T) {
+	ids := []string{"a", "b", "c", "d"}
+	tests := []struct {
+		name     string
+		id       string
+		position int
+		want     []string
+	}{
+		{name: "to the front", id: "c", position: 1, want: []string{"c", "a", "b", "d"}},
+		{name: "to the back", id: "a", position: 4, want: []string{"b", "c", "d", "a"}},
+		{name: "one place down", id: "b", position: 3, want: []string{"a", "c", "b", "d"}},
+		{name: "same place", id: "b", position: 2, want: []string{"a", "b", "c", "d"}},
+		{name: "past the end", id: "a", position: 10, want: []string{"b", "c", "d", "a"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := reorderWaitlist(ids, tt.id, tt.position); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("reorderWaitlist() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,183 @@
+package repository
+
+import (
+	"database/sql"
+	"education-service/proto/pb"
+	"errors"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"net/http"
+	"time"
+)
+
+const (
+	HolidayScopeCompany = "COMPANY"
+	HolidayScopeRoom    = "ROOM"
+	HolidayScopeGroup   = "GROUP"
+)
+
+type HolidayRepository struct {
+	db *sql.DB
+}
+
+func (r *HolidayRepository) CreateHoliday(companyId string, req *pb.AbsHoliday) (*pb.AbsResponse, error) {
+	if err := r.validateHoliday(companyId, req); err != nil {
+		return nil, err
+	}
+	id := uuid.New()
+	_, err := r.db.Exec(`INSERT INTO holiday (id, title, from_date, till_date, recurring, scope, room_id, group_id, company_id)
+		VALUES ($1, $2, $3, $4, $5, $6, NULLIF($7, 0), NULLIF($8, 0), $9)`,
+		id, req.Title, req.FromDate, req.TillDate, req.Recurring, req.Scope, req.RoomId, req.GroupId, companyId)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to create holiday: %v", err)
+	}
+	return &pb.AbsResponse{Status: http.StatusOK, Message: id.String()}, nil
+}
+
+// UpdateHoliday edits a company holiday, national holidays only take the isActive switch so seeding keeps matching them
+func (r *HolidayRepository) UpdateHoliday(companyId string, req *pb.AbsHoliday) (*pb.AbsResponse, error) {
+	var isNational bool
+	err := r.db.QueryRow(`SELECT is_national FROM holiday WHERE id = $1 AND company_id = $2`, req.Id, companyId).Scan(&isNational)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, status.Errorf(codes.NotFound, "holiday not found")
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get holiday: %v", err)
+	}
+	if isNational {
+		_, err = r.db.Exec(`UPDATE holiday SET is_active = $1 WHERE id = $2`, req.IsActive, req.Id)
+	} else {
+		if err := r.validateHoliday(companyId, req); err != nil {
+			return nil, err
+		}
+		_, err = r.db.Exec(`UPDATE holiday SET title = $1, from_date = $2, till_date = $3, recurring = $4, scope = $5,
+				room_id = NULLIF($6, 0), group_id = NULLIF($7, 0)
+			WHERE id = $8`,
+			req.Title, req.FromDate, req.TillDate, req.Recurring, req.Scope, req.RoomId, req.GroupId, req.Id)
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to update holiday: %v", err)
+	}
+	return &pb.AbsResponse{Status: http.StatusOK, Message: "holiday updated"}, nil
+}
+
+func (r *HolidayRepository) DeleteHoliday(companyId, id string) (*pb.AbsResponse, error) {
+	var isNational bool
+	err := r.db.QueryRow(`SELECT is_national FROM holiday WHERE id = $1 AND company_id = $2`, id, companyId).Scan(&isNational)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, status.Errorf(codes.NotFound, "holiday not found")
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get holiday: %v", err)
+	}
+	if isNational {
+		return nil, status.Errorf(codes.FailedPrecondition, "davlat bayramini o'chirib bo'lmaydi, uni faolsizlantiring")
+	}
+	if _, err = r.db.Exec(`DELETE FROM holiday WHERE id = $1`, id); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to delete holiday: %v", err)
+	}
+	return &pb.AbsResponse{Status: http.StatusOK, Message: "holiday deleted"}, nil
+}
+
+// GetHolidays lists the holidays of the company, recurring holidays are always listed because they repeat every year
+func (r *HolidayRepository) GetHolidays(companyId string, req *pb.GetHolidaysRequest) (*pb.GetHolidaysResponse, error) {
+	rows, err := r.db.Query(`SELECT h.id, h.title, h.from_date, h.till_date, h.recurring, h.scope, COALESCE(h.room_id, 0), COALESCE(h.group_id, 0),
+			COALESCE(rm.title, ''), COALESCE(g.name, ''), h.is_national, h.is_active
+		FROM holiday h
+		LEFT JOIN rooms rm ON rm.id = h.room_id
+		LEFT JOIN groups g ON g.id = h.group_id
+		WHERE h.company_id = $1
+			AND (h.recurring OR NULLIF($2, '') IS NULL OR h.till_date >= NULLIF($2, '')::date)
+			AND (h.recurring OR NULLIF($3, '') IS NULL OR h.from_date <= NULLIF($3, '')::date)
+		ORDER BY h.recurring DESC, h.from_date`, companyId, req.From, req.Till)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get holidays: %v", err)
+	}
+	defer rows.Close()
+	var response pb.GetHolidaysResponse
+	for rows.Next() {
+		var (
+			holiday            pb.AbsHoliday
+			fromDate, tillDate time.Time
+		)
+		err := rows.Scan(&holiday.Id, &holiday.Title, &fromDate, &tillDate, &holiday.Recurring, &holiday.Scope, &holiday.RoomId, &holiday.GroupId,
+			&holiday.RoomName, &holiday.GroupName, &holiday.IsNational, &holiday.IsActive)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to scan holiday: %v", err)
+		}
+		holiday.FromDate = fromDate.Format("2006-01-02")
+		holiday.TillDate = tillDate.Format("2006-01-02")
+		response.Holidays = append(response.Holidays, &holiday)
+	}
+	return &response, rows.Err()
+}
+
+func (r *HolidayRepository) GetGroupHolidays(companyId string, req *pb.GetGroupHolidaysRequest) (*pb.GetGroupHolidaysResponse, error) {
+	if _, err := time.Parse("2006-01-02", req.From); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid from date %s", req.From)
+	}
+	if _, err := time.Parse("2006-01-02", req.Till); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid till date %s", req.Till)
+	}
+	rows, err := r.db.Query(`SELECT hd.holiday_date FROM groups g, group_holiday_dates(g.id, $2, $3) hd
+		WHERE g.id = $1 AND g.company_id = $4 ORDER BY hd.holiday_date`, req.GroupId, req.From, req.Till, companyId)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get group holidays: %v", err)
+	}
+	defer rows.Close()
+	var response pb.GetGroupHolidaysResponse
+	for rows.Next() {
+		var date time.Time
+		if err := rows.Scan(&date); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to scan group holiday: %v", err)
+		}
+		response.Dates = append(response.Dates, date.Format("2006-01-02"))
+	}
+	return &response, rows.Err()
+}
+
+func (r *HolidayRepository) validateHoliday(companyId string, req *pb.AbsHoliday) error {
+	if req.Title == "" {
+		return status.Errorf(codes.InvalidArgument, "title is required")
+	}
+	fromDate, err := time.Parse("2006-01-02", req.FromDate)
+	if err != nil {
+		return status.Errorf(codes.InvalidArgument, "invalid fromDate %s", req.FromDate)
+	}
+	tillDate, err := time.Parse("2006-01-02", req.TillDate)
+	if err != nil {
+		return status.Errorf(codes.InvalidArgument, "invalid tillDate %s", req.TillDate)
+	}
+	if tillDate.Before(fromDate) {
+		return status.Errorf(codes.InvalidArgument, "tillDate can not be before fromDate")
+	}
+	if req.Recurring && tillDate.After(fromDate.AddDate(1, 0, -1)) {
+		return status.Errorf(codes.InvalidArgument, "a recurring holiday can not be longer than a year")
+	}
+	var exists bool
+	switch req.Scope {
+	case HolidayScopeCompany:
+		req.RoomId, req.GroupId = 0, 0
+		return nil
+	case HolidayScopeRoom:
+		req.GroupId = 0
+		err = r.db.QueryRow(`SELECT EXISTS(SELECT 1 FROM rooms WHERE id = $1 AND company_id = $2)`, req.RoomId, companyId).Scan(&exists)
+	case HolidayScopeGroup:
+		req.RoomId = 0
+		err = r.db.QueryRow(`SELECT EXISTS(SELECT 1 FROM groups WHERE id = $1 AND company_id = $2)`, req.GroupId, companyId).Scan(&exists)
+	default:
+		return status.Errorf(codes.InvalidArgument, "invalid holiday scope %s", req.Scope)
+	}
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to check holiday scope: %v", err)
+	}
+	if !exists {
+		return status.Errorf(codes.NotFound, "%s of the holiday not found", req.Scope)
+	}
+	return nil
+}
+
+func NewHolidayRepository(db *sql.DB) *HolidayRepository {
+	return &HolidayRepository{db: db}
+}
@@ -0,0 +1,261 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"education-service/internal/clients"
+	"education-service/internal/utils"
+	"education-service/proto/pb"
+	"errors"
+	"fmt"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"time"
+)
+
+// upcomingLessonDays is how far ahead the daily job saves the lessons of running groups
+const upcomingLessonDays = 14
+
+type LessonRepository struct {
+	db             *sql.DB
+	userClient     *clients.UserClient
+	attendanceRepo *AttendanceRepository
+}
+
+// GetLessons lists the lessons of a group between from and till, lessons of the schedule not saved yet are saved first
+func (r *LessonRepository) GetLessons(ctx context.Context, companyId string, req *pb.GetLessonsRequest) (*pb.GetLessonsResponse, error) {
+	from, err := time.Parse("2006-01-02", req.From)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid from date %s", req.From)
+	}
+	till, err := time.Parse("2006-01-02", req.Till)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid till date %s", req.Till)
+	}
+	if till.Before(from) || till.Sub(from) > maxTimetableDays*24*time.Hour {
+		return nil, status.Errorf(codes.InvalidArgument, "lessons can be listed for at most %d days", maxTimetableDays)
+	}
+	var exists bool
+	err = r.db.QueryRow(`SELECT EXISTS(SELECT 1 FROM groups WHERE id::text = $1 AND company_id = $2)`, req.GroupId, companyId).Scan(&exists)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to check group: %v", err)
+	}
+	if !exists {
+		return nil, status.Errorf(codes.NotFound, "group not found")
+	}
+	if err := syncLessons(r.db, req.GroupId, from, till); err != nil {
+		return nil, status.Errorf(codes.Internal, "%v", err)
+	}
+	rows, err := r.db.Query(lessonSelect+` WHERE l.group_id::text = $1 AND l.lesson_date BETWEEN $2 AND $3 ORDER BY l.lesson_date`,
+		req.GroupId, req.From, req.Till)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get lessons: %v", err)
+	}
+	defer rows.Close()
+	var response pb.GetLessonsResponse
+	for rows.Next() {
+		lesson, err := scanLesson(rows)
+		if err != nil {
+			return nil, err
+		}
+		response.Lessons = append(response.Lessons, lesson)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	r.fillTeacherNames(ctx, response.Lessons)
+	return &response, nil
+}
+
+func (r *LessonRepository) GetLessonById(ctx context.Context, companyId, id string) (*pb.AbsLesson, error) {
+	lesson, err := scanLesson(r.db.QueryRow(lessonSelect+` WHERE l.id::text = $1 AND l.company_id = $2`, id, companyId))
+	if err != nil {
+		return nil, err
+	}
+	r.fillTeacherNames(ctx, []*pb.AbsLesson{lesson})
+	return lesson, nil
+}
+
+// UpdateLesson saves what was taught and who taught it. Changing the teacher reprices the attendance of the lesson so
+// the salary goes to the teacher who taught it, which is refused once the salary period of the lesson is closed.
+func (r *LessonRepository) UpdateLesson(ctx context.Context, companyId string, req *pb.UpdateLessonRequest) (*pb.AbsLesson, error) {
+	var (
+		groupId, groupTeacherId string
+		lessonDate              time.Time
+		substitute              sql.NullString
+	)
+	err := r.db.QueryRow(`SELECT l.group_id, g.teacher_id, l.lesson_date, l.substitute_teacher_id FROM lessons l
+		JOIN groups g ON g.id = l.group_id
+		WHERE l.id::text = $1 AND l.company_id = $2`, req.Id, companyId).Scan(&groupId, &groupTeacherId, &lessonDate, &substitute)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, status.Errorf(codes.NotFound, "lesson not found")
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get lesson: %v", err)
+	}
+	currentTeacherId := groupTeacherId
+	if substitute.Valid {
+		currentTeacherId = substitute.String
+	}
+	newTeacherId := req.SubstituteTeacherId
+	if newTeacherId == "" {
+		newTeacherId = groupTeacherId
+	}
+	if req.ActionByRole == "TEACHER" {
+		if req.ActionById != currentTeacherId && req.ActionById != groupTeacherId {
+			return nil, status.Errorf(codes.PermissionDenied, "bu dars sizga tegishli emas")
+		}
+		if newTeacherId != currentTeacherId {
+			return nil, status.Errorf(codes.PermissionDenied, "o'qituvchi o'rnini faqat admin almashtira oladi")
+		}
+	}
+	if newTeacherId != currentTeacherId {
+		if newTeacherId != groupTeacherId {
+			if _, err := r.userClient.GetTeacherById(ctx, newTeacherId); err != nil {
+				return nil, status.Errorf(codes.NotFound, "substitute teacher not found: %v", err)
+			}
+		}
+		if err := r.attendanceRepo.repriceLesson(ctx, companyId, groupId, req.Id, lessonDate.Format("2006-01-02"), newTeacherId); err != nil {
+			return nil, err
+		}
+	}
+	var substituteId any
+	if newTeacherId != groupTeacherId {
+		substituteId = newTeacherId
+	}
+	materials := req.Materials
+	if materials == nil {
+		materials = []string{}
+	}
+	_, err = r.db.Exec(`UPDATE lessons SET topic = NULLIF($1, ''), notes = NULLIF($2, ''), homework = NULLIF($3, ''), materials = $4,
+			substitute_teacher_id = $5, updated_by = NULLIF($6, '')::uuid, updated_at = NOW()
+		WHERE id = $7`, req.Topic, req.Notes, req.Homework, pq.Array(materials), substituteId, req.ActionById, req.Id)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to update lesson: %v", err)
+	}
+	return r.GetLessonById(ctx, companyId, req.Id)
+}
+
+// GenerateUpcomingLessons saves the lessons of the next two weeks of every running group so topics, homework and
+// substitutes can be planned ahead, it runs daily
+func (r *LessonRepository) GenerateUpcomingLessons() {
+	rows, err := r.db.Query(`SELECT id FROM groups WHERE NOT is_archived AND end_date >= CURRENT_DATE`)
+	if err != nil {
+		fmt.Println("error while getting groups for lessons:", err)
+		return
+	}
+	var groupIds []string
+	for rows.Next() {
+		var groupId string
+		if err := rows.Scan(&groupId); err != nil {
+			fmt.Println("error while scanning group for lessons:", err)
+			continue
+		}
+		groupIds = append(groupIds, groupId)
+	}
+	rows.Close()
+	today := time.Now()
+	for _, groupId := range groupIds {
+		if err := syncLessons(r.db, groupId, today, today.AddDate(0, 0, upcomingLessonDays)); err != nil {
+			fmt.Printf("error while generating lessons of group %s: %v\n", groupId, err)
+		}
+	}
+}
+
+func (r *LessonRepository) fillTeacherNames(ctx context.Context, lessons []*pb.AbsLesson) {
+	names := make(map[string]string)
+	for _, lesson := range lessons {
+		name, ok := names[lesson.TeacherId]
+		if !ok {
+			name, _ = r.userClient.GetTeacherById(ctx, lesson.TeacherId)
+			names[lesson.TeacherId] = name
+		}
+		lesson.TeacherName = name
+	}
+}
+
+const lessonSelect = `SELECT l.id, l.group_id, g.name, l.lesson_date, l.real_date, COALESCE(l.substitute_teacher_id, g.teacher_id),
+		l.substitute_teacher_id IS NOT NULL, COALESCE(l.topic, ''), COALESCE(l.notes, ''), COALESCE(l.homework, ''), l.materials,
+		(SELECT count(*) FROM attendance a WHERE a.lesson_id = l.id), l.updated_at
+	FROM lessons l
+	JOIN groups g ON g.id = l.group_id`
+
+func scanLesson(row interface{ Scan(...any) error }) (*pb.AbsLesson, error) {
+	var (
+		lesson     pb.AbsLesson
+		lessonDate time.Time
+		realDate   sql.NullTime
+		updatedAt  time.Time
+	)
+	err := row.Scan(&lesson.Id, &lesson.GroupId, &lesson.GroupName, &lessonDate, &realDate, &lesson.TeacherId, &lesson.Substitute,
+		&lesson.Topic, &lesson.Notes, &lesson.Homework, pq.Array(&lesson.Materials), &lesson.AttendanceCount, &updatedAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, status.Errorf(codes.NotFound, "lesson not found")
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to scan lesson: %v", err)
+	}
+	lesson.Date = lessonDate.Format("2006-01-02")
+	if realDate.Valid {
+		lesson.RealDate = realDate.Time.Format("2006-01-02")
+	}
+	lesson.UpdatedAt = updatedAt.Format("2006-01-02 15:04:05")
+	return &lesson, nil
+}
+
+// ensureLesson returns the lesson of the group on date and the teacher who teaches it, the lesson is saved when it
+// does not exist yet
+func ensureLesson(db *sql.DB, groupId, date string) (string, string, error) {
+	_, err := db.Exec(`INSERT INTO lessons (id, group_id, lesson_date, real_date, company_id)
+		SELECT $1, g.id, $3::date, (SELECT real_date FROM transfer_lesson WHERE group_id = g.id AND transfer_date = $3::date LIMIT 1), g.company_id
+		FROM groups g WHERE g.id = $2
+		ON CONFLICT (group_id, lesson_date) DO NOTHING`, uuid.New(), groupId, date)
+	if err != nil {
+		return "", "", fmt.Errorf("error while saving lesson %v", err)
+	}
+	var lessonId, teacherId string
+	err = db.QueryRow(`SELECT l.id, COALESCE(l.substitute_teacher_id, g.teacher_id) FROM lessons l
+		JOIN groups g ON g.id = l.group_id
+		WHERE l.group_id = $1 AND l.lesson_date = $2`, groupId, date).Scan(&lessonId, &teacherId)
+	if err != nil {
+		return "", "", fmt.Errorf("error while getting lesson %v", err)
+	}
+	return lessonId, teacherId, nil
+}
+
+// syncLessons saves the lessons of the group schedule between from and till. Saved lessons that left the schedule are
+// removed unless something was recorded on them.
+func syncLessons(db *sql.DB, groupId string, from, till time.Time) error {
+	schedule, err := utils.GroupSchedule(db, groupId)
+	if err != nil {
+		return err
+	}
+	var dates []string
+	for _, date := range schedule.LessonDates(from, till) {
+		dates = append(dates, date.Format("2006-01-02"))
+	}
+	_, err = db.Exec(`INSERT INTO lessons (id, group_id, lesson_date, real_date, company_id)
+		SELECT gen_random_uuid(), g.id, d, (SELECT real_date FROM transfer_lesson WHERE group_id = g.id AND transfer_date = d LIMIT 1), g.company_id
+		FROM groups g, unnest($2::date[]) d
+		WHERE g.id = $1
+		ON CONFLICT (group_id, lesson_date) DO NOTHING`, groupId, pq.Array(dates))
+	if err != nil {
+		return fmt.Errorf("error while saving lessons %v", err)
+	}
+	_, err = db.Exec(`DELETE FROM lessons l
+		WHERE l.group_id = $1 AND l.lesson_date BETWEEN $2 AND $3 AND NOT l.lesson_date = ANY ($4::date[])
+			AND l.substitute_teacher_id IS NULL AND l.topic IS NULL AND l.notes IS NULL AND l.homework IS NULL
+			AND cardinality(l.materials) = 0
+			AND NOT EXISTS(SELECT 1 FROM attendance a WHERE a.lesson_id = l.id)`,
+		groupId, from.Format("2006-01-02"), till.Format("2006-01-02"), pq.Array(dates))
+	if err != nil {
+		return fmt.Errorf("error while removing unscheduled lessons %v", err)
+	}
+	return nil
+}
+
+func NewLessonRepository(db *sql.DB, userClient *clients.UserClient, attendanceRepo *AttendanceRepository) *LessonRepository {
+	return &LessonRepository{db: db, userClient: userClient, attendanceRepo: attendanceRepo}
+}
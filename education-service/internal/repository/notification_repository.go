@@ -0,0 +1,100 @@
+package repository
+
+import (
+	"database/sql"
+	"education-service/proto/pb"
+	"fmt"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"net/http"
+	"time"
+)
+
+const (
+	NotificationAtRiskStudent       = "AT_RISK_STUDENT"
+	NotificationWaitlistSeatOffered = "WAITLIST_SEAT_OFFERED"
+
+	defaultNotificationLimit = 50
+)
+
+type NotificationRepository struct {
+	db *sql.DB
+}
+
+// notification is an in app message for recipientId and every user of recipientRoles in the company
+type notification struct {
+	kind           string
+	title          string
+	body           string
+	studentId      string
+	groupId        string
+	recipientId    string
+	recipientRoles []string
+}
+
+func createNotification(db *sql.DB, companyId string, n notification) error {
+	if n.recipientRoles == nil {
+		n.recipientRoles = []string{}
+	}
+	_, err := db.Exec(`INSERT INTO notification (id, type, title, body, student_id, group_id, recipient_id, recipient_roles, company_id)
+		VALUES ($1, $2, $3, $4, NULLIF($5, '')::uuid, NULLIF($6, '')::bigint, NULLIF($7, '')::uuid, $8, $9)`,
+		uuid.New(), n.kind, n.title, n.body, n.studentId, n.groupId, n.recipientId, pq.Array(n.recipientRoles), companyId)
+	if err != nil {
+		return fmt.Errorf("error while creating notification %v", err)
+	}
+	return nil
+}
+
+func (r *NotificationRepository) GetNotifications(companyId string, req *pb.GetNotificationsRequest) (*pb.GetNotificationsResponse, error) {
+	limit := req.Limit
+	if limit <= 0 {
+		limit = defaultNotificationLimit
+	}
+	var response pb.GetNotificationsResponse
+	err := r.db.QueryRow(`SELECT COUNT(*) FROM notification n
+		WHERE n.company_id = $1 AND (n.recipient_id::text = $2 OR $3 = ANY(n.recipient_roles))
+			AND NOT EXISTS(SELECT 1 FROM notification_read nr WHERE nr.notification_id = n.id AND nr.user_id::text = $2)`,
+		companyId, req.ActionById, req.ActionByRole).Scan(&response.Unread)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to count notifications: %v", err)
+	}
+	rows, err := r.db.Query(`SELECT n.id, n.type, n.title, COALESCE(n.body, ''), COALESCE(n.student_id::text, ''), COALESCE(n.group_id, 0), nr.user_id IS NOT NULL, n.created_at
+		FROM notification n
+		LEFT JOIN notification_read nr ON nr.notification_id = n.id AND nr.user_id::text = $2
+		WHERE n.company_id = $1 AND (n.recipient_id::text = $2 OR $3 = ANY(n.recipient_roles)) AND (NOT $4 OR nr.user_id IS NULL)
+		ORDER BY n.created_at DESC
+		LIMIT $5`, companyId, req.ActionById, req.ActionByRole, req.UnreadOnly, limit)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get notifications: %v", err)
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var (
+			n         pb.Notification
+			createdAt time.Time
+		)
+		if err := rows.Scan(&n.Id, &n.Type, &n.Title, &n.Body, &n.StudentId, &n.GroupId, &n.Read, &createdAt); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to scan notification: %v", err)
+		}
+		n.CreatedAt = createdAt.Format("2006-01-02 15:04:05")
+		response.Notifications = append(response.Notifications, &n)
+	}
+	return &response, rows.Err()
+}
+
+func (r *NotificationRepository) MarkNotificationsRead(companyId string, req *pb.MarkNotificationsReadRequest) (*pb.AbsResponse, error) {
+	_, err := r.db.Exec(`INSERT INTO notification_read (notification_id, user_id)
+		SELECT n.id, $2::uuid FROM notification n
+		WHERE n.company_id = $1 AND (n.recipient_id = $2::uuid OR $3 = ANY(n.recipient_roles)) AND (COALESCE(cardinality($4::text[]), 0) = 0 OR n.id::text = ANY($4))
+		ON CONFLICT DO NOTHING`, companyId, req.ActionById, req.ActionByRole, pq.Array(req.Ids))
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to mark notifications read: %v", err)
+	}
+	return &pb.AbsResponse{Status: http.StatusOK, Message: "notifications marked read"}, nil
+}
+
+func NewNotificationRepository(db *sql.DB) *NotificationRepository {
+	return &NotificationRepository{db: db}
+}
@@ -0,0 +1,247 @@
+package repository
+
+import (
+	"database/sql"
+	"education-service/internal/pricing"
+	"education-service/internal/utils"
+	"education-service/proto/pb"
+	"encoding/json"
+	"fmt"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"sort"
+	"strings"
+	"time"
+)
+
+const (
+	ConflictRoom    = "ROOM"
+	ConflictTeacher = "TEACHER"
+
+	OverrideCreateGroup    = "CREATE_GROUP"
+	OverrideUpdateGroup    = "UPDATE_GROUP"
+	OverrideTransferLesson = "TRANSFER_LESSON"
+)
+
+// scheduleSlot is when and where a group holds its lessons, groupId is empty for a group not created yet
+type scheduleSlot struct {
+	groupId   string
+	courseId  int32
+	teacherId string
+	roomId    int32
+	dateType  string
+	days      []string
+	startTime string
+	startDate string
+	endDate   string
+}
+
+// bookedGroup is a group that shares the room or the teacher of a slot in an overlapping period
+type bookedGroup struct {
+	id        int64
+	name      string
+	roomId    sql.NullInt32
+	teacherId string
+	dateType  string
+	days      []string
+	startTime string
+	startDate time.Time
+	endDate   time.Time
+	duration  int
+}
+
+// findScheduleConflicts returns the groups whose room or teacher is busy at the lesson time of the slot on a weekday
+// both groups have lessons on while both groups run
+func findScheduleConflicts(db *sql.DB, companyId string, slot scheduleSlot) ([]*pb.ScheduleConflict, error) {
+	slotStart, slotEnd, err := lessonWindow(db, slot.courseId, slot.startTime)
+	if err != nil {
+		return nil, err
+	}
+	booked, err := findBookedGroups(db, companyId, slot, slot.startDate, slot.endDate)
+	if err != nil {
+		return nil, err
+	}
+	slotDays := pricing.Schedule{DateType: slot.dateType, Days: slot.days}.Weekdays()
+	var conflicts []*pb.ScheduleConflict
+	for _, group := range booked {
+		otherStart, otherEnd, err := parseLessonTime(group.startTime, group.duration)
+		if err != nil || !(slotStart < otherEnd && otherStart < slotEnd) {
+			continue
+		}
+		var shared []string
+		groupDays := pricing.Schedule{DateType: group.dateType, Days: group.days}.Weekdays()
+		for weekday := range groupDays {
+			if slotDays[weekday] {
+				shared = append(shared, pricing.DayName(weekday))
+			}
+		}
+		if len(shared) == 0 {
+			continue
+		}
+		sort.Slice(shared, func(i, j int) bool { return weekdayOrder(shared[i]) < weekdayOrder(shared[j]) })
+		fromDate, tillDate := laterDate(group.startDate, slot.startDate), earlierDate(group.endDate, slot.endDate)
+		conflicts = append(conflicts, group.conflicts(slot, shared, fromDate, tillDate)...)
+	}
+	return conflicts, nil
+}
+
+// findLessonConflicts returns the groups whose room or teacher is busy on date at the lesson time of the slot, it
+// checks a single lesson such as a transferred one against the real lessons of that day
+func findLessonConflicts(db *sql.DB, companyId string, slot scheduleSlot, date time.Time) ([]*pb.ScheduleConflict, error) {
+	slotStart, slotEnd, err := lessonWindow(db, slot.courseId, slot.startTime)
+	if err != nil {
+		return nil, err
+	}
+	day := date.Format("2006-01-02")
+	booked, err := findBookedGroups(db, companyId, slot, day, day)
+	if err != nil {
+		return nil, err
+	}
+	var conflicts []*pb.ScheduleConflict
+	for _, group := range booked {
+		otherStart, otherEnd, err := parseLessonTime(group.startTime, group.duration)
+		if err != nil || !(slotStart < otherEnd && otherStart < slotEnd) {
+			continue
+		}
+		schedule, err := utils.GroupSchedule(db, fmt.Sprint(group.id))
+		if err != nil {
+			return nil, err
+		}
+		if pricing.CountLessons(schedule, date, date) == 0 {
+			continue
+		}
+		conflicts = append(conflicts, group.conflicts(slot, []string{day}, day, day)...)
+	}
+	return conflicts, nil
+}
+
+func findBookedGroups(db *sql.DB, companyId string, slot scheduleSlot, from, till string) ([]bookedGroup, error) {
+	rows, err := db.Query(`SELECT g.id, g.name, g.room_id, g.teacher_id, g.date_type, g.days, g.start_time, g.start_date, g.end_date, c.duration_lesson
+		FROM groups g
+		JOIN courses c ON c.id = g.course_id
+		WHERE g.company_id = $1 AND NOT g.is_archived AND g.id::text <> $2
+			AND (g.room_id = $3 OR g.teacher_id::text = $4)
+			AND g.start_date <= $6::date AND g.end_date >= $5::date`,
+		companyId, slot.groupId, slot.roomId, slot.teacherId, from, till)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get booked groups: %v", err)
+	}
+	defer rows.Close()
+	var booked []bookedGroup
+	for rows.Next() {
+		var group bookedGroup
+		err := rows.Scan(&group.id, &group.name, &group.roomId, &group.teacherId, &group.dateType, pq.Array(&group.days), &group.startTime,
+			&group.startDate, &group.endDate, &group.duration)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan booked group: %v", err)
+		}
+		booked = append(booked, group)
+	}
+	return booked, rows.Err()
+}
+
+// conflicts reports the group once per shared resource, a group with the same room and teacher conflicts twice
+func (g bookedGroup) conflicts(slot scheduleSlot, days []string, fromDate, tillDate string) []*pb.ScheduleConflict {
+	start, end, _ := parseLessonTime(g.startTime, g.duration)
+	conflict := func(kind string) *pb.ScheduleConflict {
+		return &pb.ScheduleConflict{
+			Kind:      kind,
+			GroupId:   g.id,
+			GroupName: g.name,
+			RoomId:    g.roomId.Int32,
+			TeacherId: g.teacherId,
+			Days:      days,
+			StartTime: formatLessonTime(start),
+			EndTime:   formatLessonTime(end),
+			FromDate:  fromDate,
+			TillDate:  tillDate,
+		}
+	}
+	var conflicts []*pb.ScheduleConflict
+	if g.roomId.Valid && g.roomId.Int32 == slot.roomId {
+		conflicts = append(conflicts, conflict(ConflictRoom))
+	}
+	if g.teacherId == slot.teacherId {
+		conflicts = append(conflicts, conflict(ConflictTeacher))
+	}
+	return conflicts
+}
+
+// scheduleConflictError carries the conflicts as status details so the gateway can return them to the client
+func scheduleConflictError(conflicts []*pb.ScheduleConflict) error {
+	var names []string
+	for _, conflict := range conflicts {
+		kind := "xona"
+		if conflict.Kind == ConflictTeacher {
+			kind = "o'qituvchi"
+		}
+		names = append(names, fmt.Sprintf("%s (%s, %s-%s)", conflict.GroupName, kind, conflict.StartTime, conflict.EndTime))
+	}
+	st := status.New(codes.AlreadyExists, "jadval band: "+strings.Join(names, ", "))
+	if detailed, err := st.WithDetails(&pb.ScheduleConflicts{Conflicts: conflicts}); err == nil {
+		st = detailed
+	}
+	return st.Err()
+}
+
+// recordConflictOverride audits a change saved with force although it conflicts with other groups
+func recordConflictOverride(db *sql.DB, companyId, groupId, action string, conflicts []*pb.ScheduleConflict, actionById, actionByName string) {
+	payload, err := json.Marshal(conflicts)
+	if err != nil {
+		fmt.Println("error while encoding schedule conflicts:", err)
+		return
+	}
+	_, err = db.Exec(`INSERT INTO schedule_conflict_override (id, group_id, action, conflicts, forced_by_id, forced_by_name, company_id)
+		VALUES ($1, $2, $3, $4, NULLIF($5, '')::uuid, $6, $7)`,
+		uuid.New(), groupId, action, payload, actionById, actionByName, companyId)
+	if err != nil {
+		fmt.Println("error while saving schedule conflict override:", err)
+	}
+}
+
+// lessonWindow is the start and end minute of the day of a lesson of the course starting at startTime
+func lessonWindow(db *sql.DB, courseId int32, startTime string) (int, int, error) {
+	var duration int
+	if err := db.QueryRow(`SELECT duration_lesson FROM courses WHERE id = $1`, courseId).Scan(&duration); err != nil {
+		return 0, 0, fmt.Errorf("failed to get course lesson duration: %v", err)
+	}
+	start, end, err := parseLessonTime(startTime, duration)
+	if err != nil {
+		return 0, 0, status.Errorf(codes.InvalidArgument, "invalid lesson start time %s", startTime)
+	}
+	return start, end, nil
+}
+
+func parseLessonTime(startTime string, duration int) (int, int, error) {
+	for _, layout := range []string{"15:04", "15:04:05"} {
+		if parsed, err := time.Parse(layout, strings.TrimSpace(startTime)); err == nil {
+			start := parsed.Hour()*60 + parsed.Minute()
+			return start, start + duration, nil
+		}
+	}
+	return 0, 0, fmt.Errorf("invalid lesson time %s", startTime)
+}
+
+func formatLessonTime(minute int) string {
+	return fmt.Sprintf("%02d:%02d", minute/60%24, minute%60)
+}
+
+func weekdayOrder(name string) int {
+	return map[string]int{"DUSHANBA": 1, "SESHANBA": 2, "CHORSHANBA": 3, "PAYSHANBA": 4, "JUMA": 5, "SHANBA": 6, "YAKSHANBA": 7}[name]
+}
+
+func laterDate(date time.Time, other string) string {
+	if parsed, err := time.Parse("2006-01-02", other); err == nil && parsed.After(date) {
+		return other
+	}
+	return date.Format("2006-01-02")
+}
+
+func earlierDate(date time.Time, other string) string {
+	if parsed, err := time.Parse("2006-01-02", other); err == nil && parsed.Before(date) {
+		return other
+	}
+	return date.Format("2006-01-02")
+}
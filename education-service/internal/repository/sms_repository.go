@@ -237,36 +237,8 @@ func (r SmsRepository) GetSmsTemplate(smsType string, companyId string) (*pb.Get
 }
 
 func (r SmsRepository) SendSmsDirectly(req *pb.SendSmsDirectlyRequest, companyId string) (*pb.AbsResponse, error) {
-	isCyrillic := false
-	for _, r := range req.SmsValue {
-		if r >= 0x0400 && r <= 0x04FF {
-			isCyrillic = true
-			break
-		}
-	}
-
-	var charsPerSms int
-	if isCyrillic {
-		charsPerSms = 70
-	} else {
-		charsPerSms = 160
-	}
-
-	runes := []rune(req.SmsValue)
-	totalLen := len(runes)
-	smsCount := int32(totalLen / charsPerSms)
-	if totalLen%charsPerSms != 0 {
-		smsCount++
-	}
-
-	var textParts []string
-	for i := 0; i < totalLen; i += charsPerSms {
-		end := i + charsPerSms
-		if end > totalLen {
-			end = totalLen
-		}
-		textParts = append(textParts, string(runes[i:end]))
-	}
+	textParts := splitSmsParts(req.SmsValue)
+	smsCount := int32(len(textParts))
 
 	var phoneNumber string
 	err := r.db.QueryRow(`SELECT phone FROM students WHERE id = $1`, req.StudentId).Scan(&phoneNumber)
@@ -425,3 +397,78 @@ func (r SmsRepository) SetSmsTemplate(req *pb.SetSmsTemplateRequest, companyId s
 		Message: "template updated",
 	}, nil
 }
+
+// SendStaffSms sends a system notification to staff phones, it is paid from the company sms balance like any other sms
+func (r SmsRepository) SendStaffSms(req *pb.SendStaffSmsRequest, companyId string) (*pb.AbsResponse, error) {
+	textParts := splitSmsParts(req.SmsValue)
+	smsCount := int32(len(textParts)) * int32(len(req.Phones))
+	if smsCount == 0 {
+		return &pb.AbsResponse{Status: 200, Message: "nothing to send"}, nil
+	}
+
+	tx, err := r.db.Begin()
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		}
+	}()
+
+	var currentBalance int
+	err = tx.QueryRow(`SELECT sms_balance FROM company WHERE id = $1 FOR UPDATE`, companyId).Scan(&currentBalance)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get sms_balance: %w", err)
+	}
+	if currentBalance < int(smsCount) {
+		err = fmt.Errorf("insufficient sms balance: have %d, need %d", currentBalance, smsCount)
+		return nil, err
+	}
+
+	_, err = tx.Exec(`
+		INSERT INTO sms_used (id, company_id, texts, sms_count, created_by_id, created_by_name, sms_used_type)
+		VALUES (gen_random_uuid(), $1, $2, $3, '00000000-0000-0000-0000-000000000000', 'TIZIM', 'BY_SELF')
+	`, companyId, pq.Array(textParts), smsCount)
+	if err != nil {
+		return nil, fmt.Errorf("failed to insert sms_used: %w", err)
+	}
+	_, err = tx.Exec(`UPDATE company SET sms_balance = sms_balance - $1 WHERE id = $2`, smsCount, companyId)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update sms_balance: %w", err)
+	}
+	if err = tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	for _, phone := range req.Phones {
+		if sendErr := utils.SendSMS(phone, req.SmsValue); sendErr != nil {
+			fmt.Printf("failed to send staff sms to %s: %v\n", phone, sendErr)
+		}
+	}
+	return &pb.AbsResponse{
+		Status:  200,
+		Message: "ok",
+	}, nil
+}
+
+// splitSmsParts cuts the text into sms sized parts, cyrillic text fits 70 characters per sms and latin 160
+func splitSmsParts(text string) []string {
+	charsPerSms := 160
+	for _, r := range text {
+		if r >= 0x0400 && r <= 0x04FF {
+			charsPerSms = 70
+			break
+		}
+	}
+	runes := []rune(text)
+	var textParts []string
+	for i := 0; i < len(runes); i += charsPerSms {
+		end := i + charsPerSms
+		if end > len(runes) {
+			end = len(runes)
+		}
+		textParts = append(textParts, string(runes[i:end]))
+	}
+	return textParts
+}
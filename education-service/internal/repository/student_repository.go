@@ -5,6 +5,7 @@ import (
 	"database/sql"
 	"education-service/internal/clients"
 	"education-service/internal/models"
+	"education-service/internal/pricing"
 	"education-service/internal/utils"
 	"education-service/proto/pb"
 	"encoding/json"
@@ -254,27 +255,115 @@ func (r *StudentRepository) DeleteStudent(ctx context.Context, companyId string,
 	}
 	return nil
 }
-func (r *StudentRepository) AddToGroup(ctx context.Context, companyId string, groupId string, studentIds []string, createdDate, createdBy string) error {
+// AddToGroup enrolls the students while the group has free seats and puts the rest on its waitlist. Finance discount
+// rules (and the promo code when given) are applied to each enrolled student, the message of its result lists the
+// discounts that could not be applied
+func (r *StudentRepository) AddToGroup(ctx context.Context, companyId string, groupId string, studentIds []string, createdDate, createdBy, createdByName, promoCode string) ([]*pb.AddToGroupResult, error) {
 	var checker bool
-	query := `INSERT INTO group_students(id, group_id, student_id, condition, last_specific_date, created_by , company_id) values ($1 ,$2 ,$3 ,$4 , $5 , $6 , $7)`
-	queryForChecking := `SELECT exists(SELECT 1 FROM students where condition = 'ARCHIVED' and id=$1 and company_id=$2)`
 	queryGroupChecking := `SELECT exists(SELECT 1 FROM groups where id=$1 and is_archived=true and company_id=$2)`
 	err := r.db.QueryRow(queryGroupChecking, groupId, companyId).Scan(&checker)
 	if err != nil || checker {
-		return errors.New(fmt.Sprintf("forbidden (archived group action error) id=%s", groupId))
+		return nil, errors.New(fmt.Sprintf("forbidden (archived group action error) id=%s", groupId))
 	}
+	results := make([]*pb.AddToGroupResult, 0, len(studentIds))
 	for _, data := range studentIds {
-		err := r.db.QueryRow(queryForChecking, data, companyId).Scan(&checker)
-		if err != nil || checker {
-			return errors.New(fmt.Sprintf("forbidden (archived student action error) id=%s", data))
-		}
-		_, err = r.db.Exec(query, uuid.New(), groupId, data, "FREEZE", createdDate, createdBy, companyId)
-		if err != nil {
+		result := r.enrollStudent(companyId, groupId, data, createdDate, createdBy, createdByName)
+		results = append(results, result)
+		if result.Status != EnrollAdded {
 			continue
 		}
 		go r.SendSmsReasonAddToGroup(ctx, data, groupId, companyId)
+		if err := r.ensureFinanceClient(); err != nil {
+			result.Message = fmt.Sprintf("discount rules were not applied: %v", err)
+			continue
+		}
+		discountCtx, cancelFunc := utils.NewTimoutContext(ctx, companyId)
+		_, err = r.financeClient.ApplyDiscountRules(discountCtx, data, groupId, promoCode, createdDate, createdBy, createdByName)
+		cancelFunc()
+		if err != nil {
+			result.Message = fmt.Sprintf("discount was not applied: %v", status.Convert(err).Message())
+		}
 	}
-	return nil
+	return results, nil
+}
+
+// enrollStudent takes a seat of the group for the student, or a place on its waitlist when the group is full. A seat
+// offered to the student from the waitlist is its own, so it is not counted as taken.
+func (r *StudentRepository) enrollStudent(companyId, groupId, studentId, createdDate, createdBy, createdByName string) (result *pb.AddToGroupResult) {
+	result = &pb.AddToGroupResult{StudentId: studentId, Status: EnrollFailed}
+	var archived bool
+	err := r.db.QueryRow(`SELECT condition = 'ARCHIVED' FROM students WHERE id::text=$1 AND company_id=$2`, studentId, companyId).Scan(&archived)
+	if err != nil {
+		result.Message = "o'quvchi topilmadi"
+		return result
+	}
+	if archived {
+		result.Message = "o'quvchi arxivlangan"
+		return result
+	}
+	tx, err := r.db.Begin()
+	if err != nil {
+		result.Message = fmt.Sprintf("failed to begin transaction: %v", err)
+		return result
+	}
+	defer func() {
+		if result.Status == EnrollFailed {
+			tx.Rollback()
+		} else if err := tx.Commit(); err != nil {
+			result.Status, result.Position, result.Message = EnrollFailed, 0, fmt.Sprintf("failed to commit transaction: %v", err)
+		}
+	}()
+	capacity, taken, err := groupSeats(tx, groupId, studentId)
+	if err != nil {
+		result.Message = err.Error()
+		return result
+	}
+	var condition string
+	err = tx.QueryRow(`SELECT condition FROM group_students WHERE group_id=$1 AND student_id=$2`, groupId, studentId).Scan(&condition)
+	switch {
+	case err == nil && condition == "DELETE":
+		result.Message = "o'quvchi guruhdan chiqarilgan, qaytarish uchun holatini o'zgartiring"
+		return result
+	case err == nil:
+		result.Message = "o'quvchi guruhda allaqachon bor"
+		return result
+	case !errors.Is(err, sql.ErrNoRows):
+		result.Message = fmt.Sprintf("failed to check group student: %v", err)
+		return result
+	}
+	queued := false
+	if capacity > 0 && taken < capacity {
+		if queued, err = waitlistAhead(tx, groupId, studentId); err != nil {
+			result.Message = err.Error()
+			return result
+		}
+	}
+	if capacity > 0 && (taken >= capacity || queued) {
+		position, err := addToWaitlist(tx, companyId, groupId, studentId, createdBy, createdByName)
+		if err != nil {
+			result.Message = err.Error()
+			return result
+		}
+		result.Status, result.Position = EnrollWaitlisted, position
+		result.Message = fmt.Sprintf("guruhda bo'sh joy yo'q (%d/%d), o'quvchi navbatga qo'yildi", taken, capacity)
+		if queued {
+			result.Message = "guruhdagi bo'sh joy navbatdagilarga tegishli, o'quvchi navbatga qo'yildi"
+		}
+		return result
+	}
+	_, err = tx.Exec(`INSERT INTO group_students(id, group_id, student_id, condition, last_specific_date, created_by , company_id) values ($1 ,$2 ,$3 ,$4 , $5 , $6 , $7)`,
+		uuid.New(), groupId, studentId, "FREEZE", createdDate, createdBy, companyId)
+	if err != nil {
+		result.Message = fmt.Sprintf("failed to add student to group: %v", err)
+		return result
+	}
+	_, err = tx.Exec(`UPDATE group_waitlist SET status = 'ENROLLED' WHERE group_id = $1 AND student_id::text = $2 AND status in ('WAITING', 'OFFERED')`, groupId, studentId)
+	if err != nil {
+		result.Message = fmt.Sprintf("failed to close waitlist entry: %v", err)
+		return result
+	}
+	result.Status = EnrollAdded
+	return result
 }
 
 func (r *StudentRepository) GetStudentById(ctx context.Context, companyId string, id string) (*pb.GetStudentByIdResponse, error) {
@@ -522,7 +611,7 @@ func (r *StudentRepository) GetHistoryByStudentId(companyId string, studentId st
 
 	return response, nil
 }
-func (r *StudentRepository) TransferLessonDate(companyId string, groupId string, from string, to string) (*pb.AbsResponse, error) {
+func (r *StudentRepository) TransferLessonDate(companyId string, groupId string, from string, to string, force bool, actionById string, actionByName string) (*pb.AbsResponse, error) {
 	//validDay, err := utils.IsValidLessonDay(r.db, groupId, from)
 	//if err != nil {
 	//	return nil, err
@@ -544,17 +633,58 @@ func (r *StudentRepository) TransferLessonDate(companyId string, groupId string,
 		if err != nil {
 			return nil, err
 		}
+		// the lesson goes back to its real date with what was recorded on it
+		_, err = r.db.Exec(`UPDATE lessons SET lesson_date = real_date, real_date = NULL
+			WHERE group_id = $1 AND lesson_date = $3 AND real_date = $2
+				AND NOT EXISTS(SELECT 1 FROM lessons WHERE group_id = $1 AND lesson_date = $2)`, groupId, from, to)
+		if err != nil {
+			return nil, err
+		}
 	} else {
+		conflicts, err := r.transferConflicts(companyId, groupId, to)
+		if err != nil {
+			return nil, err
+		}
+		if len(conflicts) > 0 && !force {
+			return nil, scheduleConflictError(conflicts)
+		}
 		_, err = r.db.Exec(`INSERT INTO transfer_lesson(id, group_id, real_date, transfer_date , company_id) values ($1, $2, $3, $4 , $5)`, uuid.New(), groupId, from, to, companyId)
 		if err != nil {
 			return nil, err
 		}
+		_, err = r.db.Exec(`UPDATE lessons SET lesson_date = $3, real_date = $2
+			WHERE group_id = $1 AND lesson_date = $2
+				AND NOT EXISTS(SELECT 1 FROM lessons WHERE group_id = $1 AND lesson_date = $3)`, groupId, from, to)
+		if err != nil {
+			return nil, err
+		}
+		if len(conflicts) > 0 {
+			recordConflictOverride(r.db, companyId, groupId, OverrideTransferLesson, conflicts, actionById, actionByName)
+		}
 	}
 	return &pb.AbsResponse{
 		Status:  200,
 		Message: "accomplished",
 	}, nil
 }
+
+// transferConflicts are the lessons held on the transfer date in the room or by the teacher of the group
+func (r *StudentRepository) transferConflicts(companyId, groupId, to string) ([]*pb.ScheduleConflict, error) {
+	date, err := time.Parse("2006-01-02", to)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid transfer date %s", to)
+	}
+	slot := scheduleSlot{groupId: groupId}
+	err = r.db.QueryRow(`SELECT course_id, teacher_id, COALESCE(room_id, 0), start_time FROM groups WHERE id = $1 AND company_id = $2`,
+		groupId, companyId).Scan(&slot.courseId, &slot.teacherId, &slot.roomId, &slot.startTime)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, status.Errorf(codes.NotFound, "group not found")
+	}
+	if err != nil {
+		return nil, err
+	}
+	return findLessonConflicts(r.db, companyId, slot, date)
+}
 func (r *StudentRepository) ChangeConditionStudent(ctx context.Context, companyId string, studentId string, groupId string, status string, returnTheMoney bool, tillDate string, actionById, actionByName, comment string) (*pb.AbsResponse, error) {
 	isEliminatedInTrial := false
 
@@ -606,6 +736,28 @@ func (r *StudentRepository) ChangeConditionStudent(ctx context.Context, companyI
 		tx.Rollback()
 		return nil, fmt.Errorf("condition is the same as you give")
 	}
+	if oldCondition == "DELETE" {
+		capacity, taken, err := groupSeats(tx, groupId, studentId)
+		if err != nil {
+			tx.Rollback()
+			return nil, err
+		}
+		if capacity > 0 && taken >= capacity {
+			tx.Rollback()
+			return nil, fmt.Errorf("group is full: %d of %d seats are taken", taken, capacity)
+		}
+		if capacity > 0 {
+			queued, err := waitlistAhead(tx, groupId, studentId)
+			if err != nil {
+				tx.Rollback()
+				return nil, err
+			}
+			if queued {
+				tx.Rollback()
+				return nil, fmt.Errorf("the free seats of the group belong to the students on its waitlist")
+			}
+		}
+	}
 
 	updateStmt := `
         UPDATE group_students
@@ -653,7 +805,7 @@ func (r *StudentRepository) ChangeConditionStudent(ctx context.Context, companyI
 			monthYearDate := d.Format("2006-01-02")
 
 			manaulPriceForCourse, _ := r.financeClient.GetDiscountByStudentId(ctx, studentId, groupId)
-			amount, err := utils.CalculateMoneyForStatus(r.db, manaulPriceForCourse, groupId, monthYearDate)
+			amount, err := utils.CalculateMoneyForStatus(r.db, manaulPriceForCourse, groupId, studentId, monthYearDate)
 			if err != nil {
 				tx.Rollback()
 				return nil, fmt.Errorf("failed to calculate money for %s: %v", monthYearDate, err)
@@ -687,6 +839,9 @@ func (r *StudentRepository) ChangeConditionStudent(ctx context.Context, companyI
 	if err := tx.Commit(); err != nil {
 		return nil, fmt.Errorf("failed to commit transaction: %v", err)
 	}
+	if status == "DELETE" {
+		go offerWaitlistSeats(r.db, companyId, groupId)
+	}
 
 	return &pb.AbsResponse{
 		Message: "Condition changed successfully",
@@ -960,8 +1115,23 @@ func (r *StudentRepository) StudentBalanceTaker() {
 					fmt.Printf("error scanning groupid student %v", err)
 					continue
 				}
+				if billing, err := getGroupBilling(r.db, groupId); err == nil && billing.mode != BillingMonthly {
+					// per lesson and package groups are charged by attendance
+					continue
+				}
+				planResp, planErr := r.financeClient.ChargeInstallments(ctx, studentId, groupId, time.Now().Format("2006-01-02"))
+				if planErr != nil {
+					fmt.Printf("error charging installment plan %v", planErr)
+					continue
+				}
+				if planResp.HasPlan {
+					continue
+				}
+				if _, err := r.financeClient.ApplyDiscountRules(ctx, studentId, groupId, "", time.Now().Format("2006-01-02"), "00000000-0000-0000-0000-000000000000", "TIZIM"); err != nil {
+					fmt.Printf("error applying discount rules %v", err)
+				}
 				discountAmount, _ := r.financeClient.GetDiscountByStudentId(ctx, studentId, groupId)
-				err = r.db.QueryRow(`SELECT c.price FROM groups g join courses c on g.course_id=c.id where g.id=$1 and c.company_id=$2`, groupId, companyId).Scan(&takingPrice)
+				takingPrice, err = utils.ResolveCoursePrice(r.db, groupId, studentId, time.Now())
 				if err != nil {
 					fmt.Printf("error getting course price active student %v", err)
 					continue
@@ -985,126 +1155,490 @@ func (r *StudentRepository) StudentBalanceTaker() {
 	}
 }
 
-func (r *StudentRepository) CalculateDiscountSumma(companyId string, groupId string, startDate string, endDate string, discountPrice string, studentId string, paymentDate, activationDate string) (*pb.CalculateDiscountResponse, error) {
-	groupIDInt, err := strconv.ParseInt(groupId, 10, 64)
+// InstallmentLateFeeTaker asks finance to charge the installments that fell due and to apply late fees on overdue
+// installments of every active company
+func (r *StudentRepository) InstallmentLateFeeTaker() {
+	if err := r.ensureFinanceClient(); err != nil {
+		return
+	}
+	rows, err := r.db.Query(`SELECT id FROM company WHERE valid_date > CURRENT_DATE`)
 	if err != nil {
-		return nil, fmt.Errorf("invalid group ID: %v", err)
+		fmt.Println("error while getting companies:", err)
+		return
 	}
+	defer rows.Close()
+	for rows.Next() {
+		var companyId string
+		if err := rows.Scan(&companyId); err != nil {
+			fmt.Println("erorr while scanning companyid")
+			continue
+		}
+		ctx, cancelFunc := utils.NewTimoutContext(context.Background(), companyId)
+		if _, err := r.financeClient.ChargeDueInstallments(ctx); err != nil {
+			fmt.Printf("error charging due installments for company %s: %v\n", companyId, err)
+		}
+		if _, err := r.financeClient.ApplyInstallmentLateFees(ctx); err != nil {
+			fmt.Printf("error applying installment late fees for company %s: %v\n", companyId, err)
+		}
+		cancelFunc()
+	}
+}
 
-	discountPriceFloat, err := strconv.ParseFloat(discountPrice, 64)
+// DebtCollectionRunner runs the finance collections workflow (reminders by debt age, freeze flags) of every active company
+func (r *StudentRepository) DebtCollectionRunner() {
+	if err := r.ensureFinanceClient(); err != nil {
+		return
+	}
+	rows, err := r.db.Query(`SELECT id FROM company WHERE valid_date > CURRENT_DATE`)
 	if err != nil {
-		return nil, fmt.Errorf("invalid discount price: %v", err)
+		fmt.Println("error while getting companies:", err)
+		return
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var companyId string
+		if err := rows.Scan(&companyId); err != nil {
+			fmt.Println("erorr while scanning companyid")
+			continue
+		}
+		ctx, cancelFunc := utils.NewTimoutContext(context.Background(), companyId)
+		if _, err := r.financeClient.RunCollections(ctx); err != nil {
+			fmt.Printf("error running debt collections for company %s: %v\n", companyId, err)
+		}
+		cancelFunc()
 	}
+}
 
-	var group struct {
-		EndDate string
-		Days    []string
+// RecurringExpensePoster posts due recurring expenses (rent, utilities, subscriptions) of every active company
+func (r *StudentRepository) RecurringExpensePoster() {
+	if err := r.ensureFinanceClient(); err != nil {
+		return
 	}
+	rows, err := r.db.Query(`SELECT id FROM company WHERE valid_date > CURRENT_DATE`)
+	if err != nil {
+		fmt.Println("error while getting companies:", err)
+		return
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var companyId string
+		if err := rows.Scan(&companyId); err != nil {
+			fmt.Println("erorr while scanning companyid")
+			continue
+		}
+		ctx, cancelFunc := utils.NewTimoutContext(context.Background(), companyId)
+		if _, err := r.financeClient.PostRecurringExpenses(ctx); err != nil {
+			fmt.Printf("error posting recurring expenses for company %s: %v\n", companyId, err)
+		}
+		cancelFunc()
+	}
+}
 
-	err = r.db.QueryRow(`
-        SELECT end_date, days 
-        FROM groups 
-        WHERE id = $1 AND company_id = $2
-    `, groupIDInt, companyId).Scan(&group.EndDate, pq.Array(&group.Days))
+func (r *StudentRepository) GetDebtors(companyId string) (*pb.GetDebtorsResponse, error) {
+	rows, err := r.db.Query(`SELECT id, name, phone, balance, zero_balance_updated_at FROM students
+		WHERE company_id = $1 AND condition = 'ACTIVE' AND balance < 0`, companyId)
+	if err != nil {
+		return nil, fmt.Errorf("error while getting debtors %v", err)
+	}
+	defer rows.Close()
+	var response pb.GetDebtorsResponse
+	for rows.Next() {
+		var (
+			debtor               pb.AbsDebtor
+			zeroBalanceUpdatedAt sql.NullTime
+		)
+		if err := rows.Scan(&debtor.StudentId, &debtor.Name, &debtor.Phone, &debtor.Balance, &zeroBalanceUpdatedAt); err != nil {
+			return nil, fmt.Errorf("error while scanning debtor %v", err)
+		}
+		if zeroBalanceUpdatedAt.Valid {
+			debtor.ZeroBalanceUpdatedAt = zeroBalanceUpdatedAt.Time.Format("2006-01-02")
+		}
+		response.Debtors = append(response.Debtors, &debtor)
+	}
+	return &response, rows.Err()
+}
 
+// GetStudentsByPhones finds students whose phone or additional contact ends with the last nine digits of a requested phone,
+// phone in the response is the requested one so callers can map results back
+func (r *StudentRepository) GetStudentsByPhones(companyId string, phones []string) (*pb.GetStudentsByPhonesResponse, error) {
+	var response pb.GetStudentsByPhonesResponse
+	if len(phones) == 0 {
+		return &response, nil
+	}
+	rows, err := r.db.Query(`SELECT s.id, s.name, p.phone, s.balance
+		FROM unnest($2::varchar[]) AS p(phone)
+		JOIN students s ON s.company_id = $1
+			AND length(regexp_replace(p.phone, '\D', '', 'g')) >= 9
+			AND (right(regexp_replace(s.phone, '\D', '', 'g'), 9) = right(regexp_replace(p.phone, '\D', '', 'g'), 9)
+				OR right(regexp_replace(COALESCE(s.additional_contact, ''), '\D', '', 'g'), 9) = right(regexp_replace(p.phone, '\D', '', 'g'), 9))
+		ORDER BY s.condition, s.created_at DESC`, companyId, pq.Array(phones))
 	if err != nil {
-		return nil, fmt.Errorf("failed to get group info: %v", err)
+		return nil, fmt.Errorf("error while getting students by phones %v", err)
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var student pb.AbsDebtor
+		if err := rows.Scan(&student.StudentId, &student.Name, &student.Phone, &student.Balance); err != nil {
+			return nil, fmt.Errorf("error while scanning student %v", err)
+		}
+		response.Students = append(response.Students, &student)
+	}
+	return &response, rows.Err()
+}
+
+// GetDiscountContext returns what finance discount rules are evaluated against: the course price of the group, how many
+// courses the student attends and how many earlier registered active siblings share the student's phone or additional contact
+func (r *StudentRepository) GetDiscountContext(companyId, studentId, groupId string) (*pb.GetDiscountContextResponse, error) {
+	var (
+		response     pb.GetDiscountContextResponse
+		groupEndDate time.Time
+	)
+	err := r.db.QueryRow(`SELECT c.id, g.end_date FROM groups g JOIN courses c ON c.id = g.course_id WHERE g.id = $1 AND g.company_id = $2`,
+		groupId, companyId).Scan(&response.CourseId, &groupEndDate)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, status.Errorf(codes.NotFound, "group not found")
 	}
-	paymentDateTime, err := parseDate(paymentDate)
 	if err != nil {
-		return nil, fmt.Errorf("failed to parse payment date: %v", err)
+		return nil, fmt.Errorf("error while getting group course %v", err)
+	}
+	response.CoursePrice, err = utils.ResolveCoursePrice(r.db, groupId, studentId, time.Now())
+	if err != nil {
+		return nil, err
+	}
+	response.GroupEndDate = groupEndDate.Format("2006-01-02")
+	err = r.db.QueryRow(`SELECT COUNT(DISTINCT g.course_id) FROM group_students gs JOIN groups g ON g.id = gs.group_id
+		WHERE gs.student_id = $1 AND gs.company_id = $2 AND (gs.condition IN ('ACTIVE', 'FREEZE') OR gs.group_id = $3)`,
+		studentId, companyId, groupId).Scan(&response.CourseCount)
+	if err != nil {
+		return nil, fmt.Errorf("error while counting student courses %v", err)
+	}
+	err = r.db.QueryRow(`WITH me AS (
+			SELECT created_at,
+				ARRAY_REMOVE(ARRAY[NULLIF(right(regexp_replace(phone, '\D', '', 'g'), 9), ''),
+					NULLIF(right(regexp_replace(COALESCE(additional_contact, ''), '\D', '', 'g'), 9), '')], NULL) AS phones
+			FROM students WHERE id = $1 AND company_id = $2)
+		SELECT COUNT(*) FROM students s, me
+		WHERE s.company_id = $2 AND s.id <> $1 AND s.condition = 'ACTIVE' AND s.created_at < me.created_at
+			AND (right(regexp_replace(s.phone, '\D', '', 'g'), 9) = ANY(me.phones)
+				OR right(regexp_replace(COALESCE(s.additional_contact, ''), '\D', '', 'g'), 9) = ANY(me.phones))
+			AND EXISTS(SELECT 1 FROM group_students gs WHERE gs.student_id = s.id AND gs.condition IN ('ACTIVE', 'FREEZE'))`,
+		studentId, companyId).Scan(&response.SiblingCount)
+	if err != nil {
+		return nil, fmt.Errorf("error while counting siblings %v", err)
 	}
+	return &response, nil
+}
 
-	activationDateTime, err := parseDate(activationDate)
+// SendDebtReminder sends the company's active DEBT_REMINDER_* template of the given action type to the student
+func (r *StudentRepository) SendDebtReminder(companyId, studentId, actionType string) (*pb.AbsResponse, error) {
+	var (
+		templateId int
+		texts      []string
+		phone      string
+		balance    float64
+		smsBalance int
+	)
+	err := r.db.QueryRow(`SELECT id, texts FROM sms_template WHERE company_id=$1 AND action_type=$2 AND sms_template_type='ACTION' AND is_active=true`,
+		companyId, actionType).Scan(&templateId, pq.Array(&texts))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, status.Errorf(codes.NotFound, "active %s template not found", actionType)
+	}
 	if err != nil {
-		return nil, fmt.Errorf("failed to parse activation date: %v", err)
+		return nil, fmt.Errorf("error while getting sms template %v", err)
+	}
+	if err = r.db.QueryRow(`SELECT phone, balance FROM students WHERE id=$1 AND company_id=$2`, studentId, companyId).Scan(&phone, &balance); err != nil {
+		return nil, fmt.Errorf("error while getting student %v", err)
 	}
 
-	groupEndDateTime, err := parseDate(group.EndDate)
+	tx, err := r.db.Begin()
 	if err != nil {
-		return nil, fmt.Errorf("failed to parse group end date: %v", err)
+		return nil, fmt.Errorf("failed to begin transaction %v", err)
 	}
+	defer func() {
+		if err != nil {
+			tx.Rollback()
+		} else {
+			tx.Commit()
+		}
+	}()
+	if err = tx.QueryRow(`SELECT sms_balance FROM company WHERE id=$1`, companyId).Scan(&smsBalance); err != nil {
+		return nil, fmt.Errorf("error while getting sms balance %v", err)
+	}
+	smsText, usedSmsCount := utils.GetSmsFormatted(strings.Join(texts, " "), "O'qituvchi", r.db, studentId, "", balance, companyId)
+	if smsText == "" || usedSmsCount > smsBalance {
+		err = status.Errorf(codes.ResourceExhausted, "not enough sms balance")
+		return nil, err
+	}
+	_, err = tx.Exec(`INSERT INTO sms_used(id, company_id, sms_template_id, texts, sms_count, student_id, sms_used_type, created_at, created_by_id, created_by_name) 
+		VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9,$10)`,
+		uuid.New(), companyId, templateId, pq.Array([]string{smsText}), usedSmsCount, studentId, "BY_TEMPLATE", time.Now(), "00000000-0000-0000-0000-000000000000", "system")
+	if err != nil {
+		return nil, fmt.Errorf("error while saving used sms %v", err)
+	}
+	if _, err = tx.Exec(`UPDATE company SET sms_balance=sms_balance-$1 WHERE id=$2`, usedSmsCount, companyId); err != nil {
+		return nil, fmt.Errorf("error while updating sms balance %v", err)
+	}
+	if err = utils.SendSMS(phone, smsText); err != nil {
+		return nil, fmt.Errorf("error while sending sms %v", err)
+	}
+	return &pb.AbsResponse{Status: http.StatusOK, Message: "sms sent"}, nil
+}
 
-	monthStart := time.Date(paymentDateTime.Year(), paymentDateTime.Month(), 1, 0, 0, 0, 0, paymentDateTime.Location())
-	monthEnd := monthStart.AddDate(0, 1, -1)
+// sendLessonPackageSms sends the company's active lesson package template, (LESSONS) is replaced with the lessons left
+// and (EXPIRES) with the package expiry date
+func sendLessonPackageSms(db *sql.DB, companyId, studentId, groupId, actionType string, lessonsLeft int32, expiresAt string) {
+	sendActionSms(db, companyId, studentId, groupId, actionType, strings.NewReplacer("(LESSONS)", strconv.Itoa(int(lessonsLeft)), "(EXPIRES)", expiresAt), false)
+}
 
-	totalMonthLessons, err := r.countLessonsBetweenDates(
-		monthStart.Format("2006-01-02"),
-		monthEnd.Format("2006-01-02"),
-		group.Days,
+// sendActionSms sends the company's active template of actionType with the placeholders of replacer filled in, to the
+// additional contact of the student when toParents is set and one is saved
+func sendActionSms(db *sql.DB, companyId, studentId, groupId, actionType string, replacer *strings.Replacer, toParents bool) {
+	var (
+		templateId int
+		texts      []string
+		phone      string
+		smsBalance int
 	)
+	err := db.QueryRow(`SELECT id, texts FROM sms_template WHERE company_id=$1 AND action_type=$2 AND sms_template_type='ACTION' AND is_active=true`,
+		companyId, actionType).Scan(&templateId, pq.Array(&texts))
 	if err != nil {
-		return nil, fmt.Errorf("failed to count total month lessons: %v", err)
+		fmt.Printf("error fetching %s template: %v\n", actionType, err)
+		return
 	}
-
-	remainingStart := monthStart
-	remainingEnd := monthEnd
-
-	if groupEndDateTime.Before(monthEnd) && groupEndDateTime.After(monthStart) {
-		remainingEnd = groupEndDateTime
+	err = db.QueryRow(`SELECT CASE WHEN $2 AND COALESCE(additional_contact, '') ~ '\d{9}' THEN additional_contact ELSE phone END FROM students WHERE id=$1`,
+		studentId, toParents).Scan(&phone)
+	if err != nil {
+		fmt.Println("error fetching phone number:", err)
+		return
 	}
+	text := replacer.Replace(strings.Join(texts, " "))
+	smsText, usedSmsCount := utils.GetSmsFormatted(text, "O'qituvchi", db, studentId, groupId, 0, companyId)
 
-	if activationDateTime.After(monthStart) && activationDateTime.Before(monthEnd) {
-		remainingStart = activationDateTime
+	tx, err := db.Begin()
+	if err != nil {
+		fmt.Println("error starting transaction:", err)
+		return
+	}
+	defer func() {
+		if err != nil {
+			tx.Rollback()
+		} else {
+			tx.Commit()
+		}
+	}()
+	if err = tx.QueryRow(`SELECT sms_balance FROM company WHERE id=$1 FOR UPDATE`, companyId).Scan(&smsBalance); err != nil {
+		fmt.Println("error fetching SMS balance:", err)
+		return
 	}
+	if usedSmsCount > smsBalance {
+		err = errors.New("not enough sms balance")
+		fmt.Println(err)
+		return
+	}
+	_, err = tx.Exec(`INSERT INTO sms_used(id, company_id, sms_template_id, texts, sms_count, student_id, sms_used_type, created_at, created_by_id, created_by_name) 
+		VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9,$10)`,
+		uuid.New(), companyId, templateId, pq.Array([]string{smsText}), usedSmsCount, studentId, "BY_TEMPLATE", time.Now(), "00000000-0000-0000-0000-000000000000", "system")
+	if err != nil {
+		fmt.Println("error inserting into sms_used:", err)
+		return
+	}
+	if _, err = tx.Exec(`UPDATE company SET sms_balance=sms_balance-$1 WHERE id=$2`, usedSmsCount, companyId); err != nil {
+		fmt.Println("error updating company balance:", err)
+		return
+	}
+	if err = utils.SendSMS(phone, smsText); err != nil {
+		fmt.Println("error sending SMS:", err)
+	}
+}
 
-	remainingLessons, err := r.countLessonsBetweenDates(
-		remainingStart.Format("2006-01-02"),
-		remainingEnd.Format("2006-01-02"),
-		group.Days,
-	)
+// SellLessonPackage sells a prepaid lesson package of a PACKAGE billed group, the price is taken off the student
+// balance at once and attendance consumes the lessons afterwards
+func (r *StudentRepository) SellLessonPackage(ctx context.Context, companyId string, req *pb.SellLessonPackageRequest) (*pb.AbsResponse, error) {
+	if err := r.ensureFinanceClient(); err != nil {
+		return nil, err
+	}
+	billing, err := getGroupBilling(r.db, req.GroupId)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "group not found")
+	}
+	if billing.mode != BillingPackage {
+		return nil, status.Errorf(codes.FailedPrecondition, "group is billed %s, packages are sold only for PACKAGE groups", billing.mode)
+	}
+	var inGroup bool
+	err = r.db.QueryRow(`SELECT EXISTS(SELECT 1 FROM group_students WHERE student_id = $1 AND group_id = $2 AND company_id = $3 AND condition IN ('ACTIVE', 'FREEZE'))`,
+		req.StudentId, req.GroupId, companyId).Scan(&inGroup)
 	if err != nil {
-		return nil, fmt.Errorf("failed to count remaining lessons: %v", err)
+		return nil, fmt.Errorf("error while checking student group %v", err)
+	}
+	if !inGroup {
+		return nil, status.Errorf(codes.FailedPrecondition, "student is not in the group")
+	}
+	lessons, price := req.Lessons, req.Price
+	if lessons <= 0 {
+		lessons = billing.packageLessons
+	}
+	if price <= 0 {
+		if lessons == billing.packageLessons {
+			price = billing.packagePrice
+		} else {
+			price = math.Round(billing.perLessonPrice() * float64(lessons))
+		}
+	}
+	if lessons <= 0 || price <= 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "package lessons and price are required")
+	}
+	purchasedAt := time.Now()
+	if req.Date != "" {
+		if purchasedAt, err = time.Parse("2006-01-02", req.Date); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid date format: %v", err)
+		}
+	}
+	var expiresAt any
+	if billing.packageValidDays > 0 {
+		expiresAt = purchasedAt.AddDate(0, 0, int(billing.packageValidDays))
 	}
 
-	finalDiscountAmount := discountPriceFloat * float64(remainingLessons) / float64(totalMonthLessons)
+	tx, err := r.db.Begin()
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction %v", err)
+	}
+	defer func() {
+		if err != nil {
+			tx.Rollback()
+		} else {
+			tx.Commit()
+		}
+	}()
+	packageId := uuid.New()
+	_, err = tx.Exec(`INSERT INTO lesson_package (id, student_id, group_id, lessons_total, lessons_left, price, purchased_at, expires_at, created_by_id, created_by_name, company_id)
+		VALUES ($1, $2, $3, $4, $4, $5, $6, $7, $8, $9, $10)`,
+		packageId, req.StudentId, req.GroupId, lessons, price, purchasedAt, expiresAt, req.ActionById, req.ActionByName, companyId)
+	if err != nil {
+		return nil, fmt.Errorf("error while saving lesson package %v", err)
+	}
+	ctx, cancelFunc := utils.NewTimoutContext(ctx, companyId)
+	defer cancelFunc()
+	date := purchasedAt.Format("2006-01-02")
+	paymentId, err := r.financeClient.PaymentTakeOff(ctx, fmt.Sprintf("%d darslik paket uchun to'lov student balansidan yechib olindi", lessons),
+		date, fmt.Sprintf("%.2f", price), req.StudentId, req.ActionById, req.ActionByName, req.GroupId)
+	if err != nil {
+		return nil, fmt.Errorf("error while charging lesson package %v", err)
+	}
+	if _, err = tx.Exec(`UPDATE lesson_package SET payment_id = $1 WHERE id = $2`, paymentId, packageId); err != nil {
+		return nil, fmt.Errorf("error while saving package payment %v", err)
+	}
+	return &pb.AbsResponse{Status: http.StatusCreated, Message: packageId.String()}, nil
+}
 
-	return &pb.CalculateDiscountResponse{
-		CalculatedPrice: cast.ToString(finalDiscountAmount),
-	}, nil
+func (r *StudentRepository) GetLessonPackages(companyId, studentId, groupId string) (*pb.GetLessonPackagesResponse, error) {
+	rows, err := r.db.Query(`SELECT p.id, p.student_id, p.group_id, g.name, p.lessons_total, p.lessons_left, p.price, p.purchased_at, p.expires_at, p.status, p.created_by_name
+		FROM lesson_package p JOIN groups g ON g.id = p.group_id
+		WHERE p.student_id = $1 AND p.company_id = $2 AND ($3 = '' OR p.group_id::text = $3)
+		ORDER BY p.purchased_at DESC, p.created_at DESC`, studentId, companyId, groupId)
+	if err != nil {
+		return nil, fmt.Errorf("error while getting lesson packages %v", err)
+	}
+	defer rows.Close()
+	var response pb.GetLessonPackagesResponse
+	for rows.Next() {
+		var (
+			lessonPackage pb.AbsLessonPackage
+			purchasedAt   time.Time
+			expiresAt     sql.NullTime
+		)
+		err := rows.Scan(&lessonPackage.Id, &lessonPackage.StudentId, &lessonPackage.GroupId, &lessonPackage.GroupName, &lessonPackage.LessonsTotal,
+			&lessonPackage.LessonsLeft, &lessonPackage.Price, &purchasedAt, &expiresAt, &lessonPackage.Status, &lessonPackage.CreatedByName)
+		if err != nil {
+			return nil, fmt.Errorf("error while scanning lesson package %v", err)
+		}
+		lessonPackage.PurchasedAt = purchasedAt.Format("2006-01-02")
+		if expiresAt.Valid {
+			lessonPackage.ExpiresAt = expiresAt.Time.Format("2006-01-02")
+		}
+		response.Packages = append(response.Packages, &lessonPackage)
+	}
+	return &response, rows.Err()
 }
-func parseDate(input string) (time.Time, error) {
-	if t, err := time.Parse("2006-01-02T15:04:05Z", input); err == nil {
-		return t, nil
+
+// LessonPackageExpirer expires active packages past their expiry date and tells students about the lessons they lost
+func (r *StudentRepository) LessonPackageExpirer() {
+	rows, err := r.db.Query(`UPDATE lesson_package SET status = 'EXPIRED'
+		WHERE status = 'ACTIVE' AND expires_at < CURRENT_DATE
+		  AND company_id IN (SELECT id FROM company WHERE valid_date > CURRENT_DATE)
+		RETURNING company_id, student_id, group_id, lessons_left, expires_at`)
+	if err != nil {
+		fmt.Println("error while expiring lesson packages:", err)
+		return
+	}
+	type expiredPackage struct {
+		companyId, studentId, groupId string
+		lessonsLeft                   int32
+		expiresAt                     time.Time
+	}
+	var expired []expiredPackage
+	for rows.Next() {
+		var p expiredPackage
+		if err := rows.Scan(&p.companyId, &p.studentId, &p.groupId, &p.lessonsLeft, &p.expiresAt); err != nil {
+			fmt.Println("error scanning expired lesson package:", err)
+			continue
+		}
+		expired = append(expired, p)
+	}
+	rows.Close()
+	for _, p := range expired {
+		if p.lessonsLeft > 0 {
+			sendLessonPackageSms(r.db, p.companyId, p.studentId, p.groupId, "LESSON_PACKAGE_EXPIRED_ALERT", p.lessonsLeft, p.expiresAt.Format("2006-01-02"))
+		}
 	}
-	return time.Parse("2006-01-02", input)
 }
 
-func (r *StudentRepository) countLessonsBetweenDates(startDate, endDate string, groupDays []string) (int, error) {
-	start, err := parseDate(startDate)
+func (r *StudentRepository) CalculateDiscountSumma(companyId string, groupId string, startDate string, endDate string, discountPrice string, studentId string, paymentDate, activationDate string) (*pb.CalculateDiscountResponse, error) {
+	groupIDInt, err := strconv.ParseInt(groupId, 10, 64)
 	if err != nil {
-		return 0, err
+		return nil, fmt.Errorf("invalid group ID: %v", err)
 	}
 
-	end, err := parseDate(endDate)
+	discountPriceFloat, err := strconv.ParseFloat(discountPrice, 64)
 	if err != nil {
-		return 0, err
+		return nil, fmt.Errorf("invalid discount price: %v", err)
 	}
 
-	weekdays := make(map[string]time.Weekday)
-	weekdays["DUSHANBA"] = time.Monday
-	weekdays["SESHANBA"] = time.Tuesday
-	weekdays["CHORSHANBA"] = time.Wednesday
-	weekdays["PAYSHANBA"] = time.Thursday
-	weekdays["JUMA"] = time.Friday
-	weekdays["SHANBA"] = time.Saturday
-	weekdays["YAKSHANBA"] = time.Sunday
+	var exists bool
+	err = r.db.QueryRow(`SELECT EXISTS(SELECT 1 FROM groups WHERE id = $1 AND company_id = $2)`, groupIDInt, companyId).Scan(&exists)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get group info: %v", err)
+	}
+	if !exists {
+		return nil, status.Errorf(codes.NotFound, "group not found")
+	}
+	schedule, err := utils.GroupSchedule(r.db, groupId)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get group info: %v", err)
+	}
+	paymentDateTime, err := parseDate(paymentDate)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse payment date: %v", err)
+	}
 
-	groupWeekdays := make(map[time.Weekday]bool)
-	for _, day := range groupDays {
-		if wd, ok := weekdays[day]; ok {
-			groupWeekdays[wd] = true
-		}
+	activationDateTime, err := parseDate(activationDate)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse activation date: %v", err)
 	}
 
-	lessonCount := 0
-	for d := start; !d.After(end); d = d.AddDate(0, 0, 1) {
-		if groupWeekdays[d.Weekday()] {
-			lessonCount++
-		}
+	quote, err := pricing.QuoteMonth(schedule, discountPriceFloat, paymentDateTime, pricing.Enrollment{From: activationDateTime}, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count month lessons: %v", err)
 	}
 
-	return lessonCount, nil
+	return &pb.CalculateDiscountResponse{
+		CalculatedPrice: cast.ToString(quote.Amount),
+	}, nil
+}
+func parseDate(input string) (time.Time, error) {
+	if t, err := time.Parse("2006-01-02T15:04:05Z", input); err == nil {
+		return t, nil
+	}
+	return time.Parse("2006-01-02", input)
 }
 
 func (r *StudentRepository) SendSmsReasonAddToGroup(ctx context.Context, studentId string, groupId string, companyId string) {
@@ -1561,7 +2095,8 @@ func (r *StudentRepository) NotParticipateAlert() {
 			WHERE company_id = $1
 			AND created_at >= NOW() - INTERVAL '130 minutes'
 			AND sms_send = false
-			AND status = 0
+			AND EXISTS(SELECT 1 FROM attendance_status st WHERE st.company_id = attendance.company_id AND st.code = attendance.status AND st.absence_sms)
+			AND NOT EXISTS(SELECT 1 FROM group_holiday_dates(group_id, attend_date, attend_date))
 		`, companyId)
 		if err != nil {
 			fmt.Println("[NotParticipateAlert] failed to fetch attendance records:", err)
@@ -0,0 +1,286 @@
+package repository
+
+import (
+	"context"
+	"crypto/rand"
+	"database/sql"
+	"education-service/internal/clients"
+	"education-service/internal/utils"
+	"education-service/proto/pb"
+	"encoding/hex"
+	"errors"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"net/http"
+	"sort"
+	"strconv"
+	"time"
+)
+
+const (
+	FeedOwnerTeacher = "TEACHER"
+	FeedOwnerRoom    = "ROOM"
+
+	// maxTimetableDays keeps a timetable request to about a quarter so expanding schedules stays cheap
+	maxTimetableDays = 93
+	// feedPastDays and feedFutureDays are the lessons a calendar feed carries around today
+	feedPastDays   = 30
+	feedFutureDays = 90
+)
+
+type TimetableRepository struct {
+	db         *sql.DB
+	userClient *clients.UserClient
+}
+
+// GetTimetable expands the schedules of the matching groups into the lessons held between from and till, holidays
+// are left out and transferred lessons are shown on their new date
+func (r *TimetableRepository) GetTimetable(ctx context.Context, companyId string, req *pb.GetTimetableRequest) (*pb.GetTimetableResponse, error) {
+	from, err := time.Parse("2006-01-02", req.From)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid from date %s", req.From)
+	}
+	till, err := time.Parse("2006-01-02", req.Till)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid till date %s", req.Till)
+	}
+	if till.Before(from) {
+		return nil, status.Errorf(codes.InvalidArgument, "till can not be before from")
+	}
+	if till.Sub(from) > maxTimetableDays*24*time.Hour {
+		return nil, status.Errorf(codes.InvalidArgument, "timetable range can not be longer than %d days", maxTimetableDays)
+	}
+	lessons, err := r.expandLessons(ctx, companyId, req, from, till)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.GetTimetableResponse{Lessons: lessons}, nil
+}
+
+func (r *TimetableRepository) CreateCalendarFeed(ctx context.Context, companyId string, req *pb.CreateCalendarFeedRequest) (*pb.CalendarFeed, error) {
+	ownerName, err := r.feedOwnerName(ctx, companyId, req.OwnerType, req.OwnerId)
+	if err != nil {
+		return nil, err
+	}
+	secret := make([]byte, 24)
+	if _, err := rand.Read(secret); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to generate feed token: %v", err)
+	}
+	feed := pb.CalendarFeed{
+		Id:        uuid.New().String(),
+		OwnerType: req.OwnerType,
+		OwnerId:   req.OwnerId,
+		OwnerName: ownerName,
+		Token:     hex.EncodeToString(secret),
+	}
+	var createdAt time.Time
+	err = r.db.QueryRow(`INSERT INTO calendar_feed (id, owner_type, owner_id, token, company_id) VALUES ($1, $2, $3, $4, $5) RETURNING created_at`,
+		feed.Id, feed.OwnerType, feed.OwnerId, feed.Token, companyId).Scan(&createdAt)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to create calendar feed: %v", err)
+	}
+	feed.CreatedAt = createdAt.Format("2006-01-02 15:04:05")
+	return &feed, nil
+}
+
+func (r *TimetableRepository) GetCalendarFeeds(ctx context.Context, companyId string, req *pb.GetCalendarFeedsRequest) (*pb.GetCalendarFeedsResponse, error) {
+	rows, err := r.db.Query(`SELECT id, owner_type, owner_id, token, created_at FROM calendar_feed
+		WHERE company_id = $1 AND NOT revoked
+			AND (NULLIF($2, '') IS NULL OR owner_type = $2)
+			AND (NULLIF($3, '') IS NULL OR owner_id = $3)
+		ORDER BY created_at DESC`, companyId, req.OwnerType, req.OwnerId)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get calendar feeds: %v", err)
+	}
+	defer rows.Close()
+	var response pb.GetCalendarFeedsResponse
+	for rows.Next() {
+		var (
+			feed      pb.CalendarFeed
+			createdAt time.Time
+		)
+		if err := rows.Scan(&feed.Id, &feed.OwnerType, &feed.OwnerId, &feed.Token, &createdAt); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to scan calendar feed: %v", err)
+		}
+		feed.CreatedAt = createdAt.Format("2006-01-02 15:04:05")
+		response.Feeds = append(response.Feeds, &feed)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	for _, feed := range response.Feeds {
+		feed.OwnerName, _ = r.feedOwnerName(ctx, companyId, feed.OwnerType, feed.OwnerId)
+	}
+	return &response, nil
+}
+
+// RevokeCalendarFeed stops a feed, calendars subscribed to its url get not found from then on
+func (r *TimetableRepository) RevokeCalendarFeed(companyId, id string) (*pb.AbsResponse, error) {
+	result, err := r.db.Exec(`UPDATE calendar_feed SET revoked = TRUE WHERE id = $1 AND company_id = $2`, id, companyId)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to revoke calendar feed: %v", err)
+	}
+	if affected, _ := result.RowsAffected(); affected == 0 {
+		return nil, status.Errorf(codes.NotFound, "calendar feed not found")
+	}
+	return &pb.AbsResponse{Status: http.StatusOK, Message: "calendar feed revoked"}, nil
+}
+
+// GetCalendarFeedTimetable resolves the company from the token, the caller is a calendar app without a login
+func (r *TimetableRepository) GetCalendarFeedTimetable(req *pb.GetCalendarFeedTimetableRequest) (*pb.CalendarFeedTimetable, error) {
+	var (
+		feed      pb.CalendarFeed
+		companyId string
+		createdAt time.Time
+	)
+	err := r.db.QueryRow(`SELECT id, owner_type, owner_id, token, created_at, company_id FROM calendar_feed WHERE token = $1 AND NOT revoked`,
+		req.Token).Scan(&feed.Id, &feed.OwnerType, &feed.OwnerId, &feed.Token, &createdAt, &companyId)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, status.Errorf(codes.NotFound, "calendar feed not found")
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get calendar feed: %v", err)
+	}
+	feed.CreatedAt = createdAt.Format("2006-01-02 15:04:05")
+	ctx, cancel := utils.NewTimoutContext(context.Background(), companyId)
+	defer cancel()
+	feed.OwnerName, _ = r.feedOwnerName(ctx, companyId, feed.OwnerType, feed.OwnerId)
+
+	filter := &pb.GetTimetableRequest{}
+	if feed.OwnerType == FeedOwnerTeacher {
+		filter.TeacherId = feed.OwnerId
+	} else {
+		roomId, _ := strconv.Atoi(feed.OwnerId)
+		filter.RoomId = int32(roomId)
+	}
+	today := time.Now()
+	lessons, err := r.expandLessons(ctx, companyId, filter, today.AddDate(0, 0, -feedPastDays), today.AddDate(0, 0, feedFutureDays))
+	if err != nil {
+		return nil, err
+	}
+	return &pb.CalendarFeedTimetable{Feed: &feed, Lessons: lessons}, nil
+}
+
+// expandLessons also reads groups that ended up to a month before from, a lesson of theirs may be transferred into the range
+func (r *TimetableRepository) expandLessons(ctx context.Context, companyId string, req *pb.GetTimetableRequest, from, till time.Time) ([]*pb.TimetableLesson, error) {
+	rows, err := r.db.Query(`SELECT g.id, g.name, g.course_id, c.title, g.teacher_id, COALESCE(g.room_id, 0), COALESCE(rm.title, ''), g.start_time, c.duration_lesson
+		FROM groups g
+		JOIN courses c ON c.id = g.course_id
+		LEFT JOIN rooms rm ON rm.id = g.room_id
+		WHERE g.company_id = $1 AND NOT g.is_archived
+			AND g.start_date <= $3::date AND g.end_date >= $2::date - 31
+			AND ($4 = 0 OR g.room_id = $4)
+			AND ($5 = '' OR g.teacher_id::text = $5)
+			AND ($6 = 0 OR g.course_id = $6)
+			AND ($7 = '' OR g.id::text = $7)`,
+		companyId, from.Format("2006-01-02"), till.Format("2006-01-02"), req.RoomId, req.TeacherId, req.CourseId, req.GroupId)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get timetable groups: %v", err)
+	}
+	var groups []*pb.TimetableLesson
+	var durations []int
+	for rows.Next() {
+		var (
+			group     pb.TimetableLesson
+			startTime string
+			duration  int
+		)
+		err := rows.Scan(&group.GroupId, &group.GroupName, &group.CourseId, &group.CourseName, &group.TeacherId, &group.RoomId, &group.RoomName,
+			&startTime, &duration)
+		if err != nil {
+			rows.Close()
+			return nil, status.Errorf(codes.Internal, "failed to scan timetable group: %v", err)
+		}
+		group.StartTime = startTime
+		groups = append(groups, &group)
+		durations = append(durations, duration)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	teacherNames := make(map[string]string)
+	var lessons []*pb.TimetableLesson
+	for i, group := range groups {
+		schedule, err := utils.GroupSchedule(r.db, strconv.FormatInt(group.GroupId, 10))
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "%v", err)
+		}
+		movedFrom := make(map[string]string, len(schedule.Transfers))
+		for _, transfer := range schedule.Transfers {
+			movedFrom[transfer.TransferDate.Format("2006-01-02")] = transfer.RealDate.Format("2006-01-02")
+		}
+		startTime, endTime := group.StartTime, ""
+		if start, end, err := parseLessonTime(group.StartTime, durations[i]); err == nil {
+			startTime, endTime = formatLessonTime(start), formatLessonTime(end)
+		}
+		teacherName, ok := teacherNames[group.TeacherId]
+		if !ok {
+			teacherName, _ = r.userClient.GetTeacherById(ctx, group.TeacherId)
+			teacherNames[group.TeacherId] = teacherName
+		}
+		for _, date := range schedule.LessonDates(from, till) {
+			day := date.Format("2006-01-02")
+			realDate, transferred := movedFrom[day]
+			lessons = append(lessons, &pb.TimetableLesson{
+				GroupId:     group.GroupId,
+				GroupName:   group.GroupName,
+				CourseId:    group.CourseId,
+				CourseName:  group.CourseName,
+				TeacherId:   group.TeacherId,
+				TeacherName: teacherName,
+				RoomId:      group.RoomId,
+				RoomName:    group.RoomName,
+				Date:        day,
+				StartTime:   startTime,
+				EndTime:     endTime,
+				Transferred: transferred,
+				RealDate:    realDate,
+			})
+		}
+	}
+	sort.SliceStable(lessons, func(i, j int) bool {
+		if lessons[i].Date != lessons[j].Date {
+			return lessons[i].Date < lessons[j].Date
+		}
+		return lessons[i].StartTime < lessons[j].StartTime
+	})
+	return lessons, nil
+}
+
+func (r *TimetableRepository) feedOwnerName(ctx context.Context, companyId, ownerType, ownerId string) (string, error) {
+	switch ownerType {
+	case FeedOwnerTeacher:
+		var exists bool
+		err := r.db.QueryRow(`SELECT EXISTS(SELECT 1 FROM groups WHERE teacher_id::text = $1 AND company_id = $2)`, ownerId, companyId).Scan(&exists)
+		if err != nil {
+			return "", status.Errorf(codes.Internal, "failed to check teacher: %v", err)
+		}
+		if !exists {
+			return "", status.Errorf(codes.NotFound, "teacher has no groups")
+		}
+		name, err := r.userClient.GetTeacherById(ctx, ownerId)
+		if err != nil {
+			return "", status.Errorf(codes.NotFound, "teacher not found: %v", err)
+		}
+		return name, nil
+	case FeedOwnerRoom:
+		var title string
+		err := r.db.QueryRow(`SELECT title FROM rooms WHERE id::text = $1 AND company_id = $2`, ownerId, companyId).Scan(&title)
+		if errors.Is(err, sql.ErrNoRows) {
+			return "", status.Errorf(codes.NotFound, "room not found")
+		}
+		if err != nil {
+			return "", status.Errorf(codes.Internal, "failed to get room: %v", err)
+		}
+		return title, nil
+	default:
+		return "", status.Errorf(codes.InvalidArgument, "invalid feed owner type %s", ownerType)
+	}
+}
+
+func NewTimetableRepository(db *sql.DB, userClient *clients.UserClient) *TimetableRepository {
+	return &TimetableRepository{db: db, userClient: userClient}
+}
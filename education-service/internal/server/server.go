@@ -79,7 +79,9 @@ func RunServer() {
 	groupRepo := repository.NewGroupRepository(db, userClient)
 	groupService := service.NewGroupService(groupRepo)
 	attendanceRepo := repository.NewAttendanceRepository(db, financeClientChanForAttendance)
-	attendanceService := service.NewAttendanceService(attendanceRepo)
+	attendancePolicyRepo := repository.NewAttendancePolicyRepository(db)
+	attendanceService := service.NewAttendanceService(attendanceRepo, attendancePolicyRepo)
+	attendancePolicyService := service.NewAttendancePolicyService(attendancePolicyRepo)
 	studentRepo := repository.NewStudentRepository(db, userClient, financeClientChanForStudent)
 	studentService := service.NewStudentService(studentRepo)
 	companyRepo := repository.NewCompanyRepository(db, userClient)
@@ -90,6 +92,22 @@ func RunServer() {
 	companyFinanceService := service.NewCompanyFinanceService(companyFinanceRepo)
 	smsRepository := repository.NewSmsRepository(db)
 	smsService := service.NewSmsService(smsRepository)
+	holidayRepo := repository.NewHolidayRepository(db)
+	holidayService := service.NewHolidayService(holidayRepo)
+	timetableRepo := repository.NewTimetableRepository(db, userClient)
+	timetableService := service.NewTimetableService(timetableRepo)
+	lessonRepo := repository.NewLessonRepository(db, userClient, attendanceRepo)
+	lessonService := service.NewLessonService(lessonRepo)
+	attendanceStatusRepo := repository.NewAttendanceStatusRepository(db)
+	attendanceStatusService := service.NewAttendanceStatusService(attendanceStatusRepo)
+	checkInRepo := repository.NewCheckInRepository(db, attendanceRepo, attendancePolicyRepo)
+	checkInService := service.NewCheckInService(checkInRepo)
+	attendanceAnalyticsRepo := repository.NewAttendanceAnalyticsRepository(db, userClient, attendancePolicyRepo)
+	attendanceAnalyticsService := service.NewAttendanceAnalyticsService(attendanceAnalyticsRepo)
+	notificationRepo := repository.NewNotificationRepository(db)
+	notificationService := service.NewNotificationService(notificationRepo)
+	groupWaitlistRepo := repository.NewGroupWaitlistRepository(db, studentRepo)
+	groupWaitlistService := service.NewGroupWaitlistService(groupWaitlistRepo)
 	lis, err := net.Listen("tcp", ":"+strconv.Itoa(cfg.Server.Port))
 	if err != nil {
 		log.Fatalf("Failed to listen on port %v: %v", cfg.Server.Port, err)
@@ -102,6 +120,15 @@ func RunServer() {
 	pb.RegisterCourseServiceServer(grpcServer, courseService)
 	pb.RegisterGroupServiceServer(grpcServer, groupService)
 	pb.RegisterAttendanceServiceServer(grpcServer, attendanceService)
+	pb.RegisterHolidayServiceServer(grpcServer, holidayService)
+	pb.RegisterTimetableServiceServer(grpcServer, timetableService)
+	pb.RegisterLessonServiceServer(grpcServer, lessonService)
+	pb.RegisterAttendanceStatusServiceServer(grpcServer, attendanceStatusService)
+	pb.RegisterAttendancePolicyServiceServer(grpcServer, attendancePolicyService)
+	pb.RegisterCheckInServiceServer(grpcServer, checkInService)
+	pb.RegisterAttendanceAnalyticsServiceServer(grpcServer, attendanceAnalyticsService)
+	pb.RegisterNotificationServiceServer(grpcServer, notificationService)
+	pb.RegisterGroupWaitlistServiceServer(grpcServer, groupWaitlistService)
 	pb.RegisterStudentServiceServer(grpcServer, studentService)
 	pb.RegisterCompanyServiceServer(grpcServer, companyService)
 	pb.RegisterTariffServiceServer(grpcServer, tarrifService)
@@ -135,6 +162,27 @@ func RunServer() {
 		fmt.Println("Running Happy birthday alert ...")
 		studentRepo.HappyBirthdayAlert()
 		fmt.Println("Completed Happy birthday alert ...")
+		fmt.Println("Running installment late fee taker ...")
+		studentRepo.InstallmentLateFeeTaker()
+		fmt.Println("Completed installment late fee taker ...")
+		fmt.Println("Running debt collections ...")
+		studentRepo.DebtCollectionRunner()
+		fmt.Println("Completed debt collections ...")
+		fmt.Println("Running recurring expenses ...")
+		studentRepo.RecurringExpensePoster()
+		fmt.Println("Completed recurring expenses ...")
+		fmt.Println("Running lesson package expirer ...")
+		studentRepo.LessonPackageExpirer()
+		fmt.Println("Completed lesson package expirer ...")
+		fmt.Println("Running course price activator ...")
+		courseRepo.CoursePriceActivator()
+		fmt.Println("Completed course price activator ...")
+		fmt.Println("Running upcoming lesson generator ...")
+		lessonRepo.GenerateUpcomingLessons()
+		fmt.Println("Completed upcoming lesson generator ...")
+		fmt.Println("Running at risk student alert ...")
+		attendanceAnalyticsRepo.AtRiskAlert()
+		fmt.Println("Completed at risk student alert ...")
 	})
 
 	if err != nil {
@@ -145,6 +193,9 @@ func RunServer() {
 		fmt.Println("Running not participate alert ... ")
 		studentRepo.NotParticipateAlert()
 		fmt.Println("Completed not participate alert ...")
+		fmt.Println("Running waitlist offer expirer ...")
+		groupWaitlistRepo.ExpireWaitlistOffers()
+		fmt.Println("Completed waitlist offer expirer ...")
 	})
 	if err != nil {
 		log.Fatalf("Failed to schedule 2-hour cron job: %v", err)
@@ -0,0 +1,68 @@
+package service
+
+import (
+	"context"
+	"education-service/internal/repository"
+	"education-service/internal/utils"
+	"education-service/proto/pb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+type AttendanceAnalyticsService struct {
+	pb.UnimplementedAttendanceAnalyticsServiceServer
+	repo *repository.AttendanceAnalyticsRepository
+}
+
+func NewAttendanceAnalyticsService(repo *repository.AttendanceAnalyticsRepository) *AttendanceAnalyticsService {
+	return &AttendanceAnalyticsService{repo: repo}
+}
+
+func (s *AttendanceAnalyticsService) GetAttendanceRates(ctx context.Context, req *pb.GetAttendanceRatesRequest) (*pb.GetAttendanceRatesResponse, error) {
+	companyId := utils.GetCompanyId(ctx)
+	if companyId == "" {
+		return nil, status.Error(codes.Aborted, "error while getting company from context")
+	}
+	return s.repo.GetAttendanceRates(ctx, companyId, req)
+}
+
+func (s *AttendanceAnalyticsService) GetAbsenceStreaks(ctx context.Context, req *pb.GetAbsenceStreaksRequest) (*pb.GetAbsenceStreaksResponse, error) {
+	companyId := utils.GetCompanyId(ctx)
+	if companyId == "" {
+		return nil, status.Error(codes.Aborted, "error while getting company from context")
+	}
+	return s.repo.GetAbsenceStreaks(companyId, req)
+}
+
+func (s *AttendanceAnalyticsService) GetAttendanceTrend(ctx context.Context, req *pb.GetAttendanceTrendRequest) (*pb.GetAttendanceTrendResponse, error) {
+	companyId := utils.GetCompanyId(ctx)
+	if companyId == "" {
+		return nil, status.Error(codes.Aborted, "error while getting company from context")
+	}
+	return s.repo.GetAttendanceTrend(companyId, req)
+}
+
+func (s *AttendanceAnalyticsService) GetAtRiskPolicy(ctx context.Context, req *emptypb.Empty) (*pb.AtRiskPolicy, error) {
+	companyId := utils.GetCompanyId(ctx)
+	if companyId == "" {
+		return nil, status.Error(codes.Aborted, "error while getting company from context")
+	}
+	return s.repo.GetAtRiskPolicy(companyId)
+}
+
+func (s *AttendanceAnalyticsService) UpdateAtRiskPolicy(ctx context.Context, req *pb.AtRiskPolicy) (*pb.AbsResponse, error) {
+	companyId := utils.GetCompanyId(ctx)
+	if companyId == "" {
+		return nil, status.Error(codes.Aborted, "error while getting company from context")
+	}
+	return s.repo.UpdateAtRiskPolicy(companyId, req)
+}
+
+func (s *AttendanceAnalyticsService) GetAtRiskStudents(ctx context.Context, req *pb.GetAtRiskStudentsRequest) (*pb.GetAtRiskStudentsResponse, error) {
+	companyId := utils.GetCompanyId(ctx)
+	if companyId == "" {
+		return nil, status.Error(codes.Aborted, "error while getting company from context")
+	}
+	return s.repo.GetAtRiskStudents(companyId, req)
+}
@@ -0,0 +1,60 @@
+package service
+
+import (
+	"context"
+	"education-service/internal/repository"
+	"education-service/internal/utils"
+	"education-service/proto/pb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+type AttendancePolicyService struct {
+	pb.UnimplementedAttendancePolicyServiceServer
+	repo *repository.AttendancePolicyRepository
+}
+
+func NewAttendancePolicyService(repo *repository.AttendancePolicyRepository) *AttendancePolicyService {
+	return &AttendancePolicyService{repo: repo}
+}
+
+func (s *AttendancePolicyService) GetAttendancePolicy(ctx context.Context, req *emptypb.Empty) (*pb.AttendancePolicy, error) {
+	companyId := utils.GetCompanyId(ctx)
+	if companyId == "" {
+		return nil, status.Error(codes.Aborted, "error while getting company from context")
+	}
+	return s.repo.GetAttendancePolicy(companyId)
+}
+
+func (s *AttendancePolicyService) UpdateAttendancePolicy(ctx context.Context, req *pb.AttendancePolicy) (*pb.AbsResponse, error) {
+	companyId := utils.GetCompanyId(ctx)
+	if companyId == "" {
+		return nil, status.Error(codes.Aborted, "error while getting company from context")
+	}
+	return s.repo.UpdateAttendancePolicy(companyId, req)
+}
+
+func (s *AttendancePolicyService) RequestAttendanceUnlock(ctx context.Context, req *pb.RequestAttendanceUnlockRequest) (*pb.AbsResponse, error) {
+	companyId := utils.GetCompanyId(ctx)
+	if companyId == "" {
+		return nil, status.Error(codes.Aborted, "error while getting company from context")
+	}
+	return s.repo.RequestAttendanceUnlock(companyId, req)
+}
+
+func (s *AttendancePolicyService) GetAttendanceUnlockRequests(ctx context.Context, req *pb.GetAttendanceUnlockRequestsRequest) (*pb.GetAttendanceUnlockRequestsResponse, error) {
+	companyId := utils.GetCompanyId(ctx)
+	if companyId == "" {
+		return nil, status.Error(codes.Aborted, "error while getting company from context")
+	}
+	return s.repo.GetAttendanceUnlockRequests(companyId, req)
+}
+
+func (s *AttendancePolicyService) ReviewAttendanceUnlock(ctx context.Context, req *pb.ReviewAttendanceUnlockRequest) (*pb.AbsResponse, error) {
+	companyId := utils.GetCompanyId(ctx)
+	if companyId == "" {
+		return nil, status.Error(codes.Aborted, "error while getting company from context")
+	}
+	return s.repo.ReviewAttendanceUnlock(companyId, req)
+}
@@ -15,11 +15,13 @@ import (
 type AttendanceService struct {
 	pb.UnimplementedAttendanceServiceServer
 	attendanceRepo *repository.AttendanceRepository
+	policyRepo     *repository.AttendancePolicyRepository
 }
 
-func NewAttendanceService(repo *repository.AttendanceRepository) *AttendanceService {
+func NewAttendanceService(repo *repository.AttendanceRepository, policyRepo *repository.AttendancePolicyRepository) *AttendanceService {
 	return &AttendanceService{
 		attendanceRepo: repo,
+		policyRepo:     policyRepo,
 	}
 }
 func (s *AttendanceService) GetAttendance(ctx context.Context, req *pb.GetAttendanceRequest) (*pb.GetAttendanceResponse, error) {
@@ -51,74 +53,12 @@ func (s *AttendanceService) SetAttendance(ctx context.Context, req *pb.SetAttend
 	if req.GroupId == "" || req.StudentId == "" || req.TeacherId == "" {
 		return nil, errors.New("group ID, student ID, and teacher ID are required")
 	}
-
-	if req.ActionByRole == "CEO" || req.ActionByRole == "ADMIN" {
-		if req.Status == -1 {
-			err := s.attendanceRepo.DeleteAttendance(req.GroupId, req.StudentId, req.TeacherId, req.AttendDate)
-			if err != nil {
-				return nil, err
-			}
-			return &pb.AbsResponse{
-				Status:  200,
-				Message: "Attendance successfully deleted",
-			}, nil
-		} else {
-			err := s.attendanceRepo.CreateAttendance(ctx, companyId, req.GroupId, req.StudentId, req.TeacherId, req.AttendDate, req.Status, req.ActionById, req.ActionByRole)
-			if err != nil {
-				return nil, err
-			}
-			return &pb.AbsResponse{
-				Status:  200,
-				Message: "Attendance successfully created",
-			}, nil
-		}
-	}
-	attendDate, err := time.Parse("2006-01-02", req.AttendDate)
-	if err != nil {
-		return nil, errors.New("invalid attendance date format")
-	}
-	now := time.Now()
-	today := now.Truncate(24 * time.Hour)
-	if attendDate.After(today) {
-		hasTransferredLesson := s.attendanceRepo.IsHaveTransferredLesson(req.GroupId)
-		if hasTransferredLesson {
-			cutoffTime := time.Date(now.Year(), now.Month(), now.Day(), 12, 0, 0, 0, now.Location())
-			if attendDate.Equal(today.AddDate(0, 0, -1)) && now.After(cutoffTime) {
-				return nil, errors.New("attendance cannot be set for yesterday after 12 PM")
-			}
-
-			if req.Status == -1 {
-				err = s.attendanceRepo.DeleteAttendance(req.GroupId, req.StudentId, req.TeacherId, req.AttendDate)
-				if err != nil {
-					return nil, err
-				}
-				return &pb.AbsResponse{
-					Status:  200,
-					Message: "Attendance successfully deleted",
-				}, nil
-			} else {
-				err = s.attendanceRepo.CreateAttendance(ctx, companyId, req.GroupId, req.StudentId, req.TeacherId, req.AttendDate, req.Status, req.ActionById, req.ActionByRole)
-				if err != nil {
-					return nil, err
-				}
-				return &pb.AbsResponse{
-					Status:  200,
-					Message: "Attendance successfully created",
-				}, nil
-			}
-		}
-		return nil, errors.New("attendance date cannot be in the future")
-	}
-	validDay, err := s.attendanceRepo.IsValidGroupDay(ctx, req.GroupId, today)
-	if err != nil {
+	if err := s.checkAttendanceDate(ctx, companyId, req.GroupId, req.AttendDate, req.ActionById, req.ActionByRole); err != nil {
 		return nil, err
 	}
-	if !validDay {
-		return nil, errors.New("attendance cannot be created today; group is not active")
-	}
 
 	if req.Status == -1 {
-		err = s.attendanceRepo.DeleteAttendance(req.GroupId, req.StudentId, req.TeacherId, req.AttendDate)
+		err := s.attendanceRepo.DeleteAttendance(ctx, companyId, req.GroupId, req.StudentId, req.TeacherId, req.AttendDate)
 		if err != nil {
 			return nil, err
 		}
@@ -127,7 +67,7 @@ func (s *AttendanceService) SetAttendance(ctx context.Context, req *pb.SetAttend
 			Message: "Attendance successfully deleted",
 		}, nil
 	} else {
-		err = s.attendanceRepo.CreateAttendance(ctx, companyId, req.GroupId, req.StudentId, req.TeacherId, req.AttendDate, req.Status, req.ActionById, req.ActionByRole)
+		err := s.attendanceRepo.CreateAttendance(ctx, companyId, req.GroupId, req.StudentId, req.TeacherId, req.AttendDate, req.Status, req.MakeupGroupId, req.MakeupDate, req.ActionById, req.ActionByRole)
 		if err != nil {
 			return nil, err
 		}
@@ -137,21 +77,83 @@ func (s *AttendanceService) SetAttendance(ctx context.Context, req *pb.SetAttend
 		}, nil
 	}
 }
+
+func (s *AttendanceService) SetGroupAttendance(ctx context.Context, req *pb.SetGroupAttendanceRequest) (*pb.SetGroupAttendanceResponse, error) {
+	companyId := utils.GetCompanyId(ctx)
+	if companyId == "" {
+		return nil, status.Error(codes.PermissionDenied, "error while getting company from context")
+	}
+	if req.GroupId == "" || req.TeacherId == "" {
+		return nil, errors.New("group ID and teacher ID are required")
+	}
+	if len(req.Marks) == 0 {
+		return nil, errors.New("at least one student mark is required")
+	}
+	if err := s.checkAttendanceDate(ctx, companyId, req.GroupId, req.AttendDate, req.ActionById, req.ActionByRole); err != nil {
+		return nil, err
+	}
+	return s.attendanceRepo.SetGroupAttendance(ctx, companyId, req)
+}
+
+// checkAttendanceDate applies the attendance policy of the company, roles it does not apply to may mark any date
+func (s *AttendanceService) checkAttendanceDate(ctx context.Context, companyId, groupId, date, actionById, actionByRole string) error {
+	policy, err := s.policyRepo.GetAttendancePolicy(companyId)
+	if err != nil {
+		return err
+	}
+	if repository.CanOverrideAttendancePolicy(policy, actionByRole) {
+		return nil
+	}
+	if err := s.policyRepo.CheckAttendanceDate(policy, groupId, date, actionById); err != nil {
+		return err
+	}
+	attendDate, err := time.Parse("2006-01-02", date)
+	if err != nil {
+		return errors.New("invalid attendance date format")
+	}
+	validDay, err := s.attendanceRepo.IsValidGroupDay(ctx, groupId, attendDate)
+	if err != nil {
+		return err
+	}
+	if !validDay && !s.attendanceRepo.IsHaveTransferredLesson(groupId, attendDate) {
+		return errors.New("attendance cannot be created on this day; group has no lesson")
+	}
+	return nil
+}
 func (s *AttendanceService) CalculateTeacherSalaryByAttendance(ctx context.Context, req *pb.CalculateTeacherSalaryRequest) (*pb.CalculateTeacherSalaryResponse, error) {
 	companyId := utils.GetCompanyId(ctx)
 	if companyId == "" {
 		return nil, status.Error(codes.Aborted, "error while getting company from context")
 	}
 
+	teacherIds := req.TeacherIds
+	if len(teacherIds) == 0 {
+		teacherIds = []string{req.TeacherId}
+	}
 	var response []*pb.AbsCalculateSalary
-	groups := s.attendanceRepo.GetAllGroupsByTeacherId(req.TeacherId, req.From, req.To)
+	for _, teacherId := range teacherIds {
+		salaries, err := s.calculateTeacherSalary(companyId, teacherId, req.From, req.To)
+		if err != nil {
+			return nil, err
+		}
+		response = append(response, salaries...)
+	}
+
+	return &pb.CalculateTeacherSalaryResponse{Salaries: response}, nil
+}
+
+// calculateTeacherSalary returns the teacher's salary per group for the period
+func (s *AttendanceService) calculateTeacherSalary(companyId, teacherId, from, to string) ([]*pb.AbsCalculateSalary, error) {
+	var response []*pb.AbsCalculateSalary
+	groups := s.attendanceRepo.GetAllGroupsByTeacherId(teacherId, from, to)
 	for _, group := range groups {
 		var absCalculate pb.AbsCalculateSalary
+		absCalculate.TeacherId = teacherId
 		absCalculate.GroupId = group.Id
 		absCalculate.GroupName = group.Name
 		absCalculate.CommonLessonCountInPeriod = group.LessonCountOnPeriod
 
-		attendancesMap, err := s.attendanceRepo.GetAttendanceByTeacherAndGroup(companyId, req.TeacherId, group.Id, req.From, req.To)
+		attendancesMap, err := s.attendanceRepo.GetAttendanceByTeacherAndGroup(companyId, teacherId, group.Id, from, to)
 		if err != nil {
 			return nil, errors.New(fmt.Sprintf("error while getting attendance by teacher and group on calculating %v", err.Error()))
 		}
@@ -185,5 +187,5 @@ func (s *AttendanceService) CalculateTeacherSalaryByAttendance(ctx context.Conte
 		response = append(response, &absCalculate)
 	}
 
-	return &pb.CalculateTeacherSalaryResponse{Salaries: response}, nil
+	return response, nil
 }
@@ -0,0 +1,51 @@
+package service
+
+import (
+	"context"
+	"education-service/internal/repository"
+	"education-service/internal/utils"
+	"education-service/proto/pb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type AttendanceStatusService struct {
+	pb.UnimplementedAttendanceStatusServiceServer
+	repo *repository.AttendanceStatusRepository
+}
+
+func NewAttendanceStatusService(repo *repository.AttendanceStatusRepository) *AttendanceStatusService {
+	return &AttendanceStatusService{repo: repo}
+}
+
+func (s *AttendanceStatusService) CreateAttendanceStatus(ctx context.Context, req *pb.AbsAttendanceStatus) (*pb.AbsResponse, error) {
+	companyId := utils.GetCompanyId(ctx)
+	if companyId == "" {
+		return nil, status.Error(codes.Aborted, "error while getting company from context")
+	}
+	return s.repo.CreateAttendanceStatus(companyId, req)
+}
+
+func (s *AttendanceStatusService) UpdateAttendanceStatus(ctx context.Context, req *pb.AbsAttendanceStatus) (*pb.AbsResponse, error) {
+	companyId := utils.GetCompanyId(ctx)
+	if companyId == "" {
+		return nil, status.Error(codes.Aborted, "error while getting company from context")
+	}
+	return s.repo.UpdateAttendanceStatus(companyId, req)
+}
+
+func (s *AttendanceStatusService) DeleteAttendanceStatus(ctx context.Context, req *pb.DeleteAbsRequest) (*pb.AbsResponse, error) {
+	companyId := utils.GetCompanyId(ctx)
+	if companyId == "" {
+		return nil, status.Error(codes.Aborted, "error while getting company from context")
+	}
+	return s.repo.DeleteAttendanceStatus(companyId, req.Id)
+}
+
+func (s *AttendanceStatusService) GetAttendanceStatuses(ctx context.Context, req *pb.GetAttendanceStatusesRequest) (*pb.GetAttendanceStatusesResponse, error) {
+	companyId := utils.GetCompanyId(ctx)
+	if companyId == "" {
+		return nil, status.Error(codes.Aborted, "error while getting company from context")
+	}
+	return s.repo.GetAttendanceStatuses(companyId, req)
+}
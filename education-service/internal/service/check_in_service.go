@@ -0,0 +1,78 @@
+package service
+
+import (
+	"context"
+	"education-service/internal/repository"
+	"education-service/internal/utils"
+	"education-service/proto/pb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type CheckInService struct {
+	pb.UnimplementedCheckInServiceServer
+	repo *repository.CheckInRepository
+}
+
+func NewCheckInService(repo *repository.CheckInRepository) *CheckInService {
+	return &CheckInService{repo: repo}
+}
+
+func (s *CheckInService) SendStudentLoginCode(ctx context.Context, req *pb.SendStudentLoginCodeRequest) (*pb.AbsResponse, error) {
+	if req.Subdomain == "" || req.Phone == "" {
+		return nil, status.Error(codes.InvalidArgument, "subdomain and phone are required")
+	}
+	return s.repo.SendStudentLoginCode(req)
+}
+
+func (s *CheckInService) VerifyStudentLoginCode(ctx context.Context, req *pb.VerifyStudentLoginCodeRequest) (*pb.VerifyStudentLoginCodeResponse, error) {
+	if req.Subdomain == "" || req.Phone == "" || req.Code == "" {
+		return nil, status.Error(codes.InvalidArgument, "subdomain, phone and code are required")
+	}
+	return s.repo.VerifyStudentLoginCode(req)
+}
+
+func (s *CheckInService) ValidateStudentSession(ctx context.Context, req *pb.ValidateStudentSessionRequest) (*pb.StudentSession, error) {
+	if req.Token == "" {
+		return nil, status.Error(codes.Unauthenticated, "token is required")
+	}
+	return s.repo.ValidateStudentSession(req)
+}
+
+func (s *CheckInService) GetCheckInCode(ctx context.Context, req *pb.GetCheckInCodeRequest) (*pb.CheckInCode, error) {
+	companyId := utils.GetCompanyId(ctx)
+	if companyId == "" {
+		return nil, status.Error(codes.Aborted, "error while getting company from context")
+	}
+	return s.repo.GetCheckInCode(companyId, req)
+}
+
+func (s *CheckInService) CheckIn(ctx context.Context, req *pb.CheckInRequest) (*pb.CheckInResponse, error) {
+	companyId := utils.GetCompanyId(ctx)
+	if companyId == "" {
+		return nil, status.Error(codes.Aborted, "error while getting company from context")
+	}
+	if req.Token == "" || req.StudentId == "" {
+		return nil, status.Error(codes.InvalidArgument, "token and student ID are required")
+	}
+	return s.repo.CheckIn(ctx, companyId, req)
+}
+
+func (s *CheckInService) GetLessonCheckIns(ctx context.Context, req *pb.GetLessonCheckInsRequest) (*pb.GetLessonCheckInsResponse, error) {
+	companyId := utils.GetCompanyId(ctx)
+	if companyId == "" {
+		return nil, status.Error(codes.Aborted, "error while getting company from context")
+	}
+	return s.repo.GetLessonCheckIns(companyId, req)
+}
+
+func (s *CheckInService) ConfirmCheckIns(ctx context.Context, req *pb.ConfirmCheckInsRequest) (*pb.SetGroupAttendanceResponse, error) {
+	companyId := utils.GetCompanyId(ctx)
+	if companyId == "" {
+		return nil, status.Error(codes.Aborted, "error while getting company from context")
+	}
+	if len(req.StudentIds) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "at least one student is required")
+	}
+	return s.repo.ConfirmCheckIns(ctx, companyId, req)
+}
@@ -70,3 +70,43 @@ func (s *CourseService) GetCourseById(ctx context.Context, req *pb.GetCourseById
 	}
 	return s.repo.GetCourseById(companyId, req.Id)
 }
+
+func (s *CourseService) GetCourseBilling(ctx context.Context, req *pb.GetCourseByIdRequest) (*pb.CourseBilling, error) {
+	companyId := utils.GetCompanyId(ctx)
+	if companyId == "" {
+		return nil, status.Error(codes.Aborted, "error while getting company from context")
+	}
+	return s.repo.GetCourseBilling(companyId, req.Id)
+}
+
+func (s *CourseService) UpdateCourseBilling(ctx context.Context, req *pb.CourseBilling) (*pb.AbsResponse, error) {
+	companyId := utils.GetCompanyId(ctx)
+	if companyId == "" {
+		return nil, status.Error(codes.Aborted, "error while getting company from context")
+	}
+	return s.repo.UpdateCourseBilling(companyId, req)
+}
+
+func (s *CourseService) ScheduleCoursePrice(ctx context.Context, req *pb.ScheduleCoursePriceRequest) (*pb.AbsResponse, error) {
+	companyId := utils.GetCompanyId(ctx)
+	if companyId == "" {
+		return nil, status.Error(codes.Aborted, "error while getting company from context")
+	}
+	return s.repo.ScheduleCoursePrice(companyId, req)
+}
+
+func (s *CourseService) GetCoursePriceHistory(ctx context.Context, req *pb.GetCourseByIdRequest) (*pb.GetCoursePriceHistoryResponse, error) {
+	companyId := utils.GetCompanyId(ctx)
+	if companyId == "" {
+		return nil, status.Error(codes.Aborted, "error while getting company from context")
+	}
+	return s.repo.GetCoursePriceHistory(companyId, req.Id)
+}
+
+func (s *CourseService) SetEnrollmentPriceLock(ctx context.Context, req *pb.SetEnrollmentPriceLockRequest) (*pb.AbsResponse, error) {
+	companyId := utils.GetCompanyId(ctx)
+	if companyId == "" {
+		return nil, status.Error(codes.Aborted, "error while getting company from context")
+	}
+	return s.repo.SetEnrollmentPriceLock(companyId, req)
+}
@@ -25,7 +25,8 @@ func (s *GroupService) CreateGroup(ctx context.Context, req *pb.CreateGroupReque
 	if companyId == "" {
 		return nil, status.Error(codes.Aborted, "error while getting company from context")
 	}
-	id, err := s.repo.CreateGroup(companyId, req.Name, req.CourseId, req.TeacherId, req.Type, req.Days, req.RoomId, req.LessonStartTime, req.GroupStartDate, req.GroupEndDate)
+	id, err := s.repo.CreateGroup(companyId, req.Name, req.CourseId, req.TeacherId, req.Type, req.Days, req.RoomId, req.LessonStartTime, req.GroupStartDate, req.GroupEndDate,
+		req.Force, req.ActionById, req.ActionByName)
 	if err != nil {
 		return nil, err
 	}
@@ -37,7 +38,8 @@ func (s *GroupService) UpdateGroup(ctx context.Context, req *pb.GetUpdateGroupAb
 	if companyId == "" {
 		return nil, status.Error(codes.Aborted, "error while getting company from context")
 	}
-	err := s.repo.UpdateGroup(companyId, req.Id, req.Name, req.CourseId, req.TeacherId, req.Type, req.Days, req.RoomId, req.LessonStartTime, req.GroupStartDate, req.GroupEndDate)
+	err := s.repo.UpdateGroup(companyId, req.Id, req.Name, req.CourseId, req.TeacherId, req.Type, req.Days, req.RoomId, req.LessonStartTime, req.GroupStartDate, req.GroupEndDate,
+		req.Force, req.ActionById, req.ActionByName)
 	if err != nil {
 		return nil, err
 	}
@@ -150,3 +152,43 @@ func (s *GroupService) GetLeftAfterTrialPeriod(ctx context.Context, req *pb.GetL
 	}
 	return s.repo.GetLeftAfterTrial(companyId, req.From, req.To, req.Page, req.Size)
 }
+
+func (s *GroupService) GetGroupsByIds(ctx context.Context, req *pb.GetGroupsByIdsRequest) (*pb.GetGroupsByIdsResponse, error) {
+	companyId := utils.GetCompanyId(ctx)
+	if companyId == "" {
+		return nil, status.Error(codes.Aborted, "error while getting company from context")
+	}
+	return s.repo.GetGroupsByIds(ctx, companyId, req.Ids)
+}
+
+func (s *GroupService) UpdateGroupBillingMode(ctx context.Context, req *pb.UpdateGroupBillingModeRequest) (*pb.AbsResponse, error) {
+	companyId := utils.GetCompanyId(ctx)
+	if companyId == "" {
+		return nil, status.Error(codes.Aborted, "error while getting company from context")
+	}
+	return s.repo.UpdateGroupBillingMode(companyId, req.GroupId, req.BillingMode)
+}
+
+func (s *GroupService) UpdateGroupMaxStudents(ctx context.Context, req *pb.UpdateGroupMaxStudentsRequest) (*pb.AbsResponse, error) {
+	companyId := utils.GetCompanyId(ctx)
+	if companyId == "" {
+		return nil, status.Error(codes.Aborted, "error while getting company from context")
+	}
+	return s.repo.UpdateGroupMaxStudents(companyId, req.GroupId, req.MaxStudents)
+}
+
+func (s *GroupService) CheckScheduleConflicts(ctx context.Context, req *pb.CheckScheduleConflictsRequest) (*pb.ScheduleConflicts, error) {
+	companyId := utils.GetCompanyId(ctx)
+	if companyId == "" {
+		return nil, status.Error(codes.Aborted, "error while getting company from context")
+	}
+	return s.repo.CheckScheduleConflicts(companyId, req)
+}
+
+func (s *GroupService) GetScheduleConflictOverrides(ctx context.Context, req *pb.GetScheduleConflictOverridesRequest) (*pb.GetScheduleConflictOverridesResponse, error) {
+	companyId := utils.GetCompanyId(ctx)
+	if companyId == "" {
+		return nil, status.Error(codes.Aborted, "error while getting company from context")
+	}
+	return s.repo.GetScheduleConflictOverrides(companyId, req)
+}
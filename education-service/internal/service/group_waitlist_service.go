@@ -0,0 +1,51 @@
+package service
+
+import (
+	"context"
+	"education-service/internal/repository"
+	"education-service/internal/utils"
+	"education-service/proto/pb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type GroupWaitlistService struct {
+	pb.UnimplementedGroupWaitlistServiceServer
+	repo *repository.GroupWaitlistRepository
+}
+
+func NewGroupWaitlistService(repo *repository.GroupWaitlistRepository) *GroupWaitlistService {
+	return &GroupWaitlistService{repo: repo}
+}
+
+func (s *GroupWaitlistService) GetGroupWaitlist(ctx context.Context, req *pb.GetGroupWaitlistRequest) (*pb.GetGroupWaitlistResponse, error) {
+	companyId := utils.GetCompanyId(ctx)
+	if companyId == "" {
+		return nil, status.Error(codes.Aborted, "error while getting company from context")
+	}
+	return s.repo.GetGroupWaitlist(companyId, req)
+}
+
+func (s *GroupWaitlistService) MoveWaitlistEntry(ctx context.Context, req *pb.MoveWaitlistEntryRequest) (*pb.AbsResponse, error) {
+	companyId := utils.GetCompanyId(ctx)
+	if companyId == "" {
+		return nil, status.Error(codes.Aborted, "error while getting company from context")
+	}
+	return s.repo.MoveWaitlistEntry(companyId, req)
+}
+
+func (s *GroupWaitlistService) CancelWaitlistEntry(ctx context.Context, req *pb.WaitlistEntryRequest) (*pb.AbsResponse, error) {
+	companyId := utils.GetCompanyId(ctx)
+	if companyId == "" {
+		return nil, status.Error(codes.Aborted, "error while getting company from context")
+	}
+	return s.repo.CancelWaitlistEntry(companyId, req)
+}
+
+func (s *GroupWaitlistService) AcceptWaitlistOffer(ctx context.Context, req *pb.AcceptWaitlistOfferRequest) (*pb.AddToGroupResponse, error) {
+	companyId := utils.GetCompanyId(ctx)
+	if companyId == "" {
+		return nil, status.Error(codes.Aborted, "error while getting company from context")
+	}
+	return s.repo.AcceptWaitlistOffer(ctx, companyId, req)
+}
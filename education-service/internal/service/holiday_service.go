@@ -0,0 +1,59 @@
+package service
+
+import (
+	"context"
+	"education-service/internal/repository"
+	"education-service/internal/utils"
+	"education-service/proto/pb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type HolidayService struct {
+	pb.UnimplementedHolidayServiceServer
+	repo *repository.HolidayRepository
+}
+
+func NewHolidayService(repo *repository.HolidayRepository) *HolidayService {
+	return &HolidayService{repo: repo}
+}
+
+func (s *HolidayService) CreateHoliday(ctx context.Context, req *pb.AbsHoliday) (*pb.AbsResponse, error) {
+	companyId := utils.GetCompanyId(ctx)
+	if companyId == "" {
+		return nil, status.Error(codes.Aborted, "error while getting company from context")
+	}
+	return s.repo.CreateHoliday(companyId, req)
+}
+
+func (s *HolidayService) UpdateHoliday(ctx context.Context, req *pb.AbsHoliday) (*pb.AbsResponse, error) {
+	companyId := utils.GetCompanyId(ctx)
+	if companyId == "" {
+		return nil, status.Error(codes.Aborted, "error while getting company from context")
+	}
+	return s.repo.UpdateHoliday(companyId, req)
+}
+
+func (s *HolidayService) DeleteHoliday(ctx context.Context, req *pb.DeleteAbsRequest) (*pb.AbsResponse, error) {
+	companyId := utils.GetCompanyId(ctx)
+	if companyId == "" {
+		return nil, status.Error(codes.Aborted, "error while getting company from context")
+	}
+	return s.repo.DeleteHoliday(companyId, req.Id)
+}
+
+func (s *HolidayService) GetHolidays(ctx context.Context, req *pb.GetHolidaysRequest) (*pb.GetHolidaysResponse, error) {
+	companyId := utils.GetCompanyId(ctx)
+	if companyId == "" {
+		return nil, status.Error(codes.Aborted, "error while getting company from context")
+	}
+	return s.repo.GetHolidays(companyId, req)
+}
+
+func (s *HolidayService) GetGroupHolidays(ctx context.Context, req *pb.GetGroupHolidaysRequest) (*pb.GetGroupHolidaysResponse, error) {
+	companyId := utils.GetCompanyId(ctx)
+	if companyId == "" {
+		return nil, status.Error(codes.Aborted, "error while getting company from context")
+	}
+	return s.repo.GetGroupHolidays(companyId, req)
+}
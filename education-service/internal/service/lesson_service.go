@@ -0,0 +1,43 @@
+package service
+
+import (
+	"context"
+	"education-service/internal/repository"
+	"education-service/internal/utils"
+	"education-service/proto/pb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type LessonService struct {
+	pb.UnimplementedLessonServiceServer
+	repo *repository.LessonRepository
+}
+
+func NewLessonService(repo *repository.LessonRepository) *LessonService {
+	return &LessonService{repo: repo}
+}
+
+func (s *LessonService) GetLessons(ctx context.Context, req *pb.GetLessonsRequest) (*pb.GetLessonsResponse, error) {
+	companyId := utils.GetCompanyId(ctx)
+	if companyId == "" {
+		return nil, status.Error(codes.Aborted, "error while getting company from context")
+	}
+	return s.repo.GetLessons(ctx, companyId, req)
+}
+
+func (s *LessonService) GetLessonById(ctx context.Context, req *pb.DeleteAbsRequest) (*pb.AbsLesson, error) {
+	companyId := utils.GetCompanyId(ctx)
+	if companyId == "" {
+		return nil, status.Error(codes.Aborted, "error while getting company from context")
+	}
+	return s.repo.GetLessonById(ctx, companyId, req.Id)
+}
+
+func (s *LessonService) UpdateLesson(ctx context.Context, req *pb.UpdateLessonRequest) (*pb.AbsLesson, error) {
+	companyId := utils.GetCompanyId(ctx)
+	if companyId == "" {
+		return nil, status.Error(codes.Aborted, "error while getting company from context")
+	}
+	return s.repo.UpdateLesson(ctx, companyId, req)
+}
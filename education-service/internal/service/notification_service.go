@@ -0,0 +1,35 @@
+package service
+
+import (
+	"context"
+	"education-service/internal/repository"
+	"education-service/internal/utils"
+	"education-service/proto/pb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type NotificationService struct {
+	pb.UnimplementedNotificationServiceServer
+	repo *repository.NotificationRepository
+}
+
+func NewNotificationService(repo *repository.NotificationRepository) *NotificationService {
+	return &NotificationService{repo: repo}
+}
+
+func (s *NotificationService) GetNotifications(ctx context.Context, req *pb.GetNotificationsRequest) (*pb.GetNotificationsResponse, error) {
+	companyId := utils.GetCompanyId(ctx)
+	if companyId == "" {
+		return nil, status.Error(codes.Aborted, "error while getting company from context")
+	}
+	return s.repo.GetNotifications(companyId, req)
+}
+
+func (s *NotificationService) MarkNotificationsRead(ctx context.Context, req *pb.MarkNotificationsReadRequest) (*pb.AbsResponse, error) {
+	companyId := utils.GetCompanyId(ctx)
+	if companyId == "" {
+		return nil, status.Error(codes.Aborted, "error while getting company from context")
+	}
+	return s.repo.MarkNotificationsRead(companyId, req)
+}
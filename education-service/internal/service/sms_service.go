@@ -61,3 +61,11 @@ func (s *SmsService) SendSmsDirectly(ctx context.Context, req *pb.SendSmsDirectl
 
 	return s.smsRepo.SendSmsDirectly(req, companyId)
 }
+func (s *SmsService) SendStaffSms(ctx context.Context, req *pb.SendStaffSmsRequest) (*pb.AbsResponse, error) {
+	companyId := utils.GetCompanyId(ctx)
+	if companyId == "" {
+		return nil, status.Error(codes.FailedPrecondition, "Company ID is required")
+	}
+
+	return s.smsRepo.SendStaffSms(req, companyId)
+}
@@ -63,18 +63,16 @@ func (s *StudentService) DeleteStudent(ctx context.Context, req *pb.DeleteStuden
 		Message: "accomplished",
 	}, nil
 }
-func (s *StudentService) AddToGroup(ctx context.Context, req *pb.AddToGroupRequest) (*pb.AbsResponse, error) {
+func (s *StudentService) AddToGroup(ctx context.Context, req *pb.AddToGroupRequest) (*pb.AddToGroupResponse, error) {
 	companyId := utils.GetCompanyId(ctx)
 	if companyId == "" {
 		return nil, status.Error(codes.Aborted, "error while getting company from context")
 	}
-	if err := s.repo.AddToGroup(ctx, companyId, req.GroupId, req.StudentIds, req.CreatedDate, req.CreatedBy); err != nil {
+	results, err := s.repo.AddToGroup(ctx, companyId, req.GroupId, req.StudentIds, req.CreatedDate, req.CreatedBy, req.CreatedByName, req.PromoCode)
+	if err != nil {
 		return nil, err
 	}
-	return &pb.AbsResponse{
-		Status:  200,
-		Message: "students added to group",
-	}, nil
+	return &pb.AddToGroupResponse{Results: results}, nil
 }
 func (s *StudentService) GetStudentById(ctx context.Context, req *pb.NoteStudentByAbsRequest) (*pb.GetStudentByIdResponse, error) {
 	companyId := utils.GetCompanyId(ctx)
@@ -130,7 +128,7 @@ func (s *StudentService) TransferLessonDate(ctx context.Context, req *pb.Transfe
 	if companyId == "" {
 		return nil, status.Error(codes.Aborted, "error while getting company from context")
 	}
-	return s.repo.TransferLessonDate(companyId, req.GroupId, req.From, req.To)
+	return s.repo.TransferLessonDate(companyId, req.GroupId, req.From, req.To, req.Force, req.ActionById, req.ActionByName)
 }
 func (s *StudentService) ChangeConditionStudent(ctx context.Context, req *pb.ChangeConditionStudentRequest) (*pb.AbsResponse, error) {
 	companyId := utils.GetCompanyId(ctx)
@@ -167,3 +165,51 @@ func (s *StudentService) CalculateDiscountSumma(ctx context.Context, req *pb.Cal
 	}
 	return s.repo.CalculateDiscountSumma(companyId, req.GroupId, req.StartDate, req.EndDate, req.DiscountPrice, req.StudentId, req.PaymentDate, req.StudentActivationDateInThisGroupWhilePayment)
 }
+
+func (s *StudentService) GetDebtors(ctx context.Context, req *pb.GetDebtorsRequest) (*pb.GetDebtorsResponse, error) {
+	companyId := utils.GetCompanyId(ctx)
+	if companyId == "" {
+		return nil, status.Error(codes.Aborted, "error while getting company from context")
+	}
+	return s.repo.GetDebtors(companyId)
+}
+
+func (s *StudentService) GetStudentsByPhones(ctx context.Context, req *pb.GetStudentsByPhonesRequest) (*pb.GetStudentsByPhonesResponse, error) {
+	companyId := utils.GetCompanyId(ctx)
+	if companyId == "" {
+		return nil, status.Error(codes.Aborted, "error while getting company from context")
+	}
+	return s.repo.GetStudentsByPhones(companyId, req.Phones)
+}
+
+func (s *StudentService) GetDiscountContext(ctx context.Context, req *pb.GetDiscountContextRequest) (*pb.GetDiscountContextResponse, error) {
+	companyId := utils.GetCompanyId(ctx)
+	if companyId == "" {
+		return nil, status.Error(codes.Aborted, "error while getting company from context")
+	}
+	return s.repo.GetDiscountContext(companyId, req.StudentId, req.GroupId)
+}
+
+func (s *StudentService) SendDebtReminder(ctx context.Context, req *pb.SendDebtReminderRequest) (*pb.AbsResponse, error) {
+	companyId := utils.GetCompanyId(ctx)
+	if companyId == "" {
+		return nil, status.Error(codes.Aborted, "error while getting company from context")
+	}
+	return s.repo.SendDebtReminder(companyId, req.StudentId, req.ActionType)
+}
+
+func (s *StudentService) SellLessonPackage(ctx context.Context, req *pb.SellLessonPackageRequest) (*pb.AbsResponse, error) {
+	companyId := utils.GetCompanyId(ctx)
+	if companyId == "" {
+		return nil, status.Error(codes.Aborted, "error while getting company from context")
+	}
+	return s.repo.SellLessonPackage(ctx, companyId, req)
+}
+
+func (s *StudentService) GetLessonPackages(ctx context.Context, req *pb.GetLessonPackagesRequest) (*pb.GetLessonPackagesResponse, error) {
+	companyId := utils.GetCompanyId(ctx)
+	if companyId == "" {
+		return nil, status.Error(codes.Aborted, "error while getting company from context")
+	}
+	return s.repo.GetLessonPackages(companyId, req.StudentId, req.GroupId)
+}
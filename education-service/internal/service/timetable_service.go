@@ -0,0 +1,58 @@
+package service
+
+import (
+	"context"
+	"education-service/internal/repository"
+	"education-service/internal/utils"
+	"education-service/proto/pb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type TimetableService struct {
+	pb.UnimplementedTimetableServiceServer
+	repo *repository.TimetableRepository
+}
+
+func NewTimetableService(repo *repository.TimetableRepository) *TimetableService {
+	return &TimetableService{repo: repo}
+}
+
+func (s *TimetableService) GetTimetable(ctx context.Context, req *pb.GetTimetableRequest) (*pb.GetTimetableResponse, error) {
+	companyId := utils.GetCompanyId(ctx)
+	if companyId == "" {
+		return nil, status.Error(codes.Aborted, "error while getting company from context")
+	}
+	return s.repo.GetTimetable(ctx, companyId, req)
+}
+
+func (s *TimetableService) CreateCalendarFeed(ctx context.Context, req *pb.CreateCalendarFeedRequest) (*pb.CalendarFeed, error) {
+	companyId := utils.GetCompanyId(ctx)
+	if companyId == "" {
+		return nil, status.Error(codes.Aborted, "error while getting company from context")
+	}
+	return s.repo.CreateCalendarFeed(ctx, companyId, req)
+}
+
+func (s *TimetableService) GetCalendarFeeds(ctx context.Context, req *pb.GetCalendarFeedsRequest) (*pb.GetCalendarFeedsResponse, error) {
+	companyId := utils.GetCompanyId(ctx)
+	if companyId == "" {
+		return nil, status.Error(codes.Aborted, "error while getting company from context")
+	}
+	return s.repo.GetCalendarFeeds(ctx, companyId, req)
+}
+
+func (s *TimetableService) RevokeCalendarFeed(ctx context.Context, req *pb.DeleteAbsRequest) (*pb.AbsResponse, error) {
+	companyId := utils.GetCompanyId(ctx)
+	if companyId == "" {
+		return nil, status.Error(codes.Aborted, "error while getting company from context")
+	}
+	return s.repo.RevokeCalendarFeed(companyId, req.Id)
+}
+
+func (s *TimetableService) GetCalendarFeedTimetable(ctx context.Context, req *pb.GetCalendarFeedTimetableRequest) (*pb.CalendarFeedTimetable, error) {
+	if req.Token == "" {
+		return nil, status.Error(codes.InvalidArgument, "token is required")
+	}
+	return s.repo.GetCalendarFeedTimetable(req)
+}
@@ -3,6 +3,7 @@ package utils
 import (
 	"context"
 	"database/sql"
+	"education-service/internal/pricing"
 	"fmt"
 	"github.com/lib/pq"
 	"google.golang.org/grpc"
@@ -10,14 +11,12 @@ import (
 	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
 	"math"
-	"strings"
 	"time"
 )
 
 func IsValidLessonDay(db *sql.DB, groupId, fromDate string) (bool, error) {
-	var lessonDays []string
-
-	err := db.QueryRow(`SELECT days FROM groups WHERE id = $1`, groupId).Scan(pq.Array(&lessonDays))
+	var schedule pricing.Schedule
+	err := db.QueryRow(`SELECT date_type, days FROM groups WHERE id = $1`, groupId).Scan(&schedule.DateType, pq.Array(&schedule.Days))
 	if err != nil {
 		return false, fmt.Errorf("failed to retrieve lesson days: %v", err)
 	}
@@ -26,30 +25,24 @@ func IsValidLessonDay(db *sql.DB, groupId, fromDate string) (bool, error) {
 		return false, fmt.Errorf("invalid date format for 'fromDate': %v", err)
 	}
 
-	dayOfWeek := parsedDate.Weekday().String()
-	switch dayOfWeek {
-	case "Monday":
-		dayOfWeek = "DUSHANBA"
-	case "Tuesday":
-		dayOfWeek = "SESHANBA"
-	case "Wednesday":
-		dayOfWeek = "CHORSHANBA"
-	case "Thursday":
-		dayOfWeek = "PAYSHANBA"
-	case "Friday":
-		dayOfWeek = "JUMA"
-	case "Saturday":
-		dayOfWeek = "SHANBA"
-	case "Sunday":
-		dayOfWeek = "YAKSHANBA"
+	if !schedule.Weekdays()[parsedDate.Weekday()] {
+		return false, nil
 	}
-	for _, lessonDay := range lessonDays {
-		if strings.ToUpper(lessonDay) == strings.ToUpper(dayOfWeek) {
-			return true, nil
-		}
+	holiday, err := IsGroupHoliday(db, groupId, fromDate)
+	if err != nil {
+		return false, err
 	}
+	return !holiday, nil
+}
 
-	return false, nil
+// IsGroupHoliday reports whether the group has no lessons on date because of a company, room or group holiday
+func IsGroupHoliday(db *sql.DB, groupId string, date string) (bool, error) {
+	var holiday bool
+	err := db.QueryRow(`SELECT EXISTS(SELECT 1 FROM group_holiday_dates($1, $2, $2))`, groupId, date).Scan(&holiday)
+	if err != nil {
+		return false, fmt.Errorf("failed to check group holiday: %v", err)
+	}
+	return holiday, nil
 }
 
 func RecoveryInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp interface{}, err error) {
@@ -61,122 +54,96 @@ func RecoveryInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryS
 	}()
 	return handler(ctx, req)
 }
-func CalculateMoneyForStatus(db *sql.DB, manualPriceForCourse *float64, groupId string, tillDate string) (float64, error) {
-	var coursePrice float64
-	var courseDurationLesson int
-	var groupStartDate string
-	var groupEndDate string
-	var groupDays []string
-	var dateType string
 
-	query := `
-        SELECT c.price, c.course_duration, g.start_date, g.end_date, g.days, g.date_type
+// ResolveCoursePrice returns the monthly price a student pays in a group on the given date: the grandfathered price of
+// the enrollment once it is locked, otherwise the course price version effective on that date
+func ResolveCoursePrice(db *sql.DB, groupId string, studentId string, date time.Time) (float64, error) {
+	var price float64
+	err := db.QueryRow(`
+        SELECT COALESCE(
+            (SELECT gs.locked_price FROM group_students gs
+             WHERE gs.group_id = g.id AND gs.student_id::text = $2 AND gs.locked_price IS NOT NULL AND gs.locked_price_from <= $3::date),
+            (SELECT h.price FROM course_price_history h
+             WHERE h.course_id = g.course_id AND h.effective_from <= $3::date
+             ORDER BY h.effective_from DESC, h.created_at DESC LIMIT 1),
+            c.price)
         FROM groups g
         JOIN courses c ON g.course_id = c.id
         WHERE g.id = $1
-    `
-
-	err := db.QueryRow(query, groupId).Scan(&coursePrice, &courseDurationLesson, &groupStartDate, &groupEndDate, pq.Array(&groupDays), &dateType)
+    `, groupId, studentId, date.Format("2006-01-02")).Scan(&price)
 	if err != nil {
-		return 0, fmt.Errorf("error getting course details: %v", err)
-	}
-
-	if manualPriceForCourse != nil {
-		coursePrice = coursePrice - *manualPriceForCourse
+		return 0, fmt.Errorf("failed to resolve course price: %v", err)
 	}
+	return price, nil
+}
 
-	tillDateParsed, err := time.Parse("2006-01-02", tillDate)
+// GroupSchedule loads the lesson schedule of a group with its transferred lessons and holidays for the pricing package
+func GroupSchedule(db *sql.DB, groupId string) (pricing.Schedule, error) {
+	var schedule pricing.Schedule
+	err := db.QueryRow(`SELECT date_type, days, start_date, end_date FROM groups WHERE id = $1`, groupId).
+		Scan(&schedule.DateType, pq.Array(&schedule.Days), &schedule.StartDate, &schedule.EndDate)
 	if err != nil {
-		return 0, fmt.Errorf("error parsing till date: %v", err)
+		return schedule, fmt.Errorf("error getting group schedule: %v", err)
 	}
-
-	startOfMonth := time.Date(tillDateParsed.Year(), tillDateParsed.Month(), 1, 0, 0, 0, 0, tillDateParsed.Location())
-	endOfMonth := startOfMonth.AddDate(0, 1, -1)
-
-	// Parse the timestamp format from the database
-	groupEndDateParsed, err := time.Parse(time.RFC3339, groupEndDate)
+	rows, err := db.Query(`SELECT real_date, transfer_date FROM transfer_lesson WHERE group_id = $1`, groupId)
 	if err != nil {
-		// Try alternate format if RFC3339 fails
-		groupEndDateParsed, err = time.Parse("2006-01-02T15:04:05Z", groupEndDate)
-		if err != nil {
-			return 0, fmt.Errorf("error parsing group end date: %v", err)
-		}
+		return schedule, fmt.Errorf("error getting transferred lessons: %v", err)
 	}
-
-	// Calculate total days in month
-	daysInMonth := endOfMonth.Day()
-
-	// Calculate effective end date and days for price calculation
-	effectiveEndDate := endOfMonth
-	if groupEndDateParsed.Before(endOfMonth) {
-		effectiveEndDate = groupEndDateParsed
+	defer rows.Close()
+	for rows.Next() {
+		var transfer pricing.Transfer
+		if err := rows.Scan(&transfer.RealDate, &transfer.TransferDate); err != nil {
+			return schedule, fmt.Errorf("error scanning transferred lesson: %v", err)
+		}
+		schedule.Transfers = append(schedule.Transfers, transfer)
 	}
-
-	// Calculate the proportional price based on days
-	daysToConsider := effectiveEndDate.Day()
-	proportionalPrice := (coursePrice * float64(daysToConsider)) / float64(daysInMonth)
-
-	// Get lesson dates for the actual period
-	lessonDates := getLessonDatesInMonth(groupDays, dateType, startOfMonth, effectiveEndDate)
-	if len(lessonDates) == 0 {
-		return 0, fmt.Errorf("no lessons scheduled for the current month")
+	if err := rows.Err(); err != nil {
+		return schedule, err
 	}
-
-	firstLessonDate := lessonDates[0]
-
-	// If we're before or on the first lesson date, return proportional price
-	if !tillDateParsed.After(firstLessonDate) {
-		return math.Round(proportionalPrice), nil
+	holidayRows, err := db.Query(`SELECT holiday_date FROM group_holiday_dates($1, $2, $3)`, groupId, schedule.StartDate, schedule.EndDate)
+	if err != nil {
+		return schedule, fmt.Errorf("error getting group holidays: %v", err)
 	}
-
-	// Count passed lessons
-	passedLessons := 0
-	for _, lessonDate := range lessonDates {
-		if lessonDate.Before(tillDateParsed) {
-			passedLessons++
+	defer holidayRows.Close()
+	for holidayRows.Next() {
+		var holiday time.Time
+		if err := holidayRows.Scan(&holiday); err != nil {
+			return schedule, fmt.Errorf("error scanning group holiday: %v", err)
 		}
+		schedule.Holidays = append(schedule.Holidays, holiday)
 	}
+	return schedule, holidayRows.Err()
+}
 
-	// Calculate remaining money based on proportional price
-	pricePerLesson := proportionalPrice / float64(len(lessonDates))
-	remainingMoney := proportionalPrice - (float64(passedLessons) * pricePerLesson)
+// CalculateMoneyForStatus returns what the lessons of the month left from tillDate on cost the student, the month is
+// prorated by days when the group ends inside it
+func CalculateMoneyForStatus(db *sql.DB, manualPriceForCourse *float64, groupId string, studentId string, tillDate string) (float64, error) {
+	tillDateParsed, err := time.Parse("2006-01-02", tillDate)
+	if err != nil {
+		return 0, fmt.Errorf("error parsing till date: %v", err)
+	}
 
-	return math.Round(remainingMoney), nil
-}
-func getLessonDatesInMonth(groupDays []string, dateType string, startDate, endDate time.Time) []time.Time {
-	var lessonDates []time.Time
-	for currentDate := startDate; !currentDate.After(endDate); currentDate = currentDate.AddDate(0, 0, 1) {
-		if isLessonDay(currentDate, groupDays, dateType) {
-			lessonDates = append(lessonDates, currentDate)
-		}
+	schedule, err := GroupSchedule(db, groupId)
+	if err != nil {
+		return 0, err
 	}
-	return lessonDates
-}
 
-func isLessonDay(currentDate time.Time, groupDays []string, dateType string) bool {
-	dayName := getDayName(currentDate.Weekday())
-	isGroupDay := false
-	for _, groupDay := range groupDays {
-		if groupDay == dayName {
-			isGroupDay = true
-			break
-		}
+	coursePrice, err := ResolveCoursePrice(db, groupId, studentId, tillDateParsed)
+	if err != nil {
+		return 0, err
 	}
 
-	return isGroupDay
-}
+	// a group ending inside the month charges it by the days it still runs
+	var discounts []pricing.Discount
+	if manualPriceForCourse != nil {
+		discounts = append(discounts, pricing.Discount{Amount: pricing.ProrateMonth(schedule, *manualPriceForCourse, tillDateParsed)})
+	}
 
-func getDayName(weekday time.Weekday) string {
-	days := map[time.Weekday]string{
-		time.Monday:    "DUSHANBA",
-		time.Tuesday:   "SESHANBA",
-		time.Wednesday: "CHORSHANBA",
-		time.Thursday:  "PAYSHANBA",
-		time.Friday:    "JUMA",
-		time.Saturday:  "SHANBA",
-		time.Sunday:    "YAKSHANBA",
+	quote, err := pricing.QuoteMonth(schedule, pricing.ProrateMonth(schedule, coursePrice, tillDateParsed), tillDateParsed, pricing.Enrollment{From: tillDateParsed}, discounts)
+	if err != nil {
+		return 0, err
 	}
-	return days[weekday]
+	return math.Round(quote.Amount), nil
 }
 
 func CheckGroupAndTeacher(db *sql.DB, groupId, actionRole string, actionId string) bool {
@@ -192,88 +159,77 @@ func CheckGroupAndTeacher(db *sql.DB, groupId, actionRole string, actionId strin
 	return true
 }
 
+// CheckLessonTeacher is CheckGroupAndTeacher for the lesson of the group on date, a substitute teacher works with the
+// lessons they are assigned to only
+func CheckLessonTeacher(db *sql.DB, groupId, date, actionRole string, actionId string) bool {
+	if actionRole == "TEACHER" {
+		substitute := false
+		err := db.QueryRow(`SELECT exists(SELECT 1 FROM lessons where group_id=$1 and lesson_date=$2 and substitute_teacher_id::text=$3)`,
+			groupId, date, actionId).Scan(&substitute)
+		if err == nil && substitute {
+			return true
+		}
+	}
+	return CheckGroupAndTeacher(db, groupId, actionRole, actionId)
+}
+
+// SubstituteLessonDates are the lesson dates of the group between from and till teacherId is the substitute on
+func SubstituteLessonDates(db *sql.DB, groupId, teacherId string, from, till time.Time) (map[string]bool, error) {
+	rows, err := db.Query(`SELECT lesson_date FROM lessons WHERE group_id=$1 AND substitute_teacher_id::text=$2 AND lesson_date BETWEEN $3 AND $4`,
+		groupId, teacherId, from.Format("2006-01-02"), till.Format("2006-01-02"))
+	if err != nil {
+		return nil, fmt.Errorf("error getting substituted lessons: %v", err)
+	}
+	defer rows.Close()
+	dates := make(map[string]bool)
+	for rows.Next() {
+		var date time.Time
+		if err := rows.Scan(&date); err != nil {
+			return nil, fmt.Errorf("error scanning substituted lesson: %v", err)
+		}
+		dates[date.Format("2006-01-02")] = true
+	}
+	return dates, rows.Err()
+}
+
 func CalculateMoneyForLesson(db *sql.DB, price *float64, studentId string, groupId string, attendDate string, discountAmount, courseP, fixedSum *float64) error {
-	var coursePrice float64
-	err := db.QueryRow(`SELECT price FROM courses c JOIN groups g ON c.id = g.course_id WHERE g.id = $1`, groupId).Scan(&coursePrice)
+	parsedDate, err := time.Parse("2006-01-02", attendDate)
+	if err != nil {
+		return fmt.Errorf("invalid attendDate format: %v", err)
+	}
+
+	coursePrice, err := ResolveCoursePrice(db, groupId, studentId, parsedDate)
 	if err != nil {
 		return fmt.Errorf("failed to fetch course price: %v", err)
 	}
 
 	if fixedSum != nil {
 		if discountAmount != nil {
-			fmt.Println("discount amount ", *discountAmount)
 			percent := (*discountAmount * 100) / coursePrice
 			teacherAmount := (*fixedSum * percent) / 100
 			*fixedSum = coursePrice - *discountAmount
-			fmt.Println("fixed summa narxi ", *fixedSum)
 			coursePrice = teacherAmount
 		} else {
-			fmt.Println("discount yoq ekan ")
 			coursePrice = *fixedSum
 		}
 	} else if discountAmount != nil {
-		fmt.Println("fixed summada emas ekan")
 		coursePrice -= *discountAmount
 	}
 
-	fmt.Println("calculate moneyga kridi")
 	*courseP = coursePrice
 
-	parsedDate, err := time.Parse("2006-01-02", attendDate)
+	schedule, err := GroupSchedule(db, groupId)
 	if err != nil {
-		return fmt.Errorf("invalid attendDate format: %v", err)
+		return err
 	}
 
-	firstOfMonth := time.Date(parsedDate.Year(), parsedDate.Month(), 1, 0, 0, 0, 0, parsedDate.Location())
-	lastOfMonth := firstOfMonth.AddDate(0, 1, -1)
-
-	var lessonCount int
-	query := `
-        WITH RECURSIVE dates AS (
-            SELECT $1::date AS date
-            UNION ALL
-            SELECT date + 1
-            FROM dates
-            WHERE date < $2
-        ),
-        valid_days AS (
-            SELECT d.date
-            FROM dates d
-            CROSS JOIN (
-                SELECT unnest(days) AS day_name,
-                       start_date,
-                       end_date
-                FROM groups
-                WHERE id = $3
-            ) g
-            WHERE 
-                CASE 
-                    WHEN EXTRACT(DOW FROM d.date) = 1 THEN 'DUSHANBA'
-                    WHEN EXTRACT(DOW FROM d.date) = 2 THEN 'SESHANBA'
-                    WHEN EXTRACT(DOW FROM d.date) = 3 THEN 'CHORSHANBA'
-                    WHEN EXTRACT(DOW FROM d.date) = 4 THEN 'PAYSHANBA'
-                    WHEN EXTRACT(DOW FROM d.date) = 5 THEN 'JUMA'
-                    WHEN EXTRACT(DOW FROM d.date) = 6 THEN 'SHANBA'
-                    WHEN EXTRACT(DOW FROM d.date) = 0 THEN 'YAKSHANBA'
-                END = g.day_name
-                AND d.date >= g.start_date
-                AND d.date <= g.end_date
-        )
-        SELECT COUNT(*) 
-        FROM valid_days
-    `
-	err = db.QueryRow(query, firstOfMonth, lastOfMonth, groupId).Scan(&lessonCount)
+	quote, err := pricing.QuoteMonth(schedule, coursePrice, parsedDate, pricing.Enrollment{}, nil)
 	if err != nil {
-		return fmt.Errorf("failed to count lesson days: %v", err)
-	}
-
-	// Handle case where no lessons are found
-	if lessonCount == 0 {
 		return fmt.Errorf("no lessons found in the month for group %s", groupId)
 	}
 
 	// Calculate and set the price per lesson
-	*price = math.Round(coursePrice / float64(lessonCount))
+	*price = math.Round(quote.LessonPrice)
 	return nil
 }
 func GetCompanyId(ctx context.Context) string {
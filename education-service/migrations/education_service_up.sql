@@ -384,7 +384,7 @@ CREATE TABLE IF NOT EXISTS "sms_template" (
                                 "company_id" int NOT NULL,
                                 "texts" text[] NOT NULL,
                                 "sms_count" int NOT NULL,
-                                "action_type" varchar check ( action_type in ('BEFORE_PAYMENT_ALERT' , 'INSUFFICIENT_BALANCE_ALERT' , 'PAYMENT_SUCCESSFUL_ALERT' , 'JOINED_GROUP_ALERT' , 'BIRTHDAY_ALERT' , 'NOT_PARTICIPATE_ALERT')),
+                                "action_type" varchar check ( action_type in ('BEFORE_PAYMENT_ALERT' , 'INSUFFICIENT_BALANCE_ALERT' , 'PAYMENT_SUCCESSFUL_ALERT' , 'JOINED_GROUP_ALERT' , 'BIRTHDAY_ALERT' , 'NOT_PARTICIPATE_ALERT' , 'DEBT_REMINDER_0_30' , 'DEBT_REMINDER_31_60' , 'DEBT_REMINDER_61_90' , 'DEBT_REMINDER_90_PLUS' , 'LOW_LESSON_CREDIT_ALERT' , 'LESSON_PACKAGE_EXPIRED_ALERT' , 'AT_RISK_ALERT' , 'WAITLIST_SEAT_OFFER_ALERT')),
                                 "insufficient_balance_send_count" int NOT NULL DEFAULT 1,
                                 "sms_template_type" varchar check ( sms_template_type in ('ACTION' , 'TEMPLATE')),
                                 "is_active" bool DEFAULT FALSE,
@@ -416,6 +416,12 @@ alter table sms_used
 alter table sms_used
     add created_by_name varchar;
 
+alter table sms_template
+    drop constraint if exists sms_template_action_type_check;
+
+alter table sms_template
+    add constraint sms_template_action_type_check check ( action_type in ('BEFORE_PAYMENT_ALERT' , 'INSUFFICIENT_BALANCE_ALERT' , 'PAYMENT_SUCCESSFUL_ALERT' , 'JOINED_GROUP_ALERT' , 'BIRTHDAY_ALERT' , 'NOT_PARTICIPATE_ALERT' , 'DEBT_REMINDER_0_30' , 'DEBT_REMINDER_31_60' , 'DEBT_REMINDER_61_90' , 'DEBT_REMINDER_90_PLUS' , 'LOW_LESSON_CREDIT_ALERT' , 'LESSON_PACKAGE_EXPIRED_ALERT' , 'AT_RISK_ALERT' , 'WAITLIST_SEAT_OFFER_ALERT'));
+
 
 
 
@@ -553,3 +559,414 @@ CREATE TRIGGER trg_student_update
     ON students
     FOR EACH ROW
 EXECUTE FUNCTION log_student_update();
+
+ALTER TABLE courses
+    ADD COLUMN IF NOT EXISTS billing_mode       varchar CHECK ( billing_mode in ('MONTHLY', 'PER_LESSON', 'PACKAGE') ) NOT NULL DEFAULT 'MONTHLY',
+    ADD COLUMN IF NOT EXISTS lesson_price       double precision                                                   NOT NULL DEFAULT 0,
+    ADD COLUMN IF NOT EXISTS package_lessons    int                                                                NOT NULL DEFAULT 0,
+    ADD COLUMN IF NOT EXISTS package_price      double precision                                                   NOT NULL DEFAULT 0,
+    ADD COLUMN IF NOT EXISTS package_valid_days int                                                                NOT NULL DEFAULT 0,
+    ADD COLUMN IF NOT EXISTS low_credit_lessons int                                                                NOT NULL DEFAULT 2;
+
+ALTER TABLE groups
+    ADD COLUMN IF NOT EXISTS billing_mode varchar CHECK ( billing_mode in ('MONTHLY', 'PER_LESSON', 'PACKAGE') );
+
+CREATE TABLE IF NOT EXISTS lesson_package
+(
+    id                  uuid PRIMARY KEY,
+    student_id          uuid                                                       NOT NULL,
+    group_id            bigint references groups (id)                              NOT NULL,
+    lessons_total       int                                                        NOT NULL,
+    lessons_left        int                                                        NOT NULL,
+    price               double precision                                           NOT NULL,
+    purchased_at        date                                                       NOT NULL,
+    expires_at          date,
+    status              varchar CHECK ( status in ('ACTIVE', 'USED', 'EXPIRED') ) NOT NULL DEFAULT 'ACTIVE',
+    low_credit_notified boolean                                                    NOT NULL DEFAULT false,
+    payment_id          uuid,
+    created_by_id       uuid                                                       NOT NULL,
+    created_by_name     varchar                                                    NOT NULL,
+    created_at          timestamp DEFAULT NOW(),
+    company_id          int references company (id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_lesson_package_student_group ON lesson_package (student_id, group_id, status);
+
+CREATE TABLE IF NOT EXISTS attendance_charge
+(
+    group_id     bigint references groups (id)                                  NOT NULL,
+    student_id   uuid                                                           NOT NULL,
+    attend_date  date                                                           NOT NULL,
+    billing_mode varchar CHECK ( billing_mode in ('PER_LESSON', 'PACKAGE') )    NOT NULL,
+    package_id   uuid references lesson_package (id),
+    payment_id   uuid,
+    amount       double precision                                               NOT NULL DEFAULT 0,
+    created_at   timestamp DEFAULT NOW(),
+    company_id   int references company (id),
+    PRIMARY KEY (group_id, student_id, attend_date)
+);
+
+CREATE TABLE IF NOT EXISTS course_price_history
+(
+    id                   uuid PRIMARY KEY,
+    course_id            int references courses (id) ON DELETE CASCADE NOT NULL,
+    price                double precision                              NOT NULL,
+    effective_from       date                                          NOT NULL,
+    grandfather_existing boolean                                       NOT NULL DEFAULT false,
+    created_by_id        uuid,
+    created_by_name      varchar,
+    created_at           timestamp DEFAULT NOW(),
+    company_id           int references company (id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_course_price_history_course ON course_price_history (course_id, effective_from);
+
+INSERT INTO course_price_history (id, course_id, price, effective_from, company_id)
+SELECT gen_random_uuid(), c.id, c.price, DATE '1970-01-01', c.company_id
+FROM courses c
+WHERE NOT EXISTS(SELECT 1 FROM course_price_history h WHERE h.course_id = c.id);
+
+ALTER TABLE group_students
+    ADD COLUMN IF NOT EXISTS locked_price      double precision,
+    ADD COLUMN IF NOT EXISTS locked_price_from date;
+
+CREATE TABLE IF NOT EXISTS holiday
+(
+    id          uuid PRIMARY KEY,
+    title       varchar                                                          NOT NULL,
+    from_date   date                                                             NOT NULL,
+    till_date   date                                                             NOT NULL,
+    -- recurring holidays repeat every year on the same days
+    recurring   boolean                                                          NOT NULL DEFAULT false,
+    scope       varchar CHECK ( scope in ('COMPANY', 'ROOM', 'GROUP') )          NOT NULL DEFAULT 'COMPANY',
+    room_id     int references rooms (id) ON DELETE CASCADE,
+    group_id    bigint references groups (id) ON DELETE CASCADE,
+    is_national boolean                                                          NOT NULL DEFAULT false,
+    -- national holidays are switched off instead of deleted so seeding does not bring them back
+    is_active   boolean                                                          NOT NULL DEFAULT true,
+    created_at  timestamp DEFAULT NOW(),
+    company_id  int references company (id)                                      NOT NULL,
+    CHECK ( till_date >= from_date ),
+    CHECK ( (scope = 'ROOM') = (room_id IS NOT NULL) AND (scope = 'GROUP') = (group_id IS NOT NULL) )
+);
+
+CREATE INDEX IF NOT EXISTS idx_holiday_company ON holiday (company_id, from_date);
+
+-- group_holiday_dates returns the days between p_from and p_till on which a group has no lessons because of a company,
+-- room or group holiday
+CREATE OR REPLACE FUNCTION group_holiday_dates(p_group_id bigint, p_from date, p_till date)
+    RETURNS TABLE
+            (
+                holiday_date date
+            )
+AS
+$$
+SELECT DISTINCT days.d::date
+FROM groups g
+         JOIN holiday h ON h.company_id = g.company_id AND h.is_active
+    AND (h.scope = 'COMPANY' OR (h.scope = 'ROOM' AND h.room_id = g.room_id) OR (h.scope = 'GROUP' AND h.group_id = g.id))
+         CROSS JOIN LATERAL (
+    SELECT generate_series(h.from_date + make_interval(years => y.year - EXTRACT(YEAR FROM h.from_date)::int),
+                           h.till_date + make_interval(years => y.year - EXTRACT(YEAR FROM h.from_date)::int),
+                           interval '1 day') AS d
+    FROM generate_series(CASE WHEN h.recurring THEN EXTRACT(YEAR FROM p_from)::int ELSE EXTRACT(YEAR FROM h.from_date)::int END,
+                         CASE WHEN h.recurring THEN EXTRACT(YEAR FROM p_till)::int ELSE EXTRACT(YEAR FROM h.from_date)::int END) AS y(year)
+    ) days
+WHERE g.id = p_group_id
+  AND days.d::date BETWEEN p_from AND p_till
+$$ LANGUAGE sql STABLE;
+
+-- seed_national_holidays preloads the public holidays of Uzbekistan for a company, hayit dates follow the lunar
+-- calendar and are announced every year so they are seeded per year and can be corrected by the company
+CREATE OR REPLACE FUNCTION seed_national_holidays(p_company_id int) RETURNS void AS
+$$
+INSERT INTO holiday (id, title, from_date, till_date, recurring, is_national, company_id)
+SELECT gen_random_uuid(), n.title, n.from_date, n.till_date, n.recurring, true, p_company_id
+FROM (VALUES ('Yangi yil', DATE '2000-01-01', DATE '2000-01-01', true),
+             ('Xalqaro xotin-qizlar kuni', DATE '2000-03-08', DATE '2000-03-08', true),
+             ('Navro''z bayrami', DATE '2000-03-21', DATE '2000-03-21', true),
+             ('Xotira va qadrlash kuni', DATE '2000-05-09', DATE '2000-05-09', true),
+             ('Mustaqillik kuni', DATE '2000-09-01', DATE '2000-09-01', true),
+             ('O''qituvchi va murabbiylar kuni', DATE '2000-10-01', DATE '2000-10-01', true),
+             ('O''zbekiston Respublikasi Konstitutsiyasi kuni', DATE '2000-12-08', DATE '2000-12-08', true),
+             ('Ramazon hayiti', DATE '2025-03-30', DATE '2025-03-30', false),
+             ('Qurbon hayiti', DATE '2025-06-06', DATE '2025-06-06', false),
+             ('Ramazon hayiti', DATE '2026-03-20', DATE '2026-03-20', false),
+             ('Qurbon hayiti', DATE '2026-05-27', DATE '2026-05-27', false)) AS n(title, from_date, till_date, recurring)
+WHERE NOT EXISTS(SELECT 1
+                 FROM holiday h
+                 WHERE h.company_id = p_company_id
+                   AND h.is_national
+                   AND h.title = n.title
+                   AND h.from_date = n.from_date);
+$$ LANGUAGE sql;
+
+SELECT seed_national_holidays(id)
+FROM company;
+
+CREATE TABLE IF NOT EXISTS schedule_conflict_override
+(
+    id             uuid PRIMARY KEY,
+    group_id       bigint references groups (id) ON DELETE CASCADE                                   NOT NULL,
+    action         varchar CHECK ( action in ('CREATE_GROUP', 'UPDATE_GROUP', 'TRANSFER_LESSON') ) NOT NULL,
+    conflicts      jsonb                                                                             NOT NULL,
+    forced_by_id   uuid,
+    forced_by_name varchar,
+    created_at     timestamp DEFAULT NOW(),
+    company_id     int references company (id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_schedule_conflict_override_company ON schedule_conflict_override (company_id, created_at);
+
+CREATE TABLE IF NOT EXISTS calendar_feed
+(
+    id         uuid PRIMARY KEY,
+    owner_type varchar CHECK ( owner_type in ('TEACHER', 'ROOM') ) NOT NULL,
+    owner_id   varchar                                             NOT NULL,
+    token      varchar UNIQUE                                      NOT NULL,
+    revoked    boolean   DEFAULT FALSE                             NOT NULL,
+    created_at timestamp DEFAULT NOW(),
+    company_id int references company (id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_calendar_feed_owner ON calendar_feed (company_id, owner_type, owner_id);
+
+CREATE TABLE IF NOT EXISTS lessons
+(
+    id                    uuid PRIMARY KEY,
+    group_id              bigint references groups (id) ON DELETE CASCADE NOT NULL,
+    lesson_date           date                                            NOT NULL,
+    real_date             date,
+    -- the teacher of the group teaches the lesson unless a substitute is set
+    substitute_teacher_id uuid,
+    topic                 varchar,
+    notes                 text,
+    homework              text,
+    materials             TEXT[]    DEFAULT '{}'                          NOT NULL,
+    updated_by            uuid,
+    created_at            timestamp DEFAULT NOW(),
+    updated_at            timestamp DEFAULT NOW(),
+    company_id            int references company (id),
+    UNIQUE (group_id, lesson_date)
+);
+
+CREATE INDEX IF NOT EXISTS idx_lessons_substitute_date ON lessons (substitute_teacher_id, lesson_date);
+
+ALTER TABLE attendance
+    ADD COLUMN IF NOT EXISTS lesson_id uuid references lessons (id) ON DELETE SET NULL;
+
+INSERT INTO lessons (id, group_id, lesson_date, real_date, company_id)
+SELECT gen_random_uuid(), a.group_id, a.attend_date, tl.real_date, g.company_id
+FROM (SELECT DISTINCT group_id, attend_date FROM attendance WHERE lesson_id IS NULL) a
+         JOIN groups g ON g.id = a.group_id
+         LEFT JOIN LATERAL (SELECT real_date
+                            FROM transfer_lesson
+                            WHERE group_id = a.group_id
+                              AND transfer_date = a.attend_date
+                            LIMIT 1) tl ON TRUE
+ON CONFLICT (group_id, lesson_date) DO NOTHING;
+
+UPDATE attendance a
+SET lesson_id = l.id
+FROM lessons l
+WHERE a.lesson_id IS NULL
+  AND l.group_id = a.group_id
+  AND l.lesson_date = a.attend_date;
+
+CREATE TABLE IF NOT EXISTS attendance_status
+(
+    id           uuid PRIMARY KEY,
+    -- the value stored in attendance.status, -1 is reserved for deleting an attendance
+    code         int                         NOT NULL CHECK ( code >= 0 ),
+    name         varchar                     NOT NULL,
+    billable     boolean   DEFAULT FALSE     NOT NULL,
+    pays_teacher boolean   DEFAULT FALSE     NOT NULL,
+    absence_sms  boolean   DEFAULT FALSE     NOT NULL,
+    is_makeup    boolean   DEFAULT FALSE     NOT NULL,
+    is_default   boolean   DEFAULT FALSE     NOT NULL,
+    is_active    boolean   DEFAULT TRUE      NOT NULL,
+    created_at   timestamp DEFAULT NOW(),
+    company_id   int references company (id) NOT NULL,
+    UNIQUE (company_id, code)
+);
+
+-- seed_attendance_statuses preloads the statuses every company starts with, 0 and 1 keep the meaning attendance had
+-- before statuses were configurable so an absence still counts in the salary of the teacher
+CREATE OR REPLACE FUNCTION seed_attendance_statuses(p_company_id int) RETURNS void AS
+$$
+INSERT INTO attendance_status (id, code, name, billable, pays_teacher, absence_sms, is_makeup, is_default, company_id)
+SELECT gen_random_uuid(), s.code, s.name, s.billable, s.pays_teacher, s.absence_sms, s.is_makeup, true, p_company_id
+FROM (VALUES (0, 'Sababsiz kelmadi', false, true, true, false),
+             (1, 'Keldi', true, true, false, false),
+             (2, 'Kechikdi', true, true, false, false),
+             (3, 'Sababli kelmadi', false, false, false, false),
+             (4, 'Qayta o''tdi', true, true, false, true)) AS s(code, name, billable, pays_teacher, absence_sms, is_makeup)
+ON CONFLICT (company_id, code) DO NOTHING;
+$$ LANGUAGE sql;
+
+SELECT seed_attendance_statuses(id)
+FROM company;
+
+-- a makeup attendance names the absence in another lesson it makes up
+ALTER TABLE attendance
+    ADD COLUMN IF NOT EXISTS makeup_group_id bigint references groups (id),
+    ADD COLUMN IF NOT EXISTS makeup_date     date;
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_attendance_makeup ON attendance (makeup_group_id, student_id, makeup_date) WHERE makeup_group_id IS NOT NULL;
+
+-- a company without a row uses the default policy of the education service
+CREATE TABLE IF NOT EXISTS attendance_policy
+(
+    company_id     int references company (id) PRIMARY KEY,
+    edit_days_back int                         NOT NULL CHECK ( edit_days_back >= 0 ),
+    cutoff_hour    int                         NOT NULL CHECK ( cutoff_hour BETWEEN 0 AND 24 ),
+    timezone       varchar                     NOT NULL,
+    allow_future   boolean                     NOT NULL,
+    override_roles TEXT[]                      NOT NULL,
+    updated_by     uuid,
+    updated_at     timestamp DEFAULT NOW()
+);
+
+CREATE TABLE IF NOT EXISTS attendance_unlock_request
+(
+    id               uuid PRIMARY KEY,
+    group_id         bigint references groups (id) ON DELETE CASCADE                        NOT NULL,
+    attend_date      date                                                                    NOT NULL,
+    teacher_id       uuid                                                                    NOT NULL,
+    teacher_name     varchar,
+    reason           varchar,
+    status           varchar CHECK ( status in ('PENDING', 'APPROVED', 'REJECTED') ) DEFAULT 'PENDING' NOT NULL,
+    reviewed_by_id   uuid,
+    reviewed_by_name varchar,
+    reviewed_at      timestamp,
+    -- an approved request opens the date for the teacher until then
+    unlocked_until   timestamp,
+    created_at       timestamp DEFAULT NOW(),
+    company_id       int references company (id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_attendance_unlock_request_lookup ON attendance_unlock_request (group_id, attend_date, teacher_id);
+CREATE INDEX IF NOT EXISTS idx_attendance_unlock_request_company ON attendance_unlock_request (company_id, status, created_at);
+
+-- one time codes students log in with, only the hash of the code is kept
+CREATE TABLE IF NOT EXISTS student_login_code
+(
+    id         uuid PRIMARY KEY,
+    phone      varchar                     NOT NULL,
+    code_hash  varchar                     NOT NULL,
+    attempts   int       DEFAULT 0         NOT NULL,
+    used       boolean   DEFAULT false     NOT NULL,
+    expires_at timestamp                   NOT NULL,
+    created_at timestamp DEFAULT NOW(),
+    company_id int references company (id) NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_student_login_code_phone ON student_login_code (company_id, phone, created_at);
+
+CREATE TABLE IF NOT EXISTS student_session
+(
+    id           uuid PRIMARY KEY,
+    student_id   uuid references students (id) ON DELETE CASCADE NOT NULL,
+    token_hash   varchar UNIQUE                                  NOT NULL,
+    device       varchar,
+    revoked      boolean   DEFAULT false                         NOT NULL,
+    expires_at   timestamp                                       NOT NULL,
+    last_seen_at timestamp,
+    created_at   timestamp DEFAULT NOW(),
+    company_id   int references company (id)                     NOT NULL
+);
+
+-- the key the rotating check in codes of a lesson are signed with
+ALTER TABLE lessons
+    ADD COLUMN IF NOT EXISTS check_in_secret varchar;
+
+-- a student checking in marks attendance that is neither billed nor paid to the teacher until it is confirmed
+ALTER TABLE attendance
+    ADD COLUMN IF NOT EXISTS checked_in_at   timestamp,
+    ADD COLUMN IF NOT EXISTS check_in_device varchar,
+    ADD COLUMN IF NOT EXISTS confirmed       boolean DEFAULT true NOT NULL;
+
+ALTER TABLE attendance
+    DROP CONSTRAINT IF EXISTS attendance_creator_role_check;
+ALTER TABLE attendance
+    ADD CONSTRAINT attendance_creator_role_check CHECK ( creator_role in ('ADMIN', 'CEO', 'TEACHER', 'STUDENT') );
+
+-- a company without a row flags students with the defaults of the education service
+CREATE TABLE IF NOT EXISTS at_risk_policy
+(
+    company_id     int references company (id) PRIMARY KEY,
+    -- consecutive absences that flag a student, 0 switches the rule off
+    absence_streak int                         NOT NULL CHECK ( absence_streak >= 0 ),
+    -- attendance percent this month below which a student is flagged, 0 switches the rule off
+    min_rate       int                         NOT NULL CHECK ( min_rate BETWEEN 0 AND 100 ),
+    -- marked lessons this month before the rate is judged
+    min_lessons    int                         NOT NULL CHECK ( min_lessons >= 0 ),
+    sms_parents    boolean                     NOT NULL,
+    updated_by     uuid,
+    updated_at     timestamp DEFAULT NOW()
+);
+
+CREATE TABLE IF NOT EXISTS at_risk_student
+(
+    id              uuid PRIMARY KEY,
+    student_id      uuid references students (id) ON DELETE CASCADE                          NOT NULL,
+    group_id        bigint references groups (id) ON DELETE CASCADE                          NOT NULL,
+    reason          varchar CHECK ( reason in ('ABSENCE_STREAK', 'LOW_RATE') )                NOT NULL,
+    absence_streak  int,
+    attendance_rate double precision,
+    status          varchar CHECK ( status in ('OPEN', 'RESOLVED') ) DEFAULT 'OPEN'          NOT NULL,
+    flagged_at      timestamp DEFAULT NOW(),
+    resolved_at     timestamp,
+    company_id      int references company (id)                                             NOT NULL
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_at_risk_student_open ON at_risk_student (student_id, group_id, reason) WHERE status = 'OPEN';
+CREATE INDEX IF NOT EXISTS idx_at_risk_student_company ON at_risk_student (company_id, status, flagged_at);
+
+-- in app notifications go to one user, to every user of the roles, or both
+CREATE TABLE IF NOT EXISTS notification
+(
+    id              uuid PRIMARY KEY,
+    type            varchar                     NOT NULL,
+    title           varchar                     NOT NULL,
+    body            text,
+    student_id      uuid,
+    group_id        bigint,
+    recipient_id    uuid,
+    recipient_roles TEXT[]    DEFAULT '{}'      NOT NULL,
+    created_at      timestamp DEFAULT NOW(),
+    company_id      int references company (id) NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_notification_company ON notification (company_id, created_at);
+
+CREATE TABLE IF NOT EXISTS notification_read
+(
+    notification_id uuid references notification (id) ON DELETE CASCADE NOT NULL,
+    user_id         uuid                                                 NOT NULL,
+    read_at         timestamp DEFAULT NOW(),
+    PRIMARY KEY (notification_id, user_id)
+);
+
+-- seats of a group, NULL takes the capacity of its room
+ALTER TABLE groups
+    ADD COLUMN IF NOT EXISTS max_students int CHECK ( max_students > 0 );
+
+-- students that did not fit into a full group, a freed seat is offered to the first WAITING one and held until hold_until
+CREATE TABLE IF NOT EXISTS group_waitlist
+(
+    id              uuid PRIMARY KEY,
+    group_id        bigint references groups (id) ON DELETE CASCADE                                              NOT NULL,
+    student_id      uuid references students (id) ON DELETE CASCADE                                             NOT NULL,
+    position        int                                                                                          NOT NULL,
+    status          varchar CHECK ( status in ('WAITING', 'OFFERED', 'ENROLLED', 'EXPIRED', 'CANCELLED') ) DEFAULT 'WAITING' NOT NULL,
+    offered_at      timestamp,
+    hold_until      timestamp,
+    created_by      uuid,
+    created_by_name varchar,
+    created_at      timestamp DEFAULT NOW(),
+    company_id      int references company (id)                                                                  NOT NULL
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_group_waitlist_active ON group_waitlist (group_id, student_id) WHERE status in ('WAITING', 'OFFERED');
+CREATE INDEX IF NOT EXISTS idx_group_waitlist_group ON group_waitlist (group_id, status, position);
@@ -1878,30 +1878,37 @@ func (x *GetCourseByIdRequest) GetId() string {
 	return ""
 }
 
-type GetLeftAfterTrialPeriodRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	From          string                 `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
-	To            string                 `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
-	Page          string                 `protobuf:"bytes,3,opt,name=page,proto3" json:"page,omitempty"`
-	Size          string                 `protobuf:"bytes,4,opt,name=size,proto3" json:"size,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type CourseBilling struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	CourseId string                 `protobuf:"bytes,1,opt,name=courseId,proto3" json:"courseId,omitempty"`
+	// MONTHLY takes courses.price every month, PER_LESSON charges lessonPrice per attended lesson,
+	// PACKAGE consumes a credit of a prepaid package per attended lesson
+	BillingMode    string  `protobuf:"bytes,2,opt,name=billingMode,proto3" json:"billingMode,omitempty"`
+	LessonPrice    float64 `protobuf:"fixed64,3,opt,name=lessonPrice,proto3" json:"lessonPrice,omitempty"`
+	PackageLessons int32   `protobuf:"varint,4,opt,name=packageLessons,proto3" json:"packageLessons,omitempty"`
+	PackagePrice   float64 `protobuf:"fixed64,5,opt,name=packagePrice,proto3" json:"packagePrice,omitempty"`
+	// days a sold package stays usable, 0 means it does not expire
+	PackageValidDays int32 `protobuf:"varint,6,opt,name=packageValidDays,proto3" json:"packageValidDays,omitempty"`
+	// lessons left on a package that trigger the low credit sms
+	LowCreditLessons int32 `protobuf:"varint,7,opt,name=lowCreditLessons,proto3" json:"lowCreditLessons,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
-func (x *GetLeftAfterTrialPeriodRequest) Reset() {
-	*x = GetLeftAfterTrialPeriodRequest{}
+func (x *CourseBilling) Reset() {
+	*x = CourseBilling{}
 	mi := &file_education_proto_msgTypes[24]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetLeftAfterTrialPeriodRequest) String() string {
+func (x *CourseBilling) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetLeftAfterTrialPeriodRequest) ProtoMessage() {}
+func (*CourseBilling) ProtoMessage() {}
 
-func (x *GetLeftAfterTrialPeriodRequest) ProtoReflect() protoreflect.Message {
+func (x *CourseBilling) ProtoReflect() protoreflect.Message {
 	mi := &file_education_proto_msgTypes[24]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1913,61 +1920,88 @@ func (x *GetLeftAfterTrialPeriodRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetLeftAfterTrialPeriodRequest.ProtoReflect.Descriptor instead.
-func (*GetLeftAfterTrialPeriodRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use CourseBilling.ProtoReflect.Descriptor instead.
+func (*CourseBilling) Descriptor() ([]byte, []int) {
 	return file_education_proto_rawDescGZIP(), []int{24}
 }
 
-func (x *GetLeftAfterTrialPeriodRequest) GetFrom() string {
+func (x *CourseBilling) GetCourseId() string {
 	if x != nil {
-		return x.From
+		return x.CourseId
 	}
 	return ""
 }
 
-func (x *GetLeftAfterTrialPeriodRequest) GetTo() string {
+func (x *CourseBilling) GetBillingMode() string {
 	if x != nil {
-		return x.To
+		return x.BillingMode
 	}
 	return ""
 }
 
-func (x *GetLeftAfterTrialPeriodRequest) GetPage() string {
+func (x *CourseBilling) GetLessonPrice() float64 {
 	if x != nil {
-		return x.Page
+		return x.LessonPrice
 	}
-	return ""
+	return 0
 }
 
-func (x *GetLeftAfterTrialPeriodRequest) GetSize() string {
+func (x *CourseBilling) GetPackageLessons() int32 {
 	if x != nil {
-		return x.Size
+		return x.PackageLessons
 	}
-	return ""
+	return 0
 }
 
-type GetLeftAfterTrialPeriodResponse struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	Items          []*AbsGetLeftAfter     `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
-	TotalItemCount int32                  `protobuf:"varint,2,opt,name=totalItemCount,proto3" json:"totalItemCount,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+func (x *CourseBilling) GetPackagePrice() float64 {
+	if x != nil {
+		return x.PackagePrice
+	}
+	return 0
 }
 
-func (x *GetLeftAfterTrialPeriodResponse) Reset() {
-	*x = GetLeftAfterTrialPeriodResponse{}
+func (x *CourseBilling) GetPackageValidDays() int32 {
+	if x != nil {
+		return x.PackageValidDays
+	}
+	return 0
+}
+
+func (x *CourseBilling) GetLowCreditLessons() int32 {
+	if x != nil {
+		return x.LowCreditLessons
+	}
+	return 0
+}
+
+type ScheduleCoursePriceRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	CourseId string                 `protobuf:"bytes,1,opt,name=courseId,proto3" json:"courseId,omitempty"`
+	Price    float64                `protobuf:"fixed64,2,opt,name=price,proto3" json:"price,omitempty"`
+	// yyyy-MM-dd, the price is used for lessons and billing from this date, today when empty
+	EffectiveFrom string `protobuf:"bytes,3,opt,name=effectiveFrom,proto3" json:"effectiveFrom,omitempty"`
+	// keeps students enrolled before effectiveFrom on the price they pay now
+	GrandfatherExisting bool   `protobuf:"varint,4,opt,name=grandfatherExisting,proto3" json:"grandfatherExisting,omitempty"`
+	ActionById          string `protobuf:"bytes,5,opt,name=actionById,proto3" json:"actionById,omitempty"`
+	ActionByName        string `protobuf:"bytes,6,opt,name=actionByName,proto3" json:"actionByName,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *ScheduleCoursePriceRequest) Reset() {
+	*x = ScheduleCoursePriceRequest{}
 	mi := &file_education_proto_msgTypes[25]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetLeftAfterTrialPeriodResponse) String() string {
+func (x *ScheduleCoursePriceRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetLeftAfterTrialPeriodResponse) ProtoMessage() {}
+func (*ScheduleCoursePriceRequest) ProtoMessage() {}
 
-func (x *GetLeftAfterTrialPeriodResponse) ProtoReflect() protoreflect.Message {
+func (x *ScheduleCoursePriceRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_education_proto_msgTypes[25]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1979,54 +2013,79 @@ func (x *GetLeftAfterTrialPeriodResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetLeftAfterTrialPeriodResponse.ProtoReflect.Descriptor instead.
-func (*GetLeftAfterTrialPeriodResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ScheduleCoursePriceRequest.ProtoReflect.Descriptor instead.
+func (*ScheduleCoursePriceRequest) Descriptor() ([]byte, []int) {
 	return file_education_proto_rawDescGZIP(), []int{25}
 }
 
-func (x *GetLeftAfterTrialPeriodResponse) GetItems() []*AbsGetLeftAfter {
+func (x *ScheduleCoursePriceRequest) GetCourseId() string {
 	if x != nil {
-		return x.Items
+		return x.CourseId
 	}
-	return nil
+	return ""
 }
 
-func (x *GetLeftAfterTrialPeriodResponse) GetTotalItemCount() int32 {
+func (x *ScheduleCoursePriceRequest) GetPrice() float64 {
 	if x != nil {
-		return x.TotalItemCount
+		return x.Price
 	}
 	return 0
 }
 
-type AbsGetLeftAfter struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	StudentId      string                 `protobuf:"bytes,1,opt,name=studentId,proto3" json:"studentId,omitempty"`
-	StudentName    string                 `protobuf:"bytes,2,opt,name=studentName,proto3" json:"studentName,omitempty"`
-	StudentPhone   string                 `protobuf:"bytes,3,opt,name=studentPhone,proto3" json:"studentPhone,omitempty"`
-	StudentBalance string                 `protobuf:"bytes,4,opt,name=studentBalance,proto3" json:"studentBalance,omitempty"`
-	GroupId        string                 `protobuf:"bytes,5,opt,name=groupId,proto3" json:"groupId,omitempty"`
-	GroupName      string                 `protobuf:"bytes,6,opt,name=groupName,proto3" json:"groupName,omitempty"`
-	ReturnMoney    bool                   `protobuf:"varint,7,opt,name=returnMoney,proto3" json:"returnMoney,omitempty"`
-	CreatedAt      string                 `protobuf:"bytes,8,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
-	SpecificDate   string                 `protobuf:"bytes,9,opt,name=specificDate,proto3" json:"specificDate,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+func (x *ScheduleCoursePriceRequest) GetEffectiveFrom() string {
+	if x != nil {
+		return x.EffectiveFrom
+	}
+	return ""
 }
 
-func (x *AbsGetLeftAfter) Reset() {
-	*x = AbsGetLeftAfter{}
+func (x *ScheduleCoursePriceRequest) GetGrandfatherExisting() bool {
+	if x != nil {
+		return x.GrandfatherExisting
+	}
+	return false
+}
+
+func (x *ScheduleCoursePriceRequest) GetActionById() string {
+	if x != nil {
+		return x.ActionById
+	}
+	return ""
+}
+
+func (x *ScheduleCoursePriceRequest) GetActionByName() string {
+	if x != nil {
+		return x.ActionByName
+	}
+	return ""
+}
+
+type CoursePriceVersion struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	Id                  string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Price               float64                `protobuf:"fixed64,2,opt,name=price,proto3" json:"price,omitempty"`
+	EffectiveFrom       string                 `protobuf:"bytes,3,opt,name=effectiveFrom,proto3" json:"effectiveFrom,omitempty"`
+	GrandfatherExisting bool                   `protobuf:"varint,4,opt,name=grandfatherExisting,proto3" json:"grandfatherExisting,omitempty"`
+	CreatedByName       string                 `protobuf:"bytes,5,opt,name=createdByName,proto3" json:"createdByName,omitempty"`
+	CreatedAt           string                 `protobuf:"bytes,6,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *CoursePriceVersion) Reset() {
+	*x = CoursePriceVersion{}
 	mi := &file_education_proto_msgTypes[26]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AbsGetLeftAfter) String() string {
+func (x *CoursePriceVersion) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AbsGetLeftAfter) ProtoMessage() {}
+func (*CoursePriceVersion) ProtoMessage() {}
 
-func (x *AbsGetLeftAfter) ProtoReflect() protoreflect.Message {
+func (x *CoursePriceVersion) ProtoReflect() protoreflect.Message {
 	mi := &file_education_proto_msgTypes[26]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2038,95 +2097,76 @@ func (x *AbsGetLeftAfter) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AbsGetLeftAfter.ProtoReflect.Descriptor instead.
-func (*AbsGetLeftAfter) Descriptor() ([]byte, []int) {
+// Deprecated: Use CoursePriceVersion.ProtoReflect.Descriptor instead.
+func (*CoursePriceVersion) Descriptor() ([]byte, []int) {
 	return file_education_proto_rawDescGZIP(), []int{26}
 }
 
-func (x *AbsGetLeftAfter) GetStudentId() string {
-	if x != nil {
-		return x.StudentId
-	}
-	return ""
-}
-
-func (x *AbsGetLeftAfter) GetStudentName() string {
-	if x != nil {
-		return x.StudentName
-	}
-	return ""
-}
-
-func (x *AbsGetLeftAfter) GetStudentPhone() string {
-	if x != nil {
-		return x.StudentPhone
-	}
-	return ""
-}
-
-func (x *AbsGetLeftAfter) GetStudentBalance() string {
+func (x *CoursePriceVersion) GetId() string {
 	if x != nil {
-		return x.StudentBalance
+		return x.Id
 	}
 	return ""
 }
 
-func (x *AbsGetLeftAfter) GetGroupId() string {
+func (x *CoursePriceVersion) GetPrice() float64 {
 	if x != nil {
-		return x.GroupId
+		return x.Price
 	}
-	return ""
+	return 0
 }
 
-func (x *AbsGetLeftAfter) GetGroupName() string {
+func (x *CoursePriceVersion) GetEffectiveFrom() string {
 	if x != nil {
-		return x.GroupName
+		return x.EffectiveFrom
 	}
 	return ""
 }
 
-func (x *AbsGetLeftAfter) GetReturnMoney() bool {
+func (x *CoursePriceVersion) GetGrandfatherExisting() bool {
 	if x != nil {
-		return x.ReturnMoney
+		return x.GrandfatherExisting
 	}
 	return false
 }
 
-func (x *AbsGetLeftAfter) GetCreatedAt() string {
+func (x *CoursePriceVersion) GetCreatedByName() string {
 	if x != nil {
-		return x.CreatedAt
+		return x.CreatedByName
 	}
 	return ""
 }
 
-func (x *AbsGetLeftAfter) GetSpecificDate() string {
+func (x *CoursePriceVersion) GetCreatedAt() string {
 	if x != nil {
-		return x.SpecificDate
+		return x.CreatedAt
 	}
 	return ""
 }
 
-type StudentIdRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	StudentId     string                 `protobuf:"bytes,1,opt,name=studentId,proto3" json:"studentId,omitempty"`
+type GetCoursePriceHistoryResponse struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Versions []*CoursePriceVersion  `protobuf:"bytes,1,rep,name=versions,proto3" json:"versions,omitempty"`
+	// enrollments of the course paying a grandfathered price
+	Locks         []*EnrollmentPriceLock `protobuf:"bytes,2,rep,name=locks,proto3" json:"locks,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *StudentIdRequest) Reset() {
-	*x = StudentIdRequest{}
+func (x *GetCoursePriceHistoryResponse) Reset() {
+	*x = GetCoursePriceHistoryResponse{}
 	mi := &file_education_proto_msgTypes[27]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *StudentIdRequest) String() string {
+func (x *GetCoursePriceHistoryResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StudentIdRequest) ProtoMessage() {}
+func (*GetCoursePriceHistoryResponse) ProtoMessage() {}
 
-func (x *StudentIdRequest) ProtoReflect() protoreflect.Message {
+func (x *GetCoursePriceHistoryResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_education_proto_msgTypes[27]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2138,40 +2178,51 @@ func (x *StudentIdRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StudentIdRequest.ProtoReflect.Descriptor instead.
-func (*StudentIdRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetCoursePriceHistoryResponse.ProtoReflect.Descriptor instead.
+func (*GetCoursePriceHistoryResponse) Descriptor() ([]byte, []int) {
 	return file_education_proto_rawDescGZIP(), []int{27}
 }
 
-func (x *StudentIdRequest) GetStudentId() string {
+func (x *GetCoursePriceHistoryResponse) GetVersions() []*CoursePriceVersion {
 	if x != nil {
-		return x.StudentId
+		return x.Versions
 	}
-	return ""
+	return nil
 }
 
-type GetGroupsByStudentResponse struct {
+func (x *GetCoursePriceHistoryResponse) GetLocks() []*EnrollmentPriceLock {
+	if x != nil {
+		return x.Locks
+	}
+	return nil
+}
+
+type EnrollmentPriceLock struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Comments      []*DebtorComment       `protobuf:"bytes,1,rep,name=comments,proto3" json:"comments,omitempty"`
-	Groups        []*DebtorGroup         `protobuf:"bytes,2,rep,name=groups,proto3" json:"groups,omitempty"`
+	StudentId     string                 `protobuf:"bytes,1,opt,name=studentId,proto3" json:"studentId,omitempty"`
+	StudentName   string                 `protobuf:"bytes,2,opt,name=studentName,proto3" json:"studentName,omitempty"`
+	GroupId       string                 `protobuf:"bytes,3,opt,name=groupId,proto3" json:"groupId,omitempty"`
+	GroupName     string                 `protobuf:"bytes,4,opt,name=groupName,proto3" json:"groupName,omitempty"`
+	Price         float64                `protobuf:"fixed64,5,opt,name=price,proto3" json:"price,omitempty"`
+	LockedFrom    string                 `protobuf:"bytes,6,opt,name=lockedFrom,proto3" json:"lockedFrom,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetGroupsByStudentResponse) Reset() {
-	*x = GetGroupsByStudentResponse{}
+func (x *EnrollmentPriceLock) Reset() {
+	*x = EnrollmentPriceLock{}
 	mi := &file_education_proto_msgTypes[28]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetGroupsByStudentResponse) String() string {
+func (x *EnrollmentPriceLock) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetGroupsByStudentResponse) ProtoMessage() {}
+func (*EnrollmentPriceLock) ProtoMessage() {}
 
-func (x *GetGroupsByStudentResponse) ProtoReflect() protoreflect.Message {
+func (x *EnrollmentPriceLock) ProtoReflect() protoreflect.Message {
 	mi := &file_education_proto_msgTypes[28]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2183,47 +2234,79 @@ func (x *GetGroupsByStudentResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetGroupsByStudentResponse.ProtoReflect.Descriptor instead.
-func (*GetGroupsByStudentResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use EnrollmentPriceLock.ProtoReflect.Descriptor instead.
+func (*EnrollmentPriceLock) Descriptor() ([]byte, []int) {
 	return file_education_proto_rawDescGZIP(), []int{28}
 }
 
-func (x *GetGroupsByStudentResponse) GetComments() []*DebtorComment {
+func (x *EnrollmentPriceLock) GetStudentId() string {
 	if x != nil {
-		return x.Comments
+		return x.StudentId
 	}
-	return nil
+	return ""
 }
 
-func (x *GetGroupsByStudentResponse) GetGroups() []*DebtorGroup {
+func (x *EnrollmentPriceLock) GetStudentName() string {
 	if x != nil {
-		return x.Groups
+		return x.StudentName
 	}
-	return nil
+	return ""
 }
 
-type DebtorGroup struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	GroupId       string                 `protobuf:"bytes,1,opt,name=groupId,proto3" json:"groupId,omitempty"`
-	GroupName     string                 `protobuf:"bytes,2,opt,name=groupName,proto3" json:"groupName,omitempty"`
+func (x *EnrollmentPriceLock) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+func (x *EnrollmentPriceLock) GetGroupName() string {
+	if x != nil {
+		return x.GroupName
+	}
+	return ""
+}
+
+func (x *EnrollmentPriceLock) GetPrice() float64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *EnrollmentPriceLock) GetLockedFrom() string {
+	if x != nil {
+		return x.LockedFrom
+	}
+	return ""
+}
+
+type SetEnrollmentPriceLockRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	StudentId string                 `protobuf:"bytes,1,opt,name=studentId,proto3" json:"studentId,omitempty"`
+	GroupId   string                 `protobuf:"bytes,2,opt,name=groupId,proto3" json:"groupId,omitempty"`
+	// 0 removes the lock so the enrollment follows the course price again
+	Price float64 `protobuf:"fixed64,3,opt,name=price,proto3" json:"price,omitempty"`
+	// yyyy-MM-dd, today when empty
+	LockedFrom    string `protobuf:"bytes,4,opt,name=lockedFrom,proto3" json:"lockedFrom,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DebtorGroup) Reset() {
-	*x = DebtorGroup{}
+func (x *SetEnrollmentPriceLockRequest) Reset() {
+	*x = SetEnrollmentPriceLockRequest{}
 	mi := &file_education_proto_msgTypes[29]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DebtorGroup) String() string {
+func (x *SetEnrollmentPriceLockRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DebtorGroup) ProtoMessage() {}
+func (*SetEnrollmentPriceLockRequest) ProtoMessage() {}
 
-func (x *DebtorGroup) ProtoReflect() protoreflect.Message {
+func (x *SetEnrollmentPriceLockRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_education_proto_msgTypes[29]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2235,47 +2318,60 @@ func (x *DebtorGroup) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DebtorGroup.ProtoReflect.Descriptor instead.
-func (*DebtorGroup) Descriptor() ([]byte, []int) {
+// Deprecated: Use SetEnrollmentPriceLockRequest.ProtoReflect.Descriptor instead.
+func (*SetEnrollmentPriceLockRequest) Descriptor() ([]byte, []int) {
 	return file_education_proto_rawDescGZIP(), []int{29}
 }
 
-func (x *DebtorGroup) GetGroupId() string {
+func (x *SetEnrollmentPriceLockRequest) GetStudentId() string {
+	if x != nil {
+		return x.StudentId
+	}
+	return ""
+}
+
+func (x *SetEnrollmentPriceLockRequest) GetGroupId() string {
 	if x != nil {
 		return x.GroupId
 	}
 	return ""
 }
 
-func (x *DebtorGroup) GetGroupName() string {
+func (x *SetEnrollmentPriceLockRequest) GetPrice() float64 {
 	if x != nil {
-		return x.GroupName
+		return x.Price
+	}
+	return 0
+}
+
+func (x *SetEnrollmentPriceLockRequest) GetLockedFrom() string {
+	if x != nil {
+		return x.LockedFrom
 	}
 	return ""
 }
 
-type DebtorComment struct {
+type GetGroupsByIdsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	CommentId     string                 `protobuf:"bytes,1,opt,name=commentId,proto3" json:"commentId,omitempty"`
-	Comment       string                 `protobuf:"bytes,2,opt,name=comment,proto3" json:"comment,omitempty"`
+	Ids           []string               `protobuf:"bytes,1,rep,name=ids,proto3" json:"ids,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DebtorComment) Reset() {
-	*x = DebtorComment{}
+func (x *GetGroupsByIdsRequest) Reset() {
+	*x = GetGroupsByIdsRequest{}
 	mi := &file_education_proto_msgTypes[30]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DebtorComment) String() string {
+func (x *GetGroupsByIdsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DebtorComment) ProtoMessage() {}
+func (*GetGroupsByIdsRequest) ProtoMessage() {}
 
-func (x *DebtorComment) ProtoReflect() protoreflect.Message {
+func (x *GetGroupsByIdsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_education_proto_msgTypes[30]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2287,51 +2383,6074 @@ func (x *DebtorComment) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DebtorComment.ProtoReflect.Descriptor instead.
-func (*DebtorComment) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetGroupsByIdsRequest.ProtoReflect.Descriptor instead.
+func (*GetGroupsByIdsRequest) Descriptor() ([]byte, []int) {
 	return file_education_proto_rawDescGZIP(), []int{30}
 }
 
-func (x *DebtorComment) GetCommentId() string {
+func (x *GetGroupsByIdsRequest) GetIds() []string {
 	if x != nil {
-		return x.CommentId
+		return x.Ids
 	}
-	return ""
+	return nil
 }
 
-func (x *DebtorComment) GetComment() string {
-	if x != nil {
-		return x.Comment
-	}
-	return ""
+type GetGroupsByIdsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Groups        []*GroupMeta           `protobuf:"bytes,1,rep,name=groups,proto3" json:"groups,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetGroupsByIdsResponse) Reset() {
+	*x = GetGroupsByIdsResponse{}
+	mi := &file_education_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetGroupsByIdsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGroupsByIdsResponse) ProtoMessage() {}
+
+func (x *GetGroupsByIdsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGroupsByIdsResponse.ProtoReflect.Descriptor instead.
+func (*GetGroupsByIdsResponse) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *GetGroupsByIdsResponse) GetGroups() []*GroupMeta {
+	if x != nil {
+		return x.Groups
+	}
+	return nil
+}
+
+type GroupMeta struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	CourseId      string                 `protobuf:"bytes,3,opt,name=courseId,proto3" json:"courseId,omitempty"`
+	CourseName    string                 `protobuf:"bytes,4,opt,name=courseName,proto3" json:"courseName,omitempty"`
+	TeacherId     string                 `protobuf:"bytes,5,opt,name=teacherId,proto3" json:"teacherId,omitempty"`
+	TeacherName   string                 `protobuf:"bytes,6,opt,name=teacherName,proto3" json:"teacherName,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GroupMeta) Reset() {
+	*x = GroupMeta{}
+	mi := &file_education_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GroupMeta) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GroupMeta) ProtoMessage() {}
+
+func (x *GroupMeta) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GroupMeta.ProtoReflect.Descriptor instead.
+func (*GroupMeta) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *GroupMeta) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *GroupMeta) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *GroupMeta) GetCourseId() string {
+	if x != nil {
+		return x.CourseId
+	}
+	return ""
+}
+
+func (x *GroupMeta) GetCourseName() string {
+	if x != nil {
+		return x.CourseName
+	}
+	return ""
+}
+
+func (x *GroupMeta) GetTeacherId() string {
+	if x != nil {
+		return x.TeacherId
+	}
+	return ""
+}
+
+func (x *GroupMeta) GetTeacherName() string {
+	if x != nil {
+		return x.TeacherName
+	}
+	return ""
+}
+
+type GetLeftAfterTrialPeriodRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	From          string                 `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	To            string                 `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+	Page          string                 `protobuf:"bytes,3,opt,name=page,proto3" json:"page,omitempty"`
+	Size          string                 `protobuf:"bytes,4,opt,name=size,proto3" json:"size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetLeftAfterTrialPeriodRequest) Reset() {
+	*x = GetLeftAfterTrialPeriodRequest{}
+	mi := &file_education_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetLeftAfterTrialPeriodRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLeftAfterTrialPeriodRequest) ProtoMessage() {}
+
+func (x *GetLeftAfterTrialPeriodRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLeftAfterTrialPeriodRequest.ProtoReflect.Descriptor instead.
+func (*GetLeftAfterTrialPeriodRequest) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *GetLeftAfterTrialPeriodRequest) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *GetLeftAfterTrialPeriodRequest) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+func (x *GetLeftAfterTrialPeriodRequest) GetPage() string {
+	if x != nil {
+		return x.Page
+	}
+	return ""
+}
+
+func (x *GetLeftAfterTrialPeriodRequest) GetSize() string {
+	if x != nil {
+		return x.Size
+	}
+	return ""
+}
+
+type GetLeftAfterTrialPeriodResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Items          []*AbsGetLeftAfter     `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	TotalItemCount int32                  `protobuf:"varint,2,opt,name=totalItemCount,proto3" json:"totalItemCount,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetLeftAfterTrialPeriodResponse) Reset() {
+	*x = GetLeftAfterTrialPeriodResponse{}
+	mi := &file_education_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetLeftAfterTrialPeriodResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLeftAfterTrialPeriodResponse) ProtoMessage() {}
+
+func (x *GetLeftAfterTrialPeriodResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLeftAfterTrialPeriodResponse.ProtoReflect.Descriptor instead.
+func (*GetLeftAfterTrialPeriodResponse) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *GetLeftAfterTrialPeriodResponse) GetItems() []*AbsGetLeftAfter {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *GetLeftAfterTrialPeriodResponse) GetTotalItemCount() int32 {
+	if x != nil {
+		return x.TotalItemCount
+	}
+	return 0
+}
+
+type AbsGetLeftAfter struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	StudentId      string                 `protobuf:"bytes,1,opt,name=studentId,proto3" json:"studentId,omitempty"`
+	StudentName    string                 `protobuf:"bytes,2,opt,name=studentName,proto3" json:"studentName,omitempty"`
+	StudentPhone   string                 `protobuf:"bytes,3,opt,name=studentPhone,proto3" json:"studentPhone,omitempty"`
+	StudentBalance string                 `protobuf:"bytes,4,opt,name=studentBalance,proto3" json:"studentBalance,omitempty"`
+	GroupId        string                 `protobuf:"bytes,5,opt,name=groupId,proto3" json:"groupId,omitempty"`
+	GroupName      string                 `protobuf:"bytes,6,opt,name=groupName,proto3" json:"groupName,omitempty"`
+	ReturnMoney    bool                   `protobuf:"varint,7,opt,name=returnMoney,proto3" json:"returnMoney,omitempty"`
+	CreatedAt      string                 `protobuf:"bytes,8,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	SpecificDate   string                 `protobuf:"bytes,9,opt,name=specificDate,proto3" json:"specificDate,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *AbsGetLeftAfter) Reset() {
+	*x = AbsGetLeftAfter{}
+	mi := &file_education_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AbsGetLeftAfter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AbsGetLeftAfter) ProtoMessage() {}
+
+func (x *AbsGetLeftAfter) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AbsGetLeftAfter.ProtoReflect.Descriptor instead.
+func (*AbsGetLeftAfter) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *AbsGetLeftAfter) GetStudentId() string {
+	if x != nil {
+		return x.StudentId
+	}
+	return ""
+}
+
+func (x *AbsGetLeftAfter) GetStudentName() string {
+	if x != nil {
+		return x.StudentName
+	}
+	return ""
+}
+
+func (x *AbsGetLeftAfter) GetStudentPhone() string {
+	if x != nil {
+		return x.StudentPhone
+	}
+	return ""
+}
+
+func (x *AbsGetLeftAfter) GetStudentBalance() string {
+	if x != nil {
+		return x.StudentBalance
+	}
+	return ""
+}
+
+func (x *AbsGetLeftAfter) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+func (x *AbsGetLeftAfter) GetGroupName() string {
+	if x != nil {
+		return x.GroupName
+	}
+	return ""
+}
+
+func (x *AbsGetLeftAfter) GetReturnMoney() bool {
+	if x != nil {
+		return x.ReturnMoney
+	}
+	return false
+}
+
+func (x *AbsGetLeftAfter) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *AbsGetLeftAfter) GetSpecificDate() string {
+	if x != nil {
+		return x.SpecificDate
+	}
+	return ""
+}
+
+type StudentIdRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StudentId     string                 `protobuf:"bytes,1,opt,name=studentId,proto3" json:"studentId,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StudentIdRequest) Reset() {
+	*x = StudentIdRequest{}
+	mi := &file_education_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StudentIdRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StudentIdRequest) ProtoMessage() {}
+
+func (x *StudentIdRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StudentIdRequest.ProtoReflect.Descriptor instead.
+func (*StudentIdRequest) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *StudentIdRequest) GetStudentId() string {
+	if x != nil {
+		return x.StudentId
+	}
+	return ""
+}
+
+type GetGroupsByStudentResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Comments      []*DebtorComment       `protobuf:"bytes,1,rep,name=comments,proto3" json:"comments,omitempty"`
+	Groups        []*DebtorGroup         `protobuf:"bytes,2,rep,name=groups,proto3" json:"groups,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetGroupsByStudentResponse) Reset() {
+	*x = GetGroupsByStudentResponse{}
+	mi := &file_education_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetGroupsByStudentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGroupsByStudentResponse) ProtoMessage() {}
+
+func (x *GetGroupsByStudentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGroupsByStudentResponse.ProtoReflect.Descriptor instead.
+func (*GetGroupsByStudentResponse) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *GetGroupsByStudentResponse) GetComments() []*DebtorComment {
+	if x != nil {
+		return x.Comments
+	}
+	return nil
+}
+
+func (x *GetGroupsByStudentResponse) GetGroups() []*DebtorGroup {
+	if x != nil {
+		return x.Groups
+	}
+	return nil
+}
+
+type DebtorGroup struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GroupId       string                 `protobuf:"bytes,1,opt,name=groupId,proto3" json:"groupId,omitempty"`
+	GroupName     string                 `protobuf:"bytes,2,opt,name=groupName,proto3" json:"groupName,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DebtorGroup) Reset() {
+	*x = DebtorGroup{}
+	mi := &file_education_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DebtorGroup) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DebtorGroup) ProtoMessage() {}
+
+func (x *DebtorGroup) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DebtorGroup.ProtoReflect.Descriptor instead.
+func (*DebtorGroup) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *DebtorGroup) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+func (x *DebtorGroup) GetGroupName() string {
+	if x != nil {
+		return x.GroupName
+	}
+	return ""
+}
+
+type DebtorComment struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CommentId     string                 `protobuf:"bytes,1,opt,name=commentId,proto3" json:"commentId,omitempty"`
+	Comment       string                 `protobuf:"bytes,2,opt,name=comment,proto3" json:"comment,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DebtorComment) Reset() {
+	*x = DebtorComment{}
+	mi := &file_education_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DebtorComment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DebtorComment) ProtoMessage() {}
+
+func (x *DebtorComment) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DebtorComment.ProtoReflect.Descriptor instead.
+func (*DebtorComment) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *DebtorComment) GetCommentId() string {
+	if x != nil {
+		return x.CommentId
+	}
+	return ""
+}
+
+func (x *DebtorComment) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+type GetCommonInformationEducationResponse struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	ActiveStudentCount int32                  `protobuf:"varint,1,opt,name=activeStudentCount,proto3" json:"activeStudentCount,omitempty"`
+	ActiveGroupCount   int32                  `protobuf:"varint,2,opt,name=activeGroupCount,proto3" json:"activeGroupCount,omitempty"`
+	LeaveGroupCount    int32                  `protobuf:"varint,3,opt,name=leaveGroupCount,proto3" json:"leaveGroupCount,omitempty"`
+	DebtorsCount       int32                  `protobuf:"varint,4,opt,name=debtorsCount,proto3" json:"debtorsCount,omitempty"`
+	EleminatedInTrial  int32                  `protobuf:"varint,5,opt,name=eleminatedInTrial,proto3" json:"eleminatedInTrial,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *GetCommonInformationEducationResponse) Reset() {
+	*x = GetCommonInformationEducationResponse{}
+	mi := &file_education_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCommonInformationEducationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCommonInformationEducationResponse) ProtoMessage() {}
+
+func (x *GetCommonInformationEducationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCommonInformationEducationResponse.ProtoReflect.Descriptor instead.
+func (*GetCommonInformationEducationResponse) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *GetCommonInformationEducationResponse) GetActiveStudentCount() int32 {
+	if x != nil {
+		return x.ActiveStudentCount
+	}
+	return 0
+}
+
+func (x *GetCommonInformationEducationResponse) GetActiveGroupCount() int32 {
+	if x != nil {
+		return x.ActiveGroupCount
+	}
+	return 0
+}
+
+func (x *GetCommonInformationEducationResponse) GetLeaveGroupCount() int32 {
+	if x != nil {
+		return x.LeaveGroupCount
+	}
+	return 0
+}
+
+func (x *GetCommonInformationEducationResponse) GetDebtorsCount() int32 {
+	if x != nil {
+		return x.DebtorsCount
+	}
+	return 0
+}
+
+func (x *GetCommonInformationEducationResponse) GetEleminatedInTrial() int32 {
+	if x != nil {
+		return x.EleminatedInTrial
+	}
+	return 0
+}
+
+type GetGroupsByTeacherIdRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TeacherId     string                 `protobuf:"bytes,1,opt,name=teacherId,proto3" json:"teacherId,omitempty"`
+	IsArchived    bool                   `protobuf:"varint,2,opt,name=isArchived,proto3" json:"isArchived,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetGroupsByTeacherIdRequest) Reset() {
+	*x = GetGroupsByTeacherIdRequest{}
+	mi := &file_education_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetGroupsByTeacherIdRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGroupsByTeacherIdRequest) ProtoMessage() {}
+
+func (x *GetGroupsByTeacherIdRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGroupsByTeacherIdRequest.ProtoReflect.Descriptor instead.
+func (*GetGroupsByTeacherIdRequest) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *GetGroupsByTeacherIdRequest) GetTeacherId() string {
+	if x != nil {
+		return x.TeacherId
+	}
+	return ""
+}
+
+func (x *GetGroupsByTeacherIdRequest) GetIsArchived() bool {
+	if x != nil {
+		return x.IsArchived
+	}
+	return false
+}
+
+type GetGroupsByTeacherResponse struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Groups        []*GetGroupByTeacherAbs `protobuf:"bytes,1,rep,name=groups,proto3" json:"groups,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetGroupsByTeacherResponse) Reset() {
+	*x = GetGroupsByTeacherResponse{}
+	mi := &file_education_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetGroupsByTeacherResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGroupsByTeacherResponse) ProtoMessage() {}
+
+func (x *GetGroupsByTeacherResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGroupsByTeacherResponse.ProtoReflect.Descriptor instead.
+func (*GetGroupsByTeacherResponse) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *GetGroupsByTeacherResponse) GetGroups() []*GetGroupByTeacherAbs {
+	if x != nil {
+		return x.Groups
+	}
+	return nil
+}
+
+type GetGroupByTeacherAbs struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Id                 string                 `protobuf:"bytes,10,opt,name=id,proto3" json:"id,omitempty"`
+	Name               string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	CourseName         string                 `protobuf:"bytes,2,opt,name=courseName,proto3" json:"courseName,omitempty"`
+	RoomName           string                 `protobuf:"bytes,3,opt,name=roomName,proto3" json:"roomName,omitempty"`
+	LessonStartTime    string                 `protobuf:"bytes,4,opt,name=lessonStartTime,proto3" json:"lessonStartTime,omitempty"`
+	DayType            string                 `protobuf:"bytes,5,opt,name=dayType,proto3" json:"dayType,omitempty"`
+	GroupStartAt       string                 `protobuf:"bytes,6,opt,name=groupStartAt,proto3" json:"groupStartAt,omitempty"`
+	GroupEndAt         string                 `protobuf:"bytes,7,opt,name=groupEndAt,proto3" json:"groupEndAt,omitempty"`
+	ActiveStudentCount int32                  `protobuf:"varint,8,opt,name=activeStudentCount,proto3" json:"activeStudentCount,omitempty"`
+	Students           []*AbsStudent          `protobuf:"bytes,9,rep,name=students,proto3" json:"students,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *GetGroupByTeacherAbs) Reset() {
+	*x = GetGroupByTeacherAbs{}
+	mi := &file_education_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetGroupByTeacherAbs) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGroupByTeacherAbs) ProtoMessage() {}
+
+func (x *GetGroupByTeacherAbs) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGroupByTeacherAbs.ProtoReflect.Descriptor instead.
+func (*GetGroupByTeacherAbs) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *GetGroupByTeacherAbs) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *GetGroupByTeacherAbs) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *GetGroupByTeacherAbs) GetCourseName() string {
+	if x != nil {
+		return x.CourseName
+	}
+	return ""
+}
+
+func (x *GetGroupByTeacherAbs) GetRoomName() string {
+	if x != nil {
+		return x.RoomName
+	}
+	return ""
+}
+
+func (x *GetGroupByTeacherAbs) GetLessonStartTime() string {
+	if x != nil {
+		return x.LessonStartTime
+	}
+	return ""
+}
+
+func (x *GetGroupByTeacherAbs) GetDayType() string {
+	if x != nil {
+		return x.DayType
+	}
+	return ""
+}
+
+func (x *GetGroupByTeacherAbs) GetGroupStartAt() string {
+	if x != nil {
+		return x.GroupStartAt
+	}
+	return ""
+}
+
+func (x *GetGroupByTeacherAbs) GetGroupEndAt() string {
+	if x != nil {
+		return x.GroupEndAt
+	}
+	return ""
+}
+
+func (x *GetGroupByTeacherAbs) GetActiveStudentCount() int32 {
+	if x != nil {
+		return x.ActiveStudentCount
+	}
+	return 0
+}
+
+func (x *GetGroupByTeacherAbs) GetStudents() []*AbsStudent {
+	if x != nil {
+		return x.Students
+	}
+	return nil
+}
+
+type CreateGroupRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Name            string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	CourseId        int32                  `protobuf:"varint,2,opt,name=courseId,proto3" json:"courseId,omitempty"`
+	TeacherId       string                 `protobuf:"bytes,3,opt,name=teacherId,proto3" json:"teacherId,omitempty"`
+	Type            string                 `protobuf:"bytes,4,opt,name=type,proto3" json:"type,omitempty"`
+	Days            []string               `protobuf:"bytes,5,rep,name=days,proto3" json:"days,omitempty"`
+	RoomId          int32                  `protobuf:"varint,6,opt,name=roomId,proto3" json:"roomId,omitempty"`
+	LessonStartTime string                 `protobuf:"bytes,7,opt,name=lessonStartTime,proto3" json:"lessonStartTime,omitempty"`
+	GroupStartDate  string                 `protobuf:"bytes,8,opt,name=groupStartDate,proto3" json:"groupStartDate,omitempty"`
+	GroupEndDate    string                 `protobuf:"bytes,9,opt,name=groupEndDate,proto3" json:"groupEndDate,omitempty"`
+	// saves the group although its room or teacher is already booked, the override is audited
+	Force         bool   `protobuf:"varint,10,opt,name=force,proto3" json:"force,omitempty"`
+	ActionById    string `protobuf:"bytes,11,opt,name=actionById,proto3" json:"actionById,omitempty"`
+	ActionByName  string `protobuf:"bytes,12,opt,name=actionByName,proto3" json:"actionByName,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateGroupRequest) Reset() {
+	*x = CreateGroupRequest{}
+	mi := &file_education_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateGroupRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateGroupRequest) ProtoMessage() {}
+
+func (x *CreateGroupRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateGroupRequest.ProtoReflect.Descriptor instead.
+func (*CreateGroupRequest) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *CreateGroupRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateGroupRequest) GetCourseId() int32 {
+	if x != nil {
+		return x.CourseId
+	}
+	return 0
+}
+
+func (x *CreateGroupRequest) GetTeacherId() string {
+	if x != nil {
+		return x.TeacherId
+	}
+	return ""
+}
+
+func (x *CreateGroupRequest) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *CreateGroupRequest) GetDays() []string {
+	if x != nil {
+		return x.Days
+	}
+	return nil
+}
+
+func (x *CreateGroupRequest) GetRoomId() int32 {
+	if x != nil {
+		return x.RoomId
+	}
+	return 0
+}
+
+func (x *CreateGroupRequest) GetLessonStartTime() string {
+	if x != nil {
+		return x.LessonStartTime
+	}
+	return ""
+}
+
+func (x *CreateGroupRequest) GetGroupStartDate() string {
+	if x != nil {
+		return x.GroupStartDate
+	}
+	return ""
+}
+
+func (x *CreateGroupRequest) GetGroupEndDate() string {
+	if x != nil {
+		return x.GroupEndDate
+	}
+	return ""
+}
+
+func (x *CreateGroupRequest) GetForce() bool {
+	if x != nil {
+		return x.Force
+	}
+	return false
+}
+
+func (x *CreateGroupRequest) GetActionById() string {
+	if x != nil {
+		return x.ActionById
+	}
+	return ""
+}
+
+func (x *CreateGroupRequest) GetActionByName() string {
+	if x != nil {
+		return x.ActionByName
+	}
+	return ""
+}
+
+type GetGroupByIdRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ActionRole    string                 `protobuf:"bytes,2,opt,name=actionRole,proto3" json:"actionRole,omitempty"`
+	ActionId      string                 `protobuf:"bytes,3,opt,name=actionId,proto3" json:"actionId,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetGroupByIdRequest) Reset() {
+	*x = GetGroupByIdRequest{}
+	mi := &file_education_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetGroupByIdRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGroupByIdRequest) ProtoMessage() {}
+
+func (x *GetGroupByIdRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGroupByIdRequest.ProtoReflect.Descriptor instead.
+func (*GetGroupByIdRequest) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *GetGroupByIdRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *GetGroupByIdRequest) GetActionRole() string {
+	if x != nil {
+		return x.ActionRole
+	}
+	return ""
+}
+
+func (x *GetGroupByIdRequest) GetActionId() string {
+	if x != nil {
+		return x.ActionId
+	}
+	return ""
+}
+
+type GetUpdateGroupAbs struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              string                 `protobuf:"bytes,10,opt,name=id,proto3" json:"id,omitempty"`
+	Name            string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	CourseId        int32                  `protobuf:"varint,2,opt,name=courseId,proto3" json:"courseId,omitempty"`
+	TeacherId       string                 `protobuf:"bytes,3,opt,name=teacherId,proto3" json:"teacherId,omitempty"`
+	Type            string                 `protobuf:"bytes,4,opt,name=type,proto3" json:"type,omitempty"`
+	Days            []string               `protobuf:"bytes,5,rep,name=days,proto3" json:"days,omitempty"`
+	RoomId          int32                  `protobuf:"varint,6,opt,name=roomId,proto3" json:"roomId,omitempty"`
+	LessonStartTime string                 `protobuf:"bytes,7,opt,name=lessonStartTime,proto3" json:"lessonStartTime,omitempty"`
+	GroupStartDate  string                 `protobuf:"bytes,8,opt,name=groupStartDate,proto3" json:"groupStartDate,omitempty"`
+	GroupEndDate    string                 `protobuf:"bytes,9,opt,name=groupEndDate,proto3" json:"groupEndDate,omitempty"`
+	// saves the group although its room or teacher is already booked, the override is audited
+	Force         bool   `protobuf:"varint,11,opt,name=force,proto3" json:"force,omitempty"`
+	ActionById    string `protobuf:"bytes,12,opt,name=actionById,proto3" json:"actionById,omitempty"`
+	ActionByName  string `protobuf:"bytes,13,opt,name=actionByName,proto3" json:"actionByName,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUpdateGroupAbs) Reset() {
+	*x = GetUpdateGroupAbs{}
+	mi := &file_education_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUpdateGroupAbs) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUpdateGroupAbs) ProtoMessage() {}
+
+func (x *GetUpdateGroupAbs) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUpdateGroupAbs.ProtoReflect.Descriptor instead.
+func (*GetUpdateGroupAbs) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *GetUpdateGroupAbs) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *GetUpdateGroupAbs) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *GetUpdateGroupAbs) GetCourseId() int32 {
+	if x != nil {
+		return x.CourseId
+	}
+	return 0
+}
+
+func (x *GetUpdateGroupAbs) GetTeacherId() string {
+	if x != nil {
+		return x.TeacherId
+	}
+	return ""
+}
+
+func (x *GetUpdateGroupAbs) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *GetUpdateGroupAbs) GetDays() []string {
+	if x != nil {
+		return x.Days
+	}
+	return nil
+}
+
+func (x *GetUpdateGroupAbs) GetRoomId() int32 {
+	if x != nil {
+		return x.RoomId
+	}
+	return 0
+}
+
+func (x *GetUpdateGroupAbs) GetLessonStartTime() string {
+	if x != nil {
+		return x.LessonStartTime
+	}
+	return ""
+}
+
+func (x *GetUpdateGroupAbs) GetGroupStartDate() string {
+	if x != nil {
+		return x.GroupStartDate
+	}
+	return ""
+}
+
+func (x *GetUpdateGroupAbs) GetGroupEndDate() string {
+	if x != nil {
+		return x.GroupEndDate
+	}
+	return ""
+}
+
+func (x *GetUpdateGroupAbs) GetForce() bool {
+	if x != nil {
+		return x.Force
+	}
+	return false
+}
+
+func (x *GetUpdateGroupAbs) GetActionById() string {
+	if x != nil {
+		return x.ActionById
+	}
+	return ""
+}
+
+func (x *GetUpdateGroupAbs) GetActionByName() string {
+	if x != nil {
+		return x.ActionByName
+	}
+	return ""
+}
+
+type GetGroupsByCourseResponse struct {
+	state         protoimpl.MessageState         `protogen:"open.v1"`
+	Groups        []*GetGroupByCourseAbsResponse `protobuf:"bytes,1,rep,name=groups,proto3" json:"groups,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetGroupsByCourseResponse) Reset() {
+	*x = GetGroupsByCourseResponse{}
+	mi := &file_education_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetGroupsByCourseResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGroupsByCourseResponse) ProtoMessage() {}
+
+func (x *GetGroupsByCourseResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGroupsByCourseResponse.ProtoReflect.Descriptor instead.
+func (*GetGroupsByCourseResponse) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *GetGroupsByCourseResponse) GetGroups() []*GetGroupByCourseAbsResponse {
+	if x != nil {
+		return x.Groups
+	}
+	return nil
+}
+
+type GetGroupByCourseAbsResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	TeacherName     string                 `protobuf:"bytes,2,opt,name=teacherName,proto3" json:"teacherName,omitempty"`
+	GroupStartDate  string                 `protobuf:"bytes,3,opt,name=groupStartDate,proto3" json:"groupStartDate,omitempty"`
+	GroupEndDate    string                 `protobuf:"bytes,4,opt,name=groupEndDate,proto3" json:"groupEndDate,omitempty"`
+	DateType        string                 `protobuf:"bytes,5,opt,name=dateType,proto3" json:"dateType,omitempty"`
+	LessonStartTime string                 `protobuf:"bytes,6,opt,name=lessonStartTime,proto3" json:"lessonStartTime,omitempty"`
+	GroupName       string                 `protobuf:"bytes,7,opt,name=groupName,proto3" json:"groupName,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *GetGroupByCourseAbsResponse) Reset() {
+	*x = GetGroupByCourseAbsResponse{}
+	mi := &file_education_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetGroupByCourseAbsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGroupByCourseAbsResponse) ProtoMessage() {}
+
+func (x *GetGroupByCourseAbsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGroupByCourseAbsResponse.ProtoReflect.Descriptor instead.
+func (*GetGroupByCourseAbsResponse) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *GetGroupByCourseAbsResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *GetGroupByCourseAbsResponse) GetTeacherName() string {
+	if x != nil {
+		return x.TeacherName
+	}
+	return ""
+}
+
+func (x *GetGroupByCourseAbsResponse) GetGroupStartDate() string {
+	if x != nil {
+		return x.GroupStartDate
+	}
+	return ""
+}
+
+func (x *GetGroupByCourseAbsResponse) GetGroupEndDate() string {
+	if x != nil {
+		return x.GroupEndDate
+	}
+	return ""
+}
+
+func (x *GetGroupByCourseAbsResponse) GetDateType() string {
+	if x != nil {
+		return x.DateType
+	}
+	return ""
+}
+
+func (x *GetGroupByCourseAbsResponse) GetLessonStartTime() string {
+	if x != nil {
+		return x.LessonStartTime
+	}
+	return ""
+}
+
+func (x *GetGroupByCourseAbsResponse) GetGroupName() string {
+	if x != nil {
+		return x.GroupName
+	}
+	return ""
+}
+
+type GetGroupAbsResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Course          *AbsCourse             `protobuf:"bytes,2,opt,name=course,proto3" json:"course,omitempty"`
+	TeacherName     string                 `protobuf:"bytes,3,opt,name=teacherName,proto3" json:"teacherName,omitempty"`
+	TeacherId       string                 `protobuf:"bytes,14,opt,name=teacherId,proto3" json:"teacherId,omitempty"`
+	LessonStartTime string                 `protobuf:"bytes,4,opt,name=lessonStartTime,proto3" json:"lessonStartTime,omitempty"`
+	Room            *AbsRoom               `protobuf:"bytes,5,opt,name=room,proto3" json:"room,omitempty"`
+	StudentCount    int32                  `protobuf:"varint,6,opt,name=studentCount,proto3" json:"studentCount,omitempty"`
+	Name            string                 `protobuf:"bytes,7,opt,name=name,proto3" json:"name,omitempty"`
+	IsArchived      bool                   `protobuf:"varint,8,opt,name=isArchived,proto3" json:"isArchived,omitempty"`
+	CreatedAt       string                 `protobuf:"bytes,9,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	StartDate       string                 `protobuf:"bytes,10,opt,name=startDate,proto3" json:"startDate,omitempty"`
+	EndDate         string                 `protobuf:"bytes,11,opt,name=endDate,proto3" json:"endDate,omitempty"`
+	Days            []string               `protobuf:"bytes,12,rep,name=days,proto3" json:"days,omitempty"`
+	DateType        string                 `protobuf:"bytes,13,opt,name=dateType,proto3" json:"dateType,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *GetGroupAbsResponse) Reset() {
+	*x = GetGroupAbsResponse{}
+	mi := &file_education_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetGroupAbsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGroupAbsResponse) ProtoMessage() {}
+
+func (x *GetGroupAbsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGroupAbsResponse.ProtoReflect.Descriptor instead.
+func (*GetGroupAbsResponse) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *GetGroupAbsResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *GetGroupAbsResponse) GetCourse() *AbsCourse {
+	if x != nil {
+		return x.Course
+	}
+	return nil
+}
+
+func (x *GetGroupAbsResponse) GetTeacherName() string {
+	if x != nil {
+		return x.TeacherName
+	}
+	return ""
+}
+
+func (x *GetGroupAbsResponse) GetTeacherId() string {
+	if x != nil {
+		return x.TeacherId
+	}
+	return ""
+}
+
+func (x *GetGroupAbsResponse) GetLessonStartTime() string {
+	if x != nil {
+		return x.LessonStartTime
+	}
+	return ""
+}
+
+func (x *GetGroupAbsResponse) GetRoom() *AbsRoom {
+	if x != nil {
+		return x.Room
+	}
+	return nil
+}
+
+func (x *GetGroupAbsResponse) GetStudentCount() int32 {
+	if x != nil {
+		return x.StudentCount
+	}
+	return 0
+}
+
+func (x *GetGroupAbsResponse) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *GetGroupAbsResponse) GetIsArchived() bool {
+	if x != nil {
+		return x.IsArchived
+	}
+	return false
+}
+
+func (x *GetGroupAbsResponse) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *GetGroupAbsResponse) GetStartDate() string {
+	if x != nil {
+		return x.StartDate
+	}
+	return ""
+}
+
+func (x *GetGroupAbsResponse) GetEndDate() string {
+	if x != nil {
+		return x.EndDate
+	}
+	return ""
+}
+
+func (x *GetGroupAbsResponse) GetDays() []string {
+	if x != nil {
+		return x.Days
+	}
+	return nil
+}
+
+func (x *GetGroupAbsResponse) GetDateType() string {
+	if x != nil {
+		return x.DateType
+	}
+	return ""
+}
+
+type GetGroupsResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Groups         []*GetGroupAbsResponse `protobuf:"bytes,1,rep,name=groups,proto3" json:"groups,omitempty"`
+	TotalPageCount int32                  `protobuf:"varint,2,opt,name=totalPageCount,proto3" json:"totalPageCount,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetGroupsResponse) Reset() {
+	*x = GetGroupsResponse{}
+	mi := &file_education_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetGroupsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGroupsResponse) ProtoMessage() {}
+
+func (x *GetGroupsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGroupsResponse.ProtoReflect.Descriptor instead.
+func (*GetGroupsResponse) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *GetGroupsResponse) GetGroups() []*GetGroupAbsResponse {
+	if x != nil {
+		return x.Groups
+	}
+	return nil
+}
+
+func (x *GetGroupsResponse) GetTotalPageCount() int32 {
+	if x != nil {
+		return x.TotalPageCount
+	}
+	return 0
+}
+
+type GetGroupsRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	IsArchived     bool                   `protobuf:"varint,1,opt,name=isArchived,proto3" json:"isArchived,omitempty"`
+	TeacherId      *string                `protobuf:"bytes,2,opt,name=teacherId,proto3,oneof" json:"teacherId,omitempty"`
+	CourseId       *int32                 `protobuf:"varint,3,opt,name=courseId,proto3,oneof" json:"courseId,omitempty"`
+	DateType       *string                `protobuf:"bytes,4,opt,name=dateType,proto3,oneof" json:"dateType,omitempty"`
+	StartDate      *string                `protobuf:"bytes,5,opt,name=startDate,proto3,oneof" json:"startDate,omitempty"`
+	EndDate        *string                `protobuf:"bytes,6,opt,name=endDate,proto3,oneof" json:"endDate,omitempty"`
+	Page           *PageRequest           `protobuf:"bytes,7,opt,name=page,proto3" json:"page,omitempty"`
+	OrderBy        string                 `protobuf:"bytes,8,opt,name=orderBy,proto3" json:"orderBy,omitempty"`
+	OrderDirection string                 `protobuf:"bytes,9,opt,name=orderDirection,proto3" json:"orderDirection,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetGroupsRequest) Reset() {
+	*x = GetGroupsRequest{}
+	mi := &file_education_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetGroupsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGroupsRequest) ProtoMessage() {}
+
+func (x *GetGroupsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGroupsRequest.ProtoReflect.Descriptor instead.
+func (*GetGroupsRequest) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *GetGroupsRequest) GetIsArchived() bool {
+	if x != nil {
+		return x.IsArchived
+	}
+	return false
+}
+
+func (x *GetGroupsRequest) GetTeacherId() string {
+	if x != nil && x.TeacherId != nil {
+		return *x.TeacherId
+	}
+	return ""
+}
+
+func (x *GetGroupsRequest) GetCourseId() int32 {
+	if x != nil && x.CourseId != nil {
+		return *x.CourseId
+	}
+	return 0
+}
+
+func (x *GetGroupsRequest) GetDateType() string {
+	if x != nil && x.DateType != nil {
+		return *x.DateType
+	}
+	return ""
+}
+
+func (x *GetGroupsRequest) GetStartDate() string {
+	if x != nil && x.StartDate != nil {
+		return *x.StartDate
+	}
+	return ""
+}
+
+func (x *GetGroupsRequest) GetEndDate() string {
+	if x != nil && x.EndDate != nil {
+		return *x.EndDate
+	}
+	return ""
+}
+
+func (x *GetGroupsRequest) GetPage() *PageRequest {
+	if x != nil {
+		return x.Page
+	}
+	return nil
+}
+
+func (x *GetGroupsRequest) GetOrderBy() string {
+	if x != nil {
+		return x.OrderBy
+	}
+	return ""
+}
+
+func (x *GetGroupsRequest) GetOrderDirection() string {
+	if x != nil {
+		return x.OrderDirection
+	}
+	return ""
+}
+
+type UpdateGroupBillingModeRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	GroupId string                 `protobuf:"bytes,1,opt,name=groupId,proto3" json:"groupId,omitempty"`
+	// MONTHLY, PER_LESSON or PACKAGE, empty uses the billing mode of the course
+	BillingMode   string `protobuf:"bytes,2,opt,name=billingMode,proto3" json:"billingMode,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateGroupBillingModeRequest) Reset() {
+	*x = UpdateGroupBillingModeRequest{}
+	mi := &file_education_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateGroupBillingModeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateGroupBillingModeRequest) ProtoMessage() {}
+
+func (x *UpdateGroupBillingModeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateGroupBillingModeRequest.ProtoReflect.Descriptor instead.
+func (*UpdateGroupBillingModeRequest) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *UpdateGroupBillingModeRequest) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+func (x *UpdateGroupBillingModeRequest) GetBillingMode() string {
+	if x != nil {
+		return x.BillingMode
+	}
+	return ""
+}
+
+type UpdateGroupMaxStudentsRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	GroupId string                 `protobuf:"bytes,1,opt,name=groupId,proto3" json:"groupId,omitempty"`
+	// seats of the group, 0 uses the capacity of its room
+	MaxStudents   int32 `protobuf:"varint,2,opt,name=maxStudents,proto3" json:"maxStudents,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateGroupMaxStudentsRequest) Reset() {
+	*x = UpdateGroupMaxStudentsRequest{}
+	mi := &file_education_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateGroupMaxStudentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateGroupMaxStudentsRequest) ProtoMessage() {}
+
+func (x *UpdateGroupMaxStudentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateGroupMaxStudentsRequest.ProtoReflect.Descriptor instead.
+func (*UpdateGroupMaxStudentsRequest) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *UpdateGroupMaxStudentsRequest) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+func (x *UpdateGroupMaxStudentsRequest) GetMaxStudents() int32 {
+	if x != nil {
+		return x.MaxStudents
+	}
+	return 0
+}
+
+type ScheduleConflict struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// ROOM when the group uses the same room, TEACHER when it has the same teacher
+	Kind      string `protobuf:"bytes,1,opt,name=kind,proto3" json:"kind,omitempty"`
+	GroupId   int64  `protobuf:"varint,2,opt,name=groupId,proto3" json:"groupId,omitempty"`
+	GroupName string `protobuf:"bytes,3,opt,name=groupName,proto3" json:"groupName,omitempty"`
+	RoomId    int32  `protobuf:"varint,4,opt,name=roomId,proto3" json:"roomId,omitempty"`
+	TeacherId string `protobuf:"bytes,5,opt,name=teacherId,proto3" json:"teacherId,omitempty"`
+	// weekdays both groups have lessons on, the transfer date for a transferred lesson
+	Days []string `protobuf:"bytes,6,rep,name=days,proto3" json:"days,omitempty"`
+	// lesson time of the conflicting group, the end is the start plus courses.duration_lesson minutes
+	StartTime string `protobuf:"bytes,7,opt,name=startTime,proto3" json:"startTime,omitempty"`
+	EndTime   string `protobuf:"bytes,8,opt,name=endTime,proto3" json:"endTime,omitempty"`
+	// period both groups run in
+	FromDate      string `protobuf:"bytes,9,opt,name=fromDate,proto3" json:"fromDate,omitempty"`
+	TillDate      string `protobuf:"bytes,10,opt,name=tillDate,proto3" json:"tillDate,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ScheduleConflict) Reset() {
+	*x = ScheduleConflict{}
+	mi := &file_education_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScheduleConflict) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScheduleConflict) ProtoMessage() {}
+
+func (x *ScheduleConflict) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScheduleConflict.ProtoReflect.Descriptor instead.
+func (*ScheduleConflict) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *ScheduleConflict) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *ScheduleConflict) GetGroupId() int64 {
+	if x != nil {
+		return x.GroupId
+	}
+	return 0
+}
+
+func (x *ScheduleConflict) GetGroupName() string {
+	if x != nil {
+		return x.GroupName
+	}
+	return ""
+}
+
+func (x *ScheduleConflict) GetRoomId() int32 {
+	if x != nil {
+		return x.RoomId
+	}
+	return 0
+}
+
+func (x *ScheduleConflict) GetTeacherId() string {
+	if x != nil {
+		return x.TeacherId
+	}
+	return ""
+}
+
+func (x *ScheduleConflict) GetDays() []string {
+	if x != nil {
+		return x.Days
+	}
+	return nil
+}
+
+func (x *ScheduleConflict) GetStartTime() string {
+	if x != nil {
+		return x.StartTime
+	}
+	return ""
+}
+
+func (x *ScheduleConflict) GetEndTime() string {
+	if x != nil {
+		return x.EndTime
+	}
+	return ""
+}
+
+func (x *ScheduleConflict) GetFromDate() string {
+	if x != nil {
+		return x.FromDate
+	}
+	return ""
+}
+
+func (x *ScheduleConflict) GetTillDate() string {
+	if x != nil {
+		return x.TillDate
+	}
+	return ""
+}
+
+type ScheduleConflicts struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Conflicts     []*ScheduleConflict    `protobuf:"bytes,1,rep,name=conflicts,proto3" json:"conflicts,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ScheduleConflicts) Reset() {
+	*x = ScheduleConflicts{}
+	mi := &file_education_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScheduleConflicts) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScheduleConflicts) ProtoMessage() {}
+
+func (x *ScheduleConflicts) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScheduleConflicts.ProtoReflect.Descriptor instead.
+func (*ScheduleConflicts) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *ScheduleConflicts) GetConflicts() []*ScheduleConflict {
+	if x != nil {
+		return x.Conflicts
+	}
+	return nil
+}
+
+type CheckScheduleConflictsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// group being edited, its own lessons are not conflicts
+	GroupId         string   `protobuf:"bytes,1,opt,name=groupId,proto3" json:"groupId,omitempty"`
+	CourseId        int32    `protobuf:"varint,2,opt,name=courseId,proto3" json:"courseId,omitempty"`
+	TeacherId       string   `protobuf:"bytes,3,opt,name=teacherId,proto3" json:"teacherId,omitempty"`
+	RoomId          int32    `protobuf:"varint,4,opt,name=roomId,proto3" json:"roomId,omitempty"`
+	Type            string   `protobuf:"bytes,5,opt,name=type,proto3" json:"type,omitempty"`
+	Days            []string `protobuf:"bytes,6,rep,name=days,proto3" json:"days,omitempty"`
+	LessonStartTime string   `protobuf:"bytes,7,opt,name=lessonStartTime,proto3" json:"lessonStartTime,omitempty"`
+	GroupStartDate  string   `protobuf:"bytes,8,opt,name=groupStartDate,proto3" json:"groupStartDate,omitempty"`
+	GroupEndDate    string   `protobuf:"bytes,9,opt,name=groupEndDate,proto3" json:"groupEndDate,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *CheckScheduleConflictsRequest) Reset() {
+	*x = CheckScheduleConflictsRequest{}
+	mi := &file_education_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckScheduleConflictsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckScheduleConflictsRequest) ProtoMessage() {}
+
+func (x *CheckScheduleConflictsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckScheduleConflictsRequest.ProtoReflect.Descriptor instead.
+func (*CheckScheduleConflictsRequest) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *CheckScheduleConflictsRequest) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+func (x *CheckScheduleConflictsRequest) GetCourseId() int32 {
+	if x != nil {
+		return x.CourseId
+	}
+	return 0
+}
+
+func (x *CheckScheduleConflictsRequest) GetTeacherId() string {
+	if x != nil {
+		return x.TeacherId
+	}
+	return ""
+}
+
+func (x *CheckScheduleConflictsRequest) GetRoomId() int32 {
+	if x != nil {
+		return x.RoomId
+	}
+	return 0
+}
+
+func (x *CheckScheduleConflictsRequest) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *CheckScheduleConflictsRequest) GetDays() []string {
+	if x != nil {
+		return x.Days
+	}
+	return nil
+}
+
+func (x *CheckScheduleConflictsRequest) GetLessonStartTime() string {
+	if x != nil {
+		return x.LessonStartTime
+	}
+	return ""
+}
+
+func (x *CheckScheduleConflictsRequest) GetGroupStartDate() string {
+	if x != nil {
+		return x.GroupStartDate
+	}
+	return ""
+}
+
+func (x *CheckScheduleConflictsRequest) GetGroupEndDate() string {
+	if x != nil {
+		return x.GroupEndDate
+	}
+	return ""
+}
+
+type GetScheduleConflictOverridesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	From          string                 `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	Till          string                 `protobuf:"bytes,2,opt,name=till,proto3" json:"till,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetScheduleConflictOverridesRequest) Reset() {
+	*x = GetScheduleConflictOverridesRequest{}
+	mi := &file_education_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetScheduleConflictOverridesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetScheduleConflictOverridesRequest) ProtoMessage() {}
+
+func (x *GetScheduleConflictOverridesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetScheduleConflictOverridesRequest.ProtoReflect.Descriptor instead.
+func (*GetScheduleConflictOverridesRequest) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *GetScheduleConflictOverridesRequest) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *GetScheduleConflictOverridesRequest) GetTill() string {
+	if x != nil {
+		return x.Till
+	}
+	return ""
+}
+
+type ScheduleConflictOverride struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Id        string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	GroupId   int64                  `protobuf:"varint,2,opt,name=groupId,proto3" json:"groupId,omitempty"`
+	GroupName string                 `protobuf:"bytes,3,opt,name=groupName,proto3" json:"groupName,omitempty"`
+	// CREATE_GROUP, UPDATE_GROUP or TRANSFER_LESSON
+	Action        string              `protobuf:"bytes,4,opt,name=action,proto3" json:"action,omitempty"`
+	Conflicts     []*ScheduleConflict `protobuf:"bytes,5,rep,name=conflicts,proto3" json:"conflicts,omitempty"`
+	ForcedById    string              `protobuf:"bytes,6,opt,name=forcedById,proto3" json:"forcedById,omitempty"`
+	ForcedByName  string              `protobuf:"bytes,7,opt,name=forcedByName,proto3" json:"forcedByName,omitempty"`
+	CreatedAt     string              `protobuf:"bytes,8,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ScheduleConflictOverride) Reset() {
+	*x = ScheduleConflictOverride{}
+	mi := &file_education_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScheduleConflictOverride) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScheduleConflictOverride) ProtoMessage() {}
+
+func (x *ScheduleConflictOverride) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScheduleConflictOverride.ProtoReflect.Descriptor instead.
+func (*ScheduleConflictOverride) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *ScheduleConflictOverride) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ScheduleConflictOverride) GetGroupId() int64 {
+	if x != nil {
+		return x.GroupId
+	}
+	return 0
+}
+
+func (x *ScheduleConflictOverride) GetGroupName() string {
+	if x != nil {
+		return x.GroupName
+	}
+	return ""
+}
+
+func (x *ScheduleConflictOverride) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *ScheduleConflictOverride) GetConflicts() []*ScheduleConflict {
+	if x != nil {
+		return x.Conflicts
+	}
+	return nil
+}
+
+func (x *ScheduleConflictOverride) GetForcedById() string {
+	if x != nil {
+		return x.ForcedById
+	}
+	return ""
+}
+
+func (x *ScheduleConflictOverride) GetForcedByName() string {
+	if x != nil {
+		return x.ForcedByName
+	}
+	return ""
+}
+
+func (x *ScheduleConflictOverride) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+type GetScheduleConflictOverridesResponse struct {
+	state         protoimpl.MessageState      `protogen:"open.v1"`
+	Overrides     []*ScheduleConflictOverride `protobuf:"bytes,1,rep,name=overrides,proto3" json:"overrides,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetScheduleConflictOverridesResponse) Reset() {
+	*x = GetScheduleConflictOverridesResponse{}
+	mi := &file_education_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetScheduleConflictOverridesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetScheduleConflictOverridesResponse) ProtoMessage() {}
+
+func (x *GetScheduleConflictOverridesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetScheduleConflictOverridesResponse.ProtoReflect.Descriptor instead.
+func (*GetScheduleConflictOverridesResponse) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *GetScheduleConflictOverridesResponse) GetOverrides() []*ScheduleConflictOverride {
+	if x != nil {
+		return x.Overrides
+	}
+	return nil
+}
+
+type CalculateTeacherSalaryRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	From      string                 `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	To        string                 `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+	TeacherId string                 `protobuf:"bytes,3,opt,name=teacherId,proto3" json:"teacherId,omitempty"`
+	// calculates several teachers in one call, teacherId is ignored when set
+	TeacherIds    []string `protobuf:"bytes,4,rep,name=teacherIds,proto3" json:"teacherIds,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CalculateTeacherSalaryRequest) Reset() {
+	*x = CalculateTeacherSalaryRequest{}
+	mi := &file_education_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CalculateTeacherSalaryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CalculateTeacherSalaryRequest) ProtoMessage() {}
+
+func (x *CalculateTeacherSalaryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CalculateTeacherSalaryRequest.ProtoReflect.Descriptor instead.
+func (*CalculateTeacherSalaryRequest) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *CalculateTeacherSalaryRequest) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *CalculateTeacherSalaryRequest) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+func (x *CalculateTeacherSalaryRequest) GetTeacherId() string {
+	if x != nil {
+		return x.TeacherId
+	}
+	return ""
+}
+
+func (x *CalculateTeacherSalaryRequest) GetTeacherIds() []string {
+	if x != nil {
+		return x.TeacherIds
+	}
+	return nil
+}
+
+type CalculateTeacherSalaryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Salaries      []*AbsCalculateSalary  `protobuf:"bytes,1,rep,name=salaries,proto3" json:"salaries,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CalculateTeacherSalaryResponse) Reset() {
+	*x = CalculateTeacherSalaryResponse{}
+	mi := &file_education_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CalculateTeacherSalaryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CalculateTeacherSalaryResponse) ProtoMessage() {}
+
+func (x *CalculateTeacherSalaryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CalculateTeacherSalaryResponse.ProtoReflect.Descriptor instead.
+func (*CalculateTeacherSalaryResponse) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *CalculateTeacherSalaryResponse) GetSalaries() []*AbsCalculateSalary {
+	if x != nil {
+		return x.Salaries
+	}
+	return nil
+}
+
+type AbsCalculateSalary struct {
+	state                     protoimpl.MessageState `protogen:"open.v1"`
+	GroupId                   string                 `protobuf:"bytes,1,opt,name=groupId,proto3" json:"groupId,omitempty"`
+	GroupName                 string                 `protobuf:"bytes,2,opt,name=groupName,proto3" json:"groupName,omitempty"`
+	CommonLessonCountInPeriod int32                  `protobuf:"varint,3,opt,name=commonLessonCountInPeriod,proto3" json:"commonLessonCountInPeriod,omitempty"`
+	Salaries                  []*StudentSalary       `protobuf:"bytes,4,rep,name=salaries,proto3" json:"salaries,omitempty"`
+	TeacherId                 string                 `protobuf:"bytes,5,opt,name=teacherId,proto3" json:"teacherId,omitempty"`
+	unknownFields             protoimpl.UnknownFields
+	sizeCache                 protoimpl.SizeCache
+}
+
+func (x *AbsCalculateSalary) Reset() {
+	*x = AbsCalculateSalary{}
+	mi := &file_education_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AbsCalculateSalary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AbsCalculateSalary) ProtoMessage() {}
+
+func (x *AbsCalculateSalary) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AbsCalculateSalary.ProtoReflect.Descriptor instead.
+func (*AbsCalculateSalary) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *AbsCalculateSalary) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+func (x *AbsCalculateSalary) GetGroupName() string {
+	if x != nil {
+		return x.GroupName
+	}
+	return ""
+}
+
+func (x *AbsCalculateSalary) GetCommonLessonCountInPeriod() int32 {
+	if x != nil {
+		return x.CommonLessonCountInPeriod
+	}
+	return 0
+}
+
+func (x *AbsCalculateSalary) GetSalaries() []*StudentSalary {
+	if x != nil {
+		return x.Salaries
+	}
+	return nil
+}
+
+func (x *AbsCalculateSalary) GetTeacherId() string {
+	if x != nil {
+		return x.TeacherId
+	}
+	return ""
+}
+
+type StudentSalary struct {
+	state                    protoimpl.MessageState `protogen:"open.v1"`
+	StudentId                string                 `protobuf:"bytes,1,opt,name=studentId,proto3" json:"studentId,omitempty"`
+	StudentName              string                 `protobuf:"bytes,2,opt,name=studentName,proto3" json:"studentName,omitempty"`
+	PassedLessonCount        int32                  `protobuf:"varint,3,opt,name=passedLessonCount,proto3" json:"passedLessonCount,omitempty"`
+	CalculatedSalaryInPeriod int32                  `protobuf:"varint,4,opt,name=calculatedSalaryInPeriod,proto3" json:"calculatedSalaryInPeriod,omitempty"`
+	PriceType                string                 `protobuf:"bytes,5,opt,name=priceType,proto3" json:"priceType,omitempty"`
+	TotalCount               float64                `protobuf:"fixed64,6,opt,name=totalCount,proto3" json:"totalCount,omitempty"`
+	CoursePrice              float64                `protobuf:"fixed64,7,opt,name=coursePrice,proto3" json:"coursePrice,omitempty"`
+	unknownFields            protoimpl.UnknownFields
+	sizeCache                protoimpl.SizeCache
+}
+
+func (x *StudentSalary) Reset() {
+	*x = StudentSalary{}
+	mi := &file_education_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StudentSalary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StudentSalary) ProtoMessage() {}
+
+func (x *StudentSalary) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StudentSalary.ProtoReflect.Descriptor instead.
+func (*StudentSalary) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *StudentSalary) GetStudentId() string {
+	if x != nil {
+		return x.StudentId
+	}
+	return ""
+}
+
+func (x *StudentSalary) GetStudentName() string {
+	if x != nil {
+		return x.StudentName
+	}
+	return ""
+}
+
+func (x *StudentSalary) GetPassedLessonCount() int32 {
+	if x != nil {
+		return x.PassedLessonCount
+	}
+	return 0
+}
+
+func (x *StudentSalary) GetCalculatedSalaryInPeriod() int32 {
+	if x != nil {
+		return x.CalculatedSalaryInPeriod
+	}
+	return 0
+}
+
+func (x *StudentSalary) GetPriceType() string {
+	if x != nil {
+		return x.PriceType
+	}
+	return ""
+}
+
+func (x *StudentSalary) GetTotalCount() float64 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+func (x *StudentSalary) GetCoursePrice() float64 {
+	if x != nil {
+		return x.CoursePrice
+	}
+	return 0
+}
+
+type GetAttendanceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GroupId       string                 `protobuf:"bytes,1,opt,name=groupId,proto3" json:"groupId,omitempty"`
+	From          string                 `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
+	Till          string                 `protobuf:"bytes,3,opt,name=till,proto3" json:"till,omitempty"`
+	WithOutdated  bool                   `protobuf:"varint,4,opt,name=withOutdated,proto3" json:"withOutdated,omitempty"`
+	ActionId      string                 `protobuf:"bytes,5,opt,name=actionId,proto3" json:"actionId,omitempty"`
+	ActionRole    string                 `protobuf:"bytes,6,opt,name=actionRole,proto3" json:"actionRole,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAttendanceRequest) Reset() {
+	*x = GetAttendanceRequest{}
+	mi := &file_education_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAttendanceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAttendanceRequest) ProtoMessage() {}
+
+func (x *GetAttendanceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAttendanceRequest.ProtoReflect.Descriptor instead.
+func (*GetAttendanceRequest) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *GetAttendanceRequest) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+func (x *GetAttendanceRequest) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *GetAttendanceRequest) GetTill() string {
+	if x != nil {
+		return x.Till
+	}
+	return ""
+}
+
+func (x *GetAttendanceRequest) GetWithOutdated() bool {
+	if x != nil {
+		return x.WithOutdated
+	}
+	return false
+}
+
+func (x *GetAttendanceRequest) GetActionId() string {
+	if x != nil {
+		return x.ActionId
+	}
+	return ""
+}
+
+func (x *GetAttendanceRequest) GetActionRole() string {
+	if x != nil {
+		return x.ActionRole
+	}
+	return ""
+}
+
+type GetAttendanceResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Days          []*Day                 `protobuf:"bytes,1,rep,name=days,proto3" json:"days,omitempty"`
+	Students      []*Student             `protobuf:"bytes,2,rep,name=students,proto3" json:"students,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAttendanceResponse) Reset() {
+	*x = GetAttendanceResponse{}
+	mi := &file_education_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAttendanceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAttendanceResponse) ProtoMessage() {}
+
+func (x *GetAttendanceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAttendanceResponse.ProtoReflect.Descriptor instead.
+func (*GetAttendanceResponse) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *GetAttendanceResponse) GetDays() []*Day {
+	if x != nil {
+		return x.Days
+	}
+	return nil
+}
+
+func (x *GetAttendanceResponse) GetStudents() []*Student {
+	if x != nil {
+		return x.Students
+	}
+	return nil
+}
+
+type Day struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Date          string                 `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"`
+	TransferDate  string                 `protobuf:"bytes,2,opt,name=transferDate,proto3" json:"transferDate,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Day) Reset() {
+	*x = Day{}
+	mi := &file_education_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Day) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Day) ProtoMessage() {}
+
+func (x *Day) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Day.ProtoReflect.Descriptor instead.
+func (*Day) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *Day) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *Day) GetTransferDate() string {
+	if x != nil {
+		return x.TransferDate
+	}
+	return ""
+}
+
+type Student struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,9,opt,name=id,proto3" json:"id,omitempty"`
+	ActivatedAt   string                 `protobuf:"bytes,1,opt,name=activatedAt,proto3" json:"activatedAt,omitempty"`
+	AddedAt       string                 `protobuf:"bytes,2,opt,name=addedAt,proto3" json:"addedAt,omitempty"`
+	Attendance    []*Attendance          `protobuf:"bytes,3,rep,name=attendance,proto3" json:"attendance,omitempty"`
+	Balance       float64                `protobuf:"fixed64,4,opt,name=balance,proto3" json:"balance,omitempty"`
+	CreatedAt     string                 `protobuf:"bytes,5,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	DateOfBirth   string                 `protobuf:"bytes,6,opt,name=dateOfBirth,proto3" json:"dateOfBirth,omitempty"`
+	FreezeDetail  *FreezeDetail          `protobuf:"bytes,7,opt,name=freezeDetail,proto3" json:"freezeDetail,omitempty"`
+	Gender        bool                   `protobuf:"varint,8,opt,name=gender,proto3" json:"gender,omitempty"`
+	Condition     string                 `protobuf:"bytes,10,opt,name=condition,proto3" json:"condition,omitempty"`
+	Name          string                 `protobuf:"bytes,11,opt,name=name,proto3" json:"name,omitempty"`
+	Phone         string                 `protobuf:"bytes,12,opt,name=phone,proto3" json:"phone,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Student) Reset() {
+	*x = Student{}
+	mi := &file_education_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Student) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Student) ProtoMessage() {}
+
+func (x *Student) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Student.ProtoReflect.Descriptor instead.
+func (*Student) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *Student) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Student) GetActivatedAt() string {
+	if x != nil {
+		return x.ActivatedAt
+	}
+	return ""
+}
+
+func (x *Student) GetAddedAt() string {
+	if x != nil {
+		return x.AddedAt
+	}
+	return ""
+}
+
+func (x *Student) GetAttendance() []*Attendance {
+	if x != nil {
+		return x.Attendance
+	}
+	return nil
+}
+
+func (x *Student) GetBalance() float64 {
+	if x != nil {
+		return x.Balance
+	}
+	return 0
+}
+
+func (x *Student) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *Student) GetDateOfBirth() string {
+	if x != nil {
+		return x.DateOfBirth
+	}
+	return ""
+}
+
+func (x *Student) GetFreezeDetail() *FreezeDetail {
+	if x != nil {
+		return x.FreezeDetail
+	}
+	return nil
+}
+
+func (x *Student) GetGender() bool {
+	if x != nil {
+		return x.Gender
+	}
+	return false
+}
+
+func (x *Student) GetCondition() string {
+	if x != nil {
+		return x.Condition
+	}
+	return ""
+}
+
+func (x *Student) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Student) GetPhone() string {
+	if x != nil {
+		return x.Phone
+	}
+	return ""
+}
+
+type Attendance struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	Id         string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	AttendDate string                 `protobuf:"bytes,2,opt,name=attend_date,json=attendDate,proto3" json:"attend_date,omitempty"`
+	// the status is billable
+	IsCome     bool   `protobuf:"varint,3,opt,name=isCome,proto3" json:"isCome,omitempty"`
+	StudentId  string `protobuf:"bytes,4,opt,name=studentId,proto3" json:"studentId,omitempty"`
+	TeacherId  string `protobuf:"bytes,5,opt,name=teacherId,proto3" json:"teacherId,omitempty"`
+	Status     int32  `protobuf:"varint,6,opt,name=status,proto3" json:"status,omitempty"`
+	StatusName string `protobuf:"bytes,7,opt,name=statusName,proto3" json:"statusName,omitempty"`
+	// the absence a makeup attendance makes up
+	MakeupGroupId string `protobuf:"bytes,8,opt,name=makeupGroupId,proto3" json:"makeupGroupId,omitempty"`
+	MakeupDate    string `protobuf:"bytes,9,opt,name=makeupDate,proto3" json:"makeupDate,omitempty"`
+	// the student checked in by scanning the code of the lesson
+	SelfCheckIn bool `protobuf:"varint,10,opt,name=selfCheckIn,proto3" json:"selfCheckIn,omitempty"`
+	// a check in is neither billed nor paid until the teacher confirms it
+	Confirmed     bool `protobuf:"varint,11,opt,name=confirmed,proto3" json:"confirmed,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Attendance) Reset() {
+	*x = Attendance{}
+	mi := &file_education_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Attendance) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Attendance) ProtoMessage() {}
+
+func (x *Attendance) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Attendance.ProtoReflect.Descriptor instead.
+func (*Attendance) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *Attendance) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Attendance) GetAttendDate() string {
+	if x != nil {
+		return x.AttendDate
+	}
+	return ""
+}
+
+func (x *Attendance) GetIsCome() bool {
+	if x != nil {
+		return x.IsCome
+	}
+	return false
+}
+
+func (x *Attendance) GetStudentId() string {
+	if x != nil {
+		return x.StudentId
+	}
+	return ""
+}
+
+func (x *Attendance) GetTeacherId() string {
+	if x != nil {
+		return x.TeacherId
+	}
+	return ""
+}
+
+func (x *Attendance) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *Attendance) GetStatusName() string {
+	if x != nil {
+		return x.StatusName
+	}
+	return ""
+}
+
+func (x *Attendance) GetMakeupGroupId() string {
+	if x != nil {
+		return x.MakeupGroupId
+	}
+	return ""
+}
+
+func (x *Attendance) GetMakeupDate() string {
+	if x != nil {
+		return x.MakeupDate
+	}
+	return ""
+}
+
+func (x *Attendance) GetSelfCheckIn() bool {
+	if x != nil {
+		return x.SelfCheckIn
+	}
+	return false
+}
+
+func (x *Attendance) GetConfirmed() bool {
+	if x != nil {
+		return x.Confirmed
+	}
+	return false
+}
+
+type FreezeDetail struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Reason        string                 `protobuf:"bytes,1,opt,name=reason,proto3" json:"reason,omitempty"`
+	TillDate      string                 `protobuf:"bytes,2,opt,name=till_date,json=tillDate,proto3" json:"till_date,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FreezeDetail) Reset() {
+	*x = FreezeDetail{}
+	mi := &file_education_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FreezeDetail) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FreezeDetail) ProtoMessage() {}
+
+func (x *FreezeDetail) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FreezeDetail.ProtoReflect.Descriptor instead.
+func (*FreezeDetail) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *FreezeDetail) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *FreezeDetail) GetTillDate() string {
+	if x != nil {
+		return x.TillDate
+	}
+	return ""
+}
+
+type SetAttendanceRequest struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	AttendDate   string                 `protobuf:"bytes,1,opt,name=attendDate,proto3" json:"attendDate,omitempty"`
+	GroupId      string                 `protobuf:"bytes,2,opt,name=groupId,proto3" json:"groupId,omitempty"`
+	Status       int32                  `protobuf:"varint,3,opt,name=status,proto3" json:"status,omitempty"`
+	StudentId    string                 `protobuf:"bytes,4,opt,name=studentId,proto3" json:"studentId,omitempty"`
+	TeacherId    string                 `protobuf:"bytes,5,opt,name=teacherId,proto3" json:"teacherId,omitempty"`
+	ActionById   string                 `protobuf:"bytes,6,opt,name=actionById,proto3" json:"actionById,omitempty"`
+	ActionByRole string                 `protobuf:"bytes,7,opt,name=actionByRole,proto3" json:"actionByRole,omitempty"`
+	// group and yyyy-MM-dd date of the absence a makeup status makes up
+	MakeupGroupId string `protobuf:"bytes,8,opt,name=makeupGroupId,proto3" json:"makeupGroupId,omitempty"`
+	MakeupDate    string `protobuf:"bytes,9,opt,name=makeupDate,proto3" json:"makeupDate,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetAttendanceRequest) Reset() {
+	*x = SetAttendanceRequest{}
+	mi := &file_education_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetAttendanceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetAttendanceRequest) ProtoMessage() {}
+
+func (x *SetAttendanceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetAttendanceRequest.ProtoReflect.Descriptor instead.
+func (*SetAttendanceRequest) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *SetAttendanceRequest) GetAttendDate() string {
+	if x != nil {
+		return x.AttendDate
+	}
+	return ""
+}
+
+func (x *SetAttendanceRequest) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+func (x *SetAttendanceRequest) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *SetAttendanceRequest) GetStudentId() string {
+	if x != nil {
+		return x.StudentId
+	}
+	return ""
+}
+
+func (x *SetAttendanceRequest) GetTeacherId() string {
+	if x != nil {
+		return x.TeacherId
+	}
+	return ""
+}
+
+func (x *SetAttendanceRequest) GetActionById() string {
+	if x != nil {
+		return x.ActionById
+	}
+	return ""
+}
+
+func (x *SetAttendanceRequest) GetActionByRole() string {
+	if x != nil {
+		return x.ActionByRole
+	}
+	return ""
+}
+
+func (x *SetAttendanceRequest) GetMakeupGroupId() string {
+	if x != nil {
+		return x.MakeupGroupId
+	}
+	return ""
+}
+
+func (x *SetAttendanceRequest) GetMakeupDate() string {
+	if x != nil {
+		return x.MakeupDate
+	}
+	return ""
+}
+
+// SetGroupAttendanceRequest marks the roster of a group for one date
+type SetGroupAttendanceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AttendDate    string                 `protobuf:"bytes,1,opt,name=attendDate,proto3" json:"attendDate,omitempty"`
+	GroupId       string                 `protobuf:"bytes,2,opt,name=groupId,proto3" json:"groupId,omitempty"`
+	TeacherId     string                 `protobuf:"bytes,3,opt,name=teacherId,proto3" json:"teacherId,omitempty"`
+	Marks         []*GroupAttendanceMark `protobuf:"bytes,4,rep,name=marks,proto3" json:"marks,omitempty"`
+	ActionById    string                 `protobuf:"bytes,5,opt,name=actionById,proto3" json:"actionById,omitempty"`
+	ActionByRole  string                 `protobuf:"bytes,6,opt,name=actionByRole,proto3" json:"actionByRole,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetGroupAttendanceRequest) Reset() {
+	*x = SetGroupAttendanceRequest{}
+	mi := &file_education_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetGroupAttendanceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetGroupAttendanceRequest) ProtoMessage() {}
+
+func (x *SetGroupAttendanceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[71]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetGroupAttendanceRequest.ProtoReflect.Descriptor instead.
+func (*SetGroupAttendanceRequest) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *SetGroupAttendanceRequest) GetAttendDate() string {
+	if x != nil {
+		return x.AttendDate
+	}
+	return ""
+}
+
+func (x *SetGroupAttendanceRequest) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+func (x *SetGroupAttendanceRequest) GetTeacherId() string {
+	if x != nil {
+		return x.TeacherId
+	}
+	return ""
+}
+
+func (x *SetGroupAttendanceRequest) GetMarks() []*GroupAttendanceMark {
+	if x != nil {
+		return x.Marks
+	}
+	return nil
+}
+
+func (x *SetGroupAttendanceRequest) GetActionById() string {
+	if x != nil {
+		return x.ActionById
+	}
+	return ""
+}
+
+func (x *SetGroupAttendanceRequest) GetActionByRole() string {
+	if x != nil {
+		return x.ActionByRole
+	}
+	return ""
+}
+
+type GroupAttendanceMark struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	StudentId string                 `protobuf:"bytes,1,opt,name=studentId,proto3" json:"studentId,omitempty"`
+	// status as in SetAttendanceRequest, -1 deletes the attendance
+	Status int32 `protobuf:"varint,2,opt,name=status,proto3" json:"status,omitempty"`
+	// the absence a makeup status makes up, a student making up an absence need not be in the group
+	MakeupGroupId string `protobuf:"bytes,3,opt,name=makeupGroupId,proto3" json:"makeupGroupId,omitempty"`
+	MakeupDate    string `protobuf:"bytes,4,opt,name=makeupDate,proto3" json:"makeupDate,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GroupAttendanceMark) Reset() {
+	*x = GroupAttendanceMark{}
+	mi := &file_education_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GroupAttendanceMark) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GroupAttendanceMark) ProtoMessage() {}
+
+func (x *GroupAttendanceMark) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[72]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GroupAttendanceMark.ProtoReflect.Descriptor instead.
+func (*GroupAttendanceMark) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *GroupAttendanceMark) GetStudentId() string {
+	if x != nil {
+		return x.StudentId
+	}
+	return ""
+}
+
+func (x *GroupAttendanceMark) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *GroupAttendanceMark) GetMakeupGroupId() string {
+	if x != nil {
+		return x.MakeupGroupId
+	}
+	return ""
+}
+
+func (x *GroupAttendanceMark) GetMakeupDate() string {
+	if x != nil {
+		return x.MakeupDate
+	}
+	return ""
+}
+
+type GroupAttendanceResult struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StudentId     string                 `protobuf:"bytes,1,opt,name=studentId,proto3" json:"studentId,omitempty"`
+	Status        int32                  `protobuf:"varint,2,opt,name=status,proto3" json:"status,omitempty"`
+	Success       bool                   `protobuf:"varint,3,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GroupAttendanceResult) Reset() {
+	*x = GroupAttendanceResult{}
+	mi := &file_education_proto_msgTypes[73]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GroupAttendanceResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GroupAttendanceResult) ProtoMessage() {}
+
+func (x *GroupAttendanceResult) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[73]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GroupAttendanceResult.ProtoReflect.Descriptor instead.
+func (*GroupAttendanceResult) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *GroupAttendanceResult) GetStudentId() string {
+	if x != nil {
+		return x.StudentId
+	}
+	return ""
+}
+
+func (x *GroupAttendanceResult) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *GroupAttendanceResult) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *GroupAttendanceResult) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type SetGroupAttendanceResponse struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	Results       []*GroupAttendanceResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	Succeeded     int32                    `protobuf:"varint,2,opt,name=succeeded,proto3" json:"succeeded,omitempty"`
+	Failed        int32                    `protobuf:"varint,3,opt,name=failed,proto3" json:"failed,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetGroupAttendanceResponse) Reset() {
+	*x = SetGroupAttendanceResponse{}
+	mi := &file_education_proto_msgTypes[74]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetGroupAttendanceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetGroupAttendanceResponse) ProtoMessage() {}
+
+func (x *SetGroupAttendanceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[74]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetGroupAttendanceResponse.ProtoReflect.Descriptor instead.
+func (*SetGroupAttendanceResponse) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *SetGroupAttendanceResponse) GetResults() []*GroupAttendanceResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+func (x *SetGroupAttendanceResponse) GetSucceeded() int32 {
+	if x != nil {
+		return x.Succeeded
+	}
+	return 0
+}
+
+func (x *SetGroupAttendanceResponse) GetFailed() int32 {
+	if x != nil {
+		return x.Failed
+	}
+	return 0
+}
+
+type AbsAttendanceStatus struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// the value marked as status of an attendance, assigned when the status is created
+	Code int32  `protobuf:"varint,2,opt,name=code,proto3" json:"code,omitempty"`
+	Name string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	// charges the lesson price or a package credit in groups not billed monthly
+	Billable bool `protobuf:"varint,4,opt,name=billable,proto3" json:"billable,omitempty"`
+	// counts the student in the salary of the teacher of the lesson
+	PaysTeacher bool `protobuf:"varint,5,opt,name=paysTeacher,proto3" json:"paysTeacher,omitempty"`
+	// sends the NOT_PARTICIPATE_ALERT sms
+	AbsenceSms bool `protobuf:"varint,6,opt,name=absenceSms,proto3" json:"absenceSms,omitempty"`
+	// attending a lesson to make up an absence, the mark names the absence
+	IsMakeup bool `protobuf:"varint,7,opt,name=isMakeup,proto3" json:"isMakeup,omitempty"`
+	// preloaded status, it can be changed or switched off but not deleted
+	IsDefault     bool `protobuf:"varint,8,opt,name=isDefault,proto3" json:"isDefault,omitempty"`
+	IsActive      bool `protobuf:"varint,9,opt,name=isActive,proto3" json:"isActive,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AbsAttendanceStatus) Reset() {
+	*x = AbsAttendanceStatus{}
+	mi := &file_education_proto_msgTypes[75]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AbsAttendanceStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AbsAttendanceStatus) ProtoMessage() {}
+
+func (x *AbsAttendanceStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[75]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AbsAttendanceStatus.ProtoReflect.Descriptor instead.
+func (*AbsAttendanceStatus) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *AbsAttendanceStatus) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *AbsAttendanceStatus) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *AbsAttendanceStatus) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *AbsAttendanceStatus) GetBillable() bool {
+	if x != nil {
+		return x.Billable
+	}
+	return false
+}
+
+func (x *AbsAttendanceStatus) GetPaysTeacher() bool {
+	if x != nil {
+		return x.PaysTeacher
+	}
+	return false
+}
+
+func (x *AbsAttendanceStatus) GetAbsenceSms() bool {
+	if x != nil {
+		return x.AbsenceSms
+	}
+	return false
+}
+
+func (x *AbsAttendanceStatus) GetIsMakeup() bool {
+	if x != nil {
+		return x.IsMakeup
+	}
+	return false
+}
+
+func (x *AbsAttendanceStatus) GetIsDefault() bool {
+	if x != nil {
+		return x.IsDefault
+	}
+	return false
+}
+
+func (x *AbsAttendanceStatus) GetIsActive() bool {
+	if x != nil {
+		return x.IsActive
+	}
+	return false
+}
+
+type GetAttendanceStatusesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	WithInactive  bool                   `protobuf:"varint,1,opt,name=withInactive,proto3" json:"withInactive,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAttendanceStatusesRequest) Reset() {
+	*x = GetAttendanceStatusesRequest{}
+	mi := &file_education_proto_msgTypes[76]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAttendanceStatusesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAttendanceStatusesRequest) ProtoMessage() {}
+
+func (x *GetAttendanceStatusesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[76]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAttendanceStatusesRequest.ProtoReflect.Descriptor instead.
+func (*GetAttendanceStatusesRequest) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *GetAttendanceStatusesRequest) GetWithInactive() bool {
+	if x != nil {
+		return x.WithInactive
+	}
+	return false
+}
+
+type GetAttendanceStatusesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Statuses      []*AbsAttendanceStatus `protobuf:"bytes,1,rep,name=statuses,proto3" json:"statuses,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAttendanceStatusesResponse) Reset() {
+	*x = GetAttendanceStatusesResponse{}
+	mi := &file_education_proto_msgTypes[77]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAttendanceStatusesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAttendanceStatusesResponse) ProtoMessage() {}
+
+func (x *GetAttendanceStatusesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[77]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAttendanceStatusesResponse.ProtoReflect.Descriptor instead.
+func (*GetAttendanceStatusesResponse) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *GetAttendanceStatusesResponse) GetStatuses() []*AbsAttendanceStatus {
+	if x != nil {
+		return x.Statuses
+	}
+	return nil
+}
+
+// AttendancePolicy is when teachers may mark attendance, roles in overrideRoles may mark any date
+type AttendancePolicy struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// days before today a teacher may still mark, 0 allows today only
+	EditDaysBack int32 `protobuf:"varint,1,opt,name=editDaysBack,proto3" json:"editDaysBack,omitempty"`
+	// hour (0-24) of the day after the edit window at which the oldest editable day closes, 24 closes it at midnight
+	CutoffHour int32 `protobuf:"varint,2,opt,name=cutoffHour,proto3" json:"cutoffHour,omitempty"`
+	// IANA timezone the days and the cutoff hour are counted in
+	Timezone      string   `protobuf:"bytes,3,opt,name=timezone,proto3" json:"timezone,omitempty"`
+	AllowFuture   bool     `protobuf:"varint,4,opt,name=allowFuture,proto3" json:"allowFuture,omitempty"`
+	OverrideRoles []string `protobuf:"bytes,5,rep,name=overrideRoles,proto3" json:"overrideRoles,omitempty"`
+	ActionById    string   `protobuf:"bytes,6,opt,name=actionById,proto3" json:"actionById,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AttendancePolicy) Reset() {
+	*x = AttendancePolicy{}
+	mi := &file_education_proto_msgTypes[78]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AttendancePolicy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AttendancePolicy) ProtoMessage() {}
+
+func (x *AttendancePolicy) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[78]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AttendancePolicy.ProtoReflect.Descriptor instead.
+func (*AttendancePolicy) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *AttendancePolicy) GetEditDaysBack() int32 {
+	if x != nil {
+		return x.EditDaysBack
+	}
+	return 0
+}
+
+func (x *AttendancePolicy) GetCutoffHour() int32 {
+	if x != nil {
+		return x.CutoffHour
+	}
+	return 0
+}
+
+func (x *AttendancePolicy) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+func (x *AttendancePolicy) GetAllowFuture() bool {
+	if x != nil {
+		return x.AllowFuture
+	}
+	return false
+}
+
+func (x *AttendancePolicy) GetOverrideRoles() []string {
+	if x != nil {
+		return x.OverrideRoles
+	}
+	return nil
+}
+
+func (x *AttendancePolicy) GetActionById() string {
+	if x != nil {
+		return x.ActionById
+	}
+	return ""
+}
+
+type RequestAttendanceUnlockRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	GroupId string                 `protobuf:"bytes,1,opt,name=groupId,proto3" json:"groupId,omitempty"`
+	// yyyy-MM-dd date the teacher can no longer mark
+	AttendDate    string `protobuf:"bytes,2,opt,name=attendDate,proto3" json:"attendDate,omitempty"`
+	Reason        string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	ActionById    string `protobuf:"bytes,4,opt,name=actionById,proto3" json:"actionById,omitempty"`
+	ActionByName  string `protobuf:"bytes,5,opt,name=actionByName,proto3" json:"actionByName,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RequestAttendanceUnlockRequest) Reset() {
+	*x = RequestAttendanceUnlockRequest{}
+	mi := &file_education_proto_msgTypes[79]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RequestAttendanceUnlockRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestAttendanceUnlockRequest) ProtoMessage() {}
+
+func (x *RequestAttendanceUnlockRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[79]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestAttendanceUnlockRequest.ProtoReflect.Descriptor instead.
+func (*RequestAttendanceUnlockRequest) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *RequestAttendanceUnlockRequest) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+func (x *RequestAttendanceUnlockRequest) GetAttendDate() string {
+	if x != nil {
+		return x.AttendDate
+	}
+	return ""
+}
+
+func (x *RequestAttendanceUnlockRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *RequestAttendanceUnlockRequest) GetActionById() string {
+	if x != nil {
+		return x.ActionById
+	}
+	return ""
+}
+
+func (x *RequestAttendanceUnlockRequest) GetActionByName() string {
+	if x != nil {
+		return x.ActionByName
+	}
+	return ""
+}
+
+type AttendanceUnlockRequest struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Id          string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	GroupId     int64                  `protobuf:"varint,2,opt,name=groupId,proto3" json:"groupId,omitempty"`
+	GroupName   string                 `protobuf:"bytes,3,opt,name=groupName,proto3" json:"groupName,omitempty"`
+	AttendDate  string                 `protobuf:"bytes,4,opt,name=attendDate,proto3" json:"attendDate,omitempty"`
+	TeacherId   string                 `protobuf:"bytes,5,opt,name=teacherId,proto3" json:"teacherId,omitempty"`
+	TeacherName string                 `protobuf:"bytes,6,opt,name=teacherName,proto3" json:"teacherName,omitempty"`
+	Reason      string                 `protobuf:"bytes,7,opt,name=reason,proto3" json:"reason,omitempty"`
+	// PENDING, APPROVED or REJECTED
+	Status         string `protobuf:"bytes,8,opt,name=status,proto3" json:"status,omitempty"`
+	ReviewedByName string `protobuf:"bytes,9,opt,name=reviewedByName,proto3" json:"reviewedByName,omitempty"`
+	ReviewedAt     string `protobuf:"bytes,10,opt,name=reviewedAt,proto3" json:"reviewedAt,omitempty"`
+	// the date is open for the teacher until then
+	UnlockedUntil string `protobuf:"bytes,11,opt,name=unlockedUntil,proto3" json:"unlockedUntil,omitempty"`
+	CreatedAt     string `protobuf:"bytes,12,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AttendanceUnlockRequest) Reset() {
+	*x = AttendanceUnlockRequest{}
+	mi := &file_education_proto_msgTypes[80]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AttendanceUnlockRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AttendanceUnlockRequest) ProtoMessage() {}
+
+func (x *AttendanceUnlockRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[80]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AttendanceUnlockRequest.ProtoReflect.Descriptor instead.
+func (*AttendanceUnlockRequest) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *AttendanceUnlockRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *AttendanceUnlockRequest) GetGroupId() int64 {
+	if x != nil {
+		return x.GroupId
+	}
+	return 0
+}
+
+func (x *AttendanceUnlockRequest) GetGroupName() string {
+	if x != nil {
+		return x.GroupName
+	}
+	return ""
+}
+
+func (x *AttendanceUnlockRequest) GetAttendDate() string {
+	if x != nil {
+		return x.AttendDate
+	}
+	return ""
+}
+
+func (x *AttendanceUnlockRequest) GetTeacherId() string {
+	if x != nil {
+		return x.TeacherId
+	}
+	return ""
+}
+
+func (x *AttendanceUnlockRequest) GetTeacherName() string {
+	if x != nil {
+		return x.TeacherName
+	}
+	return ""
+}
+
+func (x *AttendanceUnlockRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *AttendanceUnlockRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *AttendanceUnlockRequest) GetReviewedByName() string {
+	if x != nil {
+		return x.ReviewedByName
+	}
+	return ""
+}
+
+func (x *AttendanceUnlockRequest) GetReviewedAt() string {
+	if x != nil {
+		return x.ReviewedAt
+	}
+	return ""
+}
+
+func (x *AttendanceUnlockRequest) GetUnlockedUntil() string {
+	if x != nil {
+		return x.UnlockedUntil
+	}
+	return ""
+}
+
+func (x *AttendanceUnlockRequest) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+type GetAttendanceUnlockRequestsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// all statuses when empty
+	Status string `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	// a teacher only sees the requests of its own
+	ActionById    string `protobuf:"bytes,2,opt,name=actionById,proto3" json:"actionById,omitempty"`
+	ActionByRole  string `protobuf:"bytes,3,opt,name=actionByRole,proto3" json:"actionByRole,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAttendanceUnlockRequestsRequest) Reset() {
+	*x = GetAttendanceUnlockRequestsRequest{}
+	mi := &file_education_proto_msgTypes[81]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAttendanceUnlockRequestsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAttendanceUnlockRequestsRequest) ProtoMessage() {}
+
+func (x *GetAttendanceUnlockRequestsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[81]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAttendanceUnlockRequestsRequest.ProtoReflect.Descriptor instead.
+func (*GetAttendanceUnlockRequestsRequest) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *GetAttendanceUnlockRequestsRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *GetAttendanceUnlockRequestsRequest) GetActionById() string {
+	if x != nil {
+		return x.ActionById
+	}
+	return ""
+}
+
+func (x *GetAttendanceUnlockRequestsRequest) GetActionByRole() string {
+	if x != nil {
+		return x.ActionByRole
+	}
+	return ""
+}
+
+type GetAttendanceUnlockRequestsResponse struct {
+	state         protoimpl.MessageState     `protogen:"open.v1"`
+	Requests      []*AttendanceUnlockRequest `protobuf:"bytes,1,rep,name=requests,proto3" json:"requests,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAttendanceUnlockRequestsResponse) Reset() {
+	*x = GetAttendanceUnlockRequestsResponse{}
+	mi := &file_education_proto_msgTypes[82]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAttendanceUnlockRequestsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAttendanceUnlockRequestsResponse) ProtoMessage() {}
+
+func (x *GetAttendanceUnlockRequestsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[82]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAttendanceUnlockRequestsResponse.ProtoReflect.Descriptor instead.
+func (*GetAttendanceUnlockRequestsResponse) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *GetAttendanceUnlockRequestsResponse) GetRequests() []*AttendanceUnlockRequest {
+	if x != nil {
+		return x.Requests
+	}
+	return nil
+}
+
+type ReviewAttendanceUnlockRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Id      string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Approve bool                   `protobuf:"varint,2,opt,name=approve,proto3" json:"approve,omitempty"`
+	// hours the date stays open after approval, 24 when 0
+	Hours         int32  `protobuf:"varint,3,opt,name=hours,proto3" json:"hours,omitempty"`
+	ActionById    string `protobuf:"bytes,4,opt,name=actionById,proto3" json:"actionById,omitempty"`
+	ActionByName  string `protobuf:"bytes,5,opt,name=actionByName,proto3" json:"actionByName,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReviewAttendanceUnlockRequest) Reset() {
+	*x = ReviewAttendanceUnlockRequest{}
+	mi := &file_education_proto_msgTypes[83]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReviewAttendanceUnlockRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReviewAttendanceUnlockRequest) ProtoMessage() {}
+
+func (x *ReviewAttendanceUnlockRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[83]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReviewAttendanceUnlockRequest.ProtoReflect.Descriptor instead.
+func (*ReviewAttendanceUnlockRequest) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *ReviewAttendanceUnlockRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ReviewAttendanceUnlockRequest) GetApprove() bool {
+	if x != nil {
+		return x.Approve
+	}
+	return false
+}
+
+func (x *ReviewAttendanceUnlockRequest) GetHours() int32 {
+	if x != nil {
+		return x.Hours
+	}
+	return 0
+}
+
+func (x *ReviewAttendanceUnlockRequest) GetActionById() string {
+	if x != nil {
+		return x.ActionById
+	}
+	return ""
+}
+
+func (x *ReviewAttendanceUnlockRequest) GetActionByName() string {
+	if x != nil {
+		return x.ActionByName
+	}
+	return ""
+}
+
+type SendStudentLoginCodeRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// subdomain of the company the student studies at
+	Subdomain     string `protobuf:"bytes,1,opt,name=subdomain,proto3" json:"subdomain,omitempty"`
+	Phone         string `protobuf:"bytes,2,opt,name=phone,proto3" json:"phone,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SendStudentLoginCodeRequest) Reset() {
+	*x = SendStudentLoginCodeRequest{}
+	mi := &file_education_proto_msgTypes[84]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SendStudentLoginCodeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendStudentLoginCodeRequest) ProtoMessage() {}
+
+func (x *SendStudentLoginCodeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[84]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendStudentLoginCodeRequest.ProtoReflect.Descriptor instead.
+func (*SendStudentLoginCodeRequest) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *SendStudentLoginCodeRequest) GetSubdomain() string {
+	if x != nil {
+		return x.Subdomain
+	}
+	return ""
+}
+
+func (x *SendStudentLoginCodeRequest) GetPhone() string {
+	if x != nil {
+		return x.Phone
+	}
+	return ""
+}
+
+type VerifyStudentLoginCodeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Subdomain     string                 `protobuf:"bytes,1,opt,name=subdomain,proto3" json:"subdomain,omitempty"`
+	Phone         string                 `protobuf:"bytes,2,opt,name=phone,proto3" json:"phone,omitempty"`
+	Code          string                 `protobuf:"bytes,3,opt,name=code,proto3" json:"code,omitempty"`
+	Device        string                 `protobuf:"bytes,4,opt,name=device,proto3" json:"device,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VerifyStudentLoginCodeRequest) Reset() {
+	*x = VerifyStudentLoginCodeRequest{}
+	mi := &file_education_proto_msgTypes[85]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VerifyStudentLoginCodeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyStudentLoginCodeRequest) ProtoMessage() {}
+
+func (x *VerifyStudentLoginCodeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[85]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyStudentLoginCodeRequest.ProtoReflect.Descriptor instead.
+func (*VerifyStudentLoginCodeRequest) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *VerifyStudentLoginCodeRequest) GetSubdomain() string {
+	if x != nil {
+		return x.Subdomain
+	}
+	return ""
+}
+
+func (x *VerifyStudentLoginCodeRequest) GetPhone() string {
+	if x != nil {
+		return x.Phone
+	}
+	return ""
+}
+
+func (x *VerifyStudentLoginCodeRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *VerifyStudentLoginCodeRequest) GetDevice() string {
+	if x != nil {
+		return x.Device
+	}
+	return ""
+}
+
+type StudentSession struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	StudentId   string                 `protobuf:"bytes,1,opt,name=studentId,proto3" json:"studentId,omitempty"`
+	StudentName string                 `protobuf:"bytes,2,opt,name=studentName,proto3" json:"studentName,omitempty"`
+	CompanyId   string                 `protobuf:"bytes,3,opt,name=companyId,proto3" json:"companyId,omitempty"`
+	// bearer token of the student, only returned when the student logs in
+	Token         string `protobuf:"bytes,4,opt,name=token,proto3" json:"token,omitempty"`
+	ExpiresAt     string `protobuf:"bytes,5,opt,name=expiresAt,proto3" json:"expiresAt,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StudentSession) Reset() {
+	*x = StudentSession{}
+	mi := &file_education_proto_msgTypes[86]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StudentSession) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StudentSession) ProtoMessage() {}
+
+func (x *StudentSession) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[86]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StudentSession.ProtoReflect.Descriptor instead.
+func (*StudentSession) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *StudentSession) GetStudentId() string {
+	if x != nil {
+		return x.StudentId
+	}
+	return ""
+}
+
+func (x *StudentSession) GetStudentName() string {
+	if x != nil {
+		return x.StudentName
+	}
+	return ""
+}
+
+func (x *StudentSession) GetCompanyId() string {
+	if x != nil {
+		return x.CompanyId
+	}
+	return ""
+}
+
+func (x *StudentSession) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *StudentSession) GetExpiresAt() string {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return ""
+}
+
+type VerifyStudentLoginCodeResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// one session for every student of the phone, siblings often share one
+	Sessions      []*StudentSession `protobuf:"bytes,1,rep,name=sessions,proto3" json:"sessions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VerifyStudentLoginCodeResponse) Reset() {
+	*x = VerifyStudentLoginCodeResponse{}
+	mi := &file_education_proto_msgTypes[87]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VerifyStudentLoginCodeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyStudentLoginCodeResponse) ProtoMessage() {}
+
+func (x *VerifyStudentLoginCodeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[87]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyStudentLoginCodeResponse.ProtoReflect.Descriptor instead.
+func (*VerifyStudentLoginCodeResponse) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *VerifyStudentLoginCodeResponse) GetSessions() []*StudentSession {
+	if x != nil {
+		return x.Sessions
+	}
+	return nil
+}
+
+type ValidateStudentSessionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ValidateStudentSessionRequest) Reset() {
+	*x = ValidateStudentSessionRequest{}
+	mi := &file_education_proto_msgTypes[88]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ValidateStudentSessionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidateStudentSessionRequest) ProtoMessage() {}
+
+func (x *ValidateStudentSessionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[88]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidateStudentSessionRequest.ProtoReflect.Descriptor instead.
+func (*ValidateStudentSessionRequest) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{88}
+}
+
+func (x *ValidateStudentSessionRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+type GetCheckInCodeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	LessonId      string                 `protobuf:"bytes,1,opt,name=lessonId,proto3" json:"lessonId,omitempty"`
+	ActionById    string                 `protobuf:"bytes,2,opt,name=actionById,proto3" json:"actionById,omitempty"`
+	ActionByRole  string                 `protobuf:"bytes,3,opt,name=actionByRole,proto3" json:"actionByRole,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCheckInCodeRequest) Reset() {
+	*x = GetCheckInCodeRequest{}
+	mi := &file_education_proto_msgTypes[89]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCheckInCodeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCheckInCodeRequest) ProtoMessage() {}
+
+func (x *GetCheckInCodeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[89]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCheckInCodeRequest.ProtoReflect.Descriptor instead.
+func (*GetCheckInCodeRequest) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{89}
+}
+
+func (x *GetCheckInCodeRequest) GetLessonId() string {
+	if x != nil {
+		return x.LessonId
+	}
+	return ""
+}
+
+func (x *GetCheckInCodeRequest) GetActionById() string {
+	if x != nil {
+		return x.ActionById
+	}
+	return ""
+}
+
+func (x *GetCheckInCodeRequest) GetActionByRole() string {
+	if x != nil {
+		return x.ActionByRole
+	}
+	return ""
+}
+
+// CheckInCode is shown as a QR code on the tablet of the room, it expires shortly after the tablet asks for the next one
+type CheckInCode struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	LessonId   string                 `protobuf:"bytes,1,opt,name=lessonId,proto3" json:"lessonId,omitempty"`
+	GroupName  string                 `protobuf:"bytes,2,opt,name=groupName,proto3" json:"groupName,omitempty"`
+	LessonDate string                 `protobuf:"bytes,3,opt,name=lessonDate,proto3" json:"lessonDate,omitempty"`
+	Token      string                 `protobuf:"bytes,4,opt,name=token,proto3" json:"token,omitempty"`
+	ExpiresAt  string                 `protobuf:"bytes,5,opt,name=expiresAt,proto3" json:"expiresAt,omitempty"`
+	// seconds after which the tablet should show a new code
+	RefreshIn     int32 `protobuf:"varint,6,opt,name=refreshIn,proto3" json:"refreshIn,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckInCode) Reset() {
+	*x = CheckInCode{}
+	mi := &file_education_proto_msgTypes[90]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckInCode) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckInCode) ProtoMessage() {}
+
+func (x *CheckInCode) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[90]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckInCode.ProtoReflect.Descriptor instead.
+func (*CheckInCode) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{90}
+}
+
+func (x *CheckInCode) GetLessonId() string {
+	if x != nil {
+		return x.LessonId
+	}
+	return ""
+}
+
+func (x *CheckInCode) GetGroupName() string {
+	if x != nil {
+		return x.GroupName
+	}
+	return ""
+}
+
+func (x *CheckInCode) GetLessonDate() string {
+	if x != nil {
+		return x.LessonDate
+	}
+	return ""
+}
+
+func (x *CheckInCode) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *CheckInCode) GetExpiresAt() string {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return ""
+}
+
+func (x *CheckInCode) GetRefreshIn() int32 {
+	if x != nil {
+		return x.RefreshIn
+	}
+	return 0
+}
+
+type CheckInRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// the token of the scanned QR code
+	Token         string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	StudentId     string `protobuf:"bytes,2,opt,name=studentId,proto3" json:"studentId,omitempty"`
+	Device        string `protobuf:"bytes,3,opt,name=device,proto3" json:"device,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckInRequest) Reset() {
+	*x = CheckInRequest{}
+	mi := &file_education_proto_msgTypes[91]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckInRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckInRequest) ProtoMessage() {}
+
+func (x *CheckInRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[91]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckInRequest.ProtoReflect.Descriptor instead.
+func (*CheckInRequest) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{91}
+}
+
+func (x *CheckInRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *CheckInRequest) GetStudentId() string {
+	if x != nil {
+		return x.StudentId
+	}
+	return ""
+}
+
+func (x *CheckInRequest) GetDevice() string {
+	if x != nil {
+		return x.Device
+	}
+	return ""
+}
+
+type CheckInResponse struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	LessonId    string                 `protobuf:"bytes,1,opt,name=lessonId,proto3" json:"lessonId,omitempty"`
+	GroupName   string                 `protobuf:"bytes,2,opt,name=groupName,proto3" json:"groupName,omitempty"`
+	LessonDate  string                 `protobuf:"bytes,3,opt,name=lessonDate,proto3" json:"lessonDate,omitempty"`
+	CheckedInAt string                 `protobuf:"bytes,4,opt,name=checkedInAt,proto3" json:"checkedInAt,omitempty"`
+	// false until the teacher confirms the check in
+	Confirmed     bool   `protobuf:"varint,5,opt,name=confirmed,proto3" json:"confirmed,omitempty"`
+	Message       string `protobuf:"bytes,6,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckInResponse) Reset() {
+	*x = CheckInResponse{}
+	mi := &file_education_proto_msgTypes[92]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckInResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckInResponse) ProtoMessage() {}
+
+func (x *CheckInResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[92]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckInResponse.ProtoReflect.Descriptor instead.
+func (*CheckInResponse) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{92}
+}
+
+func (x *CheckInResponse) GetLessonId() string {
+	if x != nil {
+		return x.LessonId
+	}
+	return ""
+}
+
+func (x *CheckInResponse) GetGroupName() string {
+	if x != nil {
+		return x.GroupName
+	}
+	return ""
+}
+
+func (x *CheckInResponse) GetLessonDate() string {
+	if x != nil {
+		return x.LessonDate
+	}
+	return ""
+}
+
+func (x *CheckInResponse) GetCheckedInAt() string {
+	if x != nil {
+		return x.CheckedInAt
+	}
+	return ""
+}
+
+func (x *CheckInResponse) GetConfirmed() bool {
+	if x != nil {
+		return x.Confirmed
+	}
+	return false
+}
+
+func (x *CheckInResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type GetLessonCheckInsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	LessonId      string                 `protobuf:"bytes,1,opt,name=lessonId,proto3" json:"lessonId,omitempty"`
+	ActionById    string                 `protobuf:"bytes,2,opt,name=actionById,proto3" json:"actionById,omitempty"`
+	ActionByRole  string                 `protobuf:"bytes,3,opt,name=actionByRole,proto3" json:"actionByRole,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetLessonCheckInsRequest) Reset() {
+	*x = GetLessonCheckInsRequest{}
+	mi := &file_education_proto_msgTypes[93]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetLessonCheckInsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLessonCheckInsRequest) ProtoMessage() {}
+
+func (x *GetLessonCheckInsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[93]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLessonCheckInsRequest.ProtoReflect.Descriptor instead.
+func (*GetLessonCheckInsRequest) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{93}
+}
+
+func (x *GetLessonCheckInsRequest) GetLessonId() string {
+	if x != nil {
+		return x.LessonId
+	}
+	return ""
+}
+
+func (x *GetLessonCheckInsRequest) GetActionById() string {
+	if x != nil {
+		return x.ActionById
+	}
+	return ""
+}
+
+func (x *GetLessonCheckInsRequest) GetActionByRole() string {
+	if x != nil {
+		return x.ActionByRole
+	}
+	return ""
+}
+
+type LessonCheckIn struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StudentId     string                 `protobuf:"bytes,1,opt,name=studentId,proto3" json:"studentId,omitempty"`
+	StudentName   string                 `protobuf:"bytes,2,opt,name=studentName,proto3" json:"studentName,omitempty"`
+	CheckedInAt   string                 `protobuf:"bytes,3,opt,name=checkedInAt,proto3" json:"checkedInAt,omitempty"`
+	Device        string                 `protobuf:"bytes,4,opt,name=device,proto3" json:"device,omitempty"`
+	Confirmed     bool                   `protobuf:"varint,5,opt,name=confirmed,proto3" json:"confirmed,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LessonCheckIn) Reset() {
+	*x = LessonCheckIn{}
+	mi := &file_education_proto_msgTypes[94]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LessonCheckIn) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LessonCheckIn) ProtoMessage() {}
+
+func (x *LessonCheckIn) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[94]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LessonCheckIn.ProtoReflect.Descriptor instead.
+func (*LessonCheckIn) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{94}
+}
+
+func (x *LessonCheckIn) GetStudentId() string {
+	if x != nil {
+		return x.StudentId
+	}
+	return ""
+}
+
+func (x *LessonCheckIn) GetStudentName() string {
+	if x != nil {
+		return x.StudentName
+	}
+	return ""
+}
+
+func (x *LessonCheckIn) GetCheckedInAt() string {
+	if x != nil {
+		return x.CheckedInAt
+	}
+	return ""
+}
+
+func (x *LessonCheckIn) GetDevice() string {
+	if x != nil {
+		return x.Device
+	}
+	return ""
+}
+
+func (x *LessonCheckIn) GetConfirmed() bool {
+	if x != nil {
+		return x.Confirmed
+	}
+	return false
+}
+
+type GetLessonCheckInsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CheckIns      []*LessonCheckIn       `protobuf:"bytes,1,rep,name=checkIns,proto3" json:"checkIns,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetLessonCheckInsResponse) Reset() {
+	*x = GetLessonCheckInsResponse{}
+	mi := &file_education_proto_msgTypes[95]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetLessonCheckInsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLessonCheckInsResponse) ProtoMessage() {}
+
+func (x *GetLessonCheckInsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[95]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLessonCheckInsResponse.ProtoReflect.Descriptor instead.
+func (*GetLessonCheckInsResponse) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{95}
+}
+
+func (x *GetLessonCheckInsResponse) GetCheckIns() []*LessonCheckIn {
+	if x != nil {
+		return x.CheckIns
+	}
+	return nil
+}
+
+type ConfirmCheckInsRequest struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	LessonId   string                 `protobuf:"bytes,1,opt,name=lessonId,proto3" json:"lessonId,omitempty"`
+	StudentIds []string               `protobuf:"bytes,2,rep,name=studentIds,proto3" json:"studentIds,omitempty"`
+	// rejected check ins are deleted
+	Reject        bool   `protobuf:"varint,3,opt,name=reject,proto3" json:"reject,omitempty"`
+	ActionById    string `protobuf:"bytes,4,opt,name=actionById,proto3" json:"actionById,omitempty"`
+	ActionByRole  string `protobuf:"bytes,5,opt,name=actionByRole,proto3" json:"actionByRole,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConfirmCheckInsRequest) Reset() {
+	*x = ConfirmCheckInsRequest{}
+	mi := &file_education_proto_msgTypes[96]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConfirmCheckInsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfirmCheckInsRequest) ProtoMessage() {}
+
+func (x *ConfirmCheckInsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[96]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfirmCheckInsRequest.ProtoReflect.Descriptor instead.
+func (*ConfirmCheckInsRequest) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{96}
+}
+
+func (x *ConfirmCheckInsRequest) GetLessonId() string {
+	if x != nil {
+		return x.LessonId
+	}
+	return ""
+}
+
+func (x *ConfirmCheckInsRequest) GetStudentIds() []string {
+	if x != nil {
+		return x.StudentIds
+	}
+	return nil
+}
+
+func (x *ConfirmCheckInsRequest) GetReject() bool {
+	if x != nil {
+		return x.Reject
+	}
+	return false
+}
+
+func (x *ConfirmCheckInsRequest) GetActionById() string {
+	if x != nil {
+		return x.ActionById
+	}
+	return ""
+}
+
+func (x *ConfirmCheckInsRequest) GetActionByRole() string {
+	if x != nil {
+		return x.ActionByRole
+	}
+	return ""
+}
+
+// a teacher only gets the attendance of the lessons it taught whatever teacherId is
+type GetAttendanceRatesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// yyyy-MM-dd
+	From string `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	Till string `protobuf:"bytes,2,opt,name=till,proto3" json:"till,omitempty"`
+	// STUDENT, GROUP, TEACHER or COURSE
+	By            string `protobuf:"bytes,3,opt,name=by,proto3" json:"by,omitempty"`
+	GroupId       string `protobuf:"bytes,4,opt,name=groupId,proto3" json:"groupId,omitempty"`
+	TeacherId     string `protobuf:"bytes,5,opt,name=teacherId,proto3" json:"teacherId,omitempty"`
+	CourseId      string `protobuf:"bytes,6,opt,name=courseId,proto3" json:"courseId,omitempty"`
+	ActionById    string `protobuf:"bytes,7,opt,name=actionById,proto3" json:"actionById,omitempty"`
+	ActionByRole  string `protobuf:"bytes,8,opt,name=actionByRole,proto3" json:"actionByRole,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAttendanceRatesRequest) Reset() {
+	*x = GetAttendanceRatesRequest{}
+	mi := &file_education_proto_msgTypes[97]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAttendanceRatesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAttendanceRatesRequest) ProtoMessage() {}
+
+func (x *GetAttendanceRatesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[97]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAttendanceRatesRequest.ProtoReflect.Descriptor instead.
+func (*GetAttendanceRatesRequest) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{97}
+}
+
+func (x *GetAttendanceRatesRequest) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *GetAttendanceRatesRequest) GetTill() string {
+	if x != nil {
+		return x.Till
+	}
+	return ""
+}
+
+func (x *GetAttendanceRatesRequest) GetBy() string {
+	if x != nil {
+		return x.By
+	}
+	return ""
+}
+
+func (x *GetAttendanceRatesRequest) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+func (x *GetAttendanceRatesRequest) GetTeacherId() string {
+	if x != nil {
+		return x.TeacherId
+	}
+	return ""
+}
+
+func (x *GetAttendanceRatesRequest) GetCourseId() string {
+	if x != nil {
+		return x.CourseId
+	}
+	return ""
+}
+
+func (x *GetAttendanceRatesRequest) GetActionById() string {
+	if x != nil {
+		return x.ActionById
+	}
+	return ""
+}
+
+func (x *GetAttendanceRatesRequest) GetActionByRole() string {
+	if x != nil {
+		return x.ActionByRole
+	}
+	return ""
+}
+
+type AttendanceRate struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name  string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// marks with a billable status
+	Attended int32 `protobuf:"varint,3,opt,name=attended,proto3" json:"attended,omitempty"`
+	Absent   int32 `protobuf:"varint,4,opt,name=absent,proto3" json:"absent,omitempty"`
+	Total    int32 `protobuf:"varint,5,opt,name=total,proto3" json:"total,omitempty"`
+	// percent of the marked lessons attended
+	Rate          float64 `protobuf:"fixed64,6,opt,name=rate,proto3" json:"rate,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AttendanceRate) Reset() {
+	*x = AttendanceRate{}
+	mi := &file_education_proto_msgTypes[98]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AttendanceRate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AttendanceRate) ProtoMessage() {}
+
+func (x *AttendanceRate) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[98]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AttendanceRate.ProtoReflect.Descriptor instead.
+func (*AttendanceRate) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{98}
+}
+
+func (x *AttendanceRate) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *AttendanceRate) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *AttendanceRate) GetAttended() int32 {
+	if x != nil {
+		return x.Attended
+	}
+	return 0
+}
+
+func (x *AttendanceRate) GetAbsent() int32 {
+	if x != nil {
+		return x.Absent
+	}
+	return 0
+}
+
+func (x *AttendanceRate) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *AttendanceRate) GetRate() float64 {
+	if x != nil {
+		return x.Rate
+	}
+	return 0
+}
+
+type GetAttendanceRatesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rates         []*AttendanceRate      `protobuf:"bytes,1,rep,name=rates,proto3" json:"rates,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAttendanceRatesResponse) Reset() {
+	*x = GetAttendanceRatesResponse{}
+	mi := &file_education_proto_msgTypes[99]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAttendanceRatesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAttendanceRatesResponse) ProtoMessage() {}
+
+func (x *GetAttendanceRatesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[99]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAttendanceRatesResponse.ProtoReflect.Descriptor instead.
+func (*GetAttendanceRatesResponse) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{99}
+}
+
+func (x *GetAttendanceRatesResponse) GetRates() []*AttendanceRate {
+	if x != nil {
+		return x.Rates
+	}
+	return nil
+}
+
+type GetAbsenceStreaksRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	GroupId   string                 `protobuf:"bytes,1,opt,name=groupId,proto3" json:"groupId,omitempty"`
+	TeacherId string                 `protobuf:"bytes,2,opt,name=teacherId,proto3" json:"teacherId,omitempty"`
+	// streaks shorter than it are left out, 2 when 0
+	MinStreak     int32  `protobuf:"varint,3,opt,name=minStreak,proto3" json:"minStreak,omitempty"`
+	ActionById    string `protobuf:"bytes,4,opt,name=actionById,proto3" json:"actionById,omitempty"`
+	ActionByRole  string `protobuf:"bytes,5,opt,name=actionByRole,proto3" json:"actionByRole,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAbsenceStreaksRequest) Reset() {
+	*x = GetAbsenceStreaksRequest{}
+	mi := &file_education_proto_msgTypes[100]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAbsenceStreaksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAbsenceStreaksRequest) ProtoMessage() {}
+
+func (x *GetAbsenceStreaksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[100]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAbsenceStreaksRequest.ProtoReflect.Descriptor instead.
+func (*GetAbsenceStreaksRequest) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{100}
+}
+
+func (x *GetAbsenceStreaksRequest) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+func (x *GetAbsenceStreaksRequest) GetTeacherId() string {
+	if x != nil {
+		return x.TeacherId
+	}
+	return ""
+}
+
+func (x *GetAbsenceStreaksRequest) GetMinStreak() int32 {
+	if x != nil {
+		return x.MinStreak
+	}
+	return 0
+}
+
+func (x *GetAbsenceStreaksRequest) GetActionById() string {
+	if x != nil {
+		return x.ActionById
+	}
+	return ""
+}
+
+func (x *GetAbsenceStreaksRequest) GetActionByRole() string {
+	if x != nil {
+		return x.ActionByRole
+	}
+	return ""
+}
+
+// AbsenceStreak is how many of the last lessons in a row a student missed, absences made up later do not count
+type AbsenceStreak struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	StudentId      string                 `protobuf:"bytes,1,opt,name=studentId,proto3" json:"studentId,omitempty"`
+	StudentName    string                 `protobuf:"bytes,2,opt,name=studentName,proto3" json:"studentName,omitempty"`
+	GroupId        int64                  `protobuf:"varint,3,opt,name=groupId,proto3" json:"groupId,omitempty"`
+	GroupName      string                 `protobuf:"bytes,4,opt,name=groupName,proto3" json:"groupName,omitempty"`
+	Streak         int32                  `protobuf:"varint,5,opt,name=streak,proto3" json:"streak,omitempty"`
+	LastAbsentDate string                 `protobuf:"bytes,6,opt,name=lastAbsentDate,proto3" json:"lastAbsentDate,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *AbsenceStreak) Reset() {
+	*x = AbsenceStreak{}
+	mi := &file_education_proto_msgTypes[101]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AbsenceStreak) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AbsenceStreak) ProtoMessage() {}
+
+func (x *AbsenceStreak) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[101]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AbsenceStreak.ProtoReflect.Descriptor instead.
+func (*AbsenceStreak) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{101}
+}
+
+func (x *AbsenceStreak) GetStudentId() string {
+	if x != nil {
+		return x.StudentId
+	}
+	return ""
+}
+
+func (x *AbsenceStreak) GetStudentName() string {
+	if x != nil {
+		return x.StudentName
+	}
+	return ""
+}
+
+func (x *AbsenceStreak) GetGroupId() int64 {
+	if x != nil {
+		return x.GroupId
+	}
+	return 0
+}
+
+func (x *AbsenceStreak) GetGroupName() string {
+	if x != nil {
+		return x.GroupName
+	}
+	return ""
+}
+
+func (x *AbsenceStreak) GetStreak() int32 {
+	if x != nil {
+		return x.Streak
+	}
+	return 0
+}
+
+func (x *AbsenceStreak) GetLastAbsentDate() string {
+	if x != nil {
+		return x.LastAbsentDate
+	}
+	return ""
+}
+
+type GetAbsenceStreaksResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Streaks       []*AbsenceStreak       `protobuf:"bytes,1,rep,name=streaks,proto3" json:"streaks,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAbsenceStreaksResponse) Reset() {
+	*x = GetAbsenceStreaksResponse{}
+	mi := &file_education_proto_msgTypes[102]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAbsenceStreaksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAbsenceStreaksResponse) ProtoMessage() {}
+
+func (x *GetAbsenceStreaksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[102]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAbsenceStreaksResponse.ProtoReflect.Descriptor instead.
+func (*GetAbsenceStreaksResponse) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{102}
+}
+
+func (x *GetAbsenceStreaksResponse) GetStreaks() []*AbsenceStreak {
+	if x != nil {
+		return x.Streaks
+	}
+	return nil
+}
+
+type GetAttendanceTrendRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	From  string                 `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	Till  string                 `protobuf:"bytes,2,opt,name=till,proto3" json:"till,omitempty"`
+	// DAY, WEEK or MONTH
+	Interval      string `protobuf:"bytes,3,opt,name=interval,proto3" json:"interval,omitempty"`
+	GroupId       string `protobuf:"bytes,4,opt,name=groupId,proto3" json:"groupId,omitempty"`
+	TeacherId     string `protobuf:"bytes,5,opt,name=teacherId,proto3" json:"teacherId,omitempty"`
+	CourseId      string `protobuf:"bytes,6,opt,name=courseId,proto3" json:"courseId,omitempty"`
+	ActionById    string `protobuf:"bytes,7,opt,name=actionById,proto3" json:"actionById,omitempty"`
+	ActionByRole  string `protobuf:"bytes,8,opt,name=actionByRole,proto3" json:"actionByRole,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAttendanceTrendRequest) Reset() {
+	*x = GetAttendanceTrendRequest{}
+	mi := &file_education_proto_msgTypes[103]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAttendanceTrendRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAttendanceTrendRequest) ProtoMessage() {}
+
+func (x *GetAttendanceTrendRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[103]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAttendanceTrendRequest.ProtoReflect.Descriptor instead.
+func (*GetAttendanceTrendRequest) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{103}
+}
+
+func (x *GetAttendanceTrendRequest) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *GetAttendanceTrendRequest) GetTill() string {
+	if x != nil {
+		return x.Till
+	}
+	return ""
+}
+
+func (x *GetAttendanceTrendRequest) GetInterval() string {
+	if x != nil {
+		return x.Interval
+	}
+	return ""
+}
+
+func (x *GetAttendanceTrendRequest) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+func (x *GetAttendanceTrendRequest) GetTeacherId() string {
+	if x != nil {
+		return x.TeacherId
+	}
+	return ""
+}
+
+func (x *GetAttendanceTrendRequest) GetCourseId() string {
+	if x != nil {
+		return x.CourseId
+	}
+	return ""
+}
+
+func (x *GetAttendanceTrendRequest) GetActionById() string {
+	if x != nil {
+		return x.ActionById
+	}
+	return ""
+}
+
+func (x *GetAttendanceTrendRequest) GetActionByRole() string {
+	if x != nil {
+		return x.ActionByRole
+	}
+	return ""
+}
+
+type AttendanceTrendPoint struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// first day of the interval
+	Period        string  `protobuf:"bytes,1,opt,name=period,proto3" json:"period,omitempty"`
+	Attended      int32   `protobuf:"varint,2,opt,name=attended,proto3" json:"attended,omitempty"`
+	Total         int32   `protobuf:"varint,3,opt,name=total,proto3" json:"total,omitempty"`
+	Rate          float64 `protobuf:"fixed64,4,opt,name=rate,proto3" json:"rate,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AttendanceTrendPoint) Reset() {
+	*x = AttendanceTrendPoint{}
+	mi := &file_education_proto_msgTypes[104]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AttendanceTrendPoint) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AttendanceTrendPoint) ProtoMessage() {}
+
+func (x *AttendanceTrendPoint) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[104]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AttendanceTrendPoint.ProtoReflect.Descriptor instead.
+func (*AttendanceTrendPoint) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{104}
+}
+
+func (x *AttendanceTrendPoint) GetPeriod() string {
+	if x != nil {
+		return x.Period
+	}
+	return ""
+}
+
+func (x *AttendanceTrendPoint) GetAttended() int32 {
+	if x != nil {
+		return x.Attended
+	}
+	return 0
+}
+
+func (x *AttendanceTrendPoint) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *AttendanceTrendPoint) GetRate() float64 {
+	if x != nil {
+		return x.Rate
+	}
+	return 0
+}
+
+type GetAttendanceTrendResponse struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Points        []*AttendanceTrendPoint `protobuf:"bytes,1,rep,name=points,proto3" json:"points,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAttendanceTrendResponse) Reset() {
+	*x = GetAttendanceTrendResponse{}
+	mi := &file_education_proto_msgTypes[105]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAttendanceTrendResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAttendanceTrendResponse) ProtoMessage() {}
+
+func (x *GetAttendanceTrendResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[105]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAttendanceTrendResponse.ProtoReflect.Descriptor instead.
+func (*GetAttendanceTrendResponse) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{105}
+}
+
+func (x *GetAttendanceTrendResponse) GetPoints() []*AttendanceTrendPoint {
+	if x != nil {
+		return x.Points
+	}
+	return nil
+}
+
+// AtRiskPolicy is when the daily job flags a student as at risk, a rule set to 0 is off
+type AtRiskPolicy struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AbsenceStreak int32                  `protobuf:"varint,1,opt,name=absenceStreak,proto3" json:"absenceStreak,omitempty"`
+	// attendance percent this month below which a student is flagged
+	MinRate int32 `protobuf:"varint,2,opt,name=minRate,proto3" json:"minRate,omitempty"`
+	// marked lessons this month before the rate is judged
+	MinLessons int32 `protobuf:"varint,3,opt,name=minLessons,proto3" json:"minLessons,omitempty"`
+	// sms the parents with the AT_RISK_ALERT template when it is active
+	SmsParents    bool   `protobuf:"varint,4,opt,name=smsParents,proto3" json:"smsParents,omitempty"`
+	ActionById    string `protobuf:"bytes,5,opt,name=actionById,proto3" json:"actionById,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AtRiskPolicy) Reset() {
+	*x = AtRiskPolicy{}
+	mi := &file_education_proto_msgTypes[106]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AtRiskPolicy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AtRiskPolicy) ProtoMessage() {}
+
+func (x *AtRiskPolicy) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[106]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AtRiskPolicy.ProtoReflect.Descriptor instead.
+func (*AtRiskPolicy) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{106}
+}
+
+func (x *AtRiskPolicy) GetAbsenceStreak() int32 {
+	if x != nil {
+		return x.AbsenceStreak
+	}
+	return 0
+}
+
+func (x *AtRiskPolicy) GetMinRate() int32 {
+	if x != nil {
+		return x.MinRate
+	}
+	return 0
+}
+
+func (x *AtRiskPolicy) GetMinLessons() int32 {
+	if x != nil {
+		return x.MinLessons
+	}
+	return 0
+}
+
+func (x *AtRiskPolicy) GetSmsParents() bool {
+	if x != nil {
+		return x.SmsParents
+	}
+	return false
+}
+
+func (x *AtRiskPolicy) GetActionById() string {
+	if x != nil {
+		return x.ActionById
+	}
+	return ""
+}
+
+type GetAtRiskStudentsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// OPEN when empty
+	Status        string `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	GroupId       string `protobuf:"bytes,2,opt,name=groupId,proto3" json:"groupId,omitempty"`
+	ActionById    string `protobuf:"bytes,3,opt,name=actionById,proto3" json:"actionById,omitempty"`
+	ActionByRole  string `protobuf:"bytes,4,opt,name=actionByRole,proto3" json:"actionByRole,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAtRiskStudentsRequest) Reset() {
+	*x = GetAtRiskStudentsRequest{}
+	mi := &file_education_proto_msgTypes[107]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAtRiskStudentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAtRiskStudentsRequest) ProtoMessage() {}
+
+func (x *GetAtRiskStudentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[107]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAtRiskStudentsRequest.ProtoReflect.Descriptor instead.
+func (*GetAtRiskStudentsRequest) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{107}
+}
+
+func (x *GetAtRiskStudentsRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *GetAtRiskStudentsRequest) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+func (x *GetAtRiskStudentsRequest) GetActionById() string {
+	if x != nil {
+		return x.ActionById
+	}
+	return ""
+}
+
+func (x *GetAtRiskStudentsRequest) GetActionByRole() string {
+	if x != nil {
+		return x.ActionByRole
+	}
+	return ""
+}
+
+type AtRiskStudent struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Id          string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	StudentId   string                 `protobuf:"bytes,2,opt,name=studentId,proto3" json:"studentId,omitempty"`
+	StudentName string                 `protobuf:"bytes,3,opt,name=studentName,proto3" json:"studentName,omitempty"`
+	GroupId     int64                  `protobuf:"varint,4,opt,name=groupId,proto3" json:"groupId,omitempty"`
+	GroupName   string                 `protobuf:"bytes,5,opt,name=groupName,proto3" json:"groupName,omitempty"`
+	// ABSENCE_STREAK or LOW_RATE
+	Reason         string  `protobuf:"bytes,6,opt,name=reason,proto3" json:"reason,omitempty"`
+	AbsenceStreak  int32   `protobuf:"varint,7,opt,name=absenceStreak,proto3" json:"absenceStreak,omitempty"`
+	AttendanceRate float64 `protobuf:"fixed64,8,opt,name=attendanceRate,proto3" json:"attendanceRate,omitempty"`
+	// OPEN until the student is no longer at risk
+	Status        string `protobuf:"bytes,9,opt,name=status,proto3" json:"status,omitempty"`
+	FlaggedAt     string `protobuf:"bytes,10,opt,name=flaggedAt,proto3" json:"flaggedAt,omitempty"`
+	ResolvedAt    string `protobuf:"bytes,11,opt,name=resolvedAt,proto3" json:"resolvedAt,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AtRiskStudent) Reset() {
+	*x = AtRiskStudent{}
+	mi := &file_education_proto_msgTypes[108]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AtRiskStudent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AtRiskStudent) ProtoMessage() {}
+
+func (x *AtRiskStudent) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[108]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AtRiskStudent.ProtoReflect.Descriptor instead.
+func (*AtRiskStudent) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{108}
+}
+
+func (x *AtRiskStudent) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *AtRiskStudent) GetStudentId() string {
+	if x != nil {
+		return x.StudentId
+	}
+	return ""
+}
+
+func (x *AtRiskStudent) GetStudentName() string {
+	if x != nil {
+		return x.StudentName
+	}
+	return ""
+}
+
+func (x *AtRiskStudent) GetGroupId() int64 {
+	if x != nil {
+		return x.GroupId
+	}
+	return 0
+}
+
+func (x *AtRiskStudent) GetGroupName() string {
+	if x != nil {
+		return x.GroupName
+	}
+	return ""
+}
+
+func (x *AtRiskStudent) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *AtRiskStudent) GetAbsenceStreak() int32 {
+	if x != nil {
+		return x.AbsenceStreak
+	}
+	return 0
+}
+
+func (x *AtRiskStudent) GetAttendanceRate() float64 {
+	if x != nil {
+		return x.AttendanceRate
+	}
+	return 0
+}
+
+func (x *AtRiskStudent) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *AtRiskStudent) GetFlaggedAt() string {
+	if x != nil {
+		return x.FlaggedAt
+	}
+	return ""
+}
+
+func (x *AtRiskStudent) GetResolvedAt() string {
+	if x != nil {
+		return x.ResolvedAt
+	}
+	return ""
+}
+
+type GetAtRiskStudentsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Students      []*AtRiskStudent       `protobuf:"bytes,1,rep,name=students,proto3" json:"students,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAtRiskStudentsResponse) Reset() {
+	*x = GetAtRiskStudentsResponse{}
+	mi := &file_education_proto_msgTypes[109]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAtRiskStudentsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAtRiskStudentsResponse) ProtoMessage() {}
+
+func (x *GetAtRiskStudentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[109]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAtRiskStudentsResponse.ProtoReflect.Descriptor instead.
+func (*GetAtRiskStudentsResponse) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{109}
+}
+
+func (x *GetAtRiskStudentsResponse) GetStudents() []*AtRiskStudent {
+	if x != nil {
+		return x.Students
+	}
+	return nil
+}
+
+type GetNotificationsRequest struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	UnreadOnly bool                   `protobuf:"varint,1,opt,name=unreadOnly,proto3" json:"unreadOnly,omitempty"`
+	// 50 when 0
+	Limit         int32  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	ActionById    string `protobuf:"bytes,3,opt,name=actionById,proto3" json:"actionById,omitempty"`
+	ActionByRole  string `protobuf:"bytes,4,opt,name=actionByRole,proto3" json:"actionByRole,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetNotificationsRequest) Reset() {
+	*x = GetNotificationsRequest{}
+	mi := &file_education_proto_msgTypes[110]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetNotificationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetNotificationsRequest) ProtoMessage() {}
+
+func (x *GetNotificationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[110]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetNotificationsRequest.ProtoReflect.Descriptor instead.
+func (*GetNotificationsRequest) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{110}
+}
+
+func (x *GetNotificationsRequest) GetUnreadOnly() bool {
+	if x != nil {
+		return x.UnreadOnly
+	}
+	return false
+}
+
+func (x *GetNotificationsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *GetNotificationsRequest) GetActionById() string {
+	if x != nil {
+		return x.ActionById
+	}
+	return ""
+}
+
+func (x *GetNotificationsRequest) GetActionByRole() string {
+	if x != nil {
+		return x.ActionByRole
+	}
+	return ""
+}
+
+type Notification struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// AT_RISK_STUDENT or WAITLIST_SEAT_OFFERED
+	Type          string `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	Title         string `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	Body          string `protobuf:"bytes,4,opt,name=body,proto3" json:"body,omitempty"`
+	StudentId     string `protobuf:"bytes,5,opt,name=studentId,proto3" json:"studentId,omitempty"`
+	GroupId       int64  `protobuf:"varint,6,opt,name=groupId,proto3" json:"groupId,omitempty"`
+	Read          bool   `protobuf:"varint,7,opt,name=read,proto3" json:"read,omitempty"`
+	CreatedAt     string `protobuf:"bytes,8,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Notification) Reset() {
+	*x = Notification{}
+	mi := &file_education_proto_msgTypes[111]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Notification) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Notification) ProtoMessage() {}
+
+func (x *Notification) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[111]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Notification.ProtoReflect.Descriptor instead.
+func (*Notification) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{111}
+}
+
+func (x *Notification) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Notification) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *Notification) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
 }
 
-type GetCommonInformationEducationResponse struct {
-	state              protoimpl.MessageState `protogen:"open.v1"`
-	ActiveStudentCount int32                  `protobuf:"varint,1,opt,name=activeStudentCount,proto3" json:"activeStudentCount,omitempty"`
-	ActiveGroupCount   int32                  `protobuf:"varint,2,opt,name=activeGroupCount,proto3" json:"activeGroupCount,omitempty"`
-	LeaveGroupCount    int32                  `protobuf:"varint,3,opt,name=leaveGroupCount,proto3" json:"leaveGroupCount,omitempty"`
-	DebtorsCount       int32                  `protobuf:"varint,4,opt,name=debtorsCount,proto3" json:"debtorsCount,omitempty"`
-	EleminatedInTrial  int32                  `protobuf:"varint,5,opt,name=eleminatedInTrial,proto3" json:"eleminatedInTrial,omitempty"`
-	unknownFields      protoimpl.UnknownFields
-	sizeCache          protoimpl.SizeCache
+func (x *Notification) GetBody() string {
+	if x != nil {
+		return x.Body
+	}
+	return ""
 }
 
-func (x *GetCommonInformationEducationResponse) Reset() {
-	*x = GetCommonInformationEducationResponse{}
-	mi := &file_education_proto_msgTypes[31]
+func (x *Notification) GetStudentId() string {
+	if x != nil {
+		return x.StudentId
+	}
+	return ""
+}
+
+func (x *Notification) GetGroupId() int64 {
+	if x != nil {
+		return x.GroupId
+	}
+	return 0
+}
+
+func (x *Notification) GetRead() bool {
+	if x != nil {
+		return x.Read
+	}
+	return false
+}
+
+func (x *Notification) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+type GetNotificationsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Notifications []*Notification        `protobuf:"bytes,1,rep,name=notifications,proto3" json:"notifications,omitempty"`
+	Unread        int32                  `protobuf:"varint,2,opt,name=unread,proto3" json:"unread,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetNotificationsResponse) Reset() {
+	*x = GetNotificationsResponse{}
+	mi := &file_education_proto_msgTypes[112]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetCommonInformationEducationResponse) String() string {
+func (x *GetNotificationsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetCommonInformationEducationResponse) ProtoMessage() {}
+func (*GetNotificationsResponse) ProtoMessage() {}
 
-func (x *GetCommonInformationEducationResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_education_proto_msgTypes[31]
+func (x *GetNotificationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[112]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2342,69 +8461,352 @@ func (x *GetCommonInformationEducationResponse) ProtoReflect() protoreflect.Mess
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetCommonInformationEducationResponse.ProtoReflect.Descriptor instead.
-func (*GetCommonInformationEducationResponse) Descriptor() ([]byte, []int) {
-	return file_education_proto_rawDescGZIP(), []int{31}
+// Deprecated: Use GetNotificationsResponse.ProtoReflect.Descriptor instead.
+func (*GetNotificationsResponse) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{112}
 }
 
-func (x *GetCommonInformationEducationResponse) GetActiveStudentCount() int32 {
+func (x *GetNotificationsResponse) GetNotifications() []*Notification {
 	if x != nil {
-		return x.ActiveStudentCount
+		return x.Notifications
+	}
+	return nil
+}
+
+func (x *GetNotificationsResponse) GetUnread() int32 {
+	if x != nil {
+		return x.Unread
 	}
 	return 0
 }
 
-func (x *GetCommonInformationEducationResponse) GetActiveGroupCount() int32 {
+type MarkNotificationsReadRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// every notification of the user when empty
+	Ids           []string `protobuf:"bytes,1,rep,name=ids,proto3" json:"ids,omitempty"`
+	ActionById    string   `protobuf:"bytes,2,opt,name=actionById,proto3" json:"actionById,omitempty"`
+	ActionByRole  string   `protobuf:"bytes,3,opt,name=actionByRole,proto3" json:"actionByRole,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MarkNotificationsReadRequest) Reset() {
+	*x = MarkNotificationsReadRequest{}
+	mi := &file_education_proto_msgTypes[113]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MarkNotificationsReadRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarkNotificationsReadRequest) ProtoMessage() {}
+
+func (x *MarkNotificationsReadRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[113]
 	if x != nil {
-		return x.ActiveGroupCount
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarkNotificationsReadRequest.ProtoReflect.Descriptor instead.
+func (*MarkNotificationsReadRequest) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{113}
+}
+
+func (x *MarkNotificationsReadRequest) GetIds() []string {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+func (x *MarkNotificationsReadRequest) GetActionById() string {
+	if x != nil {
+		return x.ActionById
+	}
+	return ""
+}
+
+func (x *MarkNotificationsReadRequest) GetActionByRole() string {
+	if x != nil {
+		return x.ActionByRole
+	}
+	return ""
+}
+
+type GetGroupWaitlistRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	GroupId string                 `protobuf:"bytes,1,opt,name=groupId,proto3" json:"groupId,omitempty"`
+	// finished entries (ENROLLED, EXPIRED, CANCELLED) are listed as well
+	WithHistory   bool `protobuf:"varint,2,opt,name=withHistory,proto3" json:"withHistory,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetGroupWaitlistRequest) Reset() {
+	*x = GetGroupWaitlistRequest{}
+	mi := &file_education_proto_msgTypes[114]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetGroupWaitlistRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGroupWaitlistRequest) ProtoMessage() {}
+
+func (x *GetGroupWaitlistRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[114]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGroupWaitlistRequest.ProtoReflect.Descriptor instead.
+func (*GetGroupWaitlistRequest) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{114}
+}
+
+func (x *GetGroupWaitlistRequest) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+func (x *GetGroupWaitlistRequest) GetWithHistory() bool {
+	if x != nil {
+		return x.WithHistory
+	}
+	return false
+}
+
+type WaitlistEntry struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	Id           string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	StudentId    string                 `protobuf:"bytes,2,opt,name=studentId,proto3" json:"studentId,omitempty"`
+	StudentName  string                 `protobuf:"bytes,3,opt,name=studentName,proto3" json:"studentName,omitempty"`
+	StudentPhone string                 `protobuf:"bytes,4,opt,name=studentPhone,proto3" json:"studentPhone,omitempty"`
+	// place among the WAITING students, 0 for the others
+	Position int32 `protobuf:"varint,5,opt,name=position,proto3" json:"position,omitempty"`
+	// WAITING, OFFERED, ENROLLED, EXPIRED or CANCELLED
+	Status    string `protobuf:"bytes,6,opt,name=status,proto3" json:"status,omitempty"`
+	OfferedAt string `protobuf:"bytes,7,opt,name=offeredAt,proto3" json:"offeredAt,omitempty"`
+	// an OFFERED seat is kept for the student until this time
+	HoldUntil     string `protobuf:"bytes,8,opt,name=holdUntil,proto3" json:"holdUntil,omitempty"`
+	CreatedByName string `protobuf:"bytes,9,opt,name=createdByName,proto3" json:"createdByName,omitempty"`
+	CreatedAt     string `protobuf:"bytes,10,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WaitlistEntry) Reset() {
+	*x = WaitlistEntry{}
+	mi := &file_education_proto_msgTypes[115]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WaitlistEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WaitlistEntry) ProtoMessage() {}
+
+func (x *WaitlistEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[115]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WaitlistEntry.ProtoReflect.Descriptor instead.
+func (*WaitlistEntry) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{115}
+}
+
+func (x *WaitlistEntry) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *WaitlistEntry) GetStudentId() string {
+	if x != nil {
+		return x.StudentId
+	}
+	return ""
+}
+
+func (x *WaitlistEntry) GetStudentName() string {
+	if x != nil {
+		return x.StudentName
+	}
+	return ""
+}
+
+func (x *WaitlistEntry) GetStudentPhone() string {
+	if x != nil {
+		return x.StudentPhone
+	}
+	return ""
+}
+
+func (x *WaitlistEntry) GetPosition() int32 {
+	if x != nil {
+		return x.Position
 	}
 	return 0
 }
 
-func (x *GetCommonInformationEducationResponse) GetLeaveGroupCount() int32 {
+func (x *WaitlistEntry) GetStatus() string {
 	if x != nil {
-		return x.LeaveGroupCount
+		return x.Status
+	}
+	return ""
+}
+
+func (x *WaitlistEntry) GetOfferedAt() string {
+	if x != nil {
+		return x.OfferedAt
+	}
+	return ""
+}
+
+func (x *WaitlistEntry) GetHoldUntil() string {
+	if x != nil {
+		return x.HoldUntil
+	}
+	return ""
+}
+
+func (x *WaitlistEntry) GetCreatedByName() string {
+	if x != nil {
+		return x.CreatedByName
+	}
+	return ""
+}
+
+func (x *WaitlistEntry) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+type GetGroupWaitlistResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 0 when neither the group nor its room limits the seats
+	Capacity int32 `protobuf:"varint,1,opt,name=capacity,proto3" json:"capacity,omitempty"`
+	Enrolled int32 `protobuf:"varint,2,opt,name=enrolled,proto3" json:"enrolled,omitempty"`
+	// seats held for OFFERED students
+	Held          int32            `protobuf:"varint,3,opt,name=held,proto3" json:"held,omitempty"`
+	Entries       []*WaitlistEntry `protobuf:"bytes,4,rep,name=entries,proto3" json:"entries,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetGroupWaitlistResponse) Reset() {
+	*x = GetGroupWaitlistResponse{}
+	mi := &file_education_proto_msgTypes[116]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetGroupWaitlistResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGroupWaitlistResponse) ProtoMessage() {}
+
+func (x *GetGroupWaitlistResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[116]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGroupWaitlistResponse.ProtoReflect.Descriptor instead.
+func (*GetGroupWaitlistResponse) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{116}
+}
+
+func (x *GetGroupWaitlistResponse) GetCapacity() int32 {
+	if x != nil {
+		return x.Capacity
 	}
 	return 0
 }
 
-func (x *GetCommonInformationEducationResponse) GetDebtorsCount() int32 {
+func (x *GetGroupWaitlistResponse) GetEnrolled() int32 {
 	if x != nil {
-		return x.DebtorsCount
+		return x.Enrolled
 	}
 	return 0
 }
 
-func (x *GetCommonInformationEducationResponse) GetEleminatedInTrial() int32 {
+func (x *GetGroupWaitlistResponse) GetHeld() int32 {
 	if x != nil {
-		return x.EleminatedInTrial
+		return x.Held
 	}
 	return 0
 }
 
-type GetGroupsByTeacherIdRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	TeacherId     string                 `protobuf:"bytes,1,opt,name=teacherId,proto3" json:"teacherId,omitempty"`
-	IsArchived    bool                   `protobuf:"varint,2,opt,name=isArchived,proto3" json:"isArchived,omitempty"`
+func (x *GetGroupWaitlistResponse) GetEntries() []*WaitlistEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+type MoveWaitlistEntryRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// new place among the WAITING students, starting from 1
+	Position      int32 `protobuf:"varint,2,opt,name=position,proto3" json:"position,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetGroupsByTeacherIdRequest) Reset() {
-	*x = GetGroupsByTeacherIdRequest{}
-	mi := &file_education_proto_msgTypes[32]
+func (x *MoveWaitlistEntryRequest) Reset() {
+	*x = MoveWaitlistEntryRequest{}
+	mi := &file_education_proto_msgTypes[117]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetGroupsByTeacherIdRequest) String() string {
+func (x *MoveWaitlistEntryRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetGroupsByTeacherIdRequest) ProtoMessage() {}
+func (*MoveWaitlistEntryRequest) ProtoMessage() {}
 
-func (x *GetGroupsByTeacherIdRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_education_proto_msgTypes[32]
+func (x *MoveWaitlistEntryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[117]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2415,47 +8817,47 @@ func (x *GetGroupsByTeacherIdRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetGroupsByTeacherIdRequest.ProtoReflect.Descriptor instead.
-func (*GetGroupsByTeacherIdRequest) Descriptor() ([]byte, []int) {
-	return file_education_proto_rawDescGZIP(), []int{32}
+// Deprecated: Use MoveWaitlistEntryRequest.ProtoReflect.Descriptor instead.
+func (*MoveWaitlistEntryRequest) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{117}
 }
 
-func (x *GetGroupsByTeacherIdRequest) GetTeacherId() string {
+func (x *MoveWaitlistEntryRequest) GetId() string {
 	if x != nil {
-		return x.TeacherId
+		return x.Id
 	}
 	return ""
 }
 
-func (x *GetGroupsByTeacherIdRequest) GetIsArchived() bool {
+func (x *MoveWaitlistEntryRequest) GetPosition() int32 {
 	if x != nil {
-		return x.IsArchived
+		return x.Position
 	}
-	return false
+	return 0
 }
 
-type GetGroupsByTeacherResponse struct {
-	state         protoimpl.MessageState  `protogen:"open.v1"`
-	Groups        []*GetGroupByTeacherAbs `protobuf:"bytes,1,rep,name=groups,proto3" json:"groups,omitempty"`
+type WaitlistEntryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetGroupsByTeacherResponse) Reset() {
-	*x = GetGroupsByTeacherResponse{}
-	mi := &file_education_proto_msgTypes[33]
+func (x *WaitlistEntryRequest) Reset() {
+	*x = WaitlistEntryRequest{}
+	mi := &file_education_proto_msgTypes[118]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetGroupsByTeacherResponse) String() string {
+func (x *WaitlistEntryRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetGroupsByTeacherResponse) ProtoMessage() {}
+func (*WaitlistEntryRequest) ProtoMessage() {}
 
-func (x *GetGroupsByTeacherResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_education_proto_msgTypes[33]
+func (x *WaitlistEntryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[118]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2466,49 +8868,44 @@ func (x *GetGroupsByTeacherResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetGroupsByTeacherResponse.ProtoReflect.Descriptor instead.
-func (*GetGroupsByTeacherResponse) Descriptor() ([]byte, []int) {
-	return file_education_proto_rawDescGZIP(), []int{33}
+// Deprecated: Use WaitlistEntryRequest.ProtoReflect.Descriptor instead.
+func (*WaitlistEntryRequest) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{118}
 }
 
-func (x *GetGroupsByTeacherResponse) GetGroups() []*GetGroupByTeacherAbs {
+func (x *WaitlistEntryRequest) GetId() string {
 	if x != nil {
-		return x.Groups
+		return x.Id
 	}
-	return nil
+	return ""
 }
 
-type GetGroupByTeacherAbs struct {
-	state              protoimpl.MessageState `protogen:"open.v1"`
-	Id                 string                 `protobuf:"bytes,10,opt,name=id,proto3" json:"id,omitempty"`
-	Name               string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	CourseName         string                 `protobuf:"bytes,2,opt,name=courseName,proto3" json:"courseName,omitempty"`
-	RoomName           string                 `protobuf:"bytes,3,opt,name=roomName,proto3" json:"roomName,omitempty"`
-	LessonStartTime    string                 `protobuf:"bytes,4,opt,name=lessonStartTime,proto3" json:"lessonStartTime,omitempty"`
-	DayType            string                 `protobuf:"bytes,5,opt,name=dayType,proto3" json:"dayType,omitempty"`
-	GroupStartAt       string                 `protobuf:"bytes,6,opt,name=groupStartAt,proto3" json:"groupStartAt,omitempty"`
-	GroupEndAt         string                 `protobuf:"bytes,7,opt,name=groupEndAt,proto3" json:"groupEndAt,omitempty"`
-	ActiveStudentCount int32                  `protobuf:"varint,8,opt,name=activeStudentCount,proto3" json:"activeStudentCount,omitempty"`
-	Students           []*AbsStudent          `protobuf:"bytes,9,rep,name=students,proto3" json:"students,omitempty"`
-	unknownFields      protoimpl.UnknownFields
-	sizeCache          protoimpl.SizeCache
+type AcceptWaitlistOfferRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	CreatedDate   string                 `protobuf:"bytes,2,opt,name=createdDate,proto3" json:"createdDate,omitempty"`
+	CreatedBy     string                 `protobuf:"bytes,3,opt,name=createdBy,proto3" json:"createdBy,omitempty"`
+	CreatedByName string                 `protobuf:"bytes,4,opt,name=createdByName,proto3" json:"createdByName,omitempty"`
+	PromoCode     string                 `protobuf:"bytes,5,opt,name=promoCode,proto3" json:"promoCode,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetGroupByTeacherAbs) Reset() {
-	*x = GetGroupByTeacherAbs{}
-	mi := &file_education_proto_msgTypes[34]
+func (x *AcceptWaitlistOfferRequest) Reset() {
+	*x = AcceptWaitlistOfferRequest{}
+	mi := &file_education_proto_msgTypes[119]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetGroupByTeacherAbs) String() string {
+func (x *AcceptWaitlistOfferRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetGroupByTeacherAbs) ProtoMessage() {}
+func (*AcceptWaitlistOfferRequest) ProtoMessage() {}
 
-func (x *GetGroupByTeacherAbs) ProtoReflect() protoreflect.Message {
-	mi := &file_education_proto_msgTypes[34]
+func (x *AcceptWaitlistOfferRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[119]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2519,111 +8916,83 @@ func (x *GetGroupByTeacherAbs) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetGroupByTeacherAbs.ProtoReflect.Descriptor instead.
-func (*GetGroupByTeacherAbs) Descriptor() ([]byte, []int) {
-	return file_education_proto_rawDescGZIP(), []int{34}
+// Deprecated: Use AcceptWaitlistOfferRequest.ProtoReflect.Descriptor instead.
+func (*AcceptWaitlistOfferRequest) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{119}
 }
 
-func (x *GetGroupByTeacherAbs) GetId() string {
+func (x *AcceptWaitlistOfferRequest) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-func (x *GetGroupByTeacherAbs) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
-}
-
-func (x *GetGroupByTeacherAbs) GetCourseName() string {
-	if x != nil {
-		return x.CourseName
-	}
-	return ""
-}
-
-func (x *GetGroupByTeacherAbs) GetRoomName() string {
-	if x != nil {
-		return x.RoomName
-	}
-	return ""
-}
-
-func (x *GetGroupByTeacherAbs) GetLessonStartTime() string {
+func (x *AcceptWaitlistOfferRequest) GetCreatedDate() string {
 	if x != nil {
-		return x.LessonStartTime
+		return x.CreatedDate
 	}
 	return ""
 }
 
-func (x *GetGroupByTeacherAbs) GetDayType() string {
+func (x *AcceptWaitlistOfferRequest) GetCreatedBy() string {
 	if x != nil {
-		return x.DayType
+		return x.CreatedBy
 	}
 	return ""
 }
 
-func (x *GetGroupByTeacherAbs) GetGroupStartAt() string {
+func (x *AcceptWaitlistOfferRequest) GetCreatedByName() string {
 	if x != nil {
-		return x.GroupStartAt
+		return x.CreatedByName
 	}
 	return ""
 }
 
-func (x *GetGroupByTeacherAbs) GetGroupEndAt() string {
+func (x *AcceptWaitlistOfferRequest) GetPromoCode() string {
 	if x != nil {
-		return x.GroupEndAt
+		return x.PromoCode
 	}
 	return ""
 }
 
-func (x *GetGroupByTeacherAbs) GetActiveStudentCount() int32 {
-	if x != nil {
-		return x.ActiveStudentCount
-	}
-	return 0
-}
-
-func (x *GetGroupByTeacherAbs) GetStudents() []*AbsStudent {
-	if x != nil {
-		return x.Students
-	}
-	return nil
-}
-
-type CreateGroupRequest struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	Name            string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	CourseId        int32                  `protobuf:"varint,2,opt,name=courseId,proto3" json:"courseId,omitempty"`
-	TeacherId       string                 `protobuf:"bytes,3,opt,name=teacherId,proto3" json:"teacherId,omitempty"`
-	Type            string                 `protobuf:"bytes,4,opt,name=type,proto3" json:"type,omitempty"`
-	Days            []string               `protobuf:"bytes,5,rep,name=days,proto3" json:"days,omitempty"`
-	RoomId          int32                  `protobuf:"varint,6,opt,name=roomId,proto3" json:"roomId,omitempty"`
-	LessonStartTime string                 `protobuf:"bytes,7,opt,name=lessonStartTime,proto3" json:"lessonStartTime,omitempty"`
-	GroupStartDate  string                 `protobuf:"bytes,8,opt,name=groupStartDate,proto3" json:"groupStartDate,omitempty"`
-	GroupEndDate    string                 `protobuf:"bytes,9,opt,name=groupEndDate,proto3" json:"groupEndDate,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+type AbsHoliday struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	// yyyy-MM-dd, a one day holiday has equal dates
+	FromDate string `protobuf:"bytes,3,opt,name=fromDate,proto3" json:"fromDate,omitempty"`
+	TillDate string `protobuf:"bytes,4,opt,name=tillDate,proto3" json:"tillDate,omitempty"`
+	// repeats every year on the same days
+	Recurring bool `protobuf:"varint,5,opt,name=recurring,proto3" json:"recurring,omitempty"`
+	// COMPANY closes every group, ROOM the groups of roomId, GROUP only groupId
+	Scope     string `protobuf:"bytes,6,opt,name=scope,proto3" json:"scope,omitempty"`
+	RoomId    int32  `protobuf:"varint,7,opt,name=roomId,proto3" json:"roomId,omitempty"`
+	GroupId   int64  `protobuf:"varint,8,opt,name=groupId,proto3" json:"groupId,omitempty"`
+	RoomName  string `protobuf:"bytes,9,opt,name=roomName,proto3" json:"roomName,omitempty"`
+	GroupName string `protobuf:"bytes,10,opt,name=groupName,proto3" json:"groupName,omitempty"`
+	// preloaded public holiday, it can be switched off but not deleted
+	IsNational    bool `protobuf:"varint,11,opt,name=isNational,proto3" json:"isNational,omitempty"`
+	IsActive      bool `protobuf:"varint,12,opt,name=isActive,proto3" json:"isActive,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateGroupRequest) Reset() {
-	*x = CreateGroupRequest{}
-	mi := &file_education_proto_msgTypes[35]
+func (x *AbsHoliday) Reset() {
+	*x = AbsHoliday{}
+	mi := &file_education_proto_msgTypes[120]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateGroupRequest) String() string {
+func (x *AbsHoliday) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateGroupRequest) ProtoMessage() {}
+func (*AbsHoliday) ProtoMessage() {}
 
-func (x *CreateGroupRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_education_proto_msgTypes[35]
+func (x *AbsHoliday) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[120]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2634,98 +9003,119 @@ func (x *CreateGroupRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateGroupRequest.ProtoReflect.Descriptor instead.
-func (*CreateGroupRequest) Descriptor() ([]byte, []int) {
-	return file_education_proto_rawDescGZIP(), []int{35}
+// Deprecated: Use AbsHoliday.ProtoReflect.Descriptor instead.
+func (*AbsHoliday) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{120}
 }
 
-func (x *CreateGroupRequest) GetName() string {
+func (x *AbsHoliday) GetId() string {
 	if x != nil {
-		return x.Name
+		return x.Id
 	}
 	return ""
 }
 
-func (x *CreateGroupRequest) GetCourseId() int32 {
+func (x *AbsHoliday) GetTitle() string {
 	if x != nil {
-		return x.CourseId
+		return x.Title
 	}
-	return 0
+	return ""
 }
 
-func (x *CreateGroupRequest) GetTeacherId() string {
+func (x *AbsHoliday) GetFromDate() string {
 	if x != nil {
-		return x.TeacherId
+		return x.FromDate
 	}
 	return ""
 }
 
-func (x *CreateGroupRequest) GetType() string {
+func (x *AbsHoliday) GetTillDate() string {
 	if x != nil {
-		return x.Type
+		return x.TillDate
 	}
 	return ""
 }
 
-func (x *CreateGroupRequest) GetDays() []string {
+func (x *AbsHoliday) GetRecurring() bool {
 	if x != nil {
-		return x.Days
+		return x.Recurring
 	}
-	return nil
+	return false
 }
 
-func (x *CreateGroupRequest) GetRoomId() int32 {
+func (x *AbsHoliday) GetScope() string {
+	if x != nil {
+		return x.Scope
+	}
+	return ""
+}
+
+func (x *AbsHoliday) GetRoomId() int32 {
 	if x != nil {
 		return x.RoomId
 	}
 	return 0
 }
 
-func (x *CreateGroupRequest) GetLessonStartTime() string {
+func (x *AbsHoliday) GetGroupId() int64 {
 	if x != nil {
-		return x.LessonStartTime
+		return x.GroupId
 	}
-	return ""
+	return 0
 }
 
-func (x *CreateGroupRequest) GetGroupStartDate() string {
+func (x *AbsHoliday) GetRoomName() string {
 	if x != nil {
-		return x.GroupStartDate
+		return x.RoomName
 	}
 	return ""
 }
 
-func (x *CreateGroupRequest) GetGroupEndDate() string {
+func (x *AbsHoliday) GetGroupName() string {
 	if x != nil {
-		return x.GroupEndDate
+		return x.GroupName
 	}
 	return ""
 }
 
-type GetGroupByIdRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	ActionRole    string                 `protobuf:"bytes,2,opt,name=actionRole,proto3" json:"actionRole,omitempty"`
-	ActionId      string                 `protobuf:"bytes,3,opt,name=actionId,proto3" json:"actionId,omitempty"`
+func (x *AbsHoliday) GetIsNational() bool {
+	if x != nil {
+		return x.IsNational
+	}
+	return false
+}
+
+func (x *AbsHoliday) GetIsActive() bool {
+	if x != nil {
+		return x.IsActive
+	}
+	return false
+}
+
+type GetHolidaysRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// yyyy-MM-dd, holidays overlapping the period, all when empty
+	From          string `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	Till          string `protobuf:"bytes,2,opt,name=till,proto3" json:"till,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetGroupByIdRequest) Reset() {
-	*x = GetGroupByIdRequest{}
-	mi := &file_education_proto_msgTypes[36]
+func (x *GetHolidaysRequest) Reset() {
+	*x = GetHolidaysRequest{}
+	mi := &file_education_proto_msgTypes[121]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetGroupByIdRequest) String() string {
+func (x *GetHolidaysRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetGroupByIdRequest) ProtoMessage() {}
+func (*GetHolidaysRequest) ProtoMessage() {}
 
-func (x *GetGroupByIdRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_education_proto_msgTypes[36]
+func (x *GetHolidaysRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[121]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2736,63 +9126,47 @@ func (x *GetGroupByIdRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetGroupByIdRequest.ProtoReflect.Descriptor instead.
-func (*GetGroupByIdRequest) Descriptor() ([]byte, []int) {
-	return file_education_proto_rawDescGZIP(), []int{36}
-}
-
-func (x *GetGroupByIdRequest) GetId() string {
-	if x != nil {
-		return x.Id
-	}
-	return ""
+// Deprecated: Use GetHolidaysRequest.ProtoReflect.Descriptor instead.
+func (*GetHolidaysRequest) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{121}
 }
 
-func (x *GetGroupByIdRequest) GetActionRole() string {
+func (x *GetHolidaysRequest) GetFrom() string {
 	if x != nil {
-		return x.ActionRole
+		return x.From
 	}
 	return ""
 }
 
-func (x *GetGroupByIdRequest) GetActionId() string {
+func (x *GetHolidaysRequest) GetTill() string {
 	if x != nil {
-		return x.ActionId
+		return x.Till
 	}
 	return ""
 }
 
-type GetUpdateGroupAbs struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	Id              string                 `protobuf:"bytes,10,opt,name=id,proto3" json:"id,omitempty"`
-	Name            string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	CourseId        int32                  `protobuf:"varint,2,opt,name=courseId,proto3" json:"courseId,omitempty"`
-	TeacherId       string                 `protobuf:"bytes,3,opt,name=teacherId,proto3" json:"teacherId,omitempty"`
-	Type            string                 `protobuf:"bytes,4,opt,name=type,proto3" json:"type,omitempty"`
-	Days            []string               `protobuf:"bytes,5,rep,name=days,proto3" json:"days,omitempty"`
-	RoomId          int32                  `protobuf:"varint,6,opt,name=roomId,proto3" json:"roomId,omitempty"`
-	LessonStartTime string                 `protobuf:"bytes,7,opt,name=lessonStartTime,proto3" json:"lessonStartTime,omitempty"`
-	GroupStartDate  string                 `protobuf:"bytes,8,opt,name=groupStartDate,proto3" json:"groupStartDate,omitempty"`
-	GroupEndDate    string                 `protobuf:"bytes,9,opt,name=groupEndDate,proto3" json:"groupEndDate,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+type GetHolidaysResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Holidays      []*AbsHoliday          `protobuf:"bytes,1,rep,name=holidays,proto3" json:"holidays,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetUpdateGroupAbs) Reset() {
-	*x = GetUpdateGroupAbs{}
-	mi := &file_education_proto_msgTypes[37]
+func (x *GetHolidaysResponse) Reset() {
+	*x = GetHolidaysResponse{}
+	mi := &file_education_proto_msgTypes[122]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetUpdateGroupAbs) String() string {
+func (x *GetHolidaysResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetUpdateGroupAbs) ProtoMessage() {}
+func (*GetHolidaysResponse) ProtoMessage() {}
 
-func (x *GetUpdateGroupAbs) ProtoReflect() protoreflect.Message {
-	mi := &file_education_proto_msgTypes[37]
+func (x *GetHolidaysResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[122]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2803,103 +9177,101 @@ func (x *GetUpdateGroupAbs) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetUpdateGroupAbs.ProtoReflect.Descriptor instead.
-func (*GetUpdateGroupAbs) Descriptor() ([]byte, []int) {
-	return file_education_proto_rawDescGZIP(), []int{37}
-}
-
-func (x *GetUpdateGroupAbs) GetId() string {
-	if x != nil {
-		return x.Id
-	}
-	return ""
+// Deprecated: Use GetHolidaysResponse.ProtoReflect.Descriptor instead.
+func (*GetHolidaysResponse) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{122}
 }
 
-func (x *GetUpdateGroupAbs) GetName() string {
+func (x *GetHolidaysResponse) GetHolidays() []*AbsHoliday {
 	if x != nil {
-		return x.Name
+		return x.Holidays
 	}
-	return ""
+	return nil
 }
 
-func (x *GetUpdateGroupAbs) GetCourseId() int32 {
-	if x != nil {
-		return x.CourseId
-	}
-	return 0
+type GetGroupHolidaysRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GroupId       string                 `protobuf:"bytes,1,opt,name=groupId,proto3" json:"groupId,omitempty"`
+	From          string                 `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
+	Till          string                 `protobuf:"bytes,3,opt,name=till,proto3" json:"till,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetUpdateGroupAbs) GetTeacherId() string {
-	if x != nil {
-		return x.TeacherId
-	}
-	return ""
+func (x *GetGroupHolidaysRequest) Reset() {
+	*x = GetGroupHolidaysRequest{}
+	mi := &file_education_proto_msgTypes[123]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetUpdateGroupAbs) GetType() string {
-	if x != nil {
-		return x.Type
-	}
-	return ""
+func (x *GetGroupHolidaysRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *GetUpdateGroupAbs) GetDays() []string {
+func (*GetGroupHolidaysRequest) ProtoMessage() {}
+
+func (x *GetGroupHolidaysRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[123]
 	if x != nil {
-		return x.Days
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *GetUpdateGroupAbs) GetRoomId() int32 {
-	if x != nil {
-		return x.RoomId
-	}
-	return 0
+// Deprecated: Use GetGroupHolidaysRequest.ProtoReflect.Descriptor instead.
+func (*GetGroupHolidaysRequest) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{123}
 }
 
-func (x *GetUpdateGroupAbs) GetLessonStartTime() string {
+func (x *GetGroupHolidaysRequest) GetGroupId() string {
 	if x != nil {
-		return x.LessonStartTime
+		return x.GroupId
 	}
 	return ""
 }
 
-func (x *GetUpdateGroupAbs) GetGroupStartDate() string {
+func (x *GetGroupHolidaysRequest) GetFrom() string {
 	if x != nil {
-		return x.GroupStartDate
+		return x.From
 	}
 	return ""
 }
 
-func (x *GetUpdateGroupAbs) GetGroupEndDate() string {
+func (x *GetGroupHolidaysRequest) GetTill() string {
 	if x != nil {
-		return x.GroupEndDate
+		return x.Till
 	}
 	return ""
 }
 
-type GetGroupsByCourseResponse struct {
-	state         protoimpl.MessageState         `protogen:"open.v1"`
-	Groups        []*GetGroupByCourseAbsResponse `protobuf:"bytes,1,rep,name=groups,proto3" json:"groups,omitempty"`
+type GetGroupHolidaysResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// days without lessons of the group in the period
+	Dates         []string `protobuf:"bytes,1,rep,name=dates,proto3" json:"dates,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetGroupsByCourseResponse) Reset() {
-	*x = GetGroupsByCourseResponse{}
-	mi := &file_education_proto_msgTypes[38]
+func (x *GetGroupHolidaysResponse) Reset() {
+	*x = GetGroupHolidaysResponse{}
+	mi := &file_education_proto_msgTypes[124]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetGroupsByCourseResponse) String() string {
+func (x *GetGroupHolidaysResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetGroupsByCourseResponse) ProtoMessage() {}
+func (*GetGroupHolidaysResponse) ProtoMessage() {}
 
-func (x *GetGroupsByCourseResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_education_proto_msgTypes[38]
+func (x *GetGroupHolidaysResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[124]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2910,46 +9282,47 @@ func (x *GetGroupsByCourseResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetGroupsByCourseResponse.ProtoReflect.Descriptor instead.
-func (*GetGroupsByCourseResponse) Descriptor() ([]byte, []int) {
-	return file_education_proto_rawDescGZIP(), []int{38}
+// Deprecated: Use GetGroupHolidaysResponse.ProtoReflect.Descriptor instead.
+func (*GetGroupHolidaysResponse) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{124}
 }
 
-func (x *GetGroupsByCourseResponse) GetGroups() []*GetGroupByCourseAbsResponse {
+func (x *GetGroupHolidaysResponse) GetDates() []string {
 	if x != nil {
-		return x.Groups
+		return x.Dates
 	}
 	return nil
 }
 
-type GetGroupByCourseAbsResponse struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	Id              string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	TeacherName     string                 `protobuf:"bytes,2,opt,name=teacherName,proto3" json:"teacherName,omitempty"`
-	GroupStartDate  string                 `protobuf:"bytes,3,opt,name=groupStartDate,proto3" json:"groupStartDate,omitempty"`
-	GroupEndDate    string                 `protobuf:"bytes,4,opt,name=groupEndDate,proto3" json:"groupEndDate,omitempty"`
-	DateType        string                 `protobuf:"bytes,5,opt,name=dateType,proto3" json:"dateType,omitempty"`
-	LessonStartTime string                 `protobuf:"bytes,6,opt,name=lessonStartTime,proto3" json:"lessonStartTime,omitempty"`
-	GroupName       string                 `protobuf:"bytes,7,opt,name=groupName,proto3" json:"groupName,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+type GetTimetableRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// yyyy-MM-dd, at most 93 days apart
+	From string `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	Till string `protobuf:"bytes,2,opt,name=till,proto3" json:"till,omitempty"`
+	// filters, zero or empty values are not applied
+	RoomId        int32  `protobuf:"varint,3,opt,name=roomId,proto3" json:"roomId,omitempty"`
+	TeacherId     string `protobuf:"bytes,4,opt,name=teacherId,proto3" json:"teacherId,omitempty"`
+	CourseId      int32  `protobuf:"varint,5,opt,name=courseId,proto3" json:"courseId,omitempty"`
+	GroupId       string `protobuf:"bytes,6,opt,name=groupId,proto3" json:"groupId,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetGroupByCourseAbsResponse) Reset() {
-	*x = GetGroupByCourseAbsResponse{}
-	mi := &file_education_proto_msgTypes[39]
+func (x *GetTimetableRequest) Reset() {
+	*x = GetTimetableRequest{}
+	mi := &file_education_proto_msgTypes[125]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetGroupByCourseAbsResponse) String() string {
+func (x *GetTimetableRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetGroupByCourseAbsResponse) ProtoMessage() {}
+func (*GetTimetableRequest) ProtoMessage() {}
 
-func (x *GetGroupByCourseAbsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_education_proto_msgTypes[39]
+func (x *GetTimetableRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[125]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2960,95 +9333,90 @@ func (x *GetGroupByCourseAbsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetGroupByCourseAbsResponse.ProtoReflect.Descriptor instead.
-func (*GetGroupByCourseAbsResponse) Descriptor() ([]byte, []int) {
-	return file_education_proto_rawDescGZIP(), []int{39}
-}
-
-func (x *GetGroupByCourseAbsResponse) GetId() string {
-	if x != nil {
-		return x.Id
-	}
-	return ""
+// Deprecated: Use GetTimetableRequest.ProtoReflect.Descriptor instead.
+func (*GetTimetableRequest) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{125}
 }
 
-func (x *GetGroupByCourseAbsResponse) GetTeacherName() string {
+func (x *GetTimetableRequest) GetFrom() string {
 	if x != nil {
-		return x.TeacherName
+		return x.From
 	}
 	return ""
 }
 
-func (x *GetGroupByCourseAbsResponse) GetGroupStartDate() string {
+func (x *GetTimetableRequest) GetTill() string {
 	if x != nil {
-		return x.GroupStartDate
+		return x.Till
 	}
 	return ""
 }
 
-func (x *GetGroupByCourseAbsResponse) GetGroupEndDate() string {
+func (x *GetTimetableRequest) GetRoomId() int32 {
 	if x != nil {
-		return x.GroupEndDate
+		return x.RoomId
 	}
-	return ""
+	return 0
 }
 
-func (x *GetGroupByCourseAbsResponse) GetDateType() string {
+func (x *GetTimetableRequest) GetTeacherId() string {
 	if x != nil {
-		return x.DateType
+		return x.TeacherId
 	}
 	return ""
 }
 
-func (x *GetGroupByCourseAbsResponse) GetLessonStartTime() string {
+func (x *GetTimetableRequest) GetCourseId() int32 {
 	if x != nil {
-		return x.LessonStartTime
+		return x.CourseId
 	}
-	return ""
+	return 0
 }
 
-func (x *GetGroupByCourseAbsResponse) GetGroupName() string {
+func (x *GetTimetableRequest) GetGroupId() string {
 	if x != nil {
-		return x.GroupName
+		return x.GroupId
 	}
 	return ""
 }
 
-type GetGroupAbsResponse struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	Id              string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Course          *AbsCourse             `protobuf:"bytes,2,opt,name=course,proto3" json:"course,omitempty"`
-	TeacherName     string                 `protobuf:"bytes,3,opt,name=teacherName,proto3" json:"teacherName,omitempty"`
-	TeacherId       string                 `protobuf:"bytes,14,opt,name=teacherId,proto3" json:"teacherId,omitempty"`
-	LessonStartTime string                 `protobuf:"bytes,4,opt,name=lessonStartTime,proto3" json:"lessonStartTime,omitempty"`
-	Room            *AbsRoom               `protobuf:"bytes,5,opt,name=room,proto3" json:"room,omitempty"`
-	StudentCount    int32                  `protobuf:"varint,6,opt,name=studentCount,proto3" json:"studentCount,omitempty"`
-	Name            string                 `protobuf:"bytes,7,opt,name=name,proto3" json:"name,omitempty"`
-	IsArchived      bool                   `protobuf:"varint,8,opt,name=isArchived,proto3" json:"isArchived,omitempty"`
-	CreatedAt       string                 `protobuf:"bytes,9,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
-	StartDate       string                 `protobuf:"bytes,10,opt,name=startDate,proto3" json:"startDate,omitempty"`
-	EndDate         string                 `protobuf:"bytes,11,opt,name=endDate,proto3" json:"endDate,omitempty"`
-	Days            []string               `protobuf:"bytes,12,rep,name=days,proto3" json:"days,omitempty"`
-	DateType        string                 `protobuf:"bytes,13,opt,name=dateType,proto3" json:"dateType,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+type TimetableLesson struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	GroupId     int64                  `protobuf:"varint,1,opt,name=groupId,proto3" json:"groupId,omitempty"`
+	GroupName   string                 `protobuf:"bytes,2,opt,name=groupName,proto3" json:"groupName,omitempty"`
+	CourseId    int32                  `protobuf:"varint,3,opt,name=courseId,proto3" json:"courseId,omitempty"`
+	CourseName  string                 `protobuf:"bytes,4,opt,name=courseName,proto3" json:"courseName,omitempty"`
+	TeacherId   string                 `protobuf:"bytes,5,opt,name=teacherId,proto3" json:"teacherId,omitempty"`
+	TeacherName string                 `protobuf:"bytes,6,opt,name=teacherName,proto3" json:"teacherName,omitempty"`
+	RoomId      int32                  `protobuf:"varint,7,opt,name=roomId,proto3" json:"roomId,omitempty"`
+	RoomName    string                 `protobuf:"bytes,8,opt,name=roomName,proto3" json:"roomName,omitempty"`
+	// yyyy-MM-dd
+	Date string `protobuf:"bytes,9,opt,name=date,proto3" json:"date,omitempty"`
+	// HH:mm, endTime adds the lesson duration of the course
+	StartTime string `protobuf:"bytes,10,opt,name=startTime,proto3" json:"startTime,omitempty"`
+	EndTime   string `protobuf:"bytes,11,opt,name=endTime,proto3" json:"endTime,omitempty"`
+	// realDate is the date the lesson was moved from when transferred is set
+	Transferred   bool   `protobuf:"varint,12,opt,name=transferred,proto3" json:"transferred,omitempty"`
+	RealDate      string `protobuf:"bytes,13,opt,name=realDate,proto3" json:"realDate,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetGroupAbsResponse) Reset() {
-	*x = GetGroupAbsResponse{}
-	mi := &file_education_proto_msgTypes[40]
+func (x *TimetableLesson) Reset() {
+	*x = TimetableLesson{}
+	mi := &file_education_proto_msgTypes[126]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetGroupAbsResponse) String() string {
+func (x *TimetableLesson) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetGroupAbsResponse) ProtoMessage() {}
+func (*TimetableLesson) ProtoMessage() {}
 
-func (x *GetGroupAbsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_education_proto_msgTypes[40]
+func (x *TimetableLesson) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[126]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3059,132 +9427,171 @@ func (x *GetGroupAbsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetGroupAbsResponse.ProtoReflect.Descriptor instead.
-func (*GetGroupAbsResponse) Descriptor() ([]byte, []int) {
-	return file_education_proto_rawDescGZIP(), []int{40}
+// Deprecated: Use TimetableLesson.ProtoReflect.Descriptor instead.
+func (*TimetableLesson) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{126}
 }
 
-func (x *GetGroupAbsResponse) GetId() string {
+func (x *TimetableLesson) GetGroupId() int64 {
 	if x != nil {
-		return x.Id
+		return x.GroupId
+	}
+	return 0
+}
+
+func (x *TimetableLesson) GetGroupName() string {
+	if x != nil {
+		return x.GroupName
 	}
 	return ""
 }
 
-func (x *GetGroupAbsResponse) GetCourse() *AbsCourse {
+func (x *TimetableLesson) GetCourseId() int32 {
 	if x != nil {
-		return x.Course
+		return x.CourseId
 	}
-	return nil
+	return 0
 }
 
-func (x *GetGroupAbsResponse) GetTeacherName() string {
+func (x *TimetableLesson) GetCourseName() string {
 	if x != nil {
-		return x.TeacherName
+		return x.CourseName
 	}
 	return ""
 }
 
-func (x *GetGroupAbsResponse) GetTeacherId() string {
+func (x *TimetableLesson) GetTeacherId() string {
 	if x != nil {
 		return x.TeacherId
 	}
 	return ""
 }
 
-func (x *GetGroupAbsResponse) GetLessonStartTime() string {
+func (x *TimetableLesson) GetTeacherName() string {
 	if x != nil {
-		return x.LessonStartTime
+		return x.TeacherName
 	}
 	return ""
 }
 
-func (x *GetGroupAbsResponse) GetRoom() *AbsRoom {
+func (x *TimetableLesson) GetRoomId() int32 {
 	if x != nil {
-		return x.Room
+		return x.RoomId
 	}
-	return nil
+	return 0
 }
 
-func (x *GetGroupAbsResponse) GetStudentCount() int32 {
+func (x *TimetableLesson) GetRoomName() string {
 	if x != nil {
-		return x.StudentCount
+		return x.RoomName
 	}
-	return 0
+	return ""
 }
 
-func (x *GetGroupAbsResponse) GetName() string {
+func (x *TimetableLesson) GetDate() string {
 	if x != nil {
-		return x.Name
+		return x.Date
 	}
 	return ""
 }
 
-func (x *GetGroupAbsResponse) GetIsArchived() bool {
+func (x *TimetableLesson) GetStartTime() string {
 	if x != nil {
-		return x.IsArchived
+		return x.StartTime
 	}
-	return false
+	return ""
 }
 
-func (x *GetGroupAbsResponse) GetCreatedAt() string {
+func (x *TimetableLesson) GetEndTime() string {
 	if x != nil {
-		return x.CreatedAt
+		return x.EndTime
 	}
 	return ""
 }
 
-func (x *GetGroupAbsResponse) GetStartDate() string {
+func (x *TimetableLesson) GetTransferred() bool {
 	if x != nil {
-		return x.StartDate
+		return x.Transferred
 	}
-	return ""
+	return false
 }
 
-func (x *GetGroupAbsResponse) GetEndDate() string {
+func (x *TimetableLesson) GetRealDate() string {
 	if x != nil {
-		return x.EndDate
+		return x.RealDate
 	}
 	return ""
 }
 
-func (x *GetGroupAbsResponse) GetDays() []string {
+type GetTimetableResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Lessons       []*TimetableLesson     `protobuf:"bytes,1,rep,name=lessons,proto3" json:"lessons,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTimetableResponse) Reset() {
+	*x = GetTimetableResponse{}
+	mi := &file_education_proto_msgTypes[127]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTimetableResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTimetableResponse) ProtoMessage() {}
+
+func (x *GetTimetableResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[127]
 	if x != nil {
-		return x.Days
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *GetGroupAbsResponse) GetDateType() string {
+// Deprecated: Use GetTimetableResponse.ProtoReflect.Descriptor instead.
+func (*GetTimetableResponse) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{127}
+}
+
+func (x *GetTimetableResponse) GetLessons() []*TimetableLesson {
 	if x != nil {
-		return x.DateType
+		return x.Lessons
 	}
-	return ""
+	return nil
 }
 
-type GetGroupsResponse struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	Groups         []*GetGroupAbsResponse `protobuf:"bytes,1,rep,name=groups,proto3" json:"groups,omitempty"`
-	TotalPageCount int32                  `protobuf:"varint,2,opt,name=totalPageCount,proto3" json:"totalPageCount,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+type CreateCalendarFeedRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// TEACHER or ROOM
+	OwnerType string `protobuf:"bytes,1,opt,name=ownerType,proto3" json:"ownerType,omitempty"`
+	// teacher id or room id
+	OwnerId       string `protobuf:"bytes,2,opt,name=ownerId,proto3" json:"ownerId,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetGroupsResponse) Reset() {
-	*x = GetGroupsResponse{}
-	mi := &file_education_proto_msgTypes[41]
+func (x *CreateCalendarFeedRequest) Reset() {
+	*x = CreateCalendarFeedRequest{}
+	mi := &file_education_proto_msgTypes[128]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetGroupsResponse) String() string {
+func (x *CreateCalendarFeedRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetGroupsResponse) ProtoMessage() {}
+func (*CreateCalendarFeedRequest) ProtoMessage() {}
 
-func (x *GetGroupsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_education_proto_msgTypes[41]
+func (x *CreateCalendarFeedRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[128]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3195,55 +9602,54 @@ func (x *GetGroupsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetGroupsResponse.ProtoReflect.Descriptor instead.
-func (*GetGroupsResponse) Descriptor() ([]byte, []int) {
-	return file_education_proto_rawDescGZIP(), []int{41}
+// Deprecated: Use CreateCalendarFeedRequest.ProtoReflect.Descriptor instead.
+func (*CreateCalendarFeedRequest) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{128}
 }
 
-func (x *GetGroupsResponse) GetGroups() []*GetGroupAbsResponse {
+func (x *CreateCalendarFeedRequest) GetOwnerType() string {
 	if x != nil {
-		return x.Groups
+		return x.OwnerType
 	}
-	return nil
+	return ""
 }
 
-func (x *GetGroupsResponse) GetTotalPageCount() int32 {
+func (x *CreateCalendarFeedRequest) GetOwnerId() string {
 	if x != nil {
-		return x.TotalPageCount
+		return x.OwnerId
 	}
-	return 0
+	return ""
 }
 
-type GetGroupsRequest struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	IsArchived     bool                   `protobuf:"varint,1,opt,name=isArchived,proto3" json:"isArchived,omitempty"`
-	TeacherId      *string                `protobuf:"bytes,2,opt,name=teacherId,proto3,oneof" json:"teacherId,omitempty"`
-	CourseId       *int32                 `protobuf:"varint,3,opt,name=courseId,proto3,oneof" json:"courseId,omitempty"`
-	DateType       *string                `protobuf:"bytes,4,opt,name=dateType,proto3,oneof" json:"dateType,omitempty"`
-	StartDate      *string                `protobuf:"bytes,5,opt,name=startDate,proto3,oneof" json:"startDate,omitempty"`
-	EndDate        *string                `protobuf:"bytes,6,opt,name=endDate,proto3,oneof" json:"endDate,omitempty"`
-	Page           *PageRequest           `protobuf:"bytes,7,opt,name=page,proto3" json:"page,omitempty"`
-	OrderBy        string                 `protobuf:"bytes,8,opt,name=orderBy,proto3" json:"orderBy,omitempty"`
-	OrderDirection string                 `protobuf:"bytes,9,opt,name=orderDirection,proto3" json:"orderDirection,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+type CalendarFeed struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Id        string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	OwnerType string                 `protobuf:"bytes,2,opt,name=ownerType,proto3" json:"ownerType,omitempty"`
+	OwnerId   string                 `protobuf:"bytes,3,opt,name=ownerId,proto3" json:"ownerId,omitempty"`
+	OwnerName string                 `protobuf:"bytes,4,opt,name=ownerName,proto3" json:"ownerName,omitempty"`
+	Token     string                 `protobuf:"bytes,5,opt,name=token,proto3" json:"token,omitempty"`
+	CreatedAt string                 `protobuf:"bytes,6,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	// url is the .ics address, it is filled in by the gateway
+	Url           string `protobuf:"bytes,7,opt,name=url,proto3" json:"url,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetGroupsRequest) Reset() {
-	*x = GetGroupsRequest{}
-	mi := &file_education_proto_msgTypes[42]
+func (x *CalendarFeed) Reset() {
+	*x = CalendarFeed{}
+	mi := &file_education_proto_msgTypes[129]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetGroupsRequest) String() string {
+func (x *CalendarFeed) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetGroupsRequest) ProtoMessage() {}
+func (*CalendarFeed) ProtoMessage() {}
 
-func (x *GetGroupsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_education_proto_msgTypes[42]
+func (x *CalendarFeed) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[129]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3254,98 +9660,134 @@ func (x *GetGroupsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetGroupsRequest.ProtoReflect.Descriptor instead.
-func (*GetGroupsRequest) Descriptor() ([]byte, []int) {
-	return file_education_proto_rawDescGZIP(), []int{42}
+// Deprecated: Use CalendarFeed.ProtoReflect.Descriptor instead.
+func (*CalendarFeed) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{129}
 }
 
-func (x *GetGroupsRequest) GetIsArchived() bool {
+func (x *CalendarFeed) GetId() string {
 	if x != nil {
-		return x.IsArchived
+		return x.Id
 	}
-	return false
+	return ""
 }
 
-func (x *GetGroupsRequest) GetTeacherId() string {
-	if x != nil && x.TeacherId != nil {
-		return *x.TeacherId
+func (x *CalendarFeed) GetOwnerType() string {
+	if x != nil {
+		return x.OwnerType
 	}
 	return ""
 }
 
-func (x *GetGroupsRequest) GetCourseId() int32 {
-	if x != nil && x.CourseId != nil {
-		return *x.CourseId
+func (x *CalendarFeed) GetOwnerId() string {
+	if x != nil {
+		return x.OwnerId
 	}
-	return 0
+	return ""
 }
 
-func (x *GetGroupsRequest) GetDateType() string {
-	if x != nil && x.DateType != nil {
-		return *x.DateType
+func (x *CalendarFeed) GetOwnerName() string {
+	if x != nil {
+		return x.OwnerName
 	}
 	return ""
 }
 
-func (x *GetGroupsRequest) GetStartDate() string {
-	if x != nil && x.StartDate != nil {
-		return *x.StartDate
+func (x *CalendarFeed) GetToken() string {
+	if x != nil {
+		return x.Token
 	}
 	return ""
 }
 
-func (x *GetGroupsRequest) GetEndDate() string {
-	if x != nil && x.EndDate != nil {
-		return *x.EndDate
+func (x *CalendarFeed) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
 	}
 	return ""
 }
 
-func (x *GetGroupsRequest) GetPage() *PageRequest {
+func (x *CalendarFeed) GetUrl() string {
 	if x != nil {
-		return x.Page
+		return x.Url
 	}
-	return nil
+	return ""
 }
 
-func (x *GetGroupsRequest) GetOrderBy() string {
+type GetCalendarFeedsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OwnerType     string                 `protobuf:"bytes,1,opt,name=ownerType,proto3" json:"ownerType,omitempty"`
+	OwnerId       string                 `protobuf:"bytes,2,opt,name=ownerId,proto3" json:"ownerId,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCalendarFeedsRequest) Reset() {
+	*x = GetCalendarFeedsRequest{}
+	mi := &file_education_proto_msgTypes[130]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCalendarFeedsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCalendarFeedsRequest) ProtoMessage() {}
+
+func (x *GetCalendarFeedsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[130]
 	if x != nil {
-		return x.OrderBy
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCalendarFeedsRequest.ProtoReflect.Descriptor instead.
+func (*GetCalendarFeedsRequest) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{130}
+}
+
+func (x *GetCalendarFeedsRequest) GetOwnerType() string {
+	if x != nil {
+		return x.OwnerType
 	}
 	return ""
 }
 
-func (x *GetGroupsRequest) GetOrderDirection() string {
+func (x *GetCalendarFeedsRequest) GetOwnerId() string {
 	if x != nil {
-		return x.OrderDirection
+		return x.OwnerId
 	}
 	return ""
 }
 
-type CalculateTeacherSalaryRequest struct {
+type GetCalendarFeedsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	From          string                 `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
-	To            string                 `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
-	TeacherId     string                 `protobuf:"bytes,3,opt,name=teacherId,proto3" json:"teacherId,omitempty"`
+	Feeds         []*CalendarFeed        `protobuf:"bytes,1,rep,name=feeds,proto3" json:"feeds,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CalculateTeacherSalaryRequest) Reset() {
-	*x = CalculateTeacherSalaryRequest{}
-	mi := &file_education_proto_msgTypes[43]
+func (x *GetCalendarFeedsResponse) Reset() {
+	*x = GetCalendarFeedsResponse{}
+	mi := &file_education_proto_msgTypes[131]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CalculateTeacherSalaryRequest) String() string {
+func (x *GetCalendarFeedsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CalculateTeacherSalaryRequest) ProtoMessage() {}
+func (*GetCalendarFeedsResponse) ProtoMessage() {}
 
-func (x *CalculateTeacherSalaryRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_education_proto_msgTypes[43]
+func (x *GetCalendarFeedsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[131]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3356,54 +9798,85 @@ func (x *CalculateTeacherSalaryRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CalculateTeacherSalaryRequest.ProtoReflect.Descriptor instead.
-func (*CalculateTeacherSalaryRequest) Descriptor() ([]byte, []int) {
-	return file_education_proto_rawDescGZIP(), []int{43}
+// Deprecated: Use GetCalendarFeedsResponse.ProtoReflect.Descriptor instead.
+func (*GetCalendarFeedsResponse) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{131}
 }
 
-func (x *CalculateTeacherSalaryRequest) GetFrom() string {
+func (x *GetCalendarFeedsResponse) GetFeeds() []*CalendarFeed {
 	if x != nil {
-		return x.From
+		return x.Feeds
 	}
-	return ""
+	return nil
 }
 
-func (x *CalculateTeacherSalaryRequest) GetTo() string {
+type GetCalendarFeedTimetableRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCalendarFeedTimetableRequest) Reset() {
+	*x = GetCalendarFeedTimetableRequest{}
+	mi := &file_education_proto_msgTypes[132]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCalendarFeedTimetableRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCalendarFeedTimetableRequest) ProtoMessage() {}
+
+func (x *GetCalendarFeedTimetableRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[132]
 	if x != nil {
-		return x.To
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *CalculateTeacherSalaryRequest) GetTeacherId() string {
+// Deprecated: Use GetCalendarFeedTimetableRequest.ProtoReflect.Descriptor instead.
+func (*GetCalendarFeedTimetableRequest) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{132}
+}
+
+func (x *GetCalendarFeedTimetableRequest) GetToken() string {
 	if x != nil {
-		return x.TeacherId
+		return x.Token
 	}
 	return ""
 }
 
-type CalculateTeacherSalaryResponse struct {
+type CalendarFeedTimetable struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Salaries      []*AbsCalculateSalary  `protobuf:"bytes,1,rep,name=salaries,proto3" json:"salaries,omitempty"`
+	Feed          *CalendarFeed          `protobuf:"bytes,1,opt,name=feed,proto3" json:"feed,omitempty"`
+	Lessons       []*TimetableLesson     `protobuf:"bytes,2,rep,name=lessons,proto3" json:"lessons,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CalculateTeacherSalaryResponse) Reset() {
-	*x = CalculateTeacherSalaryResponse{}
-	mi := &file_education_proto_msgTypes[44]
+func (x *CalendarFeedTimetable) Reset() {
+	*x = CalendarFeedTimetable{}
+	mi := &file_education_proto_msgTypes[133]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CalculateTeacherSalaryResponse) String() string {
+func (x *CalendarFeedTimetable) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CalculateTeacherSalaryResponse) ProtoMessage() {}
+func (*CalendarFeedTimetable) ProtoMessage() {}
 
-func (x *CalculateTeacherSalaryResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_education_proto_msgTypes[44]
+func (x *CalendarFeedTimetable) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[133]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3414,43 +9887,62 @@ func (x *CalculateTeacherSalaryResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CalculateTeacherSalaryResponse.ProtoReflect.Descriptor instead.
-func (*CalculateTeacherSalaryResponse) Descriptor() ([]byte, []int) {
-	return file_education_proto_rawDescGZIP(), []int{44}
+// Deprecated: Use CalendarFeedTimetable.ProtoReflect.Descriptor instead.
+func (*CalendarFeedTimetable) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{133}
 }
 
-func (x *CalculateTeacherSalaryResponse) GetSalaries() []*AbsCalculateSalary {
+func (x *CalendarFeedTimetable) GetFeed() *CalendarFeed {
 	if x != nil {
-		return x.Salaries
+		return x.Feed
 	}
 	return nil
 }
 
-type AbsCalculateSalary struct {
-	state                     protoimpl.MessageState `protogen:"open.v1"`
-	GroupId                   string                 `protobuf:"bytes,1,opt,name=groupId,proto3" json:"groupId,omitempty"`
-	GroupName                 string                 `protobuf:"bytes,2,opt,name=groupName,proto3" json:"groupName,omitempty"`
-	CommonLessonCountInPeriod int32                  `protobuf:"varint,3,opt,name=commonLessonCountInPeriod,proto3" json:"commonLessonCountInPeriod,omitempty"`
-	Salaries                  []*StudentSalary       `protobuf:"bytes,4,rep,name=salaries,proto3" json:"salaries,omitempty"`
-	unknownFields             protoimpl.UnknownFields
-	sizeCache                 protoimpl.SizeCache
+func (x *CalendarFeedTimetable) GetLessons() []*TimetableLesson {
+	if x != nil {
+		return x.Lessons
+	}
+	return nil
 }
 
-func (x *AbsCalculateSalary) Reset() {
-	*x = AbsCalculateSalary{}
-	mi := &file_education_proto_msgTypes[45]
+type AbsLesson struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Id        string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	GroupId   int64                  `protobuf:"varint,2,opt,name=groupId,proto3" json:"groupId,omitempty"`
+	GroupName string                 `protobuf:"bytes,3,opt,name=groupName,proto3" json:"groupName,omitempty"`
+	// yyyy-MM-dd, realDate is the date the lesson was moved from when it was transferred
+	Date     string `protobuf:"bytes,4,opt,name=date,proto3" json:"date,omitempty"`
+	RealDate string `protobuf:"bytes,5,opt,name=realDate,proto3" json:"realDate,omitempty"`
+	// teacherId is who teaches the lesson, substitute is set when it is not the teacher of the group
+	TeacherId       string   `protobuf:"bytes,6,opt,name=teacherId,proto3" json:"teacherId,omitempty"`
+	TeacherName     string   `protobuf:"bytes,7,opt,name=teacherName,proto3" json:"teacherName,omitempty"`
+	Substitute      bool     `protobuf:"varint,8,opt,name=substitute,proto3" json:"substitute,omitempty"`
+	Topic           string   `protobuf:"bytes,9,opt,name=topic,proto3" json:"topic,omitempty"`
+	Notes           string   `protobuf:"bytes,10,opt,name=notes,proto3" json:"notes,omitempty"`
+	Homework        string   `protobuf:"bytes,11,opt,name=homework,proto3" json:"homework,omitempty"`
+	Materials       []string `protobuf:"bytes,12,rep,name=materials,proto3" json:"materials,omitempty"`
+	AttendanceCount int32    `protobuf:"varint,13,opt,name=attendanceCount,proto3" json:"attendanceCount,omitempty"`
+	UpdatedAt       string   `protobuf:"bytes,14,opt,name=updatedAt,proto3" json:"updatedAt,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *AbsLesson) Reset() {
+	*x = AbsLesson{}
+	mi := &file_education_proto_msgTypes[134]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AbsCalculateSalary) String() string {
+func (x *AbsLesson) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AbsCalculateSalary) ProtoMessage() {}
+func (*AbsLesson) ProtoMessage() {}
 
-func (x *AbsCalculateSalary) ProtoReflect() protoreflect.Message {
-	mi := &file_education_proto_msgTypes[45]
+func (x *AbsLesson) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[134]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3461,67 +9953,134 @@ func (x *AbsCalculateSalary) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AbsCalculateSalary.ProtoReflect.Descriptor instead.
-func (*AbsCalculateSalary) Descriptor() ([]byte, []int) {
-	return file_education_proto_rawDescGZIP(), []int{45}
+// Deprecated: Use AbsLesson.ProtoReflect.Descriptor instead.
+func (*AbsLesson) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{134}
 }
 
-func (x *AbsCalculateSalary) GetGroupId() string {
+func (x *AbsLesson) GetId() string {
 	if x != nil {
-		return x.GroupId
+		return x.Id
 	}
 	return ""
 }
 
-func (x *AbsCalculateSalary) GetGroupName() string {
+func (x *AbsLesson) GetGroupId() int64 {
+	if x != nil {
+		return x.GroupId
+	}
+	return 0
+}
+
+func (x *AbsLesson) GetGroupName() string {
 	if x != nil {
 		return x.GroupName
 	}
 	return ""
 }
 
-func (x *AbsCalculateSalary) GetCommonLessonCountInPeriod() int32 {
+func (x *AbsLesson) GetDate() string {
 	if x != nil {
-		return x.CommonLessonCountInPeriod
+		return x.Date
 	}
-	return 0
+	return ""
 }
 
-func (x *AbsCalculateSalary) GetSalaries() []*StudentSalary {
+func (x *AbsLesson) GetRealDate() string {
 	if x != nil {
-		return x.Salaries
+		return x.RealDate
+	}
+	return ""
+}
+
+func (x *AbsLesson) GetTeacherId() string {
+	if x != nil {
+		return x.TeacherId
+	}
+	return ""
+}
+
+func (x *AbsLesson) GetTeacherName() string {
+	if x != nil {
+		return x.TeacherName
+	}
+	return ""
+}
+
+func (x *AbsLesson) GetSubstitute() bool {
+	if x != nil {
+		return x.Substitute
+	}
+	return false
+}
+
+func (x *AbsLesson) GetTopic() string {
+	if x != nil {
+		return x.Topic
+	}
+	return ""
+}
+
+func (x *AbsLesson) GetNotes() string {
+	if x != nil {
+		return x.Notes
+	}
+	return ""
+}
+
+func (x *AbsLesson) GetHomework() string {
+	if x != nil {
+		return x.Homework
+	}
+	return ""
+}
+
+func (x *AbsLesson) GetMaterials() []string {
+	if x != nil {
+		return x.Materials
 	}
 	return nil
 }
 
-type StudentSalary struct {
-	state                    protoimpl.MessageState `protogen:"open.v1"`
-	StudentId                string                 `protobuf:"bytes,1,opt,name=studentId,proto3" json:"studentId,omitempty"`
-	StudentName              string                 `protobuf:"bytes,2,opt,name=studentName,proto3" json:"studentName,omitempty"`
-	PassedLessonCount        int32                  `protobuf:"varint,3,opt,name=passedLessonCount,proto3" json:"passedLessonCount,omitempty"`
-	CalculatedSalaryInPeriod int32                  `protobuf:"varint,4,opt,name=calculatedSalaryInPeriod,proto3" json:"calculatedSalaryInPeriod,omitempty"`
-	PriceType                string                 `protobuf:"bytes,5,opt,name=priceType,proto3" json:"priceType,omitempty"`
-	TotalCount               float64                `protobuf:"fixed64,6,opt,name=totalCount,proto3" json:"totalCount,omitempty"`
-	CoursePrice              float64                `protobuf:"fixed64,7,opt,name=coursePrice,proto3" json:"coursePrice,omitempty"`
-	unknownFields            protoimpl.UnknownFields
-	sizeCache                protoimpl.SizeCache
+func (x *AbsLesson) GetAttendanceCount() int32 {
+	if x != nil {
+		return x.AttendanceCount
+	}
+	return 0
 }
 
-func (x *StudentSalary) Reset() {
-	*x = StudentSalary{}
-	mi := &file_education_proto_msgTypes[46]
+func (x *AbsLesson) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+type GetLessonsRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	GroupId string                 `protobuf:"bytes,1,opt,name=groupId,proto3" json:"groupId,omitempty"`
+	// yyyy-MM-dd, at most 93 days apart
+	From          string `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
+	Till          string `protobuf:"bytes,3,opt,name=till,proto3" json:"till,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetLessonsRequest) Reset() {
+	*x = GetLessonsRequest{}
+	mi := &file_education_proto_msgTypes[135]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *StudentSalary) String() string {
+func (x *GetLessonsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StudentSalary) ProtoMessage() {}
+func (*GetLessonsRequest) ProtoMessage() {}
 
-func (x *StudentSalary) ProtoReflect() protoreflect.Message {
-	mi := &file_education_proto_msgTypes[46]
+func (x *GetLessonsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[135]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3532,87 +10091,106 @@ func (x *StudentSalary) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StudentSalary.ProtoReflect.Descriptor instead.
-func (*StudentSalary) Descriptor() ([]byte, []int) {
-	return file_education_proto_rawDescGZIP(), []int{46}
+// Deprecated: Use GetLessonsRequest.ProtoReflect.Descriptor instead.
+func (*GetLessonsRequest) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{135}
 }
 
-func (x *StudentSalary) GetStudentId() string {
+func (x *GetLessonsRequest) GetGroupId() string {
 	if x != nil {
-		return x.StudentId
+		return x.GroupId
 	}
 	return ""
 }
 
-func (x *StudentSalary) GetStudentName() string {
+func (x *GetLessonsRequest) GetFrom() string {
 	if x != nil {
-		return x.StudentName
+		return x.From
 	}
 	return ""
 }
 
-func (x *StudentSalary) GetPassedLessonCount() int32 {
+func (x *GetLessonsRequest) GetTill() string {
 	if x != nil {
-		return x.PassedLessonCount
+		return x.Till
 	}
-	return 0
+	return ""
+}
+
+type GetLessonsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Lessons       []*AbsLesson           `protobuf:"bytes,1,rep,name=lessons,proto3" json:"lessons,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetLessonsResponse) Reset() {
+	*x = GetLessonsResponse{}
+	mi := &file_education_proto_msgTypes[136]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *StudentSalary) GetCalculatedSalaryInPeriod() int32 {
-	if x != nil {
-		return x.CalculatedSalaryInPeriod
-	}
-	return 0
+func (x *GetLessonsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *StudentSalary) GetPriceType() string {
+func (*GetLessonsResponse) ProtoMessage() {}
+
+func (x *GetLessonsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[136]
 	if x != nil {
-		return x.PriceType
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *StudentSalary) GetTotalCount() float64 {
-	if x != nil {
-		return x.TotalCount
-	}
-	return 0
+// Deprecated: Use GetLessonsResponse.ProtoReflect.Descriptor instead.
+func (*GetLessonsResponse) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{136}
 }
 
-func (x *StudentSalary) GetCoursePrice() float64 {
+func (x *GetLessonsResponse) GetLessons() []*AbsLesson {
 	if x != nil {
-		return x.CoursePrice
+		return x.Lessons
 	}
-	return 0
-}
-
-type GetAttendanceRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	GroupId       string                 `protobuf:"bytes,1,opt,name=groupId,proto3" json:"groupId,omitempty"`
-	From          string                 `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
-	Till          string                 `protobuf:"bytes,3,opt,name=till,proto3" json:"till,omitempty"`
-	WithOutdated  bool                   `protobuf:"varint,4,opt,name=withOutdated,proto3" json:"withOutdated,omitempty"`
-	ActionId      string                 `protobuf:"bytes,5,opt,name=actionId,proto3" json:"actionId,omitempty"`
-	ActionRole    string                 `protobuf:"bytes,6,opt,name=actionRole,proto3" json:"actionRole,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	return nil
 }
 
-func (x *GetAttendanceRequest) Reset() {
-	*x = GetAttendanceRequest{}
-	mi := &file_education_proto_msgTypes[47]
+type UpdateLessonRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Id        string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Topic     string                 `protobuf:"bytes,2,opt,name=topic,proto3" json:"topic,omitempty"`
+	Notes     string                 `protobuf:"bytes,3,opt,name=notes,proto3" json:"notes,omitempty"`
+	Homework  string                 `protobuf:"bytes,4,opt,name=homework,proto3" json:"homework,omitempty"`
+	Materials []string               `protobuf:"bytes,5,rep,name=materials,proto3" json:"materials,omitempty"`
+	// empty gives the lesson back to the teacher of the group, teachers can not change it
+	SubstituteTeacherId string `protobuf:"bytes,6,opt,name=substituteTeacherId,proto3" json:"substituteTeacherId,omitempty"`
+	ActionById          string `protobuf:"bytes,7,opt,name=actionById,proto3" json:"actionById,omitempty"`
+	ActionByRole        string `protobuf:"bytes,8,opt,name=actionByRole,proto3" json:"actionByRole,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *UpdateLessonRequest) Reset() {
+	*x = UpdateLessonRequest{}
+	mi := &file_education_proto_msgTypes[137]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetAttendanceRequest) String() string {
+func (x *UpdateLessonRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetAttendanceRequest) ProtoMessage() {}
+func (*UpdateLessonRequest) ProtoMessage() {}
 
-func (x *GetAttendanceRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_education_proto_msgTypes[47]
+func (x *UpdateLessonRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[137]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3623,76 +10201,88 @@ func (x *GetAttendanceRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetAttendanceRequest.ProtoReflect.Descriptor instead.
-func (*GetAttendanceRequest) Descriptor() ([]byte, []int) {
-	return file_education_proto_rawDescGZIP(), []int{47}
+// Deprecated: Use UpdateLessonRequest.ProtoReflect.Descriptor instead.
+func (*UpdateLessonRequest) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{137}
 }
 
-func (x *GetAttendanceRequest) GetGroupId() string {
+func (x *UpdateLessonRequest) GetId() string {
 	if x != nil {
-		return x.GroupId
+		return x.Id
 	}
 	return ""
 }
 
-func (x *GetAttendanceRequest) GetFrom() string {
+func (x *UpdateLessonRequest) GetTopic() string {
 	if x != nil {
-		return x.From
+		return x.Topic
 	}
 	return ""
 }
 
-func (x *GetAttendanceRequest) GetTill() string {
+func (x *UpdateLessonRequest) GetNotes() string {
 	if x != nil {
-		return x.Till
+		return x.Notes
 	}
 	return ""
 }
 
-func (x *GetAttendanceRequest) GetWithOutdated() bool {
+func (x *UpdateLessonRequest) GetHomework() string {
 	if x != nil {
-		return x.WithOutdated
+		return x.Homework
 	}
-	return false
+	return ""
 }
 
-func (x *GetAttendanceRequest) GetActionId() string {
+func (x *UpdateLessonRequest) GetMaterials() []string {
 	if x != nil {
-		return x.ActionId
+		return x.Materials
+	}
+	return nil
+}
+
+func (x *UpdateLessonRequest) GetSubstituteTeacherId() string {
+	if x != nil {
+		return x.SubstituteTeacherId
 	}
 	return ""
 }
 
-func (x *GetAttendanceRequest) GetActionRole() string {
+func (x *UpdateLessonRequest) GetActionById() string {
 	if x != nil {
-		return x.ActionRole
+		return x.ActionById
 	}
 	return ""
 }
 
-type GetAttendanceResponse struct {
+func (x *UpdateLessonRequest) GetActionByRole() string {
+	if x != nil {
+		return x.ActionByRole
+	}
+	return ""
+}
+
+type GetDebtorsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Days          []*Day                 `protobuf:"bytes,1,rep,name=days,proto3" json:"days,omitempty"`
-	Students      []*Student             `protobuf:"bytes,2,rep,name=students,proto3" json:"students,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetAttendanceResponse) Reset() {
-	*x = GetAttendanceResponse{}
-	mi := &file_education_proto_msgTypes[48]
+func (x *GetDebtorsRequest) Reset() {
+	*x = GetDebtorsRequest{}
+	mi := &file_education_proto_msgTypes[138]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetAttendanceResponse) String() string {
+func (x *GetDebtorsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetAttendanceResponse) ProtoMessage() {}
+func (*GetDebtorsRequest) ProtoMessage() {}
 
-func (x *GetAttendanceResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_education_proto_msgTypes[48]
+func (x *GetDebtorsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[138]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3703,48 +10293,33 @@ func (x *GetAttendanceResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetAttendanceResponse.ProtoReflect.Descriptor instead.
-func (*GetAttendanceResponse) Descriptor() ([]byte, []int) {
-	return file_education_proto_rawDescGZIP(), []int{48}
-}
-
-func (x *GetAttendanceResponse) GetDays() []*Day {
-	if x != nil {
-		return x.Days
-	}
-	return nil
-}
-
-func (x *GetAttendanceResponse) GetStudents() []*Student {
-	if x != nil {
-		return x.Students
-	}
-	return nil
+// Deprecated: Use GetDebtorsRequest.ProtoReflect.Descriptor instead.
+func (*GetDebtorsRequest) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{138}
 }
 
-type Day struct {
+type GetDebtorsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Date          string                 `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"`
-	TransferDate  string                 `protobuf:"bytes,2,opt,name=transferDate,proto3" json:"transferDate,omitempty"`
+	Debtors       []*AbsDebtor           `protobuf:"bytes,1,rep,name=debtors,proto3" json:"debtors,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Day) Reset() {
-	*x = Day{}
-	mi := &file_education_proto_msgTypes[49]
+func (x *GetDebtorsResponse) Reset() {
+	*x = GetDebtorsResponse{}
+	mi := &file_education_proto_msgTypes[139]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Day) String() string {
+func (x *GetDebtorsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Day) ProtoMessage() {}
+func (*GetDebtorsResponse) ProtoMessage() {}
 
-func (x *Day) ProtoReflect() protoreflect.Message {
-	mi := &file_education_proto_msgTypes[49]
+func (x *GetDebtorsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[139]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3755,58 +10330,44 @@ func (x *Day) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Day.ProtoReflect.Descriptor instead.
-func (*Day) Descriptor() ([]byte, []int) {
-	return file_education_proto_rawDescGZIP(), []int{49}
-}
-
-func (x *Day) GetDate() string {
-	if x != nil {
-		return x.Date
-	}
-	return ""
+// Deprecated: Use GetDebtorsResponse.ProtoReflect.Descriptor instead.
+func (*GetDebtorsResponse) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{139}
 }
 
-func (x *Day) GetTransferDate() string {
+func (x *GetDebtorsResponse) GetDebtors() []*AbsDebtor {
 	if x != nil {
-		return x.TransferDate
+		return x.Debtors
 	}
-	return ""
+	return nil
 }
 
-type Student struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,9,opt,name=id,proto3" json:"id,omitempty"`
-	ActivatedAt   string                 `protobuf:"bytes,1,opt,name=activatedAt,proto3" json:"activatedAt,omitempty"`
-	AddedAt       string                 `protobuf:"bytes,2,opt,name=addedAt,proto3" json:"addedAt,omitempty"`
-	Attendance    []*Attendance          `protobuf:"bytes,3,rep,name=attendance,proto3" json:"attendance,omitempty"`
-	Balance       float64                `protobuf:"fixed64,4,opt,name=balance,proto3" json:"balance,omitempty"`
-	CreatedAt     string                 `protobuf:"bytes,5,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
-	DateOfBirth   string                 `protobuf:"bytes,6,opt,name=dateOfBirth,proto3" json:"dateOfBirth,omitempty"`
-	FreezeDetail  *FreezeDetail          `protobuf:"bytes,7,opt,name=freezeDetail,proto3" json:"freezeDetail,omitempty"`
-	Gender        bool                   `protobuf:"varint,8,opt,name=gender,proto3" json:"gender,omitempty"`
-	Condition     string                 `protobuf:"bytes,10,opt,name=condition,proto3" json:"condition,omitempty"`
-	Name          string                 `protobuf:"bytes,11,opt,name=name,proto3" json:"name,omitempty"`
-	Phone         string                 `protobuf:"bytes,12,opt,name=phone,proto3" json:"phone,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type AbsDebtor struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	StudentId            string                 `protobuf:"bytes,1,opt,name=studentId,proto3" json:"studentId,omitempty"`
+	Name                 string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Phone                string                 `protobuf:"bytes,3,opt,name=phone,proto3" json:"phone,omitempty"`
+	Balance              float64                `protobuf:"fixed64,4,opt,name=balance,proto3" json:"balance,omitempty"`
+	ZeroBalanceUpdatedAt string                 `protobuf:"bytes,5,opt,name=zeroBalanceUpdatedAt,proto3" json:"zeroBalanceUpdatedAt,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
 }
 
-func (x *Student) Reset() {
-	*x = Student{}
-	mi := &file_education_proto_msgTypes[50]
+func (x *AbsDebtor) Reset() {
+	*x = AbsDebtor{}
+	mi := &file_education_proto_msgTypes[140]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Student) String() string {
+func (x *AbsDebtor) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Student) ProtoMessage() {}
+func (*AbsDebtor) ProtoMessage() {}
 
-func (x *Student) ProtoReflect() protoreflect.Message {
-	mi := &file_education_proto_msgTypes[50]
+func (x *AbsDebtor) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[140]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3817,121 +10378,126 @@ func (x *Student) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Student.ProtoReflect.Descriptor instead.
-func (*Student) Descriptor() ([]byte, []int) {
-	return file_education_proto_rawDescGZIP(), []int{50}
+// Deprecated: Use AbsDebtor.ProtoReflect.Descriptor instead.
+func (*AbsDebtor) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{140}
 }
 
-func (x *Student) GetId() string {
+func (x *AbsDebtor) GetStudentId() string {
 	if x != nil {
-		return x.Id
+		return x.StudentId
 	}
 	return ""
 }
 
-func (x *Student) GetActivatedAt() string {
+func (x *AbsDebtor) GetName() string {
 	if x != nil {
-		return x.ActivatedAt
+		return x.Name
 	}
 	return ""
 }
 
-func (x *Student) GetAddedAt() string {
+func (x *AbsDebtor) GetPhone() string {
 	if x != nil {
-		return x.AddedAt
+		return x.Phone
 	}
 	return ""
 }
 
-func (x *Student) GetAttendance() []*Attendance {
-	if x != nil {
-		return x.Attendance
-	}
-	return nil
-}
-
-func (x *Student) GetBalance() float64 {
+func (x *AbsDebtor) GetBalance() float64 {
 	if x != nil {
 		return x.Balance
 	}
 	return 0
 }
 
-func (x *Student) GetCreatedAt() string {
+func (x *AbsDebtor) GetZeroBalanceUpdatedAt() string {
 	if x != nil {
-		return x.CreatedAt
+		return x.ZeroBalanceUpdatedAt
 	}
 	return ""
 }
 
-func (x *Student) GetDateOfBirth() string {
-	if x != nil {
-		return x.DateOfBirth
-	}
-	return ""
+type GetDiscountContextRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StudentId     string                 `protobuf:"bytes,1,opt,name=studentId,proto3" json:"studentId,omitempty"`
+	GroupId       string                 `protobuf:"bytes,2,opt,name=groupId,proto3" json:"groupId,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Student) GetFreezeDetail() *FreezeDetail {
-	if x != nil {
-		return x.FreezeDetail
-	}
-	return nil
+func (x *GetDiscountContextRequest) Reset() {
+	*x = GetDiscountContextRequest{}
+	mi := &file_education_proto_msgTypes[141]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *Student) GetGender() bool {
-	if x != nil {
-		return x.Gender
-	}
-	return false
+func (x *GetDiscountContextRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *Student) GetCondition() string {
+func (*GetDiscountContextRequest) ProtoMessage() {}
+
+func (x *GetDiscountContextRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[141]
 	if x != nil {
-		return x.Condition
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *Student) GetName() string {
+// Deprecated: Use GetDiscountContextRequest.ProtoReflect.Descriptor instead.
+func (*GetDiscountContextRequest) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{141}
+}
+
+func (x *GetDiscountContextRequest) GetStudentId() string {
 	if x != nil {
-		return x.Name
+		return x.StudentId
 	}
 	return ""
 }
 
-func (x *Student) GetPhone() string {
+func (x *GetDiscountContextRequest) GetGroupId() string {
 	if x != nil {
-		return x.Phone
+		return x.GroupId
 	}
 	return ""
 }
 
-type Attendance struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	AttendDate    string                 `protobuf:"bytes,2,opt,name=attend_date,json=attendDate,proto3" json:"attend_date,omitempty"`
-	IsCome        bool                   `protobuf:"varint,3,opt,name=isCome,proto3" json:"isCome,omitempty"`
-	StudentId     string                 `protobuf:"bytes,4,opt,name=studentId,proto3" json:"studentId,omitempty"`
-	TeacherId     string                 `protobuf:"bytes,5,opt,name=teacherId,proto3" json:"teacherId,omitempty"`
+type GetDiscountContextResponse struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	CoursePrice  float64                `protobuf:"fixed64,1,opt,name=coursePrice,proto3" json:"coursePrice,omitempty"`
+	CourseId     string                 `protobuf:"bytes,2,opt,name=courseId,proto3" json:"courseId,omitempty"`
+	GroupEndDate string                 `protobuf:"bytes,3,opt,name=groupEndDate,proto3" json:"groupEndDate,omitempty"`
+	// distinct courses the student is enrolled in, this group included
+	CourseCount int32 `protobuf:"varint,4,opt,name=courseCount,proto3" json:"courseCount,omitempty"`
+	// active students sharing a family phone that were registered before this student
+	SiblingCount  int32 `protobuf:"varint,5,opt,name=siblingCount,proto3" json:"siblingCount,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Attendance) Reset() {
-	*x = Attendance{}
-	mi := &file_education_proto_msgTypes[51]
+func (x *GetDiscountContextResponse) Reset() {
+	*x = GetDiscountContextResponse{}
+	mi := &file_education_proto_msgTypes[142]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Attendance) String() string {
+func (x *GetDiscountContextResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Attendance) ProtoMessage() {}
+func (*GetDiscountContextResponse) ProtoMessage() {}
 
-func (x *Attendance) ProtoReflect() protoreflect.Message {
-	mi := &file_education_proto_msgTypes[51]
+func (x *GetDiscountContextResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[142]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3942,69 +10508,68 @@ func (x *Attendance) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Attendance.ProtoReflect.Descriptor instead.
-func (*Attendance) Descriptor() ([]byte, []int) {
-	return file_education_proto_rawDescGZIP(), []int{51}
+// Deprecated: Use GetDiscountContextResponse.ProtoReflect.Descriptor instead.
+func (*GetDiscountContextResponse) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{142}
 }
 
-func (x *Attendance) GetId() string {
+func (x *GetDiscountContextResponse) GetCoursePrice() float64 {
 	if x != nil {
-		return x.Id
+		return x.CoursePrice
 	}
-	return ""
+	return 0
 }
 
-func (x *Attendance) GetAttendDate() string {
+func (x *GetDiscountContextResponse) GetCourseId() string {
 	if x != nil {
-		return x.AttendDate
+		return x.CourseId
 	}
 	return ""
 }
 
-func (x *Attendance) GetIsCome() bool {
+func (x *GetDiscountContextResponse) GetGroupEndDate() string {
 	if x != nil {
-		return x.IsCome
+		return x.GroupEndDate
 	}
-	return false
+	return ""
 }
 
-func (x *Attendance) GetStudentId() string {
+func (x *GetDiscountContextResponse) GetCourseCount() int32 {
 	if x != nil {
-		return x.StudentId
+		return x.CourseCount
 	}
-	return ""
+	return 0
 }
 
-func (x *Attendance) GetTeacherId() string {
+func (x *GetDiscountContextResponse) GetSiblingCount() int32 {
 	if x != nil {
-		return x.TeacherId
+		return x.SiblingCount
 	}
-	return ""
+	return 0
 }
 
-type FreezeDetail struct {
+type GetStudentsByPhonesRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Reason        string                 `protobuf:"bytes,1,opt,name=reason,proto3" json:"reason,omitempty"`
-	TillDate      string                 `protobuf:"bytes,2,opt,name=till_date,json=tillDate,proto3" json:"till_date,omitempty"`
+	Phones        []string               `protobuf:"bytes,1,rep,name=phones,proto3" json:"phones,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *FreezeDetail) Reset() {
-	*x = FreezeDetail{}
-	mi := &file_education_proto_msgTypes[52]
+func (x *GetStudentsByPhonesRequest) Reset() {
+	*x = GetStudentsByPhonesRequest{}
+	mi := &file_education_proto_msgTypes[143]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *FreezeDetail) String() string {
+func (x *GetStudentsByPhonesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FreezeDetail) ProtoMessage() {}
+func (*GetStudentsByPhonesRequest) ProtoMessage() {}
 
-func (x *FreezeDetail) ProtoReflect() protoreflect.Message {
-	mi := &file_education_proto_msgTypes[52]
+func (x *GetStudentsByPhonesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[143]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4015,53 +10580,40 @@ func (x *FreezeDetail) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FreezeDetail.ProtoReflect.Descriptor instead.
-func (*FreezeDetail) Descriptor() ([]byte, []int) {
-	return file_education_proto_rawDescGZIP(), []int{52}
-}
-
-func (x *FreezeDetail) GetReason() string {
-	if x != nil {
-		return x.Reason
-	}
-	return ""
+// Deprecated: Use GetStudentsByPhonesRequest.ProtoReflect.Descriptor instead.
+func (*GetStudentsByPhonesRequest) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{143}
 }
 
-func (x *FreezeDetail) GetTillDate() string {
+func (x *GetStudentsByPhonesRequest) GetPhones() []string {
 	if x != nil {
-		return x.TillDate
+		return x.Phones
 	}
-	return ""
+	return nil
 }
 
-type SetAttendanceRequest struct {
+type GetStudentsByPhonesResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	AttendDate    string                 `protobuf:"bytes,1,opt,name=attendDate,proto3" json:"attendDate,omitempty"`
-	GroupId       string                 `protobuf:"bytes,2,opt,name=groupId,proto3" json:"groupId,omitempty"`
-	Status        int32                  `protobuf:"varint,3,opt,name=status,proto3" json:"status,omitempty"`
-	StudentId     string                 `protobuf:"bytes,4,opt,name=studentId,proto3" json:"studentId,omitempty"`
-	TeacherId     string                 `protobuf:"bytes,5,opt,name=teacherId,proto3" json:"teacherId,omitempty"`
-	ActionById    string                 `protobuf:"bytes,6,opt,name=actionById,proto3" json:"actionById,omitempty"`
-	ActionByRole  string                 `protobuf:"bytes,7,opt,name=actionByRole,proto3" json:"actionByRole,omitempty"`
+	Students      []*AbsDebtor           `protobuf:"bytes,1,rep,name=students,proto3" json:"students,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SetAttendanceRequest) Reset() {
-	*x = SetAttendanceRequest{}
-	mi := &file_education_proto_msgTypes[53]
+func (x *GetStudentsByPhonesResponse) Reset() {
+	*x = GetStudentsByPhonesResponse{}
+	mi := &file_education_proto_msgTypes[144]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SetAttendanceRequest) String() string {
+func (x *GetStudentsByPhonesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SetAttendanceRequest) ProtoMessage() {}
+func (*GetStudentsByPhonesResponse) ProtoMessage() {}
 
-func (x *SetAttendanceRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_education_proto_msgTypes[53]
+func (x *GetStudentsByPhonesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[144]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4072,56 +10624,66 @@ func (x *SetAttendanceRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SetAttendanceRequest.ProtoReflect.Descriptor instead.
-func (*SetAttendanceRequest) Descriptor() ([]byte, []int) {
-	return file_education_proto_rawDescGZIP(), []int{53}
+// Deprecated: Use GetStudentsByPhonesResponse.ProtoReflect.Descriptor instead.
+func (*GetStudentsByPhonesResponse) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{144}
 }
 
-func (x *SetAttendanceRequest) GetAttendDate() string {
+func (x *GetStudentsByPhonesResponse) GetStudents() []*AbsDebtor {
 	if x != nil {
-		return x.AttendDate
+		return x.Students
 	}
-	return ""
+	return nil
 }
 
-func (x *SetAttendanceRequest) GetGroupId() string {
-	if x != nil {
-		return x.GroupId
-	}
-	return ""
+type SendDebtReminderRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StudentId     string                 `protobuf:"bytes,1,opt,name=studentId,proto3" json:"studentId,omitempty"`
+	ActionType    string                 `protobuf:"bytes,2,opt,name=actionType,proto3" json:"actionType,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SetAttendanceRequest) GetStatus() int32 {
-	if x != nil {
-		return x.Status
-	}
-	return 0
+func (x *SendDebtReminderRequest) Reset() {
+	*x = SendDebtReminderRequest{}
+	mi := &file_education_proto_msgTypes[145]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *SetAttendanceRequest) GetStudentId() string {
-	if x != nil {
-		return x.StudentId
-	}
-	return ""
+func (x *SendDebtReminderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *SetAttendanceRequest) GetTeacherId() string {
+func (*SendDebtReminderRequest) ProtoMessage() {}
+
+func (x *SendDebtReminderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[145]
 	if x != nil {
-		return x.TeacherId
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *SetAttendanceRequest) GetActionById() string {
+// Deprecated: Use SendDebtReminderRequest.ProtoReflect.Descriptor instead.
+func (*SendDebtReminderRequest) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{145}
+}
+
+func (x *SendDebtReminderRequest) GetStudentId() string {
 	if x != nil {
-		return x.ActionById
+		return x.StudentId
 	}
 	return ""
 }
 
-func (x *SetAttendanceRequest) GetActionByRole() string {
+func (x *SendDebtReminderRequest) GetActionType() string {
 	if x != nil {
-		return x.ActionByRole
+		return x.ActionType
 	}
 	return ""
 }
@@ -4141,7 +10703,7 @@ type CalculateDiscountSummaRequest struct {
 
 func (x *CalculateDiscountSummaRequest) Reset() {
 	*x = CalculateDiscountSummaRequest{}
-	mi := &file_education_proto_msgTypes[54]
+	mi := &file_education_proto_msgTypes[146]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4153,7 +10715,7 @@ func (x *CalculateDiscountSummaRequest) String() string {
 func (*CalculateDiscountSummaRequest) ProtoMessage() {}
 
 func (x *CalculateDiscountSummaRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_education_proto_msgTypes[54]
+	mi := &file_education_proto_msgTypes[146]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4166,7 +10728,7 @@ func (x *CalculateDiscountSummaRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CalculateDiscountSummaRequest.ProtoReflect.Descriptor instead.
 func (*CalculateDiscountSummaRequest) Descriptor() ([]byte, []int) {
-	return file_education_proto_rawDescGZIP(), []int{54}
+	return file_education_proto_rawDescGZIP(), []int{146}
 }
 
 func (x *CalculateDiscountSummaRequest) GetGroupId() string {
@@ -4227,7 +10789,7 @@ type CalculateDiscountResponse struct {
 
 func (x *CalculateDiscountResponse) Reset() {
 	*x = CalculateDiscountResponse{}
-	mi := &file_education_proto_msgTypes[55]
+	mi := &file_education_proto_msgTypes[147]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4239,7 +10801,7 @@ func (x *CalculateDiscountResponse) String() string {
 func (*CalculateDiscountResponse) ProtoMessage() {}
 
 func (x *CalculateDiscountResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_education_proto_msgTypes[55]
+	mi := &file_education_proto_msgTypes[147]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4252,7 +10814,7 @@ func (x *CalculateDiscountResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CalculateDiscountResponse.ProtoReflect.Descriptor instead.
 func (*CalculateDiscountResponse) Descriptor() ([]byte, []int) {
-	return file_education_proto_rawDescGZIP(), []int{55}
+	return file_education_proto_rawDescGZIP(), []int{147}
 }
 
 func (x *CalculateDiscountResponse) GetCalculatedPrice() string {
@@ -4279,7 +10841,7 @@ type ChangeUserBalanceHistoryByDebitRequest struct {
 
 func (x *ChangeUserBalanceHistoryByDebitRequest) Reset() {
 	*x = ChangeUserBalanceHistoryByDebitRequest{}
-	mi := &file_education_proto_msgTypes[56]
+	mi := &file_education_proto_msgTypes[148]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4291,7 +10853,7 @@ func (x *ChangeUserBalanceHistoryByDebitRequest) String() string {
 func (*ChangeUserBalanceHistoryByDebitRequest) ProtoMessage() {}
 
 func (x *ChangeUserBalanceHistoryByDebitRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_education_proto_msgTypes[56]
+	mi := &file_education_proto_msgTypes[148]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4304,7 +10866,7 @@ func (x *ChangeUserBalanceHistoryByDebitRequest) ProtoReflect() protoreflect.Mes
 
 // Deprecated: Use ChangeUserBalanceHistoryByDebitRequest.ProtoReflect.Descriptor instead.
 func (*ChangeUserBalanceHistoryByDebitRequest) Descriptor() ([]byte, []int) {
-	return file_education_proto_rawDescGZIP(), []int{56}
+	return file_education_proto_rawDescGZIP(), []int{148}
 }
 
 func (x *ChangeUserBalanceHistoryByDebitRequest) GetStudentId() string {
@@ -4386,7 +10948,7 @@ type ChangeUserBalanceHistoryRequest struct {
 
 func (x *ChangeUserBalanceHistoryRequest) Reset() {
 	*x = ChangeUserBalanceHistoryRequest{}
-	mi := &file_education_proto_msgTypes[57]
+	mi := &file_education_proto_msgTypes[149]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4398,7 +10960,7 @@ func (x *ChangeUserBalanceHistoryRequest) String() string {
 func (*ChangeUserBalanceHistoryRequest) ProtoMessage() {}
 
 func (x *ChangeUserBalanceHistoryRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_education_proto_msgTypes[57]
+	mi := &file_education_proto_msgTypes[149]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4411,7 +10973,7 @@ func (x *ChangeUserBalanceHistoryRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ChangeUserBalanceHistoryRequest.ProtoReflect.Descriptor instead.
 func (*ChangeUserBalanceHistoryRequest) Descriptor() ([]byte, []int) {
-	return file_education_proto_rawDescGZIP(), []int{57}
+	return file_education_proto_rawDescGZIP(), []int{149}
 }
 
 func (x *ChangeUserBalanceHistoryRequest) GetStudentId() string {
@@ -4482,7 +11044,7 @@ type DeleteStudentRequest struct {
 
 func (x *DeleteStudentRequest) Reset() {
 	*x = DeleteStudentRequest{}
-	mi := &file_education_proto_msgTypes[58]
+	mi := &file_education_proto_msgTypes[150]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4494,7 +11056,7 @@ func (x *DeleteStudentRequest) String() string {
 func (*DeleteStudentRequest) ProtoMessage() {}
 
 func (x *DeleteStudentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_education_proto_msgTypes[58]
+	mi := &file_education_proto_msgTypes[150]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4507,7 +11069,7 @@ func (x *DeleteStudentRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteStudentRequest.ProtoReflect.Descriptor instead.
 func (*DeleteStudentRequest) Descriptor() ([]byte, []int) {
-	return file_education_proto_rawDescGZIP(), []int{58}
+	return file_education_proto_rawDescGZIP(), []int{150}
 }
 
 func (x *DeleteStudentRequest) GetStudentId() string {
@@ -4547,7 +11109,7 @@ type GetStudentsByGroupIdResponse struct {
 
 func (x *GetStudentsByGroupIdResponse) Reset() {
 	*x = GetStudentsByGroupIdResponse{}
-	mi := &file_education_proto_msgTypes[59]
+	mi := &file_education_proto_msgTypes[151]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4559,7 +11121,7 @@ func (x *GetStudentsByGroupIdResponse) String() string {
 func (*GetStudentsByGroupIdResponse) ProtoMessage() {}
 
 func (x *GetStudentsByGroupIdResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_education_proto_msgTypes[59]
+	mi := &file_education_proto_msgTypes[151]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4572,7 +11134,7 @@ func (x *GetStudentsByGroupIdResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetStudentsByGroupIdResponse.ProtoReflect.Descriptor instead.
 func (*GetStudentsByGroupIdResponse) Descriptor() ([]byte, []int) {
-	return file_education_proto_rawDescGZIP(), []int{59}
+	return file_education_proto_rawDescGZIP(), []int{151}
 }
 
 func (x *GetStudentsByGroupIdResponse) GetStudents() []*AbsStudent {
@@ -4592,7 +11154,7 @@ type GetStudentsByGroupIdRequest struct {
 
 func (x *GetStudentsByGroupIdRequest) Reset() {
 	*x = GetStudentsByGroupIdRequest{}
-	mi := &file_education_proto_msgTypes[60]
+	mi := &file_education_proto_msgTypes[152]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4604,7 +11166,7 @@ func (x *GetStudentsByGroupIdRequest) String() string {
 func (*GetStudentsByGroupIdRequest) ProtoMessage() {}
 
 func (x *GetStudentsByGroupIdRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_education_proto_msgTypes[60]
+	mi := &file_education_proto_msgTypes[152]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4617,7 +11179,7 @@ func (x *GetStudentsByGroupIdRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetStudentsByGroupIdRequest.ProtoReflect.Descriptor instead.
 func (*GetStudentsByGroupIdRequest) Descriptor() ([]byte, []int) {
-	return file_education_proto_rawDescGZIP(), []int{60}
+	return file_education_proto_rawDescGZIP(), []int{152}
 }
 
 func (x *GetStudentsByGroupIdRequest) GetGroupId() string {
@@ -4650,7 +11212,7 @@ type ChangeConditionStudentRequest struct {
 
 func (x *ChangeConditionStudentRequest) Reset() {
 	*x = ChangeConditionStudentRequest{}
-	mi := &file_education_proto_msgTypes[61]
+	mi := &file_education_proto_msgTypes[153]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4662,7 +11224,7 @@ func (x *ChangeConditionStudentRequest) String() string {
 func (*ChangeConditionStudentRequest) ProtoMessage() {}
 
 func (x *ChangeConditionStudentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_education_proto_msgTypes[61]
+	mi := &file_education_proto_msgTypes[153]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4675,7 +11237,7 @@ func (x *ChangeConditionStudentRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ChangeConditionStudentRequest.ProtoReflect.Descriptor instead.
 func (*ChangeConditionStudentRequest) Descriptor() ([]byte, []int) {
-	return file_education_proto_rawDescGZIP(), []int{61}
+	return file_education_proto_rawDescGZIP(), []int{153}
 }
 
 func (x *ChangeConditionStudentRequest) GetStudentId() string {
@@ -4735,17 +11297,21 @@ func (x *ChangeConditionStudentRequest) GetComment() string {
 }
 
 type TransferLessonRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	From          string                 `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
-	To            string                 `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
-	GroupId       string                 `protobuf:"bytes,3,opt,name=groupId,proto3" json:"groupId,omitempty"`
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	From    string                 `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	To      string                 `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+	GroupId string                 `protobuf:"bytes,3,opt,name=groupId,proto3" json:"groupId,omitempty"`
+	// moves the lesson although the room or teacher is already booked on the new date, the override is audited
+	Force         bool   `protobuf:"varint,4,opt,name=force,proto3" json:"force,omitempty"`
+	ActionById    string `protobuf:"bytes,5,opt,name=actionById,proto3" json:"actionById,omitempty"`
+	ActionByName  string `protobuf:"bytes,6,opt,name=actionByName,proto3" json:"actionByName,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *TransferLessonRequest) Reset() {
 	*x = TransferLessonRequest{}
-	mi := &file_education_proto_msgTypes[62]
+	mi := &file_education_proto_msgTypes[154]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4757,7 +11323,7 @@ func (x *TransferLessonRequest) String() string {
 func (*TransferLessonRequest) ProtoMessage() {}
 
 func (x *TransferLessonRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_education_proto_msgTypes[62]
+	mi := &file_education_proto_msgTypes[154]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4770,7 +11336,7 @@ func (x *TransferLessonRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TransferLessonRequest.ProtoReflect.Descriptor instead.
 func (*TransferLessonRequest) Descriptor() ([]byte, []int) {
-	return file_education_proto_rawDescGZIP(), []int{62}
+	return file_education_proto_rawDescGZIP(), []int{154}
 }
 
 func (x *TransferLessonRequest) GetFrom() string {
@@ -4794,6 +11360,27 @@ func (x *TransferLessonRequest) GetGroupId() string {
 	return ""
 }
 
+func (x *TransferLessonRequest) GetForce() bool {
+	if x != nil {
+		return x.Force
+	}
+	return false
+}
+
+func (x *TransferLessonRequest) GetActionById() string {
+	if x != nil {
+		return x.ActionById
+	}
+	return ""
+}
+
+func (x *TransferLessonRequest) GetActionByName() string {
+	if x != nil {
+		return x.ActionByName
+	}
+	return ""
+}
+
 type GetHistoryGroupResponse struct {
 	state           protoimpl.MessageState `protogen:"open.v1"`
 	GroupHistory    []*AbsHistory          `protobuf:"bytes,1,rep,name=groupHistory,proto3" json:"groupHistory,omitempty"`
@@ -4804,7 +11391,7 @@ type GetHistoryGroupResponse struct {
 
 func (x *GetHistoryGroupResponse) Reset() {
 	*x = GetHistoryGroupResponse{}
-	mi := &file_education_proto_msgTypes[63]
+	mi := &file_education_proto_msgTypes[155]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4816,7 +11403,7 @@ func (x *GetHistoryGroupResponse) String() string {
 func (*GetHistoryGroupResponse) ProtoMessage() {}
 
 func (x *GetHistoryGroupResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_education_proto_msgTypes[63]
+	mi := &file_education_proto_msgTypes[155]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4829,7 +11416,7 @@ func (x *GetHistoryGroupResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetHistoryGroupResponse.ProtoReflect.Descriptor instead.
 func (*GetHistoryGroupResponse) Descriptor() ([]byte, []int) {
-	return file_education_proto_rawDescGZIP(), []int{63}
+	return file_education_proto_rawDescGZIP(), []int{155}
 }
 
 func (x *GetHistoryGroupResponse) GetGroupHistory() []*AbsHistory {
@@ -4856,7 +11443,7 @@ type GetHistoryStudentResponse struct {
 
 func (x *GetHistoryStudentResponse) Reset() {
 	*x = GetHistoryStudentResponse{}
-	mi := &file_education_proto_msgTypes[64]
+	mi := &file_education_proto_msgTypes[156]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4868,7 +11455,7 @@ func (x *GetHistoryStudentResponse) String() string {
 func (*GetHistoryStudentResponse) ProtoMessage() {}
 
 func (x *GetHistoryStudentResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_education_proto_msgTypes[64]
+	mi := &file_education_proto_msgTypes[156]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4881,7 +11468,7 @@ func (x *GetHistoryStudentResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetHistoryStudentResponse.ProtoReflect.Descriptor instead.
 func (*GetHistoryStudentResponse) Descriptor() ([]byte, []int) {
-	return file_education_proto_rawDescGZIP(), []int{64}
+	return file_education_proto_rawDescGZIP(), []int{156}
 }
 
 func (x *GetHistoryStudentResponse) GetStudentHistory() []*AbsHistory {
@@ -4913,7 +11500,7 @@ type AbsStudentHistory struct {
 
 func (x *AbsStudentHistory) Reset() {
 	*x = AbsStudentHistory{}
-	mi := &file_education_proto_msgTypes[65]
+	mi := &file_education_proto_msgTypes[157]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4925,7 +11512,7 @@ func (x *AbsStudentHistory) String() string {
 func (*AbsStudentHistory) ProtoMessage() {}
 
 func (x *AbsStudentHistory) ProtoReflect() protoreflect.Message {
-	mi := &file_education_proto_msgTypes[65]
+	mi := &file_education_proto_msgTypes[157]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4938,7 +11525,7 @@ func (x *AbsStudentHistory) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AbsStudentHistory.ProtoReflect.Descriptor instead.
 func (*AbsStudentHistory) Descriptor() ([]byte, []int) {
-	return file_education_proto_rawDescGZIP(), []int{65}
+	return file_education_proto_rawDescGZIP(), []int{157}
 }
 
 func (x *AbsStudentHistory) GetStudent() *AbsStudent {
@@ -5008,7 +11595,7 @@ type AbsGroup struct {
 
 func (x *AbsGroup) Reset() {
 	*x = AbsGroup{}
-	mi := &file_education_proto_msgTypes[66]
+	mi := &file_education_proto_msgTypes[158]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5020,7 +11607,7 @@ func (x *AbsGroup) String() string {
 func (*AbsGroup) ProtoMessage() {}
 
 func (x *AbsGroup) ProtoReflect() protoreflect.Message {
-	mi := &file_education_proto_msgTypes[66]
+	mi := &file_education_proto_msgTypes[158]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5033,7 +11620,7 @@ func (x *AbsGroup) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AbsGroup.ProtoReflect.Descriptor instead.
 func (*AbsGroup) Descriptor() ([]byte, []int) {
-	return file_education_proto_rawDescGZIP(), []int{66}
+	return file_education_proto_rawDescGZIP(), []int{158}
 }
 
 func (x *AbsGroup) GetId() string {
@@ -5118,7 +11705,7 @@ type AbsHistory struct {
 
 func (x *AbsHistory) Reset() {
 	*x = AbsHistory{}
-	mi := &file_education_proto_msgTypes[67]
+	mi := &file_education_proto_msgTypes[159]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5130,7 +11717,7 @@ func (x *AbsHistory) String() string {
 func (*AbsHistory) ProtoMessage() {}
 
 func (x *AbsHistory) ProtoReflect() protoreflect.Message {
-	mi := &file_education_proto_msgTypes[67]
+	mi := &file_education_proto_msgTypes[159]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5143,7 +11730,7 @@ func (x *AbsHistory) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AbsHistory.ProtoReflect.Descriptor instead.
 func (*AbsHistory) Descriptor() ([]byte, []int) {
-	return file_education_proto_rawDescGZIP(), []int{67}
+	return file_education_proto_rawDescGZIP(), []int{159}
 }
 
 func (x *AbsHistory) GetId() string {
@@ -5183,7 +11770,7 @@ type SearchStudentRequest struct {
 
 func (x *SearchStudentRequest) Reset() {
 	*x = SearchStudentRequest{}
-	mi := &file_education_proto_msgTypes[68]
+	mi := &file_education_proto_msgTypes[160]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5195,7 +11782,7 @@ func (x *SearchStudentRequest) String() string {
 func (*SearchStudentRequest) ProtoMessage() {}
 
 func (x *SearchStudentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_education_proto_msgTypes[68]
+	mi := &file_education_proto_msgTypes[160]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5208,7 +11795,7 @@ func (x *SearchStudentRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SearchStudentRequest.ProtoReflect.Descriptor instead.
 func (*SearchStudentRequest) Descriptor() ([]byte, []int) {
-	return file_education_proto_rawDescGZIP(), []int{68}
+	return file_education_proto_rawDescGZIP(), []int{160}
 }
 
 func (x *SearchStudentRequest) GetValue() string {
@@ -5227,7 +11814,7 @@ type SearchStudentResponse struct {
 
 func (x *SearchStudentResponse) Reset() {
 	*x = SearchStudentResponse{}
-	mi := &file_education_proto_msgTypes[69]
+	mi := &file_education_proto_msgTypes[161]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5239,7 +11826,7 @@ func (x *SearchStudentResponse) String() string {
 func (*SearchStudentResponse) ProtoMessage() {}
 
 func (x *SearchStudentResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_education_proto_msgTypes[69]
+	mi := &file_education_proto_msgTypes[161]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5252,7 +11839,7 @@ func (x *SearchStudentResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SearchStudentResponse.ProtoReflect.Descriptor instead.
 func (*SearchStudentResponse) Descriptor() ([]byte, []int) {
-	return file_education_proto_rawDescGZIP(), []int{69}
+	return file_education_proto_rawDescGZIP(), []int{161}
 }
 
 func (x *SearchStudentResponse) GetStudents() []*AbsStudent {
@@ -5273,7 +11860,7 @@ type AbsStudent struct {
 
 func (x *AbsStudent) Reset() {
 	*x = AbsStudent{}
-	mi := &file_education_proto_msgTypes[70]
+	mi := &file_education_proto_msgTypes[162]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5285,7 +11872,7 @@ func (x *AbsStudent) String() string {
 func (*AbsStudent) ProtoMessage() {}
 
 func (x *AbsStudent) ProtoReflect() protoreflect.Message {
-	mi := &file_education_proto_msgTypes[70]
+	mi := &file_education_proto_msgTypes[162]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5298,7 +11885,7 @@ func (x *AbsStudent) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AbsStudent.ProtoReflect.Descriptor instead.
 func (*AbsStudent) Descriptor() ([]byte, []int) {
-	return file_education_proto_rawDescGZIP(), []int{70}
+	return file_education_proto_rawDescGZIP(), []int{162}
 }
 
 func (x *AbsStudent) GetId() string {
@@ -5337,7 +11924,7 @@ type GetAllStudentRequest struct {
 
 func (x *GetAllStudentRequest) Reset() {
 	*x = GetAllStudentRequest{}
-	mi := &file_education_proto_msgTypes[71]
+	mi := &file_education_proto_msgTypes[163]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5349,7 +11936,7 @@ func (x *GetAllStudentRequest) String() string {
 func (*GetAllStudentRequest) ProtoMessage() {}
 
 func (x *GetAllStudentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_education_proto_msgTypes[71]
+	mi := &file_education_proto_msgTypes[163]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5362,7 +11949,7 @@ func (x *GetAllStudentRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetAllStudentRequest.ProtoReflect.Descriptor instead.
 func (*GetAllStudentRequest) Descriptor() ([]byte, []int) {
-	return file_education_proto_rawDescGZIP(), []int{71}
+	return file_education_proto_rawDescGZIP(), []int{163}
 }
 
 func (x *GetAllStudentRequest) GetCondition() string {
@@ -5424,7 +12011,7 @@ type GetAllStudentResponse struct {
 
 func (x *GetAllStudentResponse) Reset() {
 	*x = GetAllStudentResponse{}
-	mi := &file_education_proto_msgTypes[72]
+	mi := &file_education_proto_msgTypes[164]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5436,7 +12023,7 @@ func (x *GetAllStudentResponse) String() string {
 func (*GetAllStudentResponse) ProtoMessage() {}
 
 func (x *GetAllStudentResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_education_proto_msgTypes[72]
+	mi := &file_education_proto_msgTypes[164]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5449,7 +12036,7 @@ func (x *GetAllStudentResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetAllStudentResponse.ProtoReflect.Descriptor instead.
 func (*GetAllStudentResponse) Descriptor() ([]byte, []int) {
-	return file_education_proto_rawDescGZIP(), []int{72}
+	return file_education_proto_rawDescGZIP(), []int{164}
 }
 
 func (x *GetAllStudentResponse) GetResponse() []*GetGroupsAbsForStudent {
@@ -5487,7 +12074,7 @@ type GetGroupsAbsForStudent struct {
 
 func (x *GetGroupsAbsForStudent) Reset() {
 	*x = GetGroupsAbsForStudent{}
-	mi := &file_education_proto_msgTypes[73]
+	mi := &file_education_proto_msgTypes[165]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5499,7 +12086,7 @@ func (x *GetGroupsAbsForStudent) String() string {
 func (*GetGroupsAbsForStudent) ProtoMessage() {}
 
 func (x *GetGroupsAbsForStudent) ProtoReflect() protoreflect.Message {
-	mi := &file_education_proto_msgTypes[73]
+	mi := &file_education_proto_msgTypes[165]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5512,7 +12099,7 @@ func (x *GetGroupsAbsForStudent) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetGroupsAbsForStudent.ProtoReflect.Descriptor instead.
 func (*GetGroupsAbsForStudent) Descriptor() ([]byte, []int) {
-	return file_education_proto_rawDescGZIP(), []int{73}
+	return file_education_proto_rawDescGZIP(), []int{165}
 }
 
 func (x *GetGroupsAbsForStudent) GetId() string {
@@ -5626,7 +12213,7 @@ type GroupGetAllStudentAbs struct {
 
 func (x *GroupGetAllStudentAbs) Reset() {
 	*x = GroupGetAllStudentAbs{}
-	mi := &file_education_proto_msgTypes[74]
+	mi := &file_education_proto_msgTypes[166]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5638,7 +12225,7 @@ func (x *GroupGetAllStudentAbs) String() string {
 func (*GroupGetAllStudentAbs) ProtoMessage() {}
 
 func (x *GroupGetAllStudentAbs) ProtoReflect() protoreflect.Message {
-	mi := &file_education_proto_msgTypes[74]
+	mi := &file_education_proto_msgTypes[166]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5651,7 +12238,7 @@ func (x *GroupGetAllStudentAbs) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GroupGetAllStudentAbs.ProtoReflect.Descriptor instead.
 func (*GroupGetAllStudentAbs) Descriptor() ([]byte, []int) {
-	return file_education_proto_rawDescGZIP(), []int{74}
+	return file_education_proto_rawDescGZIP(), []int{166}
 }
 
 func (x *GroupGetAllStudentAbs) GetId() string {
@@ -5757,7 +12344,7 @@ type CreateStudentRequest struct {
 
 func (x *CreateStudentRequest) Reset() {
 	*x = CreateStudentRequest{}
-	mi := &file_education_proto_msgTypes[75]
+	mi := &file_education_proto_msgTypes[167]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5769,7 +12356,7 @@ func (x *CreateStudentRequest) String() string {
 func (*CreateStudentRequest) ProtoMessage() {}
 
 func (x *CreateStudentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_education_proto_msgTypes[75]
+	mi := &file_education_proto_msgTypes[167]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5782,7 +12369,7 @@ func (x *CreateStudentRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateStudentRequest.ProtoReflect.Descriptor instead.
 func (*CreateStudentRequest) Descriptor() ([]byte, []int) {
-	return file_education_proto_rawDescGZIP(), []int{75}
+	return file_education_proto_rawDescGZIP(), []int{167}
 }
 
 func (x *CreateStudentRequest) GetPhoneNumber() string {
@@ -5878,7 +12465,7 @@ type UpdateStudentRequest struct {
 
 func (x *UpdateStudentRequest) Reset() {
 	*x = UpdateStudentRequest{}
-	mi := &file_education_proto_msgTypes[76]
+	mi := &file_education_proto_msgTypes[168]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5890,7 +12477,7 @@ func (x *UpdateStudentRequest) String() string {
 func (*UpdateStudentRequest) ProtoMessage() {}
 
 func (x *UpdateStudentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_education_proto_msgTypes[76]
+	mi := &file_education_proto_msgTypes[168]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5903,7 +12490,7 @@ func (x *UpdateStudentRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateStudentRequest.ProtoReflect.Descriptor instead.
 func (*UpdateStudentRequest) Descriptor() ([]byte, []int) {
-	return file_education_proto_rawDescGZIP(), []int{76}
+	return file_education_proto_rawDescGZIP(), []int{168}
 }
 
 func (x *UpdateStudentRequest) GetStudentId() string {
@@ -5968,13 +12555,15 @@ type AddToGroupRequest struct {
 	GroupId       string                 `protobuf:"bytes,2,opt,name=groupId,proto3" json:"groupId,omitempty"`
 	StudentIds    []string               `protobuf:"bytes,3,rep,name=student_ids,json=studentIds,proto3" json:"student_ids,omitempty"`
 	CreatedBy     string                 `protobuf:"bytes,4,opt,name=createdBy,proto3" json:"createdBy,omitempty"`
+	PromoCode     string                 `protobuf:"bytes,5,opt,name=promoCode,proto3" json:"promoCode,omitempty"`
+	CreatedByName string                 `protobuf:"bytes,6,opt,name=createdByName,proto3" json:"createdByName,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *AddToGroupRequest) Reset() {
 	*x = AddToGroupRequest{}
-	mi := &file_education_proto_msgTypes[77]
+	mi := &file_education_proto_msgTypes[169]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5986,7 +12575,7 @@ func (x *AddToGroupRequest) String() string {
 func (*AddToGroupRequest) ProtoMessage() {}
 
 func (x *AddToGroupRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_education_proto_msgTypes[77]
+	mi := &file_education_proto_msgTypes[169]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5999,7 +12588,7 @@ func (x *AddToGroupRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AddToGroupRequest.ProtoReflect.Descriptor instead.
 func (*AddToGroupRequest) Descriptor() ([]byte, []int) {
-	return file_education_proto_rawDescGZIP(), []int{77}
+	return file_education_proto_rawDescGZIP(), []int{169}
 }
 
 func (x *AddToGroupRequest) GetCreatedDate() string {
@@ -6023,11 +12612,140 @@ func (x *AddToGroupRequest) GetStudentIds() []string {
 	return nil
 }
 
-func (x *AddToGroupRequest) GetCreatedBy() string {
+func (x *AddToGroupRequest) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+func (x *AddToGroupRequest) GetPromoCode() string {
+	if x != nil {
+		return x.PromoCode
+	}
+	return ""
+}
+
+func (x *AddToGroupRequest) GetCreatedByName() string {
+	if x != nil {
+		return x.CreatedByName
+	}
+	return ""
+}
+
+type AddToGroupResult struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	StudentId string                 `protobuf:"bytes,1,opt,name=studentId,proto3" json:"studentId,omitempty"`
+	// ADDED, WAITLISTED when the group is full or FAILED
+	Status string `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	// the reason of a failure, or the discounts that could not be applied
+	Message string `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	// place in the waitlist of a WAITLISTED student
+	Position      int32 `protobuf:"varint,4,opt,name=position,proto3" json:"position,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddToGroupResult) Reset() {
+	*x = AddToGroupResult{}
+	mi := &file_education_proto_msgTypes[170]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddToGroupResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddToGroupResult) ProtoMessage() {}
+
+func (x *AddToGroupResult) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[170]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddToGroupResult.ProtoReflect.Descriptor instead.
+func (*AddToGroupResult) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{170}
+}
+
+func (x *AddToGroupResult) GetStudentId() string {
+	if x != nil {
+		return x.StudentId
+	}
+	return ""
+}
+
+func (x *AddToGroupResult) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *AddToGroupResult) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *AddToGroupResult) GetPosition() int32 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
+type AddToGroupResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Results       []*AddToGroupResult    `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddToGroupResponse) Reset() {
+	*x = AddToGroupResponse{}
+	mi := &file_education_proto_msgTypes[171]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddToGroupResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddToGroupResponse) ProtoMessage() {}
+
+func (x *AddToGroupResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[171]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddToGroupResponse.ProtoReflect.Descriptor instead.
+func (*AddToGroupResponse) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{171}
+}
+
+func (x *AddToGroupResponse) GetResults() []*AddToGroupResult {
 	if x != nil {
-		return x.CreatedBy
+		return x.Results
 	}
-	return ""
+	return nil
 }
 
 type GetStudentByIdResponse struct {
@@ -6048,7 +12766,7 @@ type GetStudentByIdResponse struct {
 
 func (x *GetStudentByIdResponse) Reset() {
 	*x = GetStudentByIdResponse{}
-	mi := &file_education_proto_msgTypes[78]
+	mi := &file_education_proto_msgTypes[172]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6060,7 +12778,7 @@ func (x *GetStudentByIdResponse) String() string {
 func (*GetStudentByIdResponse) ProtoMessage() {}
 
 func (x *GetStudentByIdResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_education_proto_msgTypes[78]
+	mi := &file_education_proto_msgTypes[172]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6073,7 +12791,7 @@ func (x *GetStudentByIdResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetStudentByIdResponse.ProtoReflect.Descriptor instead.
 func (*GetStudentByIdResponse) Descriptor() ([]byte, []int) {
-	return file_education_proto_rawDescGZIP(), []int{78}
+	return file_education_proto_rawDescGZIP(), []int{172}
 }
 
 func (x *GetStudentByIdResponse) GetId() string {
@@ -6155,7 +12873,7 @@ type NoteStudentByAbsRequest struct {
 
 func (x *NoteStudentByAbsRequest) Reset() {
 	*x = NoteStudentByAbsRequest{}
-	mi := &file_education_proto_msgTypes[79]
+	mi := &file_education_proto_msgTypes[173]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6167,7 +12885,7 @@ func (x *NoteStudentByAbsRequest) String() string {
 func (*NoteStudentByAbsRequest) ProtoMessage() {}
 
 func (x *NoteStudentByAbsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_education_proto_msgTypes[79]
+	mi := &file_education_proto_msgTypes[173]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6180,7 +12898,7 @@ func (x *NoteStudentByAbsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use NoteStudentByAbsRequest.ProtoReflect.Descriptor instead.
 func (*NoteStudentByAbsRequest) Descriptor() ([]byte, []int) {
-	return file_education_proto_rawDescGZIP(), []int{79}
+	return file_education_proto_rawDescGZIP(), []int{173}
 }
 
 func (x *NoteStudentByAbsRequest) GetId() string {
@@ -6212,7 +12930,7 @@ type GetGroupStudent struct {
 
 func (x *GetGroupStudent) Reset() {
 	*x = GetGroupStudent{}
-	mi := &file_education_proto_msgTypes[80]
+	mi := &file_education_proto_msgTypes[174]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6224,7 +12942,7 @@ func (x *GetGroupStudent) String() string {
 func (*GetGroupStudent) ProtoMessage() {}
 
 func (x *GetGroupStudent) ProtoReflect() protoreflect.Message {
-	mi := &file_education_proto_msgTypes[80]
+	mi := &file_education_proto_msgTypes[174]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6237,7 +12955,7 @@ func (x *GetGroupStudent) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetGroupStudent.ProtoReflect.Descriptor instead.
 func (*GetGroupStudent) Descriptor() ([]byte, []int) {
-	return file_education_proto_rawDescGZIP(), []int{80}
+	return file_education_proto_rawDescGZIP(), []int{174}
 }
 
 func (x *GetGroupStudent) GetId() string {
@@ -6347,7 +13065,7 @@ type GetNotesByStudent struct {
 
 func (x *GetNotesByStudent) Reset() {
 	*x = GetNotesByStudent{}
-	mi := &file_education_proto_msgTypes[81]
+	mi := &file_education_proto_msgTypes[175]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6359,7 +13077,309 @@ func (x *GetNotesByStudent) String() string {
 func (*GetNotesByStudent) ProtoMessage() {}
 
 func (x *GetNotesByStudent) ProtoReflect() protoreflect.Message {
-	mi := &file_education_proto_msgTypes[81]
+	mi := &file_education_proto_msgTypes[175]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetNotesByStudent.ProtoReflect.Descriptor instead.
+func (*GetNotesByStudent) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{175}
+}
+
+func (x *GetNotesByStudent) GetNotes() []*AbsNote {
+	if x != nil {
+		return x.Notes
+	}
+	return nil
+}
+
+type AbsNote struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Comment       string                 `protobuf:"bytes,2,opt,name=comment,proto3" json:"comment,omitempty"`
+	CreatedAt     string                 `protobuf:"bytes,3,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AbsNote) Reset() {
+	*x = AbsNote{}
+	mi := &file_education_proto_msgTypes[176]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AbsNote) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AbsNote) ProtoMessage() {}
+
+func (x *AbsNote) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[176]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AbsNote.ProtoReflect.Descriptor instead.
+func (*AbsNote) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{176}
+}
+
+func (x *AbsNote) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *AbsNote) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+func (x *AbsNote) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+type CreateNoteRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Note          string                 `protobuf:"bytes,1,opt,name=note,proto3" json:"note,omitempty"`
+	StudentId     string                 `protobuf:"bytes,2,opt,name=studentId,proto3" json:"studentId,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateNoteRequest) Reset() {
+	*x = CreateNoteRequest{}
+	mi := &file_education_proto_msgTypes[177]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateNoteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateNoteRequest) ProtoMessage() {}
+
+func (x *CreateNoteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[177]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateNoteRequest.ProtoReflect.Descriptor instead.
+func (*CreateNoteRequest) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{177}
+}
+
+func (x *CreateNoteRequest) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+func (x *CreateNoteRequest) GetStudentId() string {
+	if x != nil {
+		return x.StudentId
+	}
+	return ""
+}
+
+type SellLessonPackageRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	StudentId string                 `protobuf:"bytes,1,opt,name=studentId,proto3" json:"studentId,omitempty"`
+	GroupId   string                 `protobuf:"bytes,2,opt,name=groupId,proto3" json:"groupId,omitempty"`
+	// lessons and price default to the course package when zero
+	Lessons       int32   `protobuf:"varint,3,opt,name=lessons,proto3" json:"lessons,omitempty"`
+	Price         float64 `protobuf:"fixed64,4,opt,name=price,proto3" json:"price,omitempty"`
+	Date          string  `protobuf:"bytes,5,opt,name=date,proto3" json:"date,omitempty"`
+	ActionById    string  `protobuf:"bytes,6,opt,name=actionById,proto3" json:"actionById,omitempty"`
+	ActionByName  string  `protobuf:"bytes,7,opt,name=actionByName,proto3" json:"actionByName,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SellLessonPackageRequest) Reset() {
+	*x = SellLessonPackageRequest{}
+	mi := &file_education_proto_msgTypes[178]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SellLessonPackageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SellLessonPackageRequest) ProtoMessage() {}
+
+func (x *SellLessonPackageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[178]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SellLessonPackageRequest.ProtoReflect.Descriptor instead.
+func (*SellLessonPackageRequest) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{178}
+}
+
+func (x *SellLessonPackageRequest) GetStudentId() string {
+	if x != nil {
+		return x.StudentId
+	}
+	return ""
+}
+
+func (x *SellLessonPackageRequest) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+func (x *SellLessonPackageRequest) GetLessons() int32 {
+	if x != nil {
+		return x.Lessons
+	}
+	return 0
+}
+
+func (x *SellLessonPackageRequest) GetPrice() float64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *SellLessonPackageRequest) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *SellLessonPackageRequest) GetActionById() string {
+	if x != nil {
+		return x.ActionById
+	}
+	return ""
+}
+
+func (x *SellLessonPackageRequest) GetActionByName() string {
+	if x != nil {
+		return x.ActionByName
+	}
+	return ""
+}
+
+type GetLessonPackagesRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	StudentId string                 `protobuf:"bytes,1,opt,name=studentId,proto3" json:"studentId,omitempty"`
+	// optional
+	GroupId       string `protobuf:"bytes,2,opt,name=groupId,proto3" json:"groupId,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetLessonPackagesRequest) Reset() {
+	*x = GetLessonPackagesRequest{}
+	mi := &file_education_proto_msgTypes[179]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetLessonPackagesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLessonPackagesRequest) ProtoMessage() {}
+
+func (x *GetLessonPackagesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[179]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLessonPackagesRequest.ProtoReflect.Descriptor instead.
+func (*GetLessonPackagesRequest) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{179}
+}
+
+func (x *GetLessonPackagesRequest) GetStudentId() string {
+	if x != nil {
+		return x.StudentId
+	}
+	return ""
+}
+
+func (x *GetLessonPackagesRequest) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+type GetLessonPackagesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Packages      []*AbsLessonPackage    `protobuf:"bytes,1,rep,name=packages,proto3" json:"packages,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetLessonPackagesResponse) Reset() {
+	*x = GetLessonPackagesResponse{}
+	mi := &file_education_proto_msgTypes[180]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetLessonPackagesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLessonPackagesResponse) ProtoMessage() {}
+
+func (x *GetLessonPackagesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[180]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6370,42 +13390,51 @@ func (x *GetNotesByStudent) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetNotesByStudent.ProtoReflect.Descriptor instead.
-func (*GetNotesByStudent) Descriptor() ([]byte, []int) {
-	return file_education_proto_rawDescGZIP(), []int{81}
+// Deprecated: Use GetLessonPackagesResponse.ProtoReflect.Descriptor instead.
+func (*GetLessonPackagesResponse) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{180}
 }
 
-func (x *GetNotesByStudent) GetNotes() []*AbsNote {
+func (x *GetLessonPackagesResponse) GetPackages() []*AbsLessonPackage {
 	if x != nil {
-		return x.Notes
+		return x.Packages
 	}
 	return nil
 }
 
-type AbsNote struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Comment       string                 `protobuf:"bytes,2,opt,name=comment,proto3" json:"comment,omitempty"`
-	CreatedAt     string                 `protobuf:"bytes,3,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+type AbsLessonPackage struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	Id           string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	StudentId    string                 `protobuf:"bytes,2,opt,name=studentId,proto3" json:"studentId,omitempty"`
+	GroupId      string                 `protobuf:"bytes,3,opt,name=groupId,proto3" json:"groupId,omitempty"`
+	GroupName    string                 `protobuf:"bytes,4,opt,name=groupName,proto3" json:"groupName,omitempty"`
+	LessonsTotal int32                  `protobuf:"varint,5,opt,name=lessonsTotal,proto3" json:"lessonsTotal,omitempty"`
+	LessonsLeft  int32                  `protobuf:"varint,6,opt,name=lessonsLeft,proto3" json:"lessonsLeft,omitempty"`
+	Price        float64                `protobuf:"fixed64,7,opt,name=price,proto3" json:"price,omitempty"`
+	PurchasedAt  string                 `protobuf:"bytes,8,opt,name=purchasedAt,proto3" json:"purchasedAt,omitempty"`
+	ExpiresAt    string                 `protobuf:"bytes,9,opt,name=expiresAt,proto3" json:"expiresAt,omitempty"`
+	// ACTIVE, USED or EXPIRED
+	Status        string `protobuf:"bytes,10,opt,name=status,proto3" json:"status,omitempty"`
+	CreatedByName string `protobuf:"bytes,11,opt,name=createdByName,proto3" json:"createdByName,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AbsNote) Reset() {
-	*x = AbsNote{}
-	mi := &file_education_proto_msgTypes[82]
+func (x *AbsLessonPackage) Reset() {
+	*x = AbsLessonPackage{}
+	mi := &file_education_proto_msgTypes[181]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AbsNote) String() string {
+func (x *AbsLessonPackage) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AbsNote) ProtoMessage() {}
+func (*AbsLessonPackage) ProtoMessage() {}
 
-func (x *AbsNote) ProtoReflect() protoreflect.Message {
-	mi := &file_education_proto_msgTypes[82]
+func (x *AbsLessonPackage) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[181]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6416,80 +13445,84 @@ func (x *AbsNote) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AbsNote.ProtoReflect.Descriptor instead.
-func (*AbsNote) Descriptor() ([]byte, []int) {
-	return file_education_proto_rawDescGZIP(), []int{82}
+// Deprecated: Use AbsLessonPackage.ProtoReflect.Descriptor instead.
+func (*AbsLessonPackage) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{181}
 }
 
-func (x *AbsNote) GetId() string {
+func (x *AbsLessonPackage) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-func (x *AbsNote) GetComment() string {
+func (x *AbsLessonPackage) GetStudentId() string {
 	if x != nil {
-		return x.Comment
+		return x.StudentId
 	}
 	return ""
 }
 
-func (x *AbsNote) GetCreatedAt() string {
+func (x *AbsLessonPackage) GetGroupId() string {
 	if x != nil {
-		return x.CreatedAt
+		return x.GroupId
 	}
 	return ""
 }
 
-type CreateNoteRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Note          string                 `protobuf:"bytes,1,opt,name=note,proto3" json:"note,omitempty"`
-	StudentId     string                 `protobuf:"bytes,2,opt,name=studentId,proto3" json:"studentId,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *AbsLessonPackage) GetGroupName() string {
+	if x != nil {
+		return x.GroupName
+	}
+	return ""
 }
 
-func (x *CreateNoteRequest) Reset() {
-	*x = CreateNoteRequest{}
-	mi := &file_education_proto_msgTypes[83]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *AbsLessonPackage) GetLessonsTotal() int32 {
+	if x != nil {
+		return x.LessonsTotal
+	}
+	return 0
 }
 
-func (x *CreateNoteRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *AbsLessonPackage) GetLessonsLeft() int32 {
+	if x != nil {
+		return x.LessonsLeft
+	}
+	return 0
 }
 
-func (*CreateNoteRequest) ProtoMessage() {}
+func (x *AbsLessonPackage) GetPrice() float64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
 
-func (x *CreateNoteRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_education_proto_msgTypes[83]
+func (x *AbsLessonPackage) GetPurchasedAt() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.PurchasedAt
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use CreateNoteRequest.ProtoReflect.Descriptor instead.
-func (*CreateNoteRequest) Descriptor() ([]byte, []int) {
-	return file_education_proto_rawDescGZIP(), []int{83}
+func (x *AbsLessonPackage) GetExpiresAt() string {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return ""
 }
 
-func (x *CreateNoteRequest) GetNote() string {
+func (x *AbsLessonPackage) GetStatus() string {
 	if x != nil {
-		return x.Note
+		return x.Status
 	}
 	return ""
 }
 
-func (x *CreateNoteRequest) GetStudentId() string {
+func (x *AbsLessonPackage) GetCreatedByName() string {
 	if x != nil {
-		return x.StudentId
+		return x.CreatedByName
 	}
 	return ""
 }
@@ -6504,7 +13537,7 @@ type GetSmsLogRequest struct {
 
 func (x *GetSmsLogRequest) Reset() {
 	*x = GetSmsLogRequest{}
-	mi := &file_education_proto_msgTypes[84]
+	mi := &file_education_proto_msgTypes[182]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6516,7 +13549,7 @@ func (x *GetSmsLogRequest) String() string {
 func (*GetSmsLogRequest) ProtoMessage() {}
 
 func (x *GetSmsLogRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_education_proto_msgTypes[84]
+	mi := &file_education_proto_msgTypes[182]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6529,7 +13562,7 @@ func (x *GetSmsLogRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetSmsLogRequest.ProtoReflect.Descriptor instead.
 func (*GetSmsLogRequest) Descriptor() ([]byte, []int) {
-	return file_education_proto_rawDescGZIP(), []int{84}
+	return file_education_proto_rawDescGZIP(), []int{182}
 }
 
 func (x *GetSmsLogRequest) GetPageRequest() *PageRequest {
@@ -6556,7 +13589,7 @@ type GetSmsLogResponse struct {
 
 func (x *GetSmsLogResponse) Reset() {
 	*x = GetSmsLogResponse{}
-	mi := &file_education_proto_msgTypes[85]
+	mi := &file_education_proto_msgTypes[183]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6568,7 +13601,7 @@ func (x *GetSmsLogResponse) String() string {
 func (*GetSmsLogResponse) ProtoMessage() {}
 
 func (x *GetSmsLogResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_education_proto_msgTypes[85]
+	mi := &file_education_proto_msgTypes[183]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6581,7 +13614,7 @@ func (x *GetSmsLogResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetSmsLogResponse.ProtoReflect.Descriptor instead.
 func (*GetSmsLogResponse) Descriptor() ([]byte, []int) {
-	return file_education_proto_rawDescGZIP(), []int{85}
+	return file_education_proto_rawDescGZIP(), []int{183}
 }
 
 func (x *GetSmsLogResponse) GetDatas() []*SmsLogList {
@@ -6610,7 +13643,7 @@ type SmsLogList struct {
 
 func (x *SmsLogList) Reset() {
 	*x = SmsLogList{}
-	mi := &file_education_proto_msgTypes[86]
+	mi := &file_education_proto_msgTypes[184]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6622,7 +13655,7 @@ func (x *SmsLogList) String() string {
 func (*SmsLogList) ProtoMessage() {}
 
 func (x *SmsLogList) ProtoReflect() protoreflect.Message {
-	mi := &file_education_proto_msgTypes[86]
+	mi := &file_education_proto_msgTypes[184]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6635,7 +13668,7 @@ func (x *SmsLogList) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SmsLogList.ProtoReflect.Descriptor instead.
 func (*SmsLogList) Descriptor() ([]byte, []int) {
-	return file_education_proto_rawDescGZIP(), []int{86}
+	return file_education_proto_rawDescGZIP(), []int{184}
 }
 
 func (x *SmsLogList) GetCreatorName() string {
@@ -6678,7 +13711,7 @@ type AddSmsRequest struct {
 
 func (x *AddSmsRequest) Reset() {
 	*x = AddSmsRequest{}
-	mi := &file_education_proto_msgTypes[87]
+	mi := &file_education_proto_msgTypes[185]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6690,7 +13723,7 @@ func (x *AddSmsRequest) String() string {
 func (*AddSmsRequest) ProtoMessage() {}
 
 func (x *AddSmsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_education_proto_msgTypes[87]
+	mi := &file_education_proto_msgTypes[185]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6703,7 +13736,7 @@ func (x *AddSmsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AddSmsRequest.ProtoReflect.Descriptor instead.
 func (*AddSmsRequest) Descriptor() ([]byte, []int) {
-	return file_education_proto_rawDescGZIP(), []int{87}
+	return file_education_proto_rawDescGZIP(), []int{185}
 }
 
 func (x *AddSmsRequest) GetCompanyId() string {
@@ -6743,7 +13776,7 @@ type GetSmsTransactionDetailResponse struct {
 
 func (x *GetSmsTransactionDetailResponse) Reset() {
 	*x = GetSmsTransactionDetailResponse{}
-	mi := &file_education_proto_msgTypes[88]
+	mi := &file_education_proto_msgTypes[186]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6755,7 +13788,7 @@ func (x *GetSmsTransactionDetailResponse) String() string {
 func (*GetSmsTransactionDetailResponse) ProtoMessage() {}
 
 func (x *GetSmsTransactionDetailResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_education_proto_msgTypes[88]
+	mi := &file_education_proto_msgTypes[186]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6768,7 +13801,7 @@ func (x *GetSmsTransactionDetailResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetSmsTransactionDetailResponse.ProtoReflect.Descriptor instead.
 func (*GetSmsTransactionDetailResponse) Descriptor() ([]byte, []int) {
-	return file_education_proto_rawDescGZIP(), []int{88}
+	return file_education_proto_rawDescGZIP(), []int{186}
 }
 
 func (x *GetSmsTransactionDetailResponse) GetDatas() []*GetSmsTransactionList {
@@ -6790,7 +13823,7 @@ type GetSmsTransactionList struct {
 
 func (x *GetSmsTransactionList) Reset() {
 	*x = GetSmsTransactionList{}
-	mi := &file_education_proto_msgTypes[89]
+	mi := &file_education_proto_msgTypes[187]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6802,7 +13835,7 @@ func (x *GetSmsTransactionList) String() string {
 func (*GetSmsTransactionList) ProtoMessage() {}
 
 func (x *GetSmsTransactionList) ProtoReflect() protoreflect.Message {
-	mi := &file_education_proto_msgTypes[89]
+	mi := &file_education_proto_msgTypes[187]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6815,7 +13848,7 @@ func (x *GetSmsTransactionList) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetSmsTransactionList.ProtoReflect.Descriptor instead.
 func (*GetSmsTransactionList) Descriptor() ([]byte, []int) {
-	return file_education_proto_rawDescGZIP(), []int{89}
+	return file_education_proto_rawDescGZIP(), []int{187}
 }
 
 func (x *GetSmsTransactionList) GetTransactionId() int32 {
@@ -6855,7 +13888,7 @@ type GetSmsTemplateRequest struct {
 
 func (x *GetSmsTemplateRequest) Reset() {
 	*x = GetSmsTemplateRequest{}
-	mi := &file_education_proto_msgTypes[90]
+	mi := &file_education_proto_msgTypes[188]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6867,7 +13900,7 @@ func (x *GetSmsTemplateRequest) String() string {
 func (*GetSmsTemplateRequest) ProtoMessage() {}
 
 func (x *GetSmsTemplateRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_education_proto_msgTypes[90]
+	mi := &file_education_proto_msgTypes[188]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6880,7 +13913,7 @@ func (x *GetSmsTemplateRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetSmsTemplateRequest.ProtoReflect.Descriptor instead.
 func (*GetSmsTemplateRequest) Descriptor() ([]byte, []int) {
-	return file_education_proto_rawDescGZIP(), []int{90}
+	return file_education_proto_rawDescGZIP(), []int{188}
 }
 
 func (x *GetSmsTemplateRequest) GetSmsType() string {
@@ -6901,7 +13934,7 @@ type GetSmsTemplateResponse struct {
 
 func (x *GetSmsTemplateResponse) Reset() {
 	*x = GetSmsTemplateResponse{}
-	mi := &file_education_proto_msgTypes[91]
+	mi := &file_education_proto_msgTypes[189]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6913,7 +13946,7 @@ func (x *GetSmsTemplateResponse) String() string {
 func (*GetSmsTemplateResponse) ProtoMessage() {}
 
 func (x *GetSmsTemplateResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_education_proto_msgTypes[91]
+	mi := &file_education_proto_msgTypes[189]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6926,7 +13959,7 @@ func (x *GetSmsTemplateResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetSmsTemplateResponse.ProtoReflect.Descriptor instead.
 func (*GetSmsTemplateResponse) Descriptor() ([]byte, []int) {
-	return file_education_proto_rawDescGZIP(), []int{91}
+	return file_education_proto_rawDescGZIP(), []int{189}
 }
 
 func (x *GetSmsTemplateResponse) GetDatas() []*SmsTemplateList {
@@ -6962,7 +13995,7 @@ type SmsTemplateList struct {
 
 func (x *SmsTemplateList) Reset() {
 	*x = SmsTemplateList{}
-	mi := &file_education_proto_msgTypes[92]
+	mi := &file_education_proto_msgTypes[190]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6974,7 +14007,7 @@ func (x *SmsTemplateList) String() string {
 func (*SmsTemplateList) ProtoMessage() {}
 
 func (x *SmsTemplateList) ProtoReflect() protoreflect.Message {
-	mi := &file_education_proto_msgTypes[92]
+	mi := &file_education_proto_msgTypes[190]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6987,7 +14020,7 @@ func (x *SmsTemplateList) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SmsTemplateList.ProtoReflect.Descriptor instead.
 func (*SmsTemplateList) Descriptor() ([]byte, []int) {
-	return file_education_proto_rawDescGZIP(), []int{92}
+	return file_education_proto_rawDescGZIP(), []int{190}
 }
 
 func (x *SmsTemplateList) GetActionName() string {
@@ -7034,7 +14067,7 @@ type SetSmsTemplateRequest struct {
 
 func (x *SetSmsTemplateRequest) Reset() {
 	*x = SetSmsTemplateRequest{}
-	mi := &file_education_proto_msgTypes[93]
+	mi := &file_education_proto_msgTypes[191]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7046,7 +14079,7 @@ func (x *SetSmsTemplateRequest) String() string {
 func (*SetSmsTemplateRequest) ProtoMessage() {}
 
 func (x *SetSmsTemplateRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_education_proto_msgTypes[93]
+	mi := &file_education_proto_msgTypes[191]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7059,7 +14092,7 @@ func (x *SetSmsTemplateRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SetSmsTemplateRequest.ProtoReflect.Descriptor instead.
 func (*SetSmsTemplateRequest) Descriptor() ([]byte, []int) {
-	return file_education_proto_rawDescGZIP(), []int{93}
+	return file_education_proto_rawDescGZIP(), []int{191}
 }
 
 func (x *SetSmsTemplateRequest) GetAction() string {
@@ -7131,7 +14164,7 @@ type SendSmsDirectlyRequest struct {
 
 func (x *SendSmsDirectlyRequest) Reset() {
 	*x = SendSmsDirectlyRequest{}
-	mi := &file_education_proto_msgTypes[94]
+	mi := &file_education_proto_msgTypes[192]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7143,7 +14176,7 @@ func (x *SendSmsDirectlyRequest) String() string {
 func (*SendSmsDirectlyRequest) ProtoMessage() {}
 
 func (x *SendSmsDirectlyRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_education_proto_msgTypes[94]
+	mi := &file_education_proto_msgTypes[192]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7156,7 +14189,7 @@ func (x *SendSmsDirectlyRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SendSmsDirectlyRequest.ProtoReflect.Descriptor instead.
 func (*SendSmsDirectlyRequest) Descriptor() ([]byte, []int) {
-	return file_education_proto_rawDescGZIP(), []int{94}
+	return file_education_proto_rawDescGZIP(), []int{192}
 }
 
 func (x *SendSmsDirectlyRequest) GetSmsValue() string {
@@ -7194,6 +14227,58 @@ func (x *SendSmsDirectlyRequest) GetStudentId() string {
 	return ""
 }
 
+type SendStaffSmsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Phones        []string               `protobuf:"bytes,1,rep,name=phones,proto3" json:"phones,omitempty"`
+	SmsValue      string                 `protobuf:"bytes,2,opt,name=smsValue,proto3" json:"smsValue,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SendStaffSmsRequest) Reset() {
+	*x = SendStaffSmsRequest{}
+	mi := &file_education_proto_msgTypes[193]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SendStaffSmsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendStaffSmsRequest) ProtoMessage() {}
+
+func (x *SendStaffSmsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[193]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendStaffSmsRequest.ProtoReflect.Descriptor instead.
+func (*SendStaffSmsRequest) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{193}
+}
+
+func (x *SendStaffSmsRequest) GetPhones() []string {
+	if x != nil {
+		return x.Phones
+	}
+	return nil
+}
+
+func (x *SendStaffSmsRequest) GetSmsValue() string {
+	if x != nil {
+		return x.SmsValue
+	}
+	return ""
+}
+
 var File_education_proto protoreflect.FileDescriptor
 
 const file_education_proto_rawDesc = "" +
@@ -7382,7 +14467,63 @@ const file_education_proto_rawDesc = "" +
 	"\vdescription\x18\x05 \x01(\tR\vdescription\x12\"\n" +
 	"\fstudentCount\x18\x06 \x01(\x05R\fstudentCount\"&\n" +
 	"\x14GetCourseByIdRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\"l\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\x93\x02\n" +
+	"\rCourseBilling\x12\x1a\n" +
+	"\bcourseId\x18\x01 \x01(\tR\bcourseId\x12 \n" +
+	"\vbillingMode\x18\x02 \x01(\tR\vbillingMode\x12 \n" +
+	"\vlessonPrice\x18\x03 \x01(\x01R\vlessonPrice\x12&\n" +
+	"\x0epackageLessons\x18\x04 \x01(\x05R\x0epackageLessons\x12\"\n" +
+	"\fpackagePrice\x18\x05 \x01(\x01R\fpackagePrice\x12*\n" +
+	"\x10packageValidDays\x18\x06 \x01(\x05R\x10packageValidDays\x12*\n" +
+	"\x10lowCreditLessons\x18\a \x01(\x05R\x10lowCreditLessons\"\xea\x01\n" +
+	"\x1aScheduleCoursePriceRequest\x12\x1a\n" +
+	"\bcourseId\x18\x01 \x01(\tR\bcourseId\x12\x14\n" +
+	"\x05price\x18\x02 \x01(\x01R\x05price\x12$\n" +
+	"\reffectiveFrom\x18\x03 \x01(\tR\reffectiveFrom\x120\n" +
+	"\x13grandfatherExisting\x18\x04 \x01(\bR\x13grandfatherExisting\x12\x1e\n" +
+	"\n" +
+	"actionById\x18\x05 \x01(\tR\n" +
+	"actionById\x12\"\n" +
+	"\factionByName\x18\x06 \x01(\tR\factionByName\"\xd6\x01\n" +
+	"\x12CoursePriceVersion\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
+	"\x05price\x18\x02 \x01(\x01R\x05price\x12$\n" +
+	"\reffectiveFrom\x18\x03 \x01(\tR\reffectiveFrom\x120\n" +
+	"\x13grandfatherExisting\x18\x04 \x01(\bR\x13grandfatherExisting\x12$\n" +
+	"\rcreatedByName\x18\x05 \x01(\tR\rcreatedByName\x12\x1c\n" +
+	"\tcreatedAt\x18\x06 \x01(\tR\tcreatedAt\"\x90\x01\n" +
+	"\x1dGetCoursePriceHistoryResponse\x129\n" +
+	"\bversions\x18\x01 \x03(\v2\x1d.education.CoursePriceVersionR\bversions\x124\n" +
+	"\x05locks\x18\x02 \x03(\v2\x1e.education.EnrollmentPriceLockR\x05locks\"\xc3\x01\n" +
+	"\x13EnrollmentPriceLock\x12\x1c\n" +
+	"\tstudentId\x18\x01 \x01(\tR\tstudentId\x12 \n" +
+	"\vstudentName\x18\x02 \x01(\tR\vstudentName\x12\x18\n" +
+	"\agroupId\x18\x03 \x01(\tR\agroupId\x12\x1c\n" +
+	"\tgroupName\x18\x04 \x01(\tR\tgroupName\x12\x14\n" +
+	"\x05price\x18\x05 \x01(\x01R\x05price\x12\x1e\n" +
+	"\n" +
+	"lockedFrom\x18\x06 \x01(\tR\n" +
+	"lockedFrom\"\x8d\x01\n" +
+	"\x1dSetEnrollmentPriceLockRequest\x12\x1c\n" +
+	"\tstudentId\x18\x01 \x01(\tR\tstudentId\x12\x18\n" +
+	"\agroupId\x18\x02 \x01(\tR\agroupId\x12\x14\n" +
+	"\x05price\x18\x03 \x01(\x01R\x05price\x12\x1e\n" +
+	"\n" +
+	"lockedFrom\x18\x04 \x01(\tR\n" +
+	"lockedFrom\")\n" +
+	"\x15GetGroupsByIdsRequest\x12\x10\n" +
+	"\x03ids\x18\x01 \x03(\tR\x03ids\"F\n" +
+	"\x16GetGroupsByIdsResponse\x12,\n" +
+	"\x06groups\x18\x01 \x03(\v2\x14.education.GroupMetaR\x06groups\"\xab\x01\n" +
+	"\tGroupMeta\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1a\n" +
+	"\bcourseId\x18\x03 \x01(\tR\bcourseId\x12\x1e\n" +
+	"\n" +
+	"courseName\x18\x04 \x01(\tR\n" +
+	"courseName\x12\x1c\n" +
+	"\tteacherId\x18\x05 \x01(\tR\tteacherId\x12 \n" +
+	"\vteacherName\x18\x06 \x01(\tR\vteacherName\"l\n" +
 	"\x1eGetLeftAfterTrialPeriodRequest\x12\x12\n" +
 	"\x04from\x18\x01 \x01(\tR\x04from\x12\x0e\n" +
 	"\x02to\x18\x02 \x01(\tR\x02to\x12\x12\n" +
@@ -7440,7 +14581,7 @@ const file_education_proto_rawDesc = "" +
 	"groupEndAt\x18\a \x01(\tR\n" +
 	"groupEndAt\x12.\n" +
 	"\x12activeStudentCount\x18\b \x01(\x05R\x12activeStudentCount\x121\n" +
-	"\bstudents\x18\t \x03(\v2\x15.education.AbsStudentR\bstudents\"\x98\x02\n" +
+	"\bstudents\x18\t \x03(\v2\x15.education.AbsStudentR\bstudents\"\xf2\x02\n" +
 	"\x12CreateGroupRequest\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1a\n" +
 	"\bcourseId\x18\x02 \x01(\x05R\bcourseId\x12\x1c\n" +
@@ -7450,13 +14591,19 @@ const file_education_proto_rawDesc = "" +
 	"\x06roomId\x18\x06 \x01(\x05R\x06roomId\x12(\n" +
 	"\x0flessonStartTime\x18\a \x01(\tR\x0flessonStartTime\x12&\n" +
 	"\x0egroupStartDate\x18\b \x01(\tR\x0egroupStartDate\x12\"\n" +
-	"\fgroupEndDate\x18\t \x01(\tR\fgroupEndDate\"a\n" +
+	"\fgroupEndDate\x18\t \x01(\tR\fgroupEndDate\x12\x14\n" +
+	"\x05force\x18\n" +
+	" \x01(\bR\x05force\x12\x1e\n" +
+	"\n" +
+	"actionById\x18\v \x01(\tR\n" +
+	"actionById\x12\"\n" +
+	"\factionByName\x18\f \x01(\tR\factionByName\"a\n" +
 	"\x13GetGroupByIdRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1e\n" +
 	"\n" +
 	"actionRole\x18\x02 \x01(\tR\n" +
 	"actionRole\x12\x1a\n" +
-	"\bactionId\x18\x03 \x01(\tR\bactionId\"\xa7\x02\n" +
+	"\bactionId\x18\x03 \x01(\tR\bactionId\"\x81\x03\n" +
 	"\x11GetUpdateGroupAbs\x12\x0e\n" +
 	"\x02id\x18\n" +
 	" \x01(\tR\x02id\x12\x12\n" +
@@ -7468,7 +14615,12 @@ const file_education_proto_rawDesc = "" +
 	"\x06roomId\x18\x06 \x01(\x05R\x06roomId\x12(\n" +
 	"\x0flessonStartTime\x18\a \x01(\tR\x0flessonStartTime\x12&\n" +
 	"\x0egroupStartDate\x18\b \x01(\tR\x0egroupStartDate\x12\"\n" +
-	"\fgroupEndDate\x18\t \x01(\tR\fgroupEndDate\"[\n" +
+	"\fgroupEndDate\x18\t \x01(\tR\fgroupEndDate\x12\x14\n" +
+	"\x05force\x18\v \x01(\bR\x05force\x12\x1e\n" +
+	"\n" +
+	"actionById\x18\f \x01(\tR\n" +
+	"actionById\x12\"\n" +
+	"\factionByName\x18\r \x01(\tR\factionByName\"[\n" +
 	"\x19GetGroupsByCourseResponse\x12>\n" +
 	"\x06groups\x18\x01 \x03(\v2&.education.GetGroupByCourseAbsResponseR\x06groups\"\xff\x01\n" +
 	"\x1bGetGroupByCourseAbsResponse\x12\x0e\n" +
@@ -7519,18 +14671,68 @@ const file_education_proto_rawDesc = "" +
 	"\n" +
 	"_startDateB\n" +
 	"\n" +
-	"\b_endDate\"a\n" +
+	"\b_endDate\"[\n" +
+	"\x1dUpdateGroupBillingModeRequest\x12\x18\n" +
+	"\agroupId\x18\x01 \x01(\tR\agroupId\x12 \n" +
+	"\vbillingMode\x18\x02 \x01(\tR\vbillingMode\"[\n" +
+	"\x1dUpdateGroupMaxStudentsRequest\x12\x18\n" +
+	"\agroupId\x18\x01 \x01(\tR\agroupId\x12 \n" +
+	"\vmaxStudents\x18\x02 \x01(\x05R\vmaxStudents\"\x98\x02\n" +
+	"\x10ScheduleConflict\x12\x12\n" +
+	"\x04kind\x18\x01 \x01(\tR\x04kind\x12\x18\n" +
+	"\agroupId\x18\x02 \x01(\x03R\agroupId\x12\x1c\n" +
+	"\tgroupName\x18\x03 \x01(\tR\tgroupName\x12\x16\n" +
+	"\x06roomId\x18\x04 \x01(\x05R\x06roomId\x12\x1c\n" +
+	"\tteacherId\x18\x05 \x01(\tR\tteacherId\x12\x12\n" +
+	"\x04days\x18\x06 \x03(\tR\x04days\x12\x1c\n" +
+	"\tstartTime\x18\a \x01(\tR\tstartTime\x12\x18\n" +
+	"\aendTime\x18\b \x01(\tR\aendTime\x12\x1a\n" +
+	"\bfromDate\x18\t \x01(\tR\bfromDate\x12\x1a\n" +
+	"\btillDate\x18\n" +
+	" \x01(\tR\btillDate\"N\n" +
+	"\x11ScheduleConflicts\x129\n" +
+	"\tconflicts\x18\x01 \x03(\v2\x1b.education.ScheduleConflictR\tconflicts\"\xa9\x02\n" +
+	"\x1dCheckScheduleConflictsRequest\x12\x18\n" +
+	"\agroupId\x18\x01 \x01(\tR\agroupId\x12\x1a\n" +
+	"\bcourseId\x18\x02 \x01(\x05R\bcourseId\x12\x1c\n" +
+	"\tteacherId\x18\x03 \x01(\tR\tteacherId\x12\x16\n" +
+	"\x06roomId\x18\x04 \x01(\x05R\x06roomId\x12\x12\n" +
+	"\x04type\x18\x05 \x01(\tR\x04type\x12\x12\n" +
+	"\x04days\x18\x06 \x03(\tR\x04days\x12(\n" +
+	"\x0flessonStartTime\x18\a \x01(\tR\x0flessonStartTime\x12&\n" +
+	"\x0egroupStartDate\x18\b \x01(\tR\x0egroupStartDate\x12\"\n" +
+	"\fgroupEndDate\x18\t \x01(\tR\fgroupEndDate\"M\n" +
+	"#GetScheduleConflictOverridesRequest\x12\x12\n" +
+	"\x04from\x18\x01 \x01(\tR\x04from\x12\x12\n" +
+	"\x04till\x18\x02 \x01(\tR\x04till\"\x97\x02\n" +
+	"\x18ScheduleConflictOverride\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x18\n" +
+	"\agroupId\x18\x02 \x01(\x03R\agroupId\x12\x1c\n" +
+	"\tgroupName\x18\x03 \x01(\tR\tgroupName\x12\x16\n" +
+	"\x06action\x18\x04 \x01(\tR\x06action\x129\n" +
+	"\tconflicts\x18\x05 \x03(\v2\x1b.education.ScheduleConflictR\tconflicts\x12\x1e\n" +
+	"\n" +
+	"forcedById\x18\x06 \x01(\tR\n" +
+	"forcedById\x12\"\n" +
+	"\fforcedByName\x18\a \x01(\tR\fforcedByName\x12\x1c\n" +
+	"\tcreatedAt\x18\b \x01(\tR\tcreatedAt\"i\n" +
+	"$GetScheduleConflictOverridesResponse\x12A\n" +
+	"\toverrides\x18\x01 \x03(\v2#.education.ScheduleConflictOverrideR\toverrides\"\x81\x01\n" +
 	"\x1dCalculateTeacherSalaryRequest\x12\x12\n" +
 	"\x04from\x18\x01 \x01(\tR\x04from\x12\x0e\n" +
 	"\x02to\x18\x02 \x01(\tR\x02to\x12\x1c\n" +
-	"\tteacherId\x18\x03 \x01(\tR\tteacherId\"[\n" +
+	"\tteacherId\x18\x03 \x01(\tR\tteacherId\x12\x1e\n" +
+	"\n" +
+	"teacherIds\x18\x04 \x03(\tR\n" +
+	"teacherIds\"[\n" +
 	"\x1eCalculateTeacherSalaryResponse\x129\n" +
-	"\bsalaries\x18\x01 \x03(\v2\x1d.education.AbsCalculateSalaryR\bsalaries\"\xc0\x01\n" +
+	"\bsalaries\x18\x01 \x03(\v2\x1d.education.AbsCalculateSalaryR\bsalaries\"\xde\x01\n" +
 	"\x12AbsCalculateSalary\x12\x18\n" +
 	"\agroupId\x18\x01 \x01(\tR\agroupId\x12\x1c\n" +
 	"\tgroupName\x18\x02 \x01(\tR\tgroupName\x12<\n" +
 	"\x19commonLessonCountInPeriod\x18\x03 \x01(\x05R\x19commonLessonCountInPeriod\x124\n" +
-	"\bsalaries\x18\x04 \x03(\v2\x18.education.StudentSalaryR\bsalaries\"\x99\x02\n" +
+	"\bsalaries\x18\x04 \x03(\v2\x18.education.StudentSalaryR\bsalaries\x12\x1c\n" +
+	"\tteacherId\x18\x05 \x01(\tR\tteacherId\"\x99\x02\n" +
 	"\rStudentSalary\x12\x1c\n" +
 	"\tstudentId\x18\x01 \x01(\tR\tstudentId\x12 \n" +
 	"\vstudentName\x18\x02 \x01(\tR\vstudentName\x12,\n" +
@@ -7571,7 +14773,7 @@ const file_education_proto_rawDesc = "" +
 	"\tcondition\x18\n" +
 	" \x01(\tR\tcondition\x12\x12\n" +
 	"\x04name\x18\v \x01(\tR\x04name\x12\x14\n" +
-	"\x05phone\x18\f \x01(\tR\x05phone\"\x91\x01\n" +
+	"\x05phone\x18\f \x01(\tR\x05phone\"\xcf\x02\n" +
 	"\n" +
 	"Attendance\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1f\n" +
@@ -7579,10 +14781,21 @@ const file_education_proto_rawDesc = "" +
 	"attendDate\x12\x16\n" +
 	"\x06isCome\x18\x03 \x01(\bR\x06isCome\x12\x1c\n" +
 	"\tstudentId\x18\x04 \x01(\tR\tstudentId\x12\x1c\n" +
-	"\tteacherId\x18\x05 \x01(\tR\tteacherId\"C\n" +
+	"\tteacherId\x18\x05 \x01(\tR\tteacherId\x12\x16\n" +
+	"\x06status\x18\x06 \x01(\x05R\x06status\x12\x1e\n" +
+	"\n" +
+	"statusName\x18\a \x01(\tR\n" +
+	"statusName\x12$\n" +
+	"\rmakeupGroupId\x18\b \x01(\tR\rmakeupGroupId\x12\x1e\n" +
+	"\n" +
+	"makeupDate\x18\t \x01(\tR\n" +
+	"makeupDate\x12 \n" +
+	"\vselfCheckIn\x18\n" +
+	" \x01(\bR\vselfCheckIn\x12\x1c\n" +
+	"\tconfirmed\x18\v \x01(\bR\tconfirmed\"C\n" +
 	"\fFreezeDetail\x12\x16\n" +
 	"\x06reason\x18\x01 \x01(\tR\x06reason\x12\x1b\n" +
-	"\ttill_date\x18\x02 \x01(\tR\btillDate\"\xe8\x01\n" +
+	"\ttill_date\x18\x02 \x01(\tR\btillDate\"\xae\x02\n" +
 	"\x14SetAttendanceRequest\x12\x1e\n" +
 	"\n" +
 	"attendDate\x18\x01 \x01(\tR\n" +
@@ -7594,7 +14807,465 @@ const file_education_proto_rawDesc = "" +
 	"\n" +
 	"actionById\x18\x06 \x01(\tR\n" +
 	"actionById\x12\"\n" +
-	"\factionByRole\x18\a \x01(\tR\factionByRole\"\xbb\x02\n" +
+	"\factionByRole\x18\a \x01(\tR\factionByRole\x12$\n" +
+	"\rmakeupGroupId\x18\b \x01(\tR\rmakeupGroupId\x12\x1e\n" +
+	"\n" +
+	"makeupDate\x18\t \x01(\tR\n" +
+	"makeupDate\"\xed\x01\n" +
+	"\x19SetGroupAttendanceRequest\x12\x1e\n" +
+	"\n" +
+	"attendDate\x18\x01 \x01(\tR\n" +
+	"attendDate\x12\x18\n" +
+	"\agroupId\x18\x02 \x01(\tR\agroupId\x12\x1c\n" +
+	"\tteacherId\x18\x03 \x01(\tR\tteacherId\x124\n" +
+	"\x05marks\x18\x04 \x03(\v2\x1e.education.GroupAttendanceMarkR\x05marks\x12\x1e\n" +
+	"\n" +
+	"actionById\x18\x05 \x01(\tR\n" +
+	"actionById\x12\"\n" +
+	"\factionByRole\x18\x06 \x01(\tR\factionByRole\"\x91\x01\n" +
+	"\x13GroupAttendanceMark\x12\x1c\n" +
+	"\tstudentId\x18\x01 \x01(\tR\tstudentId\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\x05R\x06status\x12$\n" +
+	"\rmakeupGroupId\x18\x03 \x01(\tR\rmakeupGroupId\x12\x1e\n" +
+	"\n" +
+	"makeupDate\x18\x04 \x01(\tR\n" +
+	"makeupDate\"\x81\x01\n" +
+	"\x15GroupAttendanceResult\x12\x1c\n" +
+	"\tstudentId\x18\x01 \x01(\tR\tstudentId\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\x05R\x06status\x12\x18\n" +
+	"\asuccess\x18\x03 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x04 \x01(\tR\amessage\"\x8e\x01\n" +
+	"\x1aSetGroupAttendanceResponse\x12:\n" +
+	"\aresults\x18\x01 \x03(\v2 .education.GroupAttendanceResultR\aresults\x12\x1c\n" +
+	"\tsucceeded\x18\x02 \x01(\x05R\tsucceeded\x12\x16\n" +
+	"\x06failed\x18\x03 \x01(\x05R\x06failed\"\x81\x02\n" +
+	"\x13AbsAttendanceStatus\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04code\x18\x02 \x01(\x05R\x04code\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x1a\n" +
+	"\bbillable\x18\x04 \x01(\bR\bbillable\x12 \n" +
+	"\vpaysTeacher\x18\x05 \x01(\bR\vpaysTeacher\x12\x1e\n" +
+	"\n" +
+	"absenceSms\x18\x06 \x01(\bR\n" +
+	"absenceSms\x12\x1a\n" +
+	"\bisMakeup\x18\a \x01(\bR\bisMakeup\x12\x1c\n" +
+	"\tisDefault\x18\b \x01(\bR\tisDefault\x12\x1a\n" +
+	"\bisActive\x18\t \x01(\bR\bisActive\"B\n" +
+	"\x1cGetAttendanceStatusesRequest\x12\"\n" +
+	"\fwithInactive\x18\x01 \x01(\bR\fwithInactive\"[\n" +
+	"\x1dGetAttendanceStatusesResponse\x12:\n" +
+	"\bstatuses\x18\x01 \x03(\v2\x1e.education.AbsAttendanceStatusR\bstatuses\"\xda\x01\n" +
+	"\x10AttendancePolicy\x12\"\n" +
+	"\feditDaysBack\x18\x01 \x01(\x05R\feditDaysBack\x12\x1e\n" +
+	"\n" +
+	"cutoffHour\x18\x02 \x01(\x05R\n" +
+	"cutoffHour\x12\x1a\n" +
+	"\btimezone\x18\x03 \x01(\tR\btimezone\x12 \n" +
+	"\vallowFuture\x18\x04 \x01(\bR\vallowFuture\x12$\n" +
+	"\roverrideRoles\x18\x05 \x03(\tR\roverrideRoles\x12\x1e\n" +
+	"\n" +
+	"actionById\x18\x06 \x01(\tR\n" +
+	"actionById\"\xb6\x01\n" +
+	"\x1eRequestAttendanceUnlockRequest\x12\x18\n" +
+	"\agroupId\x18\x01 \x01(\tR\agroupId\x12\x1e\n" +
+	"\n" +
+	"attendDate\x18\x02 \x01(\tR\n" +
+	"attendDate\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\x12\x1e\n" +
+	"\n" +
+	"actionById\x18\x04 \x01(\tR\n" +
+	"actionById\x12\"\n" +
+	"\factionByName\x18\x05 \x01(\tR\factionByName\"\xfd\x02\n" +
+	"\x17AttendanceUnlockRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x18\n" +
+	"\agroupId\x18\x02 \x01(\x03R\agroupId\x12\x1c\n" +
+	"\tgroupName\x18\x03 \x01(\tR\tgroupName\x12\x1e\n" +
+	"\n" +
+	"attendDate\x18\x04 \x01(\tR\n" +
+	"attendDate\x12\x1c\n" +
+	"\tteacherId\x18\x05 \x01(\tR\tteacherId\x12 \n" +
+	"\vteacherName\x18\x06 \x01(\tR\vteacherName\x12\x16\n" +
+	"\x06reason\x18\a \x01(\tR\x06reason\x12\x16\n" +
+	"\x06status\x18\b \x01(\tR\x06status\x12&\n" +
+	"\x0ereviewedByName\x18\t \x01(\tR\x0ereviewedByName\x12\x1e\n" +
+	"\n" +
+	"reviewedAt\x18\n" +
+	" \x01(\tR\n" +
+	"reviewedAt\x12$\n" +
+	"\runlockedUntil\x18\v \x01(\tR\runlockedUntil\x12\x1c\n" +
+	"\tcreatedAt\x18\f \x01(\tR\tcreatedAt\"\x80\x01\n" +
+	"\"GetAttendanceUnlockRequestsRequest\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\tR\x06status\x12\x1e\n" +
+	"\n" +
+	"actionById\x18\x02 \x01(\tR\n" +
+	"actionById\x12\"\n" +
+	"\factionByRole\x18\x03 \x01(\tR\factionByRole\"e\n" +
+	"#GetAttendanceUnlockRequestsResponse\x12>\n" +
+	"\brequests\x18\x01 \x03(\v2\".education.AttendanceUnlockRequestR\brequests\"\xa3\x01\n" +
+	"\x1dReviewAttendanceUnlockRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x18\n" +
+	"\aapprove\x18\x02 \x01(\bR\aapprove\x12\x14\n" +
+	"\x05hours\x18\x03 \x01(\x05R\x05hours\x12\x1e\n" +
+	"\n" +
+	"actionById\x18\x04 \x01(\tR\n" +
+	"actionById\x12\"\n" +
+	"\factionByName\x18\x05 \x01(\tR\factionByName\"Q\n" +
+	"\x1bSendStudentLoginCodeRequest\x12\x1c\n" +
+	"\tsubdomain\x18\x01 \x01(\tR\tsubdomain\x12\x14\n" +
+	"\x05phone\x18\x02 \x01(\tR\x05phone\"\x7f\n" +
+	"\x1dVerifyStudentLoginCodeRequest\x12\x1c\n" +
+	"\tsubdomain\x18\x01 \x01(\tR\tsubdomain\x12\x14\n" +
+	"\x05phone\x18\x02 \x01(\tR\x05phone\x12\x12\n" +
+	"\x04code\x18\x03 \x01(\tR\x04code\x12\x16\n" +
+	"\x06device\x18\x04 \x01(\tR\x06device\"\xa2\x01\n" +
+	"\x0eStudentSession\x12\x1c\n" +
+	"\tstudentId\x18\x01 \x01(\tR\tstudentId\x12 \n" +
+	"\vstudentName\x18\x02 \x01(\tR\vstudentName\x12\x1c\n" +
+	"\tcompanyId\x18\x03 \x01(\tR\tcompanyId\x12\x14\n" +
+	"\x05token\x18\x04 \x01(\tR\x05token\x12\x1c\n" +
+	"\texpiresAt\x18\x05 \x01(\tR\texpiresAt\"W\n" +
+	"\x1eVerifyStudentLoginCodeResponse\x125\n" +
+	"\bsessions\x18\x01 \x03(\v2\x19.education.StudentSessionR\bsessions\"5\n" +
+	"\x1dValidateStudentSessionRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\"w\n" +
+	"\x15GetCheckInCodeRequest\x12\x1a\n" +
+	"\blessonId\x18\x01 \x01(\tR\blessonId\x12\x1e\n" +
+	"\n" +
+	"actionById\x18\x02 \x01(\tR\n" +
+	"actionById\x12\"\n" +
+	"\factionByRole\x18\x03 \x01(\tR\factionByRole\"\xb9\x01\n" +
+	"\vCheckInCode\x12\x1a\n" +
+	"\blessonId\x18\x01 \x01(\tR\blessonId\x12\x1c\n" +
+	"\tgroupName\x18\x02 \x01(\tR\tgroupName\x12\x1e\n" +
+	"\n" +
+	"lessonDate\x18\x03 \x01(\tR\n" +
+	"lessonDate\x12\x14\n" +
+	"\x05token\x18\x04 \x01(\tR\x05token\x12\x1c\n" +
+	"\texpiresAt\x18\x05 \x01(\tR\texpiresAt\x12\x1c\n" +
+	"\trefreshIn\x18\x06 \x01(\x05R\trefreshIn\"\\\n" +
+	"\x0eCheckInRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\x12\x1c\n" +
+	"\tstudentId\x18\x02 \x01(\tR\tstudentId\x12\x16\n" +
+	"\x06device\x18\x03 \x01(\tR\x06device\"\xc5\x01\n" +
+	"\x0fCheckInResponse\x12\x1a\n" +
+	"\blessonId\x18\x01 \x01(\tR\blessonId\x12\x1c\n" +
+	"\tgroupName\x18\x02 \x01(\tR\tgroupName\x12\x1e\n" +
+	"\n" +
+	"lessonDate\x18\x03 \x01(\tR\n" +
+	"lessonDate\x12 \n" +
+	"\vcheckedInAt\x18\x04 \x01(\tR\vcheckedInAt\x12\x1c\n" +
+	"\tconfirmed\x18\x05 \x01(\bR\tconfirmed\x12\x18\n" +
+	"\amessage\x18\x06 \x01(\tR\amessage\"z\n" +
+	"\x18GetLessonCheckInsRequest\x12\x1a\n" +
+	"\blessonId\x18\x01 \x01(\tR\blessonId\x12\x1e\n" +
+	"\n" +
+	"actionById\x18\x02 \x01(\tR\n" +
+	"actionById\x12\"\n" +
+	"\factionByRole\x18\x03 \x01(\tR\factionByRole\"\xa7\x01\n" +
+	"\rLessonCheckIn\x12\x1c\n" +
+	"\tstudentId\x18\x01 \x01(\tR\tstudentId\x12 \n" +
+	"\vstudentName\x18\x02 \x01(\tR\vstudentName\x12 \n" +
+	"\vcheckedInAt\x18\x03 \x01(\tR\vcheckedInAt\x12\x16\n" +
+	"\x06device\x18\x04 \x01(\tR\x06device\x12\x1c\n" +
+	"\tconfirmed\x18\x05 \x01(\bR\tconfirmed\"Q\n" +
+	"\x19GetLessonCheckInsResponse\x124\n" +
+	"\bcheckIns\x18\x01 \x03(\v2\x18.education.LessonCheckInR\bcheckIns\"\xb0\x01\n" +
+	"\x16ConfirmCheckInsRequest\x12\x1a\n" +
+	"\blessonId\x18\x01 \x01(\tR\blessonId\x12\x1e\n" +
+	"\n" +
+	"studentIds\x18\x02 \x03(\tR\n" +
+	"studentIds\x12\x16\n" +
+	"\x06reject\x18\x03 \x01(\bR\x06reject\x12\x1e\n" +
+	"\n" +
+	"actionById\x18\x04 \x01(\tR\n" +
+	"actionById\x12\"\n" +
+	"\factionByRole\x18\x05 \x01(\tR\factionByRole\"\xeb\x01\n" +
+	"\x19GetAttendanceRatesRequest\x12\x12\n" +
+	"\x04from\x18\x01 \x01(\tR\x04from\x12\x12\n" +
+	"\x04till\x18\x02 \x01(\tR\x04till\x12\x0e\n" +
+	"\x02by\x18\x03 \x01(\tR\x02by\x12\x18\n" +
+	"\agroupId\x18\x04 \x01(\tR\agroupId\x12\x1c\n" +
+	"\tteacherId\x18\x05 \x01(\tR\tteacherId\x12\x1a\n" +
+	"\bcourseId\x18\x06 \x01(\tR\bcourseId\x12\x1e\n" +
+	"\n" +
+	"actionById\x18\a \x01(\tR\n" +
+	"actionById\x12\"\n" +
+	"\factionByRole\x18\b \x01(\tR\factionByRole\"\x92\x01\n" +
+	"\x0eAttendanceRate\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1a\n" +
+	"\battended\x18\x03 \x01(\x05R\battended\x12\x16\n" +
+	"\x06absent\x18\x04 \x01(\x05R\x06absent\x12\x14\n" +
+	"\x05total\x18\x05 \x01(\x05R\x05total\x12\x12\n" +
+	"\x04rate\x18\x06 \x01(\x01R\x04rate\"M\n" +
+	"\x1aGetAttendanceRatesResponse\x12/\n" +
+	"\x05rates\x18\x01 \x03(\v2\x19.education.AttendanceRateR\x05rates\"\xb4\x01\n" +
+	"\x18GetAbsenceStreaksRequest\x12\x18\n" +
+	"\agroupId\x18\x01 \x01(\tR\agroupId\x12\x1c\n" +
+	"\tteacherId\x18\x02 \x01(\tR\tteacherId\x12\x1c\n" +
+	"\tminStreak\x18\x03 \x01(\x05R\tminStreak\x12\x1e\n" +
+	"\n" +
+	"actionById\x18\x04 \x01(\tR\n" +
+	"actionById\x12\"\n" +
+	"\factionByRole\x18\x05 \x01(\tR\factionByRole\"\xc7\x01\n" +
+	"\rAbsenceStreak\x12\x1c\n" +
+	"\tstudentId\x18\x01 \x01(\tR\tstudentId\x12 \n" +
+	"\vstudentName\x18\x02 \x01(\tR\vstudentName\x12\x18\n" +
+	"\agroupId\x18\x03 \x01(\x03R\agroupId\x12\x1c\n" +
+	"\tgroupName\x18\x04 \x01(\tR\tgroupName\x12\x16\n" +
+	"\x06streak\x18\x05 \x01(\x05R\x06streak\x12&\n" +
+	"\x0elastAbsentDate\x18\x06 \x01(\tR\x0elastAbsentDate\"O\n" +
+	"\x19GetAbsenceStreaksResponse\x122\n" +
+	"\astreaks\x18\x01 \x03(\v2\x18.education.AbsenceStreakR\astreaks\"\xf7\x01\n" +
+	"\x19GetAttendanceTrendRequest\x12\x12\n" +
+	"\x04from\x18\x01 \x01(\tR\x04from\x12\x12\n" +
+	"\x04till\x18\x02 \x01(\tR\x04till\x12\x1a\n" +
+	"\binterval\x18\x03 \x01(\tR\binterval\x12\x18\n" +
+	"\agroupId\x18\x04 \x01(\tR\agroupId\x12\x1c\n" +
+	"\tteacherId\x18\x05 \x01(\tR\tteacherId\x12\x1a\n" +
+	"\bcourseId\x18\x06 \x01(\tR\bcourseId\x12\x1e\n" +
+	"\n" +
+	"actionById\x18\a \x01(\tR\n" +
+	"actionById\x12\"\n" +
+	"\factionByRole\x18\b \x01(\tR\factionByRole\"t\n" +
+	"\x14AttendanceTrendPoint\x12\x16\n" +
+	"\x06period\x18\x01 \x01(\tR\x06period\x12\x1a\n" +
+	"\battended\x18\x02 \x01(\x05R\battended\x12\x14\n" +
+	"\x05total\x18\x03 \x01(\x05R\x05total\x12\x12\n" +
+	"\x04rate\x18\x04 \x01(\x01R\x04rate\"U\n" +
+	"\x1aGetAttendanceTrendResponse\x127\n" +
+	"\x06points\x18\x01 \x03(\v2\x1f.education.AttendanceTrendPointR\x06points\"\xae\x01\n" +
+	"\fAtRiskPolicy\x12$\n" +
+	"\rabsenceStreak\x18\x01 \x01(\x05R\rabsenceStreak\x12\x18\n" +
+	"\aminRate\x18\x02 \x01(\x05R\aminRate\x12\x1e\n" +
+	"\n" +
+	"minLessons\x18\x03 \x01(\x05R\n" +
+	"minLessons\x12\x1e\n" +
+	"\n" +
+	"smsParents\x18\x04 \x01(\bR\n" +
+	"smsParents\x12\x1e\n" +
+	"\n" +
+	"actionById\x18\x05 \x01(\tR\n" +
+	"actionById\"\x90\x01\n" +
+	"\x18GetAtRiskStudentsRequest\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\tR\x06status\x12\x18\n" +
+	"\agroupId\x18\x02 \x01(\tR\agroupId\x12\x1e\n" +
+	"\n" +
+	"actionById\x18\x03 \x01(\tR\n" +
+	"actionById\x12\"\n" +
+	"\factionByRole\x18\x04 \x01(\tR\factionByRole\"\xd3\x02\n" +
+	"\rAtRiskStudent\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1c\n" +
+	"\tstudentId\x18\x02 \x01(\tR\tstudentId\x12 \n" +
+	"\vstudentName\x18\x03 \x01(\tR\vstudentName\x12\x18\n" +
+	"\agroupId\x18\x04 \x01(\x03R\agroupId\x12\x1c\n" +
+	"\tgroupName\x18\x05 \x01(\tR\tgroupName\x12\x16\n" +
+	"\x06reason\x18\x06 \x01(\tR\x06reason\x12$\n" +
+	"\rabsenceStreak\x18\a \x01(\x05R\rabsenceStreak\x12&\n" +
+	"\x0eattendanceRate\x18\b \x01(\x01R\x0eattendanceRate\x12\x16\n" +
+	"\x06status\x18\t \x01(\tR\x06status\x12\x1c\n" +
+	"\tflaggedAt\x18\n" +
+	" \x01(\tR\tflaggedAt\x12\x1e\n" +
+	"\n" +
+	"resolvedAt\x18\v \x01(\tR\n" +
+	"resolvedAt\"Q\n" +
+	"\x19GetAtRiskStudentsResponse\x124\n" +
+	"\bstudents\x18\x01 \x03(\v2\x18.education.AtRiskStudentR\bstudents\"\x93\x01\n" +
+	"\x17GetNotificationsRequest\x12\x1e\n" +
+	"\n" +
+	"unreadOnly\x18\x01 \x01(\bR\n" +
+	"unreadOnly\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\x12\x1e\n" +
+	"\n" +
+	"actionById\x18\x03 \x01(\tR\n" +
+	"actionById\x12\"\n" +
+	"\factionByRole\x18\x04 \x01(\tR\factionByRole\"\xc6\x01\n" +
+	"\fNotification\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04type\x18\x02 \x01(\tR\x04type\x12\x14\n" +
+	"\x05title\x18\x03 \x01(\tR\x05title\x12\x12\n" +
+	"\x04body\x18\x04 \x01(\tR\x04body\x12\x1c\n" +
+	"\tstudentId\x18\x05 \x01(\tR\tstudentId\x12\x18\n" +
+	"\agroupId\x18\x06 \x01(\x03R\agroupId\x12\x12\n" +
+	"\x04read\x18\a \x01(\bR\x04read\x12\x1c\n" +
+	"\tcreatedAt\x18\b \x01(\tR\tcreatedAt\"q\n" +
+	"\x18GetNotificationsResponse\x12=\n" +
+	"\rnotifications\x18\x01 \x03(\v2\x17.education.NotificationR\rnotifications\x12\x16\n" +
+	"\x06unread\x18\x02 \x01(\x05R\x06unread\"t\n" +
+	"\x1cMarkNotificationsReadRequest\x12\x10\n" +
+	"\x03ids\x18\x01 \x03(\tR\x03ids\x12\x1e\n" +
+	"\n" +
+	"actionById\x18\x02 \x01(\tR\n" +
+	"actionById\x12\"\n" +
+	"\factionByRole\x18\x03 \x01(\tR\factionByRole\"U\n" +
+	"\x17GetGroupWaitlistRequest\x12\x18\n" +
+	"\agroupId\x18\x01 \x01(\tR\agroupId\x12 \n" +
+	"\vwithHistory\x18\x02 \x01(\bR\vwithHistory\"\xb7\x02\n" +
+	"\rWaitlistEntry\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1c\n" +
+	"\tstudentId\x18\x02 \x01(\tR\tstudentId\x12 \n" +
+	"\vstudentName\x18\x03 \x01(\tR\vstudentName\x12\"\n" +
+	"\fstudentPhone\x18\x04 \x01(\tR\fstudentPhone\x12\x1a\n" +
+	"\bposition\x18\x05 \x01(\x05R\bposition\x12\x16\n" +
+	"\x06status\x18\x06 \x01(\tR\x06status\x12\x1c\n" +
+	"\tofferedAt\x18\a \x01(\tR\tofferedAt\x12\x1c\n" +
+	"\tholdUntil\x18\b \x01(\tR\tholdUntil\x12$\n" +
+	"\rcreatedByName\x18\t \x01(\tR\rcreatedByName\x12\x1c\n" +
+	"\tcreatedAt\x18\n" +
+	" \x01(\tR\tcreatedAt\"\x9a\x01\n" +
+	"\x18GetGroupWaitlistResponse\x12\x1a\n" +
+	"\bcapacity\x18\x01 \x01(\x05R\bcapacity\x12\x1a\n" +
+	"\benrolled\x18\x02 \x01(\x05R\benrolled\x12\x12\n" +
+	"\x04held\x18\x03 \x01(\x05R\x04held\x122\n" +
+	"\aentries\x18\x04 \x03(\v2\x18.education.WaitlistEntryR\aentries\"F\n" +
+	"\x18MoveWaitlistEntryRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1a\n" +
+	"\bposition\x18\x02 \x01(\x05R\bposition\"&\n" +
+	"\x14WaitlistEntryRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\xb0\x01\n" +
+	"\x1aAcceptWaitlistOfferRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12 \n" +
+	"\vcreatedDate\x18\x02 \x01(\tR\vcreatedDate\x12\x1c\n" +
+	"\tcreatedBy\x18\x03 \x01(\tR\tcreatedBy\x12$\n" +
+	"\rcreatedByName\x18\x04 \x01(\tR\rcreatedByName\x12\x1c\n" +
+	"\tpromoCode\x18\x05 \x01(\tR\tpromoCode\"\xc6\x02\n" +
+	"\n" +
+	"AbsHoliday\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x12\x1a\n" +
+	"\bfromDate\x18\x03 \x01(\tR\bfromDate\x12\x1a\n" +
+	"\btillDate\x18\x04 \x01(\tR\btillDate\x12\x1c\n" +
+	"\trecurring\x18\x05 \x01(\bR\trecurring\x12\x14\n" +
+	"\x05scope\x18\x06 \x01(\tR\x05scope\x12\x16\n" +
+	"\x06roomId\x18\a \x01(\x05R\x06roomId\x12\x18\n" +
+	"\agroupId\x18\b \x01(\x03R\agroupId\x12\x1a\n" +
+	"\broomName\x18\t \x01(\tR\broomName\x12\x1c\n" +
+	"\tgroupName\x18\n" +
+	" \x01(\tR\tgroupName\x12\x1e\n" +
+	"\n" +
+	"isNational\x18\v \x01(\bR\n" +
+	"isNational\x12\x1a\n" +
+	"\bisActive\x18\f \x01(\bR\bisActive\"<\n" +
+	"\x12GetHolidaysRequest\x12\x12\n" +
+	"\x04from\x18\x01 \x01(\tR\x04from\x12\x12\n" +
+	"\x04till\x18\x02 \x01(\tR\x04till\"H\n" +
+	"\x13GetHolidaysResponse\x121\n" +
+	"\bholidays\x18\x01 \x03(\v2\x15.education.AbsHolidayR\bholidays\"[\n" +
+	"\x17GetGroupHolidaysRequest\x12\x18\n" +
+	"\agroupId\x18\x01 \x01(\tR\agroupId\x12\x12\n" +
+	"\x04from\x18\x02 \x01(\tR\x04from\x12\x12\n" +
+	"\x04till\x18\x03 \x01(\tR\x04till\"0\n" +
+	"\x18GetGroupHolidaysResponse\x12\x14\n" +
+	"\x05dates\x18\x01 \x03(\tR\x05dates\"\xa9\x01\n" +
+	"\x13GetTimetableRequest\x12\x12\n" +
+	"\x04from\x18\x01 \x01(\tR\x04from\x12\x12\n" +
+	"\x04till\x18\x02 \x01(\tR\x04till\x12\x16\n" +
+	"\x06roomId\x18\x03 \x01(\x05R\x06roomId\x12\x1c\n" +
+	"\tteacherId\x18\x04 \x01(\tR\tteacherId\x12\x1a\n" +
+	"\bcourseId\x18\x05 \x01(\x05R\bcourseId\x12\x18\n" +
+	"\agroupId\x18\x06 \x01(\tR\agroupId\"\x83\x03\n" +
+	"\x0fTimetableLesson\x12\x18\n" +
+	"\agroupId\x18\x01 \x01(\x03R\agroupId\x12\x1c\n" +
+	"\tgroupName\x18\x02 \x01(\tR\tgroupName\x12\x1a\n" +
+	"\bcourseId\x18\x03 \x01(\x05R\bcourseId\x12\x1e\n" +
+	"\n" +
+	"courseName\x18\x04 \x01(\tR\n" +
+	"courseName\x12\x1c\n" +
+	"\tteacherId\x18\x05 \x01(\tR\tteacherId\x12 \n" +
+	"\vteacherName\x18\x06 \x01(\tR\vteacherName\x12\x16\n" +
+	"\x06roomId\x18\a \x01(\x05R\x06roomId\x12\x1a\n" +
+	"\broomName\x18\b \x01(\tR\broomName\x12\x12\n" +
+	"\x04date\x18\t \x01(\tR\x04date\x12\x1c\n" +
+	"\tstartTime\x18\n" +
+	" \x01(\tR\tstartTime\x12\x18\n" +
+	"\aendTime\x18\v \x01(\tR\aendTime\x12 \n" +
+	"\vtransferred\x18\f \x01(\bR\vtransferred\x12\x1a\n" +
+	"\brealDate\x18\r \x01(\tR\brealDate\"L\n" +
+	"\x14GetTimetableResponse\x124\n" +
+	"\alessons\x18\x01 \x03(\v2\x1a.education.TimetableLessonR\alessons\"S\n" +
+	"\x19CreateCalendarFeedRequest\x12\x1c\n" +
+	"\townerType\x18\x01 \x01(\tR\townerType\x12\x18\n" +
+	"\aownerId\x18\x02 \x01(\tR\aownerId\"\xba\x01\n" +
+	"\fCalendarFeed\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1c\n" +
+	"\townerType\x18\x02 \x01(\tR\townerType\x12\x18\n" +
+	"\aownerId\x18\x03 \x01(\tR\aownerId\x12\x1c\n" +
+	"\townerName\x18\x04 \x01(\tR\townerName\x12\x14\n" +
+	"\x05token\x18\x05 \x01(\tR\x05token\x12\x1c\n" +
+	"\tcreatedAt\x18\x06 \x01(\tR\tcreatedAt\x12\x10\n" +
+	"\x03url\x18\a \x01(\tR\x03url\"Q\n" +
+	"\x17GetCalendarFeedsRequest\x12\x1c\n" +
+	"\townerType\x18\x01 \x01(\tR\townerType\x12\x18\n" +
+	"\aownerId\x18\x02 \x01(\tR\aownerId\"I\n" +
+	"\x18GetCalendarFeedsResponse\x12-\n" +
+	"\x05feeds\x18\x01 \x03(\v2\x17.education.CalendarFeedR\x05feeds\"7\n" +
+	"\x1fGetCalendarFeedTimetableRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\"z\n" +
+	"\x15CalendarFeedTimetable\x12+\n" +
+	"\x04feed\x18\x01 \x01(\v2\x17.education.CalendarFeedR\x04feed\x124\n" +
+	"\alessons\x18\x02 \x03(\v2\x1a.education.TimetableLessonR\alessons\"\x91\x03\n" +
+	"\tAbsLesson\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x18\n" +
+	"\agroupId\x18\x02 \x01(\x03R\agroupId\x12\x1c\n" +
+	"\tgroupName\x18\x03 \x01(\tR\tgroupName\x12\x12\n" +
+	"\x04date\x18\x04 \x01(\tR\x04date\x12\x1a\n" +
+	"\brealDate\x18\x05 \x01(\tR\brealDate\x12\x1c\n" +
+	"\tteacherId\x18\x06 \x01(\tR\tteacherId\x12 \n" +
+	"\vteacherName\x18\a \x01(\tR\vteacherName\x12\x1e\n" +
+	"\n" +
+	"substitute\x18\b \x01(\bR\n" +
+	"substitute\x12\x14\n" +
+	"\x05topic\x18\t \x01(\tR\x05topic\x12\x14\n" +
+	"\x05notes\x18\n" +
+	" \x01(\tR\x05notes\x12\x1a\n" +
+	"\bhomework\x18\v \x01(\tR\bhomework\x12\x1c\n" +
+	"\tmaterials\x18\f \x03(\tR\tmaterials\x12(\n" +
+	"\x0fattendanceCount\x18\r \x01(\x05R\x0fattendanceCount\x12\x1c\n" +
+	"\tupdatedAt\x18\x0e \x01(\tR\tupdatedAt\"U\n" +
+	"\x11GetLessonsRequest\x12\x18\n" +
+	"\agroupId\x18\x01 \x01(\tR\agroupId\x12\x12\n" +
+	"\x04from\x18\x02 \x01(\tR\x04from\x12\x12\n" +
+	"\x04till\x18\x03 \x01(\tR\x04till\"D\n" +
+	"\x12GetLessonsResponse\x12.\n" +
+	"\alessons\x18\x01 \x03(\v2\x14.education.AbsLessonR\alessons\"\x81\x02\n" +
+	"\x13UpdateLessonRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
+	"\x05topic\x18\x02 \x01(\tR\x05topic\x12\x14\n" +
+	"\x05notes\x18\x03 \x01(\tR\x05notes\x12\x1a\n" +
+	"\bhomework\x18\x04 \x01(\tR\bhomework\x12\x1c\n" +
+	"\tmaterials\x18\x05 \x03(\tR\tmaterials\x120\n" +
+	"\x13substituteTeacherId\x18\x06 \x01(\tR\x13substituteTeacherId\x12\x1e\n" +
+	"\n" +
+	"actionById\x18\a \x01(\tR\n" +
+	"actionById\x12\"\n" +
+	"\factionByRole\x18\b \x01(\tR\factionByRole\"\x13\n" +
+	"\x11GetDebtorsRequest\"D\n" +
+	"\x12GetDebtorsResponse\x12.\n" +
+	"\adebtors\x18\x01 \x03(\v2\x14.education.AbsDebtorR\adebtors\"\xa1\x01\n" +
+	"\tAbsDebtor\x12\x1c\n" +
+	"\tstudentId\x18\x01 \x01(\tR\tstudentId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x14\n" +
+	"\x05phone\x18\x03 \x01(\tR\x05phone\x12\x18\n" +
+	"\abalance\x18\x04 \x01(\x01R\abalance\x122\n" +
+	"\x14zeroBalanceUpdatedAt\x18\x05 \x01(\tR\x14zeroBalanceUpdatedAt\"S\n" +
+	"\x19GetDiscountContextRequest\x12\x1c\n" +
+	"\tstudentId\x18\x01 \x01(\tR\tstudentId\x12\x18\n" +
+	"\agroupId\x18\x02 \x01(\tR\agroupId\"\xc4\x01\n" +
+	"\x1aGetDiscountContextResponse\x12 \n" +
+	"\vcoursePrice\x18\x01 \x01(\x01R\vcoursePrice\x12\x1a\n" +
+	"\bcourseId\x18\x02 \x01(\tR\bcourseId\x12\"\n" +
+	"\fgroupEndDate\x18\x03 \x01(\tR\fgroupEndDate\x12 \n" +
+	"\vcourseCount\x18\x04 \x01(\x05R\vcourseCount\x12\"\n" +
+	"\fsiblingCount\x18\x05 \x01(\x05R\fsiblingCount\"4\n" +
+	"\x1aGetStudentsByPhonesRequest\x12\x16\n" +
+	"\x06phones\x18\x01 \x03(\tR\x06phones\"O\n" +
+	"\x1bGetStudentsByPhonesResponse\x120\n" +
+	"\bstudents\x18\x01 \x03(\v2\x14.education.AbsDebtorR\bstudents\"W\n" +
+	"\x17SendDebtReminderRequest\x12\x1c\n" +
+	"\tstudentId\x18\x01 \x01(\tR\tstudentId\x12\x1e\n" +
+	"\n" +
+	"actionType\x18\x02 \x01(\tR\n" +
+	"actionType\"\xbb\x02\n" +
 	"\x1dCalculateDiscountSummaRequest\x12\x18\n" +
 	"\agroupId\x18\x01 \x01(\tR\agroupId\x12\x1c\n" +
 	"\tstudentId\x18\x02 \x01(\tR\tstudentId\x12$\n" +
@@ -7646,11 +15317,16 @@ const file_education_proto_rawDesc = "" +
 	"actionById\x18\x06 \x01(\tR\n" +
 	"actionById\x12\"\n" +
 	"\factionByName\x18\a \x01(\tR\factionByName\x12\x18\n" +
-	"\acomment\x18\b \x01(\tR\acomment\"U\n" +
+	"\acomment\x18\b \x01(\tR\acomment\"\xaf\x01\n" +
 	"\x15TransferLessonRequest\x12\x12\n" +
 	"\x04from\x18\x01 \x01(\tR\x04from\x12\x0e\n" +
 	"\x02to\x18\x02 \x01(\tR\x02to\x12\x18\n" +
-	"\agroupId\x18\x03 \x01(\tR\agroupId\"\x9c\x01\n" +
+	"\agroupId\x18\x03 \x01(\tR\agroupId\x12\x14\n" +
+	"\x05force\x18\x04 \x01(\bR\x05force\x12\x1e\n" +
+	"\n" +
+	"actionById\x18\x05 \x01(\tR\n" +
+	"actionById\x12\"\n" +
+	"\factionByName\x18\x06 \x01(\tR\factionByName\"\x9c\x01\n" +
 	"\x17GetHistoryGroupResponse\x129\n" +
 	"\fgroupHistory\x18\x01 \x03(\v2\x15.education.AbsHistoryR\fgroupHistory\x12F\n" +
 	"\x0fstudentsHistory\x18\x02 \x03(\v2\x1c.education.AbsStudentHistoryR\x0fstudentsHistory\"\xa6\x01\n" +
@@ -7763,13 +15439,22 @@ const file_education_proto_rawDesc = "" +
 	"\aaddress\x18\x06 \x01(\tR\aaddress\x12\x1e\n" +
 	"\n" +
 	"passportId\x18\a \x01(\tR\n" +
-	"passportId\"\x8e\x01\n" +
+	"passportId\"\xd2\x01\n" +
 	"\x11AddToGroupRequest\x12 \n" +
 	"\vcreatedDate\x18\x01 \x01(\tR\vcreatedDate\x12\x18\n" +
 	"\agroupId\x18\x02 \x01(\tR\agroupId\x12\x1f\n" +
 	"\vstudent_ids\x18\x03 \x03(\tR\n" +
 	"studentIds\x12\x1c\n" +
-	"\tcreatedBy\x18\x04 \x01(\tR\tcreatedBy\"\xc4\x02\n" +
+	"\tcreatedBy\x18\x04 \x01(\tR\tcreatedBy\x12\x1c\n" +
+	"\tpromoCode\x18\x05 \x01(\tR\tpromoCode\x12$\n" +
+	"\rcreatedByName\x18\x06 \x01(\tR\rcreatedByName\"~\n" +
+	"\x10AddToGroupResult\x12\x1c\n" +
+	"\tstudentId\x18\x01 \x01(\tR\tstudentId\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\x12\x18\n" +
+	"\amessage\x18\x03 \x01(\tR\amessage\x12\x1a\n" +
+	"\bposition\x18\x04 \x01(\x05R\bposition\"K\n" +
+	"\x12AddToGroupResponse\x125\n" +
+	"\aresults\x18\x01 \x03(\v2\x1b.education.AddToGroupResultR\aresults\"\xc4\x02\n" +
 	"\x16GetStudentByIdResponse\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12\x16\n" +
@@ -7807,7 +15492,35 @@ const file_education_proto_rawDesc = "" +
 	"\tcreatedAt\x18\x03 \x01(\tR\tcreatedAt\"E\n" +
 	"\x11CreateNoteRequest\x12\x12\n" +
 	"\x04note\x18\x01 \x01(\tR\x04note\x12\x1c\n" +
-	"\tstudentId\x18\x02 \x01(\tR\tstudentId\"g\n" +
+	"\tstudentId\x18\x02 \x01(\tR\tstudentId\"\xda\x01\n" +
+	"\x18SellLessonPackageRequest\x12\x1c\n" +
+	"\tstudentId\x18\x01 \x01(\tR\tstudentId\x12\x18\n" +
+	"\agroupId\x18\x02 \x01(\tR\agroupId\x12\x18\n" +
+	"\alessons\x18\x03 \x01(\x05R\alessons\x12\x14\n" +
+	"\x05price\x18\x04 \x01(\x01R\x05price\x12\x12\n" +
+	"\x04date\x18\x05 \x01(\tR\x04date\x12\x1e\n" +
+	"\n" +
+	"actionById\x18\x06 \x01(\tR\n" +
+	"actionById\x12\"\n" +
+	"\factionByName\x18\a \x01(\tR\factionByName\"R\n" +
+	"\x18GetLessonPackagesRequest\x12\x1c\n" +
+	"\tstudentId\x18\x01 \x01(\tR\tstudentId\x12\x18\n" +
+	"\agroupId\x18\x02 \x01(\tR\agroupId\"T\n" +
+	"\x19GetLessonPackagesResponse\x127\n" +
+	"\bpackages\x18\x01 \x03(\v2\x1b.education.AbsLessonPackageR\bpackages\"\xd2\x02\n" +
+	"\x10AbsLessonPackage\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1c\n" +
+	"\tstudentId\x18\x02 \x01(\tR\tstudentId\x12\x18\n" +
+	"\agroupId\x18\x03 \x01(\tR\agroupId\x12\x1c\n" +
+	"\tgroupName\x18\x04 \x01(\tR\tgroupName\x12\"\n" +
+	"\flessonsTotal\x18\x05 \x01(\x05R\flessonsTotal\x12 \n" +
+	"\vlessonsLeft\x18\x06 \x01(\x05R\vlessonsLeft\x12\x14\n" +
+	"\x05price\x18\a \x01(\x01R\x05price\x12 \n" +
+	"\vpurchasedAt\x18\b \x01(\tR\vpurchasedAt\x12\x1c\n" +
+	"\texpiresAt\x18\t \x01(\tR\texpiresAt\x12\x16\n" +
+	"\x06status\x18\n" +
+	" \x01(\tR\x06status\x12$\n" +
+	"\rcreatedByName\x18\v \x01(\tR\rcreatedByName\"g\n" +
 	"\x10GetSmsLogRequest\x125\n" +
 	"\vpageRequest\x18\x01 \x01(\v2\x13.common.PageRequestR\vpageRequest\x12\x1c\n" +
 	"\tstudentId\x18\x02 \x01(\tR\tstudentId\"\\\n" +
@@ -7863,7 +15576,10 @@ const file_education_proto_rawDesc = "" +
 	"\bsmsCount\x18\x02 \x01(\x05R\bsmsCount\x12 \n" +
 	"\vcreatorName\x18\x03 \x01(\tR\vcreatorName\x12\x1c\n" +
 	"\tcreatorId\x18\x04 \x01(\tR\tcreatorId\x12\x1c\n" +
-	"\tstudentId\x18\x05 \x01(\tR\tstudentId2\xe9\x02\n" +
+	"\tstudentId\x18\x05 \x01(\tR\tstudentId\"I\n" +
+	"\x13SendStaffSmsRequest\x12\x16\n" +
+	"\x06phones\x18\x01 \x03(\tR\x06phones\x12\x1a\n" +
+	"\bsmsValue\x18\x02 \x01(\tR\bsmsValue2\xe9\x02\n" +
 	"\x15CompanyFinanceService\x12@\n" +
 	"\x06Create\x12\x19.education.CompanyFinance\x1a\x19.education.CompanyFinance\"\x00\x129\n" +
 	"\x06Delete\x12\x18.common.DeleteAbsRequest\x1a\x13.common.AbsResponse\"\x00\x12>\n" +
@@ -7888,14 +15604,20 @@ const file_education_proto_rawDesc = "" +
 	"\n" +
 	"UpdateRoom\x12\x12.education.AbsRoom\x1a\x13.common.AbsResponse\x12;\n" +
 	"\n" +
-	"DeleteRoom\x12\x18.common.DeleteAbsRequest\x1a\x13.common.AbsResponse2\xe7\x02\n" +
+	"DeleteRoom\x12\x18.common.DeleteAbsRequest\x1a\x13.common.AbsResponse2\x8c\x06\n" +
 	"\rCourseService\x12C\n" +
 	"\fCreateCourse\x12\x1e.education.CreateCourseRequest\x1a\x13.common.AbsResponse\x12C\n" +
 	"\n" +
 	"GetCourses\x12\x16.google.protobuf.Empty\x1a\x1d.education.GetUpdateCourseAbs\x12R\n" +
 	"\rGetCourseById\x12\x1f.education.GetCourseByIdRequest\x1a .education.GetCourseByIdResponse\x129\n" +
 	"\fUpdateCourse\x12\x14.education.AbsCourse\x1a\x13.common.AbsResponse\x12=\n" +
-	"\fDeleteCourse\x12\x18.common.DeleteAbsRequest\x1a\x13.common.AbsResponse2\xe6\x06\n" +
+	"\fDeleteCourse\x12\x18.common.DeleteAbsRequest\x1a\x13.common.AbsResponse\x12M\n" +
+	"\x10GetCourseBilling\x12\x1f.education.GetCourseByIdRequest\x1a\x18.education.CourseBilling\x12D\n" +
+	"\x13UpdateCourseBilling\x12\x18.education.CourseBilling\x1a\x13.common.AbsResponse\x12Q\n" +
+	"\x13ScheduleCoursePrice\x12%.education.ScheduleCoursePriceRequest\x1a\x13.common.AbsResponse\x12b\n" +
+	"\x15GetCoursePriceHistory\x12\x1f.education.GetCourseByIdRequest\x1a(.education.GetCoursePriceHistoryResponse\x12W\n" +
+	"\x16SetEnrollmentPriceLock\x12(.education.SetEnrollmentPriceLockRequest\x1a\x13.common.AbsResponse2\xd2\n" +
+	"\n" +
 	"\fGroupService\x12A\n" +
 	"\vCreateGroup\x12\x1d.education.CreateGroupRequest\x1a\x13.common.AbsResponse\x12F\n" +
 	"\tGetGroups\x12\x1b.education.GetGroupsRequest\x1a\x1c.education.GetGroupsResponse\x12N\n" +
@@ -7906,18 +15628,75 @@ const file_education_proto_rawDesc = "" +
 	"\vDeleteGroup\x12\x18.common.DeleteAbsRequest\x1a\x13.common.AbsResponse\x12e\n" +
 	"\x14GetGroupsByTeacherId\x12&.education.GetGroupsByTeacherIdRequest\x1a%.education.GetGroupsByTeacherResponse\x12i\n" +
 	"\x1dGetCommonInformationEducation\x12\x16.google.protobuf.Empty\x1a0.education.GetCommonInformationEducationResponse\x12p\n" +
-	"\x17GetLeftAfterTrialPeriod\x12).education.GetLeftAfterTrialPeriodRequest\x1a*.education.GetLeftAfterTrialPeriodResponse2\xa9\x02\n" +
+	"\x17GetLeftAfterTrialPeriod\x12).education.GetLeftAfterTrialPeriodRequest\x1a*.education.GetLeftAfterTrialPeriodResponse\x12U\n" +
+	"\x0eGetGroupsByIds\x12 .education.GetGroupsByIdsRequest\x1a!.education.GetGroupsByIdsResponse\x12W\n" +
+	"\x16UpdateGroupBillingMode\x12(.education.UpdateGroupBillingModeRequest\x1a\x13.common.AbsResponse\x12W\n" +
+	"\x16UpdateGroupMaxStudents\x12(.education.UpdateGroupMaxStudentsRequest\x1a\x13.common.AbsResponse\x12`\n" +
+	"\x16CheckScheduleConflicts\x12(.education.CheckScheduleConflictsRequest\x1a\x1c.education.ScheduleConflicts\x12\x7f\n" +
+	"\x1cGetScheduleConflictOverrides\x12..education.GetScheduleConflictOverridesRequest\x1a/.education.GetScheduleConflictOverridesResponse2\x8c\x03\n" +
 	"\x11AttendanceService\x12R\n" +
 	"\rGetAttendance\x12\x1f.education.GetAttendanceRequest\x1a .education.GetAttendanceResponse\x12E\n" +
-	"\rSetAttendance\x12\x1f.education.SetAttendanceRequest\x1a\x13.common.AbsResponse\x12y\n" +
-	"\"CalculateTeacherSalaryByAttendance\x12(.education.CalculateTeacherSalaryRequest\x1a).education.CalculateTeacherSalaryResponse2\x99\f\n" +
+	"\rSetAttendance\x12\x1f.education.SetAttendanceRequest\x1a\x13.common.AbsResponse\x12a\n" +
+	"\x12SetGroupAttendance\x12$.education.SetGroupAttendanceRequest\x1a%.education.SetGroupAttendanceResponse\x12y\n" +
+	"\"CalculateTeacherSalaryByAttendance\x12(.education.CalculateTeacherSalaryRequest\x1a).education.CalculateTeacherSalaryResponse2\xec\x02\n" +
+	"\x17AttendanceStatusService\x12M\n" +
+	"\x16CreateAttendanceStatus\x12\x1e.education.AbsAttendanceStatus\x1a\x13.common.AbsResponse\x12M\n" +
+	"\x16UpdateAttendanceStatus\x12\x1e.education.AbsAttendanceStatus\x1a\x13.common.AbsResponse\x12G\n" +
+	"\x16DeleteAttendanceStatus\x12\x18.common.DeleteAbsRequest\x1a\x13.common.AbsResponse\x12j\n" +
+	"\x15GetAttendanceStatuses\x12'.education.GetAttendanceStatusesRequest\x1a(.education.GetAttendanceStatusesResponse2\xe3\x03\n" +
+	"\x17AttendancePolicyService\x12J\n" +
+	"\x13GetAttendancePolicy\x12\x16.google.protobuf.Empty\x1a\x1b.education.AttendancePolicy\x12J\n" +
+	"\x16UpdateAttendancePolicy\x12\x1b.education.AttendancePolicy\x1a\x13.common.AbsResponse\x12Y\n" +
+	"\x17RequestAttendanceUnlock\x12).education.RequestAttendanceUnlockRequest\x1a\x13.common.AbsResponse\x12|\n" +
+	"\x1bGetAttendanceUnlockRequests\x12-.education.GetAttendanceUnlockRequestsRequest\x1a..education.GetAttendanceUnlockRequestsResponse\x12W\n" +
+	"\x16ReviewAttendanceUnlock\x12(.education.ReviewAttendanceUnlockRequest\x1a\x13.common.AbsResponse2\xfe\x04\n" +
+	"\x0eCheckInService\x12S\n" +
+	"\x14SendStudentLoginCode\x12&.education.SendStudentLoginCodeRequest\x1a\x13.common.AbsResponse\x12m\n" +
+	"\x16VerifyStudentLoginCode\x12(.education.VerifyStudentLoginCodeRequest\x1a).education.VerifyStudentLoginCodeResponse\x12]\n" +
+	"\x16ValidateStudentSession\x12(.education.ValidateStudentSessionRequest\x1a\x19.education.StudentSession\x12J\n" +
+	"\x0eGetCheckInCode\x12 .education.GetCheckInCodeRequest\x1a\x16.education.CheckInCode\x12@\n" +
+	"\aCheckIn\x12\x19.education.CheckInRequest\x1a\x1a.education.CheckInResponse\x12^\n" +
+	"\x11GetLessonCheckIns\x12#.education.GetLessonCheckInsRequest\x1a$.education.GetLessonCheckInsResponse\x12[\n" +
+	"\x0fConfirmCheckIns\x12!.education.ConfirmCheckInsRequest\x1a%.education.SetGroupAttendanceResponse2\xaa\x04\n" +
+	"\x1aAttendanceAnalyticsService\x12a\n" +
+	"\x12GetAttendanceRates\x12$.education.GetAttendanceRatesRequest\x1a%.education.GetAttendanceRatesResponse\x12^\n" +
+	"\x11GetAbsenceStreaks\x12#.education.GetAbsenceStreaksRequest\x1a$.education.GetAbsenceStreaksResponse\x12a\n" +
+	"\x12GetAttendanceTrend\x12$.education.GetAttendanceTrendRequest\x1a%.education.GetAttendanceTrendResponse\x12B\n" +
+	"\x0fGetAtRiskPolicy\x12\x16.google.protobuf.Empty\x1a\x17.education.AtRiskPolicy\x12B\n" +
+	"\x12UpdateAtRiskPolicy\x12\x17.education.AtRiskPolicy\x1a\x13.common.AbsResponse\x12^\n" +
+	"\x11GetAtRiskStudents\x12#.education.GetAtRiskStudentsRequest\x1a$.education.GetAtRiskStudentsResponse2\xc9\x01\n" +
+	"\x13NotificationService\x12[\n" +
+	"\x10GetNotifications\x12\".education.GetNotificationsRequest\x1a#.education.GetNotificationsResponse\x12U\n" +
+	"\x15MarkNotificationsRead\x12'.education.MarkNotificationsReadRequest\x1a\x13.common.AbsResponse2\xec\x02\n" +
+	"\x14GroupWaitlistService\x12[\n" +
+	"\x10GetGroupWaitlist\x12\".education.GetGroupWaitlistRequest\x1a#.education.GetGroupWaitlistResponse\x12M\n" +
+	"\x11MoveWaitlistEntry\x12#.education.MoveWaitlistEntryRequest\x1a\x13.common.AbsResponse\x12K\n" +
+	"\x13CancelWaitlistEntry\x12\x1f.education.WaitlistEntryRequest\x1a\x13.common.AbsResponse\x12[\n" +
+	"\x13AcceptWaitlistOffer\x12%.education.AcceptWaitlistOfferRequest\x1a\x1d.education.AddToGroupResponse2\xf5\x02\n" +
+	"\x0eHolidayService\x12;\n" +
+	"\rCreateHoliday\x12\x15.education.AbsHoliday\x1a\x13.common.AbsResponse\x12;\n" +
+	"\rUpdateHoliday\x12\x15.education.AbsHoliday\x1a\x13.common.AbsResponse\x12>\n" +
+	"\rDeleteHoliday\x12\x18.common.DeleteAbsRequest\x1a\x13.common.AbsResponse\x12L\n" +
+	"\vGetHolidays\x12\x1d.education.GetHolidaysRequest\x1a\x1e.education.GetHolidaysResponse\x12[\n" +
+	"\x10GetGroupHolidays\x12\".education.GetGroupHolidaysRequest\x1a#.education.GetGroupHolidaysResponse2\xc4\x03\n" +
+	"\x10TimetableService\x12O\n" +
+	"\fGetTimetable\x12\x1e.education.GetTimetableRequest\x1a\x1f.education.GetTimetableResponse\x12S\n" +
+	"\x12CreateCalendarFeed\x12$.education.CreateCalendarFeedRequest\x1a\x17.education.CalendarFeed\x12[\n" +
+	"\x10GetCalendarFeeds\x12\".education.GetCalendarFeedsRequest\x1a#.education.GetCalendarFeedsResponse\x12C\n" +
+	"\x12RevokeCalendarFeed\x12\x18.common.DeleteAbsRequest\x1a\x13.common.AbsResponse\x12h\n" +
+	"\x18GetCalendarFeedTimetable\x12*.education.GetCalendarFeedTimetableRequest\x1a .education.CalendarFeedTimetable2\xe1\x01\n" +
+	"\rLessonService\x12I\n" +
+	"\n" +
+	"GetLessons\x12\x1c.education.GetLessonsRequest\x1a\x1d.education.GetLessonsResponse\x12?\n" +
+	"\rGetLessonById\x12\x18.common.DeleteAbsRequest\x1a\x14.education.AbsLesson\x12D\n" +
+	"\fUpdateLesson\x12\x1e.education.UpdateLessonRequest\x1a\x14.education.AbsLesson2\xb3\x10\n" +
 	"\x0eStudentService\x12R\n" +
 	"\rGetAllStudent\x12\x1f.education.GetAllStudentRequest\x1a .education.GetAllStudentResponse\x12E\n" +
 	"\rCreateStudent\x12\x1f.education.CreateStudentRequest\x1a\x13.common.AbsResponse\x12E\n" +
 	"\rUpdateStudent\x12\x1f.education.UpdateStudentRequest\x1a\x13.common.AbsResponse\x12E\n" +
-	"\rDeleteStudent\x12\x1f.education.DeleteStudentRequest\x1a\x13.common.AbsResponse\x12?\n" +
+	"\rDeleteStudent\x12\x1f.education.DeleteStudentRequest\x1a\x13.common.AbsResponse\x12I\n" +
 	"\n" +
-	"AddToGroup\x12\x1c.education.AddToGroupRequest\x1a\x13.common.AbsResponse\x12W\n" +
+	"AddToGroup\x12\x1c.education.AddToGroupRequest\x1a\x1d.education.AddToGroupResponse\x12W\n" +
 	"\x0eGetStudentById\x12\".education.NoteStudentByAbsRequest\x1a!.education.GetStudentByIdResponse\x12T\n" +
 	"\x10GetNoteByStudent\x12\".education.NoteStudentByAbsRequest\x1a\x1c.education.GetNotesByStudent\x12I\n" +
 	"\x14CreateNoteForStudent\x12\x1c.education.CreateNoteRequest\x1a\x13.common.AbsResponse\x12L\n" +
@@ -7930,7 +15709,14 @@ const file_education_proto_rawDesc = "" +
 	"\x14GetStudentsByGroupId\x12&.education.GetStudentsByGroupIdRequest\x1a'.education.GetStudentsByGroupIdResponse\x12[\n" +
 	"\x18ChangeUserBalanceHistory\x12*.education.ChangeUserBalanceHistoryRequest\x1a\x13.common.AbsResponse\x12i\n" +
 	"\x1fChangeUserBalanceHistoryByDebit\x121.education.ChangeUserBalanceHistoryByDebitRequest\x1a\x13.common.AbsResponse\x12h\n" +
-	"\x16CalculateDiscountSumma\x12(.education.CalculateDiscountSummaRequest\x1a$.education.CalculateDiscountResponse2\x91\x04\n" +
+	"\x16CalculateDiscountSumma\x12(.education.CalculateDiscountSummaRequest\x1a$.education.CalculateDiscountResponse\x12I\n" +
+	"\n" +
+	"GetDebtors\x12\x1c.education.GetDebtorsRequest\x1a\x1d.education.GetDebtorsResponse\x12K\n" +
+	"\x10SendDebtReminder\x12\".education.SendDebtReminderRequest\x1a\x13.common.AbsResponse\x12d\n" +
+	"\x13GetStudentsByPhones\x12%.education.GetStudentsByPhonesRequest\x1a&.education.GetStudentsByPhonesResponse\x12a\n" +
+	"\x12GetDiscountContext\x12$.education.GetDiscountContextRequest\x1a%.education.GetDiscountContextResponse\x12M\n" +
+	"\x11SellLessonPackage\x12#.education.SellLessonPackageRequest\x1a\x13.common.AbsResponse\x12^\n" +
+	"\x11GetLessonPackages\x12#.education.GetLessonPackagesRequest\x1a$.education.GetLessonPackagesResponse2\xd6\x04\n" +
 	"\n" +
 	"SmsService\x12G\n" +
 	"\n" +
@@ -7940,7 +15726,8 @@ const file_education_proto_rawDesc = "" +
 	"\x17GetSmsTransactionDetail\x12\x13.common.PageRequest\x1a*.education.GetSmsTransactionDetailResponse\x12U\n" +
 	"\x0eGetSmsTemplate\x12 .education.GetSmsTemplateRequest\x1a!.education.GetSmsTemplateResponse\x12G\n" +
 	"\x0eSetSmsTemplate\x12 .education.SetSmsTemplateRequest\x1a\x13.common.AbsResponse\x12I\n" +
-	"\x0fSendSmsDirectly\x12!.education.SendSmsDirectlyRequest\x1a\x13.common.AbsResponseB\n" +
+	"\x0fSendSmsDirectly\x12!.education.SendSmsDirectlyRequest\x1a\x13.common.AbsResponse\x12C\n" +
+	"\fSendStaffSms\x12\x1e.education.SendStaffSmsRequest\x1a\x13.common.AbsResponseB\n" +
 	"Z\bproto/pbb\x06proto3"
 
 var (
@@ -7955,7 +15742,7 @@ func file_education_proto_rawDescGZIP() []byte {
 	return file_education_proto_rawDescData
 }
 
-var file_education_proto_msgTypes = make([]protoimpl.MessageInfo, 96)
+var file_education_proto_msgTypes = make([]protoimpl.MessageInfo, 195)
 var file_education_proto_goTypes = []any{
 	(*CompanyFinance)(nil),                         // 0: education.CompanyFinance
 	(*CompanyFinanceSelf)(nil),                     // 1: education.CompanyFinanceSelf
@@ -7981,82 +15768,181 @@ var file_education_proto_goTypes = []any{
 	(*AbsCourse)(nil),                              // 21: education.AbsCourse
 	(*GetCourseByIdResponse)(nil),                  // 22: education.GetCourseByIdResponse
 	(*GetCourseByIdRequest)(nil),                   // 23: education.GetCourseByIdRequest
-	(*GetLeftAfterTrialPeriodRequest)(nil),         // 24: education.GetLeftAfterTrialPeriodRequest
-	(*GetLeftAfterTrialPeriodResponse)(nil),        // 25: education.GetLeftAfterTrialPeriodResponse
-	(*AbsGetLeftAfter)(nil),                        // 26: education.AbsGetLeftAfter
-	(*StudentIdRequest)(nil),                       // 27: education.StudentIdRequest
-	(*GetGroupsByStudentResponse)(nil),             // 28: education.GetGroupsByStudentResponse
-	(*DebtorGroup)(nil),                            // 29: education.DebtorGroup
-	(*DebtorComment)(nil),                          // 30: education.DebtorComment
-	(*GetCommonInformationEducationResponse)(nil),  // 31: education.GetCommonInformationEducationResponse
-	(*GetGroupsByTeacherIdRequest)(nil),            // 32: education.GetGroupsByTeacherIdRequest
-	(*GetGroupsByTeacherResponse)(nil),             // 33: education.GetGroupsByTeacherResponse
-	(*GetGroupByTeacherAbs)(nil),                   // 34: education.GetGroupByTeacherAbs
-	(*CreateGroupRequest)(nil),                     // 35: education.CreateGroupRequest
-	(*GetGroupByIdRequest)(nil),                    // 36: education.GetGroupByIdRequest
-	(*GetUpdateGroupAbs)(nil),                      // 37: education.GetUpdateGroupAbs
-	(*GetGroupsByCourseResponse)(nil),              // 38: education.GetGroupsByCourseResponse
-	(*GetGroupByCourseAbsResponse)(nil),            // 39: education.GetGroupByCourseAbsResponse
-	(*GetGroupAbsResponse)(nil),                    // 40: education.GetGroupAbsResponse
-	(*GetGroupsResponse)(nil),                      // 41: education.GetGroupsResponse
-	(*GetGroupsRequest)(nil),                       // 42: education.GetGroupsRequest
-	(*CalculateTeacherSalaryRequest)(nil),          // 43: education.CalculateTeacherSalaryRequest
-	(*CalculateTeacherSalaryResponse)(nil),         // 44: education.CalculateTeacherSalaryResponse
-	(*AbsCalculateSalary)(nil),                     // 45: education.AbsCalculateSalary
-	(*StudentSalary)(nil),                          // 46: education.StudentSalary
-	(*GetAttendanceRequest)(nil),                   // 47: education.GetAttendanceRequest
-	(*GetAttendanceResponse)(nil),                  // 48: education.GetAttendanceResponse
-	(*Day)(nil),                                    // 49: education.Day
-	(*Student)(nil),                                // 50: education.Student
-	(*Attendance)(nil),                             // 51: education.Attendance
-	(*FreezeDetail)(nil),                           // 52: education.FreezeDetail
-	(*SetAttendanceRequest)(nil),                   // 53: education.SetAttendanceRequest
-	(*CalculateDiscountSummaRequest)(nil),          // 54: education.CalculateDiscountSummaRequest
-	(*CalculateDiscountResponse)(nil),              // 55: education.CalculateDiscountResponse
-	(*ChangeUserBalanceHistoryByDebitRequest)(nil), // 56: education.ChangeUserBalanceHistoryByDebitRequest
-	(*ChangeUserBalanceHistoryRequest)(nil),        // 57: education.ChangeUserBalanceHistoryRequest
-	(*DeleteStudentRequest)(nil),                   // 58: education.DeleteStudentRequest
-	(*GetStudentsByGroupIdResponse)(nil),           // 59: education.GetStudentsByGroupIdResponse
-	(*GetStudentsByGroupIdRequest)(nil),            // 60: education.GetStudentsByGroupIdRequest
-	(*ChangeConditionStudentRequest)(nil),          // 61: education.ChangeConditionStudentRequest
-	(*TransferLessonRequest)(nil),                  // 62: education.TransferLessonRequest
-	(*GetHistoryGroupResponse)(nil),                // 63: education.GetHistoryGroupResponse
-	(*GetHistoryStudentResponse)(nil),              // 64: education.GetHistoryStudentResponse
-	(*AbsStudentHistory)(nil),                      // 65: education.AbsStudentHistory
-	(*AbsGroup)(nil),                               // 66: education.AbsGroup
-	(*AbsHistory)(nil),                             // 67: education.AbsHistory
-	(*SearchStudentRequest)(nil),                   // 68: education.SearchStudentRequest
-	(*SearchStudentResponse)(nil),                  // 69: education.SearchStudentResponse
-	(*AbsStudent)(nil),                             // 70: education.AbsStudent
-	(*GetAllStudentRequest)(nil),                   // 71: education.GetAllStudentRequest
-	(*GetAllStudentResponse)(nil),                  // 72: education.GetAllStudentResponse
-	(*GetGroupsAbsForStudent)(nil),                 // 73: education.GetGroupsAbsForStudent
-	(*GroupGetAllStudentAbs)(nil),                  // 74: education.GroupGetAllStudentAbs
-	(*CreateStudentRequest)(nil),                   // 75: education.CreateStudentRequest
-	(*UpdateStudentRequest)(nil),                   // 76: education.UpdateStudentRequest
-	(*AddToGroupRequest)(nil),                      // 77: education.AddToGroupRequest
-	(*GetStudentByIdResponse)(nil),                 // 78: education.GetStudentByIdResponse
-	(*NoteStudentByAbsRequest)(nil),                // 79: education.NoteStudentByAbsRequest
-	(*GetGroupStudent)(nil),                        // 80: education.GetGroupStudent
-	(*GetNotesByStudent)(nil),                      // 81: education.GetNotesByStudent
-	(*AbsNote)(nil),                                // 82: education.AbsNote
-	(*CreateNoteRequest)(nil),                      // 83: education.CreateNoteRequest
-	(*GetSmsLogRequest)(nil),                       // 84: education.GetSmsLogRequest
-	(*GetSmsLogResponse)(nil),                      // 85: education.GetSmsLogResponse
-	(*SmsLogList)(nil),                             // 86: education.SmsLogList
-	(*AddSmsRequest)(nil),                          // 87: education.AddSmsRequest
-	(*GetSmsTransactionDetailResponse)(nil),        // 88: education.GetSmsTransactionDetailResponse
-	(*GetSmsTransactionList)(nil),                  // 89: education.GetSmsTransactionList
-	(*GetSmsTemplateRequest)(nil),                  // 90: education.GetSmsTemplateRequest
-	(*GetSmsTemplateResponse)(nil),                 // 91: education.GetSmsTemplateResponse
-	(*SmsTemplateList)(nil),                        // 92: education.SmsTemplateList
-	(*SetSmsTemplateRequest)(nil),                  // 93: education.SetSmsTemplateRequest
-	(*SendSmsDirectlyRequest)(nil),                 // 94: education.SendSmsDirectlyRequest
-	nil,                                            // 95: education.OtherDetails.DetailsEntry
-	(*PageRequest)(nil),                            // 96: common.PageRequest
-	(*DeleteAbsRequest)(nil),                       // 97: common.DeleteAbsRequest
-	(*emptypb.Empty)(nil),                          // 98: google.protobuf.Empty
-	(*AbsResponse)(nil),                            // 99: common.AbsResponse
+	(*CourseBilling)(nil),                          // 24: education.CourseBilling
+	(*ScheduleCoursePriceRequest)(nil),             // 25: education.ScheduleCoursePriceRequest
+	(*CoursePriceVersion)(nil),                     // 26: education.CoursePriceVersion
+	(*GetCoursePriceHistoryResponse)(nil),          // 27: education.GetCoursePriceHistoryResponse
+	(*EnrollmentPriceLock)(nil),                    // 28: education.EnrollmentPriceLock
+	(*SetEnrollmentPriceLockRequest)(nil),          // 29: education.SetEnrollmentPriceLockRequest
+	(*GetGroupsByIdsRequest)(nil),                  // 30: education.GetGroupsByIdsRequest
+	(*GetGroupsByIdsResponse)(nil),                 // 31: education.GetGroupsByIdsResponse
+	(*GroupMeta)(nil),                              // 32: education.GroupMeta
+	(*GetLeftAfterTrialPeriodRequest)(nil),         // 33: education.GetLeftAfterTrialPeriodRequest
+	(*GetLeftAfterTrialPeriodResponse)(nil),        // 34: education.GetLeftAfterTrialPeriodResponse
+	(*AbsGetLeftAfter)(nil),                        // 35: education.AbsGetLeftAfter
+	(*StudentIdRequest)(nil),                       // 36: education.StudentIdRequest
+	(*GetGroupsByStudentResponse)(nil),             // 37: education.GetGroupsByStudentResponse
+	(*DebtorGroup)(nil),                            // 38: education.DebtorGroup
+	(*DebtorComment)(nil),                          // 39: education.DebtorComment
+	(*GetCommonInformationEducationResponse)(nil),  // 40: education.GetCommonInformationEducationResponse
+	(*GetGroupsByTeacherIdRequest)(nil),            // 41: education.GetGroupsByTeacherIdRequest
+	(*GetGroupsByTeacherResponse)(nil),             // 42: education.GetGroupsByTeacherResponse
+	(*GetGroupByTeacherAbs)(nil),                   // 43: education.GetGroupByTeacherAbs
+	(*CreateGroupRequest)(nil),                     // 44: education.CreateGroupRequest
+	(*GetGroupByIdRequest)(nil),                    // 45: education.GetGroupByIdRequest
+	(*GetUpdateGroupAbs)(nil),                      // 46: education.GetUpdateGroupAbs
+	(*GetGroupsByCourseResponse)(nil),              // 47: education.GetGroupsByCourseResponse
+	(*GetGroupByCourseAbsResponse)(nil),            // 48: education.GetGroupByCourseAbsResponse
+	(*GetGroupAbsResponse)(nil),                    // 49: education.GetGroupAbsResponse
+	(*GetGroupsResponse)(nil),                      // 50: education.GetGroupsResponse
+	(*GetGroupsRequest)(nil),                       // 51: education.GetGroupsRequest
+	(*UpdateGroupBillingModeRequest)(nil),          // 52: education.UpdateGroupBillingModeRequest
+	(*UpdateGroupMaxStudentsRequest)(nil),          // 53: education.UpdateGroupMaxStudentsRequest
+	(*ScheduleConflict)(nil),                       // 54: education.ScheduleConflict
+	(*ScheduleConflicts)(nil),                      // 55: education.ScheduleConflicts
+	(*CheckScheduleConflictsRequest)(nil),          // 56: education.CheckScheduleConflictsRequest
+	(*GetScheduleConflictOverridesRequest)(nil),    // 57: education.GetScheduleConflictOverridesRequest
+	(*ScheduleConflictOverride)(nil),               // 58: education.ScheduleConflictOverride
+	(*GetScheduleConflictOverridesResponse)(nil),   // 59: education.GetScheduleConflictOverridesResponse
+	(*CalculateTeacherSalaryRequest)(nil),          // 60: education.CalculateTeacherSalaryRequest
+	(*CalculateTeacherSalaryResponse)(nil),         // 61: education.CalculateTeacherSalaryResponse
+	(*AbsCalculateSalary)(nil),                     // 62: education.AbsCalculateSalary
+	(*StudentSalary)(nil),                          // 63: education.StudentSalary
+	(*GetAttendanceRequest)(nil),                   // 64: education.GetAttendanceRequest
+	(*GetAttendanceResponse)(nil),                  // 65: education.GetAttendanceResponse
+	(*Day)(nil),                                    // 66: education.Day
+	(*Student)(nil),                                // 67: education.Student
+	(*Attendance)(nil),                             // 68: education.Attendance
+	(*FreezeDetail)(nil),                           // 69: education.FreezeDetail
+	(*SetAttendanceRequest)(nil),                   // 70: education.SetAttendanceRequest
+	(*SetGroupAttendanceRequest)(nil),              // 71: education.SetGroupAttendanceRequest
+	(*GroupAttendanceMark)(nil),                    // 72: education.GroupAttendanceMark
+	(*GroupAttendanceResult)(nil),                  // 73: education.GroupAttendanceResult
+	(*SetGroupAttendanceResponse)(nil),             // 74: education.SetGroupAttendanceResponse
+	(*AbsAttendanceStatus)(nil),                    // 75: education.AbsAttendanceStatus
+	(*GetAttendanceStatusesRequest)(nil),           // 76: education.GetAttendanceStatusesRequest
+	(*GetAttendanceStatusesResponse)(nil),          // 77: education.GetAttendanceStatusesResponse
+	(*AttendancePolicy)(nil),                       // 78: education.AttendancePolicy
+	(*RequestAttendanceUnlockRequest)(nil),         // 79: education.RequestAttendanceUnlockRequest
+	(*AttendanceUnlockRequest)(nil),                // 80: education.AttendanceUnlockRequest
+	(*GetAttendanceUnlockRequestsRequest)(nil),     // 81: education.GetAttendanceUnlockRequestsRequest
+	(*GetAttendanceUnlockRequestsResponse)(nil),    // 82: education.GetAttendanceUnlockRequestsResponse
+	(*ReviewAttendanceUnlockRequest)(nil),          // 83: education.ReviewAttendanceUnlockRequest
+	(*SendStudentLoginCodeRequest)(nil),            // 84: education.SendStudentLoginCodeRequest
+	(*VerifyStudentLoginCodeRequest)(nil),          // 85: education.VerifyStudentLoginCodeRequest
+	(*StudentSession)(nil),                         // 86: education.StudentSession
+	(*VerifyStudentLoginCodeResponse)(nil),         // 87: education.VerifyStudentLoginCodeResponse
+	(*ValidateStudentSessionRequest)(nil),          // 88: education.ValidateStudentSessionRequest
+	(*GetCheckInCodeRequest)(nil),                  // 89: education.GetCheckInCodeRequest
+	(*CheckInCode)(nil),                            // 90: education.CheckInCode
+	(*CheckInRequest)(nil),                         // 91: education.CheckInRequest
+	(*CheckInResponse)(nil),                        // 92: education.CheckInResponse
+	(*GetLessonCheckInsRequest)(nil),               // 93: education.GetLessonCheckInsRequest
+	(*LessonCheckIn)(nil),                          // 94: education.LessonCheckIn
+	(*GetLessonCheckInsResponse)(nil),              // 95: education.GetLessonCheckInsResponse
+	(*ConfirmCheckInsRequest)(nil),                 // 96: education.ConfirmCheckInsRequest
+	(*GetAttendanceRatesRequest)(nil),              // 97: education.GetAttendanceRatesRequest
+	(*AttendanceRate)(nil),                         // 98: education.AttendanceRate
+	(*GetAttendanceRatesResponse)(nil),             // 99: education.GetAttendanceRatesResponse
+	(*GetAbsenceStreaksRequest)(nil),               // 100: education.GetAbsenceStreaksRequest
+	(*AbsenceStreak)(nil),                          // 101: education.AbsenceStreak
+	(*GetAbsenceStreaksResponse)(nil),              // 102: education.GetAbsenceStreaksResponse
+	(*GetAttendanceTrendRequest)(nil),              // 103: education.GetAttendanceTrendRequest
+	(*AttendanceTrendPoint)(nil),                   // 104: education.AttendanceTrendPoint
+	(*GetAttendanceTrendResponse)(nil),             // 105: education.GetAttendanceTrendResponse
+	(*AtRiskPolicy)(nil),                           // 106: education.AtRiskPolicy
+	(*GetAtRiskStudentsRequest)(nil),               // 107: education.GetAtRiskStudentsRequest
+	(*AtRiskStudent)(nil),                          // 108: education.AtRiskStudent
+	(*GetAtRiskStudentsResponse)(nil),              // 109: education.GetAtRiskStudentsResponse
+	(*GetNotificationsRequest)(nil),                // 110: education.GetNotificationsRequest
+	(*Notification)(nil),                           // 111: education.Notification
+	(*GetNotificationsResponse)(nil),               // 112: education.GetNotificationsResponse
+	(*MarkNotificationsReadRequest)(nil),           // 113: education.MarkNotificationsReadRequest
+	(*GetGroupWaitlistRequest)(nil),                // 114: education.GetGroupWaitlistRequest
+	(*WaitlistEntry)(nil),                          // 115: education.WaitlistEntry
+	(*GetGroupWaitlistResponse)(nil),               // 116: education.GetGroupWaitlistResponse
+	(*MoveWaitlistEntryRequest)(nil),               // 117: education.MoveWaitlistEntryRequest
+	(*WaitlistEntryRequest)(nil),                   // 118: education.WaitlistEntryRequest
+	(*AcceptWaitlistOfferRequest)(nil),             // 119: education.AcceptWaitlistOfferRequest
+	(*AbsHoliday)(nil),                             // 120: education.AbsHoliday
+	(*GetHolidaysRequest)(nil),                     // 121: education.GetHolidaysRequest
+	(*GetHolidaysResponse)(nil),                    // 122: education.GetHolidaysResponse
+	(*GetGroupHolidaysRequest)(nil),                // 123: education.GetGroupHolidaysRequest
+	(*GetGroupHolidaysResponse)(nil),               // 124: education.GetGroupHolidaysResponse
+	(*GetTimetableRequest)(nil),                    // 125: education.GetTimetableRequest
+	(*TimetableLesson)(nil),                        // 126: education.TimetableLesson
+	(*GetTimetableResponse)(nil),                   // 127: education.GetTimetableResponse
+	(*CreateCalendarFeedRequest)(nil),              // 128: education.CreateCalendarFeedRequest
+	(*CalendarFeed)(nil),                           // 129: education.CalendarFeed
+	(*GetCalendarFeedsRequest)(nil),                // 130: education.GetCalendarFeedsRequest
+	(*GetCalendarFeedsResponse)(nil),               // 131: education.GetCalendarFeedsResponse
+	(*GetCalendarFeedTimetableRequest)(nil),        // 132: education.GetCalendarFeedTimetableRequest
+	(*CalendarFeedTimetable)(nil),                  // 133: education.CalendarFeedTimetable
+	(*AbsLesson)(nil),                              // 134: education.AbsLesson
+	(*GetLessonsRequest)(nil),                      // 135: education.GetLessonsRequest
+	(*GetLessonsResponse)(nil),                     // 136: education.GetLessonsResponse
+	(*UpdateLessonRequest)(nil),                    // 137: education.UpdateLessonRequest
+	(*GetDebtorsRequest)(nil),                      // 138: education.GetDebtorsRequest
+	(*GetDebtorsResponse)(nil),                     // 139: education.GetDebtorsResponse
+	(*AbsDebtor)(nil),                              // 140: education.AbsDebtor
+	(*GetDiscountContextRequest)(nil),              // 141: education.GetDiscountContextRequest
+	(*GetDiscountContextResponse)(nil),             // 142: education.GetDiscountContextResponse
+	(*GetStudentsByPhonesRequest)(nil),             // 143: education.GetStudentsByPhonesRequest
+	(*GetStudentsByPhonesResponse)(nil),            // 144: education.GetStudentsByPhonesResponse
+	(*SendDebtReminderRequest)(nil),                // 145: education.SendDebtReminderRequest
+	(*CalculateDiscountSummaRequest)(nil),          // 146: education.CalculateDiscountSummaRequest
+	(*CalculateDiscountResponse)(nil),              // 147: education.CalculateDiscountResponse
+	(*ChangeUserBalanceHistoryByDebitRequest)(nil), // 148: education.ChangeUserBalanceHistoryByDebitRequest
+	(*ChangeUserBalanceHistoryRequest)(nil),        // 149: education.ChangeUserBalanceHistoryRequest
+	(*DeleteStudentRequest)(nil),                   // 150: education.DeleteStudentRequest
+	(*GetStudentsByGroupIdResponse)(nil),           // 151: education.GetStudentsByGroupIdResponse
+	(*GetStudentsByGroupIdRequest)(nil),            // 152: education.GetStudentsByGroupIdRequest
+	(*ChangeConditionStudentRequest)(nil),          // 153: education.ChangeConditionStudentRequest
+	(*TransferLessonRequest)(nil),                  // 154: education.TransferLessonRequest
+	(*GetHistoryGroupResponse)(nil),                // 155: education.GetHistoryGroupResponse
+	(*GetHistoryStudentResponse)(nil),              // 156: education.GetHistoryStudentResponse
+	(*AbsStudentHistory)(nil),                      // 157: education.AbsStudentHistory
+	(*AbsGroup)(nil),                               // 158: education.AbsGroup
+	(*AbsHistory)(nil),                             // 159: education.AbsHistory
+	(*SearchStudentRequest)(nil),                   // 160: education.SearchStudentRequest
+	(*SearchStudentResponse)(nil),                  // 161: education.SearchStudentResponse
+	(*AbsStudent)(nil),                             // 162: education.AbsStudent
+	(*GetAllStudentRequest)(nil),                   // 163: education.GetAllStudentRequest
+	(*GetAllStudentResponse)(nil),                  // 164: education.GetAllStudentResponse
+	(*GetGroupsAbsForStudent)(nil),                 // 165: education.GetGroupsAbsForStudent
+	(*GroupGetAllStudentAbs)(nil),                  // 166: education.GroupGetAllStudentAbs
+	(*CreateStudentRequest)(nil),                   // 167: education.CreateStudentRequest
+	(*UpdateStudentRequest)(nil),                   // 168: education.UpdateStudentRequest
+	(*AddToGroupRequest)(nil),                      // 169: education.AddToGroupRequest
+	(*AddToGroupResult)(nil),                       // 170: education.AddToGroupResult
+	(*AddToGroupResponse)(nil),                     // 171: education.AddToGroupResponse
+	(*GetStudentByIdResponse)(nil),                 // 172: education.GetStudentByIdResponse
+	(*NoteStudentByAbsRequest)(nil),                // 173: education.NoteStudentByAbsRequest
+	(*GetGroupStudent)(nil),                        // 174: education.GetGroupStudent
+	(*GetNotesByStudent)(nil),                      // 175: education.GetNotesByStudent
+	(*AbsNote)(nil),                                // 176: education.AbsNote
+	(*CreateNoteRequest)(nil),                      // 177: education.CreateNoteRequest
+	(*SellLessonPackageRequest)(nil),               // 178: education.SellLessonPackageRequest
+	(*GetLessonPackagesRequest)(nil),               // 179: education.GetLessonPackagesRequest
+	(*GetLessonPackagesResponse)(nil),              // 180: education.GetLessonPackagesResponse
+	(*AbsLessonPackage)(nil),                       // 181: education.AbsLessonPackage
+	(*GetSmsLogRequest)(nil),                       // 182: education.GetSmsLogRequest
+	(*GetSmsLogResponse)(nil),                      // 183: education.GetSmsLogResponse
+	(*SmsLogList)(nil),                             // 184: education.SmsLogList
+	(*AddSmsRequest)(nil),                          // 185: education.AddSmsRequest
+	(*GetSmsTransactionDetailResponse)(nil),        // 186: education.GetSmsTransactionDetailResponse
+	(*GetSmsTransactionList)(nil),                  // 187: education.GetSmsTransactionList
+	(*GetSmsTemplateRequest)(nil),                  // 188: education.GetSmsTemplateRequest
+	(*GetSmsTemplateResponse)(nil),                 // 189: education.GetSmsTemplateResponse
+	(*SmsTemplateList)(nil),                        // 190: education.SmsTemplateList
+	(*SetSmsTemplateRequest)(nil),                  // 191: education.SetSmsTemplateRequest
+	(*SendSmsDirectlyRequest)(nil),                 // 192: education.SendSmsDirectlyRequest
+	(*SendStaffSmsRequest)(nil),                    // 193: education.SendStaffSmsRequest
+	nil,                                            // 194: education.OtherDetails.DetailsEntry
+	(*PageRequest)(nil),                            // 195: common.PageRequest
+	(*DeleteAbsRequest)(nil),                       // 196: common.DeleteAbsRequest
+	(*emptypb.Empty)(nil),                          // 197: google.protobuf.Empty
+	(*AbsResponse)(nil),                            // 198: common.AbsResponse
 }
 var file_education_proto_depIdxs = []int32{
 	1,   // 0: education.CompanyFinanceSelfList.items:type_name -> education.CompanyFinanceSelf
@@ -8064,175 +15950,321 @@ var file_education_proto_depIdxs = []int32{
 	7,   // 2: education.GetStatisticResponse.details:type_name -> education.CompanyCommonDetails
 	6,   // 3: education.GetStatisticResponse.registerDetails:type_name -> education.OtherDetails
 	6,   // 4: education.GetStatisticResponse.paymentDetails:type_name -> education.OtherDetails
-	95,  // 5: education.OtherDetails.details:type_name -> education.OtherDetails.DetailsEntry
+	194, // 5: education.OtherDetails.details:type_name -> education.OtherDetails.DetailsEntry
 	13,  // 6: education.GetAllResponse.items:type_name -> education.GetCompanyResponse
 	14,  // 7: education.GetCompanyResponse.tariff:type_name -> education.Tariff
 	14,  // 8: education.TariffList.items:type_name -> education.Tariff
 	18,  // 9: education.GetUpdateRoomAbs.rooms:type_name -> education.AbsRoom
 	21,  // 10: education.GetUpdateCourseAbs.courses:type_name -> education.AbsCourse
-	26,  // 11: education.GetLeftAfterTrialPeriodResponse.items:type_name -> education.AbsGetLeftAfter
-	30,  // 12: education.GetGroupsByStudentResponse.comments:type_name -> education.DebtorComment
-	29,  // 13: education.GetGroupsByStudentResponse.groups:type_name -> education.DebtorGroup
-	34,  // 14: education.GetGroupsByTeacherResponse.groups:type_name -> education.GetGroupByTeacherAbs
-	70,  // 15: education.GetGroupByTeacherAbs.students:type_name -> education.AbsStudent
-	39,  // 16: education.GetGroupsByCourseResponse.groups:type_name -> education.GetGroupByCourseAbsResponse
-	21,  // 17: education.GetGroupAbsResponse.course:type_name -> education.AbsCourse
-	18,  // 18: education.GetGroupAbsResponse.room:type_name -> education.AbsRoom
-	40,  // 19: education.GetGroupsResponse.groups:type_name -> education.GetGroupAbsResponse
-	96,  // 20: education.GetGroupsRequest.page:type_name -> common.PageRequest
-	45,  // 21: education.CalculateTeacherSalaryResponse.salaries:type_name -> education.AbsCalculateSalary
-	46,  // 22: education.AbsCalculateSalary.salaries:type_name -> education.StudentSalary
-	49,  // 23: education.GetAttendanceResponse.days:type_name -> education.Day
-	50,  // 24: education.GetAttendanceResponse.students:type_name -> education.Student
-	51,  // 25: education.Student.attendance:type_name -> education.Attendance
-	52,  // 26: education.Student.freezeDetail:type_name -> education.FreezeDetail
-	70,  // 27: education.GetStudentsByGroupIdResponse.students:type_name -> education.AbsStudent
-	67,  // 28: education.GetHistoryGroupResponse.groupHistory:type_name -> education.AbsHistory
-	65,  // 29: education.GetHistoryGroupResponse.studentsHistory:type_name -> education.AbsStudentHistory
-	67,  // 30: education.GetHistoryStudentResponse.studentHistory:type_name -> education.AbsHistory
-	65,  // 31: education.GetHistoryStudentResponse.conditionsHistory:type_name -> education.AbsStudentHistory
-	70,  // 32: education.AbsStudentHistory.student:type_name -> education.AbsStudent
-	66,  // 33: education.AbsStudentHistory.group:type_name -> education.AbsGroup
-	21,  // 34: education.AbsGroup.course:type_name -> education.AbsCourse
-	70,  // 35: education.SearchStudentResponse.students:type_name -> education.AbsStudent
-	73,  // 36: education.GetAllStudentResponse.response:type_name -> education.GetGroupsAbsForStudent
-	74,  // 37: education.GetGroupsAbsForStudent.groups:type_name -> education.GroupGetAllStudentAbs
-	21,  // 38: education.GroupGetAllStudentAbs.course:type_name -> education.AbsCourse
-	80,  // 39: education.GetStudentByIdResponse.groups:type_name -> education.GetGroupStudent
-	18,  // 40: education.GetGroupStudent.room:type_name -> education.AbsRoom
-	21,  // 41: education.GetGroupStudent.course:type_name -> education.AbsCourse
-	82,  // 42: education.GetNotesByStudent.notes:type_name -> education.AbsNote
-	96,  // 43: education.GetSmsLogRequest.pageRequest:type_name -> common.PageRequest
-	86,  // 44: education.GetSmsLogResponse.datas:type_name -> education.SmsLogList
-	89,  // 45: education.GetSmsTransactionDetailResponse.datas:type_name -> education.GetSmsTransactionList
-	92,  // 46: education.GetSmsTemplateResponse.datas:type_name -> education.SmsTemplateList
-	0,   // 47: education.CompanyFinanceService.Create:input_type -> education.CompanyFinance
-	97,  // 48: education.CompanyFinanceService.Delete:input_type -> common.DeleteAbsRequest
-	96,  // 49: education.CompanyFinanceService.GetAll:input_type -> common.PageRequest
-	96,  // 50: education.CompanyFinanceService.GetByCompany:input_type -> common.PageRequest
-	0,   // 51: education.CompanyFinanceService.UpdateByCompany:input_type -> education.CompanyFinance
-	12,  // 52: education.CompanyService.GetCompanyBySubdomain:input_type -> education.GetCompanyRequest
-	11,  // 53: education.CompanyService.CreateCompany:input_type -> education.CreateCompanyRequest
-	96,  // 54: education.CompanyService.GetAll:input_type -> common.PageRequest
-	9,   // 55: education.CompanyService.UpdateCompany:input_type -> education.UpdateCompanyRequest
-	8,   // 56: education.CompanyService.GetStatistic:input_type -> education.GetStatisticRequest
-	14,  // 57: education.TariffService.Create:input_type -> education.Tariff
-	14,  // 58: education.TariffService.Update:input_type -> education.Tariff
-	14,  // 59: education.TariffService.Delete:input_type -> education.Tariff
-	98,  // 60: education.TariffService.Get:input_type -> google.protobuf.Empty
-	16,  // 61: education.RoomService.CreateRoom:input_type -> education.CreateRoomRequest
-	98,  // 62: education.RoomService.GetRooms:input_type -> google.protobuf.Empty
-	18,  // 63: education.RoomService.UpdateRoom:input_type -> education.AbsRoom
-	97,  // 64: education.RoomService.DeleteRoom:input_type -> common.DeleteAbsRequest
-	19,  // 65: education.CourseService.CreateCourse:input_type -> education.CreateCourseRequest
-	98,  // 66: education.CourseService.GetCourses:input_type -> google.protobuf.Empty
-	23,  // 67: education.CourseService.GetCourseById:input_type -> education.GetCourseByIdRequest
-	21,  // 68: education.CourseService.UpdateCourse:input_type -> education.AbsCourse
-	97,  // 69: education.CourseService.DeleteCourse:input_type -> common.DeleteAbsRequest
-	35,  // 70: education.GroupService.CreateGroup:input_type -> education.CreateGroupRequest
-	42,  // 71: education.GroupService.GetGroups:input_type -> education.GetGroupsRequest
-	36,  // 72: education.GroupService.GetGroupById:input_type -> education.GetGroupByIdRequest
-	36,  // 73: education.GroupService.GetGroupsByCourseId:input_type -> education.GetGroupByIdRequest
-	27,  // 74: education.GroupService.GetGroupsByStudentId:input_type -> education.StudentIdRequest
-	37,  // 75: education.GroupService.UpdateGroup:input_type -> education.GetUpdateGroupAbs
-	97,  // 76: education.GroupService.DeleteGroup:input_type -> common.DeleteAbsRequest
-	32,  // 77: education.GroupService.GetGroupsByTeacherId:input_type -> education.GetGroupsByTeacherIdRequest
-	98,  // 78: education.GroupService.GetCommonInformationEducation:input_type -> google.protobuf.Empty
-	24,  // 79: education.GroupService.GetLeftAfterTrialPeriod:input_type -> education.GetLeftAfterTrialPeriodRequest
-	47,  // 80: education.AttendanceService.GetAttendance:input_type -> education.GetAttendanceRequest
-	53,  // 81: education.AttendanceService.SetAttendance:input_type -> education.SetAttendanceRequest
-	43,  // 82: education.AttendanceService.CalculateTeacherSalaryByAttendance:input_type -> education.CalculateTeacherSalaryRequest
-	71,  // 83: education.StudentService.GetAllStudent:input_type -> education.GetAllStudentRequest
-	75,  // 84: education.StudentService.CreateStudent:input_type -> education.CreateStudentRequest
-	76,  // 85: education.StudentService.UpdateStudent:input_type -> education.UpdateStudentRequest
-	58,  // 86: education.StudentService.DeleteStudent:input_type -> education.DeleteStudentRequest
-	77,  // 87: education.StudentService.AddToGroup:input_type -> education.AddToGroupRequest
-	79,  // 88: education.StudentService.GetStudentById:input_type -> education.NoteStudentByAbsRequest
-	79,  // 89: education.StudentService.GetNoteByStudent:input_type -> education.NoteStudentByAbsRequest
-	83,  // 90: education.StudentService.CreateNoteForStudent:input_type -> education.CreateNoteRequest
-	79,  // 91: education.StudentService.DeleteStudentNote:input_type -> education.NoteStudentByAbsRequest
-	68,  // 92: education.StudentService.SearchStudent:input_type -> education.SearchStudentRequest
-	79,  // 93: education.StudentService.GetHistoryGroupById:input_type -> education.NoteStudentByAbsRequest
-	79,  // 94: education.StudentService.GetHistoryStudentById:input_type -> education.NoteStudentByAbsRequest
-	62,  // 95: education.StudentService.TransferLessonDate:input_type -> education.TransferLessonRequest
-	61,  // 96: education.StudentService.ChangeConditionStudent:input_type -> education.ChangeConditionStudentRequest
-	60,  // 97: education.StudentService.GetStudentsByGroupId:input_type -> education.GetStudentsByGroupIdRequest
-	57,  // 98: education.StudentService.ChangeUserBalanceHistory:input_type -> education.ChangeUserBalanceHistoryRequest
-	56,  // 99: education.StudentService.ChangeUserBalanceHistoryByDebit:input_type -> education.ChangeUserBalanceHistoryByDebitRequest
-	54,  // 100: education.StudentService.CalculateDiscountSumma:input_type -> education.CalculateDiscountSummaRequest
-	84,  // 101: education.SmsService.GetSmsLogs:input_type -> education.GetSmsLogRequest
-	87,  // 102: education.SmsService.AddSms:input_type -> education.AddSmsRequest
-	97,  // 103: education.SmsService.DeleteSms:input_type -> common.DeleteAbsRequest
-	96,  // 104: education.SmsService.GetSmsTransactionDetail:input_type -> common.PageRequest
-	90,  // 105: education.SmsService.GetSmsTemplate:input_type -> education.GetSmsTemplateRequest
-	93,  // 106: education.SmsService.SetSmsTemplate:input_type -> education.SetSmsTemplateRequest
-	94,  // 107: education.SmsService.SendSmsDirectly:input_type -> education.SendSmsDirectlyRequest
-	0,   // 108: education.CompanyFinanceService.Create:output_type -> education.CompanyFinance
-	99,  // 109: education.CompanyFinanceService.Delete:output_type -> common.AbsResponse
-	3,   // 110: education.CompanyFinanceService.GetAll:output_type -> education.CompanyFinanceList
-	2,   // 111: education.CompanyFinanceService.GetByCompany:output_type -> education.CompanyFinanceSelfList
-	0,   // 112: education.CompanyFinanceService.UpdateByCompany:output_type -> education.CompanyFinance
-	13,  // 113: education.CompanyService.GetCompanyBySubdomain:output_type -> education.GetCompanyResponse
-	99,  // 114: education.CompanyService.CreateCompany:output_type -> common.AbsResponse
-	10,  // 115: education.CompanyService.GetAll:output_type -> education.GetAllResponse
-	99,  // 116: education.CompanyService.UpdateCompany:output_type -> common.AbsResponse
-	5,   // 117: education.CompanyService.GetStatistic:output_type -> education.GetStatisticResponse
-	14,  // 118: education.TariffService.Create:output_type -> education.Tariff
-	14,  // 119: education.TariffService.Update:output_type -> education.Tariff
-	14,  // 120: education.TariffService.Delete:output_type -> education.Tariff
-	15,  // 121: education.TariffService.Get:output_type -> education.TariffList
-	99,  // 122: education.RoomService.CreateRoom:output_type -> common.AbsResponse
-	17,  // 123: education.RoomService.GetRooms:output_type -> education.GetUpdateRoomAbs
-	99,  // 124: education.RoomService.UpdateRoom:output_type -> common.AbsResponse
-	99,  // 125: education.RoomService.DeleteRoom:output_type -> common.AbsResponse
-	99,  // 126: education.CourseService.CreateCourse:output_type -> common.AbsResponse
-	20,  // 127: education.CourseService.GetCourses:output_type -> education.GetUpdateCourseAbs
-	22,  // 128: education.CourseService.GetCourseById:output_type -> education.GetCourseByIdResponse
-	99,  // 129: education.CourseService.UpdateCourse:output_type -> common.AbsResponse
-	99,  // 130: education.CourseService.DeleteCourse:output_type -> common.AbsResponse
-	99,  // 131: education.GroupService.CreateGroup:output_type -> common.AbsResponse
-	41,  // 132: education.GroupService.GetGroups:output_type -> education.GetGroupsResponse
-	40,  // 133: education.GroupService.GetGroupById:output_type -> education.GetGroupAbsResponse
-	38,  // 134: education.GroupService.GetGroupsByCourseId:output_type -> education.GetGroupsByCourseResponse
-	28,  // 135: education.GroupService.GetGroupsByStudentId:output_type -> education.GetGroupsByStudentResponse
-	99,  // 136: education.GroupService.UpdateGroup:output_type -> common.AbsResponse
-	99,  // 137: education.GroupService.DeleteGroup:output_type -> common.AbsResponse
-	33,  // 138: education.GroupService.GetGroupsByTeacherId:output_type -> education.GetGroupsByTeacherResponse
-	31,  // 139: education.GroupService.GetCommonInformationEducation:output_type -> education.GetCommonInformationEducationResponse
-	25,  // 140: education.GroupService.GetLeftAfterTrialPeriod:output_type -> education.GetLeftAfterTrialPeriodResponse
-	48,  // 141: education.AttendanceService.GetAttendance:output_type -> education.GetAttendanceResponse
-	99,  // 142: education.AttendanceService.SetAttendance:output_type -> common.AbsResponse
-	44,  // 143: education.AttendanceService.CalculateTeacherSalaryByAttendance:output_type -> education.CalculateTeacherSalaryResponse
-	72,  // 144: education.StudentService.GetAllStudent:output_type -> education.GetAllStudentResponse
-	99,  // 145: education.StudentService.CreateStudent:output_type -> common.AbsResponse
-	99,  // 146: education.StudentService.UpdateStudent:output_type -> common.AbsResponse
-	99,  // 147: education.StudentService.DeleteStudent:output_type -> common.AbsResponse
-	99,  // 148: education.StudentService.AddToGroup:output_type -> common.AbsResponse
-	78,  // 149: education.StudentService.GetStudentById:output_type -> education.GetStudentByIdResponse
-	81,  // 150: education.StudentService.GetNoteByStudent:output_type -> education.GetNotesByStudent
-	99,  // 151: education.StudentService.CreateNoteForStudent:output_type -> common.AbsResponse
-	99,  // 152: education.StudentService.DeleteStudentNote:output_type -> common.AbsResponse
-	69,  // 153: education.StudentService.SearchStudent:output_type -> education.SearchStudentResponse
-	63,  // 154: education.StudentService.GetHistoryGroupById:output_type -> education.GetHistoryGroupResponse
-	64,  // 155: education.StudentService.GetHistoryStudentById:output_type -> education.GetHistoryStudentResponse
-	99,  // 156: education.StudentService.TransferLessonDate:output_type -> common.AbsResponse
-	99,  // 157: education.StudentService.ChangeConditionStudent:output_type -> common.AbsResponse
-	59,  // 158: education.StudentService.GetStudentsByGroupId:output_type -> education.GetStudentsByGroupIdResponse
-	99,  // 159: education.StudentService.ChangeUserBalanceHistory:output_type -> common.AbsResponse
-	99,  // 160: education.StudentService.ChangeUserBalanceHistoryByDebit:output_type -> common.AbsResponse
-	55,  // 161: education.StudentService.CalculateDiscountSumma:output_type -> education.CalculateDiscountResponse
-	85,  // 162: education.SmsService.GetSmsLogs:output_type -> education.GetSmsLogResponse
-	99,  // 163: education.SmsService.AddSms:output_type -> common.AbsResponse
-	99,  // 164: education.SmsService.DeleteSms:output_type -> common.AbsResponse
-	88,  // 165: education.SmsService.GetSmsTransactionDetail:output_type -> education.GetSmsTransactionDetailResponse
-	91,  // 166: education.SmsService.GetSmsTemplate:output_type -> education.GetSmsTemplateResponse
-	99,  // 167: education.SmsService.SetSmsTemplate:output_type -> common.AbsResponse
-	99,  // 168: education.SmsService.SendSmsDirectly:output_type -> common.AbsResponse
-	108, // [108:169] is the sub-list for method output_type
-	47,  // [47:108] is the sub-list for method input_type
-	47,  // [47:47] is the sub-list for extension type_name
-	47,  // [47:47] is the sub-list for extension extendee
-	0,   // [0:47] is the sub-list for field type_name
+	26,  // 11: education.GetCoursePriceHistoryResponse.versions:type_name -> education.CoursePriceVersion
+	28,  // 12: education.GetCoursePriceHistoryResponse.locks:type_name -> education.EnrollmentPriceLock
+	32,  // 13: education.GetGroupsByIdsResponse.groups:type_name -> education.GroupMeta
+	35,  // 14: education.GetLeftAfterTrialPeriodResponse.items:type_name -> education.AbsGetLeftAfter
+	39,  // 15: education.GetGroupsByStudentResponse.comments:type_name -> education.DebtorComment
+	38,  // 16: education.GetGroupsByStudentResponse.groups:type_name -> education.DebtorGroup
+	43,  // 17: education.GetGroupsByTeacherResponse.groups:type_name -> education.GetGroupByTeacherAbs
+	162, // 18: education.GetGroupByTeacherAbs.students:type_name -> education.AbsStudent
+	48,  // 19: education.GetGroupsByCourseResponse.groups:type_name -> education.GetGroupByCourseAbsResponse
+	21,  // 20: education.GetGroupAbsResponse.course:type_name -> education.AbsCourse
+	18,  // 21: education.GetGroupAbsResponse.room:type_name -> education.AbsRoom
+	49,  // 22: education.GetGroupsResponse.groups:type_name -> education.GetGroupAbsResponse
+	195, // 23: education.GetGroupsRequest.page:type_name -> common.PageRequest
+	54,  // 24: education.ScheduleConflicts.conflicts:type_name -> education.ScheduleConflict
+	54,  // 25: education.ScheduleConflictOverride.conflicts:type_name -> education.ScheduleConflict
+	58,  // 26: education.GetScheduleConflictOverridesResponse.overrides:type_name -> education.ScheduleConflictOverride
+	62,  // 27: education.CalculateTeacherSalaryResponse.salaries:type_name -> education.AbsCalculateSalary
+	63,  // 28: education.AbsCalculateSalary.salaries:type_name -> education.StudentSalary
+	66,  // 29: education.GetAttendanceResponse.days:type_name -> education.Day
+	67,  // 30: education.GetAttendanceResponse.students:type_name -> education.Student
+	68,  // 31: education.Student.attendance:type_name -> education.Attendance
+	69,  // 32: education.Student.freezeDetail:type_name -> education.FreezeDetail
+	72,  // 33: education.SetGroupAttendanceRequest.marks:type_name -> education.GroupAttendanceMark
+	73,  // 34: education.SetGroupAttendanceResponse.results:type_name -> education.GroupAttendanceResult
+	75,  // 35: education.GetAttendanceStatusesResponse.statuses:type_name -> education.AbsAttendanceStatus
+	80,  // 36: education.GetAttendanceUnlockRequestsResponse.requests:type_name -> education.AttendanceUnlockRequest
+	86,  // 37: education.VerifyStudentLoginCodeResponse.sessions:type_name -> education.StudentSession
+	94,  // 38: education.GetLessonCheckInsResponse.checkIns:type_name -> education.LessonCheckIn
+	98,  // 39: education.GetAttendanceRatesResponse.rates:type_name -> education.AttendanceRate
+	101, // 40: education.GetAbsenceStreaksResponse.streaks:type_name -> education.AbsenceStreak
+	104, // 41: education.GetAttendanceTrendResponse.points:type_name -> education.AttendanceTrendPoint
+	108, // 42: education.GetAtRiskStudentsResponse.students:type_name -> education.AtRiskStudent
+	111, // 43: education.GetNotificationsResponse.notifications:type_name -> education.Notification
+	115, // 44: education.GetGroupWaitlistResponse.entries:type_name -> education.WaitlistEntry
+	120, // 45: education.GetHolidaysResponse.holidays:type_name -> education.AbsHoliday
+	126, // 46: education.GetTimetableResponse.lessons:type_name -> education.TimetableLesson
+	129, // 47: education.GetCalendarFeedsResponse.feeds:type_name -> education.CalendarFeed
+	129, // 48: education.CalendarFeedTimetable.feed:type_name -> education.CalendarFeed
+	126, // 49: education.CalendarFeedTimetable.lessons:type_name -> education.TimetableLesson
+	134, // 50: education.GetLessonsResponse.lessons:type_name -> education.AbsLesson
+	140, // 51: education.GetDebtorsResponse.debtors:type_name -> education.AbsDebtor
+	140, // 52: education.GetStudentsByPhonesResponse.students:type_name -> education.AbsDebtor
+	162, // 53: education.GetStudentsByGroupIdResponse.students:type_name -> education.AbsStudent
+	159, // 54: education.GetHistoryGroupResponse.groupHistory:type_name -> education.AbsHistory
+	157, // 55: education.GetHistoryGroupResponse.studentsHistory:type_name -> education.AbsStudentHistory
+	159, // 56: education.GetHistoryStudentResponse.studentHistory:type_name -> education.AbsHistory
+	157, // 57: education.GetHistoryStudentResponse.conditionsHistory:type_name -> education.AbsStudentHistory
+	162, // 58: education.AbsStudentHistory.student:type_name -> education.AbsStudent
+	158, // 59: education.AbsStudentHistory.group:type_name -> education.AbsGroup
+	21,  // 60: education.AbsGroup.course:type_name -> education.AbsCourse
+	162, // 61: education.SearchStudentResponse.students:type_name -> education.AbsStudent
+	165, // 62: education.GetAllStudentResponse.response:type_name -> education.GetGroupsAbsForStudent
+	166, // 63: education.GetGroupsAbsForStudent.groups:type_name -> education.GroupGetAllStudentAbs
+	21,  // 64: education.GroupGetAllStudentAbs.course:type_name -> education.AbsCourse
+	170, // 65: education.AddToGroupResponse.results:type_name -> education.AddToGroupResult
+	174, // 66: education.GetStudentByIdResponse.groups:type_name -> education.GetGroupStudent
+	18,  // 67: education.GetGroupStudent.room:type_name -> education.AbsRoom
+	21,  // 68: education.GetGroupStudent.course:type_name -> education.AbsCourse
+	176, // 69: education.GetNotesByStudent.notes:type_name -> education.AbsNote
+	181, // 70: education.GetLessonPackagesResponse.packages:type_name -> education.AbsLessonPackage
+	195, // 71: education.GetSmsLogRequest.pageRequest:type_name -> common.PageRequest
+	184, // 72: education.GetSmsLogResponse.datas:type_name -> education.SmsLogList
+	187, // 73: education.GetSmsTransactionDetailResponse.datas:type_name -> education.GetSmsTransactionList
+	190, // 74: education.GetSmsTemplateResponse.datas:type_name -> education.SmsTemplateList
+	0,   // 75: education.CompanyFinanceService.Create:input_type -> education.CompanyFinance
+	196, // 76: education.CompanyFinanceService.Delete:input_type -> common.DeleteAbsRequest
+	195, // 77: education.CompanyFinanceService.GetAll:input_type -> common.PageRequest
+	195, // 78: education.CompanyFinanceService.GetByCompany:input_type -> common.PageRequest
+	0,   // 79: education.CompanyFinanceService.UpdateByCompany:input_type -> education.CompanyFinance
+	12,  // 80: education.CompanyService.GetCompanyBySubdomain:input_type -> education.GetCompanyRequest
+	11,  // 81: education.CompanyService.CreateCompany:input_type -> education.CreateCompanyRequest
+	195, // 82: education.CompanyService.GetAll:input_type -> common.PageRequest
+	9,   // 83: education.CompanyService.UpdateCompany:input_type -> education.UpdateCompanyRequest
+	8,   // 84: education.CompanyService.GetStatistic:input_type -> education.GetStatisticRequest
+	14,  // 85: education.TariffService.Create:input_type -> education.Tariff
+	14,  // 86: education.TariffService.Update:input_type -> education.Tariff
+	14,  // 87: education.TariffService.Delete:input_type -> education.Tariff
+	197, // 88: education.TariffService.Get:input_type -> google.protobuf.Empty
+	16,  // 89: education.RoomService.CreateRoom:input_type -> education.CreateRoomRequest
+	197, // 90: education.RoomService.GetRooms:input_type -> google.protobuf.Empty
+	18,  // 91: education.RoomService.UpdateRoom:input_type -> education.AbsRoom
+	196, // 92: education.RoomService.DeleteRoom:input_type -> common.DeleteAbsRequest
+	19,  // 93: education.CourseService.CreateCourse:input_type -> education.CreateCourseRequest
+	197, // 94: education.CourseService.GetCourses:input_type -> google.protobuf.Empty
+	23,  // 95: education.CourseService.GetCourseById:input_type -> education.GetCourseByIdRequest
+	21,  // 96: education.CourseService.UpdateCourse:input_type -> education.AbsCourse
+	196, // 97: education.CourseService.DeleteCourse:input_type -> common.DeleteAbsRequest
+	23,  // 98: education.CourseService.GetCourseBilling:input_type -> education.GetCourseByIdRequest
+	24,  // 99: education.CourseService.UpdateCourseBilling:input_type -> education.CourseBilling
+	25,  // 100: education.CourseService.ScheduleCoursePrice:input_type -> education.ScheduleCoursePriceRequest
+	23,  // 101: education.CourseService.GetCoursePriceHistory:input_type -> education.GetCourseByIdRequest
+	29,  // 102: education.CourseService.SetEnrollmentPriceLock:input_type -> education.SetEnrollmentPriceLockRequest
+	44,  // 103: education.GroupService.CreateGroup:input_type -> education.CreateGroupRequest
+	51,  // 104: education.GroupService.GetGroups:input_type -> education.GetGroupsRequest
+	45,  // 105: education.GroupService.GetGroupById:input_type -> education.GetGroupByIdRequest
+	45,  // 106: education.GroupService.GetGroupsByCourseId:input_type -> education.GetGroupByIdRequest
+	36,  // 107: education.GroupService.GetGroupsByStudentId:input_type -> education.StudentIdRequest
+	46,  // 108: education.GroupService.UpdateGroup:input_type -> education.GetUpdateGroupAbs
+	196, // 109: education.GroupService.DeleteGroup:input_type -> common.DeleteAbsRequest
+	41,  // 110: education.GroupService.GetGroupsByTeacherId:input_type -> education.GetGroupsByTeacherIdRequest
+	197, // 111: education.GroupService.GetCommonInformationEducation:input_type -> google.protobuf.Empty
+	33,  // 112: education.GroupService.GetLeftAfterTrialPeriod:input_type -> education.GetLeftAfterTrialPeriodRequest
+	30,  // 113: education.GroupService.GetGroupsByIds:input_type -> education.GetGroupsByIdsRequest
+	52,  // 114: education.GroupService.UpdateGroupBillingMode:input_type -> education.UpdateGroupBillingModeRequest
+	53,  // 115: education.GroupService.UpdateGroupMaxStudents:input_type -> education.UpdateGroupMaxStudentsRequest
+	56,  // 116: education.GroupService.CheckScheduleConflicts:input_type -> education.CheckScheduleConflictsRequest
+	57,  // 117: education.GroupService.GetScheduleConflictOverrides:input_type -> education.GetScheduleConflictOverridesRequest
+	64,  // 118: education.AttendanceService.GetAttendance:input_type -> education.GetAttendanceRequest
+	70,  // 119: education.AttendanceService.SetAttendance:input_type -> education.SetAttendanceRequest
+	71,  // 120: education.AttendanceService.SetGroupAttendance:input_type -> education.SetGroupAttendanceRequest
+	60,  // 121: education.AttendanceService.CalculateTeacherSalaryByAttendance:input_type -> education.CalculateTeacherSalaryRequest
+	75,  // 122: education.AttendanceStatusService.CreateAttendanceStatus:input_type -> education.AbsAttendanceStatus
+	75,  // 123: education.AttendanceStatusService.UpdateAttendanceStatus:input_type -> education.AbsAttendanceStatus
+	196, // 124: education.AttendanceStatusService.DeleteAttendanceStatus:input_type -> common.DeleteAbsRequest
+	76,  // 125: education.AttendanceStatusService.GetAttendanceStatuses:input_type -> education.GetAttendanceStatusesRequest
+	197, // 126: education.AttendancePolicyService.GetAttendancePolicy:input_type -> google.protobuf.Empty
+	78,  // 127: education.AttendancePolicyService.UpdateAttendancePolicy:input_type -> education.AttendancePolicy
+	79,  // 128: education.AttendancePolicyService.RequestAttendanceUnlock:input_type -> education.RequestAttendanceUnlockRequest
+	81,  // 129: education.AttendancePolicyService.GetAttendanceUnlockRequests:input_type -> education.GetAttendanceUnlockRequestsRequest
+	83,  // 130: education.AttendancePolicyService.ReviewAttendanceUnlock:input_type -> education.ReviewAttendanceUnlockRequest
+	84,  // 131: education.CheckInService.SendStudentLoginCode:input_type -> education.SendStudentLoginCodeRequest
+	85,  // 132: education.CheckInService.VerifyStudentLoginCode:input_type -> education.VerifyStudentLoginCodeRequest
+	88,  // 133: education.CheckInService.ValidateStudentSession:input_type -> education.ValidateStudentSessionRequest
+	89,  // 134: education.CheckInService.GetCheckInCode:input_type -> education.GetCheckInCodeRequest
+	91,  // 135: education.CheckInService.CheckIn:input_type -> education.CheckInRequest
+	93,  // 136: education.CheckInService.GetLessonCheckIns:input_type -> education.GetLessonCheckInsRequest
+	96,  // 137: education.CheckInService.ConfirmCheckIns:input_type -> education.ConfirmCheckInsRequest
+	97,  // 138: education.AttendanceAnalyticsService.GetAttendanceRates:input_type -> education.GetAttendanceRatesRequest
+	100, // 139: education.AttendanceAnalyticsService.GetAbsenceStreaks:input_type -> education.GetAbsenceStreaksRequest
+	103, // 140: education.AttendanceAnalyticsService.GetAttendanceTrend:input_type -> education.GetAttendanceTrendRequest
+	197, // 141: education.AttendanceAnalyticsService.GetAtRiskPolicy:input_type -> google.protobuf.Empty
+	106, // 142: education.AttendanceAnalyticsService.UpdateAtRiskPolicy:input_type -> education.AtRiskPolicy
+	107, // 143: education.AttendanceAnalyticsService.GetAtRiskStudents:input_type -> education.GetAtRiskStudentsRequest
+	110, // 144: education.NotificationService.GetNotifications:input_type -> education.GetNotificationsRequest
+	113, // 145: education.NotificationService.MarkNotificationsRead:input_type -> education.MarkNotificationsReadRequest
+	114, // 146: education.GroupWaitlistService.GetGroupWaitlist:input_type -> education.GetGroupWaitlistRequest
+	117, // 147: education.GroupWaitlistService.MoveWaitlistEntry:input_type -> education.MoveWaitlistEntryRequest
+	118, // 148: education.GroupWaitlistService.CancelWaitlistEntry:input_type -> education.WaitlistEntryRequest
+	119, // 149: education.GroupWaitlistService.AcceptWaitlistOffer:input_type -> education.AcceptWaitlistOfferRequest
+	120, // 150: education.HolidayService.CreateHoliday:input_type -> education.AbsHoliday
+	120, // 151: education.HolidayService.UpdateHoliday:input_type -> education.AbsHoliday
+	196, // 152: education.HolidayService.DeleteHoliday:input_type -> common.DeleteAbsRequest
+	121, // 153: education.HolidayService.GetHolidays:input_type -> education.GetHolidaysRequest
+	123, // 154: education.HolidayService.GetGroupHolidays:input_type -> education.GetGroupHolidaysRequest
+	125, // 155: education.TimetableService.GetTimetable:input_type -> education.GetTimetableRequest
+	128, // 156: education.TimetableService.CreateCalendarFeed:input_type -> education.CreateCalendarFeedRequest
+	130, // 157: education.TimetableService.GetCalendarFeeds:input_type -> education.GetCalendarFeedsRequest
+	196, // 158: education.TimetableService.RevokeCalendarFeed:input_type -> common.DeleteAbsRequest
+	132, // 159: education.TimetableService.GetCalendarFeedTimetable:input_type -> education.GetCalendarFeedTimetableRequest
+	135, // 160: education.LessonService.GetLessons:input_type -> education.GetLessonsRequest
+	196, // 161: education.LessonService.GetLessonById:input_type -> common.DeleteAbsRequest
+	137, // 162: education.LessonService.UpdateLesson:input_type -> education.UpdateLessonRequest
+	163, // 163: education.StudentService.GetAllStudent:input_type -> education.GetAllStudentRequest
+	167, // 164: education.StudentService.CreateStudent:input_type -> education.CreateStudentRequest
+	168, // 165: education.StudentService.UpdateStudent:input_type -> education.UpdateStudentRequest
+	150, // 166: education.StudentService.DeleteStudent:input_type -> education.DeleteStudentRequest
+	169, // 167: education.StudentService.AddToGroup:input_type -> education.AddToGroupRequest
+	173, // 168: education.StudentService.GetStudentById:input_type -> education.NoteStudentByAbsRequest
+	173, // 169: education.StudentService.GetNoteByStudent:input_type -> education.NoteStudentByAbsRequest
+	177, // 170: education.StudentService.CreateNoteForStudent:input_type -> education.CreateNoteRequest
+	173, // 171: education.StudentService.DeleteStudentNote:input_type -> education.NoteStudentByAbsRequest
+	160, // 172: education.StudentService.SearchStudent:input_type -> education.SearchStudentRequest
+	173, // 173: education.StudentService.GetHistoryGroupById:input_type -> education.NoteStudentByAbsRequest
+	173, // 174: education.StudentService.GetHistoryStudentById:input_type -> education.NoteStudentByAbsRequest
+	154, // 175: education.StudentService.TransferLessonDate:input_type -> education.TransferLessonRequest
+	153, // 176: education.StudentService.ChangeConditionStudent:input_type -> education.ChangeConditionStudentRequest
+	152, // 177: education.StudentService.GetStudentsByGroupId:input_type -> education.GetStudentsByGroupIdRequest
+	149, // 178: education.StudentService.ChangeUserBalanceHistory:input_type -> education.ChangeUserBalanceHistoryRequest
+	148, // 179: education.StudentService.ChangeUserBalanceHistoryByDebit:input_type -> education.ChangeUserBalanceHistoryByDebitRequest
+	146, // 180: education.StudentService.CalculateDiscountSumma:input_type -> education.CalculateDiscountSummaRequest
+	138, // 181: education.StudentService.GetDebtors:input_type -> education.GetDebtorsRequest
+	145, // 182: education.StudentService.SendDebtReminder:input_type -> education.SendDebtReminderRequest
+	143, // 183: education.StudentService.GetStudentsByPhones:input_type -> education.GetStudentsByPhonesRequest
+	141, // 184: education.StudentService.GetDiscountContext:input_type -> education.GetDiscountContextRequest
+	178, // 185: education.StudentService.SellLessonPackage:input_type -> education.SellLessonPackageRequest
+	179, // 186: education.StudentService.GetLessonPackages:input_type -> education.GetLessonPackagesRequest
+	182, // 187: education.SmsService.GetSmsLogs:input_type -> education.GetSmsLogRequest
+	185, // 188: education.SmsService.AddSms:input_type -> education.AddSmsRequest
+	196, // 189: education.SmsService.DeleteSms:input_type -> common.DeleteAbsRequest
+	195, // 190: education.SmsService.GetSmsTransactionDetail:input_type -> common.PageRequest
+	188, // 191: education.SmsService.GetSmsTemplate:input_type -> education.GetSmsTemplateRequest
+	191, // 192: education.SmsService.SetSmsTemplate:input_type -> education.SetSmsTemplateRequest
+	192, // 193: education.SmsService.SendSmsDirectly:input_type -> education.SendSmsDirectlyRequest
+	193, // 194: education.SmsService.SendStaffSms:input_type -> education.SendStaffSmsRequest
+	0,   // 195: education.CompanyFinanceService.Create:output_type -> education.CompanyFinance
+	198, // 196: education.CompanyFinanceService.Delete:output_type -> common.AbsResponse
+	3,   // 197: education.CompanyFinanceService.GetAll:output_type -> education.CompanyFinanceList
+	2,   // 198: education.CompanyFinanceService.GetByCompany:output_type -> education.CompanyFinanceSelfList
+	0,   // 199: education.CompanyFinanceService.UpdateByCompany:output_type -> education.CompanyFinance
+	13,  // 200: education.CompanyService.GetCompanyBySubdomain:output_type -> education.GetCompanyResponse
+	198, // 201: education.CompanyService.CreateCompany:output_type -> common.AbsResponse
+	10,  // 202: education.CompanyService.GetAll:output_type -> education.GetAllResponse
+	198, // 203: education.CompanyService.UpdateCompany:output_type -> common.AbsResponse
+	5,   // 204: education.CompanyService.GetStatistic:output_type -> education.GetStatisticResponse
+	14,  // 205: education.TariffService.Create:output_type -> education.Tariff
+	14,  // 206: education.TariffService.Update:output_type -> education.Tariff
+	14,  // 207: education.TariffService.Delete:output_type -> education.Tariff
+	15,  // 208: education.TariffService.Get:output_type -> education.TariffList
+	198, // 209: education.RoomService.CreateRoom:output_type -> common.AbsResponse
+	17,  // 210: education.RoomService.GetRooms:output_type -> education.GetUpdateRoomAbs
+	198, // 211: education.RoomService.UpdateRoom:output_type -> common.AbsResponse
+	198, // 212: education.RoomService.DeleteRoom:output_type -> common.AbsResponse
+	198, // 213: education.CourseService.CreateCourse:output_type -> common.AbsResponse
+	20,  // 214: education.CourseService.GetCourses:output_type -> education.GetUpdateCourseAbs
+	22,  // 215: education.CourseService.GetCourseById:output_type -> education.GetCourseByIdResponse
+	198, // 216: education.CourseService.UpdateCourse:output_type -> common.AbsResponse
+	198, // 217: education.CourseService.DeleteCourse:output_type -> common.AbsResponse
+	24,  // 218: education.CourseService.GetCourseBilling:output_type -> education.CourseBilling
+	198, // 219: education.CourseService.UpdateCourseBilling:output_type -> common.AbsResponse
+	198, // 220: education.CourseService.ScheduleCoursePrice:output_type -> common.AbsResponse
+	27,  // 221: education.CourseService.GetCoursePriceHistory:output_type -> education.GetCoursePriceHistoryResponse
+	198, // 222: education.CourseService.SetEnrollmentPriceLock:output_type -> common.AbsResponse
+	198, // 223: education.GroupService.CreateGroup:output_type -> common.AbsResponse
+	50,  // 224: education.GroupService.GetGroups:output_type -> education.GetGroupsResponse
+	49,  // 225: education.GroupService.GetGroupById:output_type -> education.GetGroupAbsResponse
+	47,  // 226: education.GroupService.GetGroupsByCourseId:output_type -> education.GetGroupsByCourseResponse
+	37,  // 227: education.GroupService.GetGroupsByStudentId:output_type -> education.GetGroupsByStudentResponse
+	198, // 228: education.GroupService.UpdateGroup:output_type -> common.AbsResponse
+	198, // 229: education.GroupService.DeleteGroup:output_type -> common.AbsResponse
+	42,  // 230: education.GroupService.GetGroupsByTeacherId:output_type -> education.GetGroupsByTeacherResponse
+	40,  // 231: education.GroupService.GetCommonInformationEducation:output_type -> education.GetCommonInformationEducationResponse
+	34,  // 232: education.GroupService.GetLeftAfterTrialPeriod:output_type -> education.GetLeftAfterTrialPeriodResponse
+	31,  // 233: education.GroupService.GetGroupsByIds:output_type -> education.GetGroupsByIdsResponse
+	198, // 234: education.GroupService.UpdateGroupBillingMode:output_type -> common.AbsResponse
+	198, // 235: education.GroupService.UpdateGroupMaxStudents:output_type -> common.AbsResponse
+	55,  // 236: education.GroupService.CheckScheduleConflicts:output_type -> education.ScheduleConflicts
+	59,  // 237: education.GroupService.GetScheduleConflictOverrides:output_type -> education.GetScheduleConflictOverridesResponse
+	65,  // 238: education.AttendanceService.GetAttendance:output_type -> education.GetAttendanceResponse
+	198, // 239: education.AttendanceService.SetAttendance:output_type -> common.AbsResponse
+	74,  // 240: education.AttendanceService.SetGroupAttendance:output_type -> education.SetGroupAttendanceResponse
+	61,  // 241: education.AttendanceService.CalculateTeacherSalaryByAttendance:output_type -> education.CalculateTeacherSalaryResponse
+	198, // 242: education.AttendanceStatusService.CreateAttendanceStatus:output_type -> common.AbsResponse
+	198, // 243: education.AttendanceStatusService.UpdateAttendanceStatus:output_type -> common.AbsResponse
+	198, // 244: education.AttendanceStatusService.DeleteAttendanceStatus:output_type -> common.AbsResponse
+	77,  // 245: education.AttendanceStatusService.GetAttendanceStatuses:output_type -> education.GetAttendanceStatusesResponse
+	78,  // 246: education.AttendancePolicyService.GetAttendancePolicy:output_type -> education.AttendancePolicy
+	198, // 247: education.AttendancePolicyService.UpdateAttendancePolicy:output_type -> common.AbsResponse
+	198, // 248: education.AttendancePolicyService.RequestAttendanceUnlock:output_type -> common.AbsResponse
+	82,  // 249: education.AttendancePolicyService.GetAttendanceUnlockRequests:output_type -> education.GetAttendanceUnlockRequestsResponse
+	198, // 250: education.AttendancePolicyService.ReviewAttendanceUnlock:output_type -> common.AbsResponse
+	198, // 251: education.CheckInService.SendStudentLoginCode:output_type -> common.AbsResponse
+	87,  // 252: education.CheckInService.VerifyStudentLoginCode:output_type -> education.VerifyStudentLoginCodeResponse
+	86,  // 253: education.CheckInService.ValidateStudentSession:output_type -> education.StudentSession
+	90,  // 254: education.CheckInService.GetCheckInCode:output_type -> education.CheckInCode
+	92,  // 255: education.CheckInService.CheckIn:output_type -> education.CheckInResponse
+	95,  // 256: education.CheckInService.GetLessonCheckIns:output_type -> education.GetLessonCheckInsResponse
+	74,  // 257: education.CheckInService.ConfirmCheckIns:output_type -> education.SetGroupAttendanceResponse
+	99,  // 258: education.AttendanceAnalyticsService.GetAttendanceRates:output_type -> education.GetAttendanceRatesResponse
+	102, // 259: education.AttendanceAnalyticsService.GetAbsenceStreaks:output_type -> education.GetAbsenceStreaksResponse
+	105, // 260: education.AttendanceAnalyticsService.GetAttendanceTrend:output_type -> education.GetAttendanceTrendResponse
+	106, // 261: education.AttendanceAnalyticsService.GetAtRiskPolicy:output_type -> education.AtRiskPolicy
+	198, // 262: education.AttendanceAnalyticsService.UpdateAtRiskPolicy:output_type -> common.AbsResponse
+	109, // 263: education.AttendanceAnalyticsService.GetAtRiskStudents:output_type -> education.GetAtRiskStudentsResponse
+	112, // 264: education.NotificationService.GetNotifications:output_type -> education.GetNotificationsResponse
+	198, // 265: education.NotificationService.MarkNotificationsRead:output_type -> common.AbsResponse
+	116, // 266: education.GroupWaitlistService.GetGroupWaitlist:output_type -> education.GetGroupWaitlistResponse
+	198, // 267: education.GroupWaitlistService.MoveWaitlistEntry:output_type -> common.AbsResponse
+	198, // 268: education.GroupWaitlistService.CancelWaitlistEntry:output_type -> common.AbsResponse
+	171, // 269: education.GroupWaitlistService.AcceptWaitlistOffer:output_type -> education.AddToGroupResponse
+	198, // 270: education.HolidayService.CreateHoliday:output_type -> common.AbsResponse
+	198, // 271: education.HolidayService.UpdateHoliday:output_type -> common.AbsResponse
+	198, // 272: education.HolidayService.DeleteHoliday:output_type -> common.AbsResponse
+	122, // 273: education.HolidayService.GetHolidays:output_type -> education.GetHolidaysResponse
+	124, // 274: education.HolidayService.GetGroupHolidays:output_type -> education.GetGroupHolidaysResponse
+	127, // 275: education.TimetableService.GetTimetable:output_type -> education.GetTimetableResponse
+	129, // 276: education.TimetableService.CreateCalendarFeed:output_type -> education.CalendarFeed
+	131, // 277: education.TimetableService.GetCalendarFeeds:output_type -> education.GetCalendarFeedsResponse
+	198, // 278: education.TimetableService.RevokeCalendarFeed:output_type -> common.AbsResponse
+	133, // 279: education.TimetableService.GetCalendarFeedTimetable:output_type -> education.CalendarFeedTimetable
+	136, // 280: education.LessonService.GetLessons:output_type -> education.GetLessonsResponse
+	134, // 281: education.LessonService.GetLessonById:output_type -> education.AbsLesson
+	134, // 282: education.LessonService.UpdateLesson:output_type -> education.AbsLesson
+	164, // 283: education.StudentService.GetAllStudent:output_type -> education.GetAllStudentResponse
+	198, // 284: education.StudentService.CreateStudent:output_type -> common.AbsResponse
+	198, // 285: education.StudentService.UpdateStudent:output_type -> common.AbsResponse
+	198, // 286: education.StudentService.DeleteStudent:output_type -> common.AbsResponse
+	171, // 287: education.StudentService.AddToGroup:output_type -> education.AddToGroupResponse
+	172, // 288: education.StudentService.GetStudentById:output_type -> education.GetStudentByIdResponse
+	175, // 289: education.StudentService.GetNoteByStudent:output_type -> education.GetNotesByStudent
+	198, // 290: education.StudentService.CreateNoteForStudent:output_type -> common.AbsResponse
+	198, // 291: education.StudentService.DeleteStudentNote:output_type -> common.AbsResponse
+	161, // 292: education.StudentService.SearchStudent:output_type -> education.SearchStudentResponse
+	155, // 293: education.StudentService.GetHistoryGroupById:output_type -> education.GetHistoryGroupResponse
+	156, // 294: education.StudentService.GetHistoryStudentById:output_type -> education.GetHistoryStudentResponse
+	198, // 295: education.StudentService.TransferLessonDate:output_type -> common.AbsResponse
+	198, // 296: education.StudentService.ChangeConditionStudent:output_type -> common.AbsResponse
+	151, // 297: education.StudentService.GetStudentsByGroupId:output_type -> education.GetStudentsByGroupIdResponse
+	198, // 298: education.StudentService.ChangeUserBalanceHistory:output_type -> common.AbsResponse
+	198, // 299: education.StudentService.ChangeUserBalanceHistoryByDebit:output_type -> common.AbsResponse
+	147, // 300: education.StudentService.CalculateDiscountSumma:output_type -> education.CalculateDiscountResponse
+	139, // 301: education.StudentService.GetDebtors:output_type -> education.GetDebtorsResponse
+	198, // 302: education.StudentService.SendDebtReminder:output_type -> common.AbsResponse
+	144, // 303: education.StudentService.GetStudentsByPhones:output_type -> education.GetStudentsByPhonesResponse
+	142, // 304: education.StudentService.GetDiscountContext:output_type -> education.GetDiscountContextResponse
+	198, // 305: education.StudentService.SellLessonPackage:output_type -> common.AbsResponse
+	180, // 306: education.StudentService.GetLessonPackages:output_type -> education.GetLessonPackagesResponse
+	183, // 307: education.SmsService.GetSmsLogs:output_type -> education.GetSmsLogResponse
+	198, // 308: education.SmsService.AddSms:output_type -> common.AbsResponse
+	198, // 309: education.SmsService.DeleteSms:output_type -> common.AbsResponse
+	186, // 310: education.SmsService.GetSmsTransactionDetail:output_type -> education.GetSmsTransactionDetailResponse
+	189, // 311: education.SmsService.GetSmsTemplate:output_type -> education.GetSmsTemplateResponse
+	198, // 312: education.SmsService.SetSmsTemplate:output_type -> common.AbsResponse
+	198, // 313: education.SmsService.SendSmsDirectly:output_type -> common.AbsResponse
+	198, // 314: education.SmsService.SendStaffSms:output_type -> common.AbsResponse
+	195, // [195:315] is the sub-list for method output_type
+	75,  // [75:195] is the sub-list for method input_type
+	75,  // [75:75] is the sub-list for extension type_name
+	75,  // [75:75] is the sub-list for extension extendee
+	0,   // [0:75] is the sub-list for field type_name
 }
 
 func init() { file_education_proto_init() }
@@ -8241,16 +16273,16 @@ func file_education_proto_init() {
 		return
 	}
 	file_common_proto_init()
-	file_education_proto_msgTypes[42].OneofWrappers = []any{}
+	file_education_proto_msgTypes[51].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_education_proto_rawDesc), len(file_education_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   96,
+			NumMessages:   195,
 			NumExtensions: 0,
-			NumServices:   9,
+			NumServices:   18,
 		},
 		GoTypes:           file_education_proto_goTypes,
 		DependencyIndexes: file_education_proto_depIdxs,
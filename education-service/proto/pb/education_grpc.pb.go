@@ -964,11 +964,16 @@ var RoomService_ServiceDesc = grpc.ServiceDesc{
 }
 
 const (
-	CourseService_CreateCourse_FullMethodName  = "/education.CourseService/CreateCourse"
-	CourseService_GetCourses_FullMethodName    = "/education.CourseService/GetCourses"
-	CourseService_GetCourseById_FullMethodName = "/education.CourseService/GetCourseById"
-	CourseService_UpdateCourse_FullMethodName  = "/education.CourseService/UpdateCourse"
-	CourseService_DeleteCourse_FullMethodName  = "/education.CourseService/DeleteCourse"
+	CourseService_CreateCourse_FullMethodName           = "/education.CourseService/CreateCourse"
+	CourseService_GetCourses_FullMethodName             = "/education.CourseService/GetCourses"
+	CourseService_GetCourseById_FullMethodName          = "/education.CourseService/GetCourseById"
+	CourseService_UpdateCourse_FullMethodName           = "/education.CourseService/UpdateCourse"
+	CourseService_DeleteCourse_FullMethodName           = "/education.CourseService/DeleteCourse"
+	CourseService_GetCourseBilling_FullMethodName       = "/education.CourseService/GetCourseBilling"
+	CourseService_UpdateCourseBilling_FullMethodName    = "/education.CourseService/UpdateCourseBilling"
+	CourseService_ScheduleCoursePrice_FullMethodName    = "/education.CourseService/ScheduleCoursePrice"
+	CourseService_GetCoursePriceHistory_FullMethodName  = "/education.CourseService/GetCoursePriceHistory"
+	CourseService_SetEnrollmentPriceLock_FullMethodName = "/education.CourseService/SetEnrollmentPriceLock"
 )
 
 // CourseServiceClient is the client API for CourseService service.
@@ -982,6 +987,11 @@ type CourseServiceClient interface {
 	GetCourseById(ctx context.Context, in *GetCourseByIdRequest, opts ...grpc.CallOption) (*GetCourseByIdResponse, error)
 	UpdateCourse(ctx context.Context, in *AbsCourse, opts ...grpc.CallOption) (*AbsResponse, error)
 	DeleteCourse(ctx context.Context, in *DeleteAbsRequest, opts ...grpc.CallOption) (*AbsResponse, error)
+	GetCourseBilling(ctx context.Context, in *GetCourseByIdRequest, opts ...grpc.CallOption) (*CourseBilling, error)
+	UpdateCourseBilling(ctx context.Context, in *CourseBilling, opts ...grpc.CallOption) (*AbsResponse, error)
+	ScheduleCoursePrice(ctx context.Context, in *ScheduleCoursePriceRequest, opts ...grpc.CallOption) (*AbsResponse, error)
+	GetCoursePriceHistory(ctx context.Context, in *GetCourseByIdRequest, opts ...grpc.CallOption) (*GetCoursePriceHistoryResponse, error)
+	SetEnrollmentPriceLock(ctx context.Context, in *SetEnrollmentPriceLockRequest, opts ...grpc.CallOption) (*AbsResponse, error)
 }
 
 type courseServiceClient struct {
@@ -1042,6 +1052,56 @@ func (c *courseServiceClient) DeleteCourse(ctx context.Context, in *DeleteAbsReq
 	return out, nil
 }
 
+func (c *courseServiceClient) GetCourseBilling(ctx context.Context, in *GetCourseByIdRequest, opts ...grpc.CallOption) (*CourseBilling, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CourseBilling)
+	err := c.cc.Invoke(ctx, CourseService_GetCourseBilling_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *courseServiceClient) UpdateCourseBilling(ctx context.Context, in *CourseBilling, opts ...grpc.CallOption) (*AbsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AbsResponse)
+	err := c.cc.Invoke(ctx, CourseService_UpdateCourseBilling_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *courseServiceClient) ScheduleCoursePrice(ctx context.Context, in *ScheduleCoursePriceRequest, opts ...grpc.CallOption) (*AbsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AbsResponse)
+	err := c.cc.Invoke(ctx, CourseService_ScheduleCoursePrice_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *courseServiceClient) GetCoursePriceHistory(ctx context.Context, in *GetCourseByIdRequest, opts ...grpc.CallOption) (*GetCoursePriceHistoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetCoursePriceHistoryResponse)
+	err := c.cc.Invoke(ctx, CourseService_GetCoursePriceHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *courseServiceClient) SetEnrollmentPriceLock(ctx context.Context, in *SetEnrollmentPriceLockRequest, opts ...grpc.CallOption) (*AbsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AbsResponse)
+	err := c.cc.Invoke(ctx, CourseService_SetEnrollmentPriceLock_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // CourseServiceServer is the server API for CourseService service.
 // All implementations must embed UnimplementedCourseServiceServer
 // for forward compatibility.
@@ -1053,6 +1113,11 @@ type CourseServiceServer interface {
 	GetCourseById(context.Context, *GetCourseByIdRequest) (*GetCourseByIdResponse, error)
 	UpdateCourse(context.Context, *AbsCourse) (*AbsResponse, error)
 	DeleteCourse(context.Context, *DeleteAbsRequest) (*AbsResponse, error)
+	GetCourseBilling(context.Context, *GetCourseByIdRequest) (*CourseBilling, error)
+	UpdateCourseBilling(context.Context, *CourseBilling) (*AbsResponse, error)
+	ScheduleCoursePrice(context.Context, *ScheduleCoursePriceRequest) (*AbsResponse, error)
+	GetCoursePriceHistory(context.Context, *GetCourseByIdRequest) (*GetCoursePriceHistoryResponse, error)
+	SetEnrollmentPriceLock(context.Context, *SetEnrollmentPriceLockRequest) (*AbsResponse, error)
 	mustEmbedUnimplementedCourseServiceServer()
 }
 
@@ -1078,6 +1143,21 @@ func (UnimplementedCourseServiceServer) UpdateCourse(context.Context, *AbsCourse
 func (UnimplementedCourseServiceServer) DeleteCourse(context.Context, *DeleteAbsRequest) (*AbsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteCourse not implemented")
 }
+func (UnimplementedCourseServiceServer) GetCourseBilling(context.Context, *GetCourseByIdRequest) (*CourseBilling, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCourseBilling not implemented")
+}
+func (UnimplementedCourseServiceServer) UpdateCourseBilling(context.Context, *CourseBilling) (*AbsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateCourseBilling not implemented")
+}
+func (UnimplementedCourseServiceServer) ScheduleCoursePrice(context.Context, *ScheduleCoursePriceRequest) (*AbsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScheduleCoursePrice not implemented")
+}
+func (UnimplementedCourseServiceServer) GetCoursePriceHistory(context.Context, *GetCourseByIdRequest) (*GetCoursePriceHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCoursePriceHistory not implemented")
+}
+func (UnimplementedCourseServiceServer) SetEnrollmentPriceLock(context.Context, *SetEnrollmentPriceLockRequest) (*AbsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetEnrollmentPriceLock not implemented")
+}
 func (UnimplementedCourseServiceServer) mustEmbedUnimplementedCourseServiceServer() {}
 func (UnimplementedCourseServiceServer) testEmbeddedByValue()                       {}
 
@@ -1189,6 +1269,96 @@ func _CourseService_DeleteCourse_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _CourseService_GetCourseBilling_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCourseByIdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CourseServiceServer).GetCourseBilling(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CourseService_GetCourseBilling_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CourseServiceServer).GetCourseBilling(ctx, req.(*GetCourseByIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CourseService_UpdateCourseBilling_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CourseBilling)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CourseServiceServer).UpdateCourseBilling(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CourseService_UpdateCourseBilling_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CourseServiceServer).UpdateCourseBilling(ctx, req.(*CourseBilling))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CourseService_ScheduleCoursePrice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ScheduleCoursePriceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CourseServiceServer).ScheduleCoursePrice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CourseService_ScheduleCoursePrice_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CourseServiceServer).ScheduleCoursePrice(ctx, req.(*ScheduleCoursePriceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CourseService_GetCoursePriceHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCourseByIdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CourseServiceServer).GetCoursePriceHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CourseService_GetCoursePriceHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CourseServiceServer).GetCoursePriceHistory(ctx, req.(*GetCourseByIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CourseService_SetEnrollmentPriceLock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetEnrollmentPriceLockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CourseServiceServer).SetEnrollmentPriceLock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CourseService_SetEnrollmentPriceLock_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CourseServiceServer).SetEnrollmentPriceLock(ctx, req.(*SetEnrollmentPriceLockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // CourseService_ServiceDesc is the grpc.ServiceDesc for CourseService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -1216,6 +1386,26 @@ var CourseService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteCourse",
 			Handler:    _CourseService_DeleteCourse_Handler,
 		},
+		{
+			MethodName: "GetCourseBilling",
+			Handler:    _CourseService_GetCourseBilling_Handler,
+		},
+		{
+			MethodName: "UpdateCourseBilling",
+			Handler:    _CourseService_UpdateCourseBilling_Handler,
+		},
+		{
+			MethodName: "ScheduleCoursePrice",
+			Handler:    _CourseService_ScheduleCoursePrice_Handler,
+		},
+		{
+			MethodName: "GetCoursePriceHistory",
+			Handler:    _CourseService_GetCoursePriceHistory_Handler,
+		},
+		{
+			MethodName: "SetEnrollmentPriceLock",
+			Handler:    _CourseService_SetEnrollmentPriceLock_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "education.proto",
@@ -1232,6 +1422,11 @@ const (
 	GroupService_GetGroupsByTeacherId_FullMethodName          = "/education.GroupService/GetGroupsByTeacherId"
 	GroupService_GetCommonInformationEducation_FullMethodName = "/education.GroupService/GetCommonInformationEducation"
 	GroupService_GetLeftAfterTrialPeriod_FullMethodName       = "/education.GroupService/GetLeftAfterTrialPeriod"
+	GroupService_GetGroupsByIds_FullMethodName                = "/education.GroupService/GetGroupsByIds"
+	GroupService_UpdateGroupBillingMode_FullMethodName        = "/education.GroupService/UpdateGroupBillingMode"
+	GroupService_UpdateGroupMaxStudents_FullMethodName        = "/education.GroupService/UpdateGroupMaxStudents"
+	GroupService_CheckScheduleConflicts_FullMethodName        = "/education.GroupService/CheckScheduleConflicts"
+	GroupService_GetScheduleConflictOverrides_FullMethodName  = "/education.GroupService/GetScheduleConflictOverrides"
 )
 
 // GroupServiceClient is the client API for GroupService service.
@@ -1250,6 +1445,11 @@ type GroupServiceClient interface {
 	GetGroupsByTeacherId(ctx context.Context, in *GetGroupsByTeacherIdRequest, opts ...grpc.CallOption) (*GetGroupsByTeacherResponse, error)
 	GetCommonInformationEducation(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetCommonInformationEducationResponse, error)
 	GetLeftAfterTrialPeriod(ctx context.Context, in *GetLeftAfterTrialPeriodRequest, opts ...grpc.CallOption) (*GetLeftAfterTrialPeriodResponse, error)
+	GetGroupsByIds(ctx context.Context, in *GetGroupsByIdsRequest, opts ...grpc.CallOption) (*GetGroupsByIdsResponse, error)
+	UpdateGroupBillingMode(ctx context.Context, in *UpdateGroupBillingModeRequest, opts ...grpc.CallOption) (*AbsResponse, error)
+	UpdateGroupMaxStudents(ctx context.Context, in *UpdateGroupMaxStudentsRequest, opts ...grpc.CallOption) (*AbsResponse, error)
+	CheckScheduleConflicts(ctx context.Context, in *CheckScheduleConflictsRequest, opts ...grpc.CallOption) (*ScheduleConflicts, error)
+	GetScheduleConflictOverrides(ctx context.Context, in *GetScheduleConflictOverridesRequest, opts ...grpc.CallOption) (*GetScheduleConflictOverridesResponse, error)
 }
 
 type groupServiceClient struct {
@@ -1360,6 +1560,56 @@ func (c *groupServiceClient) GetLeftAfterTrialPeriod(ctx context.Context, in *Ge
 	return out, nil
 }
 
+func (c *groupServiceClient) GetGroupsByIds(ctx context.Context, in *GetGroupsByIdsRequest, opts ...grpc.CallOption) (*GetGroupsByIdsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetGroupsByIdsResponse)
+	err := c.cc.Invoke(ctx, GroupService_GetGroupsByIds_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupServiceClient) UpdateGroupBillingMode(ctx context.Context, in *UpdateGroupBillingModeRequest, opts ...grpc.CallOption) (*AbsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AbsResponse)
+	err := c.cc.Invoke(ctx, GroupService_UpdateGroupBillingMode_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupServiceClient) UpdateGroupMaxStudents(ctx context.Context, in *UpdateGroupMaxStudentsRequest, opts ...grpc.CallOption) (*AbsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AbsResponse)
+	err := c.cc.Invoke(ctx, GroupService_UpdateGroupMaxStudents_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupServiceClient) CheckScheduleConflicts(ctx context.Context, in *CheckScheduleConflictsRequest, opts ...grpc.CallOption) (*ScheduleConflicts, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ScheduleConflicts)
+	err := c.cc.Invoke(ctx, GroupService_CheckScheduleConflicts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupServiceClient) GetScheduleConflictOverrides(ctx context.Context, in *GetScheduleConflictOverridesRequest, opts ...grpc.CallOption) (*GetScheduleConflictOverridesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetScheduleConflictOverridesResponse)
+	err := c.cc.Invoke(ctx, GroupService_GetScheduleConflictOverrides_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // GroupServiceServer is the server API for GroupService service.
 // All implementations must embed UnimplementedGroupServiceServer
 // for forward compatibility.
@@ -1376,6 +1626,11 @@ type GroupServiceServer interface {
 	GetGroupsByTeacherId(context.Context, *GetGroupsByTeacherIdRequest) (*GetGroupsByTeacherResponse, error)
 	GetCommonInformationEducation(context.Context, *emptypb.Empty) (*GetCommonInformationEducationResponse, error)
 	GetLeftAfterTrialPeriod(context.Context, *GetLeftAfterTrialPeriodRequest) (*GetLeftAfterTrialPeriodResponse, error)
+	GetGroupsByIds(context.Context, *GetGroupsByIdsRequest) (*GetGroupsByIdsResponse, error)
+	UpdateGroupBillingMode(context.Context, *UpdateGroupBillingModeRequest) (*AbsResponse, error)
+	UpdateGroupMaxStudents(context.Context, *UpdateGroupMaxStudentsRequest) (*AbsResponse, error)
+	CheckScheduleConflicts(context.Context, *CheckScheduleConflictsRequest) (*ScheduleConflicts, error)
+	GetScheduleConflictOverrides(context.Context, *GetScheduleConflictOverridesRequest) (*GetScheduleConflictOverridesResponse, error)
 	mustEmbedUnimplementedGroupServiceServer()
 }
 
@@ -1416,6 +1671,21 @@ func (UnimplementedGroupServiceServer) GetCommonInformationEducation(context.Con
 func (UnimplementedGroupServiceServer) GetLeftAfterTrialPeriod(context.Context, *GetLeftAfterTrialPeriodRequest) (*GetLeftAfterTrialPeriodResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetLeftAfterTrialPeriod not implemented")
 }
+func (UnimplementedGroupServiceServer) GetGroupsByIds(context.Context, *GetGroupsByIdsRequest) (*GetGroupsByIdsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetGroupsByIds not implemented")
+}
+func (UnimplementedGroupServiceServer) UpdateGroupBillingMode(context.Context, *UpdateGroupBillingModeRequest) (*AbsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateGroupBillingMode not implemented")
+}
+func (UnimplementedGroupServiceServer) UpdateGroupMaxStudents(context.Context, *UpdateGroupMaxStudentsRequest) (*AbsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateGroupMaxStudents not implemented")
+}
+func (UnimplementedGroupServiceServer) CheckScheduleConflicts(context.Context, *CheckScheduleConflictsRequest) (*ScheduleConflicts, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckScheduleConflicts not implemented")
+}
+func (UnimplementedGroupServiceServer) GetScheduleConflictOverrides(context.Context, *GetScheduleConflictOverridesRequest) (*GetScheduleConflictOverridesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetScheduleConflictOverrides not implemented")
+}
 func (UnimplementedGroupServiceServer) mustEmbedUnimplementedGroupServiceServer() {}
 func (UnimplementedGroupServiceServer) testEmbeddedByValue()                      {}
 
@@ -1617,6 +1887,96 @@ func _GroupService_GetLeftAfterTrialPeriod_Handler(srv interface{}, ctx context.
 	return interceptor(ctx, in, info, handler)
 }
 
+func _GroupService_GetGroupsByIds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetGroupsByIdsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupServiceServer).GetGroupsByIds(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GroupService_GetGroupsByIds_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupServiceServer).GetGroupsByIds(ctx, req.(*GetGroupsByIdsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GroupService_UpdateGroupBillingMode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateGroupBillingModeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupServiceServer).UpdateGroupBillingMode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GroupService_UpdateGroupBillingMode_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupServiceServer).UpdateGroupBillingMode(ctx, req.(*UpdateGroupBillingModeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GroupService_UpdateGroupMaxStudents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateGroupMaxStudentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupServiceServer).UpdateGroupMaxStudents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GroupService_UpdateGroupMaxStudents_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupServiceServer).UpdateGroupMaxStudents(ctx, req.(*UpdateGroupMaxStudentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GroupService_CheckScheduleConflicts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckScheduleConflictsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupServiceServer).CheckScheduleConflicts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GroupService_CheckScheduleConflicts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupServiceServer).CheckScheduleConflicts(ctx, req.(*CheckScheduleConflictsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GroupService_GetScheduleConflictOverrides_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetScheduleConflictOverridesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupServiceServer).GetScheduleConflictOverrides(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GroupService_GetScheduleConflictOverrides_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupServiceServer).GetScheduleConflictOverrides(ctx, req.(*GetScheduleConflictOverridesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // GroupService_ServiceDesc is the grpc.ServiceDesc for GroupService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -1645,24 +2005,2245 @@ var GroupService_ServiceDesc = grpc.ServiceDesc{
 			Handler:    _GroupService_GetGroupsByStudentId_Handler,
 		},
 		{
-			MethodName: "UpdateGroup",
-			Handler:    _GroupService_UpdateGroup_Handler,
+			MethodName: "UpdateGroup",
+			Handler:    _GroupService_UpdateGroup_Handler,
+		},
+		{
+			MethodName: "DeleteGroup",
+			Handler:    _GroupService_DeleteGroup_Handler,
+		},
+		{
+			MethodName: "GetGroupsByTeacherId",
+			Handler:    _GroupService_GetGroupsByTeacherId_Handler,
+		},
+		{
+			MethodName: "GetCommonInformationEducation",
+			Handler:    _GroupService_GetCommonInformationEducation_Handler,
+		},
+		{
+			MethodName: "GetLeftAfterTrialPeriod",
+			Handler:    _GroupService_GetLeftAfterTrialPeriod_Handler,
+		},
+		{
+			MethodName: "GetGroupsByIds",
+			Handler:    _GroupService_GetGroupsByIds_Handler,
+		},
+		{
+			MethodName: "UpdateGroupBillingMode",
+			Handler:    _GroupService_UpdateGroupBillingMode_Handler,
+		},
+		{
+			MethodName: "UpdateGroupMaxStudents",
+			Handler:    _GroupService_UpdateGroupMaxStudents_Handler,
+		},
+		{
+			MethodName: "CheckScheduleConflicts",
+			Handler:    _GroupService_CheckScheduleConflicts_Handler,
+		},
+		{
+			MethodName: "GetScheduleConflictOverrides",
+			Handler:    _GroupService_GetScheduleConflictOverrides_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "education.proto",
+}
+
+const (
+	AttendanceService_GetAttendance_FullMethodName                      = "/education.AttendanceService/GetAttendance"
+	AttendanceService_SetAttendance_FullMethodName                      = "/education.AttendanceService/SetAttendance"
+	AttendanceService_SetGroupAttendance_FullMethodName                 = "/education.AttendanceService/SetGroupAttendance"
+	AttendanceService_CalculateTeacherSalaryByAttendance_FullMethodName = "/education.AttendanceService/CalculateTeacherSalaryByAttendance"
+)
+
+// AttendanceServiceClient is the client API for AttendanceService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// attendance service start
+type AttendanceServiceClient interface {
+	GetAttendance(ctx context.Context, in *GetAttendanceRequest, opts ...grpc.CallOption) (*GetAttendanceResponse, error)
+	SetAttendance(ctx context.Context, in *SetAttendanceRequest, opts ...grpc.CallOption) (*AbsResponse, error)
+	SetGroupAttendance(ctx context.Context, in *SetGroupAttendanceRequest, opts ...grpc.CallOption) (*SetGroupAttendanceResponse, error)
+	CalculateTeacherSalaryByAttendance(ctx context.Context, in *CalculateTeacherSalaryRequest, opts ...grpc.CallOption) (*CalculateTeacherSalaryResponse, error)
+}
+
+type attendanceServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewAttendanceServiceClient(cc grpc.ClientConnInterface) AttendanceServiceClient {
+	return &attendanceServiceClient{cc}
+}
+
+func (c *attendanceServiceClient) GetAttendance(ctx context.Context, in *GetAttendanceRequest, opts ...grpc.CallOption) (*GetAttendanceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetAttendanceResponse)
+	err := c.cc.Invoke(ctx, AttendanceService_GetAttendance_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *attendanceServiceClient) SetAttendance(ctx context.Context, in *SetAttendanceRequest, opts ...grpc.CallOption) (*AbsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AbsResponse)
+	err := c.cc.Invoke(ctx, AttendanceService_SetAttendance_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *attendanceServiceClient) SetGroupAttendance(ctx context.Context, in *SetGroupAttendanceRequest, opts ...grpc.CallOption) (*SetGroupAttendanceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetGroupAttendanceResponse)
+	err := c.cc.Invoke(ctx, AttendanceService_SetGroupAttendance_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *attendanceServiceClient) CalculateTeacherSalaryByAttendance(ctx context.Context, in *CalculateTeacherSalaryRequest, opts ...grpc.CallOption) (*CalculateTeacherSalaryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CalculateTeacherSalaryResponse)
+	err := c.cc.Invoke(ctx, AttendanceService_CalculateTeacherSalaryByAttendance_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// AttendanceServiceServer is the server API for AttendanceService service.
+// All implementations must embed UnimplementedAttendanceServiceServer
+// for forward compatibility.
+//
+// attendance service start
+type AttendanceServiceServer interface {
+	GetAttendance(context.Context, *GetAttendanceRequest) (*GetAttendanceResponse, error)
+	SetAttendance(context.Context, *SetAttendanceRequest) (*AbsResponse, error)
+	SetGroupAttendance(context.Context, *SetGroupAttendanceRequest) (*SetGroupAttendanceResponse, error)
+	CalculateTeacherSalaryByAttendance(context.Context, *CalculateTeacherSalaryRequest) (*CalculateTeacherSalaryResponse, error)
+	mustEmbedUnimplementedAttendanceServiceServer()
+}
+
+// UnimplementedAttendanceServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedAttendanceServiceServer struct{}
+
+func (UnimplementedAttendanceServiceServer) GetAttendance(context.Context, *GetAttendanceRequest) (*GetAttendanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAttendance not implemented")
+}
+func (UnimplementedAttendanceServiceServer) SetAttendance(context.Context, *SetAttendanceRequest) (*AbsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetAttendance not implemented")
+}
+func (UnimplementedAttendanceServiceServer) SetGroupAttendance(context.Context, *SetGroupAttendanceRequest) (*SetGroupAttendanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetGroupAttendance not implemented")
+}
+func (UnimplementedAttendanceServiceServer) CalculateTeacherSalaryByAttendance(context.Context, *CalculateTeacherSalaryRequest) (*CalculateTeacherSalaryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CalculateTeacherSalaryByAttendance not implemented")
+}
+func (UnimplementedAttendanceServiceServer) mustEmbedUnimplementedAttendanceServiceServer() {}
+func (UnimplementedAttendanceServiceServer) testEmbeddedByValue()                           {}
+
+// UnsafeAttendanceServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to AttendanceServiceServer will
+// result in compilation errors.
+type UnsafeAttendanceServiceServer interface {
+	mustEmbedUnimplementedAttendanceServiceServer()
+}
+
+func RegisterAttendanceServiceServer(s grpc.ServiceRegistrar, srv AttendanceServiceServer) {
+	// If the following call pancis, it indicates UnimplementedAttendanceServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&AttendanceService_ServiceDesc, srv)
+}
+
+func _AttendanceService_GetAttendance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAttendanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AttendanceServiceServer).GetAttendance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AttendanceService_GetAttendance_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AttendanceServiceServer).GetAttendance(ctx, req.(*GetAttendanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AttendanceService_SetAttendance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetAttendanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AttendanceServiceServer).SetAttendance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AttendanceService_SetAttendance_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AttendanceServiceServer).SetAttendance(ctx, req.(*SetAttendanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AttendanceService_SetGroupAttendance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetGroupAttendanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AttendanceServiceServer).SetGroupAttendance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AttendanceService_SetGroupAttendance_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AttendanceServiceServer).SetGroupAttendance(ctx, req.(*SetGroupAttendanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AttendanceService_CalculateTeacherSalaryByAttendance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CalculateTeacherSalaryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AttendanceServiceServer).CalculateTeacherSalaryByAttendance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AttendanceService_CalculateTeacherSalaryByAttendance_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AttendanceServiceServer).CalculateTeacherSalaryByAttendance(ctx, req.(*CalculateTeacherSalaryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// AttendanceService_ServiceDesc is the grpc.ServiceDesc for AttendanceService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var AttendanceService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "education.AttendanceService",
+	HandlerType: (*AttendanceServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetAttendance",
+			Handler:    _AttendanceService_GetAttendance_Handler,
+		},
+		{
+			MethodName: "SetAttendance",
+			Handler:    _AttendanceService_SetAttendance_Handler,
+		},
+		{
+			MethodName: "SetGroupAttendance",
+			Handler:    _AttendanceService_SetGroupAttendance_Handler,
+		},
+		{
+			MethodName: "CalculateTeacherSalaryByAttendance",
+			Handler:    _AttendanceService_CalculateTeacherSalaryByAttendance_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "education.proto",
+}
+
+const (
+	AttendanceStatusService_CreateAttendanceStatus_FullMethodName = "/education.AttendanceStatusService/CreateAttendanceStatus"
+	AttendanceStatusService_UpdateAttendanceStatus_FullMethodName = "/education.AttendanceStatusService/UpdateAttendanceStatus"
+	AttendanceStatusService_DeleteAttendanceStatus_FullMethodName = "/education.AttendanceStatusService/DeleteAttendanceStatus"
+	AttendanceStatusService_GetAttendanceStatuses_FullMethodName  = "/education.AttendanceStatusService/GetAttendanceStatuses"
+)
+
+// AttendanceStatusServiceClient is the client API for AttendanceStatusService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// attendance status service start
+type AttendanceStatusServiceClient interface {
+	CreateAttendanceStatus(ctx context.Context, in *AbsAttendanceStatus, opts ...grpc.CallOption) (*AbsResponse, error)
+	UpdateAttendanceStatus(ctx context.Context, in *AbsAttendanceStatus, opts ...grpc.CallOption) (*AbsResponse, error)
+	DeleteAttendanceStatus(ctx context.Context, in *DeleteAbsRequest, opts ...grpc.CallOption) (*AbsResponse, error)
+	GetAttendanceStatuses(ctx context.Context, in *GetAttendanceStatusesRequest, opts ...grpc.CallOption) (*GetAttendanceStatusesResponse, error)
+}
+
+type attendanceStatusServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewAttendanceStatusServiceClient(cc grpc.ClientConnInterface) AttendanceStatusServiceClient {
+	return &attendanceStatusServiceClient{cc}
+}
+
+func (c *attendanceStatusServiceClient) CreateAttendanceStatus(ctx context.Context, in *AbsAttendanceStatus, opts ...grpc.CallOption) (*AbsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AbsResponse)
+	err := c.cc.Invoke(ctx, AttendanceStatusService_CreateAttendanceStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *attendanceStatusServiceClient) UpdateAttendanceStatus(ctx context.Context, in *AbsAttendanceStatus, opts ...grpc.CallOption) (*AbsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AbsResponse)
+	err := c.cc.Invoke(ctx, AttendanceStatusService_UpdateAttendanceStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *attendanceStatusServiceClient) DeleteAttendanceStatus(ctx context.Context, in *DeleteAbsRequest, opts ...grpc.CallOption) (*AbsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AbsResponse)
+	err := c.cc.Invoke(ctx, AttendanceStatusService_DeleteAttendanceStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *attendanceStatusServiceClient) GetAttendanceStatuses(ctx context.Context, in *GetAttendanceStatusesRequest, opts ...grpc.CallOption) (*GetAttendanceStatusesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetAttendanceStatusesResponse)
+	err := c.cc.Invoke(ctx, AttendanceStatusService_GetAttendanceStatuses_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// AttendanceStatusServiceServer is the server API for AttendanceStatusService service.
+// All implementations must embed UnimplementedAttendanceStatusServiceServer
+// for forward compatibility.
+//
+// attendance status service start
+type AttendanceStatusServiceServer interface {
+	CreateAttendanceStatus(context.Context, *AbsAttendanceStatus) (*AbsResponse, error)
+	UpdateAttendanceStatus(context.Context, *AbsAttendanceStatus) (*AbsResponse, error)
+	DeleteAttendanceStatus(context.Context, *DeleteAbsRequest) (*AbsResponse, error)
+	GetAttendanceStatuses(context.Context, *GetAttendanceStatusesRequest) (*GetAttendanceStatusesResponse, error)
+	mustEmbedUnimplementedAttendanceStatusServiceServer()
+}
+
+// UnimplementedAttendanceStatusServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedAttendanceStatusServiceServer struct{}
+
+func (UnimplementedAttendanceStatusServiceServer) CreateAttendanceStatus(context.Context, *AbsAttendanceStatus) (*AbsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateAttendanceStatus not implemented")
+}
+func (UnimplementedAttendanceStatusServiceServer) UpdateAttendanceStatus(context.Context, *AbsAttendanceStatus) (*AbsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateAttendanceStatus not implemented")
+}
+func (UnimplementedAttendanceStatusServiceServer) DeleteAttendanceStatus(context.Context, *DeleteAbsRequest) (*AbsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteAttendanceStatus not implemented")
+}
+func (UnimplementedAttendanceStatusServiceServer) GetAttendanceStatuses(context.Context, *GetAttendanceStatusesRequest) (*GetAttendanceStatusesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAttendanceStatuses not implemented")
+}
+func (UnimplementedAttendanceStatusServiceServer) mustEmbedUnimplementedAttendanceStatusServiceServer() {
+}
+func (UnimplementedAttendanceStatusServiceServer) testEmbeddedByValue() {}
+
+// UnsafeAttendanceStatusServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to AttendanceStatusServiceServer will
+// result in compilation errors.
+type UnsafeAttendanceStatusServiceServer interface {
+	mustEmbedUnimplementedAttendanceStatusServiceServer()
+}
+
+func RegisterAttendanceStatusServiceServer(s grpc.ServiceRegistrar, srv AttendanceStatusServiceServer) {
+	// If the following call pancis, it indicates UnimplementedAttendanceStatusServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&AttendanceStatusService_ServiceDesc, srv)
+}
+
+func _AttendanceStatusService_CreateAttendanceStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AbsAttendanceStatus)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AttendanceStatusServiceServer).CreateAttendanceStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AttendanceStatusService_CreateAttendanceStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AttendanceStatusServiceServer).CreateAttendanceStatus(ctx, req.(*AbsAttendanceStatus))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AttendanceStatusService_UpdateAttendanceStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AbsAttendanceStatus)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AttendanceStatusServiceServer).UpdateAttendanceStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AttendanceStatusService_UpdateAttendanceStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AttendanceStatusServiceServer).UpdateAttendanceStatus(ctx, req.(*AbsAttendanceStatus))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AttendanceStatusService_DeleteAttendanceStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteAbsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AttendanceStatusServiceServer).DeleteAttendanceStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AttendanceStatusService_DeleteAttendanceStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AttendanceStatusServiceServer).DeleteAttendanceStatus(ctx, req.(*DeleteAbsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AttendanceStatusService_GetAttendanceStatuses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAttendanceStatusesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AttendanceStatusServiceServer).GetAttendanceStatuses(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AttendanceStatusService_GetAttendanceStatuses_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AttendanceStatusServiceServer).GetAttendanceStatuses(ctx, req.(*GetAttendanceStatusesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// AttendanceStatusService_ServiceDesc is the grpc.ServiceDesc for AttendanceStatusService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var AttendanceStatusService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "education.AttendanceStatusService",
+	HandlerType: (*AttendanceStatusServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateAttendanceStatus",
+			Handler:    _AttendanceStatusService_CreateAttendanceStatus_Handler,
+		},
+		{
+			MethodName: "UpdateAttendanceStatus",
+			Handler:    _AttendanceStatusService_UpdateAttendanceStatus_Handler,
+		},
+		{
+			MethodName: "DeleteAttendanceStatus",
+			Handler:    _AttendanceStatusService_DeleteAttendanceStatus_Handler,
+		},
+		{
+			MethodName: "GetAttendanceStatuses",
+			Handler:    _AttendanceStatusService_GetAttendanceStatuses_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "education.proto",
+}
+
+const (
+	AttendancePolicyService_GetAttendancePolicy_FullMethodName         = "/education.AttendancePolicyService/GetAttendancePolicy"
+	AttendancePolicyService_UpdateAttendancePolicy_FullMethodName      = "/education.AttendancePolicyService/UpdateAttendancePolicy"
+	AttendancePolicyService_RequestAttendanceUnlock_FullMethodName     = "/education.AttendancePolicyService/RequestAttendanceUnlock"
+	AttendancePolicyService_GetAttendanceUnlockRequests_FullMethodName = "/education.AttendancePolicyService/GetAttendanceUnlockRequests"
+	AttendancePolicyService_ReviewAttendanceUnlock_FullMethodName      = "/education.AttendancePolicyService/ReviewAttendanceUnlock"
+)
+
+// AttendancePolicyServiceClient is the client API for AttendancePolicyService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// attendance policy service start
+type AttendancePolicyServiceClient interface {
+	GetAttendancePolicy(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*AttendancePolicy, error)
+	UpdateAttendancePolicy(ctx context.Context, in *AttendancePolicy, opts ...grpc.CallOption) (*AbsResponse, error)
+	RequestAttendanceUnlock(ctx context.Context, in *RequestAttendanceUnlockRequest, opts ...grpc.CallOption) (*AbsResponse, error)
+	GetAttendanceUnlockRequests(ctx context.Context, in *GetAttendanceUnlockRequestsRequest, opts ...grpc.CallOption) (*GetAttendanceUnlockRequestsResponse, error)
+	ReviewAttendanceUnlock(ctx context.Context, in *ReviewAttendanceUnlockRequest, opts ...grpc.CallOption) (*AbsResponse, error)
+}
+
+type attendancePolicyServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewAttendancePolicyServiceClient(cc grpc.ClientConnInterface) AttendancePolicyServiceClient {
+	return &attendancePolicyServiceClient{cc}
+}
+
+func (c *attendancePolicyServiceClient) GetAttendancePolicy(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*AttendancePolicy, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AttendancePolicy)
+	err := c.cc.Invoke(ctx, AttendancePolicyService_GetAttendancePolicy_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *attendancePolicyServiceClient) UpdateAttendancePolicy(ctx context.Context, in *AttendancePolicy, opts ...grpc.CallOption) (*AbsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AbsResponse)
+	err := c.cc.Invoke(ctx, AttendancePolicyService_UpdateAttendancePolicy_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *attendancePolicyServiceClient) RequestAttendanceUnlock(ctx context.Context, in *RequestAttendanceUnlockRequest, opts ...grpc.CallOption) (*AbsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AbsResponse)
+	err := c.cc.Invoke(ctx, AttendancePolicyService_RequestAttendanceUnlock_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *attendancePolicyServiceClient) GetAttendanceUnlockRequests(ctx context.Context, in *GetAttendanceUnlockRequestsRequest, opts ...grpc.CallOption) (*GetAttendanceUnlockRequestsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetAttendanceUnlockRequestsResponse)
+	err := c.cc.Invoke(ctx, AttendancePolicyService_GetAttendanceUnlockRequests_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *attendancePolicyServiceClient) ReviewAttendanceUnlock(ctx context.Context, in *ReviewAttendanceUnlockRequest, opts ...grpc.CallOption) (*AbsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AbsResponse)
+	err := c.cc.Invoke(ctx, AttendancePolicyService_ReviewAttendanceUnlock_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// AttendancePolicyServiceServer is the server API for AttendancePolicyService service.
+// All implementations must embed UnimplementedAttendancePolicyServiceServer
+// for forward compatibility.
+//
+// attendance policy service start
+type AttendancePolicyServiceServer interface {
+	GetAttendancePolicy(context.Context, *emptypb.Empty) (*AttendancePolicy, error)
+	UpdateAttendancePolicy(context.Context, *AttendancePolicy) (*AbsResponse, error)
+	RequestAttendanceUnlock(context.Context, *RequestAttendanceUnlockRequest) (*AbsResponse, error)
+	GetAttendanceUnlockRequests(context.Context, *GetAttendanceUnlockRequestsRequest) (*GetAttendanceUnlockRequestsResponse, error)
+	ReviewAttendanceUnlock(context.Context, *ReviewAttendanceUnlockRequest) (*AbsResponse, error)
+	mustEmbedUnimplementedAttendancePolicyServiceServer()
+}
+
+// UnimplementedAttendancePolicyServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedAttendancePolicyServiceServer struct{}
+
+func (UnimplementedAttendancePolicyServiceServer) GetAttendancePolicy(context.Context, *emptypb.Empty) (*AttendancePolicy, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAttendancePolicy not implemented")
+}
+func (UnimplementedAttendancePolicyServiceServer) UpdateAttendancePolicy(context.Context, *AttendancePolicy) (*AbsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateAttendancePolicy not implemented")
+}
+func (UnimplementedAttendancePolicyServiceServer) RequestAttendanceUnlock(context.Context, *RequestAttendanceUnlockRequest) (*AbsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequestAttendanceUnlock not implemented")
+}
+func (UnimplementedAttendancePolicyServiceServer) GetAttendanceUnlockRequests(context.Context, *GetAttendanceUnlockRequestsRequest) (*GetAttendanceUnlockRequestsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAttendanceUnlockRequests not implemented")
+}
+func (UnimplementedAttendancePolicyServiceServer) ReviewAttendanceUnlock(context.Context, *ReviewAttendanceUnlockRequest) (*AbsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReviewAttendanceUnlock not implemented")
+}
+func (UnimplementedAttendancePolicyServiceServer) mustEmbedUnimplementedAttendancePolicyServiceServer() {
+}
+func (UnimplementedAttendancePolicyServiceServer) testEmbeddedByValue() {}
+
+// UnsafeAttendancePolicyServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to AttendancePolicyServiceServer will
+// result in compilation errors.
+type UnsafeAttendancePolicyServiceServer interface {
+	mustEmbedUnimplementedAttendancePolicyServiceServer()
+}
+
+func RegisterAttendancePolicyServiceServer(s grpc.ServiceRegistrar, srv AttendancePolicyServiceServer) {
+	// If the following call pancis, it indicates UnimplementedAttendancePolicyServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&AttendancePolicyService_ServiceDesc, srv)
+}
+
+func _AttendancePolicyService_GetAttendancePolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AttendancePolicyServiceServer).GetAttendancePolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AttendancePolicyService_GetAttendancePolicy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AttendancePolicyServiceServer).GetAttendancePolicy(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AttendancePolicyService_UpdateAttendancePolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AttendancePolicy)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AttendancePolicyServiceServer).UpdateAttendancePolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AttendancePolicyService_UpdateAttendancePolicy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AttendancePolicyServiceServer).UpdateAttendancePolicy(ctx, req.(*AttendancePolicy))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AttendancePolicyService_RequestAttendanceUnlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RequestAttendanceUnlockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AttendancePolicyServiceServer).RequestAttendanceUnlock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AttendancePolicyService_RequestAttendanceUnlock_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AttendancePolicyServiceServer).RequestAttendanceUnlock(ctx, req.(*RequestAttendanceUnlockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AttendancePolicyService_GetAttendanceUnlockRequests_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAttendanceUnlockRequestsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AttendancePolicyServiceServer).GetAttendanceUnlockRequests(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AttendancePolicyService_GetAttendanceUnlockRequests_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AttendancePolicyServiceServer).GetAttendanceUnlockRequests(ctx, req.(*GetAttendanceUnlockRequestsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AttendancePolicyService_ReviewAttendanceUnlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReviewAttendanceUnlockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AttendancePolicyServiceServer).ReviewAttendanceUnlock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AttendancePolicyService_ReviewAttendanceUnlock_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AttendancePolicyServiceServer).ReviewAttendanceUnlock(ctx, req.(*ReviewAttendanceUnlockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// AttendancePolicyService_ServiceDesc is the grpc.ServiceDesc for AttendancePolicyService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var AttendancePolicyService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "education.AttendancePolicyService",
+	HandlerType: (*AttendancePolicyServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetAttendancePolicy",
+			Handler:    _AttendancePolicyService_GetAttendancePolicy_Handler,
+		},
+		{
+			MethodName: "UpdateAttendancePolicy",
+			Handler:    _AttendancePolicyService_UpdateAttendancePolicy_Handler,
+		},
+		{
+			MethodName: "RequestAttendanceUnlock",
+			Handler:    _AttendancePolicyService_RequestAttendanceUnlock_Handler,
+		},
+		{
+			MethodName: "GetAttendanceUnlockRequests",
+			Handler:    _AttendancePolicyService_GetAttendanceUnlockRequests_Handler,
+		},
+		{
+			MethodName: "ReviewAttendanceUnlock",
+			Handler:    _AttendancePolicyService_ReviewAttendanceUnlock_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "education.proto",
+}
+
+const (
+	CheckInService_SendStudentLoginCode_FullMethodName   = "/education.CheckInService/SendStudentLoginCode"
+	CheckInService_VerifyStudentLoginCode_FullMethodName = "/education.CheckInService/VerifyStudentLoginCode"
+	CheckInService_ValidateStudentSession_FullMethodName = "/education.CheckInService/ValidateStudentSession"
+	CheckInService_GetCheckInCode_FullMethodName         = "/education.CheckInService/GetCheckInCode"
+	CheckInService_CheckIn_FullMethodName                = "/education.CheckInService/CheckIn"
+	CheckInService_GetLessonCheckIns_FullMethodName      = "/education.CheckInService/GetLessonCheckIns"
+	CheckInService_ConfirmCheckIns_FullMethodName        = "/education.CheckInService/ConfirmCheckIns"
+)
+
+// CheckInServiceClient is the client API for CheckInService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type CheckInServiceClient interface {
+	SendStudentLoginCode(ctx context.Context, in *SendStudentLoginCodeRequest, opts ...grpc.CallOption) (*AbsResponse, error)
+	VerifyStudentLoginCode(ctx context.Context, in *VerifyStudentLoginCodeRequest, opts ...grpc.CallOption) (*VerifyStudentLoginCodeResponse, error)
+	ValidateStudentSession(ctx context.Context, in *ValidateStudentSessionRequest, opts ...grpc.CallOption) (*StudentSession, error)
+	GetCheckInCode(ctx context.Context, in *GetCheckInCodeRequest, opts ...grpc.CallOption) (*CheckInCode, error)
+	CheckIn(ctx context.Context, in *CheckInRequest, opts ...grpc.CallOption) (*CheckInResponse, error)
+	GetLessonCheckIns(ctx context.Context, in *GetLessonCheckInsRequest, opts ...grpc.CallOption) (*GetLessonCheckInsResponse, error)
+	ConfirmCheckIns(ctx context.Context, in *ConfirmCheckInsRequest, opts ...grpc.CallOption) (*SetGroupAttendanceResponse, error)
+}
+
+type checkInServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewCheckInServiceClient(cc grpc.ClientConnInterface) CheckInServiceClient {
+	return &checkInServiceClient{cc}
+}
+
+func (c *checkInServiceClient) SendStudentLoginCode(ctx context.Context, in *SendStudentLoginCodeRequest, opts ...grpc.CallOption) (*AbsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AbsResponse)
+	err := c.cc.Invoke(ctx, CheckInService_SendStudentLoginCode_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *checkInServiceClient) VerifyStudentLoginCode(ctx context.Context, in *VerifyStudentLoginCodeRequest, opts ...grpc.CallOption) (*VerifyStudentLoginCodeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(VerifyStudentLoginCodeResponse)
+	err := c.cc.Invoke(ctx, CheckInService_VerifyStudentLoginCode_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *checkInServiceClient) ValidateStudentSession(ctx context.Context, in *ValidateStudentSessionRequest, opts ...grpc.CallOption) (*StudentSession, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StudentSession)
+	err := c.cc.Invoke(ctx, CheckInService_ValidateStudentSession_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *checkInServiceClient) GetCheckInCode(ctx context.Context, in *GetCheckInCodeRequest, opts ...grpc.CallOption) (*CheckInCode, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CheckInCode)
+	err := c.cc.Invoke(ctx, CheckInService_GetCheckInCode_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *checkInServiceClient) CheckIn(ctx context.Context, in *CheckInRequest, opts ...grpc.CallOption) (*CheckInResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CheckInResponse)
+	err := c.cc.Invoke(ctx, CheckInService_CheckIn_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *checkInServiceClient) GetLessonCheckIns(ctx context.Context, in *GetLessonCheckInsRequest, opts ...grpc.CallOption) (*GetLessonCheckInsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetLessonCheckInsResponse)
+	err := c.cc.Invoke(ctx, CheckInService_GetLessonCheckIns_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *checkInServiceClient) ConfirmCheckIns(ctx context.Context, in *ConfirmCheckInsRequest, opts ...grpc.CallOption) (*SetGroupAttendanceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetGroupAttendanceResponse)
+	err := c.cc.Invoke(ctx, CheckInService_ConfirmCheckIns_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// CheckInServiceServer is the server API for CheckInService service.
+// All implementations must embed UnimplementedCheckInServiceServer
+// for forward compatibility.
+type CheckInServiceServer interface {
+	SendStudentLoginCode(context.Context, *SendStudentLoginCodeRequest) (*AbsResponse, error)
+	VerifyStudentLoginCode(context.Context, *VerifyStudentLoginCodeRequest) (*VerifyStudentLoginCodeResponse, error)
+	ValidateStudentSession(context.Context, *ValidateStudentSessionRequest) (*StudentSession, error)
+	GetCheckInCode(context.Context, *GetCheckInCodeRequest) (*CheckInCode, error)
+	CheckIn(context.Context, *CheckInRequest) (*CheckInResponse, error)
+	GetLessonCheckIns(context.Context, *GetLessonCheckInsRequest) (*GetLessonCheckInsResponse, error)
+	ConfirmCheckIns(context.Context, *ConfirmCheckInsRequest) (*SetGroupAttendanceResponse, error)
+	mustEmbedUnimplementedCheckInServiceServer()
+}
+
+// UnimplementedCheckInServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedCheckInServiceServer struct{}
+
+func (UnimplementedCheckInServiceServer) SendStudentLoginCode(context.Context, *SendStudentLoginCodeRequest) (*AbsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendStudentLoginCode not implemented")
+}
+func (UnimplementedCheckInServiceServer) VerifyStudentLoginCode(context.Context, *VerifyStudentLoginCodeRequest) (*VerifyStudentLoginCodeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifyStudentLoginCode not implemented")
+}
+func (UnimplementedCheckInServiceServer) ValidateStudentSession(context.Context, *ValidateStudentSessionRequest) (*StudentSession, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidateStudentSession not implemented")
+}
+func (UnimplementedCheckInServiceServer) GetCheckInCode(context.Context, *GetCheckInCodeRequest) (*CheckInCode, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCheckInCode not implemented")
+}
+func (UnimplementedCheckInServiceServer) CheckIn(context.Context, *CheckInRequest) (*CheckInResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckIn not implemented")
+}
+func (UnimplementedCheckInServiceServer) GetLessonCheckIns(context.Context, *GetLessonCheckInsRequest) (*GetLessonCheckInsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLessonCheckIns not implemented")
+}
+func (UnimplementedCheckInServiceServer) ConfirmCheckIns(context.Context, *ConfirmCheckInsRequest) (*SetGroupAttendanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConfirmCheckIns not implemented")
+}
+func (UnimplementedCheckInServiceServer) mustEmbedUnimplementedCheckInServiceServer() {}
+func (UnimplementedCheckInServiceServer) testEmbeddedByValue()                        {}
+
+// UnsafeCheckInServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to CheckInServiceServer will
+// result in compilation errors.
+type UnsafeCheckInServiceServer interface {
+	mustEmbedUnimplementedCheckInServiceServer()
+}
+
+func RegisterCheckInServiceServer(s grpc.ServiceRegistrar, srv CheckInServiceServer) {
+	// If the following call pancis, it indicates UnimplementedCheckInServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&CheckInService_ServiceDesc, srv)
+}
+
+func _CheckInService_SendStudentLoginCode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SendStudentLoginCodeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CheckInServiceServer).SendStudentLoginCode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CheckInService_SendStudentLoginCode_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CheckInServiceServer).SendStudentLoginCode(ctx, req.(*SendStudentLoginCodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CheckInService_VerifyStudentLoginCode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifyStudentLoginCodeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CheckInServiceServer).VerifyStudentLoginCode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CheckInService_VerifyStudentLoginCode_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CheckInServiceServer).VerifyStudentLoginCode(ctx, req.(*VerifyStudentLoginCodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CheckInService_ValidateStudentSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ValidateStudentSessionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CheckInServiceServer).ValidateStudentSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CheckInService_ValidateStudentSession_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CheckInServiceServer).ValidateStudentSession(ctx, req.(*ValidateStudentSessionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CheckInService_GetCheckInCode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCheckInCodeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CheckInServiceServer).GetCheckInCode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CheckInService_GetCheckInCode_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CheckInServiceServer).GetCheckInCode(ctx, req.(*GetCheckInCodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CheckInService_CheckIn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckInRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CheckInServiceServer).CheckIn(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CheckInService_CheckIn_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CheckInServiceServer).CheckIn(ctx, req.(*CheckInRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CheckInService_GetLessonCheckIns_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLessonCheckInsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CheckInServiceServer).GetLessonCheckIns(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CheckInService_GetLessonCheckIns_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CheckInServiceServer).GetLessonCheckIns(ctx, req.(*GetLessonCheckInsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CheckInService_ConfirmCheckIns_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConfirmCheckInsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CheckInServiceServer).ConfirmCheckIns(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CheckInService_ConfirmCheckIns_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CheckInServiceServer).ConfirmCheckIns(ctx, req.(*ConfirmCheckInsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// CheckInService_ServiceDesc is the grpc.ServiceDesc for CheckInService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var CheckInService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "education.CheckInService",
+	HandlerType: (*CheckInServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "SendStudentLoginCode",
+			Handler:    _CheckInService_SendStudentLoginCode_Handler,
+		},
+		{
+			MethodName: "VerifyStudentLoginCode",
+			Handler:    _CheckInService_VerifyStudentLoginCode_Handler,
+		},
+		{
+			MethodName: "ValidateStudentSession",
+			Handler:    _CheckInService_ValidateStudentSession_Handler,
+		},
+		{
+			MethodName: "GetCheckInCode",
+			Handler:    _CheckInService_GetCheckInCode_Handler,
+		},
+		{
+			MethodName: "CheckIn",
+			Handler:    _CheckInService_CheckIn_Handler,
+		},
+		{
+			MethodName: "GetLessonCheckIns",
+			Handler:    _CheckInService_GetLessonCheckIns_Handler,
+		},
+		{
+			MethodName: "ConfirmCheckIns",
+			Handler:    _CheckInService_ConfirmCheckIns_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "education.proto",
+}
+
+const (
+	AttendanceAnalyticsService_GetAttendanceRates_FullMethodName = "/education.AttendanceAnalyticsService/GetAttendanceRates"
+	AttendanceAnalyticsService_GetAbsenceStreaks_FullMethodName  = "/education.AttendanceAnalyticsService/GetAbsenceStreaks"
+	AttendanceAnalyticsService_GetAttendanceTrend_FullMethodName = "/education.AttendanceAnalyticsService/GetAttendanceTrend"
+	AttendanceAnalyticsService_GetAtRiskPolicy_FullMethodName    = "/education.AttendanceAnalyticsService/GetAtRiskPolicy"
+	AttendanceAnalyticsService_UpdateAtRiskPolicy_FullMethodName = "/education.AttendanceAnalyticsService/UpdateAtRiskPolicy"
+	AttendanceAnalyticsService_GetAtRiskStudents_FullMethodName  = "/education.AttendanceAnalyticsService/GetAtRiskStudents"
+)
+
+// AttendanceAnalyticsServiceClient is the client API for AttendanceAnalyticsService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type AttendanceAnalyticsServiceClient interface {
+	GetAttendanceRates(ctx context.Context, in *GetAttendanceRatesRequest, opts ...grpc.CallOption) (*GetAttendanceRatesResponse, error)
+	GetAbsenceStreaks(ctx context.Context, in *GetAbsenceStreaksRequest, opts ...grpc.CallOption) (*GetAbsenceStreaksResponse, error)
+	GetAttendanceTrend(ctx context.Context, in *GetAttendanceTrendRequest, opts ...grpc.CallOption) (*GetAttendanceTrendResponse, error)
+	GetAtRiskPolicy(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*AtRiskPolicy, error)
+	UpdateAtRiskPolicy(ctx context.Context, in *AtRiskPolicy, opts ...grpc.CallOption) (*AbsResponse, error)
+	GetAtRiskStudents(ctx context.Context, in *GetAtRiskStudentsRequest, opts ...grpc.CallOption) (*GetAtRiskStudentsResponse, error)
+}
+
+type attendanceAnalyticsServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewAttendanceAnalyticsServiceClient(cc grpc.ClientConnInterface) AttendanceAnalyticsServiceClient {
+	return &attendanceAnalyticsServiceClient{cc}
+}
+
+func (c *attendanceAnalyticsServiceClient) GetAttendanceRates(ctx context.Context, in *GetAttendanceRatesRequest, opts ...grpc.CallOption) (*GetAttendanceRatesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetAttendanceRatesResponse)
+	err := c.cc.Invoke(ctx, AttendanceAnalyticsService_GetAttendanceRates_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *attendanceAnalyticsServiceClient) GetAbsenceStreaks(ctx context.Context, in *GetAbsenceStreaksRequest, opts ...grpc.CallOption) (*GetAbsenceStreaksResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetAbsenceStreaksResponse)
+	err := c.cc.Invoke(ctx, AttendanceAnalyticsService_GetAbsenceStreaks_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *attendanceAnalyticsServiceClient) GetAttendanceTrend(ctx context.Context, in *GetAttendanceTrendRequest, opts ...grpc.CallOption) (*GetAttendanceTrendResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetAttendanceTrendResponse)
+	err := c.cc.Invoke(ctx, AttendanceAnalyticsService_GetAttendanceTrend_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *attendanceAnalyticsServiceClient) GetAtRiskPolicy(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*AtRiskPolicy, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AtRiskPolicy)
+	err := c.cc.Invoke(ctx, AttendanceAnalyticsService_GetAtRiskPolicy_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *attendanceAnalyticsServiceClient) UpdateAtRiskPolicy(ctx context.Context, in *AtRiskPolicy, opts ...grpc.CallOption) (*AbsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AbsResponse)
+	err := c.cc.Invoke(ctx, AttendanceAnalyticsService_UpdateAtRiskPolicy_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *attendanceAnalyticsServiceClient) GetAtRiskStudents(ctx context.Context, in *GetAtRiskStudentsRequest, opts ...grpc.CallOption) (*GetAtRiskStudentsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetAtRiskStudentsResponse)
+	err := c.cc.Invoke(ctx, AttendanceAnalyticsService_GetAtRiskStudents_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// AttendanceAnalyticsServiceServer is the server API for AttendanceAnalyticsService service.
+// All implementations must embed UnimplementedAttendanceAnalyticsServiceServer
+// for forward compatibility.
+type AttendanceAnalyticsServiceServer interface {
+	GetAttendanceRates(context.Context, *GetAttendanceRatesRequest) (*GetAttendanceRatesResponse, error)
+	GetAbsenceStreaks(context.Context, *GetAbsenceStreaksRequest) (*GetAbsenceStreaksResponse, error)
+	GetAttendanceTrend(context.Context, *GetAttendanceTrendRequest) (*GetAttendanceTrendResponse, error)
+	GetAtRiskPolicy(context.Context, *emptypb.Empty) (*AtRiskPolicy, error)
+	UpdateAtRiskPolicy(context.Context, *AtRiskPolicy) (*AbsResponse, error)
+	GetAtRiskStudents(context.Context, *GetAtRiskStudentsRequest) (*GetAtRiskStudentsResponse, error)
+	mustEmbedUnimplementedAttendanceAnalyticsServiceServer()
+}
+
+// UnimplementedAttendanceAnalyticsServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedAttendanceAnalyticsServiceServer struct{}
+
+func (UnimplementedAttendanceAnalyticsServiceServer) GetAttendanceRates(context.Context, *GetAttendanceRatesRequest) (*GetAttendanceRatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAttendanceRates not implemented")
+}
+func (UnimplementedAttendanceAnalyticsServiceServer) GetAbsenceStreaks(context.Context, *GetAbsenceStreaksRequest) (*GetAbsenceStreaksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAbsenceStreaks not implemented")
+}
+func (UnimplementedAttendanceAnalyticsServiceServer) GetAttendanceTrend(context.Context, *GetAttendanceTrendRequest) (*GetAttendanceTrendResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAttendanceTrend not implemented")
+}
+func (UnimplementedAttendanceAnalyticsServiceServer) GetAtRiskPolicy(context.Context, *emptypb.Empty) (*AtRiskPolicy, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAtRiskPolicy not implemented")
+}
+func (UnimplementedAttendanceAnalyticsServiceServer) UpdateAtRiskPolicy(context.Context, *AtRiskPolicy) (*AbsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateAtRiskPolicy not implemented")
+}
+func (UnimplementedAttendanceAnalyticsServiceServer) GetAtRiskStudents(context.Context, *GetAtRiskStudentsRequest) (*GetAtRiskStudentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAtRiskStudents not implemented")
+}
+func (UnimplementedAttendanceAnalyticsServiceServer) mustEmbedUnimplementedAttendanceAnalyticsServiceServer() {
+}
+func (UnimplementedAttendanceAnalyticsServiceServer) testEmbeddedByValue() {}
+
+// UnsafeAttendanceAnalyticsServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to AttendanceAnalyticsServiceServer will
+// result in compilation errors.
+type UnsafeAttendanceAnalyticsServiceServer interface {
+	mustEmbedUnimplementedAttendanceAnalyticsServiceServer()
+}
+
+func RegisterAttendanceAnalyticsServiceServer(s grpc.ServiceRegistrar, srv AttendanceAnalyticsServiceServer) {
+	// If the following call pancis, it indicates UnimplementedAttendanceAnalyticsServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&AttendanceAnalyticsService_ServiceDesc, srv)
+}
+
+func _AttendanceAnalyticsService_GetAttendanceRates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAttendanceRatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AttendanceAnalyticsServiceServer).GetAttendanceRates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AttendanceAnalyticsService_GetAttendanceRates_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AttendanceAnalyticsServiceServer).GetAttendanceRates(ctx, req.(*GetAttendanceRatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AttendanceAnalyticsService_GetAbsenceStreaks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAbsenceStreaksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AttendanceAnalyticsServiceServer).GetAbsenceStreaks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AttendanceAnalyticsService_GetAbsenceStreaks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AttendanceAnalyticsServiceServer).GetAbsenceStreaks(ctx, req.(*GetAbsenceStreaksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AttendanceAnalyticsService_GetAttendanceTrend_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAttendanceTrendRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AttendanceAnalyticsServiceServer).GetAttendanceTrend(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AttendanceAnalyticsService_GetAttendanceTrend_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AttendanceAnalyticsServiceServer).GetAttendanceTrend(ctx, req.(*GetAttendanceTrendRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AttendanceAnalyticsService_GetAtRiskPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AttendanceAnalyticsServiceServer).GetAtRiskPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AttendanceAnalyticsService_GetAtRiskPolicy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AttendanceAnalyticsServiceServer).GetAtRiskPolicy(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AttendanceAnalyticsService_UpdateAtRiskPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AtRiskPolicy)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AttendanceAnalyticsServiceServer).UpdateAtRiskPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AttendanceAnalyticsService_UpdateAtRiskPolicy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AttendanceAnalyticsServiceServer).UpdateAtRiskPolicy(ctx, req.(*AtRiskPolicy))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AttendanceAnalyticsService_GetAtRiskStudents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAtRiskStudentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AttendanceAnalyticsServiceServer).GetAtRiskStudents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AttendanceAnalyticsService_GetAtRiskStudents_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AttendanceAnalyticsServiceServer).GetAtRiskStudents(ctx, req.(*GetAtRiskStudentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// AttendanceAnalyticsService_ServiceDesc is the grpc.ServiceDesc for AttendanceAnalyticsService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var AttendanceAnalyticsService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "education.AttendanceAnalyticsService",
+	HandlerType: (*AttendanceAnalyticsServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetAttendanceRates",
+			Handler:    _AttendanceAnalyticsService_GetAttendanceRates_Handler,
+		},
+		{
+			MethodName: "GetAbsenceStreaks",
+			Handler:    _AttendanceAnalyticsService_GetAbsenceStreaks_Handler,
+		},
+		{
+			MethodName: "GetAttendanceTrend",
+			Handler:    _AttendanceAnalyticsService_GetAttendanceTrend_Handler,
+		},
+		{
+			MethodName: "GetAtRiskPolicy",
+			Handler:    _AttendanceAnalyticsService_GetAtRiskPolicy_Handler,
+		},
+		{
+			MethodName: "UpdateAtRiskPolicy",
+			Handler:    _AttendanceAnalyticsService_UpdateAtRiskPolicy_Handler,
+		},
+		{
+			MethodName: "GetAtRiskStudents",
+			Handler:    _AttendanceAnalyticsService_GetAtRiskStudents_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "education.proto",
+}
+
+const (
+	NotificationService_GetNotifications_FullMethodName      = "/education.NotificationService/GetNotifications"
+	NotificationService_MarkNotificationsRead_FullMethodName = "/education.NotificationService/MarkNotificationsRead"
+)
+
+// NotificationServiceClient is the client API for NotificationService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type NotificationServiceClient interface {
+	GetNotifications(ctx context.Context, in *GetNotificationsRequest, opts ...grpc.CallOption) (*GetNotificationsResponse, error)
+	MarkNotificationsRead(ctx context.Context, in *MarkNotificationsReadRequest, opts ...grpc.CallOption) (*AbsResponse, error)
+}
+
+type notificationServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewNotificationServiceClient(cc grpc.ClientConnInterface) NotificationServiceClient {
+	return &notificationServiceClient{cc}
+}
+
+func (c *notificationServiceClient) GetNotifications(ctx context.Context, in *GetNotificationsRequest, opts ...grpc.CallOption) (*GetNotificationsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetNotificationsResponse)
+	err := c.cc.Invoke(ctx, NotificationService_GetNotifications_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notificationServiceClient) MarkNotificationsRead(ctx context.Context, in *MarkNotificationsReadRequest, opts ...grpc.CallOption) (*AbsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AbsResponse)
+	err := c.cc.Invoke(ctx, NotificationService_MarkNotificationsRead_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// NotificationServiceServer is the server API for NotificationService service.
+// All implementations must embed UnimplementedNotificationServiceServer
+// for forward compatibility.
+type NotificationServiceServer interface {
+	GetNotifications(context.Context, *GetNotificationsRequest) (*GetNotificationsResponse, error)
+	MarkNotificationsRead(context.Context, *MarkNotificationsReadRequest) (*AbsResponse, error)
+	mustEmbedUnimplementedNotificationServiceServer()
+}
+
+// UnimplementedNotificationServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedNotificationServiceServer struct{}
+
+func (UnimplementedNotificationServiceServer) GetNotifications(context.Context, *GetNotificationsRequest) (*GetNotificationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetNotifications not implemented")
+}
+func (UnimplementedNotificationServiceServer) MarkNotificationsRead(context.Context, *MarkNotificationsReadRequest) (*AbsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MarkNotificationsRead not implemented")
+}
+func (UnimplementedNotificationServiceServer) mustEmbedUnimplementedNotificationServiceServer() {}
+func (UnimplementedNotificationServiceServer) testEmbeddedByValue()                             {}
+
+// UnsafeNotificationServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to NotificationServiceServer will
+// result in compilation errors.
+type UnsafeNotificationServiceServer interface {
+	mustEmbedUnimplementedNotificationServiceServer()
+}
+
+func RegisterNotificationServiceServer(s grpc.ServiceRegistrar, srv NotificationServiceServer) {
+	// If the following call pancis, it indicates UnimplementedNotificationServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&NotificationService_ServiceDesc, srv)
+}
+
+func _NotificationService_GetNotifications_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetNotificationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotificationServiceServer).GetNotifications(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NotificationService_GetNotifications_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotificationServiceServer).GetNotifications(ctx, req.(*GetNotificationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NotificationService_MarkNotificationsRead_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MarkNotificationsReadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotificationServiceServer).MarkNotificationsRead(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NotificationService_MarkNotificationsRead_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotificationServiceServer).MarkNotificationsRead(ctx, req.(*MarkNotificationsReadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// NotificationService_ServiceDesc is the grpc.ServiceDesc for NotificationService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var NotificationService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "education.NotificationService",
+	HandlerType: (*NotificationServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetNotifications",
+			Handler:    _NotificationService_GetNotifications_Handler,
+		},
+		{
+			MethodName: "MarkNotificationsRead",
+			Handler:    _NotificationService_MarkNotificationsRead_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "education.proto",
+}
+
+const (
+	GroupWaitlistService_GetGroupWaitlist_FullMethodName    = "/education.GroupWaitlistService/GetGroupWaitlist"
+	GroupWaitlistService_MoveWaitlistEntry_FullMethodName   = "/education.GroupWaitlistService/MoveWaitlistEntry"
+	GroupWaitlistService_CancelWaitlistEntry_FullMethodName = "/education.GroupWaitlistService/CancelWaitlistEntry"
+	GroupWaitlistService_AcceptWaitlistOffer_FullMethodName = "/education.GroupWaitlistService/AcceptWaitlistOffer"
+)
+
+// GroupWaitlistServiceClient is the client API for GroupWaitlistService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// group waitlist service start
+type GroupWaitlistServiceClient interface {
+	GetGroupWaitlist(ctx context.Context, in *GetGroupWaitlistRequest, opts ...grpc.CallOption) (*GetGroupWaitlistResponse, error)
+	MoveWaitlistEntry(ctx context.Context, in *MoveWaitlistEntryRequest, opts ...grpc.CallOption) (*AbsResponse, error)
+	CancelWaitlistEntry(ctx context.Context, in *WaitlistEntryRequest, opts ...grpc.CallOption) (*AbsResponse, error)
+	AcceptWaitlistOffer(ctx context.Context, in *AcceptWaitlistOfferRequest, opts ...grpc.CallOption) (*AddToGroupResponse, error)
+}
+
+type groupWaitlistServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewGroupWaitlistServiceClient(cc grpc.ClientConnInterface) GroupWaitlistServiceClient {
+	return &groupWaitlistServiceClient{cc}
+}
+
+func (c *groupWaitlistServiceClient) GetGroupWaitlist(ctx context.Context, in *GetGroupWaitlistRequest, opts ...grpc.CallOption) (*GetGroupWaitlistResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetGroupWaitlistResponse)
+	err := c.cc.Invoke(ctx, GroupWaitlistService_GetGroupWaitlist_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupWaitlistServiceClient) MoveWaitlistEntry(ctx context.Context, in *MoveWaitlistEntryRequest, opts ...grpc.CallOption) (*AbsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AbsResponse)
+	err := c.cc.Invoke(ctx, GroupWaitlistService_MoveWaitlistEntry_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupWaitlistServiceClient) CancelWaitlistEntry(ctx context.Context, in *WaitlistEntryRequest, opts ...grpc.CallOption) (*AbsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AbsResponse)
+	err := c.cc.Invoke(ctx, GroupWaitlistService_CancelWaitlistEntry_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupWaitlistServiceClient) AcceptWaitlistOffer(ctx context.Context, in *AcceptWaitlistOfferRequest, opts ...grpc.CallOption) (*AddToGroupResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AddToGroupResponse)
+	err := c.cc.Invoke(ctx, GroupWaitlistService_AcceptWaitlistOffer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// GroupWaitlistServiceServer is the server API for GroupWaitlistService service.
+// All implementations must embed UnimplementedGroupWaitlistServiceServer
+// for forward compatibility.
+//
+// group waitlist service start
+type GroupWaitlistServiceServer interface {
+	GetGroupWaitlist(context.Context, *GetGroupWaitlistRequest) (*GetGroupWaitlistResponse, error)
+	MoveWaitlistEntry(context.Context, *MoveWaitlistEntryRequest) (*AbsResponse, error)
+	CancelWaitlistEntry(context.Context, *WaitlistEntryRequest) (*AbsResponse, error)
+	AcceptWaitlistOffer(context.Context, *AcceptWaitlistOfferRequest) (*AddToGroupResponse, error)
+	mustEmbedUnimplementedGroupWaitlistServiceServer()
+}
+
+// UnimplementedGroupWaitlistServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedGroupWaitlistServiceServer struct{}
+
+func (UnimplementedGroupWaitlistServiceServer) GetGroupWaitlist(context.Context, *GetGroupWaitlistRequest) (*GetGroupWaitlistResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetGroupWaitlist not implemented")
+}
+func (UnimplementedGroupWaitlistServiceServer) MoveWaitlistEntry(context.Context, *MoveWaitlistEntryRequest) (*AbsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MoveWaitlistEntry not implemented")
+}
+func (UnimplementedGroupWaitlistServiceServer) CancelWaitlistEntry(context.Context, *WaitlistEntryRequest) (*AbsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelWaitlistEntry not implemented")
+}
+func (UnimplementedGroupWaitlistServiceServer) AcceptWaitlistOffer(context.Context, *AcceptWaitlistOfferRequest) (*AddToGroupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcceptWaitlistOffer not implemented")
+}
+func (UnimplementedGroupWaitlistServiceServer) mustEmbedUnimplementedGroupWaitlistServiceServer() {}
+func (UnimplementedGroupWaitlistServiceServer) testEmbeddedByValue()                              {}
+
+// UnsafeGroupWaitlistServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to GroupWaitlistServiceServer will
+// result in compilation errors.
+type UnsafeGroupWaitlistServiceServer interface {
+	mustEmbedUnimplementedGroupWaitlistServiceServer()
+}
+
+func RegisterGroupWaitlistServiceServer(s grpc.ServiceRegistrar, srv GroupWaitlistServiceServer) {
+	// If the following call pancis, it indicates UnimplementedGroupWaitlistServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&GroupWaitlistService_ServiceDesc, srv)
+}
+
+func _GroupWaitlistService_GetGroupWaitlist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetGroupWaitlistRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupWaitlistServiceServer).GetGroupWaitlist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GroupWaitlistService_GetGroupWaitlist_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupWaitlistServiceServer).GetGroupWaitlist(ctx, req.(*GetGroupWaitlistRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GroupWaitlistService_MoveWaitlistEntry_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MoveWaitlistEntryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupWaitlistServiceServer).MoveWaitlistEntry(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GroupWaitlistService_MoveWaitlistEntry_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupWaitlistServiceServer).MoveWaitlistEntry(ctx, req.(*MoveWaitlistEntryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GroupWaitlistService_CancelWaitlistEntry_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WaitlistEntryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupWaitlistServiceServer).CancelWaitlistEntry(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GroupWaitlistService_CancelWaitlistEntry_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupWaitlistServiceServer).CancelWaitlistEntry(ctx, req.(*WaitlistEntryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GroupWaitlistService_AcceptWaitlistOffer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AcceptWaitlistOfferRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupWaitlistServiceServer).AcceptWaitlistOffer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GroupWaitlistService_AcceptWaitlistOffer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupWaitlistServiceServer).AcceptWaitlistOffer(ctx, req.(*AcceptWaitlistOfferRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// GroupWaitlistService_ServiceDesc is the grpc.ServiceDesc for GroupWaitlistService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var GroupWaitlistService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "education.GroupWaitlistService",
+	HandlerType: (*GroupWaitlistServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetGroupWaitlist",
+			Handler:    _GroupWaitlistService_GetGroupWaitlist_Handler,
+		},
+		{
+			MethodName: "MoveWaitlistEntry",
+			Handler:    _GroupWaitlistService_MoveWaitlistEntry_Handler,
+		},
+		{
+			MethodName: "CancelWaitlistEntry",
+			Handler:    _GroupWaitlistService_CancelWaitlistEntry_Handler,
+		},
+		{
+			MethodName: "AcceptWaitlistOffer",
+			Handler:    _GroupWaitlistService_AcceptWaitlistOffer_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "education.proto",
+}
+
+const (
+	HolidayService_CreateHoliday_FullMethodName    = "/education.HolidayService/CreateHoliday"
+	HolidayService_UpdateHoliday_FullMethodName    = "/education.HolidayService/UpdateHoliday"
+	HolidayService_DeleteHoliday_FullMethodName    = "/education.HolidayService/DeleteHoliday"
+	HolidayService_GetHolidays_FullMethodName      = "/education.HolidayService/GetHolidays"
+	HolidayService_GetGroupHolidays_FullMethodName = "/education.HolidayService/GetGroupHolidays"
+)
+
+// HolidayServiceClient is the client API for HolidayService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// holiday service start
+type HolidayServiceClient interface {
+	CreateHoliday(ctx context.Context, in *AbsHoliday, opts ...grpc.CallOption) (*AbsResponse, error)
+	UpdateHoliday(ctx context.Context, in *AbsHoliday, opts ...grpc.CallOption) (*AbsResponse, error)
+	DeleteHoliday(ctx context.Context, in *DeleteAbsRequest, opts ...grpc.CallOption) (*AbsResponse, error)
+	GetHolidays(ctx context.Context, in *GetHolidaysRequest, opts ...grpc.CallOption) (*GetHolidaysResponse, error)
+	GetGroupHolidays(ctx context.Context, in *GetGroupHolidaysRequest, opts ...grpc.CallOption) (*GetGroupHolidaysResponse, error)
+}
+
+type holidayServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewHolidayServiceClient(cc grpc.ClientConnInterface) HolidayServiceClient {
+	return &holidayServiceClient{cc}
+}
+
+func (c *holidayServiceClient) CreateHoliday(ctx context.Context, in *AbsHoliday, opts ...grpc.CallOption) (*AbsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AbsResponse)
+	err := c.cc.Invoke(ctx, HolidayService_CreateHoliday_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *holidayServiceClient) UpdateHoliday(ctx context.Context, in *AbsHoliday, opts ...grpc.CallOption) (*AbsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AbsResponse)
+	err := c.cc.Invoke(ctx, HolidayService_UpdateHoliday_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *holidayServiceClient) DeleteHoliday(ctx context.Context, in *DeleteAbsRequest, opts ...grpc.CallOption) (*AbsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AbsResponse)
+	err := c.cc.Invoke(ctx, HolidayService_DeleteHoliday_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *holidayServiceClient) GetHolidays(ctx context.Context, in *GetHolidaysRequest, opts ...grpc.CallOption) (*GetHolidaysResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetHolidaysResponse)
+	err := c.cc.Invoke(ctx, HolidayService_GetHolidays_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *holidayServiceClient) GetGroupHolidays(ctx context.Context, in *GetGroupHolidaysRequest, opts ...grpc.CallOption) (*GetGroupHolidaysResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetGroupHolidaysResponse)
+	err := c.cc.Invoke(ctx, HolidayService_GetGroupHolidays_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// HolidayServiceServer is the server API for HolidayService service.
+// All implementations must embed UnimplementedHolidayServiceServer
+// for forward compatibility.
+//
+// holiday service start
+type HolidayServiceServer interface {
+	CreateHoliday(context.Context, *AbsHoliday) (*AbsResponse, error)
+	UpdateHoliday(context.Context, *AbsHoliday) (*AbsResponse, error)
+	DeleteHoliday(context.Context, *DeleteAbsRequest) (*AbsResponse, error)
+	GetHolidays(context.Context, *GetHolidaysRequest) (*GetHolidaysResponse, error)
+	GetGroupHolidays(context.Context, *GetGroupHolidaysRequest) (*GetGroupHolidaysResponse, error)
+	mustEmbedUnimplementedHolidayServiceServer()
+}
+
+// UnimplementedHolidayServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedHolidayServiceServer struct{}
+
+func (UnimplementedHolidayServiceServer) CreateHoliday(context.Context, *AbsHoliday) (*AbsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateHoliday not implemented")
+}
+func (UnimplementedHolidayServiceServer) UpdateHoliday(context.Context, *AbsHoliday) (*AbsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateHoliday not implemented")
+}
+func (UnimplementedHolidayServiceServer) DeleteHoliday(context.Context, *DeleteAbsRequest) (*AbsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteHoliday not implemented")
+}
+func (UnimplementedHolidayServiceServer) GetHolidays(context.Context, *GetHolidaysRequest) (*GetHolidaysResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetHolidays not implemented")
+}
+func (UnimplementedHolidayServiceServer) GetGroupHolidays(context.Context, *GetGroupHolidaysRequest) (*GetGroupHolidaysResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetGroupHolidays not implemented")
+}
+func (UnimplementedHolidayServiceServer) mustEmbedUnimplementedHolidayServiceServer() {}
+func (UnimplementedHolidayServiceServer) testEmbeddedByValue()                        {}
+
+// UnsafeHolidayServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to HolidayServiceServer will
+// result in compilation errors.
+type UnsafeHolidayServiceServer interface {
+	mustEmbedUnimplementedHolidayServiceServer()
+}
+
+func RegisterHolidayServiceServer(s grpc.ServiceRegistrar, srv HolidayServiceServer) {
+	// If the following call pancis, it indicates UnimplementedHolidayServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&HolidayService_ServiceDesc, srv)
+}
+
+func _HolidayService_CreateHoliday_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AbsHoliday)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HolidayServiceServer).CreateHoliday(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HolidayService_CreateHoliday_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HolidayServiceServer).CreateHoliday(ctx, req.(*AbsHoliday))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HolidayService_UpdateHoliday_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AbsHoliday)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HolidayServiceServer).UpdateHoliday(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HolidayService_UpdateHoliday_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HolidayServiceServer).UpdateHoliday(ctx, req.(*AbsHoliday))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HolidayService_DeleteHoliday_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteAbsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HolidayServiceServer).DeleteHoliday(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HolidayService_DeleteHoliday_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HolidayServiceServer).DeleteHoliday(ctx, req.(*DeleteAbsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HolidayService_GetHolidays_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetHolidaysRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HolidayServiceServer).GetHolidays(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HolidayService_GetHolidays_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HolidayServiceServer).GetHolidays(ctx, req.(*GetHolidaysRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HolidayService_GetGroupHolidays_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetGroupHolidaysRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HolidayServiceServer).GetGroupHolidays(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HolidayService_GetGroupHolidays_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HolidayServiceServer).GetGroupHolidays(ctx, req.(*GetGroupHolidaysRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// HolidayService_ServiceDesc is the grpc.ServiceDesc for HolidayService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var HolidayService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "education.HolidayService",
+	HandlerType: (*HolidayServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateHoliday",
+			Handler:    _HolidayService_CreateHoliday_Handler,
+		},
+		{
+			MethodName: "UpdateHoliday",
+			Handler:    _HolidayService_UpdateHoliday_Handler,
+		},
+		{
+			MethodName: "DeleteHoliday",
+			Handler:    _HolidayService_DeleteHoliday_Handler,
+		},
+		{
+			MethodName: "GetHolidays",
+			Handler:    _HolidayService_GetHolidays_Handler,
+		},
+		{
+			MethodName: "GetGroupHolidays",
+			Handler:    _HolidayService_GetGroupHolidays_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "education.proto",
+}
+
+const (
+	TimetableService_GetTimetable_FullMethodName             = "/education.TimetableService/GetTimetable"
+	TimetableService_CreateCalendarFeed_FullMethodName       = "/education.TimetableService/CreateCalendarFeed"
+	TimetableService_GetCalendarFeeds_FullMethodName         = "/education.TimetableService/GetCalendarFeeds"
+	TimetableService_RevokeCalendarFeed_FullMethodName       = "/education.TimetableService/RevokeCalendarFeed"
+	TimetableService_GetCalendarFeedTimetable_FullMethodName = "/education.TimetableService/GetCalendarFeedTimetable"
+)
+
+// TimetableServiceClient is the client API for TimetableService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// timetable service start
+type TimetableServiceClient interface {
+	GetTimetable(ctx context.Context, in *GetTimetableRequest, opts ...grpc.CallOption) (*GetTimetableResponse, error)
+	CreateCalendarFeed(ctx context.Context, in *CreateCalendarFeedRequest, opts ...grpc.CallOption) (*CalendarFeed, error)
+	GetCalendarFeeds(ctx context.Context, in *GetCalendarFeedsRequest, opts ...grpc.CallOption) (*GetCalendarFeedsResponse, error)
+	RevokeCalendarFeed(ctx context.Context, in *DeleteAbsRequest, opts ...grpc.CallOption) (*AbsResponse, error)
+	// GetCalendarFeedTimetable is called without a company, the token tells whose lessons to return
+	GetCalendarFeedTimetable(ctx context.Context, in *GetCalendarFeedTimetableRequest, opts ...grpc.CallOption) (*CalendarFeedTimetable, error)
+}
+
+type timetableServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewTimetableServiceClient(cc grpc.ClientConnInterface) TimetableServiceClient {
+	return &timetableServiceClient{cc}
+}
+
+func (c *timetableServiceClient) GetTimetable(ctx context.Context, in *GetTimetableRequest, opts ...grpc.CallOption) (*GetTimetableResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetTimetableResponse)
+	err := c.cc.Invoke(ctx, TimetableService_GetTimetable_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *timetableServiceClient) CreateCalendarFeed(ctx context.Context, in *CreateCalendarFeedRequest, opts ...grpc.CallOption) (*CalendarFeed, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CalendarFeed)
+	err := c.cc.Invoke(ctx, TimetableService_CreateCalendarFeed_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *timetableServiceClient) GetCalendarFeeds(ctx context.Context, in *GetCalendarFeedsRequest, opts ...grpc.CallOption) (*GetCalendarFeedsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetCalendarFeedsResponse)
+	err := c.cc.Invoke(ctx, TimetableService_GetCalendarFeeds_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *timetableServiceClient) RevokeCalendarFeed(ctx context.Context, in *DeleteAbsRequest, opts ...grpc.CallOption) (*AbsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AbsResponse)
+	err := c.cc.Invoke(ctx, TimetableService_RevokeCalendarFeed_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *timetableServiceClient) GetCalendarFeedTimetable(ctx context.Context, in *GetCalendarFeedTimetableRequest, opts ...grpc.CallOption) (*CalendarFeedTimetable, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CalendarFeedTimetable)
+	err := c.cc.Invoke(ctx, TimetableService_GetCalendarFeedTimetable_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// TimetableServiceServer is the server API for TimetableService service.
+// All implementations must embed UnimplementedTimetableServiceServer
+// for forward compatibility.
+//
+// timetable service start
+type TimetableServiceServer interface {
+	GetTimetable(context.Context, *GetTimetableRequest) (*GetTimetableResponse, error)
+	CreateCalendarFeed(context.Context, *CreateCalendarFeedRequest) (*CalendarFeed, error)
+	GetCalendarFeeds(context.Context, *GetCalendarFeedsRequest) (*GetCalendarFeedsResponse, error)
+	RevokeCalendarFeed(context.Context, *DeleteAbsRequest) (*AbsResponse, error)
+	// GetCalendarFeedTimetable is called without a company, the token tells whose lessons to return
+	GetCalendarFeedTimetable(context.Context, *GetCalendarFeedTimetableRequest) (*CalendarFeedTimetable, error)
+	mustEmbedUnimplementedTimetableServiceServer()
+}
+
+// UnimplementedTimetableServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedTimetableServiceServer struct{}
+
+func (UnimplementedTimetableServiceServer) GetTimetable(context.Context, *GetTimetableRequest) (*GetTimetableResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTimetable not implemented")
+}
+func (UnimplementedTimetableServiceServer) CreateCalendarFeed(context.Context, *CreateCalendarFeedRequest) (*CalendarFeed, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateCalendarFeed not implemented")
+}
+func (UnimplementedTimetableServiceServer) GetCalendarFeeds(context.Context, *GetCalendarFeedsRequest) (*GetCalendarFeedsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCalendarFeeds not implemented")
+}
+func (UnimplementedTimetableServiceServer) RevokeCalendarFeed(context.Context, *DeleteAbsRequest) (*AbsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeCalendarFeed not implemented")
+}
+func (UnimplementedTimetableServiceServer) GetCalendarFeedTimetable(context.Context, *GetCalendarFeedTimetableRequest) (*CalendarFeedTimetable, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCalendarFeedTimetable not implemented")
+}
+func (UnimplementedTimetableServiceServer) mustEmbedUnimplementedTimetableServiceServer() {}
+func (UnimplementedTimetableServiceServer) testEmbeddedByValue()                          {}
+
+// UnsafeTimetableServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to TimetableServiceServer will
+// result in compilation errors.
+type UnsafeTimetableServiceServer interface {
+	mustEmbedUnimplementedTimetableServiceServer()
+}
+
+func RegisterTimetableServiceServer(s grpc.ServiceRegistrar, srv TimetableServiceServer) {
+	// If the following call pancis, it indicates UnimplementedTimetableServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&TimetableService_ServiceDesc, srv)
+}
+
+func _TimetableService_GetTimetable_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTimetableRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TimetableServiceServer).GetTimetable(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TimetableService_GetTimetable_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TimetableServiceServer).GetTimetable(ctx, req.(*GetTimetableRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TimetableService_CreateCalendarFeed_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateCalendarFeedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TimetableServiceServer).CreateCalendarFeed(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TimetableService_CreateCalendarFeed_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TimetableServiceServer).CreateCalendarFeed(ctx, req.(*CreateCalendarFeedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TimetableService_GetCalendarFeeds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCalendarFeedsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TimetableServiceServer).GetCalendarFeeds(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TimetableService_GetCalendarFeeds_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TimetableServiceServer).GetCalendarFeeds(ctx, req.(*GetCalendarFeedsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TimetableService_RevokeCalendarFeed_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteAbsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TimetableServiceServer).RevokeCalendarFeed(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TimetableService_RevokeCalendarFeed_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TimetableServiceServer).RevokeCalendarFeed(ctx, req.(*DeleteAbsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TimetableService_GetCalendarFeedTimetable_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCalendarFeedTimetableRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TimetableServiceServer).GetCalendarFeedTimetable(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TimetableService_GetCalendarFeedTimetable_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TimetableServiceServer).GetCalendarFeedTimetable(ctx, req.(*GetCalendarFeedTimetableRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// TimetableService_ServiceDesc is the grpc.ServiceDesc for TimetableService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var TimetableService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "education.TimetableService",
+	HandlerType: (*TimetableServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetTimetable",
+			Handler:    _TimetableService_GetTimetable_Handler,
 		},
 		{
-			MethodName: "DeleteGroup",
-			Handler:    _GroupService_DeleteGroup_Handler,
+			MethodName: "CreateCalendarFeed",
+			Handler:    _TimetableService_CreateCalendarFeed_Handler,
 		},
 		{
-			MethodName: "GetGroupsByTeacherId",
-			Handler:    _GroupService_GetGroupsByTeacherId_Handler,
+			MethodName: "GetCalendarFeeds",
+			Handler:    _TimetableService_GetCalendarFeeds_Handler,
 		},
 		{
-			MethodName: "GetCommonInformationEducation",
-			Handler:    _GroupService_GetCommonInformationEducation_Handler,
+			MethodName: "RevokeCalendarFeed",
+			Handler:    _TimetableService_RevokeCalendarFeed_Handler,
 		},
 		{
-			MethodName: "GetLeftAfterTrialPeriod",
-			Handler:    _GroupService_GetLeftAfterTrialPeriod_Handler,
+			MethodName: "GetCalendarFeedTimetable",
+			Handler:    _TimetableService_GetCalendarFeedTimetable_Handler,
 		},
 	},
 	Streams:  []grpc.StreamDesc{},
@@ -1670,181 +4251,181 @@ var GroupService_ServiceDesc = grpc.ServiceDesc{
 }
 
 const (
-	AttendanceService_GetAttendance_FullMethodName                      = "/education.AttendanceService/GetAttendance"
-	AttendanceService_SetAttendance_FullMethodName                      = "/education.AttendanceService/SetAttendance"
-	AttendanceService_CalculateTeacherSalaryByAttendance_FullMethodName = "/education.AttendanceService/CalculateTeacherSalaryByAttendance"
+	LessonService_GetLessons_FullMethodName    = "/education.LessonService/GetLessons"
+	LessonService_GetLessonById_FullMethodName = "/education.LessonService/GetLessonById"
+	LessonService_UpdateLesson_FullMethodName  = "/education.LessonService/UpdateLesson"
 )
 
-// AttendanceServiceClient is the client API for AttendanceService service.
+// LessonServiceClient is the client API for LessonService service.
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 //
-// attendance service start
-type AttendanceServiceClient interface {
-	GetAttendance(ctx context.Context, in *GetAttendanceRequest, opts ...grpc.CallOption) (*GetAttendanceResponse, error)
-	SetAttendance(ctx context.Context, in *SetAttendanceRequest, opts ...grpc.CallOption) (*AbsResponse, error)
-	CalculateTeacherSalaryByAttendance(ctx context.Context, in *CalculateTeacherSalaryRequest, opts ...grpc.CallOption) (*CalculateTeacherSalaryResponse, error)
+// lesson service start
+type LessonServiceClient interface {
+	GetLessons(ctx context.Context, in *GetLessonsRequest, opts ...grpc.CallOption) (*GetLessonsResponse, error)
+	GetLessonById(ctx context.Context, in *DeleteAbsRequest, opts ...grpc.CallOption) (*AbsLesson, error)
+	UpdateLesson(ctx context.Context, in *UpdateLessonRequest, opts ...grpc.CallOption) (*AbsLesson, error)
 }
 
-type attendanceServiceClient struct {
+type lessonServiceClient struct {
 	cc grpc.ClientConnInterface
 }
 
-func NewAttendanceServiceClient(cc grpc.ClientConnInterface) AttendanceServiceClient {
-	return &attendanceServiceClient{cc}
+func NewLessonServiceClient(cc grpc.ClientConnInterface) LessonServiceClient {
+	return &lessonServiceClient{cc}
 }
 
-func (c *attendanceServiceClient) GetAttendance(ctx context.Context, in *GetAttendanceRequest, opts ...grpc.CallOption) (*GetAttendanceResponse, error) {
+func (c *lessonServiceClient) GetLessons(ctx context.Context, in *GetLessonsRequest, opts ...grpc.CallOption) (*GetLessonsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(GetAttendanceResponse)
-	err := c.cc.Invoke(ctx, AttendanceService_GetAttendance_FullMethodName, in, out, cOpts...)
+	out := new(GetLessonsResponse)
+	err := c.cc.Invoke(ctx, LessonService_GetLessons_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *attendanceServiceClient) SetAttendance(ctx context.Context, in *SetAttendanceRequest, opts ...grpc.CallOption) (*AbsResponse, error) {
+func (c *lessonServiceClient) GetLessonById(ctx context.Context, in *DeleteAbsRequest, opts ...grpc.CallOption) (*AbsLesson, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(AbsResponse)
-	err := c.cc.Invoke(ctx, AttendanceService_SetAttendance_FullMethodName, in, out, cOpts...)
+	out := new(AbsLesson)
+	err := c.cc.Invoke(ctx, LessonService_GetLessonById_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *attendanceServiceClient) CalculateTeacherSalaryByAttendance(ctx context.Context, in *CalculateTeacherSalaryRequest, opts ...grpc.CallOption) (*CalculateTeacherSalaryResponse, error) {
+func (c *lessonServiceClient) UpdateLesson(ctx context.Context, in *UpdateLessonRequest, opts ...grpc.CallOption) (*AbsLesson, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(CalculateTeacherSalaryResponse)
-	err := c.cc.Invoke(ctx, AttendanceService_CalculateTeacherSalaryByAttendance_FullMethodName, in, out, cOpts...)
+	out := new(AbsLesson)
+	err := c.cc.Invoke(ctx, LessonService_UpdateLesson_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-// AttendanceServiceServer is the server API for AttendanceService service.
-// All implementations must embed UnimplementedAttendanceServiceServer
+// LessonServiceServer is the server API for LessonService service.
+// All implementations must embed UnimplementedLessonServiceServer
 // for forward compatibility.
 //
-// attendance service start
-type AttendanceServiceServer interface {
-	GetAttendance(context.Context, *GetAttendanceRequest) (*GetAttendanceResponse, error)
-	SetAttendance(context.Context, *SetAttendanceRequest) (*AbsResponse, error)
-	CalculateTeacherSalaryByAttendance(context.Context, *CalculateTeacherSalaryRequest) (*CalculateTeacherSalaryResponse, error)
-	mustEmbedUnimplementedAttendanceServiceServer()
+// lesson service start
+type LessonServiceServer interface {
+	GetLessons(context.Context, *GetLessonsRequest) (*GetLessonsResponse, error)
+	GetLessonById(context.Context, *DeleteAbsRequest) (*AbsLesson, error)
+	UpdateLesson(context.Context, *UpdateLessonRequest) (*AbsLesson, error)
+	mustEmbedUnimplementedLessonServiceServer()
 }
 
-// UnimplementedAttendanceServiceServer must be embedded to have
+// UnimplementedLessonServiceServer must be embedded to have
 // forward compatible implementations.
 //
 // NOTE: this should be embedded by value instead of pointer to avoid a nil
 // pointer dereference when methods are called.
-type UnimplementedAttendanceServiceServer struct{}
+type UnimplementedLessonServiceServer struct{}
 
-func (UnimplementedAttendanceServiceServer) GetAttendance(context.Context, *GetAttendanceRequest) (*GetAttendanceResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetAttendance not implemented")
+func (UnimplementedLessonServiceServer) GetLessons(context.Context, *GetLessonsRequest) (*GetLessonsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLessons not implemented")
 }
-func (UnimplementedAttendanceServiceServer) SetAttendance(context.Context, *SetAttendanceRequest) (*AbsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SetAttendance not implemented")
+func (UnimplementedLessonServiceServer) GetLessonById(context.Context, *DeleteAbsRequest) (*AbsLesson, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLessonById not implemented")
 }
-func (UnimplementedAttendanceServiceServer) CalculateTeacherSalaryByAttendance(context.Context, *CalculateTeacherSalaryRequest) (*CalculateTeacherSalaryResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CalculateTeacherSalaryByAttendance not implemented")
+func (UnimplementedLessonServiceServer) UpdateLesson(context.Context, *UpdateLessonRequest) (*AbsLesson, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateLesson not implemented")
 }
-func (UnimplementedAttendanceServiceServer) mustEmbedUnimplementedAttendanceServiceServer() {}
-func (UnimplementedAttendanceServiceServer) testEmbeddedByValue()                           {}
+func (UnimplementedLessonServiceServer) mustEmbedUnimplementedLessonServiceServer() {}
+func (UnimplementedLessonServiceServer) testEmbeddedByValue()                       {}
 
-// UnsafeAttendanceServiceServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to AttendanceServiceServer will
+// UnsafeLessonServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to LessonServiceServer will
 // result in compilation errors.
-type UnsafeAttendanceServiceServer interface {
-	mustEmbedUnimplementedAttendanceServiceServer()
+type UnsafeLessonServiceServer interface {
+	mustEmbedUnimplementedLessonServiceServer()
 }
 
-func RegisterAttendanceServiceServer(s grpc.ServiceRegistrar, srv AttendanceServiceServer) {
-	// If the following call pancis, it indicates UnimplementedAttendanceServiceServer was
+func RegisterLessonServiceServer(s grpc.ServiceRegistrar, srv LessonServiceServer) {
+	// If the following call pancis, it indicates UnimplementedLessonServiceServer was
 	// embedded by pointer and is nil.  This will cause panics if an
 	// unimplemented method is ever invoked, so we test this at initialization
 	// time to prevent it from happening at runtime later due to I/O.
 	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
 		t.testEmbeddedByValue()
 	}
-	s.RegisterService(&AttendanceService_ServiceDesc, srv)
+	s.RegisterService(&LessonService_ServiceDesc, srv)
 }
 
-func _AttendanceService_GetAttendance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetAttendanceRequest)
+func _LessonService_GetLessons_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLessonsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AttendanceServiceServer).GetAttendance(ctx, in)
+		return srv.(LessonServiceServer).GetLessons(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: AttendanceService_GetAttendance_FullMethodName,
+		FullMethod: LessonService_GetLessons_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AttendanceServiceServer).GetAttendance(ctx, req.(*GetAttendanceRequest))
+		return srv.(LessonServiceServer).GetLessons(ctx, req.(*GetLessonsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AttendanceService_SetAttendance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SetAttendanceRequest)
+func _LessonService_GetLessonById_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteAbsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AttendanceServiceServer).SetAttendance(ctx, in)
+		return srv.(LessonServiceServer).GetLessonById(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: AttendanceService_SetAttendance_FullMethodName,
+		FullMethod: LessonService_GetLessonById_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AttendanceServiceServer).SetAttendance(ctx, req.(*SetAttendanceRequest))
+		return srv.(LessonServiceServer).GetLessonById(ctx, req.(*DeleteAbsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AttendanceService_CalculateTeacherSalaryByAttendance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CalculateTeacherSalaryRequest)
+func _LessonService_UpdateLesson_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateLessonRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AttendanceServiceServer).CalculateTeacherSalaryByAttendance(ctx, in)
+		return srv.(LessonServiceServer).UpdateLesson(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: AttendanceService_CalculateTeacherSalaryByAttendance_FullMethodName,
+		FullMethod: LessonService_UpdateLesson_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AttendanceServiceServer).CalculateTeacherSalaryByAttendance(ctx, req.(*CalculateTeacherSalaryRequest))
+		return srv.(LessonServiceServer).UpdateLesson(ctx, req.(*UpdateLessonRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-// AttendanceService_ServiceDesc is the grpc.ServiceDesc for AttendanceService service.
+// LessonService_ServiceDesc is the grpc.ServiceDesc for LessonService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
-var AttendanceService_ServiceDesc = grpc.ServiceDesc{
-	ServiceName: "education.AttendanceService",
-	HandlerType: (*AttendanceServiceServer)(nil),
+var LessonService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "education.LessonService",
+	HandlerType: (*LessonServiceServer)(nil),
 	Methods: []grpc.MethodDesc{
 		{
-			MethodName: "GetAttendance",
-			Handler:    _AttendanceService_GetAttendance_Handler,
+			MethodName: "GetLessons",
+			Handler:    _LessonService_GetLessons_Handler,
 		},
 		{
-			MethodName: "SetAttendance",
-			Handler:    _AttendanceService_SetAttendance_Handler,
+			MethodName: "GetLessonById",
+			Handler:    _LessonService_GetLessonById_Handler,
 		},
 		{
-			MethodName: "CalculateTeacherSalaryByAttendance",
-			Handler:    _AttendanceService_CalculateTeacherSalaryByAttendance_Handler,
+			MethodName: "UpdateLesson",
+			Handler:    _LessonService_UpdateLesson_Handler,
 		},
 	},
 	Streams:  []grpc.StreamDesc{},
@@ -1870,6 +4451,12 @@ const (
 	StudentService_ChangeUserBalanceHistory_FullMethodName        = "/education.StudentService/ChangeUserBalanceHistory"
 	StudentService_ChangeUserBalanceHistoryByDebit_FullMethodName = "/education.StudentService/ChangeUserBalanceHistoryByDebit"
 	StudentService_CalculateDiscountSumma_FullMethodName          = "/education.StudentService/CalculateDiscountSumma"
+	StudentService_GetDebtors_FullMethodName                      = "/education.StudentService/GetDebtors"
+	StudentService_SendDebtReminder_FullMethodName                = "/education.StudentService/SendDebtReminder"
+	StudentService_GetStudentsByPhones_FullMethodName             = "/education.StudentService/GetStudentsByPhones"
+	StudentService_GetDiscountContext_FullMethodName              = "/education.StudentService/GetDiscountContext"
+	StudentService_SellLessonPackage_FullMethodName               = "/education.StudentService/SellLessonPackage"
+	StudentService_GetLessonPackages_FullMethodName               = "/education.StudentService/GetLessonPackages"
 )
 
 // StudentServiceClient is the client API for StudentService service.
@@ -1882,7 +4469,7 @@ type StudentServiceClient interface {
 	CreateStudent(ctx context.Context, in *CreateStudentRequest, opts ...grpc.CallOption) (*AbsResponse, error)
 	UpdateStudent(ctx context.Context, in *UpdateStudentRequest, opts ...grpc.CallOption) (*AbsResponse, error)
 	DeleteStudent(ctx context.Context, in *DeleteStudentRequest, opts ...grpc.CallOption) (*AbsResponse, error)
-	AddToGroup(ctx context.Context, in *AddToGroupRequest, opts ...grpc.CallOption) (*AbsResponse, error)
+	AddToGroup(ctx context.Context, in *AddToGroupRequest, opts ...grpc.CallOption) (*AddToGroupResponse, error)
 	GetStudentById(ctx context.Context, in *NoteStudentByAbsRequest, opts ...grpc.CallOption) (*GetStudentByIdResponse, error)
 	GetNoteByStudent(ctx context.Context, in *NoteStudentByAbsRequest, opts ...grpc.CallOption) (*GetNotesByStudent, error)
 	CreateNoteForStudent(ctx context.Context, in *CreateNoteRequest, opts ...grpc.CallOption) (*AbsResponse, error)
@@ -1896,6 +4483,12 @@ type StudentServiceClient interface {
 	ChangeUserBalanceHistory(ctx context.Context, in *ChangeUserBalanceHistoryRequest, opts ...grpc.CallOption) (*AbsResponse, error)
 	ChangeUserBalanceHistoryByDebit(ctx context.Context, in *ChangeUserBalanceHistoryByDebitRequest, opts ...grpc.CallOption) (*AbsResponse, error)
 	CalculateDiscountSumma(ctx context.Context, in *CalculateDiscountSummaRequest, opts ...grpc.CallOption) (*CalculateDiscountResponse, error)
+	GetDebtors(ctx context.Context, in *GetDebtorsRequest, opts ...grpc.CallOption) (*GetDebtorsResponse, error)
+	SendDebtReminder(ctx context.Context, in *SendDebtReminderRequest, opts ...grpc.CallOption) (*AbsResponse, error)
+	GetStudentsByPhones(ctx context.Context, in *GetStudentsByPhonesRequest, opts ...grpc.CallOption) (*GetStudentsByPhonesResponse, error)
+	GetDiscountContext(ctx context.Context, in *GetDiscountContextRequest, opts ...grpc.CallOption) (*GetDiscountContextResponse, error)
+	SellLessonPackage(ctx context.Context, in *SellLessonPackageRequest, opts ...grpc.CallOption) (*AbsResponse, error)
+	GetLessonPackages(ctx context.Context, in *GetLessonPackagesRequest, opts ...grpc.CallOption) (*GetLessonPackagesResponse, error)
 }
 
 type studentServiceClient struct {
@@ -1946,9 +4539,9 @@ func (c *studentServiceClient) DeleteStudent(ctx context.Context, in *DeleteStud
 	return out, nil
 }
 
-func (c *studentServiceClient) AddToGroup(ctx context.Context, in *AddToGroupRequest, opts ...grpc.CallOption) (*AbsResponse, error) {
+func (c *studentServiceClient) AddToGroup(ctx context.Context, in *AddToGroupRequest, opts ...grpc.CallOption) (*AddToGroupResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(AbsResponse)
+	out := new(AddToGroupResponse)
 	err := c.cc.Invoke(ctx, StudentService_AddToGroup_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
@@ -2086,6 +4679,66 @@ func (c *studentServiceClient) CalculateDiscountSumma(ctx context.Context, in *C
 	return out, nil
 }
 
+func (c *studentServiceClient) GetDebtors(ctx context.Context, in *GetDebtorsRequest, opts ...grpc.CallOption) (*GetDebtorsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetDebtorsResponse)
+	err := c.cc.Invoke(ctx, StudentService_GetDebtors_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *studentServiceClient) SendDebtReminder(ctx context.Context, in *SendDebtReminderRequest, opts ...grpc.CallOption) (*AbsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AbsResponse)
+	err := c.cc.Invoke(ctx, StudentService_SendDebtReminder_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *studentServiceClient) GetStudentsByPhones(ctx context.Context, in *GetStudentsByPhonesRequest, opts ...grpc.CallOption) (*GetStudentsByPhonesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetStudentsByPhonesResponse)
+	err := c.cc.Invoke(ctx, StudentService_GetStudentsByPhones_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *studentServiceClient) GetDiscountContext(ctx context.Context, in *GetDiscountContextRequest, opts ...grpc.CallOption) (*GetDiscountContextResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetDiscountContextResponse)
+	err := c.cc.Invoke(ctx, StudentService_GetDiscountContext_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *studentServiceClient) SellLessonPackage(ctx context.Context, in *SellLessonPackageRequest, opts ...grpc.CallOption) (*AbsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AbsResponse)
+	err := c.cc.Invoke(ctx, StudentService_SellLessonPackage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *studentServiceClient) GetLessonPackages(ctx context.Context, in *GetLessonPackagesRequest, opts ...grpc.CallOption) (*GetLessonPackagesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetLessonPackagesResponse)
+	err := c.cc.Invoke(ctx, StudentService_GetLessonPackages_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // StudentServiceServer is the server API for StudentService service.
 // All implementations must embed UnimplementedStudentServiceServer
 // for forward compatibility.
@@ -2096,7 +4749,7 @@ type StudentServiceServer interface {
 	CreateStudent(context.Context, *CreateStudentRequest) (*AbsResponse, error)
 	UpdateStudent(context.Context, *UpdateStudentRequest) (*AbsResponse, error)
 	DeleteStudent(context.Context, *DeleteStudentRequest) (*AbsResponse, error)
-	AddToGroup(context.Context, *AddToGroupRequest) (*AbsResponse, error)
+	AddToGroup(context.Context, *AddToGroupRequest) (*AddToGroupResponse, error)
 	GetStudentById(context.Context, *NoteStudentByAbsRequest) (*GetStudentByIdResponse, error)
 	GetNoteByStudent(context.Context, *NoteStudentByAbsRequest) (*GetNotesByStudent, error)
 	CreateNoteForStudent(context.Context, *CreateNoteRequest) (*AbsResponse, error)
@@ -2110,6 +4763,12 @@ type StudentServiceServer interface {
 	ChangeUserBalanceHistory(context.Context, *ChangeUserBalanceHistoryRequest) (*AbsResponse, error)
 	ChangeUserBalanceHistoryByDebit(context.Context, *ChangeUserBalanceHistoryByDebitRequest) (*AbsResponse, error)
 	CalculateDiscountSumma(context.Context, *CalculateDiscountSummaRequest) (*CalculateDiscountResponse, error)
+	GetDebtors(context.Context, *GetDebtorsRequest) (*GetDebtorsResponse, error)
+	SendDebtReminder(context.Context, *SendDebtReminderRequest) (*AbsResponse, error)
+	GetStudentsByPhones(context.Context, *GetStudentsByPhonesRequest) (*GetStudentsByPhonesResponse, error)
+	GetDiscountContext(context.Context, *GetDiscountContextRequest) (*GetDiscountContextResponse, error)
+	SellLessonPackage(context.Context, *SellLessonPackageRequest) (*AbsResponse, error)
+	GetLessonPackages(context.Context, *GetLessonPackagesRequest) (*GetLessonPackagesResponse, error)
 	mustEmbedUnimplementedStudentServiceServer()
 }
 
@@ -2132,7 +4791,7 @@ func (UnimplementedStudentServiceServer) UpdateStudent(context.Context, *UpdateS
 func (UnimplementedStudentServiceServer) DeleteStudent(context.Context, *DeleteStudentRequest) (*AbsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteStudent not implemented")
 }
-func (UnimplementedStudentServiceServer) AddToGroup(context.Context, *AddToGroupRequest) (*AbsResponse, error) {
+func (UnimplementedStudentServiceServer) AddToGroup(context.Context, *AddToGroupRequest) (*AddToGroupResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AddToGroup not implemented")
 }
 func (UnimplementedStudentServiceServer) GetStudentById(context.Context, *NoteStudentByAbsRequest) (*GetStudentByIdResponse, error) {
@@ -2174,6 +4833,24 @@ func (UnimplementedStudentServiceServer) ChangeUserBalanceHistoryByDebit(context
 func (UnimplementedStudentServiceServer) CalculateDiscountSumma(context.Context, *CalculateDiscountSummaRequest) (*CalculateDiscountResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CalculateDiscountSumma not implemented")
 }
+func (UnimplementedStudentServiceServer) GetDebtors(context.Context, *GetDebtorsRequest) (*GetDebtorsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDebtors not implemented")
+}
+func (UnimplementedStudentServiceServer) SendDebtReminder(context.Context, *SendDebtReminderRequest) (*AbsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendDebtReminder not implemented")
+}
+func (UnimplementedStudentServiceServer) GetStudentsByPhones(context.Context, *GetStudentsByPhonesRequest) (*GetStudentsByPhonesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStudentsByPhones not implemented")
+}
+func (UnimplementedStudentServiceServer) GetDiscountContext(context.Context, *GetDiscountContextRequest) (*GetDiscountContextResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDiscountContext not implemented")
+}
+func (UnimplementedStudentServiceServer) SellLessonPackage(context.Context, *SellLessonPackageRequest) (*AbsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SellLessonPackage not implemented")
+}
+func (UnimplementedStudentServiceServer) GetLessonPackages(context.Context, *GetLessonPackagesRequest) (*GetLessonPackagesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLessonPackages not implemented")
+}
 func (UnimplementedStudentServiceServer) mustEmbedUnimplementedStudentServiceServer() {}
 func (UnimplementedStudentServiceServer) testEmbeddedByValue()                        {}
 
@@ -2519,6 +5196,114 @@ func _StudentService_CalculateDiscountSumma_Handler(srv interface{}, ctx context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _StudentService_GetDebtors_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDebtorsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StudentServiceServer).GetDebtors(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StudentService_GetDebtors_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StudentServiceServer).GetDebtors(ctx, req.(*GetDebtorsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StudentService_SendDebtReminder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SendDebtReminderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StudentServiceServer).SendDebtReminder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StudentService_SendDebtReminder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StudentServiceServer).SendDebtReminder(ctx, req.(*SendDebtReminderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StudentService_GetStudentsByPhones_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetStudentsByPhonesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StudentServiceServer).GetStudentsByPhones(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StudentService_GetStudentsByPhones_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StudentServiceServer).GetStudentsByPhones(ctx, req.(*GetStudentsByPhonesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StudentService_GetDiscountContext_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDiscountContextRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StudentServiceServer).GetDiscountContext(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StudentService_GetDiscountContext_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StudentServiceServer).GetDiscountContext(ctx, req.(*GetDiscountContextRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StudentService_SellLessonPackage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SellLessonPackageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StudentServiceServer).SellLessonPackage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StudentService_SellLessonPackage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StudentServiceServer).SellLessonPackage(ctx, req.(*SellLessonPackageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StudentService_GetLessonPackages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLessonPackagesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StudentServiceServer).GetLessonPackages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StudentService_GetLessonPackages_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StudentServiceServer).GetLessonPackages(ctx, req.(*GetLessonPackagesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // StudentService_ServiceDesc is the grpc.ServiceDesc for StudentService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -2598,6 +5383,30 @@ var StudentService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CalculateDiscountSumma",
 			Handler:    _StudentService_CalculateDiscountSumma_Handler,
 		},
+		{
+			MethodName: "GetDebtors",
+			Handler:    _StudentService_GetDebtors_Handler,
+		},
+		{
+			MethodName: "SendDebtReminder",
+			Handler:    _StudentService_SendDebtReminder_Handler,
+		},
+		{
+			MethodName: "GetStudentsByPhones",
+			Handler:    _StudentService_GetStudentsByPhones_Handler,
+		},
+		{
+			MethodName: "GetDiscountContext",
+			Handler:    _StudentService_GetDiscountContext_Handler,
+		},
+		{
+			MethodName: "SellLessonPackage",
+			Handler:    _StudentService_SellLessonPackage_Handler,
+		},
+		{
+			MethodName: "GetLessonPackages",
+			Handler:    _StudentService_GetLessonPackages_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "education.proto",
@@ -2611,6 +5420,7 @@ const (
 	SmsService_GetSmsTemplate_FullMethodName          = "/education.SmsService/GetSmsTemplate"
 	SmsService_SetSmsTemplate_FullMethodName          = "/education.SmsService/SetSmsTemplate"
 	SmsService_SendSmsDirectly_FullMethodName         = "/education.SmsService/SendSmsDirectly"
+	SmsService_SendStaffSms_FullMethodName            = "/education.SmsService/SendStaffSms"
 )
 
 // SmsServiceClient is the client API for SmsService service.
@@ -2626,6 +5436,7 @@ type SmsServiceClient interface {
 	GetSmsTemplate(ctx context.Context, in *GetSmsTemplateRequest, opts ...grpc.CallOption) (*GetSmsTemplateResponse, error)
 	SetSmsTemplate(ctx context.Context, in *SetSmsTemplateRequest, opts ...grpc.CallOption) (*AbsResponse, error)
 	SendSmsDirectly(ctx context.Context, in *SendSmsDirectlyRequest, opts ...grpc.CallOption) (*AbsResponse, error)
+	SendStaffSms(ctx context.Context, in *SendStaffSmsRequest, opts ...grpc.CallOption) (*AbsResponse, error)
 }
 
 type smsServiceClient struct {
@@ -2706,6 +5517,16 @@ func (c *smsServiceClient) SendSmsDirectly(ctx context.Context, in *SendSmsDirec
 	return out, nil
 }
 
+func (c *smsServiceClient) SendStaffSms(ctx context.Context, in *SendStaffSmsRequest, opts ...grpc.CallOption) (*AbsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AbsResponse)
+	err := c.cc.Invoke(ctx, SmsService_SendStaffSms_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // SmsServiceServer is the server API for SmsService service.
 // All implementations must embed UnimplementedSmsServiceServer
 // for forward compatibility.
@@ -2719,6 +5540,7 @@ type SmsServiceServer interface {
 	GetSmsTemplate(context.Context, *GetSmsTemplateRequest) (*GetSmsTemplateResponse, error)
 	SetSmsTemplate(context.Context, *SetSmsTemplateRequest) (*AbsResponse, error)
 	SendSmsDirectly(context.Context, *SendSmsDirectlyRequest) (*AbsResponse, error)
+	SendStaffSms(context.Context, *SendStaffSmsRequest) (*AbsResponse, error)
 	mustEmbedUnimplementedSmsServiceServer()
 }
 
@@ -2750,6 +5572,9 @@ func (UnimplementedSmsServiceServer) SetSmsTemplate(context.Context, *SetSmsTemp
 func (UnimplementedSmsServiceServer) SendSmsDirectly(context.Context, *SendSmsDirectlyRequest) (*AbsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SendSmsDirectly not implemented")
 }
+func (UnimplementedSmsServiceServer) SendStaffSms(context.Context, *SendStaffSmsRequest) (*AbsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendStaffSms not implemented")
+}
 func (UnimplementedSmsServiceServer) mustEmbedUnimplementedSmsServiceServer() {}
 func (UnimplementedSmsServiceServer) testEmbeddedByValue()                    {}
 
@@ -2897,6 +5722,24 @@ func _SmsService_SendSmsDirectly_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SmsService_SendStaffSms_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SendStaffSmsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SmsServiceServer).SendStaffSms(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SmsService_SendStaffSms_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SmsServiceServer).SendStaffSms(ctx, req.(*SendStaffSmsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // SmsService_ServiceDesc is the grpc.ServiceDesc for SmsService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -2932,6 +5775,10 @@ var SmsService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SendSmsDirectly",
 			Handler:    _SmsService_SendSmsDirectly_Handler,
 		},
+		{
+			MethodName: "SendStaffSms",
+			Handler:    _SmsService_SendStaffSms_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "education.proto",
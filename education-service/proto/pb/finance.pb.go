@@ -9,6 +9,7 @@ package pb
 import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
 	reflect "reflect"
 	sync "sync"
 	unsafe "unsafe"
@@ -21,6 +22,166 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type ApplyDiscountRulesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StudentId     string                 `protobuf:"bytes,1,opt,name=studentId,proto3" json:"studentId,omitempty"`
+	GroupId       string                 `protobuf:"bytes,2,opt,name=groupId,proto3" json:"groupId,omitempty"`
+	PromoCode     string                 `protobuf:"bytes,3,opt,name=promoCode,proto3" json:"promoCode,omitempty"`
+	Date          string                 `protobuf:"bytes,4,opt,name=date,proto3" json:"date,omitempty"`
+	ActionById    string                 `protobuf:"bytes,5,opt,name=actionById,proto3" json:"actionById,omitempty"`
+	ActionByName  string                 `protobuf:"bytes,6,opt,name=actionByName,proto3" json:"actionByName,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApplyDiscountRulesRequest) Reset() {
+	*x = ApplyDiscountRulesRequest{}
+	mi := &file_finance_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApplyDiscountRulesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApplyDiscountRulesRequest) ProtoMessage() {}
+
+func (x *ApplyDiscountRulesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApplyDiscountRulesRequest.ProtoReflect.Descriptor instead.
+func (*ApplyDiscountRulesRequest) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ApplyDiscountRulesRequest) GetStudentId() string {
+	if x != nil {
+		return x.StudentId
+	}
+	return ""
+}
+
+func (x *ApplyDiscountRulesRequest) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+func (x *ApplyDiscountRulesRequest) GetPromoCode() string {
+	if x != nil {
+		return x.PromoCode
+	}
+	return ""
+}
+
+func (x *ApplyDiscountRulesRequest) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *ApplyDiscountRulesRequest) GetActionById() string {
+	if x != nil {
+		return x.ActionById
+	}
+	return ""
+}
+
+func (x *ApplyDiscountRulesRequest) GetActionByName() string {
+	if x != nil {
+		return x.ActionByName
+	}
+	return ""
+}
+
+type ApplyDiscountRulesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Applied       bool                   `protobuf:"varint,1,opt,name=applied,proto3" json:"applied,omitempty"`
+	RuleId        string                 `protobuf:"bytes,2,opt,name=ruleId,proto3" json:"ruleId,omitempty"`
+	RuleName      string                 `protobuf:"bytes,3,opt,name=ruleName,proto3" json:"ruleName,omitempty"`
+	Discount      float64                `protobuf:"fixed64,4,opt,name=discount,proto3" json:"discount,omitempty"`
+	Message       string                 `protobuf:"bytes,5,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApplyDiscountRulesResponse) Reset() {
+	*x = ApplyDiscountRulesResponse{}
+	mi := &file_finance_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApplyDiscountRulesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApplyDiscountRulesResponse) ProtoMessage() {}
+
+func (x *ApplyDiscountRulesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApplyDiscountRulesResponse.ProtoReflect.Descriptor instead.
+func (*ApplyDiscountRulesResponse) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ApplyDiscountRulesResponse) GetApplied() bool {
+	if x != nil {
+		return x.Applied
+	}
+	return false
+}
+
+func (x *ApplyDiscountRulesResponse) GetRuleId() string {
+	if x != nil {
+		return x.RuleId
+	}
+	return ""
+}
+
+func (x *ApplyDiscountRulesResponse) GetRuleName() string {
+	if x != nil {
+		return x.RuleName
+	}
+	return ""
+}
+
+func (x *ApplyDiscountRulesResponse) GetDiscount() float64 {
+	if x != nil {
+		return x.Discount
+	}
+	return 0
+}
+
+func (x *ApplyDiscountRulesResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
 type GetDiscountByStudentIdResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Amount        string                 `protobuf:"bytes,1,opt,name=amount,proto3" json:"amount,omitempty"`
@@ -32,7 +193,7 @@ type GetDiscountByStudentIdResponse struct {
 
 func (x *GetDiscountByStudentIdResponse) Reset() {
 	*x = GetDiscountByStudentIdResponse{}
-	mi := &file_finance_proto_msgTypes[0]
+	mi := &file_finance_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -44,7 +205,7 @@ func (x *GetDiscountByStudentIdResponse) String() string {
 func (*GetDiscountByStudentIdResponse) ProtoMessage() {}
 
 func (x *GetDiscountByStudentIdResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_finance_proto_msgTypes[0]
+	mi := &file_finance_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -57,7 +218,7 @@ func (x *GetDiscountByStudentIdResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetDiscountByStudentIdResponse.ProtoReflect.Descriptor instead.
 func (*GetDiscountByStudentIdResponse) Descriptor() ([]byte, []int) {
-	return file_finance_proto_rawDescGZIP(), []int{0}
+	return file_finance_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *GetDiscountByStudentIdResponse) GetAmount() string {
@@ -91,7 +252,7 @@ type GetDiscountByStudentIdRequest struct {
 
 func (x *GetDiscountByStudentIdRequest) Reset() {
 	*x = GetDiscountByStudentIdRequest{}
-	mi := &file_finance_proto_msgTypes[1]
+	mi := &file_finance_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -103,7 +264,7 @@ func (x *GetDiscountByStudentIdRequest) String() string {
 func (*GetDiscountByStudentIdRequest) ProtoMessage() {}
 
 func (x *GetDiscountByStudentIdRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_finance_proto_msgTypes[1]
+	mi := &file_finance_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -116,7 +277,7 @@ func (x *GetDiscountByStudentIdRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetDiscountByStudentIdRequest.ProtoReflect.Descriptor instead.
 func (*GetDiscountByStudentIdRequest) Descriptor() ([]byte, []int) {
-	return file_finance_proto_rawDescGZIP(), []int{1}
+	return file_finance_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *GetDiscountByStudentIdRequest) GetStudentId() string {
@@ -133,6 +294,163 @@ func (x *GetDiscountByStudentIdRequest) GetGroupId() string {
 	return ""
 }
 
+type GetDiscountsByStudentIdsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GroupId       string                 `protobuf:"bytes,1,opt,name=groupId,proto3" json:"groupId,omitempty"`
+	StudentIds    []string               `protobuf:"bytes,2,rep,name=studentIds,proto3" json:"studentIds,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDiscountsByStudentIdsRequest) Reset() {
+	*x = GetDiscountsByStudentIdsRequest{}
+	mi := &file_finance_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDiscountsByStudentIdsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDiscountsByStudentIdsRequest) ProtoMessage() {}
+
+func (x *GetDiscountsByStudentIdsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDiscountsByStudentIdsRequest.ProtoReflect.Descriptor instead.
+func (*GetDiscountsByStudentIdsRequest) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetDiscountsByStudentIdsRequest) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+func (x *GetDiscountsByStudentIdsRequest) GetStudentIds() []string {
+	if x != nil {
+		return x.StudentIds
+	}
+	return nil
+}
+
+type StudentGroupDiscount struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StudentId     string                 `protobuf:"bytes,1,opt,name=studentId,proto3" json:"studentId,omitempty"`
+	Amount        string                 `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	DiscountOwner string                 `protobuf:"bytes,3,opt,name=discountOwner,proto3" json:"discountOwner,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StudentGroupDiscount) Reset() {
+	*x = StudentGroupDiscount{}
+	mi := &file_finance_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StudentGroupDiscount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StudentGroupDiscount) ProtoMessage() {}
+
+func (x *StudentGroupDiscount) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StudentGroupDiscount.ProtoReflect.Descriptor instead.
+func (*StudentGroupDiscount) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *StudentGroupDiscount) GetStudentId() string {
+	if x != nil {
+		return x.StudentId
+	}
+	return ""
+}
+
+func (x *StudentGroupDiscount) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *StudentGroupDiscount) GetDiscountOwner() string {
+	if x != nil {
+		return x.DiscountOwner
+	}
+	return ""
+}
+
+type GetDiscountsByStudentIdsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// only students with a discount running now are listed
+	Discounts     []*StudentGroupDiscount `protobuf:"bytes,1,rep,name=discounts,proto3" json:"discounts,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDiscountsByStudentIdsResponse) Reset() {
+	*x = GetDiscountsByStudentIdsResponse{}
+	mi := &file_finance_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDiscountsByStudentIdsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDiscountsByStudentIdsResponse) ProtoMessage() {}
+
+func (x *GetDiscountsByStudentIdsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDiscountsByStudentIdsResponse.ProtoReflect.Descriptor instead.
+func (*GetDiscountsByStudentIdsResponse) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *GetDiscountsByStudentIdsResponse) GetDiscounts() []*StudentGroupDiscount {
+	if x != nil {
+		return x.Discounts
+	}
+	return nil
+}
+
 type PaymentAddRequest struct {
 	state                protoimpl.MessageState `protogen:"open.v1"`
 	Comment              string                 `protobuf:"bytes,1,opt,name=comment,proto3" json:"comment,omitempty"`
@@ -151,7 +469,7 @@ type PaymentAddRequest struct {
 
 func (x *PaymentAddRequest) Reset() {
 	*x = PaymentAddRequest{}
-	mi := &file_finance_proto_msgTypes[2]
+	mi := &file_finance_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -163,7 +481,7 @@ func (x *PaymentAddRequest) String() string {
 func (*PaymentAddRequest) ProtoMessage() {}
 
 func (x *PaymentAddRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_finance_proto_msgTypes[2]
+	mi := &file_finance_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -176,7 +494,7 @@ func (x *PaymentAddRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PaymentAddRequest.ProtoReflect.Descriptor instead.
 func (*PaymentAddRequest) Descriptor() ([]byte, []int) {
-	return file_finance_proto_rawDescGZIP(), []int{2}
+	return file_finance_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *PaymentAddRequest) GetComment() string {
@@ -249,28 +567,30 @@ func (x *PaymentAddRequest) GetStudentconditiondate() string {
 	return ""
 }
 
-type DeleteTeacherSalaryRequest struct {
+type PaymentReturnRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	TeacherId     string                 `protobuf:"bytes,1,opt,name=teacherId,proto3" json:"teacherId,omitempty"`
+	PaymentId     string                 `protobuf:"bytes,1,opt,name=paymentId,proto3" json:"paymentId,omitempty"`
+	ActionById    string                 `protobuf:"bytes,2,opt,name=actionById,proto3" json:"actionById,omitempty"`
+	ActionByName  string                 `protobuf:"bytes,3,opt,name=actionByName,proto3" json:"actionByName,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteTeacherSalaryRequest) Reset() {
-	*x = DeleteTeacherSalaryRequest{}
-	mi := &file_finance_proto_msgTypes[3]
+func (x *PaymentReturnRequest) Reset() {
+	*x = PaymentReturnRequest{}
+	mi := &file_finance_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteTeacherSalaryRequest) String() string {
+func (x *PaymentReturnRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteTeacherSalaryRequest) ProtoMessage() {}
+func (*PaymentReturnRequest) ProtoMessage() {}
 
-func (x *DeleteTeacherSalaryRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_finance_proto_msgTypes[3]
+func (x *PaymentReturnRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -281,9 +601,68 @@ func (x *DeleteTeacherSalaryRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteTeacherSalaryRequest.ProtoReflect.Descriptor instead.
-func (*DeleteTeacherSalaryRequest) Descriptor() ([]byte, []int) {
-	return file_finance_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use PaymentReturnRequest.ProtoReflect.Descriptor instead.
+func (*PaymentReturnRequest) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *PaymentReturnRequest) GetPaymentId() string {
+	if x != nil {
+		return x.PaymentId
+	}
+	return ""
+}
+
+func (x *PaymentReturnRequest) GetActionById() string {
+	if x != nil {
+		return x.ActionById
+	}
+	return ""
+}
+
+func (x *PaymentReturnRequest) GetActionByName() string {
+	if x != nil {
+		return x.ActionByName
+	}
+	return ""
+}
+
+type DeleteTeacherSalaryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TeacherId     string                 `protobuf:"bytes,1,opt,name=teacherId,proto3" json:"teacherId,omitempty"`
+	Id            string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteTeacherSalaryRequest) Reset() {
+	*x = DeleteTeacherSalaryRequest{}
+	mi := &file_finance_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteTeacherSalaryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteTeacherSalaryRequest) ProtoMessage() {}
+
+func (x *DeleteTeacherSalaryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteTeacherSalaryRequest.ProtoReflect.Descriptor instead.
+func (*DeleteTeacherSalaryRequest) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *DeleteTeacherSalaryRequest) GetTeacherId() string {
@@ -293,19 +672,30 @@ func (x *DeleteTeacherSalaryRequest) GetTeacherId() string {
 	return ""
 }
 
+func (x *DeleteTeacherSalaryRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
 type AbsGetTeachersSalary struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	TeacherId     string                 `protobuf:"bytes,1,opt,name=teacherId,proto3" json:"teacherId,omitempty"`
 	Type          string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
 	Amount        int32                  `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`
 	TeacherName   string                 `protobuf:"bytes,4,opt,name=teacherName,proto3" json:"teacherName,omitempty"`
+	Id            string                 `protobuf:"bytes,5,opt,name=id,proto3" json:"id,omitempty"`
+	CourseId      string                 `protobuf:"bytes,6,opt,name=courseId,proto3" json:"courseId,omitempty"`
+	GroupId       string                 `protobuf:"bytes,7,opt,name=groupId,proto3" json:"groupId,omitempty"`
+	EffectiveFrom string                 `protobuf:"bytes,8,opt,name=effectiveFrom,proto3" json:"effectiveFrom,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *AbsGetTeachersSalary) Reset() {
 	*x = AbsGetTeachersSalary{}
-	mi := &file_finance_proto_msgTypes[4]
+	mi := &file_finance_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -317,7 +707,7 @@ func (x *AbsGetTeachersSalary) String() string {
 func (*AbsGetTeachersSalary) ProtoMessage() {}
 
 func (x *AbsGetTeachersSalary) ProtoReflect() protoreflect.Message {
-	mi := &file_finance_proto_msgTypes[4]
+	mi := &file_finance_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -330,7 +720,7 @@ func (x *AbsGetTeachersSalary) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AbsGetTeachersSalary.ProtoReflect.Descriptor instead.
 func (*AbsGetTeachersSalary) Descriptor() ([]byte, []int) {
-	return file_finance_proto_rawDescGZIP(), []int{4}
+	return file_finance_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *AbsGetTeachersSalary) GetTeacherId() string {
@@ -361,18 +751,348 @@ func (x *AbsGetTeachersSalary) GetTeacherName() string {
 	return ""
 }
 
+func (x *AbsGetTeachersSalary) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *AbsGetTeachersSalary) GetCourseId() string {
+	if x != nil {
+		return x.CourseId
+	}
+	return ""
+}
+
+func (x *AbsGetTeachersSalary) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+func (x *AbsGetTeachersSalary) GetEffectiveFrom() string {
+	if x != nil {
+		return x.EffectiveFrom
+	}
+	return ""
+}
+
+type ResolveTeacherSalaryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TeacherId     string                 `protobuf:"bytes,1,opt,name=teacherId,proto3" json:"teacherId,omitempty"`
+	GroupId       string                 `protobuf:"bytes,2,opt,name=groupId,proto3" json:"groupId,omitempty"`
+	CourseId      string                 `protobuf:"bytes,3,opt,name=courseId,proto3" json:"courseId,omitempty"`
+	Date          string                 `protobuf:"bytes,4,opt,name=date,proto3" json:"date,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResolveTeacherSalaryRequest) Reset() {
+	*x = ResolveTeacherSalaryRequest{}
+	mi := &file_finance_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResolveTeacherSalaryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResolveTeacherSalaryRequest) ProtoMessage() {}
+
+func (x *ResolveTeacherSalaryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResolveTeacherSalaryRequest.ProtoReflect.Descriptor instead.
+func (*ResolveTeacherSalaryRequest) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ResolveTeacherSalaryRequest) GetTeacherId() string {
+	if x != nil {
+		return x.TeacherId
+	}
+	return ""
+}
+
+func (x *ResolveTeacherSalaryRequest) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+func (x *ResolveTeacherSalaryRequest) GetCourseId() string {
+	if x != nil {
+		return x.CourseId
+	}
+	return ""
+}
+
+func (x *ResolveTeacherSalaryRequest) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+type CheckPeriodRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Date          string                 `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckPeriodRequest) Reset() {
+	*x = CheckPeriodRequest{}
+	mi := &file_finance_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckPeriodRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckPeriodRequest) ProtoMessage() {}
+
+func (x *CheckPeriodRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckPeriodRequest.ProtoReflect.Descriptor instead.
+func (*CheckPeriodRequest) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *CheckPeriodRequest) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+type CheckPeriodResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	IsClosed      bool                   `protobuf:"varint,1,opt,name=isClosed,proto3" json:"isClosed,omitempty"`
+	Period        string                 `protobuf:"bytes,2,opt,name=period,proto3" json:"period,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckPeriodResponse) Reset() {
+	*x = CheckPeriodResponse{}
+	mi := &file_finance_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckPeriodResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckPeriodResponse) ProtoMessage() {}
+
+func (x *CheckPeriodResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckPeriodResponse.ProtoReflect.Descriptor instead.
+func (*CheckPeriodResponse) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *CheckPeriodResponse) GetIsClosed() bool {
+	if x != nil {
+		return x.IsClosed
+	}
+	return false
+}
+
+func (x *CheckPeriodResponse) GetPeriod() string {
+	if x != nil {
+		return x.Period
+	}
+	return ""
+}
+
+type ChargeInstallmentsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StudentId     string                 `protobuf:"bytes,1,opt,name=studentId,proto3" json:"studentId,omitempty"`
+	GroupId       string                 `protobuf:"bytes,2,opt,name=groupId,proto3" json:"groupId,omitempty"`
+	Date          string                 `protobuf:"bytes,3,opt,name=date,proto3" json:"date,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChargeInstallmentsRequest) Reset() {
+	*x = ChargeInstallmentsRequest{}
+	mi := &file_finance_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChargeInstallmentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChargeInstallmentsRequest) ProtoMessage() {}
+
+func (x *ChargeInstallmentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChargeInstallmentsRequest.ProtoReflect.Descriptor instead.
+func (*ChargeInstallmentsRequest) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ChargeInstallmentsRequest) GetStudentId() string {
+	if x != nil {
+		return x.StudentId
+	}
+	return ""
+}
+
+func (x *ChargeInstallmentsRequest) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+func (x *ChargeInstallmentsRequest) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+type ChargeInstallmentsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	HasPlan       bool                   `protobuf:"varint,1,opt,name=hasPlan,proto3" json:"hasPlan,omitempty"`
+	ChargedCount  int32                  `protobuf:"varint,2,opt,name=chargedCount,proto3" json:"chargedCount,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChargeInstallmentsResponse) Reset() {
+	*x = ChargeInstallmentsResponse{}
+	mi := &file_finance_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChargeInstallmentsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChargeInstallmentsResponse) ProtoMessage() {}
+
+func (x *ChargeInstallmentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_finance_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChargeInstallmentsResponse.ProtoReflect.Descriptor instead.
+func (*ChargeInstallmentsResponse) Descriptor() ([]byte, []int) {
+	return file_finance_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ChargeInstallmentsResponse) GetHasPlan() bool {
+	if x != nil {
+		return x.HasPlan
+	}
+	return false
+}
+
+func (x *ChargeInstallmentsResponse) GetChargedCount() int32 {
+	if x != nil {
+		return x.ChargedCount
+	}
+	return 0
+}
+
 var File_finance_proto protoreflect.FileDescriptor
 
 const file_finance_proto_rawDesc = "" +
 	"\n" +
-	"\rfinance.proto\x12\afinance\x1a\fcommon.proto\"v\n" +
+	"\rfinance.proto\x12\afinance\x1a\fcommon.proto\x1a\x1bgoogle/protobuf/empty.proto\"\xc9\x01\n" +
+	"\x19ApplyDiscountRulesRequest\x12\x1c\n" +
+	"\tstudentId\x18\x01 \x01(\tR\tstudentId\x12\x18\n" +
+	"\agroupId\x18\x02 \x01(\tR\agroupId\x12\x1c\n" +
+	"\tpromoCode\x18\x03 \x01(\tR\tpromoCode\x12\x12\n" +
+	"\x04date\x18\x04 \x01(\tR\x04date\x12\x1e\n" +
+	"\n" +
+	"actionById\x18\x05 \x01(\tR\n" +
+	"actionById\x12\"\n" +
+	"\factionByName\x18\x06 \x01(\tR\factionByName\"\xa0\x01\n" +
+	"\x1aApplyDiscountRulesResponse\x12\x18\n" +
+	"\aapplied\x18\x01 \x01(\bR\aapplied\x12\x16\n" +
+	"\x06ruleId\x18\x02 \x01(\tR\x06ruleId\x12\x1a\n" +
+	"\bruleName\x18\x03 \x01(\tR\bruleName\x12\x1a\n" +
+	"\bdiscount\x18\x04 \x01(\x01R\bdiscount\x12\x18\n" +
+	"\amessage\x18\x05 \x01(\tR\amessage\"v\n" +
 	"\x1eGetDiscountByStudentIdResponse\x12\x16\n" +
 	"\x06amount\x18\x01 \x01(\tR\x06amount\x12\x16\n" +
 	"\x06isHave\x18\x02 \x01(\bR\x06isHave\x12$\n" +
 	"\rdiscountOwner\x18\x03 \x01(\tR\rdiscountOwner\"W\n" +
 	"\x1dGetDiscountByStudentIdRequest\x12\x1c\n" +
 	"\tstudentId\x18\x01 \x01(\tR\tstudentId\x12\x18\n" +
-	"\agroupId\x18\x02 \x01(\tR\agroupId\"\xa9\x02\n" +
+	"\agroupId\x18\x02 \x01(\tR\agroupId\"[\n" +
+	"\x1fGetDiscountsByStudentIdsRequest\x12\x18\n" +
+	"\agroupId\x18\x01 \x01(\tR\agroupId\x12\x1e\n" +
+	"\n" +
+	"studentIds\x18\x02 \x03(\tR\n" +
+	"studentIds\"r\n" +
+	"\x14StudentGroupDiscount\x12\x1c\n" +
+	"\tstudentId\x18\x01 \x01(\tR\tstudentId\x12\x16\n" +
+	"\x06amount\x18\x02 \x01(\tR\x06amount\x12$\n" +
+	"\rdiscountOwner\x18\x03 \x01(\tR\rdiscountOwner\"_\n" +
+	" GetDiscountsByStudentIdsResponse\x12;\n" +
+	"\tdiscounts\x18\x01 \x03(\v2\x1d.finance.StudentGroupDiscountR\tdiscounts\"\xa9\x02\n" +
 	"\x11PaymentAddRequest\x12\x18\n" +
 	"\acomment\x18\x01 \x01(\tR\acomment\x12\x12\n" +
 	"\x04date\x18\x02 \x01(\tR\x04date\x12\x16\n" +
@@ -386,21 +1106,64 @@ const file_finance_proto_rawDesc = "" +
 	"\factionByName\x18\b \x01(\tR\factionByName\x12\x18\n" +
 	"\agroupId\x18\t \x01(\tR\agroupId\x122\n" +
 	"\x14studentconditiondate\x18\n" +
-	" \x01(\tR\x14studentconditiondate\":\n" +
+	" \x01(\tR\x14studentconditiondate\"x\n" +
+	"\x14PaymentReturnRequest\x12\x1c\n" +
+	"\tpaymentId\x18\x01 \x01(\tR\tpaymentId\x12\x1e\n" +
+	"\n" +
+	"actionById\x18\x02 \x01(\tR\n" +
+	"actionById\x12\"\n" +
+	"\factionByName\x18\x03 \x01(\tR\factionByName\"J\n" +
 	"\x1aDeleteTeacherSalaryRequest\x12\x1c\n" +
-	"\tteacherId\x18\x01 \x01(\tR\tteacherId\"\x82\x01\n" +
+	"\tteacherId\x18\x01 \x01(\tR\tteacherId\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\tR\x02id\"\xee\x01\n" +
 	"\x14AbsGetTeachersSalary\x12\x1c\n" +
 	"\tteacherId\x18\x01 \x01(\tR\tteacherId\x12\x12\n" +
 	"\x04type\x18\x02 \x01(\tR\x04type\x12\x16\n" +
 	"\x06amount\x18\x03 \x01(\x05R\x06amount\x12 \n" +
-	"\vteacherName\x18\x04 \x01(\tR\vteacherName2|\n" +
+	"\vteacherName\x18\x04 \x01(\tR\vteacherName\x12\x0e\n" +
+	"\x02id\x18\x05 \x01(\tR\x02id\x12\x1a\n" +
+	"\bcourseId\x18\x06 \x01(\tR\bcourseId\x12\x18\n" +
+	"\agroupId\x18\a \x01(\tR\agroupId\x12$\n" +
+	"\reffectiveFrom\x18\b \x01(\tR\reffectiveFrom\"\x85\x01\n" +
+	"\x1bResolveTeacherSalaryRequest\x12\x1c\n" +
+	"\tteacherId\x18\x01 \x01(\tR\tteacherId\x12\x18\n" +
+	"\agroupId\x18\x02 \x01(\tR\agroupId\x12\x1a\n" +
+	"\bcourseId\x18\x03 \x01(\tR\bcourseId\x12\x12\n" +
+	"\x04date\x18\x04 \x01(\tR\x04date\"(\n" +
+	"\x12CheckPeriodRequest\x12\x12\n" +
+	"\x04date\x18\x01 \x01(\tR\x04date\"I\n" +
+	"\x13CheckPeriodResponse\x12\x1a\n" +
+	"\bisClosed\x18\x01 \x01(\bR\bisClosed\x12\x16\n" +
+	"\x06period\x18\x02 \x01(\tR\x06period\"g\n" +
+	"\x19ChargeInstallmentsRequest\x12\x1c\n" +
+	"\tstudentId\x18\x01 \x01(\tR\tstudentId\x12\x18\n" +
+	"\agroupId\x18\x02 \x01(\tR\agroupId\x12\x12\n" +
+	"\x04date\x18\x03 \x01(\tR\x04date\"Z\n" +
+	"\x1aChargeInstallmentsResponse\x12\x18\n" +
+	"\ahasPlan\x18\x01 \x01(\bR\ahasPlan\x12\"\n" +
+	"\fchargedCount\x18\x02 \x01(\x05R\fchargedCount2\xcc\x02\n" +
 	"\x0fDiscountService\x12i\n" +
-	"\x16GetDiscountByStudentId\x12&.finance.GetDiscountByStudentIdRequest\x1a'.finance.GetDiscountByStudentIdResponse2O\n" +
+	"\x16GetDiscountByStudentId\x12&.finance.GetDiscountByStudentIdRequest\x1a'.finance.GetDiscountByStudentIdResponse\x12o\n" +
+	"\x18GetDiscountsByStudentIds\x12(.finance.GetDiscountsByStudentIdsRequest\x1a).finance.GetDiscountsByStudentIdsResponse\x12]\n" +
+	"\x12ApplyDiscountRules\x12\".finance.ApplyDiscountRulesRequest\x1a#.finance.ApplyDiscountRulesResponse2\xd7\x01\n" +
 	"\x0ePaymentService\x12=\n" +
 	"\n" +
-	"PaymentAdd\x12\x1a.finance.PaymentAddRequest\x1a\x13.common.AbsResponse2y\n" +
+	"PaymentAdd\x12\x1a.finance.PaymentAddRequest\x1a\x13.common.AbsResponse\x12A\n" +
+	"\x0ePaymentTakeOff\x12\x1a.finance.PaymentAddRequest\x1a\x13.common.AbsResponse\x12C\n" +
+	"\rPaymentReturn\x12\x1d.finance.PaymentReturnRequest\x1a\x13.common.AbsResponse2\xd6\x01\n" +
 	"\x14TeacherSalaryService\x12a\n" +
-	"\x1bGetTeacherSalaryByTeacherID\x12#.finance.DeleteTeacherSalaryRequest\x1a\x1d.finance.AbsGetTeachersSalaryB\n" +
+	"\x1bGetTeacherSalaryByTeacherID\x12#.finance.DeleteTeacherSalaryRequest\x1a\x1d.finance.AbsGetTeachersSalary\x12[\n" +
+	"\x14ResolveTeacherSalary\x12$.finance.ResolveTeacherSalaryRequest\x1a\x1d.finance.AbsGetTeachersSalary2c\n" +
+	"\x17AccountingPeriodService\x12H\n" +
+	"\vCheckPeriod\x12\x1b.finance.CheckPeriodRequest\x1a\x1c.finance.CheckPeriodResponse2\x82\x02\n" +
+	"\x12InstallmentService\x12]\n" +
+	"\x12ChargeInstallments\x12\".finance.ChargeInstallmentsRequest\x1a#.finance.ChargeInstallmentsResponse\x12G\n" +
+	"\x18ApplyInstallmentLateFees\x12\x16.google.protobuf.Empty\x1a\x13.common.AbsResponse\x12D\n" +
+	"\x15ChargeDueInstallments\x12\x16.google.protobuf.Empty\x1a\x13.common.AbsResponse2R\n" +
+	"\x11CollectionService\x12=\n" +
+	"\x0eRunCollections\x12\x16.google.protobuf.Empty\x1a\x13.common.AbsResponse2V\n" +
+	"\x0eExpenseService\x12D\n" +
+	"\x15PostRecurringExpenses\x12\x16.google.protobuf.Empty\x1a\x13.common.AbsResponseB\n" +
 	"Z\bproto/pbb\x06proto3"
 
 var (
@@ -415,27 +1178,62 @@ func file_finance_proto_rawDescGZIP() []byte {
 	return file_finance_proto_rawDescData
 }
 
-var file_finance_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_finance_proto_msgTypes = make([]protoimpl.MessageInfo, 16)
 var file_finance_proto_goTypes = []any{
-	(*GetDiscountByStudentIdResponse)(nil), // 0: finance.GetDiscountByStudentIdResponse
-	(*GetDiscountByStudentIdRequest)(nil),  // 1: finance.GetDiscountByStudentIdRequest
-	(*PaymentAddRequest)(nil),              // 2: finance.PaymentAddRequest
-	(*DeleteTeacherSalaryRequest)(nil),     // 3: finance.DeleteTeacherSalaryRequest
-	(*AbsGetTeachersSalary)(nil),           // 4: finance.AbsGetTeachersSalary
-	(*AbsResponse)(nil),                    // 5: common.AbsResponse
+	(*ApplyDiscountRulesRequest)(nil),        // 0: finance.ApplyDiscountRulesRequest
+	(*ApplyDiscountRulesResponse)(nil),       // 1: finance.ApplyDiscountRulesResponse
+	(*GetDiscountByStudentIdResponse)(nil),   // 2: finance.GetDiscountByStudentIdResponse
+	(*GetDiscountByStudentIdRequest)(nil),    // 3: finance.GetDiscountByStudentIdRequest
+	(*GetDiscountsByStudentIdsRequest)(nil),  // 4: finance.GetDiscountsByStudentIdsRequest
+	(*StudentGroupDiscount)(nil),             // 5: finance.StudentGroupDiscount
+	(*GetDiscountsByStudentIdsResponse)(nil), // 6: finance.GetDiscountsByStudentIdsResponse
+	(*PaymentAddRequest)(nil),                // 7: finance.PaymentAddRequest
+	(*PaymentReturnRequest)(nil),             // 8: finance.PaymentReturnRequest
+	(*DeleteTeacherSalaryRequest)(nil),       // 9: finance.DeleteTeacherSalaryRequest
+	(*AbsGetTeachersSalary)(nil),             // 10: finance.AbsGetTeachersSalary
+	(*ResolveTeacherSalaryRequest)(nil),      // 11: finance.ResolveTeacherSalaryRequest
+	(*CheckPeriodRequest)(nil),               // 12: finance.CheckPeriodRequest
+	(*CheckPeriodResponse)(nil),              // 13: finance.CheckPeriodResponse
+	(*ChargeInstallmentsRequest)(nil),        // 14: finance.ChargeInstallmentsRequest
+	(*ChargeInstallmentsResponse)(nil),       // 15: finance.ChargeInstallmentsResponse
+	(*emptypb.Empty)(nil),                    // 16: google.protobuf.Empty
+	(*AbsResponse)(nil),                      // 17: common.AbsResponse
 }
 var file_finance_proto_depIdxs = []int32{
-	1, // 0: finance.DiscountService.GetDiscountByStudentId:input_type -> finance.GetDiscountByStudentIdRequest
-	2, // 1: finance.PaymentService.PaymentAdd:input_type -> finance.PaymentAddRequest
-	3, // 2: finance.TeacherSalaryService.GetTeacherSalaryByTeacherID:input_type -> finance.DeleteTeacherSalaryRequest
-	0, // 3: finance.DiscountService.GetDiscountByStudentId:output_type -> finance.GetDiscountByStudentIdResponse
-	5, // 4: finance.PaymentService.PaymentAdd:output_type -> common.AbsResponse
-	4, // 5: finance.TeacherSalaryService.GetTeacherSalaryByTeacherID:output_type -> finance.AbsGetTeachersSalary
-	3, // [3:6] is the sub-list for method output_type
-	0, // [0:3] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+	5,  // 0: finance.GetDiscountsByStudentIdsResponse.discounts:type_name -> finance.StudentGroupDiscount
+	3,  // 1: finance.DiscountService.GetDiscountByStudentId:input_type -> finance.GetDiscountByStudentIdRequest
+	4,  // 2: finance.DiscountService.GetDiscountsByStudentIds:input_type -> finance.GetDiscountsByStudentIdsRequest
+	0,  // 3: finance.DiscountService.ApplyDiscountRules:input_type -> finance.ApplyDiscountRulesRequest
+	7,  // 4: finance.PaymentService.PaymentAdd:input_type -> finance.PaymentAddRequest
+	7,  // 5: finance.PaymentService.PaymentTakeOff:input_type -> finance.PaymentAddRequest
+	8,  // 6: finance.PaymentService.PaymentReturn:input_type -> finance.PaymentReturnRequest
+	9,  // 7: finance.TeacherSalaryService.GetTeacherSalaryByTeacherID:input_type -> finance.DeleteTeacherSalaryRequest
+	11, // 8: finance.TeacherSalaryService.ResolveTeacherSalary:input_type -> finance.ResolveTeacherSalaryRequest
+	12, // 9: finance.AccountingPeriodService.CheckPeriod:input_type -> finance.CheckPeriodRequest
+	14, // 10: finance.InstallmentService.ChargeInstallments:input_type -> finance.ChargeInstallmentsRequest
+	16, // 11: finance.InstallmentService.ApplyInstallmentLateFees:input_type -> google.protobuf.Empty
+	16, // 12: finance.InstallmentService.ChargeDueInstallments:input_type -> google.protobuf.Empty
+	16, // 13: finance.CollectionService.RunCollections:input_type -> google.protobuf.Empty
+	16, // 14: finance.ExpenseService.PostRecurringExpenses:input_type -> google.protobuf.Empty
+	2,  // 15: finance.DiscountService.GetDiscountByStudentId:output_type -> finance.GetDiscountByStudentIdResponse
+	6,  // 16: finance.DiscountService.GetDiscountsByStudentIds:output_type -> finance.GetDiscountsByStudentIdsResponse
+	1,  // 17: finance.DiscountService.ApplyDiscountRules:output_type -> finance.ApplyDiscountRulesResponse
+	17, // 18: finance.PaymentService.PaymentAdd:output_type -> common.AbsResponse
+	17, // 19: finance.PaymentService.PaymentTakeOff:output_type -> common.AbsResponse
+	17, // 20: finance.PaymentService.PaymentReturn:output_type -> common.AbsResponse
+	10, // 21: finance.TeacherSalaryService.GetTeacherSalaryByTeacherID:output_type -> finance.AbsGetTeachersSalary
+	10, // 22: finance.TeacherSalaryService.ResolveTeacherSalary:output_type -> finance.AbsGetTeachersSalary
+	13, // 23: finance.AccountingPeriodService.CheckPeriod:output_type -> finance.CheckPeriodResponse
+	15, // 24: finance.InstallmentService.ChargeInstallments:output_type -> finance.ChargeInstallmentsResponse
+	17, // 25: finance.InstallmentService.ApplyInstallmentLateFees:output_type -> common.AbsResponse
+	17, // 26: finance.InstallmentService.ChargeDueInstallments:output_type -> common.AbsResponse
+	17, // 27: finance.CollectionService.RunCollections:output_type -> common.AbsResponse
+	17, // 28: finance.ExpenseService.PostRecurringExpenses:output_type -> common.AbsResponse
+	15, // [15:29] is the sub-list for method output_type
+	1,  // [1:15] is the sub-list for method input_type
+	1,  // [1:1] is the sub-list for extension type_name
+	1,  // [1:1] is the sub-list for extension extendee
+	0,  // [0:1] is the sub-list for field type_name
 }
 
 func init() { file_finance_proto_init() }
@@ -450,9 +1248,9 @@ func file_finance_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_finance_proto_rawDesc), len(file_finance_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   5,
+			NumMessages:   16,
 			NumExtensions: 0,
-			NumServices:   3,
+			NumServices:   7,
 		},
 		GoTypes:           file_finance_proto_goTypes,
 		DependencyIndexes: file_finance_proto_depIdxs,
@@ -11,6 +11,7 @@ import (
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
 )
 
 // This is a compile-time assertion to ensure that this generated file
@@ -19,7 +20,9 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	DiscountService_GetDiscountByStudentId_FullMethodName = "/finance.DiscountService/GetDiscountByStudentId"
+	DiscountService_GetDiscountByStudentId_FullMethodName   = "/finance.DiscountService/GetDiscountByStudentId"
+	DiscountService_GetDiscountsByStudentIds_FullMethodName = "/finance.DiscountService/GetDiscountsByStudentIds"
+	DiscountService_ApplyDiscountRules_FullMethodName       = "/finance.DiscountService/ApplyDiscountRules"
 )
 
 // DiscountServiceClient is the client API for DiscountService service.
@@ -29,6 +32,8 @@ const (
 // discount service start
 type DiscountServiceClient interface {
 	GetDiscountByStudentId(ctx context.Context, in *GetDiscountByStudentIdRequest, opts ...grpc.CallOption) (*GetDiscountByStudentIdResponse, error)
+	GetDiscountsByStudentIds(ctx context.Context, in *GetDiscountsByStudentIdsRequest, opts ...grpc.CallOption) (*GetDiscountsByStudentIdsResponse, error)
+	ApplyDiscountRules(ctx context.Context, in *ApplyDiscountRulesRequest, opts ...grpc.CallOption) (*ApplyDiscountRulesResponse, error)
 }
 
 type discountServiceClient struct {
@@ -49,6 +54,26 @@ func (c *discountServiceClient) GetDiscountByStudentId(ctx context.Context, in *
 	return out, nil
 }
 
+func (c *discountServiceClient) GetDiscountsByStudentIds(ctx context.Context, in *GetDiscountsByStudentIdsRequest, opts ...grpc.CallOption) (*GetDiscountsByStudentIdsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetDiscountsByStudentIdsResponse)
+	err := c.cc.Invoke(ctx, DiscountService_GetDiscountsByStudentIds_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *discountServiceClient) ApplyDiscountRules(ctx context.Context, in *ApplyDiscountRulesRequest, opts ...grpc.CallOption) (*ApplyDiscountRulesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ApplyDiscountRulesResponse)
+	err := c.cc.Invoke(ctx, DiscountService_ApplyDiscountRules_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // DiscountServiceServer is the server API for DiscountService service.
 // All implementations must embed UnimplementedDiscountServiceServer
 // for forward compatibility.
@@ -56,6 +81,8 @@ func (c *discountServiceClient) GetDiscountByStudentId(ctx context.Context, in *
 // discount service start
 type DiscountServiceServer interface {
 	GetDiscountByStudentId(context.Context, *GetDiscountByStudentIdRequest) (*GetDiscountByStudentIdResponse, error)
+	GetDiscountsByStudentIds(context.Context, *GetDiscountsByStudentIdsRequest) (*GetDiscountsByStudentIdsResponse, error)
+	ApplyDiscountRules(context.Context, *ApplyDiscountRulesRequest) (*ApplyDiscountRulesResponse, error)
 	mustEmbedUnimplementedDiscountServiceServer()
 }
 
@@ -69,6 +96,12 @@ type UnimplementedDiscountServiceServer struct{}
 func (UnimplementedDiscountServiceServer) GetDiscountByStudentId(context.Context, *GetDiscountByStudentIdRequest) (*GetDiscountByStudentIdResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetDiscountByStudentId not implemented")
 }
+func (UnimplementedDiscountServiceServer) GetDiscountsByStudentIds(context.Context, *GetDiscountsByStudentIdsRequest) (*GetDiscountsByStudentIdsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDiscountsByStudentIds not implemented")
+}
+func (UnimplementedDiscountServiceServer) ApplyDiscountRules(context.Context, *ApplyDiscountRulesRequest) (*ApplyDiscountRulesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApplyDiscountRules not implemented")
+}
 func (UnimplementedDiscountServiceServer) mustEmbedUnimplementedDiscountServiceServer() {}
 func (UnimplementedDiscountServiceServer) testEmbeddedByValue()                         {}
 
@@ -108,6 +141,42 @@ func _DiscountService_GetDiscountByStudentId_Handler(srv interface{}, ctx contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _DiscountService_GetDiscountsByStudentIds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDiscountsByStudentIdsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DiscountServiceServer).GetDiscountsByStudentIds(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DiscountService_GetDiscountsByStudentIds_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DiscountServiceServer).GetDiscountsByStudentIds(ctx, req.(*GetDiscountsByStudentIdsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DiscountService_ApplyDiscountRules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApplyDiscountRulesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DiscountServiceServer).ApplyDiscountRules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DiscountService_ApplyDiscountRules_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DiscountServiceServer).ApplyDiscountRules(ctx, req.(*ApplyDiscountRulesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // DiscountService_ServiceDesc is the grpc.ServiceDesc for DiscountService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -119,13 +188,23 @@ var DiscountService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetDiscountByStudentId",
 			Handler:    _DiscountService_GetDiscountByStudentId_Handler,
 		},
+		{
+			MethodName: "GetDiscountsByStudentIds",
+			Handler:    _DiscountService_GetDiscountsByStudentIds_Handler,
+		},
+		{
+			MethodName: "ApplyDiscountRules",
+			Handler:    _DiscountService_ApplyDiscountRules_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "finance.proto",
 }
 
 const (
-	PaymentService_PaymentAdd_FullMethodName = "/finance.PaymentService/PaymentAdd"
+	PaymentService_PaymentAdd_FullMethodName     = "/finance.PaymentService/PaymentAdd"
+	PaymentService_PaymentTakeOff_FullMethodName = "/finance.PaymentService/PaymentTakeOff"
+	PaymentService_PaymentReturn_FullMethodName  = "/finance.PaymentService/PaymentReturn"
 )
 
 // PaymentServiceClient is the client API for PaymentService service.
@@ -135,6 +214,9 @@ const (
 // payment service start
 type PaymentServiceClient interface {
 	PaymentAdd(ctx context.Context, in *PaymentAddRequest, opts ...grpc.CallOption) (*AbsResponse, error)
+	// same as PaymentAdd with type TAKE_OFF, message holds the id of the created payment
+	PaymentTakeOff(ctx context.Context, in *PaymentAddRequest, opts ...grpc.CallOption) (*AbsResponse, error)
+	PaymentReturn(ctx context.Context, in *PaymentReturnRequest, opts ...grpc.CallOption) (*AbsResponse, error)
 }
 
 type paymentServiceClient struct {
@@ -155,6 +237,26 @@ func (c *paymentServiceClient) PaymentAdd(ctx context.Context, in *PaymentAddReq
 	return out, nil
 }
 
+func (c *paymentServiceClient) PaymentTakeOff(ctx context.Context, in *PaymentAddRequest, opts ...grpc.CallOption) (*AbsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AbsResponse)
+	err := c.cc.Invoke(ctx, PaymentService_PaymentTakeOff_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *paymentServiceClient) PaymentReturn(ctx context.Context, in *PaymentReturnRequest, opts ...grpc.CallOption) (*AbsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AbsResponse)
+	err := c.cc.Invoke(ctx, PaymentService_PaymentReturn_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // PaymentServiceServer is the server API for PaymentService service.
 // All implementations must embed UnimplementedPaymentServiceServer
 // for forward compatibility.
@@ -162,6 +264,9 @@ func (c *paymentServiceClient) PaymentAdd(ctx context.Context, in *PaymentAddReq
 // payment service start
 type PaymentServiceServer interface {
 	PaymentAdd(context.Context, *PaymentAddRequest) (*AbsResponse, error)
+	// same as PaymentAdd with type TAKE_OFF, message holds the id of the created payment
+	PaymentTakeOff(context.Context, *PaymentAddRequest) (*AbsResponse, error)
+	PaymentReturn(context.Context, *PaymentReturnRequest) (*AbsResponse, error)
 	mustEmbedUnimplementedPaymentServiceServer()
 }
 
@@ -175,6 +280,12 @@ type UnimplementedPaymentServiceServer struct{}
 func (UnimplementedPaymentServiceServer) PaymentAdd(context.Context, *PaymentAddRequest) (*AbsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method PaymentAdd not implemented")
 }
+func (UnimplementedPaymentServiceServer) PaymentTakeOff(context.Context, *PaymentAddRequest) (*AbsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PaymentTakeOff not implemented")
+}
+func (UnimplementedPaymentServiceServer) PaymentReturn(context.Context, *PaymentReturnRequest) (*AbsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PaymentReturn not implemented")
+}
 func (UnimplementedPaymentServiceServer) mustEmbedUnimplementedPaymentServiceServer() {}
 func (UnimplementedPaymentServiceServer) testEmbeddedByValue()                        {}
 
@@ -214,6 +325,42 @@ func _PaymentService_PaymentAdd_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _PaymentService_PaymentTakeOff_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PaymentAddRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaymentServiceServer).PaymentTakeOff(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PaymentService_PaymentTakeOff_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaymentServiceServer).PaymentTakeOff(ctx, req.(*PaymentAddRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PaymentService_PaymentReturn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PaymentReturnRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaymentServiceServer).PaymentReturn(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PaymentService_PaymentReturn_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaymentServiceServer).PaymentReturn(ctx, req.(*PaymentReturnRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // PaymentService_ServiceDesc is the grpc.ServiceDesc for PaymentService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -225,6 +372,14 @@ var PaymentService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "PaymentAdd",
 			Handler:    _PaymentService_PaymentAdd_Handler,
 		},
+		{
+			MethodName: "PaymentTakeOff",
+			Handler:    _PaymentService_PaymentTakeOff_Handler,
+		},
+		{
+			MethodName: "PaymentReturn",
+			Handler:    _PaymentService_PaymentReturn_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "finance.proto",
@@ -232,6 +387,7 @@ var PaymentService_ServiceDesc = grpc.ServiceDesc{
 
 const (
 	TeacherSalaryService_GetTeacherSalaryByTeacherID_FullMethodName = "/finance.TeacherSalaryService/GetTeacherSalaryByTeacherID"
+	TeacherSalaryService_ResolveTeacherSalary_FullMethodName        = "/finance.TeacherSalaryService/ResolveTeacherSalary"
 )
 
 // TeacherSalaryServiceClient is the client API for TeacherSalaryService service.
@@ -241,6 +397,7 @@ const (
 // teacher salary service start
 type TeacherSalaryServiceClient interface {
 	GetTeacherSalaryByTeacherID(ctx context.Context, in *DeleteTeacherSalaryRequest, opts ...grpc.CallOption) (*AbsGetTeachersSalary, error)
+	ResolveTeacherSalary(ctx context.Context, in *ResolveTeacherSalaryRequest, opts ...grpc.CallOption) (*AbsGetTeachersSalary, error)
 }
 
 type teacherSalaryServiceClient struct {
@@ -261,6 +418,16 @@ func (c *teacherSalaryServiceClient) GetTeacherSalaryByTeacherID(ctx context.Con
 	return out, nil
 }
 
+func (c *teacherSalaryServiceClient) ResolveTeacherSalary(ctx context.Context, in *ResolveTeacherSalaryRequest, opts ...grpc.CallOption) (*AbsGetTeachersSalary, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AbsGetTeachersSalary)
+	err := c.cc.Invoke(ctx, TeacherSalaryService_ResolveTeacherSalary_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // TeacherSalaryServiceServer is the server API for TeacherSalaryService service.
 // All implementations must embed UnimplementedTeacherSalaryServiceServer
 // for forward compatibility.
@@ -268,6 +435,7 @@ func (c *teacherSalaryServiceClient) GetTeacherSalaryByTeacherID(ctx context.Con
 // teacher salary service start
 type TeacherSalaryServiceServer interface {
 	GetTeacherSalaryByTeacherID(context.Context, *DeleteTeacherSalaryRequest) (*AbsGetTeachersSalary, error)
+	ResolveTeacherSalary(context.Context, *ResolveTeacherSalaryRequest) (*AbsGetTeachersSalary, error)
 	mustEmbedUnimplementedTeacherSalaryServiceServer()
 }
 
@@ -281,6 +449,9 @@ type UnimplementedTeacherSalaryServiceServer struct{}
 func (UnimplementedTeacherSalaryServiceServer) GetTeacherSalaryByTeacherID(context.Context, *DeleteTeacherSalaryRequest) (*AbsGetTeachersSalary, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetTeacherSalaryByTeacherID not implemented")
 }
+func (UnimplementedTeacherSalaryServiceServer) ResolveTeacherSalary(context.Context, *ResolveTeacherSalaryRequest) (*AbsGetTeachersSalary, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResolveTeacherSalary not implemented")
+}
 func (UnimplementedTeacherSalaryServiceServer) mustEmbedUnimplementedTeacherSalaryServiceServer() {}
 func (UnimplementedTeacherSalaryServiceServer) testEmbeddedByValue()                              {}
 
@@ -320,6 +491,24 @@ func _TeacherSalaryService_GetTeacherSalaryByTeacherID_Handler(srv interface{},
 	return interceptor(ctx, in, info, handler)
 }
 
+func _TeacherSalaryService_ResolveTeacherSalary_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResolveTeacherSalaryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TeacherSalaryServiceServer).ResolveTeacherSalary(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TeacherSalaryService_ResolveTeacherSalary_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TeacherSalaryServiceServer).ResolveTeacherSalary(ctx, req.(*ResolveTeacherSalaryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // TeacherSalaryService_ServiceDesc is the grpc.ServiceDesc for TeacherSalaryService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -331,6 +520,511 @@ var TeacherSalaryService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetTeacherSalaryByTeacherID",
 			Handler:    _TeacherSalaryService_GetTeacherSalaryByTeacherID_Handler,
 		},
+		{
+			MethodName: "ResolveTeacherSalary",
+			Handler:    _TeacherSalaryService_ResolveTeacherSalary_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "finance.proto",
+}
+
+const (
+	AccountingPeriodService_CheckPeriod_FullMethodName = "/finance.AccountingPeriodService/CheckPeriod"
+)
+
+// AccountingPeriodServiceClient is the client API for AccountingPeriodService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// accounting period service start
+type AccountingPeriodServiceClient interface {
+	CheckPeriod(ctx context.Context, in *CheckPeriodRequest, opts ...grpc.CallOption) (*CheckPeriodResponse, error)
+}
+
+type accountingPeriodServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewAccountingPeriodServiceClient(cc grpc.ClientConnInterface) AccountingPeriodServiceClient {
+	return &accountingPeriodServiceClient{cc}
+}
+
+func (c *accountingPeriodServiceClient) CheckPeriod(ctx context.Context, in *CheckPeriodRequest, opts ...grpc.CallOption) (*CheckPeriodResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CheckPeriodResponse)
+	err := c.cc.Invoke(ctx, AccountingPeriodService_CheckPeriod_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// AccountingPeriodServiceServer is the server API for AccountingPeriodService service.
+// All implementations must embed UnimplementedAccountingPeriodServiceServer
+// for forward compatibility.
+//
+// accounting period service start
+type AccountingPeriodServiceServer interface {
+	CheckPeriod(context.Context, *CheckPeriodRequest) (*CheckPeriodResponse, error)
+	mustEmbedUnimplementedAccountingPeriodServiceServer()
+}
+
+// UnimplementedAccountingPeriodServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedAccountingPeriodServiceServer struct{}
+
+func (UnimplementedAccountingPeriodServiceServer) CheckPeriod(context.Context, *CheckPeriodRequest) (*CheckPeriodResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckPeriod not implemented")
+}
+func (UnimplementedAccountingPeriodServiceServer) mustEmbedUnimplementedAccountingPeriodServiceServer() {
+}
+func (UnimplementedAccountingPeriodServiceServer) testEmbeddedByValue() {}
+
+// UnsafeAccountingPeriodServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to AccountingPeriodServiceServer will
+// result in compilation errors.
+type UnsafeAccountingPeriodServiceServer interface {
+	mustEmbedUnimplementedAccountingPeriodServiceServer()
+}
+
+func RegisterAccountingPeriodServiceServer(s grpc.ServiceRegistrar, srv AccountingPeriodServiceServer) {
+	// If the following call pancis, it indicates UnimplementedAccountingPeriodServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&AccountingPeriodService_ServiceDesc, srv)
+}
+
+func _AccountingPeriodService_CheckPeriod_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckPeriodRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountingPeriodServiceServer).CheckPeriod(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AccountingPeriodService_CheckPeriod_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountingPeriodServiceServer).CheckPeriod(ctx, req.(*CheckPeriodRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// AccountingPeriodService_ServiceDesc is the grpc.ServiceDesc for AccountingPeriodService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var AccountingPeriodService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "finance.AccountingPeriodService",
+	HandlerType: (*AccountingPeriodServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CheckPeriod",
+			Handler:    _AccountingPeriodService_CheckPeriod_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "finance.proto",
+}
+
+const (
+	InstallmentService_ChargeInstallments_FullMethodName       = "/finance.InstallmentService/ChargeInstallments"
+	InstallmentService_ApplyInstallmentLateFees_FullMethodName = "/finance.InstallmentService/ApplyInstallmentLateFees"
+	InstallmentService_ChargeDueInstallments_FullMethodName    = "/finance.InstallmentService/ChargeDueInstallments"
+)
+
+// InstallmentServiceClient is the client API for InstallmentService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// installment service start
+type InstallmentServiceClient interface {
+	ChargeInstallments(ctx context.Context, in *ChargeInstallmentsRequest, opts ...grpc.CallOption) (*ChargeInstallmentsResponse, error)
+	ApplyInstallmentLateFees(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*AbsResponse, error)
+	ChargeDueInstallments(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*AbsResponse, error)
+}
+
+type installmentServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewInstallmentServiceClient(cc grpc.ClientConnInterface) InstallmentServiceClient {
+	return &installmentServiceClient{cc}
+}
+
+func (c *installmentServiceClient) ChargeInstallments(ctx context.Context, in *ChargeInstallmentsRequest, opts ...grpc.CallOption) (*ChargeInstallmentsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ChargeInstallmentsResponse)
+	err := c.cc.Invoke(ctx, InstallmentService_ChargeInstallments_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *installmentServiceClient) ApplyInstallmentLateFees(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*AbsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AbsResponse)
+	err := c.cc.Invoke(ctx, InstallmentService_ApplyInstallmentLateFees_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *installmentServiceClient) ChargeDueInstallments(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*AbsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AbsResponse)
+	err := c.cc.Invoke(ctx, InstallmentService_ChargeDueInstallments_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// InstallmentServiceServer is the server API for InstallmentService service.
+// All implementations must embed UnimplementedInstallmentServiceServer
+// for forward compatibility.
+//
+// installment service start
+type InstallmentServiceServer interface {
+	ChargeInstallments(context.Context, *ChargeInstallmentsRequest) (*ChargeInstallmentsResponse, error)
+	ApplyInstallmentLateFees(context.Context, *emptypb.Empty) (*AbsResponse, error)
+	ChargeDueInstallments(context.Context, *emptypb.Empty) (*AbsResponse, error)
+	mustEmbedUnimplementedInstallmentServiceServer()
+}
+
+// UnimplementedInstallmentServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedInstallmentServiceServer struct{}
+
+func (UnimplementedInstallmentServiceServer) ChargeInstallments(context.Context, *ChargeInstallmentsRequest) (*ChargeInstallmentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChargeInstallments not implemented")
+}
+func (UnimplementedInstallmentServiceServer) ApplyInstallmentLateFees(context.Context, *emptypb.Empty) (*AbsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApplyInstallmentLateFees not implemented")
+}
+func (UnimplementedInstallmentServiceServer) ChargeDueInstallments(context.Context, *emptypb.Empty) (*AbsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChargeDueInstallments not implemented")
+}
+func (UnimplementedInstallmentServiceServer) mustEmbedUnimplementedInstallmentServiceServer() {}
+func (UnimplementedInstallmentServiceServer) testEmbeddedByValue()                            {}
+
+// UnsafeInstallmentServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to InstallmentServiceServer will
+// result in compilation errors.
+type UnsafeInstallmentServiceServer interface {
+	mustEmbedUnimplementedInstallmentServiceServer()
+}
+
+func RegisterInstallmentServiceServer(s grpc.ServiceRegistrar, srv InstallmentServiceServer) {
+	// If the following call pancis, it indicates UnimplementedInstallmentServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&InstallmentService_ServiceDesc, srv)
+}
+
+func _InstallmentService_ChargeInstallments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChargeInstallmentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InstallmentServiceServer).ChargeInstallments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InstallmentService_ChargeInstallments_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InstallmentServiceServer).ChargeInstallments(ctx, req.(*ChargeInstallmentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InstallmentService_ApplyInstallmentLateFees_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InstallmentServiceServer).ApplyInstallmentLateFees(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InstallmentService_ApplyInstallmentLateFees_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InstallmentServiceServer).ApplyInstallmentLateFees(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InstallmentService_ChargeDueInstallments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InstallmentServiceServer).ChargeDueInstallments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InstallmentService_ChargeDueInstallments_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InstallmentServiceServer).ChargeDueInstallments(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// InstallmentService_ServiceDesc is the grpc.ServiceDesc for InstallmentService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var InstallmentService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "finance.InstallmentService",
+	HandlerType: (*InstallmentServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ChargeInstallments",
+			Handler:    _InstallmentService_ChargeInstallments_Handler,
+		},
+		{
+			MethodName: "ApplyInstallmentLateFees",
+			Handler:    _InstallmentService_ApplyInstallmentLateFees_Handler,
+		},
+		{
+			MethodName: "ChargeDueInstallments",
+			Handler:    _InstallmentService_ChargeDueInstallments_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "finance.proto",
+}
+
+const (
+	CollectionService_RunCollections_FullMethodName = "/finance.CollectionService/RunCollections"
+)
+
+// CollectionServiceClient is the client API for CollectionService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// collection service start
+type CollectionServiceClient interface {
+	RunCollections(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*AbsResponse, error)
+}
+
+type collectionServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewCollectionServiceClient(cc grpc.ClientConnInterface) CollectionServiceClient {
+	return &collectionServiceClient{cc}
+}
+
+func (c *collectionServiceClient) RunCollections(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*AbsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AbsResponse)
+	err := c.cc.Invoke(ctx, CollectionService_RunCollections_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// CollectionServiceServer is the server API for CollectionService service.
+// All implementations must embed UnimplementedCollectionServiceServer
+// for forward compatibility.
+//
+// collection service start
+type CollectionServiceServer interface {
+	RunCollections(context.Context, *emptypb.Empty) (*AbsResponse, error)
+	mustEmbedUnimplementedCollectionServiceServer()
+}
+
+// UnimplementedCollectionServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedCollectionServiceServer struct{}
+
+func (UnimplementedCollectionServiceServer) RunCollections(context.Context, *emptypb.Empty) (*AbsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RunCollections not implemented")
+}
+func (UnimplementedCollectionServiceServer) mustEmbedUnimplementedCollectionServiceServer() {}
+func (UnimplementedCollectionServiceServer) testEmbeddedByValue()                           {}
+
+// UnsafeCollectionServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to CollectionServiceServer will
+// result in compilation errors.
+type UnsafeCollectionServiceServer interface {
+	mustEmbedUnimplementedCollectionServiceServer()
+}
+
+func RegisterCollectionServiceServer(s grpc.ServiceRegistrar, srv CollectionServiceServer) {
+	// If the following call pancis, it indicates UnimplementedCollectionServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&CollectionService_ServiceDesc, srv)
+}
+
+func _CollectionService_RunCollections_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CollectionServiceServer).RunCollections(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CollectionService_RunCollections_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CollectionServiceServer).RunCollections(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// CollectionService_ServiceDesc is the grpc.ServiceDesc for CollectionService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var CollectionService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "finance.CollectionService",
+	HandlerType: (*CollectionServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "RunCollections",
+			Handler:    _CollectionService_RunCollections_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "finance.proto",
+}
+
+const (
+	ExpenseService_PostRecurringExpenses_FullMethodName = "/finance.ExpenseService/PostRecurringExpenses"
+)
+
+// ExpenseServiceClient is the client API for ExpenseService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// expense service start
+type ExpenseServiceClient interface {
+	PostRecurringExpenses(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*AbsResponse, error)
+}
+
+type expenseServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewExpenseServiceClient(cc grpc.ClientConnInterface) ExpenseServiceClient {
+	return &expenseServiceClient{cc}
+}
+
+func (c *expenseServiceClient) PostRecurringExpenses(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*AbsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AbsResponse)
+	err := c.cc.Invoke(ctx, ExpenseService_PostRecurringExpenses_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ExpenseServiceServer is the server API for ExpenseService service.
+// All implementations must embed UnimplementedExpenseServiceServer
+// for forward compatibility.
+//
+// expense service start
+type ExpenseServiceServer interface {
+	PostRecurringExpenses(context.Context, *emptypb.Empty) (*AbsResponse, error)
+	mustEmbedUnimplementedExpenseServiceServer()
+}
+
+// UnimplementedExpenseServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedExpenseServiceServer struct{}
+
+func (UnimplementedExpenseServiceServer) PostRecurringExpenses(context.Context, *emptypb.Empty) (*AbsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PostRecurringExpenses not implemented")
+}
+func (UnimplementedExpenseServiceServer) mustEmbedUnimplementedExpenseServiceServer() {}
+func (UnimplementedExpenseServiceServer) testEmbeddedByValue()                        {}
+
+// UnsafeExpenseServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ExpenseServiceServer will
+// result in compilation errors.
+type UnsafeExpenseServiceServer interface {
+	mustEmbedUnimplementedExpenseServiceServer()
+}
+
+func RegisterExpenseServiceServer(s grpc.ServiceRegistrar, srv ExpenseServiceServer) {
+	// If the following call pancis, it indicates UnimplementedExpenseServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&ExpenseService_ServiceDesc, srv)
+}
+
+func _ExpenseService_PostRecurringExpenses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExpenseServiceServer).PostRecurringExpenses(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ExpenseService_PostRecurringExpenses_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExpenseServiceServer).PostRecurringExpenses(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ExpenseService_ServiceDesc is the grpc.ServiceDesc for ExpenseService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ExpenseService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "finance.ExpenseService",
+	HandlerType: (*ExpenseServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "PostRecurringExpenses",
+			Handler:    _ExpenseService_PostRecurringExpenses_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "finance.proto",
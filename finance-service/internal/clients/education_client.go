@@ -7,8 +7,10 @@ import (
 )
 
 type EducationClient struct {
-	studentClient pb.StudentServiceClient
-	groupClient   pb.GroupServiceClient
+	studentClient    pb.StudentServiceClient
+	groupClient      pb.GroupServiceClient
+	attendanceClient pb.AttendanceServiceClient
+	smsClient        pb.SmsServiceClient
 }
 
 func NewEducationClient(addr string) (*EducationClient, error) {
@@ -19,7 +21,9 @@ func NewEducationClient(addr string) (*EducationClient, error) {
 
 	studentClient := pb.NewStudentServiceClient(conn)
 	groupClient := pb.NewGroupServiceClient(conn)
-	return &EducationClient{studentClient: studentClient, groupClient: groupClient}, nil
+	attendanceClient := pb.NewAttendanceServiceClient(conn)
+	smsClient := pb.NewSmsServiceClient(conn)
+	return &EducationClient{studentClient: studentClient, groupClient: groupClient, attendanceClient: attendanceClient, smsClient: smsClient}, nil
 }
 
 func (ec *EducationClient) GetStudentById(ctx context.Context, studentId string) (string, string, float64, error) {
@@ -100,3 +104,49 @@ func (ec *EducationClient) CalculateDiscountSumma(ctx context.Context, groupId s
 	}
 	return resp.CalculatedPrice, nil
 }
+
+func (ec *EducationClient) CalculateTeacherSalaryByAttendance(ctx context.Context, from, to, teacherId string) (*pb.CalculateTeacherSalaryResponse, error) {
+	return ec.attendanceClient.CalculateTeacherSalaryByAttendance(ctx, &pb.CalculateTeacherSalaryRequest{
+		From:      from,
+		To:        to,
+		TeacherId: teacherId,
+	})
+}
+
+// CalculateTeachersSalaryByAttendance calculates the salaries of several teachers in one call, every group carries its teacher id
+func (ec *EducationClient) CalculateTeachersSalaryByAttendance(ctx context.Context, from, to string, teacherIds []string) (*pb.CalculateTeacherSalaryResponse, error) {
+	return ec.attendanceClient.CalculateTeacherSalaryByAttendance(ctx, &pb.CalculateTeacherSalaryRequest{
+		From:       from,
+		To:         to,
+		TeacherIds: teacherIds,
+	})
+}
+
+func (ec *EducationClient) GetDebtors(ctx context.Context) (*pb.GetDebtorsResponse, error) {
+	return ec.studentClient.GetDebtors(ctx, &pb.GetDebtorsRequest{})
+}
+
+func (ec *EducationClient) SendDebtReminder(ctx context.Context, studentId, actionType string) error {
+	_, err := ec.studentClient.SendDebtReminder(ctx, &pb.SendDebtReminderRequest{StudentId: studentId, ActionType: actionType})
+	return err
+}
+
+func (ec *EducationClient) GetGroupsByIds(ctx context.Context, ids []string) (*pb.GetGroupsByIdsResponse, error) {
+	return ec.groupClient.GetGroupsByIds(ctx, &pb.GetGroupsByIdsRequest{Ids: ids})
+}
+
+func (ec *EducationClient) GetStudentsByPhones(ctx context.Context, phones []string) (*pb.GetStudentsByPhonesResponse, error) {
+	return ec.studentClient.GetStudentsByPhones(ctx, &pb.GetStudentsByPhonesRequest{Phones: phones})
+}
+
+func (ec *EducationClient) GetDiscountContext(ctx context.Context, studentId, groupId string) (*pb.GetDiscountContextResponse, error) {
+	return ec.studentClient.GetDiscountContext(ctx, &pb.GetDiscountContextRequest{StudentId: studentId, GroupId: groupId})
+}
+
+func (ec *EducationClient) SendStaffSms(ctx context.Context, phones []string, message string) error {
+	if len(phones) == 0 {
+		return nil
+	}
+	_, err := ec.smsClient.SendStaffSms(ctx, &pb.SendStaffSmsRequest{Phones: phones, SmsValue: message})
+	return err
+}
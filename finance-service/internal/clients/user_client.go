@@ -23,3 +23,7 @@ func NewUserClient(addr string) (*UserClient, error) {
 func (c *UserClient) GetUserById(ctx context.Context, teacherId string) (*pb.GetUserByIdResponse, error) {
 	return c.client.GetUserById(ctx, &pb.UserAbsRequest{UserId: teacherId})
 }
+
+func (c *UserClient) GetAllStuff(ctx context.Context) (*pb.GetAllStuffResponse, error) {
+	return c.client.GetAllStuff(ctx, &pb.GetAllEmployeeRequest{IsArchived: false})
+}
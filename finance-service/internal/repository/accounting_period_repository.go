@@ -0,0 +1,268 @@
+package repository
+
+import (
+	"database/sql"
+	"errors"
+	"finance-service/proto/pb"
+	"fmt"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"net/http"
+	"strings"
+	"time"
+)
+
+type AccountingPeriodRepository struct {
+	db *sql.DB
+}
+
+func (r *AccountingPeriodRepository) ClosePeriod(companyId, period, comment, actionById, actionByName string) (*pb.AbsResponse, error) {
+	if err := parsePeriod(period); err != nil {
+		return nil, err
+	}
+	tx, err := r.db.Begin()
+	if err != nil {
+		return nil, status.Errorf(codes.Aborted, "error while creating transaction %v", err)
+	}
+	defer func() {
+		if err != nil {
+			tx.Rollback()
+		} else {
+			tx.Commit()
+		}
+	}()
+	// waits for the changes dated in the period that are still running
+	if err = lockPeriod(tx, companyId, period, true); err != nil {
+		return nil, status.Errorf(codes.Internal, "%v", err)
+	}
+
+	var isClosed bool
+	err = tx.QueryRow(`SELECT is_closed FROM accounting_period WHERE period=$1 AND company_id=$2`, period, companyId).Scan(&isClosed)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return nil, status.Errorf(codes.Internal, "error while checking period %v", err)
+	}
+	if err == nil && isClosed {
+		err = status.Errorf(codes.AlreadyExists, "period %s already closed", period)
+		return nil, err
+	}
+
+	_, err = tx.Exec(`INSERT INTO accounting_period (period, is_closed, closed_by_id, closed_by_name, closed_at, company_id)
+		VALUES ($1, TRUE, $2, $3, NOW(), $4)
+		ON CONFLICT (company_id, period) DO UPDATE SET is_closed = TRUE, closed_by_id = $2, closed_by_name = $3, closed_at = NOW()`,
+		period, actionById, actionByName, companyId)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "error while closing period %v", err)
+	}
+	if err = r.insertHistory(tx, companyId, period, "CLOSE", comment, actionById, actionByName); err != nil {
+		return nil, err
+	}
+	return &pb.AbsResponse{
+		Status:  http.StatusOK,
+		Message: "period closed",
+	}, nil
+}
+
+func (r *AccountingPeriodRepository) ReopenPeriod(companyId, period, comment, actionById, actionByName string) (*pb.AbsResponse, error) {
+	if err := parsePeriod(period); err != nil {
+		return nil, err
+	}
+	tx, err := r.db.Begin()
+	if err != nil {
+		return nil, status.Errorf(codes.Aborted, "error while creating transaction %v", err)
+	}
+	defer func() {
+		if err != nil {
+			tx.Rollback()
+		} else {
+			tx.Commit()
+		}
+	}()
+	if err = lockPeriod(tx, companyId, period, true); err != nil {
+		return nil, status.Errorf(codes.Internal, "%v", err)
+	}
+
+	result, err := tx.Exec(`UPDATE accounting_period SET is_closed = FALSE WHERE period=$1 AND company_id=$2 AND is_closed = TRUE`, period, companyId)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "error while reopening period %v", err)
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "could not check rows affected: %v", err)
+	}
+	if rowsAffected == 0 {
+		err = status.Errorf(codes.NotFound, "period %s is not closed", period)
+		return nil, err
+	}
+	if err = r.insertHistory(tx, companyId, period, "REOPEN", comment, actionById, actionByName); err != nil {
+		return nil, err
+	}
+	return &pb.AbsResponse{
+		Status:  http.StatusOK,
+		Message: "period reopened",
+	}, nil
+}
+
+func (r *AccountingPeriodRepository) GetAllPeriods(companyId string) (*pb.GetAllPeriodsResponse, error) {
+	rows, err := r.db.Query(`SELECT period, is_closed, closed_by_id, closed_by_name, closed_at FROM accounting_period WHERE company_id=$1 ORDER BY period DESC`, companyId)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to retrieve periods: %v", err)
+	}
+	defer rows.Close()
+	var response pb.GetAllPeriodsResponse
+	for rows.Next() {
+		var period pb.AbsAccountingPeriod
+		var closedAt time.Time
+		if err := rows.Scan(&period.Period, &period.IsClosed, &period.ClosedById, &period.ClosedByName, &closedAt); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to scan row: %v", err)
+		}
+		period.ClosedAt = closedAt.Format("2006-01-02 15:04:05")
+		response.Periods = append(response.Periods, &period)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, status.Errorf(codes.Internal, "error iterating rows: %v", err)
+	}
+	return &response, nil
+}
+
+func (r *AccountingPeriodRepository) GetPeriodHistory(companyId, period string) (*pb.GetPeriodHistoryResponse, error) {
+	query := `SELECT id, period, action, comment, action_by_id, action_by_name, created_at FROM accounting_period_history WHERE company_id=$1`
+	args := []interface{}{companyId}
+	if period != "" {
+		query += ` AND period=$2`
+		args = append(args, period)
+	}
+	query += ` ORDER BY created_at DESC`
+	rows, err := r.db.Query(query, args...)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to retrieve period history: %v", err)
+	}
+	defer rows.Close()
+	var response pb.GetPeriodHistoryResponse
+	for rows.Next() {
+		var history pb.AbsPeriodHistory
+		var createdAt time.Time
+		if err := rows.Scan(&history.Id, &history.Period, &history.Action, &history.Comment, &history.ActionById, &history.ActionByName, &createdAt); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to scan row: %v", err)
+		}
+		history.CreatedAt = createdAt.Format("2006-01-02 15:04:05")
+		response.Histories = append(response.Histories, &history)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, status.Errorf(codes.Internal, "error iterating rows: %v", err)
+	}
+	return &response, nil
+}
+
+func (r *AccountingPeriodRepository) CheckPeriod(companyId, date string) (*pb.CheckPeriodResponse, error) {
+	period, closed, err := isPeriodClosed(r.db, companyId, date)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+	return &pb.CheckPeriodResponse{IsClosed: closed, Period: period}, nil
+}
+
+func (r *AccountingPeriodRepository) insertHistory(tx *sql.Tx, companyId, period, action, comment, actionById, actionByName string) error {
+	_, err := tx.Exec(`INSERT INTO accounting_period_history (id, period, action, comment, action_by_id, action_by_name, company_id)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)`, uuid.New(), period, action, comment, actionById, actionByName, companyId)
+	if err != nil {
+		return status.Errorf(codes.Internal, "error while inserting period history %v", err)
+	}
+	return nil
+}
+
+// periodQueryer runs the period checks on the database or inside the transaction of the change they guard
+type periodQueryer interface {
+	QueryRow(query string, args ...any) *sql.Row
+}
+
+// lockPeriod serialises changes dated in period with ClosePeriod and ReopenPeriod until the transaction of q ends:
+// writers share the lock, closing and reopening take it alone. The advisory lock also covers a period that has no
+// accounting_period row yet.
+func lockPeriod(q periodQueryer, companyId, period string, exclusive bool) error {
+	lock := "pg_advisory_xact_lock_shared"
+	if exclusive {
+		lock = "pg_advisory_xact_lock"
+	}
+	var locked string
+	if err := q.QueryRow(`SELECT `+lock+`($1::int, hashtext($2))::text`, companyId, period).Scan(&locked); err != nil {
+		return fmt.Errorf("error while locking accounting period: %v", err)
+	}
+	return nil
+}
+
+// isPeriodClosed reports whether the month of the given date (YYYY-MM-DD) is closed for the company. The period row
+// is locked FOR SHARE so it can not be closed before the transaction of q ends.
+func isPeriodClosed(q periodQueryer, companyId, date string) (string, bool, error) {
+	if strings.Contains(date, "T") {
+		date = date[:10]
+	}
+	parsedDate, err := time.Parse("2006-01-02", date)
+	if err != nil {
+		return "", false, fmt.Errorf("invalid date format: %v", err)
+	}
+	period := parsedDate.Format("2006-01")
+	if err = lockPeriod(q, companyId, period, false); err != nil {
+		return period, false, err
+	}
+	var closed bool
+	err = q.QueryRow(`SELECT is_closed FROM accounting_period WHERE period=$1 AND company_id=$2 FOR SHARE`, period, companyId).Scan(&closed)
+	if errors.Is(err, sql.ErrNoRows) {
+		return period, false, nil
+	}
+	if err != nil {
+		return period, false, fmt.Errorf("error while checking accounting period: %v", err)
+	}
+	return period, closed, nil
+}
+
+// checkPeriodIsOpen rejects any change dated in a closed accounting period, run on the transaction of the change it
+// keeps the period open until the change is committed
+func checkPeriodIsOpen(q periodQueryer, companyId string, dates ...string) error {
+	for _, date := range dates {
+		period, closed, err := isPeriodClosed(q, companyId, date)
+		if err != nil {
+			return status.Errorf(codes.InvalidArgument, err.Error())
+		}
+		if closed {
+			return status.Errorf(codes.FailedPrecondition, "%s oyi yopilgan, o'zgartirish uchun CEO davrni qayta ochishi kerak", period)
+		}
+	}
+	return nil
+}
+
+// checkPeriodRangeIsOpen rejects a change which covers any closed accounting period between from and to
+func checkPeriodRangeIsOpen(q periodQueryer, companyId, from, to string) error {
+	fromDate, err := time.Parse("2006-01-02", dateOnly(from))
+	if err != nil {
+		return status.Errorf(codes.InvalidArgument, "invalid date format: %v", err)
+	}
+	toDate, err := time.Parse("2006-01-02", dateOnly(to))
+	if err != nil {
+		return status.Errorf(codes.InvalidArgument, "invalid date format: %v", err)
+	}
+	var dates []string
+	for month := time.Date(fromDate.Year(), fromDate.Month(), 1, 0, 0, 0, 0, time.UTC); !month.After(toDate); month = month.AddDate(0, 1, 0) {
+		dates = append(dates, month.Format("2006-01-02"))
+	}
+	return checkPeriodIsOpen(q, companyId, dates...)
+}
+
+func dateOnly(date string) string {
+	if len(date) > 10 {
+		return date[:10]
+	}
+	return date
+}
+
+// parsePeriod checks a YYYY-MM period of ClosePeriod and ReopenPeriod
+func parsePeriod(period string) error {
+	if _, err := time.Parse("2006-01", period); err != nil {
+		return status.Errorf(codes.InvalidArgument, "invalid period format, expected YYYY-MM: %v", err)
+	}
+	return nil
+}
+
+func NewAccountingPeriodRepository(db *sql.DB) *AccountingPeriodRepository {
+	return &AccountingPeriodRepository{db: db}
+}
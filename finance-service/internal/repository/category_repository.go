@@ -35,7 +35,7 @@ func (r *CategoryRepository) DeleteCategory(companyId string, id string) error {
 }
 
 func (r *CategoryRepository) GetAllCategory(companyId string) (*pb.GetAllCategoryRequest, error) {
-	query := "SELECT id, name, description FROM category where company_id=$1"
+	query := "SELECT id, name, description, COALESCE(monthly_budget, 0) FROM category where company_id=$1"
 	rows, err := r.db.Query(query, companyId)
 	if err != nil {
 		return nil, fmt.Errorf("failed to query categories: %w", err)
@@ -45,7 +45,7 @@ func (r *CategoryRepository) GetAllCategory(companyId string) (*pb.GetAllCategor
 	for rows.Next() {
 		var category pb.AbsCategory
 		var id int32
-		if err := rows.Scan(&id, &category.Name, &category.Desc); err != nil {
+		if err := rows.Scan(&id, &category.Name, &category.Desc, &category.MonthlyBudget); err != nil {
 			return nil, fmt.Errorf("failed to scan row: %w", err)
 		}
 		category.Id = fmt.Sprintf("%d", id)
@@ -57,6 +57,25 @@ func (r *CategoryRepository) GetAllCategory(companyId string) (*pb.GetAllCategor
 	return &response, nil
 }
 
+// SetCategoryBudget sets the monthly spend limit of the category, zero removes the limit
+func (r *CategoryRepository) SetCategoryBudget(companyId, categoryId string, monthlyBudget float64) error {
+	if monthlyBudget < 0 {
+		return status.Errorf(codes.InvalidArgument, "monthly budget must not be negative")
+	}
+	var budget interface{}
+	if monthlyBudget > 0 {
+		budget = monthlyBudget
+	}
+	result, err := r.db.Exec(`UPDATE category SET monthly_budget = $1 WHERE id = $2 AND company_id = $3`, budget, categoryId, companyId)
+	if err != nil {
+		return status.Errorf(codes.Aborted, "error while updating category budget %v", err)
+	}
+	if affected, _ := result.RowsAffected(); affected == 0 {
+		return status.Errorf(codes.NotFound, "category not found")
+	}
+	return nil
+}
+
 func NewCategoryRepository(db *sql.DB) *CategoryRepository {
 	return &CategoryRepository{db: db}
 }
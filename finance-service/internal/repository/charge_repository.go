@@ -0,0 +1,362 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"finance-service/internal/clients"
+	"finance-service/internal/utils"
+	"finance-service/proto/pb"
+	"fmt"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"net/http"
+	"strings"
+	"time"
+)
+
+type ChargeRepository struct {
+	db              *sql.DB
+	educationClient *clients.EducationClient
+}
+
+func (r *ChargeRepository) CreateChargeItem(companyId string, req *pb.CreateChargeItemRequest) (*pb.AbsResponse, error) {
+	name := strings.TrimSpace(req.Name)
+	if err := validateChargeItem(name, req.Price, req.Stock); err != nil {
+		return nil, err
+	}
+	var exists bool
+	err := r.db.QueryRow(`SELECT EXISTS(SELECT 1 FROM charge_item WHERE company_id = $1 AND lower(name) = lower($2))`, companyId, name).Scan(&exists)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to check charge item: %v", err)
+	}
+	if exists {
+		return nil, status.Errorf(codes.AlreadyExists, "charge item %s already exists", name)
+	}
+	stock := req.Stock
+	if !req.IsPhysical {
+		stock = 0
+	}
+	id := uuid.New()
+	_, err = r.db.Exec(`INSERT INTO charge_item (id, name, price, is_physical, stock, comment, company_id) VALUES ($1, $2, $3, $4, $5, $6, $7)`,
+		id, name, req.Price, req.IsPhysical, stock, req.Comment, companyId)
+	if err != nil {
+		return nil, status.Errorf(codes.Aborted, "error while inserting charge item %v", err)
+	}
+	return &pb.AbsResponse{
+		Status:  http.StatusCreated,
+		Message: id.String(),
+	}, nil
+}
+
+// UpdateChargeItem edits the catalog entry, stock is set to the given value so restocking is an update too
+func (r *ChargeRepository) UpdateChargeItem(companyId string, req *pb.AbsChargeItem) (*pb.AbsResponse, error) {
+	name := strings.TrimSpace(req.Name)
+	if err := validateChargeItem(name, req.Price, req.Stock); err != nil {
+		return nil, err
+	}
+	var exists bool
+	err := r.db.QueryRow(`SELECT EXISTS(SELECT 1 FROM charge_item WHERE company_id = $1 AND lower(name) = lower($2) AND id <> $3)`, companyId, name, req.Id).Scan(&exists)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to check charge item: %v", err)
+	}
+	if exists {
+		return nil, status.Errorf(codes.AlreadyExists, "charge item %s already exists", name)
+	}
+	stock := req.Stock
+	if !req.IsPhysical {
+		stock = 0
+	}
+	result, err := r.db.Exec(`UPDATE charge_item SET name = $1, price = $2, is_physical = $3, stock = $4, comment = $5, is_active = $6 WHERE id = $7 AND company_id = $8`,
+		name, req.Price, req.IsPhysical, stock, req.Comment, req.IsActive, req.Id, companyId)
+	if err != nil {
+		return nil, status.Errorf(codes.Aborted, "error while updating charge item %v", err)
+	}
+	if affected, _ := result.RowsAffected(); affected == 0 {
+		return nil, status.Errorf(codes.NotFound, "charge item not found")
+	}
+	return &pb.AbsResponse{
+		Status:  http.StatusOK,
+		Message: "charge item updated",
+	}, nil
+}
+
+func (r *ChargeRepository) GetChargeItems(companyId string, includeInactive bool) (*pb.GetChargeItemsResponse, error) {
+	rows, err := r.db.Query(`SELECT id, name, price, is_physical, stock, comment, is_active, created_at
+		FROM charge_item
+		WHERE company_id = $1 AND (is_active OR $2)
+		ORDER BY name`, companyId, includeInactive)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to retrieve charge items: %v", err)
+	}
+	defer rows.Close()
+	var response pb.GetChargeItemsResponse
+	for rows.Next() {
+		var (
+			item      pb.AbsChargeItem
+			createdAt time.Time
+		)
+		if err := rows.Scan(&item.Id, &item.Name, &item.Price, &item.IsPhysical, &item.Stock, &item.Comment, &item.IsActive, &createdAt); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to scan charge item: %v", err)
+		}
+		item.CreatedAt = createdAt.Format("2006-01-02 15:04:05")
+		response.Items = append(response.Items, &item)
+	}
+	return &response, rows.Err()
+}
+
+// ChargeStudent sells catalog items to a student, stock is taken and a single TAKE_OFF payment is posted to
+// the student balance in the same transaction so a failed balance update leaves the stock untouched
+func (r *ChargeRepository) ChargeStudent(ctx context.Context, companyId string, req *pb.ChargeStudentRequest) (*pb.AbsResponse, error) {
+	if req.StudentId == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "studentId is required")
+	}
+	if len(req.Lines) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "at least one item is required")
+	}
+	givenDate := req.Date
+	if givenDate == "" {
+		givenDate = time.Now().Format("2006-01-02")
+	}
+	if _, err := time.Parse("2006-01-02", givenDate); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid date format: %v", err)
+	}
+	// the same item given twice is sold as one line
+	var itemIds []string
+	quantities := make(map[string]int32)
+	for _, line := range req.Lines {
+		if line.Quantity <= 0 {
+			return nil, status.Errorf(codes.InvalidArgument, "quantity must be positive")
+		}
+		if _, ok := quantities[line.ItemId]; !ok {
+			itemIds = append(itemIds, line.ItemId)
+		}
+		quantities[line.ItemId] += line.Quantity
+	}
+
+	tx, err := r.db.Begin()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to begin transaction: %v", err)
+	}
+	defer func() {
+		if err != nil {
+			tx.Rollback()
+		} else {
+			tx.Commit()
+		}
+	}()
+	if err = checkPeriodIsOpen(tx, companyId, givenDate); err != nil {
+		return nil, err
+	}
+
+	type chargeLine struct {
+		itemId    string
+		itemName  string
+		quantity  int32
+		unitPrice float64
+		amount    float64
+	}
+	var (
+		lines []chargeLine
+		total float64
+		names []string
+	)
+	for _, itemId := range itemIds {
+		var (
+			line       = chargeLine{itemId: itemId, quantity: quantities[itemId]}
+			isPhysical bool
+			isActive   bool
+			stock      int32
+		)
+		err = tx.QueryRow(`SELECT name, price, is_physical, stock, is_active FROM charge_item WHERE id = $1 AND company_id = $2 FOR UPDATE`,
+			itemId, companyId).Scan(&line.itemName, &line.unitPrice, &isPhysical, &stock, &isActive)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = status.Errorf(codes.NotFound, "charge item %s not found", itemId)
+			return nil, err
+		}
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get charge item: %v", err)
+		}
+		if !isActive {
+			err = status.Errorf(codes.FailedPrecondition, "charge item %s is not active", line.itemName)
+			return nil, err
+		}
+		if isPhysical {
+			if stock < line.quantity {
+				err = status.Errorf(codes.FailedPrecondition, "only %d of %s left in stock", stock, line.itemName)
+				return nil, err
+			}
+			if _, err = tx.Exec(`UPDATE charge_item SET stock = stock - $1 WHERE id = $2`, line.quantity, itemId); err != nil {
+				return nil, status.Errorf(codes.Internal, "failed to update stock: %v", err)
+			}
+		}
+		line.amount = roundAmount(line.unitPrice * float64(line.quantity))
+		total += line.amount
+		lines = append(lines, line)
+		names = append(names, fmt.Sprintf("%s (%d dona)", line.itemName, line.quantity))
+	}
+	total = roundAmount(total)
+
+	comment := "Sotuv: " + strings.Join(names, ", ")
+	if strings.TrimSpace(req.Comment) != "" {
+		comment += " - " + strings.TrimSpace(req.Comment)
+	}
+	var groupId any
+	if req.GroupId != "" {
+		groupId = req.GroupId
+	}
+	paymentId, chargeId := uuid.New(), uuid.New()
+	_, err = tx.Exec(`INSERT INTO student_payments
+		(id, student_id, method, amount, given_date, comment, payment_type, created_by_id, created_by_name, created_at, group_id, company_id, student_activation_date)
+		VALUES ($1, $2, 'CASH', $3, $4, $5, 'TAKE_OFF', $6, $7, NOW(), $8, $9, $4)`,
+		paymentId, req.StudentId, total, givenDate, comment, req.ActionById, req.ActionByName, groupId, companyId)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to take off payment: %v", err)
+	}
+	_, err = tx.Exec(`INSERT INTO student_charge (id, student_id, group_id, payment_id, given_date, total_amount, comment, created_by_id, created_by_name, company_id)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)`,
+		chargeId, req.StudentId, groupId, paymentId, givenDate, total, strings.TrimSpace(req.Comment), req.ActionById, req.ActionByName, companyId)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to insert charge: %v", err)
+	}
+	for _, line := range lines {
+		_, err = tx.Exec(`INSERT INTO student_charge_item (id, charge_id, item_id, item_name, quantity, unit_price, amount, company_id)
+			VALUES ($1, $2, $3, $4, $5, $6, $7, $8)`,
+			uuid.New(), chargeId, line.itemId, line.itemName, line.quantity, line.unitPrice, line.amount, companyId)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to insert charge item: %v", err)
+		}
+	}
+
+	ctx, cancelFunc := utils.NewTimoutContext(ctx, companyId)
+	defer cancelFunc()
+	err = r.educationClient.ChangeUserBalanceHistory(ctx, req.StudentId, formatAmount(total), givenDate, comment, "TAKE_OFF", req.ActionById, req.ActionByName, req.GroupId)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to update user balance history: %v", err)
+	}
+	return &pb.AbsResponse{
+		Status:  http.StatusCreated,
+		Message: chargeId.String(),
+	}, nil
+}
+
+func (r *ChargeRepository) GetStudentCharges(companyId, studentId string) (*pb.GetStudentChargesResponse, error) {
+	rows, err := r.db.Query(`SELECT sc.id, sc.student_id, sc.payment_id, sc.given_date, sc.total_amount, sc.comment, sc.created_by_name, sc.created_at,
+			sci.item_id, sci.item_name, sci.quantity, sci.unit_price, sci.amount
+		FROM student_charge sc
+		JOIN student_charge_item sci ON sci.charge_id = sc.id
+		WHERE sc.student_id = $1 AND sc.company_id = $2
+		ORDER BY sc.given_date DESC, sc.created_at DESC, sci.item_name`, studentId, companyId)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to retrieve student charges: %v", err)
+	}
+	defer rows.Close()
+	var response pb.GetStudentChargesResponse
+	var current *pb.AbsStudentCharge
+	for rows.Next() {
+		var (
+			charge    pb.AbsStudentCharge
+			line      pb.AbsStudentChargeLine
+			givenDate time.Time
+			createdAt time.Time
+		)
+		err := rows.Scan(&charge.Id, &charge.StudentId, &charge.PaymentId, &givenDate, &charge.TotalAmount, &charge.Comment, &charge.CreatedByName, &createdAt,
+			&line.ItemId, &line.ItemName, &line.Quantity, &line.UnitPrice, &line.Amount)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to scan row: %v", err)
+		}
+		if current == nil || current.Id != charge.Id {
+			charge.GivenDate = givenDate.Format("2006-01-02")
+			charge.CreatedAt = createdAt.Format("2006-01-02 15:04:05")
+			current = &charge
+			response.Charges = append(response.Charges, current)
+		}
+		current.Lines = append(current.Lines, &line)
+	}
+	return &response, rows.Err()
+}
+
+// GetChargeItemSalesReport sums sold quantities and amounts per catalog item, inactive items are listed only when sold in the period
+func (r *ChargeRepository) GetChargeItemSalesReport(companyId, from, to string) (*pb.GetChargeItemSalesReportResponse, error) {
+	if from == "" {
+		from = "1970-01-01"
+	}
+	if to == "" {
+		to = "9999-12-31"
+	}
+	rows, err := r.db.Query(`SELECT ci.id, ci.name, ci.is_physical, ci.stock,
+			COALESCE(sales.quantity, 0), COALESCE(sales.amount, 0), COALESCE(sales.charge_count, 0), COALESCE(sales.student_count, 0)
+		FROM charge_item ci
+		LEFT JOIN (SELECT sci.item_id,
+				SUM(sci.quantity)             AS quantity,
+				SUM(sci.amount)               AS amount,
+				COUNT(DISTINCT sc.id)         AS charge_count,
+				COUNT(DISTINCT sc.student_id) AS student_count
+			FROM student_charge_item sci
+			JOIN student_charge sc ON sc.id = sci.charge_id
+			WHERE sc.company_id = $1 AND sc.given_date BETWEEN $2 AND $3
+			GROUP BY sci.item_id) sales ON sales.item_id = ci.id
+		WHERE ci.company_id = $1 AND (ci.is_active OR sales.item_id IS NOT NULL)
+		ORDER BY 6 DESC, ci.name`, companyId, from, to)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to retrieve sales report: %v", err)
+	}
+	defer rows.Close()
+	var response pb.GetChargeItemSalesReportResponse
+	for rows.Next() {
+		var item pb.ChargeItemSales
+		err := rows.Scan(&item.ItemId, &item.ItemName, &item.IsPhysical, &item.Stock, &item.Quantity, &item.Amount, &item.ChargeCount, &item.StudentCount)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to scan row: %v", err)
+		}
+		item.Amount = roundAmount(item.Amount)
+		response.TotalQuantity += item.Quantity
+		response.TotalAmount += item.Amount
+		response.Items = append(response.Items, &item)
+	}
+	response.TotalAmount = roundAmount(response.TotalAmount)
+	return &response, rows.Err()
+}
+
+// returnStudentCharge undoes the sale behind a returned TAKE_OFF payment and puts physical items back to stock
+func returnStudentCharge(tx *sql.Tx, companyId, paymentId string) error {
+	var chargeId string
+	err := tx.QueryRow(`SELECT id FROM student_charge WHERE payment_id = $1 AND company_id = $2`, paymentId, companyId).Scan(&chargeId)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to get payment charge: %v", err)
+	}
+	_, err = tx.Exec(`UPDATE charge_item ci SET stock = ci.stock + sci.quantity
+		FROM student_charge_item sci
+		WHERE sci.charge_id = $1 AND ci.id = sci.item_id AND ci.is_physical`, chargeId)
+	if err != nil {
+		return fmt.Errorf("failed to return stock: %v", err)
+	}
+	if _, err = tx.Exec(`DELETE FROM student_charge_item WHERE charge_id = $1`, chargeId); err != nil {
+		return fmt.Errorf("failed to delete charge items: %v", err)
+	}
+	if _, err = tx.Exec(`DELETE FROM student_charge WHERE id = $1`, chargeId); err != nil {
+		return fmt.Errorf("failed to delete charge: %v", err)
+	}
+	return nil
+}
+
+func validateChargeItem(name string, price float64, stock int32) error {
+	if name == "" {
+		return status.Errorf(codes.InvalidArgument, "charge item name is required")
+	}
+	if price < 0 {
+		return status.Errorf(codes.InvalidArgument, "price can not be negative")
+	}
+	if stock < 0 {
+		return status.Errorf(codes.InvalidArgument, "stock can not be negative")
+	}
+	return nil
+}
+
+func NewChargeRepository(db *sql.DB, educationClient *clients.EducationClient) *ChargeRepository {
+	return &ChargeRepository{db: db, educationClient: educationClient}
+}
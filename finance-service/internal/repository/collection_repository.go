@@ -0,0 +1,470 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"finance-service/internal/clients"
+	"finance-service/internal/utils"
+	"finance-service/proto/pb"
+	"fmt"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"net/http"
+	"sort"
+	"strconv"
+	"time"
+)
+
+var (
+	agingBuckets        = []string{"0-30", "31-60", "61-90", "90+"}
+	debtReminderActions = map[string]string{
+		"0-30":  "DEBT_REMINDER_0_30",
+		"31-60": "DEBT_REMINDER_31_60",
+		"61-90": "DEBT_REMINDER_61_90",
+		"90+":   "DEBT_REMINDER_90_PLUS",
+	}
+)
+
+type CollectionRepository struct {
+	db              *sql.DB
+	educationClient *clients.EducationClient
+	userClient      *clients.UserClient
+}
+
+func (r *CollectionRepository) GetDebtAging(ctx context.Context, companyId, bucket, assignedToId string, page, size int32) (*pb.GetDebtAgingResponse, error) {
+	if size <= 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid page size: must be greater than zero")
+	}
+	if page <= 0 {
+		page = 1
+	}
+	debtors, err := r.agedDebtors(ctx, companyId)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		response      pb.GetDebtAgingResponse
+		filtered      []*pb.AbsAgedDebtor
+		bucketCounts  = make(map[string]int32)
+		bucketAmounts = make(map[string]float64)
+	)
+	for _, debtor := range debtors {
+		if assignedToId != "" && debtor.AssignedToId != assignedToId {
+			continue
+		}
+		balance, _ := strconv.ParseFloat(debtor.Balance, 64)
+		bucketCounts[debtor.Bucket]++
+		bucketAmounts[debtor.Bucket] += -balance
+		if bucket != "" && debtor.Bucket != bucket {
+			continue
+		}
+		filtered = append(filtered, debtor)
+	}
+	for _, name := range agingBuckets {
+		response.Buckets = append(response.Buckets, &pb.AgingBucket{
+			Bucket: name,
+			Count:  bucketCounts[name],
+			Amount: formatAmount(bucketAmounts[name]),
+		})
+	}
+
+	totalRecords := int32(len(filtered))
+	if totalRecords == 0 {
+		totalRecords = 1
+	}
+	response.TotalPageCount = (totalRecords + size - 1) / size
+	start := int((page - 1) * size)
+	if start < len(filtered) {
+		end := start + int(size)
+		if end > len(filtered) {
+			end = len(filtered)
+		}
+		response.Debtors = filtered[start:end]
+	}
+	return &response, nil
+}
+
+func (r *CollectionRepository) AssignDebtor(ctx context.Context, companyId, studentId, assignedToId string) (*pb.AbsResponse, error) {
+	ctx, cancelFunc := utils.NewTimoutContext(ctx, companyId)
+	defer cancelFunc()
+	user, err := r.userClient.GetUserById(ctx, assignedToId)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "staff member not found: %v", err)
+	}
+	_, err = r.db.Exec(`INSERT INTO collection_case (student_id, company_id, assigned_to_id, assigned_to_name)
+		VALUES ($1, $2, $3, $4)
+		ON CONFLICT (company_id, student_id) DO UPDATE SET assigned_to_id = $3, assigned_to_name = $4`,
+		studentId, companyId, assignedToId, user.Name)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "error while assigning debtor %v", err)
+	}
+	return &pb.AbsResponse{
+		Status:  http.StatusOK,
+		Message: "debtor assigned",
+	}, nil
+}
+
+func (r *CollectionRepository) AddCollectionActivity(companyId string, req *pb.AddCollectionActivityRequest) (*pb.AbsResponse, error) {
+	validTypes := map[string]bool{"CALL": true, "SMS": true, "MEETING": true, "PROMISE": true, "NOTE": true}
+	if !validTypes[req.Type] {
+		return nil, status.Errorf(codes.InvalidArgument, "type must be one of CALL, SMS, MEETING, PROMISE, NOTE")
+	}
+	var (
+		promisedAmount interface{}
+		promisedDate   interface{}
+	)
+	if req.Type == "PROMISE" {
+		amount, err := strconv.ParseFloat(req.PromisedAmount, 64)
+		if err != nil || amount <= 0 {
+			return nil, status.Errorf(codes.InvalidArgument, "promisedAmount must be a positive number")
+		}
+		if _, err := time.Parse("2006-01-02", req.PromisedDate); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid promisedDate format: %v", err)
+		}
+		promisedAmount = amount
+		promisedDate = req.PromisedDate
+	}
+	_, err := r.db.Exec(`INSERT INTO collection_activity (id, student_id, activity_type, comment, promised_amount, promised_date, created_by_id, created_by_name, company_id)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)`,
+		uuid.New(), req.StudentId, req.Type, req.Comment, promisedAmount, promisedDate, req.ActionById, req.ActionByName, companyId)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "error while saving collection activity %v", err)
+	}
+	return &pb.AbsResponse{
+		Status:  http.StatusCreated,
+		Message: "activity saved",
+	}, nil
+}
+
+func (r *CollectionRepository) GetCollectionActivities(companyId, studentId string) (*pb.GetCollectionActivitiesResponse, error) {
+	rows, err := r.db.Query(`SELECT id, student_id, activity_type, comment, promised_amount, promised_date, created_by_id, created_by_name, created_at
+		FROM collection_activity WHERE company_id=$1 AND student_id=$2 ORDER BY created_at DESC`, companyId, studentId)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to retrieve collection activities: %v", err)
+	}
+	defer rows.Close()
+	var response pb.GetCollectionActivitiesResponse
+	for rows.Next() {
+		var (
+			activity       pb.AbsCollectionActivity
+			promisedAmount sql.NullFloat64
+			promisedDate   sql.NullTime
+			createdAt      time.Time
+		)
+		if err := rows.Scan(&activity.Id, &activity.StudentId, &activity.Type, &activity.Comment, &promisedAmount, &promisedDate,
+			&activity.CreatedById, &activity.CreatedByName, &createdAt); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to scan row: %v", err)
+		}
+		if promisedAmount.Valid {
+			activity.PromisedAmount = formatAmount(promisedAmount.Float64)
+		}
+		if promisedDate.Valid {
+			activity.PromisedDate = promisedDate.Time.Format("2006-01-02")
+		}
+		activity.CreatedAt = createdAt.Format("2006-01-02 15:04:05")
+		response.Activities = append(response.Activities, &activity)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, status.Errorf(codes.Internal, "error iterating rows: %v", err)
+	}
+	return &response, nil
+}
+
+func (r *CollectionRepository) GetCollectionSetting(companyId string) (*pb.CollectionSetting, error) {
+	setting := pb.CollectionSetting{FreezeAfterDays: 90, RemindersEnabled: true}
+	err := r.db.QueryRow(`SELECT freeze_after_days, reminders_enabled FROM collection_setting WHERE company_id=$1`, companyId).
+		Scan(&setting.FreezeAfterDays, &setting.RemindersEnabled)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return nil, status.Errorf(codes.Internal, "failed to retrieve collection setting: %v", err)
+	}
+	return &setting, nil
+}
+
+func (r *CollectionRepository) UpdateCollectionSetting(companyId string, freezeAfterDays int32, remindersEnabled bool) (*pb.AbsResponse, error) {
+	if freezeAfterDays < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "freezeAfterDays must be non-negative, 0 disables freeze flag")
+	}
+	_, err := r.db.Exec(`INSERT INTO collection_setting (company_id, freeze_after_days, reminders_enabled, updated_at)
+		VALUES ($1, $2, $3, NOW())
+		ON CONFLICT (company_id) DO UPDATE SET freeze_after_days = $2, reminders_enabled = $3, updated_at = NOW()`,
+		companyId, freezeAfterDays, remindersEnabled)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "error while updating collection setting %v", err)
+	}
+	return &pb.AbsResponse{
+		Status:  http.StatusOK,
+		Message: "collection setting updated",
+	}, nil
+}
+
+// RunCollections refreshes collection cases of the company, sends the reminder SMS of every bucket a debtor
+// moves into and flags debtors overdue longer than the configured period to be frozen
+func (r *CollectionRepository) RunCollections(ctx context.Context, companyId string) (*pb.AbsResponse, error) {
+	setting, err := r.GetCollectionSetting(companyId)
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancelFunc := utils.NewTimoutContext(ctx, companyId)
+	defer cancelFunc()
+	debtors, err := r.agedDebtors(ctx, companyId)
+	if err != nil {
+		return nil, err
+	}
+
+	studentIds := make([]string, 0, len(debtors))
+	for _, debtor := range debtors {
+		studentIds = append(studentIds, debtor.StudentId)
+		_, err := r.db.Exec(`INSERT INTO collection_case (student_id, company_id, status, debt_since)
+			VALUES ($1, $2, 'OPEN', $3)
+			ON CONFLICT (company_id, student_id) DO UPDATE SET
+				debt_since = $3,
+				last_reminder_bucket = CASE WHEN collection_case.status = 'CLOSED' THEN NULL ELSE collection_case.last_reminder_bucket END,
+				freeze_flagged = CASE WHEN collection_case.status = 'CLOSED' THEN FALSE ELSE collection_case.freeze_flagged END,
+				status = 'OPEN',
+				closed_at = NULL`, debtor.StudentId, companyId, debtor.DebtSince)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "error while updating collection case %v", err)
+		}
+	}
+	_, err = r.db.Exec(`UPDATE collection_case SET status = 'CLOSED', closed_at = NOW()
+		WHERE company_id = $1 AND status = 'OPEN' AND NOT (student_id::text = ANY($2))`, companyId, pq.Array(studentIds))
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "error while closing paid collection cases %v", err)
+	}
+
+	var reminded, flagged int
+	for _, debtor := range debtors {
+		var lastReminderBucket sql.NullString
+		var freezeFlagged bool
+		err := r.db.QueryRow(`SELECT last_reminder_bucket, freeze_flagged FROM collection_case WHERE company_id=$1 AND student_id=$2`,
+			companyId, debtor.StudentId).Scan(&lastReminderBucket, &freezeFlagged)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "error while getting collection case %v", err)
+		}
+
+		if setting.RemindersEnabled && bucketRank(debtor.Bucket) > bucketRank(lastReminderBucket.String) {
+			if err := r.educationClient.SendDebtReminder(ctx, debtor.StudentId, debtReminderActions[debtor.Bucket]); err != nil {
+				fmt.Printf("error while sending debt reminder to student %s: %v\n", debtor.StudentId, err)
+			} else {
+				if _, err := r.db.Exec(`UPDATE collection_case SET last_reminder_bucket=$3, last_reminder_at=NOW() WHERE company_id=$1 AND student_id=$2`,
+					companyId, debtor.StudentId, debtor.Bucket); err != nil {
+					return nil, status.Errorf(codes.Internal, "error while updating reminder %v", err)
+				}
+				r.addSystemActivity(companyId, debtor.StudentId, "SMS", fmt.Sprintf("%s kunlik qarzdorlik eslatmasi yuborildi", debtor.Bucket))
+				reminded++
+			}
+		}
+
+		if setting.FreezeAfterDays > 0 && debtor.DaysOverdue >= setting.FreezeAfterDays && !freezeFlagged {
+			if _, err := r.db.Exec(`UPDATE collection_case SET freeze_flagged = TRUE, freeze_flagged_at = NOW() WHERE company_id=$1 AND student_id=$2`,
+				companyId, debtor.StudentId); err != nil {
+				return nil, status.Errorf(codes.Internal, "error while flagging debtor %v", err)
+			}
+			r.addSystemActivity(companyId, debtor.StudentId, "NOTE", fmt.Sprintf("%d kundan beri qarzdor, muzlatish uchun belgilandi", debtor.DaysOverdue))
+			flagged++
+		}
+	}
+	return &pb.AbsResponse{
+		Status:  http.StatusOK,
+		Message: fmt.Sprintf("%d debtors processed, %d reminders sent, %d flagged to freeze", len(debtors), reminded, flagged),
+	}, nil
+}
+
+// agedDebtors returns every debtor of the company with the age of the debt and its collection state, oldest first
+func (r *CollectionRepository) agedDebtors(ctx context.Context, companyId string) ([]*pb.AbsAgedDebtor, error) {
+	ctx, cancelFunc := utils.NewTimoutContext(ctx, companyId)
+	defer cancelFunc()
+	resp, err := r.educationClient.GetDebtors(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "error while getting debtors %v", err)
+	}
+
+	cases, err := r.collectionCases(companyId)
+	if err != nil {
+		return nil, err
+	}
+	lastContacts, promises, err := r.lastActivities(companyId)
+	if err != nil {
+		return nil, err
+	}
+
+	today := time.Now().Truncate(24 * time.Hour)
+	debtors := make([]*pb.AbsAgedDebtor, 0, len(resp.Debtors))
+	for _, student := range resp.Debtors {
+		debtSince, err := r.debtSince(companyId, student.StudentId, student.ZeroBalanceUpdatedAt)
+		if err != nil {
+			return nil, err
+		}
+		debtor := &pb.AbsAgedDebtor{
+			StudentId:   student.StudentId,
+			StudentName: student.Name,
+			PhoneNumber: student.Phone,
+			Balance:     strconv.FormatFloat(student.Balance, 'f', 2, 64),
+			DebtSince:   debtSince.Format("2006-01-02"),
+			DaysOverdue: int32(today.Sub(debtSince).Hours() / 24),
+		}
+		if debtor.DaysOverdue < 0 {
+			debtor.DaysOverdue = 0
+		}
+		debtor.Bucket = overdueBucket(debtor.DaysOverdue)
+		if c, ok := cases[student.StudentId]; ok {
+			debtor.AssignedToId = c.AssignedToId
+			debtor.AssignedToName = c.AssignedToName
+			debtor.FreezeFlagged = c.FreezeFlagged
+			debtor.LastReminderBucket = c.LastReminderBucket
+		}
+		debtor.LastContactAt = lastContacts[student.StudentId]
+		if promise, ok := promises[student.StudentId]; ok {
+			debtor.PromisedAmount = promise.PromisedAmount
+			debtor.PromisedDate = promise.PromisedDate
+		}
+		debtors = append(debtors, debtor)
+	}
+	sort.Slice(debtors, func(i, j int) bool {
+		return debtors[i].DaysOverdue > debtors[j].DaysOverdue
+	})
+	return debtors, nil
+}
+
+// debtSince finds the oldest take-off not covered by the student's payments. The debt can not be older than
+// the moment the balance went below zero, so the later of both dates is used
+func (r *CollectionRepository) debtSince(companyId, studentId, zeroBalanceUpdatedAt string) (time.Time, error) {
+	var zeroBalanceDate time.Time
+	if zeroBalanceUpdatedAt != "" {
+		zeroBalanceDate, _ = time.Parse("2006-01-02", zeroBalanceUpdatedAt)
+	}
+	rows, err := r.db.Query(`SELECT payment_type, amount, given_date FROM student_payments
+		WHERE student_id=$1 AND company_id=$2 ORDER BY given_date, created_at`, studentId, companyId)
+	if err != nil {
+		return time.Time{}, status.Errorf(codes.Internal, "error while getting payment history %v", err)
+	}
+	defer rows.Close()
+	type takeOff struct {
+		amount    float64
+		givenDate time.Time
+	}
+	var (
+		paid     float64
+		takeOffs []takeOff
+	)
+	for rows.Next() {
+		var (
+			paymentType string
+			amount      float64
+			givenDate   time.Time
+		)
+		if err := rows.Scan(&paymentType, &amount, &givenDate); err != nil {
+			return time.Time{}, status.Errorf(codes.Internal, "failed to scan row: %v", err)
+		}
+		if paymentType == "TAKE_OFF" {
+			takeOffs = append(takeOffs, takeOff{amount: amount, givenDate: givenDate})
+		} else {
+			paid += amount
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return time.Time{}, status.Errorf(codes.Internal, "error iterating rows: %v", err)
+	}
+
+	var oldestUnpaid time.Time
+	var charged float64
+	for _, t := range takeOffs {
+		charged += t.amount
+		if roundAmount(charged) > roundAmount(paid) {
+			oldestUnpaid = t.givenDate
+			break
+		}
+	}
+	switch {
+	case oldestUnpaid.IsZero() && zeroBalanceDate.IsZero():
+		return time.Now().Truncate(24 * time.Hour), nil
+	case oldestUnpaid.After(zeroBalanceDate):
+		return oldestUnpaid, nil
+	}
+	return zeroBalanceDate, nil
+}
+
+func (r *CollectionRepository) collectionCases(companyId string) (map[string]*pb.AbsAgedDebtor, error) {
+	rows, err := r.db.Query(`SELECT student_id, coalesce(assigned_to_id::text, ''), coalesce(assigned_to_name, ''), freeze_flagged, coalesce(last_reminder_bucket, '')
+		FROM collection_case WHERE company_id=$1`, companyId)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to retrieve collection cases: %v", err)
+	}
+	defer rows.Close()
+	cases := make(map[string]*pb.AbsAgedDebtor)
+	for rows.Next() {
+		var c pb.AbsAgedDebtor
+		if err := rows.Scan(&c.StudentId, &c.AssignedToId, &c.AssignedToName, &c.FreezeFlagged, &c.LastReminderBucket); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to scan row: %v", err)
+		}
+		cases[c.StudentId] = &c
+	}
+	return cases, rows.Err()
+}
+
+func (r *CollectionRepository) lastActivities(companyId string) (map[string]string, map[string]*pb.AbsCollectionActivity, error) {
+	lastContacts := make(map[string]string)
+	rows, err := r.db.Query(`SELECT DISTINCT ON (student_id) student_id, created_at FROM collection_activity
+		WHERE company_id=$1 ORDER BY student_id, created_at DESC`, companyId)
+	if err != nil {
+		return nil, nil, status.Errorf(codes.Internal, "failed to retrieve collection activities: %v", err)
+	}
+	for rows.Next() {
+		var studentId string
+		var createdAt time.Time
+		if err := rows.Scan(&studentId, &createdAt); err != nil {
+			rows.Close()
+			return nil, nil, status.Errorf(codes.Internal, "failed to scan row: %v", err)
+		}
+		lastContacts[studentId] = createdAt.Format("2006-01-02 15:04:05")
+	}
+	rows.Close()
+
+	promises := make(map[string]*pb.AbsCollectionActivity)
+	rows, err = r.db.Query(`SELECT DISTINCT ON (student_id) student_id, promised_amount, promised_date FROM collection_activity
+		WHERE company_id=$1 AND activity_type='PROMISE' ORDER BY student_id, created_at DESC`, companyId)
+	if err != nil {
+		return nil, nil, status.Errorf(codes.Internal, "failed to retrieve promises: %v", err)
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var (
+			studentId      string
+			promisedAmount float64
+			promisedDate   time.Time
+		)
+		if err := rows.Scan(&studentId, &promisedAmount, &promisedDate); err != nil {
+			return nil, nil, status.Errorf(codes.Internal, "failed to scan row: %v", err)
+		}
+		promises[studentId] = &pb.AbsCollectionActivity{
+			PromisedAmount: formatAmount(promisedAmount),
+			PromisedDate:   promisedDate.Format("2006-01-02"),
+		}
+	}
+	return lastContacts, promises, rows.Err()
+}
+
+func (r *CollectionRepository) addSystemActivity(companyId, studentId, activityType, comment string) {
+	_, err := r.db.Exec(`INSERT INTO collection_activity (id, student_id, activity_type, comment, created_by_id, created_by_name, company_id)
+		VALUES ($1, $2, $3, $4, '00000000-0000-0000-0000-000000000000', 'TIZIM', $5)`, uuid.New(), studentId, activityType, comment, companyId)
+	if err != nil {
+		fmt.Printf("error while saving collection activity for student %s: %v\n", studentId, err)
+	}
+}
+
+func bucketRank(bucket string) int {
+	for i, name := range agingBuckets {
+		if name == bucket {
+			return i
+		}
+	}
+	return -1
+}
+
+func NewCollectionRepository(db *sql.DB, educationClient *clients.EducationClient, userClient *clients.UserClient) *CollectionRepository {
+	return &CollectionRepository{db: db, educationClient: educationClient, userClient: userClient}
+}
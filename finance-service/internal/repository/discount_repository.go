@@ -9,8 +9,12 @@ import (
 	"finance-service/proto/pb"
 	"fmt"
 	"github.com/google/uuid"
+	"github.com/lib/pq"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"math"
+	"net/http"
+	"strings"
 	"time"
 )
 
@@ -21,29 +25,52 @@ type DiscountRepository struct {
 }
 
 func (r *DiscountRepository) CreateDiscount(ctx context.Context, companyId, groupId string, studentId string, discountPrice, comment, startDate, endDate string, withTeacher bool) error {
+	return r.createDiscount(ctx, companyId, groupId, studentId, discountPrice, comment, startDate, endDate, withTeacher, "", "")
+}
+
+// createDiscount stores the discount, refunds already taken months of the range and records the rule it came from, if any
+func (r *DiscountRepository) createDiscount(ctx context.Context, companyId, groupId string, studentId string, discountPrice, comment, startDate, endDate string, withTeacher bool, ruleId, ruleName string) error {
 	var checker bool
+	// checked outside the transaction: the credits below lock their own periods in transactions of their own, a
+	// period lock held here would wait on them behind a ClosePeriod
+	if err := checkPeriodRangeIsOpen(r.db, companyId, startDate, endDate); err != nil {
+		return err
+	}
 	tx, err := r.db.Begin()
 	if err != nil {
 		return status.Errorf(codes.Aborted, "error while creating transaction %v", err)
 	}
 
-	err = tx.QueryRow(`SELECT EXISTS(SELECT 1 FROM student_discount WHERE group_id=$1 AND student_id=$2 AND company_id=$3)`, groupId, studentId, companyId).Scan(&checker)
+	err = tx.QueryRow(`SELECT EXISTS(SELECT 1 FROM student_discount WHERE group_id=$1 AND student_id=$2 AND company_id=$3 AND end_at >= $4)`, groupId, studentId, companyId, startDate).Scan(&checker)
 	if err != nil {
+		tx.Rollback()
 		return status.Errorf(codes.Internal, "Error checking existing discount: %v", err)
 	}
 	if checker {
+		tx.Rollback()
 		return status.Errorf(codes.AlreadyExists, "Discount already exists")
 	}
+	// a discount that ended before the new one starts gives way to it and stays in the history
+	_, err = tx.Exec(`WITH expired AS (
+			DELETE FROM student_discount WHERE group_id=$1 AND student_id=$2 AND company_id=$3 AND end_at < $4
+			RETURNING student_id, group_id, start_at, end_at, withteacher, comment, discount, rule_id)
+		INSERT INTO student_discount_history (id, student_id, group_id, start_at, end_at, withteacher, comment, action, discount, company_id, rule_id, rule_name)
+		SELECT $5, e.student_id, e.group_id, e.start_at, e.end_at, e.withteacher, e.comment, 'DELETE', e.discount, $3, e.rule_id, COALESCE(dr.name, '')
+		FROM expired e LEFT JOIN discount_rule dr ON dr.id = e.rule_id`, groupId, studentId, companyId, startDate, uuid.New())
+	if err != nil {
+		tx.Rollback()
+		return status.Errorf(codes.Internal, "Error replacing expired discount: %v", err)
+	}
 
-	_, err = tx.Exec(`INSERT INTO student_discount (student_id, discount, group_id, comment, start_at, end_at, withteacher , company_id) 
-		VALUES ($1, $2, $3, $4, $5, $6, $7 , $8)`, studentId, discountPrice, groupId, comment, startDate, endDate, withTeacher, companyId)
+	_, err = tx.Exec(`INSERT INTO student_discount (student_id, discount, group_id, comment, start_at, end_at, withteacher , company_id, rule_id) 
+		VALUES ($1, $2, $3, $4, $5, $6, $7 , $8, NULLIF($9, '')::uuid)`, studentId, discountPrice, groupId, comment, startDate, endDate, withTeacher, companyId, ruleId)
 	if err != nil {
 		tx.Rollback()
 		return status.Errorf(codes.Internal, "Status discount insert error: %v", err)
 	}
 
-	_, err = tx.Exec(`INSERT INTO student_discount_history (id, student_id, group_id, start_at, end_at, withteacher, comment, action , discount, company_id)
-		VALUES ($1, $2, $3, $4, $5 , $6 , $7, $8, $9, $10)`, uuid.New(), studentId, groupId, startDate, endDate, withTeacher, comment, "CREATE", discountPrice, companyId)
+	_, err = tx.Exec(`INSERT INTO student_discount_history (id, student_id, group_id, start_at, end_at, withteacher, comment, action , discount, company_id, rule_id, rule_name)
+		VALUES ($1, $2, $3, $4, $5 , $6 , $7, $8, $9, $10, NULLIF($11, '')::uuid, $12)`, uuid.New(), studentId, groupId, startDate, endDate, withTeacher, comment, "CREATE", discountPrice, companyId, ruleId, ruleName)
 	if err != nil {
 		tx.Rollback()
 		return status.Errorf(codes.Internal, "Error inserting into student history: %v", err)
@@ -103,7 +130,7 @@ func (r *DiscountRepository) CreateDiscount(ctx context.Context, companyId, grou
 			tx.Rollback()
 			return err
 		}
-		err = r.paymentRepo.AddPayment(ctx, companyId, payment.GivenDate, discountAmount, "CASH", "Studentga ushbu tolov amalga oshirilgan kunlar oralig'ida chegirma kiritildi va studentning qolgan puli qaytarib berildi.", studentId, payment.CreatedByName, payment.CreatedByID, groupId, true)
+		err = r.paymentRepo.AddDiscountCredit(ctx, companyId, payment.GivenDate, discountAmount, "Studentga ushbu tolov amalga oshirilgan kunlar oralig'ida chegirma kiritildi va studentning qolgan puli qaytarib berildi.", studentId, payment.CreatedByName, payment.CreatedByID, groupId)
 		if err != nil {
 			tx.Rollback()
 			return err
@@ -117,30 +144,61 @@ func (r *DiscountRepository) CreateDiscount(ctx context.Context, companyId, grou
 	return nil
 }
 
-func (r *DiscountRepository) DeleteDiscount(companyId, groupId string, studentId string) error {
-	var discount pb.AbsDiscountRequest
-	var createdAt string
-	err := r.db.QueryRow(`SELECT student_id, discount, group_id, comment, start_at, end_at, withteacher, created_at FROM student_discount where student_id=$1 and group_id=$2 and company_id=$3 `, studentId, groupId, companyId).Scan(
-		&discount.StudentId,
-		&discount.DiscountPrice,
-		&discount.GroupId,
-		&discount.Comment,
-		&discount.StartDate,
-		&discount.EndDate,
-		&discount.WithTeacher,
-		&createdAt,
-	)
+func (r *DiscountRepository) DeleteDiscount(companyId, groupId string, studentId string) (err error) {
+	type deletedDiscount struct {
+		discount, comment, startAt, endAt, ruleId, ruleName string
+		withTeacher                                         bool
+	}
+	tx, err := r.db.Begin()
 	if err != nil {
-		return status.Errorf(codes.NotFound, err.Error())
+		return status.Errorf(codes.Aborted, "error while creating transaction %v", err)
 	}
-	_, err = r.db.Exec(`DELETE FROM student_discount where group_id=$1 and student_id=$2 and company_id=$3`, groupId, studentId, companyId)
+	defer func() {
+		if err != nil {
+			tx.Rollback()
+		} else {
+			tx.Commit()
+		}
+	}()
+	rows, err := tx.Query(`SELECT d.discount, d.comment, d.start_at, d.end_at, d.withteacher, COALESCE(d.rule_id::text, ''), COALESCE(dr.name, '')
+		FROM student_discount d LEFT JOIN discount_rule dr ON dr.id = d.rule_id
+		where d.student_id=$1 and d.group_id=$2 and d.company_id=$3 FOR UPDATE OF d`, studentId, groupId, companyId)
 	if err != nil {
-		return status.Errorf(codes.Internal, "Error while deleting disount %f", err)
+		return status.Errorf(codes.Internal, "Error while getting discount: %v", err)
+	}
+	var deleted []deletedDiscount
+	for rows.Next() {
+		var row deletedDiscount
+		if err = rows.Scan(&row.discount, &row.comment, &row.startAt, &row.endAt, &row.withTeacher, &row.ruleId, &row.ruleName); err != nil {
+			rows.Close()
+			return status.Errorf(codes.Internal, "Error while scanning discount: %v", err)
+		}
+		deleted = append(deleted, row)
 	}
-	_, err = r.db.Exec(`INSERT INTO student_discount_history (id, student_id, group_id, start_at, end_at, withteacher, comment, action , discount , company_id)
-		VALUES ($1, $2, $3, $4, $5 , $6 , $7, $8 , $9 , $10)`, uuid.New(), studentId, groupId, discount.StartDate, discount.EndDate, discount.WithTeacher, discount.Comment, "DELETE", discount.DiscountPrice, companyId)
+	rows.Close()
+	if err = rows.Err(); err != nil {
+		return status.Errorf(codes.Internal, "Error while getting discount: %v", err)
+	}
+	if len(deleted) == 0 {
+		err = status.Errorf(codes.NotFound, "discount not found")
+		return err
+	}
+	// every deleted row has to be outside closed periods, not just the first one
+	for _, row := range deleted {
+		if err = checkPeriodRangeIsOpen(tx, companyId, row.startAt, row.endAt); err != nil {
+			return err
+		}
+	}
+	_, err = tx.Exec(`DELETE FROM student_discount where group_id=$1 and student_id=$2 and company_id=$3`, groupId, studentId, companyId)
 	if err != nil {
-		return status.Errorf(codes.Internal, "Error inserting into student history: %v", err)
+		return status.Errorf(codes.Internal, "Error while deleting disount %v", err)
+	}
+	for _, row := range deleted {
+		_, err = tx.Exec(`INSERT INTO student_discount_history (id, student_id, group_id, start_at, end_at, withteacher, comment, action , discount , company_id, rule_id, rule_name)
+			VALUES ($1, $2, $3, $4, $5 , $6 , $7, $8 , $9 , $10, NULLIF($11, '')::uuid, $12)`, uuid.New(), studentId, groupId, row.startAt, row.endAt, row.withTeacher, row.comment, "DELETE", row.discount, companyId, row.ruleId, row.ruleName)
+		if err != nil {
+			return status.Errorf(codes.Internal, "Error inserting into student history: %v", err)
+		}
 	}
 	return nil
 }
@@ -168,7 +226,7 @@ func (r *DiscountRepository) GetAllDiscountByGroup(ctx context.Context, companyI
 
 func (r *DiscountRepository) GetHistoryDiscount(ctx context.Context, companyId, id string) (*pb.GetHistoryDiscountResponse, error) {
 	query := `
-		SELECT group_id, student_id,discount, comment, start_at, end_at, withTeacher, action, created_at
+		SELECT group_id, student_id,discount, comment, start_at, end_at, withTeacher, action, created_at, COALESCE(rule_id::text, ''), rule_name
 		FROM student_discount_history
 		WHERE group_id = $1
 	`
@@ -196,6 +254,8 @@ func (r *DiscountRepository) GetHistoryDiscount(ctx context.Context, companyId,
 			&discount.WithTeacher,
 			&discount.Action,
 			&createdAt,
+			&discount.RuleId,
+			&discount.RuleName,
 		); err != nil {
 			return nil, err
 		}
@@ -256,6 +316,360 @@ func (r *DiscountRepository) GetDiscountByStudentId(companyId, studentId, groupI
 	return nil, fmt.Errorf("current time is not within the discount period")
 }
 
+// GetDiscountsByStudentIds is GetDiscountByStudentId for many students of a group in one query: the latest discount
+// of each student counts and only while it is running
+func (r *DiscountRepository) GetDiscountsByStudentIds(companyId, groupId string, studentIds []string) (*pb.GetDiscountsByStudentIdsResponse, error) {
+	rows, err := r.db.Query(`SELECT DISTINCT ON (student_id) student_id, discount, start_at, end_at, withteacher FROM student_discount
+		WHERE group_id = $1 AND company_id = $2 AND student_id::text = ANY ($3)
+		ORDER BY student_id, created_at DESC`,
+		groupId, companyId, pq.Array(studentIds))
+	if err != nil {
+		return nil, fmt.Errorf("failed to query database: %v", err)
+	}
+	defer rows.Close()
+	var response pb.GetDiscountsByStudentIdsResponse
+	now := time.Now()
+	for rows.Next() {
+		var (
+			discount       pb.StudentGroupDiscount
+			amount         float64
+			startAt, endAt time.Time
+			withTeacher    bool
+		)
+		if err := rows.Scan(&discount.StudentId, &amount, &startAt, &endAt, &withTeacher); err != nil {
+			return nil, fmt.Errorf("failed to scan discount: %v", err)
+		}
+		if !now.After(startAt) || !now.Before(endAt) {
+			continue
+		}
+		discount.Amount = fmt.Sprintf("%.2f", amount)
+		discount.DiscountOwner = "CENTER"
+		if withTeacher {
+			discount.DiscountOwner = "TEACHER"
+		}
+		response.Discounts = append(response.Discounts, &discount)
+	}
+	return &response, rows.Err()
+}
+
+var discountRuleTypes = map[string]bool{"TEMPLATE": true, "SIBLING": true, "MULTI_COURSE": true, "PROMO_CODE": true}
+
+func validateDiscountRule(req *pb.AbsDiscountRule) error {
+	if strings.TrimSpace(req.Name) == "" {
+		return status.Errorf(codes.InvalidArgument, "rule name is required")
+	}
+	if !discountRuleTypes[req.RuleType] {
+		return status.Errorf(codes.InvalidArgument, "rule type must be TEMPLATE, SIBLING, MULTI_COURSE or PROMO_CODE")
+	}
+	switch req.ValueType {
+	case "PERCENT":
+		if req.Value <= 0 || req.Value > 100 {
+			return status.Errorf(codes.InvalidArgument, "percent value must be between 0 and 100")
+		}
+	case "FIXED":
+		if req.Value <= 0 {
+			return status.Errorf(codes.InvalidArgument, "fixed value must be positive")
+		}
+	default:
+		return status.Errorf(codes.InvalidArgument, "value type must be PERCENT or FIXED")
+	}
+	if req.RuleType == "PROMO_CODE" && strings.TrimSpace(req.PromoCode) == "" {
+		return status.Errorf(codes.InvalidArgument, "promo code is required for PROMO_CODE rules")
+	}
+	if req.DurationMonths < 0 || req.UsageLimit < 0 {
+		return status.Errorf(codes.InvalidArgument, "duration and usage limit must not be negative")
+	}
+	for _, date := range []string{req.ValidFrom, req.ValidUntil} {
+		if date == "" {
+			continue
+		}
+		if _, err := time.Parse("2006-01-02", date); err != nil {
+			return status.Errorf(codes.InvalidArgument, "invalid date %s, expected YYYY-MM-DD", date)
+		}
+	}
+	return nil
+}
+
+// discountRuleArgs returns the nullable columns of a rule, the promo code is kept only for PROMO_CODE rules
+func discountRuleArgs(req *pb.AbsDiscountRule) (promoCode, validFrom, validUntil, courseId sql.NullString, minCourses int32) {
+	if req.RuleType == "PROMO_CODE" {
+		promoCode = sql.NullString{String: strings.ToUpper(strings.TrimSpace(req.PromoCode)), Valid: true}
+	}
+	validFrom = sql.NullString{String: req.ValidFrom, Valid: req.ValidFrom != ""}
+	validUntil = sql.NullString{String: req.ValidUntil, Valid: req.ValidUntil != ""}
+	courseId = sql.NullString{String: req.CourseId, Valid: req.CourseId != ""}
+	minCourses = req.MinCourses
+	if minCourses < 2 {
+		minCourses = 2
+	}
+	return
+}
+
+func (r *DiscountRepository) CreateDiscountRule(companyId string, req *pb.AbsDiscountRule) (*pb.AbsResponse, error) {
+	if err := validateDiscountRule(req); err != nil {
+		return nil, err
+	}
+	promoCode, validFrom, validUntil, courseId, minCourses := discountRuleArgs(req)
+	id := uuid.New()
+	_, err := r.db.Exec(`INSERT INTO discount_rule (id, name, rule_type, value_type, value, with_teacher, duration_months, promo_code, usage_limit,
+			valid_from, valid_until, min_courses, course_id, company_id)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14)`,
+		id, strings.TrimSpace(req.Name), req.RuleType, req.ValueType, req.Value, req.WithTeacher, req.DurationMonths, promoCode, req.UsageLimit,
+		validFrom, validUntil, minCourses, courseId, companyId)
+	if err != nil {
+		if strings.Contains(err.Error(), "idx_discount_rule_promo_code") {
+			return nil, status.Errorf(codes.AlreadyExists, "promo code %s already exists", promoCode.String)
+		}
+		return nil, status.Errorf(codes.Internal, "error while creating discount rule: %v", err)
+	}
+	return &pb.AbsResponse{
+		Status:  http.StatusCreated,
+		Message: id.String(),
+	}, nil
+}
+
+func (r *DiscountRepository) UpdateDiscountRule(companyId string, req *pb.AbsDiscountRule) (*pb.AbsResponse, error) {
+	if err := validateDiscountRule(req); err != nil {
+		return nil, err
+	}
+	promoCode, validFrom, validUntil, courseId, minCourses := discountRuleArgs(req)
+	result, err := r.db.Exec(`UPDATE discount_rule SET name = $1, rule_type = $2, value_type = $3, value = $4, with_teacher = $5, duration_months = $6,
+			promo_code = $7, usage_limit = $8, valid_from = $9, valid_until = $10, min_courses = $11, course_id = $12, is_active = $13
+		WHERE id = $14 AND company_id = $15`,
+		strings.TrimSpace(req.Name), req.RuleType, req.ValueType, req.Value, req.WithTeacher, req.DurationMonths,
+		promoCode, req.UsageLimit, validFrom, validUntil, minCourses, courseId, req.IsActive, req.Id, companyId)
+	if err != nil {
+		if strings.Contains(err.Error(), "idx_discount_rule_promo_code") {
+			return nil, status.Errorf(codes.AlreadyExists, "promo code %s already exists", promoCode.String)
+		}
+		return nil, status.Errorf(codes.Internal, "error while updating discount rule: %v", err)
+	}
+	if affected, _ := result.RowsAffected(); affected == 0 {
+		return nil, status.Errorf(codes.NotFound, "discount rule not found")
+	}
+	return &pb.AbsResponse{
+		Status:  http.StatusOK,
+		Message: "discount rule updated",
+	}, nil
+}
+
+const discountRuleSelect = `SELECT id, name, rule_type, value_type, value, with_teacher, duration_months, COALESCE(promo_code, ''), usage_limit, used_count,
+		valid_from, valid_until, min_courses, COALESCE(course_id::text, ''), is_active, created_at
+	FROM discount_rule`
+
+func scanDiscountRule(row interface{ Scan(...any) error }) (*pb.AbsDiscountRule, error) {
+	var (
+		rule                  pb.AbsDiscountRule
+		validFrom, validUntil sql.NullTime
+		createdAt             time.Time
+	)
+	err := row.Scan(&rule.Id, &rule.Name, &rule.RuleType, &rule.ValueType, &rule.Value, &rule.WithTeacher, &rule.DurationMonths, &rule.PromoCode,
+		&rule.UsageLimit, &rule.UsedCount, &validFrom, &validUntil, &rule.MinCourses, &rule.CourseId, &rule.IsActive, &createdAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, status.Errorf(codes.NotFound, "discount rule not found")
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to scan discount rule: %v", err)
+	}
+	if validFrom.Valid {
+		rule.ValidFrom = validFrom.Time.Format("2006-01-02")
+	}
+	if validUntil.Valid {
+		rule.ValidUntil = validUntil.Time.Format("2006-01-02")
+	}
+	rule.CreatedAt = createdAt.Format("2006-01-02 15:04:05")
+	return &rule, nil
+}
+
+func (r *DiscountRepository) GetDiscountRules(companyId string, includeInactive bool) (*pb.GetDiscountRulesResponse, error) {
+	rows, err := r.db.Query(discountRuleSelect+`
+		WHERE company_id = $1 AND (is_active OR $2)
+		ORDER BY created_at DESC`, companyId, includeInactive)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to retrieve discount rules: %v", err)
+	}
+	defer rows.Close()
+	var response pb.GetDiscountRulesResponse
+	for rows.Next() {
+		rule, err := scanDiscountRule(rows)
+		if err != nil {
+			return nil, err
+		}
+		response.Rules = append(response.Rules, rule)
+	}
+	return &response, rows.Err()
+}
+
+// ruleDiscountAmount is the monthly amount a rule takes off the course price
+func ruleDiscountAmount(rule *pb.AbsDiscountRule, coursePrice float64) float64 {
+	amount := rule.Value
+	if rule.ValueType == "PERCENT" {
+		amount = coursePrice * rule.Value / 100
+	}
+	return roundAmount(math.Min(amount, coursePrice))
+}
+
+// ApplyDiscountRules gives the student the most generous applicable SIBLING, MULTI_COURSE or promo code rule for the group.
+// Nothing is applied when the student already has a discount running in the group on the date, an unusable promo code is an error
+func (r *DiscountRepository) ApplyDiscountRules(ctx context.Context, companyId string, req *pb.ApplyDiscountRulesRequest) (*pb.ApplyDiscountRulesResponse, error) {
+	promoCode := strings.ToUpper(strings.TrimSpace(req.PromoCode))
+	date := req.Date
+	if date == "" {
+		date = time.Now().Format("2006-01-02")
+	}
+	if len(date) > 10 {
+		date = date[:10]
+	}
+	var exists bool
+	err := r.db.QueryRow(`SELECT EXISTS(SELECT 1 FROM student_discount WHERE student_id = $1 AND group_id = $2 AND company_id = $3 AND end_at >= $4)`,
+		req.StudentId, req.GroupId, companyId, date).Scan(&exists)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "error checking existing discount: %v", err)
+	}
+	if exists {
+		if promoCode != "" {
+			return nil, status.Errorf(codes.FailedPrecondition, "student already has a discount in this group")
+		}
+		return &pb.ApplyDiscountRulesResponse{Message: "student already has a discount in this group"}, nil
+	}
+	rows, err := r.db.Query(discountRuleSelect+`
+		WHERE company_id = $1 AND is_active
+			AND (valid_from IS NULL OR valid_from <= $2) AND (valid_until IS NULL OR valid_until >= $2)
+			AND (rule_type IN ('SIBLING', 'MULTI_COURSE') OR (rule_type = 'PROMO_CODE' AND promo_code = $3 AND (usage_limit = 0 OR used_count < usage_limit)))`,
+		companyId, date, promoCode)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to retrieve discount rules: %v", err)
+	}
+	var rules []*pb.AbsDiscountRule
+	for rows.Next() {
+		rule, err := scanDiscountRule(rows)
+		if err != nil {
+			rows.Close()
+			return nil, err
+		}
+		rules = append(rules, rule)
+	}
+	rows.Close()
+	if len(rules) == 0 {
+		if promoCode != "" {
+			return nil, status.Errorf(codes.InvalidArgument, "promo code %s is invalid, expired or used up", promoCode)
+		}
+		return &pb.ApplyDiscountRulesResponse{Message: "no applicable discount rule"}, nil
+	}
+
+	eduCtx, cancelFunc := utils.NewTimoutContext(ctx, companyId)
+	defer cancelFunc()
+	discountContext, err := r.studentClient.GetDiscountContext(eduCtx, req.StudentId, req.GroupId)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get discount context: %v", err)
+	}
+	var (
+		best       *pb.AbsDiscountRule
+		bestAmount float64
+		promoFound bool
+	)
+	for _, rule := range rules {
+		if rule.CourseId != "" && rule.CourseId != discountContext.CourseId {
+			continue
+		}
+		switch rule.RuleType {
+		case "SIBLING":
+			if discountContext.SiblingCount < 1 {
+				continue
+			}
+		case "MULTI_COURSE":
+			if discountContext.CourseCount < rule.MinCourses {
+				continue
+			}
+		case "PROMO_CODE":
+			promoFound = true
+		}
+		if amount := ruleDiscountAmount(rule, discountContext.CoursePrice); amount > bestAmount {
+			best, bestAmount = rule, amount
+		}
+	}
+	if promoCode != "" && !promoFound {
+		return nil, status.Errorf(codes.InvalidArgument, "promo code %s does not apply to this course", promoCode)
+	}
+	if best == nil {
+		return &pb.ApplyDiscountRulesResponse{Message: "no applicable discount rule"}, nil
+	}
+	return r.applyDiscountRule(ctx, companyId, best, bestAmount, discountContext.GroupEndDate, req.StudentId, req.GroupId, date, req.ActionById, req.ActionByName)
+}
+
+// ApplyDiscountTemplate applies a chosen rule, usually a TEMPLATE one, to the student by hand
+func (r *DiscountRepository) ApplyDiscountTemplate(ctx context.Context, companyId string, req *pb.ApplyDiscountTemplateRequest) (*pb.ApplyDiscountRulesResponse, error) {
+	rule, err := scanDiscountRule(r.db.QueryRow(discountRuleSelect+` WHERE id = $1 AND company_id = $2`, req.RuleId, companyId))
+	if err != nil {
+		return nil, err
+	}
+	if !rule.IsActive {
+		return nil, status.Errorf(codes.FailedPrecondition, "discount rule is not active")
+	}
+	date := req.StartDate
+	if date == "" {
+		date = time.Now().Format("2006-01-02")
+	}
+	eduCtx, cancelFunc := utils.NewTimoutContext(ctx, companyId)
+	defer cancelFunc()
+	discountContext, err := r.studentClient.GetDiscountContext(eduCtx, req.StudentId, req.GroupId)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get discount context: %v", err)
+	}
+	if rule.CourseId != "" && rule.CourseId != discountContext.CourseId {
+		return nil, status.Errorf(codes.FailedPrecondition, "discount rule does not apply to this course")
+	}
+	return r.applyDiscountRule(ctx, companyId, rule, ruleDiscountAmount(rule, discountContext.CoursePrice), discountContext.GroupEndDate,
+		req.StudentId, req.GroupId, date, req.ActionById, req.ActionByName)
+}
+
+func (r *DiscountRepository) applyDiscountRule(ctx context.Context, companyId string, rule *pb.AbsDiscountRule, amount float64, groupEndDate, studentId, groupId, date, actionById, actionByName string) (*pb.ApplyDiscountRulesResponse, error) {
+	startDate, err := time.Parse("2006-01-02", date)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid date %s, expected YYYY-MM-DD", date)
+	}
+	endDate := startDate.AddDate(0, int(rule.DurationMonths), -1)
+	if rule.DurationMonths == 0 {
+		endDate, err = time.Parse("2006-01-02", groupEndDate)
+		if err != nil || endDate.Before(startDate) {
+			endDate = startDate.AddDate(0, 1, -1)
+		}
+	}
+	// the usage is reserved first so concurrent enrollments cannot exceed the limit
+	result, err := r.db.Exec(`UPDATE discount_rule SET used_count = used_count + 1 WHERE id = $1 AND (usage_limit = 0 OR used_count < usage_limit)`, rule.Id)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to reserve discount rule usage: %v", err)
+	}
+	if affected, _ := result.RowsAffected(); affected == 0 {
+		return nil, status.Errorf(codes.FailedPrecondition, "discount rule %s is used up", rule.Name)
+	}
+	comment := fmt.Sprintf("Chegirma qoidasi: %s", rule.Name)
+	if rule.PromoCode != "" {
+		comment = fmt.Sprintf("Promokod %s: %s", rule.PromoCode, rule.Name)
+	}
+	err = r.createDiscount(ctx, companyId, groupId, studentId, fmt.Sprintf("%.2f", amount), comment,
+		startDate.Format("2006-01-02"), endDate.Format("2006-01-02"), rule.WithTeacher, rule.Id, rule.Name)
+	if err != nil {
+		_, _ = r.db.Exec(`UPDATE discount_rule SET used_count = used_count - 1 WHERE id = $1 AND used_count > 0`, rule.Id)
+		return nil, err
+	}
+	if actionById == "" {
+		actionById, actionByName = "00000000-0000-0000-0000-000000000000", "TIZIM"
+	}
+	_, err = r.db.Exec(`INSERT INTO discount_rule_application (id, rule_id, student_id, group_id, discount, applied_by_id, applied_by_name, company_id)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)`, uuid.New(), rule.Id, studentId, groupId, amount, actionById, actionByName, companyId)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "discount applied but usage was not recorded: %v", err)
+	}
+	return &pb.ApplyDiscountRulesResponse{
+		Applied:  true,
+		RuleId:   rule.Id,
+		RuleName: rule.Name,
+		Discount: amount,
+		Message:  comment,
+	}, nil
+}
+
 func NewDiscountRepository(db *sql.DB, studentClient *clients.EducationClient, paymentRepo *PaymentRepository) *DiscountRepository {
 	return &DiscountRepository{db: db, studentClient: studentClient, paymentRepo: paymentRepo}
 }
@@ -0,0 +1,59 @@
+package repository
+
+import (
+	"finance-service/proto/pb"
+	"testing"
+)
+
+func TestRuleDiscountAmount(t *testing.T) {
+	tests := []struct {
+		name        string
+		rule        *pb.AbsDiscountRule
+		coursePrice float64
+		want        float64
+	}{
+		{
+			name:        "percent of the course price",
+			rule:        &pb.AbsDiscountRule{ValueType: "PERCENT", Value: 10},
+			coursePrice: 600000,
+			want:        60000,
+		},
+		{
+			name:        "percent is rounded to tiyin",
+			rule:        &pb.AbsDiscountRule{ValueType: "PERCENT", Value: 15},
+			coursePrice: 333333.33,
+			want:        50000,
+		},
+		{
+			name:        "fixed amount",
+			rule:        &pb.AbsDiscountRule{ValueType: "FIXED", Value: 100000},
+			coursePrice: 600000,
+			want:        100000,
+		},
+		{
+			name:        "fixed amount above the course price",
+			rule:        &pb.AbsDiscountRule{ValueType: "FIXED", Value: 700000},
+			coursePrice: 600000,
+			want:        600000,
+		},
+		{
+			name:        "percent above hundred",
+			rule:        &pb.AbsDiscountRule{ValueType: "PERCENT", Value: 120},
+			coursePrice: 600000,
+			want:        600000,
+		},
+		{
+			name:        "free course",
+			rule:        &pb.AbsDiscountRule{ValueType: "FIXED", Value: 100000},
+			coursePrice: 0,
+			want:        0,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ruleDiscountAmount(tt.rule, tt.coursePrice); got != tt.want {
+				t.Errorf("amount = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
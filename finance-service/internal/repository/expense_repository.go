@@ -3,42 +3,110 @@ package repository
 import (
 	"context"
 	"database/sql"
+	"errors"
 	"finance-service/internal/clients"
 	"finance-service/internal/utils"
 	"finance-service/proto/pb"
 	"fmt"
 	"github.com/google/uuid"
+	"github.com/lib/pq"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"net/http"
+	"slices"
+	"time"
 )
 
+var budgetThresholds = []int{80, 100}
+
 type ExpenseRepository struct {
-	db         *sql.DB
-	userClient *clients.UserClient
+	db              *sql.DB
+	userClient      *clients.UserClient
+	educationClient *clients.EducationClient
 }
 
-func (r *ExpenseRepository) CreateExpense(ctx context.Context, companyId, title, givenDate, expenseType, categoryId, userId, sum, createdBy, paymentMethod string) error {
-	query := `
-		INSERT INTO expense (
-			id, title, user_id, category_id, expense_type, sum, created_at , given_date, created_by, payment_method,company_id
-		) VALUES (
-		 $1, $2, $3, $4, $5, $6, NOW(), $7, $8 , $9, $10
-		)
-	`
-	var err error
-	if expenseType == "USER" {
-		_, err = r.db.Exec(query, uuid.New(), title, userId, nil, expenseType, sum, givenDate, createdBy, paymentMethod, companyId)
+// CreateExpense saves the expense as a draft or submits it right away. Expenses above the company
+// approval thresholds wait for CEO or FINANCIST approval, smaller ones are booked as paid
+func (r *ExpenseRepository) CreateExpense(ctx context.Context, companyId string, req *pb.CreateExpenseRequest) (*pb.AbsResponse, error) {
+	sum, err := utils.ParseAmount(req.Sum)
+	if err != nil || sum <= 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "sum must be a positive number")
+	}
+	var userId, categoryId, vendorId interface{}
+	if req.ExpenseType == "USER" {
+		userId = req.UserId
 	} else {
-		_, err = r.db.Exec(query, uuid.New(), title, nil, categoryId, expenseType, sum, givenDate, createdBy, paymentMethod, companyId)
+		categoryId = req.CategoryId
+	}
+	if err = checkVendor(r.db, companyId, req.VendorId); err != nil {
+		return nil, err
+	}
+	if req.VendorId != "" {
+		vendorId = req.VendorId
 	}
 
+	expenseStatus, approverRole := "DRAFT", ""
+	if !req.AsDraft {
+		expenseStatus, approverRole, err = r.routeExpense(companyId, sum, req.CreatedByRole)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	tx, err := r.db.Begin()
 	if err != nil {
-		return status.Errorf(codes.Aborted, "error while inserting expense %v", err)
+		return nil, status.Errorf(codes.Aborted, "error while creating transaction %v", err)
 	}
-	return nil
+	defer func() {
+		if err != nil {
+			tx.Rollback()
+		}
+	}()
+	if err = checkPeriodIsOpen(tx, companyId, req.GivenDate); err != nil {
+		return nil, err
+	}
+	id := uuid.New()
+	_, err = tx.Exec(`INSERT INTO expense (id, title, user_id, category_id, expense_type, sum, created_at, given_date, created_by, payment_method, vendor_id, status, approver_role, company_id)
+		VALUES ($1, $2, $3, $4, $5, $6, NOW(), $7, $8, $9, $10, $11, $12, $13)`,
+		id, req.Title, userId, categoryId, req.ExpenseType, sum, req.GivenDate, req.CreatedById, req.PaymentMethod, vendorId, expenseStatus, nullIfEmpty(approverRole), companyId)
+	if err != nil {
+		return nil, status.Errorf(codes.Aborted, "error while inserting expense %v", err)
+	}
+	err = insertExpenseHistory(tx, companyId, id.String(), "", expenseStatus, "", req.CreatedById, req.CreatedByName)
+	if err != nil {
+		return nil, err
+	}
+	// approvers are told and the budget is summed only once the expense is committed and unlocked
+	if err = tx.Commit(); err != nil {
+		return nil, status.Errorf(codes.Internal, "error while committing expense %v", err)
+	}
+	r.afterExpenseStatusChange(ctx, companyId, expenseStatus, approverRole, req.Title, req.ExpenseType, req.CategoryId, req.GivenDate, sum)
+	return &pb.AbsResponse{
+		Status:  http.StatusCreated,
+		Message: id.String(),
+	}, nil
 }
-func (r *ExpenseRepository) DeleteExpense(companyId, id string) error {
-	_, err := r.db.Exec(`DELETE FROM expense where id=$1 and company_id=$2`, id, companyId)
+func (r *ExpenseRepository) DeleteExpense(companyId, id string) (err error) {
+	tx, err := r.db.Begin()
+	if err != nil {
+		return status.Errorf(codes.Aborted, "error while creating transaction %v", err)
+	}
+	defer func() {
+		if err != nil {
+			tx.Rollback()
+		} else {
+			err = tx.Commit()
+		}
+	}()
+	var givenDate string
+	err = tx.QueryRow(`SELECT given_date FROM expense where id=$1 and company_id=$2 FOR UPDATE`, id, companyId).Scan(&givenDate)
+	if err != nil {
+		return status.Errorf(codes.NotFound, "expense not found %v", err)
+	}
+	if err = checkPeriodIsOpen(tx, companyId, givenDate); err != nil {
+		return err
+	}
+	_, err = tx.Exec(`DELETE FROM expense where id=$1 and company_id=$2`, id, companyId)
 	if err != nil {
 		return status.Errorf(codes.Aborted, "error while deleting expense %v", err)
 	}
@@ -58,7 +126,7 @@ func (r *ExpenseRepository) GetExpenseDiagram(ctx context.Context, companyId, to
 			user_id
 		FROM expense e 
 		LEFT JOIN category c ON e.category_id = c.id
-		WHERE e.given_date BETWEEN $1 AND $2 and e.company_id=$3
+		WHERE e.given_date BETWEEN $1 AND $2 and e.company_id=$3 AND e.status IN ('APPROVED', 'PAID')
 		GROUP BY userOrCategories, month, expense_type, user_id
 		ORDER BY month;
 		`
@@ -113,9 +181,47 @@ func (r *ExpenseRepository) GetExpenseDiagram(ctx context.Context, companyId, to
 
 	response.AmountCommonExpense = fmt.Sprintf("%.2f", commonExpense)
 
+	response.Budgets, err = r.getBudgetsVsActual(companyId, from, to)
+	if err != nil {
+		return nil, err
+	}
+
 	return &response, nil
 }
-func (r *ExpenseRepository) GetAllExpense(ctx context.Context, companyId string, page, size int32, from, to, idType string, id interface{}) (*pb.GetAllExpenseResponse, error) {
+
+// getBudgetsVsActual returns spend of every budgeted category for each month between from and to
+func (r *ExpenseRepository) getBudgetsVsActual(companyId, from, to string) ([]*pb.CategoryBudget, error) {
+	rows, err := r.db.Query(`
+		SELECT c.id, c.name, c.monthly_budget, TO_CHAR(m.month, 'YYYY-MM'), COALESCE(SUM(e.sum), 0)
+		FROM category c
+		CROSS JOIN generate_series(date_trunc('month', $1::date), $2::date, interval '1 month') AS m(month)
+		LEFT JOIN expense e ON e.category_id = c.id AND e.company_id = c.company_id
+			AND e.given_date >= m.month AND e.given_date < m.month + interval '1 month' AND e.status IN ('APPROVED', 'PAID')
+		WHERE c.company_id = $3 AND c.monthly_budget IS NOT NULL
+		GROUP BY c.id, c.name, c.monthly_budget, m.month
+		ORDER BY m.month, c.name`, from, to, companyId)
+	if err != nil {
+		return nil, fmt.Errorf("error while getting category budgets: %v", err)
+	}
+	defer rows.Close()
+	var budgets []*pb.CategoryBudget
+	for rows.Next() {
+		var (
+			budget     pb.CategoryBudget
+			categoryId int32
+		)
+		if err := rows.Scan(&categoryId, &budget.CategoryName, &budget.Budget, &budget.Month, &budget.Actual); err != nil {
+			return nil, fmt.Errorf("error scanning category budget: %v", err)
+		}
+		budget.CategoryId = fmt.Sprintf("%d", categoryId)
+		if budget.Budget > 0 {
+			budget.Percent = roundAmount(budget.Actual * 100 / budget.Budget)
+		}
+		budgets = append(budgets, &budget)
+	}
+	return budgets, rows.Err()
+}
+func (r *ExpenseRepository) GetAllExpense(ctx context.Context, companyId string, page, size int32, from, to, idType string, id interface{}, expenseStatus string) (*pb.GetAllExpenseResponse, error) {
 	offset := (page - 1) * size
 
 	// Validate input parameters
@@ -136,9 +242,15 @@ func (r *ExpenseRepository) GetAllExpense(ctx context.Context, companyId string,
             e.sum,
             e.created_by,
             e.payment_method,
-            e.created_at
+            e.created_at,
+            e.status,
+            COALESCE(e.approver_role, ''),
+            COALESCE(CAST(e.vendor_id AS TEXT), ''),
+            COALESCE(v.name, ''),
+            (SELECT COUNT(*) FROM expense_receipt er WHERE er.expense_id = e.id)
         FROM expense e
         LEFT JOIN category c ON e.category_id = c.id
+        LEFT JOIN vendor v ON e.vendor_id = v.id
         WHERE e.given_date BETWEEN $1 AND $2 AND e.company_id = $3`
 
 	args := []interface{}{from, to, companyId}
@@ -146,19 +258,20 @@ func (r *ExpenseRepository) GetAllExpense(ctx context.Context, companyId string,
 
 	// Add filters based on idType
 	switch idType {
-	case "USER", "CATEGORY":
+	case "USER", "CATEGORY", "VENDOR":
 		paramCount++
-		fieldName := "e.user_id"
-		if idType == "CATEGORY" {
-			fieldName = "e.category_id"
-		}
-		baseQuery += fmt.Sprintf(" AND %s = $%d", fieldName, paramCount)
+		baseQuery += fmt.Sprintf(" AND %s = $%d", expenseFilterField(idType), paramCount)
 		args = append(args, id)
 	case "":
 		// No filter needed
 	default:
 		return nil, fmt.Errorf("invalid idType: %s", idType)
 	}
+	if expenseStatus != "" {
+		paramCount++
+		baseQuery += fmt.Sprintf(" AND e.status = $%d", paramCount)
+		args = append(args, expenseStatus)
+	}
 
 	// Add pagination
 	baseQuery += fmt.Sprintf(" ORDER BY e.created_at DESC LIMIT $%d OFFSET $%d", paramCount+1, paramCount+2)
@@ -175,7 +288,7 @@ func (r *ExpenseRepository) GetAllExpense(ctx context.Context, companyId string,
 	}
 
 	// Get total count
-	totalCount, err := r.getTotalCount(ctx, from, to, companyId, idType, id)
+	totalCount, err := r.getTotalCount(ctx, from, to, companyId, idType, id, expenseStatus)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get total count: %w", err)
 	}
@@ -210,7 +323,7 @@ func (r *ExpenseRepository) fetchExpenses(ctx context.Context, query string, arg
 	return expenses, nil
 }
 
-func (r *ExpenseRepository) scanExpense(ctx context.Context, rows *sql.Rows) (*pb.GetAllExpenseAbs, error) {
+func (r *ExpenseRepository) scanExpense(ctx context.Context, rows interface{ Scan(...any) error }) (*pb.GetAllExpenseAbs, error) {
 	var expense pb.GetAllExpenseAbs
 	var (
 		categoryID                            int
@@ -233,6 +346,11 @@ func (r *ExpenseRepository) scanExpense(ctx context.Context, rows *sql.Rows) (*p
 		&createdBy,
 		&paymentMethod,
 		&expense.CreatedAt,
+		&expense.Status,
+		&expense.ApproverRole,
+		&expense.VendorId,
+		&expense.VendorName,
+		&expense.ReceiptCount,
 	)
 	if err != nil {
 		return nil, fmt.Errorf("error scanning expense row: %w", err)
@@ -276,7 +394,7 @@ func (r *ExpenseRepository) enrichUserDetails(ctx context.Context, expense *pb.G
 	return nil
 }
 
-func (r *ExpenseRepository) getTotalCount(ctx context.Context, from, to, companyId, idType string, id interface{}) (int32, error) {
+func (r *ExpenseRepository) getTotalCount(ctx context.Context, from, to, companyId, idType string, id interface{}, expenseStatus string) (int32, error) {
 	countQuery := `
         SELECT COUNT(*)
         FROM expense e
@@ -285,13 +403,13 @@ func (r *ExpenseRepository) getTotalCount(ctx context.Context, from, to, company
 
 	args := []interface{}{from, to, companyId}
 
-	if idType == "USER" || idType == "CATEGORY" {
-		fieldName := "e.user_id"
-		if idType == "CATEGORY" {
-			fieldName = "e.category_id"
-		}
-		countQuery += fmt.Sprintf(" AND %s = $4", fieldName)
+	if idType == "USER" || idType == "CATEGORY" || idType == "VENDOR" {
 		args = append(args, id)
+		countQuery += fmt.Sprintf(" AND %s = $%d", expenseFilterField(idType), len(args))
+	}
+	if expenseStatus != "" {
+		args = append(args, expenseStatus)
+		countQuery += fmt.Sprintf(" AND e.status = $%d", len(args))
 	}
 
 	var totalCount int32
@@ -302,6 +420,658 @@ func (r *ExpenseRepository) getTotalCount(ctx context.Context, from, to, company
 
 	return totalCount, nil
 }
-func NewExpenseRepository(db *sql.DB, userClient *clients.UserClient) *ExpenseRepository {
-	return &ExpenseRepository{db: db, userClient: userClient}
+
+func (r *ExpenseRepository) CreateRecurringExpense(companyId string, req *pb.CreateRecurringExpenseRequest) (*pb.AbsResponse, error) {
+	if req.ExpenseType != "USER" && req.ExpenseType != "CATEGORY" {
+		return nil, status.Errorf(codes.InvalidArgument, "expense type must be USER or CATEGORY")
+	}
+	if req.PaymentMethod != "CASH" && req.PaymentMethod != "CLICK" && req.PaymentMethod != "PAYME" {
+		return nil, status.Errorf(codes.InvalidArgument, "payment method must be CASH, CLICK or PAYME")
+	}
+	sum, err := utils.ParseAmount(req.Sum)
+	if err != nil || sum <= 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "sum must be a positive number")
+	}
+	switch req.Schedule {
+	case "MONTHLY":
+		if req.Day < 1 || req.Day > 31 {
+			return nil, status.Errorf(codes.InvalidArgument, "day of month must be between 1 and 31")
+		}
+	case "WEEKLY":
+		if req.Day < 1 || req.Day > 7 {
+			return nil, status.Errorf(codes.InvalidArgument, "day of week must be between 1 (monday) and 7 (sunday)")
+		}
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "schedule must be MONTHLY or WEEKLY")
+	}
+	startDate, err := time.Parse("2006-01-02", req.StartDate)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid start date, expected YYYY-MM-DD: %v", err)
+	}
+	var endDate *time.Time
+	if req.EndDate != "" {
+		parsed, err := time.Parse("2006-01-02", req.EndDate)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid end date, expected YYYY-MM-DD: %v", err)
+		}
+		if parsed.Before(startDate) {
+			return nil, status.Errorf(codes.InvalidArgument, "end date must not be before start date")
+		}
+		endDate = &parsed
+	}
+
+	if err = checkVendor(r.db, companyId, req.VendorId); err != nil {
+		return nil, err
+	}
+	var userId, categoryId interface{}
+	if req.ExpenseType == "USER" {
+		userId = req.UserId
+	} else {
+		categoryId = req.CategoryId
+	}
+	id := uuid.New()
+	_, err = r.db.Exec(`INSERT INTO recurring_expense (id, title, expense_type, user_id, category_id, sum, payment_method, schedule, day, start_date, end_date, next_run_date, created_by, vendor_id, company_id)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15)`,
+		id, req.Title, req.ExpenseType, userId, categoryId, sum, req.PaymentMethod, req.Schedule, req.Day, startDate, endDate,
+		nextRecurringDate(req.Schedule, req.Day, startDate), req.CreatedById, nullIfEmpty(req.VendorId), companyId)
+	if err != nil {
+		return nil, status.Errorf(codes.Aborted, "error while inserting recurring expense %v", err)
+	}
+	return &pb.AbsResponse{
+		Status:  http.StatusCreated,
+		Message: id.String(),
+	}, nil
+}
+
+func (r *ExpenseRepository) GetRecurringExpenses(companyId string) (*pb.GetRecurringExpensesResponse, error) {
+	rows, err := r.db.Query(`SELECT re.id, re.title, re.expense_type, COALESCE(re.category_id::text, ''), COALESCE(c.name, ''), COALESCE(re.user_id::text, ''),
+			re.sum, re.payment_method, re.schedule, re.day, re.start_date, re.end_date, re.next_run_date, re.is_active, re.created_at,
+			COALESCE(re.vendor_id::text, ''), COALESCE(v.name, ''),
+			ARRAY(SELECT to_char(rs.given_date, 'YYYY-MM-DD') FROM recurring_expense_skip rs WHERE rs.recurring_expense_id = re.id ORDER BY rs.given_date)
+		FROM recurring_expense re
+		LEFT JOIN category c ON re.category_id = c.id
+		LEFT JOIN vendor v ON re.vendor_id = v.id
+		WHERE re.company_id = $1
+		ORDER BY re.is_active DESC, re.created_at DESC`, companyId)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to retrieve recurring expenses: %v", err)
+	}
+	defer rows.Close()
+	var response pb.GetRecurringExpensesResponse
+	for rows.Next() {
+		var (
+			expense                           pb.AbsRecurringExpense
+			startDate, nextRunDate, createdAt time.Time
+			endDate                           sql.NullTime
+		)
+		if err := rows.Scan(&expense.Id, &expense.Title, &expense.ExpenseType, &expense.CategoryId, &expense.CategoryName, &expense.UserId,
+			&expense.Sum, &expense.PaymentMethod, &expense.Schedule, &expense.Day, &startDate, &endDate, &nextRunDate, &expense.IsActive, &createdAt,
+			&expense.VendorId, &expense.VendorName, pq.Array(&expense.SkippedDates)); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to scan row: %v", err)
+		}
+		expense.StartDate = startDate.Format("2006-01-02")
+		if endDate.Valid {
+			expense.EndDate = endDate.Time.Format("2006-01-02")
+		}
+		expense.NextRunDate = nextRunDate.Format("2006-01-02")
+		expense.CreatedAt = createdAt.Format("2006-01-02 15:04:05")
+		response.RecurringExpenses = append(response.RecurringExpenses, &expense)
+	}
+	return &response, rows.Err()
+}
+
+// DeleteRecurringExpense stops the schedule, expenses already posted by it stay untouched
+func (r *ExpenseRepository) DeleteRecurringExpense(companyId, id string) (*pb.AbsResponse, error) {
+	result, err := r.db.Exec(`UPDATE recurring_expense SET is_active = FALSE WHERE id = $1 AND company_id = $2`, id, companyId)
+	if err != nil {
+		return nil, status.Errorf(codes.Aborted, "error while stopping recurring expense %v", err)
+	}
+	if affected, _ := result.RowsAffected(); affected == 0 {
+		return nil, status.Errorf(codes.NotFound, "recurring expense not found")
+	}
+	return &pb.AbsResponse{
+		Status:  http.StatusOK,
+		Message: "recurring expense stopped",
+	}, nil
+}
+
+// PostRecurringExpenses posts every due occurrence of active recurring expenses up to today,
+// occurrences falling into a closed accounting period are recorded as skipped for finance to post by hand
+func (r *ExpenseRepository) PostRecurringExpenses(ctx context.Context, companyId string) (*pb.AbsResponse, error) {
+	rows, err := r.db.Query(`SELECT id, title, expense_type, COALESCE(user_id::text, ''), COALESCE(category_id::text, ''), sum, payment_method,
+			schedule, day, end_date, next_run_date, created_by, vendor_id
+		FROM recurring_expense
+		WHERE company_id = $1 AND is_active AND next_run_date <= CURRENT_DATE`, companyId)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to retrieve recurring expenses: %v", err)
+	}
+	var dueExpenses []recurringExpense
+	for rows.Next() {
+		var expense recurringExpense
+		if err := rows.Scan(&expense.id, &expense.title, &expense.expenseType, &expense.userId, &expense.categoryId, &expense.sum, &expense.paymentMethod,
+			&expense.schedule, &expense.day, &expense.endDate, &expense.nextRunDate, &expense.createdBy, &expense.vendorId); err != nil {
+			rows.Close()
+			return nil, status.Errorf(codes.Internal, "failed to scan row: %v", err)
+		}
+		dueExpenses = append(dueExpenses, expense)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return nil, status.Errorf(codes.Internal, "error iterating rows: %v", err)
+	}
+
+	now := time.Now()
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+	posted, skipped := 0, 0
+	for _, expense := range dueExpenses {
+		count, skippedCount, err := r.postRecurringExpense(companyId, expense, today)
+		if err != nil {
+			return nil, err
+		}
+		posted += count
+		skipped += skippedCount
+		if count > 0 && expense.expenseType == "CATEGORY" {
+			r.checkCategoryBudget(ctx, companyId, expense.categoryId, today.Format("2006-01-02"))
+		}
+	}
+	return &pb.AbsResponse{
+		Status:  http.StatusOK,
+		Message: fmt.Sprintf("%d recurring expenses posted, %d skipped in closed periods", posted, skipped),
+	}, nil
+}
+
+type recurringExpense struct {
+	id, title, expenseType, userId, categoryId, paymentMethod, schedule, createdBy string
+	sum                                                                            float64
+	day                                                                            int32
+	endDate                                                                        sql.NullTime
+	vendorId                                                                       sql.NullString
+	nextRunDate                                                                    time.Time
+}
+
+func (r *ExpenseRepository) postRecurringExpense(companyId string, expense recurringExpense, today time.Time) (posted, skipped int, err error) {
+	tx, err := r.db.Begin()
+	if err != nil {
+		return 0, 0, status.Errorf(codes.Aborted, "error while creating transaction %v", err)
+	}
+	defer func() {
+		if err != nil {
+			tx.Rollback()
+		} else {
+			tx.Commit()
+		}
+	}()
+
+	var userId, categoryId interface{}
+	if expense.expenseType == "USER" {
+		userId = expense.userId
+	} else {
+		categoryId = expense.categoryId
+	}
+	runDate := expense.nextRunDate
+	for !runDate.After(today) {
+		if expense.endDate.Valid && runDate.After(expense.endDate.Time) {
+			break
+		}
+		givenDate := runDate.Format("2006-01-02")
+		var closed bool
+		if _, closed, err = isPeriodClosed(tx, companyId, givenDate); err != nil {
+			return 0, 0, status.Errorf(codes.Internal, "%v", err)
+		}
+		if !closed {
+			_, err = tx.Exec(`INSERT INTO expense (id, title, user_id, category_id, expense_type, sum, given_date, created_by, payment_method, recurring_expense_id, vendor_id, company_id)
+				VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)`,
+				uuid.New(), expense.title, userId, categoryId, expense.expenseType, expense.sum, givenDate, expense.createdBy, expense.paymentMethod, expense.id, expense.vendorId, companyId)
+			if err != nil {
+				return 0, 0, status.Errorf(codes.Aborted, "error while posting recurring expense %v", err)
+			}
+			posted++
+		} else {
+			fmt.Printf("recurring expense %s (%s) of %s was not posted, the accounting period is closed\n", expense.id, expense.title, givenDate)
+			_, err = tx.Exec(`INSERT INTO recurring_expense_skip (recurring_expense_id, given_date, sum, company_id) VALUES ($1, $2, $3, $4) ON CONFLICT DO NOTHING`,
+				expense.id, givenDate, expense.sum, companyId)
+			if err != nil {
+				return 0, 0, status.Errorf(codes.Aborted, "error while recording skipped recurring expense %v", err)
+			}
+			skipped++
+		}
+		runDate = nextRecurringDate(expense.schedule, expense.day, runDate.AddDate(0, 0, 1))
+	}
+	isActive := !expense.endDate.Valid || !runDate.After(expense.endDate.Time)
+	_, err = tx.Exec(`UPDATE recurring_expense SET next_run_date = $1, is_active = $2 WHERE id = $3`, runDate, isActive, expense.id)
+	if err != nil {
+		return 0, 0, status.Errorf(codes.Aborted, "error while updating recurring expense %v", err)
+	}
+	return posted, skipped, nil
+}
+
+// nextRecurringDate returns the first scheduled date on or after from. A monthly day beyond
+// the end of a month falls on its last day, a weekly day is 1 for monday through 7 for sunday
+func nextRecurringDate(schedule string, day int32, from time.Time) time.Time {
+	from = time.Date(from.Year(), from.Month(), from.Day(), 0, 0, 0, 0, time.UTC)
+	if schedule == "WEEKLY" {
+		weekday := time.Weekday(day % 7)
+		return from.AddDate(0, 0, (int(weekday)-int(from.Weekday())+7)%7)
+	}
+	monthDay := func(month time.Time) time.Time {
+		lastDay := month.AddDate(0, 1, -1).Day()
+		if int(day) < lastDay {
+			lastDay = int(day)
+		}
+		return time.Date(month.Year(), month.Month(), lastDay, 0, 0, 0, 0, time.UTC)
+	}
+	month := time.Date(from.Year(), from.Month(), 1, 0, 0, 0, 0, time.UTC)
+	if candidate := monthDay(month); !candidate.Before(from) {
+		return candidate
+	}
+	return monthDay(month.AddDate(0, 1, 0))
+}
+
+// checkCategoryBudget records a budget alert once per threshold and month and sends it to CEO and FINANCIST by sms.
+// Failures are only logged, an alert must never block the expense itself
+func (r *ExpenseRepository) checkCategoryBudget(ctx context.Context, companyId, categoryId, givenDate string) {
+	date, err := time.Parse("2006-01-02", givenDate)
+	if err != nil {
+		return
+	}
+	monthStart := time.Date(date.Year(), date.Month(), 1, 0, 0, 0, 0, time.UTC)
+	period := monthStart.Format("2006-01")
+
+	var (
+		name   string
+		budget sql.NullFloat64
+		actual float64
+	)
+	err = r.db.QueryRow(`SELECT name, monthly_budget FROM category WHERE id = $1 AND company_id = $2`, categoryId, companyId).Scan(&name, &budget)
+	if err != nil || !budget.Valid || budget.Float64 <= 0 {
+		return
+	}
+	err = r.db.QueryRow(`SELECT COALESCE(SUM(sum), 0) FROM expense
+		WHERE company_id = $1 AND category_id = $2 AND given_date >= $3 AND given_date < $4 AND status IN ('APPROVED', 'PAID')`,
+		companyId, categoryId, monthStart, monthStart.AddDate(0, 1, 0)).Scan(&actual)
+	if err != nil {
+		fmt.Printf("error while checking budget of category %s: %v\n", categoryId, err)
+		return
+	}
+
+	crossed := 0
+	for _, threshold := range budgetThresholds {
+		if actual*100 < budget.Float64*float64(threshold) {
+			break
+		}
+		result, err := r.db.Exec(`INSERT INTO budget_alert (category_id, period, threshold, budget, actual, company_id) VALUES ($1, $2, $3, $4, $5, $6)
+			ON CONFLICT DO NOTHING`, categoryId, period, threshold, budget.Float64, actual, companyId)
+		if err != nil {
+			fmt.Printf("error while saving budget alert of category %s: %v\n", categoryId, err)
+			return
+		}
+		if affected, _ := result.RowsAffected(); affected > 0 {
+			crossed = threshold
+		}
+	}
+	if crossed == 0 {
+		return
+	}
+
+	r.notifyStaff(ctx, companyId, []string{"CEO", "FINANCIST"}, fmt.Sprintf("Diqqat! \"%s\" xarajatlari %s oyi uchun byudjetning %d%% iga yetdi: %s / %s so'm",
+		name, period, crossed, formatAmount(actual), formatAmount(budget.Float64)))
+}
+
+// notifyStaff sends the message by sms to every active staff member having one of the roles
+func (r *ExpenseRepository) notifyStaff(ctx context.Context, companyId string, roles []string, message string) {
+	ctx, cancelFunc := utils.NewTimoutContext(ctx, companyId)
+	defer cancelFunc()
+	staff, err := r.userClient.GetAllStuff(ctx)
+	if err != nil {
+		fmt.Printf("error while getting staff for notification: %v\n", err)
+		return
+	}
+	var phones []string
+	for _, user := range staff.Stuff {
+		if slices.Contains(roles, user.Role) && user.PhoneNumber != "" {
+			phones = append(phones, user.PhoneNumber)
+		}
+	}
+	if err := r.educationClient.SendStaffSms(ctx, phones, message); err != nil {
+		fmt.Printf("error while sending staff notification: %v\n", err)
+	}
+}
+
+func (r *ExpenseRepository) GetBudgetAlerts(companyId, period string) (*pb.GetBudgetAlertsResponse, error) {
+	query := `SELECT ba.category_id, COALESCE(c.name, ''), ba.period, ba.threshold, ba.budget, ba.actual, ba.created_at
+		FROM budget_alert ba
+		LEFT JOIN category c ON ba.category_id = c.id
+		WHERE ba.company_id = $1`
+	args := []interface{}{companyId}
+	if period != "" {
+		query += ` AND ba.period = $2`
+		args = append(args, period)
+	}
+	query += ` ORDER BY ba.created_at DESC LIMIT 100`
+	rows, err := r.db.Query(query, args...)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to retrieve budget alerts: %v", err)
+	}
+	defer rows.Close()
+	var response pb.GetBudgetAlertsResponse
+	for rows.Next() {
+		var (
+			alert      pb.AbsBudgetAlert
+			categoryId int32
+			createdAt  time.Time
+		)
+		if err := rows.Scan(&categoryId, &alert.CategoryName, &alert.Period, &alert.Threshold, &alert.Budget, &alert.Actual, &createdAt); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to scan row: %v", err)
+		}
+		alert.CategoryId = fmt.Sprintf("%d", categoryId)
+		alert.CreatedAt = createdAt.Format("2006-01-02 15:04:05")
+		response.Alerts = append(response.Alerts, &alert)
+	}
+	return &response, rows.Err()
+}
+
+// routeExpense decides the status of a submitted expense. Expenses reaching the CEO threshold need CEO
+// approval, those reaching the FINANCIST threshold need FINANCIST (or CEO) approval, the rest are booked as paid.
+// Nothing waits for approval when the creator may approve it
+func (r *ExpenseRepository) routeExpense(companyId string, sum float64, creatorRole string) (string, string, error) {
+	setting, err := r.GetExpenseApprovalSetting(companyId)
+	if err != nil {
+		return "", "", err
+	}
+	approverRole := ""
+	if setting.CeoThreshold > 0 && sum >= setting.CeoThreshold {
+		approverRole = "CEO"
+	} else if setting.FinancistThreshold > 0 && sum >= setting.FinancistThreshold {
+		approverRole = "FINANCIST"
+	}
+	if approverRole == "" || canApproveExpense(creatorRole, approverRole) {
+		return "PAID", approverRole, nil
+	}
+	return "PENDING_APPROVAL", approverRole, nil
+}
+
+func canApproveExpense(actionRole, approverRole string) bool {
+	return actionRole == "CEO" || actionRole == approverRole
+}
+
+// afterExpenseStatusChange asks approvers to review a pending expense and checks the category budget once the expense counts as spend
+func (r *ExpenseRepository) afterExpenseStatusChange(ctx context.Context, companyId, expenseStatus, approverRole, title, expenseType, categoryId, givenDate string, sum float64) {
+	switch expenseStatus {
+	case "PENDING_APPROVAL":
+		roles := []string{approverRole}
+		if approverRole != "CEO" {
+			roles = append(roles, "CEO")
+		}
+		r.notifyStaff(ctx, companyId, roles, fmt.Sprintf("\"%s\" xarajati (%s so'm) tasdiqlashingizni kutmoqda", title, formatAmount(sum)))
+	case "APPROVED", "PAID":
+		if expenseType == "CATEGORY" {
+			r.checkCategoryBudget(ctx, companyId, categoryId, givenDate)
+		}
+	}
+}
+
+func (r *ExpenseRepository) GetExpenseById(ctx context.Context, companyId, id string) (*pb.ExpenseDetail, error) {
+	ctx, cancel := utils.NewTimoutContext(ctx, companyId)
+	defer cancel()
+	row := r.db.QueryRowContext(ctx, `
+        SELECT
+            e.id,
+            e.title,
+            e.given_date,
+            COALESCE(c.id, 0) as category_id,
+            COALESCE(c.name, '') as category_name,
+            COALESCE(c.description, '') as category_description,
+            COALESCE(CAST(e.user_id AS TEXT), '') as user_id,
+            e.expense_type,
+            e.sum,
+            e.created_by,
+            e.payment_method,
+            e.created_at,
+            e.status,
+            COALESCE(e.approver_role, ''),
+            COALESCE(CAST(e.vendor_id AS TEXT), ''),
+            COALESCE(v.name, ''),
+            (SELECT COUNT(*) FROM expense_receipt er WHERE er.expense_id = e.id)
+        FROM expense e
+        LEFT JOIN category c ON e.category_id = c.id
+        LEFT JOIN vendor v ON e.vendor_id = v.id
+        WHERE e.id = $1 AND e.company_id = $2`, id, companyId)
+	expense, err := r.scanExpense(ctx, row)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, status.Errorf(codes.NotFound, "expense not found")
+		}
+		return nil, err
+	}
+	response := &pb.ExpenseDetail{Expense: expense}
+
+	rows, err := r.db.Query(`SELECT id, file_url, file_name, uploaded_by_id, created_at FROM expense_receipt
+		WHERE expense_id = $1 AND company_id = $2 ORDER BY created_at`, id, companyId)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to retrieve receipts: %v", err)
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var (
+			receipt   pb.ExpenseReceipt
+			createdAt time.Time
+		)
+		if err := rows.Scan(&receipt.Id, &receipt.FileUrl, &receipt.FileName, &receipt.UploadedById, &createdAt); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to scan row: %v", err)
+		}
+		receipt.CreatedAt = createdAt.Format("2006-01-02 15:04:05")
+		response.Receipts = append(response.Receipts, &receipt)
+	}
+
+	historyRows, err := r.db.Query(`SELECT COALESCE(from_status, ''), to_status, comment, action_by_id, action_by_name, created_at FROM expense_status_history
+		WHERE expense_id = $1 AND company_id = $2 ORDER BY created_at`, id, companyId)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to retrieve expense history: %v", err)
+	}
+	defer historyRows.Close()
+	for historyRows.Next() {
+		var (
+			history   pb.ExpenseStatusHistory
+			createdAt time.Time
+		)
+		if err := historyRows.Scan(&history.FromStatus, &history.ToStatus, &history.Comment, &history.ActionById, &history.ActionByName, &createdAt); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to scan row: %v", err)
+		}
+		history.CreatedAt = createdAt.Format("2006-01-02 15:04:05")
+		response.History = append(response.History, &history)
+	}
+	return response, historyRows.Err()
+}
+
+// SubmitExpense sends a draft or rejected expense to approval, it is booked right away when no approval is needed
+func (r *ExpenseRepository) SubmitExpense(ctx context.Context, companyId string, req *pb.ExpenseActionRequest) (*pb.AbsResponse, error) {
+	return r.changeExpenseStatus(ctx, companyId, req, []string{"DRAFT", "REJECTED"}, func(expense *expenseState) error {
+		var err error
+		expense.status, expense.approverRole, err = r.routeExpense(companyId, expense.sum, req.ActionByRole)
+		return err
+	})
+}
+
+func (r *ExpenseRepository) ApproveExpense(ctx context.Context, companyId string, req *pb.ExpenseActionRequest) (*pb.AbsResponse, error) {
+	return r.changeExpenseStatus(ctx, companyId, req, []string{"PENDING_APPROVAL"}, func(expense *expenseState) error {
+		if !canApproveExpense(req.ActionByRole, expense.approverRole) {
+			return status.Errorf(codes.PermissionDenied, "this expense must be approved by %s", expense.approverRole)
+		}
+		expense.status = "APPROVED"
+		return nil
+	})
+}
+
+func (r *ExpenseRepository) RejectExpense(ctx context.Context, companyId string, req *pb.ExpenseActionRequest) (*pb.AbsResponse, error) {
+	if req.Comment == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "rejection reason is required")
+	}
+	return r.changeExpenseStatus(ctx, companyId, req, []string{"PENDING_APPROVAL"}, func(expense *expenseState) error {
+		if !canApproveExpense(req.ActionByRole, expense.approverRole) {
+			return status.Errorf(codes.PermissionDenied, "this expense must be reviewed by %s", expense.approverRole)
+		}
+		expense.status = "REJECTED"
+		return nil
+	})
+}
+
+func (r *ExpenseRepository) MarkExpensePaid(ctx context.Context, companyId string, req *pb.ExpenseActionRequest) (*pb.AbsResponse, error) {
+	return r.changeExpenseStatus(ctx, companyId, req, []string{"APPROVED"}, func(expense *expenseState) error {
+		expense.status = "PAID"
+		return nil
+	})
+}
+
+type expenseState struct {
+	status, approverRole, title, expenseType, categoryId, givenDate string
+	sum                                                             float64
+}
+
+// changeExpenseStatus locks the expense, lets transition move it out of one of the allowed statuses and records the history.
+// An expense dated in a closed accounting period keeps its status.
+func (r *ExpenseRepository) changeExpenseStatus(ctx context.Context, companyId string, req *pb.ExpenseActionRequest, allowed []string, transition func(*expenseState) error) (*pb.AbsResponse, error) {
+	tx, err := r.db.Begin()
+	if err != nil {
+		return nil, status.Errorf(codes.Aborted, "error while creating transaction %v", err)
+	}
+	defer func() {
+		if err != nil {
+			tx.Rollback()
+		}
+	}()
+
+	var (
+		expense   expenseState
+		givenDate time.Time
+	)
+	err = tx.QueryRow(`SELECT status, COALESCE(approver_role, ''), title, expense_type, COALESCE(category_id::text, ''), given_date, sum
+		FROM expense WHERE id = $1 AND company_id = $2 FOR UPDATE`, req.Id, companyId).
+		Scan(&expense.status, &expense.approverRole, &expense.title, &expense.expenseType, &expense.categoryId, &givenDate, &expense.sum)
+	if errors.Is(err, sql.ErrNoRows) {
+		err = status.Errorf(codes.NotFound, "expense not found")
+		return nil, err
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to retrieve expense: %v", err)
+	}
+	expense.givenDate = givenDate.Format("2006-01-02")
+	if err = checkPeriodIsOpen(tx, companyId, expense.givenDate); err != nil {
+		return nil, err
+	}
+	fromStatus := expense.status
+	if !slices.Contains(allowed, fromStatus) {
+		err = status.Errorf(codes.FailedPrecondition, "expense is %s, action is not allowed", fromStatus)
+		return nil, err
+	}
+	if err = transition(&expense); err != nil {
+		return nil, err
+	}
+	_, err = tx.Exec(`UPDATE expense SET status = $1, approver_role = $2 WHERE id = $3 AND company_id = $4`,
+		expense.status, nullIfEmpty(expense.approverRole), req.Id, companyId)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "error while updating expense %v", err)
+	}
+	if err = insertExpenseHistory(tx, companyId, req.Id, fromStatus, expense.status, req.Comment, req.ActionById, req.ActionByName); err != nil {
+		return nil, err
+	}
+	if err = tx.Commit(); err != nil {
+		return nil, status.Errorf(codes.Internal, "error while committing expense %v", err)
+	}
+	r.afterExpenseStatusChange(ctx, companyId, expense.status, expense.approverRole, expense.title, expense.expenseType, expense.categoryId, expense.givenDate, expense.sum)
+	return &pb.AbsResponse{
+		Status:  http.StatusOK,
+		Message: expense.status,
+	}, nil
+}
+
+func insertExpenseHistory(tx *sql.Tx, companyId, expenseId, fromStatus, toStatus, comment, actionById, actionByName string) error {
+	_, err := tx.Exec(`INSERT INTO expense_status_history (id, expense_id, from_status, to_status, comment, action_by_id, action_by_name, company_id)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)`, uuid.New(), expenseId, nullIfEmpty(fromStatus), toStatus, comment, actionById, actionByName, companyId)
+	if err != nil {
+		return status.Errorf(codes.Internal, "error while saving expense history %v", err)
+	}
+	return nil
+}
+
+// AddExpenseReceipt attaches a file uploaded through /api/image/upload to the expense
+func (r *ExpenseRepository) AddExpenseReceipt(companyId string, req *pb.AddExpenseReceiptRequest) (*pb.AbsResponse, error) {
+	if req.FileUrl == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "file url is required")
+	}
+	var exists bool
+	err := r.db.QueryRow(`SELECT EXISTS(SELECT 1 FROM expense WHERE id = $1 AND company_id = $2)`, req.ExpenseId, companyId).Scan(&exists)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to check expense: %v", err)
+	}
+	if !exists {
+		return nil, status.Errorf(codes.NotFound, "expense not found")
+	}
+	id := uuid.New()
+	_, err = r.db.Exec(`INSERT INTO expense_receipt (id, expense_id, file_url, file_name, uploaded_by_id, company_id) VALUES ($1, $2, $3, $4, $5, $6)`,
+		id, req.ExpenseId, req.FileUrl, req.FileName, req.UploadedById, companyId)
+	if err != nil {
+		return nil, status.Errorf(codes.Aborted, "error while saving receipt %v", err)
+	}
+	return &pb.AbsResponse{
+		Status:  http.StatusCreated,
+		Message: id.String(),
+	}, nil
+}
+
+func (r *ExpenseRepository) DeleteExpenseReceipt(companyId, id string) (*pb.AbsResponse, error) {
+	result, err := r.db.Exec(`DELETE FROM expense_receipt WHERE id = $1 AND company_id = $2`, id, companyId)
+	if err != nil {
+		return nil, status.Errorf(codes.Aborted, "error while deleting receipt %v", err)
+	}
+	if affected, _ := result.RowsAffected(); affected == 0 {
+		return nil, status.Errorf(codes.NotFound, "receipt not found")
+	}
+	return &pb.AbsResponse{
+		Status:  http.StatusOK,
+		Message: "receipt deleted",
+	}, nil
+}
+
+func (r *ExpenseRepository) GetExpenseApprovalSetting(companyId string) (*pb.ExpenseApprovalSetting, error) {
+	var setting pb.ExpenseApprovalSetting
+	err := r.db.QueryRow(`SELECT financist_threshold, ceo_threshold FROM expense_approval_setting WHERE company_id = $1`, companyId).
+		Scan(&setting.FinancistThreshold, &setting.CeoThreshold)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return nil, status.Errorf(codes.Internal, "failed to retrieve expense approval setting: %v", err)
+	}
+	return &setting, nil
+}
+
+// UpdateExpenseApprovalSetting stores the thresholds, zero turns the approval step off
+func (r *ExpenseRepository) UpdateExpenseApprovalSetting(companyId string, req *pb.ExpenseApprovalSetting) (*pb.AbsResponse, error) {
+	if req.FinancistThreshold < 0 || req.CeoThreshold < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "thresholds must not be negative")
+	}
+	if req.FinancistThreshold > 0 && req.CeoThreshold > 0 && req.CeoThreshold < req.FinancistThreshold {
+		return nil, status.Errorf(codes.InvalidArgument, "CEO threshold must not be lower than FINANCIST threshold")
+	}
+	_, err := r.db.Exec(`INSERT INTO expense_approval_setting (company_id, financist_threshold, ceo_threshold) VALUES ($1, $2, $3)
+		ON CONFLICT (company_id) DO UPDATE SET financist_threshold = EXCLUDED.financist_threshold, ceo_threshold = EXCLUDED.ceo_threshold, updated_at = NOW()`,
+		companyId, req.FinancistThreshold, req.CeoThreshold)
+	if err != nil {
+		return nil, status.Errorf(codes.Aborted, "error while saving expense approval setting %v", err)
+	}
+	return &pb.AbsResponse{
+		Status:  http.StatusOK,
+		Message: "expense approval setting updated",
+	}, nil
+}
+
+func expenseFilterField(idType string) string {
+	switch idType {
+	case "CATEGORY":
+		return "e.category_id"
+	case "VENDOR":
+		return "e.vendor_id"
+	}
+	return "e.user_id"
+}
+
+func NewExpenseRepository(db *sql.DB, userClient *clients.UserClient, educationClient *clients.EducationClient) *ExpenseRepository {
+	return &ExpenseRepository{db: db, userClient: userClient, educationClient: educationClient}
 }
@@ -0,0 +1,105 @@
+package repository
+
+import (
+	"testing"
+	"time"
+)
+
+func TestNextRecurringDate(t *testing.T) {
+	tests := []struct {
+		name     string
+		schedule string
+		day      int32
+		from     string
+		want     string
+	}{
+		{
+			name:     "monthly day later this month",
+			schedule: "MONTHLY",
+			day:      15,
+			from:     "2026-03-10",
+			want:     "2026-03-15",
+		},
+		{
+			name:     "monthly day is today",
+			schedule: "MONTHLY",
+			day:      10,
+			from:     "2026-03-10",
+			want:     "2026-03-10",
+		},
+		{
+			name:     "monthly day passed",
+			schedule: "MONTHLY",
+			day:      5,
+			from:     "2026-03-10",
+			want:     "2026-04-05",
+		},
+		{
+			name:     "31st falls on the last day of february",
+			schedule: "MONTHLY",
+			day:      31,
+			from:     "2026-02-01",
+			want:     "2026-02-28",
+		},
+		{
+			name:     "31st in a leap year february",
+			schedule: "MONTHLY",
+			day:      31,
+			from:     "2028-02-10",
+			want:     "2028-02-29",
+		},
+		{
+			name:     "31st after a short month ended",
+			schedule: "MONTHLY",
+			day:      31,
+			from:     "2026-05-01",
+			want:     "2026-05-31",
+		},
+		{
+			name:     "monthly across the year end",
+			schedule: "MONTHLY",
+			day:      1,
+			from:     "2026-12-02",
+			want:     "2027-01-01",
+		},
+		{
+			name:     "weekly later this week",
+			schedule: "WEEKLY",
+			day:      5,
+			from:     "2026-03-10",
+			want:     "2026-03-13",
+		},
+		{
+			name:     "weekly day is today",
+			schedule: "WEEKLY",
+			day:      2,
+			from:     "2026-03-10",
+			want:     "2026-03-10",
+		},
+		{
+			name:     "weekly monday next week",
+			schedule: "WEEKLY",
+			day:      1,
+			from:     "2026-03-10",
+			want:     "2026-03-16",
+		},
+		{
+			name:     "weekly sunday is 7",
+			schedule: "WEEKLY",
+			day:      7,
+			from:     "2026-03-10",
+			want:     "2026-03-15",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			from, err := time.Parse("2006-01-02", tt.from)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := nextRecurringDate(tt.schedule, tt.day, from).Format("2006-01-02"); got != tt.want {
+				t.Errorf("next date = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
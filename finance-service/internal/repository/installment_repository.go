@@ -0,0 +1,638 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"finance-service/internal/clients"
+	"finance-service/internal/utils"
+	"finance-service/proto/pb"
+	"fmt"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"math"
+	"net/http"
+	"sort"
+	"strconv"
+	"time"
+)
+
+type InstallmentRepository struct {
+	db              *sql.DB
+	educationClient *clients.EducationClient
+	paymentRepo     *PaymentRepository
+}
+
+type plannedInstallment struct {
+	dueDate time.Time
+	amount  float64
+}
+
+func (r *InstallmentRepository) CreateInstallmentPlan(ctx context.Context, companyId string, req *pb.CreateInstallmentPlanRequest) (*pb.AbsResponse, error) {
+	if req.StudentId == "" || req.GroupId == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "studentId and groupId are required")
+	}
+	startDate, err := time.Parse("2006-01-02", req.StartDate)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid startDate format: %v", err)
+	}
+	lateFee, err := parseOptionalAmount(req.LateFeeAmount)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid lateFeeAmount: %v", err)
+	}
+	if req.GraceDays < 0 || req.EarlyDays < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "graceDays and earlyDays must be non-negative")
+	}
+	if req.EarlyDiscountPercent < 0 || req.EarlyDiscountPercent > 100 {
+		return nil, status.Errorf(codes.InvalidArgument, "earlyDiscountPercent must be between 0 and 100")
+	}
+
+	installments, err := buildInstallments(req, startDate)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+	var total float64
+	for _, installment := range installments {
+		total += installment.amount
+	}
+
+	var exists bool
+	err = r.db.QueryRow(`SELECT EXISTS(SELECT 1 FROM installment_plan WHERE student_id=$1 AND group_id=$2 AND company_id=$3 AND status='ACTIVE')`,
+		req.StudentId, req.GroupId, companyId).Scan(&exists)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "error while checking installment plan %v", err)
+	}
+	if exists {
+		return nil, status.Errorf(codes.AlreadyExists, "student already has an active installment plan in this group")
+	}
+
+	planId := uuid.New()
+	if err = r.insertPlan(companyId, planId, total, lateFee, req, installments); err != nil {
+		return nil, err
+	}
+
+	// installments which are already due are charged right away, the rest is posted by the daily charge job
+	if _, err := r.chargePlan(ctx, companyId, planId.String(), req.StudentId, req.GroupId, time.Now()); err != nil {
+		return nil, err
+	}
+	return &pb.AbsResponse{
+		Status:  http.StatusCreated,
+		Message: "installment plan created",
+	}, nil
+}
+
+func (r *InstallmentRepository) insertPlan(companyId string, planId uuid.UUID, total, lateFee float64, req *pb.CreateInstallmentPlanRequest, installments []plannedInstallment) error {
+	tx, err := r.db.Begin()
+	if err != nil {
+		return status.Errorf(codes.Aborted, "error while creating transaction %v", err)
+	}
+	defer func() {
+		if err != nil {
+			tx.Rollback()
+		} else {
+			tx.Commit()
+		}
+	}()
+
+	_, err = tx.Exec(`INSERT INTO installment_plan (id, student_id, group_id, plan_type, total_amount, late_fee_amount, grace_days,
+		early_discount_percent, early_days, comment, created_by_id, created_by_name, company_id)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13)`,
+		planId, req.StudentId, req.GroupId, req.PlanType, total, lateFee, req.GraceDays, req.EarlyDiscountPercent, req.EarlyDays,
+		req.Comment, req.ActionById, req.ActionByName, companyId)
+	if err != nil {
+		return status.Errorf(codes.Internal, "error while creating installment plan %v", err)
+	}
+	for _, installment := range installments {
+		_, err = tx.Exec(`INSERT INTO installment (id, plan_id, due_date, amount, company_id) VALUES ($1, $2, $3, $4, $5)`,
+			uuid.New(), planId, installment.dueDate, installment.amount, companyId)
+		if err != nil {
+			return status.Errorf(codes.Internal, "error while creating installment %v", err)
+		}
+	}
+	return nil
+}
+
+func (r *InstallmentRepository) GetInstallmentPlans(companyId, studentId string) (*pb.GetInstallmentPlansResponse, error) {
+	query := `SELECT id FROM installment_plan WHERE company_id=$1`
+	args := []interface{}{companyId}
+	if studentId != "" {
+		query += ` AND student_id=$2`
+		args = append(args, studentId)
+	}
+	query += ` ORDER BY created_at DESC`
+	rows, err := r.db.Query(query, args...)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to retrieve installment plans: %v", err)
+	}
+	var planIds []string
+	for rows.Next() {
+		var planId string
+		if err := rows.Scan(&planId); err != nil {
+			rows.Close()
+			return nil, status.Errorf(codes.Internal, "failed to scan row: %v", err)
+		}
+		planIds = append(planIds, planId)
+	}
+	rows.Close()
+
+	var response pb.GetInstallmentPlansResponse
+	for _, planId := range planIds {
+		plan, err := r.GetInstallmentPlanById(companyId, planId)
+		if err != nil {
+			return nil, err
+		}
+		response.Plans = append(response.Plans, plan)
+	}
+	return &response, nil
+}
+
+func (r *InstallmentRepository) GetInstallmentPlanById(companyId, planId string) (*pb.AbsInstallmentPlan, error) {
+	var (
+		plan                 pb.AbsInstallmentPlan
+		totalAmount, lateFee float64
+		createdAt            time.Time
+	)
+	err := r.db.QueryRow(`SELECT id, student_id, group_id, plan_type, total_amount, late_fee_amount, grace_days, early_discount_percent,
+		early_days, status, comment, created_by_name, created_at FROM installment_plan WHERE id=$1 AND company_id=$2`, planId, companyId).
+		Scan(&plan.Id, &plan.StudentId, &plan.GroupId, &plan.PlanType, &totalAmount, &lateFee, &plan.GraceDays, &plan.EarlyDiscountPercent,
+			&plan.EarlyDays, &plan.Status, &plan.Comment, &plan.CreatedByName, &createdAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, status.Errorf(codes.NotFound, "installment plan not found")
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to retrieve installment plan: %v", err)
+	}
+	plan.TotalAmount = formatAmount(totalAmount)
+	plan.LateFeeAmount = formatAmount(lateFee)
+	plan.CreatedAt = createdAt.Format("2006-01-02 15:04:05")
+
+	rows, err := r.db.Query(`SELECT id, due_date, amount, paid_amount, discount_amount, is_charged, is_late_fee_applied, paid_at
+		FROM installment WHERE plan_id=$1 ORDER BY due_date`, planId)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to retrieve installments: %v", err)
+	}
+	defer rows.Close()
+	var paidTotal float64
+	today := time.Now()
+	for rows.Next() {
+		var (
+			installment                  pb.AbsInstallment
+			dueDate                      time.Time
+			amount, paidAmount, discount float64
+			paidAt                       sql.NullTime
+		)
+		if err := rows.Scan(&installment.Id, &dueDate, &amount, &paidAmount, &discount, &installment.IsCharged, &installment.IsLateFeeApplied, &paidAt); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to scan row: %v", err)
+		}
+		installment.DueDate = dueDate.Format("2006-01-02")
+		installment.Amount = formatAmount(amount)
+		installment.PaidAmount = formatAmount(paidAmount)
+		installment.DiscountAmount = formatAmount(discount)
+		if paidAt.Valid {
+			installment.PaidAt = paidAt.Time.Format("2006-01-02")
+		}
+		installment.Status = installmentStatus(dueDate, amount, paidAmount, discount, installment.IsCharged, today)
+		paidTotal += paidAmount
+		plan.Installments = append(plan.Installments, &installment)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, status.Errorf(codes.Internal, "error iterating rows: %v", err)
+	}
+	plan.PaidAmount = formatAmount(paidTotal)
+	return &plan, nil
+}
+
+func (r *InstallmentRepository) CancelInstallmentPlan(companyId, planId, actionById, actionByName string) (*pb.AbsResponse, error) {
+	result, err := r.db.Exec(`UPDATE installment_plan SET status='CANCELLED', cancelled_by_id=$3, cancelled_by_name=$4
+		WHERE id=$1 AND company_id=$2 AND status='ACTIVE'`, planId, companyId, actionById, actionByName)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "error while cancelling installment plan %v", err)
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "could not check rows affected: %v", err)
+	}
+	if rowsAffected == 0 {
+		return nil, status.Errorf(codes.NotFound, "active installment plan not found")
+	}
+	return &pb.AbsResponse{
+		Status:  http.StatusOK,
+		Message: "installment plan cancelled",
+	}, nil
+}
+
+// ChargeInstallments posts the installments of the student's active plan due until the given date, the later ones are
+// posted by ChargeDueInstallments on their due dates
+func (r *InstallmentRepository) ChargeInstallments(ctx context.Context, companyId, studentId, groupId, date string) (*pb.ChargeInstallmentsResponse, error) {
+	chargeDate := time.Now()
+	if date != "" {
+		parsed, err := time.Parse("2006-01-02", date)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid date format: %v", err)
+		}
+		chargeDate = parsed
+	}
+	var planId string
+	err := r.db.QueryRow(`SELECT id FROM installment_plan WHERE student_id=$1 AND group_id=$2 AND company_id=$3 AND status='ACTIVE'`,
+		studentId, groupId, companyId).Scan(&planId)
+	if errors.Is(err, sql.ErrNoRows) {
+		return &pb.ChargeInstallmentsResponse{HasPlan: false}, nil
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "error while getting installment plan %v", err)
+	}
+	charged, err := r.chargePlan(ctx, companyId, planId, studentId, groupId, chargeDate)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.ChargeInstallmentsResponse{HasPlan: true, ChargedCount: charged}, nil
+}
+
+// ApplyInstallmentLateFees charges the plan late fee once for every installment left unpaid after the grace period
+func (r *InstallmentRepository) ApplyInstallmentLateFees(ctx context.Context, companyId string) (*pb.AbsResponse, error) {
+	rows, err := r.db.Query(`SELECT i.id, p.student_id, p.group_id, p.late_fee_amount, i.due_date
+		FROM installment i JOIN installment_plan p ON p.id = i.plan_id
+		WHERE p.company_id=$1 AND p.status='ACTIVE' AND p.late_fee_amount > 0 AND i.is_late_fee_applied = FALSE
+		  AND i.paid_amount + i.discount_amount < i.amount
+		  AND i.due_date + p.grace_days < CURRENT_DATE`, companyId)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "error while getting overdue installments %v", err)
+	}
+	type lateInstallment struct {
+		id, studentId, groupId string
+		fee                    float64
+		dueDate                time.Time
+	}
+	var installments []lateInstallment
+	for rows.Next() {
+		var installment lateInstallment
+		if err := rows.Scan(&installment.id, &installment.studentId, &installment.groupId, &installment.fee, &installment.dueDate); err != nil {
+			rows.Close()
+			return nil, status.Errorf(codes.Internal, "failed to scan row: %v", err)
+		}
+		installments = append(installments, installment)
+	}
+	rows.Close()
+
+	today := time.Now().Format("2006-01-02")
+	var applied int
+	for _, installment := range installments {
+		comment := fmt.Sprintf("%s muddatdagi to'lov kechiktirilgani uchun jarima", installment.dueDate.Format("2006-01-02"))
+		err := r.paymentRepo.TakeOffPayment(ctx, companyId, today, formatAmount(installment.fee), "CASH", comment, installment.studentId,
+			"TIZIM", "00000000-0000-0000-0000-000000000000", installment.groupId, today)
+		if err != nil {
+			fmt.Printf("error while applying late fee for installment %s: %v\n", installment.id, err)
+			continue
+		}
+		if _, err := r.db.Exec(`UPDATE installment SET is_late_fee_applied = TRUE WHERE id=$1`, installment.id); err != nil {
+			return nil, status.Errorf(codes.Internal, "error while marking late fee %v", err)
+		}
+		applied++
+	}
+	return &pb.AbsResponse{
+		Status:  http.StatusOK,
+		Message: fmt.Sprintf("%d late fees applied", applied),
+	}, nil
+}
+
+func (r *InstallmentRepository) GetOverdueInstallments(ctx context.Context, companyId, date, bucket string) (*pb.GetOverdueInstallmentsResponse, error) {
+	asOf := time.Now()
+	if date != "" {
+		parsed, err := time.Parse("2006-01-02", date)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid date format: %v", err)
+		}
+		asOf = parsed
+	}
+	rows, err := r.db.Query(`SELECT i.id, p.id, p.student_id, p.group_id, i.due_date, i.amount, i.amount - i.paid_amount - i.discount_amount, i.is_late_fee_applied
+		FROM installment i JOIN installment_plan p ON p.id = i.plan_id
+		WHERE p.company_id=$1 AND p.status='ACTIVE' AND i.paid_amount + i.discount_amount < i.amount AND i.due_date < $2
+		ORDER BY i.due_date`, companyId, asOf.Format("2006-01-02"))
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to retrieve overdue installments: %v", err)
+	}
+	defer rows.Close()
+
+	ctx, cancelFunc := utils.NewTimoutContext(ctx, companyId)
+	defer cancelFunc()
+	type student struct{ name, phone string }
+	students := make(map[string]student)
+	buckets := make(map[string]*pb.OverdueBucket)
+	bucketAmounts := make(map[string]float64)
+	var response pb.GetOverdueInstallmentsResponse
+	for rows.Next() {
+		var (
+			installment       pb.AbsOverdueInstallment
+			dueDate           time.Time
+			amount, remaining float64
+		)
+		if err := rows.Scan(&installment.InstallmentId, &installment.PlanId, &installment.StudentId, &installment.GroupId, &dueDate,
+			&amount, &remaining, &installment.IsLateFeeApplied); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to scan row: %v", err)
+		}
+		installment.DaysOverdue = int32(asOf.Sub(dueDate).Hours() / 24)
+		installment.Bucket = overdueBucket(installment.DaysOverdue)
+		if bucket != "" && bucket != installment.Bucket {
+			continue
+		}
+		info, ok := students[installment.StudentId]
+		if !ok {
+			name, phone, _, err := r.educationClient.GetStudentById(ctx, installment.StudentId)
+			if err != nil {
+				name, phone = "unknown name", "unknown phoneNumber"
+			}
+			info = student{name: name, phone: phone}
+			students[installment.StudentId] = info
+		}
+		installment.StudentName = info.name
+		installment.PhoneNumber = info.phone
+		installment.DueDate = dueDate.Format("2006-01-02")
+		installment.Amount = formatAmount(amount)
+		installment.RemainingAmount = formatAmount(remaining)
+		response.Installments = append(response.Installments, &installment)
+
+		if _, ok := buckets[installment.Bucket]; !ok {
+			buckets[installment.Bucket] = &pb.OverdueBucket{Bucket: installment.Bucket}
+		}
+		buckets[installment.Bucket].Count++
+		bucketAmounts[installment.Bucket] += remaining
+	}
+	if err := rows.Err(); err != nil {
+		return nil, status.Errorf(codes.Internal, "error iterating rows: %v", err)
+	}
+	for _, name := range []string{"0-30", "31-60", "61-90", "90+"} {
+		if b, ok := buckets[name]; ok {
+			b.Amount = formatAmount(bucketAmounts[name])
+			response.Buckets = append(response.Buckets, b)
+		}
+	}
+	return &response, nil
+}
+
+// chargePlan posts take-offs for the plan's uncharged installments due until the given date
+func (r *InstallmentRepository) chargePlan(ctx context.Context, companyId, planId, studentId, groupId string, until time.Time) (int32, error) {
+	rows, err := r.db.Query(`SELECT id FROM installment WHERE plan_id=$1 AND is_charged = FALSE AND due_date <= $2 ORDER BY due_date`,
+		planId, until.Format("2006-01-02"))
+	if err != nil {
+		return 0, status.Errorf(codes.Internal, "error while getting installments %v", err)
+	}
+	var installmentIds []string
+	for rows.Next() {
+		var installmentId string
+		if err := rows.Scan(&installmentId); err != nil {
+			rows.Close()
+			return 0, status.Errorf(codes.Internal, "failed to scan row: %v", err)
+		}
+		installmentIds = append(installmentIds, installmentId)
+	}
+	rows.Close()
+
+	var charged int32
+	for _, installmentId := range installmentIds {
+		ok, err := r.chargeInstallment(ctx, companyId, installmentId, studentId, groupId)
+		if err != nil {
+			return charged, err
+		}
+		if ok {
+			charged++
+		}
+	}
+	return charged, nil
+}
+
+// chargeInstallment marks the installment charged and posts its take-off while the row stays locked, so a concurrent
+// run waits and then skips it. The mark is rolled back when the take-off fails, false means it was already charged.
+func (r *InstallmentRepository) chargeInstallment(ctx context.Context, companyId, installmentId, studentId, groupId string) (charged bool, err error) {
+	tx, err := r.db.Begin()
+	if err != nil {
+		return false, status.Errorf(codes.Aborted, "error while creating transaction %v", err)
+	}
+	defer func() {
+		if err != nil {
+			tx.Rollback()
+		} else {
+			err = tx.Commit()
+		}
+	}()
+	var (
+		dueDate time.Time
+		amount  float64
+	)
+	err = tx.QueryRow(`UPDATE installment SET is_charged = TRUE WHERE id=$1 AND is_charged = FALSE RETURNING due_date, amount - discount_amount`, installmentId).
+		Scan(&dueDate, &amount)
+	if errors.Is(err, sql.ErrNoRows) {
+		err = nil
+		return false, nil
+	}
+	if err != nil {
+		return false, status.Errorf(codes.Internal, "error while marking installment charged %v", err)
+	}
+	if amount > 0 {
+		today := time.Now().Format("2006-01-02")
+		comment := fmt.Sprintf("bo'lib to'lash rejasi bo'yicha %s muddatdagi to'lov student balansidan yechib olindi", dueDate.Format("2006-01-02"))
+		err = r.paymentRepo.TakeOffPayment(ctx, companyId, today, formatAmount(amount), "CASH", comment, studentId,
+			"TIZIM", "00000000-0000-0000-0000-000000000000", groupId, today)
+		if err != nil {
+			return false, status.Errorf(codes.Internal, "error while charging installment %v", err)
+		}
+	}
+	return true, nil
+}
+
+// ChargeDueInstallments posts the installments of every active plan of the company that fell due, it runs daily so an
+// installment is charged on its due date and can get the early payment discount until then
+func (r *InstallmentRepository) ChargeDueInstallments(ctx context.Context, companyId string) (*pb.AbsResponse, error) {
+	rows, err := r.db.Query(`SELECT DISTINCT p.id, p.student_id, p.group_id
+		FROM installment_plan p JOIN installment i ON i.plan_id = p.id
+		WHERE p.company_id=$1 AND p.status='ACTIVE' AND i.is_charged = FALSE AND i.due_date <= CURRENT_DATE`, companyId)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "error while getting due installments %v", err)
+	}
+	type duePlan struct {
+		id, studentId, groupId string
+	}
+	var plans []duePlan
+	for rows.Next() {
+		var plan duePlan
+		if err := rows.Scan(&plan.id, &plan.studentId, &plan.groupId); err != nil {
+			rows.Close()
+			return nil, status.Errorf(codes.Internal, "failed to scan row: %v", err)
+		}
+		plans = append(plans, plan)
+	}
+	rows.Close()
+
+	var charged int32
+	for _, plan := range plans {
+		count, err := r.chargePlan(ctx, companyId, plan.id, plan.studentId, plan.groupId, time.Now())
+		charged += count
+		if err != nil {
+			fmt.Printf("error while charging installment plan %s: %v\n", plan.id, err)
+		}
+	}
+	return &pb.AbsResponse{
+		Status:  http.StatusOK,
+		Message: fmt.Sprintf("%d installments charged", charged),
+	}, nil
+}
+
+// allocateInstallmentPayment spreads a student payment over the unpaid installments of active plans, oldest first.
+// An installment paid in full before its early payment window closes gets the plan's early payment discount,
+// as long as it has not been charged yet.
+func allocateInstallmentPayment(tx *sql.Tx, companyId, studentId, groupId string, amount float64, paidDate time.Time) error {
+	rows, err := tx.Query(`SELECT i.id, i.due_date, i.amount, i.paid_amount, i.is_charged, p.early_discount_percent, p.early_days
+		FROM installment i JOIN installment_plan p ON p.id = i.plan_id
+		WHERE p.student_id=$1 AND p.company_id=$2 AND p.status='ACTIVE' AND ($3 = '' OR p.group_id::text = $3)
+		  AND i.paid_amount + i.discount_amount < i.amount
+		ORDER BY i.due_date
+		FOR UPDATE OF i`, studentId, companyId, groupId)
+	if err != nil {
+		return fmt.Errorf("error while getting installments: %v", err)
+	}
+	type openInstallment struct {
+		id                 string
+		dueDate            time.Time
+		amount, paidAmount float64
+		isCharged          bool
+		discountPercent    float64
+		earlyDays          int
+	}
+	var installments []openInstallment
+	for rows.Next() {
+		var installment openInstallment
+		if err := rows.Scan(&installment.id, &installment.dueDate, &installment.amount, &installment.paidAmount, &installment.isCharged,
+			&installment.discountPercent, &installment.earlyDays); err != nil {
+			rows.Close()
+			return fmt.Errorf("error while scanning installment: %v", err)
+		}
+		installments = append(installments, installment)
+	}
+	rows.Close()
+
+	for _, installment := range installments {
+		if amount <= 0 {
+			break
+		}
+		var discount float64
+		if !installment.isCharged && installment.discountPercent > 0 && !paidDate.After(installment.dueDate.AddDate(0, 0, -installment.earlyDays)) {
+			discount = roundAmount(installment.amount * installment.discountPercent / 100)
+		}
+		required := roundAmount(installment.amount - discount - installment.paidAmount)
+		if required <= 0 {
+			continue
+		}
+		pay := math.Min(amount, required)
+		amount = roundAmount(amount - pay)
+		paidAmount := roundAmount(installment.paidAmount + pay)
+		if pay < required {
+			_, err = tx.Exec(`UPDATE installment SET paid_amount=$2 WHERE id=$1`, installment.id, paidAmount)
+		} else {
+			_, err = tx.Exec(`UPDATE installment SET paid_amount=$2, discount_amount=$3, paid_at=$4 WHERE id=$1`, installment.id, paidAmount, discount, paidDate)
+		}
+		if err != nil {
+			return fmt.Errorf("error while allocating payment to installment: %v", err)
+		}
+	}
+	return nil
+}
+
+func buildInstallments(req *pb.CreateInstallmentPlanRequest, startDate time.Time) ([]plannedInstallment, error) {
+	switch req.PlanType {
+	case "FULL_PREPAY":
+		total, err := strconv.ParseFloat(req.TotalAmount, 64)
+		if err != nil || total <= 0 {
+			return nil, errors.New("totalAmount must be a positive number")
+		}
+		return []plannedInstallment{{dueDate: startDate, amount: total}}, nil
+	case "MONTHLY":
+		total, err := strconv.ParseFloat(req.TotalAmount, 64)
+		if err != nil || total <= 0 {
+			return nil, errors.New("totalAmount must be a positive number")
+		}
+		if req.MonthsCount < 1 {
+			return nil, errors.New("monthsCount must be at least 1")
+		}
+		perMonth := math.Floor(total / float64(req.MonthsCount))
+		installments := make([]plannedInstallment, 0, req.MonthsCount)
+		for i := 0; i < int(req.MonthsCount); i++ {
+			amount := perMonth
+			if i == int(req.MonthsCount)-1 {
+				amount = roundAmount(total - perMonth*float64(req.MonthsCount-1))
+			}
+			installments = append(installments, plannedInstallment{dueDate: startDate.AddDate(0, i, 0), amount: amount})
+		}
+		return installments, nil
+	case "CUSTOM":
+		if len(req.Installments) == 0 {
+			return nil, errors.New("custom plan must have at least one installment")
+		}
+		installments := make([]plannedInstallment, 0, len(req.Installments))
+		for _, input := range req.Installments {
+			dueDate, err := time.Parse("2006-01-02", input.DueDate)
+			if err != nil {
+				return nil, fmt.Errorf("invalid installment dueDate format: %v", err)
+			}
+			amount, err := strconv.ParseFloat(input.Amount, 64)
+			if err != nil || amount <= 0 {
+				return nil, errors.New("installment amount must be a positive number")
+			}
+			installments = append(installments, plannedInstallment{dueDate: dueDate, amount: amount})
+		}
+		sort.Slice(installments, func(i, j int) bool {
+			return installments[i].dueDate.Before(installments[j].dueDate)
+		})
+		return installments, nil
+	}
+	return nil, errors.New("planType must be one of FULL_PREPAY, MONTHLY, CUSTOM")
+}
+
+func installmentStatus(dueDate time.Time, amount, paidAmount, discount float64, isCharged bool, today time.Time) string {
+	switch {
+	case paidAmount+discount >= amount:
+		return "PAID"
+	case dueDate.Before(today):
+		return "OVERDUE"
+	case isCharged:
+		return "CHARGED"
+	}
+	return "PENDING"
+}
+
+func overdueBucket(days int32) string {
+	switch {
+	case days <= 30:
+		return "0-30"
+	case days <= 60:
+		return "31-60"
+	case days <= 90:
+		return "61-90"
+	}
+	return "90+"
+}
+
+func parseOptionalAmount(value string) (float64, error) {
+	if value == "" {
+		return 0, nil
+	}
+	amount, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		return 0, err
+	}
+	if amount < 0 {
+		return 0, errors.New("amount must be non-negative")
+	}
+	return amount, nil
+}
+
+func roundAmount(amount float64) float64 {
+	return math.Round(amount*100) / 100
+}
+
+func formatAmount(amount float64) string {
+	return strconv.FormatFloat(amount, 'f', 2, 64)
+}
+
+func NewInstallmentRepository(db *sql.DB, educationClient *clients.EducationClient, paymentRepo *PaymentRepository) *InstallmentRepository {
+	return &InstallmentRepository{db: db, educationClient: educationClient, paymentRepo: paymentRepo}
+}
@@ -0,0 +1,404 @@
+package repository
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"finance-service/internal/clients"
+	"finance-service/internal/utils"
+	"finance-service/proto/pb"
+	"fmt"
+	"golang.org/x/text/encoding"
+	"golang.org/x/text/encoding/charmap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+)
+
+type OneCExportRepository struct {
+	db              *sql.DB
+	educationClient *clients.EducationClient
+	userClient      *clients.UserClient
+}
+
+var oneCSourceTypes = map[string]bool{
+	"PAYMENT_METHOD":   true,
+	"STUDENT":          true,
+	"EXPENSE_CATEGORY": true,
+	"EXPENSE_USER":     true,
+	"SALARY":           true,
+}
+
+func (r *OneCExportRepository) GetOneCSetting(companyId string) (*pb.OneCSetting, error) {
+	var setting pb.OneCSetting
+	err := r.db.QueryRow(`SELECT organization_name, inn, bank_name, bik FROM onec_setting WHERE company_id = $1`, companyId).
+		Scan(&setting.OrganizationName, &setting.Inn, &setting.BankName, &setting.Bik)
+	if err != nil && err != sql.ErrNoRows {
+		return nil, status.Errorf(codes.Internal, "failed to retrieve 1C setting: %v", err)
+	}
+	rows, err := r.db.Query(`SELECT source_type, source_key, account FROM onec_account_mapping WHERE company_id = $1 ORDER BY source_type, source_key`, companyId)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to retrieve 1C account mappings: %v", err)
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var mapping pb.OneCAccountMapping
+		if err := rows.Scan(&mapping.SourceType, &mapping.SourceKey, &mapping.Account); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to scan row: %v", err)
+		}
+		setting.Mappings = append(setting.Mappings, &mapping)
+	}
+	return &setting, rows.Err()
+}
+
+// UpdateOneCSetting replaces the requisites and the whole account mapping list of the company
+func (r *OneCExportRepository) UpdateOneCSetting(companyId string, req *pb.OneCSetting) (_ *pb.AbsResponse, err error) {
+	for _, mapping := range req.Mappings {
+		if !oneCSourceTypes[mapping.SourceType] {
+			return nil, status.Errorf(codes.InvalidArgument, "unknown source type %s", mapping.SourceType)
+		}
+		if strings.TrimSpace(mapping.Account) == "" {
+			return nil, status.Errorf(codes.InvalidArgument, "account is required for %s %s", mapping.SourceType, mapping.SourceKey)
+		}
+	}
+	tx, err := r.db.Begin()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to begin transaction: %v", err)
+	}
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+			return
+		}
+		err = tx.Commit()
+	}()
+	_, err = tx.Exec(`INSERT INTO onec_setting (company_id, organization_name, inn, bank_name, bik, updated_at)
+		VALUES ($1, $2, $3, $4, $5, NOW())
+		ON CONFLICT (company_id) DO UPDATE SET organization_name = $2, inn = $3, bank_name = $4, bik = $5, updated_at = NOW()`,
+		companyId, req.OrganizationName, req.Inn, req.BankName, req.Bik)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to save 1C setting: %v", err)
+	}
+	if _, err = tx.Exec(`DELETE FROM onec_account_mapping WHERE company_id = $1`, companyId); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to clear 1C account mappings: %v", err)
+	}
+	for _, mapping := range req.Mappings {
+		_, err = tx.Exec(`INSERT INTO onec_account_mapping (company_id, source_type, source_key, account) VALUES ($1, $2, $3, $4)
+			ON CONFLICT (company_id, source_type, source_key) DO UPDATE SET account = $4`,
+			companyId, mapping.SourceType, strings.TrimSpace(mapping.SourceKey), strings.TrimSpace(mapping.Account))
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to save 1C account mapping: %v", err)
+		}
+	}
+	return &pb.AbsResponse{
+		Status:  http.StatusOK,
+		Message: "1C setting updated",
+	}, nil
+}
+
+type oneCDocument struct {
+	number          int
+	date            time.Time
+	amount          float64
+	incoming        bool
+	account         string
+	counterparty    string
+	counterpartyAcc string
+	purpose         string
+}
+
+// ExportOneC builds a 1CClientBankExchange file in Windows-1251 with student payments, refunds, paid expenses and salary payouts
+func (r *OneCExportRepository) ExportOneC(ctx context.Context, companyId string, req *pb.ExportOneCRequest) (*pb.ExportOneCResponse, error) {
+	from, err := time.Parse("2006-01-02", req.From)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid from date, expected YYYY-MM-DD")
+	}
+	to, err := time.Parse("2006-01-02", req.To)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid to date, expected YYYY-MM-DD")
+	}
+	if to.Before(from) {
+		return nil, status.Errorf(codes.InvalidArgument, "to date must not be before from date")
+	}
+	setting, err := r.GetOneCSetting(companyId)
+	if err != nil {
+		return nil, err
+	}
+	accounts := make(map[string]string, len(setting.Mappings))
+	for _, mapping := range setting.Mappings {
+		accounts[mapping.SourceType+":"+mapping.SourceKey] = mapping.Account
+	}
+	account := func(sourceType, sourceKey string) string {
+		if acc, ok := accounts[sourceType+":"+sourceKey]; ok {
+			return acc
+		}
+		return accounts[sourceType+":"]
+	}
+
+	documents, err := r.collectPaymentDocuments(ctx, companyId, req.From, req.To, account)
+	if err != nil {
+		return nil, err
+	}
+	expenseDocuments, err := r.collectExpenseDocuments(ctx, companyId, req.From, req.To, account)
+	if err != nil {
+		return nil, err
+	}
+	documents = append(documents, expenseDocuments...)
+	sort.SliceStable(documents, func(i, j int) bool {
+		return documents[i].date.Before(documents[j].date)
+	})
+	for i := range documents {
+		documents[i].number = i + 1
+	}
+
+	content, err := encodeOneCFile(buildOneCFile(setting, from, to, documents))
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to encode 1C file: %v", err)
+	}
+	return &pb.ExportOneCResponse{
+		FileName:      fmt.Sprintf("1c_%s_%s.txt", req.From, req.To),
+		Content:       []byte(content),
+		DocumentCount: int32(len(documents)),
+	}, nil
+}
+
+// oneCPayment is a student payment row the export turns into a bank document
+type oneCPayment struct {
+	studentName    string
+	method         string
+	amount         float64
+	givenDate      time.Time
+	comment        string
+	paymentType    string
+	discountCredit bool
+}
+
+func (r *OneCExportRepository) collectPaymentDocuments(ctx context.Context, companyId, from, to string, account func(string, string) string) ([]oneCDocument, error) {
+	rows, err := r.db.Query(`SELECT student_id, method, amount, given_date, comment, payment_type, discount_credit
+		FROM student_payments
+		WHERE company_id = $1 AND given_date BETWEEN $2 AND $3 AND payment_type IN ('ADD', 'REFUND')
+		ORDER BY given_date, created_at`, companyId, from, to)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to retrieve payments: %v", err)
+	}
+	defer rows.Close()
+	var documents []oneCDocument
+	studentNames := make(map[string]string)
+	for rows.Next() {
+		var (
+			payment   oneCPayment
+			studentId string
+		)
+		if err := rows.Scan(&studentId, &payment.method, &payment.amount, &payment.givenDate, &payment.comment, &payment.paymentType, &payment.discountCredit); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to scan row: %v", err)
+		}
+		if payment.discountCredit {
+			continue
+		}
+		name, ok := studentNames[studentId]
+		if !ok {
+			name = r.studentName(ctx, companyId, studentId)
+			studentNames[studentId] = name
+		}
+		payment.studentName = name
+		if document, ok := paymentDocument(payment, account); ok {
+			documents = append(documents, document)
+		}
+	}
+	return documents, rows.Err()
+}
+
+// paymentDocument is the bank document of a student payment, a discount credit moves no money and has none
+func paymentDocument(payment oneCPayment, account func(string, string) string) (oneCDocument, bool) {
+	if payment.discountCredit {
+		return oneCDocument{}, false
+	}
+	purpose := "Ta'lim xizmatlari uchun to'lov"
+	if payment.paymentType == "REFUND" {
+		purpose = "Ta'lim xizmatlari uchun to'lovni qaytarish"
+	}
+	if payment.comment != "" {
+		purpose += ". " + payment.comment
+	}
+	return oneCDocument{
+		date:            payment.givenDate,
+		amount:          payment.amount,
+		incoming:        payment.paymentType == "ADD",
+		account:         account("PAYMENT_METHOD", payment.method),
+		counterparty:    payment.studentName,
+		counterpartyAcc: account("STUDENT", ""),
+		purpose:         purpose,
+	}, true
+}
+
+func (r *OneCExportRepository) collectExpenseDocuments(ctx context.Context, companyId, from, to string, account func(string, string) string) ([]oneCDocument, error) {
+	rows, err := r.db.Query(`SELECT e.title, e.sum, e.given_date, e.payment_method, e.expense_type,
+			COALESCE(e.category_id::text, ''), COALESCE(c.name, ''), COALESCE(e.user_id::text, ''), COALESCE(v.name, ''), pi.teacher_name
+		FROM expense e
+		LEFT JOIN category c ON c.id = e.category_id
+		LEFT JOIN vendor v ON v.id = e.vendor_id
+		LEFT JOIN payroll_item pi ON pi.expense_id = e.id
+		WHERE e.company_id = $1 AND e.given_date BETWEEN $2 AND $3 AND e.status = 'PAID'
+		ORDER BY e.given_date, e.created_at`, companyId, from, to)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to retrieve expenses: %v", err)
+	}
+	defer rows.Close()
+	var documents []oneCDocument
+	userNames := make(map[string]string)
+	for rows.Next() {
+		var (
+			title, method, expenseType, categoryId, categoryName, userId, vendorName string
+			teacherName                                                              sql.NullString
+			amount                                                                   float64
+			givenDate                                                                time.Time
+		)
+		if err := rows.Scan(&title, &amount, &givenDate, &method, &expenseType, &categoryId, &categoryName, &userId, &vendorName, &teacherName); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to scan row: %v", err)
+		}
+		document := oneCDocument{
+			date:    givenDate,
+			amount:  amount,
+			account: account("PAYMENT_METHOD", method),
+			purpose: title,
+		}
+		switch {
+		case teacherName.Valid:
+			document.counterparty = teacherName.String
+			document.counterpartyAcc = account("SALARY", "")
+			document.purpose = "Ish haqi to'lovi. " + title
+		case expenseType == "USER":
+			name, ok := userNames[userId]
+			if !ok {
+				name = r.userName(ctx, companyId, userId)
+				userNames[userId] = name
+			}
+			document.counterparty = name
+			document.counterpartyAcc = account("EXPENSE_USER", "")
+		default:
+			document.counterparty = categoryName
+			document.counterpartyAcc = account("EXPENSE_CATEGORY", categoryId)
+		}
+		if vendorName != "" && !teacherName.Valid {
+			document.counterparty = vendorName
+		}
+		documents = append(documents, document)
+	}
+	return documents, rows.Err()
+}
+
+func (r *OneCExportRepository) studentName(ctx context.Context, companyId, studentId string) string {
+	ctx, cancelFunc := utils.NewTimoutContext(ctx, companyId)
+	defer cancelFunc()
+	name, _, _, err := r.educationClient.GetStudentById(ctx, studentId)
+	if err != nil || name == "" {
+		return studentId
+	}
+	return name
+}
+
+func (r *OneCExportRepository) userName(ctx context.Context, companyId, userId string) string {
+	if userId == "" {
+		return ""
+	}
+	ctx, cancelFunc := utils.NewTimoutContext(ctx, companyId)
+	defer cancelFunc()
+	user, err := r.userClient.GetUserById(ctx, userId)
+	if err != nil || user.Name == "" {
+		return userId
+	}
+	return user.Name
+}
+
+// buildOneCFile renders documents as 1CClientBankExchange 1.03, one account section per payment method account
+func buildOneCFile(setting *pb.OneCSetting, from, to time.Time, documents []oneCDocument) string {
+	var buf bytes.Buffer
+	line := func(key, value string) {
+		buf.WriteString(key)
+		buf.WriteString("=")
+		buf.WriteString(strings.ReplaceAll(value, "\n", " "))
+		buf.WriteString("\r\n")
+	}
+	buf.WriteString("1CClientBankExchange\r\n")
+	line("ВерсияФормата", "1.03")
+	line("Кодировка", "Windows")
+	line("Отправитель", "modme")
+	line("Получатель", "Бухгалтерский учет")
+	line("ДатаСоздания", time.Now().Format("02.01.2006"))
+	line("ВремяСоздания", time.Now().Format("15:04:05"))
+	line("ДатаНачала", from.Format("02.01.2006"))
+	line("ДатаКонца", to.Format("02.01.2006"))
+
+	type accountTotals struct{ incoming, outgoing float64 }
+	totals := make(map[string]*accountTotals)
+	var accountOrder []string
+	for _, document := range documents {
+		t, ok := totals[document.account]
+		if !ok {
+			t = &accountTotals{}
+			totals[document.account] = t
+			accountOrder = append(accountOrder, document.account)
+		}
+		if document.incoming {
+			t.incoming += document.amount
+		} else {
+			t.outgoing += document.amount
+		}
+	}
+	sort.Strings(accountOrder)
+	for _, acc := range accountOrder {
+		line("РасчСчет", acc)
+	}
+	line("Документ", "Платежное поручение")
+	for _, acc := range accountOrder {
+		buf.WriteString("СекцияРасчСчет\r\n")
+		line("ДатаНачала", from.Format("02.01.2006"))
+		line("ДатаКонца", to.Format("02.01.2006"))
+		line("РасчСчет", acc)
+		line("ВсегоПоступило", fmt.Sprintf("%.2f", totals[acc].incoming))
+		line("ВсегоСписано", fmt.Sprintf("%.2f", totals[acc].outgoing))
+		buf.WriteString("КонецРасчСчет\r\n")
+	}
+	for _, document := range documents {
+		buf.WriteString("СекцияДокумент=Платежное поручение\r\n")
+		line("Номер", fmt.Sprintf("%d", document.number))
+		line("Дата", document.date.Format("02.01.2006"))
+		line("Сумма", fmt.Sprintf("%.2f", document.amount))
+		payer, payerAcc, payerInn := document.counterparty, document.counterpartyAcc, ""
+		receiver, receiverAcc, receiverInn := setting.OrganizationName, document.account, setting.Inn
+		if !document.incoming {
+			payer, payerAcc, payerInn, receiver, receiverAcc, receiverInn = receiver, receiverAcc, receiverInn, payer, payerAcc, payerInn
+		}
+		line("ПлательщикСчет", payerAcc)
+		line("Плательщик", payer)
+		line("ПлательщикИНН", payerInn)
+		line("ПолучательСчет", receiverAcc)
+		line("Получатель", receiver)
+		line("ПолучательИНН", receiverInn)
+		if document.incoming {
+			line("ПолучательБанк1", setting.BankName)
+			line("ПолучательБИК", setting.Bik)
+			line("ДатаПоступило", document.date.Format("02.01.2006"))
+		} else {
+			line("ПлательщикБанк1", setting.BankName)
+			line("ПлательщикБИК", setting.Bik)
+			line("ДатаСписано", document.date.Format("02.01.2006"))
+		}
+		line("НазначениеПлатежа", document.purpose)
+		buf.WriteString("КонецДокумента\r\n")
+	}
+	buf.WriteString("КонецФайла\r\n")
+	return buf.String()
+}
+
+// encodeOneCFile converts the file to Windows-1251, characters the code page lacks such as the uzbek ʻ become the SUB byte
+func encodeOneCFile(file string) (string, error) {
+	return encoding.ReplaceUnsupported(charmap.Windows1251.NewEncoder()).String(file)
+}
+
+func NewOneCExportRepository(db *sql.DB, educationClient *clients.EducationClient, userClient *clients.UserClient) *OneCExportRepository {
+	return &OneCExportRepository{db: db, educationClient: educationClient, userClient: userClient}
+}
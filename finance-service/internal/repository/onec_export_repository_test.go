@@ -0,0 +1,203 @@
+package repository
+
+import (
+	"finance-service/proto/pb"
+	"strings"
+	"testing"
+	"time"
+)
+
+func oneCAccount(sourceType, sourceKey string) string {
+	return sourceType + ":" + sourceKey
+}
+
+func TestPaymentDocument(t *testing.T) {
+	givenDate := time.Date(2026, 3, 10, 0, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name         string
+		payment      oneCPayment
+		wantDocument bool
+		wantIncoming bool
+		wantPurpose  string
+	}{
+		{
+			name:         "payment",
+			payment:      oneCPayment{studentName: "Ali", method: "CASH", amount: 500000, givenDate: givenDate, paymentType: "ADD"},
+			wantDocument: true,
+			wantIncoming: true,
+			wantPurpose:  "Ta'lim xizmatlari uchun to'lov",
+		},
+		{
+			name:         "cash refund",
+			payment:      oneCPayment{studentName: "Ali", method: "CLICK", amount: 100000, givenDate: givenDate, comment: "kursdan chiqdi", paymentType: "REFUND"},
+			wantDocument: true,
+			wantIncoming: false,
+			wantPurpose:  "Ta'lim xizmatlari uchun to'lovni qaytarish. kursdan chiqdi",
+		},
+		{
+			name:         "discount credit",
+			payment:      oneCPayment{studentName: "Ali", method: "CASH", amount: 50000, givenDate: givenDate, paymentType: "REFUND", discountCredit: true},
+			wantDocument: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			document, ok := paymentDocument(tt.payment, oneCAccount)
+			if ok != tt.wantDocument {
+				t.Fatalf("document = %v, want %v", ok, tt.wantDocument)
+			}
+			if !ok {
+				return
+			}
+			if document.incoming != tt.wantIncoming {
+				t.Errorf("incoming = %v, want %v", document.incoming, tt.wantIncoming)
+			}
+			if document.purpose != tt.wantPurpose {
+				t.Errorf("purpose = %q, want %q", document.purpose, tt.wantPurpose)
+			}
+			if document.account != "PAYMENT_METHOD:"+tt.payment.method {
+				t.Errorf("account = %q, want the %s account", document.account, tt.payment.method)
+			}
+		})
+	}
+}
+
+func TestDiscountCreditIsNotExported(t *testing.T) {
+	day := time.Date(2026, 3, 10, 0, 0, 0, 0, time.UTC)
+	payments := []oneCPayment{
+		{studentName: "Ali", method: "CASH", amount: 500000, givenDate: day, paymentType: "ADD"},
+		{studentName: "Vali", method: "CASH", amount: 77000, givenDate: day, paymentType: "REFUND", discountCredit: true},
+	}
+	var documents []oneCDocument
+	for _, payment := range payments {
+		if document, ok := paymentDocument(payment, oneCAccount); ok {
+			document.number = len(documents) + 1
+			documents = append(documents, document)
+		}
+	}
+	file := buildOneCFile(&pb.OneCSetting{OrganizationName: "Markaz"}, day, day, documents)
+	if strings.Contains(file, "Vali") || strings.Contains(file, "77000.00") {
+		t.Errorf("discount credit is exported:\n%s", file)
+	}
+	if strings.Contains(file, "ДатаСписано") {
+		t.Errorf("file books an outgoing payment:\n%s", file)
+	}
+	if got := strings.Count(file, "СекцияДокумент="); got != 1 {
+		t.Errorf("documents = %d, want 1", got)
+	}
+}
+
+func TestBuildOneCFile(t *testing.T) {
+	from := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2026, 3, 31, 0, 0, 0, 0, time.UTC)
+	setting := &pb.OneCSetting{OrganizationName: "Markaz", Inn: "301234567", BankName: "Kapitalbank", Bik: "01158"}
+	documents := []oneCDocument{
+		{number: 1, date: from, amount: 500000, incoming: true, account: "20208000100", counterparty: "Ali", purpose: "to'lov"},
+		{number: 2, date: to, amount: 120000, account: "20208000200", counterparty: "Ijara", purpose: "ijara\nmart"},
+	}
+	file := buildOneCFile(setting, from, to, documents)
+
+	tests := []struct {
+		name  string
+		check func(file string) bool
+	}{
+		{
+			name: "starts with the format header",
+			check: func(file string) bool {
+				return strings.HasPrefix(file, "1CClientBankExchange\r\nВерсияФормата=1.03\r\nКодировка=Windows\r\n")
+			},
+		},
+		{
+			name:  "ends with the file end",
+			check: func(file string) bool { return strings.HasSuffix(file, "КонецФайла\r\n") },
+		},
+		{
+			name: "every line ends with CRLF",
+			check: func(file string) bool {
+				rest := strings.ReplaceAll(file, "\r\n", "")
+				return !strings.ContainsAny(rest, "\r\n")
+			},
+		},
+		{
+			name: "one account section per account",
+			check: func(file string) bool {
+				return strings.Count(file, "СекцияРасчСчет\r\n") == 2 && strings.Count(file, "КонецРасчСчет\r\n") == 2
+			},
+		},
+		{
+			name: "account totals",
+			check: func(file string) bool {
+				return strings.Contains(file, "РасчСчет=20208000100\r\nВсегоПоступило=500000.00\r\nВсегоСписано=0.00\r\n") &&
+					strings.Contains(file, "РасчСчет=20208000200\r\nВсегоПоступило=0.00\r\nВсегоСписано=120000.00\r\n")
+			},
+		},
+		{
+			name: "one document section per document",
+			check: func(file string) bool {
+				return strings.Count(file, "СекцияДокумент=Платежное поручение\r\n") == 2 && strings.Count(file, "КонецДокумента\r\n") == 2
+			},
+		},
+		{
+			name: "incoming document is paid to the organization",
+			check: func(file string) bool {
+				return strings.Contains(file, "Плательщик=Ali\r\nПлательщикИНН=\r\nПолучательСчет=20208000100\r\nПолучатель=Markaz\r\nПолучательИНН=301234567\r\n") &&
+					strings.Contains(file, "ДатаПоступило=01.03.2026\r\n")
+			},
+		},
+		{
+			name: "outgoing document is paid by the organization",
+			check: func(file string) bool {
+				return strings.Contains(file, "ПлательщикСчет=20208000200\r\nПлательщик=Markaz\r\nПлательщикИНН=301234567\r\n") &&
+					strings.Contains(file, "ДатаСписано=31.03.2026\r\n")
+			},
+		},
+		{
+			name: "line breaks in values are flattened",
+			check: func(file string) bool {
+				return strings.Contains(file, "НазначениеПлатежа=ijara mart\r\n")
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if !tt.check(file) {
+				t.Errorf("unexpected file:\n%s", file)
+			}
+		})
+	}
+}
+
+func TestEncodeOneCFile(t *testing.T) {
+	tests := []struct {
+		name string
+		file string
+		want []byte
+	}{
+		{
+			name: "ascii is kept",
+			file: "Summa=100.00\r\n",
+			want: []byte("Summa=100.00\r\n"),
+		},
+		{
+			name: "cyrillic is windows-1251",
+			file: "Сумма",
+			want: []byte{0xD1, 0xF3, 0xEC, 0xEC, 0xE0},
+		},
+		{
+			name: "unsupported characters are replaced",
+			file: "oʻquv",
+			want: []byte("o\x1aquv"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := encodeOneCFile(tt.file)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got != string(tt.want) {
+				t.Errorf("encoded = % x, want % x", got, tt.want)
+			}
+		})
+	}
+}
@@ -9,6 +9,8 @@ import (
 	"finance-service/proto/pb"
 	"fmt"
 	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"net/http"
 	"strconv"
 	"strings"
@@ -21,9 +23,22 @@ type PaymentRepository struct {
 }
 
 func (r *PaymentRepository) AddPayment(ctx context.Context, companyId string, givenDate, sum, method, comment, studentId, actionByName, actionById, groupId string, isRefund bool) error {
+	_, err := r.addPayment(ctx, companyId, givenDate, sum, method, comment, studentId, actionByName, actionById, groupId, "", isRefund, false)
+	return err
+}
+
+// AddDiscountCredit gives a student balance back for lessons a discount now covers. It is a refund for the balance
+// but no money is paid out, so it is not exported as a bank document.
+func (r *PaymentRepository) AddDiscountCredit(ctx context.Context, companyId string, givenDate, sum, comment, studentId, actionByName, actionById, groupId string) error {
+	_, err := r.addPayment(ctx, companyId, givenDate, sum, "CASH", comment, studentId, actionByName, actionById, groupId, "", true, true)
+	return err
+}
+
+// addPayment stores the payment with an optional provider transaction id and returns the new payment id
+func (r *PaymentRepository) addPayment(ctx context.Context, companyId string, givenDate, sum, method, comment, studentId, actionByName, actionById, groupId, transactionId string, isRefund, discountCredit bool) (string, error) {
 	tx, err := r.db.Begin()
 	if err != nil {
-		return fmt.Errorf("failed to begin transaction: %v", err)
+		return "", fmt.Errorf("failed to begin transaction: %v", err)
 	}
 	defer func() {
 		if err != nil {
@@ -34,50 +49,76 @@ func (r *PaymentRepository) AddPayment(ctx context.Context, companyId string, gi
 	}()
 	validMethods := map[string]bool{"CLICK": true, "CASH": true, "PAYME": true}
 	if !validMethods[method] {
-		return errors.New("invalid payment method")
+		return "", errors.New("invalid payment method")
 	}
 	amount, err := strconv.ParseFloat(sum, 64)
 	if err != nil {
-		return fmt.Errorf("invalid sum amount: %v", err)
+		return "", fmt.Errorf("invalid sum amount: %v", err)
 	}
 	if strings.Contains(givenDate, "T") {
 		givenDate = givenDate[:10]
 	}
 	parsedDate, err := time.Parse("2006-01-02", givenDate)
 	if err != nil {
-		return fmt.Errorf("invalid date format: %v", err)
+		return "", fmt.Errorf("invalid date format: %v", err)
+	}
+	if err = checkPeriodIsOpen(tx, companyId, givenDate); err != nil {
+		return "", err
 	}
 	paymentID := uuid.New()
 	query := `INSERT INTO student_payments 
-		(id, student_id, method, amount, given_date, comment, created_by_id, created_by_name , created_at , group_id ,payment_type, company_id , teacher_id)
-		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9 , $10 , $11 , $12 , $13)`
+		(id, student_id, method, amount, given_date, comment, created_by_id, created_by_name , created_at , group_id ,payment_type, company_id , teacher_id, transaction_id, discount_credit)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9 , $10 , $11 , $12 , $13, NULLIF($14, ''), $15)`
 	paymentType := "ADD"
 	if isRefund {
 		paymentType = "REFUND"
 	}
 	if groupId == "" {
-		_, err = tx.Exec(query, paymentID, studentId, method, amount, parsedDate, comment, actionById, actionByName, time.Now(), nil, paymentType, companyId, nil)
+		_, err = tx.Exec(query, paymentID, studentId, method, amount, parsedDate, comment, actionById, actionByName, time.Now(), nil, paymentType, companyId, nil, transactionId, discountCredit)
 	} else {
 		ctx, cancelFunc := utils.NewTimoutContext(ctx, companyId)
 		defer cancelFunc()
-		_, err = tx.Exec(query, paymentID, studentId, method, amount, parsedDate, comment, actionById, actionByName, time.Now(), groupId, paymentType, companyId, r.educationClient.GetGroupDetailsByGroupId(ctx, groupId).TeacherId)
+		_, err = tx.Exec(query, paymentID, studentId, method, amount, parsedDate, comment, actionById, actionByName, time.Now(), groupId, paymentType, companyId, r.educationClient.GetGroupDetailsByGroupId(ctx, groupId).TeacherId, transactionId, discountCredit)
 	}
 	if err != nil {
-		return fmt.Errorf("failed to add payment: %v", err)
+		return "", fmt.Errorf("failed to add payment: %v", err)
+	}
+	if !isRefund {
+		if err = allocateInstallmentPayment(tx, companyId, studentId, groupId, amount, parsedDate); err != nil {
+			return "", err
+		}
 	}
 	ctx, cancelFunc := utils.NewTimoutContext(ctx, companyId)
 	defer cancelFunc()
 	err = r.educationClient.ChangeUserBalanceHistory(ctx, studentId, sum, givenDate, comment, "ADD", actionById, actionByName, groupId)
 	if err != nil {
-		return fmt.Errorf("failed to update user balance history: %v", err)
+		return "", fmt.Errorf("failed to update user balance history: %v", err)
 	}
-	return nil
+	return paymentID.String(), nil
 }
 
 func (r *PaymentRepository) TakeOffPayment(ctx context.Context, companyId string, date, sum, method, comment, studentId, actionByName, actionById, groupId, studentConditionDate string) error {
+	_, err := r.takeOffPayment(ctx, companyId, date, sum, method, comment, studentId, actionByName, actionById, groupId, studentConditionDate)
+	return err
+}
+
+// PaymentTakeOff is TakeOffPayment for callers that keep the payment id to return the charge later
+func (r *PaymentRepository) PaymentTakeOff(ctx context.Context, companyId string, date, sum, method, comment, studentId, actionByName, actionById, groupId, studentConditionDate string) (*pb.AbsResponse, error) {
+	paymentId, err := r.takeOffPayment(ctx, companyId, date, sum, method, comment, studentId, actionByName, actionById, groupId, studentConditionDate)
+	if err != nil {
+		return nil, status.Errorf(codes.Canceled, err.Error())
+	}
+	return &pb.AbsResponse{
+		Status:  http.StatusCreated,
+		Message: paymentId,
+	}, nil
+}
+
+// takeOffPayment takes the sum off the student balance and returns the new payment id
+func (r *PaymentRepository) takeOffPayment(ctx context.Context, companyId string, date, sum, method, comment, studentId, actionByName, actionById, groupId, studentConditionDate string) (string, error) {
 	tx, err := r.db.Begin()
 	if err != nil {
-		return fmt.Errorf("failed to begin transaction: %v", err)
+		return "", fmt.Errorf("failed to begin transaction: %v", err)
 	}
 	defer func() {
 		if err != nil {
@@ -89,17 +130,20 @@ func (r *PaymentRepository) TakeOffPayment(ctx context.Context, companyId string
 
 	validMethods := map[string]bool{"CLICK": true, "CASH": true, "PAYME": true}
 	if !validMethods[method] {
-		return errors.New("invalid payment method")
+		return "", errors.New("invalid payment method")
 	}
 
 	amount, err := strconv.ParseFloat(sum, 64)
 	if err != nil {
-		return fmt.Errorf("invalid sum amount: %v", err)
+		return "", fmt.Errorf("invalid sum amount: %v", err)
 	}
 
 	parsedDate, err := time.Parse("2006-01-02", date)
 	if err != nil {
-		return fmt.Errorf("invalid date format: %v", err)
+		return "", fmt.Errorf("invalid date format: %v", err)
+	}
+	if err = checkPeriodIsOpen(tx, companyId, date); err != nil {
+		return "", err
 	}
 	ctx, cancelFunc := utils.NewTimoutContext(ctx, companyId)
 	defer cancelFunc()
@@ -114,14 +158,14 @@ func (r *PaymentRepository) TakeOffPayment(ctx context.Context, companyId string
 		_, err = tx.Exec(query, paymentID, studentId, method, amount, parsedDate, comment, actionById, actionByName, time.Now(), groupId, companyId, studentConditionDate)
 	}
 	if err != nil {
-		return fmt.Errorf("failed to take off payment: %v", err)
+		return "", fmt.Errorf("failed to take off payment: %v", err)
 	}
 
 	err = r.educationClient.ChangeUserBalanceHistory(ctx, studentId, sum, date, comment, "TAKE_OFF", actionById, actionByName, groupId)
 	if err != nil {
-		return fmt.Errorf("failed to update user balance history: %v", err)
+		return "", fmt.Errorf("failed to update user balance history: %v", err)
 	}
-	return nil
+	return paymentID.String(), nil
 }
 
 func (r *PaymentRepository) PaymentReturn(ctx context.Context, companyId, paymentId, actionByName, actionById string) (*pb.AbsResponse, error) {
@@ -171,7 +215,13 @@ func (r *PaymentRepository) PaymentReturn(ctx context.Context, companyId, paymen
 	} else if err != nil {
 		return nil, fmt.Errorf("failed to retrieve payment: %v", err)
 	}
+	if err = checkPeriodIsOpen(tx, companyId, payment.GivenDate); err != nil {
+		return nil, err
+	}
 
+	if err = returnStudentCharge(tx, companyId, paymentId); err != nil {
+		return nil, err
+	}
 	deleteQuery := `DELETE FROM student_payments WHERE id = $1 and company_id=$2`
 	_, err = tx.Exec(deleteQuery, paymentId, companyId)
 	if err != nil {
@@ -210,11 +260,23 @@ func (r *PaymentRepository) PaymentUpdate(ctx context.Context, companyId string,
 
 	var paymentType string
 	var oldDebit string
-	query := `SELECT payment_type , amount FROM student_payments where id=$1 and company_id=$2`
-	err = tx.QueryRow(query, paymentId, companyId).Scan(&paymentType, &oldDebit)
+	var oldDate time.Time
+	query := `SELECT payment_type , amount , given_date FROM student_payments where id=$1 and company_id=$2`
+	err = tx.QueryRow(query, paymentId, companyId).Scan(&paymentType, &oldDebit, &oldDate)
 	if err != nil {
 		return nil, fmt.Errorf("error checking payment existence: %v", err)
 	}
+	if err = checkPeriodIsOpen(tx, companyId, oldDate.Format("2006-01-02"), date); err != nil {
+		return nil, err
+	}
+	var isCharge bool
+	if err = tx.QueryRow(`SELECT EXISTS(SELECT 1 FROM student_charge WHERE payment_id = $1 AND company_id = $2)`, paymentId, companyId).Scan(&isCharge); err != nil {
+		return nil, fmt.Errorf("error checking payment charge: %v", err)
+	}
+	if isCharge {
+		err = errors.New("sale charge can not be edited, return it and charge again")
+		return nil, err
+	}
 	updateQuery := `UPDATE student_payments 
 					SET given_date = $1, method = $2, comment = $3, amount = $4, created_by_id = $5, created_by_name = $6, group_id = $8 
 					WHERE id = $7 and company_id=$9`
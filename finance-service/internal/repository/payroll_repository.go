@@ -0,0 +1,543 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"finance-service/internal/clients"
+	"finance-service/internal/utils"
+	"finance-service/proto/pb"
+	"fmt"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"math"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+type PayrollRepository struct {
+	db              *sql.DB
+	educationClient *clients.EducationClient
+	userClient      *clients.UserClient
+}
+
+func (r *PayrollRepository) CreatePayrollRun(ctx context.Context, companyId, period, actionById, actionByName string) (*pb.AbsResponse, error) {
+	periodStart, err := time.Parse("2006-01", period)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid period format, expected YYYY-MM: %v", err)
+	}
+	from := periodStart.Format("2006-01-02")
+	to := periodStart.AddDate(0, 1, -1).Format("2006-01-02")
+
+	var exists bool
+	err = r.db.QueryRow(`SELECT EXISTS(SELECT 1 FROM payroll_run WHERE period=$1 AND company_id=$2)`, period, companyId).Scan(&exists)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "error while checking payroll run %v", err)
+	}
+	if exists {
+		return nil, status.Errorf(codes.AlreadyExists, "payroll run for %s already exists", period)
+	}
+
+	teacherIds, err := getTeacherIds(r.db, companyId)
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := r.db.Begin()
+	if err != nil {
+		return nil, status.Errorf(codes.Aborted, "error while creating transaction %v", err)
+	}
+	defer func() {
+		if err != nil {
+			tx.Rollback()
+		} else {
+			tx.Commit()
+		}
+	}()
+
+	runId := uuid.New()
+	_, err = tx.Exec(`INSERT INTO payroll_run (id, period, status, created_by_id, created_by_name, company_id) VALUES ($1, $2, 'DRAFT', $3, $4, $5)`,
+		runId, period, actionById, actionByName, companyId)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "error while creating payroll run %v", err)
+	}
+
+	ctx, cancelFunc := utils.NewTimoutContext(ctx, companyId)
+	defer cancelFunc()
+	for _, teacherId := range teacherIds {
+		var calculated *pb.CalculateTeacherSalaryResponse
+		calculated, err = r.educationClient.CalculateTeacherSalaryByAttendance(ctx, from, to, teacherId)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "error while calculating salary for teacher %s: %v", teacherId, err)
+		}
+		teacherName := "Teacher Name not available"
+		if user, userErr := r.userClient.GetUserById(ctx, teacherId); userErr == nil {
+			teacherName = user.Name
+		}
+
+		itemId := uuid.New()
+		var salaryAmount float64
+		for _, group := range calculated.Salaries {
+			for _, student := range group.Salaries {
+				salaryAmount += teacherShare(student)
+			}
+		}
+		_, err = tx.Exec(`INSERT INTO payroll_item (id, run_id, teacher_id, teacher_name, salary_amount, company_id) VALUES ($1, $2, $3, $4, $5, $6)`,
+			itemId, runId, teacherId, teacherName, salaryAmount, companyId)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "error while creating payroll item %v", err)
+		}
+		for _, group := range calculated.Salaries {
+			for _, student := range group.Salaries {
+				_, err = tx.Exec(`INSERT INTO payroll_item_detail (id, item_id, group_id, group_name, common_lesson_count, student_id, student_name, passed_lesson_count, amount, price_type, company_id)
+					VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)`,
+					uuid.New(), itemId, group.GroupId, group.GroupName, group.CommonLessonCountInPeriod, student.StudentId, student.StudentName, student.PassedLessonCount, teacherShare(student), student.PriceType, companyId)
+				if err != nil {
+					return nil, status.Errorf(codes.Internal, "error while creating payroll item detail %v", err)
+				}
+			}
+		}
+	}
+
+	return &pb.AbsResponse{
+		Status:  http.StatusCreated,
+		Message: runId.String(),
+	}, nil
+}
+
+func (r *PayrollRepository) GetPayrollRuns(companyId string) (*pb.GetPayrollRunsResponse, error) {
+	rows, err := r.db.Query(`SELECT id FROM payroll_run WHERE company_id=$1 ORDER BY period DESC`, companyId)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to retrieve payroll runs: %v", err)
+	}
+	defer rows.Close()
+	var runIds []string
+	for rows.Next() {
+		var runId string
+		if err := rows.Scan(&runId); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to scan row: %v", err)
+		}
+		runIds = append(runIds, runId)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, status.Errorf(codes.Internal, "error iterating rows: %v", err)
+	}
+
+	var response pb.GetPayrollRunsResponse
+	for _, runId := range runIds {
+		run, err := r.GetPayrollRunById(companyId, runId)
+		if err != nil {
+			return nil, err
+		}
+		run.Items = nil
+		response.Runs = append(response.Runs, run)
+	}
+	return &response, nil
+}
+
+func (r *PayrollRepository) GetPayrollRunById(companyId, runId string) (*pb.AbsPayrollRun, error) {
+	var (
+		run                        pb.AbsPayrollRun
+		approvedByName, paidByName sql.NullString
+		approvedAt, paidAt         sql.NullTime
+		createdAt                  time.Time
+	)
+	err := r.db.QueryRow(`SELECT id, period, status, created_by_name, approved_by_name, approved_at, paid_by_name, paid_at, created_at
+		FROM payroll_run WHERE id=$1 AND company_id=$2`, runId, companyId).Scan(
+		&run.Id, &run.Period, &run.Status, &run.CreatedByName, &approvedByName, &approvedAt, &paidByName, &paidAt, &createdAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, status.Errorf(codes.NotFound, "payroll run not found")
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to retrieve payroll run: %v", err)
+	}
+	run.ApprovedByName = approvedByName.String
+	run.PaidByName = paidByName.String
+	if approvedAt.Valid {
+		run.ApprovedAt = approvedAt.Time.Format("2006-01-02 15:04:05")
+	}
+	if paidAt.Valid {
+		run.PaidAt = paidAt.Time.Format("2006-01-02 15:04:05")
+	}
+	run.CreatedAt = createdAt.Format("2006-01-02 15:04:05")
+
+	items, err := r.getPayrollItems(companyId, runId, "")
+	if err != nil {
+		return nil, err
+	}
+	var total float64
+	for _, item := range items {
+		amount, _ := strconv.ParseFloat(item.TotalAmount, 64)
+		total += amount
+	}
+	run.Items = items
+	run.TotalAmount = fmt.Sprintf("%.2f", total)
+	return &run, nil
+}
+
+func (r *PayrollRepository) DeletePayrollRun(companyId, runId string) (*pb.AbsResponse, error) {
+	result, err := r.db.Exec(`DELETE FROM payroll_run WHERE id=$1 AND company_id=$2 AND status='DRAFT'`, runId, companyId)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to delete payroll run: %v", err)
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "could not check rows affected: %v", err)
+	}
+	if rowsAffected == 0 {
+		return nil, status.Errorf(codes.FailedPrecondition, "only DRAFT payroll run can be deleted")
+	}
+	return &pb.AbsResponse{
+		Status:  http.StatusOK,
+		Message: "payroll run deleted",
+	}, nil
+}
+
+func (r *PayrollRepository) AddPayrollAdjustment(companyId, runId, teacherId, adjustmentType, amount, comment, actionById string) (*pb.AbsResponse, error) {
+	validTypes := map[string]bool{"BONUS": true, "PENALTY": true, "ADVANCE": true}
+	if !validTypes[adjustmentType] {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid adjustment type")
+	}
+	parsedAmount, err := strconv.ParseFloat(amount, 64)
+	if err != nil || parsedAmount <= 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid adjustment amount")
+	}
+	if err := r.checkRunStatus(companyId, runId, "DRAFT"); err != nil {
+		return nil, err
+	}
+	var exists bool
+	err = r.db.QueryRow(`SELECT EXISTS(SELECT 1 FROM payroll_item WHERE run_id=$1 AND teacher_id=$2 AND company_id=$3)`, runId, teacherId, companyId).Scan(&exists)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "error while checking payroll item %v", err)
+	}
+	if !exists {
+		return nil, status.Errorf(codes.NotFound, "teacher not found in this payroll run")
+	}
+	_, err = r.db.Exec(`INSERT INTO payroll_adjustment (id, run_id, teacher_id, adjustment_type, amount, comment, created_by_id, company_id)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)`, uuid.New(), runId, teacherId, adjustmentType, parsedAmount, comment, actionById, companyId)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "error while inserting payroll adjustment %v", err)
+	}
+	return &pb.AbsResponse{
+		Status:  http.StatusCreated,
+		Message: "adjustment added",
+	}, nil
+}
+
+func (r *PayrollRepository) DeletePayrollAdjustment(companyId, id string) (*pb.AbsResponse, error) {
+	result, err := r.db.Exec(`DELETE FROM payroll_adjustment pa USING payroll_run pr
+		WHERE pa.run_id = pr.id AND pa.id=$1 AND pa.company_id=$2 AND pr.status='DRAFT'`, id, companyId)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to delete payroll adjustment: %v", err)
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "could not check rows affected: %v", err)
+	}
+	if rowsAffected == 0 {
+		return nil, status.Errorf(codes.FailedPrecondition, "adjustment not found or payroll run is not DRAFT")
+	}
+	return &pb.AbsResponse{
+		Status:  http.StatusOK,
+		Message: "adjustment deleted",
+	}, nil
+}
+
+func (r *PayrollRepository) ApprovePayrollRun(companyId, runId, actionById, actionByName string) (*pb.AbsResponse, error) {
+	result, err := r.db.Exec(`UPDATE payroll_run SET status='APPROVED', approved_by_id=$1, approved_by_name=$2, approved_at=NOW()
+		WHERE id=$3 AND company_id=$4 AND status='DRAFT'`, actionById, actionByName, runId, companyId)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to approve payroll run: %v", err)
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "could not check rows affected: %v", err)
+	}
+	if rowsAffected == 0 {
+		return nil, status.Errorf(codes.FailedPrecondition, "only DRAFT payroll run can be approved")
+	}
+	return &pb.AbsResponse{
+		Status:  http.StatusOK,
+		Message: "payroll run approved",
+	}, nil
+}
+
+// PayPayrollRun posts every teacher payout and advance of an approved run as USER expenses
+func (r *PayrollRepository) PayPayrollRun(companyId, runId, paymentMethod, givenDate, actionById, actionByName string) (*pb.AbsResponse, error) {
+	validMethods := map[string]bool{"CLICK": true, "CASH": true, "PAYME": true}
+	if !validMethods[paymentMethod] {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid payment method")
+	}
+	if givenDate == "" {
+		givenDate = time.Now().Format("2006-01-02")
+	}
+
+	tx, err := r.db.Begin()
+	if err != nil {
+		return nil, status.Errorf(codes.Aborted, "error while creating transaction %v", err)
+	}
+	defer func() {
+		if err != nil {
+			tx.Rollback()
+		} else {
+			tx.Commit()
+		}
+	}()
+	if err = checkPeriodIsOpen(tx, companyId, givenDate); err != nil {
+		return nil, err
+	}
+
+	// the run stays locked until the expenses are posted, a second call waits and then sees it PAID
+	var period, runStatus string
+	err = tx.QueryRow(`SELECT period, status FROM payroll_run WHERE id=$1 AND company_id=$2 FOR UPDATE`, runId, companyId).Scan(&period, &runStatus)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, status.Errorf(codes.NotFound, "payroll run not found")
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to retrieve payroll run: %v", err)
+	}
+	if runStatus != "APPROVED" {
+		err = status.Errorf(codes.FailedPrecondition, "payroll run must be APPROVED, current status %s", runStatus)
+		return nil, err
+	}
+	items, err := r.getPayrollItems(companyId, runId, "")
+	if err != nil {
+		return nil, err
+	}
+
+	for _, item := range items {
+		var total, advance float64
+		total, err = strconv.ParseFloat(item.TotalAmount, 64)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "invalid payroll amount %v", err)
+		}
+		advance, err = strconv.ParseFloat(item.AdvanceAmount, 64)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "invalid payroll advance %v", err)
+		}
+		// the advance is taken off the payout, it is booked as an expense of its own so the cash out stays complete
+		if advance > 0 {
+			advanceExpenseId := uuid.New()
+			_, err = tx.Exec(`INSERT INTO expense (id, title, user_id, category_id, expense_type, sum, created_at, given_date, created_by, payment_method, company_id)
+				VALUES ($1, $2, $3, NULL, 'USER', $4, NOW(), $5, $6, $7, $8)`,
+				advanceExpenseId, fmt.Sprintf("%s oylik avans", period), item.TeacherId, advance, givenDate, actionById, paymentMethod, companyId)
+			if err != nil {
+				return nil, status.Errorf(codes.Internal, "error while inserting advance expense %v", err)
+			}
+			_, err = tx.Exec(`UPDATE payroll_item SET advance_expense_id=$1 WHERE id=$2`, advanceExpenseId, item.Id)
+			if err != nil {
+				return nil, status.Errorf(codes.Internal, "error while updating payroll item %v", err)
+			}
+		}
+		if total <= 0 {
+			continue
+		}
+		expenseId := uuid.New()
+		_, err = tx.Exec(`INSERT INTO expense (id, title, user_id, category_id, expense_type, sum, created_at, given_date, created_by, payment_method, company_id)
+			VALUES ($1, $2, $3, NULL, 'USER', $4, NOW(), $5, $6, $7, $8)`,
+			expenseId, fmt.Sprintf("%s oylik maosh", period), item.TeacherId, total, givenDate, actionById, paymentMethod, companyId)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "error while inserting salary expense %v", err)
+		}
+		_, err = tx.Exec(`UPDATE payroll_item SET expense_id=$1 WHERE id=$2`, expenseId, item.Id)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "error while updating payroll item %v", err)
+		}
+	}
+	result, err := tx.Exec(`UPDATE payroll_run SET status='PAID', paid_by_id=$1, paid_by_name=$2, paid_at=NOW() WHERE id=$3 AND company_id=$4 AND status='APPROVED'`,
+		actionById, actionByName, runId, companyId)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "error while updating payroll run %v", err)
+	}
+	if updated, _ := result.RowsAffected(); updated == 0 {
+		err = status.Errorf(codes.FailedPrecondition, "payroll run is already paid")
+		return nil, err
+	}
+	return &pb.AbsResponse{
+		Status:  http.StatusOK,
+		Message: "payroll run paid",
+	}, nil
+}
+
+func (r *PayrollRepository) GetPayslip(companyId, runId, teacherId string) (*pb.Payslip, error) {
+	var payslip pb.Payslip
+	err := r.db.QueryRow(`SELECT id, period, status FROM payroll_run WHERE id=$1 AND company_id=$2`, runId, companyId).Scan(&payslip.RunId, &payslip.Period, &payslip.Status)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, status.Errorf(codes.NotFound, "payroll run not found")
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to retrieve payroll run: %v", err)
+	}
+	items, err := r.getPayrollItems(companyId, runId, teacherId)
+	if err != nil {
+		return nil, err
+	}
+	if len(items) == 0 {
+		return nil, status.Errorf(codes.NotFound, "payslip not found for teacher %s", teacherId)
+	}
+	payslip.Item = items[0]
+
+	rows, err := r.db.Query(`SELECT group_id, group_name, common_lesson_count, student_id, student_name, passed_lesson_count, amount, coalesce(price_type, '')
+		FROM payroll_item_detail WHERE item_id=$1 ORDER BY group_name, student_name`, payslip.Item.Id)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to retrieve payslip details: %v", err)
+	}
+	defer rows.Close()
+	groups := make(map[string]*pb.PayslipGroup)
+	groupAmounts := make(map[string]float64)
+	for rows.Next() {
+		var group pb.PayslipGroup
+		var student pb.PayslipStudent
+		var amount float64
+		if err := rows.Scan(&group.GroupId, &group.GroupName, &group.CommonLessonCount, &student.StudentId, &student.StudentName, &student.PassedLessonCount, &amount, &student.PriceType); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to scan row: %v", err)
+		}
+		student.Amount = fmt.Sprintf("%.2f", amount)
+		existing, ok := groups[group.GroupId]
+		if !ok {
+			existing = &group
+			groups[group.GroupId] = existing
+			payslip.Groups = append(payslip.Groups, existing)
+		}
+		existing.Students = append(existing.Students, &student)
+		groupAmounts[group.GroupId] += amount
+	}
+	if err := rows.Err(); err != nil {
+		return nil, status.Errorf(codes.Internal, "error iterating rows: %v", err)
+	}
+	for _, group := range payslip.Groups {
+		group.Amount = fmt.Sprintf("%.2f", groupAmounts[group.GroupId])
+	}
+
+	adjustmentRows, err := r.db.Query(`SELECT id, adjustment_type, amount, comment, created_at FROM payroll_adjustment
+		WHERE run_id=$1 AND teacher_id=$2 AND company_id=$3 ORDER BY created_at`, runId, teacherId, companyId)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to retrieve payroll adjustments: %v", err)
+	}
+	defer adjustmentRows.Close()
+	for adjustmentRows.Next() {
+		var adjustment pb.AbsPayrollAdjustment
+		var amount float64
+		var createdAt time.Time
+		if err := adjustmentRows.Scan(&adjustment.Id, &adjustment.Type, &amount, &adjustment.Comment, &createdAt); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to scan row: %v", err)
+		}
+		adjustment.Amount = fmt.Sprintf("%.2f", amount)
+		adjustment.CreatedAt = createdAt.Format("2006-01-02 15:04:05")
+		payslip.Adjustments = append(payslip.Adjustments, &adjustment)
+	}
+	return &payslip, nil
+}
+
+// GetTeacherPayslips returns payslips of approved and paid runs only, drafts are not visible to teachers
+func (r *PayrollRepository) GetTeacherPayslips(companyId, teacherId string) (*pb.GetTeacherPayslipsResponse, error) {
+	rows, err := r.db.Query(`SELECT pr.id FROM payroll_run pr
+		JOIN payroll_item pi ON pi.run_id = pr.id
+		WHERE pi.teacher_id=$1 AND pr.company_id=$2 AND pr.status IN ('APPROVED', 'PAID')
+		ORDER BY pr.period DESC`, teacherId, companyId)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to retrieve payslips: %v", err)
+	}
+	defer rows.Close()
+	var runIds []string
+	for rows.Next() {
+		var runId string
+		if err := rows.Scan(&runId); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to scan row: %v", err)
+		}
+		runIds = append(runIds, runId)
+	}
+	var response pb.GetTeacherPayslipsResponse
+	for _, runId := range runIds {
+		payslip, err := r.GetPayslip(companyId, runId, teacherId)
+		if err != nil {
+			return nil, err
+		}
+		response.Payslips = append(response.Payslips, payslip)
+	}
+	return &response, nil
+}
+
+func (r *PayrollRepository) getPayrollItems(companyId, runId, teacherId string) ([]*pb.AbsPayrollItem, error) {
+	query := `SELECT pi.id, pi.teacher_id, pi.teacher_name, pi.salary_amount, coalesce(pi.expense_id::text, ''),
+			COALESCE(SUM(CASE WHEN pa.adjustment_type = 'BONUS' THEN pa.amount END), 0),
+			COALESCE(SUM(CASE WHEN pa.adjustment_type = 'PENALTY' THEN pa.amount END), 0),
+			COALESCE(SUM(CASE WHEN pa.adjustment_type = 'ADVANCE' THEN pa.amount END), 0)
+		FROM payroll_item pi
+		LEFT JOIN payroll_adjustment pa ON pa.run_id = pi.run_id AND pa.teacher_id = pi.teacher_id
+		WHERE pi.run_id=$1 AND pi.company_id=$2 AND ($3 = '' OR pi.teacher_id::text = $3)
+		GROUP BY pi.id, pi.teacher_id, pi.teacher_name, pi.salary_amount, pi.expense_id
+		ORDER BY pi.teacher_name`
+	rows, err := r.db.Query(query, runId, companyId, teacherId)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to retrieve payroll items: %v", err)
+	}
+	defer rows.Close()
+	var items []*pb.AbsPayrollItem
+	for rows.Next() {
+		var item pb.AbsPayrollItem
+		var salary, bonus, penalty, advance float64
+		if err := rows.Scan(&item.Id, &item.TeacherId, &item.TeacherName, &salary, &item.ExpenseId, &bonus, &penalty, &advance); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to scan row: %v", err)
+		}
+		item.SalaryAmount = fmt.Sprintf("%.2f", salary)
+		item.BonusAmount = fmt.Sprintf("%.2f", bonus)
+		item.PenaltyAmount = fmt.Sprintf("%.2f", penalty)
+		item.AdvanceAmount = fmt.Sprintf("%.2f", advance)
+		item.TotalAmount = fmt.Sprintf("%.2f", salary+bonus-penalty-advance)
+		items = append(items, &item)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, status.Errorf(codes.Internal, "error iterating rows: %v", err)
+	}
+	return items, nil
+}
+
+// teacherShare converts the attendance based calculation into teacher pay,
+// for PERCENT rules attendance keeps the student lesson price and totalCount keeps the percent
+func teacherShare(student *pb.StudentSalary) float64 {
+	amount := float64(student.CalculatedSalaryInPeriod)
+	if strings.HasPrefix(student.PriceType, "PERCENT") {
+		return math.Round(amount * student.TotalCount / 100)
+	}
+	return amount
+}
+
+func getTeacherIds(db *sql.DB, companyId string) ([]string, error) {
+	rows, err := db.Query(`SELECT DISTINCT teacher_id FROM teacher_salary WHERE company_id=$1`, companyId)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to retrieve teachers: %v", err)
+	}
+	defer rows.Close()
+	var teacherIds []string
+	for rows.Next() {
+		var teacherId string
+		if err := rows.Scan(&teacherId); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to scan row: %v", err)
+		}
+		teacherIds = append(teacherIds, teacherId)
+	}
+	return teacherIds, rows.Err()
+}
+
+func (r *PayrollRepository) checkRunStatus(companyId, runId, expectedStatus string) error {
+	var runStatus string
+	err := r.db.QueryRow(`SELECT status FROM payroll_run WHERE id=$1 AND company_id=$2`, runId, companyId).Scan(&runStatus)
+	if errors.Is(err, sql.ErrNoRows) {
+		return status.Errorf(codes.NotFound, "payroll run not found")
+	}
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to retrieve payroll run: %v", err)
+	}
+	if runStatus != expectedStatus {
+		return status.Errorf(codes.FailedPrecondition, "payroll run must be %s, current status %s", expectedStatus, runStatus)
+	}
+	return nil
+}
+
+func NewPayrollRepository(db *sql.DB, educationClient *clients.EducationClient, userClient *clients.UserClient) *PayrollRepository {
+	return &PayrollRepository{db: db, educationClient: educationClient, userClient: userClient}
+}
@@ -0,0 +1,570 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"finance-service/internal/clients"
+	"finance-service/internal/utils"
+	"finance-service/proto/pb"
+	"fmt"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"math"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// reconciliationDateWindow is how far a statement date may be from the recorded payment date
+const reconciliationDateWindow = 3 * 24 * time.Hour
+
+var reconciliationSourceMethods = map[string]string{
+	"CSV":   "",
+	"XLSX":  "",
+	"PAYME": "PAYME",
+	"CLICK": "CLICK",
+}
+
+type ReconciliationRepository struct {
+	db              *sql.DB
+	educationClient *clients.EducationClient
+	paymentRepo     *PaymentRepository
+}
+
+type reconciliationPayment struct {
+	id            string
+	studentId     string
+	amount        float64
+	givenDate     time.Time
+	transactionId string
+}
+
+// ImportStatement parses the statement, matches every line against student payments and stores the result as a session
+func (r *ReconciliationRepository) ImportStatement(ctx context.Context, companyId string, req *pb.ImportStatementRequest) (*pb.ReconciliationSession, error) {
+	defaultMethod, ok := reconciliationSourceMethods[req.Source]
+	if !ok {
+		return nil, status.Errorf(codes.InvalidArgument, "source must be CSV, XLSX, PAYME or CLICK")
+	}
+	method := req.Method
+	if method == "" {
+		method = defaultMethod
+	}
+	if method != "CASH" && method != "CLICK" && method != "PAYME" {
+		return nil, status.Errorf(codes.InvalidArgument, "method must be CASH, CLICK or PAYME")
+	}
+	lines, err := parseStatement(req.Source, req.FileName, req.Content)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	if len(lines) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "no payment lines found in statement")
+	}
+
+	phoneStudents, err := r.studentsByPhones(ctx, companyId, lines)
+	if err != nil {
+		return nil, err
+	}
+	byTransaction, candidates, err := r.loadReconciliationPayments(companyId, method, lines)
+	if err != nil {
+		return nil, err
+	}
+
+	sessionId := uuid.New()
+	tx, err := r.db.Begin()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to begin transaction: %v", err)
+	}
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		}
+	}()
+	_, err = tx.Exec(`INSERT INTO reconciliation_session (id, source, method, file_name, created_by_id, created_by_name, company_id)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)`, sessionId, req.Source, method, req.FileName, req.ActionById, req.ActionByName, companyId)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to create reconciliation session: %v", err)
+	}
+	used := make(map[string]bool)
+	studentNames := make(map[string]string)
+	seenTransactions := make(map[string]bool)
+	for _, line := range lines {
+		lineStatus, payment, studentId, studentName, note := matchStatementLine(line, byTransaction, candidates, phoneStudents[phoneKey(line.phone)], used, seenTransactions)
+		if payment != nil {
+			used[payment.id] = true
+			studentId = payment.studentId
+		}
+		if studentId != "" && studentName == "" {
+			studentName = r.studentName(ctx, companyId, studentId, studentNames)
+		}
+		if line.transactionId != "" {
+			seenTransactions[line.transactionId] = true
+		}
+		var paidAt, paymentId, student sql.NullString
+		if !line.paidAt.IsZero() {
+			paidAt = sql.NullString{String: line.paidAt.Format("2006-01-02"), Valid: true}
+		}
+		if payment != nil {
+			paymentId = sql.NullString{String: payment.id, Valid: true}
+		}
+		if studentId != "" {
+			student = sql.NullString{String: studentId, Valid: true}
+		}
+		_, err = tx.Exec(`INSERT INTO reconciliation_line (id, session_id, line_no, transaction_id, amount, paid_at, phone, payer_name, description,
+				status, payment_id, student_id, student_name, note, company_id)
+			VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15)`,
+			uuid.New(), sessionId, line.lineNo, line.transactionId, line.amount, paidAt, line.phone, line.payerName, line.description,
+			lineStatus, paymentId, student, studentName, note, companyId)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to save reconciliation line: %v", err)
+		}
+	}
+	if err = tx.Commit(); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to commit reconciliation session: %v", err)
+	}
+	return r.GetReconciliationSession(companyId, sessionId.String(), "")
+}
+
+// matchStatementLine decides the status of a statement line: a known transaction id wins, otherwise a single unused
+// payment with the same amount within the date window, narrowed to the students owning the payer phone
+func matchStatementLine(line statementLine, byTransaction map[string]*reconciliationPayment, candidates []*reconciliationPayment,
+	students []*pb.AbsDebtor, used, seenTransactions map[string]bool) (lineStatus string, payment *reconciliationPayment, studentId, studentName, note string) {
+	if len(students) == 1 {
+		studentId, studentName = students[0].StudentId, students[0].Name
+	}
+	switch {
+	case line.parseError != "":
+		return "SUSPICIOUS", nil, studentId, studentName, line.parseError
+	case line.amount <= 0:
+		return "SUSPICIOUS", nil, studentId, studentName, "summa musbat emas"
+	case line.transactionId != "" && seenTransactions[line.transactionId]:
+		return "SUSPICIOUS", nil, studentId, studentName, "tranzaksiya ko'chirmada takrorlangan"
+	}
+	if existing, ok := byTransaction[line.transactionId]; ok && line.transactionId != "" {
+		if used[existing.id] {
+			return "SUSPICIOUS", nil, studentId, studentName, "to'lov boshqa qatorga biriktirilgan"
+		}
+		if math.Abs(existing.amount-line.amount) > 0.01 {
+			return "SUSPICIOUS", nil, existing.studentId, studentName, fmt.Sprintf("to'lov summasi farq qiladi: %.2f", existing.amount)
+		}
+		return "MATCHED", existing, existing.studentId, studentName, ""
+	}
+
+	var matches []*reconciliationPayment
+	for _, candidate := range candidates {
+		if used[candidate.id] || math.Abs(candidate.amount-line.amount) > 0.01 {
+			continue
+		}
+		if candidate.transactionId != "" && line.transactionId != "" && candidate.transactionId != line.transactionId {
+			continue
+		}
+		if line.paidAt.IsZero() || math.Abs(float64(candidate.givenDate.Sub(line.paidAt.Truncate(24*time.Hour)))) > float64(reconciliationDateWindow) {
+			continue
+		}
+		matches = append(matches, candidate)
+	}
+	closest := func(payments []*reconciliationPayment) *reconciliationPayment {
+		var best *reconciliationPayment
+		for _, p := range payments {
+			if best == nil || math.Abs(float64(p.givenDate.Sub(line.paidAt))) < math.Abs(float64(best.givenDate.Sub(line.paidAt))) {
+				best = p
+			}
+		}
+		return best
+	}
+	if len(students) > 0 {
+		owners := make(map[string]bool, len(students))
+		for _, student := range students {
+			owners[student.StudentId] = true
+		}
+		var own []*reconciliationPayment
+		for _, match := range matches {
+			if owners[match.studentId] {
+				own = append(own, match)
+			}
+		}
+		switch {
+		case len(own) > 0:
+			best := closest(own)
+			return "MATCHED", best, best.studentId, studentName, ""
+		case len(matches) > 0:
+			return "SUSPICIOUS", nil, studentId, studentName, "shu summadagi to'lov boshqa studentga yozilgan"
+		case len(students) > 1:
+			return "UNMATCHED", nil, "", "", "telefon raqami bir nechta studentga tegishli"
+		}
+		return "UNMATCHED", nil, studentId, studentName, ""
+	}
+	switch len(matches) {
+	case 0:
+		if line.phone != "" {
+			return "UNMATCHED", nil, "", "", "telefon raqami bo'yicha student topilmadi"
+		}
+		return "UNMATCHED", nil, "", "", ""
+	case 1:
+		return "MATCHED", matches[0], matches[0].studentId, "", ""
+	}
+	return "SUSPICIOUS", nil, "", "", fmt.Sprintf("%d ta to'lov summa va sana bo'yicha mos keladi", len(matches))
+}
+
+func (r *ReconciliationRepository) studentName(ctx context.Context, companyId, studentId string, cache map[string]string) string {
+	if name, ok := cache[studentId]; ok {
+		return name
+	}
+	ctx, cancelFunc := utils.NewTimoutContext(ctx, companyId)
+	defer cancelFunc()
+	name, _, _, err := r.educationClient.GetStudentById(ctx, studentId)
+	if err != nil {
+		name = ""
+	}
+	cache[studentId] = name
+	return name
+}
+
+func phoneKey(phone string) string {
+	digits := strings.Map(func(r rune) rune {
+		if r >= '0' && r <= '9' {
+			return r
+		}
+		return -1
+	}, phone)
+	if len(digits) < 9 {
+		return ""
+	}
+	return digits[len(digits)-9:]
+}
+
+func (r *ReconciliationRepository) studentsByPhones(ctx context.Context, companyId string, lines []statementLine) (map[string][]*pb.AbsDebtor, error) {
+	var phones []string
+	seen := make(map[string]bool)
+	for _, line := range lines {
+		if key := phoneKey(line.phone); key != "" && !seen[key] {
+			seen[key] = true
+			phones = append(phones, line.phone)
+		}
+	}
+	result := make(map[string][]*pb.AbsDebtor)
+	if len(phones) == 0 {
+		return result, nil
+	}
+	ctx, cancelFunc := utils.NewTimoutContext(ctx, companyId)
+	defer cancelFunc()
+	resp, err := r.educationClient.GetStudentsByPhones(ctx, phones)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to find students by phone: %v", err)
+	}
+	for _, student := range resp.Students {
+		key := phoneKey(student.Phone)
+		result[key] = append(result[key], student)
+	}
+	return result, nil
+}
+
+// loadReconciliationPayments returns payments with the statement transaction ids and unreconciled payments of the
+// method around the statement dates
+func (r *ReconciliationRepository) loadReconciliationPayments(companyId, method string, lines []statementLine) (map[string]*reconciliationPayment, []*reconciliationPayment, error) {
+	var (
+		transactionIds []string
+		from, to       time.Time
+	)
+	for _, line := range lines {
+		if line.transactionId != "" {
+			transactionIds = append(transactionIds, line.transactionId)
+		}
+		if line.paidAt.IsZero() {
+			continue
+		}
+		if from.IsZero() || line.paidAt.Before(from) {
+			from = line.paidAt
+		}
+		if to.IsZero() || line.paidAt.After(to) {
+			to = line.paidAt
+		}
+	}
+	byTransaction := make(map[string]*reconciliationPayment)
+	if len(transactionIds) > 0 {
+		rows, err := r.db.Query(`SELECT id, student_id, amount, given_date, transaction_id FROM student_payments
+			WHERE company_id = $1 AND payment_type = 'ADD' AND transaction_id = ANY($2)`, companyId, pq.Array(transactionIds))
+		if err != nil {
+			return nil, nil, status.Errorf(codes.Internal, "failed to retrieve payments by transaction: %v", err)
+		}
+		payments, err := scanReconciliationPayments(rows)
+		if err != nil {
+			return nil, nil, err
+		}
+		for _, payment := range payments {
+			byTransaction[payment.transactionId] = payment
+		}
+	}
+	if from.IsZero() {
+		return byTransaction, nil, nil
+	}
+	rows, err := r.db.Query(`SELECT p.id, p.student_id, p.amount, p.given_date, COALESCE(p.transaction_id, '') FROM student_payments p
+		WHERE p.company_id = $1 AND p.payment_type = 'ADD' AND p.method = $2 AND p.given_date BETWEEN $3 AND $4
+			AND NOT EXISTS(SELECT 1 FROM reconciliation_line l WHERE l.payment_id = p.id AND l.status IN ('MATCHED', 'CREATED'))
+		ORDER BY p.given_date, p.created_at`,
+		companyId, method, from.Add(-reconciliationDateWindow).Format("2006-01-02"), to.Add(reconciliationDateWindow).Format("2006-01-02"))
+	if err != nil {
+		return nil, nil, status.Errorf(codes.Internal, "failed to retrieve payments: %v", err)
+	}
+	candidates, err := scanReconciliationPayments(rows)
+	if err != nil {
+		return nil, nil, err
+	}
+	return byTransaction, candidates, nil
+}
+
+func scanReconciliationPayments(rows *sql.Rows) ([]*reconciliationPayment, error) {
+	defer rows.Close()
+	var payments []*reconciliationPayment
+	for rows.Next() {
+		var payment reconciliationPayment
+		if err := rows.Scan(&payment.id, &payment.studentId, &payment.amount, &payment.givenDate, &payment.transactionId); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to scan payment: %v", err)
+		}
+		payments = append(payments, &payment)
+	}
+	return payments, rows.Err()
+}
+
+const reconciliationSessionSelect = `SELECT s.id, s.source, s.method, s.file_name, s.created_by_name, s.created_at,
+		COUNT(l.id), COUNT(l.id) FILTER (WHERE l.status = 'MATCHED'), COUNT(l.id) FILTER (WHERE l.status = 'UNMATCHED'),
+		COUNT(l.id) FILTER (WHERE l.status = 'SUSPICIOUS'), COUNT(l.id) FILTER (WHERE l.status = 'CREATED'), COALESCE(SUM(l.amount), 0)
+	FROM reconciliation_session s
+	LEFT JOIN reconciliation_line l ON l.session_id = s.id`
+
+func scanReconciliationSession(row interface{ Scan(...any) error }) (*pb.ReconciliationSession, error) {
+	var (
+		session   pb.ReconciliationSession
+		createdAt time.Time
+	)
+	err := row.Scan(&session.Id, &session.Source, &session.Method, &session.FileName, &session.CreatedByName, &createdAt,
+		&session.TotalCount, &session.MatchedCount, &session.UnmatchedCount, &session.SuspiciousCount, &session.CreatedCount, &session.TotalAmount)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, status.Errorf(codes.NotFound, "reconciliation session not found")
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to scan reconciliation session: %v", err)
+	}
+	session.CreatedAt = createdAt.Format("2006-01-02 15:04:05")
+	session.TotalAmount = roundAmount(session.TotalAmount)
+	return &session, nil
+}
+
+func (r *ReconciliationRepository) GetReconciliationSessions(companyId string, page, size int32) (*pb.GetReconciliationSessionsResponse, error) {
+	if page < 1 {
+		page = 1
+	}
+	if size < 1 {
+		size = 20
+	}
+	var response pb.GetReconciliationSessionsResponse
+	if err := r.db.QueryRow(`SELECT COUNT(*) FROM reconciliation_session WHERE company_id = $1`, companyId).Scan(&response.TotalCount); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to count reconciliation sessions: %v", err)
+	}
+	rows, err := r.db.Query(reconciliationSessionSelect+`
+		WHERE s.company_id = $1
+		GROUP BY s.id
+		ORDER BY s.created_at DESC
+		LIMIT $2 OFFSET $3`, companyId, size, (page-1)*size)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to retrieve reconciliation sessions: %v", err)
+	}
+	defer rows.Close()
+	for rows.Next() {
+		session, err := scanReconciliationSession(rows)
+		if err != nil {
+			return nil, err
+		}
+		response.Sessions = append(response.Sessions, session)
+	}
+	return &response, rows.Err()
+}
+
+// GetReconciliationSession returns the session with its lines, only lines of lineStatus when it is set
+func (r *ReconciliationRepository) GetReconciliationSession(companyId, id, lineStatus string) (*pb.ReconciliationSession, error) {
+	session, err := scanReconciliationSession(r.db.QueryRow(reconciliationSessionSelect+`
+		WHERE s.id = $1 AND s.company_id = $2
+		GROUP BY s.id`, id, companyId))
+	if err != nil {
+		return nil, err
+	}
+	rows, err := r.db.Query(`SELECT id, line_no, transaction_id, amount, paid_at, phone, payer_name, description, status,
+			COALESCE(payment_id::text, ''), COALESCE(student_id::text, ''), student_name, note
+		FROM reconciliation_line
+		WHERE session_id = $1 AND ($2 = '' OR status = $2)
+		ORDER BY line_no`, id, lineStatus)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to retrieve reconciliation lines: %v", err)
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var (
+			line   pb.ReconciliationLine
+			paidAt sql.NullTime
+		)
+		err := rows.Scan(&line.Id, &line.LineNo, &line.TransactionId, &line.Amount, &paidAt, &line.Phone, &line.PayerName, &line.Description,
+			&line.Status, &line.PaymentId, &line.StudentId, &line.StudentName, &line.Note)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to scan reconciliation line: %v", err)
+		}
+		if paidAt.Valid {
+			line.PaidAt = paidAt.Time.Format("2006-01-02")
+		}
+		session.Lines = append(session.Lines, &line)
+	}
+	return session, rows.Err()
+}
+
+// CreateMissingPayments records a student payment for each selected unmatched line, every line reports its own result
+func (r *ReconciliationRepository) CreateMissingPayments(ctx context.Context, companyId string, req *pb.CreateMissingPaymentsRequest) (*pb.CreateMissingPaymentsResponse, error) {
+	var method, source string
+	err := r.db.QueryRow(`SELECT method, source FROM reconciliation_session WHERE id = $1 AND company_id = $2`, req.SessionId, companyId).Scan(&method, &source)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, status.Errorf(codes.NotFound, "reconciliation session not found")
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to retrieve reconciliation session: %v", err)
+	}
+	selected := req.Lines
+	if len(selected) == 0 {
+		rows, err := r.db.Query(`SELECT id FROM reconciliation_line WHERE session_id = $1 AND status = 'UNMATCHED' AND student_id IS NOT NULL ORDER BY line_no`, req.SessionId)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to retrieve unmatched lines: %v", err)
+		}
+		for rows.Next() {
+			var lineId string
+			if err := rows.Scan(&lineId); err != nil {
+				rows.Close()
+				return nil, status.Errorf(codes.Internal, "failed to scan row: %v", err)
+			}
+			selected = append(selected, &pb.ReconciliationLineStudent{LineId: lineId})
+		}
+		rows.Close()
+	}
+	var response pb.CreateMissingPaymentsResponse
+	for _, item := range selected {
+		result := &pb.ReconciliationLineResult{LineId: item.LineId}
+		paymentId, err := r.createLinePayment(ctx, companyId, req, method, source, item)
+		if err != nil {
+			result.Message = err.Error()
+		} else {
+			result.Success = true
+			result.PaymentId = paymentId
+			result.Message = "payment created"
+		}
+		response.Results = append(response.Results, result)
+	}
+	return &response, nil
+}
+
+func (r *ReconciliationRepository) createLinePayment(ctx context.Context, companyId string, req *pb.CreateMissingPaymentsRequest, method, source string, item *pb.ReconciliationLineStudent) (string, error) {
+	var (
+		lineStatus, transactionId, description string
+		amount                                 float64
+		paidAt                                 sql.NullTime
+		studentId                              sql.NullString
+	)
+	err := r.db.QueryRow(`SELECT status, transaction_id, description, amount, paid_at, student_id FROM reconciliation_line WHERE id = $1 AND session_id = $2`,
+		item.LineId, req.SessionId).Scan(&lineStatus, &transactionId, &description, &amount, &paidAt, &studentId)
+	if errors.Is(err, sql.ErrNoRows) {
+		return "", errors.New("line not found in session")
+	}
+	if err != nil {
+		return "", fmt.Errorf("failed to retrieve line: %v", err)
+	}
+	if lineStatus != "UNMATCHED" && lineStatus != "SUSPICIOUS" {
+		return "", fmt.Errorf("line is already %s", lineStatus)
+	}
+	if item.StudentId != "" {
+		studentId = sql.NullString{String: item.StudentId, Valid: true}
+	}
+	if !studentId.Valid {
+		return "", errors.New("student is not set for the line")
+	}
+	if !paidAt.Valid || amount <= 0 {
+		return "", errors.New("line has no valid date or amount")
+	}
+	if transactionId != "" {
+		var exists bool
+		err = r.db.QueryRow(`SELECT EXISTS(SELECT 1 FROM student_payments WHERE company_id = $1 AND transaction_id = $2)`, companyId, transactionId).Scan(&exists)
+		if err != nil {
+			return "", fmt.Errorf("failed to check transaction: %v", err)
+		}
+		if exists {
+			return "", fmt.Errorf("payment with transaction %s already exists", transactionId)
+		}
+	}
+	comment := fmt.Sprintf("%s ko'chirmasi bo'yicha to'lov", source)
+	if transactionId != "" {
+		comment += " #" + transactionId
+	}
+	if description != "" {
+		comment += ". " + description
+	}
+	paymentId, err := r.paymentRepo.addPayment(ctx, companyId, paidAt.Time.Format("2006-01-02"), strconv.FormatFloat(amount, 'f', 2, 64), method, comment,
+		studentId.String, req.ActionByName, req.ActionById, item.GroupId, transactionId, false, false)
+	if err != nil {
+		return "", err
+	}
+	_, err = r.db.Exec(`UPDATE reconciliation_line SET status = 'CREATED', payment_id = $1, student_id = $2, note = '' WHERE id = $3`,
+		paymentId, studentId.String, item.LineId)
+	if err != nil {
+		return "", fmt.Errorf("payment %s created but line was not updated: %v", paymentId, err)
+	}
+	return paymentId, nil
+}
+
+// ResolveReconciliationLine links a line to a payment by hand or ignores it
+func (r *ReconciliationRepository) ResolveReconciliationLine(companyId string, req *pb.ResolveReconciliationLineRequest) (*pb.AbsResponse, error) {
+	var lineStatus string
+	err := r.db.QueryRow(`SELECT status FROM reconciliation_line WHERE id = $1 AND company_id = $2`, req.LineId, companyId).Scan(&lineStatus)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, status.Errorf(codes.NotFound, "reconciliation line not found")
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to retrieve reconciliation line: %v", err)
+	}
+	if lineStatus == "CREATED" {
+		return nil, status.Errorf(codes.FailedPrecondition, "payment was already created for this line")
+	}
+	switch req.Status {
+	case "IGNORED":
+		_, err = r.db.Exec(`UPDATE reconciliation_line SET status = 'IGNORED', payment_id = NULL WHERE id = $1`, req.LineId)
+	case "MATCHED":
+		var studentId string
+		err = r.db.QueryRow(`SELECT student_id FROM student_payments WHERE id = $1 AND company_id = $2 AND payment_type = 'ADD'`, req.PaymentId, companyId).Scan(&studentId)
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, status.Errorf(codes.NotFound, "payment not found")
+		}
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to retrieve payment: %v", err)
+		}
+		var linked bool
+		err = r.db.QueryRow(`SELECT EXISTS(SELECT 1 FROM reconciliation_line WHERE payment_id = $1 AND id <> $2 AND status IN ('MATCHED', 'CREATED'))`,
+			req.PaymentId, req.LineId).Scan(&linked)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to check payment: %v", err)
+		}
+		if linked {
+			return nil, status.Errorf(codes.FailedPrecondition, "payment is already matched to another line")
+		}
+		_, err = r.db.Exec(`UPDATE reconciliation_line SET status = 'MATCHED', payment_id = $1, student_id = $2, note = '' WHERE id = $3`,
+			req.PaymentId, studentId, req.LineId)
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "status must be MATCHED or IGNORED")
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to update reconciliation line: %v", err)
+	}
+	return &pb.AbsResponse{
+		Status:  http.StatusOK,
+		Message: "line " + strings.ToLower(req.Status),
+	}, nil
+}
+
+func NewReconciliationRepository(db *sql.DB, educationClient *clients.EducationClient, paymentRepo *PaymentRepository) *ReconciliationRepository {
+	return &ReconciliationRepository{db: db, educationClient: educationClient, paymentRepo: paymentRepo}
+}
@@ -0,0 +1,382 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"finance-service/internal/clients"
+	"finance-service/internal/utils"
+	"finance-service/proto/pb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"sort"
+	"time"
+)
+
+const maxPnlMonths = 24
+
+type ReportRepository struct {
+	db              *sql.DB
+	educationClient *clients.EducationClient
+}
+
+// pnlKey identifies the amounts of one group inside one month
+type pnlKey struct {
+	period  string
+	groupId string
+}
+
+type pnlAmounts struct {
+	revenue     float64
+	discounts   float64
+	teacherCost float64
+}
+
+func (r *ReportRepository) GetProfitAndLoss(ctx context.Context, companyId, from, to, groupBy string) (*pb.GetProfitAndLossResponse, error) {
+	if groupBy != "" && groupBy != "COURSE" && groupBy != "GROUP" && groupBy != "TEACHER" {
+		return nil, status.Errorf(codes.InvalidArgument, "groupBy must be one of COURSE, GROUP, TEACHER")
+	}
+	periods, err := pnlPeriods(from, to)
+	if err != nil {
+		return nil, err
+	}
+	fromDate, _ := time.Parse("2006-01", periods[0])
+	toDate, _ := time.Parse("2006-01", periods[len(periods)-1])
+	toDate = toDate.AddDate(0, 1, 0)
+
+	months := make(map[string]*pb.PnlMonth, len(periods))
+	for _, period := range periods {
+		months[period] = &pb.PnlMonth{Period: period}
+	}
+	groups := make(map[pnlKey]*pnlAmounts)
+	groupAmounts := func(period, groupId string) *pnlAmounts {
+		key := pnlKey{period: period, groupId: groupId}
+		if groups[key] == nil {
+			groups[key] = &pnlAmounts{}
+		}
+		return groups[key]
+	}
+
+	if err := r.collectPayments(companyId, fromDate, toDate, months, groupAmounts); err != nil {
+		return nil, err
+	}
+	if err := r.collectExpenses(companyId, fromDate, toDate, months); err != nil {
+		return nil, err
+	}
+	if err := r.collectDiscounts(companyId, fromDate, toDate, months, groupAmounts); err != nil {
+		return nil, err
+	}
+	if err := r.collectTeacherCost(ctx, companyId, periods, months, groupAmounts); err != nil {
+		return nil, err
+	}
+
+	response := &pb.GetProfitAndLossResponse{GroupBy: groupBy, Total: &pb.PnlMonth{Period: periods[0] + ":" + periods[len(periods)-1]}}
+	for _, period := range periods {
+		month := months[period]
+		month.GrossProfit = month.Revenue - month.TeacherCost
+		month.NetProfit = month.GrossProfit - month.Expenses
+		response.Total.Revenue += month.Revenue
+		response.Total.Payments += month.Payments
+		response.Total.Discounts += month.Discounts
+		response.Total.TeacherCost += month.TeacherCost
+		response.Total.Expenses += month.Expenses
+		response.Total.GrossProfit += month.GrossProfit
+		response.Total.NetProfit += month.NetProfit
+		response.Months = append(response.Months, roundPnlMonth(month))
+	}
+	roundPnlMonth(response.Total)
+
+	if groupBy != "" {
+		response.Breakdown, err = r.breakdown(ctx, companyId, groupBy, groups)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return response, nil
+}
+
+// collectPayments sums take-offs as revenue and student payments minus refunds as cash received
+func (r *ReportRepository) collectPayments(companyId string, fromDate, toDate time.Time, months map[string]*pb.PnlMonth, groupAmounts func(string, string) *pnlAmounts) error {
+	rows, err := r.db.Query(`SELECT to_char(given_date, 'YYYY-MM'), COALESCE(group_id::text, ''), payment_type, SUM(amount)
+		FROM student_payments
+		WHERE company_id = $1 AND given_date >= $2 AND given_date < $3
+		GROUP BY 1, 2, 3`, companyId, fromDate, toDate)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to retrieve payments: %v", err)
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var period, groupId, paymentType string
+		var amount float64
+		if err := rows.Scan(&period, &groupId, &paymentType, &amount); err != nil {
+			return status.Errorf(codes.Internal, "failed to scan row: %v", err)
+		}
+		month := months[period]
+		if month == nil {
+			continue
+		}
+		switch paymentType {
+		case "TAKE_OFF":
+			month.Revenue += amount
+			groupAmounts(period, groupId).revenue += amount
+		case "ADD":
+			month.Payments += amount
+		case "REFUND":
+			month.Payments -= amount
+		}
+	}
+	return rows.Err()
+}
+
+// collectExpenses skips expenses booked by payroll runs, those are already counted as teacher cost
+func (r *ReportRepository) collectExpenses(companyId string, fromDate, toDate time.Time, months map[string]*pb.PnlMonth) error {
+	rows, err := r.db.Query(`SELECT to_char(e.given_date, 'YYYY-MM'), SUM(e.sum)
+		FROM expense e
+		WHERE e.company_id = $1 AND e.given_date >= $2 AND e.given_date < $3
+		  AND e.status IN ('APPROVED', 'PAID')
+		  AND NOT EXISTS (SELECT 1 FROM payroll_item pi WHERE e.id IN (pi.expense_id, pi.advance_expense_id))
+		GROUP BY 1`, companyId, fromDate, toDate)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to retrieve expenses: %v", err)
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var period string
+		var amount float64
+		if err := rows.Scan(&period, &amount); err != nil {
+			return status.Errorf(codes.Internal, "failed to scan row: %v", err)
+		}
+		if month := months[period]; month != nil {
+			month.Expenses += amount
+		}
+	}
+	return rows.Err()
+}
+
+// collectDiscounts counts a discount once for every month it was active, a later DELETE ends it early
+func (r *ReportRepository) collectDiscounts(companyId string, fromDate, toDate time.Time, months map[string]*pb.PnlMonth, groupAmounts func(string, string) *pnlAmounts) error {
+	rows, err := r.db.Query(`SELECT h.group_id::text, h.discount, h.start_at,
+		       LEAST(h.end_at, COALESCE((SELECT MIN(d.created_at)::date
+		                                 FROM student_discount_history d
+		                                 WHERE d.company_id = h.company_id AND d.student_id = h.student_id
+		                                   AND d.group_id = h.group_id AND d.action = 'DELETE'
+		                                   AND d.created_at > h.created_at), h.end_at))
+		FROM student_discount_history h
+		WHERE h.company_id = $1 AND h.action = 'CREATE' AND h.start_at < $3 AND h.end_at >= $2`, companyId, fromDate, toDate)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to retrieve discounts: %v", err)
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var groupId string
+		var discount float64
+		var startAt, endAt time.Time
+		if err := rows.Scan(&groupId, &discount, &startAt, &endAt); err != nil {
+			return status.Errorf(codes.Internal, "failed to scan row: %v", err)
+		}
+		month := time.Date(startAt.Year(), startAt.Month(), 1, 0, 0, 0, 0, time.UTC)
+		for !month.After(endAt) {
+			period := month.Format("2006-01")
+			if pnlMonth := months[period]; pnlMonth != nil {
+				pnlMonth.Discounts += discount
+				groupAmounts(period, groupId).discounts += discount
+			}
+			month = month.AddDate(0, 1, 0)
+		}
+	}
+	return rows.Err()
+}
+
+// collectTeacherCost takes salaries from approved or paid payroll runs when the month has one,
+// otherwise it estimates them from attendance the same way a payroll run would. Draft runs are not counted
+func (r *ReportRepository) collectTeacherCost(ctx context.Context, companyId string, periods []string, months map[string]*pb.PnlMonth, groupAmounts func(string, string) *pnlAmounts) error {
+	rows, err := r.db.Query(`SELECT pr.period, d.group_id::text, SUM(d.amount)
+		FROM payroll_run pr
+		JOIN payroll_item pi ON pi.run_id = pr.id
+		JOIN payroll_item_detail d ON d.item_id = pi.id
+		WHERE pr.company_id = $1 AND pr.period >= $2 AND pr.period <= $3 AND pr.status IN ('APPROVED', 'PAID')
+		GROUP BY 1, 2`, companyId, periods[0], periods[len(periods)-1])
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to retrieve payroll items: %v", err)
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var period, groupId string
+		var amount float64
+		if err := rows.Scan(&period, &groupId, &amount); err != nil {
+			return status.Errorf(codes.Internal, "failed to scan row: %v", err)
+		}
+		months[period].TeacherCost += amount
+		groupAmounts(period, groupId).teacherCost += amount
+	}
+	if err := rows.Err(); err != nil {
+		return status.Errorf(codes.Internal, "error iterating rows: %v", err)
+	}
+
+	covered := make(map[string]bool)
+	runRows, err := r.db.Query(`SELECT pr.period,
+		       COALESCE(SUM(CASE a.adjustment_type WHEN 'BONUS' THEN a.amount WHEN 'PENALTY' THEN -a.amount ELSE 0 END), 0)
+		FROM payroll_run pr
+		LEFT JOIN payroll_adjustment a ON a.run_id = pr.id
+		WHERE pr.company_id = $1 AND pr.period >= $2 AND pr.period <= $3 AND pr.status IN ('APPROVED', 'PAID')
+		GROUP BY 1`, companyId, periods[0], periods[len(periods)-1])
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to retrieve payroll runs: %v", err)
+	}
+	defer runRows.Close()
+	for runRows.Next() {
+		var period string
+		var adjustment float64
+		if err := runRows.Scan(&period, &adjustment); err != nil {
+			return status.Errorf(codes.Internal, "failed to scan row: %v", err)
+		}
+		covered[period] = true
+		months[period].TeacherCost += adjustment
+	}
+	if err := runRows.Err(); err != nil {
+		return status.Errorf(codes.Internal, "error iterating rows: %v", err)
+	}
+	if len(covered) == len(periods) {
+		return nil
+	}
+
+	teacherIds, err := getTeacherIds(r.db, companyId)
+	if err != nil {
+		return err
+	}
+	ctx, cancelFunc := utils.NewTimoutContext(ctx, companyId)
+	defer cancelFunc()
+	if len(teacherIds) == 0 {
+		return nil
+	}
+	for _, period := range periods {
+		if covered[period] {
+			continue
+		}
+		periodStart, _ := time.Parse("2006-01", period)
+		from := periodStart.Format("2006-01-02")
+		to := periodStart.AddDate(0, 1, -1).Format("2006-01-02")
+		calculated, err := r.educationClient.CalculateTeachersSalaryByAttendance(ctx, from, to, teacherIds)
+		if err != nil {
+			return status.Errorf(codes.Internal, "error while calculating teacher salaries for %s: %v", period, err)
+		}
+		for _, group := range calculated.Salaries {
+			for _, student := range group.Salaries {
+				share := teacherShare(student)
+				months[period].TeacherCost += share
+				groupAmounts(period, group.GroupId).teacherCost += share
+			}
+		}
+	}
+	return nil
+}
+
+// breakdown rolls the per group amounts up to the requested dimension using group metadata from education
+func (r *ReportRepository) breakdown(ctx context.Context, companyId, groupBy string, groups map[pnlKey]*pnlAmounts) ([]*pb.PnlBreakdown, error) {
+	groupIdSet := make(map[string]bool)
+	var groupIds []string
+	for key := range groups {
+		if key.groupId != "" && !groupIdSet[key.groupId] {
+			groupIdSet[key.groupId] = true
+			groupIds = append(groupIds, key.groupId)
+		}
+	}
+	meta := make(map[string]*pb.GroupMeta)
+	if len(groupIds) > 0 {
+		ctx, cancelFunc := utils.NewTimoutContext(ctx, companyId)
+		defer cancelFunc()
+		resp, err := r.educationClient.GetGroupsByIds(ctx, groupIds)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "error while getting groups from education service: %v", err)
+		}
+		for _, group := range resp.Groups {
+			meta[group.Id] = group
+		}
+	}
+
+	rowsByKey := make(map[pnlKey]*pb.PnlBreakdown)
+	for key, amounts := range groups {
+		id, name := "", "Guruhsiz"
+		if group := meta[key.groupId]; group != nil {
+			switch groupBy {
+			case "COURSE":
+				id, name = group.CourseId, group.CourseName
+			case "GROUP":
+				id, name = group.Id, group.Name
+			case "TEACHER":
+				id, name = group.TeacherId, group.TeacherName
+			}
+		} else if key.groupId != "" && groupBy == "GROUP" {
+			id, name = key.groupId, key.groupId
+		}
+		rowKey := pnlKey{period: key.period, groupId: id}
+		row := rowsByKey[rowKey]
+		if row == nil {
+			row = &pb.PnlBreakdown{Period: key.period, Id: id, Name: name}
+			rowsByKey[rowKey] = row
+		}
+		row.Revenue += amounts.revenue
+		row.Discounts += amounts.discounts
+		row.TeacherCost += amounts.teacherCost
+	}
+
+	result := make([]*pb.PnlBreakdown, 0, len(rowsByKey))
+	for _, row := range rowsByKey {
+		row.Revenue = roundAmount(row.Revenue)
+		row.Discounts = roundAmount(row.Discounts)
+		row.TeacherCost = roundAmount(row.TeacherCost)
+		row.GrossProfit = roundAmount(row.Revenue - row.TeacherCost)
+		result = append(result, row)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].Period != result[j].Period {
+			return result[i].Period < result[j].Period
+		}
+		return result[i].Name < result[j].Name
+	})
+	return result, nil
+}
+
+// pnlPeriods expands the YYYY-MM range, an empty bound falls back to the current month
+func pnlPeriods(from, to string) ([]string, error) {
+	now := time.Now().Format("2006-01")
+	if to == "" {
+		to = now
+	}
+	if from == "" {
+		from = to
+	}
+	fromMonth, err := time.Parse("2006-01", from)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid from format, expected YYYY-MM: %v", err)
+	}
+	toMonth, err := time.Parse("2006-01", to)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid to format, expected YYYY-MM: %v", err)
+	}
+	if toMonth.Before(fromMonth) {
+		return nil, status.Errorf(codes.InvalidArgument, "from must not be after to")
+	}
+	var periods []string
+	for month := fromMonth; !month.After(toMonth); month = month.AddDate(0, 1, 0) {
+		periods = append(periods, month.Format("2006-01"))
+		if len(periods) > maxPnlMonths {
+			return nil, status.Errorf(codes.InvalidArgument, "report range must not exceed %d months", maxPnlMonths)
+		}
+	}
+	return periods, nil
+}
+
+func roundPnlMonth(month *pb.PnlMonth) *pb.PnlMonth {
+	month.Revenue = roundAmount(month.Revenue)
+	month.Payments = roundAmount(month.Payments)
+	month.Discounts = roundAmount(month.Discounts)
+	month.TeacherCost = roundAmount(month.TeacherCost)
+	month.Expenses = roundAmount(month.Expenses)
+	month.GrossProfit = roundAmount(month.GrossProfit)
+	month.NetProfit = roundAmount(month.NetProfit)
+	return month
+}
+
+func NewReportRepository(db *sql.DB, educationClient *clients.EducationClient) *ReportRepository {
+	return &ReportRepository{db: db, educationClient: educationClient}
+}
@@ -0,0 +1,95 @@
+package repository
+
+import (
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestPnlPeriods(t *testing.T) {
+	now := time.Now().Format("2006-01")
+	tests := []struct {
+		name     string
+		from     string
+		to       string
+		want     []string
+		wantCode codes.Code
+	}{
+		{
+			name: "single month",
+			from: "2026-03",
+			to:   "2026-03",
+			want: []string{"2026-03"},
+		},
+		{
+			name: "across a year",
+			from: "2025-11",
+			to:   "2026-02",
+			want: []string{"2025-11", "2025-12", "2026-01", "2026-02"},
+		},
+		{
+			name: "empty from is the to month",
+			to:   "2026-05",
+			want: []string{"2026-05"},
+		},
+		{
+			name: "empty range is the current month",
+			want: []string{now},
+		},
+		{
+			name: "longest range",
+			from: "2024-01",
+			to:   "2025-12",
+			want: func() []string {
+				var months []string
+				for month := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC); month.Year() < 2026; month = month.AddDate(0, 1, 0) {
+					months = append(months, month.Format("2006-01"))
+				}
+				return months
+			}(),
+		},
+		{
+			name:     "range too long",
+			from:     "2024-01",
+			to:       "2026-01",
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name:     "from after to",
+			from:     "2026-04",
+			to:       "2026-03",
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name:     "day in from",
+			from:     "2026-03-01",
+			to:       "2026-03",
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name:     "invalid to",
+			from:     "2026-03",
+			to:       "march",
+			wantCode: codes.InvalidArgument,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := pnlPeriods(tt.from, tt.to)
+			if tt.wantCode != codes.OK {
+				if status.Code(err) != tt.wantCode {
+					t.Fatalf("error = %v, want code %v", err, tt.wantCode)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("periods = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
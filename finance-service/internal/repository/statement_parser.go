@@ -0,0 +1,222 @@
+package repository
+
+import (
+	"bytes"
+	"encoding/csv"
+	"fmt"
+	"github.com/xuri/excelize/v2"
+	"golang.org/x/text/encoding/charmap"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// statementLine is one payment row of an imported bank or provider statement
+type statementLine struct {
+	lineNo        int
+	transactionId string
+	amount        float64
+	paidAt        time.Time
+	phone         string
+	payerName     string
+	description   string
+	parseError    string
+}
+
+const (
+	statementColumnAmount = iota
+	statementColumnDate
+	statementColumnTransaction
+	statementColumnPhone
+	statementColumnPayer
+	statementColumnDescription
+)
+
+// statementColumnAliases lists lower-case header names used by banks, Payme and Click exports, the order of the
+// outer slice is the order columns are assigned in so a header is never taken by two fields
+var statementColumnAliases = [][]string{
+	statementColumnAmount:      {"amount", "sum", "summa", "сумма", "сумма платежа", "сумма операции", "miqdor", "to'lov summasi"},
+	statementColumnDate:        {"date", "sana", "дата", "дата платежа", "дата операции", "дата создания", "время создания", "payment date", "created at", "vaqt", "to'lov sanasi"},
+	statementColumnTransaction: {"transaction id", "transaction", "id транзакции", "номер транзакции", "tranzaksiya", "click id", "click_trans_id", "click trans id", "payme id", "paycom id", "receipt id", "id"},
+	statementColumnPhone:       {"phone", "telefon", "телефон", "номер телефона", "payer phone", "account", "аккаунт", "лицевой счет", "hisob raqam"},
+	statementColumnPayer:       {"payer", "плательщик", "to'lovchi", "fio", "фио", "client", "клиент", "name", "ism"},
+	statementColumnDescription: {"description", "comment", "izoh", "назначение платежа", "назначение", "purpose", "комментарий"},
+}
+
+var statementDateLayouts = []string{
+	"2006-01-02", "2006-01-02 15:04:05", "2006-01-02 15:04", "2006-01-02T15:04:05",
+	"02.01.2006", "02.01.2006 15:04:05", "02.01.2006 15:04",
+	"02/01/2006", "02/01/2006 15:04:05", "02/01/2006 15:04",
+}
+
+// parseStatement reads CSV or XLSX content and returns payment rows found under the first recognised header row
+func parseStatement(source, fileName string, content []byte) ([]statementLine, error) {
+	var (
+		rows [][]string
+		err  error
+	)
+	if source == "XLSX" || strings.HasSuffix(strings.ToLower(fileName), ".xlsx") {
+		rows, err = readStatementXlsx(content)
+	} else {
+		rows, err = readStatementCsv(content)
+	}
+	if err != nil {
+		return nil, err
+	}
+	headerRow, columns := -1, []int(nil)
+	for i := 0; i < len(rows) && i < 20; i++ {
+		detected := detectStatementColumns(rows[i])
+		if detected[statementColumnAmount] >= 0 && detected[statementColumnDate] >= 0 {
+			headerRow, columns = i, detected
+			break
+		}
+	}
+	if headerRow < 0 {
+		return nil, fmt.Errorf("statement header with date and amount columns not found")
+	}
+	if (source == "PAYME" || source == "CLICK") && columns[statementColumnTransaction] < 0 {
+		return nil, fmt.Errorf("%s statement must contain a transaction id column", source)
+	}
+	cell := func(row []string, column int) string {
+		if column < 0 || column >= len(row) {
+			return ""
+		}
+		return strings.TrimSpace(row[column])
+	}
+	var lines []statementLine
+	for i := headerRow + 1; i < len(rows); i++ {
+		row := rows[i]
+		rawAmount, rawDate := cell(row, columns[statementColumnAmount]), cell(row, columns[statementColumnDate])
+		if rawAmount == "" || rawDate == "" {
+			// blank, totals and footer rows
+			continue
+		}
+		amount, amountErr := parseStatementAmount(rawAmount)
+		paidAt, dateErr := parseStatementDate(rawDate)
+		if amountErr != nil && dateErr != nil {
+			continue
+		}
+		line := statementLine{
+			lineNo:        i + 1,
+			transactionId: cell(row, columns[statementColumnTransaction]),
+			amount:        amount,
+			paidAt:        paidAt,
+			phone:         cell(row, columns[statementColumnPhone]),
+			payerName:     cell(row, columns[statementColumnPayer]),
+			description:   cell(row, columns[statementColumnDescription]),
+		}
+		if amountErr != nil {
+			line.parseError = "summani o'qib bo'lmadi: " + rawAmount
+		} else if dateErr != nil {
+			line.parseError = "sanani o'qib bo'lmadi: " + rawDate
+		}
+		lines = append(lines, line)
+	}
+	return lines, nil
+}
+
+func readStatementXlsx(content []byte) ([][]string, error) {
+	file, err := excelize.OpenReader(bytes.NewReader(content))
+	if err != nil {
+		return nil, fmt.Errorf("failed to open xlsx statement: %v", err)
+	}
+	defer file.Close()
+	rows, err := file.GetRows(file.GetSheetName(0))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read xlsx statement: %v", err)
+	}
+	return rows, nil
+}
+
+func readStatementCsv(content []byte) ([][]string, error) {
+	content = bytes.TrimPrefix(content, []byte("\xef\xbb\xbf"))
+	if !utf8.Valid(content) {
+		decoded, err := charmap.Windows1251.NewDecoder().Bytes(content)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode csv statement: %v", err)
+		}
+		content = decoded
+	}
+	// statements often start with a title block, so the delimiter is guessed from the first lines together
+	headLines := bytes.SplitN(content, []byte("\n"), 11)
+	if len(headLines) > 10 {
+		headLines = headLines[:10]
+	}
+	head := bytes.Join(headLines, nil)
+	delimiter := ','
+	for _, candidate := range []rune{';', '\t'} {
+		if bytes.Count(head, []byte(string(candidate))) > bytes.Count(head, []byte(string(delimiter))) {
+			delimiter = candidate
+		}
+	}
+	reader := csv.NewReader(bytes.NewReader(content))
+	reader.Comma = delimiter
+	reader.LazyQuotes = true
+	reader.FieldsPerRecord = -1
+	rows, err := reader.ReadAll()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read csv statement: %v", err)
+	}
+	return rows, nil
+}
+
+// detectStatementColumns returns the column index of every statement field, -1 when the header has no such column
+func detectStatementColumns(header []string) []int {
+	normalized := make([]string, len(header))
+	for i, name := range header {
+		normalized[i] = strings.ToLower(strings.TrimSpace(name))
+	}
+	columns := make([]int, len(statementColumnAliases))
+	used := make(map[int]bool)
+	for field, aliases := range statementColumnAliases {
+		columns[field] = -1
+		for _, exact := range []bool{true, false} {
+			for i, name := range normalized {
+				if used[i] || name == "" {
+					continue
+				}
+				for _, alias := range aliases {
+					if name == alias || (!exact && len(alias) > 3 && strings.Contains(name, alias)) {
+						columns[field] = i
+						break
+					}
+				}
+				if columns[field] >= 0 {
+					break
+				}
+			}
+			if columns[field] >= 0 {
+				used[columns[field]] = true
+				break
+			}
+		}
+	}
+	return columns
+}
+
+func parseStatementAmount(raw string) (float64, error) {
+	value := strings.ToLower(raw)
+	for _, suffix := range []string{"uzs", "сум", "so'm", "som"} {
+		value = strings.TrimSuffix(strings.TrimSpace(value), suffix)
+	}
+	value = strings.NewReplacer(" ", "", " ", "", "'", "").Replace(value)
+	if strings.Contains(value, ".") && strings.Contains(value, ",") {
+		value = strings.ReplaceAll(value, ",", "")
+	} else {
+		value = strings.ReplaceAll(value, ",", ".")
+	}
+	return strconv.ParseFloat(value, 64)
+}
+
+func parseStatementDate(raw string) (time.Time, error) {
+	for _, layout := range statementDateLayouts {
+		if parsed, err := time.Parse(layout, raw); err == nil {
+			return parsed, nil
+		}
+	}
+	if serial, err := strconv.ParseFloat(raw, 64); err == nil && serial > 0 {
+		return excelize.ExcelDateToTime(serial, false)
+	}
+	return time.Time{}, fmt.Errorf("unknown date format %s", raw)
+}
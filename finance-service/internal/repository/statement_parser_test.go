@@ -0,0 +1,202 @@
+package repository
+
+import (
+	"github.com/xuri/excelize/v2"
+	"golang.org/x/text/encoding/charmap"
+	"testing"
+	"time"
+)
+
+func TestParseStatementAmount(t *testing.T) {
+	tests := []struct {
+		name    string
+		raw     string
+		want    float64
+		wantErr bool
+	}{
+		{name: "plain", raw: "150000", want: 150000},
+		{name: "decimal point", raw: "150000.50", want: 150000.5},
+		{name: "decimal comma", raw: "150000,50", want: 150000.5},
+		{name: "spaced thousands", raw: "1 500 000,50", want: 1500000.5},
+		{name: "non breaking spaces", raw: "1 500 000", want: 1500000},
+		{name: "comma thousands with point decimals", raw: "1,234,567.89", want: 1234567.89},
+		{name: "apostrophe thousands", raw: "12'500", want: 12500},
+		{name: "uzs suffix", raw: "250000 UZS", want: 250000},
+		{name: "cyrillic suffix", raw: "250 000 сум", want: 250000},
+		{name: "so'm suffix", raw: "250 000 so'm", want: 250000},
+		{name: "text", raw: "jami", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseStatementAmount(tt.raw)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("amount = %v, want an error", got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("amount = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseStatementDate(t *testing.T) {
+	tests := []struct {
+		name    string
+		raw     string
+		want    time.Time
+		wantErr bool
+	}{
+		{name: "iso date", raw: "2026-03-10", want: time.Date(2026, 3, 10, 0, 0, 0, 0, time.UTC)},
+		{name: "iso date time", raw: "2026-03-10 14:25:30", want: time.Date(2026, 3, 10, 14, 25, 30, 0, time.UTC)},
+		{name: "iso with T", raw: "2026-03-10T14:25:30", want: time.Date(2026, 3, 10, 14, 25, 30, 0, time.UTC)},
+		{name: "dotted date", raw: "10.03.2026", want: time.Date(2026, 3, 10, 0, 0, 0, 0, time.UTC)},
+		{name: "dotted date time", raw: "10.03.2026 14:25", want: time.Date(2026, 3, 10, 14, 25, 0, 0, time.UTC)},
+		{name: "slashed date", raw: "10/03/2026", want: time.Date(2026, 3, 10, 0, 0, 0, 0, time.UTC)},
+		{name: "excel serial", raw: "46091", want: time.Date(2026, 3, 10, 0, 0, 0, 0, time.UTC)},
+		{name: "text", raw: "kecha", wantErr: true},
+		{name: "american order", raw: "03/25/2026", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseStatementDate(tt.raw)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("date = %v, want an error", got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !got.Equal(tt.want) {
+				t.Errorf("date = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func statementXlsx(t *testing.T, rows [][]string) []byte {
+	file := excelize.NewFile()
+	defer file.Close()
+	sheet := file.GetSheetName(0)
+	for i, row := range rows {
+		for j, value := range row {
+			cell, err := excelize.CoordinatesToCellName(j+1, i+1)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if err := file.SetCellStr(sheet, cell, value); err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+	buf, err := file.WriteToBuffer()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+func TestParseStatement(t *testing.T) {
+	windows1251, err := charmap.Windows1251.NewEncoder().Bytes([]byte("Дата;Сумма;Плательщик;Назначение платежа\n10.03.2026;150 000,00;Алиев Вали;оплата за курс\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		name     string
+		source   string
+		fileName string
+		content  []byte
+		want     []statementLine
+		wantErr  bool
+	}{
+		{
+			name:     "comma csv",
+			source:   "BANK",
+			fileName: "statement.csv",
+			content:  []byte("date,amount,phone,payer\n2026-03-10,150000,998901234567,Ali Valiyev\n"),
+			want: []statementLine{{lineNo: 2, amount: 150000, paidAt: time.Date(2026, 3, 10, 0, 0, 0, 0, time.UTC),
+				phone: "998901234567", payerName: "Ali Valiyev"}},
+		},
+		{
+			name:     "semicolon csv with title block, bom and totals",
+			source:   "BANK",
+			fileName: "statement.csv",
+			content: []byte("\xef\xbb\xbfKapitalbank; hisob raqamdan ko'chirma\nSana;Summa;Izoh\n" +
+				"10.03.2026;150 000,00;kurs uchun\n;300 000,00;\n11.03.2026;150 000,00;\n"),
+			want: []statementLine{
+				{lineNo: 3, amount: 150000, paidAt: time.Date(2026, 3, 10, 0, 0, 0, 0, time.UTC), description: "kurs uchun"},
+				{lineNo: 5, amount: 150000, paidAt: time.Date(2026, 3, 11, 0, 0, 0, 0, time.UTC)},
+			},
+		},
+		{
+			name:     "windows-1251 csv",
+			source:   "BANK",
+			fileName: "выписка.csv",
+			content:  windows1251,
+			want: []statementLine{{lineNo: 2, amount: 150000, paidAt: time.Date(2026, 3, 10, 0, 0, 0, 0, time.UTC),
+				payerName: "Алиев Вали", description: "оплата за курс"}},
+		},
+		{
+			name:     "unreadable amount is kept with an error",
+			source:   "BANK",
+			fileName: "statement.csv",
+			content:  []byte("date,amount\n2026-03-10,yuz ming\n"),
+			want: []statementLine{{lineNo: 2, paidAt: time.Date(2026, 3, 10, 0, 0, 0, 0, time.UTC),
+				parseError: "summani o'qib bo'lmadi: yuz ming"}},
+		},
+		{
+			name:     "payme xlsx",
+			source:   "PAYME",
+			fileName: "payme.xlsx",
+			content: statementXlsx(t, [][]string{
+				{"Payme ID", "Дата создания", "Сумма", "Аккаунт"},
+				{"65f0a1", "2026-03-10 14:25:30", "150000", "998901234567"},
+			}),
+			want: []statementLine{{lineNo: 2, transactionId: "65f0a1", amount: 150000,
+				paidAt: time.Date(2026, 3, 10, 14, 25, 30, 0, time.UTC), phone: "998901234567"}},
+		},
+		{
+			name:     "payme without transaction id",
+			source:   "PAYME",
+			fileName: "payme.csv",
+			content:  []byte("date,amount\n2026-03-10,150000\n"),
+			wantErr:  true,
+		},
+		{
+			name:     "no header",
+			source:   "BANK",
+			fileName: "statement.csv",
+			content:  []byte("2026-03-10,150000\n"),
+			wantErr:  true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseStatement(tt.source, tt.fileName, tt.content)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("lines = %+v, want an error", got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if len(got) != len(tt.want) {
+				t.Fatalf("lines = %+v, want %+v", got, tt.want)
+			}
+			for i := range got {
+				if got[i] != tt.want[i] {
+					t.Errorf("line %d = %+v, want %+v", i, got[i], tt.want[i])
+				}
+			}
+		})
+	}
+}
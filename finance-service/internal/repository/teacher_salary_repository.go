@@ -7,9 +7,11 @@ import (
 	"finance-service/internal/clients"
 	"finance-service/internal/utils"
 	"finance-service/proto/pb"
+	"github.com/google/uuid"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"net/http"
+	"time"
 )
 
 type TeacherSalaryRepository struct {
@@ -17,14 +19,39 @@ type TeacherSalaryRepository struct {
 	userClient *clients.UserClient
 }
 
-func (r *TeacherSalaryRepository) CreateTeacherSalary(ctx context.Context, companyId string, amount int32, teacherId string, amountType string) (*pb.AbsResponse, error) {
+func (r *TeacherSalaryRepository) CreateTeacherSalary(ctx context.Context, companyId string, amount int32, teacherId string, amountType string, courseId, groupId, effectiveFrom string) (*pb.AbsResponse, error) {
 	if amountType == "PERCENT" && (amount > 100 || amount < 0) {
 		return nil, status.Errorf(codes.Aborted, "invalid amount for PERCENT: must be between 0 and 100")
 	}
-	if amountType != "PERCENT" && amount < 10000 {
+	if amountType == "FIXED" && amount < 10000 {
 		return nil, status.Errorf(codes.Aborted, "invalid amount: must be non-negative")
 	}
-	_, err := r.db.Exec("INSERT INTO teacher_salary (teacher_id, salary_type, salary_type_count , company_id) VALUES ($1, $2, $3 , $4)", teacherId, amountType, amount, companyId)
+	if amountType == "PER_LESSON" && amount <= 0 {
+		return nil, status.Errorf(codes.Aborted, "invalid amount for PER_LESSON: must be positive")
+	}
+	if amountType != "PERCENT" && amountType != "FIXED" && amountType != "PER_LESSON" {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid salary type")
+	}
+	if courseId != "" && groupId != "" {
+		return nil, status.Errorf(codes.InvalidArgument, "salary rule can be scoped either by course or by group")
+	}
+	if effectiveFrom == "" {
+		effectiveFrom = "2000-01-01"
+	}
+	if _, err := time.Parse("2006-01-02", effectiveFrom); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid effectiveFrom date format: %v", err)
+	}
+	var exists bool
+	err := r.db.QueryRow(`SELECT EXISTS(SELECT 1 FROM teacher_salary WHERE teacher_id=$1 AND company_id=$2 AND effective_from=$3
+		AND coalesce(course_id::text, '')=$4 AND coalesce(group_id::text, '')=$5)`, teacherId, companyId, effectiveFrom, courseId, groupId).Scan(&exists)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to check salary rule: %v", err)
+	}
+	if exists {
+		return nil, status.Errorf(codes.AlreadyExists, "salary rule already exists for this scope and date")
+	}
+	_, err = r.db.Exec("INSERT INTO teacher_salary (id, teacher_id, salary_type, salary_type_count , company_id, course_id, group_id, effective_from) VALUES ($1, $2, $3, $4, $5, $6, $7, $8)",
+		uuid.New(), teacherId, amountType, amount, companyId, nullIfEmpty(courseId), nullIfEmpty(groupId), effectiveFrom)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to insert data: %v", err)
 	}
@@ -34,8 +61,16 @@ func (r *TeacherSalaryRepository) CreateTeacherSalary(ctx context.Context, compa
 	}, nil
 }
 
-func (r *TeacherSalaryRepository) DeleteTeacherSalary(ctx context.Context, companyId string, teacherId string) (*pb.AbsResponse, error) {
-	result, err := r.db.Exec("DELETE FROM teacher_salary WHERE teacher_id = $1 and company_id=$2", teacherId, companyId)
+func (r *TeacherSalaryRepository) DeleteTeacherSalary(ctx context.Context, companyId string, teacherId string, id string) (*pb.AbsResponse, error) {
+	var (
+		result sql.Result
+		err    error
+	)
+	if id != "" {
+		result, err = r.db.Exec("DELETE FROM teacher_salary WHERE id = $1 and company_id=$2", id, companyId)
+	} else {
+		result, err = r.db.Exec("DELETE FROM teacher_salary WHERE teacher_id = $1 and company_id=$2", teacherId, companyId)
+	}
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to delete salary: %v", err)
 	}
@@ -52,7 +87,8 @@ func (r *TeacherSalaryRepository) DeleteTeacherSalary(ctx context.Context, compa
 }
 
 func (r *TeacherSalaryRepository) GetTeacherSalary(ctx context.Context, companyId string) (*pb.GetTeachersSalaryRequest, error) {
-	rows, err := r.db.Query("SELECT teacher_id, salary_type, salary_type_count  FROM teacher_salary where company_id=$1", companyId)
+	rows, err := r.db.Query(`SELECT id, teacher_id, salary_type, salary_type_count, coalesce(course_id::text, ''), coalesce(group_id::text, ''), effective_from
+		FROM teacher_salary where company_id=$1 ORDER BY teacher_id, effective_from DESC`, companyId)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to retrieve salaries: %v", err)
 	}
@@ -60,24 +96,23 @@ func (r *TeacherSalaryRepository) GetTeacherSalary(ctx context.Context, companyI
 	var salaries []*pb.AbsGetTeachersSalary
 	ctx, cancelFunc := utils.NewTimoutContext(ctx, companyId)
 	defer cancelFunc()
+	teacherNames := make(map[string]string)
 	for rows.Next() {
-		var teacherId, salaryType, teacherName string
-		var amount int32
-
-		if err := rows.Scan(&teacherId, &salaryType, &amount); err != nil {
+		salary, err := scanTeacherSalary(rows)
+		if err != nil {
 			return nil, status.Errorf(codes.Internal, "failed to scan row: %v", err)
 		}
-		user, err := r.userClient.GetUserById(ctx, teacherId)
-		if err != nil {
+		teacherName, ok := teacherNames[salary.TeacherId]
+		if !ok {
 			teacherName = "Teacher Name not available"
+			user, err := r.userClient.GetUserById(ctx, salary.TeacherId)
+			if err == nil {
+				teacherName = user.Name
+			}
+			teacherNames[salary.TeacherId] = teacherName
 		}
-		teacherName = user.Name
-		salaries = append(salaries, &pb.AbsGetTeachersSalary{
-			TeacherId:   teacherId,
-			Type:        salaryType,
-			Amount:      amount,
-			TeacherName: teacherName,
-		})
+		salary.TeacherName = teacherName
+		salaries = append(salaries, salary)
 	}
 
 	if err := rows.Err(); err != nil {
@@ -87,21 +122,50 @@ func (r *TeacherSalaryRepository) GetTeacherSalary(ctx context.Context, companyI
 	return &pb.GetTeachersSalaryRequest{Salaries: salaries}, nil
 }
 
+// GetTeacherSalaryByTeacherID returns the teacher's general rule valid today
 func (r *TeacherSalaryRepository) GetTeacherSalaryByTeacherID(ctx context.Context, companyId string, teacherId string) (*pb.AbsGetTeachersSalary, error) {
-	var salary pb.AbsGetTeachersSalary
-	err := r.db.QueryRow("SELECT teacher_id, salary_type, salary_type_count FROM teacher_salary WHERE teacher_id = $1 and company_id=$2", teacherId, companyId).
-		Scan(&salary.TeacherId, &salary.Type, &salary.Amount)
+	return r.ResolveTeacherSalary(ctx, companyId, teacherId, "", "", "")
+}
 
+// ResolveTeacherSalary picks the most specific rule valid on the date: group rule, then course rule, then general rule
+func (r *TeacherSalaryRepository) ResolveTeacherSalary(ctx context.Context, companyId, teacherId, groupId, courseId, date string) (*pb.AbsGetTeachersSalary, error) {
+	if date == "" {
+		date = time.Now().Format("2006-01-02")
+	}
+	row := r.db.QueryRow(`SELECT id, teacher_id, salary_type, salary_type_count, coalesce(course_id::text, ''), coalesce(group_id::text, ''), effective_from
+		FROM teacher_salary
+		WHERE teacher_id = $1 AND company_id = $2 AND effective_from <= $3
+		  AND (group_id IS NULL OR group_id::text = $4)
+		  AND (course_id IS NULL OR course_id::text = $5)
+		ORDER BY CASE WHEN group_id IS NOT NULL THEN 0 WHEN course_id IS NOT NULL THEN 1 ELSE 2 END, effective_from DESC, created_at DESC
+		LIMIT 1`, teacherId, companyId, date, groupId, courseId)
+	salary, err := scanTeacherSalary(row)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return nil, status.Errorf(codes.NotFound, "salary not found for teacherId: %s", teacherId)
 		}
 		return nil, status.Errorf(codes.Internal, "failed to retrieve salary: %v", err)
 	}
+	return salary, nil
+}
 
+func scanTeacherSalary(row interface{ Scan(...any) error }) (*pb.AbsGetTeachersSalary, error) {
+	var salary pb.AbsGetTeachersSalary
+	var effectiveFrom time.Time
+	if err := row.Scan(&salary.Id, &salary.TeacherId, &salary.Type, &salary.Amount, &salary.CourseId, &salary.GroupId, &effectiveFrom); err != nil {
+		return nil, err
+	}
+	salary.EffectiveFrom = effectiveFrom.Format("2006-01-02")
 	return &salary, nil
 }
 
+func nullIfEmpty(value string) interface{} {
+	if value == "" {
+		return nil
+	}
+	return value
+}
+
 func NewTeacherSalaryRepository(db *sql.DB, userClient *clients.UserClient) *TeacherSalaryRepository {
 	return &TeacherSalaryRepository{db: db, userClient: userClient}
 }
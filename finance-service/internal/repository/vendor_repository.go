@@ -0,0 +1,165 @@
+package repository
+
+import (
+	"database/sql"
+	"errors"
+	"finance-service/proto/pb"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"net/http"
+	"strings"
+	"time"
+)
+
+type VendorRepository struct {
+	db *sql.DB
+}
+
+func (r *VendorRepository) CreateVendor(companyId string, req *pb.CreateVendorRequest) (*pb.AbsResponse, error) {
+	name := strings.TrimSpace(req.Name)
+	if name == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "vendor name is required")
+	}
+	var exists bool
+	err := r.db.QueryRow(`SELECT EXISTS(SELECT 1 FROM vendor WHERE company_id = $1 AND lower(name) = lower($2))`, companyId, name).Scan(&exists)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to check vendor: %v", err)
+	}
+	if exists {
+		return nil, status.Errorf(codes.AlreadyExists, "vendor %s already exists", name)
+	}
+	id := uuid.New()
+	_, err = r.db.Exec(`INSERT INTO vendor (id, name, phone, tin, comment, company_id) VALUES ($1, $2, $3, $4, $5, $6)`,
+		id, name, req.Phone, req.Tin, req.Comment, companyId)
+	if err != nil {
+		return nil, status.Errorf(codes.Aborted, "error while inserting vendor %v", err)
+	}
+	return &pb.AbsResponse{
+		Status:  http.StatusCreated,
+		Message: id.String(),
+	}, nil
+}
+
+func (r *VendorRepository) UpdateVendor(companyId string, req *pb.AbsVendor) (*pb.AbsResponse, error) {
+	name := strings.TrimSpace(req.Name)
+	if name == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "vendor name is required")
+	}
+	result, err := r.db.Exec(`UPDATE vendor SET name = $1, phone = $2, tin = $3, comment = $4, is_active = $5 WHERE id = $6 AND company_id = $7`,
+		name, req.Phone, req.Tin, req.Comment, req.IsActive, req.Id, companyId)
+	if err != nil {
+		return nil, status.Errorf(codes.Aborted, "error while updating vendor %v", err)
+	}
+	if affected, _ := result.RowsAffected(); affected == 0 {
+		return nil, status.Errorf(codes.NotFound, "vendor not found")
+	}
+	return &pb.AbsResponse{
+		Status:  http.StatusOK,
+		Message: "vendor updated",
+	}, nil
+}
+
+// GetVendors lists vendors with their total approved and paid spend
+func (r *VendorRepository) GetVendors(companyId string, includeInactive bool) (*pb.GetVendorsResponse, error) {
+	rows, err := r.db.Query(vendorSelect+`
+		WHERE v.company_id = $1 AND (v.is_active OR $2)
+		GROUP BY v.id
+		ORDER BY v.name`, companyId, includeInactive)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to retrieve vendors: %v", err)
+	}
+	defer rows.Close()
+	var response pb.GetVendorsResponse
+	for rows.Next() {
+		vendor, err := scanVendor(rows)
+		if err != nil {
+			return nil, err
+		}
+		response.Vendors = append(response.Vendors, vendor)
+	}
+	return &response, rows.Err()
+}
+
+// GetVendorSpendHistory returns monthly spend with the vendor, the whole history when from and to are empty
+func (r *VendorRepository) GetVendorSpendHistory(companyId string, req *pb.GetVendorSpendHistoryRequest) (*pb.GetVendorSpendHistoryResponse, error) {
+	vendor, err := scanVendor(r.db.QueryRow(vendorSelect+`
+		WHERE v.id = $1 AND v.company_id = $2
+		GROUP BY v.id`, req.VendorId, companyId))
+	if err != nil {
+		return nil, err
+	}
+	from, to := req.From, req.To
+	if from == "" {
+		from = "1970-01-01"
+	}
+	if to == "" {
+		to = "9999-12-31"
+	}
+	rows, err := r.db.Query(`SELECT TO_CHAR(given_date, 'YYYY-MM'), SUM(sum), COUNT(*)
+		FROM expense
+		WHERE vendor_id = $1 AND company_id = $2 AND status IN ('APPROVED', 'PAID') AND given_date BETWEEN $3 AND $4
+		GROUP BY 1
+		ORDER BY 1`, req.VendorId, companyId, from, to)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to retrieve vendor spend: %v", err)
+	}
+	defer rows.Close()
+	response := &pb.GetVendorSpendHistoryResponse{Vendor: vendor}
+	for rows.Next() {
+		var month pb.VendorMonthSpend
+		if err := rows.Scan(&month.Month, &month.Amount, &month.ExpenseCount); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to scan row: %v", err)
+		}
+		month.Amount = roundAmount(month.Amount)
+		response.Months = append(response.Months, &month)
+	}
+	return response, rows.Err()
+}
+
+const vendorSelect = `SELECT v.id, v.name, v.phone, v.tin, v.comment, v.is_active, v.created_at,
+		COALESCE(SUM(e.sum), 0), COUNT(e.id), MAX(e.given_date)
+	FROM vendor v
+	LEFT JOIN expense e ON e.vendor_id = v.id AND e.status IN ('APPROVED', 'PAID')`
+
+// checkVendor makes sure an expense only points at a vendor of the same company, an empty id means no vendor
+func checkVendor(db *sql.DB, companyId, vendorId string) error {
+	if vendorId == "" {
+		return nil
+	}
+	var exists bool
+	err := db.QueryRow(`SELECT EXISTS(SELECT 1 FROM vendor WHERE id::text = $1 AND company_id = $2)`, vendorId, companyId).Scan(&exists)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to check vendor: %v", err)
+	}
+	if !exists {
+		return status.Errorf(codes.NotFound, "vendor not found")
+	}
+	return nil
+}
+
+func scanVendor(row interface{ Scan(...any) error }) (*pb.AbsVendor, error) {
+	var (
+		vendor          pb.AbsVendor
+		createdAt       time.Time
+		lastExpenseDate sql.NullTime
+	)
+	err := row.Scan(&vendor.Id, &vendor.Name, &vendor.Phone, &vendor.Tin, &vendor.Comment, &vendor.IsActive, &createdAt,
+		&vendor.TotalSpent, &vendor.ExpenseCount, &lastExpenseDate)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, status.Errorf(codes.NotFound, "vendor not found")
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to scan vendor: %v", err)
+	}
+	vendor.TotalSpent = roundAmount(vendor.TotalSpent)
+	vendor.CreatedAt = createdAt.Format("2006-01-02 15:04:05")
+	if lastExpenseDate.Valid {
+		vendor.LastExpenseDate = lastExpenseDate.Time.Format("2006-01-02")
+	}
+	return &vendor, nil
+}
+
+func NewVendorRepository(db *sql.DB) *VendorRepository {
+	return &VendorRepository{db: db}
+}
@@ -33,7 +33,7 @@ func RunServer() {
 	}
 	categoryRepo := repository.NewCategoryRepository(db)
 	categoryService := service.NewCategoryService(categoryRepo)
-	expenseRepo := repository.NewExpenseRepository(db, userClient)
+	expenseRepo := repository.NewExpenseRepository(db, userClient, educationClient)
 	expenseService := service.NewExpenseService(expenseRepo)
 	paymentRepo := repository.NewPaymentRepository(db, educationClient)
 	paymentService := service.NewPaymentService(paymentRepo)
@@ -41,6 +41,24 @@ func RunServer() {
 	discountService := service.NewDiscountService(discountRepo)
 	salaryRepo := repository.NewTeacherSalaryRepository(db, userClient)
 	salaryService := service.NewTeacherSalaryService(salaryRepo)
+	periodRepo := repository.NewAccountingPeriodRepository(db)
+	periodService := service.NewAccountingPeriodService(periodRepo)
+	payrollRepo := repository.NewPayrollRepository(db, educationClient, userClient)
+	payrollService := service.NewPayrollService(payrollRepo)
+	installmentRepo := repository.NewInstallmentRepository(db, educationClient, paymentRepo)
+	installmentService := service.NewInstallmentService(installmentRepo)
+	collectionRepo := repository.NewCollectionRepository(db, educationClient, userClient)
+	collectionService := service.NewCollectionService(collectionRepo)
+	reportRepo := repository.NewReportRepository(db, educationClient)
+	reportService := service.NewReportService(reportRepo)
+	vendorRepo := repository.NewVendorRepository(db)
+	vendorService := service.NewVendorService(vendorRepo)
+	oneCExportRepo := repository.NewOneCExportRepository(db, educationClient, userClient)
+	oneCExportService := service.NewOneCExportService(oneCExportRepo)
+	reconciliationRepo := repository.NewReconciliationRepository(db, educationClient, paymentRepo)
+	reconciliationService := service.NewReconciliationService(reconciliationRepo)
+	chargeRepo := repository.NewChargeRepository(db, educationClient)
+	chargeService := service.NewChargeService(chargeRepo)
 	list, err := net.Listen("tcp", ":"+strconv.Itoa(cfg.Server.Port))
 	if err != nil {
 		log.Fatalf(err.Error())
@@ -55,6 +73,15 @@ func RunServer() {
 	pb.RegisterExpenseServiceServer(grpcServer, expenseService)
 	pb.RegisterPaymentServiceServer(grpcServer, paymentService)
 	pb.RegisterTeacherSalaryServiceServer(grpcServer, salaryService)
+	pb.RegisterAccountingPeriodServiceServer(grpcServer, periodService)
+	pb.RegisterPayrollServiceServer(grpcServer, payrollService)
+	pb.RegisterInstallmentServiceServer(grpcServer, installmentService)
+	pb.RegisterCollectionServiceServer(grpcServer, collectionService)
+	pb.RegisterReportServiceServer(grpcServer, reportService)
+	pb.RegisterVendorServiceServer(grpcServer, vendorService)
+	pb.RegisterOneCExportServiceServer(grpcServer, oneCExportService)
+	pb.RegisterReconciliationServiceServer(grpcServer, reconciliationService)
+	pb.RegisterChargeServiceServer(grpcServer, chargeService)
 	log.Printf("Server listening on port %v", cfg.Server.Port)
 	if err := grpcServer.Serve(list); err != nil {
 		log.Fatalf("Failed to serve  %v", err)
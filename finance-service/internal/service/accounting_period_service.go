@@ -0,0 +1,60 @@
+package service
+
+import (
+	"context"
+	"finance-service/internal/repository"
+	"finance-service/internal/utils"
+	"finance-service/proto/pb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+type AccountingPeriodService struct {
+	pb.UnimplementedAccountingPeriodServiceServer
+	repo *repository.AccountingPeriodRepository
+}
+
+func NewAccountingPeriodService(repo *repository.AccountingPeriodRepository) *AccountingPeriodService {
+	return &AccountingPeriodService{repo: repo}
+}
+
+func (s *AccountingPeriodService) ClosePeriod(ctx context.Context, req *pb.AccountingPeriodRequest) (*pb.AbsResponse, error) {
+	companyId := utils.GetCompanyId(ctx)
+	if companyId == "" {
+		return nil, status.Error(codes.Aborted, "error while getting company from context")
+	}
+	return s.repo.ClosePeriod(companyId, req.Period, req.Comment, req.ActionById, req.ActionByName)
+}
+
+func (s *AccountingPeriodService) ReopenPeriod(ctx context.Context, req *pb.AccountingPeriodRequest) (*pb.AbsResponse, error) {
+	companyId := utils.GetCompanyId(ctx)
+	if companyId == "" {
+		return nil, status.Error(codes.Aborted, "error while getting company from context")
+	}
+	return s.repo.ReopenPeriod(companyId, req.Period, req.Comment, req.ActionById, req.ActionByName)
+}
+
+func (s *AccountingPeriodService) GetAllPeriods(ctx context.Context, req *emptypb.Empty) (*pb.GetAllPeriodsResponse, error) {
+	companyId := utils.GetCompanyId(ctx)
+	if companyId == "" {
+		return nil, status.Error(codes.Aborted, "error while getting company from context")
+	}
+	return s.repo.GetAllPeriods(companyId)
+}
+
+func (s *AccountingPeriodService) GetPeriodHistory(ctx context.Context, req *pb.GetPeriodHistoryRequest) (*pb.GetPeriodHistoryResponse, error) {
+	companyId := utils.GetCompanyId(ctx)
+	if companyId == "" {
+		return nil, status.Error(codes.Aborted, "error while getting company from context")
+	}
+	return s.repo.GetPeriodHistory(companyId, req.Period)
+}
+
+func (s *AccountingPeriodService) CheckPeriod(ctx context.Context, req *pb.CheckPeriodRequest) (*pb.CheckPeriodResponse, error) {
+	companyId := utils.GetCompanyId(ctx)
+	if companyId == "" {
+		return nil, status.Error(codes.Aborted, "error while getting company from context")
+	}
+	return s.repo.CheckPeriod(companyId, req.Date)
+}
@@ -53,3 +53,16 @@ func (c *CategoryService) GetAllCategory(ctx context.Context, req *emptypb.Empty
 	}
 	return c.repo.GetAllCategory(companyId)
 }
+func (c *CategoryService) SetCategoryBudget(ctx context.Context, req *pb.SetCategoryBudgetRequest) (*pb.AbsResponse, error) {
+	companyId := utils.GetCompanyId(ctx)
+	if companyId == "" {
+		return nil, status.Error(codes.Aborted, "error while getting company from context")
+	}
+	if err := c.repo.SetCategoryBudget(companyId, req.CategoryId, req.MonthlyBudget); err != nil {
+		return nil, err
+	}
+	return &pb.AbsResponse{
+		Status:  http.StatusOK,
+		Message: "category budget updated",
+	}, nil
+}
@@ -0,0 +1,67 @@
+package service
+
+import (
+	"context"
+	"finance-service/internal/repository"
+	"finance-service/internal/utils"
+	"finance-service/proto/pb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type ChargeService struct {
+	pb.UnimplementedChargeServiceServer
+	repo *repository.ChargeRepository
+}
+
+func NewChargeService(repo *repository.ChargeRepository) *ChargeService {
+	return &ChargeService{repo: repo}
+}
+
+func (s *ChargeService) CreateChargeItem(ctx context.Context, req *pb.CreateChargeItemRequest) (*pb.AbsResponse, error) {
+	companyId := utils.GetCompanyId(ctx)
+	if companyId == "" {
+		return nil, status.Error(codes.Aborted, "error while getting company from context")
+	}
+	return s.repo.CreateChargeItem(companyId, req)
+}
+
+func (s *ChargeService) UpdateChargeItem(ctx context.Context, req *pb.AbsChargeItem) (*pb.AbsResponse, error) {
+	companyId := utils.GetCompanyId(ctx)
+	if companyId == "" {
+		return nil, status.Error(codes.Aborted, "error while getting company from context")
+	}
+	return s.repo.UpdateChargeItem(companyId, req)
+}
+
+func (s *ChargeService) GetChargeItems(ctx context.Context, req *pb.GetChargeItemsRequest) (*pb.GetChargeItemsResponse, error) {
+	companyId := utils.GetCompanyId(ctx)
+	if companyId == "" {
+		return nil, status.Error(codes.Aborted, "error while getting company from context")
+	}
+	return s.repo.GetChargeItems(companyId, req.IncludeInactive)
+}
+
+func (s *ChargeService) ChargeStudent(ctx context.Context, req *pb.ChargeStudentRequest) (*pb.AbsResponse, error) {
+	companyId := utils.GetCompanyId(ctx)
+	if companyId == "" {
+		return nil, status.Error(codes.Aborted, "error while getting company from context")
+	}
+	return s.repo.ChargeStudent(ctx, companyId, req)
+}
+
+func (s *ChargeService) GetStudentCharges(ctx context.Context, req *pb.GetStudentChargesRequest) (*pb.GetStudentChargesResponse, error) {
+	companyId := utils.GetCompanyId(ctx)
+	if companyId == "" {
+		return nil, status.Error(codes.Aborted, "error while getting company from context")
+	}
+	return s.repo.GetStudentCharges(companyId, req.StudentId)
+}
+
+func (s *ChargeService) GetChargeItemSalesReport(ctx context.Context, req *pb.GetChargeItemSalesReportRequest) (*pb.GetChargeItemSalesReportResponse, error) {
+	companyId := utils.GetCompanyId(ctx)
+	if companyId == "" {
+		return nil, status.Error(codes.Aborted, "error while getting company from context")
+	}
+	return s.repo.GetChargeItemSalesReport(companyId, req.From, req.To)
+}
@@ -0,0 +1,76 @@
+package service
+
+import (
+	"context"
+	"finance-service/internal/repository"
+	"finance-service/internal/utils"
+	"finance-service/proto/pb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+type CollectionService struct {
+	pb.UnimplementedCollectionServiceServer
+	repo *repository.CollectionRepository
+}
+
+func NewCollectionService(repo *repository.CollectionRepository) *CollectionService {
+	return &CollectionService{repo: repo}
+}
+
+func (s *CollectionService) GetDebtAging(ctx context.Context, req *pb.GetDebtAgingRequest) (*pb.GetDebtAgingResponse, error) {
+	companyId := utils.GetCompanyId(ctx)
+	if companyId == "" {
+		return nil, status.Error(codes.Aborted, "error while getting company from context")
+	}
+	return s.repo.GetDebtAging(ctx, companyId, req.Bucket, req.AssignedToId, req.Page, req.Size)
+}
+
+func (s *CollectionService) AssignDebtor(ctx context.Context, req *pb.AssignDebtorRequest) (*pb.AbsResponse, error) {
+	companyId := utils.GetCompanyId(ctx)
+	if companyId == "" {
+		return nil, status.Error(codes.Aborted, "error while getting company from context")
+	}
+	return s.repo.AssignDebtor(ctx, companyId, req.StudentId, req.AssignedToId)
+}
+
+func (s *CollectionService) AddCollectionActivity(ctx context.Context, req *pb.AddCollectionActivityRequest) (*pb.AbsResponse, error) {
+	companyId := utils.GetCompanyId(ctx)
+	if companyId == "" {
+		return nil, status.Error(codes.Aborted, "error while getting company from context")
+	}
+	return s.repo.AddCollectionActivity(companyId, req)
+}
+
+func (s *CollectionService) GetCollectionActivities(ctx context.Context, req *pb.GetCollectionActivitiesRequest) (*pb.GetCollectionActivitiesResponse, error) {
+	companyId := utils.GetCompanyId(ctx)
+	if companyId == "" {
+		return nil, status.Error(codes.Aborted, "error while getting company from context")
+	}
+	return s.repo.GetCollectionActivities(companyId, req.StudentId)
+}
+
+func (s *CollectionService) GetCollectionSetting(ctx context.Context, req *emptypb.Empty) (*pb.CollectionSetting, error) {
+	companyId := utils.GetCompanyId(ctx)
+	if companyId == "" {
+		return nil, status.Error(codes.Aborted, "error while getting company from context")
+	}
+	return s.repo.GetCollectionSetting(companyId)
+}
+
+func (s *CollectionService) UpdateCollectionSetting(ctx context.Context, req *pb.CollectionSetting) (*pb.AbsResponse, error) {
+	companyId := utils.GetCompanyId(ctx)
+	if companyId == "" {
+		return nil, status.Error(codes.Aborted, "error while getting company from context")
+	}
+	return s.repo.UpdateCollectionSetting(companyId, req.FreezeAfterDays, req.RemindersEnabled)
+}
+
+func (s *CollectionService) RunCollections(ctx context.Context, req *emptypb.Empty) (*pb.AbsResponse, error) {
+	companyId := utils.GetCompanyId(ctx)
+	if companyId == "" {
+		return nil, status.Error(codes.Aborted, "error while getting company from context")
+	}
+	return s.repo.RunCollections(ctx, companyId)
+}
@@ -70,3 +70,51 @@ func (ds *DiscountService) GetDiscountByStudentId(ctx context.Context, req *pb.G
 	}
 	return ds.repo.GetDiscountByStudentId(companyId, req.StudentId, req.GroupId)
 }
+
+func (ds *DiscountService) GetDiscountsByStudentIds(ctx context.Context, req *pb.GetDiscountsByStudentIdsRequest) (*pb.GetDiscountsByStudentIdsResponse, error) {
+	companyId := utils.GetCompanyId(ctx)
+	if companyId == "" {
+		return nil, status.Error(codes.Aborted, "error while getting company from context")
+	}
+	return ds.repo.GetDiscountsByStudentIds(companyId, req.GroupId, req.StudentIds)
+}
+
+func (ds *DiscountService) CreateDiscountRule(ctx context.Context, req *pb.AbsDiscountRule) (*pb.AbsResponse, error) {
+	companyId := utils.GetCompanyId(ctx)
+	if companyId == "" {
+		return nil, status.Error(codes.Aborted, "error while getting company from context")
+	}
+	return ds.repo.CreateDiscountRule(companyId, req)
+}
+
+func (ds *DiscountService) UpdateDiscountRule(ctx context.Context, req *pb.AbsDiscountRule) (*pb.AbsResponse, error) {
+	companyId := utils.GetCompanyId(ctx)
+	if companyId == "" {
+		return nil, status.Error(codes.Aborted, "error while getting company from context")
+	}
+	return ds.repo.UpdateDiscountRule(companyId, req)
+}
+
+func (ds *DiscountService) GetDiscountRules(ctx context.Context, req *pb.GetDiscountRulesRequest) (*pb.GetDiscountRulesResponse, error) {
+	companyId := utils.GetCompanyId(ctx)
+	if companyId == "" {
+		return nil, status.Error(codes.Aborted, "error while getting company from context")
+	}
+	return ds.repo.GetDiscountRules(companyId, req.IncludeInactive)
+}
+
+func (ds *DiscountService) ApplyDiscountRules(ctx context.Context, req *pb.ApplyDiscountRulesRequest) (*pb.ApplyDiscountRulesResponse, error) {
+	companyId := utils.GetCompanyId(ctx)
+	if companyId == "" {
+		return nil, status.Error(codes.Aborted, "error while getting company from context")
+	}
+	return ds.repo.ApplyDiscountRules(ctx, companyId, req)
+}
+
+func (ds *DiscountService) ApplyDiscountTemplate(ctx context.Context, req *pb.ApplyDiscountTemplateRequest) (*pb.ApplyDiscountRulesResponse, error) {
+	companyId := utils.GetCompanyId(ctx)
+	if companyId == "" {
+		return nil, status.Error(codes.Aborted, "error while getting company from context")
+	}
+	return ds.repo.ApplyDiscountTemplate(ctx, companyId, req)
+}
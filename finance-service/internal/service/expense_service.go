@@ -7,6 +7,7 @@ import (
 	"finance-service/proto/pb"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
 	"net/http"
 )
 
@@ -20,13 +21,7 @@ func (e *ExpenseService) CreateExpense(ctx context.Context, req *pb.CreateExpens
 	if companyId == "" {
 		return nil, status.Error(codes.Aborted, "error while getting company from context")
 	}
-	if err := e.repo.CreateExpense(ctx, companyId, req.Title, req.GivenDate, req.ExpenseType, req.CategoryId, req.UserId, req.Sum, req.CreatedById, req.PaymentMethod); err != nil {
-		return nil, err
-	}
-	return &pb.AbsResponse{
-		Status:  http.StatusCreated,
-		Message: "expense created",
-	}, nil
+	return e.repo.CreateExpense(ctx, companyId, req)
 }
 func (e *ExpenseService) DeleteExpense(ctx context.Context, req *pb.DeleteAbsRequest) (*pb.AbsResponse, error) {
 	companyId := utils.GetCompanyId(ctx)
@@ -46,7 +41,7 @@ func (e *ExpenseService) GetAllExpense(ctx context.Context, req *pb.GetAllExpens
 	if companyId == "" {
 		return nil, status.Error(codes.Aborted, "error while getting company from context")
 	}
-	return e.repo.GetAllExpense(ctx, companyId, req.PageReq.Page, req.PageReq.Size, req.From, req.To, req.Type, req.Id)
+	return e.repo.GetAllExpense(ctx, companyId, req.PageReq.Page, req.PageReq.Size, req.From, req.To, req.Type, req.Id, req.Status)
 }
 func (e *ExpenseService) GetAllExpenseDiagram(ctx context.Context, req *pb.GetAllExpenseDiagramRequest) (*pb.GetAllExpenseDiagramResponse, error) {
 	companyId := utils.GetCompanyId(ctx)
@@ -55,6 +50,104 @@ func (e *ExpenseService) GetAllExpenseDiagram(ctx context.Context, req *pb.GetAl
 	}
 	return e.repo.GetExpenseDiagram(ctx, companyId, req.To, req.From)
 }
+func (e *ExpenseService) CreateRecurringExpense(ctx context.Context, req *pb.CreateRecurringExpenseRequest) (*pb.AbsResponse, error) {
+	companyId := utils.GetCompanyId(ctx)
+	if companyId == "" {
+		return nil, status.Error(codes.Aborted, "error while getting company from context")
+	}
+	return e.repo.CreateRecurringExpense(companyId, req)
+}
+func (e *ExpenseService) GetRecurringExpenses(ctx context.Context, req *emptypb.Empty) (*pb.GetRecurringExpensesResponse, error) {
+	companyId := utils.GetCompanyId(ctx)
+	if companyId == "" {
+		return nil, status.Error(codes.Aborted, "error while getting company from context")
+	}
+	return e.repo.GetRecurringExpenses(companyId)
+}
+func (e *ExpenseService) DeleteRecurringExpense(ctx context.Context, req *pb.DeleteAbsRequest) (*pb.AbsResponse, error) {
+	companyId := utils.GetCompanyId(ctx)
+	if companyId == "" {
+		return nil, status.Error(codes.Aborted, "error while getting company from context")
+	}
+	return e.repo.DeleteRecurringExpense(companyId, req.Id)
+}
+func (e *ExpenseService) PostRecurringExpenses(ctx context.Context, req *emptypb.Empty) (*pb.AbsResponse, error) {
+	companyId := utils.GetCompanyId(ctx)
+	if companyId == "" {
+		return nil, status.Error(codes.Aborted, "error while getting company from context")
+	}
+	return e.repo.PostRecurringExpenses(ctx, companyId)
+}
+func (e *ExpenseService) GetBudgetAlerts(ctx context.Context, req *pb.GetBudgetAlertsRequest) (*pb.GetBudgetAlertsResponse, error) {
+	companyId := utils.GetCompanyId(ctx)
+	if companyId == "" {
+		return nil, status.Error(codes.Aborted, "error while getting company from context")
+	}
+	return e.repo.GetBudgetAlerts(companyId, req.Period)
+}
+func (e *ExpenseService) GetExpenseById(ctx context.Context, req *pb.GetExpenseByIdRequest) (*pb.ExpenseDetail, error) {
+	companyId := utils.GetCompanyId(ctx)
+	if companyId == "" {
+		return nil, status.Error(codes.Aborted, "error while getting company from context")
+	}
+	return e.repo.GetExpenseById(ctx, companyId, req.Id)
+}
+func (e *ExpenseService) SubmitExpense(ctx context.Context, req *pb.ExpenseActionRequest) (*pb.AbsResponse, error) {
+	companyId := utils.GetCompanyId(ctx)
+	if companyId == "" {
+		return nil, status.Error(codes.Aborted, "error while getting company from context")
+	}
+	return e.repo.SubmitExpense(ctx, companyId, req)
+}
+func (e *ExpenseService) ApproveExpense(ctx context.Context, req *pb.ExpenseActionRequest) (*pb.AbsResponse, error) {
+	companyId := utils.GetCompanyId(ctx)
+	if companyId == "" {
+		return nil, status.Error(codes.Aborted, "error while getting company from context")
+	}
+	return e.repo.ApproveExpense(ctx, companyId, req)
+}
+func (e *ExpenseService) RejectExpense(ctx context.Context, req *pb.ExpenseActionRequest) (*pb.AbsResponse, error) {
+	companyId := utils.GetCompanyId(ctx)
+	if companyId == "" {
+		return nil, status.Error(codes.Aborted, "error while getting company from context")
+	}
+	return e.repo.RejectExpense(ctx, companyId, req)
+}
+func (e *ExpenseService) MarkExpensePaid(ctx context.Context, req *pb.ExpenseActionRequest) (*pb.AbsResponse, error) {
+	companyId := utils.GetCompanyId(ctx)
+	if companyId == "" {
+		return nil, status.Error(codes.Aborted, "error while getting company from context")
+	}
+	return e.repo.MarkExpensePaid(ctx, companyId, req)
+}
+func (e *ExpenseService) AddExpenseReceipt(ctx context.Context, req *pb.AddExpenseReceiptRequest) (*pb.AbsResponse, error) {
+	companyId := utils.GetCompanyId(ctx)
+	if companyId == "" {
+		return nil, status.Error(codes.Aborted, "error while getting company from context")
+	}
+	return e.repo.AddExpenseReceipt(companyId, req)
+}
+func (e *ExpenseService) DeleteExpenseReceipt(ctx context.Context, req *pb.DeleteAbsRequest) (*pb.AbsResponse, error) {
+	companyId := utils.GetCompanyId(ctx)
+	if companyId == "" {
+		return nil, status.Error(codes.Aborted, "error while getting company from context")
+	}
+	return e.repo.DeleteExpenseReceipt(companyId, req.Id)
+}
+func (e *ExpenseService) GetExpenseApprovalSetting(ctx context.Context, req *emptypb.Empty) (*pb.ExpenseApprovalSetting, error) {
+	companyId := utils.GetCompanyId(ctx)
+	if companyId == "" {
+		return nil, status.Error(codes.Aborted, "error while getting company from context")
+	}
+	return e.repo.GetExpenseApprovalSetting(companyId)
+}
+func (e *ExpenseService) UpdateExpenseApprovalSetting(ctx context.Context, req *pb.ExpenseApprovalSetting) (*pb.AbsResponse, error) {
+	companyId := utils.GetCompanyId(ctx)
+	if companyId == "" {
+		return nil, status.Error(codes.Aborted, "error while getting company from context")
+	}
+	return e.repo.UpdateExpenseApprovalSetting(companyId, req)
+}
 
 func NewExpenseService(repo *repository.ExpenseRepository) *ExpenseService {
 	return &ExpenseService{repo: repo}
@@ -0,0 +1,84 @@
+package service
+
+import (
+	"context"
+	"finance-service/internal/repository"
+	"finance-service/internal/utils"
+	"finance-service/proto/pb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+type InstallmentService struct {
+	pb.UnimplementedInstallmentServiceServer
+	repo *repository.InstallmentRepository
+}
+
+func NewInstallmentService(repo *repository.InstallmentRepository) *InstallmentService {
+	return &InstallmentService{repo: repo}
+}
+
+func (s *InstallmentService) CreateInstallmentPlan(ctx context.Context, req *pb.CreateInstallmentPlanRequest) (*pb.AbsResponse, error) {
+	companyId := utils.GetCompanyId(ctx)
+	if companyId == "" {
+		return nil, status.Error(codes.Aborted, "error while getting company from context")
+	}
+	return s.repo.CreateInstallmentPlan(ctx, companyId, req)
+}
+
+func (s *InstallmentService) GetInstallmentPlans(ctx context.Context, req *pb.GetInstallmentPlansRequest) (*pb.GetInstallmentPlansResponse, error) {
+	companyId := utils.GetCompanyId(ctx)
+	if companyId == "" {
+		return nil, status.Error(codes.Aborted, "error while getting company from context")
+	}
+	return s.repo.GetInstallmentPlans(companyId, req.StudentId)
+}
+
+func (s *InstallmentService) GetInstallmentPlanById(ctx context.Context, req *pb.InstallmentPlanIdRequest) (*pb.AbsInstallmentPlan, error) {
+	companyId := utils.GetCompanyId(ctx)
+	if companyId == "" {
+		return nil, status.Error(codes.Aborted, "error while getting company from context")
+	}
+	return s.repo.GetInstallmentPlanById(companyId, req.PlanId)
+}
+
+func (s *InstallmentService) CancelInstallmentPlan(ctx context.Context, req *pb.CancelInstallmentPlanRequest) (*pb.AbsResponse, error) {
+	companyId := utils.GetCompanyId(ctx)
+	if companyId == "" {
+		return nil, status.Error(codes.Aborted, "error while getting company from context")
+	}
+	return s.repo.CancelInstallmentPlan(companyId, req.PlanId, req.ActionById, req.ActionByName)
+}
+
+func (s *InstallmentService) ChargeInstallments(ctx context.Context, req *pb.ChargeInstallmentsRequest) (*pb.ChargeInstallmentsResponse, error) {
+	companyId := utils.GetCompanyId(ctx)
+	if companyId == "" {
+		return nil, status.Error(codes.Aborted, "error while getting company from context")
+	}
+	return s.repo.ChargeInstallments(ctx, companyId, req.StudentId, req.GroupId, req.Date)
+}
+
+func (s *InstallmentService) ApplyInstallmentLateFees(ctx context.Context, req *emptypb.Empty) (*pb.AbsResponse, error) {
+	companyId := utils.GetCompanyId(ctx)
+	if companyId == "" {
+		return nil, status.Error(codes.Aborted, "error while getting company from context")
+	}
+	return s.repo.ApplyInstallmentLateFees(ctx, companyId)
+}
+
+func (s *InstallmentService) ChargeDueInstallments(ctx context.Context, req *emptypb.Empty) (*pb.AbsResponse, error) {
+	companyId := utils.GetCompanyId(ctx)
+	if companyId == "" {
+		return nil, status.Error(codes.Aborted, "error while getting company from context")
+	}
+	return s.repo.ChargeDueInstallments(ctx, companyId)
+}
+
+func (s *InstallmentService) GetOverdueInstallments(ctx context.Context, req *pb.GetOverdueInstallmentsRequest) (*pb.GetOverdueInstallmentsResponse, error) {
+	companyId := utils.GetCompanyId(ctx)
+	if companyId == "" {
+		return nil, status.Error(codes.Aborted, "error while getting company from context")
+	}
+	return s.repo.GetOverdueInstallments(ctx, companyId, req.Date, req.Bucket)
+}
@@ -0,0 +1,44 @@
+package service
+
+import (
+	"context"
+	"finance-service/internal/repository"
+	"finance-service/internal/utils"
+	"finance-service/proto/pb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+type OneCExportService struct {
+	pb.UnimplementedOneCExportServiceServer
+	repo *repository.OneCExportRepository
+}
+
+func NewOneCExportService(repo *repository.OneCExportRepository) *OneCExportService {
+	return &OneCExportService{repo: repo}
+}
+
+func (s *OneCExportService) GetOneCSetting(ctx context.Context, _ *emptypb.Empty) (*pb.OneCSetting, error) {
+	companyId := utils.GetCompanyId(ctx)
+	if companyId == "" {
+		return nil, status.Error(codes.Aborted, "error while getting company from context")
+	}
+	return s.repo.GetOneCSetting(companyId)
+}
+
+func (s *OneCExportService) UpdateOneCSetting(ctx context.Context, req *pb.OneCSetting) (*pb.AbsResponse, error) {
+	companyId := utils.GetCompanyId(ctx)
+	if companyId == "" {
+		return nil, status.Error(codes.Aborted, "error while getting company from context")
+	}
+	return s.repo.UpdateOneCSetting(companyId, req)
+}
+
+func (s *OneCExportService) ExportOneC(ctx context.Context, req *pb.ExportOneCRequest) (*pb.ExportOneCResponse, error) {
+	companyId := utils.GetCompanyId(ctx)
+	if companyId == "" {
+		return nil, status.Error(codes.Aborted, "error while getting company from context")
+	}
+	return s.repo.ExportOneC(ctx, companyId, req)
+}
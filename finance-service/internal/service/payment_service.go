@@ -48,6 +48,13 @@ func (ps *PaymentService) PaymentAdd(ctx context.Context, req *pb.PaymentAddRequ
 	}
 	return nil, status.Errorf(codes.Aborted, "invalid request type")
 }
+func (ps *PaymentService) PaymentTakeOff(ctx context.Context, req *pb.PaymentAddRequest) (*pb.AbsResponse, error) {
+	companyId := utils.GetCompanyId(ctx)
+	if companyId == "" {
+		return nil, status.Error(codes.Aborted, "error while getting company from context")
+	}
+	return ps.repo.PaymentTakeOff(ctx, companyId, req.Date, req.Sum, req.Method, req.Comment, req.UserId, req.ActionByName, req.ActionById, req.GroupId, req.Studentconditiondate)
+}
 func (ps *PaymentService) PaymentReturn(ctx context.Context, req *pb.PaymentReturnRequest) (*pb.AbsResponse, error) {
 	companyId := utils.GetCompanyId(ctx)
 	if companyId == "" {
@@ -0,0 +1,100 @@
+package service
+
+import (
+	"context"
+	"finance-service/internal/repository"
+	"finance-service/internal/utils"
+	"finance-service/proto/pb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+type PayrollService struct {
+	pb.UnimplementedPayrollServiceServer
+	repo *repository.PayrollRepository
+}
+
+func NewPayrollService(repo *repository.PayrollRepository) *PayrollService {
+	return &PayrollService{repo: repo}
+}
+
+func (s *PayrollService) CreatePayrollRun(ctx context.Context, req *pb.CreatePayrollRunRequest) (*pb.AbsResponse, error) {
+	companyId := utils.GetCompanyId(ctx)
+	if companyId == "" {
+		return nil, status.Error(codes.Aborted, "error while getting company from context")
+	}
+	return s.repo.CreatePayrollRun(ctx, companyId, req.Period, req.ActionById, req.ActionByName)
+}
+
+func (s *PayrollService) GetPayrollRuns(ctx context.Context, req *emptypb.Empty) (*pb.GetPayrollRunsResponse, error) {
+	companyId := utils.GetCompanyId(ctx)
+	if companyId == "" {
+		return nil, status.Error(codes.Aborted, "error while getting company from context")
+	}
+	return s.repo.GetPayrollRuns(companyId)
+}
+
+func (s *PayrollService) GetPayrollRunById(ctx context.Context, req *pb.PayrollRunIdRequest) (*pb.AbsPayrollRun, error) {
+	companyId := utils.GetCompanyId(ctx)
+	if companyId == "" {
+		return nil, status.Error(codes.Aborted, "error while getting company from context")
+	}
+	return s.repo.GetPayrollRunById(companyId, req.RunId)
+}
+
+func (s *PayrollService) DeletePayrollRun(ctx context.Context, req *pb.PayrollRunIdRequest) (*pb.AbsResponse, error) {
+	companyId := utils.GetCompanyId(ctx)
+	if companyId == "" {
+		return nil, status.Error(codes.Aborted, "error while getting company from context")
+	}
+	return s.repo.DeletePayrollRun(companyId, req.RunId)
+}
+
+func (s *PayrollService) AddPayrollAdjustment(ctx context.Context, req *pb.AddPayrollAdjustmentRequest) (*pb.AbsResponse, error) {
+	companyId := utils.GetCompanyId(ctx)
+	if companyId == "" {
+		return nil, status.Error(codes.Aborted, "error while getting company from context")
+	}
+	return s.repo.AddPayrollAdjustment(companyId, req.RunId, req.TeacherId, req.Type, req.Amount, req.Comment, req.ActionById)
+}
+
+func (s *PayrollService) DeletePayrollAdjustment(ctx context.Context, req *pb.DeletePayrollAdjustmentRequest) (*pb.AbsResponse, error) {
+	companyId := utils.GetCompanyId(ctx)
+	if companyId == "" {
+		return nil, status.Error(codes.Aborted, "error while getting company from context")
+	}
+	return s.repo.DeletePayrollAdjustment(companyId, req.Id)
+}
+
+func (s *PayrollService) ApprovePayrollRun(ctx context.Context, req *pb.PayrollRunActionRequest) (*pb.AbsResponse, error) {
+	companyId := utils.GetCompanyId(ctx)
+	if companyId == "" {
+		return nil, status.Error(codes.Aborted, "error while getting company from context")
+	}
+	return s.repo.ApprovePayrollRun(companyId, req.RunId, req.ActionById, req.ActionByName)
+}
+
+func (s *PayrollService) PayPayrollRun(ctx context.Context, req *pb.PayPayrollRunRequest) (*pb.AbsResponse, error) {
+	companyId := utils.GetCompanyId(ctx)
+	if companyId == "" {
+		return nil, status.Error(codes.Aborted, "error while getting company from context")
+	}
+	return s.repo.PayPayrollRun(companyId, req.RunId, req.PaymentMethod, req.GivenDate, req.ActionById, req.ActionByName)
+}
+
+func (s *PayrollService) GetPayslip(ctx context.Context, req *pb.GetPayslipRequest) (*pb.Payslip, error) {
+	companyId := utils.GetCompanyId(ctx)
+	if companyId == "" {
+		return nil, status.Error(codes.Aborted, "error while getting company from context")
+	}
+	return s.repo.GetPayslip(companyId, req.RunId, req.TeacherId)
+}
+
+func (s *PayrollService) GetTeacherPayslips(ctx context.Context, req *pb.GetTeacherPayslipsRequest) (*pb.GetTeacherPayslipsResponse, error) {
+	companyId := utils.GetCompanyId(ctx)
+	if companyId == "" {
+		return nil, status.Error(codes.Aborted, "error while getting company from context")
+	}
+	return s.repo.GetTeacherPayslips(companyId, req.TeacherId)
+}
@@ -0,0 +1,59 @@
+package service
+
+import (
+	"context"
+	"finance-service/internal/repository"
+	"finance-service/internal/utils"
+	"finance-service/proto/pb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type ReconciliationService struct {
+	pb.UnimplementedReconciliationServiceServer
+	repo *repository.ReconciliationRepository
+}
+
+func NewReconciliationService(repo *repository.ReconciliationRepository) *ReconciliationService {
+	return &ReconciliationService{repo: repo}
+}
+
+func (s *ReconciliationService) ImportStatement(ctx context.Context, req *pb.ImportStatementRequest) (*pb.ReconciliationSession, error) {
+	companyId := utils.GetCompanyId(ctx)
+	if companyId == "" {
+		return nil, status.Error(codes.Aborted, "error while getting company from context")
+	}
+	return s.repo.ImportStatement(ctx, companyId, req)
+}
+
+func (s *ReconciliationService) GetReconciliationSessions(ctx context.Context, req *pb.GetReconciliationSessionsRequest) (*pb.GetReconciliationSessionsResponse, error) {
+	companyId := utils.GetCompanyId(ctx)
+	if companyId == "" {
+		return nil, status.Error(codes.Aborted, "error while getting company from context")
+	}
+	return s.repo.GetReconciliationSessions(companyId, req.Page, req.Size)
+}
+
+func (s *ReconciliationService) GetReconciliationSession(ctx context.Context, req *pb.GetReconciliationSessionRequest) (*pb.ReconciliationSession, error) {
+	companyId := utils.GetCompanyId(ctx)
+	if companyId == "" {
+		return nil, status.Error(codes.Aborted, "error while getting company from context")
+	}
+	return s.repo.GetReconciliationSession(companyId, req.Id, req.Status)
+}
+
+func (s *ReconciliationService) CreateMissingPayments(ctx context.Context, req *pb.CreateMissingPaymentsRequest) (*pb.CreateMissingPaymentsResponse, error) {
+	companyId := utils.GetCompanyId(ctx)
+	if companyId == "" {
+		return nil, status.Error(codes.Aborted, "error while getting company from context")
+	}
+	return s.repo.CreateMissingPayments(ctx, companyId, req)
+}
+
+func (s *ReconciliationService) ResolveReconciliationLine(ctx context.Context, req *pb.ResolveReconciliationLineRequest) (*pb.AbsResponse, error) {
+	companyId := utils.GetCompanyId(ctx)
+	if companyId == "" {
+		return nil, status.Error(codes.Aborted, "error while getting company from context")
+	}
+	return s.repo.ResolveReconciliationLine(companyId, req)
+}
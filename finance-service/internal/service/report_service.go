@@ -0,0 +1,27 @@
+package service
+
+import (
+	"context"
+	"finance-service/internal/repository"
+	"finance-service/internal/utils"
+	"finance-service/proto/pb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type ReportService struct {
+	pb.UnimplementedReportServiceServer
+	repo *repository.ReportRepository
+}
+
+func NewReportService(repo *repository.ReportRepository) *ReportService {
+	return &ReportService{repo: repo}
+}
+
+func (s *ReportService) GetProfitAndLoss(ctx context.Context, req *pb.GetProfitAndLossRequest) (*pb.GetProfitAndLossResponse, error) {
+	companyId := utils.GetCompanyId(ctx)
+	if companyId == "" {
+		return nil, status.Error(codes.Aborted, "error while getting company from context")
+	}
+	return s.repo.GetProfitAndLoss(ctx, companyId, req.From, req.To, req.GroupBy)
+}
@@ -26,14 +26,14 @@ func (ts *TeacherSalaryService) CreateTeacherSalary(ctx context.Context, req *pb
 	if companyId == "" {
 		return nil, status.Error(codes.Aborted, "error while getting company from context")
 	}
-	return ts.repo.CreateTeacherSalary(ctx, companyId, req.Amount, req.TeacherId, req.Type)
+	return ts.repo.CreateTeacherSalary(ctx, companyId, req.Amount, req.TeacherId, req.Type, req.CourseId, req.GroupId, req.EffectiveFrom)
 }
 func (ts *TeacherSalaryService) DeleteTeacherSalary(ctx context.Context, req *pb.DeleteTeacherSalaryRequest) (*pb.AbsResponse, error) {
 	companyId := utils.GetCompanyId(ctx)
 	if companyId == "" {
 		return nil, status.Error(codes.Aborted, "error while getting company from context")
 	}
-	return ts.repo.DeleteTeacherSalary(ctx, companyId, req.TeacherId)
+	return ts.repo.DeleteTeacherSalary(ctx, companyId, req.TeacherId, req.Id)
 }
 func (ts *TeacherSalaryService) GetTeacherSalary(ctx context.Context, req *emptypb.Empty) (*pb.GetTeachersSalaryRequest, error) {
 	companyId := utils.GetCompanyId(ctx)
@@ -50,3 +50,11 @@ func (ts *TeacherSalaryService) GetTeacherSalaryByTeacherID(ctx context.Context,
 	}
 	return ts.repo.GetTeacherSalaryByTeacherID(ctx, companyId, req.TeacherId)
 }
+
+func (ts *TeacherSalaryService) ResolveTeacherSalary(ctx context.Context, req *pb.ResolveTeacherSalaryRequest) (*pb.AbsGetTeachersSalary, error) {
+	companyId := utils.GetCompanyId(ctx)
+	if companyId == "" {
+		return nil, status.Error(codes.Aborted, "error while getting company from context")
+	}
+	return ts.repo.ResolveTeacherSalary(ctx, companyId, req.TeacherId, req.GroupId, req.CourseId, req.Date)
+}
@@ -0,0 +1,51 @@
+package service
+
+import (
+	"context"
+	"finance-service/internal/repository"
+	"finance-service/internal/utils"
+	"finance-service/proto/pb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type VendorService struct {
+	pb.UnimplementedVendorServiceServer
+	repo *repository.VendorRepository
+}
+
+func NewVendorService(repo *repository.VendorRepository) *VendorService {
+	return &VendorService{repo: repo}
+}
+
+func (s *VendorService) CreateVendor(ctx context.Context, req *pb.CreateVendorRequest) (*pb.AbsResponse, error) {
+	companyId := utils.GetCompanyId(ctx)
+	if companyId == "" {
+		return nil, status.Error(codes.Aborted, "error while getting company from context")
+	}
+	return s.repo.CreateVendor(companyId, req)
+}
+
+func (s *VendorService) UpdateVendor(ctx context.Context, req *pb.AbsVendor) (*pb.AbsResponse, error) {
+	companyId := utils.GetCompanyId(ctx)
+	if companyId == "" {
+		return nil, status.Error(codes.Aborted, "error while getting company from context")
+	}
+	return s.repo.UpdateVendor(companyId, req)
+}
+
+func (s *VendorService) GetVendors(ctx context.Context, req *pb.GetVendorsRequest) (*pb.GetVendorsResponse, error) {
+	companyId := utils.GetCompanyId(ctx)
+	if companyId == "" {
+		return nil, status.Error(codes.Aborted, "error while getting company from context")
+	}
+	return s.repo.GetVendors(companyId, req.IncludeInactive)
+}
+
+func (s *VendorService) GetVendorSpendHistory(ctx context.Context, req *pb.GetVendorSpendHistoryRequest) (*pb.GetVendorSpendHistoryResponse, error) {
+	companyId := utils.GetCompanyId(ctx)
+	if companyId == "" {
+		return nil, status.Error(codes.Aborted, "error while getting company from context")
+	}
+	return s.repo.GetVendorSpendHistory(companyId, req)
+}
@@ -80,3 +80,452 @@ CREATE TABLE IF NOT EXISTS teacher_salary
     company_id        int
 );
 
+
+
+CREATE TABLE IF NOT EXISTS accounting_period
+(
+    period         varchar(7) NOT NULL,
+    is_closed      boolean    NOT NULL DEFAULT TRUE,
+    closed_by_id   uuid       NOT NULL,
+    closed_by_name varchar    NOT NULL,
+    closed_at      timestamp           DEFAULT NOW(),
+    company_id     int        NOT NULL,
+    PRIMARY KEY (company_id, period)
+);
+
+CREATE TABLE IF NOT EXISTS accounting_period_history
+(
+    id             uuid PRIMARY KEY,
+    period         varchar(7)                                      NOT NULL,
+    action         varchar check ( action in ('CLOSE', 'REOPEN') ) NOT NULL,
+    comment        varchar                                         NOT NULL DEFAULT '',
+    action_by_id   uuid                                            NOT NULL,
+    action_by_name varchar                                         NOT NULL,
+    company_id     int                                             NOT NULL,
+    created_at     timestamp default NOW()
+);
+
+CREATE TABLE IF NOT EXISTS payroll_run
+(
+    id               uuid PRIMARY KEY,
+    period           varchar(7)                                                  NOT NULL,
+    status           varchar check ( status in ('DRAFT', 'APPROVED', 'PAID') ) NOT NULL DEFAULT 'DRAFT',
+    created_by_id    uuid                                                        NOT NULL,
+    created_by_name  varchar                                                     NOT NULL,
+    approved_by_id   uuid,
+    approved_by_name varchar,
+    approved_at      timestamp,
+    paid_by_id       uuid,
+    paid_by_name     varchar,
+    paid_at          timestamp,
+    created_at       timestamp default NOW(),
+    company_id       int                                                         NOT NULL,
+    UNIQUE (company_id, period)
+);
+
+CREATE TABLE IF NOT EXISTS payroll_item
+(
+    id            uuid PRIMARY KEY,
+    run_id        uuid REFERENCES payroll_run (id) ON DELETE CASCADE NOT NULL,
+    teacher_id    uuid                                                NOT NULL,
+    teacher_name  varchar                                             NOT NULL,
+    salary_amount double precision                                    NOT NULL DEFAULT 0,
+    expense_id    uuid,
+    company_id    int                                                 NOT NULL,
+    UNIQUE (run_id, teacher_id)
+);
+
+CREATE TABLE IF NOT EXISTS payroll_item_detail
+(
+    id                  uuid PRIMARY KEY,
+    item_id             uuid REFERENCES payroll_item (id) ON DELETE CASCADE NOT NULL,
+    group_id            bigint                                               NOT NULL,
+    group_name          varchar                                              NOT NULL,
+    common_lesson_count int                                                  NOT NULL DEFAULT 0,
+    student_id          uuid                                                 NOT NULL,
+    student_name        varchar                                              NOT NULL,
+    passed_lesson_count int                                                  NOT NULL DEFAULT 0,
+    amount              double precision                                     NOT NULL DEFAULT 0,
+    price_type          varchar,
+    company_id          int                                                  NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS payroll_adjustment
+(
+    id              uuid PRIMARY KEY,
+    run_id          uuid REFERENCES payroll_run (id) ON DELETE CASCADE                         NOT NULL,
+    teacher_id      uuid                                                                       NOT NULL,
+    adjustment_type varchar check ( adjustment_type in ('BONUS', 'PENALTY', 'ADVANCE') ) NOT NULL,
+    amount          double precision                                                           NOT NULL,
+    comment         varchar                                                                    NOT NULL DEFAULT '',
+    created_by_id   uuid                                                                       NOT NULL,
+    created_at      timestamp default NOW(),
+    company_id      int                                                                        NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS installment_plan
+(
+    id                     uuid PRIMARY KEY,
+    student_id             uuid                                                                    NOT NULL,
+    group_id               bigint                                                                  NOT NULL,
+    plan_type              varchar check ( plan_type in ('FULL_PREPAY', 'MONTHLY', 'CUSTOM') )   NOT NULL,
+    total_amount           double precision                                                        NOT NULL,
+    late_fee_amount        double precision                                                        NOT NULL DEFAULT 0,
+    grace_days             int                                                                     NOT NULL DEFAULT 0,
+    early_discount_percent double precision check ( early_discount_percent between 0 and 100 )   NOT NULL DEFAULT 0,
+    early_days             int                                                                     NOT NULL DEFAULT 0,
+    status                 varchar check ( status in ('ACTIVE', 'CANCELLED') )                   NOT NULL DEFAULT 'ACTIVE',
+    comment                varchar                                                                 NOT NULL DEFAULT '',
+    created_by_id          uuid                                                                    NOT NULL,
+    created_by_name        varchar                                                                 NOT NULL,
+    cancelled_by_id        uuid,
+    cancelled_by_name      varchar,
+    created_at             timestamp default NOW(),
+    company_id             int                                                                     NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS installment
+(
+    id                  uuid PRIMARY KEY,
+    plan_id             uuid REFERENCES installment_plan (id) ON DELETE CASCADE NOT NULL,
+    due_date            date                                                     NOT NULL,
+    amount              double precision                                         NOT NULL,
+    paid_amount         double precision                                         NOT NULL DEFAULT 0,
+    discount_amount     double precision                                         NOT NULL DEFAULT 0,
+    is_charged          boolean                                                  NOT NULL DEFAULT FALSE,
+    is_late_fee_applied boolean                                                  NOT NULL DEFAULT FALSE,
+    paid_at             date,
+    company_id          int                                                      NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS collection_setting
+(
+    company_id        int PRIMARY KEY,
+    freeze_after_days int       NOT NULL DEFAULT 90,
+    reminders_enabled boolean   NOT NULL DEFAULT TRUE,
+    updated_at        timestamp default NOW()
+);
+
+CREATE TABLE IF NOT EXISTS collection_case
+(
+    student_id           uuid                                            NOT NULL,
+    company_id           int                                             NOT NULL,
+    status               varchar check ( status in ('OPEN', 'CLOSED') ) NOT NULL DEFAULT 'OPEN',
+    debt_since           date,
+    assigned_to_id       uuid,
+    assigned_to_name     varchar,
+    last_reminder_bucket varchar,
+    last_reminder_at     timestamp,
+    freeze_flagged       boolean                                         NOT NULL DEFAULT FALSE,
+    freeze_flagged_at    timestamp,
+    created_at           timestamp default NOW(),
+    closed_at            timestamp,
+    PRIMARY KEY (company_id, student_id)
+);
+
+CREATE TABLE IF NOT EXISTS collection_activity
+(
+    id              uuid PRIMARY KEY,
+    student_id      uuid                                                                             NOT NULL,
+    activity_type   varchar check ( activity_type in ('CALL', 'SMS', 'MEETING', 'PROMISE', 'NOTE') ) NOT NULL,
+    comment         varchar                                                                          NOT NULL DEFAULT '',
+    promised_amount double precision,
+    promised_date   date,
+    created_by_id   uuid                                                                             NOT NULL,
+    created_by_name varchar                                                                          NOT NULL,
+    created_at      timestamp default NOW(),
+    company_id      int                                                                              NOT NULL
+);
+
+ALTER TABLE category
+    ADD COLUMN IF NOT EXISTS monthly_budget double precision;
+
+CREATE TABLE IF NOT EXISTS recurring_expense
+(
+    id             uuid PRIMARY KEY,
+    title          varchar                                                       NOT NULL,
+    expense_type   varchar check ( expense_type in ('USER', 'CATEGORY'))         NOT NULL,
+    user_id        uuid,
+    category_id    int,
+    sum            double precision                                              NOT NULL,
+    payment_method varchar check ( payment_method in ('CASH', 'CLICK', 'PAYME')) NOT NULL,
+    schedule       varchar check ( schedule in ('MONTHLY', 'WEEKLY') )           NOT NULL,
+    day            int                                                           NOT NULL,
+    start_date     date                                                          NOT NULL,
+    end_date       date,
+    next_run_date  date                                                          NOT NULL,
+    is_active      boolean                                                       NOT NULL DEFAULT TRUE,
+    created_by     uuid                                                          NOT NULL,
+    created_at     timestamp default NOW(),
+    company_id     int                                                           NOT NULL
+);
+
+ALTER TABLE expense
+    ADD COLUMN IF NOT EXISTS recurring_expense_id uuid;
+
+CREATE TABLE IF NOT EXISTS budget_alert
+(
+    category_id int              NOT NULL,
+    period      varchar(7)       NOT NULL,
+    threshold   int              NOT NULL,
+    budget      double precision NOT NULL,
+    actual      double precision NOT NULL,
+    created_at  timestamp default NOW(),
+    company_id  int              NOT NULL,
+    PRIMARY KEY (company_id, category_id, period, threshold)
+);
+
+CREATE TABLE IF NOT EXISTS vendor
+(
+    id         uuid PRIMARY KEY,
+    name       varchar NOT NULL,
+    phone      varchar NOT NULL DEFAULT '',
+    tin        varchar NOT NULL DEFAULT '',
+    comment    varchar NOT NULL DEFAULT '',
+    is_active  boolean NOT NULL DEFAULT TRUE,
+    created_at timestamp default NOW(),
+    company_id int     NOT NULL
+);
+
+ALTER TABLE expense
+    ADD COLUMN IF NOT EXISTS status varchar check ( status in ('DRAFT', 'PENDING_APPROVAL', 'APPROVED', 'REJECTED', 'PAID') ) NOT NULL DEFAULT 'PAID';
+ALTER TABLE expense
+    ADD COLUMN IF NOT EXISTS approver_role varchar;
+ALTER TABLE expense
+    ADD COLUMN IF NOT EXISTS vendor_id uuid REFERENCES vendor (id);
+ALTER TABLE recurring_expense
+    ADD COLUMN IF NOT EXISTS vendor_id uuid REFERENCES vendor (id);
+
+CREATE TABLE IF NOT EXISTS expense_approval_setting
+(
+    company_id          int PRIMARY KEY,
+    financist_threshold double precision NOT NULL DEFAULT 0,
+    ceo_threshold       double precision NOT NULL DEFAULT 0,
+    updated_at          timestamp default NOW()
+);
+
+CREATE TABLE IF NOT EXISTS expense_receipt
+(
+    id             uuid PRIMARY KEY,
+    expense_id     uuid REFERENCES expense (id) ON DELETE CASCADE NOT NULL,
+    file_url       varchar                                       NOT NULL,
+    file_name      varchar                                       NOT NULL DEFAULT '',
+    uploaded_by_id uuid                                          NOT NULL,
+    created_at     timestamp default NOW(),
+    company_id     int                                           NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS expense_status_history
+(
+    id             uuid PRIMARY KEY,
+    expense_id     uuid REFERENCES expense (id) ON DELETE CASCADE NOT NULL,
+    from_status    varchar,
+    to_status      varchar                                       NOT NULL,
+    comment        varchar                                       NOT NULL DEFAULT '',
+    action_by_id   uuid                                          NOT NULL,
+    action_by_name varchar                                       NOT NULL,
+    created_at     timestamp default NOW(),
+    company_id     int                                           NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS onec_setting
+(
+    company_id        int PRIMARY KEY,
+    organization_name varchar NOT NULL DEFAULT '',
+    inn               varchar NOT NULL DEFAULT '',
+    bank_name         varchar NOT NULL DEFAULT '',
+    bik               varchar NOT NULL DEFAULT '',
+    updated_at        timestamp default NOW()
+);
+
+CREATE TABLE IF NOT EXISTS onec_account_mapping
+(
+    company_id  int                                                                                                NOT NULL,
+    source_type varchar check ( source_type in ('PAYMENT_METHOD', 'STUDENT', 'EXPENSE_CATEGORY', 'EXPENSE_USER', 'SALARY') ) NOT NULL,
+    source_key  varchar                                                                                            NOT NULL DEFAULT '',
+    account     varchar                                                                                            NOT NULL,
+    PRIMARY KEY (company_id, source_type, source_key)
+);
+
+ALTER TABLE student_payments
+    ADD COLUMN IF NOT EXISTS transaction_id varchar;
+
+CREATE INDEX IF NOT EXISTS idx_student_payments_transaction_id ON student_payments (company_id, transaction_id);
+
+CREATE TABLE IF NOT EXISTS reconciliation_session
+(
+    id              uuid PRIMARY KEY,
+    source          varchar check ( source in ('CSV', 'XLSX', 'PAYME', 'CLICK') )  NOT NULL,
+    method          varchar check ( method in ('CASH', 'CLICK', 'PAYME') )          NOT NULL,
+    file_name       varchar                                                         NOT NULL DEFAULT '',
+    created_by_id   uuid                                                            NOT NULL,
+    created_by_name varchar                                                         NOT NULL,
+    created_at      timestamp default NOW(),
+    company_id      int                                                             NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS reconciliation_line
+(
+    id             uuid PRIMARY KEY,
+    session_id     uuid REFERENCES reconciliation_session (id) ON DELETE CASCADE                              NOT NULL,
+    line_no        int                                                                                        NOT NULL,
+    transaction_id varchar                                                                                    NOT NULL DEFAULT '',
+    amount         double precision                                                                           NOT NULL,
+    paid_at        date,
+    phone          varchar                                                                                    NOT NULL DEFAULT '',
+    payer_name     varchar                                                                                    NOT NULL DEFAULT '',
+    description    varchar                                                                                    NOT NULL DEFAULT '',
+    status         varchar check ( status in ('MATCHED', 'UNMATCHED', 'SUSPICIOUS', 'CREATED', 'IGNORED') ) NOT NULL,
+    payment_id     uuid,
+    student_id     uuid,
+    student_name   varchar                                                                                    NOT NULL DEFAULT '',
+    note           varchar                                                                                    NOT NULL DEFAULT '',
+    company_id     int                                                                                        NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_reconciliation_line_payment ON reconciliation_line (payment_id);
+
+CREATE TABLE IF NOT EXISTS discount_rule
+(
+    id              uuid PRIMARY KEY,
+    name            varchar                                                                          NOT NULL,
+    rule_type       varchar check ( rule_type in ('TEMPLATE', 'SIBLING', 'MULTI_COURSE', 'PROMO_CODE') ) NOT NULL,
+    value_type      varchar check ( value_type in ('PERCENT', 'FIXED') )                              NOT NULL,
+    value           double precision                                                                 NOT NULL,
+    with_teacher    boolean                                                                          NOT NULL DEFAULT false,
+    duration_months int                                                                              NOT NULL DEFAULT 0,
+    promo_code      varchar,
+    usage_limit     int                                                                              NOT NULL DEFAULT 0,
+    used_count      int                                                                              NOT NULL DEFAULT 0,
+    valid_from      date,
+    valid_until     date,
+    min_courses     int                                                                              NOT NULL DEFAULT 2,
+    course_id       int,
+    is_active       boolean                                                                          NOT NULL DEFAULT true,
+    created_at      timestamp default NOW(),
+    company_id      int                                                                              NOT NULL
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_discount_rule_promo_code ON discount_rule (company_id, upper(promo_code)) WHERE promo_code IS NOT NULL;
+
+CREATE TABLE IF NOT EXISTS discount_rule_application
+(
+    id              uuid PRIMARY KEY,
+    rule_id         uuid REFERENCES discount_rule (id) NOT NULL,
+    student_id      uuid                               NOT NULL,
+    group_id        bigint                             NOT NULL,
+    discount        double precision                   NOT NULL,
+    applied_by_id   uuid                               NOT NULL,
+    applied_by_name varchar                            NOT NULL,
+    applied_at      timestamp default NOW(),
+    company_id      int                                NOT NULL
+);
+
+ALTER TABLE student_discount
+    ADD COLUMN IF NOT EXISTS rule_id uuid;
+
+ALTER TABLE student_discount_history
+    ADD COLUMN IF NOT EXISTS rule_id   uuid,
+    ADD COLUMN IF NOT EXISTS rule_name varchar NOT NULL DEFAULT '';
+
+CREATE TABLE IF NOT EXISTS charge_item
+(
+    id          uuid PRIMARY KEY,
+    name        varchar          NOT NULL,
+    price       double precision NOT NULL CHECK ( price >= 0 ),
+    is_physical boolean          NOT NULL DEFAULT false,
+    stock       int              NOT NULL DEFAULT 0,
+    comment     varchar          NOT NULL DEFAULT '',
+    is_active   boolean          NOT NULL DEFAULT true,
+    created_at  timestamp default NOW(),
+    company_id  int              NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS student_charge
+(
+    id              uuid PRIMARY KEY,
+    student_id      uuid                                     NOT NULL,
+    group_id        bigint,
+    payment_id      uuid REFERENCES student_payments (id)    NOT NULL,
+    given_date      date                                     NOT NULL,
+    total_amount    double precision                         NOT NULL,
+    comment         varchar                                  NOT NULL DEFAULT '',
+    created_by_id   uuid                                     NOT NULL,
+    created_by_name varchar                                  NOT NULL,
+    created_at      timestamp default NOW(),
+    company_id      int                                      NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS student_charge_item
+(
+    id         uuid PRIMARY KEY,
+    charge_id  uuid REFERENCES student_charge (id) NOT NULL,
+    item_id    uuid REFERENCES charge_item (id)    NOT NULL,
+    item_name  varchar                             NOT NULL,
+    quantity   int                                 NOT NULL CHECK ( quantity > 0 ),
+    unit_price double precision                    NOT NULL,
+    amount     double precision                    NOT NULL,
+    company_id int                                 NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_student_charge_student ON student_charge (company_id, student_id);
+
+-- a teacher has several salary rules now: company wide, per course and per group, each from its effective date
+ALTER TABLE teacher_salary
+    ADD COLUMN IF NOT EXISTS id             uuid,
+    ADD COLUMN IF NOT EXISTS course_id      int,
+    ADD COLUMN IF NOT EXISTS group_id       bigint,
+    ADD COLUMN IF NOT EXISTS effective_from date NOT NULL DEFAULT '2000-01-01';
+
+UPDATE teacher_salary
+SET id = gen_random_uuid()
+WHERE id IS NULL;
+
+DO
+$$
+    BEGIN
+        IF NOT EXISTS(SELECT 1
+                      FROM information_schema.key_column_usage
+                      WHERE table_name = 'teacher_salary'
+                        AND constraint_name = 'teacher_salary_pkey'
+                        AND column_name = 'id') THEN
+            ALTER TABLE teacher_salary
+                DROP CONSTRAINT IF EXISTS teacher_salary_pkey;
+            ALTER TABLE teacher_salary
+                ADD PRIMARY KEY (id);
+        END IF;
+    END
+$$;
+
+ALTER TABLE teacher_salary
+    ALTER COLUMN teacher_id SET NOT NULL;
+
+ALTER TABLE teacher_salary
+    DROP CONSTRAINT IF EXISTS teacher_salary_salary_type_check;
+ALTER TABLE teacher_salary
+    ADD CONSTRAINT teacher_salary_salary_type_check CHECK (salary_type IN ('PERCENT', 'FIXED', 'PER_LESSON'));
+
+-- occurrences of a recurring expense dated in a closed accounting period, finance posts them by hand
+CREATE TABLE IF NOT EXISTS recurring_expense_skip
+(
+    recurring_expense_id uuid REFERENCES recurring_expense (id) NOT NULL,
+    given_date           date                                   NOT NULL,
+    sum                  double precision                       NOT NULL,
+    created_at           timestamp default NOW(),
+    company_id           int                                    NOT NULL,
+    PRIMARY KEY (recurring_expense_id, given_date)
+);
+
+-- a discount credit gives a student balance back for lessons paid before the discount, no money leaves the bank
+ALTER TABLE student_payments
+    ADD COLUMN IF NOT EXISTS discount_credit boolean NOT NULL DEFAULT FALSE;
+
+UPDATE student_payments
+SET discount_credit = TRUE
+WHERE payment_type = 'REFUND'
+  AND NOT discount_credit
+  AND comment = 'Studentga ushbu tolov amalga oshirilgan kunlar oralig''ida chegirma kiritildi va studentning qolgan puli qaytarib berildi.';
+
+-- advances are paid out as their own expense when the run is paid
+ALTER TABLE payroll_item
+    ADD COLUMN IF NOT EXISTS advance_expense_id uuid;
@@ -165,33 +165,26 @@ func (x *AbsCourse) GetDescription() string {
 	return ""
 }
 
-type CalculateDiscountSummaRequest struct {
-	state                                        protoimpl.MessageState `protogen:"open.v1"`
-	GroupId                                      string                 `protobuf:"bytes,1,opt,name=groupId,proto3" json:"groupId,omitempty"`
-	StudentId                                    string                 `protobuf:"bytes,2,opt,name=studentId,proto3" json:"studentId,omitempty"`
-	DiscountPrice                                string                 `protobuf:"bytes,3,opt,name=discountPrice,proto3" json:"discountPrice,omitempty"`
-	StartDate                                    string                 `protobuf:"bytes,4,opt,name=startDate,proto3" json:"startDate,omitempty"`
-	EndDate                                      string                 `protobuf:"bytes,5,opt,name=endDate,proto3" json:"endDate,omitempty"`
-	PaymentDate                                  string                 `protobuf:"bytes,6,opt,name=paymentDate,proto3" json:"paymentDate,omitempty"`
-	StudentActivationDateInThisGroupWhilePayment string                 `protobuf:"bytes,7,opt,name=studentActivationDateInThisGroupWhilePayment,proto3" json:"studentActivationDateInThisGroupWhilePayment,omitempty"`
-	unknownFields                                protoimpl.UnknownFields
-	sizeCache                                    protoimpl.SizeCache
+type GetDebtorsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CalculateDiscountSummaRequest) Reset() {
-	*x = CalculateDiscountSummaRequest{}
+func (x *GetDebtorsRequest) Reset() {
+	*x = GetDebtorsRequest{}
 	mi := &file_education_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CalculateDiscountSummaRequest) String() string {
+func (x *GetDebtorsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CalculateDiscountSummaRequest) ProtoMessage() {}
+func (*GetDebtorsRequest) ProtoMessage() {}
 
-func (x *CalculateDiscountSummaRequest) ProtoReflect() protoreflect.Message {
+func (x *GetDebtorsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_education_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -203,81 +196,32 @@ func (x *CalculateDiscountSummaRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CalculateDiscountSummaRequest.ProtoReflect.Descriptor instead.
-func (*CalculateDiscountSummaRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetDebtorsRequest.ProtoReflect.Descriptor instead.
+func (*GetDebtorsRequest) Descriptor() ([]byte, []int) {
 	return file_education_proto_rawDescGZIP(), []int{2}
 }
 
-func (x *CalculateDiscountSummaRequest) GetGroupId() string {
-	if x != nil {
-		return x.GroupId
-	}
-	return ""
-}
-
-func (x *CalculateDiscountSummaRequest) GetStudentId() string {
-	if x != nil {
-		return x.StudentId
-	}
-	return ""
-}
-
-func (x *CalculateDiscountSummaRequest) GetDiscountPrice() string {
-	if x != nil {
-		return x.DiscountPrice
-	}
-	return ""
-}
-
-func (x *CalculateDiscountSummaRequest) GetStartDate() string {
-	if x != nil {
-		return x.StartDate
-	}
-	return ""
-}
-
-func (x *CalculateDiscountSummaRequest) GetEndDate() string {
-	if x != nil {
-		return x.EndDate
-	}
-	return ""
-}
-
-func (x *CalculateDiscountSummaRequest) GetPaymentDate() string {
-	if x != nil {
-		return x.PaymentDate
-	}
-	return ""
-}
-
-func (x *CalculateDiscountSummaRequest) GetStudentActivationDateInThisGroupWhilePayment() string {
-	if x != nil {
-		return x.StudentActivationDateInThisGroupWhilePayment
-	}
-	return ""
-}
-
-type CalculateDiscountResponse struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	CalculatedPrice string                 `protobuf:"bytes,1,opt,name=calculatedPrice,proto3" json:"calculatedPrice,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+type GetDebtorsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Debtors       []*AbsDebtor           `protobuf:"bytes,1,rep,name=debtors,proto3" json:"debtors,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CalculateDiscountResponse) Reset() {
-	*x = CalculateDiscountResponse{}
+func (x *GetDebtorsResponse) Reset() {
+	*x = GetDebtorsResponse{}
 	mi := &file_education_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CalculateDiscountResponse) String() string {
+func (x *GetDebtorsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CalculateDiscountResponse) ProtoMessage() {}
+func (*GetDebtorsResponse) ProtoMessage() {}
 
-func (x *CalculateDiscountResponse) ProtoReflect() protoreflect.Message {
+func (x *GetDebtorsResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_education_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -289,47 +233,43 @@ func (x *CalculateDiscountResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CalculateDiscountResponse.ProtoReflect.Descriptor instead.
-func (*CalculateDiscountResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetDebtorsResponse.ProtoReflect.Descriptor instead.
+func (*GetDebtorsResponse) Descriptor() ([]byte, []int) {
 	return file_education_proto_rawDescGZIP(), []int{3}
 }
 
-func (x *CalculateDiscountResponse) GetCalculatedPrice() string {
+func (x *GetDebtorsResponse) GetDebtors() []*AbsDebtor {
 	if x != nil {
-		return x.CalculatedPrice
+		return x.Debtors
 	}
-	return ""
+	return nil
 }
 
-type ChangeUserBalanceHistoryByDebitRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	StudentId     string                 `protobuf:"bytes,1,opt,name=studentId,proto3" json:"studentId,omitempty"`
-	OldDebit      string                 `protobuf:"bytes,2,opt,name=oldDebit,proto3" json:"oldDebit,omitempty"`
-	GivenDate     string                 `protobuf:"bytes,3,opt,name=givenDate,proto3" json:"givenDate,omitempty"`
-	Comment       string                 `protobuf:"bytes,4,opt,name=comment,proto3" json:"comment,omitempty"`
-	PaymentType   string                 `protobuf:"bytes,5,opt,name=payment_type,json=paymentType,proto3" json:"payment_type,omitempty"`
-	CreatedBy     string                 `protobuf:"bytes,6,opt,name=createdBy,proto3" json:"createdBy,omitempty"`
-	CreatedByName string                 `protobuf:"bytes,7,opt,name=createdByName,proto3" json:"createdByName,omitempty"`
-	GroupId       string                 `protobuf:"bytes,8,opt,name=groupId,proto3" json:"groupId,omitempty"`
-	CurrentDebit  string                 `protobuf:"bytes,9,opt,name=currentDebit,proto3" json:"currentDebit,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type AbsDebtor struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	StudentId            string                 `protobuf:"bytes,1,opt,name=studentId,proto3" json:"studentId,omitempty"`
+	Name                 string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Phone                string                 `protobuf:"bytes,3,opt,name=phone,proto3" json:"phone,omitempty"`
+	Balance              float64                `protobuf:"fixed64,4,opt,name=balance,proto3" json:"balance,omitempty"`
+	ZeroBalanceUpdatedAt string                 `protobuf:"bytes,5,opt,name=zeroBalanceUpdatedAt,proto3" json:"zeroBalanceUpdatedAt,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
 }
 
-func (x *ChangeUserBalanceHistoryByDebitRequest) Reset() {
-	*x = ChangeUserBalanceHistoryByDebitRequest{}
+func (x *AbsDebtor) Reset() {
+	*x = AbsDebtor{}
 	mi := &file_education_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ChangeUserBalanceHistoryByDebitRequest) String() string {
+func (x *AbsDebtor) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ChangeUserBalanceHistoryByDebitRequest) ProtoMessage() {}
+func (*AbsDebtor) ProtoMessage() {}
 
-func (x *ChangeUserBalanceHistoryByDebitRequest) ProtoReflect() protoreflect.Message {
+func (x *AbsDebtor) ProtoReflect() protoreflect.Message {
 	mi := &file_education_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -341,102 +281,68 @@ func (x *ChangeUserBalanceHistoryByDebitRequest) ProtoReflect() protoreflect.Mes
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ChangeUserBalanceHistoryByDebitRequest.ProtoReflect.Descriptor instead.
-func (*ChangeUserBalanceHistoryByDebitRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use AbsDebtor.ProtoReflect.Descriptor instead.
+func (*AbsDebtor) Descriptor() ([]byte, []int) {
 	return file_education_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *ChangeUserBalanceHistoryByDebitRequest) GetStudentId() string {
+func (x *AbsDebtor) GetStudentId() string {
 	if x != nil {
 		return x.StudentId
 	}
 	return ""
 }
 
-func (x *ChangeUserBalanceHistoryByDebitRequest) GetOldDebit() string {
-	if x != nil {
-		return x.OldDebit
-	}
-	return ""
-}
-
-func (x *ChangeUserBalanceHistoryByDebitRequest) GetGivenDate() string {
-	if x != nil {
-		return x.GivenDate
-	}
-	return ""
-}
-
-func (x *ChangeUserBalanceHistoryByDebitRequest) GetComment() string {
-	if x != nil {
-		return x.Comment
-	}
-	return ""
-}
-
-func (x *ChangeUserBalanceHistoryByDebitRequest) GetPaymentType() string {
-	if x != nil {
-		return x.PaymentType
-	}
-	return ""
-}
-
-func (x *ChangeUserBalanceHistoryByDebitRequest) GetCreatedBy() string {
+func (x *AbsDebtor) GetName() string {
 	if x != nil {
-		return x.CreatedBy
+		return x.Name
 	}
 	return ""
 }
 
-func (x *ChangeUserBalanceHistoryByDebitRequest) GetCreatedByName() string {
+func (x *AbsDebtor) GetPhone() string {
 	if x != nil {
-		return x.CreatedByName
+		return x.Phone
 	}
 	return ""
 }
 
-func (x *ChangeUserBalanceHistoryByDebitRequest) GetGroupId() string {
+func (x *AbsDebtor) GetBalance() float64 {
 	if x != nil {
-		return x.GroupId
+		return x.Balance
 	}
-	return ""
+	return 0
 }
 
-func (x *ChangeUserBalanceHistoryByDebitRequest) GetCurrentDebit() string {
+func (x *AbsDebtor) GetZeroBalanceUpdatedAt() string {
 	if x != nil {
-		return x.CurrentDebit
+		return x.ZeroBalanceUpdatedAt
 	}
 	return ""
 }
 
-type ChangeUserBalanceHistoryRequest struct {
+type GetDiscountContextRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	StudentId     string                 `protobuf:"bytes,1,opt,name=studentId,proto3" json:"studentId,omitempty"`
-	Amount        string                 `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
-	GivenDate     string                 `protobuf:"bytes,3,opt,name=givenDate,proto3" json:"givenDate,omitempty"`
-	Comment       string                 `protobuf:"bytes,4,opt,name=comment,proto3" json:"comment,omitempty"`
-	PaymentType   string                 `protobuf:"bytes,5,opt,name=payment_type,json=paymentType,proto3" json:"payment_type,omitempty"`
-	CreatedBy     string                 `protobuf:"bytes,6,opt,name=createdBy,proto3" json:"createdBy,omitempty"`
-	CreatedByName string                 `protobuf:"bytes,7,opt,name=createdByName,proto3" json:"createdByName,omitempty"`
-	GroupId       string                 `protobuf:"bytes,8,opt,name=groupId,proto3" json:"groupId,omitempty"`
+	GroupId       string                 `protobuf:"bytes,2,opt,name=groupId,proto3" json:"groupId,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ChangeUserBalanceHistoryRequest) Reset() {
-	*x = ChangeUserBalanceHistoryRequest{}
+func (x *GetDiscountContextRequest) Reset() {
+	*x = GetDiscountContextRequest{}
 	mi := &file_education_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ChangeUserBalanceHistoryRequest) String() string {
+func (x *GetDiscountContextRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ChangeUserBalanceHistoryRequest) ProtoMessage() {}
+func (*GetDiscountContextRequest) ProtoMessage() {}
 
-func (x *ChangeUserBalanceHistoryRequest) ProtoReflect() protoreflect.Message {
+func (x *GetDiscountContextRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_education_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -448,89 +354,125 @@ func (x *ChangeUserBalanceHistoryRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ChangeUserBalanceHistoryRequest.ProtoReflect.Descriptor instead.
-func (*ChangeUserBalanceHistoryRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetDiscountContextRequest.ProtoReflect.Descriptor instead.
+func (*GetDiscountContextRequest) Descriptor() ([]byte, []int) {
 	return file_education_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *ChangeUserBalanceHistoryRequest) GetStudentId() string {
+func (x *GetDiscountContextRequest) GetStudentId() string {
 	if x != nil {
 		return x.StudentId
 	}
 	return ""
 }
 
-func (x *ChangeUserBalanceHistoryRequest) GetAmount() string {
+func (x *GetDiscountContextRequest) GetGroupId() string {
 	if x != nil {
-		return x.Amount
+		return x.GroupId
 	}
 	return ""
 }
 
-func (x *ChangeUserBalanceHistoryRequest) GetGivenDate() string {
+type GetDiscountContextResponse struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	CoursePrice  float64                `protobuf:"fixed64,1,opt,name=coursePrice,proto3" json:"coursePrice,omitempty"`
+	CourseId     string                 `protobuf:"bytes,2,opt,name=courseId,proto3" json:"courseId,omitempty"`
+	GroupEndDate string                 `protobuf:"bytes,3,opt,name=groupEndDate,proto3" json:"groupEndDate,omitempty"`
+	// distinct courses the student is enrolled in, this group included
+	CourseCount int32 `protobuf:"varint,4,opt,name=courseCount,proto3" json:"courseCount,omitempty"`
+	// active students sharing a family phone that were registered before this student
+	SiblingCount  int32 `protobuf:"varint,5,opt,name=siblingCount,proto3" json:"siblingCount,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDiscountContextResponse) Reset() {
+	*x = GetDiscountContextResponse{}
+	mi := &file_education_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDiscountContextResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDiscountContextResponse) ProtoMessage() {}
+
+func (x *GetDiscountContextResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[6]
 	if x != nil {
-		return x.GivenDate
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *ChangeUserBalanceHistoryRequest) GetComment() string {
+// Deprecated: Use GetDiscountContextResponse.ProtoReflect.Descriptor instead.
+func (*GetDiscountContextResponse) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *GetDiscountContextResponse) GetCoursePrice() float64 {
 	if x != nil {
-		return x.Comment
+		return x.CoursePrice
 	}
-	return ""
+	return 0
 }
 
-func (x *ChangeUserBalanceHistoryRequest) GetPaymentType() string {
+func (x *GetDiscountContextResponse) GetCourseId() string {
 	if x != nil {
-		return x.PaymentType
+		return x.CourseId
 	}
 	return ""
 }
 
-func (x *ChangeUserBalanceHistoryRequest) GetCreatedBy() string {
+func (x *GetDiscountContextResponse) GetGroupEndDate() string {
 	if x != nil {
-		return x.CreatedBy
+		return x.GroupEndDate
 	}
 	return ""
 }
 
-func (x *ChangeUserBalanceHistoryRequest) GetCreatedByName() string {
+func (x *GetDiscountContextResponse) GetCourseCount() int32 {
 	if x != nil {
-		return x.CreatedByName
+		return x.CourseCount
 	}
-	return ""
+	return 0
 }
 
-func (x *ChangeUserBalanceHistoryRequest) GetGroupId() string {
+func (x *GetDiscountContextResponse) GetSiblingCount() int32 {
 	if x != nil {
-		return x.GroupId
+		return x.SiblingCount
 	}
-	return ""
+	return 0
 }
 
-type GetStudentsByGroupIdResponse struct {
+type GetStudentsByPhonesRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Students      []*AbsStudent          `protobuf:"bytes,1,rep,name=students,proto3" json:"students,omitempty"`
+	Phones        []string               `protobuf:"bytes,1,rep,name=phones,proto3" json:"phones,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetStudentsByGroupIdResponse) Reset() {
-	*x = GetStudentsByGroupIdResponse{}
-	mi := &file_education_proto_msgTypes[6]
+func (x *GetStudentsByPhonesRequest) Reset() {
+	*x = GetStudentsByPhonesRequest{}
+	mi := &file_education_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetStudentsByGroupIdResponse) String() string {
+func (x *GetStudentsByPhonesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetStudentsByGroupIdResponse) ProtoMessage() {}
+func (*GetStudentsByPhonesRequest) ProtoMessage() {}
 
-func (x *GetStudentsByGroupIdResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_education_proto_msgTypes[6]
+func (x *GetStudentsByPhonesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -541,41 +483,40 @@ func (x *GetStudentsByGroupIdResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetStudentsByGroupIdResponse.ProtoReflect.Descriptor instead.
-func (*GetStudentsByGroupIdResponse) Descriptor() ([]byte, []int) {
-	return file_education_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use GetStudentsByPhonesRequest.ProtoReflect.Descriptor instead.
+func (*GetStudentsByPhonesRequest) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *GetStudentsByGroupIdResponse) GetStudents() []*AbsStudent {
+func (x *GetStudentsByPhonesRequest) GetPhones() []string {
 	if x != nil {
-		return x.Students
+		return x.Phones
 	}
 	return nil
 }
 
-type GetStudentsByGroupIdRequest struct {
+type GetStudentsByPhonesResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	GroupId       string                 `protobuf:"bytes,1,opt,name=groupId,proto3" json:"groupId,omitempty"`
-	WithOutdated  bool                   `protobuf:"varint,2,opt,name=withOutdated,proto3" json:"withOutdated,omitempty"`
+	Students      []*AbsDebtor           `protobuf:"bytes,1,rep,name=students,proto3" json:"students,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetStudentsByGroupIdRequest) Reset() {
-	*x = GetStudentsByGroupIdRequest{}
-	mi := &file_education_proto_msgTypes[7]
+func (x *GetStudentsByPhonesResponse) Reset() {
+	*x = GetStudentsByPhonesResponse{}
+	mi := &file_education_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetStudentsByGroupIdRequest) String() string {
+func (x *GetStudentsByPhonesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetStudentsByGroupIdRequest) ProtoMessage() {}
+func (*GetStudentsByPhonesResponse) ProtoMessage() {}
 
-func (x *GetStudentsByGroupIdRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_education_proto_msgTypes[7]
+func (x *GetStudentsByPhonesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -586,49 +527,892 @@ func (x *GetStudentsByGroupIdRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetStudentsByGroupIdRequest.ProtoReflect.Descriptor instead.
-func (*GetStudentsByGroupIdRequest) Descriptor() ([]byte, []int) {
-	return file_education_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use GetStudentsByPhonesResponse.ProtoReflect.Descriptor instead.
+func (*GetStudentsByPhonesResponse) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *GetStudentsByGroupIdRequest) GetGroupId() string {
+func (x *GetStudentsByPhonesResponse) GetStudents() []*AbsDebtor {
 	if x != nil {
-		return x.GroupId
+		return x.Students
 	}
-	return ""
+	return nil
 }
 
-func (x *GetStudentsByGroupIdRequest) GetWithOutdated() bool {
-	if x != nil {
-		return x.WithOutdated
-	}
-	return false
+type SendDebtReminderRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StudentId     string                 `protobuf:"bytes,1,opt,name=studentId,proto3" json:"studentId,omitempty"`
+	ActionType    string                 `protobuf:"bytes,2,opt,name=actionType,proto3" json:"actionType,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SendDebtReminderRequest) Reset() {
+	*x = SendDebtReminderRequest{}
+	mi := &file_education_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SendDebtReminderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendDebtReminderRequest) ProtoMessage() {}
+
+func (x *SendDebtReminderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendDebtReminderRequest.ProtoReflect.Descriptor instead.
+func (*SendDebtReminderRequest) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *SendDebtReminderRequest) GetStudentId() string {
+	if x != nil {
+		return x.StudentId
+	}
+	return ""
+}
+
+func (x *SendDebtReminderRequest) GetActionType() string {
+	if x != nil {
+		return x.ActionType
+	}
+	return ""
+}
+
+type CalculateDiscountSummaRequest struct {
+	state                                        protoimpl.MessageState `protogen:"open.v1"`
+	GroupId                                      string                 `protobuf:"bytes,1,opt,name=groupId,proto3" json:"groupId,omitempty"`
+	StudentId                                    string                 `protobuf:"bytes,2,opt,name=studentId,proto3" json:"studentId,omitempty"`
+	DiscountPrice                                string                 `protobuf:"bytes,3,opt,name=discountPrice,proto3" json:"discountPrice,omitempty"`
+	StartDate                                    string                 `protobuf:"bytes,4,opt,name=startDate,proto3" json:"startDate,omitempty"`
+	EndDate                                      string                 `protobuf:"bytes,5,opt,name=endDate,proto3" json:"endDate,omitempty"`
+	PaymentDate                                  string                 `protobuf:"bytes,6,opt,name=paymentDate,proto3" json:"paymentDate,omitempty"`
+	StudentActivationDateInThisGroupWhilePayment string                 `protobuf:"bytes,7,opt,name=studentActivationDateInThisGroupWhilePayment,proto3" json:"studentActivationDateInThisGroupWhilePayment,omitempty"`
+	unknownFields                                protoimpl.UnknownFields
+	sizeCache                                    protoimpl.SizeCache
+}
+
+func (x *CalculateDiscountSummaRequest) Reset() {
+	*x = CalculateDiscountSummaRequest{}
+	mi := &file_education_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CalculateDiscountSummaRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CalculateDiscountSummaRequest) ProtoMessage() {}
+
+func (x *CalculateDiscountSummaRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CalculateDiscountSummaRequest.ProtoReflect.Descriptor instead.
+func (*CalculateDiscountSummaRequest) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *CalculateDiscountSummaRequest) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+func (x *CalculateDiscountSummaRequest) GetStudentId() string {
+	if x != nil {
+		return x.StudentId
+	}
+	return ""
+}
+
+func (x *CalculateDiscountSummaRequest) GetDiscountPrice() string {
+	if x != nil {
+		return x.DiscountPrice
+	}
+	return ""
+}
+
+func (x *CalculateDiscountSummaRequest) GetStartDate() string {
+	if x != nil {
+		return x.StartDate
+	}
+	return ""
+}
+
+func (x *CalculateDiscountSummaRequest) GetEndDate() string {
+	if x != nil {
+		return x.EndDate
+	}
+	return ""
+}
+
+func (x *CalculateDiscountSummaRequest) GetPaymentDate() string {
+	if x != nil {
+		return x.PaymentDate
+	}
+	return ""
+}
+
+func (x *CalculateDiscountSummaRequest) GetStudentActivationDateInThisGroupWhilePayment() string {
+	if x != nil {
+		return x.StudentActivationDateInThisGroupWhilePayment
+	}
+	return ""
+}
+
+type CalculateDiscountResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	CalculatedPrice string                 `protobuf:"bytes,1,opt,name=calculatedPrice,proto3" json:"calculatedPrice,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *CalculateDiscountResponse) Reset() {
+	*x = CalculateDiscountResponse{}
+	mi := &file_education_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CalculateDiscountResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CalculateDiscountResponse) ProtoMessage() {}
+
+func (x *CalculateDiscountResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CalculateDiscountResponse.ProtoReflect.Descriptor instead.
+func (*CalculateDiscountResponse) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *CalculateDiscountResponse) GetCalculatedPrice() string {
+	if x != nil {
+		return x.CalculatedPrice
+	}
+	return ""
+}
+
+type ChangeUserBalanceHistoryByDebitRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StudentId     string                 `protobuf:"bytes,1,opt,name=studentId,proto3" json:"studentId,omitempty"`
+	OldDebit      string                 `protobuf:"bytes,2,opt,name=oldDebit,proto3" json:"oldDebit,omitempty"`
+	GivenDate     string                 `protobuf:"bytes,3,opt,name=givenDate,proto3" json:"givenDate,omitempty"`
+	Comment       string                 `protobuf:"bytes,4,opt,name=comment,proto3" json:"comment,omitempty"`
+	PaymentType   string                 `protobuf:"bytes,5,opt,name=payment_type,json=paymentType,proto3" json:"payment_type,omitempty"`
+	CreatedBy     string                 `protobuf:"bytes,6,opt,name=createdBy,proto3" json:"createdBy,omitempty"`
+	CreatedByName string                 `protobuf:"bytes,7,opt,name=createdByName,proto3" json:"createdByName,omitempty"`
+	GroupId       string                 `protobuf:"bytes,8,opt,name=groupId,proto3" json:"groupId,omitempty"`
+	CurrentDebit  string                 `protobuf:"bytes,9,opt,name=currentDebit,proto3" json:"currentDebit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChangeUserBalanceHistoryByDebitRequest) Reset() {
+	*x = ChangeUserBalanceHistoryByDebitRequest{}
+	mi := &file_education_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChangeUserBalanceHistoryByDebitRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangeUserBalanceHistoryByDebitRequest) ProtoMessage() {}
+
+func (x *ChangeUserBalanceHistoryByDebitRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangeUserBalanceHistoryByDebitRequest.ProtoReflect.Descriptor instead.
+func (*ChangeUserBalanceHistoryByDebitRequest) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ChangeUserBalanceHistoryByDebitRequest) GetStudentId() string {
+	if x != nil {
+		return x.StudentId
+	}
+	return ""
+}
+
+func (x *ChangeUserBalanceHistoryByDebitRequest) GetOldDebit() string {
+	if x != nil {
+		return x.OldDebit
+	}
+	return ""
+}
+
+func (x *ChangeUserBalanceHistoryByDebitRequest) GetGivenDate() string {
+	if x != nil {
+		return x.GivenDate
+	}
+	return ""
+}
+
+func (x *ChangeUserBalanceHistoryByDebitRequest) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+func (x *ChangeUserBalanceHistoryByDebitRequest) GetPaymentType() string {
+	if x != nil {
+		return x.PaymentType
+	}
+	return ""
+}
+
+func (x *ChangeUserBalanceHistoryByDebitRequest) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+func (x *ChangeUserBalanceHistoryByDebitRequest) GetCreatedByName() string {
+	if x != nil {
+		return x.CreatedByName
+	}
+	return ""
+}
+
+func (x *ChangeUserBalanceHistoryByDebitRequest) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+func (x *ChangeUserBalanceHistoryByDebitRequest) GetCurrentDebit() string {
+	if x != nil {
+		return x.CurrentDebit
+	}
+	return ""
+}
+
+type ChangeUserBalanceHistoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StudentId     string                 `protobuf:"bytes,1,opt,name=studentId,proto3" json:"studentId,omitempty"`
+	Amount        string                 `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	GivenDate     string                 `protobuf:"bytes,3,opt,name=givenDate,proto3" json:"givenDate,omitempty"`
+	Comment       string                 `protobuf:"bytes,4,opt,name=comment,proto3" json:"comment,omitempty"`
+	PaymentType   string                 `protobuf:"bytes,5,opt,name=payment_type,json=paymentType,proto3" json:"payment_type,omitempty"`
+	CreatedBy     string                 `protobuf:"bytes,6,opt,name=createdBy,proto3" json:"createdBy,omitempty"`
+	CreatedByName string                 `protobuf:"bytes,7,opt,name=createdByName,proto3" json:"createdByName,omitempty"`
+	GroupId       string                 `protobuf:"bytes,8,opt,name=groupId,proto3" json:"groupId,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChangeUserBalanceHistoryRequest) Reset() {
+	*x = ChangeUserBalanceHistoryRequest{}
+	mi := &file_education_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChangeUserBalanceHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangeUserBalanceHistoryRequest) ProtoMessage() {}
+
+func (x *ChangeUserBalanceHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangeUserBalanceHistoryRequest.ProtoReflect.Descriptor instead.
+func (*ChangeUserBalanceHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ChangeUserBalanceHistoryRequest) GetStudentId() string {
+	if x != nil {
+		return x.StudentId
+	}
+	return ""
+}
+
+func (x *ChangeUserBalanceHistoryRequest) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *ChangeUserBalanceHistoryRequest) GetGivenDate() string {
+	if x != nil {
+		return x.GivenDate
+	}
+	return ""
+}
+
+func (x *ChangeUserBalanceHistoryRequest) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+func (x *ChangeUserBalanceHistoryRequest) GetPaymentType() string {
+	if x != nil {
+		return x.PaymentType
+	}
+	return ""
+}
+
+func (x *ChangeUserBalanceHistoryRequest) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+func (x *ChangeUserBalanceHistoryRequest) GetCreatedByName() string {
+	if x != nil {
+		return x.CreatedByName
+	}
+	return ""
+}
+
+func (x *ChangeUserBalanceHistoryRequest) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+type GetStudentsByGroupIdResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Students      []*AbsStudent          `protobuf:"bytes,1,rep,name=students,proto3" json:"students,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetStudentsByGroupIdResponse) Reset() {
+	*x = GetStudentsByGroupIdResponse{}
+	mi := &file_education_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetStudentsByGroupIdResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStudentsByGroupIdResponse) ProtoMessage() {}
+
+func (x *GetStudentsByGroupIdResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStudentsByGroupIdResponse.ProtoReflect.Descriptor instead.
+func (*GetStudentsByGroupIdResponse) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *GetStudentsByGroupIdResponse) GetStudents() []*AbsStudent {
+	if x != nil {
+		return x.Students
+	}
+	return nil
+}
+
+type GetStudentsByGroupIdRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GroupId       string                 `protobuf:"bytes,1,opt,name=groupId,proto3" json:"groupId,omitempty"`
+	WithOutdated  bool                   `protobuf:"varint,2,opt,name=withOutdated,proto3" json:"withOutdated,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetStudentsByGroupIdRequest) Reset() {
+	*x = GetStudentsByGroupIdRequest{}
+	mi := &file_education_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetStudentsByGroupIdRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStudentsByGroupIdRequest) ProtoMessage() {}
+
+func (x *GetStudentsByGroupIdRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStudentsByGroupIdRequest.ProtoReflect.Descriptor instead.
+func (*GetStudentsByGroupIdRequest) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *GetStudentsByGroupIdRequest) GetGroupId() string {
+	if x != nil {
+		return x.GroupId
+	}
+	return ""
+}
+
+func (x *GetStudentsByGroupIdRequest) GetWithOutdated() bool {
+	if x != nil {
+		return x.WithOutdated
+	}
+	return false
+}
+
+type AbsStudent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	PhoneNumber   string                 `protobuf:"bytes,3,opt,name=phoneNumber,proto3" json:"phoneNumber,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AbsStudent) Reset() {
+	*x = AbsStudent{}
+	mi := &file_education_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AbsStudent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AbsStudent) ProtoMessage() {}
+
+func (x *AbsStudent) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AbsStudent.ProtoReflect.Descriptor instead.
+func (*AbsStudent) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *AbsStudent) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *AbsStudent) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *AbsStudent) GetPhoneNumber() string {
+	if x != nil {
+		return x.PhoneNumber
+	}
+	return ""
+}
+
+type GetStudentByIdResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Gender        bool                   `protobuf:"varint,3,opt,name=gender,proto3" json:"gender,omitempty"`
+	DateOfBirth   string                 `protobuf:"bytes,4,opt,name=dateOfBirth,proto3" json:"dateOfBirth,omitempty"`
+	Phone         string                 `protobuf:"bytes,5,opt,name=phone,proto3" json:"phone,omitempty"`
+	Balance       float64                `protobuf:"fixed64,6,opt,name=balance,proto3" json:"balance,omitempty"`
+	CreatedAt     string                 `protobuf:"bytes,7,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	Groups        []*GetGroupStudent     `protobuf:"bytes,8,rep,name=groups,proto3" json:"groups,omitempty"`
+	Condition     string                 `protobuf:"bytes,9,opt,name=condition,proto3" json:"condition,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetStudentByIdResponse) Reset() {
+	*x = GetStudentByIdResponse{}
+	mi := &file_education_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetStudentByIdResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStudentByIdResponse) ProtoMessage() {}
+
+func (x *GetStudentByIdResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-type AbsStudent struct {
+// Deprecated: Use GetStudentByIdResponse.ProtoReflect.Descriptor instead.
+func (*GetStudentByIdResponse) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *GetStudentByIdResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *GetStudentByIdResponse) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *GetStudentByIdResponse) GetGender() bool {
+	if x != nil {
+		return x.Gender
+	}
+	return false
+}
+
+func (x *GetStudentByIdResponse) GetDateOfBirth() string {
+	if x != nil {
+		return x.DateOfBirth
+	}
+	return ""
+}
+
+func (x *GetStudentByIdResponse) GetPhone() string {
+	if x != nil {
+		return x.Phone
+	}
+	return ""
+}
+
+func (x *GetStudentByIdResponse) GetBalance() float64 {
+	if x != nil {
+		return x.Balance
+	}
+	return 0
+}
+
+func (x *GetStudentByIdResponse) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *GetStudentByIdResponse) GetGroups() []*GetGroupStudent {
+	if x != nil {
+		return x.Groups
+	}
+	return nil
+}
+
+func (x *GetStudentByIdResponse) GetCondition() string {
+	if x != nil {
+		return x.Condition
+	}
+	return ""
+}
+
+type NoteStudentByAbsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	PhoneNumber   string                 `protobuf:"bytes,3,opt,name=phoneNumber,proto3" json:"phoneNumber,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AbsStudent) Reset() {
-	*x = AbsStudent{}
-	mi := &file_education_proto_msgTypes[8]
+func (x *NoteStudentByAbsRequest) Reset() {
+	*x = NoteStudentByAbsRequest{}
+	mi := &file_education_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NoteStudentByAbsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NoteStudentByAbsRequest) ProtoMessage() {}
+
+func (x *NoteStudentByAbsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NoteStudentByAbsRequest.ProtoReflect.Descriptor instead.
+func (*NoteStudentByAbsRequest) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *NoteStudentByAbsRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type GetGroupStudent struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Id                 string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name               string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	DateType           string                 `protobuf:"bytes,3,opt,name=dateType,proto3" json:"dateType,omitempty"`
+	Days               []string               `protobuf:"bytes,4,rep,name=days,proto3" json:"days,omitempty"`
+	Room               *AbsRoom               `protobuf:"bytes,5,opt,name=room,proto3" json:"room,omitempty"`
+	Course             *AbsCourse             `protobuf:"bytes,6,opt,name=course,proto3" json:"course,omitempty"`
+	LessonStartTime    string                 `protobuf:"bytes,7,opt,name=lessonStartTime,proto3" json:"lessonStartTime,omitempty"`
+	GroupStartDate     string                 `protobuf:"bytes,8,opt,name=groupStartDate,proto3" json:"groupStartDate,omitempty"`
+	GroupEndDate       string                 `protobuf:"bytes,9,opt,name=groupEndDate,proto3" json:"groupEndDate,omitempty"`
+	StudentCondition   string                 `protobuf:"bytes,11,opt,name=studentCondition,proto3" json:"studentCondition,omitempty"`
+	StudentActivatedAt string                 `protobuf:"bytes,12,opt,name=studentActivatedAt,proto3" json:"studentActivatedAt,omitempty"`
+	StudentAddedAt     string                 `protobuf:"bytes,14,opt,name=studentAddedAt,proto3" json:"studentAddedAt,omitempty"`
+	TeacherName        string                 `protobuf:"bytes,15,opt,name=teacherName,proto3" json:"teacherName,omitempty"`
+	PriceForStudent    float64                `protobuf:"fixed64,13,opt,name=priceForStudent,proto3" json:"priceForStudent,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *GetGroupStudent) Reset() {
+	*x = GetGroupStudent{}
+	mi := &file_education_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetGroupStudent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGroupStudent) ProtoMessage() {}
+
+func (x *GetGroupStudent) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGroupStudent.ProtoReflect.Descriptor instead.
+func (*GetGroupStudent) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *GetGroupStudent) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *GetGroupStudent) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *GetGroupStudent) GetDateType() string {
+	if x != nil {
+		return x.DateType
+	}
+	return ""
+}
+
+func (x *GetGroupStudent) GetDays() []string {
+	if x != nil {
+		return x.Days
+	}
+	return nil
+}
+
+func (x *GetGroupStudent) GetRoom() *AbsRoom {
+	if x != nil {
+		return x.Room
+	}
+	return nil
+}
+
+func (x *GetGroupStudent) GetCourse() *AbsCourse {
+	if x != nil {
+		return x.Course
+	}
+	return nil
+}
+
+func (x *GetGroupStudent) GetLessonStartTime() string {
+	if x != nil {
+		return x.LessonStartTime
+	}
+	return ""
+}
+
+func (x *GetGroupStudent) GetGroupStartDate() string {
+	if x != nil {
+		return x.GroupStartDate
+	}
+	return ""
+}
+
+func (x *GetGroupStudent) GetGroupEndDate() string {
+	if x != nil {
+		return x.GroupEndDate
+	}
+	return ""
+}
+
+func (x *GetGroupStudent) GetStudentCondition() string {
+	if x != nil {
+		return x.StudentCondition
+	}
+	return ""
+}
+
+func (x *GetGroupStudent) GetStudentActivatedAt() string {
+	if x != nil {
+		return x.StudentActivatedAt
+	}
+	return ""
+}
+
+func (x *GetGroupStudent) GetStudentAddedAt() string {
+	if x != nil {
+		return x.StudentAddedAt
+	}
+	return ""
+}
+
+func (x *GetGroupStudent) GetTeacherName() string {
+	if x != nil {
+		return x.TeacherName
+	}
+	return ""
+}
+
+func (x *GetGroupStudent) GetPriceForStudent() float64 {
+	if x != nil {
+		return x.PriceForStudent
+	}
+	return 0
+}
+
+type StudentIdRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StudentId     string                 `protobuf:"bytes,1,opt,name=studentId,proto3" json:"studentId,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StudentIdRequest) Reset() {
+	*x = StudentIdRequest{}
+	mi := &file_education_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AbsStudent) String() string {
+func (x *StudentIdRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AbsStudent) ProtoMessage() {}
+func (*StudentIdRequest) ProtoMessage() {}
 
-func (x *AbsStudent) ProtoReflect() protoreflect.Message {
-	mi := &file_education_proto_msgTypes[8]
+func (x *StudentIdRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -639,62 +1423,92 @@ func (x *AbsStudent) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AbsStudent.ProtoReflect.Descriptor instead.
-func (*AbsStudent) Descriptor() ([]byte, []int) {
-	return file_education_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use StudentIdRequest.ProtoReflect.Descriptor instead.
+func (*StudentIdRequest) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{20}
 }
 
-func (x *AbsStudent) GetId() string {
+func (x *StudentIdRequest) GetStudentId() string {
 	if x != nil {
-		return x.Id
+		return x.StudentId
 	}
 	return ""
 }
 
-func (x *AbsStudent) GetName() string {
+type GetGroupsByStudentResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Comments      []*DebtorComment       `protobuf:"bytes,1,rep,name=comments,proto3" json:"comments,omitempty"`
+	Groups        []*DebtorGroup         `protobuf:"bytes,2,rep,name=groups,proto3" json:"groups,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetGroupsByStudentResponse) Reset() {
+	*x = GetGroupsByStudentResponse{}
+	mi := &file_education_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetGroupsByStudentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGroupsByStudentResponse) ProtoMessage() {}
+
+func (x *GetGroupsByStudentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[21]
 	if x != nil {
-		return x.Name
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *AbsStudent) GetPhoneNumber() string {
+// Deprecated: Use GetGroupsByStudentResponse.ProtoReflect.Descriptor instead.
+func (*GetGroupsByStudentResponse) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *GetGroupsByStudentResponse) GetComments() []*DebtorComment {
 	if x != nil {
-		return x.PhoneNumber
+		return x.Comments
 	}
-	return ""
+	return nil
 }
 
-type GetStudentByIdResponse struct {
+func (x *GetGroupsByStudentResponse) GetGroups() []*DebtorGroup {
+	if x != nil {
+		return x.Groups
+	}
+	return nil
+}
+
+type GetGroupByIdRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Gender        bool                   `protobuf:"varint,3,opt,name=gender,proto3" json:"gender,omitempty"`
-	DateOfBirth   string                 `protobuf:"bytes,4,opt,name=dateOfBirth,proto3" json:"dateOfBirth,omitempty"`
-	Phone         string                 `protobuf:"bytes,5,opt,name=phone,proto3" json:"phone,omitempty"`
-	Balance       float64                `protobuf:"fixed64,6,opt,name=balance,proto3" json:"balance,omitempty"`
-	CreatedAt     string                 `protobuf:"bytes,7,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
-	Groups        []*GetGroupStudent     `protobuf:"bytes,8,rep,name=groups,proto3" json:"groups,omitempty"`
-	Condition     string                 `protobuf:"bytes,9,opt,name=condition,proto3" json:"condition,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetStudentByIdResponse) Reset() {
-	*x = GetStudentByIdResponse{}
-	mi := &file_education_proto_msgTypes[9]
+func (x *GetGroupByIdRequest) Reset() {
+	*x = GetGroupByIdRequest{}
+	mi := &file_education_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetStudentByIdResponse) String() string {
+func (x *GetGroupByIdRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetStudentByIdResponse) ProtoMessage() {}
+func (*GetGroupByIdRequest) ProtoMessage() {}
 
-func (x *GetStudentByIdResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_education_proto_msgTypes[9]
+func (x *GetGroupByIdRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -705,96 +1519,241 @@ func (x *GetStudentByIdResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetStudentByIdResponse.ProtoReflect.Descriptor instead.
-func (*GetStudentByIdResponse) Descriptor() ([]byte, []int) {
-	return file_education_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use GetGroupByIdRequest.ProtoReflect.Descriptor instead.
+func (*GetGroupByIdRequest) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{22}
 }
 
-func (x *GetStudentByIdResponse) GetId() string {
+func (x *GetGroupByIdRequest) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-func (x *GetStudentByIdResponse) GetName() string {
+type GetGroupAbsResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	TeacherName     string                 `protobuf:"bytes,3,opt,name=teacherName,proto3" json:"teacherName,omitempty"`
+	TeacherId       string                 `protobuf:"bytes,14,opt,name=teacherId,proto3" json:"teacherId,omitempty"`
+	LessonStartTime string                 `protobuf:"bytes,4,opt,name=lessonStartTime,proto3" json:"lessonStartTime,omitempty"`
+	StudentCount    int32                  `protobuf:"varint,6,opt,name=studentCount,proto3" json:"studentCount,omitempty"`
+	Name            string                 `protobuf:"bytes,7,opt,name=name,proto3" json:"name,omitempty"`
+	IsArchived      bool                   `protobuf:"varint,8,opt,name=isArchived,proto3" json:"isArchived,omitempty"`
+	CreatedAt       string                 `protobuf:"bytes,9,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	StartDate       string                 `protobuf:"bytes,10,opt,name=startDate,proto3" json:"startDate,omitempty"`
+	EndDate         string                 `protobuf:"bytes,11,opt,name=endDate,proto3" json:"endDate,omitempty"`
+	Days            []string               `protobuf:"bytes,12,rep,name=days,proto3" json:"days,omitempty"`
+	DateType        string                 `protobuf:"bytes,13,opt,name=dateType,proto3" json:"dateType,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *GetGroupAbsResponse) Reset() {
+	*x = GetGroupAbsResponse{}
+	mi := &file_education_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetGroupAbsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGroupAbsResponse) ProtoMessage() {}
+
+func (x *GetGroupAbsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[23]
 	if x != nil {
-		return x.Name
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGroupAbsResponse.ProtoReflect.Descriptor instead.
+func (*GetGroupAbsResponse) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *GetGroupAbsResponse) GetId() string {
+	if x != nil {
+		return x.Id
 	}
 	return ""
 }
 
-func (x *GetStudentByIdResponse) GetGender() bool {
+func (x *GetGroupAbsResponse) GetTeacherName() string {
 	if x != nil {
-		return x.Gender
+		return x.TeacherName
 	}
-	return false
+	return ""
 }
 
-func (x *GetStudentByIdResponse) GetDateOfBirth() string {
+func (x *GetGroupAbsResponse) GetTeacherId() string {
 	if x != nil {
-		return x.DateOfBirth
+		return x.TeacherId
 	}
 	return ""
 }
 
-func (x *GetStudentByIdResponse) GetPhone() string {
+func (x *GetGroupAbsResponse) GetLessonStartTime() string {
 	if x != nil {
-		return x.Phone
+		return x.LessonStartTime
 	}
 	return ""
 }
 
-func (x *GetStudentByIdResponse) GetBalance() float64 {
+func (x *GetGroupAbsResponse) GetStudentCount() int32 {
 	if x != nil {
-		return x.Balance
+		return x.StudentCount
 	}
 	return 0
 }
 
-func (x *GetStudentByIdResponse) GetCreatedAt() string {
+func (x *GetGroupAbsResponse) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *GetGroupAbsResponse) GetIsArchived() bool {
+	if x != nil {
+		return x.IsArchived
+	}
+	return false
+}
+
+func (x *GetGroupAbsResponse) GetCreatedAt() string {
 	if x != nil {
 		return x.CreatedAt
 	}
 	return ""
 }
 
-func (x *GetStudentByIdResponse) GetGroups() []*GetGroupStudent {
+func (x *GetGroupAbsResponse) GetStartDate() string {
 	if x != nil {
-		return x.Groups
+		return x.StartDate
+	}
+	return ""
+}
+
+func (x *GetGroupAbsResponse) GetEndDate() string {
+	if x != nil {
+		return x.EndDate
+	}
+	return ""
+}
+
+func (x *GetGroupAbsResponse) GetDays() []string {
+	if x != nil {
+		return x.Days
 	}
 	return nil
 }
 
-func (x *GetStudentByIdResponse) GetCondition() string {
+func (x *GetGroupAbsResponse) GetDateType() string {
 	if x != nil {
-		return x.Condition
+		return x.DateType
 	}
 	return ""
 }
 
-type NoteStudentByAbsRequest struct {
+type CalculateTeacherSalaryRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	From      string                 `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	To        string                 `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+	TeacherId string                 `protobuf:"bytes,3,opt,name=teacherId,proto3" json:"teacherId,omitempty"`
+	// calculates several teachers in one call, teacherId is ignored when set
+	TeacherIds    []string `protobuf:"bytes,4,rep,name=teacherIds,proto3" json:"teacherIds,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CalculateTeacherSalaryRequest) Reset() {
+	*x = CalculateTeacherSalaryRequest{}
+	mi := &file_education_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CalculateTeacherSalaryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CalculateTeacherSalaryRequest) ProtoMessage() {}
+
+func (x *CalculateTeacherSalaryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CalculateTeacherSalaryRequest.ProtoReflect.Descriptor instead.
+func (*CalculateTeacherSalaryRequest) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *CalculateTeacherSalaryRequest) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *CalculateTeacherSalaryRequest) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+func (x *CalculateTeacherSalaryRequest) GetTeacherId() string {
+	if x != nil {
+		return x.TeacherId
+	}
+	return ""
+}
+
+func (x *CalculateTeacherSalaryRequest) GetTeacherIds() []string {
+	if x != nil {
+		return x.TeacherIds
+	}
+	return nil
+}
+
+type CalculateTeacherSalaryResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Salaries      []*AbsCalculateSalary  `protobuf:"bytes,1,rep,name=salaries,proto3" json:"salaries,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *NoteStudentByAbsRequest) Reset() {
-	*x = NoteStudentByAbsRequest{}
-	mi := &file_education_proto_msgTypes[10]
+func (x *CalculateTeacherSalaryResponse) Reset() {
+	*x = CalculateTeacherSalaryResponse{}
+	mi := &file_education_proto_msgTypes[25]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *NoteStudentByAbsRequest) String() string {
+func (x *CalculateTeacherSalaryResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*NoteStudentByAbsRequest) ProtoMessage() {}
+func (*CalculateTeacherSalaryResponse) ProtoMessage() {}
 
-func (x *NoteStudentByAbsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_education_proto_msgTypes[10]
+func (x *CalculateTeacherSalaryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[25]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -805,53 +1764,44 @@ func (x *NoteStudentByAbsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use NoteStudentByAbsRequest.ProtoReflect.Descriptor instead.
-func (*NoteStudentByAbsRequest) Descriptor() ([]byte, []int) {
-	return file_education_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use CalculateTeacherSalaryResponse.ProtoReflect.Descriptor instead.
+func (*CalculateTeacherSalaryResponse) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{25}
 }
 
-func (x *NoteStudentByAbsRequest) GetId() string {
+func (x *CalculateTeacherSalaryResponse) GetSalaries() []*AbsCalculateSalary {
 	if x != nil {
-		return x.Id
+		return x.Salaries
 	}
-	return ""
+	return nil
 }
 
-type GetGroupStudent struct {
-	state              protoimpl.MessageState `protogen:"open.v1"`
-	Id                 string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name               string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	DateType           string                 `protobuf:"bytes,3,opt,name=dateType,proto3" json:"dateType,omitempty"`
-	Days               []string               `protobuf:"bytes,4,rep,name=days,proto3" json:"days,omitempty"`
-	Room               *AbsRoom               `protobuf:"bytes,5,opt,name=room,proto3" json:"room,omitempty"`
-	Course             *AbsCourse             `protobuf:"bytes,6,opt,name=course,proto3" json:"course,omitempty"`
-	LessonStartTime    string                 `protobuf:"bytes,7,opt,name=lessonStartTime,proto3" json:"lessonStartTime,omitempty"`
-	GroupStartDate     string                 `protobuf:"bytes,8,opt,name=groupStartDate,proto3" json:"groupStartDate,omitempty"`
-	GroupEndDate       string                 `protobuf:"bytes,9,opt,name=groupEndDate,proto3" json:"groupEndDate,omitempty"`
-	StudentCondition   string                 `protobuf:"bytes,11,opt,name=studentCondition,proto3" json:"studentCondition,omitempty"`
-	StudentActivatedAt string                 `protobuf:"bytes,12,opt,name=studentActivatedAt,proto3" json:"studentActivatedAt,omitempty"`
-	StudentAddedAt     string                 `protobuf:"bytes,14,opt,name=studentAddedAt,proto3" json:"studentAddedAt,omitempty"`
-	TeacherName        string                 `protobuf:"bytes,15,opt,name=teacherName,proto3" json:"teacherName,omitempty"`
-	PriceForStudent    float64                `protobuf:"fixed64,13,opt,name=priceForStudent,proto3" json:"priceForStudent,omitempty"`
-	unknownFields      protoimpl.UnknownFields
-	sizeCache          protoimpl.SizeCache
+type AbsCalculateSalary struct {
+	state                     protoimpl.MessageState `protogen:"open.v1"`
+	GroupId                   string                 `protobuf:"bytes,1,opt,name=groupId,proto3" json:"groupId,omitempty"`
+	GroupName                 string                 `protobuf:"bytes,2,opt,name=groupName,proto3" json:"groupName,omitempty"`
+	CommonLessonCountInPeriod int32                  `protobuf:"varint,3,opt,name=commonLessonCountInPeriod,proto3" json:"commonLessonCountInPeriod,omitempty"`
+	Salaries                  []*StudentSalary       `protobuf:"bytes,4,rep,name=salaries,proto3" json:"salaries,omitempty"`
+	TeacherId                 string                 `protobuf:"bytes,5,opt,name=teacherId,proto3" json:"teacherId,omitempty"`
+	unknownFields             protoimpl.UnknownFields
+	sizeCache                 protoimpl.SizeCache
 }
 
-func (x *GetGroupStudent) Reset() {
-	*x = GetGroupStudent{}
-	mi := &file_education_proto_msgTypes[11]
+func (x *AbsCalculateSalary) Reset() {
+	*x = AbsCalculateSalary{}
+	mi := &file_education_proto_msgTypes[26]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetGroupStudent) String() string {
+func (x *AbsCalculateSalary) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetGroupStudent) ProtoMessage() {}
+func (*AbsCalculateSalary) ProtoMessage() {}
 
-func (x *GetGroupStudent) ProtoReflect() protoreflect.Message {
-	mi := &file_education_proto_msgTypes[11]
+func (x *AbsCalculateSalary) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[26]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -862,131 +1812,161 @@ func (x *GetGroupStudent) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetGroupStudent.ProtoReflect.Descriptor instead.
-func (*GetGroupStudent) Descriptor() ([]byte, []int) {
-	return file_education_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use AbsCalculateSalary.ProtoReflect.Descriptor instead.
+func (*AbsCalculateSalary) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{26}
 }
 
-func (x *GetGroupStudent) GetId() string {
+func (x *AbsCalculateSalary) GetGroupId() string {
 	if x != nil {
-		return x.Id
+		return x.GroupId
 	}
 	return ""
 }
 
-func (x *GetGroupStudent) GetName() string {
+func (x *AbsCalculateSalary) GetGroupName() string {
 	if x != nil {
-		return x.Name
+		return x.GroupName
 	}
 	return ""
 }
 
-func (x *GetGroupStudent) GetDateType() string {
+func (x *AbsCalculateSalary) GetCommonLessonCountInPeriod() int32 {
 	if x != nil {
-		return x.DateType
+		return x.CommonLessonCountInPeriod
 	}
-	return ""
+	return 0
 }
 
-func (x *GetGroupStudent) GetDays() []string {
+func (x *AbsCalculateSalary) GetSalaries() []*StudentSalary {
 	if x != nil {
-		return x.Days
+		return x.Salaries
 	}
 	return nil
 }
 
-func (x *GetGroupStudent) GetRoom() *AbsRoom {
+func (x *AbsCalculateSalary) GetTeacherId() string {
 	if x != nil {
-		return x.Room
+		return x.TeacherId
 	}
-	return nil
+	return ""
 }
 
-func (x *GetGroupStudent) GetCourse() *AbsCourse {
-	if x != nil {
-		return x.Course
-	}
-	return nil
+type StudentSalary struct {
+	state                    protoimpl.MessageState `protogen:"open.v1"`
+	StudentId                string                 `protobuf:"bytes,1,opt,name=studentId,proto3" json:"studentId,omitempty"`
+	StudentName              string                 `protobuf:"bytes,2,opt,name=studentName,proto3" json:"studentName,omitempty"`
+	PassedLessonCount        int32                  `protobuf:"varint,3,opt,name=passedLessonCount,proto3" json:"passedLessonCount,omitempty"`
+	CalculatedSalaryInPeriod int32                  `protobuf:"varint,4,opt,name=calculatedSalaryInPeriod,proto3" json:"calculatedSalaryInPeriod,omitempty"`
+	PriceType                string                 `protobuf:"bytes,5,opt,name=priceType,proto3" json:"priceType,omitempty"`
+	TotalCount               float64                `protobuf:"fixed64,6,opt,name=totalCount,proto3" json:"totalCount,omitempty"`
+	CoursePrice              float64                `protobuf:"fixed64,7,opt,name=coursePrice,proto3" json:"coursePrice,omitempty"`
+	unknownFields            protoimpl.UnknownFields
+	sizeCache                protoimpl.SizeCache
 }
 
-func (x *GetGroupStudent) GetLessonStartTime() string {
+func (x *StudentSalary) Reset() {
+	*x = StudentSalary{}
+	mi := &file_education_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StudentSalary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StudentSalary) ProtoMessage() {}
+
+func (x *StudentSalary) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[27]
 	if x != nil {
-		return x.LessonStartTime
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *GetGroupStudent) GetGroupStartDate() string {
+// Deprecated: Use StudentSalary.ProtoReflect.Descriptor instead.
+func (*StudentSalary) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *StudentSalary) GetStudentId() string {
 	if x != nil {
-		return x.GroupStartDate
+		return x.StudentId
 	}
 	return ""
 }
 
-func (x *GetGroupStudent) GetGroupEndDate() string {
+func (x *StudentSalary) GetStudentName() string {
 	if x != nil {
-		return x.GroupEndDate
+		return x.StudentName
 	}
 	return ""
 }
 
-func (x *GetGroupStudent) GetStudentCondition() string {
+func (x *StudentSalary) GetPassedLessonCount() int32 {
 	if x != nil {
-		return x.StudentCondition
+		return x.PassedLessonCount
 	}
-	return ""
+	return 0
 }
 
-func (x *GetGroupStudent) GetStudentActivatedAt() string {
+func (x *StudentSalary) GetCalculatedSalaryInPeriod() int32 {
 	if x != nil {
-		return x.StudentActivatedAt
+		return x.CalculatedSalaryInPeriod
 	}
-	return ""
+	return 0
 }
 
-func (x *GetGroupStudent) GetStudentAddedAt() string {
+func (x *StudentSalary) GetPriceType() string {
 	if x != nil {
-		return x.StudentAddedAt
+		return x.PriceType
 	}
 	return ""
 }
 
-func (x *GetGroupStudent) GetTeacherName() string {
+func (x *StudentSalary) GetTotalCount() float64 {
 	if x != nil {
-		return x.TeacherName
+		return x.TotalCount
 	}
-	return ""
+	return 0
 }
 
-func (x *GetGroupStudent) GetPriceForStudent() float64 {
+func (x *StudentSalary) GetCoursePrice() float64 {
 	if x != nil {
-		return x.PriceForStudent
+		return x.CoursePrice
 	}
 	return 0
 }
 
-type StudentIdRequest struct {
+// attendance service end
+type GetGroupsByIdsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	StudentId     string                 `protobuf:"bytes,1,opt,name=studentId,proto3" json:"studentId,omitempty"`
+	Ids           []string               `protobuf:"bytes,1,rep,name=ids,proto3" json:"ids,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *StudentIdRequest) Reset() {
-	*x = StudentIdRequest{}
-	mi := &file_education_proto_msgTypes[12]
+func (x *GetGroupsByIdsRequest) Reset() {
+	*x = GetGroupsByIdsRequest{}
+	mi := &file_education_proto_msgTypes[28]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *StudentIdRequest) String() string {
+func (x *GetGroupsByIdsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StudentIdRequest) ProtoMessage() {}
+func (*GetGroupsByIdsRequest) ProtoMessage() {}
 
-func (x *StudentIdRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_education_proto_msgTypes[12]
+func (x *GetGroupsByIdsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[28]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -997,41 +1977,40 @@ func (x *StudentIdRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StudentIdRequest.ProtoReflect.Descriptor instead.
-func (*StudentIdRequest) Descriptor() ([]byte, []int) {
-	return file_education_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use GetGroupsByIdsRequest.ProtoReflect.Descriptor instead.
+func (*GetGroupsByIdsRequest) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{28}
 }
 
-func (x *StudentIdRequest) GetStudentId() string {
+func (x *GetGroupsByIdsRequest) GetIds() []string {
 	if x != nil {
-		return x.StudentId
+		return x.Ids
 	}
-	return ""
+	return nil
 }
 
-type GetGroupsByStudentResponse struct {
+type GetGroupsByIdsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Comments      []*DebtorComment       `protobuf:"bytes,1,rep,name=comments,proto3" json:"comments,omitempty"`
-	Groups        []*DebtorGroup         `protobuf:"bytes,2,rep,name=groups,proto3" json:"groups,omitempty"`
+	Groups        []*GroupMeta           `protobuf:"bytes,1,rep,name=groups,proto3" json:"groups,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetGroupsByStudentResponse) Reset() {
-	*x = GetGroupsByStudentResponse{}
-	mi := &file_education_proto_msgTypes[13]
+func (x *GetGroupsByIdsResponse) Reset() {
+	*x = GetGroupsByIdsResponse{}
+	mi := &file_education_proto_msgTypes[29]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetGroupsByStudentResponse) String() string {
+func (x *GetGroupsByIdsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetGroupsByStudentResponse) ProtoMessage() {}
+func (*GetGroupsByIdsResponse) ProtoMessage() {}
 
-func (x *GetGroupsByStudentResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_education_proto_msgTypes[13]
+func (x *GetGroupsByIdsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[29]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1042,47 +2021,45 @@ func (x *GetGroupsByStudentResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetGroupsByStudentResponse.ProtoReflect.Descriptor instead.
-func (*GetGroupsByStudentResponse) Descriptor() ([]byte, []int) {
-	return file_education_proto_rawDescGZIP(), []int{13}
-}
-
-func (x *GetGroupsByStudentResponse) GetComments() []*DebtorComment {
-	if x != nil {
-		return x.Comments
-	}
-	return nil
+// Deprecated: Use GetGroupsByIdsResponse.ProtoReflect.Descriptor instead.
+func (*GetGroupsByIdsResponse) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{29}
 }
 
-func (x *GetGroupsByStudentResponse) GetGroups() []*DebtorGroup {
+func (x *GetGroupsByIdsResponse) GetGroups() []*GroupMeta {
 	if x != nil {
 		return x.Groups
 	}
 	return nil
 }
 
-type GetGroupByIdRequest struct {
+type GroupMeta struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	CourseId      string                 `protobuf:"bytes,3,opt,name=courseId,proto3" json:"courseId,omitempty"`
+	CourseName    string                 `protobuf:"bytes,4,opt,name=courseName,proto3" json:"courseName,omitempty"`
+	TeacherId     string                 `protobuf:"bytes,5,opt,name=teacherId,proto3" json:"teacherId,omitempty"`
+	TeacherName   string                 `protobuf:"bytes,6,opt,name=teacherName,proto3" json:"teacherName,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetGroupByIdRequest) Reset() {
-	*x = GetGroupByIdRequest{}
-	mi := &file_education_proto_msgTypes[14]
+func (x *GroupMeta) Reset() {
+	*x = GroupMeta{}
+	mi := &file_education_proto_msgTypes[30]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetGroupByIdRequest) String() string {
+func (x *GroupMeta) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetGroupByIdRequest) ProtoMessage() {}
+func (*GroupMeta) ProtoMessage() {}
 
-func (x *GetGroupByIdRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_education_proto_msgTypes[14]
+func (x *GroupMeta) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[30]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1093,146 +2070,101 @@ func (x *GetGroupByIdRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetGroupByIdRequest.ProtoReflect.Descriptor instead.
-func (*GetGroupByIdRequest) Descriptor() ([]byte, []int) {
-	return file_education_proto_rawDescGZIP(), []int{14}
+// Deprecated: Use GroupMeta.ProtoReflect.Descriptor instead.
+func (*GroupMeta) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{30}
 }
 
-func (x *GetGroupByIdRequest) GetId() string {
+func (x *GroupMeta) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-type GetGroupAbsResponse struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	Id              string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	TeacherName     string                 `protobuf:"bytes,3,opt,name=teacherName,proto3" json:"teacherName,omitempty"`
-	TeacherId       string                 `protobuf:"bytes,14,opt,name=teacherId,proto3" json:"teacherId,omitempty"`
-	LessonStartTime string                 `protobuf:"bytes,4,opt,name=lessonStartTime,proto3" json:"lessonStartTime,omitempty"`
-	StudentCount    int32                  `protobuf:"varint,6,opt,name=studentCount,proto3" json:"studentCount,omitempty"`
-	Name            string                 `protobuf:"bytes,7,opt,name=name,proto3" json:"name,omitempty"`
-	IsArchived      bool                   `protobuf:"varint,8,opt,name=isArchived,proto3" json:"isArchived,omitempty"`
-	CreatedAt       string                 `protobuf:"bytes,9,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
-	StartDate       string                 `protobuf:"bytes,10,opt,name=startDate,proto3" json:"startDate,omitempty"`
-	EndDate         string                 `protobuf:"bytes,11,opt,name=endDate,proto3" json:"endDate,omitempty"`
-	Days            []string               `protobuf:"bytes,12,rep,name=days,proto3" json:"days,omitempty"`
-	DateType        string                 `protobuf:"bytes,13,opt,name=dateType,proto3" json:"dateType,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
-}
-
-func (x *GetGroupAbsResponse) Reset() {
-	*x = GetGroupAbsResponse{}
-	mi := &file_education_proto_msgTypes[15]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *GetGroupAbsResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*GetGroupAbsResponse) ProtoMessage() {}
-
-func (x *GetGroupAbsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_education_proto_msgTypes[15]
+func (x *GroupMeta) GetName() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Name
 	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use GetGroupAbsResponse.ProtoReflect.Descriptor instead.
-func (*GetGroupAbsResponse) Descriptor() ([]byte, []int) {
-	return file_education_proto_rawDescGZIP(), []int{15}
+	return ""
 }
 
-func (x *GetGroupAbsResponse) GetId() string {
+func (x *GroupMeta) GetCourseId() string {
 	if x != nil {
-		return x.Id
+		return x.CourseId
 	}
 	return ""
 }
 
-func (x *GetGroupAbsResponse) GetTeacherName() string {
+func (x *GroupMeta) GetCourseName() string {
 	if x != nil {
-		return x.TeacherName
+		return x.CourseName
 	}
 	return ""
 }
 
-func (x *GetGroupAbsResponse) GetTeacherId() string {
+func (x *GroupMeta) GetTeacherId() string {
 	if x != nil {
 		return x.TeacherId
 	}
 	return ""
 }
 
-func (x *GetGroupAbsResponse) GetLessonStartTime() string {
+func (x *GroupMeta) GetTeacherName() string {
 	if x != nil {
-		return x.LessonStartTime
+		return x.TeacherName
 	}
 	return ""
 }
 
-func (x *GetGroupAbsResponse) GetStudentCount() int32 {
-	if x != nil {
-		return x.StudentCount
-	}
-	return 0
+type SendStaffSmsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Phones        []string               `protobuf:"bytes,1,rep,name=phones,proto3" json:"phones,omitempty"`
+	SmsValue      string                 `protobuf:"bytes,2,opt,name=smsValue,proto3" json:"smsValue,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetGroupAbsResponse) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
+func (x *SendStaffSmsRequest) Reset() {
+	*x = SendStaffSmsRequest{}
+	mi := &file_education_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetGroupAbsResponse) GetIsArchived() bool {
-	if x != nil {
-		return x.IsArchived
-	}
-	return false
+func (x *SendStaffSmsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *GetGroupAbsResponse) GetCreatedAt() string {
-	if x != nil {
-		return x.CreatedAt
-	}
-	return ""
-}
+func (*SendStaffSmsRequest) ProtoMessage() {}
 
-func (x *GetGroupAbsResponse) GetStartDate() string {
+func (x *SendStaffSmsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_education_proto_msgTypes[31]
 	if x != nil {
-		return x.StartDate
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *GetGroupAbsResponse) GetEndDate() string {
-	if x != nil {
-		return x.EndDate
-	}
-	return ""
+// Deprecated: Use SendStaffSmsRequest.ProtoReflect.Descriptor instead.
+func (*SendStaffSmsRequest) Descriptor() ([]byte, []int) {
+	return file_education_proto_rawDescGZIP(), []int{31}
 }
 
-func (x *GetGroupAbsResponse) GetDays() []string {
+func (x *SendStaffSmsRequest) GetPhones() []string {
 	if x != nil {
-		return x.Days
+		return x.Phones
 	}
 	return nil
 }
 
-func (x *GetGroupAbsResponse) GetDateType() string {
+func (x *SendStaffSmsRequest) GetSmsValue() string {
 	if x != nil {
-		return x.DateType
+		return x.SmsValue
 	}
 	return ""
 }
@@ -1252,7 +2184,34 @@ const file_education_proto_rawDesc = "" +
 	"\x0elessonDuration\x18\x02 \x01(\x05R\x0elessonDuration\x12&\n" +
 	"\x0ecourseDuration\x18\x03 \x01(\x05R\x0ecourseDuration\x12\x14\n" +
 	"\x05price\x18\x04 \x01(\x01R\x05price\x12 \n" +
-	"\vdescription\x18\x05 \x01(\tR\vdescription\"\xbb\x02\n" +
+	"\vdescription\x18\x05 \x01(\tR\vdescription\"\x13\n" +
+	"\x11GetDebtorsRequest\"D\n" +
+	"\x12GetDebtorsResponse\x12.\n" +
+	"\adebtors\x18\x01 \x03(\v2\x14.education.AbsDebtorR\adebtors\"\xa1\x01\n" +
+	"\tAbsDebtor\x12\x1c\n" +
+	"\tstudentId\x18\x01 \x01(\tR\tstudentId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x14\n" +
+	"\x05phone\x18\x03 \x01(\tR\x05phone\x12\x18\n" +
+	"\abalance\x18\x04 \x01(\x01R\abalance\x122\n" +
+	"\x14zeroBalanceUpdatedAt\x18\x05 \x01(\tR\x14zeroBalanceUpdatedAt\"S\n" +
+	"\x19GetDiscountContextRequest\x12\x1c\n" +
+	"\tstudentId\x18\x01 \x01(\tR\tstudentId\x12\x18\n" +
+	"\agroupId\x18\x02 \x01(\tR\agroupId\"\xc4\x01\n" +
+	"\x1aGetDiscountContextResponse\x12 \n" +
+	"\vcoursePrice\x18\x01 \x01(\x01R\vcoursePrice\x12\x1a\n" +
+	"\bcourseId\x18\x02 \x01(\tR\bcourseId\x12\"\n" +
+	"\fgroupEndDate\x18\x03 \x01(\tR\fgroupEndDate\x12 \n" +
+	"\vcourseCount\x18\x04 \x01(\x05R\vcourseCount\x12\"\n" +
+	"\fsiblingCount\x18\x05 \x01(\x05R\fsiblingCount\"4\n" +
+	"\x1aGetStudentsByPhonesRequest\x12\x16\n" +
+	"\x06phones\x18\x01 \x03(\tR\x06phones\"O\n" +
+	"\x1bGetStudentsByPhonesResponse\x120\n" +
+	"\bstudents\x18\x01 \x03(\v2\x14.education.AbsDebtorR\bstudents\"W\n" +
+	"\x17SendDebtReminderRequest\x12\x1c\n" +
+	"\tstudentId\x18\x01 \x01(\tR\tstudentId\x12\x1e\n" +
+	"\n" +
+	"actionType\x18\x02 \x01(\tR\n" +
+	"actionType\"\xbb\x02\n" +
 	"\x1dCalculateDiscountSummaRequest\x12\x18\n" +
 	"\agroupId\x18\x01 \x01(\tR\agroupId\x12\x1c\n" +
 	"\tstudentId\x18\x02 \x01(\tR\tstudentId\x12$\n" +
@@ -1341,16 +2300,68 @@ const file_education_proto_rawDesc = "" +
 	" \x01(\tR\tstartDate\x12\x18\n" +
 	"\aendDate\x18\v \x01(\tR\aendDate\x12\x12\n" +
 	"\x04days\x18\f \x03(\tR\x04days\x12\x1a\n" +
-	"\bdateType\x18\r \x01(\tR\bdateType2\x84\x04\n" +
+	"\bdateType\x18\r \x01(\tR\bdateType\"\x81\x01\n" +
+	"\x1dCalculateTeacherSalaryRequest\x12\x12\n" +
+	"\x04from\x18\x01 \x01(\tR\x04from\x12\x0e\n" +
+	"\x02to\x18\x02 \x01(\tR\x02to\x12\x1c\n" +
+	"\tteacherId\x18\x03 \x01(\tR\tteacherId\x12\x1e\n" +
+	"\n" +
+	"teacherIds\x18\x04 \x03(\tR\n" +
+	"teacherIds\"[\n" +
+	"\x1eCalculateTeacherSalaryResponse\x129\n" +
+	"\bsalaries\x18\x01 \x03(\v2\x1d.education.AbsCalculateSalaryR\bsalaries\"\xde\x01\n" +
+	"\x12AbsCalculateSalary\x12\x18\n" +
+	"\agroupId\x18\x01 \x01(\tR\agroupId\x12\x1c\n" +
+	"\tgroupName\x18\x02 \x01(\tR\tgroupName\x12<\n" +
+	"\x19commonLessonCountInPeriod\x18\x03 \x01(\x05R\x19commonLessonCountInPeriod\x124\n" +
+	"\bsalaries\x18\x04 \x03(\v2\x18.education.StudentSalaryR\bsalaries\x12\x1c\n" +
+	"\tteacherId\x18\x05 \x01(\tR\tteacherId\"\x99\x02\n" +
+	"\rStudentSalary\x12\x1c\n" +
+	"\tstudentId\x18\x01 \x01(\tR\tstudentId\x12 \n" +
+	"\vstudentName\x18\x02 \x01(\tR\vstudentName\x12,\n" +
+	"\x11passedLessonCount\x18\x03 \x01(\x05R\x11passedLessonCount\x12:\n" +
+	"\x18calculatedSalaryInPeriod\x18\x04 \x01(\x05R\x18calculatedSalaryInPeriod\x12\x1c\n" +
+	"\tpriceType\x18\x05 \x01(\tR\tpriceType\x12\x1e\n" +
+	"\n" +
+	"totalCount\x18\x06 \x01(\x01R\n" +
+	"totalCount\x12 \n" +
+	"\vcoursePrice\x18\a \x01(\x01R\vcoursePrice\")\n" +
+	"\x15GetGroupsByIdsRequest\x12\x10\n" +
+	"\x03ids\x18\x01 \x03(\tR\x03ids\"F\n" +
+	"\x16GetGroupsByIdsResponse\x12,\n" +
+	"\x06groups\x18\x01 \x03(\v2\x14.education.GroupMetaR\x06groups\"\xab\x01\n" +
+	"\tGroupMeta\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1a\n" +
+	"\bcourseId\x18\x03 \x01(\tR\bcourseId\x12\x1e\n" +
+	"\n" +
+	"courseName\x18\x04 \x01(\tR\n" +
+	"courseName\x12\x1c\n" +
+	"\tteacherId\x18\x05 \x01(\tR\tteacherId\x12 \n" +
+	"\vteacherName\x18\x06 \x01(\tR\vteacherName\"I\n" +
+	"\x13SendStaffSmsRequest\x12\x16\n" +
+	"\x06phones\x18\x01 \x03(\tR\x06phones\x12\x1a\n" +
+	"\bsmsValue\x18\x02 \x01(\tR\bsmsValue2\xe5\x06\n" +
 	"\x0eStudentService\x12W\n" +
 	"\x0eGetStudentById\x12\".education.NoteStudentByAbsRequest\x1a!.education.GetStudentByIdResponse\x12g\n" +
 	"\x14GetStudentsByGroupId\x12&.education.GetStudentsByGroupIdRequest\x1a'.education.GetStudentsByGroupIdResponse\x12[\n" +
 	"\x18ChangeUserBalanceHistory\x12*.education.ChangeUserBalanceHistoryRequest\x1a\x13.common.AbsResponse\x12i\n" +
 	"\x1fChangeUserBalanceHistoryByDebit\x121.education.ChangeUserBalanceHistoryByDebitRequest\x1a\x13.common.AbsResponse\x12h\n" +
-	"\x16CalculateDiscountSumma\x12(.education.CalculateDiscountSummaRequest\x1a$.education.CalculateDiscountResponse2\xba\x01\n" +
+	"\x16CalculateDiscountSumma\x12(.education.CalculateDiscountSummaRequest\x1a$.education.CalculateDiscountResponse\x12I\n" +
+	"\n" +
+	"GetDebtors\x12\x1c.education.GetDebtorsRequest\x1a\x1d.education.GetDebtorsResponse\x12K\n" +
+	"\x10SendDebtReminder\x12\".education.SendDebtReminderRequest\x1a\x13.common.AbsResponse\x12d\n" +
+	"\x13GetStudentsByPhones\x12%.education.GetStudentsByPhonesRequest\x1a&.education.GetStudentsByPhonesResponse\x12a\n" +
+	"\x12GetDiscountContext\x12$.education.GetDiscountContextRequest\x1a%.education.GetDiscountContextResponse2\x91\x02\n" +
 	"\fGroupService\x12N\n" +
 	"\fGetGroupById\x12\x1e.education.GetGroupByIdRequest\x1a\x1e.education.GetGroupAbsResponse\x12Z\n" +
-	"\x14GetGroupsByStudentId\x12\x1b.education.StudentIdRequest\x1a%.education.GetGroupsByStudentResponseB\n" +
+	"\x14GetGroupsByStudentId\x12\x1b.education.StudentIdRequest\x1a%.education.GetGroupsByStudentResponse\x12U\n" +
+	"\x0eGetGroupsByIds\x12 .education.GetGroupsByIdsRequest\x1a!.education.GetGroupsByIdsResponse2\x8e\x01\n" +
+	"\x11AttendanceService\x12y\n" +
+	"\"CalculateTeacherSalaryByAttendance\x12(.education.CalculateTeacherSalaryRequest\x1a).education.CalculateTeacherSalaryResponse2Q\n" +
+	"\n" +
+	"SmsService\x12C\n" +
+	"\fSendStaffSms\x12\x1e.education.SendStaffSmsRequest\x1a\x13.common.AbsResponseB\n" +
 	"Z\bproto/pbb\x06proto3"
 
 var (
@@ -1365,54 +2376,89 @@ func file_education_proto_rawDescGZIP() []byte {
 	return file_education_proto_rawDescData
 }
 
-var file_education_proto_msgTypes = make([]protoimpl.MessageInfo, 16)
+var file_education_proto_msgTypes = make([]protoimpl.MessageInfo, 32)
 var file_education_proto_goTypes = []any{
 	(*AbsRoom)(nil),                                // 0: education.AbsRoom
 	(*AbsCourse)(nil),                              // 1: education.AbsCourse
-	(*CalculateDiscountSummaRequest)(nil),          // 2: education.CalculateDiscountSummaRequest
-	(*CalculateDiscountResponse)(nil),              // 3: education.CalculateDiscountResponse
-	(*ChangeUserBalanceHistoryByDebitRequest)(nil), // 4: education.ChangeUserBalanceHistoryByDebitRequest
-	(*ChangeUserBalanceHistoryRequest)(nil),        // 5: education.ChangeUserBalanceHistoryRequest
-	(*GetStudentsByGroupIdResponse)(nil),           // 6: education.GetStudentsByGroupIdResponse
-	(*GetStudentsByGroupIdRequest)(nil),            // 7: education.GetStudentsByGroupIdRequest
-	(*AbsStudent)(nil),                             // 8: education.AbsStudent
-	(*GetStudentByIdResponse)(nil),                 // 9: education.GetStudentByIdResponse
-	(*NoteStudentByAbsRequest)(nil),                // 10: education.NoteStudentByAbsRequest
-	(*GetGroupStudent)(nil),                        // 11: education.GetGroupStudent
-	(*StudentIdRequest)(nil),                       // 12: education.StudentIdRequest
-	(*GetGroupsByStudentResponse)(nil),             // 13: education.GetGroupsByStudentResponse
-	(*GetGroupByIdRequest)(nil),                    // 14: education.GetGroupByIdRequest
-	(*GetGroupAbsResponse)(nil),                    // 15: education.GetGroupAbsResponse
-	(*DebtorComment)(nil),                          // 16: common.DebtorComment
-	(*DebtorGroup)(nil),                            // 17: common.DebtorGroup
-	(*AbsResponse)(nil),                            // 18: common.AbsResponse
+	(*GetDebtorsRequest)(nil),                      // 2: education.GetDebtorsRequest
+	(*GetDebtorsResponse)(nil),                     // 3: education.GetDebtorsResponse
+	(*AbsDebtor)(nil),                              // 4: education.AbsDebtor
+	(*GetDiscountContextRequest)(nil),              // 5: education.GetDiscountContextRequest
+	(*GetDiscountContextResponse)(nil),             // 6: education.GetDiscountContextResponse
+	(*GetStudentsByPhonesRequest)(nil),             // 7: education.GetStudentsByPhonesRequest
+	(*GetStudentsByPhonesResponse)(nil),            // 8: education.GetStudentsByPhonesResponse
+	(*SendDebtReminderRequest)(nil),                // 9: education.SendDebtReminderRequest
+	(*CalculateDiscountSummaRequest)(nil),          // 10: education.CalculateDiscountSummaRequest
+	(*CalculateDiscountResponse)(nil),              // 11: education.CalculateDiscountResponse
+	(*ChangeUserBalanceHistoryByDebitRequest)(nil), // 12: education.ChangeUserBalanceHistoryByDebitRequest
+	(*ChangeUserBalanceHistoryRequest)(nil),        // 13: education.ChangeUserBalanceHistoryRequest
+	(*GetStudentsByGroupIdResponse)(nil),           // 14: education.GetStudentsByGroupIdResponse
+	(*GetStudentsByGroupIdRequest)(nil),            // 15: education.GetStudentsByGroupIdRequest
+	(*AbsStudent)(nil),                             // 16: education.AbsStudent
+	(*GetStudentByIdResponse)(nil),                 // 17: education.GetStudentByIdResponse
+	(*NoteStudentByAbsRequest)(nil),                // 18: education.NoteStudentByAbsRequest
+	(*GetGroupStudent)(nil),                        // 19: education.GetGroupStudent
+	(*StudentIdRequest)(nil),                       // 20: education.StudentIdRequest
+	(*GetGroupsByStudentResponse)(nil),             // 21: education.GetGroupsByStudentResponse
+	(*GetGroupByIdRequest)(nil),                    // 22: education.GetGroupByIdRequest
+	(*GetGroupAbsResponse)(nil),                    // 23: education.GetGroupAbsResponse
+	(*CalculateTeacherSalaryRequest)(nil),          // 24: education.CalculateTeacherSalaryRequest
+	(*CalculateTeacherSalaryResponse)(nil),         // 25: education.CalculateTeacherSalaryResponse
+	(*AbsCalculateSalary)(nil),                     // 26: education.AbsCalculateSalary
+	(*StudentSalary)(nil),                          // 27: education.StudentSalary
+	(*GetGroupsByIdsRequest)(nil),                  // 28: education.GetGroupsByIdsRequest
+	(*GetGroupsByIdsResponse)(nil),                 // 29: education.GetGroupsByIdsResponse
+	(*GroupMeta)(nil),                              // 30: education.GroupMeta
+	(*SendStaffSmsRequest)(nil),                    // 31: education.SendStaffSmsRequest
+	(*DebtorComment)(nil),                          // 32: common.DebtorComment
+	(*DebtorGroup)(nil),                            // 33: common.DebtorGroup
+	(*AbsResponse)(nil),                            // 34: common.AbsResponse
 }
 var file_education_proto_depIdxs = []int32{
-	8,  // 0: education.GetStudentsByGroupIdResponse.students:type_name -> education.AbsStudent
-	11, // 1: education.GetStudentByIdResponse.groups:type_name -> education.GetGroupStudent
-	0,  // 2: education.GetGroupStudent.room:type_name -> education.AbsRoom
-	1,  // 3: education.GetGroupStudent.course:type_name -> education.AbsCourse
-	16, // 4: education.GetGroupsByStudentResponse.comments:type_name -> common.DebtorComment
-	17, // 5: education.GetGroupsByStudentResponse.groups:type_name -> common.DebtorGroup
-	10, // 6: education.StudentService.GetStudentById:input_type -> education.NoteStudentByAbsRequest
-	7,  // 7: education.StudentService.GetStudentsByGroupId:input_type -> education.GetStudentsByGroupIdRequest
-	5,  // 8: education.StudentService.ChangeUserBalanceHistory:input_type -> education.ChangeUserBalanceHistoryRequest
-	4,  // 9: education.StudentService.ChangeUserBalanceHistoryByDebit:input_type -> education.ChangeUserBalanceHistoryByDebitRequest
-	2,  // 10: education.StudentService.CalculateDiscountSumma:input_type -> education.CalculateDiscountSummaRequest
-	14, // 11: education.GroupService.GetGroupById:input_type -> education.GetGroupByIdRequest
-	12, // 12: education.GroupService.GetGroupsByStudentId:input_type -> education.StudentIdRequest
-	9,  // 13: education.StudentService.GetStudentById:output_type -> education.GetStudentByIdResponse
-	6,  // 14: education.StudentService.GetStudentsByGroupId:output_type -> education.GetStudentsByGroupIdResponse
-	18, // 15: education.StudentService.ChangeUserBalanceHistory:output_type -> common.AbsResponse
-	18, // 16: education.StudentService.ChangeUserBalanceHistoryByDebit:output_type -> common.AbsResponse
-	3,  // 17: education.StudentService.CalculateDiscountSumma:output_type -> education.CalculateDiscountResponse
-	15, // 18: education.GroupService.GetGroupById:output_type -> education.GetGroupAbsResponse
-	13, // 19: education.GroupService.GetGroupsByStudentId:output_type -> education.GetGroupsByStudentResponse
-	13, // [13:20] is the sub-list for method output_type
-	6,  // [6:13] is the sub-list for method input_type
-	6,  // [6:6] is the sub-list for extension type_name
-	6,  // [6:6] is the sub-list for extension extendee
-	0,  // [0:6] is the sub-list for field type_name
+	4,  // 0: education.GetDebtorsResponse.debtors:type_name -> education.AbsDebtor
+	4,  // 1: education.GetStudentsByPhonesResponse.students:type_name -> education.AbsDebtor
+	16, // 2: education.GetStudentsByGroupIdResponse.students:type_name -> education.AbsStudent
+	19, // 3: education.GetStudentByIdResponse.groups:type_name -> education.GetGroupStudent
+	0,  // 4: education.GetGroupStudent.room:type_name -> education.AbsRoom
+	1,  // 5: education.GetGroupStudent.course:type_name -> education.AbsCourse
+	32, // 6: education.GetGroupsByStudentResponse.comments:type_name -> common.DebtorComment
+	33, // 7: education.GetGroupsByStudentResponse.groups:type_name -> common.DebtorGroup
+	26, // 8: education.CalculateTeacherSalaryResponse.salaries:type_name -> education.AbsCalculateSalary
+	27, // 9: education.AbsCalculateSalary.salaries:type_name -> education.StudentSalary
+	30, // 10: education.GetGroupsByIdsResponse.groups:type_name -> education.GroupMeta
+	18, // 11: education.StudentService.GetStudentById:input_type -> education.NoteStudentByAbsRequest
+	15, // 12: education.StudentService.GetStudentsByGroupId:input_type -> education.GetStudentsByGroupIdRequest
+	13, // 13: education.StudentService.ChangeUserBalanceHistory:input_type -> education.ChangeUserBalanceHistoryRequest
+	12, // 14: education.StudentService.ChangeUserBalanceHistoryByDebit:input_type -> education.ChangeUserBalanceHistoryByDebitRequest
+	10, // 15: education.StudentService.CalculateDiscountSumma:input_type -> education.CalculateDiscountSummaRequest
+	2,  // 16: education.StudentService.GetDebtors:input_type -> education.GetDebtorsRequest
+	9,  // 17: education.StudentService.SendDebtReminder:input_type -> education.SendDebtReminderRequest
+	7,  // 18: education.StudentService.GetStudentsByPhones:input_type -> education.GetStudentsByPhonesRequest
+	5,  // 19: education.StudentService.GetDiscountContext:input_type -> education.GetDiscountContextRequest
+	22, // 20: education.GroupService.GetGroupById:input_type -> education.GetGroupByIdRequest
+	20, // 21: education.GroupService.GetGroupsByStudentId:input_type -> education.StudentIdRequest
+	28, // 22: education.GroupService.GetGroupsByIds:input_type -> education.GetGroupsByIdsRequest
+	24, // 23: education.AttendanceService.CalculateTeacherSalaryByAttendance:input_type -> education.CalculateTeacherSalaryRequest
+	31, // 24: education.SmsService.SendStaffSms:input_type -> education.SendStaffSmsRequest
+	17, // 25: education.StudentService.GetStudentById:output_type -> education.GetStudentByIdResponse
+	14, // 26: education.StudentService.GetStudentsByGroupId:output_type -> education.GetStudentsByGroupIdResponse
+	34, // 27: education.StudentService.ChangeUserBalanceHistory:output_type -> common.AbsResponse
+	34, // 28: education.StudentService.ChangeUserBalanceHistoryByDebit:output_type -> common.AbsResponse
+	11, // 29: education.StudentService.CalculateDiscountSumma:output_type -> education.CalculateDiscountResponse
+	3,  // 30: education.StudentService.GetDebtors:output_type -> education.GetDebtorsResponse
+	34, // 31: education.StudentService.SendDebtReminder:output_type -> common.AbsResponse
+	8,  // 32: education.StudentService.GetStudentsByPhones:output_type -> education.GetStudentsByPhonesResponse
+	6,  // 33: education.StudentService.GetDiscountContext:output_type -> education.GetDiscountContextResponse
+	23, // 34: education.GroupService.GetGroupById:output_type -> education.GetGroupAbsResponse
+	21, // 35: education.GroupService.GetGroupsByStudentId:output_type -> education.GetGroupsByStudentResponse
+	29, // 36: education.GroupService.GetGroupsByIds:output_type -> education.GetGroupsByIdsResponse
+	25, // 37: education.AttendanceService.CalculateTeacherSalaryByAttendance:output_type -> education.CalculateTeacherSalaryResponse
+	34, // 38: education.SmsService.SendStaffSms:output_type -> common.AbsResponse
+	25, // [25:39] is the sub-list for method output_type
+	11, // [11:25] is the sub-list for method input_type
+	11, // [11:11] is the sub-list for extension type_name
+	11, // [11:11] is the sub-list for extension extendee
+	0,  // [0:11] is the sub-list for field type_name
 }
 
 func init() { file_education_proto_init() }
@@ -1427,9 +2473,9 @@ func file_education_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_education_proto_rawDesc), len(file_education_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   16,
+			NumMessages:   32,
 			NumExtensions: 0,
-			NumServices:   2,
+			NumServices:   4,
 		},
 		GoTypes:           file_education_proto_goTypes,
 		DependencyIndexes: file_education_proto_depIdxs,
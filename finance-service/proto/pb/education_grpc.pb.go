@@ -24,6 +24,10 @@ const (
 	StudentService_ChangeUserBalanceHistory_FullMethodName        = "/education.StudentService/ChangeUserBalanceHistory"
 	StudentService_ChangeUserBalanceHistoryByDebit_FullMethodName = "/education.StudentService/ChangeUserBalanceHistoryByDebit"
 	StudentService_CalculateDiscountSumma_FullMethodName          = "/education.StudentService/CalculateDiscountSumma"
+	StudentService_GetDebtors_FullMethodName                      = "/education.StudentService/GetDebtors"
+	StudentService_SendDebtReminder_FullMethodName                = "/education.StudentService/SendDebtReminder"
+	StudentService_GetStudentsByPhones_FullMethodName             = "/education.StudentService/GetStudentsByPhones"
+	StudentService_GetDiscountContext_FullMethodName              = "/education.StudentService/GetDiscountContext"
 )
 
 // StudentServiceClient is the client API for StudentService service.
@@ -37,6 +41,10 @@ type StudentServiceClient interface {
 	ChangeUserBalanceHistory(ctx context.Context, in *ChangeUserBalanceHistoryRequest, opts ...grpc.CallOption) (*AbsResponse, error)
 	ChangeUserBalanceHistoryByDebit(ctx context.Context, in *ChangeUserBalanceHistoryByDebitRequest, opts ...grpc.CallOption) (*AbsResponse, error)
 	CalculateDiscountSumma(ctx context.Context, in *CalculateDiscountSummaRequest, opts ...grpc.CallOption) (*CalculateDiscountResponse, error)
+	GetDebtors(ctx context.Context, in *GetDebtorsRequest, opts ...grpc.CallOption) (*GetDebtorsResponse, error)
+	SendDebtReminder(ctx context.Context, in *SendDebtReminderRequest, opts ...grpc.CallOption) (*AbsResponse, error)
+	GetStudentsByPhones(ctx context.Context, in *GetStudentsByPhonesRequest, opts ...grpc.CallOption) (*GetStudentsByPhonesResponse, error)
+	GetDiscountContext(ctx context.Context, in *GetDiscountContextRequest, opts ...grpc.CallOption) (*GetDiscountContextResponse, error)
 }
 
 type studentServiceClient struct {
@@ -97,6 +105,46 @@ func (c *studentServiceClient) CalculateDiscountSumma(ctx context.Context, in *C
 	return out, nil
 }
 
+func (c *studentServiceClient) GetDebtors(ctx context.Context, in *GetDebtorsRequest, opts ...grpc.CallOption) (*GetDebtorsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetDebtorsResponse)
+	err := c.cc.Invoke(ctx, StudentService_GetDebtors_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *studentServiceClient) SendDebtReminder(ctx context.Context, in *SendDebtReminderRequest, opts ...grpc.CallOption) (*AbsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AbsResponse)
+	err := c.cc.Invoke(ctx, StudentService_SendDebtReminder_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *studentServiceClient) GetStudentsByPhones(ctx context.Context, in *GetStudentsByPhonesRequest, opts ...grpc.CallOption) (*GetStudentsByPhonesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetStudentsByPhonesResponse)
+	err := c.cc.Invoke(ctx, StudentService_GetStudentsByPhones_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *studentServiceClient) GetDiscountContext(ctx context.Context, in *GetDiscountContextRequest, opts ...grpc.CallOption) (*GetDiscountContextResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetDiscountContextResponse)
+	err := c.cc.Invoke(ctx, StudentService_GetDiscountContext_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // StudentServiceServer is the server API for StudentService service.
 // All implementations must embed UnimplementedStudentServiceServer
 // for forward compatibility.
@@ -108,6 +156,10 @@ type StudentServiceServer interface {
 	ChangeUserBalanceHistory(context.Context, *ChangeUserBalanceHistoryRequest) (*AbsResponse, error)
 	ChangeUserBalanceHistoryByDebit(context.Context, *ChangeUserBalanceHistoryByDebitRequest) (*AbsResponse, error)
 	CalculateDiscountSumma(context.Context, *CalculateDiscountSummaRequest) (*CalculateDiscountResponse, error)
+	GetDebtors(context.Context, *GetDebtorsRequest) (*GetDebtorsResponse, error)
+	SendDebtReminder(context.Context, *SendDebtReminderRequest) (*AbsResponse, error)
+	GetStudentsByPhones(context.Context, *GetStudentsByPhonesRequest) (*GetStudentsByPhonesResponse, error)
+	GetDiscountContext(context.Context, *GetDiscountContextRequest) (*GetDiscountContextResponse, error)
 	mustEmbedUnimplementedStudentServiceServer()
 }
 
@@ -133,6 +185,18 @@ func (UnimplementedStudentServiceServer) ChangeUserBalanceHistoryByDebit(context
 func (UnimplementedStudentServiceServer) CalculateDiscountSumma(context.Context, *CalculateDiscountSummaRequest) (*CalculateDiscountResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CalculateDiscountSumma not implemented")
 }
+func (UnimplementedStudentServiceServer) GetDebtors(context.Context, *GetDebtorsRequest) (*GetDebtorsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDebtors not implemented")
+}
+func (UnimplementedStudentServiceServer) SendDebtReminder(context.Context, *SendDebtReminderRequest) (*AbsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendDebtReminder not implemented")
+}
+func (UnimplementedStudentServiceServer) GetStudentsByPhones(context.Context, *GetStudentsByPhonesRequest) (*GetStudentsByPhonesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStudentsByPhones not implemented")
+}
+func (UnimplementedStudentServiceServer) GetDiscountContext(context.Context, *GetDiscountContextRequest) (*GetDiscountContextResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDiscountContext not implemented")
+}
 func (UnimplementedStudentServiceServer) mustEmbedUnimplementedStudentServiceServer() {}
 func (UnimplementedStudentServiceServer) testEmbeddedByValue()                        {}
 
@@ -244,6 +308,78 @@ func _StudentService_CalculateDiscountSumma_Handler(srv interface{}, ctx context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _StudentService_GetDebtors_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDebtorsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StudentServiceServer).GetDebtors(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StudentService_GetDebtors_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StudentServiceServer).GetDebtors(ctx, req.(*GetDebtorsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StudentService_SendDebtReminder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SendDebtReminderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StudentServiceServer).SendDebtReminder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StudentService_SendDebtReminder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StudentServiceServer).SendDebtReminder(ctx, req.(*SendDebtReminderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StudentService_GetStudentsByPhones_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetStudentsByPhonesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StudentServiceServer).GetStudentsByPhones(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StudentService_GetStudentsByPhones_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StudentServiceServer).GetStudentsByPhones(ctx, req.(*GetStudentsByPhonesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StudentService_GetDiscountContext_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDiscountContextRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StudentServiceServer).GetDiscountContext(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StudentService_GetDiscountContext_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StudentServiceServer).GetDiscountContext(ctx, req.(*GetDiscountContextRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // StudentService_ServiceDesc is the grpc.ServiceDesc for StudentService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -271,6 +407,22 @@ var StudentService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CalculateDiscountSumma",
 			Handler:    _StudentService_CalculateDiscountSumma_Handler,
 		},
+		{
+			MethodName: "GetDebtors",
+			Handler:    _StudentService_GetDebtors_Handler,
+		},
+		{
+			MethodName: "SendDebtReminder",
+			Handler:    _StudentService_SendDebtReminder_Handler,
+		},
+		{
+			MethodName: "GetStudentsByPhones",
+			Handler:    _StudentService_GetStudentsByPhones_Handler,
+		},
+		{
+			MethodName: "GetDiscountContext",
+			Handler:    _StudentService_GetDiscountContext_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "education.proto",
@@ -279,6 +431,7 @@ var StudentService_ServiceDesc = grpc.ServiceDesc{
 const (
 	GroupService_GetGroupById_FullMethodName         = "/education.GroupService/GetGroupById"
 	GroupService_GetGroupsByStudentId_FullMethodName = "/education.GroupService/GetGroupsByStudentId"
+	GroupService_GetGroupsByIds_FullMethodName       = "/education.GroupService/GetGroupsByIds"
 )
 
 // GroupServiceClient is the client API for GroupService service.
@@ -287,6 +440,7 @@ const (
 type GroupServiceClient interface {
 	GetGroupById(ctx context.Context, in *GetGroupByIdRequest, opts ...grpc.CallOption) (*GetGroupAbsResponse, error)
 	GetGroupsByStudentId(ctx context.Context, in *StudentIdRequest, opts ...grpc.CallOption) (*GetGroupsByStudentResponse, error)
+	GetGroupsByIds(ctx context.Context, in *GetGroupsByIdsRequest, opts ...grpc.CallOption) (*GetGroupsByIdsResponse, error)
 }
 
 type groupServiceClient struct {
@@ -317,12 +471,23 @@ func (c *groupServiceClient) GetGroupsByStudentId(ctx context.Context, in *Stude
 	return out, nil
 }
 
+func (c *groupServiceClient) GetGroupsByIds(ctx context.Context, in *GetGroupsByIdsRequest, opts ...grpc.CallOption) (*GetGroupsByIdsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetGroupsByIdsResponse)
+	err := c.cc.Invoke(ctx, GroupService_GetGroupsByIds_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // GroupServiceServer is the server API for GroupService service.
 // All implementations must embed UnimplementedGroupServiceServer
 // for forward compatibility.
 type GroupServiceServer interface {
 	GetGroupById(context.Context, *GetGroupByIdRequest) (*GetGroupAbsResponse, error)
 	GetGroupsByStudentId(context.Context, *StudentIdRequest) (*GetGroupsByStudentResponse, error)
+	GetGroupsByIds(context.Context, *GetGroupsByIdsRequest) (*GetGroupsByIdsResponse, error)
 	mustEmbedUnimplementedGroupServiceServer()
 }
 
@@ -339,6 +504,9 @@ func (UnimplementedGroupServiceServer) GetGroupById(context.Context, *GetGroupBy
 func (UnimplementedGroupServiceServer) GetGroupsByStudentId(context.Context, *StudentIdRequest) (*GetGroupsByStudentResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetGroupsByStudentId not implemented")
 }
+func (UnimplementedGroupServiceServer) GetGroupsByIds(context.Context, *GetGroupsByIdsRequest) (*GetGroupsByIdsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetGroupsByIds not implemented")
+}
 func (UnimplementedGroupServiceServer) mustEmbedUnimplementedGroupServiceServer() {}
 func (UnimplementedGroupServiceServer) testEmbeddedByValue()                      {}
 
@@ -396,6 +564,24 @@ func _GroupService_GetGroupsByStudentId_Handler(srv interface{}, ctx context.Con
 	return interceptor(ctx, in, info, handler)
 }
 
+func _GroupService_GetGroupsByIds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetGroupsByIdsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupServiceServer).GetGroupsByIds(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GroupService_GetGroupsByIds_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupServiceServer).GetGroupsByIds(ctx, req.(*GetGroupsByIdsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // GroupService_ServiceDesc is the grpc.ServiceDesc for GroupService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -411,6 +597,222 @@ var GroupService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetGroupsByStudentId",
 			Handler:    _GroupService_GetGroupsByStudentId_Handler,
 		},
+		{
+			MethodName: "GetGroupsByIds",
+			Handler:    _GroupService_GetGroupsByIds_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "education.proto",
+}
+
+const (
+	AttendanceService_CalculateTeacherSalaryByAttendance_FullMethodName = "/education.AttendanceService/CalculateTeacherSalaryByAttendance"
+)
+
+// AttendanceServiceClient is the client API for AttendanceService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// attendance service start
+type AttendanceServiceClient interface {
+	CalculateTeacherSalaryByAttendance(ctx context.Context, in *CalculateTeacherSalaryRequest, opts ...grpc.CallOption) (*CalculateTeacherSalaryResponse, error)
+}
+
+type attendanceServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewAttendanceServiceClient(cc grpc.ClientConnInterface) AttendanceServiceClient {
+	return &attendanceServiceClient{cc}
+}
+
+func (c *attendanceServiceClient) CalculateTeacherSalaryByAttendance(ctx context.Context, in *CalculateTeacherSalaryRequest, opts ...grpc.CallOption) (*CalculateTeacherSalaryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CalculateTeacherSalaryResponse)
+	err := c.cc.Invoke(ctx, AttendanceService_CalculateTeacherSalaryByAttendance_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// AttendanceServiceServer is the server API for AttendanceService service.
+// All implementations must embed UnimplementedAttendanceServiceServer
+// for forward compatibility.
+//
+// attendance service start
+type AttendanceServiceServer interface {
+	CalculateTeacherSalaryByAttendance(context.Context, *CalculateTeacherSalaryRequest) (*CalculateTeacherSalaryResponse, error)
+	mustEmbedUnimplementedAttendanceServiceServer()
+}
+
+// UnimplementedAttendanceServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedAttendanceServiceServer struct{}
+
+func (UnimplementedAttendanceServiceServer) CalculateTeacherSalaryByAttendance(context.Context, *CalculateTeacherSalaryRequest) (*CalculateTeacherSalaryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CalculateTeacherSalaryByAttendance not implemented")
+}
+func (UnimplementedAttendanceServiceServer) mustEmbedUnimplementedAttendanceServiceServer() {}
+func (UnimplementedAttendanceServiceServer) testEmbeddedByValue()                           {}
+
+// UnsafeAttendanceServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to AttendanceServiceServer will
+// result in compilation errors.
+type UnsafeAttendanceServiceServer interface {
+	mustEmbedUnimplementedAttendanceServiceServer()
+}
+
+func RegisterAttendanceServiceServer(s grpc.ServiceRegistrar, srv AttendanceServiceServer) {
+	// If the following call pancis, it indicates UnimplementedAttendanceServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&AttendanceService_ServiceDesc, srv)
+}
+
+func _AttendanceService_CalculateTeacherSalaryByAttendance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CalculateTeacherSalaryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AttendanceServiceServer).CalculateTeacherSalaryByAttendance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AttendanceService_CalculateTeacherSalaryByAttendance_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AttendanceServiceServer).CalculateTeacherSalaryByAttendance(ctx, req.(*CalculateTeacherSalaryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// AttendanceService_ServiceDesc is the grpc.ServiceDesc for AttendanceService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var AttendanceService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "education.AttendanceService",
+	HandlerType: (*AttendanceServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CalculateTeacherSalaryByAttendance",
+			Handler:    _AttendanceService_CalculateTeacherSalaryByAttendance_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "education.proto",
+}
+
+const (
+	SmsService_SendStaffSms_FullMethodName = "/education.SmsService/SendStaffSms"
+)
+
+// SmsServiceClient is the client API for SmsService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// sms service start
+type SmsServiceClient interface {
+	SendStaffSms(ctx context.Context, in *SendStaffSmsRequest, opts ...grpc.CallOption) (*AbsResponse, error)
+}
+
+type smsServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewSmsServiceClient(cc grpc.ClientConnInterface) SmsServiceClient {
+	return &smsServiceClient{cc}
+}
+
+func (c *smsServiceClient) SendStaffSms(ctx context.Context, in *SendStaffSmsRequest, opts ...grpc.CallOption) (*AbsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AbsResponse)
+	err := c.cc.Invoke(ctx, SmsService_SendStaffSms_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// SmsServiceServer is the server API for SmsService service.
+// All implementations must embed UnimplementedSmsServiceServer
+// for forward compatibility.
+//
+// sms service start
+type SmsServiceServer interface {
+	SendStaffSms(context.Context, *SendStaffSmsRequest) (*AbsResponse, error)
+	mustEmbedUnimplementedSmsServiceServer()
+}
+
+// UnimplementedSmsServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedSmsServiceServer struct{}
+
+func (UnimplementedSmsServiceServer) SendStaffSms(context.Context, *SendStaffSmsRequest) (*AbsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendStaffSms not implemented")
+}
+func (UnimplementedSmsServiceServer) mustEmbedUnimplementedSmsServiceServer() {}
+func (UnimplementedSmsServiceServer) testEmbeddedByValue()                    {}
+
+// UnsafeSmsServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to SmsServiceServer will
+// result in compilation errors.
+type UnsafeSmsServiceServer interface {
+	mustEmbedUnimplementedSmsServiceServer()
+}
+
+func RegisterSmsServiceServer(s grpc.ServiceRegistrar, srv SmsServiceServer) {
+	// If the following call pancis, it indicates UnimplementedSmsServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&SmsService_ServiceDesc, srv)
+}
+
+func _SmsService_SendStaffSms_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SendStaffSmsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SmsServiceServer).SendStaffSms(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SmsService_SendStaffSms_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SmsServiceServer).SendStaffSms(ctx, req.(*SendStaffSmsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// SmsService_ServiceDesc is the grpc.ServiceDesc for SmsService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var SmsService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "education.SmsService",
+	HandlerType: (*SmsServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "SendStaffSms",
+			Handler:    _SmsService_SendStaffSms_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "education.proto",
@@ -21,6 +21,94 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type GetAllEmployeeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	IsArchived    bool                   `protobuf:"varint,1,opt,name=isArchived,proto3" json:"isArchived,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAllEmployeeRequest) Reset() {
+	*x = GetAllEmployeeRequest{}
+	mi := &file_user_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAllEmployeeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAllEmployeeRequest) ProtoMessage() {}
+
+func (x *GetAllEmployeeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAllEmployeeRequest.ProtoReflect.Descriptor instead.
+func (*GetAllEmployeeRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *GetAllEmployeeRequest) GetIsArchived() bool {
+	if x != nil {
+		return x.IsArchived
+	}
+	return false
+}
+
+type GetAllStuffResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Stuff         []*GetUserByIdResponse `protobuf:"bytes,1,rep,name=stuff,proto3" json:"stuff,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAllStuffResponse) Reset() {
+	*x = GetAllStuffResponse{}
+	mi := &file_user_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAllStuffResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAllStuffResponse) ProtoMessage() {}
+
+func (x *GetAllStuffResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAllStuffResponse.ProtoReflect.Descriptor instead.
+func (*GetAllStuffResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GetAllStuffResponse) GetStuff() []*GetUserByIdResponse {
+	if x != nil {
+		return x.Stuff
+	}
+	return nil
+}
+
 type UserAbsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	UserId        string                 `protobuf:"bytes,1,opt,name=userId,proto3" json:"userId,omitempty"`
@@ -30,7 +118,7 @@ type UserAbsRequest struct {
 
 func (x *UserAbsRequest) Reset() {
 	*x = UserAbsRequest{}
-	mi := &file_user_proto_msgTypes[0]
+	mi := &file_user_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -42,7 +130,7 @@ func (x *UserAbsRequest) String() string {
 func (*UserAbsRequest) ProtoMessage() {}
 
 func (x *UserAbsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_user_proto_msgTypes[0]
+	mi := &file_user_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -55,7 +143,7 @@ func (x *UserAbsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UserAbsRequest.ProtoReflect.Descriptor instead.
 func (*UserAbsRequest) Descriptor() ([]byte, []int) {
-	return file_user_proto_rawDescGZIP(), []int{0}
+	return file_user_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *UserAbsRequest) GetUserId() string {
@@ -82,7 +170,7 @@ type GetUserByIdResponse struct {
 
 func (x *GetUserByIdResponse) Reset() {
 	*x = GetUserByIdResponse{}
-	mi := &file_user_proto_msgTypes[1]
+	mi := &file_user_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -94,7 +182,7 @@ func (x *GetUserByIdResponse) String() string {
 func (*GetUserByIdResponse) ProtoMessage() {}
 
 func (x *GetUserByIdResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_user_proto_msgTypes[1]
+	mi := &file_user_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -107,7 +195,7 @@ func (x *GetUserByIdResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetUserByIdResponse.ProtoReflect.Descriptor instead.
 func (*GetUserByIdResponse) Descriptor() ([]byte, []int) {
-	return file_user_proto_rawDescGZIP(), []int{1}
+	return file_user_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *GetUserByIdResponse) GetId() string {
@@ -178,7 +266,13 @@ var File_user_proto protoreflect.FileDescriptor
 const file_user_proto_rawDesc = "" +
 	"\n" +
 	"\n" +
-	"user.proto\x12\x04user\"(\n" +
+	"user.proto\x12\x04user\"7\n" +
+	"\x15GetAllEmployeeRequest\x12\x1e\n" +
+	"\n" +
+	"isArchived\x18\x01 \x01(\bR\n" +
+	"isArchived\"F\n" +
+	"\x13GetAllStuffResponse\x12/\n" +
+	"\x05stuff\x18\x01 \x03(\v2\x19.user.GetUserByIdResponseR\x05stuff\"(\n" +
 	"\x0eUserAbsRequest\x12\x16\n" +
 	"\x06userId\x18\x01 \x01(\tR\x06userId\"\x80\x02\n" +
 	"\x13GetUserByIdResponse\x12\x0e\n" +
@@ -191,9 +285,10 @@ const file_user_proto_rawDesc = "" +
 	"\n" +
 	"is_deleted\x18\a \x01(\bR\tisDeleted\x12\x1c\n" +
 	"\tcreatedAt\x18\b \x01(\tR\tcreatedAt\x12\x1c\n" +
-	"\tcompanyId\x18\t \x01(\x05R\tcompanyId2M\n" +
+	"\tcompanyId\x18\t \x01(\x05R\tcompanyId2\x94\x01\n" +
 	"\vUserService\x12>\n" +
-	"\vGetUserById\x12\x14.user.UserAbsRequest\x1a\x19.user.GetUserByIdResponseB\n" +
+	"\vGetUserById\x12\x14.user.UserAbsRequest\x1a\x19.user.GetUserByIdResponse\x12E\n" +
+	"\vGetAllStuff\x12\x1b.user.GetAllEmployeeRequest\x1a\x19.user.GetAllStuffResponseB\n" +
 	"Z\bproto/pbb\x06proto3"
 
 var (
@@ -208,19 +303,24 @@ func file_user_proto_rawDescGZIP() []byte {
 	return file_user_proto_rawDescData
 }
 
-var file_user_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_user_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
 var file_user_proto_goTypes = []any{
-	(*UserAbsRequest)(nil),      // 0: user.UserAbsRequest
-	(*GetUserByIdResponse)(nil), // 1: user.GetUserByIdResponse
+	(*GetAllEmployeeRequest)(nil), // 0: user.GetAllEmployeeRequest
+	(*GetAllStuffResponse)(nil),   // 1: user.GetAllStuffResponse
+	(*UserAbsRequest)(nil),        // 2: user.UserAbsRequest
+	(*GetUserByIdResponse)(nil),   // 3: user.GetUserByIdResponse
 }
 var file_user_proto_depIdxs = []int32{
-	0, // 0: user.UserService.GetUserById:input_type -> user.UserAbsRequest
-	1, // 1: user.UserService.GetUserById:output_type -> user.GetUserByIdResponse
-	1, // [1:2] is the sub-list for method output_type
-	0, // [0:1] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+	3, // 0: user.GetAllStuffResponse.stuff:type_name -> user.GetUserByIdResponse
+	2, // 1: user.UserService.GetUserById:input_type -> user.UserAbsRequest
+	0, // 2: user.UserService.GetAllStuff:input_type -> user.GetAllEmployeeRequest
+	3, // 3: user.UserService.GetUserById:output_type -> user.GetUserByIdResponse
+	1, // 4: user.UserService.GetAllStuff:output_type -> user.GetAllStuffResponse
+	3, // [3:5] is the sub-list for method output_type
+	1, // [1:3] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
 }
 
 func init() { file_user_proto_init() }
@@ -234,7 +334,7 @@ func file_user_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_user_proto_rawDesc), len(file_user_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   2,
+			NumMessages:   4,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -20,6 +20,7 @@ const _ = grpc.SupportPackageIsVersion9
 
 const (
 	UserService_GetUserById_FullMethodName = "/user.UserService/GetUserById"
+	UserService_GetAllStuff_FullMethodName = "/user.UserService/GetAllStuff"
 )
 
 // UserServiceClient is the client API for UserService service.
@@ -27,6 +28,7 @@ const (
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type UserServiceClient interface {
 	GetUserById(ctx context.Context, in *UserAbsRequest, opts ...grpc.CallOption) (*GetUserByIdResponse, error)
+	GetAllStuff(ctx context.Context, in *GetAllEmployeeRequest, opts ...grpc.CallOption) (*GetAllStuffResponse, error)
 }
 
 type userServiceClient struct {
@@ -47,11 +49,22 @@ func (c *userServiceClient) GetUserById(ctx context.Context, in *UserAbsRequest,
 	return out, nil
 }
 
+func (c *userServiceClient) GetAllStuff(ctx context.Context, in *GetAllEmployeeRequest, opts ...grpc.CallOption) (*GetAllStuffResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetAllStuffResponse)
+	err := c.cc.Invoke(ctx, UserService_GetAllStuff_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // UserServiceServer is the server API for UserService service.
 // All implementations must embed UnimplementedUserServiceServer
 // for forward compatibility.
 type UserServiceServer interface {
 	GetUserById(context.Context, *UserAbsRequest) (*GetUserByIdResponse, error)
+	GetAllStuff(context.Context, *GetAllEmployeeRequest) (*GetAllStuffResponse, error)
 	mustEmbedUnimplementedUserServiceServer()
 }
 
@@ -65,6 +78,9 @@ type UnimplementedUserServiceServer struct{}
 func (UnimplementedUserServiceServer) GetUserById(context.Context, *UserAbsRequest) (*GetUserByIdResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetUserById not implemented")
 }
+func (UnimplementedUserServiceServer) GetAllStuff(context.Context, *GetAllEmployeeRequest) (*GetAllStuffResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAllStuff not implemented")
+}
 func (UnimplementedUserServiceServer) mustEmbedUnimplementedUserServiceServer() {}
 func (UnimplementedUserServiceServer) testEmbeddedByValue()                     {}
 
@@ -104,6 +120,24 @@ func _UserService_GetUserById_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _UserService_GetAllStuff_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAllEmployeeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).GetAllStuff(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_GetAllStuff_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).GetAllStuff(ctx, req.(*GetAllEmployeeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // UserService_ServiceDesc is the grpc.ServiceDesc for UserService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -115,6 +149,10 @@ var UserService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetUserById",
 			Handler:    _UserService_GetUserById_Handler,
 		},
+		{
+			MethodName: "GetAllStuff",
+			Handler:    _UserService_GetAllStuff_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "user.proto",
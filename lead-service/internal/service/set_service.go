@@ -94,6 +94,9 @@ func (s *SetService) ChangeToSet(ctx context.Context, req *pb.ChangeToSetRequest
 		LessonStartTime: req.StartTime,
 		GroupStartDate:  req.StartDate,
 		GroupEndDate:    req.EndDate,
+		Force:           req.Force,
+		ActionById:      req.ActionById,
+		ActionByName:    req.ActionByName,
 	}
 	ctx, cancelFunc := utils.NewTimoutContext(ctx, companyId)
 	defer cancelFunc()
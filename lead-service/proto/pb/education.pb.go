@@ -32,8 +32,12 @@ type CreateGroupRequest struct {
 	LessonStartTime string                 `protobuf:"bytes,7,opt,name=lessonStartTime,proto3" json:"lessonStartTime,omitempty"`
 	GroupStartDate  string                 `protobuf:"bytes,8,opt,name=groupStartDate,proto3" json:"groupStartDate,omitempty"`
 	GroupEndDate    string                 `protobuf:"bytes,9,opt,name=groupEndDate,proto3" json:"groupEndDate,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+	// saves the group although its room or teacher is already booked, the override is audited
+	Force         bool   `protobuf:"varint,10,opt,name=force,proto3" json:"force,omitempty"`
+	ActionById    string `protobuf:"bytes,11,opt,name=actionById,proto3" json:"actionById,omitempty"`
+	ActionByName  string `protobuf:"bytes,12,opt,name=actionByName,proto3" json:"actionByName,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *CreateGroupRequest) Reset() {
@@ -129,6 +133,27 @@ func (x *CreateGroupRequest) GetGroupEndDate() string {
 	return ""
 }
 
+func (x *CreateGroupRequest) GetForce() bool {
+	if x != nil {
+		return x.Force
+	}
+	return false
+}
+
+func (x *CreateGroupRequest) GetActionById() string {
+	if x != nil {
+		return x.ActionById
+	}
+	return ""
+}
+
+func (x *CreateGroupRequest) GetActionByName() string {
+	if x != nil {
+		return x.ActionByName
+	}
+	return ""
+}
+
 type CreateStudentRequest struct {
 	state             protoimpl.MessageState `protogen:"open.v1"`
 	PhoneNumber       string                 `protobuf:"bytes,1,opt,name=phoneNumber,proto3" json:"phoneNumber,omitempty"`
@@ -393,7 +418,7 @@ var File_education_proto protoreflect.FileDescriptor
 
 const file_education_proto_rawDesc = "" +
 	"\n" +
-	"\x0feducation.proto\x12\teducation\x1a\fcommon.proto\"\x98\x02\n" +
+	"\x0feducation.proto\x12\teducation\x1a\fcommon.proto\"\xf2\x02\n" +
 	"\x12CreateGroupRequest\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1a\n" +
 	"\bcourseId\x18\x02 \x01(\x05R\bcourseId\x12\x1c\n" +
@@ -403,7 +428,13 @@ const file_education_proto_rawDesc = "" +
 	"\x06roomId\x18\x06 \x01(\x05R\x06roomId\x12(\n" +
 	"\x0flessonStartTime\x18\a \x01(\tR\x0flessonStartTime\x12&\n" +
 	"\x0egroupStartDate\x18\b \x01(\tR\x0egroupStartDate\x12\"\n" +
-	"\fgroupEndDate\x18\t \x01(\tR\fgroupEndDate\"\xee\x02\n" +
+	"\fgroupEndDate\x18\t \x01(\tR\fgroupEndDate\x12\x14\n" +
+	"\x05force\x18\n" +
+	" \x01(\bR\x05force\x12\x1e\n" +
+	"\n" +
+	"actionById\x18\v \x01(\tR\n" +
+	"actionById\x12\"\n" +
+	"\factionByName\x18\f \x01(\tR\factionByName\"\xee\x02\n" +
 	"\x14CreateStudentRequest\x12 \n" +
 	"\vphoneNumber\x18\x01 \x01(\tR\vphoneNumber\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +